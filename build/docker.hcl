@@ -1,7 +1,7 @@
 # docker.hcl
 
 group "dev" {
-  targets = ["controller", "gateway", "store", "timer", "trigger"]
+  targets = ["controller", "gateway", "store", "timer", "trigger", "mirror"]
 }
 
 variable "TAG" {
@@ -36,4 +36,10 @@ target "trigger" {
   dockerfile = "images/trigger/Dockerfile"
   tags = ["public.ecr.aws/vanus/trigger:${TAG}"]
   platforms = ["linux/amd64", "linux/arm64"]
+}
+
+target "mirror" {
+  dockerfile = "images/mirror/Dockerfile"
+  tags = ["public.ecr.aws/vanus/mirror:${TAG}"]
+  platforms = ["linux/amd64", "linux/arm64"]
 }
\ No newline at end of file
@@ -21,12 +21,17 @@ import (
 
 	eb "github.com/linkall-labs/vanus/client/internal/vanus/eventbus"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/consumer"
 	"github.com/linkall-labs/vanus/client/pkg/eventbus"
 	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Client interface {
 	Eventbus(ctx context.Context, ebName string) api.Eventbus
+	// ConsumerGroup returns a member of the consumer group which consumes the eventbus directly.
+	ConsumerGroup(ctx context.Context, group, ebName string, opts ...consumer.Option) consumer.ConsumerGroup
 	Disconnect(ctx context.Context)
 }
 
@@ -34,6 +39,7 @@ type client struct {
 	// Endpoints is a list of URLs.
 	Endpoints  []string
 	eventbuses map[string]api.Eventbus
+	opts       *Options
 
 	mu     sync.RWMutex
 	tracer *tracing.Tracer
@@ -59,8 +65,14 @@ func (c *client) Eventbus(ctx context.Context, ebName string) api.Eventbus {
 		var ok bool
 		if bus, ok = c.eventbuses[ebName]; !ok { // double check
 			cfg := &eb.Config{
-				Endpoints: c.Endpoints,
-				Name:      ebName,
+				Endpoints:         c.Endpoints,
+				Name:              ebName,
+				RetryTimes:        c.opts.RetryTimes,
+				RefreshBackoff:    c.opts.RefreshBackoff,
+				MaxRefreshBackoff: c.opts.MaxRefreshBackoff,
+				AppendTimeout:     c.opts.AppendTimeout,
+				ReadTimeout:       c.opts.ReadTimeout,
+				LookupTimeout:     c.opts.LookupTimeout,
 			}
 			bus = eventbus.NewEventbus(cfg)
 			c.eventbuses[cfg.Name] = bus
@@ -70,6 +82,12 @@ func (c *client) Eventbus(ctx context.Context, ebName string) api.Eventbus {
 	return bus
 }
 
+func (c *client) ConsumerGroup(ctx context.Context, group, ebName string,
+	opts ...consumer.Option) consumer.ConsumerGroup {
+	ctrl := cluster.NewClusterController(c.Endpoints, insecure.NewCredentials()).EventbusService().RawClient()
+	return consumer.NewConsumerGroup(group, ebName, c.Eventbus(ctx, ebName), ctrl, opts...)
+}
+
 func (c *client) Disconnect(ctx context.Context) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -79,12 +97,17 @@ func (c *client) Disconnect(ctx context.Context) {
 	c.eventbuses = make(map[string]api.Eventbus, 0)
 }
 
-func Connect(endpoints []string) Client {
+func Connect(endpoints []string, opts ...Option) Client {
 	if len(endpoints) == 0 {
 		return nil
 	}
+	options := &Options{}
+	for _, opt := range opts {
+		opt(options)
+	}
 	return &client{
 		Endpoints:  endpoints,
 		eventbuses: make(map[string]api.Eventbus, 0),
+		opts:       options,
 	}
 }
@@ -0,0 +1,58 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	// standard libraries.
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	// third-party project.
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/consumer"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	c := client.Connect([]string{"localhost:2048"})
+	defer c.Disconnect(context.Background())
+	// run several processes with the same group to share the eventlogs of quick-start.
+	cg := c.ConsumerGroup(ctx, "quick-start-group", "quick-start",
+		consumer.WithConsumeFromWhere(api.ConsumeFromWhereEarliest),
+		consumer.WithOnAssigned(func(_ context.Context, ids []uint64) {
+			log.Printf("assigned eventlogs: %v\n", ids)
+		}),
+		consumer.WithOnRevoked(func(_ context.Context, ids []uint64) {
+			log.Printf("revoked eventlogs: %v\n", ids)
+		}))
+	err := cg.Consume(ctx, func(_ context.Context, eventlogID uint64, events []*ce.Event) error {
+		for _, e := range events {
+			log.Printf("eventlog: %d, event: %s\n", eventlogID, e.ID())
+		}
+		return nil
+	})
+	if err != nil {
+		log.Print(err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"log"
+
+	// third-party project.
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/producer"
+)
+
+func main() {
+	ctx := context.Background()
+
+	c := client.Connect([]string{"localhost:2048"})
+	defer c.Disconnect(ctx)
+	// subscribe with --dedup-key-attribute xvanusproducerkey to drop the events duplicated by retries.
+	p := producer.NewProducer(c.Eventbus(ctx, "quick-start").Writer(), producer.WithIdempotence(""))
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+
+	for i := 0; i < 100; i++ {
+		event := ce.NewEvent()
+		event.SetID(uuid.NewString())
+		event.SetSource("event-source")
+		event.SetType("event-type")
+		_ = event.SetData(ce.TextPlain, fmt.Sprintf("hello world %d", i))
+		err := p.Send(ctx, &event, func(e *ce.Event, eid string, err error) {
+			if err != nil {
+				log.Printf("event %s failed: %s\n", e.ID(), err)
+				return
+			}
+			log.Printf("event %s appended, eid: %s\n", e.ID(), eid)
+		})
+		if err != nil {
+			log.Print(err.Error())
+		}
+	}
+	if err := p.Flush(ctx); err != nil {
+		log.Print(err.Error())
+	}
+}
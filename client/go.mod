@@ -3,8 +3,10 @@ module github.com/linkall-labs/vanus/client
 go 1.18
 
 require (
+	github.com/aws/aws-sdk-go-v2 v1.16.11
 	github.com/cloudevents/sdk-go/v2 v2.11.0
 	github.com/golang/mock v1.6.0
+	github.com/google/uuid v1.3.0
 	github.com/linkall-labs/vanus/observability v0.5.1
 	github.com/linkall-labs/vanus/pkg v0.5.1
 	github.com/linkall-labs/vanus/proto v0.5.1
@@ -17,11 +19,11 @@ require (
 )
 
 require (
+	github.com/aws/smithy-go v1.12.1 // indirect
 	github.com/cenkalti/backoff/v4 v4.1.3 // indirect
 	github.com/go-logr/logr v1.2.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
-	github.com/google/uuid v1.3.0 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.0 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
@@ -0,0 +1,85 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadline
+
+import (
+	// standard libraries.
+	"context"
+	"time"
+)
+
+// The operations of client take multiple hops, e.g. looking up the route, appending and retrying after
+// the route is refreshed. They share the deadline of caller as the budget, each hop is bounded by its
+// own timeout and the backoff before retrying never takes the budget the retry needs.
+
+const (
+	// minRetryBudget is the least time left for a retry, the retry isn't attempted if there is less.
+	minRetryBudget = 10 * time.Millisecond
+)
+
+// WithDefault returns a context with the default timeout of the operation if ctx has no deadline, the
+// deadline of caller takes precedence. The timeout <= 0 means no default.
+func WithDefault(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// WithHop returns a context for a hop of the operation, it's bounded by both the timeout of hop and the
+// deadline of ctx. The timeout <= 0 means the hop is only bounded by ctx.
+func WithHop(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// Backoff returns how long to wait before a retry, it's at most half of the budget left so that the
+// retry has the other half. It returns false if the budget left isn't enough for a retry.
+func Backoff(ctx context.Context, backoff time.Duration) (time.Duration, bool) {
+	dl, ok := ctx.Deadline()
+	if !ok {
+		return backoff, true
+	}
+	left := time.Until(dl)
+	if left < 2*minRetryBudget {
+		return 0, false
+	}
+	if backoff > left/2 {
+		backoff = left / 2
+	}
+	return backoff, true
+}
+
+// Sleep waits for the backoff bounded by the budget left, it returns false if the retry shouldn't be
+// attempted because the budget is used up or ctx is done.
+func Sleep(ctx context.Context, backoff time.Duration) bool {
+	d, ok := Backoff(ctx, backoff)
+	if !ok {
+		return false
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadline
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithDefault(t *testing.T) {
+	ctx, cancel := WithDefault(context.Background(), 0)
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Errorf("no default timeout, the context shouldn't have deadline")
+	}
+
+	ctx, cancel = WithDefault(context.Background(), time.Second)
+	defer cancel()
+	dl, ok := ctx.Deadline()
+	if !ok || time.Until(dl) > time.Second {
+		t.Errorf("the default timeout isn't applied, deadline: %v", dl)
+	}
+
+	// the deadline of caller takes precedence.
+	caller, cancelCaller := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelCaller()
+	ctx, cancel = WithDefault(caller, time.Second)
+	defer cancel()
+	if dl, _ = ctx.Deadline(); time.Until(dl) < time.Minute {
+		t.Errorf("the deadline of caller is overridden, deadline: %v", dl)
+	}
+}
+
+func TestWithHop(t *testing.T) {
+	caller, cancelCaller := context.WithTimeout(context.Background(), time.Hour)
+	defer cancelCaller()
+	ctx, cancel := WithHop(caller, time.Second)
+	defer cancel()
+	if dl, _ := ctx.Deadline(); time.Until(dl) > time.Second {
+		t.Errorf("the hop isn't bounded by its timeout, deadline: %v", dl)
+	}
+
+	caller, cancelCaller = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancelCaller()
+	ctx, cancel = WithHop(caller, time.Hour)
+	defer cancel()
+	if dl, _ := ctx.Deadline(); time.Until(dl) > 100*time.Millisecond {
+		t.Errorf("the hop exceeds the deadline of caller, deadline: %v", dl)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	if d, ok := Backoff(context.Background(), time.Second); !ok || d != time.Second {
+		t.Errorf("got %v %v, want the backoff without deadline", d, ok)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	if d, ok := Backoff(ctx, 100*time.Millisecond); !ok || d != 100*time.Millisecond {
+		t.Errorf("got %v %v, want the backoff within budget", d, ok)
+	}
+	if d, ok := Backoff(ctx, 3*time.Second); !ok || d > 250*time.Millisecond {
+		t.Errorf("got %v %v, want at most half of the budget", d, ok)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, ok := Backoff(ctx, time.Millisecond); ok {
+		t.Errorf("the retry shouldn't be attempted without enough budget")
+	}
+	if Sleep(ctx, time.Millisecond) {
+		t.Errorf("the retry shouldn't be attempted without enough budget")
+	}
+}
@@ -14,8 +14,19 @@
 
 package eventbus
 
+import "time"
+
 // Config is the configuration of EventBus.
 type Config struct {
 	Endpoints []string
 	Name      string
+	// RetryTimes, RefreshBackoff and MaxRefreshBackoff are passed to the eventlogs, see eventlog.Config.
+	RetryTimes        int
+	RefreshBackoff    time.Duration
+	MaxRefreshBackoff time.Duration
+	// AppendTimeout and ReadTimeout are the default timeouts if the context of caller has no deadline.
+	AppendTimeout time.Duration
+	ReadTimeout   time.Duration
+	// LookupTimeout bounds each lookup of the route, it's passed to the eventlogs too.
+	LookupTimeout time.Duration
 }
@@ -14,8 +14,18 @@
 
 package eventlog
 
+import "time"
+
 // Config is the configuration of EventLog.
 type Config struct {
 	Endpoints []string
 	ID        uint64
+	// RetryTimes is the max times to retry an append failed by the stale route.
+	RetryTimes int
+	// RefreshBackoff is the initial backoff of refreshing the route, it doubles in each retry until
+	// MaxRefreshBackoff.
+	RefreshBackoff    time.Duration
+	MaxRefreshBackoff time.Duration
+	// LookupTimeout bounds each lookup of the route, 0 means it's only bounded by the caller.
+	LookupTimeout time.Duration
 }
@@ -67,37 +67,43 @@ func (s *BlockStore) Close() {
 	s.client.Close()
 }
 
-func (s *BlockStore) Append(ctx context.Context, block uint64, event *ce.Event) (int64, error) {
+// Append appends the event to block, and returns the offset and the stime of the event.
+func (s *BlockStore) Append(
+	ctx context.Context, block uint64, event *ce.Event, ack segpb.AckLevel,
+) (int64, int64, error) {
 	_ctx, span := s.tracer.Start(ctx, "Append")
 	defer span.End()
 
 	eventpb, err := codec.ToProto(event)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
 	req := &segpb.AppendToBlockRequest{
 		BlockId: block,
 		Events: &cepb.CloudEventBatch{
 			Events: []*cepb.CloudEvent{eventpb},
 		},
+		AckLevel: ack,
 	}
 
 	client, err := s.client.Get(_ctx)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
 
 	res, err := client.(segpb.SegmentServerClient).AppendToBlock(_ctx, req)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
 	// TODO(Y. F. Zhang): batch events
-	return res.GetOffsets()[0], nil
+	return res.GetOffsets()[0], res.GetStime(), nil
 }
 
+// Read returns the events from offset and the offset to read next, which may be beyond the last event
+// returned if some events are skipped by the filter.
 func (s *BlockStore) Read(
-	ctx context.Context, block uint64, offset int64, size int16, pollingTimeout uint32,
-) ([]*ce.Event, error) {
+	ctx context.Context, block uint64, offset int64, size int16, pollingTimeout uint32, filter *segpb.EventFilter,
+) ([]*ce.Event, int64, error) {
 	ctx, span := s.tracer.Start(ctx, "Append")
 	defer span.End()
 
@@ -106,16 +112,17 @@ func (s *BlockStore) Read(
 		Offset:         offset,
 		Number:         int64(size),
 		PollingTimeout: pollingTimeout,
+		Filter:         filter,
 	}
 
 	client, err := s.client.Get(ctx)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	resp, err := client.(segpb.SegmentServerClient).ReadFromBlock(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	if batch := resp.GetEvents(); batch != nil {
@@ -125,15 +132,23 @@ func (s *BlockStore) Read(
 				event, err2 := codec.FromProto(eventpb)
 				if err2 != nil {
 					// TODO: return events or error?
-					return events, err2
+					return events, offset + int64(len(events)), err2
 				}
 				events = append(events, event)
 			}
-			return events, nil
+			return events, nextOffset(resp, offset, len(events)), nil
 		}
 	}
 
-	return []*ce.Event{}, err
+	return []*ce.Event{}, nextOffset(resp, offset, 0), err
+}
+
+// nextOffset falls back to the offset after the events if the segment server doesn't support filters.
+func nextOffset(resp *segpb.ReadFromBlockResponse, offset int64, num int) int64 {
+	if next := resp.GetNextOffset(); next > offset {
+		return next
+	}
+	return offset + int64(num)
 }
 
 func (s *BlockStore) LookupOffset(ctx context.Context, blockID uint64, t time.Time) (int64, error) {
@@ -157,24 +172,28 @@ func (s *BlockStore) LookupOffset(ctx context.Context, blockID uint64, t time.Ti
 	return res.Offset, nil
 }
 
-func (s *BlockStore) AppendBatch(ctx context.Context, block uint64, event *cepb.CloudEventBatch) (int64, error) {
+// AppendBatch appends the events to block, and returns the offset of the first event and the
+// stime of the events.
+func (s *BlockStore) AppendBatch(
+	ctx context.Context, block uint64, event *cepb.CloudEventBatch, ack segpb.AckLevel,
+) (int64, int64, error) {
 	_ctx, span := s.tracer.Start(ctx, "AppendBatch")
 	defer span.End()
 
 	req := &segpb.AppendToBlockRequest{
-		BlockId: block,
-		Events:  event,
+		BlockId:  block,
+		Events:   event,
+		AckLevel: ack,
 	}
 
 	client, err := s.client.Get(_ctx)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
 
 	res, err := client.(segpb.SegmentServerClient).AppendToBlock(_ctx, req)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
-	// TODO(Y. F. Zhang): batch events
-	return res.GetOffsets()[0], nil
+	return res.GetOffsets()[0], res.GetStime(), nil
 }
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	api "github.com/linkall-labs/vanus/client/pkg/api"
+	consumer "github.com/linkall-labs/vanus/client/pkg/consumer"
 )
 
 // MockClient is a mock of Client interface.
@@ -35,6 +36,25 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// ConsumerGroup mocks base method.
+func (m *MockClient) ConsumerGroup(ctx context.Context, group, ebName string, opts ...consumer.Option) consumer.ConsumerGroup {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, group, ebName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumerGroup", varargs...)
+	ret0, _ := ret[0].(consumer.ConsumerGroup)
+	return ret0
+}
+
+// ConsumerGroup indicates an expected call of ConsumerGroup.
+func (mr *MockClientMockRecorder) ConsumerGroup(ctx, group, ebName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, group, ebName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroup", reflect.TypeOf((*MockClient)(nil).ConsumerGroup), varargs...)
+}
+
 // Disconnect mocks base method.
 func (m *MockClient) Disconnect(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -50,7 +70,7 @@ func (mr *MockClientMockRecorder) Disconnect(ctx interface{}) *gomock.Call {
 // Eventbus mocks base method.
 func (m *MockClient) Eventbus(ctx context.Context, ebName string) api.Eventbus {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EventbusService", ctx, ebName)
+	ret := m.ctrl.Call(m, "Eventbus", ctx, ebName)
 	ret0, _ := ret[0].(api.Eventbus)
 	return ret0
 }
@@ -58,5 +78,5 @@ func (m *MockClient) Eventbus(ctx context.Context, ebName string) api.Eventbus {
 // Eventbus indicates an expected call of Eventbus.
 func (mr *MockClientMockRecorder) Eventbus(ctx, ebName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventbusService", reflect.TypeOf((*MockClient)(nil).Eventbus), ctx, ebName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eventbus", reflect.TypeOf((*MockClient)(nil).Eventbus), ctx, ebName)
 }
@@ -0,0 +1,68 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import "time"
+
+type Option func(*Options)
+
+// Options configures how the client recovers from the stale route, e.g. the leader of segment changed
+// or the block was archived. The zero values mean the defaults.
+type Options struct {
+	// RetryTimes is the max times to retry an append.
+	RetryTimes int
+	// RefreshBackoff is the initial backoff of refreshing the route, it doubles in each retry until
+	// MaxRefreshBackoff.
+	RefreshBackoff    time.Duration
+	MaxRefreshBackoff time.Duration
+	// AppendTimeout and ReadTimeout are the default timeouts of appending and reading if the context of
+	// caller has no deadline, the retries of them are within the timeouts.
+	AppendTimeout time.Duration
+	ReadTimeout   time.Duration
+	// LookupTimeout bounds each lookup of the route from controller, so that a lookup doesn't use up
+	// the budget of the append or the read.
+	LookupTimeout time.Duration
+}
+
+func WithRetryTimes(n int) Option {
+	return func(options *Options) {
+		options.RetryTimes = n
+	}
+}
+
+func WithRefreshBackoff(backoff, maxBackoff time.Duration) Option {
+	return func(options *Options) {
+		options.RefreshBackoff = backoff
+		options.MaxRefreshBackoff = maxBackoff
+	}
+}
+
+func WithAppendTimeout(timeout time.Duration) Option {
+	return func(options *Options) {
+		options.AppendTimeout = timeout
+	}
+}
+
+func WithReadTimeout(timeout time.Duration) Option {
+	return func(options *Options) {
+		options.ReadTimeout = timeout
+	}
+}
+
+func WithLookupTimeout(timeout time.Duration) Option {
+	return func(options *Options) {
+		options.LookupTimeout = timeout
+	}
+}
@@ -17,6 +17,9 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+
 	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 )
@@ -33,11 +36,34 @@ type Eventbus interface {
 type BusWriter interface {
 	AppendOne(ctx context.Context, event *ce.Event, opts ...WriteOption) (eid string, err error)
 	AppendMany(ctx context.Context, events []*ce.Event, opts ...WriteOption) (eid string, err error)
-	AppendBatch(ctx context.Context, events *cloudevents.CloudEventBatch, opts ...WriteOption) (err error)
+	AppendBatch(ctx context.Context, events *cloudevents.CloudEventBatch, opts ...WriteOption) (*Placement, error)
+	// AppendWithPlacement appends the events in one batch like AppendMany, and returns where the
+	// events are placed.
+	AppendWithPlacement(ctx context.Context, events []*ce.Event, opts ...WriteOption) (*Placement, error)
+}
+
+// Placement is where the events appended in one batch are placed, the events are placed in the
+// same eventlog with contiguous offsets starting from Offset.
+type Placement struct {
+	EventlogID uint64
+	Offset     int64
+	// Stime is the millisecond timestamp when the events are written to block.
+	Stime int64
+}
+
+// EventID returns the ID of the i-th event in the batch.
+func (p *Placement) EventID(i int) string {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[0:8], p.EventlogID)
+	binary.BigEndian.PutUint64(buf[8:16], uint64(p.Offset+int64(i)))
+	return base64.StdEncoding.EncodeToString(buf[:])
 }
 
 type BusReader interface {
 	Read(ctx context.Context, opts ...ReadOption) ([]*ce.Event, int64, uint64, error)
+	// Seek moves the offset of read policy to the position in the eventlog picked by the policy, and
+	// returns the offset. It's used with the policy reading one eventlog, e.g. the manually policy.
+	Seek(ctx context.Context, pos Position, opts ...ReadOption) (int64, error)
 }
 
 type Eventlog interface {
@@ -148,15 +148,16 @@ func (m *MockBusWriter) EXPECT() *MockBusWriterMockRecorder {
 }
 
 // AppendBatch mocks base method.
-func (m *MockBusWriter) AppendBatch(ctx context.Context, events *cloudevents.CloudEventBatch, opts ...WriteOption) error {
+func (m *MockBusWriter) AppendBatch(ctx context.Context, events *cloudevents.CloudEventBatch, opts ...WriteOption) (*Placement, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, events}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "AppendBatch", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AppendBatch indicates an expected call of AppendBatch.
@@ -206,6 +207,26 @@ func (mr *MockBusWriterMockRecorder) AppendOne(ctx, event interface{}, opts ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendOne", reflect.TypeOf((*MockBusWriter)(nil).AppendOne), varargs...)
 }
 
+// AppendWithPlacement mocks base method.
+func (m *MockBusWriter) AppendWithPlacement(ctx context.Context, events []*v2.Event, opts ...WriteOption) (*Placement, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, events}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendWithPlacement", varargs...)
+	ret0, _ := ret[0].(*Placement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendWithPlacement indicates an expected call of AppendWithPlacement.
+func (mr *MockBusWriterMockRecorder) AppendWithPlacement(ctx, events interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, events}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendWithPlacement", reflect.TypeOf((*MockBusWriter)(nil).AppendWithPlacement), varargs...)
+}
+
 // MockBusReader is a mock of BusReader interface.
 type MockBusReader struct {
 	ctrl     *gomock.Controller
@@ -251,6 +272,26 @@ func (mr *MockBusReaderMockRecorder) Read(ctx interface{}, opts ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockBusReader)(nil).Read), varargs...)
 }
 
+// Seek mocks base method.
+func (m *MockBusReader) Seek(ctx context.Context, pos Position, opts ...ReadOption) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, pos}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Seek", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seek indicates an expected call of Seek.
+func (mr *MockBusReaderMockRecorder) Seek(ctx, pos interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, pos}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockBusReader)(nil).Seek), varargs...)
+}
+
 // MockEventlog is a mock of Eventlog interface.
 type MockEventlog struct {
 	ctrl     *gomock.Controller
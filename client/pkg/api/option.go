@@ -14,15 +14,39 @@
 
 package api
 
+import (
+	"github.com/linkall-labs/vanus/client/pkg/blob"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
 const (
 	DefaultPollingTimeout = 3000 // in milliseconds.
 )
 
 type WriteOption func(*WriteOptions)
 
+// AckLevel is when an append is acknowledged by segment server.
+type AckLevel int8
+
+const (
+	// AckQuorum acknowledges after the events are committed by the quorum of replicas.
+	AckQuorum AckLevel = iota
+	// AckLeader acknowledges after the events are persisted to the WAL of leader, the events may
+	// be lost if the leader crashes before replicating them.
+	AckLeader
+	// AckNone acknowledges after the events are accepted by leader without waiting for
+	// persistence, it has the lowest latency and the weakest durability.
+	AckNone
+)
+
 type WriteOptions struct {
-	Policy WritePolicy
-	Oneway bool
+	Policy   WritePolicy
+	Oneway   bool
+	AckLevel AckLevel
+	// BlobStore keeps the data of events larger than BlobThreshold bytes, the eventlog only keeps
+	// the reference to the data.
+	BlobStore     blob.Store
+	BlobThreshold int
 }
 
 func (wo *WriteOptions) Apply(opts ...WriteOption) {
@@ -33,8 +57,11 @@ func (wo *WriteOptions) Apply(opts ...WriteOption) {
 
 func (wo *WriteOptions) Copy() *WriteOptions {
 	return &WriteOptions{
-		Oneway: wo.Oneway,
-		Policy: wo.Policy,
+		Oneway:        wo.Oneway,
+		Policy:        wo.Policy,
+		AckLevel:      wo.AckLevel,
+		BlobStore:     wo.BlobStore,
+		BlobThreshold: wo.BlobThreshold,
 	}
 }
 
@@ -44,6 +71,12 @@ type ReadOptions struct {
 	BatchSize      int
 	PollingTimeout int64
 	Policy         ReadPolicy
+	// Filter is evaluated by segment servers, the events which don't match it are skipped. The
+	// events read are not contiguous, the reader forwards the policy by itself.
+	Filter *segpb.EventFilter
+	// BlobStore resolves the data of events offloaded by writers, nil means the events are returned
+	// with the reference.
+	BlobStore blob.Store
 }
 
 func (ro *ReadOptions) Apply(opts ...ReadOption) {
@@ -57,6 +90,8 @@ func (ro *ReadOptions) Copy() *ReadOptions {
 		BatchSize:      ro.BatchSize,
 		PollingTimeout: ro.PollingTimeout,
 		Policy:         ro.Policy,
+		Filter:         ro.Filter,
+		BlobStore:      ro.BlobStore,
 	}
 }
 
@@ -0,0 +1,70 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+type PositionKind int8
+
+const (
+	PositionEarliest PositionKind = iota
+	PositionLatest
+	PositionOffset
+	PositionTime
+)
+
+// Position is where a reader seeks to in an eventlog.
+type Position struct {
+	Kind   PositionKind
+	Offset int64
+	Time   time.Time
+}
+
+// Earliest is the position of the first event which hasn't expired.
+func Earliest() Position {
+	return Position{Kind: PositionEarliest}
+}
+
+// Latest is the position after the last event, a reader seeking to it reads the new events only.
+func Latest() Position {
+	return Position{Kind: PositionLatest}
+}
+
+func AtOffset(offset int64) Position {
+	return Position{Kind: PositionOffset, Offset: offset}
+}
+
+// AtTime is the position of the first event stored at or after t, it's looked up with the time index
+// of blocks.
+func AtTime(t time.Time) Position {
+	return Position{Kind: PositionTime, Time: t}
+}
+
+func (p Position) String() string {
+	switch p.Kind {
+	case PositionEarliest:
+		return "earliest"
+	case PositionLatest:
+		return "latest"
+	case PositionOffset:
+		return fmt.Sprintf("offset(%d)", p.Offset)
+	case PositionTime:
+		return fmt.Sprintf("time(%s)", p.Time.Format(time.RFC3339Nano))
+	}
+	return "unknown"
+}
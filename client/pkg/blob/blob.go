@@ -0,0 +1,155 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blob offloads the data of large events to an external blob store, the eventlog only keeps
+// the event with a reference to the data, which is resolved transparently on read.
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const (
+	// ExtensionRef is the extension of an offloaded event, whose value is the key of data in store.
+	ExtensionRef = "xvanusblobref"
+	// ExtensionSize is the extension of an offloaded event, whose value is the size of data.
+	ExtensionSize = "xvanusblobsize"
+)
+
+var (
+	ErrInvalidKey = errors.New("blob: invalid key")
+	ErrCorrupted  = errors.New("blob: data doesn't match the reference")
+)
+
+// Store is an external blob store, such as a shared filesystem or an object storage like S3.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+type fileStore struct {
+	dir string
+}
+
+// Make sure fileStore implements Store.
+var _ Store = (*fileStore)(nil)
+
+// NewFileStore returns a Store which keeps each blob as a file in dir, the dir should be shared by
+// the writers and readers, e.g. a NFS mount.
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	// The key is the digest of data, an existing blob has the same content.
+	if _, err = os.Stat(path); err == nil {
+		return nil
+	}
+	f, err := os.CreateTemp(s.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *fileStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+func (s *fileStore) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+// validKey the key is a name without path, so that it doesn't escape from the dir or prefix of store.
+func validKey(key string) bool {
+	return key != "" && filepath.Base(key) == key && key[0] != '.'
+}
+
+// Offload puts the data of event into store if it's larger than threshold, and returns a copy of the
+// event whose data is replaced by the reference. The event itself is returned if it isn't offloaded.
+func Offload(ctx context.Context, store Store, e *ce.Event, threshold int) (*ce.Event, error) {
+	if store == nil || len(e.DataEncoded) <= threshold {
+		return e, nil
+	}
+	if _, ok := e.Extensions()[ExtensionRef]; ok {
+		return e, nil
+	}
+
+	sum := sha256.Sum256(e.DataEncoded)
+	key := hex.EncodeToString(sum[:])
+	if err := store.Put(ctx, key, e.DataEncoded); err != nil {
+		return nil, fmt.Errorf("put blob %s: %w", key, err)
+	}
+
+	offloaded := e.Clone()
+	offloaded.SetExtension(ExtensionRef, key)
+	offloaded.SetExtension(ExtensionSize, int32(len(e.DataEncoded)))
+	offloaded.DataEncoded = nil
+	return &offloaded, nil
+}
+
+// Resolve restores the data of event from store if it was offloaded, and removes the reference.
+func Resolve(ctx context.Context, store Store, e *ce.Event) error {
+	v, ok := e.Extensions()[ExtensionRef]
+	if !ok {
+		return nil
+	}
+	key, err := types.ToString(v)
+	if err != nil {
+		return ErrInvalidKey
+	}
+	data, err := store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("get blob %s: %w", key, err)
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != key {
+		return ErrCorrupted
+	}
+
+	e.DataEncoded = data
+	e.SetExtension(ExtensionRef, nil)
+	e.SetExtension(ExtensionSize, nil)
+	return nil
+}
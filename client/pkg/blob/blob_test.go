@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+)
+
+func newEvent(data []byte) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType("type")
+	_ = e.SetData(ce.ApplicationJSON, data)
+	return &e
+}
+
+func TestOffloadAndResolve(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small := newEvent([]byte(`"small"`))
+	e, err := Offload(ctx, store, small, 16)
+	if err != nil || e != small {
+		t.Fatalf("Offload() of small event = %v, %v", e, err)
+	}
+
+	data := []byte(`"a large payload above the threshold"`)
+	large := newEvent(data)
+	e, err = Offload(ctx, store, large, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e == large || !bytes.Equal(large.Data(), data) {
+		t.Fatal("Offload() modified the original event")
+	}
+	if len(e.Data()) != 0 {
+		t.Fatalf("data of offloaded event = %q", e.Data())
+	}
+	if _, ok := e.Extensions()[ExtensionRef]; !ok {
+		t.Fatal("offloaded event has no reference")
+	}
+
+	if err = Resolve(ctx, store, e); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(e.Data(), data) {
+		t.Fatalf("data of resolved event = %q", e.Data())
+	}
+	if len(e.Extensions()) != 0 {
+		t.Fatalf("extensions of resolved event = %v", e.Extensions())
+	}
+
+	// resolving an event which isn't offloaded is a no-op.
+	if err = Resolve(ctx, store, small); err != nil || !bytes.Equal(small.Data(), []byte(`"small"`)) {
+		t.Fatalf("Resolve() of small event = %q, %v", small.Data(), err)
+	}
+}
+
+func TestResolveCorrupted(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := Offload(ctx, store, newEvent([]byte(`"a large payload above the threshold"`)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := e.Extensions()[ExtensionRef].(string)
+	if err = os.WriteFile(filepath.Join(dir, key), []byte("tampered"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = Resolve(ctx, store, e); !errors.Is(err, ErrCorrupted) {
+		t.Fatalf("Resolve() of tampered blob = %v", err)
+	}
+
+	e.SetExtension(ExtensionRef, "../escape")
+	if err = Resolve(ctx, store, e); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("Resolve() of invalid key = %v", err)
+	}
+}
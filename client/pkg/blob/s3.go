@@ -0,0 +1,148 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	s3Service       = "s3"
+	s3Timeout       = 30 * time.Second
+	// emptyPayloadHash is the SHA256 of empty body, which is signed for GET.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+var ErrNotFound = errors.New("blob: not found")
+
+// S3Config is an object storage compatible with the S3 API, e.g. AWS S3, MinIO or the interoperable
+// API of Google Cloud Storage.
+type S3Config struct {
+	// Endpoint is http[s]://host[:port], defaults to the AWS S3 endpoint of region.
+	Endpoint string
+	// Region defaults to us-east-1.
+	Region string
+	Bucket string
+	// Prefix is prepended to the keys of blobs, e.g. vanus/blob/.
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type s3Store struct {
+	cfg         S3Config
+	credentials aws.Credentials
+	signer      *v4.Signer
+	client      *http.Client
+}
+
+// Make sure s3Store implements Store.
+var _ Store = (*s3Store)(nil)
+
+// NewS3Store returns a Store which keeps each blob as an object in the bucket, the objects are
+// addressed in path style, so the bucket name needn't be a valid host name.
+func NewS3Store(cfg S3Config) (Store, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("blob: bucket is required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = defaultS3Region
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+	}
+	e, err := url.Parse(cfg.Endpoint)
+	if err != nil || (e.Scheme != "http" && e.Scheme != "https") || e.Host == "" {
+		return nil, errors.New("blob: endpoint must be http[s]://host[:port]")
+	}
+	cfg.Endpoint = e.Scheme + "://" + e.Host
+	return &s3Store{
+		cfg: cfg,
+		credentials: aws.Credentials{
+			AccessKeyID:     cfg.AccessKeyID,
+			SecretAccessKey: cfg.SecretAccessKey,
+		},
+		signer: v4.NewSigner(func(options *v4.SignerOptions) {
+			// the object keys are escaped once by S3.
+			options.DisableURIPathEscaping = true
+		}),
+		client: &http.Client{Timeout: s3Timeout},
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	if !validKey(key) {
+		return ErrInvalidKey
+	}
+	h := sha256.Sum256(data)
+	res, err := s.do(ctx, http.MethodPut, key, data, hex.EncodeToString(h[:]))
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	if !validKey(key) {
+		return nil, ErrInvalidKey
+	}
+	res, err := s.do(ctx, http.MethodGet, key, nil, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	return io.ReadAll(res.Body)
+}
+
+func (s *s3Store) do(ctx context.Context, method, key string, body []byte,
+	payloadHash string) (*http.Response, error) {
+	u := &url.URL{Path: "/" + s.cfg.Bucket + "/" + s.cfg.Prefix + key}
+	req, err := http.NewRequestWithContext(ctx, method, s.cfg.Endpoint+u.EscapedPath(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if err = s.signer.SignHTTP(ctx, s.credentials, req, payloadHash, s3Service,
+		s.cfg.Region, time.Now()); err != nil {
+		return nil, err
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < http.StatusMultipleChoices {
+		return res, nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	_ = res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	return nil, fmt.Errorf("blob: %s object responded %s: %s", method, res.Status, msg)
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3 keeps the objects in memory and checks that requests are signed with SigV4.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=ak/") ||
+		r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: map[string][]byte{}}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	if _, err := NewS3Store(S3Config{Endpoint: srv.URL}); err == nil {
+		t.Fatal("expected error without bucket")
+	}
+	if _, err := NewS3Store(S3Config{Endpoint: "minio:9000", Bucket: "vanus"}); err == nil {
+		t.Fatal("expected error of endpoint without scheme")
+	}
+	store, err := NewS3Store(S3Config{
+		Endpoint:        srv.URL,
+		Bucket:          "vanus",
+		Prefix:          "blob/",
+		AccessKeyID:     "ak",
+		SecretAccessKey: "sk",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte(`{"large":true}`)
+	if err = store.Put(ctx, "abc", data); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fake.objects["/vanus/blob/abc"]; !ok {
+		t.Fatalf("object isn't put in path style: %v", fake.objects)
+	}
+	got, err := store.Get(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("got %s, want %s", got, data)
+	}
+
+	if _, err = store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err = store.Put(ctx, "../abc", data); !errors.Is(err, ErrInvalidKey) {
+		t.Fatalf("expected ErrInvalidKey, got %v", err)
+	}
+}
+
+func TestS3Store_Unauthorized(t *testing.T) {
+	srv := httptest.NewServer(&fakeS3{objects: map[string][]byte{}})
+	defer srv.Close()
+
+	store, err := NewS3Store(S3Config{Endpoint: srv.URL, Bucket: "vanus", AccessKeyID: "other", SecretAccessKey: "sk"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Put(context.Background(), "abc", []byte("data")); err == nil ||
+		!strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected 403 error, got %v", err)
+	}
+}
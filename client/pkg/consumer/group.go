@@ -0,0 +1,341 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumer
+
+import (
+	"context"
+	stderr "errors"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	retryInterval = time.Second
+)
+
+var errConsuming = stderr.New("the consumer group is consuming")
+
+// Handler handles a batch of events read from an eventlog. The offset of the batch is committed after
+// Handler returns nil, otherwise the same batch is handled again.
+type Handler func(ctx context.Context, eventlogID uint64, events []*ce.Event) error
+
+// ConsumerGroup consumes an eventbus together with the other members of the same group. Each eventlog of
+// the eventbus is assigned to one member, and the assignment changes when members join or leave. Events
+// are delivered at least once, the events handled but not committed before a rebalance are handled again
+// by the member the eventlog is assigned to.
+type ConsumerGroup interface {
+	// Consume joins the group and handles the events of the assigned eventlogs, it blocks until ctx is
+	// done or the group is closed, and leaves the group before returning.
+	Consume(ctx context.Context, handler Handler) error
+	// Assignment returns the generation of the group and the eventlogs assigned to the member.
+	Assignment() (uint64, []uint64)
+	Close(ctx context.Context)
+}
+
+func NewConsumerGroup(group, eventbus string, bus api.Eventbus, ctrl ctrlpb.EventBusControllerClient,
+	opts ...Option) ConsumerGroup {
+	options := defaultOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &consumerGroup{
+		group:    group,
+		eventbus: eventbus,
+		bus:      bus,
+		ctrl:     ctrl,
+		opts:     options,
+	}
+}
+
+type consumerGroup struct {
+	group      string
+	eventbus   string
+	bus        api.Eventbus
+	ctrl       ctrlpb.EventBusControllerClient
+	opts       *Options
+	generation uint64
+	assignment []uint64
+	// stopConsuming stops the consuming of the current assignment.
+	stopConsuming context.CancelFunc
+	cancel        context.CancelFunc
+	done          chan struct{}
+	wg            sync.WaitGroup
+	mu            sync.RWMutex
+}
+
+func (cg *consumerGroup) Consume(ctx context.Context, handler Handler) error {
+	cg.mu.Lock()
+	if cg.cancel != nil {
+		cg.mu.Unlock()
+		return errConsuming
+	}
+	ctx, cg.cancel = context.WithCancel(ctx)
+	cg.done = make(chan struct{})
+	cg.mu.Unlock()
+	defer close(cg.done)
+	defer cg.leave()
+
+	ticker := time.NewTicker(cg.opts.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		if err := cg.heartbeat(ctx, handler); err != nil && ctx.Err() == nil {
+			log.Warning(ctx, "consumer group heartbeat failed", map[string]interface{}{
+				log.KeyError: err,
+				"group":      cg.group,
+				"member_id":  cg.opts.MemberID,
+			})
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (cg *consumerGroup) Assignment() (uint64, []uint64) {
+	cg.mu.RLock()
+	defer cg.mu.RUnlock()
+	return cg.generation, append([]uint64{}, cg.assignment...)
+}
+
+func (cg *consumerGroup) Close(ctx context.Context) {
+	cg.mu.RLock()
+	cancel, done := cg.cancel, cg.done
+	cg.mu.RUnlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// heartbeat keeps the member alive in the group, and switches to the new assignment after a rebalance.
+func (cg *consumerGroup) heartbeat(ctx context.Context, handler Handler) error {
+	res, err := cg.ctrl.ConsumerGroupHeartbeat(ctx, &ctrlpb.ConsumerGroupHeartbeatRequest{
+		Group:    cg.group,
+		Eventbus: cg.eventbus,
+		MemberId: cg.opts.MemberID,
+	})
+	if err != nil {
+		return err
+	}
+	generation, _ := cg.Assignment()
+	if res.Generation == generation {
+		return nil
+	}
+	cg.revoke(ctx)
+	return cg.assign(ctx, res, handler)
+}
+
+func (cg *consumerGroup) revoke(ctx context.Context) {
+	cg.mu.Lock()
+	stop, revoked := cg.stopConsuming, cg.assignment
+	cg.stopConsuming = nil
+	cg.assignment = nil
+	cg.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	stop()
+	cg.wg.Wait()
+	if cg.opts.OnRevoked != nil {
+		cg.opts.OnRevoked(ctx, revoked)
+	}
+}
+
+func (cg *consumerGroup) assign(ctx context.Context, res *ctrlpb.ConsumerGroupAssignment, handler Handler) error {
+	committed, err := cg.ctrl.GetConsumerGroupOffset(ctx, &ctrlpb.GetConsumerGroupOffsetRequest{Group: cg.group})
+	if err != nil {
+		return err
+	}
+	offsets := make(map[uint64]int64, len(committed.Offsets))
+	for _, o := range committed.Offsets {
+		offsets[o.EventlogId] = o.Offset
+	}
+	if cg.opts.OnAssigned != nil {
+		cg.opts.OnAssigned(ctx, res.EventlogIds)
+	}
+	consumeCtx, stop := context.WithCancel(ctx)
+	cg.mu.Lock()
+	cg.generation = res.Generation
+	cg.assignment = res.EventlogIds
+	cg.stopConsuming = stop
+	cg.mu.Unlock()
+	log.Info(ctx, "consumer group assigned", map[string]interface{}{
+		"group":      cg.group,
+		"member_id":  cg.opts.MemberID,
+		"generation": res.Generation,
+		"eventlogs":  res.EventlogIds,
+	})
+	for _, id := range res.EventlogIds {
+		offset, ok := offsets[id]
+		if !ok {
+			offset = -1
+		}
+		cg.wg.Add(1)
+		go func(id uint64, offset int64) {
+			defer cg.wg.Done()
+			cg.consumeEventlog(consumeCtx, res.Generation, id, offset, handler)
+		}(id, offset)
+	}
+	return nil
+}
+
+func (cg *consumerGroup) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), cg.opts.HeartbeatInterval)
+	defer cancel()
+	cg.revoke(ctx)
+	if _, err := cg.ctrl.LeaveConsumerGroup(ctx, &ctrlpb.LeaveConsumerGroupRequest{
+		Group:    cg.group,
+		MemberId: cg.opts.MemberID,
+	}); err != nil {
+		log.Warning(ctx, "leave consumer group failed", map[string]interface{}{
+			log.KeyError: err,
+			"group":      cg.group,
+			"member_id":  cg.opts.MemberID,
+		})
+	}
+	cg.mu.Lock()
+	cg.generation = 0
+	cg.cancel = nil
+	cg.mu.Unlock()
+}
+
+// consumeEventlog reads the eventlog from offset, a negative offset means nothing was committed and the
+// consuming starts from FromWhere. It returns when the generation is stale.
+func (cg *consumerGroup) consumeEventlog(ctx context.Context, generation, eventlogID uint64, offset int64,
+	handler Handler) {
+	var l api.Eventlog
+	var err error
+	for {
+		if l, err = cg.bus.GetLog(ctx, eventlogID); err == nil {
+			if offset < 0 {
+				offset, err = cg.initialOffset(ctx, l)
+			}
+			if err == nil {
+				break
+			}
+		}
+		log.Warning(ctx, "consumer group init eventlog failed", map[string]interface{}{
+			log.KeyError:      err,
+			log.KeyEventlogID: eventlogID,
+		})
+		if !util.SleepWithContext(ctx, retryInterval) {
+			return
+		}
+	}
+
+	p := policy.NewManuallyReadPolicy(l, offset)
+	reader := cg.bus.Reader(option.WithReadPolicy(p), option.WithBatchSize(cg.opts.BatchSize))
+	for {
+		events, off, _, err := reader.Read(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err == nil:
+		case errors.Is(err, errors.ErrOffsetOnEnd), errors.Is(err, errors.ErrTryAgain):
+			continue
+		case errors.Is(err, errors.ErrOffsetUnderflow):
+			// the events have expired, skip to the earliest one.
+			if earliest, err := l.EarliestOffset(ctx); err == nil {
+				p.Forward(int(earliest - p.Offset()))
+			}
+			continue
+		default:
+			log.Warning(ctx, "consumer group read events failed", map[string]interface{}{
+				log.KeyError:      err,
+				log.KeyEventlogID: eventlogID,
+				"offset":          p.Offset(),
+			})
+			if !util.SleepWithContext(ctx, retryInterval) {
+				return
+			}
+			continue
+		}
+		if len(events) == 0 {
+			continue
+		}
+		for _, e := range events {
+			delete(e.Extensions(), eventlog.XVanusLogOffset)
+		}
+		if !cg.handle(ctx, eventlogID, events, handler) {
+			return
+		}
+		next := off + int64(len(events))
+		if err = cg.commit(ctx, generation, eventlogID, next); errors.Is(err, errors.ErrGroupRebalanced) {
+			log.Info(ctx, "consumer group rebalanced, stop consuming eventlog", map[string]interface{}{
+				log.KeyEventlogID: eventlogID,
+				"generation":      generation,
+			})
+			return
+		} else if err != nil && ctx.Err() == nil {
+			// the offset is committed together with the next batch.
+			log.Warning(ctx, "consumer group commit offset failed", map[string]interface{}{
+				log.KeyError:      err,
+				log.KeyEventlogID: eventlogID,
+				"offset":          next,
+			})
+		}
+		p.Forward(len(events))
+	}
+}
+
+// handle calls handler until it succeeds, it returns false if ctx is done.
+func (cg *consumerGroup) handle(ctx context.Context, eventlogID uint64, events []*ce.Event, handler Handler) bool {
+	for {
+		err := handler(ctx, eventlogID, events)
+		if err == nil {
+			return true
+		}
+		log.Warning(ctx, "consumer group handle events failed", map[string]interface{}{
+			log.KeyError:      err,
+			log.KeyEventlogID: eventlogID,
+		})
+		if !util.SleepWithContext(ctx, retryInterval) {
+			return false
+		}
+	}
+}
+
+func (cg *consumerGroup) commit(ctx context.Context, generation, eventlogID uint64, offset int64) error {
+	_, err := cg.ctrl.CommitConsumerGroupOffset(ctx, &ctrlpb.CommitConsumerGroupOffsetRequest{
+		Group:      cg.group,
+		MemberId:   cg.opts.MemberID,
+		Generation: generation,
+		Offsets:    []*ctrlpb.ConsumerGroupOffset{{EventlogId: eventlogID, Offset: offset}},
+	})
+	return err
+}
+
+func (cg *consumerGroup) initialOffset(ctx context.Context, l api.Eventlog) (int64, error) {
+	if cg.opts.FromWhere == api.ConsumeFromWhereEarliest {
+		return l.EarliestOffset(ctx)
+	}
+	return l.LatestOffset(ctx)
+}
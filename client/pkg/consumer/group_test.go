@@ -0,0 +1,130 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumer
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestConsumerGroup_Consume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	ctrl := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+	bus := api.NewMockEventbus(mockCtrl)
+	reader := api.NewMockBusReader(mockCtrl)
+	el := api.NewMockEventlog(mockCtrl)
+	ctx := context.Background()
+
+	var committed int32
+	ctrl.EXPECT().ConsumerGroupHeartbeat(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, req *ctrlpb.ConsumerGroupHeartbeatRequest,
+			_ ...grpc.CallOption) (*ctrlpb.ConsumerGroupAssignment, error) {
+			if req.Group != "group" || req.Eventbus != "eb" || req.MemberId != "member" {
+				t.Errorf("unexpected heartbeat request: %v", req)
+			}
+			// the eventlog is revoked after the first batch is committed.
+			if atomic.LoadInt32(&committed) == 0 {
+				return &ctrlpb.ConsumerGroupAssignment{Group: "group", Generation: 1, EventlogIds: []uint64{1}}, nil
+			}
+			return &ctrlpb.ConsumerGroupAssignment{Group: "group", Generation: 2}, nil
+		})
+	ctrl.EXPECT().GetConsumerGroupOffset(gomock.Any(), gomock.Any()).Times(2).Return(
+		&ctrlpb.GetConsumerGroupOffsetResponse{
+			Offsets: []*ctrlpb.ConsumerGroupOffset{{EventlogId: 1, Offset: 5}},
+		}, nil)
+	ctrl.EXPECT().CommitConsumerGroupOffset(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, req *ctrlpb.CommitConsumerGroupOffsetRequest,
+			_ ...grpc.CallOption) (*emptypb.Empty, error) {
+			if req.Generation != 1 || len(req.Offsets) != 1 || req.Offsets[0].Offset != 7 {
+				t.Errorf("unexpected commit request: %v", req)
+			}
+			atomic.StoreInt32(&committed, 1)
+			return &emptypb.Empty{}, nil
+		})
+	ctrl.EXPECT().LeaveConsumerGroup(gomock.Any(), gomock.Any()).Times(1).Return(&emptypb.Empty{}, nil)
+	bus.EXPECT().GetLog(gomock.Any(), uint64(1)).Times(1).Return(el, nil)
+	bus.EXPECT().Reader(gomock.Any()).Times(1).DoAndReturn(func(opts ...api.ReadOption) api.BusReader {
+		ro := &api.ReadOptions{}
+		ro.Apply(opts...)
+		if ro.Policy.Offset() != 5 || ro.BatchSize != 2 {
+			t.Errorf("unexpected read options: %v", ro)
+		}
+		return reader
+	})
+	var reads int32
+	reader.EXPECT().Read(gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, _ ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+			if atomic.AddInt32(&reads, 1) == 1 {
+				e1, e2 := ce.NewEvent(), ce.NewEvent()
+				return []*ce.Event{&e1, &e2}, 5, 1, nil
+			}
+			select {
+			case <-ctx.Done():
+				return nil, 0, 0, ctx.Err()
+			case <-time.After(time.Millisecond):
+				return nil, 0, 0, errors.ErrOffsetOnEnd
+			}
+		})
+
+	revoked := make(chan []uint64, 1)
+	cg := NewConsumerGroup("group", "eb", bus, ctrl,
+		WithMemberID("member"),
+		WithBatchSize(2),
+		WithHeartbeatInterval(10*time.Millisecond),
+		WithOnRevoked(func(_ context.Context, ids []uint64) {
+			revoked <- ids
+		}))
+	handled := make(chan int, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- cg.Consume(ctx, func(_ context.Context, eventlogID uint64, events []*ce.Event) error {
+			if eventlogID != 1 {
+				t.Errorf("unexpected eventlog: %d", eventlogID)
+			}
+			handled <- len(events)
+			return nil
+		})
+	}()
+
+	if n := <-handled; n != 2 {
+		t.Errorf("handled %d events, want 2", n)
+	}
+	if ids := <-revoked; len(ids) != 1 || ids[0] != 1 {
+		t.Errorf("revoked %v, want [1]", ids)
+	}
+	for {
+		if generation, ids := cg.Assignment(); generation == 2 && len(ids) == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := cg.Consume(ctx, nil); err != errConsuming {
+		t.Errorf("consume twice, got %v", err)
+	}
+	cg.Close(ctx)
+	if err := <-done; err != nil {
+		t.Errorf("consume returned %v", err)
+	}
+}
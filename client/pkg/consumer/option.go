@@ -0,0 +1,95 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+)
+
+const (
+	defaultBatchSize         = 16
+	defaultHeartbeatInterval = 3 * time.Second
+)
+
+// RebalanceListener is called with the eventlogs assigned to or revoked from the member.
+type RebalanceListener func(ctx context.Context, eventlogIDs []uint64)
+
+type Option func(*Options)
+
+type Options struct {
+	// MemberID identifies the member in the group, it's generated randomly if not set.
+	MemberID          string
+	BatchSize         int
+	HeartbeatInterval time.Duration
+	// FromWhere is where to start consuming an eventlog which has no committed offset.
+	FromWhere api.ConsumeFromWhere
+	// OnAssigned is called after a rebalance, before the assigned eventlogs are consumed.
+	OnAssigned RebalanceListener
+	// OnRevoked is called before a rebalance, after the consuming of revoked eventlogs stopped.
+	OnRevoked RebalanceListener
+}
+
+func defaultOptions() *Options {
+	return &Options{
+		MemberID:          uuid.NewString(),
+		BatchSize:         defaultBatchSize,
+		HeartbeatInterval: defaultHeartbeatInterval,
+		FromWhere:         api.ConsumeFromWhereLatest,
+	}
+}
+
+func WithMemberID(id string) Option {
+	return func(options *Options) {
+		options.MemberID = id
+	}
+}
+
+func WithBatchSize(size int) Option {
+	return func(options *Options) {
+		if size > 0 {
+			options.BatchSize = size
+		}
+	}
+}
+
+func WithHeartbeatInterval(d time.Duration) Option {
+	return func(options *Options) {
+		if d > 0 {
+			options.HeartbeatInterval = d
+		}
+	}
+}
+
+func WithConsumeFromWhere(fromWhere api.ConsumeFromWhere) Option {
+	return func(options *Options) {
+		options.FromWhere = fromWhere
+	}
+}
+
+func WithOnAssigned(listener RebalanceListener) Option {
+	return func(options *Options) {
+		options.OnAssigned = listener
+	}
+}
+
+func WithOnRevoked(listener RebalanceListener) Option {
+	return func(options *Options) {
+		options.OnRevoked = listener
+	}
+}
@@ -17,8 +17,6 @@ package eventbus
 import (
 	// standard libraries.
 	"context"
-	"encoding/base64"
-	"encoding/binary"
 	stderrors "errors"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	"io"
@@ -35,11 +33,15 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/blob"
 	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/option"
 	"github.com/linkall-labs/vanus/client/pkg/policy"
 	vlog "github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
 
+	"github.com/linkall-labs/vanus/client/internal/vanus/codec"
+	"github.com/linkall-labs/vanus/client/internal/vanus/deadline"
 	eb "github.com/linkall-labs/vanus/client/internal/vanus/eventbus"
 	el "github.com/linkall-labs/vanus/client/internal/vanus/eventlog"
 )
@@ -308,8 +310,12 @@ func (b *eventbus) updateWritableLogs(ctx context.Context, re *WritableLogsResul
 	}
 	added.Each(func(logID uint64) bool {
 		cfg := &el.Config{
-			Endpoints: b.cfg.Endpoints,
-			ID:        logID,
+			Endpoints:         b.cfg.Endpoints,
+			ID:                logID,
+			RetryTimes:        b.cfg.RetryTimes,
+			RefreshBackoff:    b.cfg.RefreshBackoff,
+			MaxRefreshBackoff: b.cfg.MaxRefreshBackoff,
+			LookupTimeout:     b.cfg.LookupTimeout,
 		}
 		log := eventlog.NewEventLog(cfg)
 		lws[logID] = log
@@ -344,6 +350,8 @@ func (b *eventbus) refreshWritableLogs(ctx context.Context) {
 	_ctx, span := b.tracer.Start(ctx, "refreshWritableLogs")
 	defer span.End()
 
+	_ctx, cancel := deadline.WithHop(_ctx, b.cfg.LookupTimeout)
+	defer cancel()
 	_ = b.writableWatcher.Refresh(_ctx)
 }
 
@@ -401,8 +409,12 @@ func (b *eventbus) updateReadableLogs(ctx context.Context, re *ReadableLogsResul
 	}
 	added.Each(func(logID uint64) bool {
 		cfg := &el.Config{
-			Endpoints: b.cfg.Endpoints,
-			ID:        logID,
+			Endpoints:         b.cfg.Endpoints,
+			ID:                logID,
+			RetryTimes:        b.cfg.RetryTimes,
+			RefreshBackoff:    b.cfg.RefreshBackoff,
+			MaxRefreshBackoff: b.cfg.MaxRefreshBackoff,
+			LookupTimeout:     b.cfg.LookupTimeout,
 		}
 		log := eventlog.NewEventLog(cfg)
 		lws[logID] = log
@@ -437,6 +449,8 @@ func (b *eventbus) refreshReadableLogs(ctx context.Context) {
 	_ctx, span := b.tracer.Start(ctx, "refreshReadableLogs")
 	defer span.End()
 
+	_ctx, cancel := deadline.WithHop(_ctx, b.cfg.LookupTimeout)
+	defer cancel()
 	_ = b.readableWatcher.Refresh(_ctx)
 }
 
@@ -446,7 +460,12 @@ type busWriter struct {
 	tracer *tracing.Tracer
 }
 
-func (w *busWriter) AppendBatch(ctx context.Context, events *cloudevents.CloudEventBatch, opts ...api.WriteOption) (err error) {
+// AppendBatch appends the encoded events as is, they are never offloaded to the blob store.
+func (w *busWriter) AppendBatch(
+	ctx context.Context, events *cloudevents.CloudEventBatch, opts ...api.WriteOption,
+) (*api.Placement, error) {
+	ctx, cancel := deadline.WithDefault(ctx, w.ebus.cfg.AppendTimeout)
+	defer cancel()
 	_ctx, span := w.tracer.Start(ctx, "CloudEventBatch")
 	defer span.End()
 
@@ -461,19 +480,29 @@ func (w *busWriter) AppendBatch(ctx context.Context, events *cloudevents.CloudEv
 	// 1. pick a writer of eventlog
 	lw, err := w.pickWritableLog(_ctx, writeOpts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// 2. append the event to the eventlog
-	_, err = lw.AppendMany(_ctx, events)
-	return err
+	off, stime, err := lw.AppendMany(_ctx, events, option.WithAckLevel(writeOpts.AckLevel))
+	if err != nil {
+		return nil, err
+	}
+	return &api.Placement{
+		EventlogID: lw.Log().ID(),
+		Offset:     off,
+		Stime:      stime,
+	}, nil
 }
 
 var _ api.BusWriter = (*busWriter)(nil)
 
 func (w *busWriter) AppendOne(ctx context.Context, event *ce.Event, opts ...api.WriteOption) (eid string, err error) {
+	ctx, cancel := deadline.WithDefault(ctx, w.ebus.cfg.AppendTimeout)
+	defer cancel()
 	_ctx, span := w.tracer.Start(ctx, "AppendOne")
 	defer span.End()
+	tracing.InjectEvent(_ctx, event)
 
 	var writeOpts *api.WriteOptions = w.opts
 	if len(opts) > 0 {
@@ -483,6 +512,11 @@ func (w *busWriter) AppendOne(ctx context.Context, event *ce.Event, opts ...api.
 		}
 	}
 
+	event, err = blob.Offload(_ctx, writeOpts.BlobStore, event, writeOpts.BlobThreshold)
+	if err != nil {
+		return "", err
+	}
+
 	// 1. pick a writer of eventlog
 	lw, err := w.pickWritableLog(_ctx, writeOpts)
 	if err != nil {
@@ -490,23 +524,79 @@ func (w *busWriter) AppendOne(ctx context.Context, event *ce.Event, opts ...api.
 	}
 
 	// 2. append the event to the eventlog
-	off, err := lw.Append(_ctx, event)
+	off, stime, err := lw.Append(_ctx, event, option.WithAckLevel(writeOpts.AckLevel))
 	if err != nil {
 		return "", err
 	}
 
 	// 3. generate event ID
-	var buf [16]byte
-	binary.BigEndian.PutUint64(buf[0:8], lw.Log().ID())
-	binary.BigEndian.PutUint64(buf[8:16], uint64(off))
-	encoded := base64.StdEncoding.EncodeToString(buf[:])
-
-	return encoded, nil
+	p := api.Placement{
+		EventlogID: lw.Log().ID(),
+		Offset:     off,
+		Stime:      stime,
+	}
+	return p.EventID(0), nil
 }
 
 func (w *busWriter) AppendMany(ctx context.Context, events []*ce.Event, opts ...api.WriteOption) (eid string, err error) {
-	// TODO(jiangkai): implement this method, by jiangkai, 2022.10.24
-	return "", nil
+	p, err := w.AppendWithPlacement(ctx, events, opts...)
+	if err != nil {
+		return "", err
+	}
+	// ID of the first event
+	return p.EventID(0), nil
+}
+
+func (w *busWriter) AppendWithPlacement(
+	ctx context.Context, events []*ce.Event, opts ...api.WriteOption,
+) (*api.Placement, error) {
+	ctx, cancel := deadline.WithDefault(ctx, w.ebus.cfg.AppendTimeout)
+	defer cancel()
+	_ctx, span := w.tracer.Start(ctx, "AppendMany")
+	defer span.End()
+	for _, e := range events {
+		tracing.InjectEvent(_ctx, e)
+	}
+
+	var writeOpts *api.WriteOptions = w.opts
+	if len(opts) > 0 {
+		writeOpts = w.opts.Copy()
+		for _, opt := range opts {
+			opt(writeOpts)
+		}
+	}
+
+	batch := &cloudevents.CloudEventBatch{
+		Events: make([]*cloudevents.CloudEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		e, err := blob.Offload(_ctx, writeOpts.BlobStore, e, writeOpts.BlobThreshold)
+		if err != nil {
+			return nil, err
+		}
+		eventpb, err := codec.ToProto(e)
+		if err != nil {
+			return nil, err
+		}
+		batch.Events = append(batch.Events, eventpb)
+	}
+
+	// 1. pick a writer of eventlog
+	lw, err := w.pickWritableLog(_ctx, writeOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. append the events to the eventlog in one batch
+	off, stime, err := lw.AppendMany(_ctx, batch, option.WithAckLevel(writeOpts.AckLevel))
+	if err != nil {
+		return nil, err
+	}
+	return &api.Placement{
+		EventlogID: lw.Log().ID(),
+		Offset:     off,
+		Stime:      stime,
+	}, nil
 }
 
 func (w *busWriter) Bus() api.Eventbus {
@@ -539,6 +629,8 @@ type busReader struct {
 var _ api.BusReader = (*busReader)(nil)
 
 func (r *busReader) Read(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+	ctx, cancel := deadline.WithDefault(ctx, r.ebus.cfg.ReadTimeout)
+	defer cancel()
 	_ctx, span := r.tracer.Start(ctx, "Read")
 	defer span.End()
 
@@ -567,9 +659,45 @@ func (r *busReader) Read(ctx context.Context, opts ...api.ReadOption) ([]*ce.Eve
 	if err != nil {
 		return []*ce.Event{}, 0, 0, err
 	}
+	if readOpts.BlobStore != nil {
+		for _, e := range events {
+			if err = blob.Resolve(_ctx, readOpts.BlobStore, e); err != nil {
+				return []*ce.Event{}, 0, 0, err
+			}
+		}
+	}
+	if readOpts.Filter != nil {
+		// the events skipped by the filter are forwarded here, the caller forwards nothing.
+		next, _ := lr.Seek(_ctx, 0, io.SeekCurrent)
+		readOpts.Policy.Forward(int(next - off))
+	}
 	return events, off, lr.Log().ID(), nil
 }
 
+func (r *busReader) Seek(ctx context.Context, pos api.Position, opts ...api.ReadOption) (int64, error) {
+	_ctx, span := r.tracer.Start(ctx, "Seek")
+	defer span.End()
+
+	var readOpts *api.ReadOptions = r.opts
+	if len(opts) > 0 {
+		readOpts = r.opts.Copy()
+		for _, opt := range opts {
+			opt(readOpts)
+		}
+	}
+
+	log, err := readOpts.Policy.NextLog(_ctx)
+	if err != nil {
+		return -1, err
+	}
+	off, err := eventlog.ResolvePosition(_ctx, log, pos)
+	if err != nil {
+		return -1, err
+	}
+	readOpts.Policy.Forward(int(off - readOpts.Policy.Offset()))
+	return off, nil
+}
+
 func (r *busReader) Bus() api.Eventbus {
 	return r.ebus
 }
@@ -587,5 +715,5 @@ func (r *busReader) pickReadableLog(ctx context.Context, opts *api.ReadOptions)
 		return nil, stderrors.New("can not pick readable log")
 	}
 
-	return lr.Reader(eventlog.ReaderConfig{PollingTimeout: opts.PollingTimeout}), nil
+	return lr.Reader(eventlog.ReaderConfig{PollingTimeout: opts.PollingTimeout, Filter: opts.Filter}), nil
 }
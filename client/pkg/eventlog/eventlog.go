@@ -35,6 +35,8 @@ const (
 
 type ReaderConfig struct {
 	PollingTimeout int64
+	// Filter is pushed down to segment servers, see api.ReadOptions.
+	Filter *segpb.EventFilter
 }
 
 type Eventlog interface {
@@ -50,8 +52,14 @@ type LogWriter interface {
 
 	Close(ctx context.Context)
 
-	Append(ctx context.Context, event *ce.Event) (off int64, err error)
-	AppendMany(ctx context.Context, events *cloudevents.CloudEventBatch) (off int64, err error)
+	// Append appends the event, and returns its offset and stime, the millisecond timestamp when
+	// the event is written to block.
+	Append(ctx context.Context, event *ce.Event, opts ...api.WriteOption) (off int64, stime int64, err error)
+	// AppendMany appends the events in one batch, and returns the offset of the first event and
+	// the stime of the events.
+	AppendMany(
+		ctx context.Context, events *cloudevents.CloudEventBatch, opts ...api.WriteOption,
+	) (off int64, stime int64, err error)
 }
 
 type LogReader interface {
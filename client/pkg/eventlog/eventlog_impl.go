@@ -28,12 +28,16 @@ import (
 
 	// third-party libraries.
 	ce "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/atomic"
 
 	// this project.
+	"github.com/linkall-labs/vanus/client/internal/vanus/deadline"
 	el "github.com/linkall-labs/vanus/client/internal/vanus/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/client/pkg/record"
 	vlog "github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
 const (
@@ -110,7 +114,9 @@ type eventlog struct {
 	readableWatcher  *ReadableSegmentsWatcher
 	readableSegments []*segment
 	readableMu       sync.RWMutex
-	tracer           *tracing.Tracer
+	// routeEpoch increases each time the cached segments are updated.
+	routeEpoch atomic.Uint64
+	tracer     *tracing.Tracer
 }
 
 // make sure eventlog implements eventlog.EventLog.
@@ -211,6 +217,7 @@ func (l *eventlog) QueryOffsetByTime(ctx context.Context, timestamp int64) (int6
 }
 
 func (l *eventlog) updateWritableSegment(ctx context.Context, r *record.Segment) {
+	defer l.routeEpoch.Inc()
 	if l.writableSegment != nil {
 		if l.writableSegment.ID() == r.ID {
 			_ = l.writableSegment.Update(ctx, r, true)
@@ -257,6 +264,8 @@ func (l *eventlog) fetchWritableSegment(ctx context.Context) *segment {
 }
 
 func (l *eventlog) refreshWritableSegment(ctx context.Context) {
+	ctx, cancel := deadline.WithHop(ctx, l.cfg.LookupTimeout)
+	defer cancel()
 	_ = l.writableWatcher.Refresh(ctx)
 }
 
@@ -285,10 +294,11 @@ func (l *eventlog) updateReadableSegments(ctx context.Context, rs []*record.Segm
 		segments = append(segments, segment)
 	}
 
-	l.writableMu.Lock()
-	defer l.writableMu.Unlock()
+	l.readableMu.Lock()
+	defer l.readableMu.Unlock()
 
 	l.readableSegments = segments
+	l.routeEpoch.Inc()
 }
 
 func (l *eventlog) selectReadableSegment(ctx context.Context, offset int64) (*segment, error) {
@@ -327,6 +337,8 @@ func (l *eventlog) fetchReadableSegments(ctx context.Context) []*segment {
 }
 
 func (l *eventlog) refreshReadableSegments(ctx context.Context) {
+	ctx, cancel := deadline.WithHop(ctx, l.cfg.LookupTimeout)
+	defer cancel()
 	_ = l.readableWatcher.Refresh(ctx)
 }
 
@@ -343,26 +355,28 @@ type logWriter struct {
 	mu   sync.RWMutex
 }
 
-func (w *logWriter) AppendMany(ctx context.Context, events *cloudevents.CloudEventBatch) (off int64, err error) {
-	retryTimes := defaultRetryTimes
+func (w *logWriter) AppendMany(
+	ctx context.Context, events *cloudevents.CloudEventBatch, opts ...api.WriteOption,
+) (off int64, stime int64, err error) {
+	ack := ackLevel(opts)
+	retryTimes := w.elog.retryTimes()
 	for i := 1; i <= retryTimes; i++ {
-		offset, err := w.doAppendBatch(ctx, events)
+		epoch := w.elog.epoch()
+		offset, stime, err := w.doAppendBatch(ctx, events, ack)
 		if err == nil {
-			return offset, nil
+			return offset, stime, nil
 		}
 		vlog.Warning(ctx, "failed to Append", map[string]interface{}{
 			vlog.KeyError: err,
 			"offset":      offset,
 		})
-		if errors.Is(err, errors.ErrSegmentFull) {
-			if i < retryTimes {
-				continue
-			}
+		if i < retryTimes && w.retryable(ctx, err, epoch, i) {
+			continue
 		}
-		return -1, err
+		return -1, 0, err
 	}
 
-	return -1, errors.ErrUnknown
+	return -1, 0, errors.ErrUnknown
 }
 
 func (w *logWriter) Log() Eventlog {
@@ -373,58 +387,76 @@ func (w *logWriter) Close(ctx context.Context) {
 	// TODO: by jiangkai, 2022.10.19
 }
 
-func (w *logWriter) Append(ctx context.Context, event *ce.Event) (int64, error) {
+func (w *logWriter) Append(ctx context.Context, event *ce.Event, opts ...api.WriteOption) (int64, int64, error) {
 	// TODO: async for throughput
 
-	retryTimes := defaultRetryTimes
+	ack := ackLevel(opts)
+	retryTimes := w.elog.retryTimes()
 	for i := 1; i <= retryTimes; i++ {
-		offset, err := w.doAppend(ctx, event)
+		epoch := w.elog.epoch()
+		offset, stime, err := w.doAppend(ctx, event, ack)
 		if err == nil {
-			return offset, nil
+			return offset, stime, nil
 		}
 		vlog.Warning(ctx, "failed to Append", map[string]interface{}{
 			vlog.KeyError: err,
 			"offset":      offset,
 		})
-		if errors.Is(err, errors.ErrSegmentFull) {
-			if i < retryTimes {
-				continue
-			}
+		if i < retryTimes && w.retryable(ctx, err, epoch, i) {
+			continue
 		}
-		return -1, err
+		return -1, 0, err
 	}
 
-	return -1, errors.ErrUnknown
+	return -1, 0, errors.ErrUnknown
 }
 
-func (w *logWriter) doAppend(ctx context.Context, event *ce.Event) (int64, error) {
+// retryable returns whether the failed append can be retried. If the route is stale, the route is
+// refreshed and the retry waits for the backoff.
+func (w *logWriter) retryable(ctx context.Context, err error, epoch uint64, attempt int) bool {
+	if errors.Is(err, errors.ErrSegmentFull) {
+		return true
+	}
+	if !isRouteError(err) {
+		return false
+	}
+	w.mu.Lock()
+	w.cur = nil
+	w.mu.Unlock()
+	w.elog.invalidateWritable(ctx, epoch)
+	return deadline.Sleep(ctx, w.elog.refreshBackoff(attempt))
+}
+
+func (w *logWriter) doAppend(ctx context.Context, event *ce.Event, ack segpb.AckLevel) (int64, int64, error) {
 	segment, err := w.selectWritableSegment(ctx)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
-	offset, err := segment.Append(ctx, event)
+	offset, stime, err := segment.Append(ctx, event, ack)
 	if err != nil {
 		if errors.Is(err, errors.ErrSegmentFull) {
 			segment.SetNotWritable()
 		}
-		return -1, err
+		return -1, 0, err
 	}
-	return offset, nil
+	return offset, stime, nil
 }
 
-func (w *logWriter) doAppendBatch(ctx context.Context, event *cloudevents.CloudEventBatch) (int64, error) {
+func (w *logWriter) doAppendBatch(
+	ctx context.Context, event *cloudevents.CloudEventBatch, ack segpb.AckLevel,
+) (int64, int64, error) {
 	segment, err := w.selectWritableSegment(ctx)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
-	offset, err := segment.AppendBatch(ctx, event)
+	offset, stime, err := segment.AppendBatch(ctx, event, ack)
 	if err != nil {
 		if errors.Is(err, errors.ErrSegmentFull) {
 			segment.SetNotWritable()
 		}
-		return -1, err
+		return -1, 0, err
 	}
-	return offset, nil
+	return offset, stime, nil
 }
 
 func (w *logWriter) selectWritableSegment(ctx context.Context) (*segment, error) {
@@ -460,6 +492,8 @@ type logReader struct {
 	pos  int64
 	cur  *segment
 	cfg  ReaderConfig
+	// failures is the number of continuous reads failed by the stale route.
+	failures int
 }
 
 func (r *logReader) Log() Eventlog {
@@ -471,6 +505,7 @@ func (r *logReader) Close(ctx context.Context) {
 }
 
 func (r *logReader) Read(ctx context.Context, size int16) ([]*ce.Event, error) {
+	epoch := r.elog.epoch()
 	if r.cur == nil {
 		segment, err := r.elog.selectReadableSegment(ctx, r.pos)
 		if errors.Is(err, errors.ErrOffsetOnEnd) {
@@ -483,7 +518,7 @@ func (r *logReader) Read(ctx context.Context, size int16) ([]*ce.Event, error) {
 		r.cur = segment
 	}
 
-	events, err := r.cur.Read(ctx, r.pos, size, uint32(r.pollingTimeout(ctx)))
+	events, next, err := r.cur.Read(ctx, r.pos, size, uint32(r.pollingTimeout(ctx)), r.cfg.Filter)
 	if err != nil {
 		if errors.Is(err, errors.ErrOffsetOverflow) {
 			r.elog.refreshReadableSegments(ctx)
@@ -491,10 +526,20 @@ func (r *logReader) Read(ctx context.Context, size int16) ([]*ce.Event, error) {
 				return nil, errors.ErrTryAgain
 			}
 		}
+		if isRouteError(err) {
+			// read again from the new route after the backoff.
+			r.failures++
+			r.elog.invalidateReadable(ctx, epoch, r.cur.ID())
+			r.cur = nil
+			if deadline.Sleep(ctx, r.elog.refreshBackoff(r.failures)) {
+				return nil, errors.ErrTryAgain
+			}
+		}
 		return nil, err
 	}
 
-	r.pos += int64(len(events))
+	r.failures = 0
+	r.pos = next
 	if r.pos == r.cur.EndOffset() {
 		r.switchSegment(ctx)
 	}
@@ -529,11 +574,38 @@ func (r *logReader) switchSegment(ctx context.Context) bool {
 }
 
 func (r *logReader) Seek(ctx context.Context, offset int64, whence int) (int64, error) {
-	// TODO
-	if whence == io.SeekStart {
-		r.pos = offset
-		r.cur = nil
-		return offset, nil
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		latest, err := r.elog.LatestOffset(ctx)
+		if err != nil {
+			return -1, err
+		}
+		pos = latest + offset
+	default:
+		return -1, errors.ErrInvalidArgument
+	}
+	if pos < 0 {
+		return -1, errors.ErrInvalidArgument
+	}
+	r.pos = pos
+	r.cur = nil
+	return pos, nil
+}
+
+func ackLevel(opts []api.WriteOption) segpb.AckLevel {
+	writeOpts := &api.WriteOptions{}
+	writeOpts.Apply(opts...)
+	switch writeOpts.AckLevel {
+	case api.AckLeader:
+		return segpb.AckLevel_LEADER
+	case api.AckNone:
+		return segpb.AckLevel_NONE
+	default:
+		return segpb.AckLevel_QUORUM
 	}
-	return -1, errors.ErrInvalidArgument
 }
@@ -157,46 +157,51 @@ func (s *segment) Update(ctx context.Context, r *record.Segment, towrite bool) e
 	return nil
 }
 
-func (s *segment) Append(ctx context.Context, event *ce.Event) (int64, error) {
+func (s *segment) Append(ctx context.Context, event *ce.Event, ack segpb.AckLevel) (int64, int64, error) {
 	_ctx, span := s.tracer.Start(ctx, "Append")
 	defer span.End()
 
 	b := s.preferSegmentBlock()
 	if b == nil {
-		return -1, errors.ErrNotLeader
+		return -1, 0, errors.ErrNotLeader
 	}
-	off, err := b.Append(_ctx, event)
+	off, stime, err := b.Append(_ctx, event, ack)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
-	return off + s.startOffset, nil
+	return off + s.startOffset, stime, nil
 }
 
-func (s *segment) AppendBatch(ctx context.Context, event *cloudevents.CloudEventBatch) (int64, error) {
+func (s *segment) AppendBatch(
+	ctx context.Context, event *cloudevents.CloudEventBatch, ack segpb.AckLevel,
+) (int64, int64, error) {
 	_ctx, span := s.tracer.Start(ctx, "AppendBatch")
 	defer span.End()
 
 	b := s.preferSegmentBlock()
 	if b == nil {
-		return -1, errors.ErrNotLeader
+		return -1, 0, errors.ErrNotLeader
 	}
-	off, err := b.AppendBatch(_ctx, event)
+	off, stime, err := b.AppendBatch(_ctx, event, ack)
 	if err != nil {
-		return -1, err
+		return -1, 0, err
 	}
-	return off + s.startOffset, nil
+	return off + s.startOffset, stime, nil
 }
 
-func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeout uint32) ([]*ce.Event, error) {
+// Read returns the events from offset and the offset to read next.
+func (s *segment) Read(
+	ctx context.Context, from int64, size int16, pollingTimeout uint32, filter *segpb.EventFilter,
+) ([]*ce.Event, int64, error) {
 	if from < s.startOffset {
-		return nil, errors.ErrOffsetUnderflow
+		return nil, 0, errors.ErrOffsetUnderflow
 	}
 	ctx, span := s.tracer.Start(ctx, "Read")
 	defer span.End()
 
 	if eo := s.endOffset.Load(); eo >= 0 {
 		if from > eo {
-			return nil, errors.ErrOffsetOverflow
+			return nil, 0, errors.ErrOffsetOverflow
 		}
 		if int64(size) > eo-from {
 			size = int16(eo - from)
@@ -205,11 +210,11 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 	// TODO: cached read
 	b := s.preferSegmentBlock()
 	if b == nil {
-		return nil, errors.ErrBlockNotFound
+		return nil, 0, errors.ErrBlockNotFound
 	}
-	events, err := b.Read(ctx, from-s.startOffset, size, pollingTimeout)
+	events, next, err := b.Read(ctx, from-s.startOffset, size, pollingTimeout, filter)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	for _, e := range events {
@@ -219,7 +224,7 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 		}
 		off, ok := v.(int32)
 		if !ok {
-			return events, errors.ErrCorruptedEvent
+			return events, 0, errors.ErrCorruptedEvent
 		}
 		offset := s.startOffset + int64(off)
 		buf := make([]byte, 8)
@@ -228,7 +233,7 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 		e.SetExtension(segpb.XVanusBlockOffset, nil)
 	}
 
-	return events, err
+	return events, s.startOffset + next, err
 }
 
 func (s *segment) preferSegmentBlock() *block {
@@ -244,5 +249,10 @@ func (s *segment) setPreferSegmentBlock(prefer *block) {
 }
 
 func (s *segment) LookupOffset(ctx context.Context, t time.Time) (int64, error) {
-	return s.preferSegmentBlock().LookupOffset(ctx, t)
+	off, err := s.preferSegmentBlock().LookupOffset(ctx, t)
+	if err != nil {
+		return -1, err
+	}
+	// the offset in block is relative to the start of segment.
+	return off + s.startOffset, nil
 }
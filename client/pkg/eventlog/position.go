@@ -0,0 +1,69 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+// ResolvePosition returns the offset of the position in the eventlog. An offset out of the range of
+// eventlog is rejected, and a time after the last event resolves to the latest offset.
+func ResolvePosition(ctx context.Context, l api.Eventlog, pos api.Position) (int64, error) {
+	switch pos.Kind {
+	case api.PositionEarliest:
+		return l.EarliestOffset(ctx)
+	case api.PositionLatest:
+		return l.LatestOffset(ctx)
+	case api.PositionOffset:
+		earliest, err := l.EarliestOffset(ctx)
+		if err != nil {
+			return -1, err
+		}
+		if pos.Offset < earliest {
+			return -1, errors.ErrOffsetUnderflow
+		}
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return -1, err
+		}
+		if pos.Offset > latest {
+			return -1, errors.ErrOffsetOverflow
+		}
+		return pos.Offset, nil
+	case api.PositionTime:
+		off, err := l.QueryOffsetByTime(ctx, pos.Time.UnixMilli())
+		if err != nil {
+			return -1, err
+		}
+		if off < 0 {
+			// no readable segment yet.
+			return l.EarliestOffset(ctx)
+		}
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return -1, err
+		}
+		if off > latest {
+			return latest, nil
+		}
+		return off, nil
+	}
+	return -1, errors.ErrInvalidArgument.WithMessage("unknown position")
+}
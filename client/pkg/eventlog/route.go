@@ -0,0 +1,114 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"time"
+
+	// third-party libraries.
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// this project.
+	vlog "github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/retry"
+)
+
+// The segments of eventlog are cached as the route of appending and reading. The cache is versioned by
+// an epoch which increases each time the segments are updated. A request failed by a stale route
+// invalidates the cache only if it was routed in the current epoch, so the concurrent failures of the
+// same route refresh the metadata once.
+
+const (
+	defaultRefreshBackoff    = 100 * time.Millisecond
+	defaultMaxRefreshBackoff = 3 * time.Second
+)
+
+// isRouteError returns whether the error means the cached segment or block is stale, e.g. the leader of
+// segment changed, the block was moved or archived, or the segment server is unavailable.
+func isRouteError(err error) bool {
+	if errors.Is(err, errors.ErrNotLeader) || errors.Is(err, errors.ErrBlockNotFound) ||
+		errors.Is(err, errors.ErrSegmentNotFound) || errors.Is(err, errors.ErrNotWritable) ||
+		errors.Is(err, errors.ErrNotReadable) {
+		return true
+	}
+	return status.Code(err) == codes.Unavailable
+}
+
+func (l *eventlog) epoch() uint64 {
+	return l.routeEpoch.Load()
+}
+
+// invalidateWritable refreshes the writable segment if it's still the one of epoch.
+func (l *eventlog) invalidateWritable(ctx context.Context, epoch uint64) {
+	if l.epoch() != epoch {
+		return
+	}
+	vlog.Info(ctx, "eventlog writable route is stale, refresh it", map[string]interface{}{
+		"eventlog": l.cfg.ID,
+		"epoch":    epoch,
+	})
+	l.refreshWritableSegment(ctx)
+}
+
+// invalidateReadable refreshes the readable segments if they are still the ones of epoch, the segment
+// failed to read is rebuilt to choose the block again.
+func (l *eventlog) invalidateReadable(ctx context.Context, epoch uint64, segmentID uint64) {
+	if l.epoch() != epoch {
+		return
+	}
+	vlog.Info(ctx, "eventlog readable route is stale, refresh it", map[string]interface{}{
+		"eventlog": l.cfg.ID,
+		"segment":  segmentID,
+		"epoch":    epoch,
+	})
+	l.readableMu.Lock()
+	segments := make([]*segment, 0, len(l.readableSegments))
+	for _, s := range l.readableSegments {
+		if s.ID() == segmentID {
+			continue
+		}
+		segments = append(segments, s)
+	}
+	l.readableSegments = segments
+	l.readableMu.Unlock()
+	l.refreshReadableSegments(ctx)
+}
+
+// refreshBackoff returns how long to wait before the attempt-th retry, the metadata may not be updated
+// immediately, e.g. the new leader of segment hasn't been elected.
+func (l *eventlog) refreshBackoff(attempt int) time.Duration {
+	backoff, maxBackoff := l.cfg.RefreshBackoff, l.cfg.MaxRefreshBackoff
+	if backoff <= 0 {
+		backoff = defaultRefreshBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxRefreshBackoff
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	return retry.Exponential(attempt, backoff, maxBackoff)
+}
+
+func (l *eventlog) retryTimes() int {
+	if l.cfg.RetryTimes > 0 {
+		return l.cfg.RetryTimes
+	}
+	return defaultRetryTimes
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	stderr "errors"
+	"testing"
+	"time"
+
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	el "github.com/linkall-labs/vanus/client/internal/vanus/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+func TestIsRouteError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{errors.ErrNotLeader, true},
+		{errors.ErrBlockNotFound, true},
+		{status.Error(codes.Unknown, errors.ErrNotLeader.Error()), true},
+		{status.Error(codes.Unavailable, "connection refused"), true},
+		{errors.ErrSegmentFull, false},
+		{errors.ErrOffsetOnEnd, false},
+		{stderr.New("test"), false},
+	}
+	for _, c := range cases {
+		if got := isRouteError(c.err); got != c.want {
+			t.Errorf("isRouteError(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
+
+func TestEventlog_refreshBackoff(t *testing.T) {
+	l := &eventlog{cfg: &el.Config{}}
+	if d := l.refreshBackoff(1); d != defaultRefreshBackoff {
+		t.Errorf("got %v, want %v", d, defaultRefreshBackoff)
+	}
+	l.cfg.RefreshBackoff = 10 * time.Millisecond
+	l.cfg.MaxRefreshBackoff = 50 * time.Millisecond
+	for attempt, want := range map[int]time.Duration{
+		1: 10 * time.Millisecond,
+		2: 20 * time.Millisecond,
+		3: 40 * time.Millisecond,
+		4: 50 * time.Millisecond,
+		9: 50 * time.Millisecond,
+	} {
+		if d := l.refreshBackoff(attempt); d != want {
+			t.Errorf("attempt %d: got %v, want %v", attempt, d, want)
+		}
+	}
+}
+
+func TestEventlog_invalidate(t *testing.T) {
+	ctx := context.Background()
+	l := &eventlog{cfg: &el.Config{ID: 1}}
+	refreshed := atomic.NewInt32(0)
+	var w *primitive.Watcher
+	w = primitive.NewWatcher(time.Hour, func() {
+		refreshed.Inc()
+		l.routeEpoch.Inc()
+		w.Wakeup()
+	})
+	l.writableWatcher = &WritableSegmentWatcher{Watcher: w}
+	l.readableWatcher = &ReadableSegmentsWatcher{Watcher: w}
+	go w.Run()
+	defer w.Close()
+	// wait for the first lookup.
+	for refreshed.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	epoch := l.epoch()
+	l.invalidateWritable(ctx, epoch)
+	if refreshed.Load() != 2 {
+		t.Errorf("got %d refreshes, want 2", refreshed.Load())
+	}
+	// the route has been refreshed since the stale epoch.
+	l.invalidateWritable(ctx, epoch)
+	if refreshed.Load() != 2 {
+		t.Errorf("got %d refreshes, want 2", refreshed.Load())
+	}
+
+	l.readableSegments = []*segment{{id: 1}, {id: 2}}
+	l.invalidateReadable(ctx, l.epoch(), 1)
+	if refreshed.Load() != 3 || len(l.readableSegments) != 1 || l.readableSegments[0].ID() != 2 {
+		t.Errorf("got %d refreshes and segments %v", refreshed.Load(), l.readableSegments)
+	}
+}
+
+func TestEventlog_deadlineBudget(t *testing.T) {
+	l := &eventlog{cfg: &el.Config{ID: 1, LookupTimeout: 20 * time.Millisecond, RefreshBackoff: time.Second}}
+	// the lookup never completes.
+	w := primitive.NewWatcher(time.Hour, func() {})
+	l.writableWatcher = &WritableSegmentWatcher{Watcher: w}
+	go w.Run()
+	defer w.Close()
+
+	start := time.Now()
+	l.refreshWritableSegment(context.Background())
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("the lookup isn't bounded by the lookup timeout, it took %v", d)
+	}
+
+	// the backoff takes at most half of the budget left, and no retry is attempted without budget.
+	lw := &logWriter{elog: l}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	if !lw.retryable(ctx, errors.ErrNotLeader, l.epoch()+1, 1) {
+		t.Errorf("the append should be retried within the budget")
+	}
+	if d := time.Since(start); d > 150*time.Millisecond {
+		t.Errorf("the backoff takes %v, more than half of the budget", d)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if lw.retryable(ctx, errors.ErrNotLeader, l.epoch()+1, 1) {
+		t.Errorf("the append shouldn't be retried without budget")
+	}
+}
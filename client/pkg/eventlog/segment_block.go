@@ -26,6 +26,7 @@ import (
 	"github.com/linkall-labs/vanus/client/pkg/record"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
 func newBlock(ctx context.Context, r *record.Block) (*block, error) {
@@ -53,24 +54,28 @@ func (s *block) LookupOffset(ctx context.Context, t time.Time) (int64, error) {
 	return s.store.LookupOffset(ctx, s.id, t)
 }
 
-func (s *block) Append(ctx context.Context, event *ce.Event) (int64, error) {
-	return s.store.Append(ctx, s.id, event)
+func (s *block) Append(ctx context.Context, event *ce.Event, ack segpb.AckLevel) (int64, int64, error) {
+	return s.store.Append(ctx, s.id, event, ack)
 }
 
-func (s *block) AppendBatch(ctx context.Context, event *cloudevents.CloudEventBatch) (int64, error) {
-	return s.store.AppendBatch(ctx, s.id, event)
+func (s *block) AppendBatch(
+	ctx context.Context, event *cloudevents.CloudEventBatch, ack segpb.AckLevel,
+) (int64, int64, error) {
+	return s.store.AppendBatch(ctx, s.id, event, ack)
 }
 
-func (s *block) Read(ctx context.Context, offset int64, size int16, pollingTimeout uint32) ([]*ce.Event, error) {
+func (s *block) Read(
+	ctx context.Context, offset int64, size int16, pollingTimeout uint32, filter *segpb.EventFilter,
+) ([]*ce.Event, int64, error) {
 	if offset < 0 {
-		return nil, errors.ErrOffsetUnderflow
+		return nil, 0, errors.ErrOffsetUnderflow
 	}
 	if size > 0 {
 		// doRead
 	} else if size == 0 {
-		return make([]*ce.Event, 0), nil
+		return make([]*ce.Event, 0), offset, nil
 	} else if size < 0 {
-		return nil, errors.ErrInvalidArgument
+		return nil, 0, errors.ErrInvalidArgument
 	}
-	return s.store.Read(ctx, s.id, offset, size, pollingTimeout)
+	return s.store.Read(ctx, s.id, offset, size, pollingTimeout, filter)
 }
@@ -18,6 +18,8 @@ import (
 	"time"
 
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/blob"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
 func WithWritePolicy(policy api.WritePolicy) api.WriteOption {
@@ -32,6 +34,20 @@ func WithOneway() api.WriteOption {
 	}
 }
 
+func WithAckLevel(level api.AckLevel) api.WriteOption {
+	return func(options *api.WriteOptions) {
+		options.AckLevel = level
+	}
+}
+
+// WithBlobStore offloads the data of events larger than threshold bytes to store.
+func WithBlobStore(store blob.Store, threshold int) api.WriteOption {
+	return func(options *api.WriteOptions) {
+		options.BlobStore = store
+		options.BlobThreshold = threshold
+	}
+}
+
 func WithBatchSize(size int) api.ReadOption {
 	return func(options *api.ReadOptions) {
 		options.BatchSize = size
@@ -60,6 +76,20 @@ func WithReadPolicy(policy api.ReadPolicy) api.ReadOption {
 	}
 }
 
+// WithFilter pushes down the filter to segment servers, the events which don't match it are skipped.
+func WithFilter(filter *segpb.EventFilter) api.ReadOption {
+	return func(options *api.ReadOptions) {
+		options.Filter = filter
+	}
+}
+
+// WithBlobResolver resolves the data of offloaded events from store.
+func WithBlobResolver(store blob.Store) api.ReadOption {
+	return func(options *api.ReadOptions) {
+		options.BlobStore = store
+	}
+}
+
 func WithLogPolicy(policy api.LogPolicy) api.LogOption {
 	return func(options *api.LogOptions) {
 		options.Policy = policy
@@ -0,0 +1,126 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package producer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+)
+
+const (
+	defaultBufferSize      = 1024
+	defaultBatchSize       = 64
+	defaultLinger          = 5 * time.Millisecond
+	defaultMaxRetries      = 3
+	defaultMaxRetryBackoff = 3 * time.Second
+	defaultSendTimeout     = 5 * time.Second
+
+	retryBackoff = 200 * time.Millisecond
+)
+
+type Option func(*Options)
+
+type Options struct {
+	// BufferSize is the number of events buffered before Send blocks.
+	BufferSize int
+	// BatchSize is the max number of events appended in one batch.
+	BatchSize int
+	// Linger is how long a batch waits for more events before it's appended.
+	Linger          time.Duration
+	MaxRetries      int
+	MaxRetryBackoff time.Duration
+	// SendTimeout is the timeout of each attempt to append a batch.
+	SendTimeout time.Duration
+	AckLevel    api.AckLevel
+	// Idempotent makes each event carry a key unique to the producer, which stays the same when the
+	// event is retried.
+	Idempotent bool
+	ProducerID string
+}
+
+func defaultOptions() *Options {
+	return &Options{
+		BufferSize:      defaultBufferSize,
+		BatchSize:       defaultBatchSize,
+		Linger:          defaultLinger,
+		MaxRetries:      defaultMaxRetries,
+		MaxRetryBackoff: defaultMaxRetryBackoff,
+		SendTimeout:     defaultSendTimeout,
+		AckLevel:        api.AckQuorum,
+		ProducerID:      uuid.NewString(),
+	}
+}
+
+func WithBufferSize(size int) Option {
+	return func(options *Options) {
+		if size > 0 {
+			options.BufferSize = size
+		}
+	}
+}
+
+func WithBatchSize(size int) Option {
+	return func(options *Options) {
+		if size > 0 {
+			options.BatchSize = size
+		}
+	}
+}
+
+func WithLinger(d time.Duration) Option {
+	return func(options *Options) {
+		if d > 0 {
+			options.Linger = d
+		}
+	}
+}
+
+func WithRetry(maxRetries int, maxBackoff time.Duration) Option {
+	return func(options *Options) {
+		if maxRetries >= 0 {
+			options.MaxRetries = maxRetries
+		}
+		if maxBackoff > 0 {
+			options.MaxRetryBackoff = maxBackoff
+		}
+	}
+}
+
+func WithSendTimeout(d time.Duration) Option {
+	return func(options *Options) {
+		if d > 0 {
+			options.SendTimeout = d
+		}
+	}
+}
+
+func WithAckLevel(level api.AckLevel) Option {
+	return func(options *Options) {
+		options.AckLevel = level
+	}
+}
+
+// WithIdempotence enables the idempotent producing, id is the prefix of keys and is generated randomly
+// if empty, it must be unique among producers.
+func WithIdempotence(id string) Option {
+	return func(options *Options) {
+		options.Idempotent = true
+		if id != "" {
+			options.ProducerID = id
+		}
+	}
+}
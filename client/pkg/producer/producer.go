@@ -0,0 +1,231 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package producer
+
+import (
+	"context"
+	stderr "errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	"go.uber.org/atomic"
+)
+
+const (
+	// XVanusProducerKey is the extension carried by events of an idempotent producer, it's unique for
+	// each event and stays the same when the event is retried. Subscriptions drop the duplicate events
+	// by setting it as the dedup key attribute.
+	XVanusProducerKey = "xvanusproducerkey"
+)
+
+var errProducerClosed = stderr.New("the producer is closed")
+
+// Callback is called when the event is appended or failed after all retries, eid is the ID of the event
+// in the eventbus.
+type Callback func(event *ce.Event, eid string, err error)
+
+// Producer appends events asynchronously. The events are buffered and appended in batches, a batch
+// is appended when it's full or has waited for Linger. The batches are appended in order, and a failed
+// batch is retried with backoff before the next one.
+type Producer interface {
+	// Send buffers the event, it blocks if the buffer is full until ctx is done.
+	Send(ctx context.Context, event *ce.Event, callback Callback) error
+	// Flush appends the buffered events and waits for the result.
+	Flush(ctx context.Context) error
+	// Close flushes the buffered events and stops the producer.
+	Close(ctx context.Context) error
+}
+
+func NewProducer(writer api.BusWriter, opts ...Option) Producer {
+	options := defaultOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	p := &producer{
+		writer:   writer,
+		opts:     options,
+		messages: make(chan *message, options.BufferSize),
+		stopped:  make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+type message struct {
+	event    *ce.Event
+	callback Callback
+	// flushed is closed after the messages before it are appended, it's a flush request if not nil.
+	flushed chan struct{}
+	stop    bool
+}
+
+type producer struct {
+	writer   api.BusWriter
+	opts     *Options
+	messages chan *message
+	sequence atomic.Uint64
+	closed   bool
+	stopped  chan struct{}
+	mu       sync.RWMutex
+}
+
+func (p *producer) Send(ctx context.Context, event *ce.Event, callback Callback) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errProducerClosed
+	}
+	if p.opts.Idempotent {
+		event.SetExtension(XVanusProducerKey, fmt.Sprintf("%s-%d", p.opts.ProducerID, p.sequence.Inc()))
+	}
+	return p.put(ctx, &message{event: event, callback: callback})
+}
+
+func (p *producer) Flush(ctx context.Context) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errProducerClosed
+	}
+	return p.flush(ctx, false)
+}
+
+func (p *producer) Close(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	return p.flush(ctx, true)
+}
+
+func (p *producer) flush(ctx context.Context, stop bool) error {
+	m := &message{flushed: make(chan struct{}), stop: stop}
+	if err := p.put(ctx, m); err != nil {
+		return err
+	}
+	select {
+	case <-m.flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *producer) put(ctx context.Context, m *message) error {
+	select {
+	case p.messages <- m:
+		return nil
+	case <-p.stopped:
+		return errProducerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *producer) run() {
+	defer close(p.stopped)
+	batch := make([]*message, 0, p.opts.BatchSize)
+	var linger <-chan time.Time
+	for {
+		select {
+		case m := <-p.messages:
+			if m.flushed != nil {
+				p.send(batch)
+				batch = batch[:0]
+				linger = nil
+				close(m.flushed)
+				if m.stop {
+					return
+				}
+				continue
+			}
+			if len(batch) == 0 {
+				linger = time.After(p.opts.Linger)
+			}
+			batch = append(batch, m)
+			if len(batch) >= p.opts.BatchSize {
+				p.send(batch)
+				batch = batch[:0]
+				linger = nil
+			}
+		case <-linger:
+			p.send(batch)
+			batch = batch[:0]
+			linger = nil
+		}
+	}
+}
+
+// send appends the batch with retries, and calls the callbacks with the result.
+func (p *producer) send(batch []*message) {
+	if len(batch) == 0 {
+		return
+	}
+	events := make([]*ce.Event, len(batch))
+	for idx := range batch {
+		events[idx] = batch[idx].event
+	}
+	var placement *api.Placement
+	err := retry.Do(context.Background(), p.retryPolicy(), func(ctx context.Context, attempt int) error {
+		var err error
+		placement, err = p.append(events)
+		if err != nil {
+			log.Debug(ctx, "producer append events failed", map[string]interface{}{
+				log.KeyError: err,
+				"attempt":    attempt,
+			})
+		}
+		return err
+	})
+	for idx, m := range batch {
+		if m.callback == nil {
+			continue
+		}
+		if err != nil {
+			m.callback(m.event, "", err)
+		} else {
+			m.callback(m.event, placement.EventID(idx), nil)
+		}
+	}
+}
+
+// retryPolicy backs off from 200ms with equal jitter. Every attempt has its own timeout, so the
+// timed out attempts are retried as well as the others.
+func (p *producer) retryPolicy() retry.Policy {
+	return retry.Policy{
+		Name:        "producer_append",
+		Initial:     retryBackoff,
+		Max:         p.opts.MaxRetryBackoff,
+		Jitter:      retry.EqualJitter,
+		MaxAttempts: p.opts.MaxRetries + 1,
+		Retryable: func(error) bool {
+			return true
+		},
+	}
+}
+
+func (p *producer) append(events []*ce.Event) (*api.Placement, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.opts.SendTimeout)
+	defer cancel()
+	return p.writer.AppendWithPlacement(ctx, events, option.WithAckLevel(p.opts.AckLevel))
+}
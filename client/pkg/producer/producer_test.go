@@ -0,0 +1,127 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package producer
+
+import (
+	"context"
+	stderr "errors"
+	"sync"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+)
+
+func TestProducer_Send(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	writer := api.NewMockBusWriter(mockCtrl)
+	ctx := context.Background()
+
+	var batches [][]*ce.Event
+	writer.EXPECT().AppendWithPlacement(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) (*api.Placement, error) {
+			batches = append(batches, events)
+			return &api.Placement{EventlogID: 1, Offset: int64(len(batches) * 10)}, nil
+		})
+	p := NewProducer(writer, WithBatchSize(2), WithLinger(time.Hour), WithIdempotence("p1"))
+
+	var mu sync.Mutex
+	eids := make(map[string]string)
+	callback := func(e *ce.Event, eid string, err error) {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		eids[e.ID()] = eid
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		e := ce.NewEvent()
+		e.SetID(id)
+		if err := p.Send(ctx, &e, callback); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	// the last event is appended by flush because of the long linger.
+	if err := p.Close(ctx); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+	if key := batches[1][0].Extensions()[XVanusProducerKey]; key != "p1-3" {
+		t.Errorf("unexpected producer key: %v", key)
+	}
+	want := map[string]string{
+		"1": (&api.Placement{EventlogID: 1, Offset: 10}).EventID(0),
+		"2": (&api.Placement{EventlogID: 1, Offset: 10}).EventID(1),
+		"3": (&api.Placement{EventlogID: 1, Offset: 20}).EventID(0),
+	}
+	for id, eid := range want {
+		if eids[id] != eid {
+			t.Errorf("event %s: got eid %s, want %s", id, eids[id], eid)
+		}
+	}
+	e := ce.NewEvent()
+	if err := p.Send(ctx, &e, nil); err != errProducerClosed {
+		t.Errorf("send after close, got %v", err)
+	}
+}
+
+func TestProducer_Retry(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	writer := api.NewMockBusWriter(mockCtrl)
+	ctx := context.Background()
+	errTest := stderr.New("test")
+
+	gomock.InOrder(
+		writer.EXPECT().AppendWithPlacement(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil, errTest),
+		writer.EXPECT().AppendWithPlacement(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).
+			Return(&api.Placement{EventlogID: 1}, nil),
+		writer.EXPECT().AppendWithPlacement(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil, errTest),
+	)
+	p := NewProducer(writer, WithLinger(time.Millisecond), WithRetry(2, time.Millisecond))
+	defer func() {
+		_ = p.Close(ctx)
+	}()
+
+	results := make(chan error, 1)
+	callback := func(_ *ce.Event, _ string, err error) {
+		results <- err
+	}
+	e := ce.NewEvent()
+	if err := p.Send(ctx, &e, callback); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if err := <-results; err != nil {
+		t.Errorf("got %v, want success after retries", err)
+	}
+
+	p2 := NewProducer(writer, WithRetry(1, time.Millisecond))
+	defer func() {
+		_ = p2.Close(ctx)
+	}()
+	if err := p2.Send(ctx, &e, callback); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if err := p2.Flush(ctx); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if err := <-results; err != errTest {
+		t.Errorf("got %v, want %v", err, errTest)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vanustest provides an in-memory implementation of the client, which is used to test the
+// applications producing and consuming events without a running cluster.
+//
+//	c := vanustest.NewClient()
+//	c.CreateEventbus("test", 2)
+//	// pass c to the code under test as client.Client
+//	events := c.Events("test")
+package vanustest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/consumer"
+)
+
+var _ client.Client = (*Client)(nil)
+
+// Client keeps the eventbuses in memory. The events are never expired, and the consumer groups
+// rebalance only when members join or leave.
+type Client struct {
+	eventbuses map[string][]*eventlog
+	nextLogID  uint64
+	groups     *groupCoordinator
+	mu         sync.RWMutex
+}
+
+func NewClient() *Client {
+	c := &Client{
+		eventbuses: make(map[string][]*eventlog),
+	}
+	c.groups = newGroupCoordinator(c)
+	return c
+}
+
+// CreateEventbus creates the eventbus with numberOfEventlogs eventlogs, it does nothing if the
+// eventbus exists.
+func (c *Client) CreateEventbus(name string, numberOfEventlogs int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exist := c.eventbuses[name]; exist {
+		return
+	}
+	if numberOfEventlogs <= 0 {
+		numberOfEventlogs = 1
+	}
+	logs := make([]*eventlog, numberOfEventlogs)
+	for idx := range logs {
+		c.nextLogID++
+		logs[idx] = newEventlog(c.nextLogID)
+	}
+	c.eventbuses[name] = logs
+}
+
+func (c *Client) DeleteEventbus(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.eventbuses, name)
+}
+
+// Events returns all events of the eventbus, sorted by eventlog and offset.
+func (c *Client) Events(name string) []*ce.Event {
+	logs := c.eventlogs(name)
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].id < logs[j].id
+	})
+	events := make([]*ce.Event, 0)
+	for _, l := range logs {
+		events = append(events, l.all()...)
+	}
+	return events
+}
+
+func (c *Client) Eventbus(_ context.Context, ebName string) api.Eventbus {
+	return &eventbus{c: c, name: ebName}
+}
+
+func (c *Client) ConsumerGroup(_ context.Context, group, ebName string,
+	opts ...consumer.Option) consumer.ConsumerGroup {
+	return consumer.NewConsumerGroup(group, ebName, &eventbus{c: c, name: ebName}, c.groups, opts...)
+}
+
+func (c *Client) Disconnect(_ context.Context) {}
+
+func (c *Client) eventlogs(name string) []*eventlog {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]*eventlog{}, c.eventbuses[name]...)
+}
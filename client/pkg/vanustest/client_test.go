@@ -0,0 +1,171 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vanustest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/consumer"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+func newEvent(id string) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID(id)
+	e.SetSource("test")
+	e.SetType("test")
+	return &e
+}
+
+func TestClient_WriteAndRead(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient()
+	c.CreateEventbus("test", 1)
+	bus := c.Eventbus(ctx, "test")
+
+	if _, err := bus.Writer().AppendOne(ctx, newEvent("1")); err != nil {
+		t.Fatalf("append one: %v", err)
+	}
+	p, err := bus.Writer().AppendWithPlacement(ctx, []*ce.Event{newEvent("2"), newEvent("3")})
+	if err != nil {
+		t.Fatalf("append with placement: %v", err)
+	}
+	if p.Offset != 1 {
+		t.Errorf("got offset %d, want 1", p.Offset)
+	}
+	if _, err = bus.Writer().AppendOne(ctx, &ce.Event{}); !errors.Is(err, errors.ErrInvalidRequest) {
+		t.Errorf("append invalid event, got %v", err)
+	}
+
+	logs, err := bus.ListLog(ctx)
+	if err != nil || len(logs) != 1 {
+		t.Fatalf("list log: %v, %v", logs, err)
+	}
+	if n, _ := logs[0].Length(ctx); n != 3 {
+		t.Errorf("got length %d, want 3", n)
+	}
+	r := bus.Reader(option.WithReadPolicy(policy.NewManuallyReadPolicy(logs[0], 1)), option.WithBatchSize(5))
+	events, off, id, err := r.Read(ctx)
+	if err != nil || off != 1 || id != logs[0].ID() || len(events) != 2 || events[0].ID() != "2" {
+		t.Errorf("read: %v, %d, %d, %v", events, off, id, err)
+	}
+	_, _, _, err = r.Read(ctx, option.WithReadPolicy(policy.NewManuallyReadPolicy(logs[0], 3)),
+		option.WithPollingTimeout(time.Millisecond))
+	if !errors.Is(err, errors.ErrOffsetOnEnd) {
+		t.Errorf("read on end, got %v", err)
+	}
+	if len(c.Events("test")) != 3 {
+		t.Errorf("got %d events, want 3", len(c.Events("test")))
+	}
+
+	if _, err = c.Eventbus(ctx, "none").Writer().AppendOne(ctx, newEvent("1")); !errors.Is(err,
+		errors.ErrResourceNotFound) {
+		t.Errorf("append to nonexistent eventbus, got %v", err)
+	}
+}
+
+func TestClient_ConsumerGroup(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient()
+	c.CreateEventbus("test", 2)
+	w := c.Eventbus(ctx, "test").Writer()
+	for i := 0; i < 10; i++ {
+		if _, err := w.AppendOne(ctx, newEvent(fmt.Sprint(i))); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	}
+
+	received := make(chan string, 10)
+	cg := c.ConsumerGroup(ctx, "group", "test",
+		consumer.WithConsumeFromWhere(api.ConsumeFromWhereEarliest),
+		consumer.WithHeartbeatInterval(10*time.Millisecond))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = cg.Consume(ctx, func(_ context.Context, _ uint64, events []*ce.Event) error {
+			for _, e := range events {
+				received <- e.ID()
+			}
+			return nil
+		})
+	}()
+	seen := make(map[string]bool)
+	for len(seen) < 10 {
+		select {
+		case id := <-received:
+			seen[id] = true
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d events, want 10", len(seen))
+		}
+	}
+	cg.Close(ctx)
+	<-done
+}
+
+func TestBusReader_Seek(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient()
+	c.CreateEventbus("test", 1)
+	bus := c.Eventbus(ctx, "test")
+	for i := 0; i < 4; i++ {
+		if _, err := bus.Writer().AppendOne(ctx, newEvent(fmt.Sprint(i))); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	logs, _ := bus.ListLog(ctx)
+	p := policy.NewManuallyReadPolicy(logs[0], 0)
+	r := bus.Reader(option.WithReadPolicy(p), option.WithBatchSize(1))
+	stime, err := types.ToTime(c.Events("test")[2].Extensions()[segpb.XVanusStime])
+	if err != nil {
+		t.Fatalf("stime: %v", err)
+	}
+
+	cases := []struct {
+		pos  api.Position
+		want int64
+	}{
+		{api.Latest(), 4},
+		{api.Earliest(), 0},
+		{api.AtOffset(3), 3},
+		{api.AtTime(stime), 2},
+		{api.AtTime(stime.Add(time.Hour)), 4},
+	}
+	for _, tc := range cases {
+		off, err := r.Seek(ctx, tc.pos)
+		if err != nil || off != tc.want || p.Offset() != tc.want {
+			t.Errorf("seek %s: got %d, %d, %v, want %d", tc.pos, off, p.Offset(), err, tc.want)
+		}
+	}
+	if _, err := r.Seek(ctx, api.AtOffset(1)); err != nil {
+		t.Fatalf("seek: %v", err)
+	}
+	events, off, _, err := r.Read(ctx)
+	if err != nil || off != 1 || events[0].ID() != "1" {
+		t.Errorf("read after seek: %v, %d, %v", events, off, err)
+	}
+	if _, err = r.Seek(ctx, api.AtOffset(5)); !errors.Is(err, errors.ErrOffsetOverflow) {
+		t.Errorf("seek out of range, got %v", err)
+	}
+}
@@ -0,0 +1,335 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vanustest
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/internal/vanus/codec"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	eventlogpkg "github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+type eventbus struct {
+	c    *Client
+	name string
+	idx  uint64
+}
+
+func (b *eventbus) Writer(opts ...api.WriteOption) api.BusWriter {
+	wo := &api.WriteOptions{}
+	wo.Apply(opts...)
+	return &busWriter{bus: b, opts: wo}
+}
+
+func (b *eventbus) Reader(opts ...api.ReadOption) api.BusReader {
+	ro := &api.ReadOptions{
+		BatchSize:      1,
+		PollingTimeout: api.DefaultPollingTimeout,
+	}
+	ro.Apply(opts...)
+	if ro.Policy == nil {
+		ro.Policy = policy.NewRoundRobinReadPolicy(b, api.ConsumeFromWhereEarliest)
+	}
+	return &busReader{bus: b, opts: ro}
+}
+
+func (b *eventbus) GetLog(_ context.Context, logID uint64, _ ...api.LogOption) (api.Eventlog, error) {
+	l := b.getLog(logID)
+	if l == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	return l, nil
+}
+
+func (b *eventbus) ListLog(_ context.Context, _ ...api.LogOption) ([]api.Eventlog, error) {
+	logs := b.c.eventlogs(b.name)
+	if len(logs) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+	}
+	res := make([]api.Eventlog, len(logs))
+	for idx := range logs {
+		res[idx] = logs[idx]
+	}
+	return res, nil
+}
+
+func (b *eventbus) Close(_ context.Context) {}
+
+func (b *eventbus) getLog(logID uint64) *eventlog {
+	for _, l := range b.c.eventlogs(b.name) {
+		if l.id == logID {
+			return l
+		}
+	}
+	return nil
+}
+
+type busWriter struct {
+	bus  *eventbus
+	opts *api.WriteOptions
+}
+
+func (w *busWriter) AppendOne(ctx context.Context, event *ce.Event, opts ...api.WriteOption) (string, error) {
+	p, err := w.AppendWithPlacement(ctx, []*ce.Event{event}, opts...)
+	if err != nil {
+		return "", err
+	}
+	return p.EventID(0), nil
+}
+
+func (w *busWriter) AppendMany(ctx context.Context, events []*ce.Event, opts ...api.WriteOption) (string, error) {
+	p, err := w.AppendWithPlacement(ctx, events, opts...)
+	if err != nil {
+		return "", err
+	}
+	return p.EventID(0), nil
+}
+
+func (w *busWriter) AppendBatch(ctx context.Context, batch *cloudevents.CloudEventBatch,
+	opts ...api.WriteOption) (*api.Placement, error) {
+	events := make([]*ce.Event, 0, len(batch.GetEvents()))
+	for _, pb := range batch.GetEvents() {
+		e, err := codec.FromProto(pb)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return w.AppendWithPlacement(ctx, events, opts...)
+}
+
+func (w *busWriter) AppendWithPlacement(ctx context.Context, events []*ce.Event,
+	opts ...api.WriteOption) (*api.Placement, error) {
+	wo := w.opts
+	if len(opts) > 0 {
+		wo = w.opts.Copy()
+		wo.Apply(opts...)
+	}
+	if len(events) == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("no event to append")
+	}
+	for _, e := range events {
+		if err := e.Validate(); err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage("invalid event").Wrap(err)
+		}
+	}
+	l, err := w.pickLog(ctx, wo)
+	if err != nil {
+		return nil, err
+	}
+	offset, stime := l.append(events)
+	return &api.Placement{EventlogID: l.id, Offset: offset, Stime: stime}, nil
+}
+
+func (w *busWriter) pickLog(ctx context.Context, wo *api.WriteOptions) (*eventlog, error) {
+	if wo.Policy != nil {
+		el, err := wo.Policy.NextLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if l := w.bus.getLog(el.ID()); l != nil {
+			return l, nil
+		}
+		return nil, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	logs := w.bus.c.eventlogs(w.bus.name)
+	if len(logs) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventbus not found")
+	}
+	return logs[atomic.AddUint64(&w.bus.idx, 1)%uint64(len(logs))], nil
+}
+
+type busReader struct {
+	bus  *eventbus
+	opts *api.ReadOptions
+}
+
+func (r *busReader) Read(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+	ro := r.opts
+	if len(opts) > 0 {
+		ro = r.opts.Copy()
+		ro.Apply(opts...)
+	}
+	el, err := ro.Policy.NextLog(ctx)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	l := r.bus.getLog(el.ID())
+	if l == nil {
+		return nil, 0, 0, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	offset := ro.Policy.Offset()
+	events, err := l.read(ctx, offset, ro.BatchSize, time.Duration(ro.PollingTimeout)*time.Millisecond)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if ro.Filter != nil {
+		// the filter isn't evaluated, but the policy is forwarded like the real reader.
+		ro.Policy.Forward(len(events))
+	}
+	return events, offset, l.id, nil
+}
+
+func (r *busReader) Seek(ctx context.Context, pos api.Position, opts ...api.ReadOption) (int64, error) {
+	ro := r.opts
+	if len(opts) > 0 {
+		ro = r.opts.Copy()
+		ro.Apply(opts...)
+	}
+	el, err := ro.Policy.NextLog(ctx)
+	if err != nil {
+		return -1, err
+	}
+	l := r.bus.getLog(el.ID())
+	if l == nil {
+		return -1, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	off, err := eventlogpkg.ResolvePosition(ctx, l, pos)
+	if err != nil {
+		return -1, err
+	}
+	ro.Policy.Forward(int(off - ro.Policy.Offset()))
+	return off, nil
+}
+
+type eventlog struct {
+	id     uint64
+	events []*ce.Event
+	stimes []int64
+	// appended is closed when events are appended, the polling readers wait on it.
+	appended chan struct{}
+	mu       sync.RWMutex
+}
+
+func newEventlog(id uint64) *eventlog {
+	return &eventlog{
+		id:       id,
+		appended: make(chan struct{}),
+	}
+}
+
+func (l *eventlog) ID() uint64 {
+	return l.id
+}
+
+func (l *eventlog) EarliestOffset(_ context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (l *eventlog) LatestOffset(_ context.Context) (int64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return int64(len(l.events)), nil
+}
+
+func (l *eventlog) Length(ctx context.Context) (int64, error) {
+	return l.LatestOffset(ctx)
+}
+
+func (l *eventlog) QueryOffsetByTime(_ context.Context, timestamp int64) (int64, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	n := sort.Search(len(l.stimes), func(i int) bool {
+		return l.stimes[i] >= timestamp
+	})
+	return int64(n), nil
+}
+
+func (l *eventlog) append(events []*ce.Event) (int64, int64) {
+	stime := time.Now().UnixMilli()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	offset := int64(len(l.events))
+	for _, e := range events {
+		stored := e.Clone()
+		stored.SetExtension(segpb.XVanusStime, time.UnixMilli(stime))
+		l.events = append(l.events, &stored)
+		l.stimes = append(l.stimes, stime)
+	}
+	close(l.appended)
+	l.appended = make(chan struct{})
+	return offset, stime
+}
+
+// read returns at most size events from offset, it waits for the new events at most pollingTimeout if
+// the offset is on end.
+func (l *eventlog) read(ctx context.Context, offset int64, size int, pollingTimeout time.Duration) (
+	[]*ce.Event, error) {
+	if offset < 0 {
+		return nil, errors.ErrOffsetUnderflow
+	}
+	if size <= 0 {
+		size = 1
+	}
+	l.mu.RLock()
+	end := int64(len(l.events))
+	appended := l.appended
+	l.mu.RUnlock()
+	if offset > end {
+		return nil, errors.ErrOffsetOverflow
+	}
+	if offset == end {
+		if pollingTimeout <= 0 {
+			return nil, errors.ErrOffsetOnEnd
+		}
+		timer := time.NewTimer(pollingTimeout)
+		defer timer.Stop()
+		select {
+		case <-appended:
+		case <-timer.C:
+			return nil, errors.ErrOffsetOnEnd
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	n := int64(len(l.events)) - offset
+	if n > int64(size) {
+		n = int64(size)
+	}
+	events := make([]*ce.Event, 0, n)
+	for idx := offset; idx < offset+n; idx++ {
+		e := l.events[idx].Clone()
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, uint64(idx))
+		e.SetExtension(segpb.XVanusLogOffset, buf)
+		events = append(events, &e)
+	}
+	return events, nil
+}
+
+func (l *eventlog) all() []*ce.Event {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	events := make([]*ce.Event, len(l.events))
+	for idx := range l.events {
+		e := l.events[idx].Clone()
+		events[idx] = &e
+	}
+	return events
+}
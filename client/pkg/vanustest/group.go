@@ -0,0 +1,147 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vanustest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// groupCoordinator implements the consumer group RPCs of controller in memory, the other RPCs aren't
+// implemented and panic if called.
+type groupCoordinator struct {
+	ctrlpb.EventBusControllerClient
+	c      *Client
+	groups map[string]*group
+	mu     sync.Mutex
+}
+
+type group struct {
+	eventbus   string
+	generation uint64
+	members    map[string]struct{}
+	assignment map[string][]uint64
+	offsets    map[uint64]int64
+}
+
+func newGroupCoordinator(c *Client) *groupCoordinator {
+	return &groupCoordinator{
+		c:      c,
+		groups: make(map[string]*group),
+	}
+}
+
+func (gc *groupCoordinator) ConsumerGroupHeartbeat(_ context.Context, in *ctrlpb.ConsumerGroupHeartbeatRequest,
+	_ ...grpc.CallOption) (*ctrlpb.ConsumerGroupAssignment, error) {
+	logs := gc.c.eventlogs(in.Eventbus)
+	if len(logs) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	g, exist := gc.groups[in.Group]
+	if !exist {
+		g = &group{
+			eventbus: in.Eventbus,
+			members:  make(map[string]struct{}),
+			offsets:  make(map[uint64]int64),
+		}
+		gc.groups[in.Group] = g
+	}
+	if g.eventbus != in.Eventbus {
+		return nil, errors.ErrInvalidRequest.WithMessage("the consumer group consumes another eventbus")
+	}
+	if _, exist = g.members[in.MemberId]; !exist {
+		g.members[in.MemberId] = struct{}{}
+		g.rebalance(logs)
+	}
+	return &ctrlpb.ConsumerGroupAssignment{
+		Group:       in.Group,
+		Generation:  g.generation,
+		EventlogIds: g.assignment[in.MemberId],
+	}, nil
+}
+
+func (gc *groupCoordinator) LeaveConsumerGroup(_ context.Context, in *ctrlpb.LeaveConsumerGroupRequest,
+	_ ...grpc.CallOption) (*emptypb.Empty, error) {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	g, exist := gc.groups[in.Group]
+	if !exist {
+		return &emptypb.Empty{}, nil
+	}
+	if _, exist = g.members[in.MemberId]; exist {
+		delete(g.members, in.MemberId)
+		g.rebalance(gc.c.eventlogs(g.eventbus))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (gc *groupCoordinator) CommitConsumerGroupOffset(_ context.Context, in *ctrlpb.CommitConsumerGroupOffsetRequest,
+	_ ...grpc.CallOption) (*emptypb.Empty, error) {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	g, exist := gc.groups[in.Group]
+	if !exist || g.generation != in.Generation {
+		return nil, errors.ErrGroupRebalanced
+	}
+	for _, o := range in.Offsets {
+		g.offsets[o.EventlogId] = o.Offset
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (gc *groupCoordinator) GetConsumerGroupOffset(_ context.Context, in *ctrlpb.GetConsumerGroupOffsetRequest,
+	_ ...grpc.CallOption) (*ctrlpb.GetConsumerGroupOffsetResponse, error) {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	res := &ctrlpb.GetConsumerGroupOffsetResponse{}
+	if g, exist := gc.groups[in.Group]; exist {
+		for id, offset := range g.offsets {
+			res.Offsets = append(res.Offsets, &ctrlpb.ConsumerGroupOffset{EventlogId: id, Offset: offset})
+		}
+	}
+	return res, nil
+}
+
+// rebalance assigns the eventlogs to the members in a round-robin way, the same as the controller.
+func (g *group) rebalance(logs []*eventlog) {
+	members := make([]string, 0, len(g.members))
+	for id := range g.members {
+		members = append(members, id)
+	}
+	sort.Strings(members)
+	ids := make([]uint64, 0, len(logs))
+	for _, l := range logs {
+		ids = append(ids, l.id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	g.assignment = make(map[string][]uint64, len(members))
+	for idx, id := range ids {
+		if len(members) > 0 {
+			m := members[idx%len(members)]
+			g.assignment[m] = append(g.assignment[m], id)
+		}
+	}
+	g.generation++
+}
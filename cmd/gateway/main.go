@@ -19,10 +19,16 @@ import (
 	"flag"
 	"os"
 
+	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/internal/gateway"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
+	"github.com/linkall-labs/vanus/internal/primitive/reload"
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var (
@@ -40,8 +46,14 @@ func main() {
 		os.Exit(-1)
 	}
 
+	primitive.InitReservedAttributes(cfg.ReservedAttributePrefixes)
 	ctx := signal.SetupSignalContext()
 	ga := gateway.NewGateway(*cfg)
+	reloader := reload.NewReloader("gateway", *configPath, cfg, func(path string) (interface{}, error) {
+		return gateway.InitConfig(path)
+	}, func(ctx context.Context, c interface{}) error {
+		return ga.Reload(ctx, *c.(*gateway.Config))
+	})
 
 	if err = ga.Start(ctx); err != nil {
 		log.Error(context.Background(), "start gateway failed", map[string]interface{}{
@@ -52,11 +64,15 @@ func main() {
 	
 	cfg.Observability.T.ServerName = "Vanus Gateway"
 	_ = observability.Initialize(cfg.Observability, nil)
+	opsevent.Init(opsevent.NewEmitter("vanus-gateway",
+		cluster.NewClusterController(cfg.ControllerAddr, insecure.NewCredentials()), eb.Connect(cfg.ControllerAddr)))
+	reloader.Start(ctx)
 	log.Info(ctx, "Gateway has started", nil)
 	select {
 	case <-ctx.Done():
 		log.Info(ctx, "received system signal, preparing exit", nil)
 	}
 	ga.Stop()
+	opsevent.Close()
 	log.Info(ctx, "the gateway has been shutdown gracefully", nil)
 }
@@ -0,0 +1,61 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/linkall-labs/vanus/internal/mirror"
+	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	"github.com/linkall-labs/vanus/pkg/util/signal"
+)
+
+var (
+	configPath = flag.String("config", "./config/mirror.yaml", "the configuration file of mirror")
+)
+
+func main() {
+	flag.Parse()
+	ctx := signal.SetupSignalContext()
+	cfg, err := mirror.InitConfig(*configPath)
+	if err != nil {
+		log.Error(ctx, "init config error", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
+
+	cfg.Observability.T.ServerName = "Vanus Mirror"
+	_ = observability.Initialize(cfg.Observability, metrics.RegisterMirrorMetrics)
+	retry.SetRecorder(metrics.ObserveRetry)
+
+	m := mirror.New(cfg)
+	if err = m.Start(ctx); err != nil {
+		log.Error(ctx, "start mirror failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
+
+	<-ctx.Done()
+	log.Info(ctx, "received system signal, preparing exit", nil)
+	m.Stop(context.Background())
+	log.Info(ctx, "the mirror has been shutdown gracefully", nil)
+}
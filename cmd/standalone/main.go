@@ -0,0 +1,98 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
+	"github.com/linkall-labs/vanus/internal/primitive/reload"
+	"github.com/linkall-labs/vanus/internal/standalone"
+	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	"github.com/linkall-labs/vanus/pkg/util/signal"
+	"google.golang.org/grpc/credentials/insecure"
+
+	eb "github.com/linkall-labs/vanus/client"
+)
+
+const usage = "usage: vanus standalone --data-dir <dir> [--config <file>]"
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "standalone" {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	flags := flag.NewFlagSet("standalone", flag.ExitOnError)
+	dataDir := flags.String("data-dir", "", "the directory of metadata and events")
+	configPath := flags.String("config", "", "the optional configuration file, which overrides the default ports")
+	_ = flags.Parse(os.Args[2:])
+
+	cfg, err := standalone.InitConfig(*configPath, *dataDir)
+	if err != nil {
+		log.Error(context.Background(), "init config error", map[string]interface{}{
+			log.KeyError: err,
+		})
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(-1)
+	}
+
+	primitive.InitReservedAttributes(cfg.ReservedAttributePrefixes)
+	ctx := signal.SetupSignalContext()
+	var reloader *reload.Reloader
+	if *configPath != "" {
+		reloader = reload.NewReloader("standalone", *configPath, cfg, func(path string) (interface{}, error) {
+			return standalone.InitConfig(path, *dataDir)
+		}, func(_ context.Context, c interface{}) error {
+			observability.Reload(c.(*standalone.Config).Observability)
+			return nil
+		})
+	}
+	cfg.Observability.T.ServerName = "Vanus Standalone"
+	_ = observability.Initialize(cfg.Observability, metrics.RegisterStandaloneMetrics)
+	retry.SetRecorder(metrics.ObserveRetry)
+	ctrlAddrs := cfg.GetControllerConfig().GetControllerAddrs()
+	opsevent.Init(opsevent.NewEmitter("vanus-standalone",
+		cluster.NewClusterController(ctrlAddrs, insecure.NewCredentials()), eb.Connect(ctrlAddrs)))
+
+	s := standalone.New(cfg)
+	if err = s.Start(ctx); err != nil {
+		log.Error(ctx, "start standalone failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		s.Stop(context.Background())
+		os.Exit(-1)
+	}
+	if reloader != nil {
+		reloader.Start(ctx)
+	}
+
+	select {
+	case <-ctx.Done():
+		log.Info(ctx, "received system signal, preparing exit", nil)
+	case <-s.StopNotify():
+		log.Info(ctx, "received component ready to stop, preparing exit", nil)
+	}
+	opsevent.Close()
+	s.Stop(context.Background())
+	log.Info(ctx, "the standalone vanus has been shutdown gracefully", nil)
+}
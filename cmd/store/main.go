@@ -26,7 +26,10 @@ import (
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/retry"
 	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/reload"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store"
 	"github.com/linkall-labs/vanus/internal/store/segment"
@@ -35,7 +38,13 @@ import (
 var configPath = flag.String("config", "./config/store.yaml", "store config file path")
 
 func main() {
-	flag.Parse()
+	// `vanus-store upgrade` rewrites the on-disk formats of the volume offline.
+	upgrade := len(os.Args) > 1 && os.Args[1] == "upgrade"
+	if upgrade {
+		_ = flag.CommandLine.Parse(os.Args[2:])
+	} else {
+		flag.Parse()
+	}
 
 	cfg, err := store.InitConfig(*configPath)
 	if err != nil {
@@ -45,6 +54,25 @@ func main() {
 		os.Exit(-1)
 	}
 
+	primitive.InitReservedAttributes(cfg.ReservedAttributePrefixes)
+	if upgrade {
+		if err = segment.Upgrade(context.Background(), *cfg); err != nil {
+			log.Error(context.Background(), "Upgrade the volume failed.", map[string]interface{}{
+				log.KeyError: err,
+				"volume_dir": cfg.Volume.Dir,
+			})
+			os.Exit(-1)
+		}
+		return
+	}
+
+	reloader := reload.NewReloader("store", *configPath, cfg, func(path string) (interface{}, error) {
+		return store.InitConfig(path)
+	}, func(_ context.Context, c interface{}) error {
+		observability.Reload(c.(*store.Config).Observability)
+		return nil
+	})
+
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
 		log.Error(context.Background(), "Listen tcp port failed.", map[string]interface{}{
@@ -56,6 +84,7 @@ func main() {
 
 	cfg.Observability.T.ServerName = "Vanus Store"
 	_ = observability.Initialize(cfg.Observability, metrics.RegisterSegmentServerMetrics)
+	retry.SetRecorder(metrics.ObserveRetry)
 
 	ctx := context.Background()
 	srv := segment.NewServer(*cfg)
@@ -81,6 +110,7 @@ func main() {
 		os.Exit(-3)
 	}
 	defer vanus.DestroySnowflake()
+	reloader.Start(ctx)
 
 	if err = srv.Serve(listener); err != nil {
 		log.Error(ctx, "The SegmentServer occurred an error.", map[string]interface{}{
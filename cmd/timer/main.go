@@ -19,12 +19,16 @@ import (
 	"flag"
 	"os"
 
+	etcdkv "github.com/linkall-labs/vanus/internal/kv/etcd"
+	"github.com/linkall-labs/vanus/internal/primitive/reload"
 	"github.com/linkall-labs/vanus/internal/timer"
 	"github.com/linkall-labs/vanus/internal/timer/leaderelection"
 	"github.com/linkall-labs/vanus/internal/timer/timingwheel"
 	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/retry"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
 )
 
@@ -48,7 +52,22 @@ func main() {
 		os.Exit(-1)
 	}
 
+	reloader := reload.NewReloader("timer", *configPath, cfg, func(path string) (interface{}, error) {
+		return timer.InitConfig(path)
+	}, func(_ context.Context, c interface{}) error {
+		observability.Reload(c.(*timer.Config).Observability)
+		return nil
+	})
+	cfg.Observability.T.ServerName = "Vanus Timer"
 	_ = observability.Initialize(cfg.Observability, metrics.RegisterTimerMetrics)
+	retry.SetRecorder(metrics.ObserveRetry)
+	if etcdCheck, err := etcdkv.NewHealthCheck(cfg.EtcdEndpoints, cfg.MetadataConfig.KeyPrefix); err != nil {
+		log.Warning(ctx, "failed to create health check of etcd", map[string]interface{}{
+			log.KeyError: err,
+		})
+	} else {
+		health.AddReadinessCheck("etcd", etcdCheck)
+	}
 
 	// new leaderelection manager
 	leaderelectionMgr := leaderelection.NewLeaderElection(cfg.GetLeaderElectionConfig())
@@ -67,14 +86,14 @@ func main() {
 	callbacks := leaderelection.LeaderCallbacks{
 		OnStartedLeading: func(ctx context.Context) {
 			log.Info(ctx, "leaderelection finish, become leader", nil)
-			if timingwheelMgr.IsDeployed(ctx) {
-				err := timingwheelMgr.Recover(ctx)
-				if err != nil {
-					log.Error(ctx, "recover for failover failed, keeping follower", map[string]interface{}{
-						log.KeyError: err,
-					})
-					return
-				}
+			// the fence is acquired in recovery even if the timer isn't deployed yet, the leader can't fire
+			// events without it
+			err := timingwheelMgr.Recover(ctx)
+			if err != nil {
+				log.Error(ctx, "recover for failover failed, keeping follower", map[string]interface{}{
+					log.KeyError: err,
+				})
+				return
 			}
 			timingwheelMgr.SetLeader(true)
 		},
@@ -99,6 +118,7 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	reloader.Start(ctx)
 
 	select {
 	case <-ctx.Done():
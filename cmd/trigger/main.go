@@ -23,12 +23,19 @@ import (
 	"sync"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
+	"github.com/linkall-labs/vanus/internal/primitive/profiling"
+	"github.com/linkall-labs/vanus/internal/primitive/reload"
 	"github.com/linkall-labs/vanus/internal/trigger"
 	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/retry"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	pbtriggerv2 "github.com/linkall-labs/vanus/proto/pkg/v2/trigger"
 	"google.golang.org/grpc"
 )
 
@@ -46,6 +53,7 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	primitive.InitReservedAttributes(cfg.ReservedAttributePrefixes)
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
 		log.Error(context.Background(), "failed to listen", map[string]interface{}{
@@ -54,11 +62,23 @@ func main() {
 		os.Exit(-1)
 	}
 	ctx := signal.SetupSignalContext()
+	reloader := reload.NewReloader("trigger", *configPath, cfg, func(path string) (interface{}, error) {
+		return trigger.InitConfig(path)
+	}, func(_ context.Context, c interface{}) error {
+		observability.Reload(c.(*trigger.Config).Observability)
+		return nil
+	})
+	cfg.Observability.T.ServerName = "Vanus Trigger"
 	_ = observability.Initialize(cfg.Observability, metrics.RegisterTriggerMetrics)
+	retry.SetRecorder(metrics.ObserveRetry)
 	var opts []grpc.ServerOption
 	grpcServer := grpc.NewServer(opts...)
 	srv := trigger.NewTriggerServer(*cfg)
 	pbtrigger.RegisterTriggerWorkerServer(grpcServer, srv)
+	pbtriggerv2.RegisterTriggerWorkerServer(grpcServer, trigger.NewTriggerServerV2(srv))
+	ctrlpb.RegisterProfilingServerServer(grpcServer, profiling.NewServer())
+	ctrlpb.RegisterChaosServerServer(grpcServer, chaos.NewServer())
+	health.RegisterGRPC(grpcServer)
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -78,6 +98,7 @@ func main() {
 		})
 		os.Exit(1)
 	}
+	reloader.Start(ctx)
 	<-ctx.Done()
 	closer := srv.(primitive.Closer)
 	closer.Close(ctx)
@@ -21,25 +21,31 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus"
 	"github.com/linkall-labs/vanus/internal/controller/snowflake"
 	"github.com/linkall-labs/vanus/internal/controller/trigger"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
 	"github.com/linkall-labs/vanus/observability"
 )
 
 type Config struct {
-	NodeID               uint16               `yaml:"node_id"`
-	Name                 string               `yaml:"name"`
-	IP                   string               `yaml:"ip"`
-	Port                 int                  `yaml:"port"`
-	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
-	EtcdEndpoints        []string             `yaml:"etcd"`
-	DataDir              string               `yaml:"data_dir"`
-	MetadataConfig       MetadataConfig       `yaml:"metadata"`
-	EtcdConfig           embedetcd.Config     `yaml:"embed_etcd"`
-	Topology             map[string]string    `yaml:"topology"`
-	Replicas             uint                 `yaml:"replicas"`
-	SecretEncryptionSalt string               `yaml:"secret_encryption_salt"`
-	SegmentCapacity      int64                `yaml:"segment_capacity"`
-	Observability        observability.Config `yaml:"observability"`
+	NodeID               uint16                 `yaml:"node_id"`
+	Name                 string                 `yaml:"name"`
+	IP                   string                 `yaml:"ip"`
+	Port                 int                    `yaml:"port"`
+	GRPCReflectionEnable bool                   `yaml:"grpc_reflection_enable"`
+	EtcdEndpoints        []string               `yaml:"etcd"`
+	DataDir              string                 `yaml:"data_dir"`
+	MetadataConfig       MetadataConfig         `yaml:"metadata"`
+	EtcdConfig           embedetcd.Config       `yaml:"embed_etcd"`
+	Topology             map[string]string      `yaml:"topology"`
+	Replicas             uint                   `yaml:"replicas"`
+	SecretEncryptionSalt string                 `yaml:"secret_encryption_salt"`
+	SecretKMS            secret.KMSConfig       `yaml:"secret_kms"`
+	SegmentCapacity      int64                  `yaml:"segment_capacity"`
+	ACL                  acl.Config             `yaml:"acl"`
+	TriggerRebalance     worker.RebalanceConfig `yaml:"trigger_rebalance"`
+	Observability        observability.Config   `yaml:"observability"`
 }
 
 func (c *Config) GetEtcdConfig() embedetcd.Config {
@@ -57,6 +63,7 @@ func (c *Config) GetEventbusCtrlConfig() eventbus.Config {
 		Replicas:         c.Replicas,
 		Topology:         c.Topology,
 		SegmentCapacity:  c.SegmentCapacity,
+		ACL:              c.ACL,
 	}
 }
 
@@ -86,6 +93,9 @@ func (c *Config) GetTriggerConfig() trigger.Config {
 			ServerList: c.EtcdEndpoints,
 		},
 		SecretEncryptionSalt: c.SecretEncryptionSalt,
+		SecretKMS:            c.SecretKMS,
+		ACL:                  c.ACL,
+		Rebalance:            c.TriggerRebalance,
 	}
 }
 
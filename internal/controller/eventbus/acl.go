@@ -0,0 +1,179 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"path"
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The ACL entries are saved in kv by eventbus, gateway and trigger controller get the policy of
+// an eventbus by ListEventbusACL and enforce the publish and subscribe permissions themselves.
+
+func (ctrl *controller) PutEventbusACL(ctx context.Context, req *ctrlpb.EventbusACL) (*ctrlpb.EventbusACL, error) {
+	if req.Principal == "" || strings.Contains(req.Principal, "/") {
+		return nil, errors.ErrInvalidRequest.WithMessage("principal can't be empty or contain /")
+	}
+	if len(req.Permissions) == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("permissions can't be empty")
+	}
+	entry := &acl.Entry{Principal: req.Principal}
+	for _, v := range req.Permissions {
+		perm, err := acl.ParsePermission(v)
+		if err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+		entry.Permissions = append(entry.Permissions, perm)
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if _, exist := ctrl.eventBusMap[req.Eventbus]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	if err := ctrl.authorize(ctx, req.Eventbus, acl.PermissionAdmin); err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(entry)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusACLKey(req.Eventbus, req.Principal), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save eventbus acl in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "eventbus acl updated", map[string]interface{}{
+		"eventbus":    req.Eventbus,
+		"principal":   req.Principal,
+		"permissions": req.Permissions,
+	})
+	return req, nil
+}
+
+func (ctrl *controller) DeleteEventbusACL(ctx context.Context,
+	req *ctrlpb.DeleteEventbusACLRequest) (*emptypb.Empty, error) {
+	if err := ctrl.authorize(ctx, req.Eventbus, acl.PermissionAdmin); err != nil {
+		return nil, err
+	}
+	key := metadata.GetEventbusACLKey(req.Eventbus, req.Principal)
+	exist, err := ctrl.kvStore.Exists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if req.Principal == "" || !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the acl entry doesn't exist")
+	}
+	if err = ctrl.kvStore.Delete(ctx, key); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete eventbus acl in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "eventbus acl deleted", map[string]interface{}{
+		"eventbus":  req.Eventbus,
+		"principal": req.Principal,
+	})
+	return &emptypb.Empty{}, nil
+}
+
+// ListEventbusACL returns the ACL entries of the eventbus with the cluster wide config, it isn't
+// authorized because gateway and trigger controller evaluate the policy by it.
+func (ctrl *controller) ListEventbusACL(ctx context.Context,
+	req *ctrlpb.ListEventbusACLRequest) (*ctrlpb.ListEventbusACLResponse, error) {
+	entries, err := ctrl.listEventbusACL(ctx, req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	res := &ctrlpb.ListEventbusACLResponse{
+		Acls:          make([]*ctrlpb.EventbusACL, 0, len(entries)),
+		DenyByDefault: ctrl.cfg.ACL.DenyByDefault,
+		Admins:        ctrl.cfg.ACL.Admins,
+	}
+	for _, e := range entries {
+		a := &ctrlpb.EventbusACL{Eventbus: req.Eventbus, Principal: e.Principal}
+		for _, perm := range e.Permissions {
+			a.Permissions = append(a.Permissions, string(perm))
+		}
+		res.Acls = append(res.Acls, a)
+	}
+	return res, nil
+}
+
+func (ctrl *controller) listEventbusACL(ctx context.Context, eventbus string) ([]*acl.Entry, error) {
+	dir := metadata.GetEventbusACLKey(eventbus, "")
+	pairs, err := ctrl.kvStore.List(ctx, dir)
+	if err != nil {
+		if stderr.Is(err, kv.ErrKeyNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	entries := make([]*acl.Entry, 0, len(pairs))
+	for _, pair := range pairs {
+		// the listing is by prefix, skip the entries of the eventbus whose name starts with this one.
+		if !strings.HasSuffix(path.Dir(pair.Key), dir) {
+			continue
+		}
+		e := &acl.Entry{}
+		if err = json.Unmarshal(pair.Value, e); err != nil {
+			log.Warning(ctx, "unmarshal eventbus acl failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// authorize checks the permission of the principal carried in the incoming metadata, the system
+// eventbuses are managed by components internally and aren't under ACL.
+func (ctrl *controller) authorize(ctx context.Context, eventbus string, perm acl.Permission) error {
+	if !ctrl.cfg.ACL.Enable || strings.HasPrefix(eventbus, systemEventbusPrefix) {
+		return nil
+	}
+	entries, err := ctrl.listEventbusACL(ctx, eventbus)
+	if err != nil {
+		return err
+	}
+	policy := &acl.Policy{Config: ctrl.cfg.ACL, Entries: entries}
+	principal := acl.PrincipalFromContext(ctx)
+	if !policy.Allowed(principal, perm) {
+		return errors.ErrPermissionDenied.WithMessage(
+			"the principal " + principal + " isn't allowed to " + string(perm) + " the eventbus " + eventbus)
+	}
+	return nil
+}
+
+// deleteACLOfEventbus deletes the ACL entries of the deleted eventbus one by one, the directory can't be
+// deleted by prefix which matches the other eventbuses.
+func (ctrl *controller) deleteACLOfEventbus(ctx context.Context, eventbus string) {
+	entries, err := ctrl.listEventbusACL(ctx, eventbus)
+	if err != nil {
+		log.Warning(ctx, "list acl of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eventbus,
+		})
+		return
+	}
+	for _, e := range entries {
+		_ = ctrl.kvStore.Delete(ctx, metadata.GetEventbusACLKey(eventbus, e.Principal))
+	}
+}
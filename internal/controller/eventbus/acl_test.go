@@ -0,0 +1,112 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+func TestController_EventbusACL(t *testing.T) {
+	Convey("test eventbus acl", t, func() {
+		ctrl := NewController(Config{ACL: acl.Config{Enable: true, Admins: []string{"root"}}}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctrl.eventBusMap["test"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test"}
+		dir := metadata.GetEventbusACLKey("test", "")
+		withPrincipal := func(principal string) stdCtx.Context {
+			md, _ := grpcmd.FromOutgoingContext(acl.WithPrincipal(stdCtx.Background(), principal))
+			return grpcmd.NewIncomingContext(stdCtx.Background(), md)
+		}
+		entry := func(principal string, perms ...acl.Permission) kv.Pair {
+			data, _ := json.Marshal(&acl.Entry{Principal: principal, Permissions: perms})
+			return kv.Pair{Key: "/prefix" + metadata.GetEventbusACLKey("test", principal), Value: data}
+		}
+
+		Convey("test put acl with invalid request", func() {
+			ctx := withPrincipal("root")
+			_, err := ctrl.PutEventbusACL(ctx, &ctrlpb.EventbusACL{Eventbus: "test", Principal: "a/b",
+				Permissions: []string{"publish"}})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutEventbusACL(ctx, &ctrlpb.EventbusACL{Eventbus: "test", Principal: "alice",
+				Permissions: []string{"read"}})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutEventbusACL(ctx, &ctrlpb.EventbusACL{Eventbus: "test-2", Principal: "alice",
+				Permissions: []string{"publish"}})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test put and list acl", func() {
+			other, _ := json.Marshal(&acl.Entry{Principal: "bob", Permissions: []acl.Permission{acl.PermissionAdmin}})
+			pairs := []kv.Pair{
+				entry("alice", acl.PermissionAdmin),
+				// the entry of eventbus test-2 is matched by the prefix too.
+				{Key: "/prefix" + metadata.GetEventbusACLKey("test-2", "bob"), Value: other},
+			}
+			kvCli.EXPECT().List(gomock.Any(), dir).AnyTimes().Return(pairs, nil)
+
+			_, err := ctrl.PutEventbusACL(withPrincipal("bob"), &ctrlpb.EventbusACL{Eventbus: "test",
+				Principal: "carol", Permissions: []string{"publish"}})
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetEventbusACLKey("test", "carol"), gomock.Any()).
+				Times(1).Return(nil)
+			res, err := ctrl.PutEventbusACL(withPrincipal("alice"), &ctrlpb.EventbusACL{Eventbus: "test",
+				Principal: "carol", Permissions: []string{"publish", "subscribe"}})
+			So(err, ShouldBeNil)
+			So(res.Permissions, ShouldResemble, []string{"publish", "subscribe"})
+
+			list, err := ctrl.ListEventbusACL(stdCtx.Background(), &ctrlpb.ListEventbusACLRequest{Eventbus: "test"})
+			So(err, ShouldBeNil)
+			So(list.Acls, ShouldHaveLength, 1)
+			So(list.Acls[0].Principal, ShouldEqual, "alice")
+			So(list.Admins, ShouldResemble, []string{"root"})
+		})
+
+		Convey("test delete acl", func() {
+			kvCli.EXPECT().List(gomock.Any(), dir).AnyTimes().Return([]kv.Pair{entry("alice", acl.PermissionAdmin)}, nil)
+			key := metadata.GetEventbusACLKey("test", "alice")
+			kvCli.EXPECT().Exists(gomock.Any(), key).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(gomock.Any(), key).Times(1).Return(nil)
+			_, err := ctrl.DeleteEventbusACL(withPrincipal("root"),
+				&ctrlpb.DeleteEventbusACLRequest{Eventbus: "test", Principal: "alice"})
+			So(err, ShouldBeNil)
+
+			kvCli.EXPECT().Exists(gomock.Any(), metadata.GetEventbusACLKey("test", "bob")).Times(1).Return(false, nil)
+			_, err = ctrl.DeleteEventbusACL(withPrincipal("root"),
+				&ctrlpb.DeleteEventbusACLRequest{Eventbus: "test", Principal: "bob"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test create eventbus in deny by default mode", func() {
+			ctrl.cfg.ACL.DenyByDefault = true
+			kvCli.EXPECT().List(gomock.Any(), metadata.GetEventbusACLKey("new", "")).Times(1).Return(nil, nil)
+			_, err := ctrl.CreateEventBus(withPrincipal("alice"), &ctrlpb.CreateEventBusRequest{Name: "new"})
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The IP allowlists are saved in kv by kind and name, gateway lists all of them periodically and
+// rejects the requests from the addresses out of the allowlists before any processing.
+
+func (ctrl *controller) PutIPAllowlist(ctx context.Context, req *ctrlpb.IPAllowlist) (*ctrlpb.IPAllowlist, error) {
+	if _, err := acl.ParseAllowlistKind(req.Kind); err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	if req.Name == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("name can't be empty")
+	}
+	if len(req.Cidrs) == 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("cidrs can't be empty")
+	}
+	allowlist := &ctrlpb.IPAllowlist{Kind: req.Kind, Name: req.Name}
+	for _, v := range req.Cidrs {
+		n, err := acl.ParseCIDR(v)
+		if err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+		allowlist.Cidrs = append(allowlist.Cidrs, n.String())
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if err := ctrl.authorizeIPAllowlist(ctx, req.Kind, req.Name); err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(allowlist)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetIPAllowlistKey(req.Kind, req.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save ip allowlist in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "ip allowlist updated", map[string]interface{}{
+		"kind":  allowlist.Kind,
+		"name":  allowlist.Name,
+		"cidrs": allowlist.Cidrs,
+	})
+	return allowlist, nil
+}
+
+func (ctrl *controller) DeleteIPAllowlist(ctx context.Context,
+	req *ctrlpb.DeleteIPAllowlistRequest) (*emptypb.Empty, error) {
+	if _, err := acl.ParseAllowlistKind(req.Kind); err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	if req.Name == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("name can't be empty")
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if err := ctrl.authorizeIPAllowlist(ctx, req.Kind, req.Name); err != nil {
+		return nil, err
+	}
+	key := metadata.GetIPAllowlistKey(req.Kind, req.Name)
+	exist, err := ctrl.kvStore.Exists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the ip allowlist doesn't exist")
+	}
+	if err = ctrl.kvStore.Delete(ctx, key); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete ip allowlist in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "ip allowlist deleted", map[string]interface{}{
+		"kind": req.Kind,
+		"name": req.Name,
+	})
+	return &emptypb.Empty{}, nil
+}
+
+// ListIPAllowlist returns all IP allowlists, it isn't authorized because gateway enforces them by it.
+func (ctrl *controller) ListIPAllowlist(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ListIPAllowlistResponse, error) {
+	pairs, err := ctrl.kvStore.List(ctx, metadata.IPAllowlistKeyPrefixInKVStore)
+	if err != nil && !stderr.Is(err, kv.ErrKeyNotFound) {
+		return nil, err
+	}
+	res := &ctrlpb.ListIPAllowlistResponse{Allowlists: make([]*ctrlpb.IPAllowlist, 0, len(pairs))}
+	for _, pair := range pairs {
+		allowlist := &ctrlpb.IPAllowlist{}
+		if err = json.Unmarshal(pair.Value, allowlist); err != nil {
+			log.Warning(ctx, "unmarshal ip allowlist failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		res.Allowlists = append(res.Allowlists, allowlist)
+	}
+	return res, nil
+}
+
+// authorizeIPAllowlist requires the admin permission of the eventbus to manage its allowlist, and
+// the cluster wide admin for the allowlist of namespace.
+func (ctrl *controller) authorizeIPAllowlist(ctx context.Context, kind, name string) error {
+	if kind == acl.AllowlistKindEventbus {
+		if _, exist := ctrl.eventBusMap[name]; !exist {
+			return errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+		}
+		return ctrl.authorize(ctx, name, acl.PermissionAdmin)
+	}
+	if !ctrl.cfg.ACL.Enable {
+		return nil
+	}
+	principal := acl.PrincipalFromContext(ctx)
+	for _, admin := range ctrl.cfg.ACL.Admins {
+		if principal != "" && admin == principal {
+			return nil
+		}
+	}
+	return errors.ErrPermissionDenied.WithMessage(
+		"the principal " + principal + " isn't allowed to manage the ip allowlist of namespace " + name)
+}
+
+// deleteIPAllowlistOfEventbus deletes the IP allowlist of the deleted eventbus.
+func (ctrl *controller) deleteIPAllowlistOfEventbus(ctx context.Context, eventbus string) {
+	key := metadata.GetIPAllowlistKey(acl.AllowlistKindEventbus, eventbus)
+	if exist, err := ctrl.kvStore.Exists(ctx, key); err != nil || !exist {
+		return
+	}
+	if err := ctrl.kvStore.Delete(ctx, key); err != nil {
+		log.Warning(ctx, "delete ip allowlist of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eventbus,
+		})
+	}
+}
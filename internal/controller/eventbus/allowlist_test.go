@@ -0,0 +1,102 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestController_IPAllowlist(t *testing.T) {
+	Convey("test ip allowlist", t, func() {
+		ctrl := NewController(Config{ACL: acl.Config{Enable: true, Admins: []string{"root"}}}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctrl.eventBusMap["test"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test"}
+		withPrincipal := func(principal string) stdCtx.Context {
+			md, _ := grpcmd.FromOutgoingContext(acl.WithPrincipal(stdCtx.Background(), principal))
+			return grpcmd.NewIncomingContext(stdCtx.Background(), md)
+		}
+
+		Convey("test put ip allowlist with invalid request", func() {
+			ctx := withPrincipal("root")
+			_, err := ctrl.PutIPAllowlist(ctx, &ctrlpb.IPAllowlist{Kind: "cluster", Name: "test",
+				Cidrs: []string{"10.0.0.0/8"}})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutIPAllowlist(ctx, &ctrlpb.IPAllowlist{Kind: "eventbus", Name: "test"})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutIPAllowlist(ctx, &ctrlpb.IPAllowlist{Kind: "eventbus", Name: "test",
+				Cidrs: []string{"10.0.0.0/33"}})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutIPAllowlist(ctx, &ctrlpb.IPAllowlist{Kind: "eventbus", Name: "test-2",
+				Cidrs: []string{"10.0.0.0/8"}})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test put ip allowlist of namespace", func() {
+			req := &ctrlpb.IPAllowlist{Kind: "namespace", Name: "team-a", Cidrs: []string{"10.1.2.3/16", "::1"}}
+			_, err := ctrl.PutIPAllowlist(withPrincipal("alice"), req)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+
+			var saved []byte
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetIPAllowlistKey("namespace", "team-a"), gomock.Any()).
+				Times(1).DoAndReturn(func(_ stdCtx.Context, _ string, value []byte) error {
+				saved = value
+				return nil
+			})
+			res, err := ctrl.PutIPAllowlist(withPrincipal("root"), req)
+			So(err, ShouldBeNil)
+			So(res.Cidrs, ShouldResemble, []string{"10.1.0.0/16", "::1/128"})
+			got := &ctrlpb.IPAllowlist{}
+			So(json.Unmarshal(saved, got), ShouldBeNil)
+			So(got.Cidrs, ShouldResemble, res.Cidrs)
+
+			kvCli.EXPECT().List(gomock.Any(), metadata.IPAllowlistKeyPrefixInKVStore).Times(1).
+				Return([]kv.Pair{{Value: saved}, {Value: []byte("invalid")}}, nil)
+			list, err := ctrl.ListIPAllowlist(stdCtx.Background(), &emptypb.Empty{})
+			So(err, ShouldBeNil)
+			So(list.Allowlists, ShouldHaveLength, 1)
+			So(list.Allowlists[0].Name, ShouldEqual, "team-a")
+		})
+
+		Convey("test delete ip allowlist", func() {
+			key := metadata.GetIPAllowlistKey("eventbus", "test")
+			kvCli.EXPECT().List(gomock.Any(), metadata.GetEventbusACLKey("test", "")).AnyTimes().Return(nil, nil)
+			kvCli.EXPECT().Exists(gomock.Any(), key).Times(1).Return(false, nil)
+			_, err := ctrl.DeleteIPAllowlist(withPrincipal("alice"),
+				&ctrlpb.DeleteIPAllowlistRequest{Kind: "eventbus", Name: "test"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			kvCli.EXPECT().Exists(gomock.Any(), key).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(gomock.Any(), key).Times(1).Return(nil)
+			_, err = ctrl.DeleteIPAllowlist(withPrincipal("alice"),
+				&ctrlpb.DeleteIPAllowlistRequest{Kind: "eventbus", Name: "test"})
+			So(err, ShouldBeNil)
+		})
+	})
+}
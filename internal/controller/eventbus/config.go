@@ -14,7 +14,10 @@
 
 package eventbus
 
-import embedetcd "github.com/linkall-labs/embed-etcd"
+import (
+	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+)
 
 type Config struct {
 	IP               string            `yaml:"ip"`
@@ -25,4 +28,5 @@ type Config struct {
 	Replicas         uint              `yaml:"replicas"`
 	Topology         map[string]string `yaml:"topology"`
 	SegmentCapacity  int64             `yaml:"segment_capacity"`
+	ACL              acl.Config        `yaml:"acl"`
 }
@@ -0,0 +1,356 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// A consumer group reads an eventbus directly with the client, the eventlogs of the eventbus are assigned
+// to the members of group. The membership is kept in memory of the leader controller, the members join
+// again by heartbeat after the leader changed. The committed offsets are saved in kv.
+
+const (
+	// the member is removed from the group if it hasn't sent heartbeat in the timeout.
+	consumerGroupSessionTimeout = 15 * time.Second
+)
+
+type consumerGroup struct {
+	name       string
+	eventbus   string
+	generation uint64
+	// member id -> time of the last heartbeat
+	members map[string]time.Time
+	// member id -> eventlogs assigned to it
+	assignment map[string][]uint64
+	eventlogs  []uint64
+}
+
+func newConsumerGroup(name, eventbus string) *consumerGroup {
+	return &consumerGroup{
+		name:       name,
+		eventbus:   eventbus,
+		members:    make(map[string]time.Time),
+		assignment: make(map[string][]uint64),
+	}
+}
+
+// expire removes the members which haven't sent heartbeat in the timeout, and returns whether any is removed.
+func (g *consumerGroup) expire(now time.Time) bool {
+	expired := false
+	for id, t := range g.members {
+		if now.Sub(t) > consumerGroupSessionTimeout {
+			delete(g.members, id)
+			expired = true
+		}
+	}
+	return expired
+}
+
+// rebalance assigns the eventlogs to the members in a round-robin way, and starts a new generation.
+func (g *consumerGroup) rebalance(eventlogs []uint64) {
+	members := make([]string, 0, len(g.members))
+	for id := range g.members {
+		members = append(members, id)
+	}
+	sort.Strings(members)
+	g.eventlogs = eventlogs
+	g.assignment = make(map[string][]uint64, len(members))
+	for _, id := range members {
+		g.assignment[id] = make([]uint64, 0)
+	}
+	if len(members) > 0 {
+		for idx, el := range eventlogs {
+			id := members[idx%len(members)]
+			g.assignment[id] = append(g.assignment[id], el)
+		}
+	}
+	g.generation++
+}
+
+func (g *consumerGroup) isAssigned(memberID string, eventlogID uint64) bool {
+	for _, el := range g.assignment[memberID] {
+		if el == eventlogID {
+			return true
+		}
+	}
+	return false
+}
+
+func sameEventlogs(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+func (ctrl *controller) ConsumerGroupHeartbeat(ctx context.Context,
+	req *ctrlpb.ConsumerGroupHeartbeatRequest) (*ctrlpb.ConsumerGroupAssignment, error) {
+	if req.Group == "" || strings.Contains(req.Group, "/") {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid consumer group name")
+	}
+	if req.MemberId == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("member id can't be empty")
+	}
+	eventlogs, err := ctrl.listEventlogID(req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrl.groupMutex.Lock()
+	defer ctrl.groupMutex.Unlock()
+	g, exist := ctrl.consumerGroups[req.Group]
+	if !exist {
+		g = newConsumerGroup(req.Group, req.Eventbus)
+		ctrl.consumerGroups[req.Group] = g
+	}
+	if g.eventbus != req.Eventbus {
+		return nil, errors.ErrInvalidRequest.WithMessage("the consumer group consumes another eventbus")
+	}
+	now := time.Now()
+	changed := g.expire(now)
+	if _, exist = g.members[req.MemberId]; !exist {
+		changed = true
+		log.Info(ctx, "member joins consumer group", map[string]interface{}{
+			"group":     g.name,
+			"member_id": req.MemberId,
+		})
+	}
+	g.members[req.MemberId] = now
+	if changed || !sameEventlogs(g.eventlogs, eventlogs) {
+		g.rebalance(eventlogs)
+		log.Info(ctx, "consumer group rebalanced", map[string]interface{}{
+			"group":      g.name,
+			"generation": g.generation,
+			"members":    len(g.members),
+		})
+	}
+	return &ctrlpb.ConsumerGroupAssignment{
+		Group:       g.name,
+		Generation:  g.generation,
+		EventlogIds: g.assignment[req.MemberId],
+	}, nil
+}
+
+func (ctrl *controller) LeaveConsumerGroup(ctx context.Context,
+	req *ctrlpb.LeaveConsumerGroupRequest) (*emptypb.Empty, error) {
+	ctrl.groupMutex.Lock()
+	defer ctrl.groupMutex.Unlock()
+	g, exist := ctrl.consumerGroups[req.Group]
+	if !exist {
+		return &emptypb.Empty{}, nil
+	}
+	if _, exist = g.members[req.MemberId]; !exist {
+		return &emptypb.Empty{}, nil
+	}
+	delete(g.members, req.MemberId)
+	log.Info(ctx, "member leaves consumer group", map[string]interface{}{
+		"group":     g.name,
+		"member_id": req.MemberId,
+	})
+	if len(g.members) == 0 {
+		delete(ctrl.consumerGroups, g.name)
+		return &emptypb.Empty{}, nil
+	}
+	g.rebalance(g.eventlogs)
+	return &emptypb.Empty{}, nil
+}
+
+// CommitConsumerGroupOffset saves the offsets committed by the member. The commit is rejected if the group
+// has rebalanced since the member got its assignment, so a member never overrides the offset of an eventlog
+// which has been assigned to another member.
+func (ctrl *controller) CommitConsumerGroupOffset(ctx context.Context,
+	req *ctrlpb.CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	ctrl.groupMutex.Lock()
+	defer ctrl.groupMutex.Unlock()
+	g, exist := ctrl.consumerGroups[req.Group]
+	if !exist {
+		return nil, errors.ErrGroupRebalanced.WithMessage("the member isn't in the consumer group")
+	}
+	if _, exist = g.members[req.MemberId]; !exist || req.Generation != g.generation {
+		return nil, errors.ErrGroupRebalanced.WithMessage("the generation of consumer group is stale")
+	}
+	for _, o := range req.Offsets {
+		if !g.isAssigned(req.MemberId, o.EventlogId) {
+			return nil, errors.ErrGroupRebalanced.WithMessage("the eventlog isn't assigned to the member")
+		}
+	}
+	now := time.Now()
+	for _, o := range req.Offsets {
+		id := vanus.NewIDFromUint64(o.EventlogId)
+		data, _ := json.Marshal(&metadata.ConsumerGroupOffset{
+			EventlogID:  id,
+			Offset:      o.Offset,
+			CommittedAt: now,
+		})
+		if err := ctrl.kvStore.Set(ctx, metadata.GetConsumerGroupOffsetKey(g.name, id), data); err != nil {
+			return nil, errors.ErrInternal.WithMessage("save consumer group offset in kv failed").Wrap(err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) GetConsumerGroupOffset(ctx context.Context,
+	req *ctrlpb.GetConsumerGroupOffsetRequest) (*ctrlpb.GetConsumerGroupOffsetResponse, error) {
+	if req.Group == "" || strings.Contains(req.Group, "/") {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid consumer group name")
+	}
+	dir := path.Join(metadata.ConsumerGroupOffsetKeyPrefixInKVStore, req.Group)
+	offsets, err := ctrl.listConsumerGroupOffset(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	res := &ctrlpb.GetConsumerGroupOffsetResponse{
+		Offsets: make([]*ctrlpb.ConsumerGroupOffset, 0, len(offsets)),
+	}
+	for key, o := range offsets {
+		// the listing is by prefix, skip the offsets of the group whose name starts with this one.
+		if path.Dir(key) != dir {
+			continue
+		}
+		res.Offsets = append(res.Offsets, &ctrlpb.ConsumerGroupOffset{
+			EventlogId: o.EventlogID.Uint64(),
+			Offset:     o.Offset,
+		})
+	}
+	return res, nil
+}
+
+// SetConsumerGroupOffset overrides the committed offsets of the group, it's rejected if the group has any
+// member because the offsets are owned by the members then.
+func (ctrl *controller) SetConsumerGroupOffset(ctx context.Context,
+	req *ctrlpb.SetConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	if req.Group == "" || strings.Contains(req.Group, "/") {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid consumer group name")
+	}
+	eventlogs, err := ctrl.listEventlogID(req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range req.Offsets {
+		idx := sort.Search(len(eventlogs), func(i int) bool {
+			return eventlogs[i] >= o.EventlogId
+		})
+		if idx == len(eventlogs) || eventlogs[idx] != o.EventlogId {
+			return nil, errors.ErrInvalidRequest.WithMessage("the eventlog doesn't belong to the eventbus")
+		}
+		if o.Offset < 0 {
+			return nil, errors.ErrInvalidRequest.WithMessage("the offset can't be negative")
+		}
+	}
+
+	ctrl.groupMutex.Lock()
+	defer ctrl.groupMutex.Unlock()
+	if g, exist := ctrl.consumerGroups[req.Group]; exist && len(g.members) > 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the consumer group has active members")
+	}
+	now := time.Now()
+	for _, o := range req.Offsets {
+		id := vanus.NewIDFromUint64(o.EventlogId)
+		data, _ := json.Marshal(&metadata.ConsumerGroupOffset{
+			EventlogID:  id,
+			Offset:      o.Offset,
+			CommittedAt: now,
+		})
+		if err = ctrl.kvStore.Set(ctx, metadata.GetConsumerGroupOffsetKey(req.Group, id), data); err != nil {
+			return nil, errors.ErrInternal.WithMessage("save consumer group offset in kv failed").Wrap(err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) listEventlogID(eventbus string) ([]uint64, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	eb, exist := ctrl.eventBusMap[eventbus]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	ids := make([]uint64, 0, len(eb.EventLogs))
+	for _, el := range eb.EventLogs {
+		ids = append(ids, el.ID.Uint64())
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids, nil
+}
+
+func (ctrl *controller) listConsumerGroupOffset(ctx context.Context,
+	prefix string) (map[string]*metadata.ConsumerGroupOffset, error) {
+	pairs, err := ctrl.kvStore.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	offsets := make(map[string]*metadata.ConsumerGroupOffset, len(pairs))
+	for _, pair := range pairs {
+		o := &metadata.ConsumerGroupOffset{}
+		if err = json.Unmarshal(pair.Value, o); err != nil {
+			log.Warning(ctx, "unmarshal consumer group offset failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		offsets[pair.Key] = o
+	}
+	return offsets, nil
+}
+
+// deleteConsumerGroupOfEventbus removes the consumer groups of the deleted eventbus and their offsets.
+func (ctrl *controller) deleteConsumerGroupOfEventbus(ctx context.Context, eb *metadata.Eventbus) {
+	ctrl.groupMutex.Lock()
+	for name, g := range ctrl.consumerGroups {
+		if g.eventbus == eb.Name {
+			delete(ctrl.consumerGroups, name)
+		}
+	}
+	ctrl.groupMutex.Unlock()
+
+	offsets, err := ctrl.listConsumerGroupOffset(ctx, metadata.ConsumerGroupOffsetKeyPrefixInKVStore)
+	if err != nil {
+		log.Warning(ctx, "list consumer group offset failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eb.Name,
+		})
+		return
+	}
+	eventlogs := make(map[vanus.ID]struct{}, len(eb.EventLogs))
+	for _, el := range eb.EventLogs {
+		eventlogs[el.ID] = struct{}{}
+	}
+	for key, o := range offsets {
+		if _, ok := eventlogs[o.EventlogID]; ok {
+			_ = ctrl.kvStore.Delete(ctx, key)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_ConsumerGroup(t *testing.T) {
+	Convey("test consumer group", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+		el1 := &metadata.Eventlog{ID: vanus.NewIDFromUint64(1)}
+		el2 := &metadata.Eventlog{ID: vanus.NewIDFromUint64(2)}
+		el3 := &metadata.Eventlog{ID: vanus.NewIDFromUint64(3)}
+		ctrl.eventBusMap["test-1"] = &metadata.Eventbus{
+			ID:        vanus.NewTestID(),
+			Name:      "test-1",
+			EventLogs: []*metadata.Eventlog{el3, el1, el2},
+		}
+		heartbeat := func(member string) (*ctrlpb.ConsumerGroupAssignment, error) {
+			return ctrl.ConsumerGroupHeartbeat(ctx, &ctrlpb.ConsumerGroupHeartbeatRequest{
+				Group:    "group-1",
+				Eventbus: "test-1",
+				MemberId: member,
+			})
+		}
+
+		Convey("test heartbeat with invalid request", func() {
+			_, err := ctrl.ConsumerGroupHeartbeat(ctx, &ctrlpb.ConsumerGroupHeartbeatRequest{
+				Group: "a/b", Eventbus: "test-1", MemberId: "m1",
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.ConsumerGroupHeartbeat(ctx, &ctrlpb.ConsumerGroupHeartbeatRequest{
+				Group: "group-1", Eventbus: "test-2", MemberId: "m1",
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test members join and leave", func() {
+			res, err := heartbeat("m1")
+			So(err, ShouldBeNil)
+			So(res.Generation, ShouldEqual, 1)
+			So(res.EventlogIds, ShouldResemble, []uint64{1, 2, 3})
+
+			res, err = heartbeat("m2")
+			So(err, ShouldBeNil)
+			So(res.Generation, ShouldEqual, 2)
+			So(res.EventlogIds, ShouldResemble, []uint64{2})
+			res, err = heartbeat("m1")
+			So(err, ShouldBeNil)
+			So(res.Generation, ShouldEqual, 2)
+			So(res.EventlogIds, ShouldResemble, []uint64{1, 3})
+
+			_, err = ctrl.ConsumerGroupHeartbeat(ctx, &ctrlpb.ConsumerGroupHeartbeatRequest{
+				Group: "group-1", Eventbus: "test-2", MemberId: "m3",
+			})
+			So(err, ShouldNotBeNil)
+
+			_, err = ctrl.LeaveConsumerGroup(ctx, &ctrlpb.LeaveConsumerGroupRequest{Group: "group-1", MemberId: "m2"})
+			So(err, ShouldBeNil)
+			res, err = heartbeat("m1")
+			So(err, ShouldBeNil)
+			So(res.Generation, ShouldEqual, 3)
+			So(res.EventlogIds, ShouldResemble, []uint64{1, 2, 3})
+		})
+
+		Convey("test member expires", func() {
+			_, _ = heartbeat("m1")
+			_, _ = heartbeat("m2")
+			ctrl.consumerGroups["group-1"].members["m2"] = time.Now().Add(-2 * consumerGroupSessionTimeout)
+			res, err := heartbeat("m1")
+			So(err, ShouldBeNil)
+			So(res.Generation, ShouldEqual, 3)
+			So(res.EventlogIds, ShouldResemble, []uint64{1, 2, 3})
+		})
+
+		Convey("test commit offset", func() {
+			_, _ = heartbeat("m1")
+			res, _ := heartbeat("m2")
+			commit := func(member string, generation uint64, eventlogID uint64) error {
+				_, err := ctrl.CommitConsumerGroupOffset(ctx, &ctrlpb.CommitConsumerGroupOffsetRequest{
+					Group:      "group-1",
+					MemberId:   member,
+					Generation: generation,
+					Offsets:    []*ctrlpb.ConsumerGroupOffset{{EventlogId: eventlogID, Offset: 10}},
+				})
+				return err
+			}
+
+			kvCli.EXPECT().Set(ctx, metadata.GetConsumerGroupOffsetKey("group-1", el2.ID), gomock.Any()).
+				Times(1).Return(nil)
+			So(commit("m2", res.Generation, 2), ShouldBeNil)
+			// stale generation, unassigned eventlog and unknown member
+			So(errors.Is(commit("m2", res.Generation-1, 2), errors.ErrGroupRebalanced), ShouldBeTrue)
+			So(errors.Is(commit("m2", res.Generation, 1), errors.ErrGroupRebalanced), ShouldBeTrue)
+			So(errors.Is(commit("m3", res.Generation, 2), errors.ErrGroupRebalanced), ShouldBeTrue)
+		})
+
+		Convey("test get offset", func() {
+			data, _ := json.Marshal(&metadata.ConsumerGroupOffset{EventlogID: el1.ID, Offset: 10})
+			kvCli.EXPECT().List(ctx, metadata.ConsumerGroupOffsetKeyPrefixInKVStore+"/group-1").Times(1).
+				Return([]kv.Pair{
+					{Key: metadata.GetConsumerGroupOffsetKey("group-1", el1.ID), Value: data},
+					{Key: metadata.GetConsumerGroupOffsetKey("group-10", el2.ID), Value: data},
+					{Key: "invalid", Value: []byte("invalid")},
+				}, nil)
+			res, err := ctrl.GetConsumerGroupOffset(ctx, &ctrlpb.GetConsumerGroupOffsetRequest{Group: "group-1"})
+			So(err, ShouldBeNil)
+			So(res.Offsets, ShouldHaveLength, 1)
+			So(res.Offsets[0].EventlogId, ShouldEqual, 1)
+			So(res.Offsets[0].Offset, ShouldEqual, 10)
+		})
+
+		Convey("test set offset", func() {
+			set := func(eventbus string, eventlogID uint64, offset int64) error {
+				_, err := ctrl.SetConsumerGroupOffset(ctx, &ctrlpb.SetConsumerGroupOffsetRequest{
+					Group:    "group-1",
+					Eventbus: eventbus,
+					Offsets:  []*ctrlpb.ConsumerGroupOffset{{EventlogId: eventlogID, Offset: offset}},
+				})
+				return err
+			}
+
+			kvCli.EXPECT().Set(ctx, metadata.GetConsumerGroupOffsetKey("group-1", el3.ID), gomock.Any()).
+				Times(1).Return(nil)
+			So(set("test-1", 3, 20), ShouldBeNil)
+			So(errors.Is(set("test-2", 3, 20), errors.ErrResourceNotFound), ShouldBeTrue)
+			So(errors.Is(set("test-1", 4, 20), errors.ErrInvalidRequest), ShouldBeTrue)
+			So(errors.Is(set("test-1", 3, -1), errors.ErrInvalidRequest), ShouldBeTrue)
+
+			// the offsets are owned by the members.
+			_, _ = heartbeat("m1")
+			So(errors.Is(set("test-1", 3, 20), errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test delete consumer group of eventbus", func() {
+			_, _ = heartbeat("m1")
+			data, _ := json.Marshal(&metadata.ConsumerGroupOffset{EventlogID: el1.ID, Offset: 10})
+			other, _ := json.Marshal(&metadata.ConsumerGroupOffset{EventlogID: vanus.NewIDFromUint64(4), Offset: 10})
+			kvCli.EXPECT().List(ctx, metadata.ConsumerGroupOffsetKeyPrefixInKVStore).Times(1).
+				Return([]kv.Pair{{Key: "k1", Value: data}, {Key: "k2", Value: other}}, nil)
+			kvCli.EXPECT().Delete(ctx, "k1").Times(1).Return(nil)
+			ctrl.deleteConsumerGroupOfEventbus(ctx, ctrl.eventBusMap["test-1"])
+			So(ctrl.consumerGroups, ShouldBeEmpty)
+		})
+	})
+}
@@ -28,11 +28,14 @@ import (
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/migration"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/kv/etcd"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
@@ -59,13 +62,14 @@ const (
 
 func NewController(cfg Config, member embedetcd.Member) *controller {
 	c := &controller{
-		cfg:         &cfg,
-		ssMgr:       server.NewServerManager(),
-		eventBusMap: map[string]*metadata.Eventbus{},
-		member:      member,
-		isLeader:    false,
-		readyNotify: make(chan error, 1),
-		stopNotify:  make(chan error, 1),
+		cfg:            &cfg,
+		ssMgr:          server.NewServerManager(),
+		eventBusMap:    map[string]*metadata.Eventbus{},
+		member:         member,
+		consumerGroups: map[string]*consumerGroup{},
+		isLeader:       false,
+		readyNotify:    make(chan error, 1),
+		stopNotify:     make(chan error, 1),
 	}
 	c.volumeMgr = volume.NewVolumeManager(c.ssMgr)
 	c.eventLogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity)
@@ -87,6 +91,8 @@ type controller struct {
 	readyNotify     chan error
 	stopNotify      chan error
 	mutex           sync.Mutex
+	consumerGroups  map[string]*consumerGroup
+	groupMutex      sync.Mutex
 }
 
 func (ctrl *controller) Start(_ context.Context) error {
@@ -117,6 +123,10 @@ func (ctrl *controller) CreateEventBus(ctx context.Context,
 	if err := isValidEventbusName(req.Name); err != nil {
 		return nil, err
 	}
+	// the new eventbus hasn't ACL entry, only admins can create it in deny-by-default mode.
+	if err := ctrl.authorize(ctx, req.Name, acl.PermissionAdmin); err != nil {
+		return nil, err
+	}
 	return ctrl.createEventBus(ctx, req)
 }
 
@@ -188,7 +198,7 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		return nil, errors.ErrResourceAlreadyExist.WithMessage("the eventbus already exist")
 	}
 	for idx := 0; idx < eb.LogNumber; idx++ {
-		el, err := ctrl.eventLogMgr.AcquireEventLog(ctx, eb.ID)
+		el, err := ctrl.eventLogMgr.AcquireEventLog(ctx, eb.ID, eb.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -214,6 +224,9 @@ func (ctrl *controller) DeleteEventBus(ctx context.Context, eb *metapb.EventBus)
 	if !exist {
 		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
 	}
+	if err := ctrl.authorize(ctx, eb.Name, acl.PermissionAdmin); err != nil {
+		return nil, err
+	}
 	err := ctrl.kvStore.Delete(ctx, metadata.GetEventbusMetadataKey(eb.Name))
 	if err != nil {
 		return nil, errors.ErrInternal.WithMessage("delete eventbus metadata in kv failed").Wrap(err)
@@ -221,6 +234,12 @@ func (ctrl *controller) DeleteEventBus(ctx context.Context, eb *metapb.EventBus)
 
 	// TODO(wenfeng.wang) notify gateway to cut flow
 	delete(ctrl.eventBusMap, eb.Name)
+	ctrl.deleteCronEventOfEventbus(ctx, eb.Name)
+	ctrl.deleteReplayJobOfEventbus(ctx, eb.Name)
+	ctrl.deleteHTTPPollerOfEventbus(ctx, eb.Name)
+	ctrl.deleteConsumerGroupOfEventbus(ctx, bus)
+	ctrl.deleteACLOfEventbus(ctx, eb.Name)
+	ctrl.deleteIPAllowlistOfEventbus(ctx, eb.Name)
 	wg := sync.WaitGroup{}
 
 	for _, v := range bus.EventLogs {
@@ -526,6 +545,10 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event em
 			return nil
 		}
 		ctrl.isLeader = true
+		if err := migration.Run(ctx, ctrl.kvStore); err != nil {
+			ctrl.stop(ctx, err)
+			return err
+		}
 		if err := ctrl.loadEventbus(ctx); err != nil {
 			ctrl.stop(ctx, err)
 			return err
@@ -545,6 +568,10 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event em
 			ctrl.stop(ctx, err)
 			return err
 		}
+		opsevent.Emit(ctx, opsevent.TypeControllerLeaderChanged, &opsevent.ControllerLeaderChanged{
+			LeaderID:   ctrl.member.GetLeaderID(),
+			LeaderAddr: ctrl.member.GetLeaderAddr(),
+		})
 	case embedetcd.EventBecomeFollower:
 		if !ctrl.isLeader {
 			return nil
@@ -552,6 +579,10 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event em
 		ctrl.isLeader = false
 		ctrl.eventLogMgr.Stop()
 		ctrl.ssMgr.Stop(ctx)
+		// the members of consumer groups join the new leader by heartbeat
+		ctrl.groupMutex.Lock()
+		ctrl.consumerGroups = map[string]*consumerGroup{}
+		ctrl.groupMutex.Unlock()
 	}
 	return nil
 }
@@ -564,8 +595,7 @@ func (ctrl *controller) loadEventbus(ctx context.Context) error {
 	}
 	for idx := range pairs {
 		pair := pairs[idx]
-		busInfo := &metadata.Eventbus{}
-		err := json.Unmarshal(pair.Value, busInfo)
+		busInfo, err := metadata.DecodeEventbus(pair.Value)
 		if err != nil {
 			return err
 		}
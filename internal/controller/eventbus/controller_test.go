@@ -26,6 +26,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
@@ -57,8 +58,8 @@ func TestController_CreateEventBus(t *testing.T) {
 			el := &metadata.Eventlog{
 				ID: vanus.NewTestID(),
 			}
-			elMgr.EXPECT().AcquireEventLog(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context,
-				eventbusID vanus.ID) (*metadata.Eventlog, error) {
+			elMgr.EXPECT().AcquireEventLog(ctx, gomock.Any(), "test-1").Times(1).DoAndReturn(func(ctx stdCtx.Context,
+				eventbusID vanus.ID, _ string) (*metadata.Eventlog, error) {
 				el.ID = eventbusID
 				el.SegmentNumber = 2
 				return el, nil
@@ -156,6 +157,12 @@ func TestController_DeleteEventBus(t *testing.T) {
 		Convey("deleting an existed eventbus success", func() {
 			kvCli.EXPECT().Delete(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).
 				Return(nil)
+			kvCli.EXPECT().List(ctx, timermd.CronEventKeyPrefixInKVStore).Times(1).Return(nil, nil)
+			kvCli.EXPECT().List(ctx, timermd.ReplayJobKeyPrefixInKVStore).Times(1).Return(nil, nil)
+			kvCli.EXPECT().List(ctx, timermd.HTTPPollerKeyPrefixInKVStore).Times(1).Return(nil, nil)
+			kvCli.EXPECT().List(ctx, metadata.ConsumerGroupOffsetKeyPrefixInKVStore).Times(1).Return(nil, nil)
+			kvCli.EXPECT().List(ctx, metadata.GetEventbusACLKey("test-1", "")).Times(1).Return(nil, nil)
+			kvCli.EXPECT().Exists(ctx, metadata.GetIPAllowlistKey("eventbus", "test-1")).Times(1).Return(false, nil)
 
 			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[0].ID).Times(1)
 			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[1].ID).Times(1)
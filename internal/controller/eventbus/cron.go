@@ -0,0 +1,158 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/cron"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The cron events are only saved in kv by controller, the leader of timer loads them
+// and fires the events into their eventbus.
+
+func (ctrl *controller) CreateCronEvent(ctx context.Context,
+	req *ctrlpb.CreateCronEventRequest) (*ctrlpb.CronEvent, error) {
+	if req.Name == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("cron event name can't be empty")
+	}
+	if _, err := cron.Parse(req.Schedule); err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid schedule").Wrap(err)
+	}
+	if req.Template.GetType() == "" || req.Template.GetSource() == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("type and source of event template can't be empty")
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if _, exist := ctrl.eventBusMap[req.Eventbus]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	id, err := vanus.NewID()
+	if err != nil {
+		log.Warning(ctx, "failed to create cron event ID", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return nil, err
+	}
+	ce := &timermd.CronEvent{
+		ID:       id,
+		Name:     req.Name,
+		Schedule: req.Schedule,
+		Eventbus: req.Eventbus,
+		Template: timermd.CronEventTemplate{
+			Type:            req.Template.Type,
+			Source:          req.Template.Source,
+			Subject:         req.Template.Subject,
+			DataContentType: req.Template.DataContentType,
+			Data:            req.Template.Data,
+		},
+		Description: req.Description,
+		CreatedAt:   time.Now(),
+	}
+	data, _ := json.Marshal(ce)
+	if err = ctrl.kvStore.Set(ctx, timermd.GetCronEventKeyInKVStore(ce.ID), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save cron event metadata in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "cron event created", map[string]interface{}{
+		"id":       ce.ID,
+		"schedule": ce.Schedule,
+		"eventbus": ce.Eventbus,
+	})
+	return timermd.Convert2ProtoCronEvent(ce)[0], nil
+}
+
+func (ctrl *controller) ListCronEvent(ctx context.Context,
+	req *ctrlpb.ListCronEventRequest) (*ctrlpb.ListCronEventResponse, error) {
+	ces, err := ctrl.listCronEvent(ctx, req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	return &ctrlpb.ListCronEventResponse{CronEvents: timermd.Convert2ProtoCronEvent(ces...)}, nil
+}
+
+func (ctrl *controller) DeleteCronEvent(ctx context.Context,
+	req *ctrlpb.DeleteCronEventRequest) (*emptypb.Empty, error) {
+	id := vanus.NewIDFromUint64(req.Id)
+	exist, err := ctrl.kvStore.Exists(ctx, timermd.GetCronEventKeyInKVStore(id))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the cron event doesn't exist")
+	}
+	if err = ctrl.deleteCronEvent(ctx, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// listCronEvent returns the cron events of the eventbus, or all cron events if eventbus is empty.
+func (ctrl *controller) listCronEvent(ctx context.Context, eventbus string) ([]*timermd.CronEvent, error) {
+	pairs, err := ctrl.kvStore.List(ctx, timermd.CronEventKeyPrefixInKVStore)
+	if err != nil {
+		return nil, err
+	}
+	ces := make([]*timermd.CronEvent, 0, len(pairs))
+	for _, pair := range pairs {
+		ce := &timermd.CronEvent{}
+		if err = json.Unmarshal(pair.Value, ce); err != nil {
+			log.Warning(ctx, "unmarshal cron event metadata failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		if eventbus == "" || ce.Eventbus == eventbus {
+			ces = append(ces, ce)
+		}
+	}
+	return ces, nil
+}
+
+func (ctrl *controller) deleteCronEvent(ctx context.Context, id vanus.ID) error {
+	if err := ctrl.kvStore.Delete(ctx, timermd.GetCronEventKeyInKVStore(id)); err != nil {
+		return errors.ErrInternal.WithMessage("delete cron event metadata in kv failed").Wrap(err)
+	}
+	// the state is written by timer, it doesn't matter if the deletion failed
+	_ = ctrl.kvStore.Delete(ctx, timermd.GetCronStateKeyInKVStore(id))
+	log.Info(ctx, "cron event deleted", map[string]interface{}{
+		"id": id,
+	})
+	return nil
+}
+
+// deleteCronEventOfEventbus deletes the cron events which fire into the deleted eventbus.
+func (ctrl *controller) deleteCronEventOfEventbus(ctx context.Context, eventbus string) {
+	ces, err := ctrl.listCronEvent(ctx, eventbus)
+	if err != nil {
+		log.Warning(ctx, "list cron event of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eventbus,
+		})
+		return
+	}
+	for _, ce := range ces {
+		_ = ctrl.deleteCronEvent(ctx, ce.ID)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_CronEvent(t *testing.T) {
+	Convey("test cron event", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+		ctrl.eventBusMap["test-1"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-1"}
+		req := &ctrlpb.CreateCronEventRequest{
+			Name:     "test",
+			Schedule: "*/5 * * * *",
+			Eventbus: "test-1",
+			Template: &ctrlpb.CronEventTemplate{
+				Type:   "test.type",
+				Source: "test.source",
+				Data:   []byte("hello"),
+			},
+		}
+
+		Convey("test create cron event with invalid request", func() {
+			req.Schedule = "* * *"
+			_, err := ctrl.CreateCronEvent(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			req.Schedule = "@daily"
+			req.Template.Type = ""
+			_, err = ctrl.CreateCronEvent(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			req.Template.Type = "test.type"
+			req.Eventbus = "test-2"
+			_, err = ctrl.CreateCronEvent(ctx, req)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test create, list and delete cron event", func() {
+			var saved []byte
+			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+			res, err := ctrl.CreateCronEvent(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.Id, ShouldNotBeZeroValue)
+			So(res.Schedule, ShouldEqual, req.Schedule)
+			So(res.Template.Data, ShouldResemble, req.Template.Data)
+			ce := &timermd.CronEvent{}
+			So(json.Unmarshal(saved, ce), ShouldBeNil)
+			So(ce.ID.Uint64(), ShouldEqual, res.Id)
+
+			other, _ := json.Marshal(&timermd.CronEvent{ID: vanus.NewTestID(), Eventbus: "test-2"})
+			pairs := []kv.Pair{{Value: saved}, {Value: other}}
+			kvCli.EXPECT().List(ctx, timermd.CronEventKeyPrefixInKVStore).Times(2).Return(pairs, nil)
+			list, err := ctrl.ListCronEvent(ctx, &ctrlpb.ListCronEventRequest{})
+			So(err, ShouldBeNil)
+			So(list.CronEvents, ShouldHaveLength, 2)
+			list, err = ctrl.ListCronEvent(ctx, &ctrlpb.ListCronEventRequest{Eventbus: "test-1"})
+			So(err, ShouldBeNil)
+			So(list.CronEvents, ShouldHaveLength, 1)
+			So(list.CronEvents[0].Id, ShouldEqual, res.Id)
+
+			id := vanus.NewIDFromUint64(res.Id)
+			kvCli.EXPECT().Exists(ctx, timermd.GetCronEventKeyInKVStore(id)).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(ctx, timermd.GetCronEventKeyInKVStore(id)).Times(1).Return(nil)
+			kvCli.EXPECT().Delete(ctx, timermd.GetCronStateKeyInKVStore(id)).Times(1).Return(nil)
+			_, err = ctrl.DeleteCronEvent(ctx, &ctrlpb.DeleteCronEventRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test delete a doesn't exist cron event", func() {
+			id := vanus.NewTestID()
+			kvCli.EXPECT().Exists(ctx, timermd.GetCronEventKeyInKVStore(id)).Times(1).Return(false, nil)
+			_, err := ctrl.DeleteCronEvent(ctx, &ctrlpb.DeleteCronEventRequest{Id: id.Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
 	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
@@ -48,7 +49,7 @@ const (
 type Manager interface {
 	Run(ctx context.Context, kvClient kv.Client, startTask bool) error
 	Stop()
-	AcquireEventLog(ctx context.Context, eventbusID vanus.ID) (*metadata.Eventlog, error)
+	AcquireEventLog(ctx context.Context, eventbusID vanus.ID, eventbusName string) (*metadata.Eventlog, error)
 	GetEventLog(ctx context.Context, id vanus.ID) *metadata.Eventlog
 	DeleteEventlog(ctx context.Context, id vanus.ID)
 	GetEventLogSegmentList(elID vanus.ID) []*Segment
@@ -124,8 +125,7 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 	metrics.EventlogGaugeVec.Set(0)
 	for idx := range pairs {
 		pair := pairs[idx]
-		elMD := &metadata.Eventlog{}
-		err1 := json.Unmarshal(pair.Value, elMD)
+		elMD, err1 := metadata.DecodeEventlog(pair.Value)
 		if err1 != nil {
 			return err1
 		}
@@ -162,7 +162,8 @@ func (mgr *eventlogManager) Stop() {
 	mgr.allocator.Stop()
 }
 
-func (mgr *eventlogManager) AcquireEventLog(ctx context.Context, eventbusID vanus.ID) (*metadata.Eventlog, error) {
+func (mgr *eventlogManager) AcquireEventLog(ctx context.Context,
+	eventbusID vanus.ID, eventbusName string) (*metadata.Eventlog, error) {
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
 
@@ -175,8 +176,9 @@ func (mgr *eventlogManager) AcquireEventLog(ctx context.Context, eventbusID vanu
 		return nil, err
 	}
 	elMD := &metadata.Eventlog{
-		ID:         id,
-		EventbusID: eventbusID,
+		ID:           id,
+		EventbusID:   eventbusID,
+		EventbusName: eventbusName,
 	}
 	data, _ := json.Marshal(elMD)
 	if err := mgr.kvClient.Set(ctx, metadata.GetEventlogMetadataKey(elMD.ID), data); err != nil {
@@ -327,6 +329,14 @@ func (mgr *eventlogManager) UpdateSegment(ctx context.Context, m map[string][]Se
 						"segment":    seg.String(),
 						"eventlog":   el.md.ID.String(),
 					})
+				} else if seg.isFull() {
+					opsevent.Emit(ctx, opsevent.TypeBlockArchived, &opsevent.BlockArchived{
+						Eventbus:   el.md.EventbusName,
+						EventlogID: el.md.ID.String(),
+						SegmentID:  seg.ID.String(),
+						Size:       seg.Size,
+						Number:     seg.Number,
+					})
 				}
 			}
 		}
@@ -380,6 +390,7 @@ func (mgr *eventlogManager) UpdateSegmentReplicas(ctx context.Context, leaderID
 		return nil
 	}
 
+	leaderChanged := seg.Replicas.Leader != leaderID.Uint64()
 	seg.Replicas.Leader = leaderID.Uint64()
 	seg.Replicas.Term = term
 	data, _ := json.Marshal(seg)
@@ -391,6 +402,14 @@ func (mgr *eventlogManager) UpdateSegmentReplicas(ctx context.Context, leaderID
 		})
 		return errors.ErrInvalidSegment.WithMessage("update segment to etcd error").Wrap(err)
 	}
+	if leaderChanged {
+		opsevent.Emit(ctx, opsevent.TypeBlockLeaderChanged, &opsevent.BlockLeaderChanged{
+			EventlogID: seg.EventLogID.String(),
+			SegmentID:  seg.ID.String(),
+			LeaderID:   leaderID.String(),
+			Term:       term,
+		})
+	}
 	return nil
 }
 
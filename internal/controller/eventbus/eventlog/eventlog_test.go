@@ -418,10 +418,11 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 		grpcCli.EXPECT().ActivateSegment(ctx, gomock.Any()).Times(2).Return(nil, nil)
 
 		eventbusID := vanus.NewTestID()
-		logMD, err := utMgr.AcquireEventLog(ctx, eventbusID)
+		logMD, err := utMgr.AcquireEventLog(ctx, eventbusID, "test")
 		Convey("validate metadata", func() {
 			So(err, ShouldBeNil)
 			So(logMD.EventbusID, ShouldEqual, eventbusID)
+			So(logMD.EventbusName, ShouldEqual, "test")
 		})
 
 		Convey("validate eventlog", func() {
@@ -0,0 +1,133 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The feature flags are saved in kv by name, gateways and triggers list all of them periodically and
+// evaluate them for the namespace and the eventbus where the features are used.
+
+func (ctrl *controller) PutFeatureFlag(ctx context.Context, req *ctrlpb.FeatureFlag) (*ctrlpb.FeatureFlag, error) {
+	if req.Name == "" || strings.Contains(req.Name, "/") {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid feature name")
+	}
+	if !featureflag.ValidRolloutPercent(req.RolloutPercent) {
+		return nil, errors.ErrInvalidRequest.WithMessage("rollout percent must be in [0, 100]")
+	}
+	flag := &ctrlpb.FeatureFlag{
+		Name:           req.Name,
+		Enabled:        req.Enabled,
+		Namespaces:     req.Namespaces,
+		Eventbuses:     req.Eventbuses,
+		RolloutPercent: req.RolloutPercent,
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if err := ctrl.authorizeFeatureFlag(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(flag)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetFeatureFlagKey(req.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save feature flag in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "feature flag updated", map[string]interface{}{
+		"name":            flag.Name,
+		"enabled":         flag.Enabled,
+		"namespaces":      flag.Namespaces,
+		"eventbuses":      flag.Eventbuses,
+		"rollout_percent": flag.RolloutPercent,
+	})
+	return flag, nil
+}
+
+func (ctrl *controller) DeleteFeatureFlag(ctx context.Context,
+	req *ctrlpb.DeleteFeatureFlagRequest) (*emptypb.Empty, error) {
+	if req.Name == "" || strings.Contains(req.Name, "/") {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid feature name")
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if err := ctrl.authorizeFeatureFlag(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	key := metadata.GetFeatureFlagKey(req.Name)
+	exist, err := ctrl.kvStore.Exists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the feature flag doesn't exist")
+	}
+	if err = ctrl.kvStore.Delete(ctx, key); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete feature flag in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "feature flag deleted", map[string]interface{}{
+		"name": req.Name,
+	})
+	return &emptypb.Empty{}, nil
+}
+
+// ListFeatureFlag returns all feature flags, it isn't authorized because gateways and triggers evaluate
+// them by it.
+func (ctrl *controller) ListFeatureFlag(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ListFeatureFlagResponse, error) {
+	pairs, err := ctrl.kvStore.List(ctx, metadata.FeatureFlagKeyPrefixInKVStore)
+	if err != nil && !stderr.Is(err, kv.ErrKeyNotFound) {
+		return nil, err
+	}
+	res := &ctrlpb.ListFeatureFlagResponse{Flags: make([]*ctrlpb.FeatureFlag, 0, len(pairs))}
+	for _, pair := range pairs {
+		flag := &ctrlpb.FeatureFlag{}
+		if err = json.Unmarshal(pair.Value, flag); err != nil {
+			log.Warning(ctx, "unmarshal feature flag failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		res.Flags = append(res.Flags, flag)
+	}
+	return res, nil
+}
+
+// authorizeFeatureFlag requires the cluster wide admin to manage the feature flags.
+func (ctrl *controller) authorizeFeatureFlag(ctx context.Context, name string) error {
+	if !ctrl.cfg.ACL.Enable {
+		return nil
+	}
+	principal := acl.PrincipalFromContext(ctx)
+	for _, admin := range ctrl.cfg.ACL.Admins {
+		if principal != "" && admin == principal {
+			return nil
+		}
+	}
+	return errors.ErrPermissionDenied.WithMessage(
+		"the principal " + principal + " isn't allowed to manage the feature flag " + name)
+}
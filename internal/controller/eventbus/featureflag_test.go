@@ -0,0 +1,95 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestController_FeatureFlag(t *testing.T) {
+	Convey("test feature flag", t, func() {
+		ctrl := NewController(Config{ACL: acl.Config{Enable: true, Admins: []string{"root"}}}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		withPrincipal := func(principal string) stdCtx.Context {
+			md, _ := grpcmd.FromOutgoingContext(acl.WithPrincipal(stdCtx.Background(), principal))
+			return grpcmd.NewIncomingContext(stdCtx.Background(), md)
+		}
+		ctx := withPrincipal("root")
+
+		Convey("test put feature flag with invalid request", func() {
+			_, err := ctrl.PutFeatureFlag(ctx, &ctrlpb.FeatureFlag{})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutFeatureFlag(ctx, &ctrlpb.FeatureFlag{Name: "a/b"})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutFeatureFlag(ctx, &ctrlpb.FeatureFlag{Name: "test", RolloutPercent: 101})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.PutFeatureFlag(withPrincipal("alice"), &ctrlpb.FeatureFlag{Name: "test"})
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("test put and list feature flag", func() {
+			req := &ctrlpb.FeatureFlag{
+				Name:           "filter_pushdown",
+				Namespaces:     map[string]bool{"team-a": true},
+				RolloutPercent: 10,
+			}
+			var saved []byte
+			kvCli.EXPECT().Set(gomock.Any(), metadata.GetFeatureFlagKey("filter_pushdown"), gomock.Any()).
+				Times(1).DoAndReturn(func(_ stdCtx.Context, _ string, value []byte) error {
+				saved = value
+				return nil
+			})
+			res, err := ctrl.PutFeatureFlag(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.RolloutPercent, ShouldEqual, 10)
+			got := &ctrlpb.FeatureFlag{}
+			So(json.Unmarshal(saved, got), ShouldBeNil)
+			So(got.Namespaces, ShouldResemble, req.Namespaces)
+
+			kvCli.EXPECT().List(gomock.Any(), metadata.FeatureFlagKeyPrefixInKVStore).Times(1).
+				Return([]kv.Pair{{Value: saved}, {Value: []byte("invalid")}}, nil)
+			list, err := ctrl.ListFeatureFlag(stdCtx.Background(), &emptypb.Empty{})
+			So(err, ShouldBeNil)
+			So(list.Flags, ShouldHaveLength, 1)
+			So(list.Flags[0].Name, ShouldEqual, "filter_pushdown")
+		})
+
+		Convey("test delete feature flag", func() {
+			key := metadata.GetFeatureFlagKey("test")
+			kvCli.EXPECT().Exists(gomock.Any(), key).Times(1).Return(false, nil)
+			_, err := ctrl.DeleteFeatureFlag(ctx, &ctrlpb.DeleteFeatureFlagRequest{Name: "test"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			kvCli.EXPECT().Exists(gomock.Any(), key).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(gomock.Any(), key).Times(1).Return(nil)
+			_, err = ctrl.DeleteFeatureFlag(ctx, &ctrlpb.DeleteFeatureFlagRequest{Name: "test"})
+			So(err, ShouldBeNil)
+		})
+	})
+}
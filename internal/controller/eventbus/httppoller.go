@@ -0,0 +1,251 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"net/url"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/ohler55/ojg/jp"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const defaultHTTPPollerInterval = time.Minute
+
+// The HTTP pollers are only saved in kv by controller like the cron events, the leader of timer
+// loads them, polls the endpoints and publishes the new items into their eventbus.
+
+func (ctrl *controller) CreateHTTPPoller(ctx context.Context,
+	req *ctrlpb.CreateHTTPPollerRequest) (*ctrlpb.HTTPPoller, error) {
+	if err := validateHTTPPoller(req); err != nil {
+		return nil, err
+	}
+	interval := time.Duration(req.Interval) * time.Second
+	if interval == 0 {
+		interval = defaultHTTPPollerInterval
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if _, exist := ctrl.eventBusMap[req.Eventbus]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	id, err := vanus.NewID()
+	if err != nil {
+		log.Warning(ctx, "failed to create HTTP poller ID", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return nil, err
+	}
+	p := &timermd.HTTPPoller{
+		ID:          id,
+		Name:        req.Name,
+		Eventbus:    req.Eventbus,
+		URL:         req.Url,
+		Headers:     req.Headers,
+		Interval:    interval,
+		ItemsPath:   req.ItemsPath,
+		DedupKey:    req.DedupKey,
+		EventType:   req.EventType,
+		EventSource: req.EventSource,
+		Description: req.Description,
+		CreatedAt:   time.Now(),
+	}
+	if req.Auth != nil {
+		p.Auth = timermd.HTTPPollerAuth{
+			Type:     req.Auth.Type,
+			Username: req.Auth.Username,
+			Password: req.Auth.Password,
+			Token:    req.Auth.Token,
+		}
+	}
+	if err = ctrl.saveHTTPPoller(ctx, p); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "HTTP poller created", map[string]interface{}{
+		"id":       p.ID,
+		"url":      p.URL,
+		"eventbus": p.Eventbus,
+	})
+	return timermd.Convert2ProtoHTTPPoller(p)[0], nil
+}
+
+func validateHTTPPoller(req *ctrlpb.CreateHTTPPollerRequest) error {
+	if req.Name == "" {
+		return errors.ErrInvalidRequest.WithMessage("HTTP poller name can't be empty")
+	}
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.ErrInvalidRequest.WithMessage("url must be an http or https address")
+	}
+	if req.EventType == "" || req.EventSource == "" {
+		return errors.ErrInvalidRequest.WithMessage("event type and source can't be empty")
+	}
+	if req.ItemsPath != "" {
+		if _, err = jp.ParseString(req.ItemsPath); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("invalid items path").Wrap(err)
+		}
+	}
+	if req.DedupKey != "" {
+		if _, err = jp.ParseString(req.DedupKey); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("invalid dedup key").Wrap(err)
+		}
+	}
+	switch req.Auth.GetType() {
+	case "":
+	case timermd.HTTPPollerAuthBasic:
+		if req.Auth.Username == "" {
+			return errors.ErrInvalidRequest.WithMessage("username of basic auth can't be empty")
+		}
+	case timermd.HTTPPollerAuthBearer:
+		if req.Auth.Token == "" {
+			return errors.ErrInvalidRequest.WithMessage("token of bearer auth can't be empty")
+		}
+	default:
+		return errors.ErrInvalidRequest.WithMessage("auth type must be basic or bearer")
+	}
+	return nil
+}
+
+func (ctrl *controller) ListHTTPPoller(ctx context.Context,
+	req *ctrlpb.ListHTTPPollerRequest) (*ctrlpb.ListHTTPPollerResponse, error) {
+	ps, err := ctrl.listHTTPPoller(ctx, req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	return &ctrlpb.ListHTTPPollerResponse{HttpPollers: timermd.Convert2ProtoHTTPPoller(ps...)}, nil
+}
+
+func (ctrl *controller) PauseHTTPPoller(ctx context.Context,
+	req *ctrlpb.PauseHTTPPollerRequest) (*ctrlpb.HTTPPoller, error) {
+	return ctrl.setHTTPPollerPaused(ctx, vanus.NewIDFromUint64(req.Id), true)
+}
+
+func (ctrl *controller) ResumeHTTPPoller(ctx context.Context,
+	req *ctrlpb.ResumeHTTPPollerRequest) (*ctrlpb.HTTPPoller, error) {
+	return ctrl.setHTTPPollerPaused(ctx, vanus.NewIDFromUint64(req.Id), false)
+}
+
+func (ctrl *controller) DeleteHTTPPoller(ctx context.Context,
+	req *ctrlpb.DeleteHTTPPollerRequest) (*emptypb.Empty, error) {
+	id := vanus.NewIDFromUint64(req.Id)
+	exist, err := ctrl.kvStore.Exists(ctx, timermd.GetHTTPPollerKeyInKVStore(id))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the HTTP poller doesn't exist")
+	}
+	if err = ctrl.deleteHTTPPoller(ctx, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// setHTTPPollerPaused updates the paused flag of the poller, timer picks it up at next check.
+func (ctrl *controller) setHTTPPollerPaused(ctx context.Context, id vanus.ID,
+	paused bool) (*ctrlpb.HTTPPoller, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	data, err := ctrl.kvStore.Get(ctx, timermd.GetHTTPPollerKeyInKVStore(id))
+	if err != nil {
+		if stderr.Is(err, kv.ErrKeyNotFound) {
+			return nil, errors.ErrResourceNotFound.WithMessage("the HTTP poller doesn't exist")
+		}
+		return nil, err
+	}
+	p := &timermd.HTTPPoller{}
+	if err = json.Unmarshal(data, p); err != nil {
+		return nil, errors.ErrInternal.WithMessage("unmarshal HTTP poller metadata failed").Wrap(err)
+	}
+	if p.Paused != paused {
+		p.Paused = paused
+		if err = ctrl.saveHTTPPoller(ctx, p); err != nil {
+			return nil, err
+		}
+		log.Info(ctx, "HTTP poller updated", map[string]interface{}{
+			"id":     p.ID,
+			"paused": p.Paused,
+		})
+	}
+	return timermd.Convert2ProtoHTTPPoller(p)[0], nil
+}
+
+func (ctrl *controller) saveHTTPPoller(ctx context.Context, p *timermd.HTTPPoller) error {
+	data, _ := json.Marshal(p)
+	if err := ctrl.kvStore.Set(ctx, timermd.GetHTTPPollerKeyInKVStore(p.ID), data); err != nil {
+		return errors.ErrInternal.WithMessage("save HTTP poller metadata in kv failed").Wrap(err)
+	}
+	return nil
+}
+
+// listHTTPPoller returns the HTTP pollers of the eventbus, or all HTTP pollers if eventbus is empty.
+func (ctrl *controller) listHTTPPoller(ctx context.Context, eventbus string) ([]*timermd.HTTPPoller, error) {
+	pairs, err := ctrl.kvStore.List(ctx, timermd.HTTPPollerKeyPrefixInKVStore)
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]*timermd.HTTPPoller, 0, len(pairs))
+	for _, pair := range pairs {
+		p := &timermd.HTTPPoller{}
+		if err = json.Unmarshal(pair.Value, p); err != nil {
+			log.Warning(ctx, "unmarshal HTTP poller metadata failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		if eventbus == "" || p.Eventbus == eventbus {
+			ps = append(ps, p)
+		}
+	}
+	return ps, nil
+}
+
+func (ctrl *controller) deleteHTTPPoller(ctx context.Context, id vanus.ID) error {
+	if err := ctrl.kvStore.Delete(ctx, timermd.GetHTTPPollerKeyInKVStore(id)); err != nil {
+		return errors.ErrInternal.WithMessage("delete HTTP poller metadata in kv failed").Wrap(err)
+	}
+	// the state is written by timer, it doesn't matter if the deletion failed
+	_ = ctrl.kvStore.Delete(ctx, timermd.GetHTTPPollerStateKeyInKVStore(id))
+	log.Info(ctx, "HTTP poller deleted", map[string]interface{}{
+		"id": id,
+	})
+	return nil
+}
+
+// deleteHTTPPollerOfEventbus deletes the HTTP pollers which publish into the deleted eventbus.
+func (ctrl *controller) deleteHTTPPollerOfEventbus(ctx context.Context, eventbus string) {
+	ps, err := ctrl.listHTTPPoller(ctx, eventbus)
+	if err != nil {
+		log.Warning(ctx, "list HTTP poller of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eventbus,
+		})
+		return
+	}
+	for _, p := range ps {
+		_ = ctrl.deleteHTTPPoller(ctx, p.ID)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_HTTPPoller(t *testing.T) {
+	Convey("test HTTP poller", t, func() {
+		vanus.InitFakeSnowflake()
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+		ctrl.eventBusMap["test-1"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-1"}
+		newRequest := func() *ctrlpb.CreateHTTPPollerRequest {
+			return &ctrlpb.CreateHTTPPollerRequest{
+				Name:        "test",
+				Eventbus:    "test-1",
+				Url:         "https://example.com/api/orders",
+				Auth:        &ctrlpb.HTTPPollerAuth{Type: "basic", Username: "user", Password: "pass"},
+				ItemsPath:   "$.data.items",
+				DedupKey:    "$.id",
+				EventType:   "order.created",
+				EventSource: "example.com",
+			}
+		}
+		req := newRequest()
+
+		Convey("test create HTTP poller with invalid request", func() {
+			invalid := []func(r *ctrlpb.CreateHTTPPollerRequest){
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.Name = "" },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.Url = "ftp://example.com" },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.EventType = "" },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.ItemsPath = "$.[" },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.DedupKey = "$.[" },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.Auth.Username = "" },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.Auth = &ctrlpb.HTTPPollerAuth{Type: "bearer"} },
+				func(r *ctrlpb.CreateHTTPPollerRequest) { r.Auth.Type = "digest" },
+			}
+			for _, f := range invalid {
+				r := newRequest()
+				f(r)
+				_, err := ctrl.CreateHTTPPoller(ctx, r)
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			}
+
+			req.Eventbus = "test-2"
+			_, err := ctrl.CreateHTTPPoller(ctx, req)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test create, list, pause, resume and delete HTTP poller", func() {
+			var saved []byte
+			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+			res, err := ctrl.CreateHTTPPoller(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.Id, ShouldNotBeZeroValue)
+			So(res.Interval, ShouldEqual, 60)
+			So(res.Paused, ShouldBeFalse)
+			So(res.Auth.Password, ShouldEqual, primitive.SecretsMask)
+			p := &timermd.HTTPPoller{}
+			So(json.Unmarshal(saved, p), ShouldBeNil)
+			So(p.ID.Uint64(), ShouldEqual, res.Id)
+			So(p.Interval, ShouldEqual, time.Minute)
+			So(p.Auth.Password, ShouldEqual, "pass")
+
+			other, _ := json.Marshal(&timermd.HTTPPoller{ID: vanus.NewTestID(), Eventbus: "test-2"})
+			pairs := []kv.Pair{{Value: saved}, {Value: other}}
+			kvCli.EXPECT().List(ctx, timermd.HTTPPollerKeyPrefixInKVStore).Times(2).Return(pairs, nil)
+			list, err := ctrl.ListHTTPPoller(ctx, &ctrlpb.ListHTTPPollerRequest{})
+			So(err, ShouldBeNil)
+			So(list.HttpPollers, ShouldHaveLength, 2)
+			list, err = ctrl.ListHTTPPoller(ctx, &ctrlpb.ListHTTPPollerRequest{Eventbus: "test-1"})
+			So(err, ShouldBeNil)
+			So(list.HttpPollers, ShouldHaveLength, 1)
+			So(list.HttpPollers[0].Id, ShouldEqual, res.Id)
+
+			id := vanus.NewIDFromUint64(res.Id)
+			key := timermd.GetHTTPPollerKeyInKVStore(id)
+			kvCli.EXPECT().Get(ctx, key).Times(1).Return(saved, nil)
+			kvCli.EXPECT().Set(ctx, key, gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+			res, err = ctrl.PauseHTTPPoller(ctx, &ctrlpb.PauseHTTPPollerRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+			So(res.Paused, ShouldBeTrue)
+			So(json.Unmarshal(saved, p), ShouldBeNil)
+			So(p.Paused, ShouldBeTrue)
+
+			// pausing a paused poller changes nothing
+			kvCli.EXPECT().Get(ctx, key).Times(1).Return(saved, nil)
+			_, err = ctrl.PauseHTTPPoller(ctx, &ctrlpb.PauseHTTPPollerRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+
+			kvCli.EXPECT().Get(ctx, key).Times(1).Return(saved, nil)
+			kvCli.EXPECT().Set(ctx, key, gomock.Any()).Times(1).Return(nil)
+			res, err = ctrl.ResumeHTTPPoller(ctx, &ctrlpb.ResumeHTTPPollerRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+			So(res.Paused, ShouldBeFalse)
+
+			kvCli.EXPECT().Exists(ctx, key).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(ctx, key).Times(1).Return(nil)
+			kvCli.EXPECT().Delete(ctx, timermd.GetHTTPPollerStateKeyInKVStore(id)).Times(1).Return(nil)
+			_, err = ctrl.DeleteHTTPPoller(ctx, &ctrlpb.DeleteHTTPPollerRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test pause and delete a doesn't exist HTTP poller", func() {
+			id := vanus.NewTestID()
+			kvCli.EXPECT().Get(ctx, timermd.GetHTTPPollerKeyInKVStore(id)).Times(1).Return(nil, kv.ErrKeyNotFound)
+			_, err := ctrl.PauseHTTPPoller(ctx, &ctrlpb.PauseHTTPPollerRequest{Id: id.Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			kvCli.EXPECT().Exists(ctx, timermd.GetHTTPPollerKeyInKVStore(id)).Times(1).Return(false, nil)
+			_, err = ctrl.DeleteHTTPPoller(ctx, &ctrlpb.DeleteHTTPPollerRequest{Id: id.Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+	})
+}
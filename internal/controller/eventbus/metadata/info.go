@@ -77,6 +77,14 @@ func Convert2ProtoEventLog(ins ...*Eventlog) []*meta.EventLog {
 	return pels
 }
 
+// ConsumerGroupOffset is the offset of eventlog committed by the consumer group, the members of group
+// consume the eventlog from it after rebalancing.
+type ConsumerGroupOffset struct {
+	EventlogID  vanus.ID  `json:"eventlog_id"`
+	Offset      int64     `json:"offset"`
+	CommittedAt time.Time `json:"committed_at"`
+}
+
 type VolumeMetadata struct {
 	ID       vanus.ID          `json:"id"`
 	Capacity int64             `json:"capacity"`
@@ -30,6 +30,14 @@ const (
 	SegmentKeyPrefixInKVStore  = "/vanus/internal/resource/segment"
 
 	EventlogSegmentsKeyPrefixInKVStore = "/vanus/internal/resource/segs_of_eventlog"
+
+	ConsumerGroupOffsetKeyPrefixInKVStore = "/vanus/internal/resource/consumer_group/offset"
+
+	EventbusACLKeyPrefixInKVStore = "/vanus/internal/resource/acl"
+	IPAllowlistKeyPrefixInKVStore = "/vanus/internal/resource/ip_allowlist"
+	FeatureFlagKeyPrefixInKVStore = "/vanus/internal/resource/feature_flag"
+
+	SchemaVersionKeyInKVStore = "/vanus/internal/schema_version"
 )
 
 func GetEventbusMetadataKey(ebName string) string {
@@ -51,3 +59,22 @@ func GetSegmentMetadataKey(segmentID vanus.ID) string {
 func GetEventlogSegmentsMetadataKey(eventlogID, segmentID vanus.ID) string {
 	return path.Join(EventlogSegmentsKeyPrefixInKVStore, eventlogID.Key(), segmentID.Key())
 }
+
+func GetConsumerGroupOffsetKey(group string, eventlogID vanus.ID) string {
+	return path.Join(ConsumerGroupOffsetKeyPrefixInKVStore, group, eventlogID.Key())
+}
+
+// GetEventbusACLKey returns the key of an ACL entry, or the directory of the eventbus if principal is empty.
+func GetEventbusACLKey(eventbus, principal string) string {
+	return path.Join(EventbusACLKeyPrefixInKVStore, eventbus, principal)
+}
+
+// GetIPAllowlistKey returns the key of the IP allowlist of an eventbus or a namespace.
+func GetIPAllowlistKey(kind, name string) string {
+	return path.Join(IPAllowlistKeyPrefixInKVStore, kind, name)
+}
+
+// GetFeatureFlagKey returns the key of the flag of a feature.
+func GetFeatureFlagKey(name string) string {
+	return path.Join(FeatureFlagKeyPrefixInKVStore, name)
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// SchemaVersionLegacy is the version of the metadata written before the schema version was recorded.
+	SchemaVersionLegacy = 1
+	// SchemaVersionEventlogOwner records the eventbus name in the metadata of eventlogs.
+	SchemaVersionEventlogOwner = 2
+
+	// CurrentSchemaVersion is the schema version written by this controller.
+	CurrentSchemaVersion = SchemaVersionEventlogOwner
+)
+
+// SchemaVersion is the version of the metadata in the kv store. MinCompatible is the oldest schema
+// version whose controllers can still read and write the metadata, so that controllers of different
+// versions can serve as leader in turn during the rolling upgrade.
+type SchemaVersion struct {
+	Version       int       `json:"version"`
+	MinCompatible int       `json:"min_compatible"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// IsCompatible reports whether the controller of the schema version can manage the metadata.
+func (sv *SchemaVersion) IsCompatible(version int) bool {
+	return version >= sv.MinCompatible
+}
+
+// DecodeEventbus decodes the eventbus metadata of any schema version. The fields added by newer
+// versions are ignored and the metadata of older versions is upgraded in memory.
+func DecodeEventbus(data []byte) (*Eventbus, error) {
+	eb := &Eventbus{}
+	if err := json.Unmarshal(data, eb); err != nil {
+		return nil, err
+	}
+	for _, el := range eb.EventLogs {
+		if el != nil && el.EventbusName == "" && el.EventbusID == eb.ID {
+			el.EventbusName = eb.Name
+		}
+	}
+	return eb, nil
+}
+
+// DecodeEventlog decodes the eventlog metadata of any schema version. The eventlogs written by
+// legacy controllers don't have the eventbus name, Eventlog.Eventbus falls back to the eventbus ID.
+func DecodeEventlog(data []byte) (*Eventlog, error) {
+	el := &Eventlog{}
+	if err := json.Unmarshal(data, el); err != nil {
+		return nil, err
+	}
+	return el, nil
+}
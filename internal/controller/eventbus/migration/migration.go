@@ -0,0 +1,180 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	stdErr "errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+// Migration upgrades the metadata in the kv store to Version. Migrate must be idempotent, because
+// the controller crashed before recording the version runs it again.
+type Migration struct {
+	Version int
+	// MinCompatible is the oldest schema version whose controllers can still manage the migrated metadata.
+	MinCompatible int
+	Description   string
+	Migrate       func(ctx context.Context, cli kv.Client) error
+}
+
+var migrations = []Migration{
+	{
+		Version:       metadata.SchemaVersionEventlogOwner,
+		MinCompatible: metadata.SchemaVersionLegacy,
+		Description:   "record the eventbus name in the metadata of eventlogs",
+		Migrate:       fillEventlogOwner,
+	},
+}
+
+// Run upgrades the metadata to metadata.CurrentSchemaVersion, it's called by the leader before loading
+// the metadata. The metadata upgraded by newer controllers is left untouched as long as it's still
+// compatible with this controller.
+func Run(ctx context.Context, cli kv.Client) error {
+	return run(ctx, cli, migrations, metadata.CurrentSchemaVersion)
+}
+
+func run(ctx context.Context, cli kv.Client, migrations []Migration, current int) error {
+	sv, data, err := getSchemaVersion(ctx, cli)
+	if err != nil {
+		return err
+	}
+	if !sv.IsCompatible(current) {
+		return errors.ErrServiceState.WithMessage("the metadata schema version isn't compatible")
+	}
+	if sv.Version > current {
+		log.Info(ctx, "the metadata schema was upgraded by a newer controller", map[string]interface{}{
+			"schema_version":  sv.Version,
+			"current_version": current,
+		})
+		return nil
+	}
+	for _, m := range migrations {
+		if m.Version <= sv.Version || m.Version > current {
+			continue
+		}
+		log.Info(ctx, "start to migrate the metadata", map[string]interface{}{
+			"from_version": sv.Version,
+			"to_version":   m.Version,
+			"description":  m.Description,
+		})
+		if err = m.Migrate(ctx, cli); err != nil {
+			return err
+		}
+		next := &metadata.SchemaVersion{
+			Version:       m.Version,
+			MinCompatible: sv.MinCompatible,
+			UpdatedAt:     time.Now(),
+		}
+		if m.MinCompatible > next.MinCompatible {
+			next.MinCompatible = m.MinCompatible
+		}
+		if data, err = putSchemaVersion(ctx, cli, data, next); err != nil {
+			return err
+		}
+		sv = next
+	}
+	return nil
+}
+
+func getSchemaVersion(ctx context.Context, cli kv.Client) (*metadata.SchemaVersion, []byte, error) {
+	data, err := cli.Get(ctx, metadata.SchemaVersionKeyInKVStore)
+	if stdErr.Is(err, kv.ErrKeyNotFound) {
+		return &metadata.SchemaVersion{
+			Version:       metadata.SchemaVersionLegacy,
+			MinCompatible: metadata.SchemaVersionLegacy,
+		}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	sv := &metadata.SchemaVersion{}
+	if err = json.Unmarshal(data, sv); err != nil {
+		return nil, nil, err
+	}
+	return sv, data, nil
+}
+
+// putSchemaVersion records the schema version only if it isn't changed by another controller.
+func putSchemaVersion(ctx context.Context, cli kv.Client,
+	prev []byte, sv *metadata.SchemaVersion) ([]byte, error) {
+	data, _ := json.Marshal(sv)
+	var err error
+	if prev == nil {
+		err = cli.Create(ctx, metadata.SchemaVersionKeyInKVStore, data)
+	} else {
+		err = cli.CompareAndSwap(ctx, metadata.SchemaVersionKeyInKVStore, prev, data)
+	}
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("record the metadata schema version failed").Wrap(err)
+	}
+	return data, nil
+}
+
+func fillEventlogOwner(ctx context.Context, cli kv.Client) error {
+	pairs, err := cli.List(ctx, metadata.EventbusKeyPrefixInKVStore)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		if !strings.HasSuffix(path.Dir(pair.Key), metadata.EventbusKeyPrefixInKVStore) {
+			continue
+		}
+		eb, err := metadata.DecodeEventbus(pair.Value)
+		if err != nil {
+			return err
+		}
+		for _, el := range eb.EventLogs {
+			if el == nil {
+				continue
+			}
+			key := metadata.GetEventlogMetadataKey(el.ID)
+			data, err := cli.Get(ctx, key)
+			if stdErr.Is(err, kv.ErrKeyNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			elMD, err := metadata.DecodeEventlog(data)
+			if err != nil {
+				return err
+			}
+			if elMD.EventbusName != "" {
+				continue
+			}
+			elMD.EventbusName = eb.Name
+			newData, _ := json.Marshal(elMD)
+			if err = cli.CompareAndSwap(ctx, key, data, newData); err != nil {
+				return err
+			}
+		}
+		// DecodeEventbus has filled the eventbus name of the embedded eventlogs.
+		data, _ := json.Marshal(eb)
+		err = cli.CompareAndSwap(ctx, metadata.GetEventbusMetadataKey(eb.Name), pair.Value, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRun(t *testing.T) {
+	Convey("test run migrations", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		var applied []int
+		ms := []Migration{
+			{Version: 2, MinCompatible: 1, Migrate: func(context.Context, kv.Client) error {
+				applied = append(applied, 2)
+				return nil
+			}},
+			{Version: 3, MinCompatible: 2, Migrate: func(context.Context, kv.Client) error {
+				applied = append(applied, 3)
+				return nil
+			}},
+		}
+		saved := func(version, minCompatible int) []byte {
+			data, _ := json.Marshal(&metadata.SchemaVersion{Version: version, MinCompatible: minCompatible})
+			return data
+		}
+
+		Convey("test migrate the legacy metadata", func() {
+			kvCli.EXPECT().Get(ctx, metadata.SchemaVersionKeyInKVStore).Times(1).Return(nil, kv.ErrKeyNotFound)
+			var data []byte
+			kvCli.EXPECT().Create(ctx, metadata.SchemaVersionKeyInKVStore, gomock.Any()).Times(1).DoAndReturn(
+				func(_ context.Context, _ string, value []byte) error {
+					data = value
+					return nil
+				})
+			kvCli.EXPECT().CompareAndSwap(ctx, metadata.SchemaVersionKeyInKVStore, gomock.Any(), gomock.Any()).
+				Times(1).DoAndReturn(func(_ context.Context, _ string, prev, value []byte) error {
+				So(prev, ShouldResemble, data)
+				data = value
+				return nil
+			})
+			So(run(ctx, kvCli, ms, 3), ShouldBeNil)
+			So(applied, ShouldResemble, []int{2, 3})
+			sv := &metadata.SchemaVersion{}
+			So(json.Unmarshal(data, sv), ShouldBeNil)
+			So(sv.Version, ShouldEqual, 3)
+			So(sv.MinCompatible, ShouldEqual, 2)
+		})
+
+		Convey("test skip the applied migrations", func() {
+			kvCli.EXPECT().Get(ctx, metadata.SchemaVersionKeyInKVStore).Times(1).Return(saved(2, 1), nil)
+			kvCli.EXPECT().CompareAndSwap(ctx, metadata.SchemaVersionKeyInKVStore, saved(2, 1), gomock.Any()).
+				Times(1).Return(nil)
+			So(run(ctx, kvCli, ms, 3), ShouldBeNil)
+			So(applied, ShouldResemble, []int{3})
+		})
+
+		Convey("test run with the metadata upgraded by a newer controller", func() {
+			kvCli.EXPECT().Get(ctx, metadata.SchemaVersionKeyInKVStore).Times(1).Return(saved(3, 2), nil)
+			So(run(ctx, kvCli, ms, 2), ShouldBeNil)
+			So(applied, ShouldBeEmpty)
+
+			kvCli.EXPECT().Get(ctx, metadata.SchemaVersionKeyInKVStore).Times(1).Return(saved(3, 2), nil)
+			err := run(ctx, kvCli, ms[:0], 1)
+			So(errors.Is(err, errors.ErrServiceState), ShouldBeTrue)
+		})
+
+		Convey("test the version isn't recorded if migration failed", func() {
+			kvCli.EXPECT().Get(ctx, metadata.SchemaVersionKeyInKVStore).Times(1).Return(saved(2, 1), nil)
+			ms[1].Migrate = func(context.Context, kv.Client) error {
+				return errors.ErrInternal
+			}
+			So(run(ctx, kvCli, ms, 3), ShouldNotBeNil)
+		})
+
+		Convey("test the version changed by another controller", func() {
+			kvCli.EXPECT().Get(ctx, metadata.SchemaVersionKeyInKVStore).Times(1).Return(saved(2, 1), nil)
+			kvCli.EXPECT().CompareAndSwap(ctx, metadata.SchemaVersionKeyInKVStore, gomock.Any(), gomock.Any()).
+				Times(1).Return(kv.ErrSetFailed)
+			So(run(ctx, kvCli, ms, 3), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFillEventlogOwner(t *testing.T) {
+	Convey("test fill the eventbus name of eventlogs", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+
+		busID := vanus.NewTestID()
+		legacy := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: busID}
+		filled := &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: busID, EventbusName: "test"}
+		bus, _ := json.Marshal(&metadata.Eventbus{
+			ID: busID, Name: "test", LogNumber: 2, EventLogs: []*metadata.Eventlog{legacy, filled},
+		})
+		legacyData, _ := json.Marshal(legacy)
+		filledData, _ := json.Marshal(filled)
+		pairs := []kv.Pair{
+			{Key: "/vanus" + metadata.GetEventbusMetadataKey("test"), Value: bus},
+			{Key: metadata.EventbusKeyPrefixInKVStore + "_other/test", Value: []byte("invalid")},
+		}
+		kvCli.EXPECT().List(ctx, metadata.EventbusKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+		kvCli.EXPECT().Get(ctx, metadata.GetEventlogMetadataKey(legacy.ID)).Times(1).Return(legacyData, nil)
+		kvCli.EXPECT().Get(ctx, metadata.GetEventlogMetadataKey(filled.ID)).Times(1).Return(filledData, nil)
+		kvCli.EXPECT().CompareAndSwap(ctx, metadata.GetEventlogMetadataKey(legacy.ID), legacyData, gomock.Any()).
+			Times(1).DoAndReturn(func(_ context.Context, _ string, _, value []byte) error {
+			el, err := metadata.DecodeEventlog(value)
+			So(err, ShouldBeNil)
+			So(el.EventbusName, ShouldEqual, "test")
+			return nil
+		})
+		kvCli.EXPECT().CompareAndSwap(ctx, metadata.GetEventbusMetadataKey("test"), bus, gomock.Any()).
+			Times(1).DoAndReturn(func(_ context.Context, _ string, _, value []byte) error {
+			eb, err := metadata.DecodeEventbus(value)
+			So(err, ShouldBeNil)
+			So(eb.EventLogs[0].EventbusName, ShouldEqual, "test")
+			return nil
+		})
+		So(fillEventlogOwner(ctx, kvCli), ShouldBeNil)
+	})
+}
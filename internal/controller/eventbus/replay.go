@@ -0,0 +1,218 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/validation"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The replay jobs are only saved in kv by controller like the cron events, the leader of timer
+// loads them, copies the events into the target eventbus and saves the progress in kv.
+
+func (ctrl *controller) CreateReplayJob(ctx context.Context,
+	req *ctrlpb.CreateReplayJobRequest) (*ctrlpb.ReplayJob, error) {
+	if req.SourceEventbus == "" || req.TargetEventbus == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("source and target eventbus can't be empty")
+	}
+	if req.SourceEventbus == req.TargetEventbus {
+		return nil, errors.ErrInvalidRequest.WithMessage("source and target eventbus can't be the same")
+	}
+	now := time.Now()
+	job := &timermd.ReplayJob{
+		SourceEventbus: req.SourceEventbus,
+		TargetEventbus: req.TargetEventbus,
+		ByOffset:       req.ByOffset,
+		RateLimit:      req.RateLimit,
+		Description:    req.Description,
+		CreatedAt:      now,
+	}
+	if req.ByOffset {
+		if req.StartOffset < 0 || (req.EndOffset != 0 && req.EndOffset <= req.StartOffset) {
+			return nil, errors.ErrInvalidRequest.WithMessage("invalid offset range")
+		}
+		job.StartOffset, job.EndOffset = req.StartOffset, req.EndOffset
+	} else {
+		end := req.EndTime
+		if end == 0 {
+			end = now.UnixMilli()
+		}
+		if req.StartTime < 0 || req.StartTime >= end {
+			return nil, errors.ErrInvalidRequest.WithMessage("invalid time range")
+		}
+		job.StartTime, job.EndTime = time.UnixMilli(req.StartTime), time.UnixMilli(end)
+	}
+	if err := validation.ValidateFilterList(ctx, req.Filters); err != nil {
+		return nil, err
+	}
+	job.Filters = convert.FromPbFilters(req.Filters)
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	if _, exist := ctrl.eventBusMap[req.SourceEventbus]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the source eventbus doesn't exist")
+	}
+	if _, exist := ctrl.eventBusMap[req.TargetEventbus]; !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the target eventbus doesn't exist")
+	}
+	id, err := vanus.NewID()
+	if err != nil {
+		log.Warning(ctx, "failed to create replay job ID", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return nil, err
+	}
+	job.ID = id
+	data, _ := json.Marshal(job)
+	if err = ctrl.kvStore.Set(ctx, timermd.GetReplayJobKeyInKVStore(job.ID), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save replay job metadata in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "replay job created", map[string]interface{}{
+		"id":     job.ID,
+		"source": job.SourceEventbus,
+		"target": job.TargetEventbus,
+	})
+	return timermd.Convert2ProtoReplayJob(job, nil), nil
+}
+
+func (ctrl *controller) GetReplayJob(ctx context.Context,
+	req *ctrlpb.GetReplayJobRequest) (*ctrlpb.ReplayJob, error) {
+	id := vanus.NewIDFromUint64(req.Id)
+	data, err := ctrl.kvStore.Get(ctx, timermd.GetReplayJobKeyInKVStore(id))
+	if err != nil {
+		if stderr.Is(err, kv.ErrKeyNotFound) {
+			return nil, errors.ErrResourceNotFound.WithMessage("the replay job doesn't exist")
+		}
+		return nil, err
+	}
+	job := &timermd.ReplayJob{}
+	if err = json.Unmarshal(data, job); err != nil {
+		return nil, errors.ErrInternal.WithMessage("unmarshal replay job metadata failed").Wrap(err)
+	}
+	return timermd.Convert2ProtoReplayJob(job, ctrl.getReplayState(ctx, job.ID)), nil
+}
+
+func (ctrl *controller) ListReplayJob(ctx context.Context,
+	req *ctrlpb.ListReplayJobRequest) (*ctrlpb.ListReplayJobResponse, error) {
+	jobs, err := ctrl.listReplayJob(ctx, req.Eventbus)
+	if err != nil {
+		return nil, err
+	}
+	res := &ctrlpb.ListReplayJobResponse{ReplayJobs: make([]*ctrlpb.ReplayJob, 0, len(jobs))}
+	for _, job := range jobs {
+		res.ReplayJobs = append(res.ReplayJobs,
+			timermd.Convert2ProtoReplayJob(job, ctrl.getReplayState(ctx, job.ID)))
+	}
+	return res, nil
+}
+
+// DeleteReplayJob deletes the replay job, a running job is stopped by timer.
+func (ctrl *controller) DeleteReplayJob(ctx context.Context,
+	req *ctrlpb.DeleteReplayJobRequest) (*emptypb.Empty, error) {
+	id := vanus.NewIDFromUint64(req.Id)
+	exist, err := ctrl.kvStore.Exists(ctx, timermd.GetReplayJobKeyInKVStore(id))
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the replay job doesn't exist")
+	}
+	if err = ctrl.deleteReplayJob(ctx, id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// listReplayJob returns the replay jobs whose source or target is the eventbus, or all replay jobs
+// if eventbus is empty.
+func (ctrl *controller) listReplayJob(ctx context.Context, eventbus string) ([]*timermd.ReplayJob, error) {
+	pairs, err := ctrl.kvStore.List(ctx, timermd.ReplayJobKeyPrefixInKVStore)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*timermd.ReplayJob, 0, len(pairs))
+	for _, pair := range pairs {
+		job := &timermd.ReplayJob{}
+		if err = json.Unmarshal(pair.Value, job); err != nil {
+			log.Warning(ctx, "unmarshal replay job metadata failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		if eventbus == "" || job.SourceEventbus == eventbus || job.TargetEventbus == eventbus {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+// getReplayState returns the progress of replay job, it's nil if the job hasn't been started by timer.
+func (ctrl *controller) getReplayState(ctx context.Context, id vanus.ID) *timermd.ReplayState {
+	data, err := ctrl.kvStore.Get(ctx, timermd.GetReplayStateKeyInKVStore(id))
+	if err != nil {
+		if !stderr.Is(err, kv.ErrKeyNotFound) {
+			log.Warning(ctx, "get replay state failed", map[string]interface{}{
+				log.KeyError: err,
+				"id":         id,
+			})
+		}
+		return nil
+	}
+	state := &timermd.ReplayState{}
+	if err = json.Unmarshal(data, state); err != nil {
+		return nil
+	}
+	return state
+}
+
+func (ctrl *controller) deleteReplayJob(ctx context.Context, id vanus.ID) error {
+	if err := ctrl.kvStore.Delete(ctx, timermd.GetReplayJobKeyInKVStore(id)); err != nil {
+		return errors.ErrInternal.WithMessage("delete replay job metadata in kv failed").Wrap(err)
+	}
+	// the state is written by timer, it doesn't matter if the deletion failed
+	_ = ctrl.kvStore.Delete(ctx, timermd.GetReplayStateKeyInKVStore(id))
+	log.Info(ctx, "replay job deleted", map[string]interface{}{
+		"id": id,
+	})
+	return nil
+}
+
+// deleteReplayJobOfEventbus deletes the replay jobs from or into the deleted eventbus.
+func (ctrl *controller) deleteReplayJobOfEventbus(ctx context.Context, eventbus string) {
+	jobs, err := ctrl.listReplayJob(ctx, eventbus)
+	if err != nil {
+		log.Warning(ctx, "list replay job of eventbus failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eventbus,
+		})
+		return
+	}
+	for _, job := range jobs {
+		_ = ctrl.deleteReplayJob(ctx, job.ID)
+	}
+}
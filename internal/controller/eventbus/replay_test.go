@@ -0,0 +1,133 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_ReplayJob(t *testing.T) {
+	Convey("test replay job", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+		ctrl.eventBusMap["test-1"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-1"}
+		ctrl.eventBusMap["test-2"] = &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-2"}
+		req := &ctrlpb.CreateReplayJobRequest{
+			SourceEventbus: "test-1",
+			TargetEventbus: "test-2",
+			StartTime:      1000,
+			EndTime:        2000,
+			Filters:        []*metapb.Filter{{Exact: map[string]string{"type": "test"}}},
+			RateLimit:      100,
+		}
+
+		Convey("test create replay job with invalid request", func() {
+			req.TargetEventbus = "test-1"
+			_, err := ctrl.CreateReplayJob(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			req.TargetEventbus = "test-2"
+			req.StartTime = 3000
+			_, err = ctrl.CreateReplayJob(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			req.ByOffset = true
+			req.StartOffset = 10
+			req.EndOffset = 5
+			_, err = ctrl.CreateReplayJob(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			req.EndOffset = 0
+			req.TargetEventbus = "test-3"
+			_, err = ctrl.CreateReplayJob(ctx, req)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test create, get, list and delete replay job", func() {
+			var saved []byte
+			kvCli.EXPECT().Set(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+			res, err := ctrl.CreateReplayJob(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.Id, ShouldNotBeZeroValue)
+			So(res.Status, ShouldEqual, timermd.ReplayStatusPending)
+			So(res.StartTime, ShouldEqual, 1000)
+			So(res.EndTime, ShouldEqual, 2000)
+			So(res.Filters, ShouldHaveLength, 1)
+			job := &timermd.ReplayJob{}
+			So(json.Unmarshal(saved, job), ShouldBeNil)
+			So(job.ID.Uint64(), ShouldEqual, res.Id)
+
+			id := vanus.NewIDFromUint64(res.Id)
+			state, _ := json.Marshal(&timermd.ReplayState{
+				Cursors:  []*timermd.ReplayCursor{{EventlogID: 1, Offset: 5, EndOffset: 10}},
+				Replayed: 3,
+			})
+			kvCli.EXPECT().Get(ctx, timermd.GetReplayJobKeyInKVStore(id)).Times(1).Return(saved, nil)
+			kvCli.EXPECT().Get(ctx, timermd.GetReplayStateKeyInKVStore(id)).Times(1).Return(state, nil)
+			got, err := ctrl.GetReplayJob(ctx, &ctrlpb.GetReplayJobRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+			So(got.Status, ShouldEqual, timermd.ReplayStatusRunning)
+			So(got.Replayed, ShouldEqual, 3)
+			So(got.Progress, ShouldHaveLength, 1)
+			So(got.Progress[0].Offset, ShouldEqual, 5)
+
+			other, _ := json.Marshal(&timermd.ReplayJob{
+				ID: vanus.NewTestID(), SourceEventbus: "test-3", TargetEventbus: "test-4",
+			})
+			pairs := []kv.Pair{{Value: saved}, {Value: other}}
+			kvCli.EXPECT().List(ctx, timermd.ReplayJobKeyPrefixInKVStore).Times(2).Return(pairs, nil)
+			kvCli.EXPECT().Get(ctx, gomock.Any()).Times(3).Return(nil, kv.ErrKeyNotFound)
+			list, err := ctrl.ListReplayJob(ctx, &ctrlpb.ListReplayJobRequest{})
+			So(err, ShouldBeNil)
+			So(list.ReplayJobs, ShouldHaveLength, 2)
+			list, err = ctrl.ListReplayJob(ctx, &ctrlpb.ListReplayJobRequest{Eventbus: "test-2"})
+			So(err, ShouldBeNil)
+			So(list.ReplayJobs, ShouldHaveLength, 1)
+			So(list.ReplayJobs[0].Id, ShouldEqual, res.Id)
+
+			kvCli.EXPECT().Exists(ctx, timermd.GetReplayJobKeyInKVStore(id)).Times(1).Return(true, nil)
+			kvCli.EXPECT().Delete(ctx, timermd.GetReplayJobKeyInKVStore(id)).Times(1).Return(nil)
+			kvCli.EXPECT().Delete(ctx, timermd.GetReplayStateKeyInKVStore(id)).Times(1).Return(nil)
+			_, err = ctrl.DeleteReplayJob(ctx, &ctrlpb.DeleteReplayJobRequest{Id: res.Id})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test get a doesn't exist replay job", func() {
+			id := vanus.NewTestID()
+			kvCli.EXPECT().Get(ctx, timermd.GetReplayJobKeyInKVStore(id)).Times(1).Return(nil, kv.ErrKeyNotFound)
+			_, err := ctrl.GetReplayJob(ctx, &ctrlpb.GetReplayJobRequest{Id: id.Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
@@ -146,6 +147,11 @@ func (mgr *segmentServerManager) Run(ctx context.Context) error {
 							"address": srv.Address(),
 							"up_time": srv.Uptime(),
 						})
+						opsevent.Emit(newCtx, opsevent.TypeServerDown, &opsevent.ServerDown{
+							ServerID: srv.ID().String(),
+							Address:  srv.Address(),
+							Uptime:   srv.Uptime().UTC().Format(time.RFC3339),
+						})
 					}
 					return true
 				})
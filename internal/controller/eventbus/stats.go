@@ -0,0 +1,99 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetClusterStats returns the snapshot of segment servers and eventbuses, it's polled by vsctl cluster top.
+func (ctrl *controller) GetClusterStats(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ClusterStats, error) {
+	stats := &ctrlpb.ClusterStats{
+		ControllerLeader: ctrl.member.GetLeaderAddr(),
+		Timestamp:        time.Now().UnixMilli(),
+	}
+	for _, ins := range ctrl.volumeMgr.GetAllActiveVolumes() {
+		stats.SegmentServers = append(stats.SegmentServers, ctrl.segmentServerStats(ctx, ins))
+	}
+	sort.Slice(stats.SegmentServers, func(i, j int) bool {
+		return stats.SegmentServers[i].Address < stats.SegmentServers[j].Address
+	})
+
+	ctrl.mutex.Lock()
+	for _, eb := range ctrl.eventBusMap {
+		if strings.HasPrefix(eb.Name, systemEventbusPrefix) {
+			continue
+		}
+		ebStats := &ctrlpb.EventbusStats{
+			Name:           eb.Name,
+			Id:             eb.ID.Uint64(),
+			EventlogNumber: int32(len(eb.EventLogs)),
+		}
+		for _, el := range eb.EventLogs {
+			segments := ctrl.eventLogMgr.GetEventLogSegmentList(el.ID)
+			if len(segments) == 0 {
+				continue
+			}
+			last := segments[len(segments)-1]
+			ebStats.EventNumber += last.StartOffsetInLog + int64(last.Number)
+		}
+		stats.Eventbuses = append(stats.Eventbuses, ebStats)
+	}
+	ctrl.mutex.Unlock()
+	sort.Slice(stats.Eventbuses, func(i, j int) bool {
+		return stats.Eventbuses[i].Name < stats.Eventbuses[j].Name
+	})
+	return stats, nil
+}
+
+func (ctrl *controller) segmentServerStats(ctx context.Context, ins server.Instance) *ctrlpb.SegmentServerStats {
+	md := ins.GetMeta()
+	stats := &ctrlpb.SegmentServerStats{
+		VolumeId: ins.ID().Uint64(),
+		Capacity: md.Capacity,
+		Used:     md.Used,
+	}
+	if srv := ins.GetServer(); srv != nil {
+		stats.ServerId = srv.ID().Uint64()
+		stats.Address = srv.Address()
+	}
+	blocks, err := ctrl.volumeMgr.GetBlocksOfVolume(ctx, ins)
+	if err != nil {
+		log.Warning(ctx, "get blocks of volume failed", map[string]interface{}{
+			log.KeyError: err,
+			"volume_id":  ins.ID(),
+		})
+		return stats
+	}
+	stats.BlockNumber = int32(len(blocks))
+	for _, blk := range blocks {
+		seg, err := ctrl.eventLogMgr.GetSegmentByBlockID(blk)
+		if err != nil || seg == nil {
+			continue
+		}
+		if leader := seg.GetLeaderBlock(); leader != nil && leader.ID == blk.ID {
+			stats.LeaderNumber++
+		}
+	}
+	return stats
+}
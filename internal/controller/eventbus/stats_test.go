@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestController_GetClusterStats(t *testing.T) {
+	Convey("test get cluster stats", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		ctx := stdCtx.Background()
+
+		mockMember := embedetcd.NewMockMember(mockCtrl)
+		ctrl.member = mockMember
+		mockMember.EXPECT().GetLeaderAddr().AnyTimes().Return("127.0.0.1:2048")
+		volMgr := volume.NewMockManager(mockCtrl)
+		ctrl.volumeMgr = volMgr
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventLogMgr = elMgr
+
+		volumeID := vanus.NewTestID()
+		ins := server.NewMockInstance(mockCtrl)
+		srv := server.NewMockServer(mockCtrl)
+		volMgr.EXPECT().GetAllActiveVolumes().Times(1).Return([]server.Instance{ins})
+		ins.EXPECT().ID().AnyTimes().Return(volumeID)
+		ins.EXPECT().GetMeta().Times(1).Return(&metadata.VolumeMetadata{
+			ID:       volumeID,
+			Capacity: 1024,
+			Used:     256,
+		})
+		ins.EXPECT().GetServer().AnyTimes().Return(srv)
+		serverID := vanus.NewTestID()
+		srv.EXPECT().ID().AnyTimes().Return(serverID)
+		srv.EXPECT().Address().AnyTimes().Return("127.0.0.1:11811")
+
+		leader := &metadata.Block{ID: vanus.NewTestID(), VolumeID: volumeID}
+		follower := &metadata.Block{ID: vanus.NewTestID(), VolumeID: volumeID}
+		volMgr.EXPECT().GetBlocksOfVolume(ctx, ins).Times(1).Return(map[uint64]*metadata.Block{
+			leader.ID.Uint64():   leader,
+			follower.ID.Uint64(): follower,
+		}, nil)
+		elMgr.EXPECT().GetSegmentByBlockID(leader).Times(1).Return(&eventlog.Segment{
+			Replicas: &eventlog.ReplicaGroup{
+				Leader: leader.ID.Uint64(),
+				Peers:  map[uint64]*metadata.Block{leader.ID.Uint64(): leader},
+			},
+		}, nil)
+		elMgr.EXPECT().GetSegmentByBlockID(follower).Times(1).Return(&eventlog.Segment{
+			Replicas: &eventlog.ReplicaGroup{
+				Leader: leader.ID.Uint64(),
+				Peers:  map[uint64]*metadata.Block{leader.ID.Uint64(): leader},
+			},
+		}, nil)
+
+		el1 := &metadata.Eventlog{ID: vanus.NewTestID()}
+		el2 := &metadata.Eventlog{ID: vanus.NewTestID()}
+		ctrl.eventBusMap["test"] = &metadata.Eventbus{
+			ID:        vanus.NewTestID(),
+			Name:      "test",
+			EventLogs: []*metadata.Eventlog{el1, el2},
+		}
+		ctrl.eventBusMap[systemEventbusPrefix+"timer"] = &metadata.Eventbus{
+			ID:   vanus.NewTestID(),
+			Name: systemEventbusPrefix + "timer",
+		}
+		elMgr.EXPECT().GetEventLogSegmentList(el1.ID).Times(1).Return([]*eventlog.Segment{
+			{StartOffsetInLog: 0, Number: 100},
+			{StartOffsetInLog: 100, Number: 20},
+		})
+		elMgr.EXPECT().GetEventLogSegmentList(el2.ID).Times(1).Return(nil)
+
+		stats, err := ctrl.GetClusterStats(ctx, &emptypb.Empty{})
+		So(err, ShouldBeNil)
+		So(stats.ControllerLeader, ShouldEqual, "127.0.0.1:2048")
+		So(stats.Timestamp, ShouldBeGreaterThan, 0)
+		So(stats.SegmentServers, ShouldHaveLength, 1)
+		ss := stats.SegmentServers[0]
+		So(ss.ServerId, ShouldEqual, serverID.Uint64())
+		So(ss.Address, ShouldEqual, "127.0.0.1:11811")
+		So(ss.VolumeId, ShouldEqual, volumeID.Uint64())
+		So(ss.Capacity, ShouldEqual, 1024)
+		So(ss.Used, ShouldEqual, 256)
+		So(ss.BlockNumber, ShouldEqual, 2)
+		So(ss.LeaderNumber, ShouldEqual, 1)
+		So(stats.Eventbuses, ShouldHaveLength, 1)
+		So(stats.Eventbuses[0].Name, ShouldEqual, "test")
+		So(stats.Eventbuses[0].EventlogNumber, ShouldEqual, 2)
+		So(stats.Eventbuses[0].EventNumber, ShouldEqual, 120)
+	})
+}
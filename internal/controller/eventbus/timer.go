@@ -0,0 +1,52 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListTimerReplica lists the replicas of timer. Each replica reports its status in kv with a TTL, so the
+// replica which has stopped reporting disappears from the list.
+func (ctrl *controller) ListTimerReplica(ctx context.Context,
+	_ *emptypb.Empty) (*ctrlpb.ListTimerReplicaResponse, error) {
+	pairs, err := ctrl.kvStore.List(ctx, timermd.ReplicaKeyPrefixInKVStore)
+	if err != nil {
+		return nil, err
+	}
+	replicas := make([]*timermd.TimerReplica, 0, len(pairs))
+	for _, pair := range pairs {
+		r := &timermd.TimerReplica{}
+		if err = json.Unmarshal(pair.Value, r); err != nil {
+			log.Warning(ctx, "unmarshal timer replica failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		replicas = append(replicas, r)
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].Name < replicas[j].Name
+	})
+	return &ctrlpb.ListTimerReplicaResponse{Replicas: timermd.Convert2ProtoTimerReplica(replicas...)}, nil
+}
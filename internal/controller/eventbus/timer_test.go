@@ -0,0 +1,63 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/kv"
+	timermd "github.com/linkall-labs/vanus/internal/timer/metadata"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestController_ListTimerReplica(t *testing.T) {
+	Convey("test list timer replica", t, func() {
+		ctrl := NewController(Config{}, nil)
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+
+		now := time.UnixMilli(time.Now().UnixMilli())
+		data1, _ := json.Marshal(&timermd.TimerReplica{Name: "timer-1", IsLeader: false, HeartbeatAt: now})
+		data0, _ := json.Marshal(&timermd.TimerReplica{
+			Name:        "timer-0",
+			Address:     "127.0.0.1:2148",
+			IsLeader:    true,
+			Epoch:       3,
+			FiredOffset: 100,
+			HeartbeatAt: now,
+		})
+		kvCli.EXPECT().List(ctx, timermd.ReplicaKeyPrefixInKVStore).Times(1).Return([]kv.Pair{
+			{Key: timermd.GetReplicaKeyInKVStore("timer-1"), Value: data1},
+			{Key: timermd.GetReplicaKeyInKVStore("timer-0"), Value: data0},
+			{Key: timermd.GetReplicaKeyInKVStore("timer-2"), Value: []byte("invalid")},
+		}, nil)
+		res, err := ctrl.ListTimerReplica(ctx, &emptypb.Empty{})
+		So(err, ShouldBeNil)
+		So(res.Replicas, ShouldHaveLength, 2)
+		So(res.Replicas[0].Name, ShouldEqual, "timer-0")
+		So(res.Replicas[0].IsLeader, ShouldBeTrue)
+		So(res.Replicas[0].Epoch, ShouldEqual, 3)
+		So(res.Replicas[0].FiredOffset, ShouldEqual, 100)
+		So(res.Replicas[0].HeartbeatAt, ShouldEqual, now.UnixMilli())
+		So(res.Replicas[1].Name, ShouldEqual, "timer-1")
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"runtime/debug"
+	"sync"
+
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus"
+	"github.com/linkall-labs/vanus/internal/controller/snowflake"
+	"github.com/linkall-labs/vanus/internal/controller/trigger"
+	etcdkv "github.com/linkall-labs/vanus/internal/kv/etcd"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/memberinterceptor"
+	"github.com/linkall-labs/vanus/internal/primitive/profiling"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/health"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	ctrlpbv2 "github.com/linkall-labs/vanus/proto/pkg/v2/controller"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a controller node, it runs an embedded etcd member and the controllers of snowflake,
+// eventbus and trigger, which store their metadata in the etcd. It's started by cmd/controller and
+// by the standalone binary, which runs it in the same process with the other components, so the
+// process level setup, e.g. flags, observability and signals, is left to the callers.
+type Server struct {
+	cfg        *Config
+	grpcServer *grpc.Server
+	// exit stops the controllers and the etcd member started by Start.
+	exit       func()
+	stopNotify chan struct{}
+	wg         sync.WaitGroup
+}
+
+func NewServer(cfg *Config) *Server {
+	return &Server{
+		cfg:        cfg,
+		stopNotify: make(chan struct{}),
+	}
+}
+
+// Start starts the controller node, it returns after the id generator of node is initialized.
+func (s *Server) Start(ctx context.Context) error {
+	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", s.cfg.Port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	etcd := embedetcd.New(s.cfg.Topology)
+	if err = etcd.Init(ctx, s.cfg.GetEtcdConfig()); err != nil {
+		return fmt.Errorf("failed to init etcd: %w", err)
+	}
+	registerHealthChecks(s.cfg, etcd)
+
+	// TODO wait server ready
+	snowflakeCtrl := snowflake.NewSnowflakeController(s.cfg.GetSnowflakeConfig(), etcd)
+	if err = snowflakeCtrl.Start(ctx); err != nil {
+		return fmt.Errorf("start Snowflake Controller failed: %w", err)
+	}
+
+	segmentCtrl := eventbus.NewController(s.cfg.GetEventbusCtrlConfig(), etcd)
+	if err = segmentCtrl.Start(ctx); err != nil {
+		return fmt.Errorf("start EventbusService Controller failed: %w", err)
+	}
+
+	//trigger controller
+	triggerCtrlStv := trigger.NewController(s.cfg.GetTriggerConfig(), s.cfg.GetControllerAddrs(), etcd)
+	if err = triggerCtrlStv.Start(); err != nil {
+		return fmt.Errorf("start trigger controller fail: %w", err)
+	}
+
+	etcdStopCh, err := etcd.Start(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start etcd: %w", err)
+	}
+
+	recoveryOpt := recovery.WithRecoveryHandlerContext(
+		func(ctx context.Context, p interface{}) error {
+			log.Error(ctx, "goroutine panicked", map[string]interface{}{
+				log.KeyError: fmt.Sprintf("%v", p),
+				"stack":      string(debug.Stack()),
+			})
+			return status.Errorf(codes.Internal, "%v", p)
+		},
+	)
+
+	s.grpcServer = grpc.NewServer(
+		grpc.ChainStreamInterceptor(
+			errinterceptor.StreamServerInterceptor(),
+			recovery.StreamServerInterceptor(recoveryOpt),
+			memberinterceptor.StreamServerInterceptor(etcd),
+			otelgrpc.StreamServerInterceptor(),
+		),
+		grpc.ChainUnaryInterceptor(
+			errinterceptor.UnaryServerInterceptor(),
+			recovery.UnaryServerInterceptor(recoveryOpt),
+			memberinterceptor.UnaryServerInterceptor(etcd),
+			otelgrpc.UnaryServerInterceptor(),
+		),
+	)
+
+	// for debug in developing stage
+	if s.cfg.GRPCReflectionEnable {
+		reflection.Register(s.grpcServer)
+	}
+
+	ctrlpb.RegisterSnowflakeControllerServer(s.grpcServer, snowflakeCtrl)
+	ctrlpb.RegisterEventBusControllerServer(s.grpcServer, segmentCtrl)
+	ctrlpb.RegisterEventLogControllerServer(s.grpcServer, segmentCtrl)
+	ctrlpb.RegisterSegmentControllerServer(s.grpcServer, segmentCtrl)
+	ctrlpb.RegisterPingServerServer(s.grpcServer, segmentCtrl)
+	ctrlpb.RegisterTriggerControllerServer(s.grpcServer, triggerCtrlStv)
+	ctrlpb.RegisterProfilingServerServer(s.grpcServer, profiling.NewServer())
+	ctrlpbv2.RegisterSnowflakeControllerServer(s.grpcServer, snowflakeCtrl)
+	ctrlpbv2.RegisterEventBusControllerServer(s.grpcServer, segmentCtrl)
+	ctrlpbv2.RegisterEventLogControllerServer(s.grpcServer, segmentCtrl)
+	ctrlpbv2.RegisterSegmentControllerServer(s.grpcServer, segmentControllerV2{segmentCtrl})
+	ctrlpbv2.RegisterPingServerServer(s.grpcServer, segmentCtrl)
+	ctrlpbv2.RegisterTriggerControllerServer(s.grpcServer, triggerControllerV2{triggerCtrlStv})
+	health.RegisterGRPC(s.grpcServer)
+	log.Info(ctx, "the grpc server ready to work", nil)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := s.grpcServer.Serve(listen); err != nil {
+			log.Error(ctx, "grpc server occurred an error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+
+	s.exit = func() {
+		vanus.DestroySnowflake()
+		snowflakeCtrl.Stop()
+		triggerCtrlStv.Stop(ctx)
+		segmentCtrl.Stop()
+		etcd.Stop(ctx)
+		s.grpcServer.GracefulStop()
+	}
+	go func() {
+		select {
+		case <-etcdStopCh:
+			log.Info(ctx, "received etcd ready to stop, preparing exit", nil)
+		case <-segmentCtrl.StopNotify():
+			log.Info(ctx, "received segment controller ready to stop, preparing exit", nil)
+		case <-ctx.Done():
+			return
+		}
+		close(s.stopNotify)
+	}()
+
+	if err = vanus.InitSnowflake(ctx, s.cfg.GetControllerAddrs(),
+		vanus.NewNode(vanus.ControllerService, s.cfg.NodeID)); err != nil {
+		return fmt.Errorf("failed to init id generator: %w", err)
+	}
+	return nil
+}
+
+// StopNotify is closed when the etcd member or the eventbus controller stopped itself.
+func (s *Server) StopNotify() <-chan struct{} {
+	return s.stopNotify
+}
+
+func (s *Server) Stop() {
+	if s.exit != nil {
+		s.exit()
+	}
+	s.wg.Wait()
+}
+
+func registerHealthChecks(cfg *Config, member embedetcd.Member) {
+	etcdCheck, err := etcdkv.NewHealthCheck(cfg.EtcdEndpoints, cfg.MetadataConfig.KeyPrefix)
+	if err != nil {
+		log.Warning(context.Background(), "failed to create health check of etcd", map[string]interface{}{
+			log.KeyError: err,
+		})
+	} else {
+		health.AddReadinessCheck("etcd", etcdCheck)
+	}
+	health.AddReadinessCheck("member", health.Condition(member.IsReady))
+	health.AddReadinessCheck("leader", health.Condition(func() bool {
+		return member.GetLeaderAddr() != ""
+	}))
+}
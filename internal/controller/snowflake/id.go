@@ -0,0 +1,124 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"context"
+	stderr "errors"
+	"fmt"
+	"strconv"
+
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	idEpochKey       = "/vanus/internal/cluster/id_epoch"
+	maxAllocateCount = 10000
+	// maxIncreaseEpochRetries is the max times to increase the epoch again after it's changed by
+	// another leader concurrently.
+	maxIncreaseEpochRetries = 5
+)
+
+// AllocateIDs leases the IDs of the current epoch. The epoch is increased and persisted before the
+// first allocation of each leader, so a new leader never allocates the IDs allocated by the previous
+// ones, even if it doesn't know where they stopped.
+func (sf *snowflake) AllocateIDs(
+	ctx context.Context, in *ctrlpb.AllocateIDsRequest,
+) (*ctrlpb.AllocateIDsResponse, error) {
+	if in.Count == 0 || in.Count > maxAllocateCount {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the count of ids must be in [1, %d]", maxAllocateCount))
+	}
+
+	sf.mutex.Lock()
+	defer sf.mutex.Unlock()
+
+	if !sf.isLeader {
+		return nil, errors.ErrNotLeader
+	}
+	count := uint64(in.Count)
+	if sf.idEpoch == 0 || sf.idSequence+count-1 > vanus.MaxIDSequence {
+		if err := sf.increaseIDEpoch(ctx); err != nil {
+			return nil, err
+		}
+	}
+	start := vanus.NewEpochID(sf.idEpoch, sf.idSequence)
+	sf.idSequence += count
+	return &ctrlpb.AllocateIDsResponse{
+		Epoch: sf.idEpoch,
+		Start: start.Uint64(),
+		Count: in.Count,
+	}, nil
+}
+
+// increaseIDEpoch increases the persisted epoch by a compare-and-swap, so two controllers which both
+// think they're the leader never get the same epoch.
+func (sf *snowflake) increaseIDEpoch(ctx context.Context) error {
+	for i := 0; ; i++ {
+		epoch, err := sf.tryIncreaseIDEpoch(ctx)
+		if err == nil {
+			sf.idEpoch = epoch
+			break
+		}
+		if !stderr.Is(err, kv.ErrNodeExist) && !stderr.Is(err, kv.ErrSetFailed) {
+			return err
+		}
+		if i >= maxIncreaseEpochRetries {
+			return errors.ErrInternal.WithMessage("the epoch of ids is changed concurrently").Wrap(err)
+		}
+		log.Warning(ctx, "the epoch of ids is changed concurrently, retry", map[string]interface{}{
+			"retries": i + 1,
+		})
+	}
+	sf.idSequence = 0
+	log.Info(ctx, "the epoch of ids increased", map[string]interface{}{
+		"epoch": sf.idEpoch,
+	})
+	return nil
+}
+
+// tryIncreaseIDEpoch returns kv.ErrNodeExist or kv.ErrSetFailed if the epoch is changed after it's read.
+func (sf *snowflake) tryIncreaseIDEpoch(ctx context.Context) (uint64, error) {
+	var epoch uint64
+	data, err := sf.kvStore.Get(ctx, idEpochKey)
+	if err == nil {
+		if epoch, err = strconv.ParseUint(string(data), 10, 64); err != nil {
+			return 0, errors.ErrInternal.Wrap(err)
+		}
+	} else if !stderr.Is(err, kv.ErrKeyNotFound) {
+		return 0, errors.ErrInternal.Wrap(err)
+	}
+	epoch++
+	if epoch > vanus.MaxIDEpoch {
+		return 0, errors.ErrInternal.WithMessage("the epoch of ids is exhausted")
+	}
+	value := []byte(strconv.FormatUint(epoch, 10))
+	if data == nil {
+		err = sf.kvStore.Create(ctx, idEpochKey, value)
+	} else {
+		err = sf.kvStore.CompareAndSwap(ctx, idEpochKey, data, value)
+	}
+	if err != nil {
+		if stderr.Is(err, kv.ErrNodeExist) || stderr.Is(err, kv.ErrSetFailed) {
+			return 0, err
+		}
+		return 0, errors.ErrInternal.Wrap(err)
+	}
+	return epoch, nil
+}
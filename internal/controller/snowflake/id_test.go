@@ -0,0 +1,105 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snowflake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSnowflake_AllocateIDs(t *testing.T) {
+	Convey("test allocate ids", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		sf := &snowflake{kvStore: kvCli, isLeader: true}
+
+		Convey("test allocate ids with invalid request", func() {
+			_, err := sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: maxAllocateCount + 1})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test allocate ids by the follower", func() {
+			sf.isLeader = false
+			_, err := sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 1})
+			So(errors.Is(err, errors.ErrNotLeader), ShouldBeTrue)
+		})
+
+		Convey("test allocate ids in epochs", func() {
+			kvCli.EXPECT().Get(ctx, idEpochKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			kvCli.EXPECT().Create(ctx, idEpochKey, []byte("1")).Times(1).Return(nil)
+			res, err := sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 10})
+			So(err, ShouldBeNil)
+			So(res.Epoch, ShouldEqual, 1)
+			So(res.Start, ShouldEqual, vanus.NewEpochID(1, 0).Uint64())
+			So(res.Count, ShouldEqual, 10)
+
+			res, err = sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 10})
+			So(err, ShouldBeNil)
+			So(res.Start, ShouldEqual, vanus.NewEpochID(1, 10).Uint64())
+
+			// the new leader increases the persisted epoch.
+			sf.idEpoch = 0
+			kvCli.EXPECT().Get(ctx, idEpochKey).Times(1).Return([]byte("1"), nil)
+			kvCli.EXPECT().CompareAndSwap(ctx, idEpochKey, []byte("1"), []byte("2")).Times(1).Return(nil)
+			res, err = sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 10})
+			So(err, ShouldBeNil)
+			So(res.Epoch, ShouldEqual, 2)
+			So(res.Start, ShouldBeGreaterThan, vanus.NewEpochID(1, vanus.MaxIDSequence).Uint64())
+
+			// the epoch is increased if the sequences are exhausted.
+			sf.idSequence = vanus.MaxIDSequence - 5
+			kvCli.EXPECT().Get(ctx, idEpochKey).Times(1).Return([]byte("2"), nil)
+			kvCli.EXPECT().CompareAndSwap(ctx, idEpochKey, []byte("2"), []byte("3")).Times(1).Return(nil)
+			res, err = sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 10})
+			So(err, ShouldBeNil)
+			So(res.Start, ShouldEqual, vanus.NewEpochID(3, 0).Uint64())
+		})
+
+		Convey("test increase epoch concurrently", func() {
+			// another controller increases the epoch after it's read.
+			gomock.InOrder(
+				kvCli.EXPECT().Get(ctx, idEpochKey).Return(nil, kv.ErrKeyNotFound),
+				kvCli.EXPECT().Create(ctx, idEpochKey, []byte("1")).Return(kv.ErrNodeExist),
+				kvCli.EXPECT().Get(ctx, idEpochKey).Return([]byte("1"), nil),
+				kvCli.EXPECT().CompareAndSwap(ctx, idEpochKey, []byte("1"), []byte("2")).Return(kv.ErrSetFailed),
+				kvCli.EXPECT().Get(ctx, idEpochKey).Return([]byte("2"), nil),
+				kvCli.EXPECT().CompareAndSwap(ctx, idEpochKey, []byte("2"), []byte("3")).Return(nil),
+			)
+			res, err := sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 10})
+			So(err, ShouldBeNil)
+			So(res.Epoch, ShouldEqual, 3)
+
+			// give up if the epoch keeps being changed.
+			sf.idEpoch = 0
+			kvCli.EXPECT().Get(ctx, idEpochKey).Times(maxIncreaseEpochRetries+1).Return([]byte("3"), nil)
+			kvCli.EXPECT().CompareAndSwap(ctx, idEpochKey, []byte("3"), []byte("4")).
+				Times(maxIncreaseEpochRetries + 1).Return(kv.ErrSetFailed)
+			_, err = sf.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: 10})
+			So(errors.Is(err, errors.ErrInternal), ShouldBeTrue)
+			So(sf.idEpoch, ShouldEqual, 0)
+		})
+	})
+}
@@ -63,6 +63,9 @@ type snowflake struct {
 	nodes    map[uint16]*node
 	mutex    sync.RWMutex
 	r        *rand.Rand
+	// the epoch of ids allocated by the leader, 0 means it hasn't been increased after becoming leader.
+	idEpoch    uint64
+	idSequence uint64
 }
 
 type node struct {
@@ -214,6 +217,7 @@ func (sf *snowflake) membershipChangedProcessor(ctx context.Context, event embed
 		}
 		sf.isLeader = false
 		sf.nodes = nil
+		sf.idEpoch = 0
 	}
 	return nil
 }
@@ -0,0 +1,48 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// authorizeSubscribe checks whether the principal carried in the incoming metadata is allowed to
+// subscribe the eventbus, the ACL entries are saved by eventbus controller.
+func (ctrl *controller) authorizeSubscribe(ctx context.Context, eventbus string) error {
+	return ctrl.authorize(ctx, eventbus, acl.PermissionSubscribe)
+}
+
+func (ctrl *controller) authorize(ctx context.Context, eventbus string, permission acl.Permission) error {
+	if !ctrl.config.ACL.Enable || strings.HasPrefix(eventbus, primitive.SystemEventbusNamePrefix) {
+		return nil
+	}
+	res, err := ctrl.cl.EventbusService().RawClient().ListEventbusACL(ctx,
+		&ctrlpb.ListEventbusACLRequest{Eventbus: eventbus})
+	if err != nil {
+		return err
+	}
+	principal := acl.PrincipalFromContext(ctx)
+	if !acl.NewPolicy(res).Allowed(principal, permission) {
+		return errors.ErrPermissionDenied.WithMessage(
+			"the principal " + principal + " isn't allowed to " + string(permission) + " the eventbus " + eventbus)
+	}
+	return nil
+}
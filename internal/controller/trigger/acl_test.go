@@ -0,0 +1,89 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	grpcmd "google.golang.org/grpc/metadata"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_AuthorizeSubscribe(t *testing.T) {
+	Convey("test authorize subscribe", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{ACL: acl.Config{Enable: true}}, nil, nil)
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		cl := cluster.NewMockCluster(mockCtrl)
+		ctrl.cl = cl
+		ebService := cluster.NewMockEventbusService(mockCtrl)
+		ebClient := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+		cl.EXPECT().EventbusService().AnyTimes().Return(ebService)
+		ebService.EXPECT().RawClient().AnyTimes().Return(ebClient)
+		ctrl.state = primitive.ServerStateRunning
+		ebClient.EXPECT().ListEventbusACL(gomock.Any(), &ctrlpb.ListEventbusACLRequest{Eventbus: "test-bus"}).
+			AnyTimes().Return(&ctrlpb.ListEventbusACLResponse{
+			Acls: []*ctrlpb.EventbusACL{{Principal: "alice", Permissions: []string{"subscribe"}}},
+		}, nil)
+		withPrincipal := func(principal string) context.Context {
+			md, _ := grpcmd.FromOutgoingContext(acl.WithPrincipal(context.Background(), principal))
+			return grpcmd.NewIncomingContext(context.Background(), md)
+		}
+		create := &ctrlpb.CreateSubscriptionRequest{
+			Subscription: &ctrlpb.SubscriptionRequest{
+				EventBus: "test-bus",
+				Sink:     "test-sink",
+			},
+		}
+
+		Convey("test create subscription", func() {
+			_, err := ctrl.CreateSubscription(withPrincipal("bob"), create)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+
+			vanus.InitFakeSnowflake()
+			subManager.EXPECT().AddSubscription(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			_, err = ctrl.CreateSubscription(withPrincipal("alice"), create)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test delete subscription", func() {
+			subID := vanus.NewTestID()
+			subManager.EXPECT().GetSubscription(gomock.Any(), subID).Times(1).Return(&metadata.Subscription{
+				ID:       subID,
+				EventBus: "test-bus",
+			})
+			_, err := ctrl.DeleteSubscription(context.Background(),
+				&ctrlpb.DeleteSubscriptionRequest{Id: subID.Uint64()})
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+	})
+}
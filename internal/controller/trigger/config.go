@@ -15,7 +15,10 @@
 package trigger
 
 import (
+	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
 )
 
 type Config struct {
@@ -23,4 +26,11 @@ type Config struct {
 	Storage primitive.KvStorageConfig
 
 	SecretEncryptionSalt string
+	// SecretKMS is the external KMS to encrypt the secrets, they are encrypted by
+	// SecretEncryptionSalt if it isn't configured.
+	SecretKMS secret.KMSConfig
+	// ACL enforces the subscribe permission of the eventbus of subscriptions.
+	ACL acl.Config
+	// Rebalance moves the subscriptions between trigger workers by their load.
+	Rebalance worker.RebalanceConfig
 }
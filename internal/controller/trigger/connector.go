@@ -0,0 +1,245 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	stdErr "errors"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/validation"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (ctrl *controller) PutConnector(ctx context.Context,
+	request *ctrlpb.Connector) (*ctrlpb.Connector, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if err := validation.ValidateConnector(ctx, request); err != nil {
+		return nil, err
+	}
+	permission := acl.PermissionSubscribe
+	if request.Kind == metadata.ConnectorKindSource {
+		permission = acl.PermissionPublish
+	}
+	if err := ctrl.authorize(ctx, request.Eventbus, permission); err != nil {
+		return nil, err
+	}
+	c := convert.FromPbConnector(request)
+	c.UpdatedAt = time.Now()
+	curr, err := ctrl.getConnector(ctx, c.Name)
+	switch {
+	case err == nil:
+		if curr.Kind != c.Kind {
+			return nil, errors.ErrInvalidRequest.WithMessage("connector kind can not be changed")
+		}
+		c.Version = curr.Version + 1
+		c.CreatedAt = curr.CreatedAt
+	case errors.Is(err, errors.ErrResourceNotFound):
+		c.Version = 1
+		c.CreatedAt = c.UpdatedAt
+	default:
+		return nil, err
+	}
+	if err = ctrl.storage.SaveConnector(ctx, c); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "put connector", map[string]interface{}{
+		"connector": c.Name,
+		"version":   c.Version,
+		"replicas":  c.Replicas,
+	})
+	return convert.ToPbConnector(c), nil
+}
+
+func (ctrl *controller) GetConnector(ctx context.Context,
+	request *ctrlpb.GetConnectorRequest) (*ctrlpb.Connector, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	c, err := ctrl.getConnector(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToPbConnector(c), nil
+}
+
+func (ctrl *controller) ListConnector(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ListConnectorResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	list, err := ctrl.storage.ListConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ctrlpb.ListConnectorResponse{}
+	for _, c := range list {
+		resp.Connectors = append(resp.Connectors, convert.ToPbConnector(c))
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) DeleteConnector(ctx context.Context,
+	request *ctrlpb.DeleteConnectorRequest) (*emptypb.Empty, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if _, err := ctrl.getConnector(ctx, request.Name); err != nil {
+		return nil, err
+	}
+	if err := ctrl.storage.DeleteConnector(ctx, request.Name); err != nil {
+		return nil, err
+	}
+	if err := ctrl.storage.DeleteConnectorPosition(ctx, request.Name); err != nil {
+		log.Warning(ctx, "delete connector position error", map[string]interface{}{
+			log.KeyError: err,
+			"connector":  request.Name,
+		})
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListWorkerConnector returns the connector instances assigned to the trigger worker, the worker polls it
+// with the plugins installed on it. The instances are assigned by rendezvous hashing over the running
+// workers which have installed the plugin, so an instance moves only when its worker leaves or joins.
+func (ctrl *controller) ListWorkerConnector(ctx context.Context,
+	request *ctrlpb.ListWorkerConnectorRequest) (*ctrlpb.ListWorkerConnectorResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if request.Address == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("address is empty")
+	}
+	workers := ctrl.connectorWorkers(request.Address, request.Plugins)
+	list, err := ctrl.storage.ListConnector(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ctrlpb.ListWorkerConnectorResponse{}
+	for _, c := range list {
+		var candidates []string
+		for addr, plugins := range workers {
+			if plugins[c.Plugin] {
+				candidates = append(candidates, addr)
+			}
+		}
+		var positions map[uint32][]byte
+		for i := uint32(0); i < c.Replicas; i++ {
+			if assignConnectorInstance(candidates, c.Name, i) != request.Address {
+				continue
+			}
+			if c.Kind == metadata.ConnectorKindSource && positions == nil {
+				if positions, err = ctrl.storage.ListConnectorPosition(ctx, c.Name); err != nil {
+					return nil, err
+				}
+			}
+			resp.Instances = append(resp.Instances, &ctrlpb.ConnectorInstance{
+				Connector: convert.ToPbConnector(c),
+				Instance:  i,
+				Position:  positions[i],
+			})
+		}
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) CommitConnectorPosition(ctx context.Context,
+	request *ctrlpb.CommitConnectorPositionRequest) (*emptypb.Empty, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	c, err := ctrl.getConnector(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if c.Kind != metadata.ConnectorKindSource {
+		return nil, errors.ErrInvalidRequest.WithMessage("only the source connector has position")
+	}
+	if request.Instance >= c.Replicas {
+		return nil, errors.ErrInvalidRequest.WithMessage("connector instance is out of replicas")
+	}
+	if err = ctrl.storage.SaveConnectorPosition(ctx, request.Name, request.Instance, request.Position); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) getConnector(ctx context.Context, name string) (*metadata.Connector, error) {
+	c, err := ctrl.storage.GetConnector(ctx, name)
+	if err != nil {
+		if stdErr.Is(err, kv.ErrKeyNotFound) {
+			return nil, errors.ErrResourceNotFound.WithMessage("connector not exist")
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+// connectorWorkers records the plugins reported by the trigger worker and returns the plugins of the
+// running workers, the reports of the workers which aren't running are dropped.
+func (ctrl *controller) connectorWorkers(addr string, plugins []string) map[string]map[string]bool {
+	running := map[string]bool{}
+	for _, tWorker := range ctrl.workerManager.GetActiveRunningTriggerWorker() {
+		running[tWorker.Addr] = true
+	}
+	ctrl.connectorMutex.Lock()
+	defer ctrl.connectorMutex.Unlock()
+	reported := make(map[string]bool, len(plugins))
+	for _, p := range plugins {
+		reported[p] = true
+	}
+	ctrl.connectorPlugins[addr] = reported
+	workers := make(map[string]map[string]bool, len(running))
+	for k, v := range ctrl.connectorPlugins {
+		if !running[k] {
+			if k != addr {
+				delete(ctrl.connectorPlugins, k)
+			}
+			continue
+		}
+		workers[k] = v
+	}
+	return workers
+}
+
+// assignConnectorInstance returns the worker with the highest hash of the instance, or empty if there isn't
+// any candidate.
+func assignConnectorInstance(candidates []string, name string, instance uint32) string {
+	var (
+		assigned string
+		highest  uint64
+	)
+	key := name + "/" + strconv.FormatUint(uint64(instance), 10)
+	for _, addr := range candidates {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(addr))
+		_, _ = h.Write([]byte(key))
+		if v := h.Sum64(); assigned == "" || v > highest || (v == highest && addr < assigned) {
+			assigned, highest = addr, v
+		}
+	}
+	return assigned
+}
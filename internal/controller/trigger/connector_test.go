@@ -0,0 +1,142 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestController_Connector(t *testing.T) {
+	Convey("test connector", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		ctrl.storage = storage.NewFakeStorage()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		ctrl.state = primitive.ServerStateRunning
+		req := &ctrlpb.Connector{
+			Name:     "pg",
+			Kind:     metadata.ConnectorKindSource,
+			Plugin:   "postgres-cdc",
+			Eventbus: "orders",
+			Config:   map[string]string{"dsn": "postgres://localhost/db"},
+			Replicas: 2,
+		}
+
+		Convey("test put invalid connector", func() {
+			req.Name = "a/b"
+			_, err := ctrl.PutConnector(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			req.Name = "pg"
+			req.Kind = "unknown"
+			_, err = ctrl.PutConnector(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test put, get, list and delete connector", func() {
+			c, err := ctrl.PutConnector(ctx, req)
+			So(err, ShouldBeNil)
+			So(c.Version, ShouldEqual, 1)
+			req.Replicas = 3
+			c, err = ctrl.PutConnector(ctx, req)
+			So(err, ShouldBeNil)
+			So(c.Version, ShouldEqual, 2)
+			So(c.Replicas, ShouldEqual, 3)
+
+			req.Kind = metadata.ConnectorKindSink
+			_, err = ctrl.PutConnector(ctx, req)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			c, err = ctrl.GetConnector(ctx, &ctrlpb.GetConnectorRequest{Name: "pg"})
+			So(err, ShouldBeNil)
+			So(c.Config["dsn"], ShouldEqual, "postgres://localhost/db")
+			list, err := ctrl.ListConnector(ctx, &emptypb.Empty{})
+			So(err, ShouldBeNil)
+			So(list.Connectors, ShouldHaveLength, 1)
+
+			_, err = ctrl.DeleteConnector(ctx, &ctrlpb.DeleteConnectorRequest{Name: "pg"})
+			So(err, ShouldBeNil)
+			_, err = ctrl.GetConnector(ctx, &ctrlpb.GetConnectorRequest{Name: "pg"})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+
+		Convey("test assign connector instances", func() {
+			_, err := ctrl.PutConnector(ctx, req)
+			So(err, ShouldBeNil)
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().AnyTimes().Return([]metadata.TriggerWorkerInfo{
+				{Addr: "w1"}, {Addr: "w2"}, {Addr: "w3"},
+			})
+			_, err = ctrl.CommitConnectorPosition(ctx, &ctrlpb.CommitConnectorPositionRequest{
+				Name: "pg", Instance: 2, Position: []byte("lsn"),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = ctrl.CommitConnectorPosition(ctx, &ctrlpb.CommitConnectorPositionRequest{
+				Name: "pg", Instance: 1, Position: []byte("lsn"),
+			})
+			So(err, ShouldBeNil)
+
+			list := func(addr string, plugins ...string) []*ctrlpb.ConnectorInstance {
+				res, err := ctrl.ListWorkerConnector(ctx, &ctrlpb.ListWorkerConnectorRequest{
+					Address: addr, Plugins: plugins,
+				})
+				So(err, ShouldBeNil)
+				return res.Instances
+			}
+			So(list("w3"), ShouldBeEmpty)
+			list("w1", "postgres-cdc")
+			list("w2", "postgres-cdc")
+			instances := append(list("w1", "postgres-cdc"), list("w2", "postgres-cdc")...)
+			So(instances, ShouldHaveLength, 2)
+			for _, ins := range instances {
+				if ins.Instance == 1 {
+					So(ins.Position, ShouldResemble, []byte("lsn"))
+				} else {
+					So(ins.Position, ShouldBeNil)
+				}
+			}
+			So(list("w3"), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestAssignConnectorInstance(t *testing.T) {
+	Convey("test assign connector instance", t, func() {
+		So(assignConnectorInstance(nil, "pg", 0), ShouldEqual, "")
+		candidates := []string{"w1", "w2", "w3"}
+		assigned := assignConnectorInstance(candidates, "pg", 0)
+		So(assignConnectorInstance([]string{"w3", "w2", "w1"}, "pg", 0), ShouldEqual, assigned)
+		// removing another worker doesn't move the instance.
+		var rest []string
+		for _, addr := range candidates {
+			if addr != assigned {
+				rest = append(rest, addr)
+			}
+		}
+		So(assignConnectorInstance(append([]string{assigned}, rest[0]), "pg", 0), ShouldEqual, assigned)
+	})
+}
@@ -19,10 +19,12 @@ import (
 	stdErr "errors"
 	"io"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
 	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/lag"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
@@ -31,6 +33,8 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
 	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
@@ -40,6 +44,7 @@ import (
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -49,6 +54,7 @@ var (
 
 const (
 	defaultGcSubscriptionInterval = time.Second * 10
+	defaultLagInterval            = time.Second * 30
 )
 
 func NewController(config Config, controllerAddr []string, member embedetcd.Member) *controller {
@@ -56,9 +62,12 @@ func NewController(config Config, controllerAddr []string, member embedetcd.Memb
 		config:                config,
 		member:                member,
 		needCleanSubscription: map[vanus.ID]string{},
+		secretWatchers:        map[chan *ctrlpb.SecretEvent]struct{}{},
+		connectorPlugins:      map[string]map[string]bool{},
 		state:                 primitive.ServerStateCreated,
 		cl:                    cluster.NewClusterController(controllerAddr, insecure.NewCredentials()),
 	}
+	ctrl.lagCalculator = lag.NewCalculator(ctrl.cl)
 	ctrl.ctx, ctrl.stopFunc = context.WithCancel(context.Background())
 	return ctrl
 }
@@ -79,6 +88,12 @@ type controller struct {
 	stopFunc              context.CancelFunc
 	state                 primitive.ServerState
 	cl                    cluster.Cluster
+	lagCalculator         lag.Calculator
+	secretWatchers        map[chan *ctrlpb.SecretEvent]struct{}
+	secretMutex           sync.Mutex
+	// connectorPlugins is the plugins reported by trigger workers, keyed by the worker address.
+	connectorPlugins map[string]map[string]bool
+	connectorMutex   sync.Mutex
 }
 
 func (ctrl *controller) CommitOffset(ctx context.Context,
@@ -144,6 +159,12 @@ func (ctrl *controller) CreateSubscription(ctx context.Context,
 		})
 		return nil, err
 	}
+	if err = ctrl.validateSinkSecret(ctx, request.Subscription); err != nil {
+		return nil, err
+	}
+	if err = ctrl.authorizeSubscribe(ctx, request.Subscription.EventBus); err != nil {
+		return nil, err
+	}
 	sub := convert.FromPbSubscriptionRequest(request.Subscription)
 	sub.ID, err = vanus.NewID()
 	sub.CreatedAt = time.Now()
@@ -171,9 +192,15 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	if sub == nil {
 		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
 	}
+	if err := ctrl.authorizeSubscribe(ctx, sub.EventBus); err != nil {
+		return nil, err
+	}
 	if err := validation.ValidateSubscriptionRequest(ctx, request.Subscription); err != nil {
 		return nil, err
 	}
+	if err := ctrl.validateSinkSecret(ctx, request.Subscription); err != nil {
+		return nil, err
+	}
 	if request.Subscription.EventBus != sub.EventBus {
 		return nil, errors.ErrInvalidRequest.WithMessage("can not change eventbus")
 	}
@@ -189,6 +216,7 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	} else if sub.Transformer.Exist() && !update.Transformer.Exist() {
 		transChange = -1
 	}
+	paused := !sub.Disable && update.Disable
 	change := sub.Update(update)
 	if !change {
 		return nil, errors.ErrInvalidRequest.WithMessage("no change")
@@ -201,6 +229,13 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	if transChange != 0 {
 		metrics.SubscriptionTransformerGauge.WithLabelValues(sub.EventBus).Add(float64(transChange))
 	}
+	if paused {
+		opsevent.Emit(ctx, opsevent.TypeSubscriptionPaused, &opsevent.SubscriptionPaused{
+			SubscriptionID: sub.ID.String(),
+			Name:           sub.Name,
+			Eventbus:       sub.EventBus,
+		})
+	}
 	ctrl.scheduler.EnqueueNormalSubscription(sub.ID)
 	resp := convert.ToPbSubscription(sub, nil)
 	return resp, nil
@@ -214,6 +249,9 @@ func (ctrl *controller) DeleteSubscription(ctx context.Context,
 	subID := vanus.ID(request.Id)
 	sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
 	if sub != nil {
+		if err := ctrl.authorizeSubscribe(ctx, sub.EventBus); err != nil {
+			return nil, err
+		}
 		sub.Phase = metadata.SubscriptionPhaseToDelete
 		err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub)
 		if err != nil {
@@ -242,9 +280,40 @@ func (ctrl *controller) GetSubscription(ctx context.Context,
 	}
 	offsets, _ := ctrl.subscriptionManager.GetOffset(ctx, sub.ID)
 	resp := convert.ToPbSubscription(sub, offsets)
+	subLag, err := ctrl.lagCalculator.Calculate(ctx, sub.EventBus, offsets)
+	if err != nil {
+		log.Warning(ctx, "calculate subscription lag error", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: sub.ID,
+		})
+	} else {
+		resp.LagEvents = subLag.Events
+		resp.LagTime = subLag.Time.Milliseconds()
+	}
+	if load, ok := ctrl.getSubscriptionLoad(sub); ok {
+		resp.DeliveryLatencyP50 = load.DeliveryLatencyP50.Milliseconds()
+		resp.DeliveryLatencyP99 = load.DeliveryLatencyP99.Milliseconds()
+	}
 	return resp, nil
 }
 
+// getSubscriptionLoad returns the load which the trigger worker of the subscription reported lastly.
+func (ctrl *controller) getSubscriptionLoad(sub *metadata.Subscription) (metadata.SubscriptionLoad, bool) {
+	if sub.TriggerWorker == "" {
+		return metadata.SubscriptionLoad{}, false
+	}
+	tWorker := ctrl.workerManager.GetTriggerWorker(sub.TriggerWorker)
+	if tWorker == nil {
+		return metadata.SubscriptionLoad{}, false
+	}
+	for _, load := range tWorker.GetSubscriptionLoad() {
+		if load.SubscriptionID == sub.ID {
+			return load, true
+		}
+	}
+	return metadata.SubscriptionLoad{}, false
+}
+
 func (ctrl *controller) TriggerWorkerHeartbeat(
 	heartbeat ctrlpb.TriggerController_TriggerWorkerHeartbeatServer) error {
 	ctx := ctrl.ctx
@@ -292,7 +361,8 @@ func (ctrl *controller) triggerWorkerHeartbeatRequest(ctx context.Context,
 			})
 		}
 	}
-	err := ctrl.workerManager.UpdateTriggerWorkerInfo(ctx, req.Address)
+	err := ctrl.workerManager.UpdateTriggerWorkerInfo(ctx, req.Address,
+		convert.FromPbSubscriptionLoads(req.SubscriptionLoad))
 	if err != nil {
 		log.Info(context.Background(), "unknown trigger worker", map[string]interface{}{
 			log.KeyTriggerWorkerAddr: req.Address,
@@ -352,20 +422,166 @@ func (ctrl *controller) ListSubscription(ctx context.Context,
 	return &ctrlpb.ListSubscriptionResponse{Subscription: list}, nil
 }
 
+func (ctrl *controller) ListTriggerWorker(ctx context.Context,
+	_ *emptypb.Empty) (*ctrlpb.ListTriggerWorkerResponse, error) {
+	tWorkers := ctrl.workerManager.ListTriggerWorker()
+	list := make([]*ctrlpb.TriggerWorkerInfo, 0, len(tWorkers))
+	for _, tWorker := range tWorkers {
+		list = append(list, convert.ToPbTriggerWorkerInfo(tWorker.GetInfo(), tWorker.GetSubscriptionLoad()))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Address < list[j].Address
+	})
+	return &ctrlpb.ListTriggerWorkerResponse{TriggerWorker: list}, nil
+}
+
 // gcSubscription before delete subscription,need
 //
 // 1.trigger worker remove subscription
 // 2.delete offset
 // 3.delete subscription .
+func (ctrl *controller) ExportSubscription(ctx context.Context,
+	request *ctrlpb.ExportSubscriptionRequest) (*ctrlpb.ExportSubscriptionResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	sub := ctrl.subscriptionManager.GetSubscription(ctx, vanus.ID(request.Id))
+	if sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	offsets, err := ctrl.subscriptionManager.GetOffset(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
+	subLag, err := ctrl.lagCalculator.Calculate(ctx, sub.EventBus, offsets)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("calculate subscription checkpoint time error").Wrap(err)
+	}
+	now := time.Now()
+	return &ctrlpb.ExportSubscriptionResponse{
+		Checkpoint: &ctrlpb.SubscriptionCheckpoint{
+			SubscriptionId: uint64(sub.ID),
+			Subscription:   convert.ToPbSubscriptionRequest(sub),
+			Offsets:        convert.ToPbOffsetInfos(offsets),
+			CheckpointTime: now.Add(-subLag.Time).UnixMilli(),
+			ExportedAt:     now.UnixMilli(),
+		},
+	}, nil
+}
+
+func (ctrl *controller) ImportSubscription(ctx context.Context,
+	request *ctrlpb.ImportSubscriptionRequest) (*meta.Subscription, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	checkpoint := request.Checkpoint
+	if checkpoint == nil || checkpoint.Subscription == nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("checkpoint subscription is empty")
+	}
+	subRequest, _ := proto.Clone(checkpoint.Subscription).(*ctrlpb.SubscriptionRequest)
+	if request.EventBus != "" {
+		subRequest.EventBus = request.EventBus
+	}
+	if request.Name != "" {
+		subRequest.Name = request.Name
+	}
+	if request.SinkCredential != nil {
+		subRequest.SinkCredential = request.SinkCredential
+	} else if subRequest.SinkCredential.GetCredentialType() != meta.SinkCredential_None {
+		// the secret of sink credential is masked when export
+		return nil, errors.ErrInvalidRequest.WithMessage("sink credential is required")
+	}
+	err := validation.ValidateSubscriptionRequest(ctx, subRequest)
+	if err != nil {
+		log.Info(ctx, "import subscription validate fail", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return nil, err
+	}
+	if err = ctrl.authorizeSubscribe(ctx, subRequest.EventBus); err != nil {
+		return nil, err
+	}
+	offsets, err := ctrl.checkpointOffsets(ctx, subRequest, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	sub := convert.FromPbSubscriptionRequest(subRequest)
+	sub.ID, err = vanus.NewID()
+	if err != nil {
+		return nil, err
+	}
+	sub.CreatedAt = time.Now()
+	sub.UpdatedAt = time.Now()
+	sub.Phase = metadata.SubscriptionPhaseCreated
+	err = ctrl.subscriptionManager.AddSubscription(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	if len(offsets) > 0 {
+		// the offsets must be saved before schedule, then trigger worker consume from them.
+		if err = ctrl.subscriptionManager.SaveOffset(ctx, sub.ID, offsets, true); err != nil {
+			_ = ctrl.subscriptionManager.DeleteSubscription(ctx, sub.ID)
+			return nil, err
+		}
+	}
+	ctrl.scheduler.EnqueueNormalSubscription(sub.ID)
+	log.Info(ctx, "import subscription", map[string]interface{}{
+		log.KeySubscriptionID: sub.ID,
+		"checkpoint_id":       vanus.ID(checkpoint.SubscriptionId),
+		"offsets":             offsets,
+	})
+	return convert.ToPbSubscription(sub, offsets), nil
+}
+
+// checkpointOffsets keep the offsets of which eventlog belongs to the eventbus of subscription. If some eventlogs
+// have no offset, for example the checkpoint is exported from another cluster, they consume from checkpoint time.
+func (ctrl *controller) checkpointOffsets(ctx context.Context, subRequest *ctrlpb.SubscriptionRequest,
+	checkpoint *ctrlpb.SubscriptionCheckpoint) (info.ListOffsetInfo, error) {
+	if len(checkpoint.Offsets) == 0 {
+		return nil, nil
+	}
+	eb, err := ctrl.cl.EventbusService().RawClient().GetEventBus(ctx, &meta.EventBus{Name: subRequest.EventBus})
+	if err != nil {
+		return nil, err
+	}
+	logs := make(map[uint64]struct{}, len(eb.Logs))
+	for _, l := range eb.Logs {
+		logs[l.EventLogId] = struct{}{}
+	}
+	var offsets info.ListOffsetInfo
+	for _, offset := range convert.FromPbOffsetInfos(checkpoint.Offsets) {
+		if _, exist := logs[offset.EventLogID.Uint64()]; exist {
+			offsets = append(offsets, offset)
+		}
+	}
+	if len(offsets) < len(logs) && checkpoint.CheckpointTime > 0 {
+		if subRequest.Config == nil {
+			subRequest.Config = &meta.SubscriptionConfig{}
+		}
+		ts := uint64(checkpoint.CheckpointTime / time.Second.Milliseconds())
+		subRequest.Config.OffsetType = meta.SubscriptionConfig_TIMESTAMP
+		subRequest.Config.OffsetTimestamp = &ts
+	}
+	return offsets, nil
+}
+
 func (ctrl *controller) gcSubscription(ctx context.Context, id vanus.ID, addr string) error {
 	tWorker := ctrl.workerManager.GetTriggerWorker(addr)
 	if tWorker != nil {
 		tWorker.UnAssignSubscription(id)
 	}
+	if sub := ctrl.subscriptionManager.GetSubscription(ctx, id); sub != nil && sub.StandbyWorker != "" {
+		if standby := ctrl.workerManager.GetTriggerWorker(sub.StandbyWorker); standby != nil {
+			standby.UnAssignStandbySubscription(id)
+		}
+	}
 	err := ctrl.subscriptionManager.DeleteSubscription(ctx, id)
 	if err != nil {
 		return err
 	}
+	labels := map[string]string{metrics.LabelSubscription: id.String()}
+	metrics.SubscriptionLagEventGauge.DeletePartialMatch(labels)
+	metrics.SubscriptionLagTimeGauge.DeletePartialMatch(labels)
 	return nil
 }
 
@@ -382,11 +598,42 @@ func (ctrl *controller) gcSubscriptions(ctx context.Context) {
 	}, defaultGcSubscriptionInterval)
 }
 
+func (ctrl *controller) updateSubscriptionLags(ctx context.Context) {
+	util.UntilWithContext(ctx, func(ctx context.Context) {
+		subscriptions := ctrl.subscriptionManager.ListSubscription(ctx)
+		for _, sub := range subscriptions {
+			offsets, err := ctrl.subscriptionManager.GetOffset(ctx, sub.ID)
+			if err != nil {
+				continue
+			}
+			subLag, err := ctrl.lagCalculator.Calculate(ctx, sub.EventBus, offsets)
+			if err != nil {
+				log.Debug(ctx, "calculate subscription lag error", map[string]interface{}{
+					log.KeyError:          err,
+					log.KeySubscriptionID: sub.ID,
+				})
+				continue
+			}
+			metrics.SubscriptionLagEventGauge.WithLabelValues(sub.EventBus, sub.ID.String()).Set(float64(subLag.Events))
+			metrics.SubscriptionLagTimeGauge.WithLabelValues(sub.EventBus, sub.ID.String()).Set(subLag.Time.Seconds())
+		}
+	}, defaultLagInterval)
+}
+
 func (ctrl *controller) requeueSubscription(ctx context.Context, id vanus.ID, addr string) error {
 	sub := ctrl.subscriptionManager.GetSubscription(ctx, id)
 	if sub == nil {
 		return nil
 	}
+	if sub.TriggerWorker != addr && sub.StandbyWorker == addr {
+		// the standby trigger worker leaves, schedule another one.
+		sub.StandbyWorker = ""
+		if err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub); err != nil {
+			return err
+		}
+		ctrl.scheduler.EnqueueSubscription(id)
+		return nil
+	}
 	if sub.TriggerWorker != addr {
 		// data is not consistent, record
 		log.Error(ctx, "requeue subscription invalid", map[string]interface{}{
@@ -396,6 +643,12 @@ func (ctrl *controller) requeueSubscription(ctx context.Context, id vanus.ID, ad
 	}
 	metrics.CtrlTriggerGauge.WithLabelValues(sub.TriggerWorker).Dec()
 	sub.TriggerWorker = ""
+	if sub.StandbyWorker != "" && ctrl.workerManager.GetTriggerWorker(sub.StandbyWorker) != nil {
+		// the standby trigger worker takes over the subscription at once.
+		sub.TriggerWorker = sub.StandbyWorker
+		metrics.CtrlTriggerGauge.WithLabelValues(sub.TriggerWorker).Inc()
+	}
+	sub.StandbyWorker = ""
 	sub.Phase = metadata.SubscriptionPhasePending
 	err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub)
 	if err != nil {
@@ -453,6 +706,7 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context,
 		ctrl.subscriptionManager.Start()
 		ctrl.scheduler.Run()
 		go ctrl.gcSubscriptions(ctx)
+		go ctrl.updateSubscriptionLags(ctx)
 		ctrl.state = primitive.ServerStateRunning
 		ctrl.isLeader = true
 	case embedetcd.EventBecomeFollower:
@@ -488,13 +742,17 @@ func (ctrl *controller) Start() error {
 		return err
 	}
 	ctrl.storage = s
-	secretStorage, err := storage.NewSecretStorage(ctrl.config.Storage, ctrl.config.SecretEncryptionSalt)
+	cipher, err := secret.NewCipher(ctrl.config.SecretKMS, ctrl.config.SecretEncryptionSalt)
+	if err != nil {
+		return err
+	}
+	secretStorage, err := storage.NewSecretStorage(ctrl.config.Storage, cipher)
 	if err != nil {
 		return err
 	}
 	ctrl.secretStorage = secretStorage
 	ctrl.subscriptionManager = subscription.NewSubscriptionManager(ctrl.storage, ctrl.secretStorage)
-	ctrl.workerManager = worker.NewTriggerWorkerManager(worker.Config{}, ctrl.storage,
+	ctrl.workerManager = worker.NewTriggerWorkerManager(worker.Config{Rebalance: ctrl.config.Rebalance}, ctrl.storage,
 		ctrl.subscriptionManager, ctrl.requeueSubscription)
 	ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
 
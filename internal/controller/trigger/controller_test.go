@@ -21,12 +21,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/linkall-labs/vanus/internal/controller/trigger/lag"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/cluster"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
@@ -380,7 +382,7 @@ func TestController_DeleteSubscription(t *testing.T) {
 				ID:            subID,
 				TriggerWorker: addr,
 			}
-			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Times(2).Return(sub)
 			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil)
 			workerManager.EXPECT().GetTriggerWorker(addr).Return(tWorker)
 			tWorker.EXPECT().UnAssignSubscription(gomock.Eq(subID)).Return()
@@ -410,6 +412,52 @@ func TestController_DeleteSubscription(t *testing.T) {
 	})
 }
 
+func TestController_RequeueSubscription(t *testing.T) {
+	Convey("test requeue subscription", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+
+		addr, standbyAddr := "test", "standby"
+		sub := &metadata.Subscription{
+			ID:            vanus.NewTestID(),
+			TriggerWorker: addr,
+			StandbyWorker: standbyAddr,
+			Phase:         metadata.SubscriptionPhaseRunning,
+		}
+		subManager.EXPECT().GetSubscription(gomock.Any(), sub.ID).AnyTimes().Return(sub)
+		subManager.EXPECT().UpdateSubscription(gomock.Any(), sub).Return(nil)
+		Convey("test standby trigger worker takes over", func() {
+			workerManager.EXPECT().GetTriggerWorker(standbyAddr).Return(worker.NewMockTriggerWorker(mockCtrl))
+			err := ctrl.requeueSubscription(ctx, sub.ID, addr)
+			So(err, ShouldBeNil)
+			So(sub.TriggerWorker, ShouldEqual, standbyAddr)
+			So(sub.StandbyWorker, ShouldBeEmpty)
+			So(sub.Phase, ShouldEqual, metadata.SubscriptionPhasePending)
+		})
+		Convey("test standby trigger worker isn't running", func() {
+			workerManager.EXPECT().GetTriggerWorker(standbyAddr).Return(nil)
+			err := ctrl.requeueSubscription(ctx, sub.ID, addr)
+			So(err, ShouldBeNil)
+			So(sub.TriggerWorker, ShouldBeEmpty)
+			So(sub.StandbyWorker, ShouldBeEmpty)
+		})
+		Convey("test standby trigger worker leaves", func() {
+			err := ctrl.requeueSubscription(ctx, sub.ID, standbyAddr)
+			So(err, ShouldBeNil)
+			So(sub.TriggerWorker, ShouldEqual, addr)
+			So(sub.StandbyWorker, ShouldBeEmpty)
+			So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseRunning)
+		})
+	})
+}
+
 func TestController_GetSubscription(t *testing.T) {
 	Convey("test get subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
@@ -421,6 +469,8 @@ func TestController_GetSubscription(t *testing.T) {
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
 		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		lagCalculator := lag.NewMockCalculator(mockCtrl)
+		ctrl.lagCalculator = lagCalculator
 
 		subID := vanus.NewTestID()
 		request := &ctrlpb.GetSubscriptionRequest{
@@ -439,10 +489,191 @@ func TestController_GetSubscription(t *testing.T) {
 			}
 			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
 			subManager.EXPECT().GetOffset(gomock.Any(), gomock.Any()).Return(info.ListOffsetInfo{}, nil)
+			lagCalculator.EXPECT().Calculate(gomock.Any(), gomock.Eq(sub.EventBus), gomock.Any()).Return(lag.Lag{
+				Events: 10,
+				Time:   time.Minute,
+			}, nil)
 			resp, err := ctrl.GetSubscription(ctx, request)
 			So(err, ShouldBeNil)
 			So(resp.EventBus, ShouldEqual, sub.EventBus)
 			So(resp.Id, ShouldEqual, sub.ID)
+			So(resp.LagEvents, ShouldEqual, 10)
+			So(resp.LagTime, ShouldEqual, time.Minute.Milliseconds())
+		})
+		Convey("get subscription with delivery latency", func() {
+			sub := &metadata.Subscription{
+				ID:            subID,
+				EventBus:      "test-bus",
+				TriggerWorker: "test-addr",
+			}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			subManager.EXPECT().GetOffset(gomock.Any(), gomock.Any()).Return(info.ListOffsetInfo{}, nil)
+			lagCalculator.EXPECT().Calculate(gomock.Any(), gomock.Eq(sub.EventBus), gomock.Any()).Return(lag.Lag{}, nil)
+			tWorker := worker.NewMockTriggerWorker(mockCtrl)
+			workerManager.EXPECT().GetTriggerWorker(gomock.Eq("test-addr")).Return(tWorker)
+			tWorker.EXPECT().GetSubscriptionLoad().Return([]metadata.SubscriptionLoad{{
+				SubscriptionID:     subID,
+				DeliveryLatencyP50: 20 * time.Millisecond,
+				DeliveryLatencyP99: time.Second,
+			}})
+			resp, err := ctrl.GetSubscription(ctx, request)
+			So(err, ShouldBeNil)
+			So(resp.DeliveryLatencyP50, ShouldEqual, 20)
+			So(resp.DeliveryLatencyP99, ShouldEqual, 1000)
+		})
+	})
+}
+
+func TestController_ExportSubscription(t *testing.T) {
+	Convey("test export subscription", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		lagCalculator := lag.NewMockCalculator(mockCtrl)
+		ctrl.lagCalculator = lagCalculator
+		ctrl.state = primitive.ServerStateRunning
+
+		subID := vanus.NewTestID()
+		request := &ctrlpb.ExportSubscriptionRequest{Id: subID.Uint64()}
+		Convey("export subscription no exist", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(nil)
+			_, err := ctrl.ExportSubscription(ctx, request)
+			So(err, ShouldNotBeNil)
+		})
+		Convey("export subscription exist", func() {
+			credentialType := primitive.AWS
+			sub := &metadata.Subscription{
+				ID:                 subID,
+				EventBus:           "test-bus",
+				Sink:               "test-sink",
+				SinkCredentialType: &credentialType,
+				SinkCredential:     primitive.NewAkSkSinkCredential("ak", "sk"),
+			}
+			offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: 10}}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			subManager.EXPECT().GetOffset(gomock.Any(), gomock.Eq(subID)).Return(offsets, nil)
+			lagCalculator.EXPECT().Calculate(gomock.Any(), gomock.Eq(sub.EventBus), gomock.Any()).Return(lag.Lag{
+				Events: 10,
+				Time:   time.Minute,
+			}, nil)
+			resp, err := ctrl.ExportSubscription(ctx, request)
+			So(err, ShouldBeNil)
+			checkpoint := resp.Checkpoint
+			So(checkpoint.SubscriptionId, ShouldEqual, subID.Uint64())
+			So(checkpoint.Subscription.EventBus, ShouldEqual, sub.EventBus)
+			So(checkpoint.Subscription.Sink, ShouldEqual, sub.Sink)
+			So(checkpoint.Subscription.SinkCredential.CredentialType, ShouldEqual, metapb.SinkCredential_AWS)
+			So(checkpoint.Subscription.SinkCredential.GetAws().GetSecretAccessKey(), ShouldEqual, primitive.SecretsMask)
+			So(checkpoint.Offsets, ShouldHaveLength, 1)
+			So(checkpoint.Offsets[0].Offset, ShouldEqual, 10)
+			So(checkpoint.ExportedAt-checkpoint.CheckpointTime, ShouldEqual, time.Minute.Milliseconds())
+		})
+	})
+}
+
+func TestController_ImportSubscription(t *testing.T) {
+	Convey("test import subscription", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		cl := cluster.NewMockCluster(mockCtrl)
+		ctrl.cl = cl
+		ebService := cluster.NewMockEventbusService(mockCtrl)
+		ebClient := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+		cl.EXPECT().EventbusService().AnyTimes().Return(ebService)
+		ebService.EXPECT().RawClient().AnyTimes().Return(ebClient)
+		ctrl.state = primitive.ServerStateRunning
+		vanus.InitFakeSnowflake()
+
+		eventlogID := vanus.NewTestID()
+		checkpointTime := time.Now().Add(-time.Hour)
+		checkpoint := &ctrlpb.SubscriptionCheckpoint{
+			SubscriptionId: vanus.NewTestID().Uint64(),
+			Subscription: &ctrlpb.SubscriptionRequest{
+				EventBus: "test-bus",
+				Sink:     "http://localhost:8080",
+				Name:     "test",
+			},
+			Offsets:        []*metapb.OffsetInfo{{EventLogId: eventlogID.Uint64(), Offset: 10}},
+			CheckpointTime: checkpointTime.UnixMilli(),
+		}
+		Convey("import empty checkpoint", func() {
+			_, err := ctrl.ImportSubscription(ctx, &ctrlpb.ImportSubscriptionRequest{})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("import without sink credential", func() {
+			checkpoint.Subscription.SinkCredential = &metapb.SinkCredential{
+				CredentialType: metapb.SinkCredential_PLAIN,
+				Credential: &metapb.SinkCredential_Plain{Plain: &metapb.PlainCredential{
+					Identifier: primitive.SecretsMask,
+					Secret:     primitive.SecretsMask,
+				}},
+			}
+			_, err := ctrl.ImportSubscription(ctx, &ctrlpb.ImportSubscriptionRequest{
+				Checkpoint: checkpoint,
+			})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("import to the same eventbus", func() {
+			ebClient.EXPECT().GetEventBus(gomock.Any(), gomock.Any()).Return(&metapb.EventBus{
+				Name: "test-bus",
+				Logs: []*metapb.EventLog{{EventLogId: eventlogID.Uint64()}},
+			}, nil)
+			subManager.EXPECT().AddSubscription(gomock.Any(), gomock.Any()).Return(nil)
+			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Any(), gomock.Any(), true).
+				DoAndReturn(func(_ context.Context, _ vanus.ID, offsets info.ListOffsetInfo, _ bool) error {
+					So(offsets, ShouldHaveLength, 1)
+					So(offsets[0].EventLogID, ShouldEqual, eventlogID)
+					So(offsets[0].Offset, ShouldEqual, 10)
+					return nil
+				})
+			resp, err := ctrl.ImportSubscription(ctx, &ctrlpb.ImportSubscriptionRequest{
+				Checkpoint: checkpoint,
+				Name:       "test-import",
+			})
+			So(err, ShouldBeNil)
+			So(resp.Name, ShouldEqual, "test-import")
+			So(resp.Id, ShouldNotEqual, checkpoint.SubscriptionId)
+			So(resp.Config.OffsetType, ShouldEqual, metapb.SubscriptionConfig_LATEST)
+			So(checkpoint.Subscription.Name, ShouldEqual, "test")
+		})
+		Convey("import to another eventbus", func() {
+			ebClient.EXPECT().GetEventBus(gomock.Any(), gomock.Any()).Return(&metapb.EventBus{
+				Name: "other-bus",
+				Logs: []*metapb.EventLog{{EventLogId: vanus.NewTestID().Uint64()}},
+			}, nil)
+			subManager.EXPECT().AddSubscription(gomock.Any(), gomock.Any()).Return(nil)
+			resp, err := ctrl.ImportSubscription(ctx, &ctrlpb.ImportSubscriptionRequest{
+				Checkpoint: checkpoint,
+				EventBus:   "other-bus",
+			})
+			So(err, ShouldBeNil)
+			So(resp.EventBus, ShouldEqual, "other-bus")
+			So(resp.Offsets, ShouldBeEmpty)
+			So(resp.Config.OffsetType, ShouldEqual, metapb.SubscriptionConfig_TIMESTAMP)
+			So(resp.Config.GetOffsetTimestamp(), ShouldEqual, checkpointTime.Unix())
+		})
+		Convey("import save offset fail", func() {
+			ebClient.EXPECT().GetEventBus(gomock.Any(), gomock.Any()).Return(&metapb.EventBus{
+				Name: "test-bus",
+				Logs: []*metapb.EventLog{{EventLogId: eventlogID.Uint64()}},
+			}, nil)
+			subManager.EXPECT().AddSubscription(gomock.Any(), gomock.Any()).Return(nil)
+			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Any(), gomock.Any(), true).Return(fmt.Errorf("test"))
+			subManager.EXPECT().DeleteSubscription(gomock.Any(), gomock.Any()).Return(nil)
+			_, err := ctrl.ImportSubscription(ctx, &ctrlpb.ImportSubscriptionRequest{
+				Checkpoint: checkpoint,
+			})
+			So(err, ShouldNotBeNil)
 		})
 	})
 }
@@ -472,6 +703,36 @@ func TestController_ListSubscription(t *testing.T) {
 	})
 }
 
+func TestController_ListTriggerWorker(t *testing.T) {
+	Convey("test list trigger worker", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		Convey("list trigger worker", func() {
+			id := vanus.NewTestID()
+			w1 := worker.NewMockTriggerWorker(mockCtrl)
+			w1.EXPECT().GetInfo().Return(metadata.TriggerWorkerInfo{Addr: "addr2", Load: 2})
+			w1.EXPECT().GetSubscriptionLoad().Return([]metadata.SubscriptionLoad{
+				{SubscriptionID: id, EventRate: 1},
+			})
+			w2 := worker.NewMockTriggerWorker(mockCtrl)
+			w2.EXPECT().GetInfo().Return(metadata.TriggerWorkerInfo{Addr: "addr1"})
+			w2.EXPECT().GetSubscriptionLoad().Return(nil)
+			workerManager.EXPECT().ListTriggerWorker().Return([]worker.TriggerWorker{w1, w2})
+			resp, err := ctrl.ListTriggerWorker(ctx, nil)
+			So(err, ShouldBeNil)
+			So(len(resp.TriggerWorker), ShouldEqual, 2)
+			So(resp.TriggerWorker[0].Address, ShouldEqual, "addr1")
+			So(resp.TriggerWorker[1].Address, ShouldEqual, "addr2")
+			So(resp.TriggerWorker[1].Load, ShouldEqual, 2)
+			So(resp.TriggerWorker[1].SubscriptionLoad[0].SubscriptionId, ShouldEqual, id.Uint64())
+		})
+	})
+}
+
 func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 	Convey("test trigger worker heartbeat", t, func() {
 		mockCtrl := gomock.NewController(t)
@@ -516,12 +777,12 @@ func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID2), request.Address, gomock.Any()).AnyTimes().Return(nil)
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID3), request.Address, gomock.Any()).AnyTimes().Return(nil)
 		Convey("heartbeat error", func() {
-			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address)).Return(fmt.Errorf("error"))
+			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address), gomock.Any()).Return(fmt.Errorf("error"))
 			err := ctrl.triggerWorkerHeartbeatRequest(ctx, request)
 			So(err, ShouldNotBeNil)
 		})
 		Convey("heartbeat success", func() {
-			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address)).Return(nil)
+			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address), gomock.Any()).Return(nil)
 			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Eq(subID1), gomock.Any(), false).Return(nil)
 			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Eq(subID2), gomock.Any(), false).Return(fmt.Errorf("error"))
 			err := ctrl.triggerWorkerHeartbeatRequest(ctx, request)
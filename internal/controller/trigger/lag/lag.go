@@ -0,0 +1,130 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=lag.go  -destination=mock_lag.go -package=lag
+package lag
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+// Lag is how far the subscription behind the eventbus.
+type Lag struct {
+	// Events is the number of events which has been written but not committed.
+	Events int64
+	// Time is the age of the oldest event which has not been committed.
+	Time time.Duration
+}
+
+type Calculator interface {
+	Calculate(ctx context.Context, eventbus string, offsets info.ListOffsetInfo) (Lag, error)
+}
+
+func NewCalculator(cl cluster.Cluster) Calculator {
+	return &calculator{
+		eventbusClient: cl.EventbusService().RawClient(),
+		eventlogClient: cl.EventlogService().RawClient(),
+		now:            time.Now,
+	}
+}
+
+type calculator struct {
+	eventbusClient ctrlpb.EventBusControllerClient
+	eventlogClient ctrlpb.EventLogControllerClient
+	now            func() time.Time
+}
+
+// Calculate compare the committed offsets with the high-watermark of eventlogs, the eventlog
+// which subscription has not committed offset is ignored.
+func (c *calculator) Calculate(ctx context.Context, eventbus string, offsets info.ListOffsetInfo) (Lag, error) {
+	var lag Lag
+	eb, err := c.eventbusClient.GetEventBus(ctx, &metapb.EventBus{Name: eventbus})
+	if err != nil {
+		return lag, err
+	}
+	committed := make(map[uint64]uint64, len(offsets))
+	for _, offset := range offsets {
+		committed[offset.EventLogID.Uint64()] = offset.Offset
+	}
+	now := c.now()
+	for _, el := range eb.Logs {
+		offset, exist := committed[el.EventLogId]
+		if !exist {
+			continue
+		}
+		res, err := c.eventlogClient.ListSegment(ctx, &ctrlpb.ListSegmentRequest{
+			EventBusId: eb.Id,
+			EventLogId: el.EventLogId,
+		})
+		if err != nil {
+			return lag, err
+		}
+		l := calculateEventlogLag(res.Segments, int64(offset), now)
+		lag.Events += l.Events
+		if l.Time > lag.Time {
+			lag.Time = l.Time
+		}
+	}
+	return lag, nil
+}
+
+func calculateEventlogLag(segments []*metapb.Segment, committed int64, now time.Time) Lag {
+	var lag Lag
+	var watermark int64
+	for _, seg := range segments {
+		if seg.EndOffsetInLog > watermark {
+			watermark = seg.EndOffsetInLog
+		}
+	}
+	if watermark <= committed {
+		return lag
+	}
+	lag.Events = watermark - committed
+	born := estimateBornTime(segments, committed)
+	if !born.IsZero() && now.After(born) {
+		lag.Time = now.Sub(born)
+	}
+	return lag
+}
+
+// estimateBornTime segment only has born time of the first and the last event,
+// the born time of event in the middle is estimated by its offset.
+func estimateBornTime(segments []*metapb.Segment, offset int64) time.Time {
+	for _, seg := range segments {
+		if seg.FirstEventBornAtByUnixMs <= 0 || seg.EndOffsetInLog <= seg.StartOffsetInLog {
+			continue
+		}
+		if offset >= seg.EndOffsetInLog {
+			continue
+		}
+		first := seg.FirstEventBornAtByUnixMs
+		if offset <= seg.StartOffsetInLog {
+			// the committed offset is older than the oldest segment which may be expired
+			return time.UnixMilli(first)
+		}
+		last := seg.LastEvnetBornAtByUnixMs
+		if last < first {
+			last = first
+		}
+		ratio := float64(offset-seg.StartOffsetInLog) / float64(seg.EndOffsetInLog-seg.StartOffsetInLog)
+		return time.UnixMilli(first + int64(float64(last-first)*ratio))
+	}
+	return time.Time{}
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lag
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCalculateEventlogLag(t *testing.T) {
+	Convey("test calculate eventlog lag", t, func() {
+		now := time.UnixMilli(100000)
+		segments := []*metapb.Segment{
+			{StartOffsetInLog: 0, EndOffsetInLog: 100, FirstEventBornAtByUnixMs: 10000, LastEvnetBornAtByUnixMs: 20000},
+			{StartOffsetInLog: 100, EndOffsetInLog: 200, FirstEventBornAtByUnixMs: 30000, LastEvnetBornAtByUnixMs: 50000},
+			{StartOffsetInLog: 200, EndOffsetInLog: -1},
+		}
+		Convey("no lag", func() {
+			lag := calculateEventlogLag(segments, 200, now)
+			So(lag.Events, ShouldEqual, 0)
+			So(lag.Time, ShouldEqual, 0)
+		})
+		Convey("lag in middle of segment", func() {
+			lag := calculateEventlogLag(segments, 150, now)
+			So(lag.Events, ShouldEqual, 50)
+			So(lag.Time, ShouldEqual, 60*time.Second)
+		})
+		Convey("lag at start of segment", func() {
+			lag := calculateEventlogLag(segments, 0, now)
+			So(lag.Events, ShouldEqual, 200)
+			So(lag.Time, ShouldEqual, 90*time.Second)
+		})
+		Convey("no segment", func() {
+			lag := calculateEventlogLag(nil, 0, now)
+			So(lag.Events, ShouldEqual, 0)
+		})
+	})
+}
+
+func TestCalculator_Calculate(t *testing.T) {
+	Convey("test calculate subscription lag", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ebCli := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+		elCli := ctrlpb.NewMockEventLogControllerClient(mockCtrl)
+		c := &calculator{
+			eventbusClient: ebCli,
+			eventlogClient: elCli,
+			now: func() time.Time {
+				return time.UnixMilli(100000)
+			},
+		}
+		ebCli.EXPECT().GetEventBus(gomock.Any(), gomock.Any()).Return(&metapb.EventBus{
+			Id:   1,
+			Logs: []*metapb.EventLog{{EventLogId: 1}, {EventLogId: 2}, {EventLogId: 3}},
+		}, nil)
+		elCli.EXPECT().ListSegment(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *ctrlpb.ListSegmentRequest, _ ...interface{}) (*ctrlpb.ListSegmentResponse, error) {
+				return &ctrlpb.ListSegmentResponse{Segments: []*metapb.Segment{{
+					EventLogId:               req.EventLogId,
+					StartOffsetInLog:         0,
+					EndOffsetInLog:           100,
+					FirstEventBornAtByUnixMs: int64(req.EventLogId) * 10000,
+					LastEvnetBornAtByUnixMs:  int64(req.EventLogId) * 10000,
+				}}}, nil
+			}).Times(2)
+		lag, err := c.Calculate(ctx, "test", info.ListOffsetInfo{
+			{EventLogID: vanus.NewIDFromUint64(1), Offset: 60},
+			{EventLogID: vanus.NewIDFromUint64(2), Offset: 90},
+		})
+		So(err, ShouldBeNil)
+		So(lag.Events, ShouldEqual, 50)
+		So(lag.Time, ShouldEqual, 90*time.Second)
+	})
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lag.go
+
+// Package lag is a generated GoMock package.
+package lag
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	info "github.com/linkall-labs/vanus/internal/primitive/info"
+)
+
+// MockCalculator is a mock of Calculator interface.
+type MockCalculator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalculatorMockRecorder
+}
+
+// MockCalculatorMockRecorder is the mock recorder for MockCalculator.
+type MockCalculatorMockRecorder struct {
+	mock *MockCalculator
+}
+
+// NewMockCalculator creates a new mock instance.
+func NewMockCalculator(ctrl *gomock.Controller) *MockCalculator {
+	mock := &MockCalculator{ctrl: ctrl}
+	mock.recorder = &MockCalculatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalculator) EXPECT() *MockCalculatorMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockCalculator) Calculate(ctx context.Context, eventbus string, offsets info.ListOffsetInfo) (Lag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, eventbus, offsets)
+	ret0, _ := ret[0].(Lag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockCalculatorMockRecorder) Calculate(ctx, eventbus, offsets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockCalculator)(nil).Calculate), ctx, eventbus, offsets)
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import "time"
+
+const (
+	ConnectorKindSource = "source"
+	ConnectorKindSink   = "sink"
+)
+
+// Connector runs a source or sink plugin on the trigger workers, a source connector reads events from
+// an external system and appends them to the eventbus, a sink connector writes the events of the eventbus
+// to an external system.
+type Connector struct {
+	Name     string            `json:"name"`
+	Kind     string            `json:"kind"`
+	Plugin   string            `json:"plugin"`
+	Eventbus string            `json:"eventbus"`
+	Config   map[string]string `json:"config,omitempty"`
+	// Replicas is the number of instances, each instance is assigned to one trigger worker.
+	Replicas  uint32    `json:"replicas"`
+	Version   uint64    `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
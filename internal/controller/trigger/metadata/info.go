@@ -37,6 +37,8 @@ type TriggerWorkerInfo struct {
 	ID    string             `json:"-"`
 	Addr  string             `json:"addr"`
 	Phase TriggerWorkerPhase `json:"phase"`
+	// Load is the sum of assigned subscription load score, it is not persisted.
+	Load float64 `json:"-"`
 }
 
 func NewTriggerWorkerInfo(addr string) *TriggerWorkerInfo {
@@ -52,6 +54,30 @@ func (tw *TriggerWorkerInfo) String() string {
 	return fmt.Sprintf("addr:%s,phase:%v", tw.Addr, tw.Phase)
 }
 
+const (
+	// every subscription has a base score so that subscription count still
+	// matters when trigger worker not report load.
+	baseLoadScore     = 1
+	inflightLoadScore = 0.1
+	lagLoadScore      = 0.01
+)
+
+// SubscriptionLoad is the load of subscription which trigger worker report.
+type SubscriptionLoad struct {
+	SubscriptionID vanus.ID  `json:"subscription_id"`
+	EventRate      float64   `json:"event_rate"`
+	Inflight       uint64    `json:"inflight"`
+	Lag            uint64    `json:"lag"`
+	AssignTime     time.Time `json:"assign_time"`
+	// the delivery latency percentiles reported by trigger worker
+	DeliveryLatencyP50 time.Duration `json:"delivery_latency_p50"`
+	DeliveryLatencyP99 time.Duration `json:"delivery_latency_p99"`
+}
+
+func (l SubscriptionLoad) Score() float64 {
+	return baseLoadScore + l.EventRate + float64(l.Inflight)*inflightLoadScore + float64(l.Lag)*lagLoadScore
+}
+
 type SubscriptionPhase string
 
 const (
@@ -71,6 +97,7 @@ type Subscription struct {
 	Sink               primitive.URI                   `json:"sink,omitempty"`
 	SinkCredentialType *primitive.CredentialType       `json:"sink_credential_type,omitempty"`
 	SinkCredential     primitive.SinkCredential        `json:"-"`
+	SinkSecret         string                          `json:"sink_secret,omitempty"`
 	Protocol           primitive.Protocol              `json:"protocol,omitempty"`
 	ProtocolSetting    *primitive.ProtocolSetting      `json:"protocol_settings,omitempty"`
 	EventBus           string                          `json:"eventbus"`
@@ -84,7 +111,9 @@ type Subscription struct {
 	// not from api
 	Phase         SubscriptionPhase `json:"phase"`
 	TriggerWorker string            `json:"trigger_worker,omitempty"`
-	HeartbeatTime time.Time         `json:"-"`
+	// StandbyWorker prepares the subscription and takes over it when TriggerWorker is disconnected.
+	StandbyWorker string    `json:"standby_worker,omitempty"`
+	HeartbeatTime time.Time `json:"-"`
 }
 
 // Update property change from api .
@@ -122,6 +151,10 @@ func (s *Subscription) Update(update *Subscription) bool {
 		change = true
 		s.SinkCredentialType = update.SinkCredentialType
 	}
+	if s.SinkSecret != update.SinkSecret {
+		change = true
+		s.SinkSecret = update.SinkSecret
+	}
 	primitive.FillSinkCredential(update.SinkCredential, s.SinkCredential)
 	if !reflect.DeepEqual(s.SinkCredential, update.SinkCredential) {
 		change = true
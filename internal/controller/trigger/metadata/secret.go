@@ -0,0 +1,32 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+// Secret is a sink credential stored by name, the subscriptions reference it instead of embedding
+// the credential, so it's rotated without updating them.
+type Secret struct {
+	Name           string                   `json:"name"`
+	CredentialType primitive.CredentialType `json:"credential_type"`
+	Credential     primitive.SinkCredential `json:"-"`
+	Version        uint64                   `json:"version"`
+	CreatedAt      time.Time                `json:"created_at"`
+	UpdatedAt      time.Time                `json:"updated_at"`
+}
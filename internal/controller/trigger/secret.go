@@ -0,0 +1,180 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	stdErr "errors"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/validation"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// secretWatcherBufferSize is the number of events buffered for a watcher, the watcher is dropped if it's
+// full, and it lists all secrets again after it reconnects.
+const secretWatcherBufferSize = 64
+
+func (ctrl *controller) PutSecret(ctx context.Context,
+	request *ctrlpb.PutSecretRequest) (*ctrlpb.Secret, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if err := validation.ValidatePutSecretRequest(ctx, request); err != nil {
+		return nil, err
+	}
+	s := convert.FromPbPutSecretRequest(request)
+	s.UpdatedAt = time.Now()
+	curr, err := ctrl.getSecret(ctx, s.Name)
+	switch {
+	case err == nil:
+		s.Version = curr.Version + 1
+		s.CreatedAt = curr.CreatedAt
+	case errors.Is(err, errors.ErrResourceNotFound):
+		s.Version = 1
+		s.CreatedAt = s.UpdatedAt
+	default:
+		return nil, err
+	}
+	if err = ctrl.secretStorage.PutNamedSecret(ctx, s); err != nil {
+		return nil, err
+	}
+	ctrl.notifySecretWatchers(&ctrlpb.SecretEvent{Name: s.Name, Version: s.Version})
+	return convert.ToPbSecret(s, false), nil
+}
+
+func (ctrl *controller) GetSecret(ctx context.Context,
+	request *ctrlpb.GetSecretRequest) (*ctrlpb.Secret, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	s, err := ctrl.getSecret(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convert.ToPbSecret(s, true), nil
+}
+
+func (ctrl *controller) ListSecret(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ListSecretResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	list, err := ctrl.secretStorage.ListNamedSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ctrlpb.ListSecretResponse{}
+	for _, s := range list {
+		resp.Secrets = append(resp.Secrets, convert.ToPbSecret(s, false))
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) DeleteSecret(ctx context.Context,
+	request *ctrlpb.DeleteSecretRequest) (*emptypb.Empty, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if _, err := ctrl.getSecret(ctx, request.Name); err != nil {
+		return nil, err
+	}
+	for _, sub := range ctrl.subscriptionManager.ListSubscription(ctx) {
+		if sub.SinkSecret == request.Name {
+			return nil, errors.ErrResourceCanNotOp.WithMessage(
+				"secret is referenced by subscription " + sub.ID.String())
+		}
+	}
+	if err := ctrl.secretStorage.DeleteNamedSecret(ctx, request.Name); err != nil {
+		return nil, err
+	}
+	ctrl.notifySecretWatchers(&ctrlpb.SecretEvent{Name: request.Name, Deleted: true})
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) WatchSecret(_ *emptypb.Empty, stream ctrlpb.TriggerController_WatchSecretServer) error {
+	if ctrl.state != primitive.ServerStateRunning {
+		return errors.ErrServerNotStart
+	}
+	ch := make(chan *ctrlpb.SecretEvent, secretWatcherBufferSize)
+	ctrl.secretMutex.Lock()
+	ctrl.secretWatchers[ch] = struct{}{}
+	ctrl.secretMutex.Unlock()
+	defer func() {
+		ctrl.secretMutex.Lock()
+		delete(ctrl.secretWatchers, ch)
+		ctrl.secretMutex.Unlock()
+	}()
+	for {
+		select {
+		case <-ctrl.ctx.Done():
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return errors.ErrResourceCanNotOp.WithMessage("secret watcher is too slow")
+			}
+			if err := stream.Send(event); err != nil {
+				log.Warning(stream.Context(), "send secret event error", map[string]interface{}{
+					log.KeyError: err,
+				})
+				return err
+			}
+		}
+	}
+}
+
+func (ctrl *controller) getSecret(ctx context.Context, name string) (*metadata.Secret, error) {
+	s, err := ctrl.secretStorage.GetNamedSecret(ctx, name)
+	if err != nil {
+		if stdErr.Is(err, kv.ErrKeyNotFound) {
+			return nil, errors.ErrResourceNotFound.WithMessage("secret not exist")
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+func (ctrl *controller) notifySecretWatchers(event *ctrlpb.SecretEvent) {
+	ctrl.secretMutex.Lock()
+	defer ctrl.secretMutex.Unlock()
+	for ch := range ctrl.secretWatchers {
+		select {
+		case ch <- event:
+		default:
+			close(ch)
+			delete(ctrl.secretWatchers, ch)
+		}
+	}
+}
+
+// validateSinkSecret validates the sink with the credential of the secret if the subscription references one.
+func (ctrl *controller) validateSinkSecret(ctx context.Context, request *ctrlpb.SubscriptionRequest) error {
+	if request.SinkSecret == "" {
+		return nil
+	}
+	s, err := ctrl.getSecret(ctx, request.SinkSecret)
+	if err != nil {
+		return err
+	}
+	return validation.ValidateSinkSecret(ctx, request, convert.ToPbSecret(s, true).Credential)
+}
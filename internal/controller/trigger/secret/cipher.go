@@ -0,0 +1,146 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util/crypto"
+)
+
+// Cipher encrypts the secrets before they are stored and decrypts them after they are read.
+type Cipher interface {
+	Encrypt(ctx context.Context, plaintext string) (string, error)
+	Decrypt(ctx context.Context, ciphertext string) (string, error)
+}
+
+const KMSTypeVaultTransit = "vault-transit"
+
+type KMSConfig struct {
+	// Type is the type of external KMS, only vault-transit is supported now, empty means the secrets
+	// are encrypted by the cluster key.
+	Type    string        `yaml:"type"`
+	Address string        `yaml:"address"`
+	KeyName string        `yaml:"key_name"`
+	Token   string        `yaml:"token"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// NewCipher returns the cipher of external KMS if it's configured, otherwise the cipher using
+// the cluster key.
+func NewCipher(cfg KMSConfig, clusterKey string) (Cipher, error) {
+	switch cfg.Type {
+	case "":
+		return &aesCipher{key: clusterKey}, nil
+	case KMSTypeVaultTransit:
+		if cfg.Address == "" || cfg.KeyName == "" {
+			return nil, errors.ErrInvalidRequest.WithMessage("the address and key name of vault transit are required")
+		}
+		return newVaultTransitCipher(cfg), nil
+	}
+	return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown kms type %s", cfg.Type))
+}
+
+type aesCipher struct {
+	key string
+}
+
+func (c *aesCipher) Encrypt(_ context.Context, plaintext string) (string, error) {
+	return crypto.AESEncrypt(plaintext, c.key)
+}
+
+func (c *aesCipher) Decrypt(_ context.Context, ciphertext string) (string, error) {
+	return crypto.AESDecrypt(ciphertext, c.key)
+}
+
+const defaultKMSTimeout = 5 * time.Second
+
+// vaultTransitCipher encrypts the secrets by the transit secrets engine of vault, the key never
+// leaves vault.
+type vaultTransitCipher struct {
+	address string
+	keyName string
+	token   string
+	client  *http.Client
+}
+
+func newVaultTransitCipher(cfg KMSConfig) *vaultTransitCipher {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultKMSTimeout
+	}
+	return &vaultTransitCipher{
+		address: strings.TrimSuffix(cfg.Address, "/"),
+		keyName: cfg.KeyName,
+		token:   cfg.Token,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *vaultTransitCipher) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	data, err := c.call(ctx, "encrypt", map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString([]byte(plaintext)),
+	})
+	if err != nil {
+		return "", err
+	}
+	return data["ciphertext"], nil
+}
+
+func (c *vaultTransitCipher) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	data, err := c.call(ctx, "decrypt", map[string]string{"ciphertext": ciphertext})
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(data["plaintext"])
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func (c *vaultTransitCipher) call(ctx context.Context, op string, body map[string]string) (map[string]string, error) {
+	payload, _ := json.Marshal(body)
+	url := fmt.Sprintf("%s/v1/transit/%s/%s", c.address, op, c.keyName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault transit %s failed, status code: %d", op, resp.StatusCode)
+	}
+	result := struct {
+		Data map[string]string `json:"data"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
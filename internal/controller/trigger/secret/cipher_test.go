@@ -0,0 +1,86 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCipher(t *testing.T) {
+	ctx := context.Background()
+	Convey("test cluster key cipher", t, func() {
+		c, err := NewCipher(KMSConfig{}, "just_for_test")
+		So(err, ShouldBeNil)
+		ciphertext, err := c.Encrypt(ctx, "test")
+		So(err, ShouldBeNil)
+		So(ciphertext, ShouldNotEqual, "test")
+		plaintext, err := c.Decrypt(ctx, ciphertext)
+		So(err, ShouldBeNil)
+		So(plaintext, ShouldEqual, "test")
+	})
+
+	Convey("test vault transit cipher", t, func() {
+		_, err := NewCipher(KMSConfig{Type: KMSTypeVaultTransit}, "")
+		So(err, ShouldNotBeNil)
+		_, err = NewCipher(KMSConfig{Type: "unknown"}, "")
+		So(err, ShouldNotBeNil)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Vault-Token") != "token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			body := map[string]string{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			data := map[string]string{}
+			switch r.URL.Path {
+			case "/v1/transit/encrypt/test":
+				data["ciphertext"] = "vault:v1:" + body["plaintext"]
+			case "/v1/transit/decrypt/test":
+				data["plaintext"] = strings.TrimPrefix(body["ciphertext"], "vault:v1:")
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+		}))
+		defer server.Close()
+
+		c, err := NewCipher(KMSConfig{
+			Type:    KMSTypeVaultTransit,
+			Address: server.URL + "/",
+			KeyName: "test",
+			Token:   "token",
+		}, "")
+		So(err, ShouldBeNil)
+		ciphertext, err := c.Encrypt(ctx, "test")
+		So(err, ShouldBeNil)
+		So(ciphertext, ShouldStartWith, "vault:v1:")
+		plaintext, err := c.Decrypt(ctx, ciphertext)
+		So(err, ShouldBeNil)
+		So(plaintext, ShouldEqual, "test")
+
+		c, _ = NewCipher(KMSConfig{Type: KMSTypeVaultTransit, Address: server.URL, KeyName: "test"}, "")
+		_, err = c.Encrypt(ctx, "test")
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	metadata "github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	primitive "github.com/linkall-labs/vanus/internal/primitive"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
@@ -50,6 +51,64 @@ func (mr *MockStorageMockRecorder) Delete(ctx, subID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), ctx, subID)
 }
 
+// DeleteNamedSecret mocks base method.
+func (m *MockStorage) DeleteNamedSecret(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNamedSecret", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNamedSecret indicates an expected call of DeleteNamedSecret.
+func (mr *MockStorageMockRecorder) DeleteNamedSecret(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNamedSecret", reflect.TypeOf((*MockStorage)(nil).DeleteNamedSecret), ctx, name)
+}
+
+// GetNamedSecret mocks base method.
+func (m *MockStorage) GetNamedSecret(ctx context.Context, name string) (*metadata.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNamedSecret", ctx, name)
+	ret0, _ := ret[0].(*metadata.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNamedSecret indicates an expected call of GetNamedSecret.
+func (mr *MockStorageMockRecorder) GetNamedSecret(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamedSecret", reflect.TypeOf((*MockStorage)(nil).GetNamedSecret), ctx, name)
+}
+
+// ListNamedSecret mocks base method.
+func (m *MockStorage) ListNamedSecret(ctx context.Context) ([]*metadata.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamedSecret", ctx)
+	ret0, _ := ret[0].([]*metadata.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamedSecret indicates an expected call of ListNamedSecret.
+func (mr *MockStorageMockRecorder) ListNamedSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamedSecret", reflect.TypeOf((*MockStorage)(nil).ListNamedSecret), ctx)
+}
+
+// PutNamedSecret mocks base method.
+func (m *MockStorage) PutNamedSecret(ctx context.Context, secret *metadata.Secret) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutNamedSecret", ctx, secret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutNamedSecret indicates an expected call of PutNamedSecret.
+func (mr *MockStorageMockRecorder) PutNamedSecret(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutNamedSecret", reflect.TypeOf((*MockStorage)(nil).PutNamedSecret), ctx, secret)
+}
+
 // Read mocks base method.
 func (m *MockStorage) Read(ctx context.Context, subID vanus.ID, credentialType primitive.CredentialType) (primitive.SinkCredential, error) {
 	m.ctrl.T.Helper()
@@ -18,6 +18,7 @@ package secret
 import (
 	"context"
 
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
@@ -26,4 +27,9 @@ type Storage interface {
 	Read(ctx context.Context, subID vanus.ID, credentialType primitive.CredentialType) (primitive.SinkCredential, error)
 	Write(ctx context.Context, subID vanus.ID, credential primitive.SinkCredential) error
 	Delete(ctx context.Context, subID vanus.ID) error
+	// ListNamedSecret returns the named secrets without credential.
+	ListNamedSecret(ctx context.Context) ([]*metadata.Secret, error)
+	GetNamedSecret(ctx context.Context, name string) (*metadata.Secret, error)
+	PutNamedSecret(ctx context.Context, secret *metadata.Secret) error
+	DeleteNamedSecret(ctx context.Context, name string) error
 }
@@ -0,0 +1,133 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_Secret(t *testing.T) {
+	Convey("test secret", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, nil)
+		ctx := context.Background()
+		secretStorage := secret.NewMockStorage(mockCtrl)
+		ctrl.secretStorage = secretStorage
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		plain := &metapb.SinkCredential{
+			CredentialType: metapb.SinkCredential_PLAIN,
+			Credential: &metapb.SinkCredential_Plain{
+				Plain: &metapb.PlainCredential{Identifier: "user", Secret: "password"},
+			},
+		}
+		ch := make(chan *ctrlpb.SecretEvent, 1)
+		ctrl.secretWatchers[ch] = struct{}{}
+
+		Convey("test put secret", func() {
+			_, err := ctrl.PutSecret(ctx, &ctrlpb.PutSecretRequest{Name: "a/b", Credential: plain})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			secretStorage.EXPECT().GetNamedSecret(ctx, "test").Return(nil, kv.ErrKeyNotFound)
+			secretStorage.EXPECT().PutNamedSecret(ctx, gomock.Any()).Return(nil)
+			s, err := ctrl.PutSecret(ctx, &ctrlpb.PutSecretRequest{Name: "test", Credential: plain})
+			So(err, ShouldBeNil)
+			So(s.Version, ShouldEqual, 1)
+			So(s.Credential.GetPlain().GetSecret(), ShouldEqual, primitive.SecretsMask)
+			So((<-ch).Version, ShouldEqual, 1)
+
+			createdAt := time.Now().Add(-time.Hour)
+			secretStorage.EXPECT().GetNamedSecret(ctx, "test").Return(&metadata.Secret{
+				Name: "test", Version: 1, CreatedAt: createdAt,
+			}, nil)
+			secretStorage.EXPECT().PutNamedSecret(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, s *metadata.Secret) error {
+					So(s.CreatedAt, ShouldEqual, createdAt)
+					So(s.CredentialType, ShouldEqual, primitive.Plain)
+					return nil
+				})
+			s, err = ctrl.PutSecret(ctx, &ctrlpb.PutSecretRequest{Name: "test", Credential: plain})
+			So(err, ShouldBeNil)
+			So(s.Version, ShouldEqual, 2)
+			So((<-ch).Version, ShouldEqual, 2)
+		})
+
+		Convey("test delete secret", func() {
+			secretStorage.EXPECT().GetNamedSecret(ctx, "test").AnyTimes().Return(&metadata.Secret{Name: "test"}, nil)
+			sub := &metadata.Subscription{ID: vanus.NewTestID(), SinkSecret: "test"}
+			subManager.EXPECT().ListSubscription(ctx).Return([]*metadata.Subscription{sub})
+			_, err := ctrl.DeleteSecret(ctx, &ctrlpb.DeleteSecretRequest{Name: "test"})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			sub.SinkSecret = ""
+			subManager.EXPECT().ListSubscription(ctx).Return([]*metadata.Subscription{sub})
+			secretStorage.EXPECT().DeleteNamedSecret(ctx, "test").Return(nil)
+			_, err = ctrl.DeleteSecret(ctx, &ctrlpb.DeleteSecretRequest{Name: "test"})
+			So(err, ShouldBeNil)
+			So((<-ch).Deleted, ShouldBeTrue)
+		})
+
+		Convey("test validate sink secret", func() {
+			request := &ctrlpb.SubscriptionRequest{
+				Sink:       "amqp://127.0.0.1/queue",
+				Protocol:   metapb.Protocol_AMQP,
+				SinkSecret: "test",
+			}
+			secretStorage.EXPECT().GetNamedSecret(ctx, "test").Return(nil, kv.ErrKeyNotFound)
+			So(errors.Is(ctrl.validateSinkSecret(ctx, request), errors.ErrResourceNotFound), ShouldBeTrue)
+
+			secretStorage.EXPECT().GetNamedSecret(ctx, "test").Return(&metadata.Secret{
+				Name:           "test",
+				CredentialType: primitive.AWS,
+				Credential:     primitive.NewAkSkSinkCredential("ak", "sk"),
+			}, nil)
+			So(ctrl.validateSinkSecret(ctx, request), ShouldNotBeNil)
+
+			secretStorage.EXPECT().GetNamedSecret(ctx, "test").Return(&metadata.Secret{
+				Name:           "test",
+				CredentialType: primitive.Plain,
+				Credential:     primitive.NewPlainSinkCredential("user", "password"),
+			}, nil)
+			So(ctrl.validateSinkSecret(ctx, request), ShouldBeNil)
+		})
+
+		Convey("test slow watcher is dropped", func() {
+			ctrl.notifySecretWatchers(&ctrlpb.SecretEvent{Name: "a"})
+			ctrl.notifySecretWatchers(&ctrlpb.SecretEvent{Name: "b"})
+			So(ctrl.secretWatchers, ShouldBeEmpty)
+			So((<-ch).Name, ShouldEqual, "a")
+			_, ok := <-ch
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
@@ -25,4 +25,8 @@ const (
 	KeyPrefixSubscription  KeyPrefix = "/trigger/subscriptions/"
 	KeyPrefixTriggerWorker KeyPrefix = "/trigger/triggerWorkers/"
 	KeyPrefixSecret        KeyPrefix = "/trigger/secret/"
+	KeyPrefixNamedSecret   KeyPrefix = "/trigger/namedSecrets/"
+	KeyPrefixConnector     KeyPrefix = "/trigger/connectors/"
+	// KeyPrefixConnectorPosition is the prefix of the positions committed by the source connector instances.
+	KeyPrefixConnectorPosition KeyPrefix = "/trigger/connectorPositions/"
 )
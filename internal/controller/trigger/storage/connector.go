@@ -0,0 +1,122 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=connector.go  -destination=mock_connector.go -package=storage
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strconv"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+type ConnectorStorage interface {
+	SaveConnector(ctx context.Context, c *metadata.Connector) error
+	GetConnector(ctx context.Context, name string) (*metadata.Connector, error)
+	DeleteConnector(ctx context.Context, name string) error
+	ListConnector(ctx context.Context) ([]*metadata.Connector, error)
+	SaveConnectorPosition(ctx context.Context, name string, instance uint32, position []byte) error
+	// ListConnectorPosition returns the positions of the connector instances keyed by the instance.
+	ListConnectorPosition(ctx context.Context, name string) (map[uint32][]byte, error)
+	DeleteConnectorPosition(ctx context.Context, name string) error
+}
+
+type connectorStorage struct {
+	client kv.Client
+}
+
+func NewConnectorStorage(client kv.Client) ConnectorStorage {
+	return &connectorStorage{
+		client: client,
+	}
+}
+
+func (s *connectorStorage) getKey(name string) string {
+	return path.Join(KeyPrefixConnector.String(), name)
+}
+
+func (s *connectorStorage) getPositionDir(name string) string {
+	return path.Join(KeyPrefixConnectorPosition.String(), name)
+}
+
+func (s *connectorStorage) SaveConnector(ctx context.Context, c *metadata.Connector) error {
+	v, err := json.Marshal(c)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	return s.client.Set(ctx, s.getKey(c.Name), v)
+}
+
+func (s *connectorStorage) GetConnector(ctx context.Context, name string) (*metadata.Connector, error) {
+	v, err := s.client.Get(ctx, s.getKey(name))
+	if err != nil {
+		return nil, err
+	}
+	c := &metadata.Connector{}
+	if err = json.Unmarshal(v, c); err != nil {
+		return nil, errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	return c, nil
+}
+
+func (s *connectorStorage) DeleteConnector(ctx context.Context, name string) error {
+	return s.client.Delete(ctx, s.getKey(name))
+}
+
+func (s *connectorStorage) ListConnector(ctx context.Context) ([]*metadata.Connector, error) {
+	pairs, err := s.client.List(ctx, KeyPrefixConnector.String())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*metadata.Connector, 0, len(pairs))
+	for _, v := range pairs {
+		c := &metadata.Connector{}
+		if err = json.Unmarshal(v.Value, c); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		list = append(list, c)
+	}
+	return list, nil
+}
+
+func (s *connectorStorage) SaveConnectorPosition(ctx context.Context,
+	name string, instance uint32, position []byte) error {
+	key := path.Join(s.getPositionDir(name), strconv.FormatUint(uint64(instance), 10))
+	return s.client.Set(ctx, key, position)
+}
+
+func (s *connectorStorage) ListConnectorPosition(ctx context.Context, name string) (map[uint32][]byte, error) {
+	pairs, err := s.client.List(ctx, s.getPositionDir(name))
+	if err != nil {
+		return nil, err
+	}
+	positions := make(map[uint32][]byte, len(pairs))
+	for _, v := range pairs {
+		instance, err := strconv.ParseUint(path.Base(v.Key), 10, 32)
+		if err != nil {
+			continue
+		}
+		positions[uint32(instance)] = v.Value
+	}
+	return positions, nil
+}
+
+func (s *connectorStorage) DeleteConnectorPosition(ctx context.Context, name string) error {
+	return s.client.DeleteDir(ctx, s.getPositionDir(name))
+}
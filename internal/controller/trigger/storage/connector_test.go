@@ -0,0 +1,69 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConnectorStorage(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvClient := kv.NewMockClient(ctrl)
+	s := NewConnectorStorage(kvClient).(*connectorStorage)
+	c := &metadata.Connector{Name: "pg", Kind: metadata.ConnectorKindSource, Plugin: "postgres-cdc", Replicas: 1}
+	v, _ := json.Marshal(c)
+
+	Convey("save and get connector", t, func() {
+		kvClient.EXPECT().Set(ctx, "/trigger/connectors/pg", v).Return(nil)
+		So(s.SaveConnector(ctx, c), ShouldBeNil)
+
+		kvClient.EXPECT().Get(ctx, "/trigger/connectors/pg").Return(v, nil)
+		got, err := s.GetConnector(ctx, "pg")
+		So(err, ShouldBeNil)
+		So(got, ShouldResemble, c)
+	})
+
+	Convey("list connector", t, func() {
+		kvClient.EXPECT().List(ctx, KeyPrefixConnector.String()).Return([]kv.Pair{{Value: v}}, nil)
+		list, err := s.ListConnector(ctx)
+		So(err, ShouldBeNil)
+		So(list, ShouldHaveLength, 1)
+		So(list[0].Plugin, ShouldEqual, "postgres-cdc")
+	})
+
+	Convey("save and list connector position", t, func() {
+		kvClient.EXPECT().Set(ctx, "/trigger/connectorPositions/pg/1", []byte("lsn")).Return(nil)
+		So(s.SaveConnectorPosition(ctx, "pg", 1, []byte("lsn")), ShouldBeNil)
+
+		kvClient.EXPECT().List(ctx, "/trigger/connectorPositions/pg").Return([]kv.Pair{
+			{Key: "/trigger/connectorPositions/pg/1", Value: []byte("lsn")},
+		}, nil)
+		positions, err := s.ListConnectorPosition(ctx, "pg")
+		So(err, ShouldBeNil)
+		So(positions, ShouldResemble, map[uint32][]byte{1: []byte("lsn")})
+
+		kvClient.EXPECT().DeleteDir(ctx, "/trigger/connectorPositions/pg").Return(nil)
+		So(s.DeleteConnectorPosition(ctx, "pg"), ShouldBeNil)
+	})
+}
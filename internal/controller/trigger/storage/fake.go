@@ -24,16 +24,20 @@ import (
 )
 
 type fake struct {
-	subs     map[vanus.ID]*metadata.Subscription
-	offset   map[vanus.ID]map[vanus.ID]pInfo.OffsetInfo
-	tWorkers map[string]*metadata.TriggerWorkerInfo
+	subs       map[vanus.ID]*metadata.Subscription
+	offset     map[vanus.ID]map[vanus.ID]pInfo.OffsetInfo
+	tWorkers   map[string]*metadata.TriggerWorkerInfo
+	connectors map[string]*metadata.Connector
+	positions  map[string]map[uint32][]byte
 }
 
 func NewFakeStorage() Storage {
 	s := &fake{
-		subs:     map[vanus.ID]*metadata.Subscription{},
-		offset:   map[vanus.ID]map[vanus.ID]pInfo.OffsetInfo{},
-		tWorkers: map[string]*metadata.TriggerWorkerInfo{},
+		subs:       map[vanus.ID]*metadata.Subscription{},
+		offset:     map[vanus.ID]map[vanus.ID]pInfo.OffsetInfo{},
+		tWorkers:   map[string]*metadata.TriggerWorkerInfo{},
+		connectors: map[string]*metadata.Connector{},
+		positions:  map[string]map[uint32][]byte{},
 	}
 	return s
 }
@@ -121,3 +125,52 @@ func (f *fake) ListTriggerWorker(ctx context.Context) ([]*metadata.TriggerWorker
 	}
 	return list, nil
 }
+
+func (f *fake) SaveConnector(ctx context.Context, c *metadata.Connector) error {
+	f.connectors[c.Name] = c
+	return nil
+}
+
+func (f *fake) GetConnector(ctx context.Context, name string) (*metadata.Connector, error) {
+	c, exist := f.connectors[name]
+	if !exist {
+		return nil, kv.ErrKeyNotFound
+	}
+	return c, nil
+}
+
+func (f *fake) DeleteConnector(ctx context.Context, name string) error {
+	delete(f.connectors, name)
+	return nil
+}
+
+func (f *fake) ListConnector(ctx context.Context) ([]*metadata.Connector, error) {
+	list := make([]*metadata.Connector, 0)
+	for _, c := range f.connectors {
+		list = append(list, c)
+	}
+	return list, nil
+}
+
+func (f *fake) SaveConnectorPosition(ctx context.Context, name string, instance uint32, position []byte) error {
+	positions, exist := f.positions[name]
+	if !exist {
+		positions = map[uint32][]byte{}
+		f.positions[name] = positions
+	}
+	positions[instance] = position
+	return nil
+}
+
+func (f *fake) ListConnectorPosition(ctx context.Context, name string) (map[uint32][]byte, error) {
+	positions := map[uint32][]byte{}
+	for k, v := range f.positions[name] {
+		positions[k] = v
+	}
+	return positions, nil
+}
+
+func (f *fake) DeleteConnectorPosition(ctx context.Context, name string) error {
+	delete(f.positions, name)
+	return nil
+}
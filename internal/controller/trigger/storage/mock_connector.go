@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: connector.go
+
+// Package storage is a generated GoMock package.
+package storage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	metadata "github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+)
+
+// MockConnectorStorage is a mock of ConnectorStorage interface.
+type MockConnectorStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectorStorageMockRecorder
+}
+
+// MockConnectorStorageMockRecorder is the mock recorder for MockConnectorStorage.
+type MockConnectorStorageMockRecorder struct {
+	mock *MockConnectorStorage
+}
+
+// NewMockConnectorStorage creates a new mock instance.
+func NewMockConnectorStorage(ctrl *gomock.Controller) *MockConnectorStorage {
+	mock := &MockConnectorStorage{ctrl: ctrl}
+	mock.recorder = &MockConnectorStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnectorStorage) EXPECT() *MockConnectorStorageMockRecorder {
+	return m.recorder
+}
+
+// DeleteConnector mocks base method.
+func (m *MockConnectorStorage) DeleteConnector(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnector", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockConnectorStorageMockRecorder) DeleteConnector(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockConnectorStorage)(nil).DeleteConnector), ctx, name)
+}
+
+// DeleteConnectorPosition mocks base method.
+func (m *MockConnectorStorage) DeleteConnectorPosition(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnectorPosition", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnectorPosition indicates an expected call of DeleteConnectorPosition.
+func (mr *MockConnectorStorageMockRecorder) DeleteConnectorPosition(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnectorPosition", reflect.TypeOf((*MockConnectorStorage)(nil).DeleteConnectorPosition), ctx, name)
+}
+
+// GetConnector mocks base method.
+func (m *MockConnectorStorage) GetConnector(ctx context.Context, name string) (*metadata.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnector", ctx, name)
+	ret0, _ := ret[0].(*metadata.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnector indicates an expected call of GetConnector.
+func (mr *MockConnectorStorageMockRecorder) GetConnector(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnector", reflect.TypeOf((*MockConnectorStorage)(nil).GetConnector), ctx, name)
+}
+
+// ListConnector mocks base method.
+func (m *MockConnectorStorage) ListConnector(ctx context.Context) ([]*metadata.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnector", ctx)
+	ret0, _ := ret[0].([]*metadata.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnector indicates an expected call of ListConnector.
+func (mr *MockConnectorStorageMockRecorder) ListConnector(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnector", reflect.TypeOf((*MockConnectorStorage)(nil).ListConnector), ctx)
+}
+
+// ListConnectorPosition mocks base method.
+func (m *MockConnectorStorage) ListConnectorPosition(ctx context.Context, name string) (map[uint32][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectorPosition", ctx, name)
+	ret0, _ := ret[0].(map[uint32][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectorPosition indicates an expected call of ListConnectorPosition.
+func (mr *MockConnectorStorageMockRecorder) ListConnectorPosition(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectorPosition", reflect.TypeOf((*MockConnectorStorage)(nil).ListConnectorPosition), ctx, name)
+}
+
+// SaveConnector mocks base method.
+func (m *MockConnectorStorage) SaveConnector(ctx context.Context, c *metadata.Connector) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConnector", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConnector indicates an expected call of SaveConnector.
+func (mr *MockConnectorStorageMockRecorder) SaveConnector(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConnector", reflect.TypeOf((*MockConnectorStorage)(nil).SaveConnector), ctx, c)
+}
+
+// SaveConnectorPosition mocks base method.
+func (m *MockConnectorStorage) SaveConnectorPosition(ctx context.Context, name string, instance uint32, position []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConnectorPosition", ctx, name, instance, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConnectorPosition indicates an expected call of SaveConnectorPosition.
+func (mr *MockConnectorStorageMockRecorder) SaveConnectorPosition(ctx, name, instance, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConnectorPosition", reflect.TypeOf((*MockConnectorStorage)(nil).SaveConnectorPosition), ctx, name, instance, position)
+}
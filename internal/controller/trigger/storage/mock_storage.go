@@ -20,6 +20,7 @@ type MockStorage struct {
 	*MockOffsetStorage
 	*MockSubscriptionStorage
 	*MockTriggerWorkerStorage
+	*MockConnectorStorage
 }
 
 // NewMockStorage creates a new mock instance.
@@ -28,6 +29,7 @@ func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
 		MockOffsetStorage:        NewMockOffsetStorage(ctrl),
 		MockSubscriptionStorage:  NewMockSubscriptionStorage(ctrl),
 		MockTriggerWorkerStorage: NewMockTriggerWorkerStorage(ctrl),
+		MockConnectorStorage:     NewMockConnectorStorage(ctrl),
 	}
 	return mock
 }
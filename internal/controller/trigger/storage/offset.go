@@ -20,6 +20,7 @@ import (
 	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
@@ -38,6 +39,12 @@ var (
 	bitSize = 64
 )
 
+const (
+	// the value is offset@delivered1,delivered2 when has delivered offsets.
+	deliveredSeparator = "@"
+	offsetSeparator    = ","
+)
+
 type offsetStorage struct {
 	client kv.Client
 }
@@ -75,12 +82,42 @@ func (s *offsetStorage) byteArrToUint64(b []byte) uint64 {
 	return v
 }
 
+func (s *offsetStorage) offsetToByteArr(info info.OffsetInfo) []byte {
+	if len(info.Delivered) == 0 {
+		return s.int64ToByteArr(info.Offset)
+	}
+	delivered := make([]string, len(info.Delivered))
+	for i, offset := range info.Delivered {
+		delivered[i] = strconv.FormatUint(offset, base)
+	}
+	str := strconv.FormatUint(info.Offset, base) + deliveredSeparator + strings.Join(delivered, offsetSeparator)
+	return []byte(str)
+}
+
+func (s *offsetStorage) byteArrToOffset(b []byte) (uint64, []uint64) {
+	str := string(b)
+	idx := strings.Index(str, deliveredSeparator)
+	if idx < 0 {
+		return s.byteArrToUint64(b), nil
+	}
+	offset, _ := strconv.ParseUint(str[:idx], base, bitSize)
+	var delivered []uint64
+	for _, v := range strings.Split(str[idx+1:], offsetSeparator) {
+		d, err := strconv.ParseUint(v, base, bitSize)
+		if err != nil {
+			continue
+		}
+		delivered = append(delivered, d)
+	}
+	return offset, delivered
+}
+
 func (s *offsetStorage) CreateOffset(ctx context.Context, subscriptionID vanus.ID, info info.OffsetInfo) error {
-	return s.client.Create(ctx, s.getKey(subscriptionID, info.EventLogID), s.int64ToByteArr(info.Offset))
+	return s.client.Create(ctx, s.getKey(subscriptionID, info.EventLogID), s.offsetToByteArr(info))
 }
 
 func (s *offsetStorage) UpdateOffset(ctx context.Context, subscriptionID vanus.ID, info info.OffsetInfo) error {
-	return s.client.Update(ctx, s.getKey(subscriptionID, info.EventLogID), s.int64ToByteArr(info.Offset))
+	return s.client.Update(ctx, s.getKey(subscriptionID, info.EventLogID), s.offsetToByteArr(info))
 }
 
 func (s *offsetStorage) GetOffsets(ctx context.Context, subscriptionID vanus.ID) (info.ListOffsetInfo, error) {
@@ -94,7 +131,8 @@ func (s *offsetStorage) GetOffsets(ctx context.Context, subscriptionID vanus.ID)
 		if err != nil {
 			return nil, err
 		}
-		infos = append(infos, info.OffsetInfo{EventLogID: id, Offset: s.byteArrToUint64(v.Value)})
+		offset, delivered := s.byteArrToOffset(v.Value)
+		infos = append(infos, info.OffsetInfo{EventLogID: id, Offset: offset, Delivered: delivered})
 	}
 	return infos, nil
 }
@@ -84,6 +84,19 @@ func TestGetOffset(t *testing.T) {
 		So(offsets[0].Offset, ShouldEqual, offset)
 		So(offsets[0].EventLogID, ShouldEqual, eventLogID)
 	})
+	Convey("get offset with delivered", t, func() {
+		kvClient.EXPECT().List(ctx, s.getSubKey(subID)).Return([]kv.Pair{
+			{Key: fmt.Sprintf("/test/%d", eventLogID), Value: s.offsetToByteArr(info.OffsetInfo{
+				Offset:    offset,
+				Delivered: []uint64{102, 105},
+			})},
+		}, nil)
+		offsets, err := s.GetOffsets(ctx, subID)
+		So(err, ShouldBeNil)
+		So(len(offsets), ShouldEqual, 1)
+		So(offsets[0].Offset, ShouldEqual, offset)
+		So(offsets[0].Delivered, ShouldResemble, []uint64{102, 105})
+	})
 }
 
 func TestDeleteOffset(t *testing.T) {
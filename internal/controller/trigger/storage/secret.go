@@ -19,35 +19,39 @@ import (
 	"encoding/json"
 	"path"
 
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/kv/etcd"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/pkg/errors"
-	"github.com/linkall-labs/vanus/pkg/util/crypto"
 )
 
-func NewSecretStorage(config primitive.KvStorageConfig, encryption string) (secret.Storage, error) {
+func NewSecretStorage(config primitive.KvStorageConfig, cipher secret.Cipher) (secret.Storage, error) {
 	client, err := etcd.NewEtcdClientV3(config.ServerList, config.KeyPrefix)
 	if err != nil {
 		return nil, err
 	}
 	return &SecretStorage{
-		client:    client,
-		cipherKey: encryption,
+		client: client,
+		cipher: cipher,
 	}, nil
 }
 
 type SecretStorage struct {
-	client    kv.Client
-	cipherKey string
+	client kv.Client
+	cipher secret.Cipher
 }
 
 func (p *SecretStorage) getKey(subID vanus.ID) string {
 	return path.Join(KeyPrefixSecret.String(), subID.String())
 }
 
+func (p *SecretStorage) getNamedKey(name string) string {
+	return path.Join(KeyPrefixNamedSecret.String(), name)
+}
+
 func (p *SecretStorage) Read(ctx context.Context,
 	subID vanus.ID,
 	credentialType primitive.CredentialType) (primitive.SinkCredential, error) {
@@ -55,17 +59,92 @@ func (p *SecretStorage) Read(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	return p.decrypt(ctx, v, credentialType)
+}
+
+func (p *SecretStorage) Write(ctx context.Context, subID vanus.ID, credential primitive.SinkCredential) error {
+	v, err := p.encrypt(ctx, credential)
+	if err != nil {
+		return err
+	}
+	key := p.getKey(subID)
+	return p.client.Set(ctx, key, v)
+}
+
+func (p *SecretStorage) Delete(ctx context.Context, subID vanus.ID) error {
+	key := p.getKey(subID)
+	return p.client.Delete(ctx, key)
+}
+
+// namedSecret is the stored form of a named secret, the credential is encrypted.
+type namedSecret struct {
+	*metadata.Secret
+	Credential json.RawMessage `json:"credential"`
+}
+
+func (p *SecretStorage) ListNamedSecret(ctx context.Context) ([]*metadata.Secret, error) {
+	pairs, err := p.client.List(ctx, KeyPrefixNamedSecret.String())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*metadata.Secret, 0, len(pairs))
+	for _, v := range pairs {
+		ns := &namedSecret{Secret: &metadata.Secret{}}
+		if err = json.Unmarshal(v.Value, ns); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		list = append(list, ns.Secret)
+	}
+	return list, nil
+}
+
+func (p *SecretStorage) GetNamedSecret(ctx context.Context, name string) (*metadata.Secret, error) {
+	v, err := p.client.Get(ctx, p.getNamedKey(name))
+	if err != nil {
+		return nil, err
+	}
+	ns := &namedSecret{Secret: &metadata.Secret{}}
+	if err = json.Unmarshal(v, ns); err != nil {
+		return nil, errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	ns.Secret.Credential, err = p.decrypt(ctx, ns.Credential, ns.CredentialType)
+	if err != nil {
+		return nil, err
+	}
+	return ns.Secret, nil
+}
+
+func (p *SecretStorage) PutNamedSecret(ctx context.Context, s *metadata.Secret) error {
+	credential, err := p.encrypt(ctx, s.Credential)
+	if err != nil {
+		return err
+	}
+	v, err := json.Marshal(&namedSecret{Secret: s, Credential: credential})
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	return p.client.Set(ctx, p.getNamedKey(s.Name), v)
+}
+
+func (p *SecretStorage) DeleteNamedSecret(ctx context.Context, name string) error {
+	return p.client.Delete(ctx, p.getNamedKey(name))
+}
+
+func (p *SecretStorage) decrypt(ctx context.Context,
+	v []byte,
+	credentialType primitive.CredentialType) (primitive.SinkCredential, error) {
+	var err error
 	switch credentialType {
 	case primitive.AWS:
 		credential := &primitive.AkSkSinkCredential{}
 		if err = json.Unmarshal(v, credential); err != nil {
 			return nil, errors.ErrJSONUnMarshal.Wrap(err)
 		}
-		accessKeyID, err := crypto.AESDecrypt(credential.AccessKeyID, p.cipherKey)
+		accessKeyID, err := p.cipher.Decrypt(ctx, credential.AccessKeyID)
 		if err != nil {
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
-		secretAccessKey, err := crypto.AESDecrypt(credential.SecretAccessKey, p.cipherKey)
+		secretAccessKey, err := p.cipher.Decrypt(ctx, credential.SecretAccessKey)
 		if err != nil {
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
@@ -76,7 +155,7 @@ func (p *SecretStorage) Read(ctx context.Context,
 			return nil, errors.ErrJSONUnMarshal.Wrap(err)
 		}
 
-		credentialJSON, err := crypto.AESDecrypt(credential.CredentialJSON, p.cipherKey)
+		credentialJSON, err := p.cipher.Decrypt(ctx, credential.CredentialJSON)
 		if err != nil {
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
@@ -86,11 +165,11 @@ func (p *SecretStorage) Read(ctx context.Context,
 		if err = json.Unmarshal(v, credential); err != nil {
 			return nil, errors.ErrJSONUnMarshal.Wrap(err)
 		}
-		identifier, err := crypto.AESDecrypt(credential.Identifier, p.cipherKey)
+		identifier, err := p.cipher.Decrypt(ctx, credential.Identifier)
 		if err != nil {
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
-		secret, err := crypto.AESDecrypt(credential.Secret, p.cipherKey)
+		secret, err := p.cipher.Decrypt(ctx, credential.Secret)
 		if err != nil {
 			return nil, errors.ErrAESDecrypt.Wrap(err)
 		}
@@ -99,51 +178,45 @@ func (p *SecretStorage) Read(ctx context.Context,
 	return nil, errors.ErrInvalidRequest.WithMessage("unknown credential type")
 }
 
-func (p *SecretStorage) Write(ctx context.Context, subID vanus.ID, credential primitive.SinkCredential) error {
+func (p *SecretStorage) encrypt(ctx context.Context, credential primitive.SinkCredential) ([]byte, error) {
 	var save primitive.SinkCredential
 	switch credential.GetType() {
 	case primitive.AWS:
 		cloud, _ := credential.(*primitive.AkSkSinkCredential)
-		accessKeyID, err := crypto.AESEncrypt(cloud.AccessKeyID, p.cipherKey)
+		accessKeyID, err := p.cipher.Encrypt(ctx, cloud.AccessKeyID)
 		if err != nil {
-			return errors.ErrAESEncrypt.Wrap(err)
+			return nil, errors.ErrAESEncrypt.Wrap(err)
 		}
-		secretAccessKey, err := crypto.AESEncrypt(cloud.SecretAccessKey, p.cipherKey)
+		secretAccessKey, err := p.cipher.Encrypt(ctx, cloud.SecretAccessKey)
 		if err != nil {
-			return errors.ErrAESEncrypt.Wrap(err)
+			return nil, errors.ErrAESEncrypt.Wrap(err)
 		}
 		save = primitive.NewAkSkSinkCredential(accessKeyID, secretAccessKey)
 	case primitive.GCloud:
 		gcloud, _ := credential.(*primitive.GCloudSinkCredential)
-		credentialJSON, err := crypto.AESEncrypt(gcloud.CredentialJSON, p.cipherKey)
+		credentialJSON, err := p.cipher.Encrypt(ctx, gcloud.CredentialJSON)
 		if err != nil {
-			return errors.ErrAESEncrypt.Wrap(err)
+			return nil, errors.ErrAESEncrypt.Wrap(err)
 		}
 		save = primitive.NewGCloudSinkCredential(credentialJSON)
 	case primitive.Plain:
 		plain, _ := credential.(*primitive.PlainSinkCredential)
-		identifier, err := crypto.AESEncrypt(plain.Identifier, p.cipherKey)
+		identifier, err := p.cipher.Encrypt(ctx, plain.Identifier)
 		if err != nil {
-			return errors.ErrAESEncrypt.Wrap(err)
+			return nil, errors.ErrAESEncrypt.Wrap(err)
 		}
-		s, err := crypto.AESEncrypt(plain.Secret, p.cipherKey)
+		s, err := p.cipher.Encrypt(ctx, plain.Secret)
 		if err != nil {
-			return errors.ErrAESEncrypt.Wrap(err)
+			return nil, errors.ErrAESEncrypt.Wrap(err)
 		}
 		save = primitive.NewPlainSinkCredential(identifier, s)
 	default:
-		return errors.ErrInvalidRequest.WithMessage("unknown credential type")
+		return nil, errors.ErrInvalidRequest.WithMessage("unknown credential type")
 	}
 
 	v, err := json.Marshal(save)
 	if err != nil {
-		return errors.ErrJSONMarshal.Wrap(err)
+		return nil, errors.ErrJSONMarshal.Wrap(err)
 	}
-	key := p.getKey(subID)
-	return p.client.Set(ctx, key, v)
-}
-
-func (p *SecretStorage) Delete(ctx context.Context, subID vanus.ID) error {
-	key := p.getKey(subID)
-	return p.client.Delete(ctx, key)
+	return v, nil
 }
@@ -20,10 +20,11 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
-	"github.com/linkall-labs/vanus/pkg/util/crypto"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -33,17 +34,19 @@ func TestSecretStorage(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 		kvClient := kv.NewMockClient(ctrl)
+		cipher, err := secret.NewCipher(secret.KMSConfig{}, "just_for_test")
+		So(err, ShouldBeNil)
 		p, err := NewSecretStorage(primitive.KvStorageConfig{
 			ServerList: []string{"test"},
-		}, "just_for_test")
+		}, cipher)
 		So(err, ShouldBeNil)
 		secret := p.(*SecretStorage)
 		secret.client = kvClient
 		Convey("test credential type AK/SK", func() {
 			subID := vanus.NewTestID()
 			Convey("test read", func() {
-				a, _ := crypto.AESEncrypt("test_access_key_id", secret.cipherKey)
-				s, _ := crypto.AESEncrypt("test_secret_access_key", secret.cipherKey)
+				a, _ := secret.cipher.Encrypt(ctx, "test_access_key_id")
+				s, _ := secret.cipher.Encrypt(ctx, "test_secret_access_key")
 				v, _ := json.Marshal(primitive.NewAkSkSinkCredential(a, s))
 				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(v, nil)
 				credential, err := secret.Read(ctx, subID, primitive.AWS)
@@ -62,7 +65,7 @@ func TestSecretStorage(t *testing.T) {
 		Convey("test credential type gcloud", func() {
 			subID := vanus.NewTestID()
 			Convey("test read", func() {
-				a, _ := crypto.AESEncrypt("{\"type\":\"service_account\"}", secret.cipherKey)
+				a, _ := secret.cipher.Encrypt(ctx, "{\"type\":\"service_account\"}")
 				v, _ := json.Marshal(primitive.NewGCloudSinkCredential(a))
 				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(v, nil)
 				credential, err := secret.Read(ctx, subID, primitive.GCloud)
@@ -80,8 +83,8 @@ func TestSecretStorage(t *testing.T) {
 		Convey("test credential type plain", func() {
 			subID := vanus.NewTestID()
 			Convey("test read", func() {
-				a, _ := crypto.AESEncrypt("test_identifier", secret.cipherKey)
-				s, _ := crypto.AESEncrypt("test_secret", secret.cipherKey)
+				a, _ := secret.cipher.Encrypt(ctx, "test_identifier")
+				s, _ := secret.cipher.Encrypt(ctx, "test_secret")
 				v, _ := json.Marshal(primitive.NewPlainSinkCredential(a, s))
 				kvClient.EXPECT().Get(ctx, secret.getKey(subID)).Return(v, nil)
 				credential, err := secret.Read(ctx, subID, primitive.Plain)
@@ -97,6 +100,39 @@ func TestSecretStorage(t *testing.T) {
 				So(err, ShouldBeNil)
 			})
 		})
+		Convey("test named secret", func() {
+			s := &metadata.Secret{
+				Name:           "test",
+				CredentialType: primitive.Plain,
+				Credential:     primitive.NewPlainSinkCredential("test_identifier", "test_secret"),
+				Version:        1,
+			}
+			var saved []byte
+			kvClient.EXPECT().Set(ctx, secret.getNamedKey("test"), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+			So(secret.PutNamedSecret(ctx, s), ShouldBeNil)
+			So(string(saved), ShouldNotContainSubstring, "test_secret")
+
+			kvClient.EXPECT().Get(ctx, secret.getNamedKey("test")).Return(saved, nil)
+			got, err := secret.GetNamedSecret(ctx, "test")
+			So(err, ShouldBeNil)
+			So(got.Version, ShouldEqual, 1)
+			So(got.Credential, ShouldResemble, s.Credential)
+
+			kvClient.EXPECT().List(ctx, KeyPrefixNamedSecret.String()).Return([]kv.Pair{{Value: saved}}, nil)
+			list, err := secret.ListNamedSecret(ctx)
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Name, ShouldEqual, "test")
+			So(list[0].CredentialType, ShouldEqual, primitive.Plain)
+			So(list[0].Credential, ShouldBeNil)
+
+			kvClient.EXPECT().Delete(ctx, secret.getNamedKey("test")).Return(nil)
+			So(secret.DeleteNamedSecret(ctx, "test"), ShouldBeNil)
+		})
 		Convey("test delete", func() {
 			subID := vanus.NewTestID()
 			kvClient.EXPECT().Delete(ctx, secret.getKey(subID)).Return(nil)
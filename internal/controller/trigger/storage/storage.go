@@ -24,6 +24,7 @@ type Storage interface {
 	SubscriptionStorage
 	OffsetStorage
 	TriggerWorkerStorage
+	ConnectorStorage
 	Close()
 }
 
@@ -31,6 +32,7 @@ type storage struct {
 	SubscriptionStorage
 	OffsetStorage
 	TriggerWorkerStorage
+	ConnectorStorage
 	client kv.Client
 }
 
@@ -43,6 +45,7 @@ func NewStorage(config primitive.KvStorageConfig) (Storage, error) {
 	s.SubscriptionStorage = NewSubscriptionStorage(client)
 	s.OffsetStorage = NewOffsetStorage(client)
 	s.TriggerWorkerStorage = NewTriggerWorkerStorage(client)
+	s.ConnectorStorage = NewConnectorStorage(client)
 	return s, nil
 }
 
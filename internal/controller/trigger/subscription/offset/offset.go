@@ -162,11 +162,13 @@ func initSubscriptionOffset(ctx context.Context,
 	}
 	for _, o := range list {
 		subOffset.offsets.Store(o.EventLogID, &eventLogOffset{
-			subscriptionID: subscriptionID,
-			eventLogID:     o.EventLogID,
-			offset:         o.Offset,
-			commit:         o.Offset,
-			checkExist:     true,
+			subscriptionID:  subscriptionID,
+			eventLogID:      o.EventLogID,
+			offset:          o.Offset,
+			delivered:       o.Delivered,
+			commit:          o.Offset,
+			commitDelivered: o.Delivered,
+			checkExist:      true,
 		})
 	}
 	return subOffset, nil
@@ -189,7 +191,7 @@ func (o *subscriptionOffset) getEventLogOffset(info info.OffsetInfo) *eventLogOf
 func (o *subscriptionOffset) offset(infos info.ListOffsetInfo) {
 	for _, offset := range infos {
 		elOffset := o.getEventLogOffset(offset)
-		elOffset.setOffset(offset.Offset)
+		elOffset.setOffset(offset.Offset, offset.Delivered)
 	}
 }
 
@@ -200,6 +202,7 @@ func (o *subscriptionOffset) getOffsets() info.ListOffsetInfo {
 		offsets = append(offsets, info.OffsetInfo{
 			EventLogID: elOffset.eventLogID,
 			Offset:     elOffset.offset,
+			Delivered:  elOffset.delivered,
 		})
 		return true
 	})
@@ -234,23 +237,28 @@ func (o *subscriptionOffset) commitOffset(ctx context.Context, storage storage.O
 }
 
 type eventLogOffset struct {
-	subscriptionID vanus.ID
-	eventLogID     vanus.ID
-	offset         uint64
-	commit         uint64
-	checkExist     bool
+	subscriptionID  vanus.ID
+	eventLogID      vanus.ID
+	offset          uint64
+	delivered       []uint64
+	commit          uint64
+	commitDelivered []uint64
+	checkExist      bool
 }
 
-func (o *eventLogOffset) setOffset(offset uint64) {
+func (o *eventLogOffset) setOffset(offset uint64, delivered []uint64) {
 	o.offset = offset
+	o.delivered = delivered
 }
 
 func (o *eventLogOffset) commitOffset(ctx context.Context, storage storage.OffsetStorage) error {
 	offset := o.offset
+	delivered := o.delivered
 	if !o.checkExist {
 		err := storage.CreateOffset(ctx, o.subscriptionID, info.OffsetInfo{
 			EventLogID: o.eventLogID,
 			Offset:     offset,
+			Delivered:  delivered,
 		})
 		if err != nil {
 			return err
@@ -262,18 +270,33 @@ func (o *eventLogOffset) commitOffset(ctx context.Context, storage storage.Offse
 		})
 		o.checkExist = true
 		o.commit = offset
+		o.commitDelivered = delivered
 		return nil
 	}
-	if o.commit == offset {
+	if o.commit == offset && equalOffsets(o.commitDelivered, delivered) {
 		return nil
 	}
 	err := storage.UpdateOffset(ctx, o.subscriptionID, info.OffsetInfo{
 		EventLogID: o.eventLogID,
 		Offset:     offset,
+		Delivered:  delivered,
 	})
 	if err != nil {
 		return err
 	}
 	o.commit = offset
+	o.commitDelivered = delivered
 	return nil
 }
+
+func equalOffsets(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// maxConnectorReplicas bounds the instances of a connector, each instance runs on one trigger worker.
+const maxConnectorReplicas = 64
+
+func ValidateConnector(ctx context.Context, request *ctrlpb.Connector) error {
+	if request.Name == "" {
+		return errors.ErrInvalidRequest.WithMessage("connector name is empty")
+	}
+	if strings.Contains(request.Name, "/") {
+		return errors.ErrInvalidRequest.WithMessage("connector name can not contain /")
+	}
+	switch request.Kind {
+	case metadata.ConnectorKindSource, metadata.ConnectorKindSink:
+	default:
+		return errors.ErrInvalidRequest.WithMessage("connector kind must be source or sink")
+	}
+	if request.Plugin == "" {
+		return errors.ErrInvalidRequest.WithMessage("connector plugin is empty")
+	}
+	if request.Eventbus == "" {
+		return errors.ErrInvalidRequest.WithMessage("connector eventbus is empty")
+	}
+	if request.Replicas > maxConnectorReplicas {
+		return errors.ErrInvalidRequest.WithMessage("connector replicas is too large")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+func ValidatePutSecretRequest(ctx context.Context, request *ctrlpb.PutSecretRequest) error {
+	if request.Name == "" {
+		return errors.ErrInvalidRequest.WithMessage("secret name is empty")
+	}
+	if strings.Contains(request.Name, "/") {
+		return errors.ErrInvalidRequest.WithMessage("secret name can not contain /")
+	}
+	if request.Credential.GetCredentialType() == metapb.SinkCredential_None {
+		return errors.ErrInvalidRequest.WithMessage("secret credential is empty")
+	}
+	return validateCredential(request.Credential)
+}
+
+// ValidateSinkSecret validates the sink of the subscription which references a secret with the credential of it.
+func ValidateSinkSecret(ctx context.Context,
+	request *ctrlpb.SubscriptionRequest,
+	credential *metapb.SinkCredential) error {
+	if err := ValidateSinkAndProtocol(ctx, request.Sink, request.Protocol, credential); err != nil {
+		return err
+	}
+	return validateSinkCredential(ctx, request.Sink, credential)
+}
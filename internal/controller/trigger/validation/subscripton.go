@@ -18,12 +18,15 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/cel"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/runtime"
+	"github.com/linkall-labs/vanus/internal/trigger/client"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
@@ -33,6 +36,12 @@ import (
 	"google.golang.org/api/option"
 )
 
+// maxCommitInterval is the max offset commit interval by millisecond.
+const maxCommitInterval = 60 * 1000
+
+// maxCorrelationTimeout is the max correlation timeout by millisecond.
+const maxCorrelationTimeout = 24 * 60 * 60 * 1000
+
 func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.SubscriptionRequest) error {
 	if err := ValidateFilterList(ctx, request.Filters); err != nil {
 		return errors.ErrInvalidRequest.WithMessage("filters is invalid").Wrap(err)
@@ -40,11 +49,18 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := validateProtocol(ctx, request.Protocol); err != nil {
 		return err
 	}
-	if err := ValidateSinkAndProtocol(ctx, request.Sink, request.Protocol, request.SinkCredential); err != nil {
-		return err
-	}
-	if err := validateSinkCredential(ctx, request.Sink, request.SinkCredential); err != nil {
-		return err
+	if request.SinkSecret != "" {
+		// the sink is validated with the credential of the secret by ValidateSinkSecret.
+		if request.SinkCredential.GetCredentialType() != metapb.SinkCredential_None {
+			return errors.ErrInvalidRequest.WithMessage("sink credential and sink secret can not be both set")
+		}
+	} else {
+		if err := ValidateSinkAndProtocol(ctx, request.Sink, request.Protocol, request.SinkCredential); err != nil {
+			return err
+		}
+		if err := validateSinkCredential(ctx, request.Sink, request.SinkCredential); err != nil {
+			return err
+		}
 	}
 	if request.EventBus == "" {
 		return errors.ErrInvalidRequest.WithMessage("eventBus is empty")
@@ -52,9 +68,53 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := validateSubscriptionConfig(ctx, request.Config); err != nil {
 		return err
 	}
+	if request.Config.GetCorrelationEventbus() == request.EventBus {
+		return errors.ErrInvalidRequest.WithMessage("correlation eventbus can not be the subscribed eventbus")
+	}
+	if request.Config.GetReplyEventbus() != "" {
+		if request.Config.GetReplyEventbus() == request.EventBus {
+			return errors.ErrInvalidRequest.WithMessage("reply eventbus can not be the subscribed eventbus")
+		}
+		if request.Protocol != metapb.Protocol_HTTP {
+			return errors.ErrInvalidRequest.WithMessage("reply eventbus is only supported by http protocol")
+		}
+	}
 	if err := validateTransformer(ctx, request.Transformer); err != nil {
 		return err
 	}
+	if err := validateChatSetting(ctx, request); err != nil {
+		return err
+	}
+	return nil
+}
+
+// defaultDeliveryTimeout is the delivery timeout of trigger by millisecond if it isn't set.
+const defaultDeliveryTimeout = 5 * 1000
+
+func validateChatSetting(ctx context.Context, request *ctrlpb.SubscriptionRequest) error {
+	setting := request.ProtocolSettings.GetChat()
+	if setting == nil {
+		return nil
+	}
+	if request.Protocol != metapb.Protocol_CHAT {
+		return errors.ErrInvalidRequest.WithMessage("chat setting is only supported by chat protocol")
+	}
+	if _, err := client.ParseChatMessageTemplate(setting.MessageTemplate); err != nil {
+		return errors.ErrInvalidRequest.WithMessage("chat message template is invalid").Wrap(err)
+	}
+	// the message waits the coalesce window or the rate limit before it's posted, it must be posted in
+	// the delivery timeout.
+	timeout := request.Config.GetDeliveryTimeout()
+	if timeout == 0 {
+		timeout = defaultDeliveryTimeout
+	}
+	if setting.CoalesceWindow >= timeout {
+		return errors.ErrInvalidRequest.WithMessage("chat coalesce window must be less than delivery timeout")
+	}
+	if setting.MessagesPerMinute > 0 && 60*1000/setting.MessagesPerMinute >= timeout {
+		return errors.ErrInvalidRequest.WithMessage(
+			"the interval of chat messages per minute must be less than delivery timeout")
+	}
 	return nil
 }
 
@@ -63,6 +123,11 @@ func validateProtocol(ctx context.Context, protocol metapb.Protocol) error {
 	case metapb.Protocol_HTTP:
 	case metapb.Protocol_AWS_LAMBDA:
 	case metapb.Protocol_GCLOUD_FUNCTIONS:
+	case metapb.Protocol_AMQP:
+	case metapb.Protocol_ELASTICSEARCH:
+	case metapb.Protocol_OBJECT_STORAGE:
+	case metapb.Protocol_SQL:
+	case metapb.Protocol_CHAT:
 	default:
 		return errors.ErrInvalidRequest.WithMessage("protocol is invalid")
 	}
@@ -96,11 +161,87 @@ func ValidateSinkAndProtocol(ctx context.Context,
 			return errors.ErrInvalidRequest.
 				WithMessage("protocol is http, sink is url,url parse error").Wrap(err)
 		}
+	case metapb.Protocol_AMQP:
+		u, err := url.Parse(sink)
+		if err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is amqp, sink is url,url parse error").Wrap(err)
+		}
+		if (u.Scheme != "amqp" && u.Scheme != "amqps") || u.Host == "" || strings.Trim(u.Path, "/") == "" {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is amqp, sink must be amqp[s]://host[:port]/address")
+		}
+		switch credential.GetCredentialType() {
+		case metapb.SinkCredential_None, metapb.SinkCredential_PLAIN:
+		default:
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is amqp, sink credential type must be plain if it's set")
+		}
+	case metapb.Protocol_ELASTICSEARCH:
+		if _, err := client.ParseElasticsearchSink(sink); err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is elasticsearch, sink is invalid").Wrap(err)
+		}
+		switch credential.GetCredentialType() {
+		case metapb.SinkCredential_None, metapb.SinkCredential_PLAIN:
+		default:
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is elasticsearch, sink credential type must be plain if it's set")
+		}
+	case metapb.Protocol_OBJECT_STORAGE:
+		if _, err := client.ParseObjectStorageSink(sink); err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is object storage, sink is invalid").Wrap(err)
+		}
+		if credential.GetCredentialType() != metapb.SinkCredential_AWS {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is object storage, sink credential can not be nil and credential type is aws")
+		}
+	case metapb.Protocol_SQL:
+		if _, err := client.ParseSQLSink(sink); err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is sql, sink is invalid").Wrap(err)
+		}
+		switch credential.GetCredentialType() {
+		case metapb.SinkCredential_None, metapb.SinkCredential_PLAIN:
+		default:
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is sql, sink credential type must be plain if it's set")
+		}
+	case metapb.Protocol_CHAT:
+		u, err := url.Parse(sink)
+		if err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is chat, sink is url,url parse error").Wrap(err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is chat, sink must be the http[s] url of webhook")
+		}
+		if credential.GetCredentialType() != metapb.SinkCredential_None {
+			return errors.ErrInvalidRequest.
+				WithMessage("protocol is chat, sink credential isn't supported")
+		}
 	}
 	return nil
 }
 
 func validateSinkCredential(ctx context.Context, sink string, credential *metapb.SinkCredential) error {
+	if err := validateCredential(credential); err != nil {
+		return err
+	}
+	if credential.GetCredentialType() == metapb.SinkCredential_GCLOUD {
+		credentialJSON := credential.GetGcloud().GetCredentialsJson()
+		_, err := idtoken.NewTokenSource(ctx, sink, option.WithCredentialsJSON([]byte(credentialJSON)))
+		if err != nil {
+			return errors.ErrInvalidRequest.
+				WithMessage("gcloud credential json invalid").Wrap(err)
+		}
+	}
+	return nil
+}
+
+func validateCredential(credential *metapb.SinkCredential) error {
 	if credential == nil {
 		return nil
 	}
@@ -116,16 +257,10 @@ func validateSinkCredential(ctx context.Context, sink string, credential *metapb
 				WithMessage("sink credential type is aws,accessKeyId and SecretAccessKey can not empty")
 		}
 	case metapb.SinkCredential_GCLOUD:
-		credentialJSON := credential.GetGcloud().GetCredentialsJson()
-		if credentialJSON == "" {
+		if credential.GetGcloud().GetCredentialsJson() == "" {
 			return errors.ErrInvalidRequest.
 				WithMessage("sink credential type is gcloud,credential json can not empty")
 		}
-		_, err := idtoken.NewTokenSource(ctx, sink, option.WithCredentialsJSON([]byte(credentialJSON)))
-		if err != nil {
-			return errors.ErrInvalidRequest.
-				WithMessage("gcloud credential json invalid").Wrap(err)
-		}
 	default:
 		return errors.ErrInvalidRequest.WithMessage("sink credential type is invalid")
 	}
@@ -152,6 +287,62 @@ func validateSubscriptionConfig(ctx context.Context, cfg *metapb.SubscriptionCon
 		return errors.ErrInvalidRequest.WithMessage(
 			fmt.Sprintf("could not set max retry attempts greater than %d", primitive.MaxRetryAttempts))
 	}
+	if cfg.PoisonThreshold > primitive.MaxRetryAttempts {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("could not set poison threshold greater than %d", primitive.MaxRetryAttempts))
+	}
+	if cfg.DedupKeyAttribute != "" {
+		if cfg.DedupWindow == 0 {
+			return errors.ErrInvalidRequest.WithMessage("dedup key attribute is set but dedup window is 0")
+		}
+		if err := util.ValidateEventAttrName(cfg.DedupKeyAttribute); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("dedup key attribute is invalid").Wrap(err)
+		}
+	}
+	if cfg.OrderingKeyAttribute != "" {
+		if cfg.OrderedEvent {
+			return errors.ErrInvalidRequest.WithMessage("ordering key attribute can not be set with ordered event")
+		}
+		if err := util.ValidateEventAttrName(cfg.OrderingKeyAttribute); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("ordering key attribute is invalid").Wrap(err)
+		}
+	}
+	if cfg.CommitInterval > maxCommitInterval {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("could not set commit interval greater than %d", maxCommitInterval))
+	}
+	if err := validateEventlogs(cfg); err != nil {
+		return err
+	}
+	if cfg.CorrelationEventbus != "" {
+		if cfg.CorrelationKeyAttribute == "" {
+			return errors.ErrInvalidRequest.WithMessage("correlation eventbus is set but correlation key attribute is empty")
+		}
+		if err := util.ValidateEventAttrName(cfg.CorrelationKeyAttribute); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("correlation key attribute is invalid").Wrap(err)
+		}
+		if cfg.CorrelationTimeout > maxCorrelationTimeout {
+			return errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("could not set correlation timeout greater than %d", maxCorrelationTimeout))
+		}
+	}
+	return nil
+}
+
+func validateEventlogs(cfg *metapb.SubscriptionConfig) error {
+	if cfg.EventlogHashEnd == 0 {
+		if cfg.EventlogHashStart > 0 {
+			return errors.ErrInvalidRequest.WithMessage("eventlog hash start is set but eventlog hash end is 0")
+		}
+		return nil
+	}
+	if len(cfg.Eventlogs) > 0 {
+		return errors.ErrInvalidRequest.WithMessage("eventlogs and eventlog hash range can not be set both")
+	}
+	if cfg.EventlogHashEnd > primitive.EventlogHashSlots || cfg.EventlogHashStart >= cfg.EventlogHashEnd {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("eventlog hash range must be in [0, %d) and not empty", primitive.EventlogHashSlots))
+	}
 	return nil
 }
 
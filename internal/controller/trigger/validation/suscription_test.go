@@ -20,6 +20,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/linkall-labs/vanus/internal/primitive"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
@@ -48,6 +49,45 @@ func TestSubscriptionRequestValidator(t *testing.T) {
 		}
 		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
 	})
+	Convey("reply eventbus", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			Sink:     "http://example.com",
+			EventBus: "orders",
+			Config:   &metapb.SubscriptionConfig{ReplyEventbus: "replies"},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.Config.ReplyEventbus = "orders"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.Config.ReplyEventbus = "replies"
+		request.Protocol = metapb.Protocol_AMQP
+		request.Sink = "amqp://example.com"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+	})
+	Convey("chat setting", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			Sink:     "https://hooks.slack.com/services/T000/B000/XXX",
+			Protocol: metapb.Protocol_CHAT,
+			EventBus: "alerts",
+			ProtocolSettings: &metapb.ProtocolSetting{Chat: &metapb.ChatSetting{
+				MessageTemplate:   "{{.type}}: {{.data.message}}",
+				CoalesceWindow:    1000,
+				MessagesPerMinute: 20,
+			}},
+		}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.ProtocolSettings.Chat.MessageTemplate = "{{.type"
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.ProtocolSettings.Chat.MessageTemplate = ""
+		request.ProtocolSettings.Chat.CoalesceWindow = defaultDeliveryTimeout
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.Config = &metapb.SubscriptionConfig{DeliveryTimeout: 30 * 1000}
+		So(ValidateSubscriptionRequest(ctx, request), ShouldBeNil)
+		request.ProtocolSettings.Chat.MessagesPerMinute = 1
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+		request.ProtocolSettings.Chat.MessagesPerMinute = 0
+		request.Protocol = metapb.Protocol_HTTP
+		So(ValidateSubscriptionRequest(ctx, request), ShouldNotBeNil)
+	})
 }
 
 func TestValidateSubscriptionConfig(t *testing.T) {
@@ -66,6 +106,71 @@ func TestValidateSubscriptionConfig(t *testing.T) {
 			}
 			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
 		})
+		Convey("test dedup key attribute", func() {
+			config := &metapb.SubscriptionConfig{
+				DedupKeyAttribute: "orderid",
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.DedupWindow = 1000
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.DedupKeyAttribute = "order-id"
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test ordering key attribute", func() {
+			config := &metapb.SubscriptionConfig{
+				OrderingKeyAttribute: "orderid",
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.OrderedEvent = true
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test commit interval", func() {
+			config := &metapb.SubscriptionConfig{
+				CommitInterval: maxCommitInterval,
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.CommitInterval = maxCommitInterval + 1
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test poison threshold", func() {
+			config := &metapb.SubscriptionConfig{
+				PoisonThreshold: primitive.MaxRetryAttempts,
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.PoisonThreshold = primitive.MaxRetryAttempts + 1
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test eventlogs", func() {
+			config := &metapb.SubscriptionConfig{
+				Eventlogs: []uint64{1, 2},
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.EventlogHashEnd = 100
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.Eventlogs = nil
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.EventlogHashStart = 100
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.EventlogHashStart = 0
+			config.EventlogHashEnd = primitive.EventlogHashSlots + 1
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.EventlogHashStart = 1
+			config.EventlogHashEnd = 0
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
+		Convey("test correlation", func() {
+			config := &metapb.SubscriptionConfig{
+				CorrelationEventbus: "payments",
+			}
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.CorrelationKeyAttribute = "orderid"
+			So(validateSubscriptionConfig(ctx, config), ShouldBeNil)
+			config.CorrelationTimeout = maxCorrelationTimeout + 1
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+			config.CorrelationTimeout = 0
+			config.CorrelationKeyAttribute = "order-id"
+			So(validateSubscriptionConfig(ctx, config), ShouldNotBeNil)
+		})
 	})
 }
 
@@ -147,6 +252,90 @@ func TestValidateSinkAndProtocol(t *testing.T) {
 			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_GCLOUD_FUNCTIONS, credential), ShouldBeNil)
 		})
 	})
+	Convey("subscription protocol is amqp", t, func() {
+		Convey("sink is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "http://127.0.0.1/queue", metapb.Protocol_AMQP, nil), ShouldNotBeNil)
+			So(ValidateSinkAndProtocol(ctx, "amqp://127.0.0.1", metapb.Protocol_AMQP, nil), ShouldNotBeNil)
+		})
+		Convey("sink credential type is invalid", func() {
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_AWS}
+			So(ValidateSinkAndProtocol(ctx, "amqp://127.0.0.1/queue", metapb.Protocol_AMQP, credential), ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			So(ValidateSinkAndProtocol(ctx, "amqps://127.0.0.1:5671/queue", metapb.Protocol_AMQP, nil), ShouldBeNil)
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_PLAIN}
+			So(ValidateSinkAndProtocol(ctx, "amqp://127.0.0.1/queue", metapb.Protocol_AMQP, credential), ShouldBeNil)
+		})
+	})
+	Convey("subscription protocol is elasticsearch", t, func() {
+		Convey("sink is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "http://127.0.0.1:9200", metapb.Protocol_ELASTICSEARCH, nil), ShouldNotBeNil)
+			So(ValidateSinkAndProtocol(ctx, "http://127.0.0.1:9200/events?bulk_size=-1",
+				metapb.Protocol_ELASTICSEARCH, nil), ShouldNotBeNil)
+		})
+		Convey("sink credential type is invalid", func() {
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_AWS}
+			So(ValidateSinkAndProtocol(ctx, "http://127.0.0.1:9200/events",
+				metapb.Protocol_ELASTICSEARCH, credential), ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			So(ValidateSinkAndProtocol(ctx, "https://127.0.0.1:9200/events-{time:2006.01.02}",
+				metapb.Protocol_ELASTICSEARCH, nil), ShouldBeNil)
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_PLAIN}
+			So(ValidateSinkAndProtocol(ctx, "http://127.0.0.1:9200/events",
+				metapb.Protocol_ELASTICSEARCH, credential), ShouldBeNil)
+		})
+	})
+	Convey("subscription protocol is object storage", t, func() {
+		credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_AWS}
+		Convey("sink is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "https://bucket/archive", metapb.Protocol_OBJECT_STORAGE, credential),
+				ShouldNotBeNil)
+			So(ValidateSinkAndProtocol(ctx, "s3://bucket/archive?format=csv", metapb.Protocol_OBJECT_STORAGE, credential),
+				ShouldNotBeNil)
+		})
+		Convey("sink credential type is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "s3://bucket/archive", metapb.Protocol_OBJECT_STORAGE, nil), ShouldNotBeNil)
+			credential.CredentialType = metapb.SinkCredential_PLAIN
+			So(ValidateSinkAndProtocol(ctx, "s3://bucket/archive", metapb.Protocol_OBJECT_STORAGE, credential),
+				ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			So(ValidateSinkAndProtocol(ctx, "s3://bucket/archive/{time:2006/01/02}?format=parquet",
+				metapb.Protocol_OBJECT_STORAGE, credential), ShouldBeNil)
+			So(ValidateSinkAndProtocol(ctx, "gs://bucket", metapb.Protocol_OBJECT_STORAGE, credential), ShouldBeNil)
+		})
+	})
+	Convey("subscription protocol is sql", t, func() {
+		sink := "postgres://127.0.0.1:5432/db?table=orders&key=id&column.id=$.data.id&column.source=source"
+		Convey("sink is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "postgres://127.0.0.1:5432/db?table=orders", metapb.Protocol_SQL, nil),
+				ShouldNotBeNil)
+		})
+		Convey("sink credential type is invalid", func() {
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_AWS}
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_SQL, credential), ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_SQL, nil), ShouldBeNil)
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_PLAIN}
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_SQL, credential), ShouldBeNil)
+		})
+	})
+	Convey("subscription protocol is chat", t, func() {
+		sink := "https://discord.com/api/webhooks/1/token"
+		Convey("sink is invalid", func() {
+			So(ValidateSinkAndProtocol(ctx, "discord.com/api/webhooks/1/token", metapb.Protocol_CHAT, nil),
+				ShouldNotBeNil)
+		})
+		Convey("sink credential isn't supported", func() {
+			credential := &metapb.SinkCredential{CredentialType: metapb.SinkCredential_PLAIN}
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_CHAT, credential), ShouldNotBeNil)
+		})
+		Convey("all valid", func() {
+			So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_CHAT, nil), ShouldBeNil)
+		})
+	})
 }
 
 func TestValidateSinkCredential(t *testing.T) {
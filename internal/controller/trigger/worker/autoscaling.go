@@ -0,0 +1,135 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultAutoscalingTargetLoad = 1000
+	autoscalingWebhookTimeout    = 5 * time.Second
+)
+
+type AutoscalingConfig struct {
+	// Webhook the load report is posted to it as JSON every rebalance interval, so that an external
+	// autoscaler, e.g. a KEDA metrics API scaler, adds or removes trigger workers. Empty means disable.
+	Webhook string `yaml:"webhook"`
+	// TargetLoad is the load a trigger worker is expected to take, the desired number of trigger
+	// workers is the total load divided by it.
+	TargetLoad float64 `yaml:"target_load"`
+	MinWorkers int     `yaml:"min_workers"`
+	// MaxWorkers 0 means no limit.
+	MaxWorkers int `yaml:"max_workers"`
+}
+
+func (c *AutoscalingConfig) init() {
+	if c.TargetLoad <= 0 {
+		c.TargetLoad = defaultAutoscalingTargetLoad
+	}
+	if c.MinWorkers <= 0 {
+		c.MinWorkers = 1
+	}
+}
+
+// desiredWorkers returns the number of trigger workers which take the load at the target.
+func (c *AutoscalingConfig) desiredWorkers(totalLoad float64) int {
+	n := int(math.Ceil(totalLoad / c.TargetLoad))
+	if n < c.MinWorkers {
+		n = c.MinWorkers
+	}
+	if c.MaxWorkers > 0 && n > c.MaxWorkers {
+		n = c.MaxWorkers
+	}
+	return n
+}
+
+type LoadReport struct {
+	Time           time.Time          `json:"time"`
+	Workers        []WorkerLoadReport `json:"workers"`
+	TotalLoad      float64            `json:"total_load"`
+	CurrentWorkers int                `json:"current_workers"`
+	DesiredWorkers int                `json:"desired_workers"`
+}
+
+type WorkerLoadReport struct {
+	Addr          string  `json:"addr"`
+	Load          float64 `json:"load"`
+	Subscriptions int     `json:"subscriptions"`
+}
+
+// AutoscalingHook is told the load of the running trigger workers every rebalance interval, it's
+// called before the subscriptions are moved.
+type AutoscalingHook interface {
+	OnLoadReport(ctx context.Context, report LoadReport) error
+}
+
+func newLoadReport(workers []*workerLoad, config AutoscalingConfig, now time.Time) LoadReport {
+	report := LoadReport{
+		Time:           now,
+		Workers:        make([]WorkerLoadReport, 0, len(workers)),
+		CurrentWorkers: len(workers),
+	}
+	for _, w := range workers {
+		report.TotalLoad += w.load
+		report.Workers = append(report.Workers, WorkerLoadReport{
+			Addr:          w.addr,
+			Load:          w.load,
+			Subscriptions: len(w.subscriptions),
+		})
+	}
+	report.DesiredWorkers = config.desiredWorkers(report.TotalLoad)
+	return report
+}
+
+type webhookAutoscalingHook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAutoscalingHook posts the load report to the url.
+func NewWebhookAutoscalingHook(url string) AutoscalingHook {
+	return &webhookAutoscalingHook{
+		url:    url,
+		client: &http.Client{Timeout: autoscalingWebhookTimeout},
+	}
+}
+
+func (h *webhookAutoscalingHook) OnLoadReport(ctx context.Context, report LoadReport) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = res.Body.Close()
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("autoscaling webhook responded %s", res.Status)
+	}
+	return nil
+}
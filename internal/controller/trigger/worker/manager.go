@@ -43,8 +43,9 @@ type Manager interface {
 	AddTriggerWorker(ctx context.Context, addr string) error
 	GetTriggerWorker(addr string) TriggerWorker
 	RemoveTriggerWorker(ctx context.Context, addr string)
-	UpdateTriggerWorkerInfo(ctx context.Context, addr string) error
+	UpdateTriggerWorkerInfo(ctx context.Context, addr string, loads []metadata.SubscriptionLoad) error
 	GetActiveRunningTriggerWorker() []metadata.TriggerWorkerInfo
+	ListTriggerWorker() []TriggerWorker
 	Init(ctx context.Context) error
 	Start()
 	Stop()
@@ -65,6 +66,10 @@ type Config struct {
 
 	StartWorkerDuration       time.Duration
 	StartSubscriptionDuration time.Duration
+
+	Rebalance RebalanceConfig
+	// AutoscalingHook defaults to posting the load to Rebalance.Autoscaling.Webhook if it's set.
+	AutoscalingHook AutoscalingHook
 }
 
 func (c *Config) init() {
@@ -86,6 +91,10 @@ func (c *Config) init() {
 	if c.StartWorkerDuration <= 0 {
 		c.StartWorkerDuration = defaultStartWorkerDuration
 	}
+	c.Rebalance.init()
+	if c.AutoscalingHook == nil && c.Rebalance.Autoscaling.Webhook != "" {
+		c.AutoscalingHook = NewWebhookAutoscalingHook(c.Rebalance.Autoscaling.Webhook)
+	}
 }
 
 type manager struct {
@@ -179,7 +188,8 @@ func (m *manager) RemoveTriggerWorker(ctx context.Context, addr string) {
 	m.cleanTriggerWorker(ctx, tWorker)
 }
 
-func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string) error {
+func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string,
+	loads []metadata.SubscriptionLoad) error {
 	tWorker := m.GetTriggerWorker(addr)
 	if tWorker == nil {
 		return ErrTriggerWorkerNotFound
@@ -198,6 +208,7 @@ func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string) erro
 			})
 		}
 	}
+	tWorker.UpdateSubscriptionLoad(loads)
 	tWorker.Polish()
 	return nil
 }
@@ -219,6 +230,9 @@ func (m *manager) startTriggerWorker(ctx context.Context, tWorker TriggerWorker)
 	for _, id := range assignSubscription {
 		tWorker.AssignSubscription(id)
 	}
+	for _, id := range tWorker.GetStandbySubscriptions() {
+		tWorker.AssignStandbySubscription(id)
+	}
 }
 func (m *manager) cleanTriggerWorker(ctx context.Context, tWorker TriggerWorker) {
 	hasFail := m.doTriggerWorkerLeave(ctx, tWorker)
@@ -264,22 +278,47 @@ func (m *manager) doTriggerWorkerLeave(ctx context.Context, tWorker TriggerWorke
 			})
 		}
 	}
+	// the subscriptions need another standby trigger worker
+	for _, id := range tWorker.GetStandbySubscriptions() {
+		err := m.onRemoveSubscription(ctx, id, tWorker.GetAddr())
+		if err != nil {
+			hasFail = true
+			log.Warning(ctx, "trigger worker leave on remove standby subscription error", map[string]interface{}{
+				log.KeyError:             err,
+				log.KeySubscriptionID:    id,
+				log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
+			})
+		}
+	}
 	return hasFail
 }
 
 func (m *manager) GetActiveRunningTriggerWorker() []metadata.TriggerWorkerInfo {
+	tWorkers := m.getActiveRunningTriggerWorker()
+	runningTriggerWorker := make([]metadata.TriggerWorkerInfo, 0, len(tWorkers))
+	for _, tWorker := range tWorkers {
+		runningTriggerWorker = append(runningTriggerWorker, tWorker.GetInfo())
+	}
+	return runningTriggerWorker
+}
+
+func (m *manager) getActiveRunningTriggerWorker() []TriggerWorker {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 	now := time.Now()
-	runningTriggerWorker := make([]metadata.TriggerWorkerInfo, 0)
+	tWorkers := make([]TriggerWorker, 0)
 	for _, tWorker := range m.triggerWorkers {
 		if !tWorker.IsActive() ||
 			now.Sub(tWorker.GetHeartbeatTime()) > 10*time.Second {
 			continue
 		}
-		runningTriggerWorker = append(runningTriggerWorker, tWorker.GetInfo())
+		tWorkers = append(tWorkers, tWorker)
 	}
-	return runningTriggerWorker
+	return tWorkers
+}
+
+func (m *manager) ListTriggerWorker() []TriggerWorker {
+	return m.getTriggerWorkers()
 }
 
 func (m *manager) getTriggerWorkers() []TriggerWorker {
@@ -323,6 +362,12 @@ func (m *manager) Init(ctx context.Context) error {
 				tWorker.AssignSubscription(metaData.ID)
 			}
 		}
+		if metaData.StandbyWorker != "" {
+			tWorker, exist := m.triggerWorkers[metaData.StandbyWorker]
+			if exist {
+				tWorker.AssignStandbySubscription(metaData.ID)
+			}
+		}
 	}
 	return nil
 }
@@ -338,6 +383,9 @@ func (m *manager) Stop() {
 
 func (m *manager) Start() {
 	go util.UntilWithContext(m.ctx, m.check, m.config.CheckInterval)
+	if !m.config.Rebalance.Disable || m.config.AutoscalingHook != nil {
+		go util.UntilWithContext(m.ctx, m.rebalance, m.config.Rebalance.Interval)
+	}
 }
 
 func (m *manager) check(ctx context.Context) {
@@ -412,6 +460,7 @@ func (m *manager) runningTriggerWorkerHandler(ctx context.Context, tWorker Trigg
 				log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
 			})
 		}
+		m.failoverToStandby(ctx, tWorker)
 	} else if d > m.config.LostHeartbeatTime {
 		log.Warning(ctx, "trigger worker lost heartbeat", map[string]interface{}{
 			log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
@@ -419,3 +468,29 @@ func (m *manager) runningTriggerWorkerHandler(ctx context.Context, tWorker Trigg
 		})
 	}
 }
+
+// failoverToStandby moves the subscriptions which have a running standby trigger worker away from the
+// disconnected trigger worker at once, the others wait it to reconnect until disconnect clean time.
+func (m *manager) failoverToStandby(ctx context.Context, tWorker TriggerWorker) {
+	for _, id := range tWorker.GetAssignedSubscriptions() {
+		sub := m.subscriptionManager.GetSubscription(ctx, id)
+		if sub == nil || sub.StandbyWorker == "" || m.GetTriggerWorker(sub.StandbyWorker) == nil {
+			continue
+		}
+		err := m.onRemoveSubscription(ctx, id, tWorker.GetAddr())
+		if err != nil {
+			log.Warning(ctx, "failover subscription to standby trigger worker error", map[string]interface{}{
+				log.KeyError:             err,
+				log.KeySubscriptionID:    id,
+				log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
+			})
+			continue
+		}
+		tWorker.UnAssignSubscription(id)
+		log.Info(ctx, "failover subscription to standby trigger worker", map[string]interface{}{
+			log.KeySubscriptionID:    id,
+			log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
+			"standby":                sub.StandbyWorker,
+		})
+	}
+}
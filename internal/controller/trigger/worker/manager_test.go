@@ -129,6 +129,7 @@ func TestRemoveTriggerWorker(t *testing.T) {
 		workerStorage.EXPECT().DeleteTriggerWorker(ctx, gomock.Any()).AnyTimes().Return(nil)
 		tWorker.EXPECT().SetPhase(metadata.TriggerWorkerPhasePaused).AnyTimes().Return()
 		tWorker.EXPECT().GetAssignedSubscriptions().AnyTimes().Return([]vanus.ID{sub.ID})
+		tWorker.EXPECT().GetStandbySubscriptions().AnyTimes().Return(nil)
 		tWorker.EXPECT().Close().AnyTimes().Return(nil)
 		Convey("test remove subscription no error", func() {
 			twManager.triggerWorkers[addr] = tWorker
@@ -157,15 +158,16 @@ func TestManager_UpdateTriggerWorkerInfo(t *testing.T) {
 		workerStorage := storage.NewMockTriggerWorkerStorage(ctrl)
 		twManager := NewTriggerWorkerManager(Config{}, workerStorage, nil, getTestTriggerWorkerRemoveSubscription()).(*manager)
 		Convey("trigger worker not exist", func() {
-			err := twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err := twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldNotBeNil)
 		})
 		tWorker.EXPECT().Polish().AnyTimes().Return()
+		tWorker.EXPECT().UpdateSubscriptionLoad(gomock.Any()).AnyTimes().Return()
 		tWorker.EXPECT().GetInfo().AnyTimes().Return(metadata.TriggerWorkerInfo{})
 		Convey("trigger worker running", func() {
 			twManager.triggerWorkers[addr] = tWorker
 			tWorker.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
-			err := twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err := twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldBeNil)
 		})
 
@@ -175,10 +177,10 @@ func TestManager_UpdateTriggerWorkerInfo(t *testing.T) {
 			tWorker.EXPECT().SetPhase(metadata.TriggerWorkerPhaseRunning).AnyTimes().Return()
 			tWorker.EXPECT().GetAddr().Return(addr)
 			workerStorage.EXPECT().SaveTriggerWorker(gomock.Any(), gomock.Any()).Return(nil)
-			err := twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err := twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldBeNil)
 			workerStorage.EXPECT().SaveTriggerWorker(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
-			err = twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err = twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldBeNil)
 		})
 	})
@@ -205,6 +207,8 @@ func TestPendingTriggerWorkerHandler(t *testing.T) {
 			time.Sleep(time.Millisecond)
 			tWorker.EXPECT().GetAssignedSubscriptions().AnyTimes().Return([]vanus.ID{vanus.NewTestID()})
 			tWorker.EXPECT().AssignSubscription(gomock.Any()).AnyTimes().Return()
+			tWorker.EXPECT().GetStandbySubscriptions().AnyTimes().Return([]vanus.ID{vanus.NewTestID()})
+			tWorker.EXPECT().AssignStandbySubscription(gomock.Any()).AnyTimes().Return()
 			tWorker.EXPECT().RemoteStart(ctx).Return(nil)
 			twManager.pendingTriggerWorkerHandler(ctx, tWorker)
 			tWorker.EXPECT().RemoteStart(ctx).Return(fmt.Errorf("start trigget worker error"))
@@ -214,6 +218,7 @@ func TestPendingTriggerWorkerHandler(t *testing.T) {
 			tWorker.EXPECT().GetPendingTime().Return(time.Now().Add(twManager.config.WaitRunningTimeout * -1))
 			tWorker.EXPECT().SetPhase(metadata.TriggerWorkerPhasePaused).Return()
 			tWorker.EXPECT().GetAssignedSubscriptions().Return([]vanus.ID{sub.ID})
+			tWorker.EXPECT().GetStandbySubscriptions().Return(nil)
 			workerStorage.EXPECT().DeleteTriggerWorker(ctx, gomock.Any()).Return(nil)
 			time.Sleep(time.Millisecond)
 			twManager.pendingTriggerWorkerHandler(ctx, tWorker)
@@ -241,8 +246,37 @@ func TestRunningTriggerWorkerHandler(t *testing.T) {
 			tWorker.EXPECT().GetHeartbeatTime().Return(hbTime)
 			tWorker.EXPECT().SetPhase(metadata.TriggerWorkerPhaseDisconnect).Return()
 			workerStorage.EXPECT().SaveTriggerWorker(ctx, gomock.Any()).Return(nil)
+			tWorker.EXPECT().GetAssignedSubscriptions().Return(nil)
+			time.Sleep(time.Millisecond)
+			twManager.runningTriggerWorkerHandler(ctx, tWorker)
+		})
+
+		Convey("running worker heartbeat timeout failover to standby", func() {
+			standbyAddr := "standby"
+			standby := NewMockTriggerWorker(ctrl)
+			standby.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
+			twManager.triggerWorkers[standbyAddr] = standby
+			sub := getTestSubscription()
+			sub.TriggerWorker = addr
+			sub.StandbyWorker = standbyAddr
+			other := getTestSubscription()
+			other.TriggerWorker = addr
+			subManager.EXPECT().GetSubscription(ctx, sub.ID).Return(sub)
+			subManager.EXPECT().GetSubscription(ctx, other.ID).Return(other)
+			tWorker.EXPECT().IsActive().Return(true)
+			tWorker.EXPECT().GetHeartbeatTime().Return(time.Now().Add(twManager.config.HeartbeatTimeout * -1))
+			tWorker.EXPECT().SetPhase(metadata.TriggerWorkerPhaseDisconnect).Return()
+			workerStorage.EXPECT().SaveTriggerWorker(ctx, gomock.Any()).Return(nil)
+			tWorker.EXPECT().GetAssignedSubscriptions().Return([]vanus.ID{sub.ID, other.ID})
+			tWorker.EXPECT().UnAssignSubscription(sub.ID).Times(1)
+			var removed []vanus.ID
+			twManager.onRemoveSubscription = func(_ context.Context, id vanus.ID, _ string) error {
+				removed = append(removed, id)
+				return nil
+			}
 			time.Sleep(time.Millisecond)
 			twManager.runningTriggerWorkerHandler(ctx, tWorker)
+			So(removed, ShouldResemble, []vanus.ID{sub.ID})
 		})
 
 		Convey("running worker lost heartbeat ", func() {
@@ -285,6 +319,7 @@ func TestManagerCheck(t *testing.T) {
 			hbTime := time.Now().Add(twManager.config.DisconnectCleanTime * -1)
 			tWorker.EXPECT().GetHeartbeatTime().Return(hbTime)
 			tWorker.EXPECT().GetAssignedSubscriptions().Return(nil)
+			tWorker.EXPECT().GetStandbySubscriptions().Return(nil)
 			time.Sleep(time.Millisecond)
 			workerStorage.EXPECT().DeleteTriggerWorker(ctx, gomock.Any()).Return(nil)
 			twManager.check(ctx)
@@ -292,6 +327,7 @@ func TestManagerCheck(t *testing.T) {
 		Convey("pause check", func() {
 			tWorker.EXPECT().GetPhase().Return(metadata.TriggerWorkerPhasePaused)
 			tWorker.EXPECT().GetAssignedSubscriptions().Return(nil)
+			tWorker.EXPECT().GetStandbySubscriptions().Return(nil)
 			workerStorage.EXPECT().DeleteTriggerWorker(ctx, gomock.Any()).Return(nil)
 			twManager.check(ctx)
 		})
@@ -37,6 +37,18 @@ func (m *MockTriggerWorker) EXPECT() *MockTriggerWorkerMockRecorder {
 	return m.recorder
 }
 
+// AssignStandbySubscription mocks base method.
+func (m *MockTriggerWorker) AssignStandbySubscription(id vanus.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AssignStandbySubscription", id)
+}
+
+// AssignStandbySubscription indicates an expected call of AssignStandbySubscription.
+func (mr *MockTriggerWorkerMockRecorder) AssignStandbySubscription(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignStandbySubscription", reflect.TypeOf((*MockTriggerWorker)(nil).AssignStandbySubscription), id)
+}
+
 // AssignSubscription mocks base method.
 func (m *MockTriggerWorker) AssignSubscription(id vanus.ID) {
 	m.ctrl.T.Helper()
@@ -147,6 +159,34 @@ func (mr *MockTriggerWorkerMockRecorder) GetPhase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhase", reflect.TypeOf((*MockTriggerWorker)(nil).GetPhase))
 }
 
+// GetStandbySubscriptions mocks base method.
+func (m *MockTriggerWorker) GetStandbySubscriptions() []vanus.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandbySubscriptions")
+	ret0, _ := ret[0].([]vanus.ID)
+	return ret0
+}
+
+// GetStandbySubscriptions indicates an expected call of GetStandbySubscriptions.
+func (mr *MockTriggerWorkerMockRecorder) GetStandbySubscriptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandbySubscriptions", reflect.TypeOf((*MockTriggerWorker)(nil).GetStandbySubscriptions))
+}
+
+// GetSubscriptionLoad mocks base method.
+func (m *MockTriggerWorker) GetSubscriptionLoad() []metadata.SubscriptionLoad {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionLoad")
+	ret0, _ := ret[0].([]metadata.SubscriptionLoad)
+	return ret0
+}
+
+// GetSubscriptionLoad indicates an expected call of GetSubscriptionLoad.
+func (mr *MockTriggerWorkerMockRecorder) GetSubscriptionLoad() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionLoad", reflect.TypeOf((*MockTriggerWorker)(nil).GetSubscriptionLoad))
+}
+
 // IsActive mocks base method.
 func (m *MockTriggerWorker) IsActive() bool {
 	m.ctrl.T.Helper()
@@ -253,6 +293,18 @@ func (mr *MockTriggerWorkerMockRecorder) Start(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTriggerWorker)(nil).Start), ctx)
 }
 
+// UnAssignStandbySubscription mocks base method.
+func (m *MockTriggerWorker) UnAssignStandbySubscription(id vanus.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnAssignStandbySubscription", id)
+}
+
+// UnAssignStandbySubscription indicates an expected call of UnAssignStandbySubscription.
+func (mr *MockTriggerWorkerMockRecorder) UnAssignStandbySubscription(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnAssignStandbySubscription", reflect.TypeOf((*MockTriggerWorker)(nil).UnAssignStandbySubscription), id)
+}
+
 // UnAssignSubscription mocks base method.
 func (m *MockTriggerWorker) UnAssignSubscription(id vanus.ID) {
 	m.ctrl.T.Helper()
@@ -264,3 +316,15 @@ func (mr *MockTriggerWorkerMockRecorder) UnAssignSubscription(id interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnAssignSubscription", reflect.TypeOf((*MockTriggerWorker)(nil).UnAssignSubscription), id)
 }
+
+// UpdateSubscriptionLoad mocks base method.
+func (m *MockTriggerWorker) UpdateSubscriptionLoad(loads []metadata.SubscriptionLoad) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateSubscriptionLoad", loads)
+}
+
+// UpdateSubscriptionLoad indicates an expected call of UpdateSubscriptionLoad.
+func (mr *MockTriggerWorkerMockRecorder) UpdateSubscriptionLoad(loads interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionLoad", reflect.TypeOf((*MockTriggerWorker)(nil).UpdateSubscriptionLoad), loads)
+}
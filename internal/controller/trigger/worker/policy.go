@@ -44,3 +44,19 @@ type RandomPolicy struct {
 func (r *RandomPolicy) Acquire(ctx context.Context, workers []metadata.TriggerWorkerInfo) metadata.TriggerWorkerInfo {
 	return workers[rand.New(rand.NewSource(time.Now().Unix())).Intn(len(workers))]
 }
+
+// LeastLoadPolicy acquire the trigger worker which has the least load.
+type LeastLoadPolicy struct {
+}
+
+func (l *LeastLoadPolicy) Acquire(ctx context.Context,
+	workers []metadata.TriggerWorkerInfo) metadata.TriggerWorkerInfo {
+	idx := 0
+	for i := 1; i < len(workers); i++ {
+		if workers[i].Load < workers[idx].Load ||
+			(workers[i].Load == workers[idx].Load && workers[i].Addr < workers[idx].Addr) {
+			idx = i
+		}
+	}
+	return workers[idx]
+}
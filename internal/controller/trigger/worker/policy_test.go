@@ -47,6 +47,24 @@ func TestRoundRobinPolicy(t *testing.T) {
 	})
 }
 
+func TestLeastLoadPolicy(t *testing.T) {
+	ctx := context.Background()
+	Convey("least load policy", t, func() {
+		p := &LeastLoadPolicy{}
+		tWorkers := getTriggerWorker(10)
+		for i := range tWorkers {
+			tWorkers[i].Load = float64(10 - i)
+		}
+		So(p.Acquire(ctx, tWorkers).ID, ShouldEqual, "9")
+		tWorkers[3].Load = 0
+		So(p.Acquire(ctx, tWorkers).ID, ShouldEqual, "3")
+		Convey("same load choose by addr", func() {
+			tWorkers[5].Load = 0
+			So(p.Acquire(ctx, tWorkers).ID, ShouldEqual, "3")
+		})
+	})
+}
+
 func getTriggerWorker(size int) []metadata.TriggerWorkerInfo {
 	var list []metadata.TriggerWorkerInfo
 	for i := 0; i < size; i++ {
@@ -0,0 +1,206 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+const (
+	defaultRebalanceInterval  = time.Minute
+	defaultRebalanceThreshold = 1.5
+	defaultMinAssignDuration  = 5 * time.Minute
+	defaultMaxRebalanceMove   = 1
+)
+
+type RebalanceConfig struct {
+	// Disable subscription will not be moved between trigger workers by load.
+	Disable  bool          `yaml:"disable"`
+	Interval time.Duration `yaml:"interval"`
+	// Threshold the trigger worker which load more than average load * Threshold need rebalance.
+	Threshold float64 `yaml:"threshold"`
+	// MinAssignDuration the subscription assigned less than it is sticky and will not be moved.
+	MinAssignDuration time.Duration `yaml:"min_assign_duration"`
+	// MaxMove the max subscription number moved in one round.
+	MaxMove int `yaml:"max_move"`
+	// Autoscaling the load is reported to the AutoscalingHook every interval, even if rebalance
+	// is disabled.
+	Autoscaling AutoscalingConfig `yaml:"autoscaling"`
+}
+
+func (c *RebalanceConfig) init() {
+	if c.Interval <= 0 {
+		c.Interval = defaultRebalanceInterval
+	}
+	if c.Threshold <= 1 {
+		c.Threshold = defaultRebalanceThreshold
+	}
+	if c.MinAssignDuration <= 0 {
+		c.MinAssignDuration = defaultMinAssignDuration
+	}
+	if c.MaxMove <= 0 {
+		c.MaxMove = defaultMaxRebalanceMove
+	}
+	c.Autoscaling.init()
+}
+
+type workerLoad struct {
+	addr          string
+	load          float64
+	subscriptions []metadata.SubscriptionLoad
+}
+
+type subscriptionMove struct {
+	subscriptionID vanus.ID
+	from           string
+	to             string
+}
+
+// planRebalance move subscription from the most loaded trigger worker to the least loaded one,
+// only when the most loaded is over threshold and the move make the difference smaller, so that
+// subscriptions keep stay in the assigned trigger worker as much as possible.
+func planRebalance(workers []*workerLoad, config RebalanceConfig, now time.Time) []subscriptionMove {
+	if len(workers) < 2 {
+		return nil
+	}
+	var total float64
+	for _, w := range workers {
+		total += w.load
+	}
+	avg := total / float64(len(workers))
+	moves := make([]subscriptionMove, 0)
+	for len(moves) < config.MaxMove {
+		sort.Slice(workers, func(i, j int) bool {
+			if workers[i].load == workers[j].load {
+				return workers[i].addr < workers[j].addr
+			}
+			return workers[i].load < workers[j].load
+		})
+		least, most := workers[0], workers[len(workers)-1]
+		if most.load <= avg*config.Threshold {
+			break
+		}
+		gap := most.load - least.load
+		idx := -1
+		for i, sub := range most.subscriptions {
+			if now.Sub(sub.AssignTime) < config.MinAssignDuration {
+				continue
+			}
+			score := sub.Score()
+			if score >= gap {
+				continue
+			}
+			if idx == -1 || score > most.subscriptions[idx].Score() {
+				idx = i
+			}
+		}
+		if idx == -1 {
+			break
+		}
+		sub := most.subscriptions[idx]
+		most.subscriptions = append(most.subscriptions[:idx], most.subscriptions[idx+1:]...)
+		most.load -= sub.Score()
+		sub.AssignTime = now
+		least.subscriptions = append(least.subscriptions, sub)
+		least.load += sub.Score()
+		moves = append(moves, subscriptionMove{
+			subscriptionID: sub.SubscriptionID,
+			from:           most.addr,
+			to:             least.addr,
+		})
+	}
+	return moves
+}
+
+func (m *manager) rebalance(ctx context.Context) {
+	workers := make([]*workerLoad, 0)
+	for _, tWorker := range m.getActiveRunningTriggerWorker() {
+		workers = append(workers, &workerLoad{
+			addr:          tWorker.GetAddr(),
+			load:          tWorker.GetInfo().Load,
+			subscriptions: tWorker.GetSubscriptionLoad(),
+		})
+	}
+	now := time.Now()
+	if m.config.AutoscalingHook != nil {
+		report := newLoadReport(workers, m.config.Rebalance.Autoscaling, now)
+		if err := m.config.AutoscalingHook.OnLoadReport(ctx, report); err != nil {
+			log.Warning(ctx, "report trigger worker load to autoscaling hook error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+	if m.config.Rebalance.Disable {
+		return
+	}
+	for _, move := range planRebalance(workers, m.config.Rebalance, now) {
+		err := m.moveSubscription(ctx, move)
+		if err != nil {
+			log.Warning(ctx, "rebalance move subscription error", map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: move.subscriptionID,
+				"from":                move.from,
+				"to":                  move.to,
+			})
+			continue
+		}
+		log.Info(ctx, "rebalance move subscription", map[string]interface{}{
+			log.KeySubscriptionID: move.subscriptionID,
+			"from":                move.from,
+			"to":                  move.to,
+		})
+	}
+}
+
+func (m *manager) moveSubscription(ctx context.Context, move subscriptionMove) error {
+	from := m.GetTriggerWorker(move.from)
+	to := m.GetTriggerWorker(move.to)
+	if from == nil || to == nil {
+		return ErrTriggerWorkerNotFound
+	}
+	sub := m.subscriptionManager.GetSubscription(ctx, move.subscriptionID)
+	if sub == nil || sub.TriggerWorker != move.from {
+		return nil
+	}
+	standbyAddr := sub.StandbyWorker
+	if standbyAddr == move.to {
+		// swap with the standby trigger worker.
+		sub.StandbyWorker = move.from
+	}
+	sub.TriggerWorker = move.to
+	sub.Phase = metadata.SubscriptionPhaseScheduled
+	sub.HeartbeatTime = time.Now()
+	err := m.subscriptionManager.UpdateSubscription(ctx, sub)
+	if err != nil {
+		sub.TriggerWorker = move.from
+		sub.StandbyWorker = standbyAddr
+		return err
+	}
+	from.UnAssignSubscription(move.subscriptionID)
+	if standbyAddr == move.to {
+		from.AssignStandbySubscription(move.subscriptionID)
+	}
+	metrics.CtrlTriggerGauge.WithLabelValues(move.from).Dec()
+	metrics.CtrlTriggerGauge.WithLabelValues(move.to).Inc()
+	to.AssignSubscription(move.subscriptionID)
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func getTestWorkerLoad(addr string, now time.Time, eventRates ...float64) *workerLoad {
+	w := &workerLoad{addr: addr}
+	for _, rate := range eventRates {
+		load := metadata.SubscriptionLoad{
+			SubscriptionID: vanus.NewTestID(),
+			EventRate:      rate,
+			AssignTime:     now.Add(-time.Hour),
+		}
+		w.subscriptions = append(w.subscriptions, load)
+		w.load += load.Score()
+	}
+	return w
+}
+
+func TestPlanRebalance(t *testing.T) {
+	Convey("test plan rebalance", t, func() {
+		now := time.Now()
+		config := RebalanceConfig{}
+		config.init()
+		Convey("single trigger worker", func() {
+			moves := planRebalance([]*workerLoad{getTestWorkerLoad("w1", now, 10, 10)}, config, now)
+			So(moves, ShouldHaveLength, 0)
+		})
+		Convey("balance trigger worker", func() {
+			moves := planRebalance([]*workerLoad{
+				getTestWorkerLoad("w1", now, 10, 10),
+				getTestWorkerLoad("w2", now, 5, 15),
+			}, config, now)
+			So(moves, ShouldHaveLength, 0)
+		})
+		Convey("new trigger worker join", func() {
+			w1 := getTestWorkerLoad("w1", now, 10, 30, 20)
+			id := w1.subscriptions[1].SubscriptionID
+			moves := planRebalance([]*workerLoad{w1, getTestWorkerLoad("w2", now)}, config, now)
+			So(moves, ShouldHaveLength, 1)
+			So(moves[0].from, ShouldEqual, "w1")
+			So(moves[0].to, ShouldEqual, "w2")
+			So(moves[0].subscriptionID, ShouldEqual, id)
+		})
+		Convey("skip subscription which make difference bigger", func() {
+			config.Threshold = 1.1
+			config.MaxMove = 3
+			w1 := getTestWorkerLoad("w1", now, 300, 40)
+			id := w1.subscriptions[1].SubscriptionID
+			moves := planRebalance([]*workerLoad{w1, getTestWorkerLoad("w2", now, 250)}, config, now)
+			So(moves, ShouldHaveLength, 1)
+			So(moves[0].subscriptionID, ShouldEqual, id)
+		})
+		Convey("subscription assigned recently is sticky", func() {
+			w1 := getTestWorkerLoad("w1", now, 10, 30, 20)
+			for i := range w1.subscriptions {
+				w1.subscriptions[i].AssignTime = now
+			}
+			moves := planRebalance([]*workerLoad{w1, getTestWorkerLoad("w2", now)}, config, now)
+			So(moves, ShouldHaveLength, 0)
+		})
+	})
+}
+
+func TestManager_Rebalance(t *testing.T) {
+	Convey("test manager rebalance", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		subManager := subscription.NewMockManager(ctrl)
+		m := NewTriggerWorkerManager(Config{}, nil, subManager, getTestTriggerWorkerRemoveSubscription()).(*manager)
+		w1 := NewMockTriggerWorker(ctrl)
+		w2 := NewMockTriggerWorker(ctrl)
+		m.triggerWorkers["w1"] = w1
+		m.triggerWorkers["w2"] = w2
+		id := vanus.NewTestID()
+		sub := &metadata.Subscription{ID: id, TriggerWorker: "w1"}
+		subManager.EXPECT().GetSubscription(gomock.Any(), id).Return(sub)
+		subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil)
+		w1.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
+		w2.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
+		w1.EXPECT().UnAssignSubscription(id).Return()
+		w2.EXPECT().AssignSubscription(id).Return()
+		err := m.moveSubscription(ctx, subscriptionMove{subscriptionID: id, from: "w1", to: "w2"})
+		So(err, ShouldBeNil)
+		So(sub.TriggerWorker, ShouldEqual, "w2")
+		So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseScheduled)
+	})
+}
+
+type testAutoscalingHook struct {
+	reports []LoadReport
+}
+
+func (h *testAutoscalingHook) OnLoadReport(_ context.Context, report LoadReport) error {
+	h.reports = append(h.reports, report)
+	return nil
+}
+
+func TestManager_RebalanceAutoscaling(t *testing.T) {
+	Convey("test report load to autoscaling hook", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		hook := &testAutoscalingHook{}
+		config := Config{
+			Rebalance: RebalanceConfig{
+				Disable:     true,
+				Autoscaling: AutoscalingConfig{TargetLoad: 10, MaxWorkers: 3},
+			},
+			AutoscalingHook: hook,
+		}
+		m := NewTriggerWorkerManager(config, nil, nil, getTestTriggerWorkerRemoveSubscription()).(*manager)
+		w1 := NewMockTriggerWorker(ctrl)
+		m.triggerWorkers["w1"] = w1
+		w1.EXPECT().IsActive().AnyTimes().Return(true)
+		w1.EXPECT().GetHeartbeatTime().AnyTimes().Return(time.Now())
+		w1.EXPECT().GetAddr().AnyTimes().Return("w1")
+		w1.EXPECT().GetInfo().AnyTimes().Return(metadata.TriggerWorkerInfo{Load: 25})
+		w1.EXPECT().GetSubscriptionLoad().AnyTimes().Return([]metadata.SubscriptionLoad{{EventRate: 25}})
+		// the subscriptions aren't moved since rebalance is disabled.
+		m.rebalance(ctx)
+		So(hook.reports, ShouldHaveLength, 1)
+		report := hook.reports[0]
+		So(report.CurrentWorkers, ShouldEqual, 1)
+		So(report.DesiredWorkers, ShouldEqual, 3)
+		So(report.TotalLoad, ShouldEqual, 25)
+		So(report.Workers, ShouldResemble, []WorkerLoadReport{{Addr: "w1", Load: 25, Subscriptions: 1}})
+	})
+
+	Convey("test desired workers", t, func() {
+		config := AutoscalingConfig{TargetLoad: 10, MinWorkers: 2, MaxWorkers: 5}
+		So(config.desiredWorkers(0), ShouldEqual, 2)
+		So(config.desiredWorkers(31), ShouldEqual, 4)
+		So(config.desiredWorkers(100), ShouldEqual, 5)
+		config = AutoscalingConfig{}
+		config.init()
+		So(config.desiredWorkers(0), ShouldEqual, 1)
+		So(config.desiredWorkers(2500), ShouldEqual, 3)
+	})
+
+	Convey("test webhook autoscaling hook", t, func() {
+		var received LoadReport
+		status := http.StatusOK
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			w.WriteHeader(status)
+		}))
+		defer srv.Close()
+		config := Config{Rebalance: RebalanceConfig{Autoscaling: AutoscalingConfig{Webhook: srv.URL}}}
+		config.init()
+		So(config.AutoscalingHook, ShouldNotBeNil)
+		err := config.AutoscalingHook.OnLoadReport(context.Background(), LoadReport{CurrentWorkers: 2, DesiredWorkers: 3})
+		So(err, ShouldBeNil)
+		So(received.CurrentWorkers, ShouldEqual, 2)
+		So(received.DesiredWorkers, ShouldEqual, 3)
+		status = http.StatusInternalServerError
+		err = config.AutoscalingHook.OnLoadReport(context.Background(), LoadReport{})
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -45,7 +45,7 @@ func NewSubscriptionScheduler(workerManager Manager,
 	s := &SubscriptionScheduler{
 		normalQueue:         queue.New(),
 		maxRetryPrintLog:    defaultRetryPrintLog,
-		policy:              &RoundRobinPolicy{},
+		policy:              &LeastLoadPolicy{},
 		workerManager:       workerManager,
 		subscriptionManager: subscriptionManager,
 	}
@@ -122,6 +122,8 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 		metrics.CtrlTriggerGauge.WithLabelValues(twAddr).Inc()
 	}
 	subscription.TriggerWorker = twAddr
+	standbyAddr := subscription.StandbyWorker
+	subscription.StandbyWorker = s.acquireStandbyWorker(ctx, subscription)
 	subscription.Phase = metadata.SubscriptionPhaseScheduled
 	subscription.HeartbeatTime = time.Now()
 	err := s.subscriptionManager.UpdateSubscription(ctx, subscription)
@@ -129,5 +131,39 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 		return err
 	}
 	tWorker.AssignSubscription(subscriptionID)
+	if standbyAddr != "" && standbyAddr != subscription.StandbyWorker {
+		if standby := s.workerManager.GetTriggerWorker(standbyAddr); standby != nil {
+			standby.UnAssignStandbySubscription(subscriptionID)
+		}
+	}
+	if subscription.StandbyWorker != "" {
+		if standby := s.workerManager.GetTriggerWorker(subscription.StandbyWorker); standby != nil {
+			standby.AssignStandbySubscription(subscriptionID)
+		}
+	}
 	return nil
 }
+
+// acquireStandbyWorker returns the standby trigger worker of the subscription which enables warm standby,
+// the current one is kept if it's still running, it's empty if there is no other running trigger worker.
+func (s *SubscriptionScheduler) acquireStandbyWorker(ctx context.Context,
+	subscription *metadata.Subscription) string {
+	if !subscription.Config.WarmStandby {
+		return ""
+	}
+	twInfos := s.workerManager.GetActiveRunningTriggerWorker()
+	candidates := make([]metadata.TriggerWorkerInfo, 0, len(twInfos))
+	for _, twInfo := range twInfos {
+		if twInfo.Addr == subscription.TriggerWorker {
+			continue
+		}
+		if twInfo.Addr == subscription.StandbyWorker {
+			return twInfo.Addr
+		}
+		candidates = append(candidates, twInfo)
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	return s.policy.Acquire(ctx, candidates).Addr
+}
@@ -71,6 +71,41 @@ func TestSubscriptionSchedulerHandler(t *testing.T) {
 			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
 			scheduler.handler(ctx, subscriptionID)
 		})
+
+		Convey("test scheduler handler assign standby trigger worker", func() {
+			standbyAddr := "standby"
+			standby := NewMockTriggerWorker(ctrl)
+			sub := &metadata.Subscription{
+				ID:            subscriptionID,
+				Phase:         metadata.SubscriptionPhasePending,
+				TriggerWorker: workerAddr,
+			}
+			sub.Config.WarmStandby = true
+			subscriptionManager.EXPECT().GetSubscription(ctx, subscriptionID).AnyTimes().Return(sub)
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
+			workerManager.EXPECT().GetTriggerWorker(workerAddr).AnyTimes().Return(tWorker)
+			workerManager.EXPECT().GetTriggerWorker(standbyAddr).AnyTimes().Return(standby)
+			Convey("test no other trigger worker", func() {
+				workerManager.EXPECT().GetActiveRunningTriggerWorker().Return([]metadata.TriggerWorkerInfo{
+					{Addr: workerAddr},
+				})
+				So(scheduler.handler(ctx, subscriptionID), ShouldBeNil)
+				So(sub.StandbyWorker, ShouldBeEmpty)
+			})
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().AnyTimes().Return([]metadata.TriggerWorkerInfo{
+				{Addr: workerAddr}, {Addr: standbyAddr},
+			})
+			standby.EXPECT().AssignStandbySubscription(subscriptionID).Times(1)
+			So(scheduler.handler(ctx, subscriptionID), ShouldBeNil)
+			So(sub.TriggerWorker, ShouldEqual, workerAddr)
+			So(sub.StandbyWorker, ShouldEqual, standbyAddr)
+			Convey("test disable warm standby", func() {
+				sub.Config.WarmStandby = false
+				standby.EXPECT().UnAssignStandbySubscription(subscriptionID).Times(1)
+				So(scheduler.handler(ctx, subscriptionID), ShouldBeNil)
+				So(sub.StandbyWorker, ShouldBeEmpty)
+			})
+		})
 	})
 }
 
@@ -51,6 +51,11 @@ type TriggerWorker interface {
 	AssignSubscription(id vanus.ID)
 	UnAssignSubscription(id vanus.ID)
 	GetAssignedSubscriptions() []vanus.ID
+	AssignStandbySubscription(id vanus.ID)
+	UnAssignStandbySubscription(id vanus.ID)
+	GetStandbySubscriptions() []vanus.ID
+	UpdateSubscriptionLoad(loads []metadata.SubscriptionLoad)
+	GetSubscriptionLoad() []metadata.SubscriptionLoad
 	ResetOffsetToTimestamp(id vanus.ID, timestamp uint64) error
 }
 
@@ -61,12 +66,15 @@ type triggerWorker struct {
 	client                trigger.TriggerWorkerClient
 	lock                  sync.RWMutex
 	assignSubscriptionIDs sync.Map
-	pendingTime           time.Time
-	heartbeatTime         time.Time
-	ctx                   context.Context
-	stop                  context.CancelFunc
-	subscriptionManager   subscription.Manager
-	subscriptionQueue     queue.Queue
+	// standbySubscriptionIDs is the subscriptions prepared by the trigger worker but not started.
+	standbySubscriptionIDs sync.Map
+	subscriptionLoad       map[vanus.ID]metadata.SubscriptionLoad
+	pendingTime            time.Time
+	heartbeatTime          time.Time
+	ctx                    context.Context
+	stop                   context.CancelFunc
+	subscriptionManager    subscription.Manager
+	subscriptionQueue      queue.Queue
 }
 
 var newTriggerWorker = NewTriggerWorker
@@ -81,6 +89,7 @@ func NewTriggerWorker(twInfo *metadata.TriggerWorkerInfo, subscriptionManager su
 		info:                twInfo,
 		subscriptionManager: subscriptionManager,
 		subscriptionQueue:   queue.New(),
+		subscriptionLoad:    map[vanus.ID]metadata.SubscriptionLoad{},
 		pendingTime:         time.Now(),
 		stop:                func() {},
 	}
@@ -126,8 +135,10 @@ func (tw *triggerWorker) Start(ctx context.Context) error {
 func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) error {
 	_, exist := tw.assignSubscriptionIDs.Load(subscriptionID)
 	if !exist {
-		// no assign to this trigger worker,remove subscription
-		return tw.removeSubscription(ctx, subscriptionID)
+		if _, standby := tw.standbySubscriptionIDs.Load(subscriptionID); !standby {
+			// no assign to this trigger worker,remove subscription
+			return tw.removeSubscription(ctx, subscriptionID)
+		}
 	}
 	sub := tw.subscriptionManager.GetSubscription(ctx, subscriptionID)
 	if sub == nil {
@@ -171,10 +182,15 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		Protocol:        sub.Protocol,
 		ProtocolSetting: sub.ProtocolSetting,
 		SinkCredential:  sub.SinkCredential,
+		SinkSecret:      sub.SinkSecret,
+		Standby:         !exist,
 	})
 	if err != nil {
 		return err
 	}
+	if !exist {
+		return nil
+	}
 	// modify subscription to running
 	sub.Phase = metadata.SubscriptionPhaseRunning
 	err = tw.subscriptionManager.UpdateSubscription(ctx, sub)
@@ -205,7 +221,13 @@ func (tw *triggerWorker) Reset() {
 }
 
 func (tw *triggerWorker) GetInfo() metadata.TriggerWorkerInfo {
-	return *tw.info
+	tw.lock.RLock()
+	info := *tw.info
+	tw.lock.RUnlock()
+	for _, load := range tw.GetSubscriptionLoad() {
+		info.Load += load.Score()
+	}
+	return info
 }
 
 func (tw *triggerWorker) GetAddr() string {
@@ -242,6 +264,7 @@ func (tw *triggerWorker) AssignSubscription(id vanus.ID) {
 		log.KeyTriggerWorkerAddr: tw.info.Addr,
 		log.KeySubscriptionID:    id,
 	})
+	tw.standbySubscriptionIDs.Delete(id)
 	tw.assignSubscriptionIDs.Store(id, time.Now())
 	tw.subscriptionQueue.Add(id)
 }
@@ -275,6 +298,83 @@ func (tw *triggerWorker) GetAssignedSubscriptions() []vanus.ID {
 	return ids
 }
 
+func (tw *triggerWorker) AssignStandbySubscription(id vanus.ID) {
+	if _, exist := tw.assignSubscriptionIDs.Load(id); exist {
+		return
+	}
+	log.Info(context.Background(), "trigger worker assign a standby subscription", map[string]interface{}{
+		log.KeyTriggerWorkerAddr: tw.info.Addr,
+		log.KeySubscriptionID:    id,
+	})
+	tw.standbySubscriptionIDs.Store(id, time.Now())
+	tw.subscriptionQueue.Add(id)
+}
+
+func (tw *triggerWorker) UnAssignStandbySubscription(id vanus.ID) {
+	if _, exist := tw.standbySubscriptionIDs.LoadAndDelete(id); !exist {
+		return
+	}
+	log.Info(context.Background(), "trigger worker remove a standby subscription", map[string]interface{}{
+		log.KeyTriggerWorkerAddr: tw.info.Addr,
+		log.KeySubscriptionID:    id,
+	})
+	if _, exist := tw.assignSubscriptionIDs.Load(id); exist {
+		return
+	}
+	if tw.info.Phase == metadata.TriggerWorkerPhaseRunning {
+		err := tw.removeSubscription(tw.ctx, id)
+		if err != nil {
+			log.Warning(context.Background(), "trigger worker remove standby subscription error",
+				map[string]interface{}{
+					log.KeyError:             err,
+					log.KeyTriggerWorkerAddr: tw.info.Addr,
+					log.KeySubscriptionID:    id,
+				})
+			tw.subscriptionQueue.Add(id)
+		}
+	}
+}
+
+func (tw *triggerWorker) GetStandbySubscriptions() []vanus.ID {
+	ids := make([]vanus.ID, 0)
+	tw.standbySubscriptionIDs.Range(func(key, value interface{}) bool {
+		id, _ := key.(vanus.ID)
+		ids = append(ids, id)
+		return true
+	})
+	return ids
+}
+
+// UpdateSubscriptionLoad replace the load which trigger worker report.
+func (tw *triggerWorker) UpdateSubscriptionLoad(loads []metadata.SubscriptionLoad) {
+	m := make(map[vanus.ID]metadata.SubscriptionLoad, len(loads))
+	for _, load := range loads {
+		m[load.SubscriptionID] = load
+	}
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+	tw.subscriptionLoad = m
+}
+
+// GetSubscriptionLoad return the load of assigned subscriptions, the subscription
+// which has no report yet only has assign time.
+func (tw *triggerWorker) GetSubscriptionLoad() []metadata.SubscriptionLoad {
+	tw.lock.RLock()
+	defer tw.lock.RUnlock()
+	loads := make([]metadata.SubscriptionLoad, 0)
+	tw.assignSubscriptionIDs.Range(func(key, value interface{}) bool {
+		id, _ := key.(vanus.ID)
+		load, exist := tw.subscriptionLoad[id]
+		if !exist {
+			load = metadata.SubscriptionLoad{SubscriptionID: id}
+		}
+		load.AssignTime, _ = value.(time.Time)
+		loads = append(loads, load)
+		return true
+	})
+	return loads
+}
+
 func (tw *triggerWorker) GetPendingTime() time.Time {
 	tw.lock.RLock()
 	defer tw.lock.RUnlock()
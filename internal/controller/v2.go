@@ -0,0 +1,61 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	ctrlpbv2 "github.com/linkall-labs/vanus/proto/pkg/v2/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// segmentControllerV2 serves the v2 of segment controller API by v1, the streams of v2 have the
+// same methods as v1, so they are passed through.
+type segmentControllerV2 struct {
+	ctrlpb.SegmentControllerServer
+}
+
+func (c segmentControllerV2) SegmentHeartbeat(stream ctrlpbv2.SegmentController_SegmentHeartbeatServer) error {
+	return c.SegmentControllerServer.SegmentHeartbeat(stream)
+}
+
+// triggerControllerV2 serves the v2 of trigger controller API by v1.
+type triggerControllerV2 struct {
+	ctrlpb.TriggerControllerServer
+}
+
+func (c triggerControllerV2) ResetOffsetToTimestamp(ctx context.Context,
+	request *ctrlpbv2.ResetOffsetToTimestampRequest) (*emptypb.Empty, error) {
+	if request.Time == nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("time is required")
+	}
+	// the timestamp of v1 is passed to the trigger worker, which uses it as milliseconds.
+	return c.TriggerControllerServer.ResetOffsetToTimestamp(ctx, &ctrlpb.ResetOffsetToTimestampRequest{
+		SubscriptionId: request.SubscriptionId,
+		Timestamp:      uint64(request.Time.AsTime().UnixMilli()),
+	})
+}
+
+func (c triggerControllerV2) TriggerWorkerHeartbeat(
+	stream ctrlpbv2.TriggerController_TriggerWorkerHeartbeatServer) error {
+	return c.TriggerControllerServer.TriggerWorkerHeartbeat(stream)
+}
+
+func (c triggerControllerV2) WatchSecret(request *emptypb.Empty,
+	stream ctrlpbv2.TriggerController_WatchSecretServer) error {
+	return c.TriggerControllerServer.WatchSecret(request, stream)
+}
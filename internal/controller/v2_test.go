@@ -0,0 +1,67 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	ctrlpbv2 "github.com/linkall-labs/vanus/proto/pkg/v2/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTriggerControllerV2(t *testing.T) {
+	Convey("test trigger controller v2", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		v1 := ctrlpb.NewMockTriggerControllerServer(ctrl)
+		var s ctrlpbv2.TriggerControllerServer = triggerControllerV2{v1}
+		Convey("test reset offset to timestamp", func() {
+			now := time.Now()
+			v1.EXPECT().ResetOffsetToTimestamp(gomock.Any(), &ctrlpb.ResetOffsetToTimestampRequest{
+				SubscriptionId: 1,
+				Timestamp:      uint64(now.UnixMilli()),
+			}).Return(&emptypb.Empty{}, nil)
+			_, err := s.ResetOffsetToTimestamp(ctx, &ctrlpbv2.ResetOffsetToTimestampRequest{
+				SubscriptionId: 1,
+				Time:           timestamppb.New(now),
+			})
+			So(err, ShouldBeNil)
+			_, err = s.ResetOffsetToTimestamp(ctx, &ctrlpbv2.ResetOffsetToTimestampRequest{SubscriptionId: 1})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("test watch secret", func() {
+			stream := ctrlpbv2.NewMockTriggerController_WatchSecretServer(ctrl)
+			stream.EXPECT().Send(gomock.Any()).Return(nil)
+			v1.EXPECT().WatchSecret(gomock.Any(), stream).DoAndReturn(
+				func(_ *emptypb.Empty, stream ctrlpb.TriggerController_WatchSecretServer) error {
+					return stream.Send(&ctrlpb.SecretEvent{})
+				})
+			err := s.WatchSecret(&emptypb.Empty{}, stream)
+			So(err, ShouldBeNil)
+		})
+		Convey("test unchanged method", func() {
+			v1.EXPECT().DeleteSecret(gomock.Any(), gomock.Any()).Return(&emptypb.Empty{}, nil)
+			_, err := s.DeleteSecret(ctx, &ctrlpb.DeleteSecretRequest{})
+			So(err, ShouldBeNil)
+		})
+	})
+}
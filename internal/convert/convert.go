@@ -15,6 +15,8 @@
 package convert
 
 import (
+	"time"
+
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
@@ -33,9 +35,10 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) *metadata.Subscrip
 		Sink:               primitive.URI(sub.Sink),
 		SinkCredential:     fromPbSinkCredential(sub.SinkCredential),
 		SinkCredentialType: fromPbSinkCredentialType(sub.SinkCredential),
+		SinkSecret:         sub.SinkSecret,
 		Protocol:           fromPbProtocol(sub.Protocol),
 		ProtocolSetting:    fromPbProtocolSettings(sub.ProtocolSettings),
-		Filters:            fromPbFilters(sub.Filters),
+		Filters:            FromPbFilters(sub.Filters),
 		Transformer:        fromPbTransformer(sub.Transformer),
 		EventBus:           sub.EventBus,
 		Name:               sub.Name,
@@ -45,6 +48,27 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) *metadata.Subscrip
 	return to
 }
 
+// ToPbSubscriptionRequest the secret of sink credential is not contained.
+func ToPbSubscriptionRequest(sub *metadata.Subscription) *ctrl.SubscriptionRequest {
+	to := &ctrl.SubscriptionRequest{
+		Source:           sub.Source,
+		Types:            sub.Types,
+		Config:           toPbSubscriptionConfig(sub.Config),
+		Filters:          ToPbFilters(sub.Filters),
+		Sink:             string(sub.Sink),
+		SinkCredential:   toPbSinkCredentialByType(sub.SinkCredentialType),
+		SinkSecret:       sub.SinkSecret,
+		Protocol:         toPbProtocol(sub.Protocol),
+		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
+		EventBus:         sub.EventBus,
+		Transformer:      ToPbTransformer(sub.Transformer),
+		Name:             sub.Name,
+		Description:      sub.Description,
+		Disable:          sub.Disable,
+	}
+	return to
+}
+
 func fromPbProtocol(from pb.Protocol) primitive.Protocol {
 	var to primitive.Protocol
 	switch from {
@@ -54,6 +78,16 @@ func fromPbProtocol(from pb.Protocol) primitive.Protocol {
 		to = primitive.AwsLambdaProtocol
 	case pb.Protocol_GCLOUD_FUNCTIONS:
 		to = primitive.GCloudFunctions
+	case pb.Protocol_AMQP:
+		to = primitive.AMQPProtocol
+	case pb.Protocol_ELASTICSEARCH:
+		to = primitive.ElasticsearchProtocol
+	case pb.Protocol_OBJECT_STORAGE:
+		to = primitive.ObjectStorageProtocol
+	case pb.Protocol_SQL:
+		to = primitive.SQLProtocol
+	case pb.Protocol_CHAT:
+		to = primitive.ChatProtocol
 	}
 	return to
 }
@@ -67,6 +101,16 @@ func toPbProtocol(from primitive.Protocol) pb.Protocol {
 		to = pb.Protocol_AWS_LAMBDA
 	case primitive.GCloudFunctions:
 		to = pb.Protocol_GCLOUD_FUNCTIONS
+	case primitive.AMQPProtocol:
+		to = pb.Protocol_AMQP
+	case primitive.ElasticsearchProtocol:
+		to = pb.Protocol_ELASTICSEARCH
+	case primitive.ObjectStorageProtocol:
+		to = pb.Protocol_OBJECT_STORAGE
+	case primitive.SQLProtocol:
+		to = pb.Protocol_SQL
+	case primitive.ChatProtocol:
+		to = pb.Protocol_CHAT
 	}
 	return to
 }
@@ -78,6 +122,13 @@ func fromPbProtocolSettings(from *pb.ProtocolSetting) *primitive.ProtocolSetting
 	to := &primitive.ProtocolSetting{
 		Headers: from.Headers,
 	}
+	if from.Chat != nil {
+		to.Chat = &primitive.ChatSetting{
+			MessageTemplate:   from.Chat.MessageTemplate,
+			CoalesceWindow:    from.Chat.CoalesceWindow,
+			MessagesPerMinute: from.Chat.MessagesPerMinute,
+		}
+	}
 	return to
 }
 
@@ -88,6 +139,13 @@ func toPbProtocolSettings(from *primitive.ProtocolSetting) *pb.ProtocolSetting {
 	to := &pb.ProtocolSetting{
 		Headers: from.Headers,
 	}
+	if from.Chat != nil {
+		to.Chat = &pb.ChatSetting{
+			MessageTemplate:   from.Chat.MessageTemplate,
+			CoalesceWindow:    from.Chat.CoalesceWindow,
+			MessagesPerMinute: from.Chat.MessagesPerMinute,
+		}
+	}
 	return to
 }
 
@@ -203,11 +261,27 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 		return primitive.SubscriptionConfig{}
 	}
 	to := primitive.SubscriptionConfig{
-		RateLimit:          config.RateLimit,
-		MaxRetryAttempts:   config.MaxRetryAttempts,
-		DeliveryTimeout:    config.DeliveryTimeout,
-		DeadLetterEventbus: config.DeadLetterEventbus,
-		OrderedEvent:       config.OrderedEvent,
+		RateLimit:               config.RateLimit,
+		MaxRetryAttempts:        config.MaxRetryAttempts,
+		DeliveryTimeout:         config.DeliveryTimeout,
+		DeadLetterEventbus:      config.DeadLetterEventbus,
+		OrderedEvent:            config.OrderedEvent,
+		DedupWindow:             config.DedupWindow,
+		DedupKeyAttribute:       config.DedupKeyAttribute,
+		OrderingKeyAttribute:    config.OrderingKeyAttribute,
+		IdempotentDelivery:      config.IdempotentDelivery,
+		CommitInterval:          config.CommitInterval,
+		CommitBatchSize:         config.CommitBatchSize,
+		DisableSyncCommitOnStop: config.DisableSyncCommitOnStop,
+		CorrelationEventbus:     config.CorrelationEventbus,
+		CorrelationKeyAttribute: config.CorrelationKeyAttribute,
+		CorrelationTimeout:      config.CorrelationTimeout,
+		Eventlogs:               config.Eventlogs,
+		EventlogHashStart:       config.EventlogHashStart,
+		EventlogHashEnd:         config.EventlogHashEnd,
+		PoisonThreshold:         config.PoisonThreshold,
+		ReplyEventbus:           config.ReplyEventbus,
+		WarmStandby:             config.WarmStandby,
 	}
 	switch config.OffsetType {
 	case pb.SubscriptionConfig_LATEST:
@@ -223,11 +297,27 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 
 func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.SubscriptionConfig {
 	to := &pb.SubscriptionConfig{
-		RateLimit:          config.RateLimit,
-		MaxRetryAttempts:   config.MaxRetryAttempts,
-		DeliveryTimeout:    config.DeliveryTimeout,
-		DeadLetterEventbus: config.DeadLetterEventbus,
-		OrderedEvent:       config.OrderedEvent,
+		RateLimit:               config.RateLimit,
+		MaxRetryAttempts:        config.MaxRetryAttempts,
+		DeliveryTimeout:         config.DeliveryTimeout,
+		DeadLetterEventbus:      config.DeadLetterEventbus,
+		OrderedEvent:            config.OrderedEvent,
+		DedupWindow:             config.DedupWindow,
+		DedupKeyAttribute:       config.DedupKeyAttribute,
+		OrderingKeyAttribute:    config.OrderingKeyAttribute,
+		IdempotentDelivery:      config.IdempotentDelivery,
+		CommitInterval:          config.CommitInterval,
+		CommitBatchSize:         config.CommitBatchSize,
+		DisableSyncCommitOnStop: config.DisableSyncCommitOnStop,
+		CorrelationEventbus:     config.CorrelationEventbus,
+		CorrelationKeyAttribute: config.CorrelationKeyAttribute,
+		CorrelationTimeout:      config.CorrelationTimeout,
+		Eventlogs:               config.Eventlogs,
+		EventlogHashStart:       config.EventlogHashStart,
+		EventlogHashEnd:         config.EventlogHashEnd,
+		PoisonThreshold:         config.PoisonThreshold,
+		ReplyEventbus:           config.ReplyEventbus,
+		WarmStandby:             config.WarmStandby,
 	}
 	switch config.OffsetType {
 	case primitive.LatestOffset:
@@ -246,13 +336,15 @@ func FromPbAddSubscription(sub *pbtrigger.AddSubscriptionRequest) *primitive.Sub
 		ID:              vanus.ID(sub.Id),
 		Sink:            primitive.URI(sub.Sink),
 		SinkCredential:  fromPbSinkCredential(sub.SinkCredential),
+		SinkSecret:      sub.SinkSecret,
 		Protocol:        fromPbProtocol(sub.Protocol),
 		ProtocolSetting: fromPbProtocolSettings(sub.ProtocolSettings),
 		EventBus:        sub.EventBus,
 		Offsets:         FromPbOffsetInfos(sub.Offsets),
-		Filters:         fromPbFilters(sub.Filters),
+		Filters:         FromPbFilters(sub.Filters),
 		Transformer:     fromPbTransformer(sub.Transformer),
 		Config:          fromPbSubscriptionConfig(sub.Config),
+		Standby:         sub.Standby,
 	}
 	return to
 }
@@ -262,13 +354,15 @@ func ToPbAddSubscription(sub *primitive.Subscription) *pbtrigger.AddSubscription
 		Id:               uint64(sub.ID),
 		Sink:             string(sub.Sink),
 		SinkCredential:   toPbSinkCredential(sub.SinkCredential),
+		SinkSecret:       sub.SinkSecret,
 		EventBus:         sub.EventBus,
 		Offsets:          ToPbOffsetInfos(sub.Offsets),
-		Filters:          toPbFilters(sub.Filters),
+		Filters:          ToPbFilters(sub.Filters),
 		Transformer:      ToPbTransformer(sub.Transformer),
 		Config:           toPbSubscriptionConfig(sub.Config),
 		Protocol:         toPbProtocol(sub.Protocol),
 		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
+		Standby:          sub.Standby,
 	}
 	return to
 }
@@ -281,10 +375,11 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 		Config:           toPbSubscriptionConfig(sub.Config),
 		Sink:             string(sub.Sink),
 		SinkCredential:   toPbSinkCredentialByType(sub.SinkCredentialType),
+		SinkSecret:       sub.SinkSecret,
 		Protocol:         toPbProtocol(sub.Protocol),
 		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
 		EventBus:         sub.EventBus,
-		Filters:          toPbFilters(sub.Filters),
+		Filters:          ToPbFilters(sub.Filters),
 		Transformer:      ToPbTransformer(sub.Transformer),
 		Offsets:          ToPbOffsetInfos(offsets),
 		Name:             sub.Name,
@@ -296,7 +391,7 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 	return to
 }
 
-func fromPbFilters(filters []*pb.Filter) []*primitive.SubscriptionFilter {
+func FromPbFilters(filters []*pb.Filter) []*primitive.SubscriptionFilter {
 	if len(filters) == 0 {
 		return nil
 	}
@@ -331,15 +426,15 @@ func fromPbFilter(filter *pb.Filter) *primitive.SubscriptionFilter {
 		return &primitive.SubscriptionFilter{CEL: filter.Cel}
 	}
 	if len(filter.All) > 0 {
-		return &primitive.SubscriptionFilter{All: fromPbFilters(filter.All)}
+		return &primitive.SubscriptionFilter{All: FromPbFilters(filter.All)}
 	}
 	if len(filter.Any) > 0 {
-		return &primitive.SubscriptionFilter{Any: fromPbFilters(filter.Any)}
+		return &primitive.SubscriptionFilter{Any: FromPbFilters(filter.Any)}
 	}
 	return nil
 }
 
-func toPbFilters(filters []*primitive.SubscriptionFilter) []*pb.Filter {
+func ToPbFilters(filters []*primitive.SubscriptionFilter) []*pb.Filter {
 	to := make([]*pb.Filter, 0, len(filters))
 	for _, filter := range filters {
 		to = append(to, toPbFilter(filter))
@@ -367,10 +462,10 @@ func toPbFilter(filter *primitive.SubscriptionFilter) *pb.Filter {
 		return &pb.Filter{Cel: filter.CEL}
 	}
 	if len(filter.All) > 0 {
-		return &pb.Filter{All: toPbFilters(filter.All)}
+		return &pb.Filter{All: ToPbFilters(filter.All)}
 	}
 	if len(filter.Any) > 0 {
-		return &pb.Filter{Any: toPbFilters(filter.Any)}
+		return &pb.Filter{Any: ToPbFilters(filter.Any)}
 	}
 	return nil
 }
@@ -387,6 +482,7 @@ func fromPbOffsetInfo(offset *pb.OffsetInfo) info.OffsetInfo {
 	return info.OffsetInfo{
 		EventLogID: vanus.ID(offset.EventLogId),
 		Offset:     offset.Offset,
+		Delivered:  offset.Delivered,
 	}
 }
 
@@ -411,6 +507,7 @@ func toPbOffsetInfo(offset info.OffsetInfo) *pb.OffsetInfo {
 	return &pb.OffsetInfo{
 		EventLogId: uint64(offset.EventLogID),
 		Offset:     offset.Offset,
+		Delivered:  offset.Delivered,
 	}
 }
 func fromPbTransformer(transformer *pb.Transformer) *primitive.Transformer {
@@ -471,3 +568,116 @@ func ToPbTransformer(transformer *primitive.Transformer) *pb.Transformer {
 		Pipeline: toPbActions(transformer.Pipeline),
 	}
 }
+
+func ToPbSubscriptionLoad(load info.SubscriptionLoad) *ctrl.SubscriptionLoad {
+	return &ctrl.SubscriptionLoad{
+		SubscriptionId:     uint64(load.SubscriptionID),
+		EventRate:          load.EventRate,
+		Inflight:           load.Inflight,
+		Lag:                load.Lag,
+		DeliveryLatencyP50: load.DeliveryLatencyP50.Milliseconds(),
+		DeliveryLatencyP99: load.DeliveryLatencyP99.Milliseconds(),
+	}
+}
+
+func FromPbSubscriptionLoads(loads []*ctrl.SubscriptionLoad) []metadata.SubscriptionLoad {
+	to := make([]metadata.SubscriptionLoad, len(loads))
+	for i, load := range loads {
+		to[i] = metadata.SubscriptionLoad{
+			SubscriptionID:     vanus.ID(load.SubscriptionId),
+			EventRate:          load.EventRate,
+			Inflight:           load.Inflight,
+			Lag:                load.Lag,
+			DeliveryLatencyP50: time.Duration(load.DeliveryLatencyP50) * time.Millisecond,
+			DeliveryLatencyP99: time.Duration(load.DeliveryLatencyP99) * time.Millisecond,
+		}
+	}
+	return to
+}
+
+func ToPbTriggerWorkerInfo(tw metadata.TriggerWorkerInfo, loads []metadata.SubscriptionLoad) *ctrl.TriggerWorkerInfo {
+	to := &ctrl.TriggerWorkerInfo{
+		Address:          tw.Addr,
+		Phase:            string(tw.Phase),
+		Load:             tw.Load,
+		SubscriptionLoad: make([]*ctrl.SubscriptionLoad, len(loads)),
+	}
+	for i, load := range loads {
+		to.SubscriptionLoad[i] = &ctrl.SubscriptionLoad{
+			SubscriptionId:     uint64(load.SubscriptionID),
+			EventRate:          load.EventRate,
+			Inflight:           load.Inflight,
+			Lag:                load.Lag,
+			DeliveryLatencyP50: load.DeliveryLatencyP50.Milliseconds(),
+			DeliveryLatencyP99: load.DeliveryLatencyP99.Milliseconds(),
+		}
+	}
+	return to
+}
+
+func FromPbPutSecretRequest(req *ctrl.PutSecretRequest) *metadata.Secret {
+	to := &metadata.Secret{
+		Name:       req.Name,
+		Credential: fromPbSinkCredential(req.Credential),
+	}
+	if to.Credential != nil {
+		to.CredentialType = to.Credential.GetType()
+	}
+	return to
+}
+
+// ToPbSecret converts the secret, the credential is masked unless withCredential is true.
+func ToPbSecret(s *metadata.Secret, withCredential bool) *ctrl.Secret {
+	to := &ctrl.Secret{
+		Name:      s.Name,
+		Version:   s.Version,
+		CreatedAt: s.CreatedAt.UnixMilli(),
+		UpdatedAt: s.UpdatedAt.UnixMilli(),
+	}
+	if withCredential {
+		to.Credential = toPbSinkCredential(s.Credential)
+	} else {
+		credentialType := s.CredentialType
+		to.Credential = toPbSinkCredentialByType(&credentialType)
+	}
+	return to
+}
+
+func FromPbSecret(s *ctrl.Secret) *metadata.Secret {
+	to := &metadata.Secret{
+		Name:       s.Name,
+		Credential: fromPbSinkCredential(s.Credential),
+		Version:    s.Version,
+		CreatedAt:  time.UnixMilli(s.CreatedAt),
+		UpdatedAt:  time.UnixMilli(s.UpdatedAt),
+	}
+	if to.Credential != nil {
+		to.CredentialType = to.Credential.GetType()
+	}
+	return to
+}
+
+func FromPbConnector(c *ctrl.Connector) *metadata.Connector {
+	return &metadata.Connector{
+		Name:     c.Name,
+		Kind:     c.Kind,
+		Plugin:   c.Plugin,
+		Eventbus: c.Eventbus,
+		Config:   c.Config,
+		Replicas: c.Replicas,
+	}
+}
+
+func ToPbConnector(c *metadata.Connector) *ctrl.Connector {
+	return &ctrl.Connector{
+		Name:      c.Name,
+		Kind:      c.Kind,
+		Plugin:    c.Plugin,
+		Eventbus:  c.Eventbus,
+		Config:    c.Config,
+		Replicas:  c.Replicas,
+		Version:   c.Version,
+		CreatedAt: c.CreatedAt.UnixMilli(),
+		UpdatedAt: c.UpdatedAt.UnixMilli(),
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+// authorize checks the permission of the principal of the bearer token in the HTTP request, it allows
+// all requests if ACL isn't enabled.
+func (ga *ceGateway) authorize(ctx context.Context, header http.Header, eventbus string,
+	perm acl.Permission) error {
+	principal, err := ga.acl.Principal(header.Get(proxy.AuthorizationHeader))
+	if err != nil {
+		return err
+	}
+	return ga.acl.Authorize(ctx, eventbus, principal, perm)
+}
+
+type remoteAddrKey struct{}
+
+// withRemoteAddr carries the address of the connection received by MQTT, Kafka and AMQP listeners.
+func withRemoteAddr(ctx context.Context, addr net.Addr) context.Context {
+	return context.WithValue(ctx, remoteAddrKey{}, addr)
+}
+
+// authorizeConn checks the address of the connection carried in ctx by the IP allowlist, and the
+// publish permission of the anonymous principal.
+func (ga *ceGateway) authorizeConn(ctx context.Context, eventbus string) error {
+	addr, _ := ctx.Value(remoteAddrKey{}).(net.Addr)
+	if err := ga.allowlist.CheckAddr(ctx, addr, eventbus); err != nil {
+		return err
+	}
+	return ga.acl.Authorize(ctx, eventbus, "", acl.PermissionPublish)
+}
+
+// authorizeStatusCode returns the status code responded when the authorization failed, the error of
+// getting ACL entries from controller is responded with 500.
+func authorizeStatusCode(err error) int {
+	if errors.Is(err, errors.ErrPermissionDenied) {
+		return http.StatusForbidden
+	}
+	return http.StatusInternalServerError
+}
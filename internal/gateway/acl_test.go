@@ -0,0 +1,80 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/prashantv/gostub"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_receiveWithACL(t *testing.T) {
+	Convey("test receive with acl", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		ga := NewGateway(Config{})
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		ga.client = mockClient
+		ebCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		ebCtrl.EXPECT().ListEventbusACL(Any(), Any()).Times(1).Return(&ctrlpb.ListEventbusACLResponse{
+			Acls: []*ctrlpb.EventbusACL{{Principal: "alice", Permissions: []string{"publish"}}},
+		}, nil)
+		ga.acl = proxy.NewAuthorizer(proxy.ACLConfig{
+			Enable: true,
+			Tokens: map[string]string{"t1": "alice", "t2": "bob"},
+		}, ebCtrl)
+		reqData := &cehttp.RequestData{
+			URL:    &url.URL{Opaque: "/gateway/test"},
+			Header: http.Header{},
+		}
+		stub := StubFunc(&requestDataFromContext, reqData)
+		defer stub.Reset()
+
+		e := ce.NewEvent()
+		e.SetID("example-event")
+		e.SetSource("example/uri")
+		e.SetType("example.type")
+
+		_, ret := ga.receive(ctx, e)
+		So(ret.(*cehttp.Result).StatusCode, ShouldEqual, http.StatusForbidden)
+		reqData.Header.Set(proxy.AuthorizationHeader, "Bearer t2")
+		_, ret = ga.receive(ctx, e)
+		So(ret.(*cehttp.Result).StatusCode, ShouldEqual, http.StatusForbidden)
+		reqData.Header.Set(proxy.AuthorizationHeader, "Bearer t3")
+		_, ret = ga.receive(ctx, e)
+		So(ret.(*cehttp.Result).StatusCode, ShouldEqual, http.StatusForbidden)
+
+		reqData.Header.Set(proxy.AuthorizationHeader, "Bearer t1")
+		mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).Times(1).Return(&api.Placement{}, nil)
+		_, ret = ga.receive(ctx, e)
+		So(ce.IsACK(ret), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,186 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultNamespaceHeader = "X-Vanus-Namespace"
+	// maxAdmissionNamespaces bounds the limiters kept for namespaces, they are dropped and
+	// recreated when there are more namespaces.
+	maxAdmissionNamespaces = 10000
+	// the ops event of a namespace exceeding its quota is emitted at most once in quotaAlertInterval.
+	quotaAlertInterval = time.Minute
+)
+
+// admission limits the requests and the bytes per second of publish requests, both globally
+// and for each namespace, a request is rejected with 429 if any limit is exceeded.
+type admission struct {
+	global     *rateLimiter
+	header     string
+	limit      RateLimit
+	limits     map[string]RateLimit
+	namespaces map[string]*rateLimiter
+	alerts     map[string]time.Time
+	mu         sync.Mutex
+}
+
+// rateLimiter has a nil limiter for the unlimited one.
+type rateLimiter struct {
+	requests *rate.Limiter
+	bytes    *rate.Limiter
+}
+
+func newAdmission(cfg RateLimitConfig) *admission {
+	a := &admission{
+		global:     newRateLimiter(cfg.Global),
+		header:     cfg.NamespaceHeader,
+		limit:      cfg.Namespace,
+		limits:     cfg.Namespaces,
+		namespaces: map[string]*rateLimiter{},
+		alerts:     map[string]time.Time{},
+	}
+	if a.header == "" {
+		a.header = defaultNamespaceHeader
+	}
+	return a
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	l := &rateLimiter{}
+	if limit.RequestsPerSecond > 0 {
+		l.requests = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.RequestsPerSecond)
+	}
+	if limit.BytesPerSecond > 0 {
+		l.bytes = rate.NewLimiter(rate.Limit(limit.BytesPerSecond), limit.BytesPerSecond)
+	}
+	return l
+}
+
+func (ga *ceGateway) admissionMiddleware(next http.Handler) http.Handler {
+	if ga.admission == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		now := time.Now()
+		if delay := ga.admission.admit(req, now); delay > 0 {
+			ns := req.Header.Get(ga.admission.header)
+			if ga.admission.shouldAlert(ns, now) {
+				opsevent.Emit(req.Context(), opsevent.TypeQuotaExceeded, &opsevent.QuotaExceeded{
+					Namespace:  ns,
+					RetryAfter: delay.Seconds(),
+				})
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// admit returns 0 if the request is admitted, otherwise it returns how long the client should
+// wait before retrying.
+func (a *admission) admit(req *http.Request, now time.Time) time.Duration {
+	size := int(req.ContentLength)
+	if size < 0 {
+		size = 0
+	}
+	reservations := make([]*rate.Reservation, 0, 4)
+	a.mu.Lock()
+	limiters := []*rateLimiter{a.global}
+	a.mu.Unlock()
+	if ns := req.Header.Get(a.header); ns != "" {
+		limiters = append(limiters, a.namespace(ns))
+	}
+	for _, l := range limiters {
+		for _, v := range []struct {
+			limiter *rate.Limiter
+			n       int
+		}{{l.requests, 1}, {l.bytes, size}} {
+			if v.limiter == nil || v.n == 0 {
+				continue
+			}
+			// a request larger than the burst is admitted when the bucket is full
+			n := v.n
+			if n > v.limiter.Burst() {
+				n = v.limiter.Burst()
+			}
+			r := v.limiter.ReserveN(now, n)
+			reservations = append(reservations, r)
+			if delay := r.DelayFrom(now); delay > 0 {
+				// give back the tokens, the rejected request doesn't consume any limit
+				for _, r := range reservations {
+					r.CancelAt(now)
+				}
+				return delay
+			}
+		}
+	}
+	return 0
+}
+
+// update replaces the limits when the config is reloaded, the limiters of namespaces are recreated
+// with the new limits on demand.
+func (a *admission) update(cfg RateLimitConfig) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.global = newRateLimiter(cfg.Global)
+	a.limit = cfg.Namespace
+	a.limits = cfg.Namespaces
+	a.namespaces = map[string]*rateLimiter{}
+}
+
+func (a *admission) namespace(ns string) *rateLimiter {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if l, ok := a.namespaces[ns]; ok {
+		return l
+	}
+	if len(a.namespaces) >= maxAdmissionNamespaces {
+		a.namespaces = map[string]*rateLimiter{}
+	}
+	limit, ok := a.limits[ns]
+	if !ok {
+		limit = a.limit
+	}
+	l := newRateLimiter(limit)
+	a.namespaces[ns] = l
+	return l
+}
+
+// shouldAlert returns whether an ops event is emitted for the rejected requests of the namespace,
+// it's rate limited by quotaAlertInterval.
+func (a *admission) shouldAlert(ns string, now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if last, ok := a.alerts[ns]; ok && now.Sub(last) < quotaAlertInterval {
+		return false
+	}
+	if len(a.alerts) >= maxAdmissionNamespaces {
+		a.alerts = map[string]time.Time{}
+	}
+	a.alerts[ns] = now
+	return true
+}
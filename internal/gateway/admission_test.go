@@ -0,0 +1,126 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_admission(t *testing.T) {
+	newRequest := func(ns string, size int) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/gateway/test", strings.NewReader(strings.Repeat("a", size)))
+		if ns != "" {
+			req.Header.Set(defaultNamespaceHeader, ns)
+		}
+		return req
+	}
+
+	Convey("test global and namespace limits", t, func() {
+		a := newAdmission(RateLimitConfig{
+			Enable:    true,
+			Global:    RateLimit{RequestsPerSecond: 3},
+			Namespace: RateLimit{RequestsPerSecond: 1},
+			Namespaces: map[string]RateLimit{
+				"orders": {RequestsPerSecond: 2},
+			},
+		})
+		now := time.Now()
+		So(a.admit(newRequest("users", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("users", 0), now), ShouldBeGreaterThan, 0)
+		So(a.admit(newRequest("orders", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("orders", 0), now), ShouldEqual, 0)
+		// the global limit is exceeded
+		So(a.admit(newRequest("", 0), now), ShouldBeGreaterThan, 0)
+		So(a.admit(newRequest("", 0), now.Add(time.Second)), ShouldEqual, 0)
+	})
+
+	Convey("test rejected request doesn't consume the limit", t, func() {
+		a := newAdmission(RateLimitConfig{
+			Enable:    true,
+			Global:    RateLimit{RequestsPerSecond: 2},
+			Namespace: RateLimit{RequestsPerSecond: 1},
+		})
+		now := time.Now()
+		So(a.admit(newRequest("users", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("users", 0), now), ShouldBeGreaterThan, 0)
+		So(a.admit(newRequest("orders", 0), now), ShouldEqual, 0)
+	})
+
+	Convey("test update the limits", t, func() {
+		a := newAdmission(RateLimitConfig{
+			Enable:    true,
+			Namespace: RateLimit{RequestsPerSecond: 1},
+		})
+		now := time.Now()
+		So(a.admit(newRequest("users", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("users", 0), now), ShouldBeGreaterThan, 0)
+		a.update(RateLimitConfig{
+			Global:     RateLimit{RequestsPerSecond: 3},
+			Namespace:  RateLimit{RequestsPerSecond: 1},
+			Namespaces: map[string]RateLimit{"users": {RequestsPerSecond: 2}},
+		})
+		So(a.admit(newRequest("users", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("users", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("users", 0), now), ShouldBeGreaterThan, 0)
+		So(a.admit(newRequest("", 0), now), ShouldEqual, 0)
+		So(a.admit(newRequest("", 0), now), ShouldBeGreaterThan, 0)
+	})
+
+	Convey("test bytes limit", t, func() {
+		a := newAdmission(RateLimitConfig{
+			Enable: true,
+			Global: RateLimit{BytesPerSecond: 100},
+		})
+		now := time.Now()
+		So(a.admit(newRequest("", 60), now), ShouldEqual, 0)
+		delay := a.admit(newRequest("", 60), now)
+		So(delay, ShouldBeGreaterThan, 0)
+		So(delay, ShouldBeLessThanOrEqualTo, time.Second)
+		// larger than the burst
+		So(a.admit(newRequest("", 1000), now.Add(time.Second)), ShouldEqual, 0)
+	})
+
+	Convey("test quota alert is rate limited", t, func() {
+		a := newAdmission(RateLimitConfig{Enable: true})
+		now := time.Now()
+		So(a.shouldAlert("users", now), ShouldBeTrue)
+		So(a.shouldAlert("users", now.Add(time.Second)), ShouldBeFalse)
+		So(a.shouldAlert("orders", now.Add(time.Second)), ShouldBeTrue)
+		So(a.shouldAlert("users", now.Add(quotaAlertInterval)), ShouldBeTrue)
+	})
+
+	Convey("test middleware responds 429 with Retry-After", t, func() {
+		ga := &ceGateway{admission: newAdmission(RateLimitConfig{
+			Enable: true,
+			Global: RateLimit{RequestsPerSecond: 1},
+		})}
+		h := ga.admissionMiddleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest("", 0))
+		So(w.Code, ShouldEqual, http.StatusOK)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, newRequest("", 0))
+		So(w.Code, ShouldEqual, http.StatusTooManyRequests)
+		So(w.Header().Get("Retry-After"), ShouldEqual, "1")
+	})
+}
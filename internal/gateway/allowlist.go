@@ -0,0 +1,46 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ipAllowlistMiddleware rejects the requests from the addresses out of the IP allowlists with 403
+// before any processing, the eventbus is the first segment of the path after prefix.
+func (ga *ceGateway) ipAllowlistMiddleware(prefix string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if ga.allowlist == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			eventbus := strings.TrimLeft(strings.TrimPrefix(req.URL.Path, prefix), "/")
+			if idx := strings.Index(eventbus, "/"); idx >= 0 {
+				eventbus = eventbus[:idx]
+			}
+			err := ga.allowlist.CheckHTTP(req, eventbus)
+			// the reply eventbus of request-reply is subscribed by the request.
+			if replyEb := req.URL.Query().Get(replyParameter); err == nil && replyEb != "" {
+				err = ga.allowlist.CheckHTTP(req, replyEb)
+			}
+			if err != nil {
+				http.Error(w, err.Error(), authorizeStatusCode(err))
+				return
+			}
+			next.ServeHTTP(w, req)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_ipAllowlistMiddleware(t *testing.T) {
+	Convey("test ip allowlist middleware", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		ga := NewGateway(Config{})
+		next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		serve := func(h http.Handler, target, addr string) int {
+			req := httptest.NewRequest(http.MethodPost, target, nil)
+			req.RemoteAddr = addr
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			return w.Code
+		}
+
+		Convey("test disabled ip allowlist", func() {
+			h := ga.ipAllowlistMiddleware(httpRequestPrefix)(next)
+			So(serve(h, "/gateway/test", "192.168.0.1:5000"), ShouldEqual, http.StatusOK)
+		})
+
+		Convey("test enabled ip allowlist", func() {
+			ebCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+			ebCtrl.EXPECT().ListIPAllowlist(Any(), Any()).Times(1).Return(&ctrlpb.ListIPAllowlistResponse{
+				Allowlists: []*ctrlpb.IPAllowlist{
+					{Kind: "eventbus", Name: "test", Cidrs: []string{"10.0.0.0/8"}},
+				},
+			}, nil)
+			ga.allowlist = proxy.NewIPAllowlist(proxy.IPAllowlistConfig{Enable: true}, ebCtrl)
+			h := ga.ipAllowlistMiddleware(httpRequestPrefix)(next)
+			So(serve(h, "/gateway/test", "10.0.0.1:5000"), ShouldEqual, http.StatusOK)
+			So(serve(h, "/gateway/test", "192.168.0.1:5000"), ShouldEqual, http.StatusForbidden)
+			So(serve(h, "/gateway/test/batch", "192.168.0.1:5000"), ShouldEqual, http.StatusForbidden)
+			So(serve(h, "/gateway/other", "192.168.0.1:5000"), ShouldEqual, http.StatusOK)
+			So(serve(h, "/gateway/other?reply=test", "192.168.0.1:5000"), ShouldEqual, http.StatusForbidden)
+
+			h = ga.ipAllowlistMiddleware(sseRequestPrefix)(next)
+			So(serve(h, "/sse/eventbus/test", "192.168.0.1:5000"), ShouldEqual, http.StatusForbidden)
+		})
+	})
+}
@@ -0,0 +1,386 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/amqp"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// The AMQP listener accepts AMQP 1.0 sender links, the target address of a link is mapped
+// to eventbus. SASL ANONYMOUS and PLAIN are offered and credentials aren't verified.
+
+const (
+	defaultAMQPPort     = 5672
+	defaultAMQPType     = "com.linkall.vanus.amqp"
+	amqpContainerID     = "vanus-gateway"
+	amqpConnectTimeout  = 10 * time.Second
+	amqpSessionWindow   = uint32(2048)
+	amqpLinkCredit      = uint32(100)
+	amqpAddressProperty = "amqpaddress"
+)
+
+var errAMQPUnexpectedFrame = errors.New("unexpected AMQP frame")
+
+type amqpServer struct {
+	*connServer
+	ga *ceGateway
+}
+
+type amqpSession struct {
+	nextIncomingID uint32
+	links          map[uint32]*amqpLink
+}
+
+type amqpLink struct {
+	address       string
+	eventbus      string
+	deliveryCount uint32
+	credit        uint32
+	// the message of delivery which is being transferred in multiple frames
+	deliveryID uint32
+	settled    bool
+	pending    bytes.Buffer
+}
+
+func (ga *ceGateway) startAMQPReceiver(ctx context.Context) error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetAMQPPort()))
+	if err != nil {
+		return err
+	}
+	ga.amqpSrv = &amqpServer{ga: ga}
+	ga.amqpSrv.connServer = newConnServer(ls, ga.amqpSrv.handleConn)
+	go ga.amqpSrv.serve(ctx)
+	return nil
+}
+
+func (s *amqpServer) handleConn(ctx context.Context, conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	ctx = withRemoteAddr(ctx, conn.RemoteAddr())
+	r := bufio.NewReader(conn)
+	_ = conn.SetReadDeadline(time.Now().Add(amqpConnectTimeout))
+	if err := s.handshake(r, conn); err != nil {
+		log.Debug(ctx, "AMQP handshake failed", map[string]interface{}{
+			log.KeyError: err,
+			"remote":     conn.RemoteAddr().String(),
+		})
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+	sessions := map[uint16]*amqpSession{}
+	for {
+		f, err := amqp.ReadFrame(r, amqp.DefaultMaxFrameSize)
+		if err != nil {
+			return
+		}
+		if f.Performative == nil {
+			continue
+		}
+		if err = s.handleFrame(ctx, conn, sessions, f); err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Warning(ctx, "handle AMQP frame failed", map[string]interface{}{
+					log.KeyError: err,
+					"remote":     conn.RemoteAddr().String(),
+				})
+			}
+			return
+		}
+	}
+}
+
+// handshake negotiates SASL if the client asks for it and then the AMQP protocol.
+func (s *amqpServer) handshake(r *bufio.Reader, conn net.Conn) error {
+	header, err := amqp.ReadProtocolHeader(r)
+	if err != nil {
+		// tell the client the supported protocol before closing
+		_, _ = conn.Write(amqp.ProtocolHeaderAMQP)
+		return err
+	}
+	if bytes.Equal(header, amqp.ProtocolHeaderSASL) {
+		if _, err = conn.Write(amqp.ProtocolHeaderSASL); err != nil {
+			return err
+		}
+		err = amqp.WriteFrame(conn, &amqp.Frame{
+			Type: amqp.FrameTypeSASL,
+			Performative: amqp.NewPerformative(amqp.CodeSASLMechanisms,
+				[]amqp.Symbol{amqp.SASLMechanismAnonymous, amqp.SASLMechanismPlain}),
+		})
+		if err != nil {
+			return err
+		}
+		f, err := amqp.ReadFrame(r, amqp.DefaultMaxFrameSize)
+		if err != nil {
+			return err
+		}
+		if f.Performative == nil || f.Performative.Code() != amqp.CodeSASLInit {
+			return errAMQPUnexpectedFrame
+		}
+		err = amqp.WriteFrame(conn, &amqp.Frame{
+			Type:         amqp.FrameTypeSASL,
+			Performative: amqp.NewPerformative(amqp.CodeSASLOutcome, amqp.SASLCodeOK),
+		})
+		if err != nil {
+			return err
+		}
+		if header, err = amqp.ReadProtocolHeader(r); err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(header, amqp.ProtocolHeaderAMQP) {
+		return fmt.Errorf("unexpected AMQP protocol header %v", header)
+	}
+	_, err = conn.Write(amqp.ProtocolHeaderAMQP)
+	return err
+}
+
+func (s *amqpServer) handleFrame(ctx context.Context, conn net.Conn,
+	sessions map[uint16]*amqpSession, f *amqp.Frame) error {
+	fields := f.Performative.Fields()
+	write := func(p *amqp.Described) error {
+		return amqp.WriteFrame(conn, &amqp.Frame{Channel: f.Channel, Performative: p})
+	}
+	switch f.Performative.Code() {
+	case amqp.CodeOpen:
+		return write(amqp.NewPerformative(amqp.CodeOpen, amqpContainerID, nil, uint32(amqp.DefaultMaxFrameSize)))
+	case amqp.CodeBegin:
+		nextOutgoingID, _ := fields.Field(1).(uint32)
+		sessions[f.Channel] = &amqpSession{
+			nextIncomingID: nextOutgoingID,
+			links:          map[uint32]*amqpLink{},
+		}
+		return write(amqp.NewPerformative(amqp.CodeBegin, f.Channel, uint32(0), amqpSessionWindow, amqpSessionWindow))
+	case amqp.CodeEnd:
+		delete(sessions, f.Channel)
+		return write(amqp.NewPerformative(amqp.CodeEnd))
+	case amqp.CodeClose:
+		_ = write(amqp.NewPerformative(amqp.CodeClose))
+		return io.EOF
+	case amqp.CodeFlow, amqp.CodeDisposition:
+		return nil
+	}
+	session, ok := sessions[f.Channel]
+	if !ok {
+		return fmt.Errorf("AMQP session of channel %d isn't begun", f.Channel)
+	}
+	if f.Performative.Code() == amqp.CodeAttach {
+		return s.attach(session, fields, write)
+	}
+	// handle is the first field of detach and transfer
+	handle, _ := fields.Field(0).(uint32)
+	switch f.Performative.Code() {
+	case amqp.CodeDetach:
+		delete(session.links, handle)
+		return write(amqp.NewPerformative(amqp.CodeDetach, handle, true))
+	case amqp.CodeTransfer:
+		link, ok := session.links[handle]
+		if !ok {
+			return fmt.Errorf("AMQP link of handle %d isn't attached", handle)
+		}
+		session.nextIncomingID++
+		if link.pending.Len() == 0 {
+			link.deliveryID, _ = fields.Field(1).(uint32)
+			link.settled, _ = fields.Field(4).(bool)
+		}
+		link.pending.Write(f.Payload)
+		if more, _ := fields.Field(5).(bool); more {
+			return nil
+		}
+		state := s.deliver(ctx, link, link.pending.Bytes())
+		link.pending.Reset()
+		link.deliveryCount++
+		if link.credit > 0 {
+			link.credit--
+		}
+		if !link.settled {
+			err := write(amqp.NewPerformative(amqp.CodeDisposition, amqp.RoleReceiver, link.deliveryID, nil, true, state))
+			if err != nil {
+				return err
+			}
+		}
+		if link.credit < amqpLinkCredit/2 {
+			link.credit = amqpLinkCredit
+			return write(s.flow(session, handle, link))
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported AMQP performative 0x%x", f.Performative.Code())
+}
+
+func (s *amqpServer) attach(session *amqpSession, fields amqp.List, write func(p *amqp.Described) error) error {
+	name, _ := fields.Field(0).(string)
+	handle, _ := fields.Field(1).(uint32)
+	role, _ := fields.Field(2).(bool)
+	var address string
+	if target, ok := fields.Field(6).(*amqp.Described); ok {
+		address, _ = target.Fields().Field(0).(string)
+	}
+	if role != amqp.RoleSender || address == "" {
+		// refuse the link by attaching with a null target and detaching it
+		err := write(amqp.NewPerformative(amqp.CodeAttach, name, handle, amqp.RoleReceiver, nil, nil, fields.Field(5)))
+		if err != nil {
+			return err
+		}
+		return write(amqp.NewPerformative(amqp.CodeDetach, handle, true,
+			amqp.NewError(amqp.ErrorNotImplemented, "only sender links with target address are supported")))
+	}
+	link := &amqpLink{
+		address:  address,
+		eventbus: address,
+		credit:   amqpLinkCredit,
+	}
+	if v, ok := s.ga.config.AMQP.Addresses[address]; ok {
+		link.eventbus = v
+	}
+	link.deliveryCount, _ = fields.Field(9).(uint32)
+	session.links[handle] = link
+	err := write(amqp.NewPerformative(amqp.CodeAttach, name, handle, amqp.RoleReceiver,
+		fields.Field(3), fields.Field(4), fields.Field(5), fields.Field(6)))
+	if err != nil {
+		return err
+	}
+	return write(s.flow(session, handle, link))
+}
+
+func (s *amqpServer) flow(session *amqpSession, handle uint32, link *amqpLink) *amqp.Described {
+	return amqp.NewPerformative(amqp.CodeFlow, session.nextIncomingID, amqpSessionWindow, uint32(0),
+		amqpSessionWindow, handle, link.deliveryCount, link.credit)
+}
+
+// deliver appends the message to eventbus and returns the delivery state, a malformed message is
+// rejected and a message failed to append is released so that the client can resend it.
+func (s *amqpServer) deliver(ctx context.Context, link *amqpLink, data []byte) *amqp.Described {
+	msg, err := amqp.UnmarshalMessage(data)
+	if err != nil {
+		return amqp.NewPerformative(amqp.CodeRejected, amqp.NewError(amqp.ErrorDecode, err.Error()))
+	}
+	event, err := amqpToEvent(link.address, link.eventbus, msg, s.ga.reserved)
+	if err != nil {
+		return amqp.NewPerformative(amqp.CodeRejected, amqp.NewError(amqp.ErrorInvalidField, err.Error()))
+	}
+	if err = s.ga.validateEvent(link.eventbus, event); err != nil {
+		return amqp.NewPerformative(amqp.CodeRejected, amqp.NewError(amqp.ErrorInvalidField, err.Error()))
+	}
+	_ctx, span := s.ga.tracer.Start(ctx, "receiveAMQP")
+	defer span.End()
+	if err = s.ga.authorizeConn(_ctx, link.eventbus); err != nil {
+		return amqp.NewPerformative(amqp.CodeRejected, amqp.NewError(amqp.ErrorUnauthorized, err.Error()))
+	}
+	if _, err = s.ga.getBusWriter(_ctx, link.eventbus).AppendOne(_ctx, event); err != nil {
+		log.Warning(_ctx, "append AMQP event failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   link.eventbus,
+			"address":    link.address,
+		})
+		return amqp.NewPerformative(amqp.CodeReleased)
+	}
+	return amqp.NewPerformative(amqp.CodeAccepted)
+}
+
+// amqpToEvent converts a message to CloudEvent, a message in the binary content mode of
+// CloudEvents AMQP protocol binding carries attributes in application properties, otherwise
+// it's wrapped with body as data.
+func amqpToEvent(address, ebName string, msg *amqp.Message,
+	reserved *primitive.ReservedAttributes) (*v2.Event, error) {
+	e := v2.NewEvent()
+	id, ok := msg.MessageID.(string)
+	if !ok || id == "" {
+		id = uuid.NewString()
+	}
+	e.SetID(id)
+	e.SetType(defaultAMQPType)
+	e.SetSource("/amqp/" + address)
+	e.SetSubject(msg.Subject)
+	if msg.CreationTime.IsZero() {
+		e.SetTime(time.Now())
+	} else {
+		e.SetTime(msg.CreationTime)
+	}
+	e.SetExtension(amqpAddressProperty, address)
+	for _, p := range msg.ApplicationProperties {
+		k, _ := p.Key.(string)
+		var attr string
+		switch {
+		case strings.HasPrefix(k, amqp.CloudEventsPropertyPrefix):
+			attr = strings.TrimPrefix(k, amqp.CloudEventsPropertyPrefix)
+		case strings.HasPrefix(k, amqp.LegacyCloudEventsPropertyPrefix):
+			attr = strings.TrimPrefix(k, amqp.LegacyCloudEventsPropertyPrefix)
+		default:
+			continue
+		}
+		if t, ok := p.Value.(time.Time); ok && attr == "time" {
+			e.SetTime(t)
+			continue
+		}
+		v := fmt.Sprint(p.Value)
+		switch attr {
+		case "specversion":
+		case "id":
+			e.SetID(v)
+		case "type":
+			e.SetType(v)
+		case "source":
+			e.SetSource(v)
+		case "subject":
+			e.SetSubject(v)
+		case "dataschema":
+			e.SetDataSchema(v)
+		case "time":
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ce time: %w", err)
+			}
+			e.SetTime(t)
+		default:
+			if err := reserved.Check(attr); err != nil {
+				return nil, err
+			}
+			if err := e.Context.SetExtension(attr, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	data := msg.Data
+	switch v := msg.Value.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	}
+	if len(data) > 0 {
+		if err := e.SetData(string(msg.ContentType), data); err != nil {
+			return nil, err
+		}
+	}
+	e.SetExtension(primitive.XVanusEventbus, ebName)
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/amqp"
+	triggerclient "github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_amqpToEvent(t *testing.T) {
+	Convey("test convert AMQP message to event", t, func() {
+		reserved := primitive.NewReservedAttributes([]string{"xtenant"})
+		Convey("test message without CloudEvents attributes", func() {
+			e, err := amqpToEvent("queue", "bus", &amqp.Message{
+				MessageID:   "id",
+				Subject:     "subject",
+				ContentType: "text/plain",
+				Value:       "hello",
+			}, reserved)
+			So(err, ShouldBeNil)
+			So(e.ID(), ShouldEqual, "id")
+			So(e.Type(), ShouldEqual, defaultAMQPType)
+			So(e.Source(), ShouldEqual, "/amqp/queue")
+			So(e.Subject(), ShouldEqual, "subject")
+			So(e.Extensions()[amqpAddressProperty], ShouldEqual, "queue")
+			So(e.Extensions()[primitive.XVanusEventbus], ShouldEqual, "bus")
+			So(string(e.Data()), ShouldEqual, "hello")
+		})
+
+		Convey("test message with legacy CloudEvents attributes", func() {
+			e, err := amqpToEvent("queue", "bus", &amqp.Message{
+				ApplicationProperties: amqp.Map{
+					{Key: amqp.LegacyCloudEventsPropertyPrefix + "type", Value: "test.type"},
+					{Key: amqp.LegacyCloudEventsPropertyPrefix + "time", Value: "2022-01-02T15:04:05Z"},
+					{Key: "other", Value: "v"},
+				},
+			}, reserved)
+			So(err, ShouldBeNil)
+			So(e.Type(), ShouldEqual, "test.type")
+			So(e.Time().Unix(), ShouldEqual, time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC).Unix())
+			So(e.Extensions()["other"], ShouldBeNil)
+
+			_, err = amqpToEvent("queue", "bus", &amqp.Message{
+				ApplicationProperties: amqp.Map{
+					{Key: amqp.CloudEventsPropertyPrefix + primitive.XVanusEventbus, Value: "test"},
+				},
+			}, reserved)
+			So(err, ShouldNotBeNil)
+			_, err = amqpToEvent("queue", "bus", &amqp.Message{
+				ApplicationProperties: amqp.Map{
+					{Key: amqp.CloudEventsPropertyPrefix + "xtenantid", Value: "test"},
+				},
+			}, reserved)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestGateway_handleAMQPConn(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+	mockClient := client.NewMockClient(ctrl)
+	mockEventbus := api.NewMockEventbus(ctrl)
+	mockBusWriter := api.NewMockBusWriter(ctrl)
+	mockClient.EXPECT().Eventbus(Any(), "orders").AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+
+	ga := &ceGateway{
+		reserved: primitive.NewReservedAttributes(nil),
+		client:   mockClient,
+		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		config: Config{
+			AMQP: AMQPConfig{
+				Enable:    true,
+				Addresses: map[string]string{"order-queue": "orders"},
+			},
+		},
+	}
+	ls, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &amqpServer{ga: ga}
+	s.connServer = newConnServer(ls, s.handleConn)
+	ctx := context.Background()
+	go s.serve(ctx)
+	defer s.stop()
+
+	Convey("test send events to AMQP listener by AMQP sink", t, func() {
+		cli := triggerclient.NewAMQPClient(fmt.Sprintf("amqp://%s/order-queue", ls.Addr().String()), "user", "pass")
+		event := ce.NewEvent()
+		event.SetID("id-1")
+		event.SetSource("/orders")
+		event.SetType("order.created")
+		event.SetExtension("region", "us")
+		_ = event.SetData(ce.ApplicationJSON, map[string]int{"id": 1})
+
+		Convey("test event is accepted", func() {
+			var received *ce.Event
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(2).DoAndReturn(
+				func(_ interface{}, e *ce.Event, _ ...api.WriteOption) (string, error) {
+					received = e
+					return "AABBCC", nil
+				})
+			_ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			defer cancel()
+			res := cli.Send(_ctx, event)
+			So(res.Err, ShouldBeNil)
+			So(received.ID(), ShouldEqual, "id-1")
+			So(received.Source(), ShouldEqual, "/orders")
+			So(received.Type(), ShouldEqual, "order.created")
+			So(received.Extensions()["region"], ShouldEqual, "us")
+			So(received.DataContentType(), ShouldEqual, ce.ApplicationJSON)
+			So(string(received.Data()), ShouldEqual, `{"id":1}`)
+			So(received.Extensions()[primitive.XVanusEventbus], ShouldEqual, "orders")
+
+			// the connection is reused
+			res = cli.Send(_ctx, event)
+			So(res.Err, ShouldBeNil)
+		})
+
+		Convey("test event is released if append failed", func() {
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Return("", fmt.Errorf("test"))
+			_ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			defer cancel()
+			res := cli.Send(_ctx, event)
+			So(res.Err, ShouldNotBeNil)
+			So(res.StatusCode, ShouldEqual, triggerclient.ErrUndefined)
+		})
+
+		Convey("test event with vanus attribute is rejected", func() {
+			e := event.Clone()
+			e.SetExtension(primitive.XVanus+"test", "test")
+			_ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+			defer cancel()
+			res := cli.Send(_ctx, e)
+			So(res.Err, ShouldNotBeNil)
+			So(res.StatusCode, ShouldEqual, 400)
+		})
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	// maxDecompressedBodySize limits the body size after decompression.
+	maxDecompressedBodySize = 32 << 20
+	// batchAtomicParameter set to false appends events of batch one by one, and responds
+	// the result of each event.
+	batchAtomicParameter = "atomic"
+)
+
+// BatchEventData is the response of an atomic batch, the IDs and the offsets of events of batch
+// are successive, and the placement is of the first event.
+type BatchEventData struct {
+	EventID string `json:"event_id"`
+	BusName string `json:"eventbus_name"`
+	Count   int    `json:"count"`
+	*EventPlacement
+}
+
+// BatchEventResult is the result of each event of a non-atomic batch.
+type BatchEventResult struct {
+	EventID string `json:"event_id,omitempty"`
+	BusName string `json:"eventbus_name,omitempty"`
+	Error   string `json:"error,omitempty"`
+	*EventPlacement
+}
+
+// publishMiddleware decompresses the gzip or deflate encoded body, and receives the CloudEvents
+// JSON batch which isn't supported by the CloudEvents receiver.
+func (ga *ceGateway) publishMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var (
+			body io.ReadCloser
+			err  error
+		)
+		switch encoding := strings.ToLower(req.Header.Get("Content-Encoding")); encoding {
+		case "", "identity":
+		case "gzip":
+			body, err = gzip.NewReader(req.Body)
+		case "deflate":
+			body, err = zlib.NewReader(req.Body)
+		default:
+			http.Error(w, fmt.Sprintf("unsupported content encoding: %s", encoding),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid %s body: %s",
+				req.Header.Get("Content-Encoding"), err), http.StatusBadRequest)
+			return
+		}
+		if body != nil {
+			defer func() {
+				_ = body.Close()
+			}()
+			req.Body = http.MaxBytesReader(w, body, maxDecompressedBodySize)
+			req.Header.Del("Content-Encoding")
+			req.Header.Del("Content-Length")
+			req.ContentLength = -1
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+		if mediaType == v2.ApplicationCloudEventsBatchJSON {
+			ga.receiveBatch(w, req)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+func (ga *ceGateway) receiveBatch(w http.ResponseWriter, req *http.Request) {
+	ctx, span := ga.tracer.Start(req.Context(), "receiveBatch")
+	defer span.End()
+
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	ebName := strings.TrimLeft(strings.TrimPrefix(req.URL.Path, httpRequestPrefix), "/")
+	if ebName == "" || !strings.HasPrefix(req.URL.Path, httpRequestPrefix) {
+		http.Error(w, "invalid eventbus name", http.StatusBadRequest)
+		return
+	}
+	if err := ga.authorize(ctx, req.Header, ebName, acl.PermissionPublish); err != nil {
+		http.Error(w, err.Error(), authorizeStatusCode(err))
+		return
+	}
+	var events []*v2.Event
+	if err := json.NewDecoder(req.Body).Decode(&events); err != nil {
+		http.Error(w, fmt.Sprintf("invalid batch: %s", err), http.StatusBadRequest)
+		return
+	}
+	if len(events) == 0 {
+		http.Error(w, "empty batch", http.StatusBadRequest)
+		return
+	}
+	ack, err := parseAckLevel(req.URL.Query().Get(ackParameter))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.URL.Query().Get(batchAtomicParameter) == "false" {
+		results := make([]BatchEventResult, len(events))
+		for i, e := range events {
+			results[i] = ga.appendBatchEvent(ctx, ebName, e, ack)
+		}
+		writeJSON(w, http.StatusOK, results)
+		return
+	}
+
+	// all events are validated before appending, and they must be appended to the same eventbus
+	target := ""
+	for i, e := range events {
+		if err := e.Validate(); err != nil {
+			http.Error(w, fmt.Sprintf("invalid event [%d]: %s", i, err), http.StatusBadRequest)
+			return
+		}
+		name, err := ga.prepareEvent(ctx, ebName, e)
+		if ve := (&ValidationError{}); errors.As(err, &ve) {
+			ve.Message = fmt.Sprintf("%s of event [%d]", ve.Message, i)
+			writeJSON(w, ve.statusCode(), ve)
+			return
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid event [%d]: %s", i, err), http.StatusBadRequest)
+			return
+		}
+		if target != "" && target != name {
+			http.Error(w, "events with and without delivery time can't be appended atomically",
+				http.StatusBadRequest)
+			return
+		}
+		target = name
+	}
+	eventID, placement, err := ga.appendEvents(ctx, target, events, option.WithAckLevel(ack))
+	if err != nil {
+		log.Warning(ctx, "append batch failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   target,
+		})
+		http.Error(w, err.Error(), appendStatusCode(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, BatchEventData{
+		EventID:        eventID,
+		BusName:        target,
+		Count:          len(events),
+		EventPlacement: placement,
+	})
+}
+
+func (ga *ceGateway) appendBatchEvent(
+	ctx context.Context, ebName string, e *v2.Event, ack api.AckLevel,
+) BatchEventResult {
+	if err := e.Validate(); err != nil {
+		return BatchEventResult{Error: err.Error()}
+	}
+	target, err := ga.prepareEvent(ctx, ebName, e)
+	if err != nil {
+		return BatchEventResult{Error: err.Error()}
+	}
+	eventID, placement, err := ga.appendEvents(ctx, target, []*v2.Event{e}, option.WithAckLevel(ack))
+	if err != nil {
+		log.Warning(ctx, "append to failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   target,
+		})
+		return BatchEventResult{Error: err.Error()}
+	}
+	return BatchEventResult{EventID: eventID, BusName: target, EventPlacement: placement}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", v2.ApplicationJSON)
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
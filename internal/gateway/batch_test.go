@@ -0,0 +1,204 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_publishMiddleware(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+	mockClient := client.NewMockClient(ctrl)
+	mockEventbus := api.NewMockEventbus(ctrl)
+	mockBusWriter := api.NewMockBusWriter(ctrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+
+	ga := &ceGateway{
+		reserved: primitive.NewReservedAttributes(nil),
+		client:   mockClient,
+		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+	}
+	var nextBody []byte
+	srv := httptest.NewServer(ga.publishMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		nextBody, _ = io.ReadAll(req.Body)
+		w.WriteHeader(http.StatusAccepted)
+	})))
+	defer srv.Close()
+
+	newEvent := func(id string) *ce.Event {
+		e := ce.NewEvent()
+		e.SetID(id)
+		e.SetSource("/test")
+		e.SetType("test")
+		return &e
+	}
+	post := func(query, contentType, encoding string, body []byte) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL+httpRequestPrefix+"/test"+query, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		if encoding != "" {
+			req.Header.Set("Content-Encoding", encoding)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		So(err, ShouldBeNil)
+		return resp
+	}
+	gzipData := func(data []byte) []byte {
+		buf := &bytes.Buffer{}
+		w := gzip.NewWriter(buf)
+		_, _ = w.Write(data)
+		_ = w.Close()
+		return buf.Bytes()
+	}
+
+	Convey("test compressed requests", t, func() {
+		Convey("test gzip body is decompressed for the CloudEvents receiver", func() {
+			resp := post("", ce.ApplicationJSON, "gzip", gzipData([]byte("hello")))
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusAccepted)
+			So(string(nextBody), ShouldEqual, "hello")
+		})
+
+		Convey("test deflate body is decompressed", func() {
+			buf := &bytes.Buffer{}
+			w := zlib.NewWriter(buf)
+			_, _ = w.Write([]byte("world"))
+			_ = w.Close()
+			resp := post("", ce.ApplicationJSON, "deflate", buf.Bytes())
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusAccepted)
+			So(string(nextBody), ShouldEqual, "world")
+		})
+
+		Convey("test invalid encodings", func() {
+			resp := post("", ce.ApplicationJSON, "br", []byte("hello"))
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusUnsupportedMediaType)
+			resp2 := post("", ce.ApplicationJSON, "gzip", []byte("hello"))
+			defer resp2.Body.Close()
+			So(resp2.StatusCode, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+
+	Convey("test batch requests", t, func() {
+		Convey("test atomic batch", func() {
+			var received []*ce.Event
+			var opts api.WriteOptions
+			placement := &api.Placement{EventlogID: 1, Offset: 10, Stime: 1000}
+			mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).DoAndReturn(
+				func(_ interface{}, events []*ce.Event, writeOpts ...api.WriteOption) (*api.Placement, error) {
+					received = events
+					opts.Apply(writeOpts...)
+					return placement, nil
+				})
+			data, _ := json.Marshal([]*ce.Event{newEvent("1"), newEvent("2")})
+			resp := post("?ack=leader", ce.ApplicationCloudEventsBatchJSON, "gzip", gzipData(data))
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			var res BatchEventData
+			So(json.NewDecoder(resp.Body).Decode(&res), ShouldBeNil)
+			So(res, ShouldResemble, BatchEventData{
+				EventID:        placement.EventID(0),
+				BusName:        "test",
+				Count:          2,
+				EventPlacement: &EventPlacement{EventlogID: 1, Offset: 10, Stime: 1000},
+			})
+			So(received, ShouldHaveLength, 2)
+			So(received[1].ID(), ShouldEqual, "2")
+			So(received[1].Extensions()[primitive.XVanusEventbus], ShouldEqual, "test")
+			So(opts.AckLevel, ShouldEqual, api.AckLeader)
+		})
+
+		Convey("test atomic batch with legacy response", func() {
+			ga.config.LegacyPublishResponse = true
+			defer func() {
+				ga.config.LegacyPublishResponse = false
+			}()
+			mockBusWriter.EXPECT().AppendMany(Any(), Any(), Any()).Return("AABBCC", nil)
+			data, _ := json.Marshal([]*ce.Event{newEvent("1"), newEvent("2")})
+			resp := post("", ce.ApplicationCloudEventsBatchJSON, "", data)
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			body, _ := io.ReadAll(resp.Body)
+			So(string(body), ShouldEqual, `{"event_id":"AABBCC","eventbus_name":"test","count":2}`)
+		})
+
+		Convey("test atomic batch with invalid event", func() {
+			e := newEvent("2")
+			e.SetExtension(primitive.XVanus+"test", "test")
+			data, _ := json.Marshal([]*ce.Event{newEvent("1"), e})
+			resp := post("", ce.ApplicationCloudEventsBatchJSON, "", data)
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+
+			e = newEvent("2")
+			e.SetExtension(primitive.XVanusDeliveryTime, "2022-01-02T15:04:05Z")
+			data, _ = json.Marshal([]*ce.Event{newEvent("1"), e})
+			resp2 := post("", ce.ApplicationCloudEventsBatchJSON, "", data)
+			defer resp2.Body.Close()
+			So(resp2.StatusCode, ShouldEqual, http.StatusBadRequest)
+
+			resp3 := post("", ce.ApplicationCloudEventsBatchJSON, "", []byte("[]"))
+			defer resp3.Body.Close()
+			So(resp3.StatusCode, ShouldEqual, http.StatusBadRequest)
+
+			data, _ = json.Marshal([]*ce.Event{newEvent("1")})
+			resp4 := post("?ack=all", ce.ApplicationCloudEventsBatchJSON, "", data)
+			defer resp4.Body.Close()
+			So(resp4.StatusCode, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("test non-atomic batch", func() {
+			placement := &api.Placement{EventlogID: 1, Offset: 10, Stime: 1000}
+			mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).Return(placement, nil)
+			mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).Return(nil, fmt.Errorf("test"))
+			e := newEvent("2")
+			e.SetExtension(primitive.XVanus+"test", "test")
+			data, _ := json.Marshal([]*ce.Event{newEvent("1"), e, newEvent("3")})
+			resp := post("?atomic=false", ce.ApplicationCloudEventsBatchJSON+"; charset=utf-8", "", data)
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			var res []BatchEventResult
+			So(json.NewDecoder(resp.Body).Decode(&res), ShouldBeNil)
+			So(res, ShouldHaveLength, 3)
+			So(res[0], ShouldResemble, BatchEventResult{
+				EventID:        placement.EventID(0),
+				BusName:        "test",
+				EventPlacement: &EventPlacement{EventlogID: 1, Offset: 10, Stime: 1000},
+			})
+			So(res[1].Error, ShouldNotBeEmpty)
+			So(res[2].Error, ShouldEqual, "test")
+		})
+	})
+}
@@ -15,8 +15,11 @@
 package gateway
 
 import (
+	"time"
+
 	"github.com/linkall-labs/vanus/internal/gateway/proxy"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
 	"github.com/linkall-labs/vanus/observability"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -26,6 +29,228 @@ type Config struct {
 	Observability        observability.Config `yaml:"observability"`
 	ControllerAddr       []string             `yaml:"controllers"`
 	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
+	Webhook              WebhookConfig        `yaml:"webhook"`
+	MQTT                 MQTTConfig           `yaml:"mqtt"`
+	Kafka                KafkaConfig          `yaml:"kafka"`
+	AMQP                 AMQPConfig           `yaml:"amqp"`
+	WebSocket            WebSocketConfig      `yaml:"websocket"`
+	SSE                  SSEConfig            `yaml:"sse"`
+	// Consume serves the REST API pulling events with cursor and committing offsets, for the SDKs
+	// which don't speak gRPC of store.
+	Consume ConsumeConfig `yaml:"consume"`
+	// Eventbuses are the policies validating events published to eventbus, keyed by
+	// eventbus name. Eventbus without a policy accepts any event.
+	Eventbuses map[string]EventbusPolicy `yaml:"eventbuses"`
+	RateLimit  RateLimitConfig           `yaml:"rate_limit"`
+	// LegacyPublishResponse omits the placement of events from the publish response, for old
+	// clients which don't accept unknown fields.
+	LegacyPublishResponse bool       `yaml:"legacy_publish_response"`
+	Blob                  BlobConfig `yaml:"blob"`
+	// Federation forwards the publish and lookup requests of the eventbuses owned by peer clusters
+	// to their gateways, so that applications spanning clusters connect to a single gateway.
+	Federation FederationConfig `yaml:"federation"`
+	// Dedup responds the result of the original publish to the retries of producers instead of
+	// appending the event again.
+	Dedup DedupConfig `yaml:"dedup"`
+	// ReservedAttributePrefixes are the prefixes of extension attributes which producers can't set
+	// besides xvanus, e.g. the prefix of the tenant attributes set by a proxy in front of gateway.
+	ReservedAttributePrefixes []string `yaml:"reserved_attribute_prefixes"`
+	// ACL authenticates producers and consumers by bearer token, and enforces the ACL entries of
+	// eventbuses saved in controller.
+	ACL ACLConfig `yaml:"acl"`
+	// IPAllowlist rejects the requests from the addresses out of the allowlists of eventbuses and
+	// namespaces saved in controller before any processing.
+	IPAllowlist IPAllowlistConfig `yaml:"ip_allowlist"`
+	// FeatureFlags the defaults of experimental features, which are overridden by the flags saved in
+	// controller.
+	FeatureFlags featureflag.Config `yaml:"feature_flags"`
+	// QUIC serves the CloudEvents receiver over HTTP/3 and the gRPC proxy over QUIC besides TCP, for the
+	// producers on lossy networks.
+	QUIC QUICConfig `yaml:"quic"`
+}
+
+// QUICConfig listens the UDP ports with the same numbers as the TCP ports of the CloudEvents receiver
+// and the gRPC proxy.
+type QUICConfig struct {
+	Enable bool `yaml:"enable"`
+	// CertFile and KeyFile are the TLS certificate of the QUIC listeners, QUIC always requires TLS.
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+// IPAllowlistConfig is the source address restriction of gateway, the namespace of request is the
+// header of rate limit, and the allowlist of namespace applies to the requests declaring it.
+type IPAllowlistConfig struct {
+	Enable bool `yaml:"enable"`
+	// CacheTTL is how long the allowlists are cached, defaults to 10s.
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+	// TrustForwardedFor takes the last address of X-Forwarded-For as the source address, enable it
+	// only if gateway is behind a load balancer which appends it.
+	TrustForwardedFor bool `yaml:"trust_forwarded_for"`
+}
+
+// ACLConfig is the authentication of gateway, the requests without token and the ones received by MQTT,
+// Kafka and AMQP listeners are made by the anonymous principal.
+type ACLConfig struct {
+	Enable bool `yaml:"enable"`
+	// Tokens maps the bearer token to the principal.
+	Tokens map[string]string `yaml:"tokens"`
+	// CacheTTL is how long the ACL entries of an eventbus are cached, defaults to 10s.
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+// DedupConfig deduplicates the events published to the CloudEvents receiver by eventbus, source and id.
+type DedupConfig struct {
+	Enable bool `yaml:"enable"`
+	// Window is how long the result of publish is remembered, defaults to 5m.
+	Window time.Duration `yaml:"window"`
+	// Capacity is the max number of results remembered, the oldest one is forgotten first when it's
+	// exceeded, defaults to 1048576.
+	Capacity int `yaml:"capacity"`
+	// File persists the results when the gateway stops and loads them when it starts, so that the
+	// retries across restart are deduplicated too. The results are only kept in memory if it's empty.
+	File string `yaml:"file"`
+}
+
+type FederationConfig struct {
+	// Clusters maps the name of peer cluster to the address of its gateway, which is the host and
+	// the proxy port, e.g. 10.0.0.2:8080.
+	Clusters map[string]string `yaml:"clusters"`
+	// Eventbuses maps the eventbus owned by a peer cluster to the name of cluster.
+	Eventbuses map[string]string `yaml:"eventbuses"`
+}
+
+// BlobConfig offloads the data of large events to a blob store shared with the trigger workers,
+// the eventlog only keeps the reference, so that blocks are kept small and appends are fast.
+type BlobConfig struct {
+	// Dir is the directory of blob store, it must be a volume shared by all gateways and trigger
+	// workers. Offloading is disabled if neither Dir nor S3 is set.
+	Dir string `yaml:"dir"`
+	// S3 stores the blobs in an S3 compatible bucket instead of Dir.
+	S3 primitive.BlobS3Config `yaml:"s3"`
+	// Threshold is the min bytes of data to offload, defaults to 256KiB.
+	Threshold int `yaml:"threshold"`
+}
+
+// RateLimitConfig limits the HTTP publish requests of the CloudEvents receiver and webhook, the
+// limits are reloadable.
+type RateLimitConfig struct {
+	Enable bool      `yaml:"enable"`
+	Global RateLimit `yaml:"global" reload:"true"`
+	// NamespaceHeader is the request header whose value is the namespace, defaults to
+	// X-Vanus-Namespace. Set it to Authorization to limit each token.
+	NamespaceHeader string `yaml:"namespace_header"`
+	// Namespace is the limit of each namespace without its own limit in Namespaces.
+	Namespace  RateLimit            `yaml:"namespace" reload:"true"`
+	Namespaces map[string]RateLimit `yaml:"namespaces" reload:"true"`
+}
+
+// RateLimit is unlimited if the value is 0.
+type RateLimit struct {
+	RequestsPerSecond int `yaml:"requests_per_second"`
+	BytesPerSecond    int `yaml:"bytes_per_second"`
+}
+
+type EventbusPolicy struct {
+	// MaxEventSize is the max bytes of an event in the structured JSON format, 0 means unlimited.
+	MaxEventSize int `yaml:"max_event_size"`
+	// RequiredAttributes are the optional attributes or the extensions which must be set,
+	// e.g. subject.
+	RequiredAttributes []string `yaml:"required_attributes"`
+	// Schema is the JSON Schema of data, the drafts from 4 to 2020-12 are supported, the draft is
+	// told by $schema and defaults to 2020-12. SchemaFile takes precedence when it's set.
+	Schema     string `yaml:"schema"`
+	SchemaFile string `yaml:"schema_file"`
+}
+
+type SSEConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to the WebSocket port plus one.
+	Port int `yaml:"port"`
+	// MaxRate is the max number of events sent per second of each connection,
+	// clients can lower it by the rate query parameter.
+	MaxRate int `yaml:"max_rate"`
+}
+
+type ConsumeConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to the SSE port plus one.
+	Port int `yaml:"port"`
+	// MaxEvents is the max number of events responded by a pull, clients can lower it by the max
+	// query parameter, defaults to 100.
+	MaxEvents int `yaml:"max_events"`
+}
+
+type WebSocketConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to the webhook port plus one.
+	Port int `yaml:"port"`
+	// MaxRate is the max number of events sent per second of each connection,
+	// clients can lower it by the rate query parameter.
+	MaxRate int `yaml:"max_rate"`
+	// AllowedOrigins allows all origins if it's empty.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+type AMQPConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to 5672.
+	Port int `yaml:"port"`
+	// Addresses maps target address of AMQP link to eventbus, an address without
+	// the mapping is written to the eventbus with the same name.
+	Addresses map[string]string `yaml:"addresses"`
+}
+
+type KafkaConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to 9092.
+	Port int `yaml:"port"`
+	// AdvertisedHost is the host returned to clients in metadata, defaults to
+	// the hostname of gateway.
+	AdvertisedHost string `yaml:"advertised_host"`
+	// Topics maps Kafka topic to eventbus, a topic without the mapping is
+	// written to the eventbus with the same name.
+	Topics map[string]string `yaml:"topics"`
+}
+
+type MQTTConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to 1883.
+	Port int `yaml:"port"`
+	// Rules map MQTT topics to eventbuses, the first matched rule is used.
+	Rules []MQTTRule `yaml:"rules"`
+}
+
+type MQTTRule struct {
+	// Topic is a MQTT topic filter, wildcards + and # are supported.
+	Topic    string `yaml:"topic"`
+	Eventbus string `yaml:"eventbus"`
+	Type     string `yaml:"type"`
+	Source   string `yaml:"source"`
+}
+
+type WebhookConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port defaults to the CloudEvents receiver port plus one.
+	Port int `yaml:"port"`
+	// Rules describe how requests posted to /webhook/<eventbus> are wrapped
+	// into CloudEvents, keyed by eventbus name. Eventbus without a rule uses
+	// the default one.
+	Rules map[string]WebhookRule `yaml:"rules"`
+}
+
+type WebhookRule struct {
+	// Type is the ce type, TypeHeader takes precedence when the request has it.
+	Type       string `yaml:"type"`
+	TypeHeader string `yaml:"type_header"`
+	// Source is the ce source, SourceHeader takes precedence when the request has it.
+	Source       string `yaml:"source"`
+	SourceHeader string `yaml:"source_header"`
+	// IDHeader is the header used as ce id, e.g. X-GitHub-Delivery, a uuid is
+	// generated if it's empty or missing.
+	IDHeader string `yaml:"id_header"`
+	// Extensions maps request header to ce extension attribute.
+	Extensions map[string]string `yaml:"extensions"`
 }
 
 func (c Config) GetProxyConfig() proxy.Config {
@@ -35,6 +260,27 @@ func (c Config) GetProxyConfig() proxy.Config {
 		CloudEventReceiverPort: c.GetCloudEventReceiverPort(),
 		GRPCReflectionEnable:   c.GRPCReflectionEnable,
 		Credentials:            insecure.NewCredentials(),
+		Federation: proxy.FederationConfig{
+			Clusters:   c.Federation.Clusters,
+			Eventbuses: c.Federation.Eventbuses,
+		},
+		ACL: proxy.ACLConfig{
+			Enable:   c.ACL.Enable,
+			Tokens:   c.ACL.Tokens,
+			CacheTTL: c.ACL.CacheTTL,
+		},
+		IPAllowlist: proxy.IPAllowlistConfig{
+			Enable:            c.IPAllowlist.Enable,
+			CacheTTL:          c.IPAllowlist.CacheTTL,
+			TrustForwardedFor: c.IPAllowlist.TrustForwardedFor,
+			NamespaceHeader:   c.RateLimit.NamespaceHeader,
+		},
+		FeatureFlags: c.FeatureFlags,
+		QUIC: proxy.QUICConfig{
+			Enable:   c.QUIC.Enable,
+			CertFile: c.QUIC.CertFile,
+			KeyFile:  c.QUIC.KeyFile,
+		},
 	}
 }
 
@@ -42,6 +288,97 @@ func (c Config) GetCloudEventReceiverPort() int {
 	return c.Port + 1
 }
 
+func (c Config) GetWebhookPort() int {
+	if c.Webhook.Port > 0 {
+		return c.Webhook.Port
+	}
+	return c.GetCloudEventReceiverPort() + 1
+}
+
+func (c Config) GetMQTTPort() int {
+	if c.MQTT.Port > 0 {
+		return c.MQTT.Port
+	}
+	return defaultMQTTPort
+}
+
+func (c Config) GetKafkaPort() int {
+	if c.Kafka.Port > 0 {
+		return c.Kafka.Port
+	}
+	return defaultKafkaPort
+}
+
+func (c Config) GetAMQPPort() int {
+	if c.AMQP.Port > 0 {
+		return c.AMQP.Port
+	}
+	return defaultAMQPPort
+}
+
+func (c Config) GetWebSocketPort() int {
+	if c.WebSocket.Port > 0 {
+		return c.WebSocket.Port
+	}
+	return c.GetWebhookPort() + 1
+}
+
+func (c Config) GetWebSocketMaxRate() int {
+	if c.WebSocket.MaxRate > 0 {
+		return c.WebSocket.MaxRate
+	}
+	return defaultStreamMaxRate
+}
+
+func (c Config) GetSSEPort() int {
+	if c.SSE.Port > 0 {
+		return c.SSE.Port
+	}
+	return c.GetWebSocketPort() + 1
+}
+
+func (c Config) GetSSEMaxRate() int {
+	if c.SSE.MaxRate > 0 {
+		return c.SSE.MaxRate
+	}
+	return defaultStreamMaxRate
+}
+
+func (c Config) GetConsumePort() int {
+	if c.Consume.Port > 0 {
+		return c.Consume.Port
+	}
+	return c.GetSSEPort() + 1
+}
+
+func (c Config) GetConsumeMaxEvents() int {
+	if c.Consume.MaxEvents > 0 {
+		return c.Consume.MaxEvents
+	}
+	return defaultConsumeMaxEvents
+}
+
+func (c Config) GetBlobThreshold() int {
+	if c.Blob.Threshold > 0 {
+		return c.Blob.Threshold
+	}
+	return defaultBlobThreshold
+}
+
+func (c Config) GetDedupWindow() time.Duration {
+	if c.Dedup.Window > 0 {
+		return c.Dedup.Window
+	}
+	return defaultDedupWindow
+}
+
+func (c Config) GetDedupCapacity() int {
+	if c.Dedup.Capacity > 0 {
+		return c.Dedup.Capacity
+	}
+	return defaultDedupCapacity
+}
+
 func InitConfig(filename string) (*Config, error) {
 	c := new(Config)
 	err := primitive.LoadConfig(filename, c)
@@ -0,0 +1,87 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// connServer serves each accepted connection with handler until it's stopped,
+// it's shared by the listeners of non-HTTP protocols.
+type connServer struct {
+	listener net.Listener
+	handler  func(ctx context.Context, conn net.Conn)
+	wg       sync.WaitGroup
+	mutex    sync.Mutex
+	conns    map[net.Conn]struct{}
+	closed   bool
+}
+
+func newConnServer(ls net.Listener, handler func(ctx context.Context, conn net.Conn)) *connServer {
+	return &connServer{
+		listener: ls,
+		handler:  handler,
+		conns:    map[net.Conn]struct{}{},
+	}
+}
+
+func (s *connServer) serve(ctx context.Context) {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.mutex.Lock()
+			closed := s.closed
+			s.mutex.Unlock()
+			if !closed {
+				log.Error(ctx, "accept connection failed", map[string]interface{}{
+					log.KeyError: err,
+					"addr":       s.listener.Addr().String(),
+				})
+			}
+			return
+		}
+		s.mutex.Lock()
+		if s.closed {
+			s.mutex.Unlock()
+			_ = conn.Close()
+			return
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mutex.Unlock()
+		go func() {
+			defer s.wg.Done()
+			s.handler(ctx, conn)
+			s.mutex.Lock()
+			delete(s.conns, conn)
+			s.mutex.Unlock()
+		}()
+	}
+}
+
+func (s *connServer) stop() {
+	s.mutex.Lock()
+	s.closed = true
+	_ = s.listener.Close()
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mutex.Unlock()
+	s.wg.Wait()
+}
@@ -0,0 +1,394 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// The consume endpoints are the REST API for the SDKs which don't speak gRPC of store. A client pulls
+// events with the cursor returned by the last pull, and commits the cursor after processing them. The
+// committed offsets of a consumer are saved in controller as a consumer group without members, so
+// that the consumer resumes from them on any gateway.
+//
+//	GET  /consume/eventbus/<eventbus>/events?consumer=&cursor=&max=&filters=
+//	GET  /consume/eventbus/<eventbus>/commit?consumer=
+//	POST /consume/eventbus/<eventbus>/commit {"consumer": "", "cursor": ""}
+//	POST /consume/eventbus/<eventbus>/seek   {"consumer": "", "position": "earliest|latest|<cursor>"}
+
+const (
+	consumeRequestPrefix    = "/consume/eventbus/"
+	defaultConsumeMaxEvents = 100
+	// consumePullTimeout bounds the time a pull scans the eventlogs when the filters reject most events.
+	consumePullTimeout = 3 * time.Second
+
+	consumeActionEvents = "events"
+	consumeActionCommit = "commit"
+	consumeActionSeek   = "seek"
+
+	consumePositionEarliest = "earliest"
+	consumePositionLatest   = "latest"
+)
+
+var errPullFull = stderr.New("the pull is full")
+
+type pullResponse struct {
+	// Events are in structured JSON of CloudEvents.
+	Events []json.RawMessage `json:"events"`
+	// Cursor is the position after the events, the next pull resumes with it.
+	Cursor string `json:"cursor"`
+}
+
+type commitRequest struct {
+	Consumer string `json:"consumer"`
+	Cursor   string `json:"cursor"`
+}
+
+type seekRequest struct {
+	Consumer string `json:"consumer"`
+	// Position is earliest, latest or a cursor.
+	Position string `json:"position"`
+	// Time seeks to the events stored since it, it takes precedence over Position.
+	Time *time.Time `json:"time,omitempty"`
+}
+
+type cursorResponse struct {
+	Cursor string `json:"cursor"`
+}
+
+// pullCollector collects events of a pull, it stops the stream once max events are collected.
+type pullCollector struct {
+	max    int
+	events []json.RawMessage
+	cursor string
+}
+
+func (c *pullCollector) sendEvent(e *ce.Event, cursor string) error {
+	data, err := e.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	c.events = append(c.events, data)
+	c.cursor = cursor
+	if len(c.events) >= c.max {
+		return errPullFull
+	}
+	return nil
+}
+
+func (c *pullCollector) sendCursor(cursor string) error {
+	c.cursor = cursor
+	return nil
+}
+
+func (ga *ceGateway) startConsumeReceiver() error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetConsumePort()))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(consumeRequestPrefix,
+		ga.ipAllowlistMiddleware(consumeRequestPrefix)(http.HandlerFunc(ga.serveConsume)))
+	ga.consumeSrv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := ga.consumeSrv.Serve(ls); err != nil && err != http.ErrServerClosed {
+			panic(fmt.Sprintf("start consume server failed: %s", err.Error()))
+		}
+	}()
+	return nil
+}
+
+func (ga *ceGateway) serveConsume(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, consumeRequestPrefix)
+	idx := strings.Index(path, "/")
+	if idx <= 0 {
+		http.NotFound(w, req)
+		return
+	}
+	ebName, action := path[:idx], path[idx+1:]
+	var handler func(http.ResponseWriter, *http.Request, string)
+	switch {
+	case action == consumeActionEvents && req.Method == http.MethodGet:
+		handler = ga.pullEvents
+	case action == consumeActionCommit && req.Method == http.MethodGet:
+		handler = ga.getCommitted
+	case action == consumeActionCommit && req.Method == http.MethodPost:
+		handler = ga.commitCursor
+	case action == consumeActionSeek && req.Method == http.MethodPost:
+		handler = ga.seekConsumer
+	case action == consumeActionEvents || action == consumeActionCommit || action == consumeActionSeek:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	default:
+		http.NotFound(w, req)
+		return
+	}
+	if err := ga.authorize(req.Context(), req.Header, ebName, acl.PermissionSubscribe); err != nil {
+		http.Error(w, err.Error(), authorizeStatusCode(err))
+		return
+	}
+	handler(w, req, ebName)
+}
+
+func (ga *ceGateway) pullEvents(w http.ResponseWriter, req *http.Request, ebName string) {
+	ctx := req.Context()
+	query := req.URL.Query()
+	f, err := parseStreamFilter(query.Get("filters"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	maxEvents := ga.config.GetConsumeMaxEvents()
+	if v := query.Get("max"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid max", http.StatusBadRequest)
+			return
+		}
+		if n < maxEvents {
+			maxEvents = n
+		}
+	}
+	sr := &streamRequest{ebName: ebName, filter: f}
+	if v := query.Get("cursor"); v != "" {
+		if sr.offsets, err = decodeStreamCursor(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else if consumer := query.Get("consumer"); consumer != "" {
+		if err = validateConsumer(consumer); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if sr.offsets, err = ga.getConsumerOffsets(ctx, consumer); err != nil {
+			http.Error(w, err.Error(), consumeStatusCode(err))
+			return
+		}
+	}
+
+	c := &pullCollector{max: maxEvents, events: make([]json.RawMessage, 0)}
+	s, err := ga.newEventStream(ctx, sr, c)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if len(sr.offsets) > 0 {
+		// the consumer has a position, the eventlogs created after it are all new to the consumer.
+		for _, l := range s.logs {
+			if _, ok := sr.offsets[l.ID()]; ok {
+				continue
+			}
+			if s.offsets[l.ID()], err = l.EarliestOffset(ctx); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	cursor, err := s.pull(ctx, c)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeConsumeResponse(w, &pullResponse{Events: c.events, Cursor: cursor})
+}
+
+// pull reads the eventlogs round by round until the collector is full or no event is read, it returns
+// the cursor after the events collected.
+func (s *eventStream) pull(ctx context.Context, c *pullCollector) (string, error) {
+	start := time.Now()
+	for time.Since(start) < consumePullTimeout {
+		read := 0
+		for _, l := range s.logs {
+			n, err := s.stream(ctx, l)
+			if stderr.Is(err, errPullFull) {
+				return c.cursor, nil
+			}
+			if err != nil {
+				return "", err
+			}
+			read += n
+		}
+		if read == 0 {
+			break
+		}
+	}
+	return encodeStreamCursor(s.offsets), nil
+}
+
+func (ga *ceGateway) getCommitted(w http.ResponseWriter, req *http.Request, _ string) {
+	consumer := req.URL.Query().Get("consumer")
+	if err := validateConsumer(consumer); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	offsets, err := ga.getConsumerOffsets(req.Context(), consumer)
+	if err != nil {
+		http.Error(w, err.Error(), consumeStatusCode(err))
+		return
+	}
+	res := &cursorResponse{}
+	if len(offsets) > 0 {
+		res.Cursor = encodeStreamCursor(offsets)
+	}
+	writeConsumeResponse(w, res)
+}
+
+func (ga *ceGateway) commitCursor(w http.ResponseWriter, req *http.Request, ebName string) {
+	body := &commitRequest{}
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+		return
+	}
+	if err := validateConsumer(body.Consumer); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	offsets, err := decodeStreamCursor(body.Cursor)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = ga.setConsumerOffsets(req.Context(), ebName, body.Consumer, offsets); err != nil {
+		http.Error(w, err.Error(), consumeStatusCode(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ga *ceGateway) seekConsumer(w http.ResponseWriter, req *http.Request, ebName string) {
+	ctx := req.Context()
+	body := &seekRequest{}
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+		return
+	}
+	if err := validateConsumer(body.Consumer); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var offsets map[uint64]int64
+	var err error
+	switch {
+	case body.Time == nil && body.Position != consumePositionEarliest && body.Position != consumePositionLatest:
+		if offsets, err = decodeStreamCursor(body.Position); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	default:
+		if offsets, err = ga.seekOffsets(ctx, ebName, body); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+	}
+	if err = ga.setConsumerOffsets(ctx, ebName, body.Consumer, offsets); err != nil {
+		http.Error(w, err.Error(), consumeStatusCode(err))
+		return
+	}
+	writeConsumeResponse(w, &cursorResponse{Cursor: encodeStreamCursor(offsets)})
+}
+
+// seekOffsets returns the offsets of each eventlog at the time or the position of seek request.
+func (ga *ceGateway) seekOffsets(ctx context.Context, ebName string, body *seekRequest) (map[uint64]int64, error) {
+	logs, err := ga.client.Eventbus(ctx, ebName).ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offsets := make(map[uint64]int64, len(logs))
+	for _, l := range logs {
+		var off int64
+		switch {
+		case body.Time != nil:
+			off, err = l.QueryOffsetByTime(ctx, body.Time.UnixMilli())
+		case body.Position == consumePositionEarliest:
+			off, err = l.EarliestOffset(ctx)
+		default:
+			off, err = l.LatestOffset(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		offsets[l.ID()] = off
+	}
+	return offsets, nil
+}
+
+func (ga *ceGateway) getConsumerOffsets(ctx context.Context, consumer string) (map[uint64]int64, error) {
+	res, err := ga.eventbusCtrl.GetConsumerGroupOffset(ctx, &ctrlpb.GetConsumerGroupOffsetRequest{
+		Group: consumer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	offsets := make(map[uint64]int64, len(res.Offsets))
+	for _, o := range res.Offsets {
+		offsets[o.EventlogId] = o.Offset
+	}
+	return offsets, nil
+}
+
+func (ga *ceGateway) setConsumerOffsets(ctx context.Context, ebName, consumer string,
+	offsets map[uint64]int64) error {
+	req := &ctrlpb.SetConsumerGroupOffsetRequest{
+		Group:    consumer,
+		Eventbus: ebName,
+		Offsets:  make([]*ctrlpb.ConsumerGroupOffset, 0, len(offsets)),
+	}
+	for id, off := range offsets {
+		req.Offsets = append(req.Offsets, &ctrlpb.ConsumerGroupOffset{EventlogId: id, Offset: off})
+	}
+	_, err := ga.eventbusCtrl.SetConsumerGroupOffset(ctx, req)
+	return err
+}
+
+func validateConsumer(consumer string) error {
+	if consumer == "" || strings.Contains(consumer, "/") {
+		return fmt.Errorf("invalid consumer")
+	}
+	return nil
+}
+
+func consumeStatusCode(err error) int {
+	switch {
+	case errors.Is(err, errors.ErrInvalidRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, errors.ErrResourceNotFound):
+		return http.StatusNotFound
+	default:
+		return authorizeStatusCode(err)
+	}
+}
+
+func writeConsumeResponse(w http.ResponseWriter, res interface{}) {
+	data, _ := json.Marshal(res)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
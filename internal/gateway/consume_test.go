@@ -0,0 +1,212 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_serveConsume(t *testing.T) {
+	Convey("test consume events by REST API", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockEventlog := api.NewMockEventlog(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		ebCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), "test").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+		mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+		mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+		events := make([]*ce.Event, 0)
+		for i, eventType := range []string{"order.created", "order.deleted", "order.updated"} {
+			e := ce.NewEvent()
+			e.SetID(eventType)
+			e.SetSource("/test")
+			e.SetType(eventType)
+			buf := make([]byte, 8)
+			binary.BigEndian.PutUint64(buf, uint64(5+i))
+			e.SetExtension(eventlog.XVanusLogOffset, buf)
+			events = append(events, &e)
+		}
+
+		ga := &ceGateway{
+			client:       mockClient,
+			eventbusCtrl: ebCtrl,
+			config:       Config{Consume: ConsumeConfig{Enable: true}},
+		}
+		srv := httptest.NewServer(http.HandlerFunc(ga.serveConsume))
+		defer srv.Close()
+		pull := func(q url.Values) (int, *pullResponse) {
+			resp, err := http.Get(srv.URL + consumeRequestPrefix + "test/events?" + q.Encode())
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			res := &pullResponse{}
+			if resp.StatusCode == http.StatusOK {
+				So(json.NewDecoder(resp.Body).Decode(res), ShouldBeNil)
+			}
+			return resp.StatusCode, res
+		}
+		post := func(action string, body interface{}) *http.Response {
+			data, _ := json.Marshal(body)
+			resp, err := http.Post(srv.URL+consumeRequestPrefix+"test/"+action, "application/json",
+				bytes.NewReader(data))
+			So(err, ShouldBeNil)
+			return resp
+		}
+
+		Convey("test pull with cursor, filters and max", func() {
+			mockBusReader.EXPECT().Read(Any()).Times(1).Return(events, int64(0), uint64(1), nil)
+			q := url.Values{}
+			q.Set("cursor", encodeStreamCursor(map[uint64]int64{1: 5}))
+			q.Set("filters", `[{"not":{"exact":{"type":"order.created"}}}]`)
+			q.Set("max", "1")
+			code, res := pull(q)
+			So(code, ShouldEqual, http.StatusOK)
+			So(res.Events, ShouldHaveLength, 1)
+			e := ce.NewEvent()
+			So(json.Unmarshal(res.Events[0], &e), ShouldBeNil)
+			So(e.ID(), ShouldEqual, "order.deleted")
+			So(e.Extensions()[eventlog.XVanusLogOffset], ShouldBeNil)
+			offsets, err := decodeStreamCursor(res.Cursor)
+			So(err, ShouldBeNil)
+			So(offsets, ShouldResemble, map[uint64]int64{1: 7})
+		})
+
+		var setReq *ctrlpb.SetConsumerGroupOffsetRequest
+		expectSet := func(err error) {
+			ebCtrl.EXPECT().SetConsumerGroupOffset(Any(), Any()).Times(1).DoAndReturn(
+				func(_ interface{}, req *ctrlpb.SetConsumerGroupOffsetRequest, _ ...interface{}) (interface{}, error) {
+					setReq = req
+					return nil, err
+				})
+		}
+
+		Convey("test pull from the committed offsets", func() {
+			var getReq *ctrlpb.GetConsumerGroupOffsetRequest
+			ebCtrl.EXPECT().GetConsumerGroupOffset(Any(), Any()).Times(1).DoAndReturn(
+				func(_ interface{}, req *ctrlpb.GetConsumerGroupOffsetRequest,
+					_ ...interface{}) (*ctrlpb.GetConsumerGroupOffsetResponse, error) {
+					getReq = req
+					return &ctrlpb.GetConsumerGroupOffsetResponse{
+						Offsets: []*ctrlpb.ConsumerGroupOffset{{EventlogId: 1, Offset: 5}},
+					}, nil
+				})
+			mockBusReader.EXPECT().Read(Any()).Times(1).Return(events, int64(0), uint64(1), nil)
+			mockBusReader.EXPECT().Read(Any()).Times(1).Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+			code, res := pull(url.Values{"consumer": []string{"c1"}})
+			So(code, ShouldEqual, http.StatusOK)
+			So(getReq.Group, ShouldEqual, "c1")
+			So(res.Events, ShouldHaveLength, 3)
+			offsets, err := decodeStreamCursor(res.Cursor)
+			So(err, ShouldBeNil)
+			So(offsets, ShouldResemble, map[uint64]int64{1: 8})
+		})
+
+		Convey("test pull a new consumer from the latest", func() {
+			ebCtrl.EXPECT().GetConsumerGroupOffset(Any(), Any()).Times(1).Return(
+				&ctrlpb.GetConsumerGroupOffsetResponse{}, nil)
+			mockEventlog.EXPECT().LatestOffset(Any()).Times(1).Return(int64(10), nil)
+			mockBusReader.EXPECT().Read(Any()).Times(1).Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+			code, res := pull(url.Values{"consumer": []string{"c1"}})
+			So(code, ShouldEqual, http.StatusOK)
+			So(res.Events, ShouldBeEmpty)
+			offsets, _ := decodeStreamCursor(res.Cursor)
+			So(offsets, ShouldResemble, map[uint64]int64{1: 10})
+		})
+
+		Convey("test commit and get the committed cursor", func() {
+			expectSet(nil)
+			resp := post(consumeActionCommit, &commitRequest{
+				Consumer: "c1", Cursor: encodeStreamCursor(map[uint64]int64{1: 8}),
+			})
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusNoContent)
+			So(setReq.Group, ShouldEqual, "c1")
+			So(setReq.Eventbus, ShouldEqual, "test")
+			So(setReq.Offsets, ShouldResemble, []*ctrlpb.ConsumerGroupOffset{{EventlogId: 1, Offset: 8}})
+
+			ebCtrl.EXPECT().GetConsumerGroupOffset(Any(), Any()).Times(1).Return(
+				&ctrlpb.GetConsumerGroupOffsetResponse{
+					Offsets: []*ctrlpb.ConsumerGroupOffset{{EventlogId: 1, Offset: 8}},
+				}, nil)
+			resp, err := http.Get(srv.URL + consumeRequestPrefix + "test/commit?consumer=c1")
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			res := &cursorResponse{}
+			So(json.NewDecoder(resp.Body).Decode(res), ShouldBeNil)
+			So(res.Cursor, ShouldEqual, encodeStreamCursor(map[uint64]int64{1: 8}))
+
+			// the consumer group has active members.
+			expectSet(errors.ErrInvalidRequest)
+			resp = post(consumeActionCommit, &commitRequest{
+				Consumer: "c1", Cursor: encodeStreamCursor(map[uint64]int64{1: 8}),
+			})
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("test seek to the earliest", func() {
+			mockEventlog.EXPECT().EarliestOffset(Any()).Times(1).Return(int64(2), nil)
+			expectSet(nil)
+			resp := post(consumeActionSeek, &seekRequest{Consumer: "c1", Position: consumePositionEarliest})
+			defer resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusOK)
+			So(setReq.Offsets, ShouldResemble, []*ctrlpb.ConsumerGroupOffset{{EventlogId: 1, Offset: 2}})
+			res := &cursorResponse{}
+			So(json.NewDecoder(resp.Body).Decode(res), ShouldBeNil)
+			So(res.Cursor, ShouldEqual, encodeStreamCursor(map[uint64]int64{1: 2}))
+		})
+
+		Convey("test invalid requests", func() {
+			code, _ := pull(url.Values{"cursor": []string{"invalid!"}})
+			So(code, ShouldEqual, http.StatusBadRequest)
+			code, _ = pull(url.Values{"max": []string{"0"}})
+			So(code, ShouldEqual, http.StatusBadRequest)
+			code, _ = pull(url.Values{"consumer": []string{"a/b"}})
+			So(code, ShouldEqual, http.StatusBadRequest)
+
+			resp := post(consumeActionCommit, &commitRequest{Consumer: "c1", Cursor: "invalid!"})
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+			resp = post(consumeActionSeek, &seekRequest{Position: consumePositionLatest})
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+			resp = post(consumeActionEvents, nil)
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusMethodNotAllowed)
+			resp = post("unknown", nil)
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusNotFound)
+		})
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+)
+
+const (
+	defaultDedupWindow   = 5 * time.Minute
+	defaultDedupCapacity = 1 << 20
+	dedupShardNum        = 16
+)
+
+// publishDedup remembers the result of each publish for a window, the retry of a publish is responded
+// with the result of the original one. The records are sharded by key to reduce the lock contention,
+// and each shard forgets its oldest record first when it's full.
+type publishDedup struct {
+	window time.Duration
+	shards []*dedupShard
+}
+
+type dedupShard struct {
+	capacity int
+	records  map[string]*list.Element
+	// front is the oldest.
+	entries *list.List
+	lock    sync.Mutex
+}
+
+type dedupRecord struct {
+	Key  string     `json:"key"`
+	Time time.Time  `json:"time"`
+	Data *EventData `json:"data"`
+	// done is closed when the original publish completes, Data is nil if it failed.
+	done chan struct{}
+}
+
+func newPublishDedup(window time.Duration, capacity int) *publishDedup {
+	d := &publishDedup{
+		window: window,
+		shards: make([]*dedupShard, dedupShardNum),
+	}
+	shardCapacity := (capacity + dedupShardNum - 1) / dedupShardNum
+	for i := range d.shards {
+		d.shards[i] = &dedupShard{
+			capacity: shardCapacity,
+			records:  map[string]*list.Element{},
+			entries:  list.New(),
+		}
+	}
+	return d
+}
+
+// dedupKey identifies the event published to eventbus, the producer retrying a publish sends the
+// event with the same source and id.
+func dedupKey(ebName string, e *v2.Event) string {
+	return strings.Join([]string{ebName, e.Source(), e.ID()}, "\x00")
+}
+
+func (d *publishDedup) shard(key string) *dedupShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return d.shards[h.Sum32()%uint32(len(d.shards))]
+}
+
+// acquire returns the result of the original publish of key if there is one, otherwise the record
+// which the caller must complete after publishing. It waits if the original publish is inflight.
+func (d *publishDedup) acquire(ctx context.Context, key string) (*dedupRecord, *EventData, error) {
+	for {
+		r, owner := d.begin(key, time.Now())
+		if owner {
+			return r, nil, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-r.done:
+		}
+		if r.Data != nil {
+			return nil, r.Data, nil
+		}
+		// the original publish failed, publish again.
+	}
+}
+
+func (d *publishDedup) begin(key string, now time.Time) (*dedupRecord, bool) {
+	s := d.shard(key)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.expire(now, d.window)
+	if e, exist := s.records[key]; exist {
+		r, _ := e.Value.(*dedupRecord)
+		return r, false
+	}
+	r := &dedupRecord{Key: key, Time: now, done: make(chan struct{})}
+	s.add(r)
+	return r, true
+}
+
+// complete records the result of publish, the record is removed if the publish failed so that the
+// retry publishes again.
+func (d *publishDedup) complete(r *dedupRecord, data *EventData) {
+	s := d.shard(r.Key)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if data == nil {
+		if e, exist := s.records[r.Key]; exist && e.Value == r {
+			s.remove(e)
+		}
+	}
+	r.Data = data
+	close(r.done)
+}
+
+func (s *dedupShard) add(r *dedupRecord) {
+	s.records[r.Key] = s.entries.PushBack(r)
+	for s.entries.Len() > s.capacity {
+		s.remove(s.entries.Front())
+	}
+}
+
+func (s *dedupShard) expire(now time.Time, window time.Duration) {
+	for e := s.entries.Front(); e != nil; e = s.entries.Front() {
+		if now.Sub(e.Value.(*dedupRecord).Time) < window {
+			return
+		}
+		s.remove(e)
+	}
+}
+
+func (s *dedupShard) remove(e *list.Element) {
+	s.entries.Remove(e)
+	delete(s.records, e.Value.(*dedupRecord).Key)
+}
+
+// save writes the completed records to file in JSON lines.
+func (d *publishDedup) save(file string) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	for _, s := range d.shards {
+		s.lock.Lock()
+		for e := s.entries.Front(); e != nil && err == nil; e = e.Next() {
+			if r, _ := e.Value.(*dedupRecord); r.Data != nil {
+				err = enc.Encode(r)
+			}
+		}
+		s.lock.Unlock()
+		if err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+// load reads the records saved by save, the expired ones are skipped.
+func (d *publishDedup) load(file string, now time.Time) error {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		r := &dedupRecord{}
+		if err = dec.Decode(r); err != nil {
+			return err
+		}
+		if r.Data == nil || now.Sub(r.Time) >= d.window {
+			continue
+		}
+		r.done = make(chan struct{})
+		close(r.done)
+		s := d.shard(r.Key)
+		s.lock.Lock()
+		if _, exist := s.records[r.Key]; !exist {
+			s.add(r)
+		}
+		s.lock.Unlock()
+	}
+	return nil
+}
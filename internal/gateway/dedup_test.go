@@ -0,0 +1,157 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	. "github.com/prashantv/gostub"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPublishDedup(t *testing.T) {
+	Convey("test publish dedup", t, func() {
+		now := time.Now()
+		d := newPublishDedup(time.Minute, dedupShardNum)
+		data := &EventData{EventID: "1", BusName: "test"}
+
+		r, owner := d.begin("a", now)
+		So(owner, ShouldBeTrue)
+		r2, owner := d.begin("a", now)
+		So(owner, ShouldBeFalse)
+		So(r2, ShouldEqual, r)
+
+		Convey("test the original publish succeeded", func() {
+			d.complete(r, data)
+			rec, origin, err := d.acquire(context.Background(), "a")
+			So(err, ShouldBeNil)
+			So(rec, ShouldBeNil)
+			So(origin, ShouldResemble, data)
+		})
+		Convey("test the original publish failed", func() {
+			d.complete(r, nil)
+			rec, origin, err := d.acquire(context.Background(), "a")
+			So(err, ShouldBeNil)
+			So(rec, ShouldNotBeNil)
+			So(origin, ShouldBeNil)
+		})
+		Convey("test wait the inflight publish", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			_, _, err := d.acquire(ctx, "a")
+			So(err, ShouldNotBeNil)
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				d.complete(r, data)
+			}()
+			_, origin, err := d.acquire(context.Background(), "a")
+			So(err, ShouldBeNil)
+			So(origin, ShouldResemble, data)
+		})
+		Convey("test window expired", func() {
+			d.complete(r, data)
+			_, owner = d.begin("a", now.Add(time.Minute))
+			So(owner, ShouldBeTrue)
+		})
+		Convey("test capacity exceeded", func() {
+			s := d.shard("a")
+			for i := 0; ; i++ {
+				if key := fmt.Sprintf("key-%d", i); d.shard(key) == s {
+					d.begin(key, now)
+					break
+				}
+			}
+			So(s.entries.Len(), ShouldEqual, 1)
+			_, owner = d.begin("a", now)
+			So(owner, ShouldBeTrue)
+		})
+		Convey("test save and load", func() {
+			d.complete(r, data)
+			d.begin("b", now)
+			file := filepath.Join(t.TempDir(), "dedup")
+			So(d.save(file), ShouldBeNil)
+
+			d2 := newPublishDedup(time.Minute, dedupShardNum)
+			So(d2.load(file, now), ShouldBeNil)
+			rec, origin, err := d2.acquire(context.Background(), "a")
+			So(err, ShouldBeNil)
+			So(rec, ShouldBeNil)
+			So(origin, ShouldResemble, data)
+			// the inflight publish isn't saved.
+			_, owner = d2.begin("b", now)
+			So(owner, ShouldBeTrue)
+
+			d3 := newPublishDedup(time.Minute, dedupShardNum)
+			So(d3.load(file, now.Add(time.Minute)), ShouldBeNil)
+			_, owner = d3.begin("a", now)
+			So(owner, ShouldBeTrue)
+		})
+	})
+}
+
+func TestGateway_receiveDuplicateEvent(t *testing.T) {
+	Convey("test receive duplicate event", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		ga := NewGateway(Config{Dedup: DedupConfig{Enable: true}})
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		ga.client = mockClient
+		reqData := &cehttp.RequestData{
+			URL: &url.URL{
+				Opaque: "/gateway/test",
+			},
+		}
+		stub := StubFunc(&requestDataFromContext, reqData)
+		defer stub.Reset()
+
+		newEvent := func(id string) ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("example/uri")
+			e.SetType("example.type")
+			return e
+		}
+		mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).Times(1).Return(nil, fmt.Errorf("test"))
+		_, ret := ga.receive(ctx, newEvent("example-event"))
+		So(ce.IsACK(ret), ShouldBeFalse)
+
+		placement := &api.Placement{EventlogID: 1, Offset: 10, Stime: 1000}
+		mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).Times(1).Return(placement, nil)
+		res1, ret := ga.receive(ctx, newEvent("example-event"))
+		So(ce.IsACK(ret), ShouldBeTrue)
+		res2, ret := ga.receive(ctx, newEvent("example-event"))
+		So(ce.IsACK(ret), ShouldBeTrue)
+		So(res2.Data(), ShouldResemble, res1.Data())
+
+		mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).Times(1).Return(placement, nil)
+		_, ret = ga.receive(ctx, newEvent("another-event"))
+		So(ce.IsACK(ret), ShouldBeTrue)
+	})
+}
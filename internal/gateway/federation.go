@@ -0,0 +1,65 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/client"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+func (ga *ceGateway) initFederation() error {
+	if len(ga.config.Federation.Eventbuses) == 0 {
+		return nil
+	}
+	if err := ga.federation.Validate(); err != nil {
+		return fmt.Errorf("invalid federation: %w", err)
+	}
+	c, err := client.NewHTTP()
+	if err != nil {
+		return err
+	}
+	ga.peerClient = c
+	return nil
+}
+
+// forward publishes the event to the CloudEvents receiver of the gateway of peer cluster, with the
+// path and the query of request, the response of peer is returned as it is.
+func (ga *ceGateway) forward(ctx context.Context, addr string, reqData *cehttp.RequestData,
+	event v2.Event) (*v2.Event, protocol.Result) {
+	host, err := proxy.CloudEventsAddress(addr)
+	if err != nil {
+		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+	}
+	target := url.URL{Scheme: "http", Host: host, Path: reqData.URL.Path, RawQuery: reqData.URL.RawQuery}
+	ctx = v2.ContextWithTarget(ctx, target.String())
+	ctx = cehttp.WithCustomHeader(ctx, http.Header{proxy.FederatedHeader: []string{"true"}})
+	res, result := ga.peerClient.Request(ctx, event)
+	if !v2.IsACK(result) {
+		log.Warning(ctx, "forward event to peer cluster failed", map[string]interface{}{
+			log.KeyError: result,
+			"gateway":    addr,
+		})
+	}
+	return res, result
+}
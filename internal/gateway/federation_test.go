@@ -0,0 +1,90 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/client"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	. "github.com/prashantv/gostub"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestGateway_forward(t *testing.T) {
+	Convey("test forward events to the peer cluster", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ls, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		peer, err := client.NewHTTP(cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware())
+		So(err, ShouldBeNil)
+		requests := make(chan *cehttp.RequestData, 1)
+		go func() {
+			_ = peer.StartReceiver(ctx, func(ctx context.Context, e ce.Event) (*ce.Event, protocol.Result) {
+				reqData := cehttp.RequestDataFromContext(ctx)
+				requests <- reqData
+				if e.ID() == "bad" {
+					return nil, ce.NewHTTPResult(http.StatusBadRequest, "invalid event")
+				}
+				return &e, ce.ResultACK
+			})
+		}()
+
+		port := ls.Addr().(*net.TCPAddr).Port
+		cfg := Config{Federation: FederationConfig{
+			Clusters:   map[string]string{"b": fmt.Sprintf("127.0.0.1:%d", port-1)},
+			Eventbuses: map[string]string{"remote": "b"},
+		}}
+		ga := &ceGateway{
+			config:     cfg,
+			federation: proxy.NewFederation(cfg.GetProxyConfig().Federation, insecure.NewCredentials()),
+		}
+		So(ga.initFederation(), ShouldBeNil)
+		reqData := &cehttp.RequestData{
+			URL: &url.URL{Path: "/gateway/remote", RawQuery: "ack=leader"},
+		}
+		stub := StubFunc(&requestDataFromContext, reqData)
+		defer stub.Reset()
+
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("source")
+		e.SetType("type")
+		res, result := ga.receive(ctx, e)
+		So(ce.IsACK(result), ShouldBeTrue)
+		So(res.ID(), ShouldEqual, "1")
+		got := <-requests
+		So(got.URL.Path, ShouldEqual, "/gateway/remote")
+		So(got.URL.RawQuery, ShouldEqual, "ack=leader")
+		So(got.Header.Get(proxy.FederatedHeader), ShouldEqual, "true")
+
+		e.SetID("bad")
+		_, result = ga.receive(ctx, e)
+		var httpResult *cehttp.Result
+		So(ce.ResultAs(result, &httpResult), ShouldBeTrue)
+		So(httpResult.StatusCode, ShouldEqual, http.StatusBadRequest)
+		<-requests
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/client"
@@ -30,15 +31,26 @@ import (
 	"github.com/google/uuid"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/blob"
+	"github.com/linkall-labs/vanus/client/pkg/option"
 	"github.com/linkall-labs/vanus/internal/gateway/proxy"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/quic-go/quic-go/http3"
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	httpRequestPrefix = "/gateway"
+	// ackParameter is the ack level of publish request, see parseAckLevel.
+	ackParameter = "ack"
+	// defaultBlobThreshold is the min bytes of data offloaded to the blob store.
+	defaultBlobThreshold = 256 * 1024
 )
 
 var (
@@ -48,44 +60,199 @@ var (
 type EventData struct {
 	EventID string `json:"event_id"`
 	BusName string `json:"eventbus_name"`
+	*EventPlacement
+}
+
+// EventPlacement is where the event is placed, producers can checkpoint by it. It's omitted from
+// the response if legacy_publish_response is enabled.
+type EventPlacement struct {
+	EventlogID uint64 `json:"eventlog_id,string"`
+	Offset     int64  `json:"offset"`
+	// Stime is the millisecond timestamp when the event is written to block.
+	Stime int64 `json:"stime"`
 }
 
 type ceGateway struct {
 	// ceClient  v2.Client
-	busWriter  sync.Map
-	config     Config
-	client     eb.Client
-	proxySrv   *proxy.ControllerProxy
-	tracer     *tracing.Tracer
-	ceListener net.Listener
+	busWriter    sync.Map
+	config       Config
+	client       eb.Client
+	proxySrv     *proxy.ControllerProxy
+	tracer       *tracing.Tracer
+	ceListener   net.Listener
+	http3Srv     *http3.Server
+	http3Conn    net.PacketConn
+	webhookSrv   *http.Server
+	mqttSrv      *mqttServer
+	kafkaSrv     *kafkaServer
+	amqpSrv      *amqpServer
+	websocketSrv *http.Server
+	sseSrv       *http.Server
+	consumeSrv   *http.Server
+	mailboxes    map[string]*replyMailbox
+	validators   map[string]*eventValidator
+	admission    *admission
+	dedup        *publishDedup
+	blobStore    blob.Store
+	federation   *proxy.Federation
+	acl          *proxy.Authorizer
+	eventbusCtrl ctrlpb.EventBusControllerClient
+	allowlist    *proxy.IPAllowlist
+	reserved     *primitive.ReservedAttributes
+	// peerClient publishes the events of eventbuses owned by peer clusters.
+	peerClient v2.Client
+	mailboxMu  sync.Mutex
 }
 
 func NewGateway(config Config) *ceGateway {
-	return &ceGateway{
-		config:   config,
-		client:   eb.Connect(config.ControllerAddr),
-		proxySrv: proxy.NewControllerProxy(config.GetProxyConfig()),
-		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+	proxyCfg := config.GetProxyConfig()
+	ga := &ceGateway{
+		config:     config,
+		client:     eb.Connect(config.ControllerAddr),
+		proxySrv:   proxy.NewControllerProxy(proxyCfg),
+		tracer:     tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		federation: proxy.NewFederation(proxyCfg.Federation, proxyCfg.Credentials),
+		reserved:   primitive.GetReservedAttributes(),
+	}
+	if config.RateLimit.Enable {
+		ga.admission = newAdmission(config.RateLimit)
+	}
+	if config.Dedup.Enable {
+		ga.dedup = newPublishDedup(config.GetDedupWindow(), config.GetDedupCapacity())
 	}
+	ga.acl = ga.proxySrv.Authorizer()
+	ga.allowlist = ga.proxySrv.IPAllowlist()
+	ga.eventbusCtrl = ga.proxySrv.EventbusController()
+	return ga
 }
 
 func (ga *ceGateway) Start(ctx context.Context) error {
+	if err := ga.initValidators(); err != nil {
+		return err
+	}
+	if err := ga.initFederation(); err != nil {
+		return err
+	}
+	store, err := primitive.NewBlobStore(ga.config.Blob.Dir, ga.config.Blob.S3)
+	if err != nil {
+		return fmt.Errorf("init blob store error: %w", err)
+	}
+	ga.blobStore = store
+	if ga.dedup != nil && ga.config.Dedup.File != "" {
+		if err := ga.dedup.load(ga.config.Dedup.File, time.Now()); err != nil {
+			log.Warning(ctx, "load dedup records error", map[string]interface{}{
+				log.KeyError: err,
+				"file":       ga.config.Dedup.File,
+			})
+		}
+	}
 	if err := ga.startCloudEventsReceiver(ctx); err != nil {
 		return err
 	}
+	if ga.config.Webhook.Enable {
+		if err := ga.startWebhookReceiver(); err != nil {
+			return err
+		}
+	}
+	if ga.config.MQTT.Enable {
+		if err := ga.startMQTTReceiver(ctx); err != nil {
+			return err
+		}
+	}
+	if ga.config.Kafka.Enable {
+		if err := ga.startKafkaReceiver(ctx); err != nil {
+			return err
+		}
+	}
+	if ga.config.AMQP.Enable {
+		if err := ga.startAMQPReceiver(ctx); err != nil {
+			return err
+		}
+	}
+	if ga.config.WebSocket.Enable {
+		if err := ga.startWebSocketReceiver(); err != nil {
+			return err
+		}
+	}
+	if ga.config.SSE.Enable {
+		if err := ga.startSSEReceiver(); err != nil {
+			return err
+		}
+	}
+	if ga.config.Consume.Enable {
+		if err := ga.startConsumeReceiver(); err != nil {
+			return err
+		}
+	}
 	if err := ga.proxySrv.Start(); err != nil {
 		return err
 	}
 	return nil
 }
 
+// Reload applies the reloadable fields of the config, see reload.Reloader.
+func (ga *ceGateway) Reload(_ context.Context, cfg Config) error {
+	observability.Reload(cfg.Observability)
+	if ga.admission != nil {
+		ga.admission.update(cfg.RateLimit)
+	}
+	return nil
+}
+
 func (ga *ceGateway) Stop() {
 	ga.proxySrv.Stop()
+	ga.stopReplyMailboxes()
 	if err := ga.ceListener.Close(); err != nil {
 		log.Warning(context.Background(), "close CloudEvents listener error", map[string]interface{}{
 			log.KeyError: err,
 		})
 	}
+	ga.stopHTTP3Receiver()
+	if ga.webhookSrv != nil {
+		if err := ga.webhookSrv.Close(); err != nil {
+			log.Warning(context.Background(), "close webhook server error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+	if ga.mqttSrv != nil {
+		ga.mqttSrv.stop()
+	}
+	if ga.kafkaSrv != nil {
+		ga.kafkaSrv.stop()
+	}
+	if ga.amqpSrv != nil {
+		ga.amqpSrv.stop()
+	}
+	if ga.websocketSrv != nil {
+		if err := ga.websocketSrv.Close(); err != nil {
+			log.Warning(context.Background(), "close WebSocket server error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+	if ga.sseSrv != nil {
+		if err := ga.sseSrv.Close(); err != nil {
+			log.Warning(context.Background(), "close SSE server error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+	if ga.consumeSrv != nil {
+		if err := ga.consumeSrv.Close(); err != nil {
+			log.Warning(context.Background(), "close consume server error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+	if ga.dedup != nil && ga.config.Dedup.File != "" {
+		if err := ga.dedup.save(ga.config.Dedup.File); err != nil {
+			log.Warning(context.Background(), "save dedup records error", map[string]interface{}{
+				log.KeyError: err,
+				"file":       ga.config.Dedup.File,
+			})
+		}
+	}
 }
 
 func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
@@ -94,65 +261,125 @@ func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
 		return err
 	}
 
-	c, err := client.NewHTTP(cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware())
+	c, handler, err := ga.newCloudEventsReceiver(ls)
 	if err != nil {
 		return err
 	}
 
 	ga.ceListener = ls
 	go func() {
-		if err := c.StartReceiver(ctx, ga.receive); err != nil {
+		if err := c.StartReceiver(ctx, ga.receive); err != nil && ctx.Err() == nil {
 			panic(fmt.Sprintf("start CloudEvents receiver failed: %s", err.Error()))
 		}
 	}()
+	if ga.config.QUIC.Enable {
+		return ga.startHTTP3Receiver(handler)
+	}
 	return nil
 }
 
+// newCloudEventsReceiver returns the client receiving the events from the listener, and the handler
+// serving the same requests with the same middlewares, which is served over HTTP/3.
+func (ga *ceGateway) newCloudEventsReceiver(ls net.Listener) (client.Client, http.Handler, error) {
+	middlewares := []cehttp.Middleware{
+		ga.publishMiddleware, ga.admissionMiddleware, ga.ipAllowlistMiddleware(httpRequestPrefix),
+	}
+	opts := []cehttp.Option{cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware()}
+	for _, m := range middlewares {
+		opts = append(opts, cehttp.WithMiddleware(m))
+	}
+	p, err := cehttp.New(opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := client.New(p, client.WithTimeNow(), client.WithUUIDs())
+	if err != nil {
+		return nil, nil, err
+	}
+	// the protocol applies the middlewares in order, the last one is the outermost.
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.ServeHTTP(w, r.WithContext(cehttp.WithRequestDataAtContext(r.Context(), r)))
+	})
+	for _, m := range middlewares {
+		handler = m(handler)
+	}
+	return c, handler, nil
+}
+
 func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, protocol.Result) {
-	_ctx, span := ga.tracer.Start(ctx, "receive")
+	// continue the trace of the producer if the event carries one.
+	_ctx, span := ga.tracer.Start(tracing.ExtractEvent(ctx, &event), "receive")
 	defer span.End()
-	ebName := getEventBusFromPath(requestDataFromContext(_ctx))
+	reqData := requestDataFromContext(_ctx)
+	ebName := getEventBusFromPath(reqData)
 
 	if ebName == "" {
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid eventbus name")
 	}
-
-	extensions := event.Extensions()
-	err := checkExtension(extensions)
+	if addr, ok := ga.federation.Owner(ebName); ok && reqData.Header.Get(proxy.FederatedHeader) == "" {
+		return ga.forward(_ctx, addr, reqData, event)
+	}
+	if err := ga.authorize(_ctx, reqData.Header, ebName, acl.PermissionPublish); err != nil {
+		return nil, v2.NewHTTPResult(authorizeStatusCode(err), err.Error())
+	}
+	ack, err := parseAckLevel(reqData.URL.Query().Get(ackParameter))
 	if err != nil {
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
 	}
 
-	event.SetExtension(primitive.XVanusEventbus, ebName)
-	if eventTime, ok := extensions[primitive.XVanusDeliveryTime]; ok {
-		// validate event time
-		if _, err := types.ParseTime(eventTime.(string)); err != nil {
-			log.Error(_ctx, "invalid format of event time", map[string]interface{}{
-				log.KeyError: err,
-				"eventTime":  eventTime.(string),
-			})
-			return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid delivery time")
+	key := dedupKey(ebName, &event)
+	ebName, err = ga.prepareEvent(_ctx, ebName, &event)
+	if err != nil {
+		return rejectResult(err)
+	}
+
+	if replyEb := reqData.URL.Query().Get(replyParameter); replyEb != "" {
+		if err = ga.authorize(_ctx, reqData.Header, replyEb, acl.PermissionSubscribe); err != nil {
+			return nil, v2.NewHTTPResult(authorizeStatusCode(err), err.Error())
 		}
-		ebName = primitive.TimerEventbusName
+		return ga.requestReply(_ctx, ebName, &event, ack, replyEb,
+			reqData.URL.Query().Get(replyTimeoutParameter))
 	}
 
-	v, exist := ga.busWriter.Load(ebName)
-	if !exist {
-		v, _ = ga.busWriter.LoadOrStore(ebName, ga.client.Eventbus(ctx, ebName).Writer())
+	var record *dedupRecord
+	if ga.dedup != nil {
+		var origin *EventData
+		record, origin, err = ga.dedup.acquire(_ctx, key)
+		if err != nil {
+			return nil, v2.NewHTTPResult(http.StatusServiceUnavailable, err.Error())
+		}
+		if origin != nil {
+			log.Debug(_ctx, "duplicate event is published", map[string]interface{}{
+				"eventbus": ebName,
+				"id":       event.ID(),
+				"source":   event.Source(),
+			})
+			return publishResult(*origin)
+		}
 	}
-	writer, _ := v.(api.BusWriter)
-	eventID, err := writer.AppendOne(_ctx, &event)
+	eventID, placement, err := ga.appendEvents(_ctx, ebName, []*v2.Event{&event}, option.WithAckLevel(ack))
 	if err != nil {
+		if record != nil {
+			ga.dedup.complete(record, nil)
+		}
 		log.Warning(_ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
 			"eventbus":   ebName,
 		})
-		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+		return nil, v2.NewHTTPResult(appendStatusCode(err), err.Error())
 	}
 	eventData := EventData{
-		BusName: ebName,
-		EventID: eventID,
+		BusName:        ebName,
+		EventID:        eventID,
+		EventPlacement: placement,
+	}
+	if record != nil {
+		ga.dedup.complete(record, &eventData)
 	}
+	return publishResult(eventData)
+}
+
+func publishResult(eventData EventData) (*v2.Event, protocol.Result) {
 	resEvent, err := createResponseEvent(eventData)
 	if err != nil {
 		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
@@ -160,25 +387,119 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 	return resEvent, v2.ResultACK
 }
 
-func checkExtension(extensions map[string]interface{}) error {
-	if len(extensions) == 0 {
-		return nil
+// prepareEvent validates the extensions of event received for eventbus and the policy of
+// eventbus, and returns the name of eventbus to append to, which is the timer eventbus if the
+// event has a delivery time.
+func (ga *ceGateway) prepareEvent(ctx context.Context, ebName string, event *v2.Event) (string, error) {
+	extensions := event.Extensions()
+	if err := ga.checkExtension(extensions); err != nil {
+		return "", err
 	}
-	for name := range extensions {
-		if name == primitive.XVanusDeliveryTime {
-			continue
+	if err := ga.validateEvent(ebName, event); err != nil {
+		return "", err
+	}
+
+	event.SetExtension(primitive.XVanusEventbus, ebName)
+	if eventTime, ok := extensions[primitive.XVanusDeliveryTime]; ok {
+		// validate event time
+		if _, err := types.ParseTime(eventTime.(string)); err != nil {
+			log.Error(ctx, "invalid format of event time", map[string]interface{}{
+				log.KeyError: err,
+				"eventTime":  eventTime.(string),
+			})
+			return "", fmt.Errorf("invalid delivery time")
 		}
-		// event attribute can not prefix with vanus system use
-		if strings.HasPrefix(name, primitive.XVanus) {
-			return fmt.Errorf("invalid ce attribute [%s] perfix %s", name, primitive.XVanus)
+		return primitive.TimerEventbusName, nil
+	}
+	return ebName, nil
+}
+
+func (ga *ceGateway) getBusWriter(ctx context.Context, ebName string) api.BusWriter {
+	v, exist := ga.busWriter.Load(ebName)
+	if !exist {
+		var opts []api.WriteOption
+		if ga.blobStore != nil {
+			opts = append(opts, option.WithBlobStore(ga.blobStore, ga.config.GetBlobThreshold()))
+		}
+		v, _ = ga.busWriter.LoadOrStore(ebName, ga.client.Eventbus(ctx, ebName).Writer(opts...))
+	}
+	writer, _ := v.(api.BusWriter)
+	return writer
+}
+
+// appendEvents appends the events to eventbus in one batch, and returns the ID of the first event
+// and the placement of the first event, which is nil if legacy_publish_response is enabled.
+func (ga *ceGateway) appendEvents(
+	ctx context.Context, ebName string, events []*v2.Event, opts ...api.WriteOption,
+) (string, *EventPlacement, error) {
+	writer := ga.getBusWriter(ctx, ebName)
+	if ga.config.LegacyPublishResponse {
+		var (
+			eventID string
+			err     error
+		)
+		if len(events) == 1 {
+			eventID, err = writer.AppendOne(ctx, events[0], opts...)
+		} else {
+			eventID, err = writer.AppendMany(ctx, events, opts...)
+		}
+		return eventID, nil, err
+	}
+	p, err := writer.AppendWithPlacement(ctx, events, opts...)
+	if err != nil {
+		return "", nil, err
+	}
+	return p.EventID(0), &EventPlacement{
+		EventlogID: p.EventlogID,
+		Offset:     p.Offset,
+		Stime:      p.Stime,
+	}, nil
+}
+
+// appendStatusCode returns the status code responded when appending events failed, the events rejected
+// by the size limits of segment servers are responded with 413.
+func appendStatusCode(err error) int {
+	if errors.Is(err, errors.ErrEventTooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusInternalServerError
+}
+
+// checkExtension rejects the event whose extension attributes are reserved for system use.
+func (ga *ceGateway) checkExtension(extensions map[string]interface{}) error {
+	for name := range extensions {
+		if err := ga.reserved.Check(name); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// parseAckLevel parses the ack level of publish request, the lower level has the lower latency
+// and the weaker durability:
+//   - quorum (default): acknowledged after the event is committed by the quorum of replicas;
+//   - leader: acknowledged after the event is persisted to the WAL of the leader replica, the event
+//     may be lost if the leader crashes before replicating it;
+//   - none: acknowledged after the event is accepted by the leader replica, the event may be lost
+//     if the leader crashes before persisting it.
+func parseAckLevel(level string) (api.AckLevel, error) {
+	switch level {
+	case "", "quorum":
+		return api.AckQuorum, nil
+	case "leader":
+		return api.AckLeader, nil
+	case "none":
+		return api.AckNone, nil
+	default:
+		return api.AckQuorum, fmt.Errorf("invalid ack level: %s", level)
+	}
+}
+
 func getEventBusFromPath(reqData *cehttp.RequestData) string {
 	// TODO validate
-	reqPathStr := reqData.URL.String()
+	u := *reqData.URL
+	u.RawQuery = ""
+	reqPathStr := u.String()
 	if !strings.HasPrefix(reqPathStr, httpRequestPrefix) {
 		return ""
 	}
@@ -17,6 +17,7 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"testing"
 	"time"
@@ -24,6 +25,9 @@ import (
 	"github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
@@ -63,7 +67,7 @@ func TestGateway_StartReceive(t *testing.T) {
 
 func TestGateway_receive(t *testing.T) {
 	ctx := context.Background()
-	ga := &ceGateway{}
+	ga := &ceGateway{reserved: primitive.NewReservedAttributes(nil)}
 	Convey("test receive failure1 ", t, func() {
 		e := ce.NewEvent()
 		reqData := &cehttp.RequestData{
@@ -111,14 +115,52 @@ func TestGateway_receive(t *testing.T) {
 
 func TestGateway_checkExtension(t *testing.T) {
 	Convey("test check extensions", t, func() {
+		primitive.InitReservedAttributes([]string{"xTenant"})
+		defer primitive.InitReservedAttributes(nil)
+		ga := NewGateway(Config{})
 		e := ce.NewEvent()
-		err := checkExtension(e.Extensions())
+		err := ga.checkExtension(e.Extensions())
 		So(err, ShouldBeNil)
 		e.SetExtension(primitive.XVanusDeliveryTime, "test")
-		err = checkExtension(e.Extensions())
+		err = ga.checkExtension(e.Extensions())
 		So(err, ShouldBeNil)
+		e.SetExtension(primitive.XVanusCorrelationID, "test")
+		err = ga.checkExtension(e.Extensions())
+		So(err, ShouldBeNil)
+		e.SetExtension("tenant", "test")
+		err = ga.checkExtension(e.Extensions())
+		So(err, ShouldBeNil)
+		e.SetExtension("xtenantid", "test")
+		err = ga.checkExtension(e.Extensions())
+		So(err, ShouldNotBeNil)
+		e.SetExtension("xtenantid", nil)
 		e.SetExtension(primitive.XVanus+"fortest", "test")
-		err = checkExtension(e.Extensions())
+		err = ga.checkExtension(e.Extensions())
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestGateway_appendStatusCode(t *testing.T) {
+	Convey("test status code of append errors", t, func() {
+		err := errors.ErrEventTooLarge.WithMessage("entry size 2048 exceeds the limit 1024")
+		So(appendStatusCode(err), ShouldEqual, http.StatusRequestEntityTooLarge)
+		// the error returned by segment server through gRPC.
+		So(appendStatusCode(status.Error(codes.Unknown, errors.ConvertToGRPCError(err).Error())),
+			ShouldEqual, http.StatusRequestEntityTooLarge)
+		So(appendStatusCode(errors.ErrNotLeader), ShouldEqual, http.StatusInternalServerError)
+	})
+}
+
+func TestGateway_parseAckLevel(t *testing.T) {
+	Convey("test parse ack level", t, func() {
+		for level, expected := range map[string]api.AckLevel{
+			"": api.AckQuorum, "quorum": api.AckQuorum, "leader": api.AckLeader, "none": api.AckNone,
+		} {
+			ack, err := parseAckLevel(level)
+			So(err, ShouldBeNil)
+			So(ack, ShouldEqual, expected)
+		}
+		_, err := parseAckLevel("all")
 		So(err, ShouldNotBeNil)
 	})
 }
@@ -142,6 +184,16 @@ func TestGateway_getEventBusFromPath(t *testing.T) {
 		ret := getEventBusFromPath(reqData)
 		So(ret, ShouldEqual, "test")
 	})
+	Convey("test get eventbus from path with query", t, func() {
+		reqData := &cehttp.RequestData{
+			URL: &url.URL{
+				Path:     "/gateway/test",
+				RawQuery: "ack=none",
+			},
+		}
+		ret := getEventBusFromPath(reqData)
+		So(ret, ShouldEqual, "test")
+	})
 }
 
 func TestGateway_EventID(t *testing.T) {
@@ -159,7 +211,9 @@ func TestGateway_EventID(t *testing.T) {
 	mockBusWriter := api.NewMockBusWriter(ctrl)
 	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
 	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
-	mockBusWriter.EXPECT().AppendOne(Any(), Any()).AnyTimes().Return("AABBCC", nil)
+	placement := &api.Placement{EventlogID: 1, Offset: 10, Stime: 1000}
+	mockBusWriter.EXPECT().AppendWithPlacement(Any(), Any(), Any()).AnyTimes().Return(placement, nil)
+	mockBusWriter.EXPECT().AppendOne(Any(), Any(), Any()).AnyTimes().Return(eventID, nil)
 
 	cfg := Config{
 		Port:           port,
@@ -198,6 +252,28 @@ func TestGateway_EventID(t *testing.T) {
 		err = resEvent.DataAs(&ed)
 		So(err, ShouldBeNil)
 		So(ed.BusName, ShouldEqual, busName)
-		So(ed.EventID, ShouldEqual, eventID)
+		So(ed.EventID, ShouldEqual, placement.EventID(0))
+		So(ed.EventPlacement, ShouldResemble, &EventPlacement{EventlogID: 1, Offset: 10, Stime: 1000})
+	})
+
+	Convey("test put event with legacy response", t, func() {
+		ga.config.LegacyPublishResponse = true
+		defer func() {
+			ga.config.LegacyPublishResponse = false
+		}()
+		p, err := ce.NewHTTP()
+		So(err, ShouldBeNil)
+		c, err := ce.NewClient(p, ce.WithTimeNow(), ce.WithUUIDs())
+		So(err, ShouldBeNil)
+
+		event := ce.NewEvent()
+		event.SetID("example-event")
+		event.SetSource("example/uri")
+		event.SetType("example.type")
+
+		ctx := ce.ContextWithTarget(context.Background(), fmt.Sprintf("http://127.0.0.1:%d/gateway/%s", cfg.GetCloudEventReceiverPort(), busName))
+		resEvent, res := c.Request(ctx, event)
+		So(ce.IsACK(res), ShouldBeTrue)
+		So(string(resEvent.Data()), ShouldEqual, `{"event_id":"AABBCC","eventbus_name":"test"}`)
 	})
 }
@@ -0,0 +1,639 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// The Kafka frontend implements the produce path of the Kafka wire protocol, which are
+// ApiVersions v0-v2, Metadata v0-v4 and Produce v3-v7. Each topic has only one partition
+// and the gateway is the leader of it. Compression other than gzip, transactions and
+// idempotent producing aren't supported.
+
+const (
+	defaultKafkaPort      = 9092
+	defaultKafkaType      = "com.linkall.vanus.kafka"
+	kafkaKeyExtension     = "kafkakey"
+	kafkaCEHeaderPrefix   = "ce_"
+	kafkaNodeID           = 0
+	maxKafkaRequestSize   = 16 * 1024 * 1024
+	kafkaRecordBatchMagic = 2
+
+	kafkaAPIProduce     = 0
+	kafkaAPIMetadata    = 3
+	kafkaAPIApiVersions = 18
+
+	kafkaErrNone                    = 0
+	kafkaErrUnknownServer           = -1
+	kafkaErrCorruptMessage          = 2
+	kafkaErrUnknownTopicOrPartition = 3
+	kafkaErrTopicAuthFailed         = 29
+	kafkaErrUnsupportedVersion      = 35
+	kafkaErrUnsupportedCompression  = 76
+	kafkaErrInvalidRecord           = 87
+
+	kafkaCompressionNone = 0
+	kafkaCompressionGzip = 1
+)
+
+var (
+	errKafkaMalformedRequest = errors.New("malformed Kafka request")
+	errKafkaUnsupportedCodec = errors.New("unsupported Kafka compression codec")
+	kafkaCRCTable            = crc32.MakeTable(crc32.Castagnoli)
+
+	// supported versions of API key: {min, max}.
+	kafkaAPIVersions = map[int16][2]int16{
+		kafkaAPIProduce:     {3, 7},
+		kafkaAPIMetadata:    {0, 4},
+		kafkaAPIApiVersions: {0, 2},
+	}
+)
+
+type kafkaServer struct {
+	*connServer
+	ga   *ceGateway
+	host string
+	port int32
+}
+
+type kafkaRequestHeader struct {
+	apiKey        int16
+	apiVersion    int16
+	correlationID int32
+}
+
+type kafkaRecord struct {
+	timestamp int64
+	key       []byte
+	value     []byte
+	headers   map[string][]byte
+}
+
+func (ga *ceGateway) startKafkaReceiver(ctx context.Context) error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetKafkaPort()))
+	if err != nil {
+		return err
+	}
+	host := ga.config.Kafka.AdvertisedHost
+	if host == "" {
+		if host, err = os.Hostname(); err != nil {
+			_ = ls.Close()
+			return err
+		}
+	}
+	ga.kafkaSrv = &kafkaServer{
+		ga:   ga,
+		host: host,
+		port: int32(ga.config.GetKafkaPort()),
+	}
+	ga.kafkaSrv.connServer = newConnServer(ls, ga.kafkaSrv.handleConn)
+	go ga.kafkaSrv.serve(ctx)
+	return nil
+}
+
+func (s *kafkaServer) handleConn(ctx context.Context, conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	ctx = withRemoteAddr(ctx, conn.RemoteAddr())
+	r := bufio.NewReader(conn)
+	for {
+		var size int32
+		if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+			return
+		}
+		if size < 0 || size > maxKafkaRequestSize {
+			return
+		}
+		req := make([]byte, size)
+		if _, err := io.ReadFull(r, req); err != nil {
+			return
+		}
+		res, err := s.handleRequest(ctx, req)
+		if err != nil {
+			log.Warning(ctx, "handle Kafka request failed", map[string]interface{}{
+				log.KeyError: err,
+				"remote":     conn.RemoteAddr().String(),
+			})
+			return
+		}
+		// a produce request with acks=0 has no response
+		if res == nil {
+			continue
+		}
+		frame := make([]byte, 4, 4+len(res))
+		binary.BigEndian.PutUint32(frame, uint32(len(res)))
+		if _, err = conn.Write(append(frame, res...)); err != nil {
+			return
+		}
+	}
+}
+
+func (s *kafkaServer) handleRequest(ctx context.Context, req []byte) ([]byte, error) {
+	d := &kafkaDecoder{buf: req}
+	header := kafkaRequestHeader{
+		apiKey:        d.int16(),
+		apiVersion:    d.int16(),
+		correlationID: d.int32(),
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	versions, ok := kafkaAPIVersions[header.apiKey]
+	if !ok {
+		return nil, fmt.Errorf("unsupported Kafka API key %d", header.apiKey)
+	}
+	e := &kafkaEncoder{}
+	e.int32(header.correlationID)
+	if header.apiVersion < versions[0] || header.apiVersion > versions[1] {
+		if header.apiKey != kafkaAPIApiVersions {
+			return nil, fmt.Errorf("unsupported version %d of Kafka API key %d", header.apiVersion, header.apiKey)
+		}
+		// clients retry with v0 after receiving the supported versions in v0 format
+		writeKafkaAPIVersions(e, 0, kafkaErrUnsupportedVersion)
+		return e.buf.Bytes(), nil
+	}
+	// client id
+	d.nullableString()
+	switch header.apiKey {
+	case kafkaAPIApiVersions:
+		writeKafkaAPIVersions(e, header.apiVersion, kafkaErrNone)
+	case kafkaAPIMetadata:
+		s.handleMetadata(header.apiVersion, d, e)
+	case kafkaAPIProduce:
+		if !s.handleProduce(ctx, header.apiVersion, d, e) {
+			return nil, d.err
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return e.buf.Bytes(), nil
+}
+
+func writeKafkaAPIVersions(e *kafkaEncoder, version int16, errCode int16) {
+	e.int16(errCode)
+	e.int32(int32(len(kafkaAPIVersions)))
+	for _, key := range []int16{kafkaAPIProduce, kafkaAPIMetadata, kafkaAPIApiVersions} {
+		e.int16(key)
+		e.int16(kafkaAPIVersions[key][0])
+		e.int16(kafkaAPIVersions[key][1])
+	}
+	if version >= 1 {
+		// throttle time
+		e.int32(0)
+	}
+}
+
+func (s *kafkaServer) handleMetadata(version int16, d *kafkaDecoder, e *kafkaEncoder) {
+	var topics []string
+	n := d.int32()
+	if n < 0 || (n == 0 && version == 0) {
+		// all topics
+		for topic := range s.ga.config.Kafka.Topics {
+			topics = append(topics, topic)
+		}
+	}
+	for i := int32(0); i < n && d.err == nil; i++ {
+		topics = append(topics, d.string())
+	}
+	if version >= 3 {
+		e.int32(0)
+	}
+	// brokers
+	e.int32(1)
+	e.int32(kafkaNodeID)
+	e.string(s.host)
+	e.int32(s.port)
+	if version >= 1 {
+		// rack
+		e.nullableString(nil)
+	}
+	if version >= 2 {
+		// cluster id
+		e.nullableString(nil)
+	}
+	if version >= 1 {
+		// controller id
+		e.int32(kafkaNodeID)
+	}
+	e.int32(int32(len(topics)))
+	for _, topic := range topics {
+		e.int16(kafkaErrNone)
+		e.string(topic)
+		if version >= 1 {
+			// is internal
+			e.int8(0)
+		}
+		// only one partition led by the gateway
+		e.int32(1)
+		e.int16(kafkaErrNone)
+		e.int32(0)
+		e.int32(kafkaNodeID)
+		e.int32(1)
+		e.int32(kafkaNodeID)
+		e.int32(1)
+		e.int32(kafkaNodeID)
+	}
+}
+
+// handleProduce appends records of each partition to the eventbus mapped from topic, it returns
+// false if there is no response should be sent.
+func (s *kafkaServer) handleProduce(ctx context.Context, version int16, d *kafkaDecoder, e *kafkaEncoder) bool {
+	// transactional id
+	d.nullableString()
+	acks := d.int16()
+	// timeout
+	d.int32()
+	topicNum := d.int32()
+	e.int32(topicNum)
+	for i := int32(0); i < topicNum && d.err == nil; i++ {
+		topic := d.string()
+		e.string(topic)
+		partitionNum := d.int32()
+		e.int32(partitionNum)
+		for j := int32(0); j < partitionNum && d.err == nil; j++ {
+			partition := d.int32()
+			records := d.bytes()
+			if d.err != nil {
+				return false
+			}
+			errCode := s.produce(ctx, topic, partition, records)
+			e.int32(partition)
+			e.int16(errCode)
+			// base offset, events don't have offset until they are appended to segments
+			e.int64(-1)
+			// log append time
+			e.int64(-1)
+			if version >= 5 {
+				// log start offset
+				e.int64(-1)
+			}
+		}
+	}
+	// throttle time
+	e.int32(0)
+	return acks != 0 && d.err == nil
+}
+
+func (s *kafkaServer) produce(ctx context.Context, topic string, partition int32, data []byte) int16 {
+	if partition != 0 {
+		return kafkaErrUnknownTopicOrPartition
+	}
+	records, err := decodeKafkaRecordBatches(data)
+	if err != nil {
+		log.Warning(ctx, "decode Kafka records failed", map[string]interface{}{
+			log.KeyError: err,
+			"topic":      topic,
+		})
+		if errors.Is(err, errKafkaUnsupportedCodec) {
+			return kafkaErrUnsupportedCompression
+		}
+		return kafkaErrCorruptMessage
+	}
+	if len(records) == 0 {
+		return kafkaErrNone
+	}
+	ebName := topic
+	if v, ok := s.ga.config.Kafka.Topics[topic]; ok {
+		ebName = v
+	}
+	events := make([]*v2.Event, 0, len(records))
+	for idx := range records {
+		event, err := kafkaToEvent(topic, ebName, &records[idx], s.ga.reserved)
+		if err != nil {
+			log.Warning(ctx, "convert Kafka record failed", map[string]interface{}{
+				log.KeyError: err,
+				"topic":      topic,
+			})
+			return kafkaErrCorruptMessage
+		}
+		if err = s.ga.validateEvent(ebName, event); err != nil {
+			log.Warning(ctx, "invalid Kafka record", map[string]interface{}{
+				log.KeyError: err,
+				"topic":      topic,
+			})
+			return kafkaErrInvalidRecord
+		}
+		events = append(events, event)
+	}
+	_ctx, span := s.ga.tracer.Start(ctx, "receiveKafka")
+	defer span.End()
+	if err := s.ga.authorizeConn(_ctx, ebName); err != nil {
+		return kafkaErrTopicAuthFailed
+	}
+	if _, err = s.ga.getBusWriter(_ctx, ebName).AppendMany(_ctx, events); err != nil {
+		log.Warning(_ctx, "append Kafka events failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   ebName,
+			"topic":      topic,
+		})
+		return kafkaErrUnknownServer
+	}
+	return kafkaErrNone
+}
+
+// kafkaToEvent converts a record to CloudEvent, a record in the binary content mode of
+// CloudEvents Kafka protocol binding carries attributes in ce_ headers, otherwise it's wrapped
+// with value as data.
+func kafkaToEvent(topic, ebName string, record *kafkaRecord,
+	reserved *primitive.ReservedAttributes) (*v2.Event, error) {
+	e := v2.NewEvent()
+	e.SetID(uuid.NewString())
+	e.SetType(defaultKafkaType)
+	e.SetSource("/kafka/" + topic)
+	e.SetTime(time.UnixMilli(record.timestamp))
+	if len(record.key) > 0 {
+		e.SetExtension(kafkaKeyExtension, string(record.key))
+	}
+	for k, v := range record.headers {
+		if !strings.HasPrefix(k, kafkaCEHeaderPrefix) {
+			continue
+		}
+		attr := strings.TrimPrefix(k, kafkaCEHeaderPrefix)
+		switch attr {
+		case "specversion":
+		case "id":
+			e.SetID(string(v))
+		case "type":
+			e.SetType(string(v))
+		case "source":
+			e.SetSource(string(v))
+		case "subject":
+			e.SetSubject(string(v))
+		case "dataschema":
+			e.SetDataSchema(string(v))
+		case "time":
+			t, err := time.Parse(time.RFC3339Nano, string(v))
+			if err != nil {
+				return nil, fmt.Errorf("invalid ce time: %w", err)
+			}
+			e.SetTime(t)
+		default:
+			if err := reserved.Check(attr); err != nil {
+				return nil, err
+			}
+			if err := e.Context.SetExtension(attr, string(v)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(record.value) > 0 {
+		if err := e.SetData(string(record.headers["content-type"]), record.value); err != nil {
+			return nil, err
+		}
+	}
+	e.SetExtension(primitive.XVanusEventbus, ebName)
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func decodeKafkaRecordBatches(data []byte) ([]kafkaRecord, error) {
+	var records []kafkaRecord
+	d := &kafkaDecoder{buf: data}
+	for d.remaining() > 0 {
+		// base offset
+		d.int64()
+		length := d.int32()
+		if d.err != nil || length < 0 || int(length) > d.remaining() {
+			return nil, errKafkaMalformedRequest
+		}
+		batch := &kafkaDecoder{buf: d.read(int(length))}
+		// partition leader epoch
+		batch.int32()
+		if magic := batch.int8(); magic != kafkaRecordBatchMagic {
+			return nil, fmt.Errorf("unsupported Kafka record batch magic %d", magic)
+		}
+		crc := uint32(batch.int32())
+		if batch.err != nil || crc32.Checksum(batch.buf[batch.pos:], kafkaCRCTable) != crc {
+			return nil, errKafkaMalformedRequest
+		}
+		attributes := batch.int16()
+		// last offset delta
+		batch.int32()
+		firstTimestamp := batch.int64()
+		// max timestamp, producer id, producer epoch and base sequence
+		batch.read(8 + 8 + 2 + 4)
+		num := batch.int32()
+		if batch.err != nil {
+			return nil, errKafkaMalformedRequest
+		}
+		body := batch
+		switch attributes & 0x07 {
+		case kafkaCompressionNone:
+		case kafkaCompressionGzip:
+			gr, err := gzip.NewReader(bytes.NewReader(batch.buf[batch.pos:]))
+			if err != nil {
+				return nil, err
+			}
+			buf, err := io.ReadAll(io.LimitReader(gr, maxKafkaRequestSize))
+			if err != nil {
+				return nil, err
+			}
+			body = &kafkaDecoder{buf: buf}
+		default:
+			return nil, errKafkaUnsupportedCodec
+		}
+		for i := int32(0); i < num; i++ {
+			record, err := decodeKafkaRecord(body, firstTimestamp)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func decodeKafkaRecord(d *kafkaDecoder, firstTimestamp int64) (kafkaRecord, error) {
+	length := d.varint()
+	if d.err != nil || length < 0 || int(length) > d.remaining() {
+		return kafkaRecord{}, errKafkaMalformedRequest
+	}
+	rd := &kafkaDecoder{buf: d.read(int(length))}
+	// attributes
+	rd.int8()
+	record := kafkaRecord{timestamp: firstTimestamp + rd.varint()}
+	// offset delta
+	rd.varint()
+	record.key = rd.varbytes()
+	record.value = rd.varbytes()
+	headerNum := rd.varint()
+	// each header takes at least one byte, the count can't exceed the remaining bytes.
+	if rd.err != nil || headerNum < 0 || headerNum > int64(rd.remaining()) {
+		return kafkaRecord{}, errKafkaMalformedRequest
+	}
+	if headerNum > 0 {
+		record.headers = make(map[string][]byte, headerNum)
+	}
+	for i := int64(0); i < headerNum && rd.err == nil; i++ {
+		k := string(rd.varbytes())
+		record.headers[k] = rd.varbytes()
+	}
+	if rd.err != nil {
+		return kafkaRecord{}, rd.err
+	}
+	return record, nil
+}
+
+type kafkaDecoder struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (d *kafkaDecoder) remaining() int {
+	return len(d.buf) - d.pos
+}
+
+func (d *kafkaDecoder) read(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > d.remaining() {
+		d.err = errKafkaMalformedRequest
+		return nil
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	b := d.read(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	b := d.read(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	b := d.read(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	b := d.read(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (d *kafkaDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf[d.pos:])
+	if n <= 0 {
+		d.err = errKafkaMalformedRequest
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *kafkaDecoder) string() string {
+	return string(d.read(int(d.int16())))
+}
+
+func (d *kafkaDecoder) nullableString() *string {
+	n := d.int16()
+	if n < 0 {
+		return nil
+	}
+	s := string(d.read(int(n)))
+	return &s
+}
+
+func (d *kafkaDecoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	return d.read(int(n))
+}
+
+func (d *kafkaDecoder) varbytes() []byte {
+	n := d.varint()
+	if n < 0 {
+		return nil
+	}
+	return d.read(int(n))
+}
+
+type kafkaEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *kafkaEncoder) int8(v int8) {
+	e.buf.WriteByte(byte(v))
+}
+
+func (e *kafkaEncoder) int16(v int16) {
+	_ = binary.Write(&e.buf, binary.BigEndian, v)
+}
+
+func (e *kafkaEncoder) int32(v int32) {
+	_ = binary.Write(&e.buf, binary.BigEndian, v)
+}
+
+func (e *kafkaEncoder) int64(v int64) {
+	_ = binary.Write(&e.buf, binary.BigEndian, v)
+}
+
+func (e *kafkaEncoder) string(v string) {
+	e.int16(int16(len(v)))
+	e.buf.WriteString(v)
+}
+
+func (e *kafkaEncoder) nullableString(v *string) {
+	if v == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*v)
+}
@@ -0,0 +1,284 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func kafkaTestAppendVarint(b []byte, v int64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
+func kafkaTestVarbytes(b []byte) []byte {
+	buf := kafkaTestAppendVarint(nil, int64(len(b)))
+	return append(buf, b...)
+}
+
+func kafkaTestRecordBatch(codec int16, records ...kafkaRecord) []byte {
+	var body []byte
+	for _, r := range records {
+		rec := []byte{0}
+		rec = kafkaTestAppendVarint(rec, r.timestamp-1000)
+		rec = kafkaTestAppendVarint(rec, 0)
+		rec = append(rec, kafkaTestVarbytes(r.key)...)
+		rec = append(rec, kafkaTestVarbytes(r.value)...)
+		rec = kafkaTestAppendVarint(rec, int64(len(r.headers)))
+		for k, v := range r.headers {
+			rec = append(rec, kafkaTestVarbytes([]byte(k))...)
+			rec = append(rec, kafkaTestVarbytes(v)...)
+		}
+		body = append(body, kafkaTestAppendVarint(nil, int64(len(rec)))...)
+		body = append(body, rec...)
+	}
+	if codec == kafkaCompressionGzip {
+		buf := bytes.Buffer{}
+		w := gzip.NewWriter(&buf)
+		_, _ = w.Write(body)
+		_ = w.Close()
+		body = buf.Bytes()
+	}
+	e := &kafkaEncoder{}
+	e.int16(codec)
+	e.int32(int32(len(records) - 1))
+	e.int64(1000)
+	e.int64(1000)
+	e.int64(-1)
+	e.int16(-1)
+	e.int32(-1)
+	e.int32(int32(len(records)))
+	e.buf.Write(body)
+	crcData := e.buf.Bytes()
+
+	batch := &kafkaEncoder{}
+	batch.int64(0)
+	batch.int32(int32(4 + 1 + 4 + len(crcData)))
+	batch.int32(0)
+	batch.int8(kafkaRecordBatchMagic)
+	batch.int32(int32(crc32.Checksum(crcData, kafkaCRCTable)))
+	batch.buf.Write(crcData)
+	return batch.buf.Bytes()
+}
+
+func kafkaTestRequest(apiKey, version int16, body func(e *kafkaEncoder)) []byte {
+	e := &kafkaEncoder{}
+	e.int16(apiKey)
+	e.int16(version)
+	e.int32(100)
+	clientID := "test"
+	e.nullableString(&clientID)
+	if body != nil {
+		body(e)
+	}
+	return e.buf.Bytes()
+}
+
+func kafkaTestProduce(acks int16, topic string, records []byte) []byte {
+	return kafkaTestRequest(kafkaAPIProduce, 7, func(e *kafkaEncoder) {
+		e.nullableString(nil)
+		e.int16(acks)
+		e.int32(1000)
+		e.int32(1)
+		e.string(topic)
+		e.int32(1)
+		e.int32(0)
+		e.int32(int32(len(records)))
+		e.buf.Write(records)
+	})
+}
+
+func TestDecodeKafkaRecord(t *testing.T) {
+	Convey("test decode Kafka record", t, func() {
+		record := func(headerNum int64, headers ...[]byte) *kafkaDecoder {
+			rec := []byte{0}
+			rec = kafkaTestAppendVarint(rec, 0)
+			rec = kafkaTestAppendVarint(rec, 0)
+			rec = append(rec, kafkaTestVarbytes([]byte("key"))...)
+			rec = append(rec, kafkaTestVarbytes([]byte("value"))...)
+			rec = kafkaTestAppendVarint(rec, headerNum)
+			for _, h := range headers {
+				rec = append(rec, kafkaTestVarbytes(h)...)
+			}
+			return &kafkaDecoder{buf: append(kafkaTestAppendVarint(nil, int64(len(rec))), rec...)}
+		}
+
+		r, err := decodeKafkaRecord(record(1, []byte("k"), []byte("v")), 1000)
+		So(err, ShouldBeNil)
+		So(r.timestamp, ShouldEqual, 1000)
+		So(string(r.key), ShouldEqual, "key")
+		So(string(r.value), ShouldEqual, "value")
+		So(string(r.headers["k"]), ShouldEqual, "v")
+
+		Convey("test malformed header count", func() {
+			_, err = decodeKafkaRecord(record(-1), 1000)
+			So(err, ShouldEqual, errKafkaMalformedRequest)
+			_, err = decodeKafkaRecord(record(1<<40), 1000)
+			So(err, ShouldEqual, errKafkaMalformedRequest)
+			_, err = decodeKafkaRecord(record(3, []byte("k"), []byte("v")), 1000)
+			So(err, ShouldEqual, errKafkaMalformedRequest)
+		})
+	})
+}
+
+func TestGateway_handleKafkaRequest(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+	mockClient := client.NewMockClient(ctrl)
+	mockEventbus := api.NewMockEventbus(ctrl)
+	mockBusWriter := api.NewMockBusWriter(ctrl)
+	mockClient.EXPECT().Eventbus(Any(), "orders").AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+
+	ga := &ceGateway{
+		reserved: primitive.NewReservedAttributes(nil),
+		client:   mockClient,
+		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		config: Config{
+			Kafka: KafkaConfig{
+				Enable: true,
+				Topics: map[string]string{"order-topic": "orders"},
+			},
+		},
+	}
+	s := &kafkaServer{ga: ga, host: "localhost", port: defaultKafkaPort}
+	ctx := context.Background()
+
+	Convey("test Kafka api versions", t, func() {
+		res, err := s.handleRequest(ctx, kafkaTestRequest(kafkaAPIApiVersions, 3, nil))
+		So(err, ShouldBeNil)
+		d := &kafkaDecoder{buf: res}
+		So(d.int32(), ShouldEqual, 100)
+		So(d.int16(), ShouldEqual, kafkaErrUnsupportedVersion)
+		So(d.int32(), ShouldEqual, 3)
+
+		res, err = s.handleRequest(ctx, kafkaTestRequest(kafkaAPIApiVersions, 2, nil))
+		So(err, ShouldBeNil)
+		d = &kafkaDecoder{buf: res}
+		So(d.int32(), ShouldEqual, 100)
+		So(d.int16(), ShouldEqual, kafkaErrNone)
+		So(d.int32(), ShouldEqual, 3)
+		So(d.int16(), ShouldEqual, kafkaAPIProduce)
+		So(d.int16(), ShouldEqual, 3)
+		So(d.int16(), ShouldEqual, 7)
+
+		_, err = s.handleRequest(ctx, kafkaTestRequest(kafkaAPIProduce, 9, nil))
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test Kafka metadata", t, func() {
+		res, err := s.handleRequest(ctx, kafkaTestRequest(kafkaAPIMetadata, 4, func(e *kafkaEncoder) {
+			e.int32(1)
+			e.string("order-topic")
+			e.int8(0)
+		}))
+		So(err, ShouldBeNil)
+		d := &kafkaDecoder{buf: res}
+		So(d.int32(), ShouldEqual, 100)
+		// throttle time
+		d.int32()
+		So(d.int32(), ShouldEqual, 1)
+		So(d.int32(), ShouldEqual, kafkaNodeID)
+		So(d.string(), ShouldEqual, "localhost")
+		So(d.int32(), ShouldEqual, defaultKafkaPort)
+		So(d.nullableString(), ShouldBeNil)
+		So(d.nullableString(), ShouldBeNil)
+		So(d.int32(), ShouldEqual, kafkaNodeID)
+		So(d.int32(), ShouldEqual, 1)
+		So(d.int16(), ShouldEqual, kafkaErrNone)
+		So(d.string(), ShouldEqual, "order-topic")
+		So(d.int8(), ShouldEqual, 0)
+		So(d.int32(), ShouldEqual, 1)
+		So(d.err, ShouldBeNil)
+	})
+
+	Convey("test Kafka produce", t, func() {
+		Convey("test produce records in CloudEvents binary mode", func() {
+			var events []*ce.Event
+			mockBusWriter.EXPECT().AppendMany(Any(), Any()).DoAndReturn(
+				func(_ interface{}, es []*ce.Event, _ ...api.WriteOption) (string, error) {
+					events = es
+					return "AABBCC", nil
+				})
+			batch := kafkaTestRecordBatch(kafkaCompressionGzip,
+				kafkaRecord{timestamp: 2000, key: []byte("k1"), value: []byte(`{"id":1}`)},
+				kafkaRecord{timestamp: 3000, value: []byte(`{"id":2}`), headers: map[string][]byte{
+					"ce_specversion": []byte("1.0"),
+					"ce_id":          []byte("id-2"),
+					"ce_type":        []byte("order.created"),
+					"ce_source":      []byte("/orders"),
+					"ce_region":      []byte("us"),
+					"content-type":   []byte(ce.ApplicationJSON),
+				}},
+			)
+			res, err := s.handleRequest(ctx, kafkaTestProduce(1, "order-topic", batch))
+			So(err, ShouldBeNil)
+			d := &kafkaDecoder{buf: res}
+			So(d.int32(), ShouldEqual, 100)
+			So(d.int32(), ShouldEqual, 1)
+			So(d.string(), ShouldEqual, "order-topic")
+			So(d.int32(), ShouldEqual, 1)
+			So(d.int32(), ShouldEqual, 0)
+			So(d.int16(), ShouldEqual, kafkaErrNone)
+
+			So(events, ShouldHaveLength, 2)
+			So(events[0].Type(), ShouldEqual, defaultKafkaType)
+			So(events[0].Source(), ShouldEqual, "/kafka/order-topic")
+			So(events[0].Time().UnixMilli(), ShouldEqual, 2000)
+			So(events[0].Extensions()[kafkaKeyExtension], ShouldEqual, "k1")
+			So(events[0].Extensions()[primitive.XVanusEventbus], ShouldEqual, "orders")
+			So(string(events[0].Data()), ShouldEqual, `{"id":1}`)
+			So(events[1].ID(), ShouldEqual, "id-2")
+			So(events[1].Type(), ShouldEqual, "order.created")
+			So(events[1].Source(), ShouldEqual, "/orders")
+			So(events[1].Extensions()["region"], ShouldEqual, "us")
+			So(events[1].DataContentType(), ShouldEqual, ce.ApplicationJSON)
+		})
+
+		Convey("test produce with acks=0 has no response", func() {
+			mockBusWriter.EXPECT().AppendMany(Any(), Any()).Return("AABBCC", nil)
+			batch := kafkaTestRecordBatch(kafkaCompressionNone, kafkaRecord{timestamp: 2000, value: []byte("v")})
+			res, err := s.handleRequest(ctx, kafkaTestProduce(0, "order-topic", batch))
+			So(err, ShouldBeNil)
+			So(res, ShouldBeNil)
+		})
+
+		Convey("test produce corrupt records", func() {
+			batch := kafkaTestRecordBatch(kafkaCompressionNone, kafkaRecord{timestamp: 2000, value: []byte("v")})
+			batch[len(batch)-1] = 'x'
+			res, err := s.handleRequest(ctx, kafkaTestProduce(1, "order-topic", batch))
+			So(err, ShouldBeNil)
+			d := &kafkaDecoder{buf: res}
+			d.read(4 + 4 + 2 + len("order-topic") + 4 + 4)
+			So(d.int16(), ShouldEqual, kafkaErrCorruptMessage)
+		})
+	})
+}
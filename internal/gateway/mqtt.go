@@ -0,0 +1,436 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// The MQTT listener is ingestion only, it accepts CONNECT, PUBLISH with QoS 0 or 1,
+// PINGREQ and DISCONNECT of MQTT 3.1.1 and 5.0, the connection is closed on any other packet.
+
+const (
+	defaultMQTTPort      = 1883
+	defaultMQTTType      = "com.linkall.vanus.mqtt"
+	mqttTopicExtension   = "mqtttopic"
+	maxMQTTPacketSize    = 4 * 1024 * 1024
+	mqttProtocolName     = "MQTT"
+	mqttProtocolLevel3   = 4
+	mqttProtocolLevel5   = 5
+	mqttConnectTimeout   = 10 * time.Second
+	mqttPacketConnect    = 1
+	mqttPacketConnack    = 2
+	mqttPacketPublish    = 3
+	mqttPacketPuback     = 4
+	mqttPacketPingreq    = 12
+	mqttPacketPingresp   = 13
+	mqttPacketDisconnect = 14
+
+	mqttConnackUnacceptableProtocol = 0x01
+	mqttReasonNoMatchingSubscribers = 0x10
+	mqttReasonUnspecifiedError      = 0x80
+	mqttReasonNotAuthorized         = 0x87
+	mqttReasonPayloadFormatInvalid  = 0x99
+	mqttPropertyContentType         = 0x03
+	mqttPropertyMaximumQoS          = 0x24
+)
+
+var (
+	errMQTTUnsupportedProtocol = errors.New("unsupported MQTT protocol")
+	errMQTTMalformedPacket     = errors.New("malformed MQTT packet")
+	errMQTTQoSNotSupported     = errors.New("MQTT QoS 2 is not supported")
+)
+
+type mqttPacket struct {
+	typ   byte
+	flags byte
+	body  []byte
+}
+
+type mqttMessage struct {
+	topic       string
+	qos         byte
+	packetID    uint16
+	contentType string
+	payload     []byte
+}
+
+type mqttServer struct {
+	*connServer
+	ga *ceGateway
+}
+
+func (ga *ceGateway) startMQTTReceiver(ctx context.Context) error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetMQTTPort()))
+	if err != nil {
+		return err
+	}
+	ga.mqttSrv = &mqttServer{ga: ga}
+	ga.mqttSrv.connServer = newConnServer(ls, ga.mqttSrv.handleConn)
+	go ga.mqttSrv.serve(ctx)
+	return nil
+}
+
+func (s *mqttServer) handleConn(ctx context.Context, conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	ctx = withRemoteAddr(ctx, conn.RemoteAddr())
+	r := bufio.NewReader(conn)
+	_ = conn.SetReadDeadline(time.Now().Add(mqttConnectTimeout))
+	pkt, err := readMQTTPacket(r)
+	if err != nil || pkt.typ != mqttPacketConnect {
+		return
+	}
+	level, keepAlive, err := parseMQTTConnect(pkt.body)
+	if err != nil {
+		if errors.Is(err, errMQTTUnsupportedProtocol) {
+			_, _ = conn.Write([]byte{mqttPacketConnack << 4, 2, 0, mqttConnackUnacceptableProtocol})
+		}
+		return
+	}
+	if _, err = conn.Write(mqttConnack(level)); err != nil {
+		return
+	}
+	for {
+		if keepAlive > 0 {
+			// the server disconnects the client in one and a half times the keep alive period
+			_ = conn.SetReadDeadline(time.Now().Add(time.Duration(keepAlive) * time.Second * 3 / 2))
+		} else {
+			_ = conn.SetReadDeadline(time.Time{})
+		}
+		pkt, err = readMQTTPacket(r)
+		if err != nil {
+			return
+		}
+		switch pkt.typ {
+		case mqttPacketPublish:
+			if err = s.handlePublish(ctx, conn, level, pkt); err != nil {
+				log.Warning(ctx, "handle MQTT publish failed", map[string]interface{}{
+					log.KeyError: err,
+					"remote":     conn.RemoteAddr().String(),
+				})
+				return
+			}
+		case mqttPacketPingreq:
+			if _, err = conn.Write([]byte{mqttPacketPingresp << 4, 0}); err != nil {
+				return
+			}
+		case mqttPacketDisconnect:
+			return
+		default:
+			log.Warning(ctx, "unsupported MQTT packet", map[string]interface{}{
+				"type":   pkt.typ,
+				"remote": conn.RemoteAddr().String(),
+			})
+			return
+		}
+	}
+}
+
+// handlePublish appends the message to eventbus of the matched rule. A QoS 1 message is
+// acknowledged only after it has been appended, if appending failed the MQTT 3.1.1
+// connection is closed so that the client redelivers it.
+func (s *mqttServer) handlePublish(ctx context.Context, conn net.Conn, level byte, pkt *mqttPacket) error {
+	msg, err := parseMQTTPublish(level, pkt.flags, pkt.body)
+	if err != nil {
+		return err
+	}
+	rule := s.match(msg.topic)
+	if rule == nil {
+		log.Warning(ctx, "no MQTT rule matched, drop the message", map[string]interface{}{
+			"topic": msg.topic,
+		})
+		return s.ack(conn, level, msg, mqttReasonNoMatchingSubscribers)
+	}
+	event, err := mqttToEvent(rule, msg)
+	if err != nil {
+		return err
+	}
+	if err = s.ga.validateEvent(rule.Eventbus, event); err != nil {
+		// MQTT 3.1.1 can't reject, the message is dropped so that it isn't redelivered
+		log.Warning(ctx, "invalid MQTT message, drop it", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   rule.Eventbus,
+			"topic":      msg.topic,
+		})
+		if level == mqttProtocolLevel5 {
+			return s.ack(conn, level, msg, mqttReasonPayloadFormatInvalid)
+		}
+		return s.ack(conn, level, msg, 0)
+	}
+	_ctx, span := s.ga.tracer.Start(ctx, "receiveMQTT")
+	defer span.End()
+	if err = s.ga.authorizeConn(_ctx, rule.Eventbus); err != nil {
+		log.Warning(_ctx, "MQTT message isn't allowed, drop it", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   rule.Eventbus,
+			"topic":      msg.topic,
+		})
+		if level == mqttProtocolLevel5 {
+			return s.ack(conn, level, msg, mqttReasonNotAuthorized)
+		}
+		return s.ack(conn, level, msg, 0)
+	}
+	_, err = s.ga.getBusWriter(_ctx, rule.Eventbus).AppendOne(_ctx, event)
+	if err != nil {
+		log.Warning(_ctx, "append MQTT event failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   rule.Eventbus,
+			"topic":      msg.topic,
+		})
+		if level == mqttProtocolLevel5 {
+			return s.ack(conn, level, msg, mqttReasonUnspecifiedError)
+		}
+		if msg.qos == 0 {
+			return nil
+		}
+		return err
+	}
+	return s.ack(conn, level, msg, 0)
+}
+
+func (s *mqttServer) ack(conn net.Conn, level byte, msg *mqttMessage, reason byte) error {
+	if msg.qos == 0 {
+		return nil
+	}
+	ack := []byte{mqttPacketPuback << 4, 2, byte(msg.packetID >> 8), byte(msg.packetID)}
+	if level == mqttProtocolLevel5 && reason != 0 {
+		ack[1] = 3
+		ack = append(ack, reason)
+	}
+	_, err := conn.Write(ack)
+	return err
+}
+
+func (s *mqttServer) match(topic string) *MQTTRule {
+	for idx := range s.ga.config.MQTT.Rules {
+		if matchMQTTTopic(s.ga.config.MQTT.Rules[idx].Topic, topic) {
+			return &s.ga.config.MQTT.Rules[idx]
+		}
+	}
+	return nil
+}
+
+func mqttToEvent(rule *MQTTRule, msg *mqttMessage) (*v2.Event, error) {
+	e := v2.NewEvent()
+	e.SetID(uuid.NewString())
+	e.SetType(valueOrDefault(rule.Type, defaultMQTTType))
+	e.SetSource(valueOrDefault(rule.Source, "/mqtt/"+msg.topic))
+	e.SetTime(time.Now())
+	e.SetExtension(mqttTopicExtension, msg.topic)
+	if len(msg.payload) > 0 {
+		if err := e.SetData(msg.contentType, msg.payload); err != nil {
+			return nil, err
+		}
+	}
+	e.SetExtension(primitive.XVanusEventbus, rule.Eventbus)
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func matchMQTTTopic(filter, topic string) bool {
+	fs := strings.Split(filter, "/")
+	ts := strings.Split(topic, "/")
+	// topics beginning with $ are not matched by wildcards at the first level
+	if strings.HasPrefix(topic, "$") && (fs[0] == "+" || fs[0] == "#") {
+		return false
+	}
+	for idx, f := range fs {
+		if f == "#" {
+			return true
+		}
+		if idx >= len(ts) {
+			return false
+		}
+		if f != "+" && f != ts[idx] {
+			return false
+		}
+	}
+	return len(fs) == len(ts)
+}
+
+func mqttConnack(level byte) []byte {
+	if level == mqttProtocolLevel5 {
+		return []byte{mqttPacketConnack << 4, 5, 0, 0, 2, mqttPropertyMaximumQoS, 1}
+	}
+	return []byte{mqttPacketConnack << 4, 2, 0, 0}
+}
+
+func readMQTTPacket(r *bufio.Reader) (*mqttPacket, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	length, err := readMQTTVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxMQTTPacketSize {
+		return nil, fmt.Errorf("MQTT packet is too large: %d", length)
+	}
+	body := make([]byte, length)
+	if _, err = io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	return &mqttPacket{typ: b >> 4, flags: b & 0x0f, body: body}, nil
+}
+
+func parseMQTTConnect(body []byte) (byte, uint16, error) {
+	r := bytes.NewReader(body)
+	name, err := readMQTTString(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	level, err := r.ReadByte()
+	if err != nil {
+		return 0, 0, errMQTTMalformedPacket
+	}
+	if name != mqttProtocolName || (level != mqttProtocolLevel3 && level != mqttProtocolLevel5) {
+		return 0, 0, errMQTTUnsupportedProtocol
+	}
+	// connect flags
+	if _, err = r.ReadByte(); err != nil {
+		return 0, 0, errMQTTMalformedPacket
+	}
+	keepAlive, err := readMQTTUint16(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	return level, keepAlive, nil
+}
+
+func parseMQTTPublish(level, flags byte, body []byte) (*mqttMessage, error) {
+	msg := &mqttMessage{qos: (flags >> 1) & 0x03}
+	if msg.qos > 1 {
+		return nil, errMQTTQoSNotSupported
+	}
+	r := bytes.NewReader(body)
+	var err error
+	if msg.topic, err = readMQTTString(r); err != nil {
+		return nil, err
+	}
+	if msg.topic == "" {
+		return nil, errMQTTMalformedPacket
+	}
+	if msg.qos > 0 {
+		if msg.packetID, err = readMQTTUint16(r); err != nil {
+			return nil, err
+		}
+	}
+	if level == mqttProtocolLevel5 {
+		length, err := readMQTTVarint(r)
+		if err != nil {
+			return nil, err
+		}
+		props := make([]byte, length)
+		if _, err = io.ReadFull(r, props); err != nil {
+			return nil, errMQTTMalformedPacket
+		}
+		if msg.contentType, err = parseMQTTContentType(props); err != nil {
+			return nil, err
+		}
+	}
+	msg.payload = body[len(body)-r.Len():]
+	return msg, nil
+}
+
+// parseMQTTContentType walks through MQTT 5.0 properties and returns the content type.
+func parseMQTTContentType(props []byte) (string, error) {
+	r := bytes.NewReader(props)
+	contentType := ""
+	for r.Len() > 0 {
+		id, err := readMQTTVarint(r)
+		if err != nil {
+			return "", err
+		}
+		switch id {
+		case 0x01, 0x17, 0x19, 0x24, 0x25, 0x28, 0x29, 0x2A:
+			_, err = r.ReadByte()
+		case 0x13, 0x21, 0x22, 0x23:
+			_, err = readMQTTUint16(r)
+		case 0x02, 0x11, 0x18, 0x27:
+			_, err = io.ReadFull(r, make([]byte, 4))
+		case 0x0B:
+			_, err = readMQTTVarint(r)
+		case mqttPropertyContentType:
+			contentType, err = readMQTTString(r)
+		case 0x08, 0x09, 0x12, 0x15, 0x16, 0x1A, 0x1C, 0x1F:
+			_, err = readMQTTString(r)
+		case 0x26:
+			if _, err = readMQTTString(r); err == nil {
+				_, err = readMQTTString(r)
+			}
+		default:
+			err = errMQTTMalformedPacket
+		}
+		if err != nil {
+			return "", errMQTTMalformedPacket
+		}
+	}
+	return contentType, nil
+}
+
+func readMQTTVarint(r io.ByteReader) (int, error) {
+	value, multiplier := 0, 1
+	for i := 0; i < 4; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		value += int(b&0x7f) * multiplier
+		if b&0x80 == 0 {
+			return value, nil
+		}
+		multiplier *= 128
+	}
+	return 0, errMQTTMalformedPacket
+}
+
+func readMQTTUint16(r *bytes.Reader) (uint16, error) {
+	b := make([]byte, 2)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return 0, errMQTTMalformedPacket
+	}
+	return binary.BigEndian.Uint16(b), nil
+}
+
+func readMQTTString(r *bytes.Reader) (string, error) {
+	length, err := readMQTTUint16(r)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, length)
+	if _, err = io.ReadFull(r, b); err != nil {
+		return "", errMQTTMalformedPacket
+	}
+	return string(b), nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func mqttTestString(s string) []byte {
+	return append([]byte{byte(len(s) >> 8), byte(len(s))}, s...)
+}
+
+func mqttTestPacket(header byte, body []byte) []byte {
+	// test packets are all shorter than 128 bytes
+	return append([]byte{header, byte(len(body))}, body...)
+}
+
+func mqttTestConnect(level byte) []byte {
+	body := append(mqttTestString("MQTT"), level, 0x02, 0, 60)
+	if level == mqttProtocolLevel5 {
+		body = append(body, 0)
+	}
+	body = append(body, mqttTestString("client")...)
+	return mqttTestPacket(mqttPacketConnect<<4, body)
+}
+
+func mqttTestPublish(level byte, topic string, packetID uint16, payload string) []byte {
+	body := mqttTestString(topic)
+	body = append(body, byte(packetID>>8), byte(packetID))
+	if level == mqttProtocolLevel5 {
+		// message expiry interval and content type
+		props := []byte{0x02, 0, 0, 0, 60, mqttPropertyContentType}
+		props = append(props, mqttTestString(ce.ApplicationJSON)...)
+		body = append(body, byte(len(props)))
+		body = append(body, props...)
+	}
+	body = append(body, payload...)
+	return mqttTestPacket(mqttPacketPublish<<4|0x02, body)
+}
+
+func readTestFrame(conn net.Conn, n int) []byte {
+	b := make([]byte, n)
+	_, err := io.ReadFull(conn, b)
+	So(err, ShouldBeNil)
+	return b
+}
+
+func TestGateway_matchMQTTTopic(t *testing.T) {
+	Convey("test match MQTT topic", t, func() {
+		So(matchMQTTTopic("a/b", "a/b"), ShouldBeTrue)
+		So(matchMQTTTopic("a/b", "a/c"), ShouldBeFalse)
+		So(matchMQTTTopic("a/+", "a/c"), ShouldBeTrue)
+		So(matchMQTTTopic("a/+", "a/c/d"), ShouldBeFalse)
+		So(matchMQTTTopic("a/+/d", "a/c/d"), ShouldBeTrue)
+		So(matchMQTTTopic("a/#", "a"), ShouldBeTrue)
+		So(matchMQTTTopic("a/#", "a/c/d"), ShouldBeTrue)
+		So(matchMQTTTopic("#", "a/c/d"), ShouldBeTrue)
+		So(matchMQTTTopic("#", "$SYS/a"), ShouldBeFalse)
+	})
+}
+
+func TestGateway_parseMQTTPublish(t *testing.T) {
+	Convey("test parse MQTT publish", t, func() {
+		pkt := mqttTestPublish(mqttProtocolLevel5, "a/b", 10, "hello")
+		msg, err := parseMQTTPublish(mqttProtocolLevel5, pkt[0]&0x0f, pkt[2:])
+		So(err, ShouldBeNil)
+		So(msg.topic, ShouldEqual, "a/b")
+		So(msg.qos, ShouldEqual, 1)
+		So(msg.packetID, ShouldEqual, 10)
+		So(msg.contentType, ShouldEqual, ce.ApplicationJSON)
+		So(string(msg.payload), ShouldEqual, "hello")
+
+		pkt = mqttTestPublish(mqttProtocolLevel3, "a/b", 10, "hello")
+		msg, err = parseMQTTPublish(mqttProtocolLevel3, pkt[0]&0x0f, pkt[2:])
+		So(err, ShouldBeNil)
+		So(msg.contentType, ShouldEqual, "")
+		So(string(msg.payload), ShouldEqual, "hello")
+
+		_, err = parseMQTTPublish(mqttProtocolLevel3, 0x04, pkt[2:])
+		So(err, ShouldEqual, errMQTTQoSNotSupported)
+	})
+}
+
+func TestGateway_handleMQTTConn(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+	mockClient := client.NewMockClient(ctrl)
+	mockEventbus := api.NewMockEventbus(ctrl)
+	mockBusWriter := api.NewMockBusWriter(ctrl)
+	mockClient.EXPECT().Eventbus(Any(), "devices").AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+
+	ga := &ceGateway{
+		client: mockClient,
+		tracer: tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		config: Config{
+			MQTT: MQTTConfig{
+				Enable: true,
+				Rules: []MQTTRule{
+					{Topic: "devices/+/telemetry", Eventbus: "devices", Type: "telemetry"},
+				},
+			},
+		},
+	}
+	s := &mqttServer{ga: ga}
+	ctx := context.Background()
+
+	for _, level := range []byte{mqttProtocolLevel3, mqttProtocolLevel5} {
+		Convey(fmt.Sprintf("test handle MQTT connection of protocol level %d", level), t, func() {
+			cli, srv := net.Pipe()
+			done := make(chan struct{})
+			go func() {
+				s.handleConn(ctx, srv)
+				close(done)
+			}()
+			_, err := cli.Write(mqttTestConnect(level))
+			So(err, ShouldBeNil)
+			So(readTestFrame(cli, len(mqttConnack(level))), ShouldResemble, mqttConnack(level))
+
+			Convey("test QoS 1 message is acknowledged after it's appended", func() {
+				var event *ce.Event
+				mockBusWriter.EXPECT().AppendOne(Any(), Any()).DoAndReturn(
+					func(_ interface{}, e *ce.Event, _ ...api.WriteOption) (string, error) {
+						event = e
+						return "AABBCC", nil
+					})
+				_, err = cli.Write(mqttTestPublish(level, "devices/1/telemetry", 1, `{"t":20}`))
+				So(err, ShouldBeNil)
+				So(readTestFrame(cli, 4), ShouldResemble, []byte{mqttPacketPuback << 4, 2, 0, 1})
+				So(event.Type(), ShouldEqual, "telemetry")
+				So(event.Source(), ShouldEqual, "/mqtt/devices/1/telemetry")
+				So(event.Extensions()[mqttTopicExtension], ShouldEqual, "devices/1/telemetry")
+				So(event.Extensions()[primitive.XVanusEventbus], ShouldEqual, "devices")
+				So(string(event.Data()), ShouldEqual, `{"t":20}`)
+
+				_, err = cli.Write([]byte{mqttPacketPingreq << 4, 0})
+				So(err, ShouldBeNil)
+				So(readTestFrame(cli, 2), ShouldResemble, []byte{mqttPacketPingresp << 4, 0})
+				_, err = cli.Write([]byte{mqttPacketDisconnect << 4, 0})
+				So(err, ShouldBeNil)
+				<-done
+			})
+
+			Convey("test append failed", func() {
+				mockBusWriter.EXPECT().AppendOne(Any(), Any()).Return("", fmt.Errorf("test"))
+				_, err = cli.Write(mqttTestPublish(level, "devices/1/telemetry", 2, `{"t":20}`))
+				So(err, ShouldBeNil)
+				if level == mqttProtocolLevel5 {
+					So(readTestFrame(cli, 5), ShouldResemble,
+						[]byte{mqttPacketPuback << 4, 3, 0, 2, mqttReasonUnspecifiedError})
+					_ = cli.Close()
+				} else {
+					// connection is closed without acknowledgement
+					_, err = cli.Read(make([]byte, 1))
+					So(err, ShouldEqual, io.EOF)
+				}
+				<-done
+			})
+		})
+	}
+}
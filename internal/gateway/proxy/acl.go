@@ -0,0 +1,154 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// AuthorizationHeader carries the bearer token of principal in HTTP requests and gRPC metadata.
+	AuthorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+
+	defaultACLCacheTTL = 10 * time.Second
+)
+
+// ACLConfig authenticates the principal of requests by bearer token, and enforces the ACL entries of
+// eventbuses saved in controller. The requests without token are made by the anonymous principal.
+type ACLConfig struct {
+	Enable bool
+	// Tokens maps the bearer token to the principal.
+	Tokens map[string]string
+	// CacheTTL is how long the policy of eventbus is cached, defaults to 10s.
+	CacheTTL time.Duration
+}
+
+type cachedPolicy struct {
+	policy   *acl.Policy
+	expireAt time.Time
+}
+
+// Authorizer is nil if ACL isn't enabled, which allows all requests.
+type Authorizer struct {
+	cfg      ACLConfig
+	ctrl     ctrlpb.EventBusControllerClient
+	policies map[string]*cachedPolicy
+	mu       sync.Mutex
+}
+
+func NewAuthorizer(cfg ACLConfig, ctrl ctrlpb.EventBusControllerClient) *Authorizer {
+	if !cfg.Enable {
+		return nil
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultACLCacheTTL
+	}
+	return &Authorizer{
+		cfg:      cfg,
+		ctrl:     ctrl,
+		policies: map[string]*cachedPolicy{},
+	}
+}
+
+// Principal returns the principal of the authorization, which is empty for the anonymous one.
+func (a *Authorizer) Principal(authorization string) (string, error) {
+	if a == nil || authorization == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return "", errors.ErrPermissionDenied.WithMessage("only the bearer token is supported")
+	}
+	principal, ok := a.cfg.Tokens[strings.TrimPrefix(authorization, bearerPrefix)]
+	if !ok {
+		return "", errors.ErrPermissionDenied.WithMessage("invalid token")
+	}
+	return principal, nil
+}
+
+// Authorize returns ErrPermissionDenied if the principal isn't allowed to access the eventbus.
+func (a *Authorizer) Authorize(ctx context.Context, eventbus, principal string, perm acl.Permission) error {
+	if a == nil {
+		return nil
+	}
+	policy, err := a.policy(ctx, eventbus)
+	if err != nil {
+		return err
+	}
+	if !policy.Allowed(principal, perm) {
+		return errors.ErrPermissionDenied.WithMessage(
+			"the principal " + principal + " isn't allowed to " + string(perm) + " the eventbus " + eventbus)
+	}
+	return nil
+}
+
+func (a *Authorizer) policy(ctx context.Context, eventbus string) (*acl.Policy, error) {
+	now := time.Now()
+	a.mu.Lock()
+	c, ok := a.policies[eventbus]
+	a.mu.Unlock()
+	if ok && now.Before(c.expireAt) {
+		return c.policy, nil
+	}
+	res, err := a.ctrl.ListEventbusACL(ctx, &ctrlpb.ListEventbusACLRequest{Eventbus: eventbus})
+	if err != nil {
+		return nil, err
+	}
+	policy := acl.NewPolicy(res)
+	a.mu.Lock()
+	a.policies[eventbus] = &cachedPolicy{policy: policy, expireAt: now.Add(a.cfg.CacheTTL)}
+	a.mu.Unlock()
+	return policy, nil
+}
+
+type principalKey struct{}
+
+// UnaryServerInterceptor authenticates the principal of gRPC requests, and carries it to controllers,
+// which enforce the admin and subscribe permissions themselves.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if a == nil {
+			return handler(ctx, req)
+		}
+		var authorization string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(AuthorizationHeader); len(v) > 0 {
+				authorization = v[0]
+			}
+		}
+		principal, err := a.Principal(authorization)
+		if err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, principalKey{}, principal)
+		return handler(acl.WithPrincipal(ctx, principal), req)
+	}
+}
+
+// principalFromContext returns the principal authenticated by UnaryServerInterceptor.
+func principalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthorizer(t *testing.T) {
+	Convey("test authorizer", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ebCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		a := NewAuthorizer(ACLConfig{Enable: true, Tokens: map[string]string{"t1": "alice"}, CacheTTL: time.Hour},
+			ebCtrl)
+		ctx := stdCtx.Background()
+
+		Convey("test disabled authorizer", func() {
+			var disabled *Authorizer
+			So(NewAuthorizer(ACLConfig{}, ebCtrl), ShouldBeNil)
+			principal, err := disabled.Principal("Bearer t2")
+			So(err, ShouldBeNil)
+			So(principal, ShouldBeEmpty)
+			So(disabled.Authorize(ctx, "test", "", acl.PermissionPublish), ShouldBeNil)
+		})
+
+		Convey("test principal of token", func() {
+			principal, err := a.Principal("Bearer t1")
+			So(err, ShouldBeNil)
+			So(principal, ShouldEqual, "alice")
+			principal, err = a.Principal("")
+			So(err, ShouldBeNil)
+			So(principal, ShouldBeEmpty)
+			_, err = a.Principal("Bearer t2")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+			_, err = a.Principal("Basic t1")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("test authorize with cached policy", func() {
+			ebCtrl.EXPECT().ListEventbusACL(gomock.Any(), &ctrlpb.ListEventbusACLRequest{Eventbus: "test"}).
+				Times(1).Return(&ctrlpb.ListEventbusACLResponse{
+				Acls: []*ctrlpb.EventbusACL{{Principal: "alice", Permissions: []string{"publish"}}},
+			}, nil)
+			So(a.Authorize(ctx, "test", "alice", acl.PermissionPublish), ShouldBeNil)
+			err := a.Authorize(ctx, "test", "alice", acl.PermissionSubscribe)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+			err = a.Authorize(ctx, "test", "", acl.PermissionPublish)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("test interceptor carries the principal to controllers", func() {
+			interceptor := a.UnaryServerInterceptor()
+			handler := func(ctx stdCtx.Context, _ interface{}) (interface{}, error) {
+				So(principalFromContext(ctx), ShouldEqual, "alice")
+				md, _ := metadata.FromOutgoingContext(ctx)
+				return md.Get("x-vanus-principal"), nil
+			}
+			in := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer t1"))
+			res, err := interceptor(in, nil, &grpc.UnaryServerInfo{}, handler)
+			So(err, ShouldBeNil)
+			So(res, ShouldResemble, []string{"alice"})
+
+			in = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer t2"))
+			_, err = interceptor(in, nil, &grpc.UnaryServerInfo{}, handler)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+	})
+}
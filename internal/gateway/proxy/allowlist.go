@@ -0,0 +1,186 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultNamespaceHeader   = "X-Vanus-Namespace"
+	forwardedForHeader       = "X-Forwarded-For"
+	defaultAllowlistCacheTTL = 10 * time.Second
+)
+
+// IPAllowlistConfig enforces the source IP allowlists of eventbuses and namespaces saved in controller.
+type IPAllowlistConfig struct {
+	Enable bool
+	// CacheTTL is how long the allowlists are cached, defaults to 10s.
+	CacheTTL time.Duration
+	// TrustForwardedFor takes the last address of X-Forwarded-For as the source address, which is
+	// appended by the load balancer in front of gateway. Don't enable it if gateway is exposed directly.
+	TrustForwardedFor bool
+	// NamespaceHeader is the header whose value is the namespace, defaults to X-Vanus-Namespace.
+	NamespaceHeader string
+}
+
+// IPAllowlist is nil if it isn't enabled, which allows all addresses.
+type IPAllowlist struct {
+	cfg        IPAllowlistConfig
+	ctrl       ctrlpb.EventBusControllerClient
+	allowlists *acl.Allowlists
+	expireAt   time.Time
+	mu         sync.Mutex
+}
+
+func NewIPAllowlist(cfg IPAllowlistConfig, ctrl ctrlpb.EventBusControllerClient) *IPAllowlist {
+	if !cfg.Enable {
+		return nil
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultAllowlistCacheTTL
+	}
+	if cfg.NamespaceHeader == "" {
+		cfg.NamespaceHeader = defaultNamespaceHeader
+	}
+	return &IPAllowlist{cfg: cfg, ctrl: ctrl}
+}
+
+// Check returns ErrPermissionDenied if the address isn't allowed to access the eventbus of the namespace.
+func (l *IPAllowlist) Check(ctx context.Context, ip net.IP, eventbus, namespace string) error {
+	if l == nil {
+		return nil
+	}
+	allowlists, err := l.get(ctx)
+	if err != nil {
+		return err
+	}
+	if !allowlists.Allowed(ip, eventbus, namespace) {
+		return errors.ErrPermissionDenied.WithMessage("the address " + ip.String() + " isn't allowed")
+	}
+	return nil
+}
+
+// get returns the cached allowlists, the stale ones are used if refreshing failed, and the requests are
+// rejected if they have never been got.
+func (l *IPAllowlist) get(ctx context.Context) (*acl.Allowlists, error) {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.allowlists != nil && now.Before(l.expireAt) {
+		return l.allowlists, nil
+	}
+	res, err := l.ctrl.ListIPAllowlist(ctx, &emptypb.Empty{})
+	if err != nil {
+		if l.allowlists == nil {
+			return nil, err
+		}
+		log.Warning(ctx, "refresh ip allowlists failed, use the stale ones", map[string]interface{}{
+			log.KeyError: err,
+		})
+		l.expireAt = now.Add(l.cfg.CacheTTL)
+		return l.allowlists, nil
+	}
+	l.allowlists = acl.NewAllowlists(res)
+	l.expireAt = now.Add(l.cfg.CacheTTL)
+	return l.allowlists, nil
+}
+
+// CheckAddr checks the source address of the connections of MQTT, Kafka and AMQP listeners, which
+// don't have namespace.
+func (l *IPAllowlist) CheckAddr(ctx context.Context, addr net.Addr, eventbus string) error {
+	if l == nil {
+		return nil
+	}
+	var ip net.IP
+	if addr != nil {
+		ip = parseIP(addr.String())
+	}
+	return l.Check(ctx, ip, eventbus, "")
+}
+
+// CheckHTTP checks the source address and the namespace header of the HTTP request.
+func (l *IPAllowlist) CheckHTTP(req *http.Request, eventbus string) error {
+	if l == nil {
+		return nil
+	}
+	addr := req.RemoteAddr
+	if l.cfg.TrustForwardedFor {
+		if v := req.Header.Values(forwardedForHeader); len(v) > 0 {
+			addr = lastForwardedFor(v[len(v)-1])
+		}
+	}
+	return l.Check(req.Context(), parseIP(addr), eventbus, req.Header.Get(l.cfg.NamespaceHeader))
+}
+
+// UnaryServerInterceptor checks the source address of gRPC requests, the eventbus is taken from the
+// request if it has one.
+func (l *IPAllowlist) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if l == nil {
+			return handler(ctx, req)
+		}
+		var addr, eventbus, namespace string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			addr = p.Addr.String()
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(forwardedForHeader); l.cfg.TrustForwardedFor && len(v) > 0 {
+				addr = lastForwardedFor(v[len(v)-1])
+			}
+			if v := md.Get(l.cfg.NamespaceHeader); len(v) > 0 {
+				namespace = v[0]
+			}
+		}
+		switch r := req.(type) {
+		case interface{ GetEventbus() string }:
+			eventbus = r.GetEventbus()
+		case interface{ GetEventbusName() string }:
+			eventbus = r.GetEventbusName()
+		}
+		if err := l.Check(ctx, parseIP(addr), eventbus, namespace); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func lastForwardedFor(v string) string {
+	addrs := strings.Split(v, ",")
+	return strings.TrimSpace(addrs[len(addrs)-1])
+}
+
+// parseIP parses the address with or without port, it returns nil if the address is invalid.
+func parseIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestIPAllowlist(t *testing.T) {
+	Convey("test ip allowlist", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ebCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		l := NewIPAllowlist(IPAllowlistConfig{Enable: true, CacheTTL: time.Hour}, ebCtrl)
+		ctx := stdCtx.Background()
+		res := &ctrlpb.ListIPAllowlistResponse{
+			Allowlists: []*ctrlpb.IPAllowlist{
+				{Kind: "eventbus", Name: "test", Cidrs: []string{"10.0.0.0/8"}},
+				{Kind: "namespace", Name: "team-a", Cidrs: []string{"10.1.0.0/16"}},
+			},
+		}
+
+		Convey("test disabled ip allowlist", func() {
+			var disabled *IPAllowlist
+			So(NewIPAllowlist(IPAllowlistConfig{}, ebCtrl), ShouldBeNil)
+			So(disabled.Check(ctx, net.ParseIP("192.168.0.1"), "test", ""), ShouldBeNil)
+		})
+
+		Convey("test check with cached allowlists", func() {
+			ebCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1).Return(res, nil)
+			So(l.Check(ctx, net.ParseIP("10.0.0.1"), "test", ""), ShouldBeNil)
+			err := l.Check(ctx, net.ParseIP("192.168.0.1"), "test", "")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+			err = l.Check(ctx, net.ParseIP("10.0.0.1"), "test", "team-a")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("test check if refreshing failed", func() {
+			ebCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.ErrInternal)
+			err := l.Check(ctx, net.ParseIP("10.0.0.1"), "test", "")
+			So(errors.Is(err, errors.ErrInternal), ShouldBeTrue)
+
+			ebCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1).Return(res, nil)
+			So(l.Check(ctx, net.ParseIP("10.0.0.1"), "test", ""), ShouldBeNil)
+			l.expireAt = time.Now()
+			ebCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.ErrInternal)
+			err = l.Check(ctx, net.ParseIP("192.168.0.1"), "test", "")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("test check HTTP request", func() {
+			ebCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1).Return(res, nil)
+			req := httptest.NewRequest("POST", "/gateway/test", nil)
+			req.RemoteAddr = "10.1.0.1:5000"
+			So(l.CheckHTTP(req, "test"), ShouldBeNil)
+			req.Header.Set(defaultNamespaceHeader, "team-a")
+			So(l.CheckHTTP(req, "test"), ShouldBeNil)
+
+			req.RemoteAddr = "10.2.0.1:5000"
+			req.Header.Set(forwardedForHeader, "10.1.0.1, 10.1.0.2")
+			So(errors.Is(l.CheckHTTP(req, "test"), errors.ErrPermissionDenied), ShouldBeTrue)
+			l.cfg.TrustForwardedFor = true
+			So(l.CheckHTTP(req, "test"), ShouldBeNil)
+		})
+
+		Convey("test interceptor checks the eventbus of request", func() {
+			ebCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1).Return(res, nil)
+			interceptor := l.UnaryServerInterceptor()
+			handler := func(_ stdCtx.Context, _ interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+			_ctx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.168.0.1"), Port: 5000}})
+			_, err := interceptor(_ctx, &cloudevents.BatchEvent{EventbusName: "test"},
+				&grpc.UnaryServerInfo{}, handler)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+			_, err = interceptor(_ctx, &proxypb.GetEventRequest{Eventbus: "test"}, &grpc.UnaryServerInfo{}, handler)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+			_, err = interceptor(_ctx, &proxypb.GetEventRequest{Eventbus: "other"}, &grpc.UnaryServerInfo{}, handler)
+			So(err, ShouldBeNil)
+			_ctx = metadata.NewIncomingContext(_ctx, metadata.Pairs(defaultNamespaceHeader, "team-a"))
+			_, err = interceptor(_ctx, &proxypb.GetEventRequest{Eventbus: "other"}, &grpc.UnaryServerInfo{}, handler)
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// A delayed event is kept by timer until its delivery time. It's cancelled or rescheduled by a tombstone
+// written to the tombstone station of timer, the timer checks the tombstones when the event fires.
+
+const (
+	tombstoneSource = "vanus-gateway"
+	tombstoneType   = "vanus.timer.tombstone"
+)
+
+var (
+	errInvalidEventID = errors.ErrInvalidRequest.WithMessage("the event id can't be empty")
+)
+
+func (cp *ControllerProxy) CancelDelayedEvent(ctx context.Context,
+	req *proxypb.CancelDelayedEventRequest) (*emptypb.Empty, error) {
+	if req.GetEventbus() == "" {
+		return nil, errInvalidEventbus
+	}
+	if req.GetEventId() == "" {
+		return nil, errInvalidEventID
+	}
+	if err := cp.writeTombstone(ctx, req.Eventbus, req.EventId, ""); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (cp *ControllerProxy) RescheduleDelayedEvent(ctx context.Context,
+	req *proxypb.RescheduleDelayedEventRequest) (*emptypb.Empty, error) {
+	if req.GetEventbus() == "" {
+		return nil, errInvalidEventbus
+	}
+	if req.GetEventId() == "" {
+		return nil, errInvalidEventID
+	}
+	if _, err := types.ParseTime(req.GetDeliveryTime()); err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid delivery time").Wrap(err)
+	}
+	if err := cp.writeTombstone(ctx, req.Eventbus, req.EventId, req.DeliveryTime); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// writeTombstone writes a tombstone of the delayed event, the event is cancelled if deliveryTime is empty.
+func (cp *ControllerProxy) writeTombstone(ctx context.Context, eventbus, eventID, deliveryTime string) error {
+	e := v2.NewEvent()
+	e.SetID(uuid.NewString())
+	e.SetSource(tombstoneSource)
+	e.SetType(tombstoneType)
+	e.SetTime(time.Now())
+	e.SetExtension(primitive.XVanusEventbus, eventbus)
+	e.SetExtension(primitive.XVanusTimerEventID, eventID)
+	if deliveryTime != "" {
+		e.SetExtension(primitive.XVanusDeliveryTime, deliveryTime)
+	}
+	_, err := cp.client.Eventbus(ctx, primitive.TimerTombstoneEventbusName).Writer().AppendOne(ctx, &e)
+	if err != nil {
+		log.Warning(ctx, "write tombstone of delayed event failed", map[string]interface{}{
+			log.KeyError:    err,
+			"eventbus":      eventbus,
+			"event_id":      eventID,
+			"delivery_time": deliveryTime,
+		})
+		return err
+	}
+	log.Info(ctx, "tombstone of delayed event written", map[string]interface{}{
+		"eventbus":      eventbus,
+		"event_id":      eventID,
+		"delivery_time": deliveryTime,
+	})
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"fmt"
+	"testing"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestControllerProxy_CancelDelayedEvent(t *testing.T) {
+	Convey("test cancel delayed event", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockWriter := api.NewMockBusWriter(ctrl)
+		cp := &ControllerProxy{client: mockClient}
+		ctx := stdCtx.Background()
+
+		Convey("test invalid params", func() {
+			_, err := cp.CancelDelayedEvent(ctx, &proxypb.CancelDelayedEventRequest{EventId: "ut"})
+			So(err, ShouldEqual, errInvalidEventbus)
+			_, err = cp.CancelDelayedEvent(ctx, &proxypb.CancelDelayedEventRequest{Eventbus: "ut"})
+			So(err, ShouldEqual, errInvalidEventID)
+		})
+
+		Convey("test write tombstone", func() {
+			mockClient.EXPECT().Eventbus(gomock.Any(), primitive.TimerTombstoneEventbusName).Return(mockEventbus)
+			mockEventbus.EXPECT().Writer().Return(mockWriter)
+			mockWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ stdCtx.Context, e *v2.Event, _ ...api.WriteOption) (string, error) {
+					So(e.Type(), ShouldEqual, tombstoneType)
+					So(e.Extensions()[primitive.XVanusEventbus], ShouldEqual, "ut-eb")
+					So(e.Extensions()[primitive.XVanusTimerEventID], ShouldEqual, "ut-id")
+					So(e.Extensions(), ShouldNotContainKey, primitive.XVanusDeliveryTime)
+					return "", nil
+				})
+			_, err := cp.CancelDelayedEvent(ctx, &proxypb.CancelDelayedEventRequest{
+				Eventbus: "ut-eb",
+				EventId:  "ut-id",
+			})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test write tombstone failed", func() {
+			mockClient.EXPECT().Eventbus(gomock.Any(), primitive.TimerTombstoneEventbusName).Return(mockEventbus)
+			mockEventbus.EXPECT().Writer().Return(mockWriter)
+			mockWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).Return("", fmt.Errorf("test error"))
+			_, err := cp.CancelDelayedEvent(ctx, &proxypb.CancelDelayedEventRequest{
+				Eventbus: "ut-eb",
+				EventId:  "ut-id",
+			})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestControllerProxy_RescheduleDelayedEvent(t *testing.T) {
+	Convey("test reschedule delayed event", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockWriter := api.NewMockBusWriter(ctrl)
+		cp := &ControllerProxy{client: mockClient}
+		ctx := stdCtx.Background()
+
+		Convey("test invalid delivery time", func() {
+			_, err := cp.RescheduleDelayedEvent(ctx, &proxypb.RescheduleDelayedEventRequest{
+				Eventbus:     "ut-eb",
+				EventId:      "ut-id",
+				DeliveryTime: "tomorrow",
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("test write tombstone", func() {
+			mockClient.EXPECT().Eventbus(gomock.Any(), primitive.TimerTombstoneEventbusName).Return(mockEventbus)
+			mockEventbus.EXPECT().Writer().Return(mockWriter)
+			mockWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ stdCtx.Context, e *v2.Event, _ ...api.WriteOption) (string, error) {
+					So(e.Extensions()[primitive.XVanusTimerEventID], ShouldEqual, "ut-id")
+					So(e.Extensions()[primitive.XVanusDeliveryTime], ShouldEqual, "2022-11-24T11:20:56Z")
+					return "", nil
+				})
+			_, err := cp.RescheduleDelayedEvent(ctx, &proxypb.RescheduleDelayedEventRequest{
+				Eventbus:     "ut-eb",
+				EventId:      "ut-id",
+				DeliveryTime: "2022-11-24T11:20:56Z",
+			})
+			So(err, ShouldBeNil)
+		})
+	})
+}
@@ -39,6 +39,13 @@ func (cp *ControllerProxy) DeleteEventBus(ctx context.Context,
 
 func (cp *ControllerProxy) GetEventBus(ctx context.Context,
 	req *metapb.EventBus) (*metapb.EventBus, error) {
+	peer, err := cp.peerProxy(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if peer != nil {
+		return peer.GetEventBus(federatedContext(ctx), req)
+	}
 	return cp.eventbusCtrl.GetEventBus(ctx, req)
 }
 
@@ -57,6 +64,76 @@ func (cp *ControllerProxy) ListSegment(ctx context.Context,
 	return cp.eventlogCtrl.ListSegment(ctx, req)
 }
 
+func (cp *ControllerProxy) CreateCronEvent(ctx context.Context,
+	req *ctrlpb.CreateCronEventRequest) (*ctrlpb.CronEvent, error) {
+	return cp.eventbusCtrl.CreateCronEvent(ctx, req)
+}
+
+func (cp *ControllerProxy) ListCronEvent(ctx context.Context,
+	req *ctrlpb.ListCronEventRequest) (*ctrlpb.ListCronEventResponse, error) {
+	return cp.eventbusCtrl.ListCronEvent(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteCronEvent(ctx context.Context,
+	req *ctrlpb.DeleteCronEventRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteCronEvent(ctx, req)
+}
+
+func (cp *ControllerProxy) ListTimerReplica(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListTimerReplicaResponse, error) {
+	return cp.eventbusCtrl.ListTimerReplica(ctx, req)
+}
+
+func (cp *ControllerProxy) GetClusterStats(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ClusterStats, error) {
+	return cp.eventbusCtrl.GetClusterStats(ctx, req)
+}
+
+func (cp *ControllerProxy) CreateReplayJob(ctx context.Context,
+	req *ctrlpb.CreateReplayJobRequest) (*ctrlpb.ReplayJob, error) {
+	return cp.eventbusCtrl.CreateReplayJob(ctx, req)
+}
+
+func (cp *ControllerProxy) GetReplayJob(ctx context.Context,
+	req *ctrlpb.GetReplayJobRequest) (*ctrlpb.ReplayJob, error) {
+	return cp.eventbusCtrl.GetReplayJob(ctx, req)
+}
+
+func (cp *ControllerProxy) ListReplayJob(ctx context.Context,
+	req *ctrlpb.ListReplayJobRequest) (*ctrlpb.ListReplayJobResponse, error) {
+	return cp.eventbusCtrl.ListReplayJob(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteReplayJob(ctx context.Context,
+	req *ctrlpb.DeleteReplayJobRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteReplayJob(ctx, req)
+}
+
+func (cp *ControllerProxy) CreateHTTPPoller(ctx context.Context,
+	req *ctrlpb.CreateHTTPPollerRequest) (*ctrlpb.HTTPPoller, error) {
+	return cp.eventbusCtrl.CreateHTTPPoller(ctx, req)
+}
+
+func (cp *ControllerProxy) ListHTTPPoller(ctx context.Context,
+	req *ctrlpb.ListHTTPPollerRequest) (*ctrlpb.ListHTTPPollerResponse, error) {
+	return cp.eventbusCtrl.ListHTTPPoller(ctx, req)
+}
+
+func (cp *ControllerProxy) PauseHTTPPoller(ctx context.Context,
+	req *ctrlpb.PauseHTTPPollerRequest) (*ctrlpb.HTTPPoller, error) {
+	return cp.eventbusCtrl.PauseHTTPPoller(ctx, req)
+}
+
+func (cp *ControllerProxy) ResumeHTTPPoller(ctx context.Context,
+	req *ctrlpb.ResumeHTTPPollerRequest) (*ctrlpb.HTTPPoller, error) {
+	return cp.eventbusCtrl.ResumeHTTPPoller(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteHTTPPoller(ctx context.Context,
+	req *ctrlpb.DeleteHTTPPollerRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteHTTPPoller(ctx, req)
+}
+
 func (cp *ControllerProxy) CreateSubscription(ctx context.Context,
 	req *ctrlpb.CreateSubscriptionRequest) (*metapb.Subscription, error) {
 	return cp.triggerCtrl.CreateSubscription(ctx, req)
@@ -81,3 +158,98 @@ func (cp *ControllerProxy) ListSubscription(ctx context.Context,
 	req *emptypb.Empty) (*ctrlpb.ListSubscriptionResponse, error) {
 	return cp.triggerCtrl.ListSubscription(ctx, req)
 }
+
+func (cp *ControllerProxy) ListTriggerWorker(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListTriggerWorkerResponse, error) {
+	return cp.triggerCtrl.ListTriggerWorker(ctx, req)
+}
+
+func (cp *ControllerProxy) ExportSubscription(ctx context.Context,
+	req *ctrlpb.ExportSubscriptionRequest) (*ctrlpb.ExportSubscriptionResponse, error) {
+	return cp.triggerCtrl.ExportSubscription(ctx, req)
+}
+
+func (cp *ControllerProxy) ImportSubscription(ctx context.Context,
+	req *ctrlpb.ImportSubscriptionRequest) (*metapb.Subscription, error) {
+	return cp.triggerCtrl.ImportSubscription(ctx, req)
+}
+
+func (cp *ControllerProxy) PutSecret(ctx context.Context,
+	req *ctrlpb.PutSecretRequest) (*ctrlpb.Secret, error) {
+	return cp.triggerCtrl.PutSecret(ctx, req)
+}
+
+func (cp *ControllerProxy) ListSecret(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListSecretResponse, error) {
+	return cp.triggerCtrl.ListSecret(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteSecret(ctx context.Context,
+	req *ctrlpb.DeleteSecretRequest) (*emptypb.Empty, error) {
+	return cp.triggerCtrl.DeleteSecret(ctx, req)
+}
+
+func (cp *ControllerProxy) PutEventbusACL(ctx context.Context,
+	req *ctrlpb.EventbusACL) (*ctrlpb.EventbusACL, error) {
+	return cp.eventbusCtrl.PutEventbusACL(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteEventbusACL(ctx context.Context,
+	req *ctrlpb.DeleteEventbusACLRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteEventbusACL(ctx, req)
+}
+
+func (cp *ControllerProxy) ListEventbusACL(ctx context.Context,
+	req *ctrlpb.ListEventbusACLRequest) (*ctrlpb.ListEventbusACLResponse, error) {
+	return cp.eventbusCtrl.ListEventbusACL(ctx, req)
+}
+
+func (cp *ControllerProxy) PutIPAllowlist(ctx context.Context,
+	req *ctrlpb.IPAllowlist) (*ctrlpb.IPAllowlist, error) {
+	return cp.eventbusCtrl.PutIPAllowlist(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteIPAllowlist(ctx context.Context,
+	req *ctrlpb.DeleteIPAllowlistRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteIPAllowlist(ctx, req)
+}
+
+func (cp *ControllerProxy) ListIPAllowlist(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListIPAllowlistResponse, error) {
+	return cp.eventbusCtrl.ListIPAllowlist(ctx, req)
+}
+
+func (cp *ControllerProxy) PutFeatureFlag(ctx context.Context,
+	req *ctrlpb.FeatureFlag) (*ctrlpb.FeatureFlag, error) {
+	return cp.eventbusCtrl.PutFeatureFlag(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteFeatureFlag(ctx context.Context,
+	req *ctrlpb.DeleteFeatureFlagRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteFeatureFlag(ctx, req)
+}
+
+func (cp *ControllerProxy) ListFeatureFlag(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListFeatureFlagResponse, error) {
+	return cp.eventbusCtrl.ListFeatureFlag(ctx, req)
+}
+
+func (cp *ControllerProxy) PutConnector(ctx context.Context,
+	req *ctrlpb.Connector) (*ctrlpb.Connector, error) {
+	return cp.triggerCtrl.PutConnector(ctx, req)
+}
+
+func (cp *ControllerProxy) GetConnector(ctx context.Context,
+	req *ctrlpb.GetConnectorRequest) (*ctrlpb.Connector, error) {
+	return cp.triggerCtrl.GetConnector(ctx, req)
+}
+
+func (cp *ControllerProxy) ListConnector(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListConnectorResponse, error) {
+	return cp.triggerCtrl.ListConnector(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteConnector(ctx context.Context,
+	req *ctrlpb.DeleteConnectorRequest) (*emptypb.Empty, error) {
+	return cp.triggerCtrl.DeleteConnector(ctx, req)
+}
@@ -29,10 +29,54 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		eventbusCtrl.EXPECT().DeleteEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().GetEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().ListEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().CreateCronEvent(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListCronEvent(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().DeleteCronEvent(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListTimerReplica(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().CreateReplayJob(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().GetReplayJob(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListReplayJob(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().DeleteReplayJob(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().CreateHTTPPoller(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListHTTPPoller(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().PauseHTTPPoller(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ResumeHTTPPoller(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().DeleteHTTPPoller(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().PutEventbusACL(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().DeleteEventbusACL(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListEventbusACL(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().PutIPAllowlist(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().DeleteIPAllowlist(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListIPAllowlist(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().PutFeatureFlag(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().DeleteFeatureFlag(gomock.Any(), gomock.Any()).Times(1)
+		eventbusCtrl.EXPECT().ListFeatureFlag(gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateEventBus(stdCtx.Background(), &ctrlpb.CreateEventBusRequest{})
 		_, _ = cp.DeleteEventBus(stdCtx.Background(), &metapb.EventBus{})
 		_, _ = cp.GetEventBus(stdCtx.Background(), &metapb.EventBus{})
 		_, _ = cp.ListEventBus(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.CreateCronEvent(stdCtx.Background(), &ctrlpb.CreateCronEventRequest{})
+		_, _ = cp.ListCronEvent(stdCtx.Background(), &ctrlpb.ListCronEventRequest{})
+		_, _ = cp.DeleteCronEvent(stdCtx.Background(), &ctrlpb.DeleteCronEventRequest{})
+		_, _ = cp.ListTimerReplica(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.CreateReplayJob(stdCtx.Background(), &ctrlpb.CreateReplayJobRequest{})
+		_, _ = cp.GetReplayJob(stdCtx.Background(), &ctrlpb.GetReplayJobRequest{})
+		_, _ = cp.ListReplayJob(stdCtx.Background(), &ctrlpb.ListReplayJobRequest{})
+		_, _ = cp.DeleteReplayJob(stdCtx.Background(), &ctrlpb.DeleteReplayJobRequest{})
+		_, _ = cp.CreateHTTPPoller(stdCtx.Background(), &ctrlpb.CreateHTTPPollerRequest{})
+		_, _ = cp.ListHTTPPoller(stdCtx.Background(), &ctrlpb.ListHTTPPollerRequest{})
+		_, _ = cp.PauseHTTPPoller(stdCtx.Background(), &ctrlpb.PauseHTTPPollerRequest{})
+		_, _ = cp.ResumeHTTPPoller(stdCtx.Background(), &ctrlpb.ResumeHTTPPollerRequest{})
+		_, _ = cp.DeleteHTTPPoller(stdCtx.Background(), &ctrlpb.DeleteHTTPPollerRequest{})
+		_, _ = cp.PutEventbusACL(stdCtx.Background(), &ctrlpb.EventbusACL{})
+		_, _ = cp.DeleteEventbusACL(stdCtx.Background(), &ctrlpb.DeleteEventbusACLRequest{})
+		_, _ = cp.ListEventbusACL(stdCtx.Background(), &ctrlpb.ListEventbusACLRequest{})
+		_, _ = cp.PutIPAllowlist(stdCtx.Background(), &ctrlpb.IPAllowlist{})
+		_, _ = cp.DeleteIPAllowlist(stdCtx.Background(), &ctrlpb.DeleteIPAllowlistRequest{})
+		_, _ = cp.ListIPAllowlist(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.PutFeatureFlag(stdCtx.Background(), &ctrlpb.FeatureFlag{})
+		_, _ = cp.DeleteFeatureFlag(stdCtx.Background(), &ctrlpb.DeleteFeatureFlagRequest{})
+		_, _ = cp.ListFeatureFlag(stdCtx.Background(), &emptypb.Empty{})
 		_, err := cp.UpdateEventBus(stdCtx.Background(), &ctrlpb.UpdateEventBusRequest{})
 		So(err, ShouldEqual, errMethodNotImplemented)
 
@@ -48,10 +92,22 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		triggerCtrl.EXPECT().DeleteSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		triggerCtrl.EXPECT().GetSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		triggerCtrl.EXPECT().ListSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().ListTriggerWorker(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().ExportSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().ImportSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().PutSecret(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().ListSecret(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		triggerCtrl.EXPECT().DeleteSecret(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateSubscription(stdCtx.Background(), &ctrlpb.CreateSubscriptionRequest{})
 		_, _ = cp.UpdateSubscription(stdCtx.Background(), &ctrlpb.UpdateSubscriptionRequest{})
 		_, _ = cp.DeleteSubscription(stdCtx.Background(), &ctrlpb.DeleteSubscriptionRequest{})
 		_, _ = cp.GetSubscription(stdCtx.Background(), &ctrlpb.GetSubscriptionRequest{})
 		_, _ = cp.ListSubscription(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.ListTriggerWorker(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.ExportSubscription(stdCtx.Background(), &ctrlpb.ExportSubscriptionRequest{})
+		_, _ = cp.ImportSubscription(stdCtx.Background(), &ctrlpb.ImportSubscriptionRequest{})
+		_, _ = cp.PutSecret(stdCtx.Background(), &ctrlpb.PutSecretRequest{})
+		_, _ = cp.ListSecret(stdCtx.Background(), &emptypb.Empty{})
+		_, _ = cp.DeleteSecret(stdCtx.Background(), &ctrlpb.DeleteSecretRequest{})
 	})
 }
@@ -0,0 +1,166 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// FederatedHeader marks the requests forwarded by the gateway of a peer cluster, they're always served
+// by the local cluster, so that the inconsistent federation maps of clusters can't forward in a loop.
+const FederatedHeader = "X-Vanus-Federated"
+
+// the gRPC metadata key of FederatedHeader, the keys of metadata are lowercase.
+const federatedMetadataKey = "x-vanus-federated"
+
+// FederationConfig makes the gateway a single endpoint of several clusters, the requests of the
+// eventbuses owned by a peer cluster are forwarded to the gateway of that cluster.
+type FederationConfig struct {
+	// Clusters maps the name of peer cluster to the address of its gateway, which is the host and
+	// the proxy port.
+	Clusters map[string]string
+	// Eventbuses maps the eventbus owned by a peer cluster to the name of cluster.
+	Eventbuses map[string]string
+}
+
+type Federation struct {
+	cfg         FederationConfig
+	credentials credentials.TransportCredentials
+	// address of peer gateway -> connection
+	conns map[string]*grpc.ClientConn
+	mu    sync.Mutex
+}
+
+func NewFederation(cfg FederationConfig, credentials credentials.TransportCredentials) *Federation {
+	return &Federation{
+		cfg:         cfg,
+		credentials: credentials,
+		conns:       map[string]*grpc.ClientConn{},
+	}
+}
+
+func (f *Federation) Validate() error {
+	for eventbus, cluster := range f.cfg.Eventbuses {
+		addr, ok := f.cfg.Clusters[cluster]
+		if !ok {
+			return fmt.Errorf("the cluster %s of eventbus %s isn't configured", cluster, eventbus)
+		}
+		if _, err := CloudEventsAddress(addr); err != nil {
+			return fmt.Errorf("invalid gateway address of cluster %s: %w", cluster, err)
+		}
+	}
+	return nil
+}
+
+// Owner returns the address of gateway of the peer cluster owning the eventbus, it returns false if
+// the eventbus is owned by the local cluster.
+func (f *Federation) Owner(eventbus string) (string, bool) {
+	if f == nil {
+		return "", false
+	}
+	cluster, ok := f.cfg.Eventbuses[eventbus]
+	if !ok {
+		return "", false
+	}
+	addr, ok := f.cfg.Clusters[cluster]
+	return addr, ok
+}
+
+// peer returns the connection to the gateway of the peer cluster owning the eventbus, it returns nil
+// if the eventbus is owned by the local cluster or the request has been forwarded by a peer.
+func (f *Federation) peer(ctx context.Context, eventbus string) (*grpc.ClientConn, error) {
+	addr, ok := f.Owner(eventbus)
+	if !ok || isFederated(ctx) {
+		return nil, nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if conn, ok := f.conns[addr]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(f.credentials))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("connect to gateway of peer cluster failed").Wrap(err)
+	}
+	f.conns[addr] = conn
+	return conn, nil
+}
+
+func (f *Federation) Close() {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for addr, conn := range f.conns {
+		_ = conn.Close()
+		delete(f.conns, addr)
+	}
+}
+
+// CloudEventsAddress returns the address of CloudEvents receiver of the gateway, whose port is next to
+// the proxy port.
+func CloudEventsAddress(addr string) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, strconv.Itoa(p+1)), nil
+}
+
+func isFederated(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(federatedMetadataKey)) > 0
+}
+
+func federatedContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, federatedMetadataKey, "true")
+}
+
+// peerCloudEvents returns the CloudEvents client of the peer cluster owning the eventbus, it's nil if
+// the eventbus is owned by the local cluster.
+func (cp *ControllerProxy) peerCloudEvents(ctx context.Context,
+	eventbus string) (cloudevents.CloudEventsClient, error) {
+	conn, err := cp.federation.peer(ctx, eventbus)
+	if conn == nil {
+		return nil, err
+	}
+	return cloudevents.NewCloudEventsClient(conn), nil
+}
+
+// peerProxy returns the proxy client of the peer cluster owning the eventbus, it's nil if the
+// eventbus is owned by the local cluster.
+func (cp *ControllerProxy) peerProxy(ctx context.Context, eventbus string) (proxypb.ControllerProxyClient, error) {
+	conn, err := cp.federation.peer(ctx, eventbus)
+	if conn == nil {
+		return nil, err
+	}
+	return proxypb.NewControllerProxyClient(conn), nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"net"
+	"testing"
+
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	cloudeventsv2 "github.com/linkall-labs/vanus/proto/pkg/v2/cloudevents"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type peerProxyServer struct {
+	proxypb.UnimplementedControllerProxyServer
+	cloudevents.UnimplementedCloudEventsServer
+	federated chan bool
+}
+
+func (s *peerProxyServer) GetEventBus(ctx stdCtx.Context, req *metapb.EventBus) (*metapb.EventBus, error) {
+	s.federated <- isFederated(ctx)
+	return &metapb.EventBus{Name: req.Name, Id: 1}, nil
+}
+
+func (s *peerProxyServer) Send(ctx stdCtx.Context,
+	batch *cloudevents.BatchEvent) (*cloudevents.SendResponse, error) {
+	s.federated <- isFederated(ctx)
+	ids := make([]string, len(batch.GetEvents().GetEvents()))
+	for i, e := range batch.GetEvents().GetEvents() {
+		ids[i] = e.Id
+	}
+	return &cloudevents.SendResponse{EventlogId: 2, Offset: 3, EventIds: ids}, nil
+}
+
+func TestFederation(t *testing.T) {
+	Convey("test federation", t, func() {
+		cfg := FederationConfig{
+			Clusters:   map[string]string{"b": "127.0.0.1:8080"},
+			Eventbuses: map[string]string{"remote": "b"},
+		}
+		f := NewFederation(cfg, insecure.NewCredentials())
+		So(f.Validate(), ShouldBeNil)
+		addr, ok := f.Owner("remote")
+		So(ok, ShouldBeTrue)
+		So(addr, ShouldEqual, "127.0.0.1:8080")
+		_, ok = f.Owner("local")
+		So(ok, ShouldBeFalse)
+		_, ok = (*Federation)(nil).Owner("remote")
+		So(ok, ShouldBeFalse)
+
+		addr, err := CloudEventsAddress("127.0.0.1:8080")
+		So(err, ShouldBeNil)
+		So(addr, ShouldEqual, "127.0.0.1:8081")
+		_, err = CloudEventsAddress("127.0.0.1")
+		So(err, ShouldNotBeNil)
+
+		cfg.Eventbuses["other"] = "c"
+		So(NewFederation(cfg, insecure.NewCredentials()).Validate(), ShouldNotBeNil)
+		delete(cfg.Eventbuses, "other")
+		cfg.Clusters["b"] = "127.0.0.1"
+		So(NewFederation(cfg, insecure.NewCredentials()).Validate(), ShouldNotBeNil)
+	})
+}
+
+func TestControllerProxy_Federation(t *testing.T) {
+	Convey("test forward requests to the peer cluster", t, func() {
+		ls, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		peer := &peerProxyServer{federated: make(chan bool, 1)}
+		srv := grpc.NewServer()
+		proxypb.RegisterControllerProxyServer(srv, peer)
+		cloudevents.RegisterCloudEventsServer(srv, peer)
+		go func() {
+			_ = srv.Serve(ls)
+		}()
+		defer srv.Stop()
+
+		cp := NewControllerProxy(Config{
+			Endpoints:   []string{"127.0.0.1:20001"},
+			Credentials: insecure.NewCredentials(),
+			Federation: FederationConfig{
+				Clusters:   map[string]string{"b": ls.Addr().String()},
+				Eventbuses: map[string]string{"remote": "b"},
+			},
+		})
+		defer cp.Stop()
+		ctx := stdCtx.Background()
+
+		bus, err := cp.GetEventBus(ctx, &metapb.EventBus{Name: "remote"})
+		So(err, ShouldBeNil)
+		So(bus.Id, ShouldEqual, 1)
+		So(<-peer.federated, ShouldBeTrue)
+
+		res, err := cp.Send(ctx, &cloudevents.BatchEvent{
+			EventbusName: "remote",
+			Events:       &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{{Id: "1"}}},
+		})
+		So(err, ShouldBeNil)
+		So(res.EventlogId, ShouldEqual, 2)
+		So(<-peer.federated, ShouldBeTrue)
+
+		// the v2 publish is forwarded to the peer by v1.
+		published, err := cp.Publish(ctx, &cloudeventsv2.PublishRequest{
+			Eventbus: "remote",
+			Events: &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{
+				{Id: "1"}, {Id: "2"},
+			}},
+		})
+		So(err, ShouldBeNil)
+		So(published.Results, ShouldHaveLength, 2)
+		So(published.Results[1].EventId, ShouldEqual, "2")
+		So(published.Results[1].EventlogId, ShouldEqual, 2)
+		So(published.Results[1].Offset, ShouldEqual, 4)
+		So(<-peer.federated, ShouldBeTrue)
+
+		// the request forwarded by a peer is served locally.
+		conn, err := cp.federation.peer(metadata.NewIncomingContext(ctx,
+			metadata.Pairs(federatedMetadataKey, "true")), "remote")
+		So(err, ShouldBeNil)
+		So(conn, ShouldBeNil)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/primitive/profiling"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	componentGateway    = "gateway"
+	componentController = "controller"
+)
+
+// CaptureProfile captures a profile of the gateway itself or forwards the request to the
+// component which has the ProfilingServer.
+func (cp *ControllerProxy) CaptureProfile(ctx context.Context,
+	req *proxypb.CaptureProfileRequest) (*ctrlpb.CaptureProfileResponse, error) {
+	in := &ctrlpb.CaptureProfileRequest{
+		Type:    req.GetType(),
+		Seconds: req.GetSeconds(),
+	}
+	if req.GetComponent() == componentGateway {
+		return profiling.NewServer().CaptureProfile(ctx, in)
+	}
+
+	addr, err := cp.findComponent(ctx, req.GetComponent())
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(cp.cfg.Credentials))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("connect to component failed").Wrap(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	return ctrlpb.NewProfilingServerClient(conn).CaptureProfile(ctx, in)
+}
+
+// findComponent returns the address of the component, it's the leader controller, or a segment server
+// or a trigger worker whose address or hostname is equal to the name.
+func (cp *ControllerProxy) findComponent(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", errors.ErrInvalidRequest.WithMessage("component is empty")
+	}
+	stats, err := cp.eventbusCtrl.GetClusterStats(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", err
+	}
+	if name == componentController {
+		if stats.GetControllerLeader() == "" {
+			return "", errors.ErrNoControllerLeader
+		}
+		return stats.GetControllerLeader(), nil
+	}
+	for _, ss := range stats.GetSegmentServers() {
+		if matchComponent(ss.GetAddress(), name) {
+			return ss.GetAddress(), nil
+		}
+	}
+	res, err := cp.triggerCtrl.ListTriggerWorker(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", err
+	}
+	for _, tw := range res.GetTriggerWorker() {
+		if matchComponent(tw.GetAddress(), name) {
+			return tw.GetAddress(), nil
+		}
+	}
+	return "", errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("the component %s isn't found", name))
+}
+
+func matchComponent(addr, name string) bool {
+	if addr == name {
+		return true
+	}
+	host := addr
+	if idx := strings.LastIndex(host, ":"); idx >= 0 {
+		host = host[:idx]
+	}
+	if host == name {
+		return true
+	}
+	// such as vanus-store-0.vanus-store.vanus.svc.cluster.local
+	return strings.HasPrefix(host, name+".")
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestControllerProxy_CaptureProfile(t *testing.T) {
+	Convey("test capture profile", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints:              []string{"127.0.0.1:20001"},
+			ProxyPort:              18082,
+			CloudEventReceiverPort: 18080,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		eventbusCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.eventbusCtrl = eventbusCtrl
+		cp.triggerCtrl = triggerCtrl
+		ctx := stdCtx.Background()
+
+		Convey("test capture profile of gateway", func() {
+			res, err := cp.CaptureProfile(ctx, &proxypb.CaptureProfileRequest{
+				Component: componentGateway,
+				Type:      "goroutine",
+			})
+			So(err, ShouldBeNil)
+			So(res.Data, ShouldNotBeEmpty)
+
+			_, err = cp.CaptureProfile(ctx, &proxypb.CaptureProfileRequest{
+				Component: componentGateway,
+				Type:      "unknown",
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test find component", func() {
+			eventbusCtrl.EXPECT().GetClusterStats(gomock.Any(), gomock.Any()).AnyTimes().Return(
+				&ctrlpb.ClusterStats{
+					ControllerLeader: "vanus-controller-0.vanus-controller:2048",
+					SegmentServers: []*ctrlpb.SegmentServerStats{
+						{Address: "vanus-store-0.vanus-store.vanus.svc:11811"},
+						{Address: "vanus-store-1.vanus-store.vanus.svc:11811"},
+					},
+				}, nil)
+			triggerCtrl.EXPECT().ListTriggerWorker(gomock.Any(), gomock.Any()).AnyTimes().Return(
+				&ctrlpb.ListTriggerWorkerResponse{
+					TriggerWorker: []*ctrlpb.TriggerWorkerInfo{{Address: "10.0.0.1:2148"}},
+				}, nil)
+
+			addr, err := cp.findComponent(ctx, componentController)
+			So(err, ShouldBeNil)
+			So(addr, ShouldEqual, "vanus-controller-0.vanus-controller:2048")
+
+			addr, err = cp.findComponent(ctx, "vanus-store-1")
+			So(err, ShouldBeNil)
+			So(addr, ShouldEqual, "vanus-store-1.vanus-store.vanus.svc:11811")
+
+			addr, err = cp.findComponent(ctx, "10.0.0.1")
+			So(err, ShouldBeNil)
+			So(addr, ShouldEqual, "10.0.0.1:2148")
+
+			addr, err = cp.findComponent(ctx, "10.0.0.1:2148")
+			So(err, ShouldBeNil)
+			So(addr, ShouldEqual, "10.0.0.1:2148")
+
+			_, err = cp.findComponent(ctx, "vanus-store")
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			_, err = cp.findComponent(ctx, "")
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+	})
+}
@@ -19,10 +19,10 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"net/http"
 	"runtime/debug"
-	"strings"
 	"sync"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
@@ -34,17 +34,22 @@ import (
 	"github.com/linkall-labs/vanus/client/pkg/policy"
 	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/filter"
 	"github.com/linkall-labs/vanus/internal/trigger/transform"
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	cloudeventsv2 "github.com/linkall-labs/vanus/proto/pkg/v2/cloudevents"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
@@ -59,6 +64,7 @@ import (
 
 const (
 	maximumNumberPerGetRequest = 64
+	defaultPreviewSampleNumber = 10
 )
 
 var (
@@ -71,6 +77,12 @@ type Config struct {
 	CloudEventReceiverPort int
 	Credentials            credentials.TransportCredentials
 	GRPCReflectionEnable   bool
+	Federation             FederationConfig
+	ACL                    ACLConfig
+	IPAllowlist            IPAllowlistConfig
+	FeatureFlags           featureflag.Config
+	// QUIC serves the proxy over QUIC besides TCP.
+	QUIC QUICConfig
 }
 
 var (
@@ -86,29 +98,51 @@ type ControllerProxy struct {
 	triggerCtrl  ctrlpb.TriggerControllerClient
 	grpcSrv      *grpc.Server
 	ctrl         cluster.Cluster
+	federation   *Federation
+	acl          *Authorizer
+	allowlist    *IPAllowlist
+	reserved     *primitive.ReservedAttributes
+	features     *featureflag.Registry
 }
 
-func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEvent) (*emptypb.Empty, error) {
+// Send is the v1 of publish API, which is kept for the SDKs don't support v2.
+func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEvent) (*cloudevents.SendResponse, error) {
 	_ctx, span := cp.tracer.Start(ctx, "Send")
 	defer span.End()
+	return cp.publish(_ctx, batch.GetEventbusName(), batch.GetEvents())
+}
 
-	if batch.EventbusName == "" {
+// publish appends the events to the eventbus, or forwards them to the peer cluster owning it by v1 so
+// that the peers of older versions are supported. It's shared by all versions of publish API.
+func (cp *ControllerProxy) publish(ctx context.Context, eventbus string,
+	events *cloudevents.CloudEventBatch) (*cloudevents.SendResponse, error) {
+	if eventbus == "" {
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid eventbus name")
 	}
+	peer, err := cp.peerCloudEvents(ctx, eventbus)
+	if err != nil {
+		return nil, err
+	}
+	if peer != nil {
+		return peer.Send(federatedContext(ctx), &cloudevents.BatchEvent{EventbusName: eventbus, Events: events})
+	}
+	if err = cp.authorize(ctx, eventbus, acl.PermissionPublish); err != nil {
+		return nil, err
+	}
 
-	for idx := range batch.Events.Events {
-		e := batch.Events.Events[idx]
-		err := checkExtension(e.Attributes)
+	for idx := range events.GetEvents() {
+		e := events.Events[idx]
+		err := cp.checkExtension(e.Attributes)
 		if err != nil {
 			return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
 		}
 		e.Attributes[primitive.XVanusEventbus] = &cloudevents.CloudEvent_CloudEventAttributeValue{
-			Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: batch.EventbusName},
+			Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: eventbus},
 		}
 		if eventTime, ok := e.Attributes[primitive.XVanusDeliveryTime]; ok {
 			// validate event time
 			if _, err := types.ParseTime(eventTime.String()); err != nil {
-				log.Error(_ctx, "invalid format of event time", map[string]interface{}{
+				log.Error(ctx, "invalid format of event time", map[string]interface{}{
 					log.KeyError: err,
 					"eventTime":  eventTime.String(),
 				})
@@ -119,29 +153,33 @@ func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEve
 		}
 	}
 
-	err := cp.client.Eventbus(ctx, batch.GetEventbusName()).Writer().AppendBatch(_ctx, batch.GetEvents())
+	p, err := cp.client.Eventbus(ctx, eventbus).Writer().AppendBatch(ctx, events)
 	if err != nil {
-		log.Warning(_ctx, "append to failed", map[string]interface{}{
+		log.Warning(ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
-			"eventbus":   batch.EventbusName,
+			"eventbus":   eventbus,
 		})
 		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
 	}
 
-	return &emptypb.Empty{}, nil
+	eventIDs := make([]string, len(events.Events))
+	for i := range eventIDs {
+		eventIDs[i] = p.EventID(i)
+	}
+	return &cloudevents.SendResponse{
+		EventlogId: p.EventlogID,
+		Offset:     p.Offset,
+		Stime:      p.Stime,
+		EventIds:   eventIDs,
+	}, nil
 }
 
-func checkExtension(extensions map[string]*cloudevents.CloudEvent_CloudEventAttributeValue) error {
-	if len(extensions) == 0 {
-		return nil
-	}
+// checkExtension rejects the event whose extension attributes are reserved for system use.
+func (cp *ControllerProxy) checkExtension(
+	extensions map[string]*cloudevents.CloudEvent_CloudEventAttributeValue) error {
 	for name := range extensions {
-		if name == primitive.XVanusDeliveryTime {
-			continue
-		}
-		// event attribute can not prefix with vanus system use
-		if strings.HasPrefix(name, primitive.XVanus) {
-			return fmt.Errorf("invalid ce attribute [%s] perfix %s", name, primitive.XVanus)
+		if err := cp.reserved.Check(name); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -157,9 +195,35 @@ func NewControllerProxy(cfg Config) *ControllerProxy {
 		eventbusCtrl: ctrl.EventbusService().RawClient(),
 		eventlogCtrl: ctrl.EventlogService().RawClient(),
 		triggerCtrl:  ctrl.TriggerService().RawClient(),
+		federation:   NewFederation(cfg.Federation, cfg.Credentials),
+		acl:          NewAuthorizer(cfg.ACL, ctrl.EventbusService().RawClient()),
+		allowlist:    NewIPAllowlist(cfg.IPAllowlist, ctrl.EventbusService().RawClient()),
+		reserved:     primitive.GetReservedAttributes(),
+		features:     featureflag.NewRegistry(cfg.FeatureFlags, ctrl.EventbusService().RawClient()),
 	}
 }
 
+// Authorizer returns the authorizer shared with the HTTP receivers of gateway, it's nil if ACL isn't enabled.
+func (cp *ControllerProxy) Authorizer() *Authorizer {
+	return cp.acl
+}
+
+// EventbusController returns the controller client of eventbus, the REST consumers of gateway commit
+// offsets by it.
+func (cp *ControllerProxy) EventbusController() ctrlpb.EventBusControllerClient {
+	return cp.eventbusCtrl
+}
+
+// IPAllowlist returns the IP allowlist shared with the HTTP receivers of gateway, it's nil if it isn't enabled.
+func (cp *ControllerProxy) IPAllowlist() *IPAllowlist {
+	return cp.allowlist
+}
+
+// authorize checks the permission of the principal authenticated by the interceptor of Authorizer.
+func (cp *ControllerProxy) authorize(ctx context.Context, eventbus string, perm acl.Permission) error {
+	return cp.acl.Authorize(ctx, eventbus, principalFromContext(ctx), perm)
+}
+
 func (cp *ControllerProxy) Start() error {
 	recoveryOpt := recovery.WithRecoveryHandlerContext(
 		func(ctx context.Context, p interface{}) error {
@@ -180,7 +244,9 @@ func (cp *ControllerProxy) Start() error {
 		grpc.ChainUnaryInterceptor(
 			errinterceptor.UnaryServerInterceptor(),
 			recovery.UnaryServerInterceptor(recoveryOpt),
+			cp.allowlist.UnaryServerInterceptor(),
 			otelgrpc.UnaryServerInterceptor(),
+			cp.acl.UnaryServerInterceptor(),
 		),
 	)
 
@@ -191,6 +257,11 @@ func (cp *ControllerProxy) Start() error {
 
 	proxypb.RegisterControllerProxyServer(cp.grpcSrv, cp)
 	cloudevents.RegisterCloudEventsServer(cp.grpcSrv, cp)
+	cloudeventsv2.RegisterCloudEventsServer(cp.grpcSrv, cp)
+	health.RegisterGRPC(cp.grpcSrv)
+	health.AddReadinessCheck("controller", health.Condition(func() bool {
+		return cp.ctrl.IsReady(false)
+	}))
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", cp.cfg.ProxyPort))
 	if err != nil {
@@ -207,6 +278,9 @@ func (cp *ControllerProxy) Start() error {
 		wg.Done()
 	}()
 	log.Info(context.Background(), "the grpc proxy ready to work", nil)
+	if cp.cfg.QUIC.Enable {
+		return cp.serveQUIC()
+	}
 	return nil
 }
 
@@ -214,6 +288,7 @@ func (cp *ControllerProxy) Stop() {
 	if cp.grpcSrv != nil {
 		cp.grpcSrv.GracefulStop()
 	}
+	cp.federation.Close()
 }
 
 func (cp *ControllerProxy) ClusterInfo(_ context.Context, _ *emptypb.Empty) (*proxypb.ClusterInfoResponse, error) {
@@ -225,6 +300,13 @@ func (cp *ControllerProxy) ClusterInfo(_ context.Context, _ *emptypb.Empty) (*pr
 
 func (cp *ControllerProxy) LookupOffset(ctx context.Context,
 	req *proxypb.LookupOffsetRequest) (*proxypb.LookupOffsetResponse, error) {
+	peer, err := cp.peerProxy(ctx, req.GetEventbus())
+	if err != nil {
+		return nil, err
+	}
+	if peer != nil {
+		return peer.LookupOffset(federatedContext(ctx), req)
+	}
 	elList := make([]api.Eventlog, 0)
 	if req.EventlogId > 0 {
 		id := vanus.NewIDFromUint64(req.EventlogId)
@@ -257,11 +339,95 @@ func (cp *ControllerProxy) LookupOffset(ctx context.Context,
 	return res, nil
 }
 
+// LookupEventlog returns the route of each eventlog, with which clients append to the leader of
+// appendable segment directly. The epoch is the digest of routes, so that clients polling with
+// the epoch of cached routes can tell whether they are stale cheaply.
+func (cp *ControllerProxy) LookupEventlog(ctx context.Context,
+	req *proxypb.LookupEventlogRequest) (*proxypb.LookupEventlogResponse, error) {
+	if req.GetEventbus() == "" {
+		return nil, errInvalidEventbus
+	}
+	peer, err := cp.peerProxy(ctx, req.GetEventbus())
+	if err != nil {
+		return nil, err
+	}
+	if peer != nil {
+		return peer.LookupEventlog(federatedContext(ctx), req)
+	}
+	bus, err := cp.eventbusCtrl.GetEventBus(ctx, &metapb.EventBus{Name: req.GetEventbus()})
+	if err != nil {
+		return nil, err
+	}
+	routes := make([]*proxypb.EventlogRoute, 0, len(bus.GetLogs()))
+	for idx, l := range bus.GetLogs() {
+		if req.GetEventlogId() != 0 && l.GetEventLogId() != req.GetEventlogId() {
+			continue
+		}
+		route, err := cp.lookupEventlogRoute(ctx, bus.GetId(), l.GetEventLogId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup eventlog %d: %w", l.GetEventLogId(), err)
+		}
+		route.Partition = int32(idx)
+		routes = append(routes, route)
+	}
+	if len(routes) == 0 {
+		return nil, errors.New("eventlog not found")
+	}
+	epoch := routesEpoch(routes)
+	if req.GetEpoch() == epoch {
+		return &proxypb.LookupEventlogResponse{Epoch: epoch, NotModified: true}, nil
+	}
+	return &proxypb.LookupEventlogResponse{Routes: routes, Epoch: epoch}, nil
+}
+
+func (cp *ControllerProxy) lookupEventlogRoute(ctx context.Context,
+	ebID, logID uint64) (*proxypb.EventlogRoute, error) {
+	res, err := cp.eventlogCtrl.GetAppendableSegment(ctx, &ctrlpb.GetAppendableSegmentRequest{
+		EventBusId: ebID,
+		EventLogId: logID,
+		Limited:    1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	route := &proxypb.EventlogRoute{EventlogId: logID}
+	if len(res.GetSegments()) == 0 {
+		// no appendable segment yet, the first append creates it
+		return route, nil
+	}
+	seg := res.GetSegments()[0]
+	route.SegmentId = seg.GetId()
+	route.StartOffset = seg.GetStartOffsetInLog()
+	route.LeaderBlockId = seg.GetLeaderBlockId()
+	route.Replicas = make(map[uint64]string, len(seg.GetReplicas()))
+	for id, blk := range seg.GetReplicas() {
+		route.Replicas[id] = blk.GetEndpoint()
+	}
+	route.LeaderEndpoint = route.Replicas[seg.GetLeaderBlockId()]
+	return route, nil
+}
+
+func routesEpoch(routes []*proxypb.EventlogRoute) uint64 {
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, r := range routes {
+		for _, v := range []uint64{r.EventlogId, r.SegmentId, r.LeaderBlockId} {
+			binary.BigEndian.PutUint64(buf, v)
+			_, _ = h.Write(buf)
+		}
+		_, _ = h.Write([]byte(r.LeaderEndpoint))
+	}
+	return h.Sum64()
+}
+
 func (cp *ControllerProxy) GetEvent(ctx context.Context,
 	req *proxypb.GetEventRequest) (*proxypb.GetEventResponse, error) {
 	if req.GetEventbus() == "" {
 		return nil, errInvalidEventbus
 	}
+	if err := cp.authorize(ctx, req.GetEventbus(), acl.PermissionSubscribe); err != nil {
+		return nil, err
+	}
 
 	if req.EventId != "" {
 		return cp.getByEventID(ctx, req)
@@ -280,14 +446,27 @@ func (cp *ControllerProxy) GetEvent(ctx context.Context,
 		num = maximumNumberPerGetRequest
 	}
 
-	ls, err := cp.client.Eventbus(ctx, req.GetEventbus()).ListLog(ctx)
-	if err != nil {
-		return nil, err
+	var l api.Eventlog
+	if req.GetEventlogId() > 0 {
+		el, err := cp.client.Eventbus(ctx, req.GetEventbus()).GetLog(ctx, req.GetEventlogId())
+		if err != nil {
+			return nil, err
+		}
+		l = el
+	} else {
+		ls, err := cp.client.Eventbus(ctx, req.GetEventbus()).ListLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(ls) == 0 {
+			return nil, errors.New("eventbus not found")
+		}
+		l = ls[0]
 	}
 
 	events, _, _, err := cp.client.Eventbus(ctx, req.GetEventbus()).Reader(
 		option.WithDisablePolling(),
-		option.WithReadPolicy(policy.NewManuallyReadPolicy(ls[0], offset)),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, offset)),
 		option.WithBatchSize(int(num)),
 	).Read(ctx)
 	if err != nil {
@@ -356,6 +535,82 @@ func (cp *ControllerProxy) ValidateSubscription(ctx context.Context,
 }
 
 // getByEventID why added this? can it be deleted?
+// PreviewSubscription run the filter and transformer of subscription against the latest events,
+// the sink is not invoked.
+func (cp *ControllerProxy) PreviewSubscription(ctx context.Context,
+	req *proxypb.PreviewSubscriptionRequest) (*proxypb.PreviewSubscriptionResponse, error) {
+	num := int64(req.Sample)
+	if num <= 0 {
+		num = defaultPreviewSampleNumber
+	}
+	if num > maximumNumberPerGetRequest {
+		num = maximumNumberPerGetRequest
+	}
+	sub, err := cp.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: req.SubscriptionId})
+	if err != nil {
+		return nil, err
+	}
+	if err = cp.authorize(ctx, sub.EventBus, acl.PermissionSubscribe); err != nil {
+		return nil, err
+	}
+	ls, err := cp.client.Eventbus(ctx, sub.EventBus).ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := convert.FromPbSubscriptionRequest(&ctrlpb.SubscriptionRequest{
+		Filters:     sub.Filters,
+		Transformer: sub.Transformer,
+	})
+	f := filter.GetFilter(s.Filters)
+	t := transform.NewTransformer(s.Transformer)
+	res := &proxypb.PreviewSubscriptionResponse{}
+	for _, l := range ls {
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return nil, err
+		}
+		earliest, err := l.EarliestOffset(ctx)
+		if err != nil {
+			return nil, err
+		}
+		offset := latest - num
+		if offset < earliest {
+			offset = earliest
+		}
+		if offset >= latest {
+			continue
+		}
+		events, _, _, err := cp.client.Eventbus(ctx, sub.EventBus).Reader(
+			option.WithDisablePolling(),
+			option.WithReadPolicy(policy.NewManuallyReadPolicy(l, offset)),
+			option.WithBatchSize(int(latest-offset)),
+		).Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for idx, e := range events {
+			r := &proxypb.PreviewResult{
+				EventlogId: l.ID(),
+				Offset:     offset + int64(idx),
+			}
+			r.Event, _ = e.MarshalJSON()
+			res.Results = append(res.Results, r)
+			if filter.Run(f, *e) == filter.FailFilter {
+				continue
+			}
+			r.FilterResult = true
+			if t != nil {
+				if err = t.Execute(e); err != nil {
+					r.Error = err.Error()
+					continue
+				}
+			}
+			r.TransformerResult, _ = e.MarshalJSON()
+		}
+	}
+	return res, nil
+}
+
 func (cp *ControllerProxy) getByEventID(ctx context.Context,
 	req *proxypb.GetEventRequest) (*proxypb.GetEventResponse, error) {
 	logID, off, err := decodeEventID(req.EventId)
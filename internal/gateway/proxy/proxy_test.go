@@ -177,6 +177,34 @@ func TestControllerProxy_GetEvent(t *testing.T) {
 			}
 		})
 
+		Convey("test get events from specified eventlog", func() {
+			el := api.NewMockEventlog(ctrl)
+			id := vanus.NewTestID().Uint64()
+			utEB1.EXPECT().GetLog(gomock.Any(), id).Times(1).Return(el, nil)
+			utEB1.EXPECT().Reader(gomock.Any()).Times(1).DoAndReturn(func(
+				opts ...api.ReadOption) api.BusReader {
+				opt := &api.ReadOptions{}
+				opt.Apply(opts...)
+				So(opt.Policy, ShouldResemble, policy.NewManuallyReadPolicy(el, 10))
+				So(opt.BatchSize, ShouldEqual, 2)
+				return reader
+			})
+
+			e := v2.NewEvent()
+			e.SetID("ut")
+			e.SetSource("ut")
+			e.SetType("ut")
+			reader.EXPECT().Read(gomock.Any()).Times(1).Return([]*v2.Event{&e}, int64(10), id, nil)
+			res, err := cp.GetEvent(stdCtx.Background(), &proxypb.GetEventRequest{
+				Eventbus:   "ut1",
+				EventlogId: id,
+				Offset:     10,
+				Number:     2,
+			})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+		})
+
 		Convey("test get events by eventID", func() {
 			b := make([]byte, 16)
 			id := vanus.NewTestID().Uint64()
@@ -345,7 +373,7 @@ func TestControllerProxy_ValidateSubscription(t *testing.T) {
 
 			// mock eventbus
 			cli.EXPECT().Eventbus(gomock.Any(), gomock.Any()).Times(2).Return(eb)
-			eb.EXPECT().ListLog(gomock.Any()).Times(1).Return([]api.Eventlog{nil}, nil)
+			eb.EXPECT().GetLog(gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
 			rd := api.NewMockBusReader(ctrl)
 			eb.EXPECT().Reader(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(rd)
 			rd.EXPECT().Read(gomock.Any()).Times(1).Return([]*v2.Event{&e}, int64(0), uint64(0), nil)
@@ -382,3 +410,148 @@ func TestControllerProxy_ValidateSubscription(t *testing.T) {
 		})
 	})
 }
+
+func TestControllerProxy_PreviewSubscription(t *testing.T) {
+	Convey("test PreviewSubscription", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+			CloudEventReceiverPort: 18080,
+			ProxyPort:              18082,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockClient(ctrl)
+		cp.client = cli
+		eb := api.NewMockEventbus(ctrl)
+		mockTriggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = mockTriggerCtrl
+		ctx := stdCtx.Background()
+
+		trans := `{"pipeline":[{"command":["create","$.xvpreview","true"]}]}`
+		var _transformer *primitive.Transformer
+		_ = stdJson.Unmarshal([]byte(trans), &_transformer)
+		mockTriggerCtrl.EXPECT().GetSubscription(ctx, gomock.Any()).Return(&metapb.Subscription{
+			EventBus: "test",
+			Filters: []*metapb.Filter{
+				{Exact: map[string]string{"source": "match"}},
+			},
+			Transformer: convert.ToPbTransformer(_transformer),
+		}, nil)
+		cli.EXPECT().Eventbus(gomock.Any(), "test").AnyTimes().Return(eb)
+		el := api.NewMockEventlog(ctrl)
+		eb.EXPECT().ListLog(gomock.Any()).Return([]api.Eventlog{el}, nil)
+		el.EXPECT().ID().AnyTimes().Return(uint64(1))
+		el.EXPECT().LatestOffset(gomock.Any()).Return(int64(100), nil)
+		el.EXPECT().EarliestOffset(gomock.Any()).Return(int64(0), nil)
+		rd := api.NewMockBusReader(ctrl)
+		eb.EXPECT().Reader(gomock.Any()).DoAndReturn(func(opts ...api.ReadOption) api.BusReader {
+			opt := &api.ReadOptions{}
+			opt.Apply(opts...)
+			So(opt.Policy, ShouldResemble, policy.NewManuallyReadPolicy(el, 98))
+			So(opt.BatchSize, ShouldEqual, 2)
+			return rd
+		})
+		match := v2.NewEvent()
+		match.SetID("1")
+		match.SetSource("match")
+		match.SetType("test")
+		_ = match.SetData(v2.ApplicationJSON, map[string]interface{}{"key": "value"})
+		notMatch := v2.NewEvent()
+		notMatch.SetID("2")
+		notMatch.SetSource("not-match")
+		notMatch.SetType("test")
+		rd.EXPECT().Read(gomock.Any()).Return([]*v2.Event{&match, &notMatch}, int64(98), uint64(1), nil)
+
+		res, err := cp.PreviewSubscription(ctx, &proxypb.PreviewSubscriptionRequest{
+			SubscriptionId: vanus.NewTestID().Uint64(),
+			Sample:         2,
+		})
+		So(err, ShouldBeNil)
+		So(res.Results, ShouldHaveLength, 2)
+		So(res.Results[0].Offset, ShouldEqual, 98)
+		So(res.Results[0].FilterResult, ShouldBeTrue)
+		So(gjson.GetBytes(res.Results[0].TransformerResult, "xvpreview").String(), ShouldEqual, "true")
+		So(gjson.GetBytes(res.Results[0].Event, "xvpreview").Exists(), ShouldBeFalse)
+		So(res.Results[1].Offset, ShouldEqual, 99)
+		So(res.Results[1].FilterResult, ShouldBeFalse)
+		So(res.Results[1].TransformerResult, ShouldBeEmpty)
+	})
+}
+
+func TestControllerProxy_LookupEventlog(t *testing.T) {
+	Convey("test LookupEventlog", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+			CloudEventReceiverPort: 18080,
+			ProxyPort:              18082,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEventbusCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		mockEventlogCtrl := ctrlpb.NewMockEventLogControllerClient(ctrl)
+		cp.eventbusCtrl = mockEventbusCtrl
+		cp.eventlogCtrl = mockEventlogCtrl
+		ctx := stdCtx.Background()
+
+		mockEventbusCtrl.EXPECT().GetEventBus(ctx, gomock.Any()).AnyTimes().Return(&metapb.EventBus{
+			Id:   1,
+			Name: "test",
+			Logs: []*metapb.EventLog{{EventLogId: 2}, {EventLogId: 3}},
+		}, nil)
+		leader := uint64(5)
+		mockEventlogCtrl.EXPECT().GetAppendableSegment(ctx, gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ stdCtx.Context, req *ctrlpb.GetAppendableSegmentRequest,
+				_ ...grpc.CallOption) (*ctrlpb.GetAppendableSegmentResponse, error) {
+				if req.EventLogId == 3 {
+					return &ctrlpb.GetAppendableSegmentResponse{}, nil
+				}
+				return &ctrlpb.GetAppendableSegmentResponse{Segments: []*metapb.Segment{{
+					Id:               4,
+					StartOffsetInLog: 100,
+					LeaderBlockId:    leader,
+					Replicas: map[uint64]*metapb.Block{
+						5: {Id: 5, Endpoint: "127.0.0.1:11811"},
+						6: {Id: 6, Endpoint: "127.0.0.1:11812"},
+					},
+				}}}, nil
+			})
+
+		_, err := cp.LookupEventlog(ctx, &proxypb.LookupEventlogRequest{})
+		So(err, ShouldEqual, errInvalidEventbus)
+
+		res, err := cp.LookupEventlog(ctx, &proxypb.LookupEventlogRequest{Eventbus: "test"})
+		So(err, ShouldBeNil)
+		So(res.NotModified, ShouldBeFalse)
+		So(res.Routes, ShouldHaveLength, 2)
+		So(res.Routes[0].EventlogId, ShouldEqual, 2)
+		So(res.Routes[0].SegmentId, ShouldEqual, 4)
+		So(res.Routes[0].StartOffset, ShouldEqual, 100)
+		So(res.Routes[0].LeaderEndpoint, ShouldEqual, "127.0.0.1:11811")
+		So(res.Routes[0].Replicas, ShouldHaveLength, 2)
+		So(res.Routes[1].Partition, ShouldEqual, 1)
+		So(res.Routes[1].SegmentId, ShouldEqual, 0)
+
+		res2, err := cp.LookupEventlog(ctx, &proxypb.LookupEventlogRequest{Eventbus: "test", Epoch: res.Epoch})
+		So(err, ShouldBeNil)
+		So(res2.NotModified, ShouldBeTrue)
+		So(res2.Routes, ShouldBeEmpty)
+
+		// the epoch changes after the leader changed
+		leader = 6
+		res3, err := cp.LookupEventlog(ctx, &proxypb.LookupEventlogRequest{
+			Eventbus: "test", EventlogId: 2, Epoch: res.Epoch,
+		})
+		So(err, ShouldBeNil)
+		So(res3.NotModified, ShouldBeFalse)
+		So(res3.Epoch, ShouldNotEqual, res.Epoch)
+		So(res3.Routes, ShouldHaveLength, 1)
+		So(res3.Routes[0].LeaderEndpoint, ShouldEqual, "127.0.0.1:11812")
+
+		_, err = cp.LookupEventlog(ctx, &proxypb.LookupEventlogRequest{Eventbus: "test", EventlogId: 9})
+		So(err, ShouldNotBeNil)
+	})
+}
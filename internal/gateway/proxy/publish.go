@@ -0,0 +1,45 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	cloudeventsv2 "github.com/linkall-labs/vanus/proto/pkg/v2/cloudevents"
+)
+
+// Publish is the v2 of publish API, it returns where each event is placed instead of the placement of
+// the batch.
+func (cp *ControllerProxy) Publish(ctx context.Context,
+	req *cloudeventsv2.PublishRequest) (*cloudeventsv2.PublishResponse, error) {
+	_ctx, span := cp.tracer.Start(ctx, "Publish")
+	defer span.End()
+
+	res, err := cp.publish(_ctx, req.GetEventbus(), req.GetEvents())
+	if err != nil {
+		return nil, err
+	}
+	// the events are placed with contiguous offsets.
+	results := make([]*cloudeventsv2.PublishResult, len(res.EventIds))
+	for i, id := range res.EventIds {
+		results[i] = &cloudeventsv2.PublishResult{
+			EventId:    id,
+			EventlogId: res.EventlogId,
+			Offset:     res.Offset + int64(i),
+			Stime:      res.Stime,
+		}
+	}
+	return &cloudeventsv2.PublishResponse{Results: results}, nil
+}
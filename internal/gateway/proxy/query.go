@@ -0,0 +1,244 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// maximumReadsPerQuery limits the reads of a page, so that a query whose filters rarely match
+	// returns in time, the rest is read by the next page.
+	maximumReadsPerQuery = 32
+	// pageTokenCursorSize is the size of a cursor in page token, the eventlog id and the offset.
+	pageTokenCursorSize = 16
+)
+
+var (
+	errInvalidTimeWindow = errors.ErrInvalidRequest.WithMessage("invalid time window")
+	errInvalidPageToken  = errors.ErrInvalidRequest.WithMessage("invalid page token")
+)
+
+// queryCursor is the position of an eventlog in a query.
+type queryCursor struct {
+	log    api.Eventlog
+	offset int64
+	done   bool
+}
+
+// QueryEvents returns the events which match the filters within the time window from an eventbus.
+// Reading of each eventlog starts from the offset looked up by the time index, and the filters are
+// pushed down to segment servers, which skip the events don't match by the attribute index, and skip
+// the blocks which don't contain the id by the bloom filter if the filters require an id.
+func (cp *ControllerProxy) QueryEvents(ctx context.Context,
+	req *proxypb.QueryEventsRequest) (*proxypb.QueryEventsResponse, error) {
+	if req.GetEventbus() == "" {
+		return nil, errInvalidEventbus
+	}
+	start, end := req.GetStartTime(), req.GetEndTime()
+	if end == 0 {
+		end = time.Now().UnixMilli()
+	}
+	if start < 0 || start >= end {
+		return nil, errInvalidTimeWindow
+	}
+	if err := cp.authorize(ctx, req.GetEventbus(), acl.PermissionSubscribe); err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > maximumNumberPerGetRequest {
+		limit = maximumNumberPerGetRequest
+	}
+
+	bus := cp.client.Eventbus(ctx, req.GetEventbus())
+	ls, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ls) == 0 {
+		return nil, errors.New("eventbus not found")
+	}
+	cursors, err := queryCursors(ctx, ls, start, req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	s := convert.FromPbSubscriptionRequest(&ctrlpb.SubscriptionRequest{Filters: req.GetFilters()})
+	f := filter.GetFilter(s.Filters)
+	var pushdown *segpb.EventFilter
+	scope := featureflag.Scope{Namespace: cp.namespaceOf(ctx), Eventbus: req.GetEventbus()}
+	if cp.features.Enabled(ctx, featureflag.FilterPushdown, scope) {
+		pushdown = filter.GetPushdownFilter(s.Filters)
+	}
+
+	res := &proxypb.QueryEventsResponse{}
+	reads := 0
+	for _, c := range cursors {
+		for !c.done && len(res.Events) < limit && reads < maximumReadsPerQuery {
+			reads++
+			// the batch never exceeds the rest of page, so that the cursor doesn't skip any event.
+			events, err := c.read(ctx, bus, limit-len(res.Events), pushdown)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range events {
+				if stime, ok := storedTime(e); ok {
+					if stime >= end {
+						c.done = true
+						break
+					}
+					if stime < start {
+						continue
+					}
+				}
+				if filter.Run(f, *e) == filter.FailFilter {
+					continue
+				}
+				data, _ := e.MarshalJSON()
+				res.Events = append(res.Events, wrapperspb.Bytes(data))
+			}
+		}
+	}
+	res.NextPageToken = encodePageToken(cursors)
+	return res, nil
+}
+
+// namespaceOf returns the namespace declared by the header of request, it's empty if there is none.
+func (cp *ControllerProxy) namespaceOf(ctx context.Context) string {
+	header := cp.cfg.IPAllowlist.NamespaceHeader
+	if header == "" {
+		header = defaultNamespaceHeader
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(header); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+func queryCursors(ctx context.Context, ls []api.Eventlog, start int64, token string) ([]*queryCursor, error) {
+	if token == "" {
+		cursors := make([]*queryCursor, 0, len(ls))
+		for _, l := range ls {
+			off, err := l.QueryOffsetByTime(ctx, start)
+			if err != nil {
+				return nil, err
+			}
+			// there are no readable segments yet.
+			cursors = append(cursors, &queryCursor{log: l, offset: off, done: off < 0})
+		}
+		return cursors, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil || len(decoded)%pageTokenCursorSize != 0 {
+		return nil, errInvalidPageToken
+	}
+	logs := make(map[uint64]api.Eventlog, len(ls))
+	for _, l := range ls {
+		logs[l.ID()] = l
+	}
+	cursors := make([]*queryCursor, 0, len(decoded)/pageTokenCursorSize)
+	for i := 0; i < len(decoded); i += pageTokenCursorSize {
+		l, ok := logs[binary.BigEndian.Uint64(decoded[i:])]
+		if !ok {
+			return nil, errInvalidPageToken
+		}
+		off := int64(binary.BigEndian.Uint64(decoded[i+8:]))
+		cursors = append(cursors, &queryCursor{log: l, offset: off})
+	}
+	return cursors, nil
+}
+
+// encodePageToken encodes the cursors which aren't done, it's empty if all cursors are done.
+func encodePageToken(cursors []*queryCursor) string {
+	buf := make([]byte, len(cursors)*pageTokenCursorSize)
+	n := 0
+	for _, c := range cursors {
+		if c.done {
+			continue
+		}
+		binary.BigEndian.PutUint64(buf[n:], c.log.ID())
+		binary.BigEndian.PutUint64(buf[n+8:], uint64(c.offset))
+		n += pageTokenCursorSize
+	}
+	if n == 0 {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(buf[:n])
+}
+
+func (c *queryCursor) read(ctx context.Context, bus api.Eventbus, num int,
+	pushdown *segpb.EventFilter) ([]*v2.Event, error) {
+	p := policy.NewManuallyReadPolicy(c.log, c.offset)
+	opts := []api.ReadOption{
+		option.WithDisablePolling(),
+		option.WithReadPolicy(p),
+		option.WithBatchSize(num),
+	}
+	if pushdown != nil {
+		// the policy is forwarded over the skipped events by reader.
+		opts = append(opts, option.WithFilter(pushdown))
+	}
+	events, _, _, err := bus.Reader(opts...).Read(ctx)
+	if err != nil {
+		if errors.Is(err, errors.ErrOffsetOnEnd) {
+			c.done = true
+			return nil, nil
+		}
+		return nil, err
+	}
+	if pushdown == nil {
+		p.Forward(len(events))
+	}
+	if p.Offset() == c.offset {
+		c.done = true
+	}
+	c.offset = p.Offset()
+	return events, nil
+}
+
+// storedTime returns the time in unix milliseconds when the event was stored.
+func storedTime(e *v2.Event) (int64, bool) {
+	v, ok := e.Extensions()[segpb.XVanusStime]
+	if !ok {
+		return 0, false
+	}
+	t, err := types.ToTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return t.UnixMilli(), true
+}
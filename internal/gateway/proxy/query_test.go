@@ -0,0 +1,120 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/tidwall/gjson"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestControllerProxy_QueryEvents(t *testing.T) {
+	Convey("test QueryEvents", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints: []string{"127.0.0.1:20001",
+				"127.0.0.1:20002", "127.0.0.1:20003"},
+			CloudEventReceiverPort: 18080,
+			ProxyPort:              18082,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockClient(ctrl)
+		cp.client = cli
+		eb := api.NewMockEventbus(ctrl)
+		cli.EXPECT().Eventbus(gomock.Any(), "test").AnyTimes().Return(eb)
+		el := api.NewMockEventlog(ctrl)
+		el.EXPECT().ID().AnyTimes().Return(uint64(1))
+		eb.EXPECT().ListLog(gomock.Any()).AnyTimes().Return([]api.Eventlog{el}, nil)
+		ctx := stdCtx.Background()
+
+		newEvent := func(id, source string, stime int64) *v2.Event {
+			e := v2.NewEvent()
+			e.SetID(id)
+			e.SetSource(source)
+			e.SetType("test")
+			e.SetExtension(segpb.XVanusStime, time.UnixMilli(stime))
+			return &e
+		}
+		rd := api.NewMockBusReader(ctrl)
+		// expectRead expects a read from offset, the reader skips skipped events by the filter.
+		expectRead := func(offset int64, num int, events []*v2.Event, skipped int) {
+			eb.EXPECT().Reader(gomock.Any()).DoAndReturn(func(opts ...api.ReadOption) api.BusReader {
+				opt := &api.ReadOptions{}
+				opt.Apply(opts...)
+				So(opt.Policy.Offset(), ShouldEqual, offset)
+				So(opt.BatchSize, ShouldEqual, num)
+				So(opt.Filter, ShouldNotBeNil)
+				opt.Policy.Forward(len(events) + skipped)
+				return rd
+			})
+			rd.EXPECT().Read(gomock.Any()).Return(events, offset, uint64(1), nil)
+		}
+
+		req := &proxypb.QueryEventsRequest{
+			Eventbus: "test",
+			Filters: []*metapb.Filter{
+				{Exact: map[string]string{"source": "match"}},
+			},
+			StartTime: 1000,
+			EndTime:   2000,
+			Limit:     2,
+		}
+
+		Convey("test invalid request", func() {
+			_, err := cp.QueryEvents(ctx, &proxypb.QueryEventsRequest{StartTime: 1000, EndTime: 2000})
+			So(err, ShouldEqual, errInvalidEventbus)
+			_, err = cp.QueryEvents(ctx, &proxypb.QueryEventsRequest{
+				Eventbus: "test", StartTime: 2000, EndTime: 1000,
+			})
+			So(err, ShouldEqual, errInvalidTimeWindow)
+			req.PageToken = "invalid"
+			_, err = cp.QueryEvents(ctx, req)
+			So(err, ShouldEqual, errInvalidPageToken)
+		})
+
+		Convey("test query by pages", func() {
+			el.EXPECT().QueryOffsetByTime(gomock.Any(), int64(1000)).Return(int64(10), nil)
+			expectRead(10, 2, []*v2.Event{newEvent("1", "match", 1000), newEvent("2", "not-match", 1001)}, 3)
+			expectRead(15, 1, []*v2.Event{newEvent("3", "match", 1500)}, 0)
+
+			res, err := cp.QueryEvents(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 2)
+			So(gjson.GetBytes(res.Events[0].Value, "id").String(), ShouldEqual, "1")
+			So(gjson.GetBytes(res.Events[1].Value, "id").String(), ShouldEqual, "3")
+			So(res.NextPageToken, ShouldNotBeEmpty)
+
+			// the query stops at the end of time window.
+			expectRead(16, 2, []*v2.Event{newEvent("4", "match", 2000)}, 0)
+			req.PageToken = res.NextPageToken
+			res, err = cp.QueryEvents(ctx, req)
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldBeEmpty)
+			So(res.NextPageToken, ShouldBeEmpty)
+		})
+	})
+}
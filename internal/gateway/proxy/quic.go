@@ -0,0 +1,175 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/quic-go/quic-go"
+)
+
+const (
+	// QUICProtocol is the ALPN of gRPC over QUIC, each QUIC connection carries a gRPC connection
+	// on its first bidirectional stream.
+	QUICProtocol = "vanus-grpc"
+	// quicKeepAlivePeriod keeps the idle gRPC connections, QUIC closes the connection idle for 30s.
+	quicKeepAlivePeriod = 15 * time.Second
+	// quicStreamTimeout is how long the listener waits for the stream of a new connection.
+	quicStreamTimeout = 10 * time.Second
+)
+
+type QUICConfig struct {
+	Enable bool
+	// CertFile and KeyFile are the TLS certificate of the QUIC listener, QUIC always requires TLS.
+	CertFile string
+	KeyFile  string
+}
+
+func newQUICConfig() *quic.Config {
+	return &quic.Config{KeepAlivePeriod: quicKeepAlivePeriod}
+}
+
+// quicListener accepts the first stream of each QUIC connection as a net.Conn, so that it's served
+// by grpc.Server like a TCP listener.
+type quicListener struct {
+	ln     *quic.Listener
+	conns  chan net.Conn
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewQUICListener listens the UDP address, the ALPN of tlsConf is set to QUICProtocol.
+func NewQUICListener(addr string, tlsConf *tls.Config) (net.Listener, error) {
+	tlsConf = tlsConf.Clone()
+	tlsConf.NextProtos = []string{QUICProtocol}
+	ln, err := quic.ListenAddr(addr, tlsConf, newQUICConfig())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &quicListener{
+		ln:     ln,
+		conns:  make(chan net.Conn),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	go l.run()
+	return l, nil
+}
+
+func (l *quicListener) run() {
+	for {
+		conn, err := l.ln.Accept(l.ctx)
+		if err != nil {
+			return
+		}
+		// a connection waiting for its stream doesn't block the others.
+		go func() {
+			ctx, cancel := context.WithTimeout(l.ctx, quicStreamTimeout)
+			defer cancel()
+			stream, err := conn.AcceptStream(ctx)
+			if err != nil {
+				_ = conn.CloseWithError(0, "no stream")
+				return
+			}
+			select {
+			case l.conns <- &quicConn{Stream: stream, conn: conn}:
+			case <-l.ctx.Done():
+				_ = conn.CloseWithError(0, "listener closed")
+			}
+		}()
+	}
+}
+
+func (l *quicListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.ctx.Done():
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *quicListener) Close() error {
+	l.cancel()
+	return l.ln.Close()
+}
+
+func (l *quicListener) Addr() net.Addr {
+	return l.ln.Addr()
+}
+
+// DialQUIC opens a gRPC connection over QUIC, it's used with grpc.WithContextDialer and insecure
+// transport credentials since QUIC is encrypted by tlsConf already.
+func DialQUIC(ctx context.Context, addr string, tlsConf *tls.Config) (net.Conn, error) {
+	tlsConf = tlsConf.Clone()
+	tlsConf.NextProtos = []string{QUICProtocol}
+	conn, err := quic.DialAddr(ctx, addr, tlsConf, newQUICConfig())
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		_ = conn.CloseWithError(0, "open stream failed")
+		return nil, err
+	}
+	return &quicConn{Stream: stream, conn: conn}, nil
+}
+
+type quicConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// Close closes the whole connection, since the connection only carries this stream.
+func (c *quicConn) Close() error {
+	_ = c.Stream.Close()
+	return c.conn.CloseWithError(0, "")
+}
+
+// serveQUIC serves the gRPC proxy over QUIC on the UDP port with the same number as the TCP port.
+func (cp *ControllerProxy) serveQUIC() error {
+	cert, err := tls.LoadX509KeyPair(cp.cfg.QUIC.CertFile, cp.cfg.QUIC.KeyFile)
+	if err != nil {
+		return fmt.Errorf("load certificate of QUIC listener error: %w", err)
+	}
+	ls, err := NewQUICListener(fmt.Sprintf(":%d", cp.cfg.ProxyPort),
+		&tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS13})
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := cp.grpcSrv.Serve(ls); err != nil {
+			log.Error(context.Background(), "serve grpc proxy over QUIC failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+	log.Info(context.Background(), "the grpc proxy over QUIC ready to work", nil)
+	return nil
+}
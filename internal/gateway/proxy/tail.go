@@ -0,0 +1,93 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"io"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TailSubscription forwards the delivery results stream of the trigger worker which the
+// subscription is running on.
+func (cp *ControllerProxy) TailSubscription(req *pbtrigger.TailSubscriptionRequest,
+	stream proxypb.ControllerProxy_TailSubscriptionServer) error {
+	ctx := stream.Context()
+	addr, err := cp.findTriggerWorker(ctx, req.GetSubscriptionId())
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(cp.cfg.Credentials))
+	if err != nil {
+		return errors.ErrInternal.WithMessage("connect to trigger worker failed").Wrap(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	cli, err := pbtrigger.NewTriggerWorkerClient(conn).TailSubscription(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := cli.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(res); err != nil {
+			log.Info(ctx, "send delivery result failed", map[string]interface{}{
+				log.KeyError:      err,
+				"subscription_id": req.GetSubscriptionId(),
+			})
+			return err
+		}
+	}
+}
+
+func (cp *ControllerProxy) findTriggerWorker(ctx context.Context, id uint64) (string, error) {
+	workers, err := cp.listSubscriptionWorkers(ctx)
+	if err != nil {
+		return "", err
+	}
+	if addr, ok := workers[id]; ok {
+		return addr, nil
+	}
+	return "", errors.ErrResourceNotFound.WithMessage("the subscription isn't running on any trigger worker")
+}
+
+// listSubscriptionWorkers returns the addresses of trigger workers which the subscriptions are running on.
+func (cp *ControllerProxy) listSubscriptionWorkers(ctx context.Context) (map[uint64]string, error) {
+	res, err := cp.triggerCtrl.ListTriggerWorker(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	workers := map[uint64]string{}
+	for _, tw := range res.GetTriggerWorker() {
+		for _, load := range tw.GetSubscriptionLoad() {
+			workers[load.GetSubscriptionId()] = tw.GetAddress()
+		}
+	}
+	return workers, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestControllerProxy_TailSubscription(t *testing.T) {
+	Convey("test tail subscription", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints:              []string{"127.0.0.1:20001"},
+			ProxyPort:              18082,
+			CloudEventReceiverPort: 18080,
+			Credentials:            insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = triggerCtrl
+		stream := pbtrigger.NewMockTriggerWorker_TailSubscriptionServer(ctrl)
+		stream.EXPECT().Context().AnyTimes().Return(stdCtx.Background())
+
+		Convey("test find trigger worker", func() {
+			triggerCtrl.EXPECT().ListTriggerWorker(gomock.Any(), gomock.Any()).Return(
+				&ctrlpb.ListTriggerWorkerResponse{
+					TriggerWorker: []*ctrlpb.TriggerWorkerInfo{
+						{Address: "127.0.0.1:2148", SubscriptionLoad: []*ctrlpb.SubscriptionLoad{{SubscriptionId: 1}}},
+						{Address: "127.0.0.1:2149", SubscriptionLoad: []*ctrlpb.SubscriptionLoad{{SubscriptionId: 2}}},
+					},
+				}, nil)
+			addr, err := cp.findTriggerWorker(stdCtx.Background(), 2)
+			So(err, ShouldBeNil)
+			So(addr, ShouldEqual, "127.0.0.1:2149")
+		})
+
+		Convey("test subscription isn't running", func() {
+			triggerCtrl.EXPECT().ListTriggerWorker(gomock.Any(), gomock.Any()).Return(
+				&ctrlpb.ListTriggerWorkerResponse{
+					TriggerWorker: []*ctrlpb.TriggerWorkerInfo{{Address: "127.0.0.1:2148"}},
+				}, nil)
+			err := cp.TailSubscription(&pbtrigger.TailSubscriptionRequest{SubscriptionId: 1}, stream)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+	})
+}
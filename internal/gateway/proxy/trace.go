@@ -0,0 +1,133 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TraceEvent looks up where the event went, which are where it was appended, the subscriptions of
+// the eventbus matched it, whether they have committed it and the deliveries traced by trigger workers.
+func (cp *ControllerProxy) TraceEvent(ctx context.Context,
+	req *proxypb.TraceEventRequest) (*proxypb.TraceEventResponse, error) {
+	if req.GetEventbus() == "" {
+		return nil, errInvalidEventbus
+	}
+	logID, off, err := decodeEventID(req.GetEventId())
+	if err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("invalid event id").Wrap(err)
+	}
+	got, err := cp.getByEventID(ctx, &proxypb.GetEventRequest{
+		Eventbus: req.GetEventbus(),
+		EventId:  req.GetEventId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(got.GetEvents()) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("event not found")
+	}
+	e := v2.NewEvent()
+	if err = e.UnmarshalJSON(got.GetEvents()[0].GetValue()); err != nil {
+		return nil, errors.ErrInternal.WithMessage("failed to unmarshall event to CloudEvent").Wrap(err)
+	}
+
+	subs, err := cp.triggerCtrl.ListSubscription(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	workers, err := cp.listSubscriptionWorkers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &proxypb.TraceEventResponse{
+		EventlogId: logID,
+		Offset:     off,
+		Event:      got.GetEvents()[0].GetValue(),
+	}
+	for _, sub := range subs.GetSubscription() {
+		if sub.GetEventBus() != req.GetEventbus() {
+			continue
+		}
+		st := &proxypb.SubscriptionTrace{
+			SubscriptionId: sub.GetId(),
+			Name:           sub.GetName(),
+			Committed:      isCommitted(sub.GetOffsets(), logID, off),
+		}
+		res.Subscriptions = append(res.Subscriptions, st)
+		s := convert.FromPbSubscriptionRequest(&ctrlpb.SubscriptionRequest{Filters: sub.GetFilters()})
+		if filter.Run(filter.GetFilter(s.Filters), e) == filter.FailFilter {
+			continue
+		}
+		st.FilterResult = true
+		addr, ok := workers[sub.GetId()]
+		if !ok {
+			continue
+		}
+		st.TriggerWorker = addr
+		traced, err := cp.traceDeliveries(ctx, addr, &pbtrigger.TraceEventRequest{
+			SubscriptionId: sub.GetId(),
+			EventId:        e.ID(),
+		})
+		if err != nil {
+			st.Error = err.Error()
+			continue
+		}
+		st.TraceEnabled = traced.GetEnabled()
+		st.Deliveries = traced.GetResults()
+	}
+	return res, nil
+}
+
+func (cp *ControllerProxy) traceDeliveries(ctx context.Context, addr string,
+	req *pbtrigger.TraceEventRequest) (*pbtrigger.TraceEventResponse, error) {
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(cp.cfg.Credentials))
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("connect to trigger worker failed").Wrap(err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	return pbtrigger.NewTriggerWorkerClient(conn).TraceEvent(ctx, req)
+}
+
+// isCommitted returns whether the offsets of subscription have passed the event at the offset of eventlog.
+func isCommitted(offsets []*metapb.OffsetInfo, eventlogID uint64, offset int64) bool {
+	for _, o := range offsets {
+		if o.GetEventLogId() != eventlogID {
+			continue
+		}
+		if int64(o.GetOffset()) > offset {
+			return true
+		}
+		for _, delivered := range o.GetDelivered() {
+			if int64(delivered) == offset {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"testing"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type traceTriggerWorker struct {
+	pbtrigger.UnimplementedTriggerWorkerServer
+}
+
+func (w *traceTriggerWorker) TraceEvent(_ stdCtx.Context,
+	req *pbtrigger.TraceEventRequest) (*pbtrigger.TraceEventResponse, error) {
+	return &pbtrigger.TraceEventResponse{
+		Enabled: true,
+		Results: []*pbtrigger.DeliveryResult{{EventId: req.EventId, StatusCode: 200}},
+	}, nil
+}
+
+func TestControllerProxy_TraceEvent(t *testing.T) {
+	Convey("test trace event", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints:   []string{"127.0.0.1:20001"},
+			Credentials: insecure.NewCredentials(),
+		})
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockClient(ctrl)
+		cp.client = cli
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = triggerCtrl
+		ctx := stdCtx.Background()
+
+		Convey("test invalid event id", func() {
+			_, err := cp.TraceEvent(ctx, &proxypb.TraceEventRequest{Eventbus: "test", EventId: "invalid"})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test trace the subscriptions of eventbus", func() {
+			ls, err := net.Listen("tcp", "127.0.0.1:0")
+			So(err, ShouldBeNil)
+			srv := grpc.NewServer()
+			pbtrigger.RegisterTriggerWorkerServer(srv, &traceTriggerWorker{})
+			go func() {
+				_ = srv.Serve(ls)
+			}()
+			defer srv.Stop()
+
+			var b [16]byte
+			binary.BigEndian.PutUint64(b[0:8], 1)
+			binary.BigEndian.PutUint64(b[8:], 10)
+			eb := api.NewMockEventbus(ctrl)
+			cli.EXPECT().Eventbus(gomock.Any(), "test").AnyTimes().Return(eb)
+			eb.EXPECT().GetLog(gomock.Any(), uint64(1)).Return(api.NewMockEventlog(ctrl), nil)
+			rd := api.NewMockBusReader(ctrl)
+			eb.EXPECT().Reader(gomock.Any()).Return(rd)
+			e := v2.NewEvent()
+			e.SetID("ce-1")
+			e.SetSource("match")
+			e.SetType("test")
+			rd.EXPECT().Read(gomock.Any()).Return([]*v2.Event{&e}, int64(10), uint64(1), nil)
+			matched := []*metapb.Filter{{Exact: map[string]string{"source": "match"}}}
+			triggerCtrl.EXPECT().ListSubscription(gomock.Any(), gomock.Any()).Return(&ctrlpb.ListSubscriptionResponse{
+				Subscription: []*metapb.Subscription{
+					{Id: 1, EventBus: "test", Filters: matched,
+						Offsets: []*metapb.OffsetInfo{{EventLogId: 1, Offset: 11}}},
+					{Id: 2, EventBus: "test", Filters: []*metapb.Filter{{Exact: map[string]string{"source": "other"}}}},
+					{Id: 3, EventBus: "test", Filters: matched,
+						Offsets: []*metapb.OffsetInfo{{EventLogId: 1, Offset: 5, Delivered: []uint64{10}}}},
+					{Id: 4, EventBus: "other"},
+				},
+			}, nil)
+			triggerCtrl.EXPECT().ListTriggerWorker(gomock.Any(), gomock.Any()).Return(&ctrlpb.ListTriggerWorkerResponse{
+				TriggerWorker: []*ctrlpb.TriggerWorkerInfo{
+					{Address: ls.Addr().String(), SubscriptionLoad: []*ctrlpb.SubscriptionLoad{{SubscriptionId: 1}}},
+				},
+			}, nil)
+
+			res, err := cp.TraceEvent(ctx, &proxypb.TraceEventRequest{
+				Eventbus: "test",
+				EventId:  base64.StdEncoding.EncodeToString(b[:]),
+			})
+			So(err, ShouldBeNil)
+			So(res.EventlogId, ShouldEqual, 1)
+			So(res.Offset, ShouldEqual, 10)
+			So(res.Subscriptions, ShouldHaveLength, 3)
+
+			st := res.Subscriptions[0]
+			So(st.FilterResult, ShouldBeTrue)
+			So(st.Committed, ShouldBeTrue)
+			So(st.TriggerWorker, ShouldEqual, ls.Addr().String())
+			So(st.TraceEnabled, ShouldBeTrue)
+			So(st.Deliveries, ShouldHaveLength, 1)
+			So(st.Deliveries[0].EventId, ShouldEqual, "ce-1")
+
+			So(res.Subscriptions[1].FilterResult, ShouldBeFalse)
+			So(res.Subscriptions[1].Committed, ShouldBeFalse)
+
+			st = res.Subscriptions[2]
+			So(st.FilterResult, ShouldBeTrue)
+			So(st.Committed, ShouldBeTrue)
+			So(st.TriggerWorker, ShouldBeEmpty)
+			So(st.Deliveries, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	stderr "errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/quic-go/quic-go/http3"
+)
+
+// startHTTP3Receiver serves the CloudEvents receiver over HTTP/3 on the UDP port with the same number
+// as the TCP port.
+func (ga *ceGateway) startHTTP3Receiver(handler http.Handler) error {
+	cert, err := tls.LoadX509KeyPair(ga.config.QUIC.CertFile, ga.config.QUIC.KeyFile)
+	if err != nil {
+		return fmt.Errorf("load certificate of QUIC listener error: %w", err)
+	}
+	conn, err := net.ListenPacket("udp", fmt.Sprintf(":%d", ga.config.GetCloudEventReceiverPort()))
+	if err != nil {
+		return err
+	}
+	ga.serveHTTP3(conn, handler, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS13})
+	return nil
+}
+
+func (ga *ceGateway) serveHTTP3(conn net.PacketConn, handler http.Handler, tlsConf *tls.Config) {
+	ga.http3Srv = &http3.Server{Handler: handler, TLSConfig: tlsConf}
+	ga.http3Conn = conn
+	go func() {
+		if err := ga.http3Srv.Serve(conn); err != nil && !stderr.Is(err, http.ErrServerClosed) {
+			log.Error(context.Background(), "serve CloudEvents receiver over HTTP/3 failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+	log.Info(context.Background(), "the CloudEvents receiver over HTTP/3 ready to work", map[string]interface{}{
+		"addr": conn.LocalAddr().String(),
+	})
+}
+
+func (ga *ceGateway) stopHTTP3Receiver() {
+	if ga.http3Srv == nil {
+		return
+	}
+	if err := ga.http3Srv.Close(); err != nil {
+		log.Warning(context.Background(), "close HTTP/3 server error", map[string]interface{}{
+			log.KeyError: err,
+		})
+	}
+	_ = ga.http3Conn.Close()
+}
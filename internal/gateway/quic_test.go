@@ -0,0 +1,86 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go/http3"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newTestCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestGateway_serveHTTP3(t *testing.T) {
+	Convey("test serve CloudEvents receiver over HTTP/3", t, func() {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		ga := NewGateway(Config{})
+		var received []byte
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		})
+		cert := newTestCertificate(t)
+		ga.serveHTTP3(conn, handler, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS13})
+		defer ga.stopHTTP3Receiver()
+
+		rt := &http3.RoundTripper{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true, MinVersion: tls.VersionTLS13}, //nolint:gosec // test only
+		}
+		defer func() {
+			_ = rt.Close()
+		}()
+		cli := &http.Client{Transport: rt, Timeout: 5 * time.Second}
+		req, err := http.NewRequest(http.MethodPost, "https://"+conn.LocalAddr().String()+"/gateway/test",
+			bytes.NewBufferString(`{"specversion":"1.0"}`))
+		So(err, ShouldBeNil)
+		res, err := cli.Do(req)
+		So(err, ShouldBeNil)
+		_ = res.Body.Close()
+		So(res.StatusCode, ShouldEqual, http.StatusAccepted)
+		So(res.Proto, ShouldEqual, "HTTP/3.0")
+		So(string(received), ShouldEqual, `{"specversion":"1.0"}`)
+	})
+}
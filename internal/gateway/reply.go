@@ -0,0 +1,165 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	stderr "errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// A request-reply publish has the reply query parameter. The gateway sets a new correlation ID
+// and the reply eventbus to the extensions of the request event, then responds the first event
+// appended to the reply eventbus with the same correlation ID, or 504 after the timeout. The
+// responder publishes the reply to the eventbus of xvanusreplyto, keeping xvanuscorrelationid.
+
+const (
+	replyParameter        = "reply"
+	replyTimeoutParameter = "timeout"
+	defaultReplyTimeout   = 10 * time.Second
+	maxReplyTimeout       = 60 * time.Second
+)
+
+// replyMailbox streams events of a reply eventbus to the requests waiting for them.
+type replyMailbox struct {
+	ebName  string
+	cancel  context.CancelFunc
+	waiters sync.Map
+}
+
+func (ga *ceGateway) requestReply(ctx context.Context, ebName string, event *v2.Event,
+	ack api.AckLevel, replyEb string, timeoutStr string) (*v2.Event, protocol.Result) {
+	timeout, err := parseReplyTimeout(timeoutStr)
+	if err != nil {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
+	mb, err := ga.getReplyMailbox(ctx, replyEb)
+	if err != nil {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid reply eventbus: %s", err)
+	}
+
+	correlationID := uuid.NewString()
+	event.SetExtension(primitive.XVanusCorrelationID, correlationID)
+	event.SetExtension(primitive.XVanusReplyTo, replyEb)
+	// wait before appending, the reply may come before AppendOne returns
+	ch := make(chan *v2.Event, 1)
+	mb.waiters.Store(correlationID, ch)
+	defer mb.waiters.Delete(correlationID)
+
+	if _, err = ga.getBusWriter(ctx, ebName).AppendOne(ctx, event, option.WithAckLevel(ack)); err != nil {
+		log.Warning(ctx, "append to failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   ebName,
+		})
+		return nil, v2.NewHTTPResult(appendStatusCode(err), err.Error())
+	}
+
+	select {
+	case e := <-ch:
+		return e, v2.ResultACK
+	case <-time.After(timeout):
+		return nil, v2.NewHTTPResult(http.StatusGatewayTimeout, "wait for reply timeout")
+	case <-ctx.Done():
+		return nil, v2.NewHTTPResult(http.StatusGatewayTimeout, ctx.Err().Error())
+	}
+}
+
+// getReplyMailbox returns the mailbox of reply eventbus, which is started on the first request
+// and receives the events appended after.
+func (ga *ceGateway) getReplyMailbox(ctx context.Context, ebName string) (*replyMailbox, error) {
+	ga.mailboxMu.Lock()
+	defer ga.mailboxMu.Unlock()
+	if mb, ok := ga.mailboxes[ebName]; ok {
+		return mb, nil
+	}
+
+	mb := &replyMailbox{ebName: ebName}
+	s, err := ga.newEventStream(ctx, &streamRequest{ebName: ebName}, mb)
+	if err != nil {
+		return nil, err
+	}
+	if ga.mailboxes == nil {
+		ga.mailboxes = map[string]*replyMailbox{}
+	}
+	ga.mailboxes[ebName] = mb
+
+	var runCtx context.Context
+	runCtx, mb.cancel = context.WithCancel(context.Background())
+	go func() {
+		if err := s.run(runCtx, nil); err != nil && !stderr.Is(err, context.Canceled) {
+			log.Warning(runCtx, "stream reply events failed", map[string]interface{}{
+				log.KeyError:        err,
+				log.KeyEventbusName: ebName,
+			})
+		}
+		// the next request restarts the mailbox
+		ga.mailboxMu.Lock()
+		defer ga.mailboxMu.Unlock()
+		if ga.mailboxes[ebName] == mb {
+			delete(ga.mailboxes, ebName)
+		}
+	}()
+	return mb, nil
+}
+
+func (ga *ceGateway) stopReplyMailboxes() {
+	ga.mailboxMu.Lock()
+	defer ga.mailboxMu.Unlock()
+	for _, mb := range ga.mailboxes {
+		mb.cancel()
+	}
+}
+
+func (mb *replyMailbox) sendEvent(e *v2.Event, _ string) error {
+	correlationID, _ := types.ToString(e.Extensions()[primitive.XVanusCorrelationID])
+	if correlationID == "" {
+		return nil
+	}
+	if ch, ok := mb.waiters.LoadAndDelete(correlationID); ok {
+		ch.(chan *v2.Event) <- e
+	}
+	return nil
+}
+
+// sendCursor does nothing, the mailbox always starts from the latest.
+func (mb *replyMailbox) sendCursor(_ string) error {
+	return nil
+}
+
+func parseReplyTimeout(v string) (time.Duration, error) {
+	if v == "" {
+		return defaultReplyTimeout, nil
+	}
+	timeout, err := time.ParseDuration(v)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid reply timeout: %s", v)
+	}
+	if timeout > maxReplyTimeout {
+		timeout = maxReplyTimeout
+	}
+	return timeout, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/prashantv/gostub"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_requestReply(t *testing.T) {
+	Convey("test request reply", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockReplyEventbus := api.NewMockEventbus(ctrl)
+		mockEventlog := api.NewMockEventlog(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), "test").AnyTimes().Return(mockEventbus)
+		mockClient.EXPECT().Eventbus(Any(), "reply").AnyTimes().Return(mockReplyEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockReplyEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+		mockReplyEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+		mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+		mockEventlog.EXPECT().LatestOffset(Any()).AnyTimes().Return(int64(0), nil)
+
+		replies := make(chan *ce.Event, 1)
+		mockBusWriter.EXPECT().AppendOne(Any(), Any(), Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+				if e.ID() == "no-reply" {
+					return "AABBCC", nil
+				}
+				So(e.Extensions()[primitive.XVanusReplyTo], ShouldEqual, "reply")
+				reply := ce.NewEvent()
+				reply.SetID("reply")
+				reply.SetSource("/test")
+				reply.SetType("test.reply")
+				reply.SetExtension(primitive.XVanusCorrelationID, e.Extensions()[primitive.XVanusCorrelationID])
+				replies <- &reply
+				return "AABBCC", nil
+			})
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, _ ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+				select {
+				case e := <-replies:
+					return []*ce.Event{e}, int64(0), uint64(1), nil
+				default:
+					return nil, int64(0), uint64(0), errors.ErrOffsetOnEnd
+				}
+			})
+
+		ga := &ceGateway{
+			client: mockClient,
+			tracer: tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		}
+		defer ga.stopReplyMailboxes()
+		receive := func(id, query string) (*ce.Event, *cehttp.Result) {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("/test")
+			e.SetType("test")
+			stub := StubFunc(&requestDataFromContext, &cehttp.RequestData{
+				URL: &url.URL{Path: "/gateway/test", RawQuery: query},
+			})
+			defer stub.Reset()
+			resEvent, res := ga.receive(context.Background(), e)
+			var httpResult *cehttp.Result
+			ce.ResultAs(res, &httpResult)
+			return resEvent, httpResult
+		}
+
+		Convey("test receive the reply", func() {
+			resEvent, res := receive("request", "reply=reply")
+			So(res, ShouldBeNil)
+			So(resEvent, ShouldNotBeNil)
+			So(resEvent.ID(), ShouldEqual, "reply")
+		})
+
+		Convey("test wait for reply timeout", func() {
+			start := time.Now()
+			_, res := receive("no-reply", "reply=reply&timeout=100ms")
+			So(res, ShouldNotBeNil)
+			So(res.StatusCode, ShouldEqual, http.StatusGatewayTimeout)
+			So(time.Since(start), ShouldBeLessThan, time.Second)
+		})
+
+		Convey("test invalid reply timeout", func() {
+			_, res := receive("request", "reply=reply&timeout=-1s")
+			So(res, ShouldNotBeNil)
+			So(res.StatusCode, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
+
+func TestGateway_parseReplyTimeout(t *testing.T) {
+	Convey("test parse reply timeout", t, func() {
+		timeout, err := parseReplyTimeout("")
+		So(err, ShouldBeNil)
+		So(timeout, ShouldEqual, defaultReplyTimeout)
+		timeout, err = parseReplyTimeout("3s")
+		So(err, ShouldBeNil)
+		So(timeout, ShouldEqual, 3*time.Second)
+		timeout, err = parseReplyTimeout("1h")
+		So(err, ShouldBeNil)
+		So(timeout, ShouldEqual, maxReplyTimeout)
+		_, err = parseReplyTimeout("abc")
+		So(err, ShouldNotBeNil)
+	})
+}
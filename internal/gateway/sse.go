@@ -0,0 +1,134 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	stderr "errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// The SSE endpoint streams events of eventbus as Server-Sent Events, the data of each message
+// is the event in structured JSON and the id is the cursor after the event, so that the
+// Last-Event-ID header sent by a reconnecting client resumes the stream.
+
+const (
+	sseRequestPrefix = "/sse/eventbus/"
+	sseLastEventID   = "Last-Event-ID"
+)
+
+type sseSender struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+func (ga *ceGateway) startSSEReceiver() error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetSSEPort()))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(sseRequestPrefix, ga.ipAllowlistMiddleware(sseRequestPrefix)(http.HandlerFunc(ga.serveSSE)))
+	ga.sseSrv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := ga.sseSrv.Serve(ls); err != nil && err != http.ErrServerClosed {
+			panic(fmt.Sprintf("start SSE server failed: %s", err.Error()))
+		}
+	}()
+	return nil
+}
+
+func (ga *ceGateway) serveSSE(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	sr, err := parseStreamRequest(req, sseRequestPrefix, ga.config.GetSSEMaxRate())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if v := req.Header.Get(sseLastEventID); v != "" {
+		if sr.offsets, err = decodeStreamCursor(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	ctx := req.Context()
+	if err = ga.authorize(ctx, req.Header, sr.ebName, acl.PermissionSubscribe); err != nil {
+		http.Error(w, err.Error(), authorizeStatusCode(err))
+		return
+	}
+	sender := &sseSender{w: w, flusher: flusher}
+	s, err := ga.newEventStream(ctx, sr, sender)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	if err = s.run(ctx, nil); err != nil && !stderr.Is(err, context.Canceled) {
+		log.Warning(ctx, "stream events to SSE failed", map[string]interface{}{
+			log.KeyError:        err,
+			log.KeyEventbusName: sr.ebName,
+		})
+	}
+}
+
+func (s *sseSender) sendEvent(e *ce.Event, cursor string) error {
+	data, err := e.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	buf := bytes.NewBufferString("id: ")
+	buf.WriteString(cursor)
+	buf.WriteByte('\n')
+	// the data of event may be multi-line JSON, each line is a data field
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	if _, err = s.w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// sendCursor does nothing, the id of the last event is the cursor of SSE.
+func (s *sseSender) sendCursor(_ string) error {
+	return nil
+}
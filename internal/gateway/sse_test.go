@@ -0,0 +1,120 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_serveSSE(t *testing.T) {
+	Convey("test stream events by SSE", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockEventlog := api.NewMockEventlog(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), "test").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+		mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+		mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+		events := make([]*ce.Event, 0)
+		for i, eventType := range []string{"order.created", "order.deleted"} {
+			e := ce.NewEvent()
+			e.SetID(eventType)
+			e.SetSource("/test")
+			e.SetType(eventType)
+			buf := make([]byte, 8)
+			binary.BigEndian.PutUint64(buf, uint64(5+i))
+			e.SetExtension(eventlog.XVanusLogOffset, buf)
+			events = append(events, &e)
+		}
+		mockBusReader.EXPECT().Read(Any()).Return(events, int64(0), uint64(1), nil)
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+
+		ga := &ceGateway{
+			client: mockClient,
+			config: Config{SSE: SSEConfig{Enable: true}},
+		}
+		srv := httptest.NewServer(http.HandlerFunc(ga.serveSSE))
+		defer srv.Close()
+
+		q := url.Values{}
+		q.Set("filters", `[{"exact":{"type":"order.deleted"}}]`)
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet,
+			srv.URL+sseRequestPrefix+"test?"+q.Encode(), nil)
+		req.Header.Set(sseLastEventID, encodeStreamCursor(map[uint64]int64{1: 5}))
+		resp, err := http.DefaultClient.Do(req)
+		So(err, ShouldBeNil)
+		defer resp.Body.Close()
+		So(resp.StatusCode, ShouldEqual, http.StatusOK)
+		So(resp.Header.Get("Content-Type"), ShouldEqual, "text/event-stream")
+
+		var id, data string
+		reader := bufio.NewReader(resp.Body)
+		for {
+			line, err := reader.ReadString('\n')
+			So(err, ShouldBeNil)
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				break
+			}
+			if strings.HasPrefix(line, "id: ") {
+				id = strings.TrimPrefix(line, "id: ")
+			} else if strings.HasPrefix(line, "data: ") {
+				data += strings.TrimPrefix(line, "data: ")
+			}
+		}
+		offsets, err := decodeStreamCursor(id)
+		So(err, ShouldBeNil)
+		So(offsets, ShouldResemble, map[uint64]int64{1: 7})
+		e := ce.NewEvent()
+		So(json.Unmarshal([]byte(data), &e), ShouldBeNil)
+		So(e.ID(), ShouldEqual, "order.deleted")
+		So(e.Extensions()[eventlog.XVanusLogOffset], ShouldBeNil)
+	})
+
+	Convey("test invalid Last-Event-ID", t, func() {
+		ga := &ceGateway{}
+		srv := httptest.NewServer(http.HandlerFunc(ga.serveSSE))
+		defer srv.Close()
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+sseRequestPrefix+"test", nil)
+		req.Header.Set(sseLastEventID, "invalid!")
+		resp, err := http.DefaultClient.Do(req)
+		So(err, ShouldBeNil)
+		defer resp.Body.Close()
+		So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"go.uber.org/ratelimit"
+)
+
+const (
+	defaultStreamMaxRate  = 1000
+	streamReadBatchSize   = 16
+	streamIdleInterval    = 200 * time.Millisecond
+	streamReadTimeout     = 5 * time.Second
+	streamRefreshInterval = 30 * time.Second
+)
+
+// streamSender sends events read by eventStream to a client, the cursor is the position after
+// the event or the round, with which the client resumes.
+type streamSender interface {
+	sendEvent(e *ce.Event, cursor string) error
+	sendCursor(cursor string) error
+}
+
+type streamRequest struct {
+	ebName  string
+	filter  filter.Filter
+	rate    int
+	offsets map[uint64]int64
+}
+
+// parseStreamRequest parses the eventbus name from path after prefix, and the filters, rate and
+// cursor query parameters.
+func parseStreamRequest(req *http.Request, prefix string, maxRate int) (*streamRequest, error) {
+	ebName := strings.TrimPrefix(req.URL.Path, prefix)
+	if ebName == "" || strings.Contains(ebName, "/") {
+		return nil, fmt.Errorf("invalid eventbus name")
+	}
+	query := req.URL.Query()
+	f, err := parseStreamFilter(query.Get("filters"))
+	if err != nil {
+		return nil, err
+	}
+	rate := maxRate
+	if v := query.Get("rate"); v != "" {
+		r, err := strconv.Atoi(v)
+		if err != nil || r <= 0 {
+			return nil, fmt.Errorf("invalid rate")
+		}
+		if r < rate {
+			rate = r
+		}
+	}
+	sr := &streamRequest{
+		ebName: ebName,
+		filter: f,
+		rate:   rate,
+	}
+	if v := query.Get("cursor"); v != "" {
+		if sr.offsets, err = decodeStreamCursor(v); err != nil {
+			return nil, err
+		}
+	}
+	return sr, nil
+}
+
+// parseStreamFilter parses the filters in JSON of subscription, an empty value passes all events.
+func parseStreamFilter(v string) (filter.Filter, error) {
+	var filters []*primitive.SubscriptionFilter
+	if v != "" {
+		if err := json.Unmarshal([]byte(v), &filters); err != nil {
+			return nil, fmt.Errorf("invalid filters: %w", err)
+		}
+	}
+	return filter.GetFilter(filters), nil
+}
+
+type eventStream struct {
+	ga      *ceGateway
+	ebName  string
+	filter  filter.Filter
+	limiter ratelimit.Limiter
+	sender  streamSender
+	logs    []api.Eventlog
+	// the next offset to read of each eventlog
+	offsets map[uint64]int64
+}
+
+func (ga *ceGateway) newEventStream(ctx context.Context, sr *streamRequest,
+	sender streamSender) (*eventStream, error) {
+	logs, err := ga.client.Eventbus(ctx, sr.ebName).ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := &eventStream{
+		ga:      ga,
+		ebName:  sr.ebName,
+		filter:  sr.filter,
+		limiter: ratelimit.NewUnlimited(),
+		sender:  sender,
+		logs:    logs,
+	}
+	if sr.rate > 0 {
+		s.limiter = ratelimit.New(sr.rate)
+	}
+	if err = s.seek(ctx, sr.offsets); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// run streams events until ctx is done or sending failed, the offsets received from cursorCh
+// are sought, cursorCh may be nil if the client can't seek.
+func (s *eventStream) run(ctx context.Context, cursorCh <-chan map[uint64]int64) error {
+	refreshed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case offsets := <-cursorCh:
+			if err := s.seek(ctx, offsets); err != nil {
+				return err
+			}
+		default:
+		}
+		if time.Since(refreshed) > streamRefreshInterval {
+			// pick up new eventlogs of eventbus
+			if err := s.refresh(ctx); err != nil {
+				return err
+			}
+			refreshed = time.Now()
+		}
+		sent := 0
+		for _, l := range s.logs {
+			n, err := s.stream(ctx, l)
+			if err != nil {
+				return err
+			}
+			sent += n
+		}
+		if sent > 0 {
+			if err := s.sender.sendCursor(encodeStreamCursor(s.offsets)); err != nil {
+				return err
+			}
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(streamIdleInterval):
+		}
+	}
+}
+
+// stream reads a batch of events of eventlog and sends the ones passed the filter, it returns
+// the number of events read.
+func (s *eventStream) stream(ctx context.Context, l api.Eventlog) (int, error) {
+	offset := s.offsets[l.ID()]
+	_ctx, cancel := context.WithTimeout(ctx, streamReadTimeout)
+	defer cancel()
+	opts := []api.ReadOption{
+		option.WithDisablePolling(),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, offset)),
+		option.WithBatchSize(streamReadBatchSize),
+	}
+	if s.ga.blobStore != nil {
+		opts = append(opts, option.WithBlobResolver(s.ga.blobStore))
+	}
+	events, _, _, err := s.ga.client.Eventbus(ctx, s.ebName).Reader(opts...).Read(_ctx)
+	switch {
+	case err == nil:
+	case errors.Is(err, errors.ErrOffsetOnEnd), errors.Is(err, errors.ErrTryAgain),
+		stderr.Is(err, context.DeadlineExceeded):
+		return 0, nil
+	case errors.Is(err, errors.ErrOffsetUnderflow):
+		// the events have been expired, start from the earliest
+		earliest, err := l.EarliestOffset(ctx)
+		if err != nil {
+			return 0, err
+		}
+		s.offsets[l.ID()] = earliest
+		return 0, nil
+	default:
+		return 0, err
+	}
+	for i, e := range events {
+		if ec, ok := e.Context.(*ce.EventContextV1); ok {
+			delete(ec.Extensions, eventlog.XVanusLogOffset)
+		}
+		if filter.Run(s.filter, *e) == filter.FailFilter {
+			continue
+		}
+		s.offsets[l.ID()] = offset + int64(i) + 1
+		s.limiter.Take()
+		if err = s.sender.sendEvent(e, encodeStreamCursor(s.offsets)); err != nil {
+			return 0, err
+		}
+	}
+	s.offsets[l.ID()] = offset + int64(len(events))
+	return len(events), nil
+}
+
+// seek moves to offsets of cursor, an eventlog not in cursor starts from the latest.
+func (s *eventStream) seek(ctx context.Context, offsets map[uint64]int64) error {
+	s.offsets = map[uint64]int64{}
+	for _, l := range s.logs {
+		if off, ok := offsets[l.ID()]; ok {
+			s.offsets[l.ID()] = off
+			continue
+		}
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return err
+		}
+		s.offsets[l.ID()] = latest
+	}
+	return nil
+}
+
+func (s *eventStream) refresh(ctx context.Context) error {
+	logs, err := s.ga.client.Eventbus(ctx, s.ebName).ListLog(ctx)
+	if err != nil {
+		return err
+	}
+	for _, l := range logs {
+		if _, ok := s.offsets[l.ID()]; !ok {
+			// events of new eventlog are all new to client
+			earliest, err := l.EarliestOffset(ctx)
+			if err != nil {
+				return err
+			}
+			s.offsets[l.ID()] = earliest
+		}
+	}
+	s.logs = logs
+	return nil
+}
+
+func encodeStreamCursor(offsets map[uint64]int64) string {
+	m := make(map[string]int64, len(offsets))
+	for id, off := range offsets {
+		m[strconv.FormatUint(id, 10)] = off
+	}
+	data, _ := json.Marshal(m)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeStreamCursor(cursor string) (map[uint64]int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	var m map[string]int64
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	offsets := make(map[uint64]int64, len(m))
+	for k, off := range m {
+		id, err := strconv.ParseUint(k, 10, 64)
+		if err != nil || off < 0 {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		offsets[id] = off
+	}
+	return offsets, nil
+}
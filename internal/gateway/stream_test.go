@@ -0,0 +1,35 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_streamCursor(t *testing.T) {
+	Convey("test encode and decode cursor", t, func() {
+		offsets := map[uint64]int64{1: 10, 2: 0}
+		res, err := decodeStreamCursor(encodeStreamCursor(offsets))
+		So(err, ShouldBeNil)
+		So(res, ShouldResemble, offsets)
+
+		_, err = decodeStreamCursor("invalid!")
+		So(err, ShouldNotBeNil)
+		_, err = decodeStreamCursor(encodeStreamCursor(map[uint64]int64{1: -1}))
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/google/uuid"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// schemaURL is the location the schema of an eventbus is compiled as, each eventbus has its own compiler.
+const schemaURL = "schema.json"
+
+const (
+	ValidationCodeEventTooLarge    = "event_too_large"
+	ValidationCodeMissingAttribute = "missing_attribute"
+	ValidationCodeInvalidData      = "invalid_data"
+)
+
+// ValidationError is the structured error responded when an event is rejected by the policy of
+// eventbus.
+type ValidationError struct {
+	Code    string `json:"code"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Message)
+	}
+	return fmt.Sprintf("%s: %s %s", e.Code, e.Field, e.Message)
+}
+
+func (e *ValidationError) statusCode() int {
+	if e.Code == ValidationCodeEventTooLarge {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+type eventValidator struct {
+	maxSize  int
+	required []string
+	schema   *jsonschema.Schema
+}
+
+func newEventValidator(policy EventbusPolicy) (*eventValidator, error) {
+	v := &eventValidator{
+		maxSize:  policy.MaxEventSize,
+		required: policy.RequiredAttributes,
+	}
+	data := []byte(policy.Schema)
+	if policy.SchemaFile != "" {
+		var err error
+		if data, err = os.ReadFile(policy.SchemaFile); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) > 0 {
+		s, err := compileSchema(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema: %w", err)
+		}
+		v.schema = s
+	}
+	return v, nil
+}
+
+func compileSchema(data []byte) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	// the format keyword is asserted, since the events are validated at edge.
+	c.AssertFormat = true
+	if err := c.AddResource(schemaURL, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return c.Compile(schemaURL)
+}
+
+func (ga *ceGateway) initValidators() error {
+	ga.validators = make(map[string]*eventValidator, len(ga.config.Eventbuses))
+	for ebName, policy := range ga.config.Eventbuses {
+		v, err := newEventValidator(policy)
+		if err != nil {
+			return fmt.Errorf("invalid policy of eventbus %s: %w", ebName, err)
+		}
+		ga.validators[ebName] = v
+	}
+	return nil
+}
+
+// validateEvent validates the event published to eventbus by its policy, it returns
+// *ValidationError if the event is rejected.
+func (ga *ceGateway) validateEvent(ebName string, e *v2.Event) error {
+	v, ok := ga.validators[ebName]
+	if !ok {
+		return nil
+	}
+	return v.validate(e)
+}
+
+func (v *eventValidator) validate(e *v2.Event) error {
+	if v.maxSize > 0 {
+		data, err := e.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		if len(data) > v.maxSize {
+			return &ValidationError{
+				Code:    ValidationCodeEventTooLarge,
+				Message: fmt.Sprintf("event size %d exceeds the limit %d", len(data), v.maxSize),
+			}
+		}
+	}
+	for _, name := range v.required {
+		if !hasAttribute(e, name) {
+			return &ValidationError{
+				Code:    ValidationCodeMissingAttribute,
+				Field:   name,
+				Message: "is required",
+			}
+		}
+	}
+	if v.schema == nil {
+		return nil
+	}
+	var data interface{}
+	if len(e.Data()) > 0 {
+		// the numbers are decoded as json.Number, so that big integers are validated exactly.
+		d := json.NewDecoder(bytes.NewReader(e.Data()))
+		d.UseNumber()
+		if err := d.Decode(&data); err != nil {
+			return &ValidationError{
+				Code:    ValidationCodeInvalidData,
+				Field:   "data",
+				Message: "isn't JSON",
+			}
+		}
+	}
+	if err := v.schema.Validate(data); err != nil {
+		ve := &jsonschema.ValidationError{}
+		if !stderr.As(err, &ve) {
+			return err
+		}
+		// the first leaf is the violation nearest to the value.
+		for len(ve.Causes) > 0 {
+			ve = ve.Causes[0]
+		}
+		return &ValidationError{
+			Code:    ValidationCodeInvalidData,
+			Field:   "data" + instancePath(ve.InstanceLocation),
+			Message: ve.Message,
+		}
+	}
+	return nil
+}
+
+// instancePath converts the JSON pointer of a value to the path like ".items[0].name".
+func instancePath(pointer string) string {
+	if pointer == "" {
+		return ""
+	}
+	var b strings.Builder
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if _, err := strconv.Atoi(token); err == nil {
+			b.WriteString("[" + token + "]")
+			continue
+		}
+		b.WriteString("." + token)
+	}
+	return b.String()
+}
+
+// hasAttribute returns whether the optional context attribute or the extension is set.
+func hasAttribute(e *v2.Event, name string) bool {
+	switch name {
+	case "id", "source", "specversion", "type":
+		return true
+	case "subject":
+		return e.Subject() != ""
+	case "time":
+		return !e.Time().IsZero()
+	case "dataschema":
+		return e.DataSchema() != ""
+	case "datacontenttype":
+		return e.DataContentType() != ""
+	default:
+		_, ok := e.Extensions()[name]
+		return ok
+	}
+}
+
+// rejectResult returns the result of an event failed to prepare, the response carries the
+// structured error if the event is rejected by the policy of eventbus.
+func rejectResult(err error) (*v2.Event, protocol.Result) {
+	ve := &ValidationError{}
+	if !stderr.As(err, &ve) {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
+	e := v2.NewEvent("1.0")
+	e.SetID(uuid.NewString())
+	e.SetType("com.linkall.vanus.event.rejected")
+	e.SetSource("https://linkall.com/vanus")
+	if err = e.SetData(v2.ApplicationJSON, ve); err != nil {
+		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+	}
+	return &e, v2.NewHTTPResult(ve.statusCode(), ve.Error())
+}
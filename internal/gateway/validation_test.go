@@ -0,0 +1,138 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/prashantv/gostub"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_validateEvent(t *testing.T) {
+	ga := &ceGateway{
+		reserved: primitive.NewReservedAttributes(nil),
+		config: Config{Eventbuses: map[string]EventbusPolicy{
+			"orders": {
+				MaxEventSize:       512,
+				RequiredAttributes: []string{"subject", "tenant"},
+				Schema:             `{"type": "object", "required": ["id"], "properties": {"id": {"type": "string"}}}`,
+			},
+			"payments": {
+				Schema: `{
+					"type": "object",
+					"properties": {
+						"email": {"type": "string", "format": "email"},
+						"items": {"type": "array", "items": {"$ref": "#/$defs/item"}},
+						"amount": {"oneOf": [{"type": "integer", "maximum": 9007199254740993}, {"type": "null"}]}
+					},
+					"$defs": {"item": {"type": "object", "properties": {"count": {"type": "integer", "minimum": 1}}}}
+				}`,
+			},
+		}},
+		tracer: tracing.NewTracer("cloudevents", trace.SpanKindServer),
+	}
+	if err := ga.initValidators(); err != nil {
+		t.Fatal(err)
+	}
+	newEvent := func(data string) *ce.Event {
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("/test")
+		e.SetType("test")
+		e.SetSubject("order")
+		e.SetExtension("tenant", "vanus")
+		_ = e.SetData(ce.ApplicationJSON, []byte(data))
+		return &e
+	}
+	validationError := func(err error) *ValidationError {
+		ve, ok := err.(*ValidationError)
+		So(ok, ShouldBeTrue)
+		return ve
+	}
+
+	Convey("test validate event by policy of eventbus", t, func() {
+		So(ga.validateEvent("orders", newEvent(`{"id": "o-1"}`)), ShouldBeNil)
+		So(ga.validateEvent("others", newEvent(`[]`)), ShouldBeNil)
+
+		ve := validationError(ga.validateEvent("orders", newEvent(`{"id": "`+strings.Repeat("a", 512)+`"}`)))
+		So(ve.Code, ShouldEqual, ValidationCodeEventTooLarge)
+		So(ve.statusCode(), ShouldEqual, http.StatusRequestEntityTooLarge)
+
+		e := newEvent(`{"id": "o-1"}`)
+		e.SetSubject("")
+		ve = validationError(ga.validateEvent("orders", e))
+		So(ve.Code, ShouldEqual, ValidationCodeMissingAttribute)
+		So(ve.Field, ShouldEqual, "subject")
+
+		ve = validationError(ga.validateEvent("orders", newEvent(`{"id": 1}`)))
+		So(ve.Code, ShouldEqual, ValidationCodeInvalidData)
+		So(ve.Field, ShouldEqual, "data.id")
+		So(ve.statusCode(), ShouldEqual, http.StatusBadRequest)
+
+		ve = validationError(ga.validateEvent("orders", newEvent(`not json`)))
+		So(ve.Code, ShouldEqual, ValidationCodeInvalidData)
+		So(ve.Field, ShouldEqual, "data")
+
+		So(ga.validateEvent("payments", newEvent(`{"email": "a@b.com", "items": [{"count": 1}], "amount": null}`)),
+			ShouldBeNil)
+		ve = validationError(ga.validateEvent("payments", newEvent(`{"items": [{"count": 1}, {"count": 0}]}`)))
+		So(ve.Field, ShouldEqual, "data.items[1].count")
+		ve = validationError(ga.validateEvent("payments", newEvent(`{"email": "nobody"}`)))
+		So(ve.Field, ShouldEqual, "data.email")
+		// the big integer isn't rounded as float64.
+		So(ga.validateEvent("payments", newEvent(`{"amount": 9007199254740993}`)), ShouldBeNil)
+		ve = validationError(ga.validateEvent("payments", newEvent(`{"amount": 9007199254740994}`)))
+		So(ve.Field, ShouldEqual, "data.amount")
+	})
+
+	Convey("test receive responds the structured error", t, func() {
+		stub := StubFunc(&requestDataFromContext, &cehttp.RequestData{
+			URL: &url.URL{Path: "/gateway/orders"},
+		})
+		defer stub.Reset()
+		resEvent, res := ga.receive(context.Background(), *newEvent(`{}`))
+		var httpResult *cehttp.Result
+		So(ce.ResultAs(res, &httpResult), ShouldBeTrue)
+		So(httpResult.StatusCode, ShouldEqual, http.StatusBadRequest)
+		So(resEvent, ShouldNotBeNil)
+		var ve ValidationError
+		So(resEvent.DataAs(&ve), ShouldBeNil)
+		So(ve, ShouldResemble, ValidationError{
+			Code: ValidationCodeInvalidData, Field: "data", Message: "missing properties: 'id'",
+		})
+	})
+
+	Convey("test invalid policy", t, func() {
+		ga := &ceGateway{config: Config{Eventbuses: map[string]EventbusPolicy{
+			"orders": {Schema: `{"type": "map"}`},
+		}}}
+		So(ga.initValidators(), ShouldNotBeNil)
+		ga.config.Eventbuses["orders"] = EventbusPolicy{Schema: `{"properties": {"id": {"pattern": "("}}}`}
+		So(ga.initValidators(), ShouldNotBeNil)
+		ga.config.Eventbuses["orders"] = EventbusPolicy{Schema: `{"$ref": "#/$defs/missing"}`}
+		So(ga.initValidators(), ShouldNotBeNil)
+	})
+}
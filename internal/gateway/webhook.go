@@ -0,0 +1,162 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	webhookRequestPrefix = "/webhook"
+	defaultWebhookType   = "com.linkall.vanus.webhook"
+	maxWebhookBodySize   = 4 * 1024 * 1024
+)
+
+func (ga *ceGateway) startWebhookReceiver() error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetWebhookPort()))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(webhookRequestPrefix+"/", ga.ipAllowlistMiddleware(webhookRequestPrefix)(
+		ga.admissionMiddleware(http.HandlerFunc(ga.receiveWebhook))))
+	ga.webhookSrv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := ga.webhookSrv.Serve(ls); err != nil && err != http.ErrServerClosed {
+			panic(fmt.Sprintf("start webhook receiver failed: %s", err.Error()))
+		}
+	}()
+	return nil
+}
+
+func (ga *ceGateway) receiveWebhook(w http.ResponseWriter, req *http.Request) {
+	ctx, span := ga.tracer.Start(req.Context(), "receiveWebhook")
+	defer span.End()
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	ebName := strings.Trim(strings.TrimPrefix(req.URL.Path, webhookRequestPrefix), "/")
+	if ebName == "" || strings.Contains(ebName, "/") {
+		http.Error(w, "invalid eventbus name", http.StatusBadRequest)
+		return
+	}
+	if err := ga.authorize(ctx, req.Header, ebName, acl.PermissionPublish); err != nil {
+		http.Error(w, err.Error(), authorizeStatusCode(err))
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxWebhookBodySize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("read body failed: %s", err), http.StatusBadRequest)
+		return
+	}
+	event, err := ga.webhookToEvent(ebName, req, body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = ga.validateEvent(ebName, event); err != nil {
+		ve := &ValidationError{}
+		if errors.As(err, &ve) {
+			writeJSON(w, ve.statusCode(), ve)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	eventID, err := ga.getBusWriter(ctx, ebName).AppendOne(ctx, event)
+	if err != nil {
+		log.Warning(ctx, "append webhook event failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   ebName,
+		})
+		http.Error(w, err.Error(), appendStatusCode(err))
+		return
+	}
+	data, _ := json.Marshal(EventData{
+		BusName: ebName,
+		EventID: eventID,
+	})
+	w.Header().Set("Content-Type", v2.ApplicationJSON)
+	_, _ = w.Write(data)
+}
+
+// webhookToEvent wraps the request into a CloudEvent by the rule of eventbus,
+// the body is carried as data as it is.
+func (ga *ceGateway) webhookToEvent(ebName string, req *http.Request, body []byte) (*v2.Event, error) {
+	rule := ga.config.Webhook.Rules[ebName]
+	e := v2.NewEvent()
+	e.SetID(headerOrDefault(req, rule.IDHeader, uuid.NewString()))
+	e.SetType(headerOrDefault(req, rule.TypeHeader, valueOrDefault(rule.Type, defaultWebhookType)))
+	e.SetSource(headerOrDefault(req, rule.SourceHeader,
+		valueOrDefault(rule.Source, fmt.Sprintf("%s/%s", webhookRequestPrefix, ebName))))
+	e.SetTime(time.Now())
+	for header, attr := range rule.Extensions {
+		v := req.Header.Get(header)
+		if v == "" {
+			continue
+		}
+		if err := ga.reserved.Check(attr); err != nil {
+			return nil, err
+		}
+		if err := e.Context.SetExtension(attr, v); err != nil {
+			return nil, fmt.Errorf("invalid ce attribute [%s]: %w", attr, err)
+		}
+	}
+	contentType := req.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = v2.ApplicationJSON
+	}
+	if len(body) > 0 {
+		if err := e.SetData(contentType, body); err != nil {
+			return nil, err
+		}
+	}
+	e.SetExtension(primitive.XVanusEventbus, ebName)
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func headerOrDefault(req *http.Request, header, def string) string {
+	if header == "" {
+		return def
+	}
+	return valueOrDefault(req.Header.Get(header), def)
+}
+
+func valueOrDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
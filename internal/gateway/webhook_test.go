@@ -0,0 +1,121 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_receiveWebhook(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+	mockClient := client.NewMockClient(ctrl)
+	mockEventbus := api.NewMockEventbus(ctrl)
+	mockBusWriter := api.NewMockBusWriter(ctrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+
+	ga := &ceGateway{
+		reserved: primitive.NewReservedAttributes(nil),
+		client:   mockClient,
+		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		config: Config{
+			Webhook: WebhookConfig{
+				Enable: true,
+				Rules: map[string]WebhookRule{
+					"github": {
+						Source:     "https://github.com",
+						TypeHeader: "X-GitHub-Event",
+						IDHeader:   "X-GitHub-Delivery",
+						Extensions: map[string]string{"X-GitHub-Hook-ID": "hookid"},
+					},
+				},
+			},
+		},
+	}
+
+	Convey("test receive webhook", t, func() {
+		Convey("test invalid request", func() {
+			w := httptest.NewRecorder()
+			ga.receiveWebhook(w, httptest.NewRequest(http.MethodGet, "/webhook/github", nil))
+			So(w.Code, ShouldEqual, http.StatusMethodNotAllowed)
+
+			w = httptest.NewRecorder()
+			ga.receiveWebhook(w, httptest.NewRequest(http.MethodPost, "/webhook/", nil))
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("test wrap request by rule", func() {
+			var event *ce.Event
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).DoAndReturn(
+				func(_ interface{}, e *ce.Event, _ ...api.WriteOption) (string, error) {
+					event = e
+					return "AABBCC", nil
+				})
+			req := httptest.NewRequest(http.MethodPost, "/webhook/github", bytes.NewBufferString(`{"action":"opened"}`))
+			req.Header.Set("Content-Type", ce.ApplicationJSON)
+			req.Header.Set("X-GitHub-Event", "pull_request")
+			req.Header.Set("X-GitHub-Delivery", "delivery-id")
+			req.Header.Set("X-GitHub-Hook-ID", "123")
+			w := httptest.NewRecorder()
+			ga.receiveWebhook(w, req)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			var ed EventData
+			So(json.Unmarshal(w.Body.Bytes(), &ed), ShouldBeNil)
+			So(ed.EventID, ShouldEqual, "AABBCC")
+			So(ed.BusName, ShouldEqual, "github")
+
+			So(event.ID(), ShouldEqual, "delivery-id")
+			So(event.Type(), ShouldEqual, "pull_request")
+			So(event.Source(), ShouldEqual, "https://github.com")
+			So(event.Extensions()["hookid"], ShouldEqual, "123")
+			So(event.Extensions()[primitive.XVanusEventbus], ShouldEqual, "github")
+			So(string(event.Data()), ShouldEqual, `{"action":"opened"}`)
+		})
+
+		Convey("test wrap request by default rule", func() {
+			var event *ce.Event
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).DoAndReturn(
+				func(_ interface{}, e *ce.Event, _ ...api.WriteOption) (string, error) {
+					event = e
+					return "AABBCC", nil
+				})
+			req := httptest.NewRequest(http.MethodPost, "/webhook/test", bytes.NewBufferString("a=b"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			ga.receiveWebhook(w, req)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(event.ID(), ShouldNotBeEmpty)
+			So(event.Type(), ShouldEqual, defaultWebhookType)
+			So(event.Source(), ShouldEqual, "/webhook/test")
+			So(event.DataContentType(), ShouldEqual, "application/x-www-form-urlencoded")
+			So(string(event.Data()), ShouldEqual, "a=b")
+		})
+	})
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/gorilla/websocket"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// The WebSocket endpoint streams events of eventbus to clients in structured JSON. After the
+// events read in a round, a cursor message {"cursor": "..."} is sent, the client resumes from it
+// by the cursor query parameter or by sending the cursor message back at any time.
+
+const (
+	websocketRequestPrefix = "/ws/eventbus/"
+	websocketWriteTimeout  = 10 * time.Second
+)
+
+type websocketCursorMessage struct {
+	Cursor string `json:"cursor"`
+}
+
+type websocketSender struct {
+	conn *websocket.Conn
+}
+
+func (ga *ceGateway) startWebSocketReceiver() error {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetWebSocketPort()))
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(websocketRequestPrefix,
+		ga.ipAllowlistMiddleware(websocketRequestPrefix)(http.HandlerFunc(ga.serveWebSocket)))
+	ga.websocketSrv = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := ga.websocketSrv.Serve(ls); err != nil && err != http.ErrServerClosed {
+			panic(fmt.Sprintf("start WebSocket server failed: %s", err.Error()))
+		}
+	}()
+	return nil
+}
+
+func (ga *ceGateway) websocketUpgrader() *websocket.Upgrader {
+	return &websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			origins := ga.config.WebSocket.AllowedOrigins
+			if len(origins) == 0 {
+				return true
+			}
+			origin := r.Header.Get("Origin")
+			for _, o := range origins {
+				if o == origin {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func (ga *ceGateway) serveWebSocket(w http.ResponseWriter, req *http.Request) {
+	sr, err := parseStreamRequest(req, websocketRequestPrefix, ga.config.GetWebSocketMaxRate())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ctx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+	if err = ga.authorize(ctx, req.Header, sr.ebName, acl.PermissionSubscribe); err != nil {
+		http.Error(w, err.Error(), authorizeStatusCode(err))
+		return
+	}
+	sender := &websocketSender{}
+	s, err := ga.newEventStream(ctx, sr, sender)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	conn, err := ga.websocketUpgrader().Upgrade(w, req, nil)
+	if err != nil {
+		// the upgrader has replied the error
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+	sender.conn = conn
+	cursorCh := make(chan map[uint64]int64, 1)
+	go func() {
+		defer cancel()
+		sender.readCursor(ctx, cursorCh)
+	}()
+	if err = s.run(ctx, cursorCh); err != nil && !stderr.Is(err, context.Canceled) {
+		log.Warning(ctx, "stream events to WebSocket failed", map[string]interface{}{
+			log.KeyError:        err,
+			log.KeyEventbusName: sr.ebName,
+		})
+		_ = sender.close(websocket.CloseInternalServerErr, err.Error())
+	}
+}
+
+// readCursor reads cursor messages from client until the connection is closed.
+func (s *websocketSender) readCursor(ctx context.Context, cursorCh chan<- map[uint64]int64) {
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg websocketCursorMessage
+		if err = json.Unmarshal(data, &msg); err != nil || msg.Cursor == "" {
+			continue
+		}
+		offsets, err := decodeStreamCursor(msg.Cursor)
+		if err != nil {
+			continue
+		}
+		select {
+		case cursorCh <- offsets:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *websocketSender) sendEvent(e *ce.Event, _ string) error {
+	data, err := e.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_ = s.conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout))
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (s *websocketSender) sendCursor(cursor string) error {
+	data, err := json.Marshal(websocketCursorMessage{Cursor: cursor})
+	if err != nil {
+		return err
+	}
+	_ = s.conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout))
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (s *websocketSender) close(code int, reason string) error {
+	return s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason),
+		time.Now().Add(websocketWriteTimeout))
+}
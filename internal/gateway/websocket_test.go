@@ -0,0 +1,132 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/gorilla/websocket"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGateway_serveWebSocket(t *testing.T) {
+	newEvent := func(id, eventType string, offset int64) *ce.Event {
+		e := ce.NewEvent()
+		e.SetID(id)
+		e.SetSource("/test")
+		e.SetType(eventType)
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, uint64(offset))
+		e.SetExtension(eventlog.XVanusLogOffset, buf)
+		return &e
+	}
+	readMessage := func(conn *websocket.Conn) []byte {
+		_ = conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+		_, data, err := conn.ReadMessage()
+		So(err, ShouldBeNil)
+		return data
+	}
+
+	Convey("test stream events by WebSocket", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockEventlog := api.NewMockEventlog(ctrl)
+		mockBusReader := api.NewMockBusReader(ctrl)
+		mockClient.EXPECT().Eventbus(Any(), "test").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+		mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+		mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+		mockBusReader.EXPECT().Read(Any()).Return([]*ce.Event{
+			newEvent("id-1", "order.created", 5), newEvent("id-2", "order.deleted", 6),
+		}, int64(0), uint64(1), nil)
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd)
+
+		ga := &ceGateway{
+			client: mockClient,
+			config: Config{WebSocket: WebSocketConfig{Enable: true}},
+		}
+		srv := httptest.NewServer(http.HandlerFunc(ga.serveWebSocket))
+		defer srv.Close()
+		wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + websocketRequestPrefix + "test"
+
+		Convey("test stream from the latest", func() {
+			mockEventlog.EXPECT().LatestOffset(Any()).Return(int64(5), nil)
+			conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			for _, id := range []string{"id-1", "id-2"} {
+				e := ce.NewEvent()
+				So(json.Unmarshal(readMessage(conn), &e), ShouldBeNil)
+				So(e.ID(), ShouldEqual, id)
+				So(e.Extensions()[eventlog.XVanusLogOffset], ShouldBeNil)
+			}
+			var msg websocketCursorMessage
+			So(json.Unmarshal(readMessage(conn), &msg), ShouldBeNil)
+			offsets, err := decodeStreamCursor(msg.Cursor)
+			So(err, ShouldBeNil)
+			So(offsets, ShouldResemble, map[uint64]int64{1: 7})
+		})
+
+		Convey("test stream from cursor with filters", func() {
+			filters := `[{"exact":{"type":"order.deleted"}}]`
+			q := url.Values{}
+			q.Set("filters", filters)
+			q.Set("cursor", encodeStreamCursor(map[uint64]int64{1: 5}))
+			conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?"+q.Encode(), nil)
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			e := ce.NewEvent()
+			So(json.Unmarshal(readMessage(conn), &e), ShouldBeNil)
+			So(e.ID(), ShouldEqual, "id-2")
+			// the cursor covers the filtered events
+			var msg websocketCursorMessage
+			So(json.Unmarshal(readMessage(conn), &msg), ShouldBeNil)
+			offsets, err := decodeStreamCursor(msg.Cursor)
+			So(err, ShouldBeNil)
+			So(offsets, ShouldResemble, map[uint64]int64{1: 7})
+		})
+	})
+
+	Convey("test invalid requests", t, func() {
+		ga := &ceGateway{}
+		srv := httptest.NewServer(http.HandlerFunc(ga.serveWebSocket))
+		defer srv.Close()
+		wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + websocketRequestPrefix + "test"
+		for _, q := range []string{"?filters=invalid", "?rate=0", "?cursor=invalid!"} {
+			_, resp, err := websocket.DefaultDialer.Dial(wsURL+q, nil)
+			So(err, ShouldNotBeNil)
+			So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+			_ = resp.Body.Close()
+		}
+	})
+}
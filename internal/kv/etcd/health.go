@@ -0,0 +1,35 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/observability/health"
+)
+
+const healthKey = "/health"
+
+// NewHealthCheck returns a check which reports whether etcd is reachable.
+func NewHealthCheck(endpoints []string, keyPrefix string) (health.Check, error) {
+	cli, err := NewEtcdClientV3(endpoints, keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		_, err := cli.Exists(ctx, healthKey)
+		return err
+	}, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability"
+)
+
+type Config struct {
+	// Name identifies the agent in the consumer groups, it must be unique among the agents which mirror
+	// the same eventbuses, a random one is used if it's empty.
+	Name string `yaml:"name"`
+	// Cluster is the name of source cluster, it's the origin of the mirrored events.
+	Cluster       string           `yaml:"cluster"`
+	CtrlEndpoints []string         `yaml:"controllers"`
+	Target        ClusterConfig    `yaml:"target"`
+	Eventbuses    []EventbusConfig `yaml:"eventbuses"`
+	// Group is the prefix of consumer groups in source cluster, the offsets are committed to them.
+	Group string `yaml:"group"`
+	// FromWhere is where to start mirroring an eventlog which has no committed offset, earliest or latest.
+	FromWhere     api.ConsumeFromWhere `yaml:"from_where"`
+	BatchSize     int                  `yaml:"batch_size"`
+	LagInterval   time.Duration        `yaml:"lag_interval"`
+	Observability observability.Config `yaml:"observability"`
+}
+
+type ClusterConfig struct {
+	Cluster       string   `yaml:"cluster"`
+	CtrlEndpoints []string `yaml:"controllers"`
+}
+
+type EventbusConfig struct {
+	Source string `yaml:"source"`
+	// Target is the eventbus in target cluster, it's the same as Source if it's empty.
+	Target string `yaml:"target"`
+}
+
+// GetGroup returns the consumer group which mirrors the eventbus.
+func (c *Config) GetGroup(eventbus string) string {
+	return fmt.Sprintf("%s-%s", c.Group, eventbus)
+}
+
+func (c *Config) Validate() error {
+	if c.Cluster == "" || c.Target.Cluster == "" {
+		return fmt.Errorf("the name of source and target cluster can't be empty")
+	}
+	if c.Cluster == c.Target.Cluster {
+		return fmt.Errorf("the source and target cluster can't be the same")
+	}
+	if len(c.CtrlEndpoints) == 0 || len(c.Target.CtrlEndpoints) == 0 {
+		return fmt.Errorf("the controllers of source and target cluster can't be empty")
+	}
+	if len(c.Eventbuses) == 0 {
+		return fmt.Errorf("no eventbus to mirror")
+	}
+	for _, eb := range c.Eventbuses {
+		if eb.Source == "" {
+			return fmt.Errorf("the source eventbus can't be empty")
+		}
+	}
+	if c.FromWhere != api.ConsumeFromWhereEarliest && c.FromWhere != api.ConsumeFromWhereLatest {
+		return fmt.Errorf("invalid from_where: %s", c.FromWhere)
+	}
+	return nil
+}
+
+func Default(c *Config) {
+	if c.Group == "" {
+		c.Group = "mirror-to-" + c.Target.Cluster
+	}
+	// mirror all events retained by default, it's the point of disaster recovery.
+	if c.FromWhere == "" {
+		c.FromWhere = api.ConsumeFromWhereEarliest
+	}
+	if c.BatchSize == 0 {
+		c.BatchSize = 64
+	}
+	if c.LagInterval == 0 {
+		c.LagInterval = 10 * time.Second
+	}
+	for i := range c.Eventbuses {
+		if c.Eventbuses[i].Target == "" {
+			c.Eventbuses[i].Target = c.Eventbuses[i].Source
+		}
+	}
+}
+
+func InitConfig(filename string) (*Config, error) {
+	c := new(Config)
+	err := primitive.LoadConfig(filename, c)
+	if err != nil {
+		return nil, err
+	}
+	Default(c)
+	if err = c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/consumer"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// XVanusMirrorOrigin is the extension attribute of mirrored event, the value is the name of cluster
+	// where the event was appended first. The event isn't mirrored back into its origin cluster, so that
+	// two clusters can mirror each other without loop.
+	XVanusMirrorOrigin = "xvanusmirrororigin"
+)
+
+// Mirror replicates the eventbuses of source cluster into target cluster asynchronously. Each eventbus is
+// consumed by a consumer group of source cluster, so the agents mirroring the same eventbuses share the
+// eventlogs, and the offsets committed to the group are the checkpoints to continue from after restart.
+// The events are mirrored at least once, those appended but not committed are mirrored again.
+type Mirror struct {
+	cfg    *Config
+	source client.Client
+	target client.Client
+	// ctrl is the controller of source cluster, which the offsets of consumer groups are got from.
+	ctrl   ctrlpb.EventBusControllerClient
+	groups []consumer.ConsumerGroup
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(cfg *Config) *Mirror {
+	return &Mirror{
+		cfg:    cfg,
+		source: client.Connect(cfg.CtrlEndpoints),
+		target: client.Connect(cfg.Target.CtrlEndpoints),
+		ctrl: cluster.NewClusterController(cfg.CtrlEndpoints, insecure.NewCredentials()).
+			EventbusService().RawClient(),
+	}
+}
+
+func (m *Mirror) Start(ctx context.Context) error {
+	ctx, m.cancel = context.WithCancel(ctx)
+	opts := []consumer.Option{
+		consumer.WithBatchSize(m.cfg.BatchSize),
+		consumer.WithConsumeFromWhere(m.cfg.FromWhere),
+	}
+	if m.cfg.Name != "" {
+		opts = append(opts, consumer.WithMemberID(m.cfg.Name))
+	}
+	for _, eb := range m.cfg.Eventbuses {
+		group := m.source.ConsumerGroup(ctx, m.cfg.GetGroup(eb.Source), eb.Source, opts...)
+		m.groups = append(m.groups, group)
+		handler := m.newHandler(ctx, eb)
+		m.wg.Add(1)
+		go func(eb EventbusConfig) {
+			defer m.wg.Done()
+			if err := group.Consume(ctx, handler); err != nil {
+				log.Error(ctx, "mirror eventbus failed", map[string]interface{}{
+					log.KeyError: err,
+					"source":     eb.Source,
+					"target":     eb.Target,
+				})
+			}
+		}(eb)
+		log.Info(ctx, "start mirroring eventbus", map[string]interface{}{
+			"source": eb.Source,
+			"target": eb.Target,
+			"group":  m.cfg.GetGroup(eb.Source),
+		})
+	}
+	m.startLagMonitor(ctx)
+	return nil
+}
+
+func (m *Mirror) Stop(ctx context.Context) {
+	for _, group := range m.groups {
+		group.Close(ctx)
+	}
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.wg.Wait()
+	m.source.Disconnect(ctx)
+	m.target.Disconnect(ctx)
+}
+
+// newHandler returns the handler which appends the events of source eventbus to the target eventbus,
+// the events originated from target cluster are skipped.
+func (m *Mirror) newHandler(ctx context.Context, eb EventbusConfig) consumer.Handler {
+	writer := m.target.Eventbus(ctx, eb.Target).Writer()
+	return func(ctx context.Context, eventlogID uint64, events []*ce.Event) error {
+		batch := make([]*ce.Event, 0, len(events))
+		var last time.Time
+		for _, e := range events {
+			if t, ok := storedTime(e); ok {
+				last = t
+			}
+			if origin(e) == m.cfg.Target.Cluster {
+				continue
+			}
+			batch = append(batch, m.newMirrorEvent(e))
+		}
+		if len(batch) > 0 {
+			if _, err := writer.AppendMany(ctx, batch); err != nil {
+				return err
+			}
+		}
+		metrics.MirrorEventCounterVec.WithLabelValues(eb.Source, metrics.LabelValueMirrorEventMirrored).
+			Add(float64(len(batch)))
+		metrics.MirrorEventCounterVec.WithLabelValues(eb.Source, metrics.LabelValueMirrorEventSkipped).
+			Add(float64(len(events) - len(batch)))
+		if !last.IsZero() {
+			metrics.MirrorLagSecondGaugeVec.WithLabelValues(eb.Source).Set(time.Since(last).Seconds())
+		}
+		return nil
+	}
+}
+
+// newMirrorEvent removes the positions of event in source cluster, and marks its origin.
+func (m *Mirror) newMirrorEvent(e *ce.Event) *ce.Event {
+	e.SetExtension(segpb.XVanusStime, nil)
+	e.SetExtension(segpb.XVanusBlockOffset, nil)
+	e.SetExtension(segpb.XVanusLogOffset, nil)
+	if origin(e) == "" {
+		e.SetExtension(XVanusMirrorOrigin, m.cfg.Cluster)
+	}
+	return e
+}
+
+func (m *Mirror) startLagMonitor(ctx context.Context) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.cfg.LagInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, eb := range m.cfg.Eventbuses {
+					if err := m.updateLag(ctx, eb); err != nil {
+						log.Warning(ctx, "update lag of mirror failed", map[string]interface{}{
+							log.KeyError: err,
+							"source":     eb.Source,
+						})
+					}
+				}
+			}
+		}
+	}()
+}
+
+// updateLag sets the lag of each eventlog, which is the distance between its latest offset and the
+// offset committed by mirror.
+func (m *Mirror) updateLag(ctx context.Context, eb EventbusConfig) error {
+	res, err := m.ctrl.GetConsumerGroupOffset(ctx, &ctrlpb.GetConsumerGroupOffsetRequest{
+		Group: m.cfg.GetGroup(eb.Source),
+	})
+	if err != nil {
+		return err
+	}
+	committed := make(map[uint64]int64, len(res.Offsets))
+	for _, o := range res.Offsets {
+		committed[o.EventlogId] = o.Offset
+	}
+	ls, err := m.source.Eventbus(ctx, eb.Source).ListLog(ctx)
+	if err != nil {
+		return err
+	}
+	for _, l := range ls {
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return err
+		}
+		offset, ok := committed[l.ID()]
+		if !ok {
+			// nothing has been mirrored yet.
+			if offset, err = l.EarliestOffset(ctx); err != nil {
+				return err
+			}
+		}
+		lag := latest - offset
+		if lag < 0 {
+			lag = 0
+		}
+		metrics.MirrorLagEventGaugeVec.WithLabelValues(eb.Source, strconv.FormatUint(l.ID(), 10)).
+			Set(float64(lag))
+	}
+	return nil
+}
+
+func origin(e *ce.Event) string {
+	v, ok := e.Extensions()[XVanusMirrorOrigin]
+	if !ok {
+		return ""
+	}
+	s, _ := types.ToString(v)
+	return s
+}
+
+func storedTime(e *ce.Event) (time.Time, bool) {
+	v, ok := e.Extensions()[segpb.XVanusStime]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := types.ToTime(v)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirror
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testConfig() *Config {
+	cfg := &Config{
+		Cluster:       "a",
+		CtrlEndpoints: []string{"127.0.0.1:2048"},
+		Target:        ClusterConfig{Cluster: "b", CtrlEndpoints: []string{"127.0.0.2:2048"}},
+		Eventbuses:    []EventbusConfig{{Source: "test"}},
+	}
+	Default(cfg)
+	return cfg
+}
+
+func TestConfig(t *testing.T) {
+	Convey("test mirror config", t, func() {
+		cfg := testConfig()
+		So(cfg.Validate(), ShouldBeNil)
+		So(cfg.Eventbuses[0].Target, ShouldEqual, "test")
+		So(cfg.FromWhere, ShouldEqual, api.ConsumeFromWhereEarliest)
+		So(cfg.GetGroup("test"), ShouldEqual, "mirror-to-b-test")
+
+		cfg.Target.Cluster = "a"
+		So(cfg.Validate(), ShouldNotBeNil)
+		cfg.Target.Cluster = "b"
+		cfg.FromWhere = "middle"
+		So(cfg.Validate(), ShouldNotBeNil)
+		cfg.FromWhere = api.ConsumeFromWhereLatest
+		cfg.Eventbuses = nil
+		So(cfg.Validate(), ShouldNotBeNil)
+	})
+}
+
+func TestMirror_handler(t *testing.T) {
+	Convey("test mirror handler", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		target := client.NewMockClient(mockCtrl)
+		bus := api.NewMockEventbus(mockCtrl)
+		writer := api.NewMockBusWriter(mockCtrl)
+		target.EXPECT().Eventbus(gomock.Any(), "test").AnyTimes().Return(bus)
+		bus.EXPECT().Writer().AnyTimes().Return(writer)
+		m := &Mirror{cfg: testConfig(), target: target}
+		handler := m.newHandler(ctx, m.cfg.Eventbuses[0])
+
+		newEvent := func(id, origin string) *ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("source")
+			e.SetType("type")
+			e.SetExtension(segpb.XVanusStime, time.Now())
+			if origin != "" {
+				e.SetExtension(XVanusMirrorOrigin, origin)
+			}
+			return &e
+		}
+
+		Convey("test skip the events originated from target cluster", func() {
+			mirrored := testutil.ToFloat64(metrics.MirrorEventCounterVec.WithLabelValues(
+				"test", metrics.LabelValueMirrorEventMirrored))
+			skipped := testutil.ToFloat64(metrics.MirrorEventCounterVec.WithLabelValues(
+				"test", metrics.LabelValueMirrorEventSkipped))
+			writer.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) (string, error) {
+					So(events, ShouldHaveLength, 2)
+					So(events[0].ID(), ShouldEqual, "1")
+					So(events[0].Extensions()[XVanusMirrorOrigin], ShouldEqual, "a")
+					So(events[0].Extensions(), ShouldNotContainKey, segpb.XVanusStime)
+					// the origin of event mirrored from another cluster is kept.
+					So(events[1].ID(), ShouldEqual, "3")
+					So(events[1].Extensions()[XVanusMirrorOrigin], ShouldEqual, "c")
+					return "", nil
+				})
+			err := handler(ctx, 1, []*ce.Event{newEvent("1", ""), newEvent("2", "b"), newEvent("3", "c")})
+			So(err, ShouldBeNil)
+			So(testutil.ToFloat64(metrics.MirrorEventCounterVec.WithLabelValues(
+				"test", metrics.LabelValueMirrorEventMirrored)), ShouldEqual, mirrored+2)
+			So(testutil.ToFloat64(metrics.MirrorEventCounterVec.WithLabelValues(
+				"test", metrics.LabelValueMirrorEventSkipped)), ShouldEqual, skipped+1)
+
+			// nothing is appended if all events are skipped.
+			So(handler(ctx, 1, []*ce.Event{newEvent("4", "b")}), ShouldBeNil)
+		})
+
+		Convey("test append failed", func() {
+			writer.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).Return("", errors.ErrInternal)
+			err := handler(ctx, 1, []*ce.Event{newEvent("1", "")})
+			So(errors.Is(err, errors.ErrInternal), ShouldBeTrue)
+		})
+	})
+}
+
+func TestMirror_updateLag(t *testing.T) {
+	Convey("test update lag of mirror", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		source := client.NewMockClient(mockCtrl)
+		ctrl := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+		bus := api.NewMockEventbus(mockCtrl)
+		el1 := api.NewMockEventlog(mockCtrl)
+		el2 := api.NewMockEventlog(mockCtrl)
+		source.EXPECT().Eventbus(gomock.Any(), "test").AnyTimes().Return(bus)
+		bus.EXPECT().ListLog(gomock.Any()).Times(1).Return([]api.Eventlog{el1, el2}, nil)
+		el1.EXPECT().ID().AnyTimes().Return(uint64(1))
+		el1.EXPECT().LatestOffset(gomock.Any()).Times(1).Return(int64(100), nil)
+		el2.EXPECT().ID().AnyTimes().Return(uint64(2))
+		el2.EXPECT().LatestOffset(gomock.Any()).Times(1).Return(int64(50), nil)
+		el2.EXPECT().EarliestOffset(gomock.Any()).Times(1).Return(int64(20), nil)
+		ctrl.EXPECT().GetConsumerGroupOffset(gomock.Any(), &ctrlpb.GetConsumerGroupOffsetRequest{
+			Group: "mirror-to-b-test",
+		}).Times(1).Return(&ctrlpb.GetConsumerGroupOffsetResponse{
+			Offsets: []*ctrlpb.ConsumerGroupOffset{{EventlogId: 1, Offset: 90}},
+		}, nil)
+		m := &Mirror{cfg: testConfig(), source: source, ctrl: ctrl}
+
+		So(m.updateLag(ctx, m.cfg.Eventbuses[0]), ShouldBeNil)
+		So(testutil.ToFloat64(metrics.MirrorLagEventGaugeVec.WithLabelValues("test", "1")), ShouldEqual, 10)
+		So(testutil.ToFloat64(metrics.MirrorLagEventGaugeVec.WithLabelValues("test", "2")), ShouldEqual, 30)
+	})
+}
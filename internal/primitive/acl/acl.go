@@ -0,0 +1,130 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc/metadata"
+)
+
+type Permission string
+
+const (
+	PermissionPublish   Permission = "publish"
+	PermissionSubscribe Permission = "subscribe"
+	// PermissionAdmin allows managing the eventbus and its ACL entries, it implies the others.
+	PermissionAdmin Permission = "admin"
+
+	// AnyPrincipal matches all principals, including the anonymous one.
+	AnyPrincipal = "*"
+
+	// principalMetadataKey carries the principal authenticated by gateway to controllers.
+	principalMetadataKey = "x-vanus-principal"
+)
+
+func ParsePermission(s string) (Permission, error) {
+	switch p := Permission(s); p {
+	case PermissionPublish, PermissionSubscribe, PermissionAdmin:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid permission %s, must be publish, subscribe or admin", s)
+}
+
+// Config is the cluster wide ACL config of controller.
+type Config struct {
+	// Enable enforces the admin and subscribe permissions by controllers.
+	Enable bool `yaml:"enable"`
+	// DenyByDefault denies all principals to access the eventbus without any ACL entry.
+	DenyByDefault bool `yaml:"deny_by_default"`
+	// Admins are the principals allowed to access all eventbuses, which grant the others.
+	Admins []string `yaml:"admins"`
+}
+
+// Entry allows the principal to access an eventbus.
+type Entry struct {
+	Principal   string       `json:"principal"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Policy is the ACL entries of an eventbus with the cluster wide config.
+type Policy struct {
+	Config
+	Entries []*Entry
+}
+
+func NewPolicy(resp *ctrlpb.ListEventbusACLResponse) *Policy {
+	p := &Policy{
+		Config: Config{
+			DenyByDefault: resp.GetDenyByDefault(),
+			Admins:        resp.GetAdmins(),
+		},
+	}
+	for _, a := range resp.GetAcls() {
+		e := &Entry{Principal: a.Principal}
+		for _, perm := range a.Permissions {
+			e.Permissions = append(e.Permissions, Permission(perm))
+		}
+		p.Entries = append(p.Entries, e)
+	}
+	return p
+}
+
+// Allowed returns whether the principal has the permission, the anonymous principal is empty.
+func (p *Policy) Allowed(principal string, perm Permission) bool {
+	if principal != "" {
+		for _, admin := range p.Admins {
+			if admin == principal {
+				return true
+			}
+		}
+	}
+	if len(p.Entries) == 0 {
+		return !p.DenyByDefault
+	}
+	for _, e := range p.Entries {
+		if e.Principal != principal && e.Principal != AnyPrincipal {
+			continue
+		}
+		for _, v := range e.Permissions {
+			if v == perm || v == PermissionAdmin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithPrincipal carries the principal to controller in the outgoing metadata.
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	if principal == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, principalMetadataKey, principal)
+}
+
+// PrincipalFromContext returns the principal in the incoming metadata, it's empty for the anonymous one.
+func PrincipalFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(principalMetadataKey); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
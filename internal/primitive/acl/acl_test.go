@@ -0,0 +1,79 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"testing"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPolicy_Allowed(t *testing.T) {
+	Convey("test policy allowed", t, func() {
+		resp := &ctrlpb.ListEventbusACLResponse{Admins: []string{"root"}}
+		Convey("test eventbus without acl entry", func() {
+			p := NewPolicy(resp)
+			So(p.Allowed("", PermissionPublish), ShouldBeTrue)
+			So(p.Allowed("alice", PermissionAdmin), ShouldBeTrue)
+
+			resp.DenyByDefault = true
+			p = NewPolicy(resp)
+			So(p.Allowed("", PermissionPublish), ShouldBeFalse)
+			So(p.Allowed("alice", PermissionSubscribe), ShouldBeFalse)
+			So(p.Allowed("root", PermissionAdmin), ShouldBeTrue)
+		})
+		Convey("test eventbus with acl entries", func() {
+			resp.Acls = []*ctrlpb.EventbusACL{
+				{Principal: "alice", Permissions: []string{"publish"}},
+				{Principal: "bob", Permissions: []string{"admin"}},
+				{Principal: AnyPrincipal, Permissions: []string{"subscribe"}},
+			}
+			p := NewPolicy(resp)
+			So(p.Allowed("alice", PermissionPublish), ShouldBeTrue)
+			So(p.Allowed("alice", PermissionSubscribe), ShouldBeTrue)
+			So(p.Allowed("alice", PermissionAdmin), ShouldBeFalse)
+			So(p.Allowed("bob", PermissionPublish), ShouldBeTrue)
+			So(p.Allowed("bob", PermissionAdmin), ShouldBeTrue)
+			So(p.Allowed("", PermissionSubscribe), ShouldBeTrue)
+			So(p.Allowed("", PermissionPublish), ShouldBeFalse)
+			So(p.Allowed("root", PermissionAdmin), ShouldBeTrue)
+		})
+	})
+}
+
+func TestParsePermission(t *testing.T) {
+	Convey("test parse permission", t, func() {
+		p, err := ParsePermission("subscribe")
+		So(err, ShouldBeNil)
+		So(p, ShouldEqual, PermissionSubscribe)
+		_, err = ParsePermission("read")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestPrincipal(t *testing.T) {
+	Convey("test carry principal in metadata", t, func() {
+		ctx := context.Background()
+		So(PrincipalFromContext(ctx), ShouldBeEmpty)
+		So(WithPrincipal(ctx, ""), ShouldResemble, ctx)
+
+		md, _ := metadata.FromOutgoingContext(WithPrincipal(ctx, "alice"))
+		ctx = metadata.NewIncomingContext(ctx, md)
+		So(PrincipalFromContext(ctx), ShouldEqual, "alice")
+	})
+}
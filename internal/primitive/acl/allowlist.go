@@ -0,0 +1,113 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	AllowlistKindEventbus  = "eventbus"
+	AllowlistKindNamespace = "namespace"
+)
+
+func ParseAllowlistKind(s string) (string, error) {
+	switch s {
+	case AllowlistKindEventbus, AllowlistKindNamespace:
+		return s, nil
+	}
+	return "", fmt.Errorf("invalid allowlist kind %s, must be eventbus or namespace", s)
+}
+
+// ParseCIDR parses a CIDR or a single address, which is the CIDR of itself.
+func ParseCIDR(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid address %s", s)
+		}
+		if v4 := ip.To4(); v4 != nil {
+			return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %s", s)
+	}
+	return n, nil
+}
+
+// Allowlists are the source address allowlists of eventbuses and namespaces.
+type Allowlists struct {
+	eventbuses map[string][]*net.IPNet
+	namespaces map[string][]*net.IPNet
+}
+
+func NewAllowlists(resp *ctrlpb.ListIPAllowlistResponse) *Allowlists {
+	a := &Allowlists{
+		eventbuses: map[string][]*net.IPNet{},
+		namespaces: map[string][]*net.IPNet{},
+	}
+	for _, v := range resp.GetAllowlists() {
+		nets := make([]*net.IPNet, 0, len(v.Cidrs))
+		for _, cidr := range v.Cidrs {
+			// the CIDRs are validated by controller, skip the invalid one anyway.
+			if n, err := ParseCIDR(cidr); err == nil {
+				nets = append(nets, n)
+			}
+		}
+		switch v.Kind {
+		case AllowlistKindEventbus:
+			a.eventbuses[v.Name] = nets
+		case AllowlistKindNamespace:
+			a.namespaces[v.Name] = nets
+		}
+	}
+	return a
+}
+
+// Allowed returns whether the address is allowed to access the eventbus of the namespace, it must be
+// in both allowlists if they exist, and it's allowed if neither exists. The eventbus or namespace is
+// empty if the request hasn't one.
+func (a *Allowlists) Allowed(ip net.IP, eventbus, namespace string) bool {
+	if eventbus != "" && !contains(a.eventbuses, eventbus, ip) {
+		return false
+	}
+	if namespace != "" && !contains(a.namespaces, namespace, ip) {
+		return false
+	}
+	return true
+}
+
+func contains(allowlists map[string][]*net.IPNet, name string, ip net.IP) bool {
+	nets, ok := allowlists[name]
+	if !ok {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
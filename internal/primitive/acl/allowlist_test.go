@@ -0,0 +1,65 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acl
+
+import (
+	"net"
+	"testing"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseCIDR(t *testing.T) {
+	Convey("test parse CIDR", t, func() {
+		n, err := ParseCIDR("10.0.0.0/8")
+		So(err, ShouldBeNil)
+		So(n.String(), ShouldEqual, "10.0.0.0/8")
+		n, err = ParseCIDR("192.168.1.1")
+		So(err, ShouldBeNil)
+		So(n.String(), ShouldEqual, "192.168.1.1/32")
+		n, err = ParseCIDR("::1")
+		So(err, ShouldBeNil)
+		So(n.String(), ShouldEqual, "::1/128")
+		_, err = ParseCIDR("10.0.0.0/33")
+		So(err, ShouldNotBeNil)
+		_, err = ParseCIDR("localhost")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestAllowlists_Allowed(t *testing.T) {
+	Convey("test allowlists allowed", t, func() {
+		a := NewAllowlists(&ctrlpb.ListIPAllowlistResponse{
+			Allowlists: []*ctrlpb.IPAllowlist{
+				{Kind: AllowlistKindEventbus, Name: "test", Cidrs: []string{"10.0.0.0/8", "192.168.1.1"}},
+				{Kind: AllowlistKindNamespace, Name: "team-a", Cidrs: []string{"10.1.0.0/16"}},
+				{Kind: AllowlistKindNamespace, Name: "team-b", Cidrs: []string{}},
+			},
+		})
+		So(a.Allowed(net.ParseIP("10.2.0.1"), "test", ""), ShouldBeTrue)
+		So(a.Allowed(net.ParseIP("192.168.1.1"), "test", ""), ShouldBeTrue)
+		So(a.Allowed(net.ParseIP("192.168.1.2"), "test", ""), ShouldBeFalse)
+		So(a.Allowed(net.ParseIP("192.168.1.2"), "other", ""), ShouldBeTrue)
+
+		So(a.Allowed(net.ParseIP("10.1.0.1"), "test", "team-a"), ShouldBeTrue)
+		So(a.Allowed(net.ParseIP("10.2.0.1"), "test", "team-a"), ShouldBeFalse)
+		So(a.Allowed(net.ParseIP("10.2.0.1"), "", "team-a"), ShouldBeFalse)
+		So(a.Allowed(net.ParseIP("10.2.0.1"), "", "team-b"), ShouldBeFalse)
+		So(a.Allowed(net.ParseIP("10.2.0.1"), "", "team-c"), ShouldBeTrue)
+		So(a.Allowed(nil, "test", ""), ShouldBeFalse)
+		So(a.Allowed(nil, "other", ""), ShouldBeTrue)
+	})
+}
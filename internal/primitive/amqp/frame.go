@@ -0,0 +1,180 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	FrameTypeAMQP = 0x00
+	FrameTypeSASL = 0x01
+
+	// DefaultMaxFrameSize is the max frame size announced in open.
+	DefaultMaxFrameSize = 1024 * 1024
+	frameHeaderSize     = 8
+)
+
+// Descriptor codes of performatives and the composite types used.
+const (
+	CodeOpen               uint64 = 0x10
+	CodeBegin              uint64 = 0x11
+	CodeAttach             uint64 = 0x12
+	CodeFlow               uint64 = 0x13
+	CodeTransfer           uint64 = 0x14
+	CodeDisposition        uint64 = 0x15
+	CodeDetach             uint64 = 0x16
+	CodeEnd                uint64 = 0x17
+	CodeClose              uint64 = 0x18
+	CodeError              uint64 = 0x1d
+	CodeAccepted           uint64 = 0x24
+	CodeRejected           uint64 = 0x25
+	CodeReleased           uint64 = 0x26
+	CodeModified           uint64 = 0x27
+	CodeSource             uint64 = 0x28
+	CodeTarget             uint64 = 0x29
+	CodeSASLMechanisms     uint64 = 0x40
+	CodeSASLInit           uint64 = 0x41
+	CodeSASLOutcome        uint64 = 0x44
+	CodeHeader             uint64 = 0x70
+	CodeDeliveryAnnotation uint64 = 0x71
+	CodeMessageAnnotations uint64 = 0x72
+	CodeProperties         uint64 = 0x73
+	CodeApplicationProps   uint64 = 0x74
+	CodeData               uint64 = 0x75
+	CodeAMQPSequence       uint64 = 0x76
+	CodeAMQPValue          uint64 = 0x77
+	CodeFooter             uint64 = 0x78
+)
+
+const (
+	RoleSender   = false
+	RoleReceiver = true
+
+	SASLMechanismAnonymous Symbol = "ANONYMOUS"
+	SASLMechanismPlain     Symbol = "PLAIN"
+	SASLCodeOK                    = uint8(0)
+	SASLCodeAuth                  = uint8(1)
+
+	ErrorInternal       Symbol = "amqp:internal-error"
+	ErrorNotFound       Symbol = "amqp:not-found"
+	ErrorInvalidField   Symbol = "amqp:invalid-field"
+	ErrorNotImplemented Symbol = "amqp:not-implemented"
+	ErrorDecode         Symbol = "amqp:decode-error"
+	ErrorUnauthorized   Symbol = "amqp:unauthorized-access"
+)
+
+var (
+	ProtocolHeaderAMQP = []byte{'A', 'M', 'Q', 'P', 0, 1, 0, 0}
+	ProtocolHeaderSASL = []byte{'A', 'M', 'Q', 'P', 3, 1, 0, 0}
+)
+
+// Frame is an AMQP or SASL frame, Performative is nil for an empty frame which is used as heartbeat.
+type Frame struct {
+	Type         byte
+	Channel      uint16
+	Performative *Described
+	// Payload is the rest of body following performative, which is a message fragment of transfer.
+	Payload []byte
+}
+
+// NewPerformative returns the described list of performative, trailing nil fields are omitted.
+func NewPerformative(code uint64, fields ...interface{}) *Described {
+	for len(fields) > 0 && fields[len(fields)-1] == nil {
+		fields = fields[:len(fields)-1]
+	}
+	return &Described{Descriptor: code, Value: List(fields)}
+}
+
+// NewError returns the AMQP error used in detach, end, close and rejected.
+func NewError(condition Symbol, description string) *Described {
+	return NewPerformative(CodeError, condition, description)
+}
+
+// ErrorDescription formats the AMQP error of v.
+func ErrorDescription(v interface{}) string {
+	d, ok := v.(*Described)
+	if !ok || d.Code() != CodeError {
+		return ""
+	}
+	condition, _ := d.Fields().Field(0).(Symbol)
+	description, _ := d.Fields().Field(1).(string)
+	return fmt.Sprintf("%s: %s", condition, description)
+}
+
+// ReadProtocolHeader reads the 8 bytes protocol header.
+func ReadProtocolHeader(r io.Reader) ([]byte, error) {
+	header := make([]byte, len(ProtocolHeaderAMQP))
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header, ProtocolHeaderAMQP) && !bytes.Equal(header, ProtocolHeaderSASL) {
+		return header, fmt.Errorf("unsupported AMQP protocol header %v", header)
+	}
+	return header, nil
+}
+
+func ReadFrame(r io.Reader, maxSize uint32) (*Frame, error) {
+	header := make([]byte, frameHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	doff := int(header[4]) * 4
+	if size < frameHeaderSize || size > maxSize || doff < frameHeaderSize || doff > int(size) {
+		return nil, fmt.Errorf("invalid AMQP frame size %d", size)
+	}
+	body := make([]byte, size-frameHeaderSize)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	f := &Frame{Type: header[5], Channel: binary.BigEndian.Uint16(header[6:])}
+	body = body[doff-frameHeaderSize:]
+	if len(body) == 0 {
+		return f, nil
+	}
+	v, n, err := Unmarshal(body)
+	if err != nil {
+		return nil, err
+	}
+	performative, ok := v.(*Described)
+	if !ok {
+		return nil, ErrMalformed
+	}
+	f.Performative = performative
+	f.Payload = body[n:]
+	return f, nil
+}
+
+func WriteFrame(w io.Writer, f *Frame) error {
+	buf := &bytes.Buffer{}
+	buf.Write(make([]byte, frameHeaderSize))
+	if f.Performative != nil {
+		if err := encode(buf, f.Performative); err != nil {
+			return err
+		}
+		buf.Write(f.Payload)
+	}
+	b := buf.Bytes()
+	binary.BigEndian.PutUint32(b, uint32(len(b)))
+	b[4] = frameHeaderSize / 4
+	b[5] = f.Type
+	binary.BigEndian.PutUint16(b[6:], f.Channel)
+	_, err := w.Write(b)
+	return err
+}
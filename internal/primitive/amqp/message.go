@@ -0,0 +1,111 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+const (
+	// CloudEventsPropertyPrefix is the prefix of application properties carrying CloudEvents
+	// attributes in the binary content mode of CloudEvents AMQP protocol binding.
+	CloudEventsPropertyPrefix = "cloudEvents:"
+	// LegacyCloudEventsPropertyPrefix is used by the binding before v1.0.1.
+	LegacyCloudEventsPropertyPrefix = "cloudEvents_"
+)
+
+// Message is an AMQP message, fields not used by vanus are skipped.
+type Message struct {
+	MessageID             interface{}
+	Subject               string
+	ContentType           Symbol
+	CreationTime          time.Time
+	ApplicationProperties Map
+	// Data is the concatenation of data sections.
+	Data []byte
+	// Value is the body of amqp-value section.
+	Value interface{}
+}
+
+func (m *Message) MarshalBinary() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var creationTime interface{}
+	if !m.CreationTime.IsZero() {
+		creationTime = m.CreationTime
+	}
+	var subject, contentType interface{}
+	if m.Subject != "" {
+		subject = m.Subject
+	}
+	if m.ContentType != "" {
+		contentType = m.ContentType
+	}
+	sections := []*Described{
+		NewPerformative(CodeProperties, m.MessageID, nil, nil, subject, nil, nil, contentType, nil, nil, creationTime),
+	}
+	if len(m.ApplicationProperties) > 0 {
+		sections = append(sections, &Described{Descriptor: CodeApplicationProps, Value: m.ApplicationProperties})
+	}
+	if m.Value != nil {
+		sections = append(sections, &Described{Descriptor: CodeAMQPValue, Value: m.Value})
+	} else {
+		sections = append(sections, &Described{Descriptor: CodeData, Value: m.Data})
+	}
+	for _, s := range sections {
+		if err := encode(buf, s); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func UnmarshalMessage(data []byte) (*Message, error) {
+	m := &Message{}
+	for len(data) > 0 {
+		v, n, err := Unmarshal(data)
+		if err != nil {
+			return nil, err
+		}
+		data = data[n:]
+		section, ok := v.(*Described)
+		if !ok {
+			return nil, ErrMalformed
+		}
+		switch section.Code() {
+		case CodeProperties:
+			props := section.Fields()
+			m.MessageID = props.Field(0)
+			m.Subject, _ = props.Field(3).(string)
+			m.ContentType, _ = props.Field(6).(Symbol)
+			m.CreationTime, _ = props.Field(9).(time.Time)
+		case CodeApplicationProps:
+			m.ApplicationProperties, _ = section.Value.(Map)
+		case CodeData:
+			b, ok := section.Value.([]byte)
+			if !ok {
+				return nil, ErrMalformed
+			}
+			m.Data = append(m.Data, b...)
+		case CodeAMQPValue:
+			m.Value = section.Value
+		case CodeHeader, CodeDeliveryAnnotation, CodeMessageAnnotations, CodeAMQPSequence, CodeFooter:
+		default:
+			return nil, fmt.Errorf("unknown AMQP message section 0x%x", section.Code())
+		}
+	}
+	return m, nil
+}
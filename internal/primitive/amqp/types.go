@@ -0,0 +1,491 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amqp implements the subset of AMQP 1.0 used by the gateway listener and the
+// trigger sink, which are the type system, framing and message format.
+package amqp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+var ErrMalformed = errors.New("malformed AMQP data")
+
+// Symbol is the AMQP symbol type.
+type Symbol string
+
+// UUID is the AMQP uuid type.
+type UUID [16]byte
+
+// List is the AMQP list type.
+type List []interface{}
+
+// Array is the AMQP array type, all elements have the same type.
+type Array []interface{}
+
+// MapEntry is an entry of Map.
+type MapEntry struct {
+	Key   interface{}
+	Value interface{}
+}
+
+// Map is the AMQP map type, the order of entries is kept.
+type Map []MapEntry
+
+// Get returns the value of key, keys in map must be comparable.
+func (m Map) Get(key interface{}) interface{} {
+	for _, e := range m {
+		if _, ok := e.Key.([]byte); ok {
+			continue
+		}
+		if e.Key == key {
+			return e.Value
+		}
+	}
+	return nil
+}
+
+// Described is a value with a descriptor, descriptors are uint64 codes or symbols.
+type Described struct {
+	Descriptor interface{}
+	Value      interface{}
+}
+
+// Code returns the numeric descriptor, or 0 if the descriptor isn't numeric.
+func (d *Described) Code() uint64 {
+	code, _ := d.Descriptor.(uint64)
+	return code
+}
+
+// Fields returns the value as list, performatives and most composite types are lists.
+func (d *Described) Fields() List {
+	l, _ := d.Value.(List)
+	return l
+}
+
+// Field returns the field at idx of list, or nil if it's out of range.
+func (l List) Field(idx int) interface{} {
+	if idx < len(l) {
+		return l[idx]
+	}
+	return nil
+}
+
+const (
+	typeDescribed  = 0x00
+	typeNull       = 0x40
+	typeTrue       = 0x41
+	typeFalse      = 0x42
+	typeUint0      = 0x43
+	typeUlong0     = 0x44
+	typeList0      = 0x45
+	typeUbyte      = 0x50
+	typeByte       = 0x51
+	typeSmallUint  = 0x52
+	typeSmallUlong = 0x53
+	typeSmallInt   = 0x54
+	typeSmallLong  = 0x55
+	typeBoolean    = 0x56
+	typeUshort     = 0x60
+	typeShort      = 0x61
+	typeUint       = 0x70
+	typeInt        = 0x71
+	typeFloat      = 0x72
+	typeChar       = 0x73
+	typeDecimal32  = 0x74
+	typeUlong      = 0x80
+	typeLong       = 0x81
+	typeDouble     = 0x82
+	typeTimestamp  = 0x83
+	typeDecimal64  = 0x84
+	typeDecimal128 = 0x94
+	typeUUID       = 0x98
+	typeVbin8      = 0xa0
+	typeStr8       = 0xa1
+	typeSym8       = 0xa3
+	typeVbin32     = 0xb0
+	typeStr32      = 0xb1
+	typeSym32      = 0xb3
+	typeList8      = 0xc0
+	typeMap8       = 0xc1
+	typeList32     = 0xd0
+	typeMap32      = 0xd1
+	typeArray8     = 0xe0
+	typeArray32    = 0xf0
+)
+
+// Marshal encodes v, which is one of the Go types returned by Unmarshal.
+func Marshal(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := encode(buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encode(buf *bytes.Buffer, v interface{}) error {
+	switch val := v.(type) {
+	case nil:
+		buf.WriteByte(typeNull)
+	case bool:
+		if val {
+			buf.WriteByte(typeTrue)
+		} else {
+			buf.WriteByte(typeFalse)
+		}
+	case uint8:
+		buf.Write([]byte{typeUbyte, val})
+	case uint16:
+		buf.WriteByte(typeUshort)
+		writeUint(buf, uint64(val), 2)
+	case uint32:
+		switch {
+		case val == 0:
+			buf.WriteByte(typeUint0)
+		case val <= math.MaxUint8:
+			buf.Write([]byte{typeSmallUint, byte(val)})
+		default:
+			buf.WriteByte(typeUint)
+			writeUint(buf, uint64(val), 4)
+		}
+	case uint64:
+		switch {
+		case val == 0:
+			buf.WriteByte(typeUlong0)
+		case val <= math.MaxUint8:
+			buf.Write([]byte{typeSmallUlong, byte(val)})
+		default:
+			buf.WriteByte(typeUlong)
+			writeUint(buf, val, 8)
+		}
+	case int8:
+		buf.Write([]byte{typeByte, byte(val)})
+	case int16:
+		buf.WriteByte(typeShort)
+		writeUint(buf, uint64(val), 2)
+	case int32:
+		buf.WriteByte(typeInt)
+		writeUint(buf, uint64(val), 4)
+	case int64:
+		buf.WriteByte(typeLong)
+		writeUint(buf, uint64(val), 8)
+	case float32:
+		buf.WriteByte(typeFloat)
+		writeUint(buf, uint64(math.Float32bits(val)), 4)
+	case float64:
+		buf.WriteByte(typeDouble)
+		writeUint(buf, math.Float64bits(val), 8)
+	case time.Time:
+		buf.WriteByte(typeTimestamp)
+		writeUint(buf, uint64(val.UnixMilli()), 8)
+	case UUID:
+		buf.WriteByte(typeUUID)
+		buf.Write(val[:])
+	case []byte:
+		writeVariable(buf, typeVbin8, typeVbin32, val)
+	case string:
+		writeVariable(buf, typeStr8, typeStr32, []byte(val))
+	case Symbol:
+		writeVariable(buf, typeSym8, typeSym32, []byte(val))
+	case []Symbol:
+		arr := make(Array, len(val))
+		for i := range val {
+			arr[i] = val[i]
+		}
+		return encode(buf, arr)
+	case List:
+		if len(val) == 0 {
+			buf.WriteByte(typeList0)
+			return nil
+		}
+		body := &bytes.Buffer{}
+		for _, e := range val {
+			if err := encode(body, e); err != nil {
+				return err
+			}
+		}
+		writeCompound(buf, typeList32, len(val), body.Bytes())
+	case Map:
+		body := &bytes.Buffer{}
+		for _, e := range val {
+			if err := encode(body, e.Key); err != nil {
+				return err
+			}
+			if err := encode(body, e.Value); err != nil {
+				return err
+			}
+		}
+		writeCompound(buf, typeMap32, len(val)*2, body.Bytes())
+	case Array:
+		return encodeArray(buf, val)
+	case *Described:
+		buf.WriteByte(typeDescribed)
+		if err := encode(buf, val.Descriptor); err != nil {
+			return err
+		}
+		return encode(buf, val.Value)
+	default:
+		return fmt.Errorf("unsupported AMQP type %T", v)
+	}
+	return nil
+}
+
+// encodeArray encodes array with the constructor of 32-bit width, only arrays of symbol,
+// string and ulong are needed.
+func encodeArray(buf *bytes.Buffer, arr Array) error {
+	body := &bytes.Buffer{}
+	constructor := byte(typeSym32)
+	if len(arr) > 0 {
+		switch arr[0].(type) {
+		case Symbol:
+		case string:
+			constructor = typeStr32
+		case uint64:
+			constructor = typeUlong
+		default:
+			return fmt.Errorf("unsupported AMQP array of %T", arr[0])
+		}
+	}
+	body.WriteByte(constructor)
+	for _, e := range arr {
+		switch val := e.(type) {
+		case Symbol:
+			writeUint(body, uint64(len(val)), 4)
+			body.WriteString(string(val))
+		case string:
+			writeUint(body, uint64(len(val)), 4)
+			body.WriteString(val)
+		case uint64:
+			writeUint(body, val, 8)
+		default:
+			return fmt.Errorf("AMQP array elements must have the same type")
+		}
+	}
+	writeCompound(buf, typeArray32, len(arr), body.Bytes())
+	return nil
+}
+
+func writeUint(buf *bytes.Buffer, v uint64, width int) {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	buf.Write(b[8-width:])
+}
+
+func writeVariable(buf *bytes.Buffer, code8, code32 byte, b []byte) {
+	if len(b) <= math.MaxUint8 {
+		buf.Write([]byte{code8, byte(len(b))})
+	} else {
+		buf.WriteByte(code32)
+		writeUint(buf, uint64(len(b)), 4)
+	}
+	buf.Write(b)
+}
+
+func writeCompound(buf *bytes.Buffer, code byte, count int, body []byte) {
+	buf.WriteByte(code)
+	// size includes the count field
+	writeUint(buf, uint64(len(body)+4), 4)
+	writeUint(buf, uint64(count), 4)
+	buf.Write(body)
+}
+
+// Unmarshal decodes the first value of data and returns the number of bytes consumed.
+func Unmarshal(data []byte) (interface{}, int, error) {
+	d := &decoder{buf: data}
+	v := d.value()
+	if d.err != nil {
+		return nil, 0, d.err
+	}
+	return v, d.pos, nil
+}
+
+type decoder struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (d *decoder) read(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.buf)-d.pos {
+		d.err = ErrMalformed
+		return nil
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b
+}
+
+func (d *decoder) uint(width int) uint64 {
+	b := d.read(width)
+	if b == nil {
+		return 0
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+func (d *decoder) value() interface{} {
+	code := d.uint(1)
+	if d.err != nil {
+		return nil
+	}
+	return d.valueOf(byte(code))
+}
+
+func (d *decoder) valueOf(code byte) interface{} {
+	switch code {
+	case typeDescribed:
+		descriptor := d.value()
+		return &Described{Descriptor: descriptor, Value: d.value()}
+	case typeNull:
+		return nil
+	case typeTrue:
+		return true
+	case typeFalse:
+		return false
+	case typeBoolean:
+		return d.uint(1) != 0
+	case typeUint0:
+		return uint32(0)
+	case typeUlong0:
+		return uint64(0)
+	case typeList0:
+		return List{}
+	case typeUbyte:
+		return uint8(d.uint(1))
+	case typeByte:
+		return int8(d.uint(1))
+	case typeSmallUint:
+		return uint32(d.uint(1))
+	case typeSmallUlong:
+		return d.uint(1)
+	case typeSmallInt:
+		return int32(int8(d.uint(1)))
+	case typeSmallLong:
+		return int64(int8(d.uint(1)))
+	case typeUshort:
+		return uint16(d.uint(2))
+	case typeShort:
+		return int16(d.uint(2))
+	case typeUint:
+		return uint32(d.uint(4))
+	case typeInt:
+		return int32(d.uint(4))
+	case typeFloat:
+		return math.Float32frombits(uint32(d.uint(4)))
+	case typeChar:
+		return rune(d.uint(4))
+	case typeUlong:
+		return d.uint(8)
+	case typeLong:
+		return int64(d.uint(8))
+	case typeDouble:
+		return math.Float64frombits(d.uint(8))
+	case typeTimestamp:
+		return time.UnixMilli(int64(d.uint(8)))
+	case typeDecimal32:
+		return d.read(4)
+	case typeDecimal64:
+		return d.read(8)
+	case typeDecimal128:
+		return d.read(16)
+	case typeUUID:
+		var u UUID
+		copy(u[:], d.read(16))
+		return u
+	case typeVbin8, typeVbin32:
+		return d.read(int(d.uint(variableWidth(code))))
+	case typeStr8, typeStr32:
+		return string(d.read(int(d.uint(variableWidth(code)))))
+	case typeSym8, typeSym32:
+		return Symbol(d.read(int(d.uint(variableWidth(code)))))
+	case typeList8, typeList32, typeMap8, typeMap32:
+		width := variableWidth(code)
+		size := int(d.uint(width))
+		end := d.pos + size
+		count := int(d.uint(width))
+		if d.err != nil || end > len(d.buf) || count > size {
+			d.err = ErrMalformed
+			return nil
+		}
+		var v interface{}
+		if code == typeList8 || code == typeList32 {
+			l := make(List, 0, count)
+			for i := 0; i < count && d.err == nil; i++ {
+				l = append(l, d.value())
+			}
+			v = l
+		} else {
+			m := make(Map, 0, count/2)
+			for i := 0; i < count/2 && d.err == nil; i++ {
+				k := d.value()
+				m = append(m, MapEntry{Key: k, Value: d.value()})
+			}
+			v = m
+		}
+		if d.err == nil && d.pos != end {
+			d.err = ErrMalformed
+		}
+		return v
+	case typeArray8, typeArray32:
+		width := variableWidth(code)
+		size := int(d.uint(width))
+		end := d.pos + size
+		count := int(d.uint(width))
+		if d.err != nil || end > len(d.buf) || count > size {
+			d.err = ErrMalformed
+			return nil
+		}
+		constructor := byte(d.uint(1))
+		var descriptor interface{}
+		if constructor == typeDescribed {
+			descriptor = d.value()
+			constructor = byte(d.uint(1))
+		}
+		arr := make(Array, 0, count)
+		for i := 0; i < count && d.err == nil; i++ {
+			v := d.valueOf(constructor)
+			if descriptor != nil {
+				v = &Described{Descriptor: descriptor, Value: v}
+			}
+			arr = append(arr, v)
+		}
+		if d.err == nil && d.pos != end {
+			d.err = ErrMalformed
+		}
+		return arr
+	default:
+		d.err = fmt.Errorf("unknown AMQP type code 0x%x", code)
+		return nil
+	}
+}
+
+func variableWidth(code byte) int {
+	// 0xa_, 0xc_ and 0xe_ have one byte width, the others have four
+	if code&0xf0 == 0xa0 || code&0xf0 == 0xc0 || code&0xf0 == 0xe0 {
+		return 1
+	}
+	return 4
+}
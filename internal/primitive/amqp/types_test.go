@@ -0,0 +1,122 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarshal(t *testing.T) {
+	Convey("test marshal and unmarshal", t, func() {
+		now := time.UnixMilli(time.Now().UnixMilli())
+		values := []interface{}{
+			nil, true, false, uint8(1), uint16(2), uint32(0), uint32(3), uint32(70000),
+			uint64(0), uint64(4), uint64(1 << 40), int8(-1), int16(-2), int32(-3), int64(-4),
+			float32(1.5), 2.5, now, UUID{1, 2, 3}, []byte("bin"), "str", Symbol("sym"),
+			strings.Repeat("a", 300), List{}, List{"a", uint32(1), List{Symbol("b")}},
+			Map{{Key: Symbol("k"), Value: "v"}, {Key: "n", Value: int64(1)}},
+			Array{Symbol("a"), Symbol("b")},
+			&Described{Descriptor: CodeSource, Value: List{"address"}},
+		}
+		for _, v := range values {
+			data, err := Marshal(v)
+			So(err, ShouldBeNil)
+			res, n, err := Unmarshal(data)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, len(data))
+			So(res, ShouldResemble, v)
+		}
+		data, err := Marshal([]Symbol{SASLMechanismAnonymous})
+		So(err, ShouldBeNil)
+		res, _, err := Unmarshal(data)
+		So(err, ShouldBeNil)
+		So(res, ShouldResemble, Array{SASLMechanismAnonymous})
+
+		_, _, err = Unmarshal(data[:len(data)-1])
+		So(err, ShouldNotBeNil)
+		_, err = Marshal(struct{}{})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test unmarshal compact encodings", t, func() {
+		// list8 of smallint, smalllong and str8
+		res, _, err := Unmarshal([]byte{typeList8, 8, 3, typeSmallInt, 0xff, typeSmallLong, 1, typeStr8, 1, 'a'})
+		So(err, ShouldBeNil)
+		So(res, ShouldResemble, List{int32(-1), int64(1), "a"})
+		// map8 of sym8 to boolean
+		res, _, err = Unmarshal([]byte{typeMap8, 6, 2, typeSym8, 1, 'k', typeBoolean, 1})
+		So(err, ShouldBeNil)
+		So(res.(Map).Get(Symbol("k")), ShouldEqual, true)
+		// array8 of smallulong
+		res, _, err = Unmarshal([]byte{typeArray8, 4, 2, typeSmallUlong, 1, 2})
+		So(err, ShouldBeNil)
+		So(res, ShouldResemble, Array{uint64(1), uint64(2)})
+	})
+}
+
+func TestFrame(t *testing.T) {
+	Convey("test write and read frame", t, func() {
+		buf := &bytes.Buffer{}
+		f := &Frame{
+			Type:         FrameTypeSASL,
+			Channel:      3,
+			Performative: NewPerformative(CodeSASLInit, SASLMechanismPlain, nil, nil),
+			Payload:      []byte("payload"),
+		}
+		So(WriteFrame(buf, f), ShouldBeNil)
+		So(WriteFrame(buf, &Frame{}), ShouldBeNil)
+		res, err := ReadFrame(buf, DefaultMaxFrameSize)
+		So(err, ShouldBeNil)
+		So(res.Type, ShouldEqual, FrameTypeSASL)
+		So(res.Channel, ShouldEqual, 3)
+		So(res.Performative.Code(), ShouldEqual, CodeSASLInit)
+		// trailing nil fields are omitted
+		So(res.Performative.Fields(), ShouldResemble, List{SASLMechanismPlain})
+		So(string(res.Payload), ShouldEqual, "payload")
+		res, err = ReadFrame(buf, DefaultMaxFrameSize)
+		So(err, ShouldBeNil)
+		So(res.Performative, ShouldBeNil)
+	})
+}
+
+func TestMessage(t *testing.T) {
+	Convey("test marshal and unmarshal message", t, func() {
+		msg := &Message{
+			MessageID:             "id",
+			Subject:               "subject",
+			ContentType:           "application/json",
+			CreationTime:          time.UnixMilli(1000),
+			ApplicationProperties: Map{{Key: CloudEventsPropertyPrefix + "type", Value: "test"}},
+			Data:                  []byte(`{"a":1}`),
+		}
+		data, err := msg.MarshalBinary()
+		So(err, ShouldBeNil)
+		res, err := UnmarshalMessage(data)
+		So(err, ShouldBeNil)
+		So(res, ShouldResemble, msg)
+
+		msg = &Message{Value: "value"}
+		data, err = msg.MarshalBinary()
+		So(err, ShouldBeNil)
+		res, err = UnmarshalMessage(data)
+		So(err, ShouldBeNil)
+		So(res.Value, ShouldEqual, "value")
+	})
+}
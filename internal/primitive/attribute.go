@@ -0,0 +1,103 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// producerAttributes are the reserved attributes which producers and transformers can set, e.g. the
+// delivery time of delayed events and the correlation id of replies.
+var producerAttributes = map[string]struct{}{
+	XVanusDeliveryTime:  {},
+	XVanusCorrelationID: {},
+}
+
+// componentAttributes are the reserved attributes which components set to the events written to
+// store, e.g. the retry attempts of the events written to the retry eventbus.
+var componentAttributes = map[string]struct{}{
+	XVanusEventbus:       {},
+	XVanusRetryAttempts:  {},
+	XVanusSubscriptionID: {},
+	XVanusIdempotencyKey: {},
+	XVanusReplyTo:        {},
+	XVanusTimerEventID:   {},
+	XVanusBornTime:       {},
+}
+
+var (
+	defaultReserved = NewReservedAttributes(nil)
+	reservedMu      sync.RWMutex
+)
+
+// InitReservedAttributes replaces the reserved attributes of the process by the configured prefixes,
+// it's called before the components are created.
+func InitReservedAttributes(prefixes []string) {
+	reservedMu.Lock()
+	defer reservedMu.Unlock()
+	defaultReserved = NewReservedAttributes(prefixes)
+}
+
+// GetReservedAttributes returns the reserved attributes of the process, which only reserves the
+// attributes prefixed with XVanus if InitReservedAttributes isn't called.
+func GetReservedAttributes() *ReservedAttributes {
+	reservedMu.RLock()
+	defer reservedMu.RUnlock()
+	return defaultReserved
+}
+
+// ReservedAttributes are the extension attributes which producers and transformers can't set, they
+// are set by components to carry the system states, e.g. sequence, stime and tenant. The attributes
+// prefixed with XVanus are always reserved.
+type ReservedAttributes struct {
+	prefixes []string
+}
+
+// NewReservedAttributes returns the attributes reserved by prefixes.
+func NewReservedAttributes(prefixes []string) *ReservedAttributes {
+	r := &ReservedAttributes{
+		prefixes: []string{XVanus},
+	}
+	for _, p := range prefixes {
+		if p = strings.ToLower(p); p != "" && p != XVanus {
+			r.prefixes = append(r.prefixes, p)
+		}
+	}
+	return r
+}
+
+// Check returns an error if producers can't set the attribute.
+func (r *ReservedAttributes) Check(name string) error {
+	if _, ok := producerAttributes[name]; ok {
+		return nil
+	}
+	for _, p := range r.prefixes {
+		if strings.HasPrefix(name, p) {
+			return fmt.Errorf("invalid ce attribute [%s] prefix %s", name, p)
+		}
+	}
+	return nil
+}
+
+// CheckComponent is same as Check, but the attributes set by components are allowed, it's used by
+// store which accepts the events written by both producers and components.
+func (r *ReservedAttributes) CheckComponent(name string) error {
+	if _, ok := componentAttributes[name]; ok {
+		return nil
+	}
+	return r.Check(name)
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaos injects faults at some points of store and trigger, so that integration tests and chaos
+// experiments can exercise the recovery paths deterministically. The faults are only injected by the
+// binaries built with the chaos tag, the points are no-ops otherwise.
+package chaos
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// PointStoreWriteAt delays or fails the writes of WAL and blocks.
+	PointStoreWriteAt = "store.write-at"
+	// PointStoreDropFragment drops the raft messages replicating fragments to followers.
+	PointStoreDropFragment = "store.drop-fragment"
+	// PointStoreDropHeartbeat drops the heartbeats of store to controller.
+	PointStoreDropHeartbeat = "store.drop-heartbeat"
+	// PointTriggerDuplicateDelivery delivers the events to sink twice.
+	PointTriggerDuplicateDelivery = "trigger.duplicate-delivery"
+	// PointTriggerDropHeartbeat drops the heartbeats of trigger worker to controller.
+	PointTriggerDropHeartbeat = "trigger.drop-heartbeat"
+)
+
+var (
+	ErrDisabled     = errors.New("chaos is disabled, the binary isn't built with the chaos tag")
+	ErrUnknownPoint = errors.New("unknown fault point")
+	ErrInvalidFault = errors.New("invalid fault")
+	// ErrInjected is returned by the points which fail.
+	ErrInjected = errors.New("injected fault")
+
+	points = map[string]bool{
+		PointStoreWriteAt:             true,
+		PointStoreDropFragment:        true,
+		PointStoreDropHeartbeat:       true,
+		PointTriggerDuplicateDelivery: true,
+		PointTriggerDropHeartbeat:     true,
+	}
+)
+
+// Fault is injected at a point, it replaces the fault injected at the same point before.
+type Fault struct {
+	Point string
+	// Delay is waited before the point proceeds.
+	Delay time.Duration
+	// Fail makes the point fail, drop or duplicate what it handles.
+	Fail bool
+	// Percent is the probability that the fault is triggered, 100 if it's 0.
+	Percent int
+	// Times is the number of times the fault is triggered before it's cleared, unlimited if it's 0.
+	Times int
+}
+
+func (f *Fault) validate() error {
+	if !points[f.Point] {
+		return ErrUnknownPoint
+	}
+	if f.Delay < 0 || f.Percent < 0 || f.Percent > 100 || f.Times < 0 {
+		return ErrInvalidFault
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build chaos
+// +build chaos
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestChaos(t *testing.T) {
+	Convey("test chaos", t, func() {
+		ctx := context.Background()
+		srv := NewServer()
+		Reset(func() {
+			_ = Clear("")
+		})
+
+		Convey("test inject invalid fault", func() {
+			_, err := srv.InjectFault(ctx, &ctrlpb.Fault{Point: "unknown"})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			_, err = srv.InjectFault(ctx, &ctrlpb.Fault{Point: PointStoreWriteAt, Percent: 101})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test hit the point", func() {
+			So(Hit(PointStoreWriteAt), ShouldBeFalse)
+			_, err := srv.InjectFault(ctx, &ctrlpb.Fault{Point: PointStoreWriteAt, Fail: true, Times: 2})
+			So(err, ShouldBeNil)
+			_, err = srv.InjectFault(ctx, &ctrlpb.Fault{Point: PointTriggerDropHeartbeat, DelayMs: 10})
+			So(err, ShouldBeNil)
+			res, err := srv.ListFault(ctx, &emptypb.Empty{})
+			So(err, ShouldBeNil)
+			So(res.Faults, ShouldHaveLength, 2)
+			So(res.Faults[0].Point, ShouldEqual, PointStoreWriteAt)
+
+			So(Hit(PointStoreDropHeartbeat), ShouldBeFalse)
+			So(Hit(PointStoreWriteAt), ShouldBeTrue)
+			So(Hit(PointStoreWriteAt), ShouldBeTrue)
+			// the fault is cleared after triggered twice.
+			So(Hit(PointStoreWriteAt), ShouldBeFalse)
+
+			start := time.Now()
+			So(Hit(PointTriggerDropHeartbeat), ShouldBeFalse)
+			So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 10*time.Millisecond)
+
+			_, err = srv.ClearFault(ctx, &ctrlpb.ClearFaultRequest{Point: PointTriggerDropHeartbeat})
+			So(err, ShouldBeNil)
+			res, _ = srv.ListFault(ctx, &emptypb.Empty{})
+			So(res.Faults, ShouldBeEmpty)
+		})
+
+		Convey("test hit the point by probability", func() {
+			So(Inject(Fault{Point: PointTriggerDuplicateDelivery, Fail: true, Percent: 50}), ShouldBeNil)
+			hits := 0
+			for i := 0; i < 1000; i++ {
+				if Hit(PointTriggerDuplicateDelivery) {
+					hits++
+				}
+			}
+			So(hits, ShouldBeBetween, 300, 700)
+		})
+	})
+}
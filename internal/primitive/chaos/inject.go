@@ -0,0 +1,99 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build chaos
+// +build chaos
+
+package chaos
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	mu     sync.Mutex
+	faults = map[string]*Fault{}
+	// active is the number of faults, the points needn't acquire the lock if it's zero.
+	active int32
+)
+
+// Inject injects the fault at its point.
+func Inject(f Fault) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	faults[f.Point] = &f
+	atomic.StoreInt32(&active, int32(len(faults)))
+	return nil
+}
+
+// Clear clears the fault at the point, or all faults if the point is empty.
+func Clear(point string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if point == "" {
+		faults = map[string]*Fault{}
+	} else {
+		delete(faults, point)
+	}
+	atomic.StoreInt32(&active, int32(len(faults)))
+	return nil
+}
+
+// List returns the faults sorted by point.
+func List() ([]Fault, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	list := make([]Fault, 0, len(faults))
+	for _, f := range faults {
+		list = append(list, *f)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Point < list[j].Point
+	})
+	return list, nil
+}
+
+// Hit waits for the delay of the fault at the point if it's triggered, and reports whether the point
+// should fail.
+func Hit(point string) bool {
+	if atomic.LoadInt32(&active) == 0 {
+		return false
+	}
+	mu.Lock()
+	f, ok := faults[point]
+	if !ok || (f.Percent > 0 && rand.Intn(100) >= f.Percent) { //nolint:gosec // it's not for security.
+		mu.Unlock()
+		return false
+	}
+	delay, fail := f.Delay, f.Fail
+	if f.Times > 0 {
+		if f.Times--; f.Times == 0 {
+			delete(faults, point)
+			atomic.StoreInt32(&active, int32(len(faults)))
+		}
+	}
+	mu.Unlock()
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+	return fail
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !chaos
+// +build !chaos
+
+package chaos
+
+func Inject(f Fault) error {
+	if err := f.validate(); err != nil {
+		return err
+	}
+	return ErrDisabled
+}
+
+func Clear(_ string) error {
+	return ErrDisabled
+}
+
+func List() ([]Fault, error) {
+	return nil, ErrDisabled
+}
+
+// Hit is inlined, so the points cost nothing without the chaos tag.
+func Hit(_ string) bool {
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"time"
+
+	// third-party libraries.
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+type server struct{}
+
+// NewServer returns the ChaosServer which is registered to the gRPC server of store and trigger.
+func NewServer() ctrlpb.ChaosServerServer {
+	return &server{}
+}
+
+func (s *server) InjectFault(ctx context.Context, req *ctrlpb.Fault) (*emptypb.Empty, error) {
+	log.Info(ctx, "inject fault", map[string]interface{}{
+		"point":   req.GetPoint(),
+		"delay":   req.GetDelayMs(),
+		"fail":    req.GetFail(),
+		"percent": req.GetPercent(),
+		"times":   req.GetTimes(),
+	})
+	err := Inject(Fault{
+		Point:   req.GetPoint(),
+		Delay:   time.Duration(req.GetDelayMs()) * time.Millisecond,
+		Fail:    req.GetFail(),
+		Percent: int(req.GetPercent()),
+		Times:   int(req.GetTimes()),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ClearFault(ctx context.Context, req *ctrlpb.ClearFaultRequest) (*emptypb.Empty, error) {
+	log.Info(ctx, "clear fault", map[string]interface{}{
+		"point": req.GetPoint(),
+	})
+	if err := Clear(req.GetPoint()); err != nil {
+		return nil, convertError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ListFault(_ context.Context, _ *emptypb.Empty) (*ctrlpb.ListFaultResponse, error) {
+	faults, err := List()
+	if err != nil {
+		return nil, convertError(err)
+	}
+	res := &ctrlpb.ListFaultResponse{Faults: make([]*ctrlpb.Fault, 0, len(faults))}
+	for _, f := range faults {
+		res.Faults = append(res.Faults, &ctrlpb.Fault{
+			Point:   f.Point,
+			DelayMs: uint32(f.Delay.Milliseconds()),
+			Fail:    f.Fail,
+			Percent: uint32(f.Percent),
+			Times:   uint32(f.Times),
+		})
+	}
+	return res, nil
+}
+
+func convertError(err error) error {
+	switch {
+	case stderr.Is(err, ErrDisabled):
+		return errors.ErrResourceCanNotOp.WithMessage(err.Error())
+	case stderr.Is(err, ErrUnknownPoint), stderr.Is(err, ErrInvalidFault):
+		return errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	return errors.ErrInternal.WithMessage("chaos failed").Wrap(err)
+}
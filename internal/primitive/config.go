@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/linkall-labs/vanus/client/pkg/blob"
 	"gopkg.in/yaml.v3"
 )
 
@@ -26,6 +27,36 @@ type KvStorageConfig struct {
 	ServerList []string `yaml:"server_list" json:"serverList"`
 }
 
+// BlobS3Config is the S3 compatible object storage which the data of large events is offloaded to.
+type BlobS3Config struct {
+	// Endpoint defaults to the AWS S3 endpoint of region, e.g. http://minio:9000.
+	Endpoint        string `yaml:"endpoint"`
+	Region          string `yaml:"region"`
+	Bucket          string `yaml:"bucket"`
+	Prefix          string `yaml:"prefix"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+}
+
+// NewBlobStore returns the S3 store if the bucket is set, or the file store in dir, which must be a
+// volume shared by the gateways and trigger workers. It returns nil if neither is configured.
+func NewBlobStore(dir string, s3 BlobS3Config) (blob.Store, error) {
+	if s3.Bucket != "" {
+		return blob.NewS3Store(blob.S3Config{
+			Endpoint:        s3.Endpoint,
+			Region:          s3.Region,
+			Bucket:          s3.Bucket,
+			Prefix:          s3.Prefix,
+			AccessKeyID:     s3.AccessKeyID,
+			SecretAccessKey: s3.SecretAccessKey,
+		})
+	}
+	if dir != "" {
+		return blob.NewFileStore(dir)
+	}
+	return nil, nil
+}
+
 func LoadConfig(filename string, config interface{}) error {
 	b, err := ioutil.ReadFile(filename)
 	if err != nil {
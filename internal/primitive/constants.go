@@ -19,12 +19,27 @@ const (
 	RetryEventbusName        = "__retry_eb"
 	DeadLetterEventbusName   = "__dl_eb"
 	TimerEventbusName        = "__Timer_RS"
+	// TimerTombstoneEventbusName receives the tombstones which cancel or reschedule delayed events.
+	TimerTombstoneEventbusName = "__Timer_TS"
+	// OpsEventbusName receives the events which notify operators of abnormal states, e.g. timer drift.
+	OpsEventbusName = "__ops_eb"
 
 	XVanus               = "xvanus"
 	XVanusEventbus       = XVanus + "eventbus"
 	XVanusDeliveryTime   = XVanus + "deliverytime"
 	XVanusRetryAttempts  = XVanus + "retryattempts"
 	XVanusSubscriptionID = XVanus + "subscriptionid"
+	// XVanusIdempotencyKey is same for every delivery of an event to a subscription.
+	XVanusIdempotencyKey = XVanus + "idempotencykey"
+	// XVanusCorrelationID is set by gateway to a request event, the reply event keeps it.
+	XVanusCorrelationID = XVanus + "correlationid"
+	// XVanusReplyTo is the eventbus which the reply of a request event is published to.
+	XVanusReplyTo = XVanus + "replyto"
+	// XVanusTimerEventID is the id of the delayed event which a timer tombstone cancels or reschedules.
+	XVanusTimerEventID = XVanus + "timereventid"
+	// XVanusBornTime is the time when the event was written to the eventbus at first, it is kept when a failed
+	// event is written to the retry eventbus.
+	XVanusBornTime = XVanus + "borntime"
 
 	LastDeliveryTime  = "lastdeliverytime"
 	LastDeliveryError = "lastdeliveryerror"
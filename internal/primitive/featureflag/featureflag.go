@@ -0,0 +1,152 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featureflag
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// The experimental features, their flags are saved in controller by name and override the defaults.
+const (
+	// FilterPushdown evaluates the subscription filters by segment servers when reading.
+	FilterPushdown = "filter_pushdown"
+)
+
+const (
+	defaultCacheTTL       = 10 * time.Second
+	defaultRefreshTimeout = 3 * time.Second
+	maxRolloutPercent     = 100
+)
+
+// builtinDefaults are the defaults of the features which are used if they aren't configured.
+var builtinDefaults = map[string]bool{
+	FilterPushdown: true,
+}
+
+type Config struct {
+	// Defaults overrides the builtin defaults of features, which are used if the features have no
+	// flag in controller.
+	Defaults map[string]bool `yaml:"defaults"`
+	// CacheTTL is how long the flags are cached, defaults to 10s.
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+func (c Config) defaultOf(name string) bool {
+	if v, ok := c.Defaults[name]; ok {
+		return v
+	}
+	return builtinDefaults[name]
+}
+
+// Scope is where a feature is used, the empty fields are never matched by the overrides.
+type Scope struct {
+	Namespace string
+	Eventbus  string
+}
+
+// Registry evaluates the feature flags listed from controller, a nil Registry only uses the builtin
+// defaults.
+type Registry struct {
+	cfg      Config
+	ctrl     ctrlpb.EventBusControllerClient
+	flags    map[string]*ctrlpb.FeatureFlag
+	expireAt time.Time
+	mu       sync.Mutex
+}
+
+func NewRegistry(cfg Config, ctrl ctrlpb.EventBusControllerClient) *Registry {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = defaultCacheTTL
+	}
+	return &Registry{cfg: cfg, ctrl: ctrl}
+}
+
+// Enabled reports whether the feature is enabled in the scope.
+func (r *Registry) Enabled(ctx context.Context, name string, scope Scope) bool {
+	if r == nil {
+		return builtinDefaults[name]
+	}
+	flag, ok := r.get(ctx)[name]
+	if !ok {
+		return r.cfg.defaultOf(name)
+	}
+	return Evaluate(flag, scope)
+}
+
+// get returns the cached flags, the stale ones are used if refreshing failed, and the defaults are
+// used if they have never been got.
+func (r *Registry) get(ctx context.Context) map[string]*ctrlpb.FeatureFlag {
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Before(r.expireAt) {
+		return r.flags
+	}
+	r.expireAt = now.Add(r.cfg.CacheTTL)
+	ctx, cancel := context.WithTimeout(ctx, defaultRefreshTimeout)
+	defer cancel()
+	res, err := r.ctrl.ListFeatureFlag(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.Warning(ctx, "refresh feature flags failed, use the stale ones", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return r.flags
+	}
+	flags := make(map[string]*ctrlpb.FeatureFlag, len(res.GetFlags()))
+	for _, flag := range res.GetFlags() {
+		flags[flag.Name] = flag
+	}
+	r.flags = flags
+	return r.flags
+}
+
+// Evaluate reports whether the flag enables the feature in the scope, the override of eventbus takes
+// precedence over the one of namespace, then the eventbuses in the rollout are enabled, and the others
+// use the flag.
+func Evaluate(flag *ctrlpb.FeatureFlag, scope Scope) bool {
+	if v, ok := flag.GetEventbuses()[scope.Eventbus]; ok && scope.Eventbus != "" {
+		return v
+	}
+	if v, ok := flag.GetNamespaces()[scope.Namespace]; ok && scope.Namespace != "" {
+		return v
+	}
+	if flag.GetRolloutPercent() > 0 && scope.Eventbus != "" &&
+		rolloutBucket(flag.GetName(), scope.Eventbus) < flag.GetRolloutPercent() {
+		return true
+	}
+	return flag.GetEnabled()
+}
+
+// rolloutBucket hashes the eventbus to [0, 100), the feature name is hashed too so that the rollouts of
+// different features don't choose the same eventbuses.
+func rolloutBucket(name, eventbus string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	_, _ = h.Write([]byte{'/'})
+	_, _ = h.Write([]byte(eventbus))
+	return h.Sum32() % maxRolloutPercent
+}
+
+// ValidRolloutPercent reports whether the percentage of rollout is valid.
+func ValidRolloutPercent(percent uint32) bool {
+	return percent <= maxRolloutPercent
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featureflag
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEvaluate(t *testing.T) {
+	Convey("test evaluate feature flag", t, func() {
+		flag := &ctrlpb.FeatureFlag{
+			Name:       "test",
+			Namespaces: map[string]bool{"team-a": true, "team-b": false},
+			Eventbuses: map[string]bool{"bus-1": false, "bus-2": true},
+		}
+		So(Evaluate(flag, Scope{}), ShouldBeFalse)
+		So(Evaluate(flag, Scope{Namespace: "team-a"}), ShouldBeTrue)
+		So(Evaluate(flag, Scope{Namespace: "team-a", Eventbus: "bus-1"}), ShouldBeFalse)
+		So(Evaluate(flag, Scope{Namespace: "team-b", Eventbus: "bus-2"}), ShouldBeTrue)
+		flag.Enabled = true
+		So(Evaluate(flag, Scope{Eventbus: "bus-3"}), ShouldBeTrue)
+		So(Evaluate(flag, Scope{Namespace: "team-b", Eventbus: "bus-3"}), ShouldBeFalse)
+
+		Convey("test rollout", func() {
+			flag.Enabled = false
+			flag.RolloutPercent = 30
+			enabled := 0
+			for i := 0; i < 1000; i++ {
+				bus := fmt.Sprintf("rollout-%d", i)
+				v := Evaluate(flag, Scope{Eventbus: bus})
+				So(Evaluate(flag, Scope{Eventbus: bus}), ShouldEqual, v)
+				if v {
+					enabled++
+				}
+			}
+			So(enabled, ShouldBeBetween, 200, 400)
+			// the eventbuses in a rollout are still in it when the percentage increases.
+			for i := 0; i < 1000; i++ {
+				bus := fmt.Sprintf("rollout-%d", i)
+				if Evaluate(flag, Scope{Eventbus: bus}) {
+					flag.RolloutPercent = 60
+					So(Evaluate(flag, Scope{Eventbus: bus}), ShouldBeTrue)
+					flag.RolloutPercent = 30
+				}
+			}
+			flag.RolloutPercent = 100
+			So(Evaluate(flag, Scope{Eventbus: "bus-3"}), ShouldBeTrue)
+			So(Evaluate(flag, Scope{Eventbus: "bus-1"}), ShouldBeFalse)
+		})
+	})
+}
+
+func TestRegistry_Enabled(t *testing.T) {
+	Convey("test feature flag registry", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		ctrl := ctrlpb.NewMockEventBusControllerClient(mockCtrl)
+
+		Convey("test nil registry", func() {
+			var r *Registry
+			So(r.Enabled(ctx, FilterPushdown, Scope{}), ShouldBeTrue)
+			So(r.Enabled(ctx, "unknown", Scope{}), ShouldBeFalse)
+		})
+
+		Convey("test defaults and flags", func() {
+			r := NewRegistry(Config{Defaults: map[string]bool{"test": true}, CacheTTL: time.Hour}, ctrl)
+			ctrl.EXPECT().ListFeatureFlag(gomock.Any(), gomock.Any()).Times(1).Return(
+				&ctrlpb.ListFeatureFlagResponse{Flags: []*ctrlpb.FeatureFlag{
+					{Name: FilterPushdown, Eventbuses: map[string]bool{"bus-1": false}, Enabled: true},
+				}}, nil)
+			So(r.Enabled(ctx, "test", Scope{}), ShouldBeTrue)
+			So(r.Enabled(ctx, "unknown", Scope{}), ShouldBeFalse)
+			So(r.Enabled(ctx, FilterPushdown, Scope{Eventbus: "bus-1"}), ShouldBeFalse)
+			So(r.Enabled(ctx, FilterPushdown, Scope{Eventbus: "bus-2"}), ShouldBeTrue)
+		})
+
+		Convey("test use the stale flags if refreshing failed", func() {
+			r := NewRegistry(Config{}, ctrl)
+			ctrl.EXPECT().ListFeatureFlag(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.ErrInternal)
+			So(r.Enabled(ctx, FilterPushdown, Scope{}), ShouldBeTrue)
+
+			r.expireAt = time.Time{}
+			ctrl.EXPECT().ListFeatureFlag(gomock.Any(), gomock.Any()).Times(1).Return(
+				&ctrlpb.ListFeatureFlagResponse{Flags: []*ctrlpb.FeatureFlag{{Name: FilterPushdown}}}, nil)
+			So(r.Enabled(ctx, FilterPushdown, Scope{}), ShouldBeFalse)
+
+			r.expireAt = time.Time{}
+			ctrl.EXPECT().ListFeatureFlag(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.ErrInternal)
+			So(r.Enabled(ctx, FilterPushdown, Scope{}), ShouldBeFalse)
+		})
+	})
+}
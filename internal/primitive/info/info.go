@@ -16,6 +16,7 @@ package info
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
@@ -25,9 +26,26 @@ type SubscriptionInfo struct {
 	Offsets        ListOffsetInfo `json:"offsetInfos"`
 }
 
+// SubscriptionLoad is the load of subscription in trigger worker.
+type SubscriptionLoad struct {
+	SubscriptionID vanus.ID `json:"subscriptionID"`
+	// EventRate is delivered event number per second
+	EventRate float64 `json:"eventRate"`
+	// Inflight is event number which is delivering
+	Inflight uint64 `json:"inflight"`
+	// Lag is event number which has read but not deliver
+	Lag uint64 `json:"lag"`
+	// DeliveryLatencyP50 and DeliveryLatencyP99 are the percentiles of the latency from the recent events
+	// were written to eventbus to the sink acked them.
+	DeliveryLatencyP50 time.Duration `json:"deliveryLatencyP50"`
+	DeliveryLatencyP99 time.Duration `json:"deliveryLatencyP99"`
+}
+
 type OffsetInfo struct {
 	EventLogID vanus.ID `json:"eventLogID"`
 	Offset     uint64   `json:"offset"`
+	// Delivered is the offsets which are behind Offset but have been delivered
+	Delivered []uint64 `json:"delivered,omitempty"`
 }
 
 type ListOffsetInfo []OffsetInfo
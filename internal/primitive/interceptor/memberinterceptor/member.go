@@ -23,6 +23,14 @@ import (
 	"google.golang.org/grpc"
 )
 
+// bypassMethods can be served by the followers.
+var bypassMethods = map[string]bool{
+	"/linkall.vanus.controller.PingServer/Ping":                true,
+	"/linkall.vanus.controller.v2.PingServer/Ping":             true,
+	"/linkall.vanus.controller.ProfilingServer/CaptureProfile": true,
+	"/grpc.health.v1.Health/Check":                             true,
+}
+
 func StreamServerInterceptor(member embedetcd.Member) grpc.StreamServerInterceptor {
 	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		if !member.IsLeader() {
@@ -37,8 +45,7 @@ func StreamServerInterceptor(member embedetcd.Member) grpc.StreamServerIntercept
 func UnaryServerInterceptor(member embedetcd.Member) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (interface{}, error) {
-		if info.FullMethod != "/linkall.vanus.controller.PingServer/Ping" &&
-			!member.IsLeader() {
+		if !bypassMethods[info.FullMethod] && !member.IsLeader() {
 			// TODO  read-only request bypass
 			return nil, errors.ErrNotLeader.WithMessage(
 				fmt.Sprintf("i'm not leader, please connect to: %s", member.GetLeaderAddr()))
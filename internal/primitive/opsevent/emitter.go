@@ -0,0 +1,168 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opsevent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+)
+
+const (
+	TypeBlockArchived           = "vanus.segment.block.archived"
+	TypeBlockLeaderChanged      = "vanus.segment.block.leader.changed"
+	TypeControllerLeaderChanged = "vanus.controller.leader.changed"
+	TypeServerDown              = "vanus.segment.server.down"
+	TypeSubscriptionPaused      = "vanus.trigger.subscription.paused"
+	TypeQuotaExceeded           = "vanus.gateway.quota.exceeded"
+	TypeConfigReloaded          = "vanus.config.reloaded"
+
+	defaultBufferSize  = 1024
+	defaultSendTimeout = 5 * time.Second
+)
+
+// Emitter writes events to the ops eventbus, operators subscribe to it to build alerting.
+type Emitter interface {
+	// Emit never blocks, the event is dropped if the emitter can't keep up.
+	Emit(ctx context.Context, eventType string, data interface{})
+	Close()
+}
+
+var (
+	defaultEmitter Emitter = noopEmitter{}
+	mu             sync.RWMutex
+)
+
+// Init replaces the default emitter, which discards all events.
+func Init(e Emitter) {
+	mu.Lock()
+	defer mu.Unlock()
+	defaultEmitter = e
+}
+
+// Emit emits an event by the default emitter.
+func Emit(ctx context.Context, eventType string, data interface{}) {
+	mu.RLock()
+	defer mu.RUnlock()
+	defaultEmitter.Emit(ctx, eventType, data)
+}
+
+// Close closes the default emitter and restores the one which discards all events.
+func Close() {
+	mu.Lock()
+	defer mu.Unlock()
+	defaultEmitter.Close()
+	defaultEmitter = noopEmitter{}
+}
+
+type noopEmitter struct{}
+
+func (noopEmitter) Emit(context.Context, string, interface{}) {}
+
+func (noopEmitter) Close() {}
+
+type emitter struct {
+	source  string
+	ctrl    cluster.Cluster
+	client  client.Client
+	events  chan *ce.Event
+	created bool
+	closeC  chan struct{}
+	once    sync.Once
+	wg      sync.WaitGroup
+}
+
+// NewEmitter returns an emitter which creates the ops eventbus on demand and writes events to it
+// in background, source is the source attribute of the events.
+func NewEmitter(source string, ctrl cluster.Cluster, c client.Client) Emitter {
+	e := &emitter{
+		source: source,
+		ctrl:   ctrl,
+		client: c,
+		events: make(chan *ce.Event, defaultBufferSize),
+		closeC: make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e
+}
+
+func (e *emitter) Emit(ctx context.Context, eventType string, data interface{}) {
+	event := ce.NewEvent()
+	event.SetID(uuid.NewString())
+	event.SetSource(e.source)
+	event.SetType(eventType)
+	event.SetTime(time.Now())
+	if err := event.SetData(ce.ApplicationJSON, data); err != nil {
+		log.Warning(ctx, "encode ops event failed", map[string]interface{}{
+			"type":       eventType,
+			log.KeyError: err,
+		})
+		return
+	}
+	select {
+	case <-e.closeC:
+	case e.events <- &event:
+	default:
+		log.Warning(ctx, "the buffer of ops events is full, drop the event", map[string]interface{}{
+			"type": eventType,
+		})
+	}
+}
+
+func (e *emitter) Close() {
+	e.once.Do(func() {
+		close(e.closeC)
+	})
+	e.wg.Wait()
+}
+
+func (e *emitter) run() {
+	defer e.wg.Done()
+	for {
+		select {
+		case <-e.closeC:
+			return
+		case event := <-e.events:
+			ctx, cancel := context.WithTimeout(context.Background(), defaultSendTimeout)
+			if err := e.send(ctx, event); err != nil {
+				log.Warning(ctx, "emit ops event failed", map[string]interface{}{
+					"type":       event.Type(),
+					log.KeyError: err,
+				})
+			}
+			cancel()
+		}
+	}
+}
+
+func (e *emitter) send(ctx context.Context, event *ce.Event) error {
+	if !e.created {
+		if err := e.ctrl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.OpsEventbusName,
+			"System Eventbus For Ops Events"); err != nil {
+			return err
+		}
+		e.created = true
+	}
+	_, err := e.client.Eventbus(ctx, primitive.OpsEventbusName).Writer().AppendOne(ctx, event)
+	return err
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opsevent
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmitter(t *testing.T) {
+	Convey("test ops event emitter", t, func() {
+		ctx := context.Background()
+		mockCtrl := NewController(t)
+		defer mockCtrl.Finish()
+		mockClient := client.NewMockClient(mockCtrl)
+		mockEventbus := api.NewMockEventbus(mockCtrl)
+		mockBusWriter := api.NewMockBusWriter(mockCtrl)
+		mockCl := cluster.NewMockCluster(mockCtrl)
+		mockSvc := cluster.NewMockEventbusService(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), primitive.OpsEventbusName).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockCl.EXPECT().EventbusService().AnyTimes().Return(mockSvc)
+
+		received := make(chan *ce.Event, 2)
+		mockBusWriter.EXPECT().AppendOne(Any(), Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+				received <- e
+				return "", nil
+			})
+
+		Convey("test the eventbus is created once", func() {
+			mockSvc.EXPECT().CreateSystemEventbusIfNotExist(Any(), primitive.OpsEventbusName, Any()).
+				Times(1).Return(nil)
+			e := NewEmitter("ut", mockCl, mockClient)
+			e.Emit(ctx, TypeServerDown, map[string]string{"address": "127.0.0.1:11811"})
+			e.Emit(ctx, TypeServerDown, map[string]string{"address": "127.0.0.1:11812"})
+			for _, addr := range []string{"127.0.0.1:11811", "127.0.0.1:11812"} {
+				event := waitEvent(received)
+				So(event, ShouldNotBeNil)
+				So(event.Source(), ShouldEqual, "ut")
+				So(event.Type(), ShouldEqual, TypeServerDown)
+				data := map[string]string{}
+				So(json.Unmarshal(event.Data(), &data), ShouldBeNil)
+				So(data["address"], ShouldEqual, addr)
+			}
+			e.Close()
+		})
+
+		Convey("test creating the eventbus is retried", func() {
+			first := mockSvc.EXPECT().CreateSystemEventbusIfNotExist(Any(), Any(), Any()).
+				Times(1).Return(stderr.New("test"))
+			mockSvc.EXPECT().CreateSystemEventbusIfNotExist(Any(), Any(), Any()).
+				Times(1).Return(nil).After(first)
+			e := NewEmitter("ut", mockCl, mockClient)
+			e.Emit(ctx, TypeBlockArchived, nil)
+			e.Emit(ctx, TypeBlockLeaderChanged, nil)
+			So(waitEvent(received).Type(), ShouldEqual, TypeBlockLeaderChanged)
+			e.Close()
+		})
+
+		Convey("test the default emitter", func() {
+			// the default emitter discards events
+			Emit(ctx, TypeQuotaExceeded, nil)
+			mockSvc.EXPECT().CreateSystemEventbusIfNotExist(Any(), Any(), Any()).Times(1).Return(nil)
+			Init(NewEmitter("ut", mockCl, mockClient))
+			Emit(ctx, TypeQuotaExceeded, nil)
+			So(waitEvent(received).Type(), ShouldEqual, TypeQuotaExceeded)
+			Close()
+			Emit(ctx, TypeQuotaExceeded, nil)
+		})
+	})
+}
+
+func waitEvent(c chan *ce.Event) *ce.Event {
+	select {
+	case e := <-c:
+		return e
+	case <-time.After(3 * time.Second):
+		return nil
+	}
+}
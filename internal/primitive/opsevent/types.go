@@ -0,0 +1,73 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opsevent
+
+// ControllerLeaderChanged is the data of TypeControllerLeaderChanged, it's emitted by the new leader.
+type ControllerLeaderChanged struct {
+	LeaderID   string `json:"leader_id"`
+	LeaderAddr string `json:"leader_addr"`
+}
+
+// BlockArchived is the data of TypeBlockArchived, the blocks of the segment are full and read-only.
+type BlockArchived struct {
+	Eventbus   string `json:"eventbus"`
+	EventlogID string `json:"eventlog_id"`
+	SegmentID  string `json:"segment_id"`
+	Size       int64  `json:"size"`
+	Number     int32  `json:"number"`
+}
+
+// BlockLeaderChanged is the data of TypeBlockLeaderChanged.
+type BlockLeaderChanged struct {
+	EventlogID string `json:"eventlog_id"`
+	SegmentID  string `json:"segment_id"`
+	LeaderID   string `json:"leader_id"`
+	Term       uint64 `json:"term"`
+}
+
+// ServerDown is the data of TypeServerDown, the segment server stops heartbeating.
+type ServerDown struct {
+	ServerID string `json:"server_id"`
+	Address  string `json:"address"`
+	Uptime   string `json:"up_time"`
+}
+
+// SubscriptionPaused is the data of TypeSubscriptionPaused.
+type SubscriptionPaused struct {
+	SubscriptionID string `json:"subscription_id"`
+	Name           string `json:"name"`
+	Eventbus       string `json:"eventbus"`
+}
+
+// QuotaExceeded is the data of TypeQuotaExceeded, the requests of the namespace are rejected.
+type QuotaExceeded struct {
+	Namespace  string  `json:"namespace,omitempty"`
+	RetryAfter float64 `json:"retry_after_seconds"`
+}
+
+// ConfigReloaded is the data of TypeConfigReloaded, Ignored are the changed fields which aren't
+// reloadable and take effect after restart.
+type ConfigReloaded struct {
+	Component string         `json:"component"`
+	Changes   []ConfigChange `json:"changes,omitempty"`
+	Ignored   []string       `json:"ignored,omitempty"`
+}
+
+// ConfigChange is a reloaded field of config.
+type ConfigChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/profiling"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+type server struct{}
+
+// NewServer returns the ProfilingServer which is registered to the gRPC server of every component.
+func NewServer() ctrlpb.ProfilingServerServer {
+	return &server{}
+}
+
+func (s *server) CaptureProfile(
+	ctx context.Context, req *ctrlpb.CaptureProfileRequest,
+) (*ctrlpb.CaptureProfileResponse, error) {
+	log.Info(ctx, "capture profile", map[string]interface{}{
+		"type":    req.GetType(),
+		"seconds": req.GetSeconds(),
+	})
+	data, err := profiling.Capture(ctx, req.GetType(), time.Duration(req.GetSeconds())*time.Second)
+	if err != nil {
+		switch {
+		case stderr.Is(err, profiling.ErrUnknownType):
+			return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+		case stderr.Is(err, profiling.ErrInProgress):
+			return nil, errors.ErrResourceCanNotOp.WithMessage(err.Error())
+		}
+		return nil, errors.ErrInternal.WithMessage("capture profile failed").Wrap(err)
+	}
+	return &ctrlpb.CaptureProfileResponse{Data: data}, nil
+}
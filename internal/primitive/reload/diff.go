@@ -0,0 +1,98 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reload
+
+import (
+	"reflect"
+	"strings"
+)
+
+// tagReload declares the field is reloadable, all fields of a reloadable struct are reloadable.
+const tagReload = "reload"
+
+// Change is a changed field of the config, Field is the path of its yaml keys.
+type Change struct {
+	Field      string
+	Old        interface{}
+	New        interface{}
+	Reloadable bool
+}
+
+// Diff returns the changed fields between two configs of the same struct type, or pointers to them.
+// The structs are compared field by field, and the other values, including maps and slices, are
+// compared as a whole. A field is reloadable if it or any struct containing it has the tag
+// `reload:"true"`.
+func Diff(old, new interface{}) []Change {
+	var changes []Change
+	diff(reflect.Indirect(reflect.ValueOf(old)), reflect.Indirect(reflect.ValueOf(new)), "", false, &changes)
+	return changes
+}
+
+func diff(old, new reflect.Value, prefix string, reloadable bool, changes *[]Change) {
+	if old.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(old.Interface(), new.Interface()) {
+			*changes = append(*changes, Change{
+				Field:      prefix,
+				Old:        old.Interface(),
+				New:        new.Interface(),
+				Reloadable: reloadable,
+			})
+		}
+		return
+	}
+	t := old.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := fieldName(f)
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		diff(old.Field(i), new.Field(i), name, reloadable || isReloadable(f), changes)
+	}
+}
+
+// merge copies the reloadable fields of src to dst, which is addressable.
+func merge(dst, src reflect.Value, reloadable bool) {
+	if reloadable {
+		dst.Set(src)
+		return
+	}
+	if dst.Kind() != reflect.Struct {
+		return
+	}
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		merge(dst.Field(i), src.Field(i), isReloadable(f))
+	}
+}
+
+func isReloadable(f reflect.StructField) bool {
+	return f.Tag.Get(tagReload) == "true"
+}
+
+func fieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testLimit struct {
+	Rate int `yaml:"rate"`
+}
+
+type testConfig struct {
+	Port     int                  `yaml:"port"`
+	Level    string               `yaml:"level" reload:"true"`
+	Interval time.Duration        `yaml:"interval" reload:"true"`
+	Limit    testLimit            `yaml:"limit" reload:"true"`
+	Limits   map[string]testLimit `yaml:"limits"`
+	Token    string               `yaml:"token"`
+}
+
+func TestDiff(t *testing.T) {
+	Convey("test diff configs", t, func() {
+		old := &testConfig{Port: 8080, Level: "info", Limit: testLimit{Rate: 10}}
+		So(Diff(old, old), ShouldBeEmpty)
+
+		changed := *old
+		changed.Port = 8081
+		changed.Level = "debug"
+		changed.Limit.Rate = 20
+		changed.Limits = map[string]testLimit{"a": {Rate: 1}}
+		changes := Diff(old, &changed)
+		So(changes, ShouldResemble, []Change{
+			{Field: "port", Old: 8080, New: 8081},
+			{Field: "level", Old: "info", New: "debug", Reloadable: true},
+			{Field: "limit.rate", Old: 10, New: 20, Reloadable: true},
+			{Field: "limits", Old: map[string]testLimit(nil), New: changed.Limits},
+		})
+	})
+}
+
+func TestReloader_Reload(t *testing.T) {
+	Convey("test reload config", t, func() {
+		ctx := context.Background()
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		write := func(content string) {
+			So(os.WriteFile(path, []byte(content), 0o644), ShouldBeNil)
+		}
+		load := func(path string) (interface{}, error) {
+			c := &testConfig{}
+			if err := primitive.LoadConfig(path, c); err != nil {
+				return nil, err
+			}
+			return c, nil
+		}
+		write("port: 8080\nlevel: info\ntoken: a\n")
+		cfg, _ := load(path)
+		var applied []*testConfig
+		var applyErr error
+		r := NewReloader("test", path, cfg, load, func(_ context.Context, c interface{}) error {
+			if applyErr != nil {
+				return applyErr
+			}
+			applied = append(applied, c.(*testConfig))
+			return nil
+		})
+
+		Convey("test reload the reloadable fields only", func() {
+			write("port: 8081\nlevel: debug\ninterval: 1s\ntoken: b\n")
+			changes, err := r.Reload(ctx)
+			So(err, ShouldBeNil)
+			So(changes, ShouldHaveLength, 4)
+			So(applied, ShouldHaveLength, 1)
+			So(applied[0].Port, ShouldEqual, 8080)
+			So(applied[0].Token, ShouldEqual, "a")
+			So(applied[0].Level, ShouldEqual, "debug")
+			So(applied[0].Interval, ShouldEqual, time.Second)
+			// the config loaded at start isn't changed.
+			So(cfg.(*testConfig).Level, ShouldEqual, "info")
+
+			// the fields which aren't reloadable are still different after reloading.
+			changes, err = r.Reload(ctx)
+			So(err, ShouldBeNil)
+			So(changes, ShouldHaveLength, 2)
+			So(applied, ShouldHaveLength, 1)
+		})
+
+		Convey("test the config isn't changed if applying failed", func() {
+			write("port: 8080\nlevel: debug\ntoken: a\n")
+			applyErr = fmt.Errorf("test")
+			_, err := r.Reload(ctx)
+			So(err, ShouldNotBeNil)
+			applyErr = nil
+			changes, err := r.Reload(ctx)
+			So(err, ShouldBeNil)
+			So(changes, ShouldHaveLength, 1)
+			So(applied, ShouldHaveLength, 1)
+		})
+
+		Convey("test reload an invalid config", func() {
+			write("port: [")
+			_, err := r.Reload(ctx)
+			So(err, ShouldNotBeNil)
+			So(applied, ShouldBeEmpty)
+		})
+
+		Convey("test reload by the handler", func() {
+			write("port: 8081\nlevel: debug\ntoken: a\n")
+			w := httptest.NewRecorder()
+			r.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodPost, HandlerPath, nil))
+			So(w.Code, ShouldEqual, http.StatusOK)
+			var states []changeState
+			So(json.Unmarshal(w.Body.Bytes(), &states), ShouldBeNil)
+			So(states, ShouldResemble, []changeState{
+				{Field: "port"},
+				{Field: "level", Reloadable: true},
+			})
+
+			w = httptest.NewRecorder()
+			r.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, HandlerPath, nil))
+			So(w.Code, ShouldEqual, http.StatusMethodNotAllowed)
+		})
+	})
+}
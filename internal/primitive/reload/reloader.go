@@ -0,0 +1,194 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reload
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+
+	"github.com/linkall-labs/vanus/internal/primitive/opsevent"
+	"github.com/linkall-labs/vanus/observability"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// HandlerPath is the path of admin endpoint which reloads the config, see Reloader.Handler.
+const HandlerPath = "/debug/config/reload"
+
+// LoadFunc loads the config from the file, it returns a pointer to the config struct.
+type LoadFunc func(path string) (interface{}, error)
+
+// ApplyFunc applies the reloadable fields of the config, which is a pointer to the config struct.
+type ApplyFunc func(ctx context.Context, cfg interface{}) error
+
+// Reloader reloads the config file of a component on SIGHUP or by the admin endpoint. Only the
+// reloadable fields declared by the tag `reload:"true"` are applied, the changes of the other
+// fields are logged and take effect after restart.
+type Reloader struct {
+	component string
+	path      string
+	// current is the effective config, the fields which aren't reloadable are never changed.
+	current reflect.Value
+	load    LoadFunc
+	apply   ApplyFunc
+	mu      sync.Mutex
+}
+
+// NewReloader creates a Reloader of the config loaded at start, cfg is a pointer to the config struct.
+func NewReloader(component, path string, cfg interface{}, load LoadFunc, apply ApplyFunc) *Reloader {
+	current := reflect.New(reflect.TypeOf(cfg).Elem())
+	current.Elem().Set(reflect.ValueOf(cfg).Elem())
+	return &Reloader{
+		component: component,
+		path:      path,
+		current:   current,
+		load:      load,
+		apply:     apply,
+	}
+}
+
+// Reload loads the config file and applies the changes of the reloadable fields, it returns all changes.
+func (r *Reloader) Reload(ctx context.Context) ([]Change, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, err := r.load(r.path)
+	if err != nil {
+		return nil, err
+	}
+	loaded := reflect.ValueOf(cfg)
+	if loaded.Type() != r.current.Type() {
+		return nil, fmt.Errorf("the reloaded config is %s, not %s", loaded.Type(), r.current.Type())
+	}
+	changes := Diff(r.current.Interface(), cfg)
+	next := reflect.New(r.current.Type().Elem())
+	next.Elem().Set(r.current.Elem())
+	merge(next.Elem(), loaded.Elem(), false)
+	if hasReloadable(changes) {
+		if err = r.apply(ctx, next.Interface()); err != nil {
+			return changes, err
+		}
+		r.current = next
+	}
+	r.audit(ctx, changes)
+	return changes, nil
+}
+
+func hasReloadable(changes []Change) bool {
+	for _, c := range changes {
+		if c.Reloadable {
+			return true
+		}
+	}
+	return false
+}
+
+// audit logs the changes and emits them as an ops event. The values of the fields which aren't
+// reloadable are omitted, because they may be credentials.
+func (r *Reloader) audit(ctx context.Context, changes []Change) {
+	if len(changes) == 0 {
+		log.Info(ctx, "the config is reloaded without changes", map[string]interface{}{
+			"component": r.component,
+			"path":      r.path,
+		})
+		return
+	}
+	data := &opsevent.ConfigReloaded{Component: r.component}
+	for _, c := range changes {
+		if !c.Reloadable {
+			log.Warning(ctx, "the changed config field isn't reloadable, it takes effect after restart",
+				map[string]interface{}{
+					"component": r.component,
+					"field":     c.Field,
+				})
+			data.Ignored = append(data.Ignored, c.Field)
+			continue
+		}
+		log.Info(ctx, "the config field is reloaded", map[string]interface{}{
+			"component": r.component,
+			"field":     c.Field,
+			"old":       c.Old,
+			"new":       c.New,
+		})
+		data.Changes = append(data.Changes, opsevent.ConfigChange{
+			Field: c.Field,
+			Old:   fmt.Sprint(c.Old),
+			New:   fmt.Sprint(c.New),
+		})
+	}
+	opsevent.Emit(ctx, opsevent.TypeConfigReloaded, data)
+}
+
+// Start reloads the config on SIGHUP and by the admin endpoint of observability until ctx is done.
+func (r *Reloader) Start(ctx context.Context) {
+	observability.Handle(HandlerPath, r.Handler())
+	go r.Watch(ctx)
+}
+
+// Watch reloads the config on SIGHUP until ctx is done.
+func (r *Reloader) Watch(ctx context.Context) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch:
+			if _, err := r.Reload(ctx); err != nil {
+				log.Error(ctx, "reload config failed", map[string]interface{}{
+					log.KeyError: err,
+					"component":  r.component,
+					"path":       r.path,
+				})
+			}
+		}
+	}
+}
+
+type changeState struct {
+	Field      string `json:"field"`
+	Reloadable bool   `json:"reloadable"`
+}
+
+// Handler reloads the config by PUT or POST, it responds the changed fields.
+//
+//	curl -X POST 'http://127.0.0.1:2112/debug/config/reload'
+func (r *Reloader) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut && req.Method != http.MethodPost {
+			w.Header().Set("Allow", "PUT, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		changes, err := r.Reload(req.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		states := make([]changeState, 0, len(changes))
+		for _, c := range changes {
+			states = append(states, changeState{Field: c.Field, Reloadable: c.Reloadable})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(states)
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type decoder interface {
+	schema() *Schema
+	// toJSON convert binary data to JSON.
+	toJSON(data []byte) ([]byte, error)
+}
+
+func newDecoder(s *Schema) (decoder, error) {
+	switch s.Type {
+	case Protobuf:
+		return newProtobufDecoder(s)
+	case Avro:
+		return newAvroDecoder(s)
+	default:
+		return nil, fmt.Errorf("schema type %s not support", s.Type)
+	}
+}
+
+type protobufDecoder struct {
+	s    *Schema
+	desc protoreflect.MessageDescriptor
+}
+
+func newProtobufDecoder(s *Schema) (decoder, error) {
+	if s.MessageName == "" {
+		return nil, ErrMessageNameRequired
+	}
+	fdSet := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(s.Definition, fdSet); err != nil {
+		return nil, fmt.Errorf("unmarshal file descriptor set error: %w", err)
+	}
+	files, err := protodesc.NewFiles(fdSet)
+	if err != nil {
+		return nil, fmt.Errorf("new file descriptor error: %w", err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(s.MessageName))
+	if err != nil {
+		return nil, fmt.Errorf("find message %s error: %w", s.MessageName, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", s.MessageName)
+	}
+	return &protobufDecoder{s: s, desc: md}, nil
+}
+
+func (d *protobufDecoder) schema() *Schema {
+	return d.s
+}
+
+func (d *protobufDecoder) toJSON(data []byte) ([]byte, error) {
+	msg := dynamicpb.NewMessage(d.desc)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+}
+
+type avroDecoder struct {
+	s     *Schema
+	codec *goavro.Codec
+}
+
+func newAvroDecoder(s *Schema) (decoder, error) {
+	codec, err := goavro.NewCodec(string(s.Definition))
+	if err != nil {
+		return nil, fmt.Errorf("new avro codec error: %w", err)
+	}
+	return &avroDecoder{s: s, codec: codec}, nil
+}
+
+func (d *avroDecoder) schema() *Schema {
+	return d.s
+}
+
+func (d *avroDecoder) toJSON(data []byte) ([]byte, error) {
+	native, _, err := d.codec.NativeFromBinary(data)
+	if err != nil {
+		return nil, err
+	}
+	return d.codec.TextualFromNative(nil, native)
+}
+
+// IsBinary return true if the event data content type need a schema to decode.
+func IsBinary(contentType string) bool {
+	return contentType == ContentTypeProtobuf || contentType == ContentTypeAvro
+}
+
+// JSONData return event data as JSON, binary data will be decoded by the schema
+// which id is the event dataschema.
+func JSONData(registry Registry, event ce.Event) ([]byte, error) {
+	contentType := event.DataContentType()
+	if !IsBinary(contentType) {
+		return event.Data(), nil
+	}
+	return registry.Decode(event.DataSchema(), contentType, event.Data())
+}
+
+// Data return event data as the generic value which JSON unmarshal to.
+func Data(registry Registry, event ce.Event) (interface{}, error) {
+	b, err := JSONData(registry, event)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	if err = json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func typeMatch(t Type, contentType string) bool {
+	switch t {
+	case Protobuf:
+		return contentType == ContentTypeProtobuf
+	case Avro:
+		return contentType == ContentTypeAvro
+	}
+	return false
+}
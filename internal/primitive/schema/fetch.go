@@ -0,0 +1,180 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	defaultCacheTTL     = time.Minute
+	defaultFetchTimeout = 3 * time.Second
+)
+
+// Fetcher gets the schemas from a schema registry.
+type Fetcher interface {
+	Fetch(ctx context.Context, id string) (*Schema, error)
+}
+
+type httpFetcher struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPFetcher returns a Fetcher which gets the schema by GET <endpoint>/schemas?id=<id>, the response
+// is the Schema in JSON, whose definition is encoded in base64, and 404 means the schema doesn't exist.
+func NewHTTPFetcher(endpoint string) Fetcher {
+	return &httpFetcher{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{},
+	}
+}
+
+func (f *httpFetcher) Fetch(ctx context.Context, id string) (*Schema, error) {
+	u := fmt.Sprintf("%s/schemas?id=%s", f.endpoint, url.QueryEscape(id))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrSchemaNotFound
+	default:
+		return nil, fmt.Errorf("fetch schema %s: unexpected status %s", id, resp.Status)
+	}
+	s := &Schema{}
+	if err = json.NewDecoder(resp.Body).Decode(s); err != nil {
+		return nil, fmt.Errorf("decode schema %s error: %w", id, err)
+	}
+	s.ID = id
+	return s, nil
+}
+
+// RegistryConfig is the schema registry the schemas which aren't registered locally are fetched from.
+type RegistryConfig struct {
+	// Endpoint is the base URL of the schema registry, empty means only the local schemas are used.
+	Endpoint string `yaml:"endpoint"`
+	// CacheTTL is how long the fetched schemas are cached, defaults to 1m.
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+type cacheEntry struct {
+	d        decoder
+	err      error
+	expireAt time.Time
+}
+
+// cachedRegistry decodes by the schemas registered locally, and the others are fetched and cached.
+type cachedRegistry struct {
+	*memoryRegistry
+	fetcher  Fetcher
+	cacheTTL time.Duration
+	entries  map[string]*cacheEntry
+	mu       sync.Mutex
+}
+
+// NewCachedRegistry returns a Registry which fetches the schemas not registered from the fetcher, the
+// fetched ones are cached for the ttl, and the stale ones are used if refreshing failed.
+func NewCachedRegistry(fetcher Fetcher, ttl time.Duration) Registry {
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	return &cachedRegistry{
+		memoryRegistry: &memoryRegistry{},
+		fetcher:        fetcher,
+		cacheTTL:       ttl,
+		entries:        map[string]*cacheEntry{},
+	}
+}
+
+func (r *cachedRegistry) Get(id string) (*Schema, error) {
+	d, err := r.load(id)
+	if err != nil {
+		return nil, err
+	}
+	return d.schema(), nil
+}
+
+func (r *cachedRegistry) Remove(id string) {
+	r.memoryRegistry.Remove(id)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries, id)
+}
+
+func (r *cachedRegistry) Decode(id, contentType string, data []byte) ([]byte, error) {
+	d, err := r.load(id)
+	if err != nil {
+		return nil, err
+	}
+	return decode(d, contentType, data)
+}
+
+func (r *cachedRegistry) load(id string) (decoder, error) {
+	if d, err := r.memoryRegistry.load(id); err == nil {
+		return d, nil
+	}
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.entries[id]
+	if !ok {
+		e = &cacheEntry{}
+		r.entries[id] = e
+	} else if now.Before(e.expireAt) {
+		return e.d, e.err
+	}
+	// the failures are cached too, so that the registry isn't requested for every event.
+	e.expireAt = now.Add(r.cacheTTL)
+	ctx, cancel := context.WithTimeout(context.Background(), defaultFetchTimeout)
+	defer cancel()
+	d, err := r.fetch(ctx, id)
+	switch {
+	case err == nil:
+		e.d, e.err = d, nil
+	case errors.Is(err, ErrSchemaNotFound) || e.d == nil:
+		e.d, e.err = nil, err
+	default:
+		log.Warning(ctx, "refresh schema failed, use the stale one", map[string]interface{}{
+			"schema_id":  id,
+			log.KeyError: err,
+		})
+	}
+	return e.d, e.err
+}
+
+func (r *cachedRegistry) fetch(ctx context.Context, id string) (decoder, error) {
+	s, err := r.fetcher.Fetch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return newDecoder(s)
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkedin/goavro/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCachedRegistry(t *testing.T) {
+	Convey("test cached registry", t, func() {
+		definition := `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"}]}`
+		var requests int32
+		var status int32 = http.StatusOK
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			if req.URL.Path != "/schemas" || req.URL.Query().Get("id") != "vanus://schemas/order" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if s := atomic.LoadInt32(&status); s != http.StatusOK {
+				w.WriteHeader(int(s))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&Schema{Type: Avro, Definition: []byte(definition)})
+		}))
+		defer srv.Close()
+		r := NewCachedRegistry(NewHTTPFetcher(srv.URL+"/"), time.Minute)
+
+		codec, _ := goavro.NewCodec(definition)
+		b, _ := codec.BinaryFromNative(nil, map[string]interface{}{"id": "o-1", "amount": 10})
+		e := ce.NewEvent()
+		e.SetDataSchema("vanus://schemas/order")
+		_ = e.SetData(ContentTypeAvro, b)
+
+		Convey("test fetch and cache", func() {
+			for i := 0; i < 2; i++ {
+				data, err := Data(r, e)
+				So(err, ShouldBeNil)
+				So(data, ShouldResemble, map[string]interface{}{"id": "o-1", "amount": float64(10)})
+			}
+			So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+			s, err := r.Get("vanus://schemas/order")
+			So(err, ShouldBeNil)
+			So(s.ID, ShouldEqual, "vanus://schemas/order")
+			So(s.Type, ShouldEqual, Avro)
+		})
+		Convey("test not found is cached", func() {
+			for i := 0; i < 2; i++ {
+				_, err := r.Get("vanus://schemas/none")
+				So(err, ShouldEqual, ErrSchemaNotFound)
+			}
+			So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+		})
+		Convey("test refresh", func() {
+			_, err := Data(r, e)
+			So(err, ShouldBeNil)
+			expire := func() {
+				cr, _ := r.(*cachedRegistry)
+				cr.entries["vanus://schemas/order"].expireAt = time.Now()
+			}
+
+			expire()
+			atomic.StoreInt32(&status, http.StatusInternalServerError)
+			_, err = Data(r, e)
+			So(err, ShouldBeNil)
+			So(atomic.LoadInt32(&requests), ShouldEqual, 2)
+
+			expire()
+			atomic.StoreInt32(&status, http.StatusNotFound)
+			_, err = Data(r, e)
+			So(err, ShouldEqual, ErrSchemaNotFound)
+			So(atomic.LoadInt32(&requests), ShouldEqual, 3)
+		})
+		Convey("test fetch failed without stale", func() {
+			atomic.StoreInt32(&status, http.StatusInternalServerError)
+			_, err := Data(r, e)
+			So(err, ShouldNotBeNil)
+			So(err, ShouldNotEqual, ErrSchemaNotFound)
+		})
+		Convey("test registered takes precedence", func() {
+			So(r.Register(&Schema{ID: "vanus://schemas/order", Type: Avro, Definition: []byte(definition)}),
+				ShouldBeNil)
+			_, err := Data(r, e)
+			So(err, ShouldBeNil)
+			So(atomic.LoadInt32(&requests), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestSetDefaultRegistry(t *testing.T) {
+	Convey("test set default registry", t, func() {
+		old := DefaultRegistry()
+		defer SetDefaultRegistry(old)
+		r := NewCachedRegistry(NewHTTPFetcher("http://127.0.0.1"), 0)
+		SetDefaultRegistry(r)
+		So(DefaultRegistry(), ShouldEqual, r)
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	ContentTypeProtobuf = "application/protobuf"
+	ContentTypeAvro     = "avro/binary"
+)
+
+type Type string
+
+const (
+	Protobuf Type = "protobuf"
+	Avro     Type = "avro"
+)
+
+var (
+	ErrSchemaNotFound      = fmt.Errorf("schema not found")
+	ErrSchemaTypeMismatch  = fmt.Errorf("schema type mismatch with data content type")
+	ErrMessageNameRequired = fmt.Errorf("protobuf schema message name is required")
+)
+
+// Schema describes how to decode a binary event data, it is identified by the
+// event dataschema attribute.
+type Schema struct {
+	ID   string `json:"id"`
+	Type Type   `json:"type"`
+	// Definition is the serialized FileDescriptorSet for protobuf or the JSON schema text for avro.
+	Definition []byte `json:"definition"`
+	// MessageName is the full name of the protobuf message, ignored for avro.
+	MessageName string `json:"message_name,omitempty"`
+}
+
+type Registry interface {
+	Get(id string) (*Schema, error)
+	Register(schema *Schema) error
+	Remove(id string)
+	// Decode convert the binary data to JSON by the schema.
+	Decode(id, contentType string, data []byte) ([]byte, error)
+}
+
+type memoryRegistry struct {
+	// schemaID -> *decoder
+	decoders sync.Map
+}
+
+func NewMemoryRegistry() Registry {
+	return &memoryRegistry{}
+}
+
+func (r *memoryRegistry) Get(id string) (*Schema, error) {
+	d, err := r.load(id)
+	if err != nil {
+		return nil, err
+	}
+	return d.schema(), nil
+}
+
+func (r *memoryRegistry) Register(schema *Schema) error {
+	d, err := newDecoder(schema)
+	if err != nil {
+		return err
+	}
+	r.decoders.Store(schema.ID, d)
+	return nil
+}
+
+func (r *memoryRegistry) Remove(id string) {
+	r.decoders.Delete(id)
+}
+
+func (r *memoryRegistry) Decode(id, contentType string, data []byte) ([]byte, error) {
+	d, err := r.load(id)
+	if err != nil {
+		return nil, err
+	}
+	return decode(d, contentType, data)
+}
+
+func (r *memoryRegistry) load(id string) (decoder, error) {
+	v, exist := r.decoders.Load(id)
+	if !exist {
+		return nil, ErrSchemaNotFound
+	}
+	d, _ := v.(decoder)
+	return d, nil
+}
+
+func decode(d decoder, contentType string, data []byte) ([]byte, error) {
+	if !typeMatch(d.schema().Type, contentType) {
+		return nil, ErrSchemaTypeMismatch
+	}
+	return d.toJSON(data)
+}
+
+// defaultRegistry holds a registryHolder, atomic.Value can't store the different types of Registry.
+var defaultRegistry atomic.Value
+
+type registryHolder struct {
+	Registry
+}
+
+func init() {
+	SetDefaultRegistry(NewMemoryRegistry())
+}
+
+// DefaultRegistry return the registry used by filter and transformer.
+func DefaultRegistry() Registry {
+	h, _ := defaultRegistry.Load().(registryHolder)
+	return h.Registry
+}
+
+// SetDefaultRegistry replaces the registry used by filter and transformer, it should be called before
+// the schemas are registered.
+func SetDefaultRegistry(r Registry) {
+	defaultRegistry.Store(registryHolder{Registry: r})
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkedin/goavro/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func testFileDescriptor() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("order.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Order"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("id"),
+				Number:   proto.Int32(1),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				JsonName: proto.String("id"),
+			}, {
+				Name:     proto.String("amount"),
+				Number:   proto.Int32(2),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				JsonName: proto.String("amount"),
+			}},
+		}},
+	}
+}
+
+func TestRegistry_Protobuf(t *testing.T) {
+	Convey("test protobuf schema", t, func() {
+		fd := testFileDescriptor()
+		definition, _ := proto.Marshal(&descriptorpb.FileDescriptorSet{
+			File: []*descriptorpb.FileDescriptorProto{fd},
+		})
+		r := NewMemoryRegistry()
+		Convey("test register invalid", func() {
+			err := r.Register(&Schema{ID: "order", Type: Protobuf, Definition: definition})
+			So(err, ShouldEqual, ErrMessageNameRequired)
+			err = r.Register(&Schema{ID: "order", Type: Protobuf, Definition: definition, MessageName: "test.None"})
+			So(err, ShouldNotBeNil)
+		})
+		So(r.Register(&Schema{ID: "order", Type: Protobuf, Definition: definition, MessageName: "test.Order"}),
+			ShouldBeNil)
+		s, err := r.Get("order")
+		So(err, ShouldBeNil)
+		So(s.MessageName, ShouldEqual, "test.Order")
+
+		file, _ := protodesc.NewFile(fd, nil)
+		msg := dynamicpb.NewMessage(file.Messages().ByName("Order"))
+		msg.Set(msg.Descriptor().Fields().ByName("id"), protoreflect.ValueOfString("o-1"))
+		msg.Set(msg.Descriptor().Fields().ByName("amount"), protoreflect.ValueOfInt32(10))
+		b, _ := proto.Marshal(msg)
+		e := ce.NewEvent()
+		e.SetDataSchema("order")
+		_ = e.SetData(ContentTypeProtobuf, b)
+		data, err := Data(r, e)
+		So(err, ShouldBeNil)
+		So(data, ShouldResemble, map[string]interface{}{"id": "o-1", "amount": float64(10)})
+		Convey("test content type mismatch", func() {
+			_ = e.SetData(ContentTypeAvro, b)
+			_, err = Data(r, e)
+			So(err, ShouldEqual, ErrSchemaTypeMismatch)
+		})
+		Convey("test schema not found", func() {
+			r.Remove("order")
+			_, err = Data(r, e)
+			So(err, ShouldEqual, ErrSchemaNotFound)
+		})
+	})
+}
+
+func TestRegistry_Avro(t *testing.T) {
+	Convey("test avro schema", t, func() {
+		definition := `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"},{"name":"amount","type":"int"}]}`
+		r := NewMemoryRegistry()
+		So(r.Register(&Schema{ID: "order", Type: Avro, Definition: []byte(definition)}), ShouldBeNil)
+		codec, _ := goavro.NewCodec(definition)
+		b, _ := codec.BinaryFromNative(nil, map[string]interface{}{"id": "o-1", "amount": 10})
+		e := ce.NewEvent()
+		e.SetDataSchema("order")
+		_ = e.SetData(ContentTypeAvro, b)
+		data, err := Data(r, e)
+		So(err, ShouldBeNil)
+		So(data, ShouldResemble, map[string]interface{}{"id": "o-1", "amount": float64(10)})
+	})
+}
+
+func TestData_JSON(t *testing.T) {
+	Convey("test json data", t, func() {
+		e := ce.NewEvent()
+		_ = e.SetData(ce.ApplicationJSON, map[string]interface{}{"key": "value"})
+		data, err := Data(NewMemoryRegistry(), e)
+		So(err, ShouldBeNil)
+		So(data, ShouldResemble, map[string]interface{}{"key": "value"})
+	})
+}
@@ -17,6 +17,8 @@ package primitive
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"strconv"
 
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
@@ -35,6 +37,9 @@ type Subscription struct {
 	Protocol        Protocol               `json:"protocol,omitempty"`
 	ProtocolSetting *ProtocolSetting       `json:"protocolSetting,omitempty"`
 	SinkCredential  SinkCredential         `json:"sink_credential,omitempty"`
+	SinkSecret      string                 `json:"sink_secret,omitempty"`
+	// Standby means the subscription is prepared by the trigger worker but not started.
+	Standby bool `json:"standby,omitempty"`
 }
 
 func (sub *Subscription) String() string {
@@ -49,10 +54,29 @@ const (
 	HTTPProtocol      Protocol = "http"
 	AwsLambdaProtocol Protocol = "aws-lambda"
 	GCloudFunctions   Protocol = "gcloud-functions"
+	AMQPProtocol      Protocol = "amqp"
+	// ElasticsearchProtocol bulk indexes the events into Elasticsearch or OpenSearch.
+	ElasticsearchProtocol Protocol = "elasticsearch"
+	// ObjectStorageProtocol archives the events in files of S3 or S3 compatible object storage.
+	ObjectStorageProtocol Protocol = "object-storage"
+	// SQLProtocol inserts or upserts the events into a table of Postgres or MySQL.
+	SQLProtocol Protocol = "sql"
+	// ChatProtocol posts the events as messages to the webhook of Slack, Discord, Teams or a generic chat.
+	ChatProtocol Protocol = "chat"
 )
 
 type ProtocolSetting struct {
 	Headers map[string]string `json:"headers,omitempty"`
+	Chat    *ChatSetting      `json:"chat,omitempty"`
+}
+
+type ChatSetting struct {
+	// MessageTemplate is the Go template of message over the event.
+	MessageTemplate string `json:"message_template,omitempty"`
+	// CoalesceWindow is the window by millisecond in which the messages are coalesced into one post.
+	CoalesceWindow uint32 `json:"coalesce_window,omitempty"`
+	// MessagesPerMinute is the max posts per minute, 0 means unlimited.
+	MessagesPerMinute uint32 `json:"messages_per_minute,omitempty"`
 }
 
 type OffsetType int32
@@ -73,6 +97,67 @@ type SubscriptionConfig struct {
 	DeadLetterEventbus string     `json:"dead_letter_eventbus,omitempty"`
 	// send event with ordered
 	OrderedEvent bool `json:"ordered_event"`
+	// dedup window by millisecond, 0 means disable dedup
+	DedupWindow uint32 `json:"dedup_window,omitempty"`
+	// the attribute used as dedup key, default is event id
+	DedupKeyAttribute string `json:"dedup_key_attribute,omitempty"`
+	// events with the same value of the attribute are sent in order
+	OrderingKeyAttribute string `json:"ordering_key_attribute,omitempty"`
+	// attach idempotency key to event and persist delivered offsets with offset
+	IdempotentDelivery bool `json:"idempotent_delivery,omitempty"`
+	// offset commit interval by millisecond, 0 means using default value
+	CommitInterval uint32 `json:"commit_interval,omitempty"`
+	// commit offset after the number of events processed even if commit interval not reached
+	CommitBatchSize uint32 `json:"commit_batch_size,omitempty"`
+	// stop subscription not wait offset commit to controller
+	DisableSyncCommitOnStop bool `json:"disable_sync_commit_on_stop,omitempty"`
+	// the events of the eventbus are correlated with the events of subscribed eventbus
+	CorrelationEventbus string `json:"correlation_eventbus,omitempty"`
+	// the attribute used as correlation key of both sides
+	CorrelationKeyAttribute string `json:"correlation_key_attribute,omitempty"`
+	// the events not correlated in the timeout by millisecond are dropped
+	CorrelationTimeout uint32 `json:"correlation_timeout,omitempty"`
+	// only the events of the eventlogs are consumed, empty means all eventlogs
+	Eventlogs []uint64 `json:"eventlogs,omitempty"`
+	// only the events of the eventlogs whose hash slot is in [EventlogHashStart, EventlogHashEnd) are
+	// consumed, 0 end means all eventlogs
+	EventlogHashStart uint32 `json:"eventlog_hash_start,omitempty"`
+	EventlogHashEnd   uint32 `json:"eventlog_hash_end,omitempty"`
+	// the event is quarantined to dead letter after the number of failed deliveries while the sink works
+	// for the other events, 0 means disable
+	PoisonThreshold uint32 `json:"poison_threshold,omitempty"`
+	// the event replied by the sink is written to the eventbus
+	ReplyEventbus string `json:"reply_eventbus,omitempty"`
+	// a standby trigger worker prepares the subscription, and takes over it at once if the running one
+	// is disconnected
+	WarmStandby bool `json:"warm_standby,omitempty"`
+}
+
+// EventlogHashSlots is the number of hash slots of eventlogs.
+const EventlogHashSlots = 1 << 16
+
+// EventlogHashSlot returns the hash slot of the eventlog, it's FNV-1a of the id modulo EventlogHashSlots.
+func EventlogHashSlot(id uint64) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strconv.FormatUint(id, 10)))
+	return h.Sum32() % EventlogHashSlots
+}
+
+// ContainsEventlog reports whether the events of the eventlog are consumed by the subscription.
+func (c *SubscriptionConfig) ContainsEventlog(id uint64) bool {
+	if len(c.Eventlogs) > 0 {
+		for _, el := range c.Eventlogs {
+			if el == id {
+				return true
+			}
+		}
+		return false
+	}
+	if c.EventlogHashEnd > 0 {
+		slot := EventlogHashSlot(id)
+		return slot >= c.EventlogHashStart && slot < c.EventlogHashEnd
+	}
+	return true
 }
 
 // GetMaxRetryAttempts return MaxRetryAttempts if nil return -1.
@@ -0,0 +1,133 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate_test
+
+import (
+	stdJson "encoding/json"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action/aggregate"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWindowCountAction(t *testing.T) {
+	funcName := aggregate.NewWindowCountAction().Name()
+	base := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	var emitted []*ce.Event
+	var eventbuses []string
+	emit := func(eventbus string, e *ce.Event) {
+		eventbuses = append(eventbuses, eventbus)
+		emitted = append(emitted, e)
+	}
+	execute := func(a interface {
+		Execute(*context.EventContext) error
+	}, offset time.Duration, data map[string]interface{}) {
+		e := cetest.MinEvent()
+		e.SetTime(base.Add(offset))
+		So(a.Execute(&context.EventContext{Event: &e, Data: data, Emit: emit}), ShouldBeNil)
+	}
+	aggregateData := func(e *ce.Event) map[string]interface{} {
+		var data map[string]interface{}
+		So(stdJson.Unmarshal(e.Data(), &data), ShouldBeNil)
+		return data
+	}
+
+	Convey("test window count invalid", t, func() {
+		_, err := runtime.NewAction([]interface{}{funcName, "bus", "abc"})
+		So(err, ShouldNotBeNil)
+		_, err = runtime.NewAction([]interface{}{funcName, "bus", "1m", "2m"})
+		So(err, ShouldNotBeNil)
+		_, err = runtime.NewAction([]interface{}{funcName, "bus", "1m", "30s", "10s"})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("test tumbling window count", t, func() {
+		emitted, eventbuses = nil, nil
+		a, err := runtime.NewAction([]interface{}{funcName, "agg", "1m"})
+		So(err, ShouldBeNil)
+		execute(a, 10*time.Second, nil)
+		execute(a, 50*time.Second, nil)
+		execute(a, 20*time.Second, nil)
+		So(emitted, ShouldBeEmpty)
+
+		// closes the first window.
+		execute(a, 70*time.Second, nil)
+		So(emitted, ShouldHaveLength, 1)
+		So(eventbuses[0], ShouldEqual, "agg")
+		So(emitted[0].Type(), ShouldEqual, aggregate.EventTypeBase+"count")
+		So(emitted[0].Time(), ShouldEqual, base.Add(time.Minute))
+		data := aggregateData(emitted[0])
+		So(data["count"], ShouldEqual, 3)
+		So(data["windowStart"], ShouldEqual, base.Format(time.RFC3339Nano))
+
+		// the late event is dropped.
+		execute(a, 30*time.Second, nil)
+		execute(a, 3*time.Minute, nil)
+		So(emitted, ShouldHaveLength, 2)
+		So(aggregateData(emitted[1])["count"], ShouldEqual, 1)
+	})
+
+	Convey("test sliding window count", t, func() {
+		emitted, eventbuses = nil, nil
+		a, err := runtime.NewAction([]interface{}{funcName, "agg", "1m", "30s"})
+		So(err, ShouldBeNil)
+		execute(a, 10*time.Second, nil)
+		execute(a, 40*time.Second, nil)
+		execute(a, 80*time.Second, nil)
+		// [-30s, 30s) is closed at 40s, [0s, 60s) at 80s.
+		So(emitted, ShouldHaveLength, 2)
+		So(aggregateData(emitted[0])["count"], ShouldEqual, 1)
+		So(aggregateData(emitted[1])["count"], ShouldEqual, 2)
+
+		execute(a, 2*time.Minute, nil)
+		// [30s, 90s) and [60s, 120s).
+		So(emitted, ShouldHaveLength, 4)
+		So(aggregateData(emitted[2])["count"], ShouldEqual, 2)
+		So(aggregateData(emitted[3])["count"], ShouldEqual, 1)
+	})
+}
+
+func TestWindowSumAction(t *testing.T) {
+	funcName := aggregate.NewWindowSumAction().Name()
+	Convey("test tumbling window sum", t, func() {
+		base := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+		var emitted []*ce.Event
+		a, err := runtime.NewAction([]interface{}{funcName, "$.data.amount", "agg", "1m"})
+		So(err, ShouldBeNil)
+		for i, amount := range []interface{}{1.5, "2", "abc", 4.0} {
+			e := cetest.MinEvent()
+			e.SetTime(base.Add(time.Duration(i) * 25 * time.Second))
+			_ = a.Execute(&context.EventContext{
+				Event: &e,
+				Data:  map[string]interface{}{"amount": amount},
+				Emit: func(_ string, e *ce.Event) {
+					emitted = append(emitted, e)
+				},
+			})
+		}
+		So(emitted, ShouldHaveLength, 1)
+		So(emitted[0].Type(), ShouldEqual, aggregate.EventTypeBase+"sum")
+		var data map[string]interface{}
+		So(stdJson.Unmarshal(emitted[0].Data(), &data), ShouldBeNil)
+		// the value "abc" isn't a number.
+		So(data["count"], ShouldEqual, 2)
+		So(data["sum"], ShouldEqual, 3.5)
+	})
+}
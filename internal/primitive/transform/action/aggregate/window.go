@@ -0,0 +1,196 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/common"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+)
+
+const (
+	EventSource   = "vanus.transform"
+	EventTypeBase = "vanus.transform.window."
+)
+
+var (
+	windowArgs = []arg.TypeList{[]arg.Type{arg.Constant}, []arg.Type{arg.Constant}}
+	slideArg   = arg.TypeList{arg.Constant}
+)
+
+type window struct {
+	start time.Time
+	count int64
+	sum   float64
+}
+
+// windowAction aggregates events in windows by event time, the window is tumbling if slide isn't
+// specified, otherwise it's sliding and an event belongs to size/slide windows. A window is closed
+// once an event later than its end arrives, then its aggregate event is emitted to the eventbus.
+// The events arriving after their windows have been closed are dropped. The windows are kept in
+// memory, so they are lost if the subscription is changed or moved to another trigger worker.
+type windowAction struct {
+	action.CommonAction
+	kind     string
+	eventbus string
+	size     time.Duration
+	slide    time.Duration
+
+	lock      sync.Mutex
+	windows   map[int64]*window
+	watermark time.Time
+}
+
+func newWindowAction(name, kind string, valueArg bool) *windowAction {
+	a := &windowAction{
+		kind:    kind,
+		windows: map[int64]*window{},
+	}
+	fixedArgs := windowArgs
+	if valueArg {
+		fixedArgs = append([]arg.TypeList{arg.All}, windowArgs...)
+	}
+	a.CommonAction = action.CommonAction{
+		ActionName:  name,
+		FixedArgs:   fixedArgs,
+		VariadicArg: slideArg,
+	}
+	return a
+}
+
+func (a *windowAction) Init(args []arg.Arg) error {
+	if len(a.FixedArgs) > len(windowArgs) {
+		a.Args = args[:1]
+		a.ArgTypes = []common.Type{common.Number}
+		args = args[1:]
+	}
+	if len(args) > len(windowArgs)+1 {
+		return action.ErrArgNumber
+	}
+	a.eventbus = args[0].Original()
+	if a.eventbus == "" {
+		return fmt.Errorf("eventbus is empty")
+	}
+	var err error
+	if a.size, err = time.ParseDuration(args[1].Original()); err != nil {
+		return fmt.Errorf("window size is invalid: %w", err)
+	}
+	a.slide = a.size
+	if len(args) > len(windowArgs) {
+		if a.slide, err = time.ParseDuration(args[2].Original()); err != nil {
+			return fmt.Errorf("window slide is invalid: %w", err)
+		}
+	}
+	if a.size <= 0 || a.slide <= 0 || a.slide > a.size {
+		return fmt.Errorf("window size %s or slide %s is invalid", a.size, a.slide)
+	}
+	return nil
+}
+
+func (a *windowAction) Execute(ceCtx *context.EventContext) error {
+	var value float64
+	if len(a.Args) > 0 {
+		args, err := a.RunArgs(ceCtx)
+		if err != nil {
+			return err
+		}
+		value, _ = args[0].(float64)
+	}
+	closed := a.add(eventTime(ceCtx.Event), value)
+	if ceCtx.Emit == nil {
+		return nil
+	}
+	for _, w := range closed {
+		e, err := a.newEvent(w)
+		if err != nil {
+			return err
+		}
+		ceCtx.Emit(a.eventbus, e)
+	}
+	return nil
+}
+
+// add aggregates the value into the windows containing t, and returns the windows closed by t.
+func (a *windowAction) add(t time.Time, value float64) []*window {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	last := t.Truncate(a.slide)
+	for start := last; start.Add(a.size).After(t); start = start.Add(-a.slide) {
+		if !start.Add(a.size).After(a.watermark) {
+			// the window has been closed.
+			break
+		}
+		w, ok := a.windows[start.UnixNano()]
+		if !ok {
+			w = &window{start: start}
+			a.windows[start.UnixNano()] = w
+		}
+		w.count++
+		w.sum += value
+	}
+	if !t.After(a.watermark) {
+		return nil
+	}
+	a.watermark = t
+
+	var closed []*window
+	for k, w := range a.windows {
+		if !w.start.Add(a.size).After(t) {
+			closed = append(closed, w)
+			delete(a.windows, k)
+		}
+	}
+	sort.Slice(closed, func(i, j int) bool {
+		return closed[i].start.Before(closed[j].start)
+	})
+	return closed
+}
+
+func (a *windowAction) newEvent(w *window) (*ce.Event, error) {
+	end := w.start.Add(a.size)
+	data := map[string]interface{}{
+		"windowStart": w.start.UTC().Format(time.RFC3339Nano),
+		"windowEnd":   end.UTC().Format(time.RFC3339Nano),
+		"count":       w.count,
+	}
+	if a.kind == kindSum {
+		data["sum"] = w.sum
+	}
+	e := ce.NewEvent()
+	e.SetID(uuid.NewString())
+	e.SetSource(EventSource)
+	e.SetType(EventTypeBase + a.kind)
+	e.SetTime(end)
+	if err := e.SetData(ce.ApplicationJSON, data); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// eventTime is the time attribute of event, or now if it's absent.
+func eventTime(e *ce.Event) time.Time {
+	if t := e.Time(); !t.IsZero() {
+		return t
+	}
+	return time.Now()
+}
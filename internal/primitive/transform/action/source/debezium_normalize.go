@@ -0,0 +1,114 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+)
+
+const (
+	defaultDebeziumTypePrefix = "io.debezium"
+	debeziumDB                = "iodebeziumdb"
+	debeziumTable             = "iodebeziumtable"
+)
+
+// debeziumOpNames maps the op of Debezium change event to the suffix of event type.
+var debeziumOpNames = map[string]string{
+	"c": "created",
+	"u": "updated",
+	"d": "deleted",
+	"r": "read",
+	"t": "truncated",
+}
+
+// ["debezium_normalize"] or ["debezium_normalize","type_prefix"].
+// It flattens the Debezium change event envelope in the data, with or without the schema, the data is
+// replaced by the row after the change, or the row before it for delete. The type is the prefix joined
+// with created, updated, deleted, read or truncated by the op, the op, db and table of the change are set
+// to the extensions iodebeziumop, iodebeziumdb and iodebeziumtable, the subject is the table and the time
+// is when Debezium processed the change.
+type debeziumNormalize struct {
+	action.CommonAction
+	typePrefix string
+}
+
+func NewDebeziumNormalizeAction() action.Action {
+	a := &debeziumNormalize{}
+	a.CommonAction = action.CommonAction{
+		ActionName:  "debezium_normalize",
+		VariadicArg: arg.TypeList{arg.Constant},
+	}
+	return a
+}
+
+func (a *debeziumNormalize) Init(args []arg.Arg) error {
+	if len(args) > 1 {
+		return fmt.Errorf("arg number invalid, only the type prefix is allowed")
+	}
+	a.typePrefix = defaultDebeziumTypePrefix
+	if len(args) == 1 {
+		a.typePrefix = args[0].Original()
+		if a.typePrefix == "" {
+			return fmt.Errorf("type prefix is empty")
+		}
+	}
+	_arg, _ := arg.NewArg(arg.EventDataArgPrefix)
+	a.TargetArg = _arg
+	return nil
+}
+
+func (a *debeziumNormalize) Execute(ceCtx *context.EventContext) error {
+	envelope, ok := ceCtx.Data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("data isn't a debezium change event")
+	}
+	// the envelope is wrapped in payload if the schema is enabled in the JSON converter.
+	if payload, exist := envelope["payload"].(map[string]interface{}); exist {
+		envelope = payload
+	}
+	op, _ := envelope["op"].(string)
+	name, exist := debeziumOpNames[op]
+	if !exist {
+		return fmt.Errorf("unknown op %q of debezium change event", op)
+	}
+	row := envelope["after"]
+	if op == "d" {
+		row = envelope["before"]
+	}
+	if row == nil {
+		row = map[string]interface{}{}
+	}
+	e := ceCtx.Event
+	e.SetType(a.typePrefix + "." + name)
+	e.SetExtension(debeziumOp, op)
+	if src, ok := envelope["source"].(map[string]interface{}); ok {
+		if db, ok := src["db"].(string); ok {
+			e.SetExtension(debeziumDB, db)
+		}
+		if table, ok := src["table"].(string); ok {
+			e.SetExtension(debeziumTable, table)
+			e.SetSubject(table)
+		}
+	}
+	if ts, ok := envelope["ts_ms"].(float64); ok {
+		e.SetTime(time.UnixMilli(int64(ts)))
+	}
+	return a.TargetArg.SetValue(ceCtx, row)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source_test
+
+import (
+	stdJson "encoding/json"
+	"testing"
+	"time"
+
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action/source"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDebeziumNormalizeAction(t *testing.T) {
+	funcName := source.NewDebeziumNormalizeAction().Name()
+	newContext := func(jsonStr string) *context.EventContext {
+		e := cetest.MinEvent()
+		var data interface{}
+		So(stdJson.Unmarshal([]byte(jsonStr), &data), ShouldBeNil)
+		return &context.EventContext{
+			Event: &e,
+			Data:  data,
+		}
+	}
+	Convey("test debezium normalize", t, func() {
+		Convey("test invalid args", func() {
+			_, err := runtime.NewAction([]interface{}{funcName, "a", "b"})
+			So(err, ShouldNotBeNil)
+			_, err = runtime.NewAction([]interface{}{funcName, "$.data.type"})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("test create with schema", func() {
+			a, err := runtime.NewAction([]interface{}{funcName})
+			So(err, ShouldBeNil)
+			ceCtx := newContext(`{
+				"schema": {"type": "struct"},
+				"payload": {
+					"before": null,
+					"after": {"id": 1, "name": "vanus"},
+					"source": {"connector": "postgresql", "db": "shop", "table": "orders"},
+					"op": "c",
+					"ts_ms": 1672531200000
+				}
+			}`)
+			So(a.Execute(ceCtx), ShouldBeNil)
+			So(ceCtx.Event.Type(), ShouldEqual, "io.debezium.created")
+			So(ceCtx.Event.Subject(), ShouldEqual, "orders")
+			So(ceCtx.Event.Extensions()["iodebeziumop"], ShouldEqual, "c")
+			So(ceCtx.Event.Extensions()["iodebeziumdb"], ShouldEqual, "shop")
+			So(ceCtx.Event.Extensions()["iodebeziumtable"], ShouldEqual, "orders")
+			So(ceCtx.Event.Time().Equal(time.UnixMilli(1672531200000)), ShouldBeTrue)
+			So(ceCtx.Data, ShouldResemble, map[string]interface{}{"id": float64(1), "name": "vanus"})
+		})
+		Convey("test update and delete with type prefix", func() {
+			a, err := runtime.NewAction([]interface{}{funcName, "com.example.orders"})
+			So(err, ShouldBeNil)
+			ceCtx := newContext(`{"before": {"id": 1, "name": "a"}, "after": {"id": 1, "name": "b"}, "op": "u"}`)
+			So(a.Execute(ceCtx), ShouldBeNil)
+			So(ceCtx.Event.Type(), ShouldEqual, "com.example.orders.updated")
+			So(ceCtx.Data, ShouldResemble, map[string]interface{}{"id": float64(1), "name": "b"})
+
+			ceCtx = newContext(`{"before": {"id": 1, "name": "b"}, "after": null, "op": "d"}`)
+			So(a.Execute(ceCtx), ShouldBeNil)
+			So(ceCtx.Event.Type(), ShouldEqual, "com.example.orders.deleted")
+			So(ceCtx.Data, ShouldResemble, map[string]interface{}{"id": float64(1), "name": "b"})
+
+			ceCtx = newContext(`{"op": "t"}`)
+			So(a.Execute(ceCtx), ShouldBeNil)
+			So(ceCtx.Event.Type(), ShouldEqual, "com.example.orders.truncated")
+			So(ceCtx.Data, ShouldResemble, map[string]interface{}{})
+		})
+		Convey("test not a change event", func() {
+			a, err := runtime.NewAction([]interface{}{funcName})
+			So(err, ShouldBeNil)
+			So(a.Execute(newContext(`{"id": 1}`)), ShouldNotBeNil)
+			So(a.Execute(newContext(`[1, 2]`)), ShouldNotBeNil)
+		})
+	})
+}
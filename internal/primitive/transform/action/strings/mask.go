@@ -0,0 +1,92 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/common"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+	"github.com/pkg/errors"
+)
+
+const (
+	maskChar = "*"
+	// maskedValue replaces the whole value, it has the fixed length so that the length isn't disclosed.
+	maskedValue = "***"
+)
+
+type maskAction struct {
+	action.CommonAction
+}
+
+// NewMaskAction ["mask", "key"] or ["mask", "key", keepLast], it masks the sensitive value of key,
+// all characters but the last keepLast ones are masked. The missing key is ignored.
+func NewMaskAction() action.Action {
+	return &maskAction{
+		CommonAction: action.CommonAction{
+			ActionName:  "MASK",
+			FixedArgs:   []arg.TypeList{arg.EventList},
+			VariadicArg: arg.All,
+		},
+	}
+}
+
+func (a *maskAction) Init(args []arg.Arg) error {
+	if len(args) > 2 {
+		return action.ErrArgNumber
+	}
+	a.TargetArg = args[0]
+	a.Args = args[1:]
+	a.ArgTypes = make([]common.Type, len(a.Args))
+	for i := range a.ArgTypes {
+		a.ArgTypes[i] = common.Number
+	}
+	return nil
+}
+
+func (a *maskAction) Execute(ceCtx *context.EventContext) error {
+	v, err := a.TargetArg.Evaluate(ceCtx)
+	if err != nil {
+		if errors.Is(err, arg.ErrArgValueNil) {
+			return nil
+		}
+		return err
+	}
+	args, err := a.RunArgs(ceCtx)
+	if err != nil {
+		return err
+	}
+	keepLast := 0
+	if len(args) > 0 {
+		keepLast = int(args[0].(float64))
+		if keepLast < 0 {
+			return fmt.Errorf("mask arg keepLast %d is negative", keepLast)
+		}
+	}
+	if keepLast == 0 {
+		return a.TargetArg.SetValue(ceCtx, maskedValue)
+	}
+	value, _ := common.Cast(v, common.String)
+	runes := []rune(value.(string))
+	if keepLast >= len(runes) {
+		return a.TargetArg.SetValue(ceCtx, strings.Repeat(maskChar, len(runes)))
+	}
+	masked := strings.Repeat(maskChar, len(runes)-keepLast) + string(runes[len(runes)-keepLast:])
+	return a.TargetArg.SetValue(ceCtx, masked)
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings_test
+
+import (
+	"testing"
+
+	cetest "github.com/cloudevents/sdk-go/v2/test"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action/strings"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/runtime"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMaskAction(t *testing.T) {
+	funcName := strings.NewMaskAction().Name()
+	Convey("test mask", t, func() {
+		Convey("test invalid args", func() {
+			_, err := runtime.NewAction([]interface{}{funcName, "$.data.card", float64(4), float64(1)})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("test mask the whole value", func() {
+			a, err := runtime.NewAction([]interface{}{funcName, "$.test"})
+			So(err, ShouldBeNil)
+			e := cetest.MinEvent()
+			e.SetExtension("test", "secret")
+			err = a.Execute(&context.EventContext{Event: &e})
+			So(err, ShouldBeNil)
+			So(e.Extensions()["test"], ShouldEqual, "***")
+		})
+		Convey("test mask but keep the last characters", func() {
+			a, err := runtime.NewAction([]interface{}{funcName, "$.data.card", float64(4)})
+			So(err, ShouldBeNil)
+			e := cetest.MinEvent()
+			ceCtx := &context.EventContext{
+				Event: &e,
+				Data: map[string]interface{}{
+					"card": "4111111111111234",
+				},
+			}
+			err = a.Execute(ceCtx)
+			So(err, ShouldBeNil)
+			So(ceCtx.Data.(map[string]interface{})["card"], ShouldEqual, "************1234")
+
+			ceCtx.Data = map[string]interface{}{"card": float64(123)}
+			err = a.Execute(ceCtx)
+			So(err, ShouldBeNil)
+			So(ceCtx.Data.(map[string]interface{})["card"], ShouldEqual, "***")
+		})
+		Convey("test mask a missing key", func() {
+			a, err := runtime.NewAction([]interface{}{funcName, "$.data.ssn"})
+			So(err, ShouldBeNil)
+			e := cetest.MinEvent()
+			ceCtx := &context.EventContext{
+				Event: &e,
+				Data:  map[string]interface{}{"name": "test"},
+			}
+			err = a.Execute(ceCtx)
+			So(err, ShouldBeNil)
+			So(ceCtx.Data, ShouldResemble, map[string]interface{}{"name": "test"})
+		})
+	})
+}
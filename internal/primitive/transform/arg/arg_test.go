@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
 	vContext "github.com/linkall-labs/vanus/internal/primitive/transform/context"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -114,6 +115,25 @@ func TestArgEvaluate(t *testing.T) {
 			So(err, ShouldNotBeNil)
 			So(v, ShouldBeNil)
 		})
+		Convey("test set reserved event attribute", func() {
+			arg, err := NewArg("$.xvanuseventbus")
+			So(err, ShouldBeNil)
+			err = arg.SetValue(ceCtx, "spoofed")
+			So(err, ShouldNotBeNil)
+			So(event.Extensions(), ShouldNotContainKey, "xvanuseventbus")
+			// the allowed attributes are same as producers.
+			arg, err = NewArg("$.xvanusdeliverytime")
+			So(err, ShouldBeNil)
+			So(arg.SetValue(ceCtx, "2006-01-02T15:04:05Z"), ShouldBeNil)
+		})
+		Convey("test set attribute of configured reserved prefix", func() {
+			primitive.InitReservedAttributes([]string{"xtenant"})
+			defer primitive.InitReservedAttributes(nil)
+			arg, err := NewArg("$.xtenantid")
+			So(err, ShouldBeNil)
+			So(arg.SetValue(ceCtx, "spoofed"), ShouldNotBeNil)
+			So(event.Extensions(), ShouldNotContainKey, "xtenantid")
+		})
 		Convey("test define", func() {
 			arg, err := NewArg("<var1>")
 			So(err, ShouldBeNil)
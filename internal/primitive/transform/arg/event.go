@@ -17,6 +17,7 @@ package arg
 import (
 	"strings"
 
+	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
 	"github.com/linkall-labs/vanus/internal/trigger/util"
 	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
@@ -61,6 +62,10 @@ func (arg eventAttribute) Evaluate(ceCtx *context.EventContext) (interface{}, er
 }
 
 func (arg eventAttribute) SetValue(ceCtx *context.EventContext, value interface{}) error {
+	// the system attributes are set by components, transformers can't spoof them.
+	if err := primitive.GetReservedAttributes().Check(arg.attr); err != nil {
+		return errors.Wrapf(ErrOperationNotSupport, "attribute %s is reserved", arg.attr)
+	}
 	return util.SetAttribute(ceCtx.Event, arg.attr, value)
 }
 
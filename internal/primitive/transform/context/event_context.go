@@ -16,8 +16,13 @@ package context
 
 import ce "github.com/cloudevents/sdk-go/v2"
 
+// Emitter appends the event emitted by actions to the eventbus.
+type Emitter func(eventbus string, event *ce.Event)
+
 type EventContext struct {
 	Event  *ce.Event
 	Define map[string]interface{}
 	Data   interface{}
+	// Emit is nil if the events emitted by actions are discarded.
+	Emit Emitter
 }
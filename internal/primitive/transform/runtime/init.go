@@ -15,6 +15,7 @@
 package runtime
 
 import (
+	"github.com/linkall-labs/vanus/internal/primitive/transform/action/aggregate"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/action/common"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/action/condition"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/action/datetime"
@@ -48,6 +49,7 @@ func init() {
 		strings.NewAddPrefixAction,
 		strings.NewAddSuffixAction,
 		strings.NewReplaceWithRegexAction,
+		strings.NewMaskAction,
 		// condition
 		condition.NewConditionIfAction,
 		// render
@@ -56,6 +58,10 @@ func init() {
 		common.NewLengthAction,
 		// source
 		source.NewDebeziumConvertToMongoDBSink,
+		source.NewDebeziumNormalizeAction,
+		// aggregate
+		aggregate.NewWindowCountAction,
+		aggregate.NewWindowSumAction,
 	} {
 		if err := AddAction(fn); err != nil {
 			panic(err)
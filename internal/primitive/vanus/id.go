@@ -22,14 +22,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/retry"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
-	"github.com/sony/sonyflake"
 	"go.uber.org/atomic"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -54,8 +52,39 @@ const (
 	reservedNodeIDStart             = uint16(8192)
 
 	waitFinishInitSpinInterval = 50 * time.Millisecond
+
+	// idLeaseSize is the number of IDs leased from controller each time.
+	idLeaseSize = 128
+	// allocateTimeout bounds the retries of leasing IDs, e.g. while the leader of controller is changing.
+	allocateTimeout = 10 * time.Second
+)
+
+var leasePolicy = retry.Policy{
+	Name:       "id_lease",
+	Initial:    50 * time.Millisecond,
+	Max:        time.Second,
+	Jitter:     retry.FullJitter,
+	MaxElapsed: allocateTimeout,
+}
+
+// The IDs allocated by controller are prefixed by a flag and the epoch, the epoch increases each time
+// the leader of controller changes. The flag makes them greater than the IDs generated by snowflake
+// before, and the epoch makes them greater than the IDs allocated by the previous leaders, even if
+// the clock is skewed.
+const (
+	epochIDFlag    = uint64(1) << 63
+	idSequenceBits = 40
+	// MaxIDEpoch is the max epoch of IDs.
+	MaxIDEpoch = uint64(1)<<(63-idSequenceBits) - 1
+	// MaxIDSequence is the max sequence of IDs in an epoch.
+	MaxIDSequence = uint64(1)<<idSequenceBits - 1
 )
 
+// NewEpochID returns the ID of sequence in epoch.
+func NewEpochID(epoch, sequence uint64) ID {
+	return ID(epochIDFlag | epoch<<idSequenceBits | sequence)
+}
+
 func (s Service) Name() string {
 	switch s {
 	case ControllerService:
@@ -121,10 +150,13 @@ var (
 )
 
 type snowflake struct {
-	snow     *sonyflake.Sonyflake
 	client   ctrlpb.SnowflakeControllerClient
 	ctrlAddr []string
 	n        *node
+	// the IDs leased and not used yet are [next, end).
+	mu   sync.Mutex
+	next uint64
+	end  uint64
 }
 
 // InitFakeSnowflake just only used for Uint Test.
@@ -132,7 +164,7 @@ func InitFakeSnowflake() {
 	fake = true
 }
 
-// InitSnowflake refactor in the future.
+// InitSnowflake registers the node and prepares the IDs leased from controller.
 func InitSnowflake(ctx context.Context, ctrlAddr []string, n *node) error {
 	if !n.valid() {
 		return fmt.Errorf("the nodeID number: %d exceeded, range of %s is [%d, %d)",
@@ -147,30 +179,13 @@ func InitSnowflake(ctx context.Context, ctrlAddr []string, n *node) error {
 			ctrlAddr: ctrlAddr,
 			n:        n,
 		}
-		var startTime *timestamppb.Timestamp
-		startTime, err = snow.client.GetClusterStartTime(ctx, &empty.Empty{})
-		if err != nil {
+		if _, err = snow.client.RegisterNode(ctx, &wrapperspb.UInt32Value{Value: uint32(n.logicID())}); err != nil {
+			log.Error(ctx, "register snowflake failed", map[string]interface{}{
+				log.KeyError: err,
+			})
 			return
 		}
-
-		snow.snow = sonyflake.NewSonyflake(sonyflake.Settings{
-			StartTime: startTime.AsTime(),
-			MachineID: func() (uint16, error) {
-				return n.logicID(), nil
-			},
-			CheckMachineID: func(u uint16) bool {
-				_, err := snow.client.RegisterNode(ctx, &wrapperspb.UInt32Value{Value: uint32(u)})
-				if err != nil {
-					log.Error(ctx, "register snowflake failed", map[string]interface{}{
-						log.KeyError: err,
-					})
-					return false
-				}
-				return true
-			},
-		})
-		if snow.snow == nil {
-			err = fmt.Errorf("init snowflake failed")
+		if err = snow.lease(ctx); err != nil {
 			return
 		}
 		generator = snow
@@ -203,13 +218,37 @@ func NewID() (ID, error) {
 		time.Sleep(waitFinishInitSpinInterval)
 	}
 
-	id, err := generator.snow.NextID()
-	if err != nil {
-		return EmptyID(), err
+	return generator.nextID()
+}
+
+func (s *snowflake) nextID() (ID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.next >= s.end {
+		if err := s.lease(context.Background()); err != nil {
+			return EmptyID(), err
+		}
 	}
+	id := s.next
+	s.next++
 	return ID(id), nil
 }
 
+// lease leases the next range of IDs.
+func (s *snowflake) lease(ctx context.Context) error {
+	return retry.Do(ctx, leasePolicy, func(ctx context.Context, _ int) error {
+		res, err := s.client.AllocateIDs(ctx, &ctrlpb.AllocateIDsRequest{Count: idLeaseSize})
+		if err != nil {
+			log.Warning(ctx, "failed to lease ids", map[string]interface{}{
+				log.KeyError: err,
+			})
+			return err
+		}
+		s.next, s.end = res.Start, res.Start+uint64(res.Count)
+		return nil
+	})
+}
+
 // NewTestID only used for Uint Test.
 func NewTestID() ID {
 	lock.Lock()
@@ -18,6 +18,9 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -73,3 +76,36 @@ func TestNewID(t *testing.T) {
 		So(EmptyID(), ShouldEqual, 0)
 	})
 }
+
+func TestSnowflake_nextID(t *testing.T) {
+	Convey("test next id leased from controller", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		cli := ctrlpb.NewMockSnowflakeControllerClient(mockCtrl)
+		s := &snowflake{client: cli}
+
+		cli.EXPECT().AllocateIDs(gomock.Any(), gomock.Any()).Times(1).Return(&ctrlpb.AllocateIDsResponse{
+			Epoch: 1, Start: NewEpochID(1, 0).Uint64(), Count: 2,
+		}, nil)
+		id1, err := s.nextID()
+		So(err, ShouldBeNil)
+		So(id1, ShouldEqual, NewEpochID(1, 0))
+		id2, err := s.nextID()
+		So(err, ShouldBeNil)
+		So(id2, ShouldEqual, NewEpochID(1, 1))
+
+		// the leader changed, the ids are leased from the new epoch.
+		gomock.InOrder(
+			cli.EXPECT().AllocateIDs(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.ErrNotLeader),
+			cli.EXPECT().AllocateIDs(gomock.Any(), gomock.Any()).Times(1).Return(&ctrlpb.AllocateIDsResponse{
+				Epoch: 2, Start: NewEpochID(2, 0).Uint64(), Count: 2,
+			}, nil),
+		)
+		id3, err := s.nextID()
+		So(err, ShouldBeNil)
+		So(id3, ShouldEqual, NewEpochID(2, 0))
+		So(id3, ShouldBeGreaterThan, id2)
+		// greater than the ids generated by snowflake.
+		So(id1, ShouldBeGreaterThan, ID(1<<62))
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	// first-party libraries.
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/raft/raftpb"
 )
@@ -77,6 +78,12 @@ func (h *host) Send(ctx context.Context, msg *raftpb.Message, to uint64, endpoin
 		attribute.Int64("to", int64(to)), attribute.String("endpoint", endpoint)))
 	defer span.End()
 
+	if msg.Type == raftpb.MsgApp && len(msg.Entries) != 0 && chaos.Hit(chaos.PointStoreDropFragment) {
+		// pretend it's sent, the follower rejects the next append and the leader resends them.
+		cb(nil)
+		return
+	}
+
 	mux := h.resolveMultiplexer(ctx, to, endpoint)
 	if mux == nil {
 		cb(ErrNotReachable)
@@ -0,0 +1,220 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standalone
+
+import (
+	"fmt"
+	"path/filepath"
+
+	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
+	"github.com/linkall-labs/vanus/internal/gateway"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/acl"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
+	"github.com/linkall-labs/vanus/internal/store"
+	"github.com/linkall-labs/vanus/internal/timer"
+	"github.com/linkall-labs/vanus/internal/trigger"
+	"github.com/linkall-labs/vanus/observability"
+)
+
+const (
+	nodeName       = "standalone"
+	metadataPrefix = "/vanus"
+	ioEnginePsync  = "psync"
+)
+
+// Config is the configuration of the all-in-one process, the configurations of components are derived
+// from it, all of them listen on the same IP.
+type Config struct {
+	// DataDir is the directory of the metadata in embedded etcd and the blocks of store.
+	DataDir        string `yaml:"data_dir"`
+	IP             string `yaml:"ip"`
+	ControllerPort int    `yaml:"controller_port"`
+	// EtcdPort is the client port of embedded etcd, the peer port is next to it.
+	EtcdPort    int `yaml:"etcd_port"`
+	StorePort   int `yaml:"store_port"`
+	TriggerPort int `yaml:"trigger_port"`
+	// GatewayPort is the proxy port of gateway, the CloudEvents receiver port is next to it.
+	GatewayPort int `yaml:"gateway_port"`
+	// StoreCapacity is the bytes of the volume of store.
+	StoreCapacity        uint64               `yaml:"store_capacity"`
+	SegmentCapacity      int64                `yaml:"segment_capacity"`
+	SecretEncryptionSalt string               `yaml:"secret_encryption_salt"`
+	SecretKMS            secret.KMSConfig     `yaml:"secret_kms"`
+	Observability        observability.Config `yaml:"observability"`
+	// ACL is enforced by controllers, and GatewayACL authenticates the principals by token for them.
+	ACL        acl.Config        `yaml:"acl"`
+	GatewayACL gateway.ACLConfig `yaml:"gateway_acl"`
+	// IPAllowlist rejects the requests to gateway from the addresses out of the allowlists.
+	IPAllowlist gateway.IPAllowlistConfig `yaml:"ip_allowlist"`
+	// ReservedAttributePrefixes are the prefixes of extension attributes which producers and transformers can't
+	// set besides xvanus.
+	ReservedAttributePrefixes []string `yaml:"reserved_attribute_prefixes"`
+	// FeatureFlags the defaults of experimental features of gateway and trigger.
+	FeatureFlags featureflag.Config `yaml:"feature_flags"`
+}
+
+func Default(c *Config) {
+	if c.IP == "" {
+		c.IP = "127.0.0.1"
+	}
+	if c.ControllerPort == 0 {
+		c.ControllerPort = 2048
+	}
+	if c.EtcdPort == 0 {
+		c.EtcdPort = 2379
+	}
+	if c.StorePort == 0 {
+		c.StorePort = 11811
+	}
+	if c.TriggerPort == 0 {
+		c.TriggerPort = 2148
+	}
+	if c.GatewayPort == 0 {
+		c.GatewayPort = 8080
+	}
+	if c.StoreCapacity == 0 {
+		c.StoreCapacity = 1024 * 1024 * 1024
+	}
+	if c.SegmentCapacity == 0 {
+		c.SegmentCapacity = 64 * 1024 * 1024
+	}
+	if c.SecretEncryptionSalt == "" {
+		c.SecretEncryptionSalt = "encryption_salt"
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.DataDir == "" {
+		return fmt.Errorf("the data dir can't be empty")
+	}
+	if c.SegmentCapacity <= 0 || uint64(c.SegmentCapacity) > c.StoreCapacity {
+		return fmt.Errorf("the segment capacity must be positive and not larger than the store capacity")
+	}
+	return nil
+}
+
+func (c *Config) controllerAddr() string {
+	return fmt.Sprintf("%s:%d", c.IP, c.ControllerPort)
+}
+
+func (c *Config) GetControllerConfig() *controller.Config {
+	peerAddr := fmt.Sprintf("%s:%d", c.IP, c.EtcdPort+1)
+	clientAddr := fmt.Sprintf("%s:%d", c.IP, c.EtcdPort)
+	return &controller.Config{
+		Name:           nodeName,
+		IP:             c.IP,
+		Port:           c.ControllerPort,
+		EtcdEndpoints:  []string{clientAddr},
+		DataDir:        c.DataDir,
+		MetadataConfig: controller.MetadataConfig{KeyPrefix: metadataPrefix},
+		EtcdConfig: embedetcd.Config{
+			// relative to DataDir
+			DataDir:             "etcd",
+			ListenClientAddr:    clientAddr,
+			ListenPeerAddr:      peerAddr,
+			AdvertiseClientAddr: clientAddr,
+			AdvertisePeerAddr:   peerAddr,
+			Clusters:            []string{fmt.Sprintf("%s=http://%s", nodeName, peerAddr)},
+		},
+		Topology:             map[string]string{nodeName: c.controllerAddr()},
+		Replicas:             1,
+		SecretEncryptionSalt: c.SecretEncryptionSalt,
+		SecretKMS:            c.SecretKMS,
+		SegmentCapacity:      c.SegmentCapacity,
+		Observability:        c.Observability,
+		ACL:                  c.ACL,
+	}
+}
+
+func (c *Config) GetStoreConfig() *store.Config {
+	// psync works everywhere, io_uring isn't available on all platforms for local development.
+	wal := store.WALConfig{IO: store.IOConfig{Engine: ioEnginePsync}}
+	return &store.Config{
+		ControllerAddresses: []string{c.controllerAddr()},
+		IP:                  c.IP,
+		Port:                c.StorePort,
+		Volume: store.VolumeInfo{
+			ID:       1,
+			Dir:      filepath.Join(c.DataDir, "store"),
+			Capacity: c.StoreCapacity,
+		},
+		MetaStore:     store.SyncStoreConfig{WAL: wal},
+		OffsetStore:   store.AsyncStoreConfig{WAL: wal},
+		Raft:          store.RaftConfig{WAL: wal},
+		Observability: c.Observability,
+
+		ReservedAttributePrefixes: c.ReservedAttributePrefixes,
+	}
+}
+
+func (c *Config) GetTimerConfig() *timer.Config {
+	cfg := &timer.Config{
+		Name:           nodeName,
+		IP:             c.IP,
+		Replicas:       1,
+		EtcdEndpoints:  []string{fmt.Sprintf("%s:%d", c.IP, c.EtcdPort)},
+		CtrlEndpoints:  []string{c.controllerAddr()},
+		MetadataConfig: timer.MetadataConfig{KeyPrefix: metadataPrefix},
+		Observability:  c.Observability,
+	}
+	timer.Default(cfg)
+	return cfg
+}
+
+func (c *Config) GetTriggerConfig() *trigger.Config {
+	return &trigger.Config{
+		TriggerAddr:    fmt.Sprintf("%s:%d", c.IP, c.TriggerPort),
+		IP:             c.IP,
+		Port:           c.TriggerPort,
+		ControllerAddr: []string{c.controllerAddr()},
+		Observability:  c.Observability,
+		FeatureFlags:   c.FeatureFlags,
+
+		ReservedAttributePrefixes: c.ReservedAttributePrefixes,
+	}
+}
+
+func (c *Config) GetGatewayConfig() *gateway.Config {
+	return &gateway.Config{
+		Port:                      c.GatewayPort,
+		ControllerAddr:            []string{c.controllerAddr()},
+		Observability:             c.Observability,
+		ACL:                       c.GatewayACL,
+		IPAllowlist:               c.IPAllowlist,
+		ReservedAttributePrefixes: c.ReservedAttributePrefixes,
+		FeatureFlags:              c.FeatureFlags,
+	}
+}
+
+// InitConfig loads the configuration file if it's not empty, the data dir overrides the one in file.
+func InitConfig(filename, dataDir string) (*Config, error) {
+	c := new(Config)
+	if filename != "" {
+		if err := primitive.LoadConfig(filename, c); err != nil {
+			return nil, err
+		}
+	}
+	if dataDir != "" {
+		c.DataDir = dataDir
+	}
+	Default(c)
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/linkall-labs/vanus/internal/controller"
+	"github.com/linkall-labs/vanus/internal/gateway"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
+	"github.com/linkall-labs/vanus/internal/primitive/profiling"
+	"github.com/linkall-labs/vanus/internal/store/segment"
+	"github.com/linkall-labs/vanus/internal/timer/leaderelection"
+	"github.com/linkall-labs/vanus/internal/timer/timingwheel"
+	"github.com/linkall-labs/vanus/internal/trigger"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	pbtriggerv2 "github.com/linkall-labs/vanus/proto/pkg/v2/trigger"
+	"google.golang.org/grpc"
+)
+
+type gatewayServer interface {
+	Start(ctx context.Context) error
+	Stop()
+}
+
+// Standalone runs the controller, store, timer, trigger worker and gateway in one process for local
+// development and tests. They talk to each other by the same APIs as the distributed deployment, so
+// the clients can't tell the difference.
+type Standalone struct {
+	cfg            *Config
+	controller     *controller.Server
+	store          segment.Server
+	leaderElection leaderelection.Manager
+	timingWheel    timingwheel.Manager
+	triggerWorker  pbtrigger.TriggerWorkerServer
+	triggerSrv     *grpc.Server
+	gateway        gatewayServer
+	wg             sync.WaitGroup
+}
+
+func New(cfg *Config) *Standalone {
+	return &Standalone{cfg: cfg}
+}
+
+// Start starts the components in the order of their dependencies, the controller goes first.
+func (s *Standalone) Start(ctx context.Context) error {
+	s.controller = controller.NewServer(s.cfg.GetControllerConfig())
+	if err := s.controller.Start(ctx); err != nil {
+		return fmt.Errorf("start controller failed: %w", err)
+	}
+	if err := s.startStore(ctx); err != nil {
+		return fmt.Errorf("start store failed: %w", err)
+	}
+	if err := s.startTimer(ctx); err != nil {
+		return fmt.Errorf("start timer failed: %w", err)
+	}
+	if err := s.startTrigger(ctx); err != nil {
+		return fmt.Errorf("start trigger worker failed: %w", err)
+	}
+	gw := gateway.NewGateway(*s.cfg.GetGatewayConfig())
+	if err := gw.Start(ctx); err != nil {
+		return fmt.Errorf("start gateway failed: %w", err)
+	}
+	s.gateway = gw
+	log.Info(ctx, "the standalone vanus is ready", map[string]interface{}{
+		"data_dir":   s.cfg.DataDir,
+		"controller": s.cfg.controllerAddr(),
+		"gateway":    fmt.Sprintf("%s:%d", s.cfg.IP, s.cfg.GatewayPort),
+	})
+	return nil
+}
+
+func (s *Standalone) startStore(ctx context.Context) error {
+	cfg := s.cfg.GetStoreConfig()
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	if err != nil {
+		return err
+	}
+	s.store = segment.NewServer(*cfg)
+	if err = s.store.Initialize(ctx); err != nil {
+		_ = listener.Close()
+		return err
+	}
+	// the grpc server of store is stopped by Stop of store asynchronously, it isn't waited.
+	go func() {
+		if err := s.store.Serve(listener); err != nil {
+			log.Error(ctx, "The SegmentServer occurred an error.", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+	return nil
+}
+
+func (s *Standalone) startTimer(ctx context.Context) error {
+	cfg := s.cfg.GetTimerConfig()
+	s.leaderElection = leaderelection.NewLeaderElection(cfg.GetLeaderElectionConfig())
+	s.timingWheel = timingwheel.NewTimingWheel(cfg.GetTimingWheelConfig())
+	if err := s.timingWheel.Init(ctx); err != nil {
+		return err
+	}
+	callbacks := leaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) {
+			if err := s.timingWheel.Recover(ctx); err != nil {
+				log.Error(ctx, "recover for failover failed, keeping follower", map[string]interface{}{
+					log.KeyError: err,
+				})
+				return
+			}
+			s.timingWheel.SetLeader(true)
+		},
+		OnStoppedLeading: func(ctx context.Context) {
+			s.timingWheel.SetLeader(false)
+		},
+	}
+	if err := s.leaderElection.Start(ctx, callbacks); err != nil {
+		return err
+	}
+	return s.timingWheel.Start(ctx)
+}
+
+func (s *Standalone) startTrigger(ctx context.Context) error {
+	cfg := s.cfg.GetTriggerConfig()
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
+	if err != nil {
+		return err
+	}
+	s.triggerSrv = grpc.NewServer()
+	s.triggerWorker = trigger.NewTriggerServer(*cfg)
+	pbtrigger.RegisterTriggerWorkerServer(s.triggerSrv, s.triggerWorker)
+	pbtriggerv2.RegisterTriggerWorkerServer(s.triggerSrv, trigger.NewTriggerServerV2(s.triggerWorker))
+	ctrlpb.RegisterProfilingServerServer(s.triggerSrv, profiling.NewServer())
+	ctrlpb.RegisterChaosServerServer(s.triggerSrv, chaos.NewServer())
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		if err := s.triggerSrv.Serve(listener); err != nil {
+			log.Error(ctx, "grpc server occurred an error", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+	return s.triggerWorker.(primitive.Initializer).Initialize(ctx)
+}
+
+// StopNotify is closed when the controller or the timer stopped itself.
+func (s *Standalone) StopNotify() <-chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		select {
+		case <-s.controller.StopNotify():
+		case <-s.timingWheel.StopNotify():
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// Stop stops the components in the reverse order of starting.
+func (s *Standalone) Stop(ctx context.Context) {
+	if s.gateway != nil {
+		s.gateway.Stop()
+	}
+	if s.triggerWorker != nil {
+		s.triggerWorker.(primitive.Closer).Close(ctx)
+		s.triggerSrv.GracefulStop()
+	}
+	if s.timingWheel != nil {
+		_ = s.leaderElection.Stop(ctx)
+		s.timingWheel.Stop(ctx)
+	}
+	if s.store != nil {
+		if err := s.store.Stop(ctx); err != nil {
+			log.Warning(ctx, "stop store failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+	if s.controller != nil {
+		s.controller.Stop()
+	}
+	s.wg.Wait()
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"context"
+	"sync"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+var logger = log.Module("store.block")
+
+type archivedHook struct {
+	name string
+	lis  ArchivedListener
+}
+
+// ArchivedHooks is a registry of ArchivedListener, it dispatches the archive events to all registered hooks
+// in the order of registration. A hook which panics is isolated, it doesn't affect the others.
+type ArchivedHooks struct {
+	mu    sync.RWMutex
+	hooks []archivedHook
+}
+
+// Make sure ArchivedHooks implements ArchivedListener.
+var _ ArchivedListener = (*ArchivedHooks)(nil)
+
+func NewArchivedHooks() *ArchivedHooks {
+	return &ArchivedHooks{}
+}
+
+// Register adds the hook identified by name, the returned function unregisters it.
+func (h *ArchivedHooks) Register(name string, lis ArchivedListener) func() {
+	hook := archivedHook{name: name, lis: lis}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// Copy on write, so that dispatching never holds the lock.
+	hooks := make([]archivedHook, len(h.hooks), len(h.hooks)+1)
+	copy(hooks, h.hooks)
+	h.hooks = append(hooks, hook)
+
+	return func() {
+		h.unregister(name)
+	}
+}
+
+func (h *ArchivedHooks) unregister(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	hooks := make([]archivedHook, 0, len(h.hooks))
+	for _, hook := range h.hooks {
+		if hook.name != name {
+			hooks = append(hooks, hook)
+		}
+	}
+	h.hooks = hooks
+}
+
+func (h *ArchivedHooks) OnArchived(stat Statistics) {
+	h.mu.RLock()
+	hooks := h.hooks
+	h.mu.RUnlock()
+
+	for _, hook := range hooks {
+		hook.dispatch(stat)
+	}
+}
+
+func (h *archivedHook) dispatch(stat Statistics) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error(context.Background(), "block: archived hook panicked.",
+				log.String("hook", h.name),
+				log.Stringer("block_id", stat.ID),
+				log.Any("panic", r),
+			)
+		}
+	}()
+	h.lis.OnArchived(stat)
+}
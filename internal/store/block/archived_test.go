@@ -0,0 +1,57 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+func TestArchivedHooks(t *testing.T) {
+	Convey("archived hooks", t, func() {
+		hooks := NewArchivedHooks()
+		stat := Statistics{ID: vanus.NewTestID(), Archived: true}
+
+		var calls []string
+		hooks.Register("first", ArchivedCallback(func(s Statistics) {
+			So(s, ShouldResemble, stat)
+			calls = append(calls, "first")
+		}))
+		unregister := hooks.Register("panic", ArchivedCallback(func(Statistics) {
+			calls = append(calls, "panic")
+			panic("hook panicked")
+		}))
+		hooks.Register("last", ArchivedCallback(func(Statistics) {
+			calls = append(calls, "last")
+		}))
+
+		Convey("dispatch to all hooks in order, and isolate panic", func() {
+			So(func() { hooks.OnArchived(stat) }, ShouldNotPanic)
+			So(calls, ShouldResemble, []string{"first", "panic", "last"})
+		})
+
+		Convey("unregister hook", func() {
+			unregister()
+			hooks.OnArchived(stat)
+			So(calls, ShouldResemble, []string{"first", "last"})
+		})
+	})
+}
@@ -44,10 +44,36 @@ type Reader interface {
 	Read(ctx context.Context, seq int64, num int) ([]Entry, error)
 }
 
-type AppendCallback = func(seqs []int64, err error)
+// AppendCallback is invoked with the sequence numbers of appended entries and their stime, the
+// millisecond timestamp when the entries are written to Block.
+type AppendCallback = func(seqs []int64, stime int64, err error)
+
+// AckLevel is the stage of append when AppendCallback is invoked.
+type AckLevel int8
+
+const (
+	// AckQuorum waits entries to be committed by the quorum of replicas.
+	AckQuorum AckLevel = iota
+	// AckLeader waits entries to be persisted to the log of leader.
+	AckLeader
+	// AckNone doesn't wait, the entries are only proposed.
+	AckNone
+)
+
+type AppendOptions struct {
+	AckLevel AckLevel
+}
+
+type AppendOption func(*AppendOptions)
+
+func WithAckLevel(level AckLevel) AppendOption {
+	return func(opts *AppendOptions) {
+		opts.AckLevel = level
+	}
+}
 
 type Appender interface {
-	Append(ctx context.Context, entries []Entry, cb AppendCallback)
+	Append(ctx context.Context, entries []Entry, cb AppendCallback, opts ...AppendOption)
 }
 
 type Block interface {
@@ -49,6 +49,8 @@ const (
 	defaultMaxInflightMsgs = 256
 )
 
+var logger = log.Module("store.raft")
+
 type Peer struct {
 	ID       vanus.ID
 	Endpoint string
@@ -147,10 +149,10 @@ func (a *appender) Stop(ctx context.Context) {
 	// Block until the stop has been acknowledged.
 	<-a.doneC
 
-	log.Info(ctx, "the raft node stopped", map[string]interface{}{
-		"node_id":   a.ID(),
-		"leader_id": a.leaderID,
-	})
+	logger.Info(ctx, "the raft node stopped",
+		log.Stringer("node_id", a.ID()),
+		log.Stringer("leader_id", a.leaderID),
+	)
 }
 
 func (a *appender) Bootstrap(ctx context.Context, blocks []Peer) error {
@@ -192,11 +194,11 @@ func (a *appender) run(ctx context.Context) {
 			rCtx, span := a.tracer.Start(ctx, "RaftReady", trace.WithNewRoot())
 
 			if len(rd.Entries) != 0 {
-				log.Debug(rCtx, "Append entries to raft log.", map[string]interface{}{
-					"node_id":        a.ID(),
-					"appended_index": rd.Entries[0].Index,
-					"entries_num":    len(rd.Entries),
-				})
+				logger.Debug(rCtx, "Append entries to raft log.",
+					log.Stringer("node_id", a.ID()),
+					log.Any("appended_index", rd.Entries[0].Index),
+					log.Any("entries_num", len(rd.Entries)),
+				)
 				a.log.Append(rCtx, rd.Entries, func(re raftlog.AppendResult, err error) {
 					if err != nil {
 						if stderr.Is(err, raftlog.ErrCompacted) || stderr.Is(err, raftlog.ErrTruncated) {
@@ -216,10 +218,10 @@ func (a *appender) run(ctx context.Context) {
 			}
 
 			if !raft.IsEmptyHardState(rd.HardState) {
-				log.Debug(rCtx, "Persist raft hard state.", map[string]interface{}{
-					"node_id":    a.ID(),
-					"hard_state": rd.HardState,
-				})
+				logger.Debug(rCtx, "Persist raft hard state.",
+					log.Stringer("node_id", a.ID()),
+					log.Any("hard_state", rd.HardState),
+				)
 				if err := a.log.SetHardState(rCtx, rd.HardState); err != nil {
 					span.End()
 					panic(err)
@@ -242,10 +244,10 @@ func (a *appender) run(ctx context.Context) {
 
 			if len(rd.CommittedEntries) != 0 {
 				applied := a.applyEntries(rCtx, rd.CommittedEntries)
-				log.Debug(rCtx, "Store applied offset.", map[string]interface{}{
-					"node_id":        a.ID(),
-					"applied_offset": applied,
-				})
+				logger.Debug(rCtx, "Store applied offset.",
+					log.Stringer("node_id", a.ID()),
+					log.Any("applied_offset", applied),
+				)
 				// FIXME(james.yin): persist applied after flush block.
 				a.log.SetApplied(rCtx, applied)
 			}
@@ -372,58 +374,65 @@ func (a *appender) reset(ctx context.Context) {
 
 	defer a.appendMu.Unlock()
 
-	for off > 0 {
-		pbEntries, err2 := a.log.Entries(off, off+1, 0)
+	a.actx = RecoverAppendContext(a.raw, a.log, off)
+}
+
+// RecoverAppendContext creates the append context of raw from the last normal entry in storage whose
+// index isn't larger than off, so that a new leader continues appending after the fragments in its log.
+func RecoverAppendContext(raw block.Raw, storage raft.Storage, off uint64) block.AppendContext {
+	for ; off > 0; off-- {
+		pbEntries, err := storage.Entries(off, off+1, 0)
 
 		// Entry has been compacted.
-		if err2 != nil {
-			a.actx = a.raw.NewAppendContext(nil)
-			break
+		if err != nil {
+			return raw.NewAppendContext(nil)
 		}
 
 		pbEntry := pbEntries[0]
 		if pbEntry.Type == raftpb.EntryNormal && len(pbEntry.Data) > 0 {
-			frag := block.NewFragment(pbEntry.Data)
-			a.actx = a.raw.NewAppendContext(frag)
-			break
+			return raw.NewAppendContext(block.NewFragment(pbEntry.Data))
 		}
-
-		off--
 	}
 
 	// no normal entry
-	if off == 0 {
-		a.actx = a.raw.NewAppendContext(nil)
-	}
+	return raw.NewAppendContext(nil)
 }
 
 // Append implements block.raw.
-func (a *appender) Append(ctx context.Context, entries []block.Entry, cb block.AppendCallback) {
+func (a *appender) Append(
+	ctx context.Context, entries []block.Entry, cb block.AppendCallback, opts ...block.AppendOption,
+) {
 	ctx, span := a.tracer.Start(ctx, "Append")
 	defer span.End()
 
+	var appendOpts block.AppendOptions
+	for _, opt := range opts {
+		opt(&appendOpts)
+	}
+
 	span.AddEvent("Acquiring append lock")
 	a.appendMu.Lock()
 	span.AddEvent("Got append lock")
 
 	if !a.isLeader() {
 		a.appendMu.Unlock()
-		cb(nil, errors.ErrNotLeader)
+		cb(nil, 0, errors.ErrNotLeader)
 		return
 	}
 
 	if a.actx.Archived() {
 		a.appendMu.Unlock()
-		cb(nil, errors.ErrSegmentFull)
+		cb(nil, 0, errors.ErrSegmentFull)
 		return
 	}
 
 	seqs, frag, enough, err := a.raw.PrepareAppend(ctx, a.actx, entries...)
 	if err != nil {
 		a.appendMu.Unlock()
-		cb(nil, err)
+		cb(nil, 0, err)
 		return
 	}
+	stime := a.actx.Stime()
 
 	data, _ := block.MarshalFragment(ctx, frag)
 
@@ -444,12 +453,14 @@ func (a *appender) Append(ctx context.Context, entries []block.Entry, cb block.A
 	}
 
 	pds[0] = raft.ProposeData{
-		Data: data,
+		Data:         data,
+		NoWaitCommit: appendOpts.AckLevel == block.AckNone,
+		WaitPersist:  appendOpts.AckLevel == block.AckLeader,
 		Callback: func(err error) {
 			if err != nil {
-				cb(nil, err)
+				cb(nil, 0, err)
 			} else {
-				cb(seqs, nil)
+				cb(seqs, stime, nil)
 			}
 		},
 	}
@@ -0,0 +1,294 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulation runs the replicas of a block in a single goroutine with a virtual clock and a
+// message scheduler, so that the append pipeline of raft and block can be tested under adversarial
+// schedules reproducibly. The same seed always results in the same schedule.
+package simulation
+
+import (
+	// standard libraries.
+	"container/heap"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math/rand"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/raft"
+	"github.com/linkall-labs/vanus/raft/raftpb"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+const (
+	defaultReplicas      = 3
+	defaultElectionTick  = 10
+	defaultHeartbeatTick = 3
+	defaultMaxSizePerMsg = 4096
+	defaultMaxInflight   = 256
+)
+
+var ErrNoLeader = errors.New("simulation: no leader")
+
+// RawFactory creates the raw block of a replica.
+type RawFactory func(ctx context.Context, id vanus.ID) (block.Raw, error)
+
+type Config struct {
+	// Replicas is the number of replicas, 3 if it's 0.
+	Replicas int
+	// Seed determines the schedule, the election timeouts, the latencies and the dropped messages.
+	Seed int64
+	// MinLatency and MaxLatency are the ticks a message takes to be delivered, the messages are
+	// reordered if they are different.
+	MinLatency int
+	MaxLatency int
+	// MaxPersistLatency is the max ticks the log of a replica takes to persist entries.
+	MaxPersistLatency int
+	// DropRate is the probability in percent that a message is dropped.
+	DropRate int
+	NewRaw   RawFactory
+}
+
+// Cluster is the replicas of a block. It isn't safe for concurrent use, everything is run by the
+// goroutine calling its methods.
+type Cluster struct {
+	cfg      Config
+	rand     *rand.Rand
+	now      int64
+	seq      uint64
+	events   eventQueue
+	replicas []*Replica
+	logger   raft.Logger
+}
+
+func New(ctx context.Context, cfg Config) (*Cluster, error) {
+	if cfg.Replicas <= 0 {
+		cfg.Replicas = defaultReplicas
+	}
+	if cfg.MaxLatency < cfg.MinLatency {
+		cfg.MaxLatency = cfg.MinLatency
+	}
+	c := &Cluster{
+		cfg:      cfg,
+		rand:     rand.New(rand.NewSource(cfg.Seed)), //nolint:gosec // it's for reproducible schedules.
+		replicas: make([]*Replica, 0, cfg.Replicas),
+		logger:   &raft.DefaultLogger{Logger: log.New(io.Discard, "", 0)},
+	}
+
+	peers := make([]raft.Peer, cfg.Replicas)
+	for i := range peers {
+		peers[i] = raft.Peer{ID: uint64(i + 1)}
+	}
+	for i := 0; i < cfg.Replicas; i++ {
+		id := vanus.NewIDFromUint64(uint64(i + 1))
+		raw, err := cfg.NewRaw(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		r := &Replica{
+			ID:      id,
+			Raw:     raw,
+			c:       c,
+			storage: raft.NewMemoryStorage(),
+		}
+		r.node = c.newNode(r, 0)
+		if err = r.node.Bootstrap(peers); err != nil {
+			return nil, err
+		}
+		c.replicas = append(c.replicas, r)
+	}
+	c.process(ctx)
+	return c, nil
+}
+
+func (c *Cluster) newNode(r *Replica, applied uint64) *raft.RawNode {
+	node, _ := raft.NewRawNode(&raft.Config{
+		ID:                        r.ID.Uint64(),
+		ElectionTick:              defaultElectionTick,
+		HeartbeatTick:             defaultHeartbeatTick,
+		Storage:                   r.storage,
+		Applied:                   applied,
+		MaxSizePerMsg:             defaultMaxSizePerMsg,
+		MaxInflightMsgs:           defaultMaxInflight,
+		PreVote:                   true,
+		DisableProposalForwarding: true,
+		Logger:                    c.logger,
+		Rand:                      c.rand,
+	})
+	return node
+}
+
+// Now returns the ticks elapsed.
+func (c *Cluster) Now() int64 {
+	return c.now
+}
+
+func (c *Cluster) Replicas() []*Replica {
+	return c.replicas
+}
+
+// Leader returns the leader of the latest term, or nil if there is no leader.
+func (c *Cluster) Leader() *Replica {
+	var leader *Replica
+	var term uint64
+	for _, r := range c.replicas {
+		if st := r.node.BasicStatus(); st.RaftState == raft.StateLeader && st.Term > term {
+			leader, term = r, st.Term
+		}
+	}
+	return leader
+}
+
+// Append appends entries by the leader.
+func (c *Cluster) Append(ctx context.Context, entries ...block.Entry) *Proposal {
+	r := c.Leader()
+	if r == nil {
+		p := &Proposal{}
+		p.complete(nil, ErrNoLeader)
+		return p
+	}
+	return r.Append(ctx, entries...)
+}
+
+// Tick advances the virtual clock by a tick, and runs everything scheduled until then.
+func (c *Cluster) Tick(ctx context.Context) {
+	c.now++
+	for _, r := range c.replicas {
+		r.node.Tick()
+	}
+	c.process(ctx)
+}
+
+// Run ticks until cond is satisfied or the ticks run out, it reports whether cond is satisfied.
+func (c *Cluster) Run(ctx context.Context, ticks int, cond func() bool) bool {
+	for i := 0; i < ticks; i++ {
+		if cond != nil && cond() {
+			return true
+		}
+		c.Tick(ctx)
+	}
+	return cond == nil || cond()
+}
+
+// Isolate drops the messages from and to the replica until Heal is called.
+func (c *Cluster) Isolate(r *Replica) {
+	r.isolated = true
+}
+
+func (c *Cluster) Heal() {
+	for _, r := range c.replicas {
+		r.isolated = false
+	}
+}
+
+// Campaign makes the replica start an election.
+func (c *Cluster) Campaign(ctx context.Context, r *Replica) {
+	_ = r.node.Campaign()
+	c.process(ctx)
+}
+
+// Restart restarts the replica from its log, the committed entries after applied are applied to the
+// raw block again. The entries in the log are kept, but the acknowledgements of persisting are lost.
+func (c *Cluster) Restart(ctx context.Context, r *Replica, applied uint64) {
+	r.epoch++
+	r.actx = nil
+	r.applied = applied
+	r.node = c.newNode(r, applied)
+	c.process(ctx)
+}
+
+// process handles the ready replicas and the events due, until there is nothing to do at the moment.
+func (c *Cluster) process(ctx context.Context) {
+	for {
+		for ready := true; ready; {
+			ready = false
+			for _, r := range c.replicas {
+				if r.node.HasReady() {
+					r.handleReady(ctx)
+					ready = true
+				}
+			}
+		}
+		if c.events.Len() == 0 || c.events[0].at > c.now {
+			return
+		}
+		e, _ := heap.Pop(&c.events).(*event)
+		c.dispatch(e)
+	}
+}
+
+func (c *Cluster) dispatch(e *event) {
+	r := e.to
+	if e.epoch != r.epoch {
+		// the replica has been restarted.
+		return
+	}
+	if e.persisted {
+		// Report entries has been persisted.
+		_ = r.node.Step(raftpb.Message{
+			Type:    raftpb.MsgLogResp,
+			LogTerm: e.term,
+			Index:   e.index,
+		})
+		return
+	}
+	_ = r.node.Step(e.msg)
+}
+
+func (c *Cluster) send(from *Replica, m raftpb.Message) {
+	if m.To == 0 || int(m.To) > len(c.replicas) {
+		return
+	}
+	to := c.replicas[m.To-1]
+	if from.isolated || to.isolated {
+		return
+	}
+	if c.cfg.DropRate > 0 && c.rand.Intn(100) < c.cfg.DropRate {
+		return
+	}
+	c.schedule(&event{
+		at:    c.now + c.latency(c.cfg.MinLatency, c.cfg.MaxLatency),
+		to:    to,
+		epoch: to.epoch,
+		msg:   m,
+	})
+}
+
+func (c *Cluster) persist(r *Replica, index, term uint64) {
+	c.schedule(&event{
+		at:        c.now + c.latency(0, c.cfg.MaxPersistLatency),
+		to:        r,
+		epoch:     r.epoch,
+		persisted: true,
+		index:     index,
+		term:      term,
+	})
+}
+
+func (c *Cluster) latency(min, max int) int64 {
+	if max <= min {
+		return int64(min)
+	}
+	return int64(min + c.rand.Intn(max-min+1))
+}
+
+func (c *Cluster) schedule(e *event) {
+	c.seq++
+	e.seq = c.seq
+	heap.Push(&c.events, e)
+}
@@ -0,0 +1,182 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
+	"github.com/linkall-labs/vanus/internal/store/vsb"
+)
+
+const blockCapacity = 64 * 1024
+
+func newRawFactory(t *testing.T) RawFactory {
+	return func(ctx context.Context, id vanus.ID) (block.Raw, error) {
+		engine, err := vsb.NewEngine(t.TempDir(), nil)
+		if err != nil {
+			return nil, err
+		}
+		return engine.Create(ctx, id, blockCapacity)
+	}
+}
+
+func newEntries(prefix string, n int) []block.Entry {
+	entries := make([]block.Entry, n)
+	for i := range entries {
+		entries[i] = convert.ToEntry(&cepb.CloudEvent{
+			Id:          fmt.Sprintf("%s-%d", prefix, i),
+			Source:      "simulation",
+			SpecVersion: "1.0",
+			Type:        "test",
+		})
+	}
+	return entries
+}
+
+// readIDs returns the ids of events in the raw block.
+func readIDs(ctx context.Context, r *Replica) []string {
+	var ids []string
+	for seq := int64(0); ; seq++ {
+		entries, err := r.Raw.Read(ctx, seq, 1)
+		if err != nil || len(entries) == 0 {
+			return ids
+		}
+		ids = append(ids, entries[0].GetString(ceschema.IDOrdinal))
+	}
+}
+
+// runScenario appends batches of entries under an adversarial schedule, the leader is isolated
+// and a follower is restarted from scratch in the middle.
+func runScenario(ctx context.Context, t *testing.T, seed int64) (*Cluster, []string) {
+	c, err := New(ctx, Config{
+		Seed:              seed,
+		MinLatency:        0,
+		MaxLatency:        3,
+		MaxPersistLatency: 2,
+		DropRate:          5,
+		NewRaw:            newRawFactory(t),
+	})
+	So(err, ShouldBeNil)
+	So(c.Run(ctx, 200, func() bool { return c.Leader() != nil }), ShouldBeTrue)
+
+	var acked []string
+	appendBatch := func(prefix string) {
+		leader := c.Leader()
+		if leader == nil {
+			return
+		}
+		entries := newEntries(prefix, 3)
+		p := leader.Append(ctx, entries...)
+		c.Run(ctx, 50, p.Done)
+		if p.Done() && p.Err == nil {
+			for _, e := range entries {
+				acked = append(acked, e.GetString(ceschema.IDOrdinal))
+			}
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		appendBatch(fmt.Sprintf("a%d", i))
+	}
+
+	old := c.Leader()
+	So(old, ShouldNotBeNil)
+	c.Isolate(old)
+	// the proposals of the isolated leader are never committed.
+	_ = old.Append(ctx, newEntries("lost", 2)...)
+	So(c.Run(ctx, 200, func() bool {
+		l := c.Leader()
+		return l != nil && l != old
+	}), ShouldBeTrue)
+	for i := 0; i < 5; i++ {
+		appendBatch(fmt.Sprintf("b%d", i))
+	}
+	c.Heal()
+
+	for _, r := range c.Replicas() {
+		if r != c.Leader() {
+			// the raw block has all fragments, they are trimmed when applied again.
+			c.Restart(ctx, r, 0)
+			break
+		}
+	}
+	c.Run(ctx, 100, func() bool { return c.Leader() != nil })
+	for i := 0; i < 5; i++ {
+		appendBatch(fmt.Sprintf("c%d", i))
+	}
+
+	So(c.Run(ctx, 500, func() bool {
+		applied := c.Replicas()[0].Applied()
+		for _, r := range c.Replicas() {
+			if r.Applied() != applied {
+				return false
+			}
+		}
+		return c.Leader() != nil
+	}), ShouldBeTrue)
+	return c, acked
+}
+
+func TestCluster(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("test simulation of the append pipeline", t, func() {
+		for seed := int64(1); seed <= 5; seed++ {
+			c, acked := runScenario(ctx, t, seed)
+			So(acked, ShouldNotBeEmpty)
+
+			expected := readIDs(ctx, c.Replicas()[0])
+			for _, r := range c.Replicas() {
+				So(r.Err(), ShouldBeNil)
+				So(readIDs(ctx, r), ShouldResemble, expected)
+			}
+			// the acknowledged entries are never lost.
+			for _, id := range acked {
+				So(expected, ShouldContain, id)
+			}
+			So(expected, ShouldNotContain, "lost-0")
+		}
+	})
+
+	Convey("test the same seed results in the same schedule", t, func() {
+		c1, acked1 := runScenario(ctx, t, 42)
+		c2, acked2 := runScenario(ctx, t, 42)
+		So(acked2, ShouldResemble, acked1)
+		So(c2.Now(), ShouldEqual, c1.Now())
+		So(readIDs(ctx, c2.Replicas()[0]), ShouldResemble, readIDs(ctx, c1.Replicas()[0]))
+	})
+
+	Convey("test append without leader", t, func() {
+		c, err := New(ctx, Config{Seed: 1, NewRaw: newRawFactory(t)})
+		So(err, ShouldBeNil)
+		p := c.Append(ctx, newEntries("x", 1)...)
+		So(p.Done(), ShouldBeTrue)
+		So(p.Err, ShouldEqual, ErrNoLeader)
+	})
+}
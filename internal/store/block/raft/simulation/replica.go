@@ -0,0 +1,184 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	// standard libraries.
+	"context"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/raft"
+	"github.com/linkall-labs/vanus/raft/raftpb"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	blockraft "github.com/linkall-labs/vanus/internal/store/block/raft"
+)
+
+// Proposal is the result of an append, it's completed when the entries are committed or dropped.
+type Proposal struct {
+	done bool
+	Seqs []int64
+	Err  error
+}
+
+func (p *Proposal) Done() bool {
+	return p.done
+}
+
+func (p *Proposal) complete(seqs []int64, err error) {
+	p.done = true
+	p.Seqs = seqs
+	p.Err = err
+}
+
+// Replica is a replica of the block, whose raft log is in memory.
+type Replica struct {
+	ID  vanus.ID
+	Raw block.Raw
+
+	c        *Cluster
+	node     *raft.RawNode
+	storage  *raft.MemoryStorage
+	actx     block.AppendContext
+	applied  uint64
+	epoch    uint64
+	isolated bool
+	err      error
+}
+
+func (r *Replica) IsLeader() bool {
+	return r.node.BasicStatus().RaftState == raft.StateLeader
+}
+
+// Applied returns the index of the last entry applied to the raw block.
+func (r *Replica) Applied() uint64 {
+	return r.applied
+}
+
+// Err returns the first error of committing fragments to the raw block.
+func (r *Replica) Err() error {
+	return r.err
+}
+
+// Append prepares the entries like the raft appender and proposes them, even if the replica isn't the
+// leader of the latest term.
+func (r *Replica) Append(ctx context.Context, entries ...block.Entry) *Proposal {
+	p := &Proposal{}
+	if !r.IsLeader() || r.actx == nil {
+		p.complete(nil, errors.ErrNotLeader)
+		return p
+	}
+	if r.actx.Archived() {
+		p.complete(nil, errors.ErrSegmentFull)
+		return p
+	}
+
+	seqs, frag, enough, err := r.Raw.PrepareAppend(ctx, r.actx, entries...)
+	if err != nil {
+		p.complete(nil, err)
+		return p
+	}
+	data, _ := block.MarshalFragment(ctx, frag)
+	pds := []raft.ProposeData{{
+		Data: data,
+		Callback: func(err error) {
+			if err != nil {
+				p.complete(nil, err)
+			} else {
+				p.complete(seqs, nil)
+			}
+		},
+	}}
+	if enough {
+		if frag, err := r.Raw.PrepareArchive(ctx, r.actx); err == nil {
+			archivedData, _ := block.MarshalFragment(ctx, frag)
+			pds = append(pds, raft.ProposeData{Data: archivedData})
+		}
+	}
+	r.node.ProposeWithCallback(pds...)
+	r.c.process(ctx)
+	return p
+}
+
+func (r *Replica) handleReady(ctx context.Context) {
+	rd := r.node.Ready()
+
+	if len(rd.Entries) != 0 {
+		_ = r.storage.Append(rd.Entries)
+		last := rd.Entries[len(rd.Entries)-1]
+		r.c.persist(r, last.Index, last.Term)
+	}
+
+	if !raft.IsEmptyHardState(rd.HardState) {
+		_ = r.storage.SetHardState(rd.HardState)
+	}
+
+	if rd.SoftState != nil {
+		if rd.SoftState.RaftState == raft.StateLeader {
+			last, _ := r.storage.LastIndex()
+			r.actx = blockraft.RecoverAppendContext(r.Raw, r.storage, last)
+		} else {
+			r.actx = nil
+		}
+	}
+
+	for _, m := range rd.Messages {
+		r.c.send(r, m)
+	}
+
+	if !raft.IsEmptySnap(rd.Snapshot) {
+		_ = r.storage.ApplySnapshot(rd.Snapshot)
+	}
+
+	if len(rd.CommittedEntries) != 0 {
+		r.apply(ctx, rd.CommittedEntries)
+	}
+
+	r.node.Advance(rd)
+}
+
+func (r *Replica) apply(ctx context.Context, entries []raftpb.Entry) {
+	frags := make([]block.Fragment, 0, len(entries))
+	for i := range entries {
+		pbEntry := &entries[i]
+		switch pbEntry.Type {
+		case raftpb.EntryNormal:
+			// Skip empty entry(raft heartbeat).
+			if len(pbEntry.Data) != 0 {
+				frags = append(frags, block.NewFragment(pbEntry.Data))
+			}
+		case raftpb.EntryConfChange:
+			var cc raftpb.ConfChange
+			if err := cc.Unmarshal(pbEntry.Data); err == nil {
+				r.node.ApplyConfChange(cc)
+			}
+		case raftpb.EntryConfChangeV2:
+			var cc raftpb.ConfChangeV2
+			if err := cc.Unmarshal(pbEntry.Data); err == nil {
+				r.node.ApplyConfChange(cc)
+			}
+		}
+	}
+
+	if len(frags) != 0 {
+		if _, err := r.Raw.CommitAppend(ctx, frags...); err != nil && r.err == nil {
+			r.err = err
+		}
+	}
+	r.applied = entries[len(entries)-1].Index
+}
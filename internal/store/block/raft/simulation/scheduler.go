@@ -0,0 +1,63 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import "github.com/linkall-labs/vanus/raft/raftpb"
+
+// event is a message to be delivered to a replica, or the acknowledgement of persisting its entries.
+type event struct {
+	at  int64
+	seq uint64
+	to  *Replica
+	// epoch is the epoch of the replica when the event is scheduled, the event is dropped if the
+	// replica has been restarted since then.
+	epoch     uint64
+	msg       raftpb.Message
+	persisted bool
+	index     uint64
+	term      uint64
+}
+
+// eventQueue orders the events by the time they are due, and then by the order they are scheduled.
+type eventQueue []*event
+
+func (q eventQueue) Len() int {
+	return len(q)
+}
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *eventQueue) Push(x interface{}) {
+	e, _ := x.(*event)
+	*q = append(*q, e)
+}
+
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return e
+}
@@ -28,6 +28,8 @@ var ErrSnapshotOutOfOrder = errors.New("the snapshot is out of order")
 type AppendContext interface {
 	WriteOffset() int64
 	Archived() bool
+	// Stime returns the millisecond timestamp of the entries prepared last.
+	Stime() int64
 }
 
 type TwoPCAppender interface {
@@ -42,9 +44,25 @@ type Snapshoter interface {
 	ApplySnapshot(ctx context.Context, snap Fragment) error
 }
 
+// Iterator reads entries of Block in sequence, it decodes entries lazily and reuses the buffer.
+type Iterator interface {
+	// Next returns the next entry, the entry is only valid until the next call of Next, SeekTo or Close.
+	Next() (Entry, error)
+	// SeekTo moves Iterator to the entry at seq.
+	SeekTo(seq int64)
+	// Close releases the buffer of Iterator.
+	Close()
+}
+
+type Iterable interface {
+	// NewIterator returns an Iterator starts from the entry at seq.
+	NewIterator(seq int64) Iterator
+}
+
 type Raw interface {
 	Seeker
 	Reader
+	Iterable
 	TwoPCAppender
 	Snapshoter
 
@@ -79,3 +97,19 @@ var _ ArchivedListener = (ArchivedCallback)(nil)
 func (f ArchivedCallback) OnArchived(stat Statistics) {
 	f(stat)
 }
+
+// AttributeIndexer is implemented by the blocks which index attributes of entries, so that entries can
+// be filtered without being decoded.
+type AttributeIndexer interface {
+	// LookupAttribute returns the value of attr of the entry at seq, a null value is empty. It returns
+	// false if attr isn't indexed or the entry isn't in the index.
+	LookupAttribute(seq int64, attr string) (string, bool)
+}
+
+// IDIndexer is implemented by the blocks which index ids of entries by bloom filter, so that the blocks
+// which don't contain an id can be skipped.
+type IDIndexer interface {
+	// ExcludeID returns the number of entries if no entry of Block has the id for sure. It returns false
+	// if Block may contain the id or ids aren't indexed.
+	ExcludeID(id string) (int64, bool)
+}
@@ -44,10 +44,14 @@ type Config struct {
 	IP                  string               `yaml:"ip"`
 	Port                int                  `yaml:"port"`
 	Volume              VolumeInfo           `yaml:"volume"`
+	Block               BlockConfig          `yaml:"block"`
 	MetaStore           SyncStoreConfig      `yaml:"meta_store"`
 	OffsetStore         AsyncStoreConfig     `yaml:"offset_store"`
 	Raft                RaftConfig           `yaml:"raft"`
 	Observability       observability.Config `yaml:"observability"`
+	// ReservedAttributePrefixes are the prefixes of extension attributes which only components can set
+	// besides xvanus, it should be same as the one of gateway.
+	ReservedAttributePrefixes []string `yaml:"reserved_attribute_prefixes"`
 }
 
 func (c *Config) Validate() error {
@@ -60,6 +64,9 @@ func (c *Config) Validate() error {
 	if err := c.Raft.validate(); err != nil {
 		return err
 	}
+	if err := c.Block.validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -69,6 +76,34 @@ type VolumeInfo struct {
 	Capacity uint64 `json:"capacity"`
 }
 
+type BlockConfig struct {
+	// IndexAttributes builds the index of type, source and subject of events when blocks are
+	// archived, so that the filtered reads can skip the events which don't match.
+	IndexAttributes bool `yaml:"index_attributes"`
+	// BloomFilterFPR is the false positive rate of the bloom filter of event ids built when blocks are
+	// archived, so that the reads by id can skip the blocks which don't contain it. 0 disables it.
+	BloomFilterFPR float64 `yaml:"bloom_filter_fpr"`
+	// MaxEntrySize is the max encoded size in bytes of an event appended to blocks, the larger one is
+	// rejected. 0 means no limit.
+	MaxEntrySize int `yaml:"max_entry_size"`
+	// MaxFragmentSize is the max encoded size in bytes of the events appended to a block in a batch.
+	// 0 means no limit.
+	MaxFragmentSize int `yaml:"max_fragment_size"`
+}
+
+func (c *BlockConfig) validate() error {
+	if c.BloomFilterFPR < 0 || c.BloomFilterFPR >= 1 {
+		return fmt.Errorf("bloom filter fpr must be in [0, 1)")
+	}
+	if c.MaxEntrySize < 0 || c.MaxFragmentSize < 0 {
+		return fmt.Errorf("max entry size and max fragment size must not be negative")
+	}
+	if c.MaxEntrySize > 0 && c.MaxFragmentSize > 0 && c.MaxEntrySize > c.MaxFragmentSize {
+		return fmt.Errorf("max entry size must not be larger than max fragment size")
+	}
+	return nil
+}
+
 type SyncStoreConfig struct {
 	WAL WALConfig `yaml:"wal"`
 }
@@ -115,5 +115,22 @@ raft:
 		}
 		err = cfg.Validate()
 		So(err, ShouldNotBeNil)
+
+		cfg = Config{
+			Block: BlockConfig{
+				BloomFilterFPR: 1,
+			},
+		}
+		err = cfg.Validate()
+		So(err, ShouldNotBeNil)
+
+		cfg = Config{
+			Block: BlockConfig{
+				MaxEntrySize:    2048,
+				MaxFragmentSize: 1024,
+			},
+		}
+		err = cfg.Validate()
+		So(err, ShouldNotBeNil)
 	})
 }
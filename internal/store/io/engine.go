@@ -14,7 +14,13 @@
 
 package io
 
-import "os"
+import (
+	// standard libraries.
+	"os"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
+)
 
 type Engine interface {
 	Close()
@@ -36,5 +42,9 @@ func NewEngine() Engine {
 func (e *engine) Close() {}
 
 func (e *engine) WriteAt(f *os.File, b []byte, off int64, so, eo int, cb WriteCallback) {
+	if chaos.Hit(chaos.PointStoreWriteAt) {
+		cb(0, chaos.ErrInjected)
+		return
+	}
 	cb(f.WriteAt(b, off))
 }
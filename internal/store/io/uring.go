@@ -27,6 +27,7 @@ import (
 	"github.com/iceber/iouring-go"
 
 	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
 	"github.com/linkall-labs/vanus/observability/log"
 )
 
@@ -35,6 +36,8 @@ const (
 	defaultInflightBufferSize = defaultResultBufferSize
 )
 
+var logger = log.Module("store.io")
+
 type uRing struct {
 	ring      *iouring.IOURing
 	resultC   chan iouring.Result
@@ -48,9 +51,7 @@ var _ Engine = (*uRing)(nil)
 func NewURing() Engine {
 	ring, err := iouring.New(defaultResultBufferSize)
 	if err != nil {
-		log.Error(context.Background(), "Create iouring failed.", map[string]interface{}{
-			log.KeyError: err,
-		})
+		logger.Error(context.Background(), "Create iouring failed.", log.Err(err))
 		panic(err)
 	}
 
@@ -67,9 +68,7 @@ func NewURing() Engine {
 
 func (e *uRing) Close() {
 	if err := e.ring.Close(); err != nil {
-		log.Error(context.Background(), "Encounter error when close iouring.", map[string]interface{}{
-			log.KeyError: err,
-		})
+		logger.Error(context.Background(), "Encounter error when close iouring.", log.Err(err))
 	}
 	close(e.resultC)
 }
@@ -134,6 +133,11 @@ func (e *uRing) runCallback() {
 }
 
 func (e *uRing) WriteAt(f *os.File, b []byte, off int64, so, eo int, cb WriteCallback) {
+	if chaos.Hit(chaos.PointStoreWriteAt) {
+		cb(0, chaos.ErrInjected)
+		return
+	}
+
 	seq := e.generateSeq()
 
 	pr := iouring.Pwrite(int(f.Fd()), b, uint64(off)).
@@ -36,6 +36,8 @@ const (
 	defaultDirPerm      = 0o755
 )
 
+var logger = log.Module("store.meta")
+
 func (s *store) tryCreateSnapshot(ctx context.Context) {
 	ctx, span := s.tracer.Start(ctx, "tryCreateSnapshot")
 	defer span.End()
@@ -61,10 +63,10 @@ func (s *store) createSnapshot(ctx context.Context) {
 	// Write data to file.
 	path := s.resolveSnapshotPath(s.version)
 	if err = os.WriteFile(path, data, defaultSnapshotPrem); err != nil {
-		log.Warning(context.TODO(), "Write snapshot failed.", map[string]interface{}{
-			"path":  path,
-			"error": err,
-		})
+		logger.Warning(context.TODO(), "Write snapshot failed.",
+			log.String("path", path),
+			log.Err(err),
+		)
 		return
 	}
 	lastSnapshot := s.snapshot
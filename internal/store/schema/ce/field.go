@@ -20,6 +20,10 @@ const (
 	CloudEvent uint16 = 0x6563 // ASCII of "ce" in little endian
 	End        uint16 = 0x6465 // ASCII of "ed" in little endian
 	Index      uint16 = 0x7864 // ASCII of "dx" in little endian
+	// AttributeIndex is the columnar index of attributes, it's persisted after Index when Block is archived.
+	AttributeIndex uint16 = 0x6961 // ASCII of "ai" in little endian
+	// BloomIndex is the bloom filter of ids, it's persisted after AttributeIndex when Block is archived.
+	BloomIndex uint16 = 0x6962 // ASCII of "bi" in little endian
 )
 
 func EntryType(entry block.Entry) uint16 {
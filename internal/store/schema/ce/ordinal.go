@@ -18,6 +18,8 @@ package ce
 const (
 	EntryTypeOrdinal = -1
 	IndexesOrdinal   = -2
+	ColumnsOrdinal   = -3
+	BloomOrdinal     = -4
 )
 
 // Fields of entry.
@@ -27,6 +27,7 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
 )
 
 type segmentServer struct {
@@ -118,25 +119,26 @@ func (s *segmentServer) AppendToBlock(
 ) (*segpb.AppendToBlockResponse, error) {
 	blockID := vanus.NewIDFromUint64(req.BlockId)
 	events := req.Events.GetEvents()
-	offs, err := s.srv.AppendToBlock(ctx, blockID, events)
+	offs, stime, err := s.srv.AppendToBlock(ctx, blockID, events, block.WithAckLevel(toAckLevel(req.AckLevel)))
 	if err != nil {
 		return nil, err
 	}
 
-	return &segpb.AppendToBlockResponse{Offsets: offs}, nil
+	return &segpb.AppendToBlockResponse{Offsets: offs, Stime: stime}, nil
 }
 
 func (s *segmentServer) ReadFromBlock(
 	ctx context.Context, req *segpb.ReadFromBlockRequest,
 ) (*segpb.ReadFromBlockResponse, error) {
 	blockID := vanus.NewIDFromUint64(req.BlockId)
-	events, err := s.srv.ReadFromBlock(ctx, blockID, req.Offset, int(req.Number), req.PollingTimeout)
+	events, next, err := s.srv.ReadFromBlock(ctx, blockID, req.Offset, int(req.Number), req.PollingTimeout, req.Filter)
 	if err != nil {
 		return nil, err
 	}
 
 	return &segpb.ReadFromBlockResponse{
-		Events: &cepb.CloudEventBatch{Events: events},
+		Events:     &cepb.CloudEventBatch{Events: events},
+		NextOffset: next,
 	}, nil
 }
 
@@ -151,3 +153,14 @@ func (s *segmentServer) LookupOffsetInBlock(
 
 	return &segpb.LookupOffsetInBlockResponse{Offset: off}, nil
 }
+
+func toAckLevel(level segpb.AckLevel) block.AckLevel {
+	switch level {
+	case segpb.AckLevel_LEADER:
+		return block.AckLeader
+	case segpb.AckLevel_NONE:
+		return block.AckNone
+	default:
+		return block.AckQuorum
+	}
+}
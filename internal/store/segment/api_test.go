@@ -152,9 +152,9 @@ func TestSegmentServer(t *testing.T) {
 		})
 
 		Convey("AppendToBlock()", func() {
-			srv.EXPECT().AppendToBlock(Any(), Not(vanus.EmptyID()), Not(Len(0))).Return([]int64{1}, nil)
-			srv.EXPECT().AppendToBlock(Any(), Eq(vanus.EmptyID()), Any()).Return(nil, errors.ErrInvalidRequest)
-			srv.EXPECT().AppendToBlock(Any(), Any(), Len(0)).Return(nil, errors.ErrInvalidRequest)
+			srv.EXPECT().AppendToBlock(Any(), Not(vanus.EmptyID()), Not(Len(0)), Any()).Return([]int64{1}, int64(1000), nil)
+			srv.EXPECT().AppendToBlock(Any(), Eq(vanus.EmptyID()), Any(), Any()).Return(nil, int64(0), errors.ErrInvalidRequest)
+			srv.EXPECT().AppendToBlock(Any(), Any(), Len(0), Any()).Return(nil, int64(0), errors.ErrInvalidRequest)
 
 			req := &segpb.AppendToBlockRequest{
 				BlockId: vanus.NewTestID().Uint64(),
@@ -165,6 +165,7 @@ func TestSegmentServer(t *testing.T) {
 			resp, err := ss.AppendToBlock(context.Background(), req)
 			So(err, ShouldBeNil)
 			So(resp.Offsets, ShouldResemble, []int64{1})
+			So(resp.Stime, ShouldEqual, 1000)
 
 			req = &segpb.AppendToBlockRequest{
 				BlockId: 0,
@@ -185,9 +186,12 @@ func TestSegmentServer(t *testing.T) {
 
 		Convey("ReadFromBlock()", func() {
 			id := vanus.NewTestID()
-			srv.EXPECT().ReadFromBlock(Any(), Not(vanus.EmptyID()), Any(), Not(0), Any()).Return(make([]*cepb.CloudEvent, 1), nil)
-			srv.EXPECT().ReadFromBlock(Any(), Eq(vanus.EmptyID()), Any(), Any(), Any()).Return(nil, errors.ErrInvalidRequest)
-			srv.EXPECT().ReadFromBlock(Any(), Any(), Any(), Eq(0), Any()).Return(nil, errors.ErrResourceNotFound)
+			srv.EXPECT().ReadFromBlock(Any(), Not(vanus.EmptyID()), Any(), Not(0), Any(), Any()).
+				Return(make([]*cepb.CloudEvent, 1), int64(1), nil)
+			srv.EXPECT().ReadFromBlock(Any(), Eq(vanus.EmptyID()), Any(), Any(), Any(), Any()).
+				Return(nil, int64(0), errors.ErrInvalidRequest)
+			srv.EXPECT().ReadFromBlock(Any(), Any(), Any(), Eq(0), Any(), Any()).
+				Return(nil, int64(0), errors.ErrResourceNotFound)
 
 			req := &segpb.ReadFromBlockRequest{
 				BlockId: id.Uint64(),
@@ -196,6 +200,7 @@ func TestSegmentServer(t *testing.T) {
 			resp, err := ss.ReadFromBlock(context.Background(), req)
 			So(err, ShouldBeNil)
 			So(resp.GetEvents().GetEvents(), ShouldResemble, []*cepb.CloudEvent{nil})
+			So(resp.GetNextOffset(), ShouldEqual, 1)
 
 			req = &segpb.ReadFromBlockRequest{
 				BlockId: 0,
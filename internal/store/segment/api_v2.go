@@ -0,0 +1,86 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"math"
+	"time"
+
+	// third-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// first-party libraries.
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	segpbv2 "github.com/linkall-labs/vanus/proto/pkg/v2/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+// segmentServerV2 serves the v2 of store API, the methods which aren't changed are same as v1.
+type segmentServerV2 struct {
+	*segmentServer
+}
+
+// Make sure segmentServerV2 implements segpbv2.SegmentServerServer.
+var _ segpbv2.SegmentServerServer = (*segmentServerV2)(nil)
+
+func (s *segmentServerV2) AppendToBlock(
+	ctx context.Context, req *segpb.AppendToBlockRequest,
+) (*segpbv2.AppendToBlockResponse, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	events := req.Events.GetEvents()
+	offs, stime, err := s.srv.AppendToBlock(ctx, blockID, events, block.WithAckLevel(toAckLevel(req.AckLevel)))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*segpbv2.AppendResult, len(offs))
+	for i, off := range offs {
+		results[i] = &segpbv2.AppendResult{Offset: off, Stime: stime}
+	}
+	return &segpbv2.AppendToBlockResponse{Results: results}, nil
+}
+
+func (s *segmentServerV2) ReadFromBlock(
+	ctx context.Context, req *segpbv2.ReadFromBlockRequest,
+) (*segpbv2.ReadFromBlockResponse, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	events, next, err := s.srv.ReadFromBlock(ctx, blockID, req.Offset, int(req.Number),
+		toPollingTimeout(req.PollingTimeout.AsDuration()), req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segpbv2.ReadFromBlockResponse{
+		Events:     &cepb.CloudEventBatch{Events: events},
+		NextOffset: next,
+	}, nil
+}
+
+// toPollingTimeout converts the polling timeout of v2 to the milliseconds of v1.
+func toPollingTimeout(d time.Duration) uint32 {
+	ms := d.Milliseconds()
+	if ms <= 0 {
+		return 0
+	}
+	if ms > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(ms)
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/golang/mock/gomock"
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	// first-party libraries.
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	segpbv2 "github.com/linkall-labs/vanus/proto/pkg/v2/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+func TestSegmentServerV2(t *testing.T) {
+	Convey("Test SegmentServer v2", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := NewMockServer(ctrl)
+		ss := segmentServerV2{
+			segmentServer: &segmentServer{srv: srv},
+		}
+
+		Convey("AppendToBlock()", func() {
+			srv.EXPECT().AppendToBlock(Any(), Not(vanus.EmptyID()), Len(2), Any()).
+				Return([]int64{1, 2}, int64(1000), nil)
+			srv.EXPECT().AppendToBlock(Any(), Eq(vanus.EmptyID()), Any(), Any()).
+				Return(nil, int64(0), errors.ErrInvalidRequest)
+
+			req := &segpb.AppendToBlockRequest{
+				BlockId: vanus.NewTestID().Uint64(),
+				Events: &cepb.CloudEventBatch{
+					Events: make([]*cepb.CloudEvent, 2),
+				},
+			}
+			resp, err := ss.AppendToBlock(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp.Results, ShouldHaveLength, 2)
+			So(resp.Results[0].Offset, ShouldEqual, 1)
+			So(resp.Results[1].Offset, ShouldEqual, 2)
+			So(resp.Results[1].Stime, ShouldEqual, 1000)
+
+			req.BlockId = 0
+			_, err = ss.AppendToBlock(context.Background(), req)
+			So(err, ShouldEqual, errors.ErrInvalidRequest)
+		})
+
+		Convey("ReadFromBlock()", func() {
+			id := vanus.NewTestID()
+			srv.EXPECT().ReadFromBlock(Any(), Eq(id), int64(3), 1, uint32(1500), Any()).
+				Return(make([]*cepb.CloudEvent, 1), int64(4), nil)
+			srv.EXPECT().ReadFromBlock(Any(), Eq(id), int64(4), 1, uint32(0), Any()).
+				Return(nil, int64(0), errors.ErrOffsetOnEnd)
+
+			req := &segpbv2.ReadFromBlockRequest{
+				BlockId:        id.Uint64(),
+				Offset:         3,
+				Number:         1,
+				PollingTimeout: durationpb.New(1500 * time.Millisecond),
+			}
+			resp, err := ss.ReadFromBlock(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp.GetEvents().GetEvents(), ShouldResemble, []*cepb.CloudEvent{nil})
+			So(resp.GetNextOffset(), ShouldEqual, 4)
+
+			// polling is disabled without timeout.
+			req = &segpbv2.ReadFromBlockRequest{
+				BlockId: id.Uint64(),
+				Offset:  4,
+				Number:  1,
+			}
+			_, err = ss.ReadFromBlock(context.Background(), req)
+			So(err, ShouldEqual, errors.ErrOffsetOnEnd)
+		})
+
+		Convey("unchanged methods", func() {
+			srv.EXPECT().LookupOffsetInBlock(Any(), Any(), int64(1000)).Return(int64(5), nil)
+
+			resp, err := ss.LookupOffsetInBlock(context.Background(), &segpb.LookupOffsetInBlockRequest{
+				BlockId: vanus.NewTestID().Uint64(),
+				Stime:   1000,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Offset, ShouldEqual, 5)
+		})
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"strings"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+// matchResult is the result of evaluating a filter on the server. The filter is evaluated
+// against string attributes only, the result of others is unknown and the event isn't dropped,
+// it's filtered by the trigger worker again.
+type matchResult int8
+
+const (
+	matchUnknown matchResult = iota
+	matchTrue
+	matchFalse
+)
+
+// lookupFunc returns the value of attribute, known is false if the value can't be evaluated.
+type lookupFunc func(attr string) (v string, known bool)
+
+// matchEvent returns false only if the event doesn't match the filter for sure.
+func matchEvent(filter *segpb.EventFilter, event *cepb.CloudEvent) bool {
+	return filter == nil || evalFilter(filter, func(attr string) (string, bool) {
+		return lookupAttribute(event, attr)
+	}) != matchFalse
+}
+
+// matchEntry is same as matchEvent, but the filter is evaluated on entry without decoding it.
+func matchEntry(filter *segpb.EventFilter, entry block.Entry) bool {
+	return filter == nil || evalFilter(filter, func(attr string) (string, bool) {
+		return lookupEntryAttribute(entry, attr)
+	}) != matchFalse
+}
+
+// skipIndexed returns the first entry from seq which may match the filter by the attribute index,
+// at most max entries are skipped without being read.
+func skipIndexed(filter *segpb.EventFilter, idx block.AttributeIndexer, seq int64, max int) int64 {
+	for end := seq + int64(max); seq < end; seq++ {
+		res := evalFilter(filter, func(attr string) (string, bool) {
+			return idx.LookupAttribute(seq, attr)
+		})
+		if res != matchFalse {
+			break
+		}
+	}
+	return seq
+}
+
+// requiredID returns the id which the events must have to match the filter.
+func requiredID(filter *segpb.EventFilter) (string, bool) {
+	if id, ok := filter.Exact["id"]; ok {
+		return id, true
+	}
+	for _, f := range filter.All {
+		if id, ok := requiredID(f); ok {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func evalFilter(filter *segpb.EventFilter, lookup lookupFunc) matchResult {
+	results := make([]matchResult, 0, 4)
+	results = append(results,
+		evalAttributes(filter.Exact, lookup, func(v, expected string) bool { return v == expected }),
+		evalAttributes(filter.Prefix, lookup, strings.HasPrefix),
+		evalAttributes(filter.Suffix, lookup, strings.HasSuffix),
+	)
+	for _, f := range filter.All {
+		results = append(results, evalFilter(f, lookup))
+	}
+	if len(filter.Any) > 0 {
+		results = append(results, evalAny(filter.Any, lookup))
+	}
+	if filter.Not != nil {
+		results = append(results, evalNot(filter.Not, lookup))
+	}
+	return evalAll(results)
+}
+
+func evalAll(results []matchResult) matchResult {
+	res := matchTrue
+	for _, r := range results {
+		switch r {
+		case matchFalse:
+			return matchFalse
+		case matchUnknown:
+			res = matchUnknown
+		}
+	}
+	return res
+}
+
+func evalAny(filters []*segpb.EventFilter, lookup lookupFunc) matchResult {
+	res := matchFalse
+	for _, f := range filters {
+		switch evalFilter(f, lookup) {
+		case matchTrue:
+			return matchTrue
+		case matchUnknown:
+			res = matchUnknown
+		}
+	}
+	return res
+}
+
+func evalNot(filter *segpb.EventFilter, lookup lookupFunc) matchResult {
+	switch evalFilter(filter, lookup) {
+	case matchTrue:
+		return matchFalse
+	case matchFalse:
+		return matchTrue
+	default:
+		return matchUnknown
+	}
+}
+
+func evalAttributes(attrs map[string]string, lookup lookupFunc,
+	match func(v, expected string) bool,
+) matchResult {
+	res := matchTrue
+	for attr, expected := range attrs {
+		v, known := lookup(attr)
+		if !known {
+			res = matchUnknown
+			continue
+		}
+		if !match(v, expected) {
+			return matchFalse
+		}
+	}
+	return res
+}
+
+// lookupAttribute returns the value of a string attribute, a missing attribute is same as empty
+// like the filters of trigger worker, known is false if the type of attribute isn't string. The URI and
+// URI-ref extensions aren't strings for trigger worker either, so they are unknown too.
+func lookupAttribute(event *cepb.CloudEvent, attr string) (string, bool) {
+	switch attr {
+	case "id":
+		return event.Id, true
+	case "source":
+		return event.Source, true
+	case "specversion":
+		return event.SpecVersion, true
+	case "type":
+		return event.Type, true
+	}
+	v, ok := event.Attributes[attr]
+	if !ok {
+		return "", true
+	}
+	switch val := v.Attr.(type) {
+	case *cepb.CloudEvent_CloudEventAttributeValue_CeString:
+		return val.CeString, true
+	default:
+		return "", false
+	}
+}
+
+// entryAttributes are the string attributes stored in fields of entry.
+var entryAttributes = map[string]int{
+	"id":              ceschema.IDOrdinal,
+	"source":          ceschema.SourceOrdinal,
+	"specversion":     ceschema.SpecVersionOrdinal,
+	"type":            ceschema.TypeOrdinal,
+	"datacontenttype": ceschema.DataContentTypeOrdinal,
+	"dataschema":      ceschema.DataSchemaOrdinal,
+	"subject":         ceschema.SubjectOrdinal,
+}
+
+// lookupEntryAttribute is same as lookupAttribute, the extension attributes of entry are strings.
+func lookupEntryAttribute(entry block.Entry, attr string) (string, bool) {
+	if ordinal, ok := entryAttributes[attr]; ok {
+		return entry.GetString(ordinal), true
+	}
+	switch attr {
+	case "time", segpb.XVanusBlockOffset, segpb.XVanusStime:
+		return "", false
+	}
+	return string(entry.GetExtensionAttribute([]byte(attr))), true
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+func TestMatchEvent(t *testing.T) {
+	event := &cepb.CloudEvent{
+		Id:     "1",
+		Source: "vanus.ai/test",
+		Type:   "com.example.order.created",
+		Attributes: map[string]*cepb.CloudEvent_CloudEventAttributeValue{
+			"subject": {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: "order"}},
+			"xlevel":  {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeInteger{CeInteger: 1}},
+			"xurl":    {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeUri{CeUri: "https://vanus.ai"}},
+			"xref":    {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeUriRef{CeUriRef: "/order"}},
+		},
+	}
+
+	Convey("match event without filter", t, func() {
+		So(matchEvent(nil, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{}, event), ShouldBeTrue)
+	})
+
+	Convey("match event by attributes", t, func() {
+		So(matchEvent(&segpb.EventFilter{Exact: map[string]string{"type": "com.example.order.created"}}, event),
+			ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Exact: map[string]string{"type": "com.example.order.paid"}}, event),
+			ShouldBeFalse)
+		So(matchEvent(&segpb.EventFilter{Prefix: map[string]string{"source": "vanus.ai/"}}, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Suffix: map[string]string{"subject": "der"}}, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Exact: map[string]string{"xmissing": "v"}}, event), ShouldBeFalse)
+	})
+
+	Convey("keep event if the attribute isn't string", t, func() {
+		So(matchEvent(&segpb.EventFilter{Exact: map[string]string{"xlevel": "2"}}, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Not: &segpb.EventFilter{
+			Exact: map[string]string{"xlevel": "1"},
+		}}, event), ShouldBeTrue)
+		// trigger worker doesn't compare URI with string.
+		So(matchEvent(&segpb.EventFilter{Not: &segpb.EventFilter{
+			Exact: map[string]string{"xurl": "https://vanus.ai"},
+		}}, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Not: &segpb.EventFilter{
+			Prefix: map[string]string{"xref": "/"},
+		}}, event), ShouldBeTrue)
+	})
+
+	Convey("match event by composite filters", t, func() {
+		paid := &segpb.EventFilter{Exact: map[string]string{"type": "com.example.order.paid"}}
+		created := &segpb.EventFilter{Exact: map[string]string{"type": "com.example.order.created"}}
+		So(matchEvent(&segpb.EventFilter{Any: []*segpb.EventFilter{paid, created}}, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Any: []*segpb.EventFilter{paid}}, event), ShouldBeFalse)
+		So(matchEvent(&segpb.EventFilter{All: []*segpb.EventFilter{paid, created}}, event), ShouldBeFalse)
+		So(matchEvent(&segpb.EventFilter{Not: paid}, event), ShouldBeTrue)
+		So(matchEvent(&segpb.EventFilter{Not: created}, event), ShouldBeFalse)
+	})
+}
+
+type testIndexer map[int64]string
+
+func (idx testIndexer) LookupAttribute(seq int64, attr string) (string, bool) {
+	v, ok := idx[seq]
+	return v, ok && attr == "type"
+}
+
+func TestSkipIndexed(t *testing.T) {
+	Convey("skip entries by attribute index", t, func() {
+		idx := testIndexer{0: "a", 1: "a", 2: "b", 3: "a"}
+		filter := &segpb.EventFilter{Exact: map[string]string{"type": "b"}}
+		So(skipIndexed(filter, idx, 0, 16), ShouldEqual, 2)
+		So(skipIndexed(filter, idx, 0, 1), ShouldEqual, 1)
+		// the entries out of index are unknown.
+		So(skipIndexed(filter, idx, 3, 16), ShouldEqual, 4)
+		// the attributes out of index are unknown.
+		filter = &segpb.EventFilter{Exact: map[string]string{"id": "1"}}
+		So(skipIndexed(filter, idx, 0, 16), ShouldEqual, 0)
+	})
+}
+
+func TestRequiredID(t *testing.T) {
+	Convey("required id of filter", t, func() {
+		_, ok := requiredID(&segpb.EventFilter{Exact: map[string]string{"type": "a"}})
+		So(ok, ShouldBeFalse)
+		id, ok := requiredID(&segpb.EventFilter{Exact: map[string]string{"id": "1"}})
+		So(ok, ShouldBeTrue)
+		So(id, ShouldEqual, "1")
+		id, ok = requiredID(&segpb.EventFilter{All: []*segpb.EventFilter{
+			{Prefix: map[string]string{"id": "2"}},
+			{Exact: map[string]string{"id": "1"}},
+		}})
+		So(ok, ShouldBeTrue)
+		So(id, ShouldEqual, "1")
+		// any of filters isn't required.
+		_, ok = requiredID(&segpb.EventFilter{Any: []*segpb.EventFilter{
+			{Exact: map[string]string{"id": "1"}},
+		}})
+		So(ok, ShouldBeFalse)
+	})
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/health"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+func (s *server) registerHealthChecks() {
+	health.AddReadinessCheck("disk", health.DiskWritable(s.volumeDir))
+	health.AddReadinessCheck("state", health.Condition(func() bool {
+		return s.state == primitive.ServerStateRunning
+	}))
+	health.AddReadinessCheck("raft", s.checkRaftQuorum)
+	health.AddReadinessCheck("controller", health.Condition(func() bool {
+		return s.ctrl.IsReady(false)
+	}))
+}
+
+// checkRaftQuorum fails if any block on this server has no leader, which means the raft
+// group of it lost the quorum.
+func (s *server) checkRaftQuorum(_ context.Context) error {
+	var total, leaderless int
+	s.replicas.Range(func(_, value interface{}) bool {
+		total++
+		if value.(Replica).Status().Leader == 0 {
+			leaderless++
+		}
+		return true
+	})
+	if leaderless != 0 {
+		return fmt.Errorf("%d of %d blocks have no leader", leaderless, total)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+func TestServer_checkRaftQuorum(t *testing.T) {
+	Convey("check raft quorum", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		srv := &server{}
+
+		So(srv.checkRaftQuorum(context.Background()), ShouldBeNil)
+
+		b1 := NewMockReplica(ctrl)
+		b1.EXPECT().Status().AnyTimes().Return(&metapb.SegmentHealthInfo{Leader: 1})
+		srv.replicas.Store(vanus.NewTestID(), b1)
+		So(srv.checkRaftQuorum(context.Background()), ShouldBeNil)
+
+		b2 := NewMockReplica(ctrl)
+		b2.EXPECT().Status().AnyTimes().Return(&metapb.SegmentHealthInfo{})
+		srv.replicas.Store(vanus.NewTestID(), b2)
+		err := srv.checkRaftQuorum(context.Background())
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "1 of 2 blocks have no leader")
+	})
+}
@@ -39,15 +39,20 @@ func (m *MockReplica) EXPECT() *MockReplicaMockRecorder {
 }
 
 // Append mocks base method.
-func (m *MockReplica) Append(ctx context.Context, entries []block.Entry, cb block.AppendCallback) {
+func (m *MockReplica) Append(ctx context.Context, entries []block.Entry, cb block.AppendCallback, opts ...block.AppendOption) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Append", ctx, entries, cb)
+	varargs := []interface{}{ctx, entries, cb}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Append", varargs...)
 }
 
 // Append indicates an expected call of Append.
-func (mr *MockReplicaMockRecorder) Append(ctx, entries, cb interface{}) *gomock.Call {
+func (mr *MockReplicaMockRecorder) Append(ctx, entries, cb interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockReplica)(nil).Append), ctx, entries, cb)
+	varargs := append([]interface{}{ctx, entries, cb}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockReplica)(nil).Append), varargs...)
 }
 
 // Bootstrap mocks base method.
@@ -12,7 +12,9 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	primitive "github.com/linkall-labs/vanus/internal/primitive"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	block "github.com/linkall-labs/vanus/internal/store/block"
 	cloudevents "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segment "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
 // MockServer is a mock of Server interface.
@@ -53,18 +55,24 @@ func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas in
 }
 
 // AppendToBlock mocks base method.
-func (m *MockServer) AppendToBlock(ctx context.Context, id vanus.ID, events []*cloudevents.CloudEvent) ([]int64, error) {
+func (m *MockServer) AppendToBlock(ctx context.Context, id vanus.ID, events []*cloudevents.CloudEvent, opts ...block.AppendOption) ([]int64, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AppendToBlock", ctx, id, events)
+	varargs := []interface{}{ctx, id, events}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendToBlock", varargs...)
 	ret0, _ := ret[0].([]int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // AppendToBlock indicates an expected call of AppendToBlock.
-func (mr *MockServerMockRecorder) AppendToBlock(ctx, id, events interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) AppendToBlock(ctx, id, events interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockServer)(nil).AppendToBlock), ctx, id, events)
+	varargs := append([]interface{}{ctx, id, events}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockServer)(nil).AppendToBlock), varargs...)
 }
 
 // CreateBlock mocks base method.
@@ -125,18 +133,19 @@ func (mr *MockServerMockRecorder) LookupOffsetInBlock(ctx, id, stime interface{}
 }
 
 // ReadFromBlock mocks base method.
-func (m *MockServer) ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cloudevents.CloudEvent, error) {
+func (m *MockServer) ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32, filter *segment.EventFilter) ([]*cloudevents.CloudEvent, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadFromBlock", ctx, id, seq, num, pollingTimeout)
+	ret := m.ctrl.Call(m, "ReadFromBlock", ctx, id, seq, num, pollingTimeout, filter)
 	ret0, _ := ret[0].([]*cloudevents.CloudEvent)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ReadFromBlock indicates an expected call of ReadFromBlock.
-func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, pollingTimeout interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, pollingTimeout, filter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockServer)(nil).ReadFromBlock), ctx, id, seq, num, pollingTimeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockServer)(nil).ReadFromBlock), ctx, id, seq, num, pollingTimeout, filter)
 }
 
 // RemoveBlock mocks base method.
@@ -96,9 +96,7 @@ func (s *server) recoverBlocks(ctx context.Context, logs map[vanus.ID]*raftlog.L
 
 		switch {
 		case b == nil:
-			log.Debug(ctx, "Not found block, so discard the raft log.", map[string]interface{}{
-				"node_id": id,
-			})
+			logger.Debug(ctx, "Not found block, so discard the raft log.", log.Stringer("node_id", id))
 		default:
 			continue
 		}
@@ -17,7 +17,6 @@ package segment
 import (
 	// standard libraries.
 	"context"
-	"os"
 	"testing"
 
 	// third-party libraries.
@@ -26,6 +25,7 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store"
+	"github.com/linkall-labs/vanus/internal/store/block"
 )
 
 func TestServer_recover(t *testing.T) {
@@ -33,11 +33,7 @@ func TestServer_recover(t *testing.T) {
 	defer ctrl.Finish()
 
 	Convey("recover", t, func() {
-		dir, err := os.MkdirTemp("", "volume-*")
-		So(err, ShouldBeNil)
-		defer func() {
-			So(os.RemoveAll(dir), ShouldBeNil)
-		}()
+		dir := t.TempDir()
 
 		srv := &server{
 			cfg: store.Config{
@@ -45,8 +41,10 @@ func TestServer_recover(t *testing.T) {
 					Dir: dir,
 				},
 			},
+			volumeDir:     dir,
+			archivedHooks: block.NewArchivedHooks(),
 		}
-		err = srv.loadEngine(context.Background())
+		err := srv.loadEngine(context.Background())
 		So(err, ShouldBeNil)
 
 		err = srv.recover(context.Background())
@@ -80,8 +80,31 @@ func (r *replica) Read(ctx context.Context, seq int64, num int) ([]block.Entry,
 	return r.raw.Read(ctx, seq, num)
 }
 
-func (r *replica) Append(ctx context.Context, entries []block.Entry, cb block.AppendCallback) {
-	r.appender.Append(ctx, entries, cb)
+// NewIterator returns the iterator of raw block.
+func (r *replica) NewIterator(seq int64) block.Iterator {
+	return r.raw.NewIterator(seq)
+}
+
+// LookupAttribute looks up the attribute index of raw block if it has.
+func (r *replica) LookupAttribute(seq int64, attr string) (string, bool) {
+	if idx, ok := r.raw.(block.AttributeIndexer); ok {
+		return idx.LookupAttribute(seq, attr)
+	}
+	return "", false
+}
+
+// ExcludeID looks up the id index of raw block if it has.
+func (r *replica) ExcludeID(id string) (int64, bool) {
+	if idx, ok := r.raw.(block.IDIndexer); ok {
+		return idx.ExcludeID(id)
+	}
+	return 0, false
+}
+
+func (r *replica) Append(
+	ctx context.Context, entries []block.Entry, cb block.AppendCallback, opts ...block.AppendOption,
+) {
+	r.appender.Append(ctx, entries, cb, opts...)
 }
 
 func (r *replica) Status() *metapb.SegmentHealthInfo {
@@ -40,6 +40,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/observability/tracing"
@@ -50,10 +51,13 @@ import (
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	raftpb "github.com/linkall-labs/vanus/proto/pkg/raft"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	segpbv2 "github.com/linkall-labs/vanus/proto/pkg/v2/segment"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
+	"github.com/linkall-labs/vanus/internal/primitive/profiling"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	raftlog "github.com/linkall-labs/vanus/internal/raft/log"
 	"github.com/linkall-labs/vanus/internal/raft/transport"
@@ -71,8 +75,15 @@ const (
 	debugModeENV                = "SEGMENT_SERVER_DEBUG_MODE"
 	defaultLeaderInfoBufferSize = 256
 	defaultForceStopTimeout     = 30 * time.Second
+	// maxFilterScanFactor limits the entries scanned by a filtered read to avoid blocking on a
+	// block full of unmatched events.
+	maxFilterScanFactor = 8
+	// maxIndexedSkip limits the entries skipped by the attribute index in a round of read.
+	maxIndexedSkip = 16 * 1024
 )
 
+var logger = log.Module("store.segment")
+
 type Server interface {
 	primitive.Initializer
 
@@ -89,8 +100,10 @@ type Server interface {
 	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string) error
 	InactivateSegment(ctx context.Context) error
 
-	AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error)
-	ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cepb.CloudEvent, error)
+	AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent,
+		opts ...block.AppendOption) ([]int64, int64, error)
+	ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32,
+		filter *segpb.EventFilter) ([]*cepb.CloudEvent, int64, error)
 	LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int64) (int64, error)
 }
 
@@ -122,6 +135,9 @@ func NewServer(cfg store.Config) Server {
 		closeC:       make(chan struct{}),
 		pm:           &pollingMgr{},
 		tracer:       tracing.NewTracer("store.segment.server", trace.SpanKindServer),
+		reserved:     primitive.GetReservedAttributes(),
+
+		archivedHooks: block.NewArchivedHooks(),
 	}
 
 	srv.ctrl = cluster.NewClusterController(cfg.ControllerAddresses, srv.credentials)
@@ -135,8 +151,9 @@ type leaderInfo struct {
 }
 
 type appendResult struct {
-	seqs []int64
-	err  error
+	seqs  []int64
+	stime int64
+	err   error
 }
 
 type appendFuture chan appendResult
@@ -145,16 +162,17 @@ func newAppendFuture() appendFuture {
 	return make(appendFuture, 1)
 }
 
-func (af appendFuture) onAppended(seqs []int64, err error) {
+func (af appendFuture) onAppended(seqs []int64, stime int64, err error) {
 	af <- appendResult{
-		seqs: seqs,
-		err:  err,
+		seqs:  seqs,
+		stime: stime,
+		err:   err,
 	}
 }
 
-func (af appendFuture) wait() ([]int64, error) {
+func (af appendFuture) wait() ([]int64, int64, error) {
 	res := <-af
-	return res.seqs, res.err
+	return res.seqs, res.stime, res.err
 }
 
 type server struct {
@@ -188,6 +206,13 @@ type server struct {
 
 	pm     pollingManager
 	tracer *tracing.Tracer
+
+	// reserved rejects the events whose extension attributes are reserved, in case that producers
+	// bypass gateway.
+	reserved *primitive.ReservedAttributes
+
+	// archivedHooks are notified when blocks are archived.
+	archivedHooks *block.ArchivedHooks
 }
 
 // Make sure server implements Server.
@@ -215,8 +240,13 @@ func (s *server) Serve(lis net.Listener) error {
 		),
 	)
 	segpb.RegisterSegmentServerServer(srv, segSrv)
+	segpbv2.RegisterSegmentServerServer(srv, &segmentServerV2{segmentServer: segSrv})
 	raftpb.RegisterRaftServerServer(srv, raftSrv)
+	ctrlpb.RegisterProfilingServerServer(srv, profiling.NewServer())
+	ctrlpb.RegisterChaosServerServer(srv, chaos.NewServer())
+	health.RegisterGRPC(srv)
 	s.grpcSrv = srv
+	s.registerHealthChecks()
 
 	return srv.Serve(lis)
 }
@@ -259,7 +289,7 @@ func (s *server) Initialize(ctx context.Context) error {
 			return err
 		}
 	} else {
-		log.Info(ctx, "the segment server debug mode enabled", nil)
+		logger.Info(ctx, "the segment server debug mode enabled")
 		s.id = vanus.NewTestID()
 		if err := s.Start(ctx); err != nil {
 			return err
@@ -272,8 +302,18 @@ func (s *server) Initialize(ctx context.Context) error {
 
 func (s *server) loadEngine(ctx context.Context) error {
 	// TODO(james.yin): how to organize engine?
-	return vsb.Initialize(filepath.Join(s.cfg.Volume.Dir, "block"),
-		block.ArchivedCallback(s.onBlockArchived))
+	opts := []vsb.Option{vsb.WithVolume(s.volumeIDStr)}
+	if s.cfg.Block.IndexAttributes {
+		opts = append(opts, vsb.WithAttributeIndex())
+	}
+	if s.cfg.Block.BloomFilterFPR > 0 {
+		opts = append(opts, vsb.WithBloomFilter(s.cfg.Block.BloomFilterFPR))
+	}
+	if s.cfg.Block.MaxEntrySize > 0 || s.cfg.Block.MaxFragmentSize > 0 {
+		opts = append(opts, vsb.WithSizeLimits(s.cfg.Block.MaxEntrySize, s.cfg.Block.MaxFragmentSize))
+	}
+	s.archivedHooks.Register("controller", block.ArchivedCallback(s.onBlockArchived))
+	return vsb.Initialize(filepath.Join(s.cfg.Volume.Dir, "block"), s.archivedHooks, opts...)
 }
 
 func (s *server) reconcileBlocks(ctx context.Context) error {
@@ -284,7 +324,7 @@ func (s *server) reconcileBlocks(ctx context.Context) error {
 func (s *server) registerSelf(ctx context.Context) error {
 	// TODO(james.yin): pass information of blocks.
 	start := time.Now()
-	log.Info(ctx, "connecting to controller", nil)
+	logger.Info(ctx, "connecting to controller")
 	if err := s.ctrl.WaitForControllerReady(false); err != nil {
 		return err
 	}
@@ -296,9 +336,7 @@ func (s *server) registerSelf(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	log.Info(ctx, "connected to controller", map[string]interface{}{
-		"used": time.Since(start),
-	})
+	logger.Info(ctx, "connected to controller", log.Any("used", time.Since(start)))
 	s.id = vanus.NewIDFromUint64(res.ServerId)
 
 	// FIXME(james.yin): some blocks may not be bound to segment.
@@ -324,22 +362,22 @@ func (s *server) reconcileSegments(ctx context.Context, segments map[uint64]*met
 			if block.VolumeID == s.volumeID {
 				if myID != 0 {
 					// FIXME(james.yin): multiple blocks of same segment in this server.
-					log.Warning(ctx, "Multiple blocks of the same segment in this server.", map[string]interface{}{
-						"block_id":   blockID,
-						"other":      myID,
-						"segment_id": segment.Id,
-						"volume_id":  s.volumeID,
-					})
+					logger.Warning(ctx, "Multiple blocks of the same segment in this server.",
+						log.Any("block_id", blockID),
+						log.Any("other", myID),
+						log.Any("segment_id", segment.Id),
+						log.Any("volume_id", s.volumeID),
+					)
 				}
 				myID = vanus.NewIDFromUint64(blockID)
 			}
 		}
 		if myID == 0 {
 			// TODO(james.yin): no my block
-			log.Warning(ctx, "No block of the specific segment in this server.", map[string]interface{}{
-				"segmentID": segment.Id,
-				"volumeID":  s.volumeID,
-			})
+			logger.Warning(ctx, "No block of the specific segment in this server.",
+				log.Any("segmentID", segment.Id),
+				log.Any("volumeID", s.volumeID),
+			)
 			continue
 		}
 		s.registerReplicas(ctx, segment)
@@ -352,12 +390,12 @@ func (s *server) registerReplicas(ctx context.Context, segment *metapb.Segment)
 			if block.VolumeID == s.volumeID {
 				block.Endpoint = s.localAddress
 			} else {
-				log.Info(ctx, "Block is offline.", map[string]interface{}{
-					"block_id":    blockID,
-					"segment_id":  segment.Id,
-					"eventlog_id": segment.EventLogId,
-					"volume_id":   block.VolumeID,
-				})
+				logger.Info(ctx, "Block is offline.",
+					log.Any("block_id", blockID),
+					log.Any("segment_id", segment.Id),
+					log.Any("eventlog_id", segment.EventLogId),
+					log.Any("volume_id", block.VolumeID),
+				)
 				continue
 			}
 		}
@@ -374,7 +412,7 @@ func (s *server) Start(ctx context.Context) error {
 			"start failed, server state is not created")
 	}
 
-	log.Info(ctx, "Start SegmentServer.", nil)
+	logger.Info(ctx, "Start SegmentServer.")
 	if err := s.startHeartbeatTask(ctx); err != nil {
 		return errors.ErrInternal.WithMessage("start heartbeat task failed")
 	}
@@ -409,17 +447,20 @@ func (s *server) runHeartbeat(_ context.Context) error {
 					Term:     info.term,
 				}
 				if _, err := s.cc.ReportSegmentLeader(context.Background(), req); err != nil {
-					log.Debug(ctx, "Report segment leader to controller failed.", map[string]interface{}{
-						"leader":     info.leader,
-						"term":       info.term,
-						log.KeyError: err,
-					})
+					logger.Debug(ctx, "Report segment leader to controller failed.",
+						log.Any("leader", info.leader),
+						log.Any("term", info.term),
+						log.Err(err),
+					)
 				}
 			}
 		}
 	}()
 
 	f := func() interface{} {
+		if chaos.Hit(chaos.PointStoreDropHeartbeat) {
+			return nil
+		}
 		infos := make([]*metapb.SegmentHealthInfo, 0)
 		s.replicas.Range(func(key, value interface{}) bool {
 			b, _ := value.(Replica)
@@ -471,7 +512,7 @@ func (s *server) Stop(ctx context.Context) error {
 	go func() {
 		// Force stop if timeout.
 		t := time.AfterFunc(defaultForceStopTimeout, func() {
-			log.Warning(context.Background(), "Graceful stop timeout, force stop.", nil)
+			logger.Warning(context.Background(), "Graceful stop timeout, force stop.")
 			s.grpcSrv.Stop()
 		})
 		defer t.Stop()
@@ -518,7 +559,7 @@ func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 	defer span.End()
 
 	if id == 0 {
-		log.Warning(ctx, "Can not create block with id(0).", nil)
+		logger.Warning(ctx, "Can not create block with id(0).")
 		return errors.ErrInvalidRequest.WithMessage("can not create block with id(0)")
 	}
 
@@ -526,10 +567,10 @@ func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 		return err
 	}
 
-	log.Info(ctx, "Create block.", map[string]interface{}{
-		"block_id": id,
-		"size":     size,
-	})
+	logger.Info(ctx, "Create block.",
+		log.Stringer("block_id", id),
+		log.Any("size", size),
+	)
 
 	b, err := s.createBlock(ctx, id, size)
 	if err != nil {
@@ -569,11 +610,11 @@ func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
 	}
 
 	// FIXME(james.yin): more info.
-	log.Info(ctx, "The block has been deleted.", map[string]interface{}{
-		"block_id": b.ID(),
-		// "path":     blk.Path(),
-		// "metadata": blk.HealthInfo().String(),
-	})
+	logger.Info(ctx, "The block has been deleted.",
+		log.Stringer("block_id", b.ID()),
+		// log.Any("path", blk.Path()),
+		// log.Any("metadata", blk.HealthInfo().String()),
+	)
 
 	return nil
 }
@@ -598,18 +639,18 @@ func (s *server) ActivateSegment(
 	}
 
 	if len(replicas) == 0 {
-		log.Warning(ctx, "Replicas can not be empty.", map[string]interface{}{
-			"segment_id":  segID,
-			"eventlog_id": logID,
-		})
+		logger.Warning(ctx, "Replicas can not be empty.",
+			log.Any("segment_id", segID),
+			log.Any("eventlog_id", logID),
+		)
 		return nil
 	}
 
-	log.Info(ctx, "Activate segment.", map[string]interface{}{
-		"replicas":    replicas,
-		"segment_id":  segID,
-		"eventlog_id": logID,
-	})
+	logger.Info(ctx, "Activate segment.",
+		log.Any("replicas", replicas),
+		log.Any("segment_id", segID),
+		log.Any("eventlog_id", logID),
+	)
 
 	var myID vanus.ID
 	peers := make([]raft.Peer, 0, len(replicas))
@@ -639,10 +680,10 @@ func (s *server) ActivateSegment(
 		s.resolver.Register(peer.ID.Uint64(), peer.Endpoint) //nolint:contextcheck // wrong advice
 	}
 
-	log.Info(ctx, "Bootstrap replica.", map[string]interface{}{
-		"block_id": myID,
-		"peers":    peers,
-	})
+	logger.Info(ctx, "Bootstrap replica.",
+		log.Stringer("block_id", myID),
+		log.Any("peers", peers),
+	)
 
 	// Bootstrap raft.
 	b, _ := v.(Replica)
@@ -661,28 +702,33 @@ func (s *server) InactivateSegment(ctx context.Context) error {
 	return nil
 }
 
-func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error) {
+func (s *server) AppendToBlock(
+	ctx context.Context, id vanus.ID, events []*cepb.CloudEvent, opts ...block.AppendOption,
+) ([]int64, int64, error) {
 	ctx, span := s.tracer.Start(ctx, "AppendToBlock")
 	defer span.End()
 
 	if len(events) == 0 {
-		return nil, errors.ErrInvalidRequest.WithMessage("event list is empty")
+		return nil, 0, errors.ErrInvalidRequest.WithMessage("event list is empty")
 	}
 
 	if err := s.checkState(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var b Replica
 	if v, ok := s.replicas.Load(id); ok {
 		b, _ = v.(Replica)
 	} else {
-		return nil, errors.ErrResourceNotFound.WithMessage("the block doesn't exist")
+		return nil, 0, errors.ErrResourceNotFound.WithMessage("the block doesn't exist")
 	}
 
 	var size int
 	entries := make([]block.Entry, len(events))
 	for i, event := range events {
+		if err := s.checkAttributes(event); err != nil {
+			return nil, 0, err
+		}
 		entries[i] = ceconv.ToEntry(event)
 		size += proto.Size(event)
 	}
@@ -691,16 +737,31 @@ func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.
 	metrics.WriteThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(size))
 
 	future := newAppendFuture()
-	b.Append(ctx, entries, future.onAppended)
-	seqs, err := future.wait()
+	b.Append(ctx, entries, future.onAppended, opts...)
+	seqs, stime, err := future.wait()
 	if err != nil {
-		return nil, s.processAppendError(ctx, b, err)
+		return nil, 0, s.processAppendError(ctx, b, err)
 	}
 
 	// TODO(weihe.yin) make this method deep to code
 	s.pm.NewMessageArrived(id)
 
-	return seqs, nil
+	return seqs, stime, nil
+}
+
+// checkAttributes rejects the event whose extension attributes are reserved and not set by components,
+// the attributes set by store are ignored since they are reset when the event is appended.
+func (s *server) checkAttributes(event *cepb.CloudEvent) error {
+	for name := range event.Attributes {
+		switch name {
+		case segpb.XVanusBlockOffset, segpb.XVanusLogOffset, segpb.XVanusStime:
+			continue
+		}
+		if err := s.reserved.CheckComponent(name); err != nil {
+			return errors.ErrInvalidRequest.WithMessage(err.Error())
+		}
+	}
+	return nil
 }
 
 func (s *server) processAppendError(ctx context.Context, b Replica, err error) error {
@@ -709,25 +770,21 @@ func (s *server) processAppendError(ctx context.Context, b Replica, err error) e
 	}
 
 	if errors.Is(err, errors.ErrSegmentFull) {
-		log.Debug(ctx, "Append failed: block is full.", map[string]interface{}{
-			"block_id": b.ID(),
-		})
+		logger.Debug(ctx, "Append failed: block is full.", log.Stringer("block_id", b.ID()))
 		return errors.ErrSegmentFull
 	}
 
-	log.Warning(ctx, "Append failed.", map[string]interface{}{
-		"block_id":   b.ID(),
-		log.KeyError: err,
-	})
+	logger.Warning(ctx, "Append failed.",
+		log.Stringer("block_id", b.ID()),
+		log.Err(err),
+	)
 	return errors.ErrInternal.WithMessage("write to storage failed").Wrap(err)
 }
 
 func (s *server) onBlockArchived(stat block.Statistics) {
 	id := stat.ID
 
-	log.Debug(context.Background(), "Block is full.", map[string]interface{}{
-		"block_id": id,
-	})
+	logger.Debug(context.Background(), "Block is full.", log.Stringer("block_id", id))
 
 	// FIXME(james.yin): leader info.
 	info := &metapb.SegmentHealthInfo{
@@ -754,34 +811,35 @@ func (s *server) onBlockArchived(stat block.Statistics) {
 	}()
 }
 
-// ReadFromBlock returns at most num events from seq in Block id.
+// ReadFromBlock returns at most num events from seq in Block id and the offset to read next. If the filter
+// is set, the events which don't match it are skipped, so the events may not be contiguous.
 func (s *server) ReadFromBlock(
-	ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32,
-) ([]*cepb.CloudEvent, error) {
+	ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32, filter *segpb.EventFilter,
+) ([]*cepb.CloudEvent, int64, error) {
 	ctx, span := s.tracer.Start(ctx, "ReadFromBlock")
 	defer span.End()
 
 	if err := s.checkState(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var b Replica
 	if v, ok := s.replicas.Load(id); ok {
 		b, _ = v.(Replica)
 	} else {
-		return nil, errors.ErrResourceNotFound.WithMessage(
+		return nil, 0, errors.ErrResourceNotFound.WithMessage(
 			"the segment doesn't exist on this server")
 	}
 
-	if events, err := s.readEvents(ctx, b, seq, num); err == nil {
-		return events, nil
+	if events, next, err := s.readEvents(ctx, b, seq, num, filter); err == nil {
+		return events, next, nil
 	} else if !errors.Is(err, errors.ErrOffsetOnEnd) || pollingTimeout == 0 {
-		return nil, err
+		return nil, 0, err
 	}
 
 	doneC := s.pm.Add(ctx, id)
 	if doneC == nil {
-		return nil, errors.ErrOffsetOnEnd
+		return nil, 0, errors.ErrOffsetOnEnd
 	}
 
 	t := time.NewTimer(time.Duration(pollingTimeout) * time.Millisecond)
@@ -790,32 +848,111 @@ func (s *server) ReadFromBlock(
 	select {
 	case <-doneC:
 		// FIXME(james.yin) It can't read message immediately because of async apply.
-		return s.readEvents(ctx, b, seq, num)
+		return s.readEvents(ctx, b, seq, num, filter)
 	case <-t.C:
-		return nil, errors.ErrOffsetOnEnd
+		return nil, 0, errors.ErrOffsetOnEnd
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return nil, 0, ctx.Err()
+	}
+}
+
+// readEvents keeps reading if no event matches the filter, until maxFilterScanFactor times of num
+// entries are scanned or it reaches the end of block. If the block has the attribute index, the
+// entries which don't match are skipped without being read.
+func (s *server) readEvents(
+	ctx context.Context, b Replica, seq int64, num int, filter *segpb.EventFilter,
+) ([]*cepb.CloudEvent, int64, error) {
+	var size, scanned int
+	idx, _ := b.(block.AttributeIndexer)
+	next := seq
+	events := make([]*cepb.CloudEvent, 0, num)
+	if filter != nil {
+		// Skip the rest of block if it doesn't contain the required id.
+		if id, ok := requiredID(filter); ok {
+			if idIdx, ok := b.(block.IDIndexer); ok {
+				if end, excluded := idIdx.ExcludeID(id); excluded && end > seq {
+					return events, end, nil
+				}
+			}
+		}
+	}
+	if iterable, ok := b.(block.Iterable); ok && filter != nil {
+		return s.scanEvents(iterable.NewIterator(seq), b, seq, num, filter)
+	}
+	for {
+		if filter != nil && idx != nil {
+			next = skipIndexed(filter, idx, next, maxIndexedSkip)
+		}
+		entries, err := b.Read(ctx, next, num)
+		if err != nil {
+			if next > seq && (errors.Is(err, errors.ErrOffsetOnEnd) || errors.Is(err, errors.ErrOffsetOverflow)) {
+				break
+			}
+			return nil, 0, err
+		}
+		for _, entry := range entries {
+			event := ceconv.ToPb(entry)
+			if !matchEvent(filter, event) {
+				continue
+			}
+			events = append(events, event)
+			size += proto.Size(event)
+		}
+		scanned += len(entries)
+		next += int64(len(entries))
+		if filter == nil || len(events) > 0 || len(entries) == 0 || scanned >= num*maxFilterScanFactor {
+			break
+		}
 	}
+
+	metrics.ReadTPSCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(len(events)))
+	metrics.ReadThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(size))
+
+	return events, next, nil
 }
 
-func (s *server) readEvents(ctx context.Context, b Replica, seq int64, num int) ([]*cepb.CloudEvent, error) {
-	entries, err := b.Read(ctx, seq, num)
-	if err != nil {
-		return nil, err
-	}
+// scanEvents reads the events which match filter by iterator, the entries are evaluated without being
+// decoded, so that the entries which don't match are dropped without allocations.
+func (s *server) scanEvents(
+	it block.Iterator, b Replica, seq int64, num int, filter *segpb.EventFilter,
+) ([]*cepb.CloudEvent, int64, error) {
+	defer it.Close()
 
 	var size int
-	events := make([]*cepb.CloudEvent, len(entries))
-	for i, entry := range entries {
+	idx, _ := b.(block.AttributeIndexer)
+	next := seq
+	events := make([]*cepb.CloudEvent, 0, num)
+	for scanned := 0; len(events) < num && scanned < num*maxFilterScanFactor; scanned++ {
+		if idx != nil {
+			if skipped := skipIndexed(filter, idx, next, maxIndexedSkip); skipped > next {
+				next = skipped
+				it.SeekTo(next)
+			}
+		}
+		entry, err := it.Next()
+		if err != nil {
+			if next > seq && (errors.Is(err, errors.ErrOffsetOnEnd) || errors.Is(err, errors.ErrOffsetOverflow)) {
+				break
+			}
+			return nil, 0, err
+		}
+		next++
+		if !matchEntry(filter, entry) {
+			continue
+		}
 		event := ceconv.ToPb(entry)
-		events[i] = event
+		// The data refers to the buffer of iterator, which is reused.
+		if data, ok := event.Data.(*cepb.CloudEvent_BinaryData); ok {
+			data.BinaryData = append([]byte(nil), data.BinaryData...)
+		}
+		events = append(events, event)
 		size += proto.Size(event)
 	}
 
 	metrics.ReadTPSCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(len(events)))
 	metrics.ReadThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(size))
 
-	return events, nil
+	return events, next, nil
 }
 
 func (s *server) LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int64) (int64, error) {
@@ -28,6 +28,8 @@ import (
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive"
@@ -92,7 +94,7 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			state: primitive.ServerStateRunning,
 		}
 
-		_, err := srv.ReadFromBlock(context.Background(), vanus.NewTestID(), 0, 3, uint32(0))
+		_, _, err := srv.ReadFromBlock(context.Background(), vanus.NewTestID(), 0, 3, uint32(0), nil)
 		So(err, ShouldNotBeNil)
 		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCode_RESOURCE_NOT_FOUND)
 	})
@@ -118,15 +120,28 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent1}, nil)
 
 			start := time.Now()
-			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
-				uint32(shortDelayInTest.Milliseconds()))
+			events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+				uint32(shortDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenBefore, start.Add(shortDelayInTest))
 			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 2)
 			So(events, ShouldHaveLength, 2)
 			cetest.CheckEvent0(events[0])
 			cetest.CheckEvent1(events[1])
 		})
 
+		Convey("read with filter", func() {
+			b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent0, ent0}, nil)
+			b.EXPECT().Read(Any(), int64(3), 3).Return([]block.Entry{ent1}, nil)
+
+			filter := &segpb.EventFilter{Exact: map[string]string{"attr0": "value0"}}
+			events, next, err := srv.ReadFromBlock(context.Background(), id, 0, 3, uint32(0), filter)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 4)
+			So(events, ShouldHaveLength, 1)
+			cetest.CheckEvent1(events[0])
+		})
+
 		Convey("long-polling without timeout", func() {
 			b.EXPECT().Read(Any(), int64(0), 3).Return(nil, errors.ErrOffsetOnEnd)
 			b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent1}, nil)
@@ -142,8 +157,8 @@ func TestServer_ReadFromBlock(t *testing.T) {
 				close(ch)
 			}()
 
-			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
-				uint32(longDelayInTest.Milliseconds()))
+			events, _, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+				uint32(longDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenBetween, start.Add(shortDelayInTest), start.Add(longDelayInTest))
 			So(err, ShouldBeNil)
 			So(events, ShouldHaveLength, 2)
@@ -160,8 +175,8 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			srv.pm = mgr
 
 			start := time.Now()
-			_, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
-				uint32(shortDelayInTest.Milliseconds()))
+			_, _, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+				uint32(shortDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenAfter, start.Add(shortDelayInTest))
 			So(err, ShouldBeError, errors.ErrOffsetOnEnd)
 		})
@@ -182,9 +197,35 @@ func TestServer_ReadFromBlock(t *testing.T) {
 				cancel()
 			}()
 
-			_, err := srv.ReadFromBlock(ctx, id, 0, 3, uint32(longDelayInTest.Milliseconds()))
+			_, _, err := srv.ReadFromBlock(ctx, id, 0, 3, uint32(longDelayInTest.Milliseconds()), nil)
 			So(time.Now(), ShouldHappenBetween, start.Add(shortDelayInTest), start.Add(longDelayInTest))
 			So(err, ShouldBeError, context.Canceled)
 		})
 	})
 }
+
+func TestServer_checkAttributes(t *testing.T) {
+	Convey("check attributes of appended events", t, func() {
+		srv := &server{reserved: primitive.NewReservedAttributes([]string{"xtenant"})}
+		attr := func(v string) *cepb.CloudEvent_CloudEventAttributeValue {
+			return &cepb.CloudEvent_CloudEventAttributeValue{
+				Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: v},
+			}
+		}
+		event := &cepb.CloudEvent{Attributes: map[string]*cepb.CloudEvent_CloudEventAttributeValue{
+			"subject":                      attr("test"),
+			primitive.XVanusDeliveryTime:   attr("2006-01-02T15:04:05Z"),
+			primitive.XVanusRetryAttempts:  attr("1"),
+			primitive.XVanusSubscriptionID: attr("1"),
+			segpb.XVanusStime:              attr("1000"),
+		}}
+		So(srv.checkAttributes(event), ShouldBeNil)
+
+		event.Attributes["xtenantid"] = attr("test")
+		So(srv.checkAttributes(event), ShouldBeError)
+		delete(event.Attributes, "xtenantid")
+		event.Attributes[primitive.XVanus+"fortest"] = attr("test")
+		err := srv.checkAttributes(event)
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"path/filepath"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store"
+	"github.com/linkall-labs/vanus/internal/store/vsb"
+	"github.com/linkall-labs/vanus/internal/store/wal"
+)
+
+// Upgrade rewrites the WALs and blocks of the volume to the current format versions in place. It must
+// be called offline, before the SegmentServer is started on the volume.
+func Upgrade(ctx context.Context, cfg store.Config) error {
+	for _, name := range []string{"meta", "offset", "raft"} {
+		dir := filepath.Join(cfg.Volume.Dir, name)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+		upgraded, err := wal.Upgrade(dir)
+		if err != nil {
+			return err
+		}
+		if upgraded {
+			log.Info(ctx, "The WAL has been upgraded.", map[string]interface{}{
+				"dir":            dir,
+				"format_version": wal.FormatVersion,
+			})
+		}
+	}
+
+	dir := filepath.Join(cfg.Volume.Dir, "block")
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	n, err := vsb.Upgrade(ctx, dir)
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "The blocks have been upgraded.", map[string]interface{}{
+		"dir":            dir,
+		"upgraded":       n,
+		"format_version": vsb.FormatVersion,
+	})
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sort"
+
+	// third-party libraries.
+	"go.opentelemetry.io/otel/propagation"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+const traceParentAttr = "traceparent"
+
+// FieldContext is the context of an entry being appended to Block, which auto fields are filled from.
+type FieldContext struct {
+	Ctx   context.Context
+	Entry block.Entry
+	Type  uint16
+	Seq   int64
+	Stime int64
+}
+
+// AutoField fills a system attribute of entries when they are appended to Block.
+type AutoField interface {
+	Fill(fc *FieldContext, fields *AutoFields)
+}
+
+// AutoFieldFunc is an adapter to allow the use of ordinary functions as AutoField.
+type AutoFieldFunc func(fc *FieldContext, fields *AutoFields)
+
+// Make sure AutoFieldFunc implements AutoField.
+var _ AutoField = (AutoFieldFunc)(nil)
+
+func (f AutoFieldFunc) Fill(fc *FieldContext, fields *AutoFields) {
+	f(fc, fields)
+}
+
+type int64Field struct {
+	ordinal int
+	val     int64
+}
+
+type extensionField struct {
+	attr []byte
+	val  []byte
+}
+
+// AutoFields are the attributes filled by auto fields. The optional attributes are added to entry, which
+// must not have them, and the extension attributes never override the ones which entry already has.
+type AutoFields struct {
+	ints []int64Field
+	exts []extensionField
+}
+
+// SetInt64 fills the optional attribute of ordinal.
+func (f *AutoFields) SetInt64(ordinal int, val int64) {
+	for i := range f.ints {
+		if f.ints[i].ordinal == ordinal {
+			f.ints[i].val = val
+			return
+		}
+	}
+	f.ints = append(f.ints, int64Field{ordinal: ordinal, val: val})
+}
+
+// SetExtension fills the extension attribute attr, the extension attributes are kept in order.
+func (f *AutoFields) SetExtension(attr string, val []byte) {
+	i := sort.Search(len(f.exts), func(i int) bool {
+		return string(f.exts[i].attr) >= attr
+	})
+	if i < len(f.exts) && string(f.exts[i].attr) == attr {
+		f.exts[i].val = val
+		return
+	}
+	f.exts = append(f.exts, extensionField{})
+	copy(f.exts[i+1:], f.exts[i:])
+	f.exts[i] = extensionField{attr: []byte(attr), val: val}
+}
+
+func (f *AutoFields) int64(ordinal int) (int64, bool) {
+	for i := range f.ints {
+		if f.ints[i].ordinal == ordinal {
+			return f.ints[i].val, true
+		}
+	}
+	return 0, false
+}
+
+func (f *AutoFields) extension(attr []byte) []byte {
+	i := f.searchExtension(attr)
+	if i < len(f.exts) && string(f.exts[i].attr) == string(attr) {
+		return f.exts[i].val
+	}
+	return nil
+}
+
+func (f *AutoFields) searchExtension(attr []byte) int {
+	return sort.Search(len(f.exts), func(i int) bool {
+		return string(f.exts[i].attr) >= string(attr)
+	})
+}
+
+// SequenceNumberField fills the sequence number of entry.
+var SequenceNumberField AutoField = AutoFieldFunc(func(fc *FieldContext, fields *AutoFields) {
+	fields.SetInt64(ceschema.SequenceNumberOrdinal, fc.Seq)
+})
+
+// StimeField fills the time when entry is appended.
+var StimeField AutoField = AutoFieldFunc(func(fc *FieldContext, fields *AutoFields) {
+	fields.SetInt64(ceschema.StimeOrdinal, fc.Stime)
+})
+
+// ContextField fills the extension attribute attr with the string value of key in the context of appending,
+// such as a producer id or a tenant.
+func ContextField(attr string, key interface{}) AutoField {
+	return AutoFieldFunc(func(fc *FieldContext, fields *AutoFields) {
+		if fc.Ctx == nil {
+			return
+		}
+		if v, ok := fc.Ctx.Value(key).(string); ok && v != "" {
+			fields.SetExtension(attr, []byte(v))
+		}
+	})
+}
+
+// TraceContextField fills the W3C trace context of appending as the traceparent extension attribute.
+var TraceContextField AutoField = AutoFieldFunc(func(fc *FieldContext, fields *AutoFields) {
+	if fc.Ctx == nil {
+		return
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(fc.Ctx, carrier)
+	if tp := carrier.Get(traceParentAttr); tp != "" {
+		fields.SetExtension(traceParentAttr, []byte(tp))
+	}
+})
+
+// defaultAutoFields are filled for entries of all schemas, Block relies on them to recover the append context.
+var defaultAutoFields = []AutoField{SequenceNumberField, StimeField}
+
+// autoFieldPipeline is the auto fields of each schema, which are filled after the default ones.
+type autoFieldPipeline map[uint16][]AutoField
+
+func (p autoFieldPipeline) fill(fc *FieldContext) *AutoFields {
+	fields := &AutoFields{
+		ints: make([]int64Field, 0, len(defaultAutoFields)),
+	}
+	for _, f := range defaultAutoFields {
+		f.Fill(fc, fields)
+	}
+	for _, f := range p[fc.Type] {
+		f.Fill(fc, fields)
+	}
+	fields.dropExisting(fc.Entry)
+	return fields
+}
+
+// dropExisting drops the extension attributes which entry already has.
+func (f *AutoFields) dropExisting(entry block.Entry) {
+	ext, ok := entry.(block.EntryExt)
+	if !ok || len(f.exts) == 0 {
+		return
+	}
+	exts := f.exts[:0]
+	for _, e := range f.exts {
+		if ext.GetExtensionAttribute(e.attr) == nil {
+			exts = append(exts, e)
+		}
+	}
+	f.exts = exts
+}
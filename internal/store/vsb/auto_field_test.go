@@ -0,0 +1,97 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel/trace"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
+)
+
+type tenantKey struct{}
+
+func TestAutoFields(t *testing.T) {
+	Convey("auto fields", t, func() {
+		b := &vsBlock{
+			autoFields: autoFieldPipeline{
+				ceschema.CloudEvent: {ContextField("xvanustenant", tenantKey{}), TraceContextField},
+			},
+		}
+		e := convert.ToEntry(&cepb.CloudEvent{
+			Id:          "id",
+			Source:      "source",
+			SpecVersion: "1.0",
+			Type:        "type",
+			Attributes: map[string]*cepb.CloudEvent_CloudEventAttributeValue{
+				"aaa": {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: "a"}},
+				"zzz": {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: "z"}},
+			},
+		})
+
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.WithValue(context.Background(), tenantKey{}, "tenant"), sc)
+
+		Convey("fill fields of schema", func() {
+			entry := b.wrapEntry(&FieldContext{Ctx: ctx, Entry: e, Type: ceschema.CloudEvent, Seq: 1, Stime: 2})
+			So(ceschema.EntryType(entry), ShouldEqual, ceschema.CloudEvent)
+			So(ceschema.SequenceNumber(entry), ShouldEqual, 1)
+			So(ceschema.Stime(entry), ShouldEqual, 2)
+
+			ext, _ := entry.(block.EntryExt)
+			So(ext.OptionalAttributeCount(), ShouldEqual, e.(block.EntryExt).OptionalAttributeCount()+2)
+			So(ext.ExtensionAttributeCount(), ShouldEqual, 4)
+			So(string(ext.GetExtensionAttribute([]byte("xvanustenant"))), ShouldEqual, "tenant")
+			So(string(ext.GetExtensionAttribute([]byte(traceParentAttr))), ShouldEqual,
+				"00-01000000000000000000000000000000-0200000000000000-01")
+			So(string(ext.GetExtensionAttribute([]byte("aaa"))), ShouldEqual, "a")
+
+			var attrs []string
+			ext.RangeExtensionAttributes(block.OnExtensionAttributeFunc(func(attr, _ []byte) {
+				attrs = append(attrs, string(attr))
+			}))
+			So(attrs, ShouldResemble, []string{"aaa", traceParentAttr, "xvanustenant", "zzz"})
+		})
+
+		Convey("only default fields of other schemas", func() {
+			entry := b.wrapEntry(&FieldContext{Ctx: ctx, Entry: &block.EmptyEntryExt{}, Type: ceschema.End, Seq: 3})
+			So(ceschema.SequenceNumber(entry), ShouldEqual, 3)
+			So(entry.(block.EntryExt).ExtensionAttributeCount(), ShouldEqual, 0)
+		})
+
+		Convey("never override existing extension attributes", func() {
+			b.autoFields[ceschema.CloudEvent] = []AutoField{ContextField("aaa", tenantKey{})}
+			entry := b.wrapEntry(&FieldContext{Ctx: ctx, Entry: e, Type: ceschema.CloudEvent})
+			ext, _ := entry.(block.EntryExt)
+			So(ext.ExtensionAttributeCount(), ShouldEqual, 2)
+			So(string(ext.GetExtensionAttribute([]byte("aaa"))), ShouldEqual, "a")
+		})
+	})
+}
@@ -20,9 +20,12 @@ import (
 	"os"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	// first-party.
+	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/util"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
@@ -31,7 +34,14 @@ import (
 	"github.com/linkall-labs/vanus/internal/store/vsb/index"
 )
 
-const FormatMagic = uint32(0x00627376) // ASCII of "vsb" in little endian
+const (
+	FormatMagic = uint32(0x00627376) // ASCII of "vsb" in little endian
+	// FormatVersion is the version of the format of blocks created by this store. The blocks of the
+	// previous version are still readable, Upgrade rewrites them to the current version.
+	FormatVersion = uint8(1)
+)
+
+var logger = log.Module("store.vsb")
 
 type meta struct {
 	writeOffset int64
@@ -49,8 +59,11 @@ type vsBlock struct {
 	path     string
 	capacity int64
 
+	// format is the version of the format of Block.
+	format     uint8
 	dataOffset int64
 	indexSize  uint16
+	flags      uint32
 
 	indexOffset int64
 	indexLength int
@@ -58,20 +71,45 @@ type vsBlock struct {
 	fm      meta // flushed meta
 	actx    appendContext
 	indexes []index.Index
-	mu      sync.RWMutex
+	// version is increased when entries are appended, see snapshot.
+	version uint64
+	// columns is the attribute index, it's only available after Block is archived.
+	columns []index.Column
+	// bloom is the bloom filter of ids, it's only available after Block is archived.
+	bloom *index.Bloom
+	mu    sync.RWMutex
+
+	indexAttributes bool
+	// bloomFPR is the false positive rate of bloom filter, the bloom filter is disabled if it's 0.
+	bloomFPR float64
+	// autoFields are filled when entries are appended.
+	autoFields autoFieldPipeline
+	// maxEntrySize and maxFragmentSize limit the encoded size of entries appended, 0 means no limit.
+	maxEntrySize    int
+	maxFragmentSize int
+	// clock stamps the stime of entries, the system time is used if it's nil.
+	clock util.Clock
 
 	enc codec.EntryEncoder
 	dec codec.EntryDecoder
 	lis block.ArchivedListener
 
-	f      *os.File
-	wg     sync.WaitGroup
-	tracer *tracing.Tracer
+	f       *os.File
+	wg      sync.WaitGroup
+	tracer  *tracing.Tracer
+	metrics *blockMetrics
 }
 
 // Make sure vsBlock implements block.File.
 var _ block.Raw = (*vsBlock)(nil)
 
+func (b *vsBlock) now() time.Time {
+	if b.clock != nil {
+		return b.clock.Now()
+	}
+	return time.Now()
+}
+
 func (b *vsBlock) ID() vanus.ID {
 	return b.id
 }
@@ -79,19 +117,18 @@ func (b *vsBlock) ID() vanus.ID {
 func (b *vsBlock) Close(ctx context.Context) error {
 	b.wg.Wait()
 
-	m, indexes := b.makeSnapshot()
+	snap := b.makeSnapshot()
+	m := snap.meta
 
+	flags := b.flags
 	if b.indexOffset != m.writeOffset {
-		n, err := b.appendIndexEntry(ctx, indexes, m.writeOffset)
-		if err != nil {
+		if err := b.persistIndexes(ctx, m, snap.indexes); err != nil {
 			return err
 		}
-		b.indexOffset = m.writeOffset
-		b.indexLength = n
 	}
 
 	// Flush metadata.
-	if b.fm.archived != m.archived || b.fm.entryLength != m.entryLength {
+	if b.fm.archived != m.archived || b.fm.entryLength != m.entryLength || b.flags != flags {
 		if err := b.persistHeader(ctx, m); err != nil {
 			return err
 		}
@@ -102,6 +139,7 @@ func (b *vsBlock) Close(ctx context.Context) error {
 
 func (b *vsBlock) Delete(context.Context) error {
 	// FIXME(james.yin): make sure block is closed.
+	b.metrics.release()
 	return os.Remove(b.path)
 }
 
@@ -109,7 +147,8 @@ func (b *vsBlock) status() block.Statistics {
 	return b.stat(b.makeSnapshot())
 }
 
-func (b *vsBlock) stat(m meta, indexes []index.Index) block.Statistics {
+func (b *vsBlock) stat(snap snapshot) block.Statistics {
+	m, indexes := snap.meta, snap.indexes
 	s := block.Statistics{
 		ID:              b.id,
 		Capacity:        uint64(b.capacity),
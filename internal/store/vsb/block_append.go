@@ -18,12 +18,16 @@ import (
 	// standard libraries.
 	"context"
 	stderr "errors"
+	"fmt"
+	"io"
+	"math"
 	"sync/atomic"
 	"time"
 
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/observability/log"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
@@ -34,9 +38,13 @@ import (
 
 var errCorruptedFragment = stderr.New("vsb: corrupted fragment")
 
+// skipLogger is sampled, because the fragments are skipped frequently when raft log is replayed.
+var skipLogger = logger.Sampled(time.Second, 10, 100)
+
 type appendContext struct {
 	seq      int64
 	offset   int64
+	stime    int64
 	archived uint32
 }
 
@@ -55,26 +63,81 @@ func (c *appendContext) Archived() bool {
 	return c.archived != 0
 }
 
+func (c *appendContext) Stime() int64 {
+	return c.stime
+}
+
 // Make sure vsBlock implements block.TwoPCAppender.
 var _ block.TwoPCAppender = (*vsBlock)(nil)
 
 func (b *vsBlock) NewAppendContext(last block.Fragment) block.AppendContext {
-	if last != nil {
-		_, entry, _ := b.dec.UnmarshalLast(last.Payload())
-		seq := ceschema.SequenceNumber(entry)
-		actx := &appendContext{
-			seq:    seq + 1,
-			offset: last.EndOffset(),
+	// Copy append context of written data.
+	b.mu.RLock()
+	committed := b.actx
+	b.mu.RUnlock()
+
+	if last == nil {
+		return &committed
+	}
+
+	_, entry, _ := b.dec.UnmarshalLast(last.Payload())
+	seq := ceschema.SequenceNumber(entry)
+	actx := &appendContext{
+		seq:    seq + 1,
+		offset: last.EndOffset(),
+		stime:  ceschema.Stime(entry),
+	}
+	if ceschema.EntryType(entry) == ceschema.End {
+		actx.archived = 1
+	}
+
+	// The last fragment is stale if it's behind the written data, e.g. the leader is elected with a stale
+	// view of log. Repair the append context from the data tail, so that appends never overwrite it.
+	if actx.aheadOf(&committed) {
+		return actx
+	}
+	repaired := b.scanTail(committed)
+	if repaired.offset == actx.offset && repaired.seq == actx.seq {
+		return actx
+	}
+	logger.Warning(context.Background(), "vsb: last fragment is stale, repair append context from data.",
+		log.Stringer("block_id", b.id),
+		log.Int64("fragment_offset", actx.offset),
+		log.Int64("fragment_seq", actx.seq),
+		log.Int64("repaired_offset", repaired.offset),
+		log.Int64("repaired_seq", repaired.seq),
+	)
+	return &repaired
+}
+
+// aheadOf returns whether c is strictly ahead of other, and consistent with it.
+func (c *appendContext) aheadOf(other *appendContext) bool {
+	if other.Archived() {
+		return false
+	}
+	return c.offset > other.offset && c.seq > other.seq
+}
+
+// scanTail re-scans the data after actx, which may be written but not indexed yet.
+func (b *vsBlock) scanTail(actx appendContext) appendContext {
+	r := io.NewSectionReader(b.f, actx.offset, math.MaxInt64-actx.offset)
+	for !actx.Archived() {
+		n, entry, err := b.dec.UnmarshalReader(r)
+		if err != nil || ceschema.SequenceNumber(entry) != actx.seq {
+			break
 		}
-		if ceschema.EntryType(entry) == ceschema.End {
+		switch ceschema.EntryType(entry) {
+		case ceschema.CloudEvent:
+		case ceschema.End:
 			actx.archived = 1
+		default:
+			return actx
 		}
-		return actx
+		actx.seq++
+		actx.offset += int64(n)
+		actx.stime = ceschema.Stime(entry)
 	}
-
-	// Copy append context.
-	actx := b.actx
-	return &actx
+	return actx
 }
 
 func (b *vsBlock) PrepareAppend(
@@ -89,30 +152,73 @@ func (b *vsBlock) PrepareAppend(
 	ents := make([]block.Entry, num)
 	seqs := make([]int64, num)
 
-	// TODO(james.yin): fill auto fields in a general way.
-	now := time.Now().UnixMilli()
+	now := b.now().UnixMilli()
 	for i := int64(0); i < num; i++ {
 		seq := actx.seq + i
-		ents[i] = wrapEntry(entries[i], ceschema.CloudEvent, seq, now)
+		ents[i] = b.wrapEntry(&FieldContext{
+			Ctx:   ctx,
+			Entry: entries[i],
+			Type:  ceschema.CloudEvent,
+			Seq:   seq,
+			Stime: now,
+		})
 		seqs[i] = seq
 	}
 
-	frag := newFragment(actx.offset, ents, b.enc)
+	frag := b.newFragment(actx.offset, ents)
+	if err := b.checkSize(frag); err != nil {
+		return nil, nil, false, err
+	}
 
 	actx.offset += int64(frag.Size())
 	actx.seq += num
+	actx.stime = now
 
 	return seqs, frag, actx.size(b.dataOffset) >= b.capacity, nil
 }
 
+// checkSize rejects the fragment if any entry of it is larger than maxEntrySize, or itself is larger than
+// maxFragmentSize.
+func (b *vsBlock) checkSize(frag *fragment) error {
+	if b.maxEntrySize > 0 {
+		_ = frag.size()
+		for i := 1; i < len(frag.offsets); i++ {
+			if sz := frag.offsets[i] - frag.offsets[i-1]; sz > b.maxEntrySize {
+				return errors.ErrEventTooLarge.WithMessage(
+					fmt.Sprintf("entry size %d exceeds the limit %d", sz, b.maxEntrySize))
+			}
+		}
+	}
+	if sz := frag.Size(); b.maxFragmentSize > 0 && sz > b.maxFragmentSize {
+		return errors.ErrEventTooLarge.WithMessage(
+			fmt.Sprintf("fragment size %d exceeds the limit %d", sz, b.maxFragmentSize))
+	}
+	return nil
+}
+
+func (b *vsBlock) newFragment(offset int64, entries []block.Entry) *fragment {
+	return &fragment{
+		offset:  offset,
+		entries: entries,
+		enc:     b.enc,
+		metrics: b.metrics,
+	}
+}
+
 func (b *vsBlock) PrepareArchive(ctx context.Context, appendCtx block.AppendContext) (block.Fragment, error) {
 	_, span := b.tracer.Start(ctx, "PrepareArchive")
 	defer span.End()
 
 	actx, _ := appendCtx.(*appendContext)
 
-	end := wrapEntry(&block.EmptyEntryExt{}, ceschema.End, actx.seq, time.Now().UnixMilli())
-	frag := newFragment(actx.offset, []block.Entry{end}, b.enc)
+	end := b.wrapEntry(&FieldContext{
+		Ctx:   ctx,
+		Entry: &block.EmptyEntryExt{},
+		Type:  ceschema.End,
+		Seq:   actx.seq,
+		Stime: b.now().UnixMilli(),
+	})
+	frag := b.newFragment(actx.offset, []block.Entry{end})
 
 	actx.offset += int64(frag.Size())
 	actx.seq++
@@ -142,26 +248,39 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frags ...block.Fragment) (bo
 	for _, frag := range frags {
 		sz += frag.Size()
 	}
-	data := make([]byte, sz)
+	// The payloads of fragments are retained by raft log, so only the merged buffer is pooled, it isn't
+	// referenced by indexes once written.
+	var data []byte
 	base := frags[0].StartOffset()
-	for _, frag := range frags {
-		copy(data[frag.StartOffset()-base:], frag.Payload())
+	if len(frags) == 1 {
+		data = frags[0].Payload()
+	} else {
+		data = getBuffer(sz)
+		defer putBuffer(data)
+		for _, frag := range frags {
+			copy(data[frag.StartOffset()-base:], frag.Payload())
+		}
 	}
+	b.metrics.observeBatch(len(frags), sz)
 
+	start := time.Now()
 	indexes, entryCount, archived, err := b.buildIndexes(ctx, base, data)
 	if err != nil {
 		return false, err
 	}
+	b.metrics.observeIndex(start)
 	if !archived && len(indexes) == 0 {
 		return false, nil
 	}
 
 	_, wSpan := b.tracer.Start(ctx, "writeFile")
+	start = time.Now()
 	entrySize, err := b.f.WriteAt(data[b.actx.offset-base:], b.actx.offset)
 	if err != nil {
 		wSpan.End()
 		return false, err
 	}
+	b.metrics.observeWriteAt(start)
 	wSpan.End()
 
 	span.AddEvent("Acquiring lock")
@@ -174,30 +293,34 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frags ...block.Fragment) (bo
 	)
 
 	b.indexes = append(b.indexes, indexes...)
+	b.metrics.setIndexes(len(b.indexes))
 	b.actx.seq += entryCount
 	b.actx.offset += int64(entrySize)
 	if archived {
 		atomic.StoreUint32(&b.actx.archived, 1)
 	}
+	b.version++
+
+	// The snapshot is made before releasing the lock, so that it's exactly the view when Block is archived.
+	var snap snapshot
+	if archived {
+		snap = b.snapshotLocked()
+	}
 
 	span.AddEvent("Release lock")
 	b.mu.Unlock()
 
 	if archived {
-		m, i := makeSnapshot(b.actx, b.indexes)
-
 		b.wg.Add(1)
 		go func() {
 			defer b.wg.Done()
-			if n, err := b.appendIndexEntry(ctx, i, m.writeOffset); err == nil {
-				b.indexOffset = m.writeOffset
-				b.indexLength = n
-			}
-			_ = b.persistHeader(ctx, m)
+			_ = b.persistIndexes(ctx, snap.meta, snap.indexes)
+			_ = b.persistHeader(ctx, snap.meta)
 		}()
 
+		b.metrics.incArchived()
 		if b.lis != nil {
-			b.lis.OnArchived(b.stat(m, i))
+			b.lis.OnArchived(b.stat(snap))
 		}
 	}
 
@@ -248,7 +371,8 @@ func (b *vsBlock) buildIndexes(ctx context.Context, base int64, data []byte) ([]
 func (b *vsBlock) appendIndexEntry(ctx context.Context, indexes []index.Index, off int64) (int, error) {
 	entry := index.NewEntry(indexes)
 	sz := b.enc.Size(entry)
-	data := make([]byte, sz)
+	data := getBuffer(sz)
+	defer putBuffer(data)
 	if _, err := b.enc.MarshalTo(ctx, entry, data); err != nil {
 		return 0, err
 	}
@@ -265,19 +389,29 @@ func (b *vsBlock) trimFragments(ctx context.Context, frags []block.Fragment) ([]
 	for i := 0; i < len(frags); i++ {
 		switch frag := frags[i]; {
 		case frag.EndOffset() <= off:
-			log.Info(ctx, "vsb: data of fragment has been written, skip this entry.", map[string]interface{}{
-				"block_id":              b.id,
-				"expected":              off,
-				"fragment_start_offset": frag.StartOffset(),
-				"fragment_end_offset":   frag.EndOffset(),
-			})
+			b.metrics.incSkipped(frag.Size())
+			trace.SpanFromContext(ctx).AddEvent("fragment skipped", trace.WithAttributes(
+				attribute.Int64("fragment_start_offset", frag.StartOffset()),
+				attribute.Int64("fragment_end_offset", frag.EndOffset()),
+			))
+			skipLogger.Info(ctx, "vsb: data of fragment has been written, skip this entry.",
+				log.Stringer("block_id", b.id),
+				log.Int64("expected", off),
+				log.Int64("fragment_start_offset", frag.StartOffset()),
+				log.Int64("fragment_end_offset", frag.EndOffset()),
+			)
 			continue
 		case frag.StartOffset() > off:
-			log.Error(ctx, "vsb: missing some fragments.", map[string]interface{}{
-				"block_id": b.id,
-				"expected": off,
-				"found":    frag.StartOffset(),
-			})
+			b.metrics.incMissing()
+			trace.SpanFromContext(ctx).AddEvent("fragments missing", trace.WithAttributes(
+				attribute.Int64("expected", off),
+				attribute.Int64("found", frag.StartOffset()),
+			))
+			logger.Error(ctx, "vsb: missing some fragments.",
+				log.Stringer("block_id", b.id),
+				log.Int64("expected", off),
+				log.Int64("found", frag.StartOffset()),
+			)
 			return nil, errors.ErrInternal
 		}
 		if i != 0 {
@@ -302,11 +436,16 @@ func (b *vsBlock) checkFragments(ctx context.Context, frags []block.Fragment) er
 		prevEo := frags[i-1].EndOffset()
 		nextSo := frags[i].StartOffset()
 		if prevEo != nextSo {
-			log.Error(ctx, "vsb: fragments is discontinuous.", map[string]interface{}{
-				"block_id":            b.id,
-				"next_start_offset":   nextSo,
-				"previous_end_offset": prevEo,
-			})
+			b.metrics.incDiscontinuous()
+			trace.SpanFromContext(ctx).AddEvent("fragments discontinuous", trace.WithAttributes(
+				attribute.Int64("next_start_offset", nextSo),
+				attribute.Int64("previous_end_offset", prevEo),
+			))
+			logger.Error(ctx, "vsb: fragments is discontinuous.",
+				log.Stringer("block_id", b.id),
+				log.Int64("next_start_offset", nextSo),
+				log.Int64("previous_end_offset", prevEo),
+			)
 			return errors.ErrInternal
 		}
 	}
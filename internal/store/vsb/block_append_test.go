@@ -18,18 +18,23 @@ import (
 	// standard libraries.
 	"context"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	// third-party libraries.
 	. "github.com/golang/mock/gomock"
 	. "github.com/smartystreets/goconvey/convey"
 
 	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
 	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
 	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
 	idxtest "github.com/linkall-labs/vanus/internal/store/vsb/index/testing"
 	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
 )
 
 func TestVSBlock_Append(t *testing.T) {
@@ -73,6 +78,34 @@ func TestVSBlock_Append(t *testing.T) {
 		So(actx.Archived(), ShouldBeTrue)
 	})
 
+	Convey("append entries stamped by the clock", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dec, _ := codec.NewDecoder(false, codec.IndexSize)
+		clock := util.NewFakeClock(time.UnixMilli(1000))
+		b := &vsBlock{
+			capacity:   vsbtest.EntrySize0 + vsbtest.EntrySize1,
+			dataOffset: headerBlockSize,
+			actx: appendContext{
+				offset: headerBlockSize,
+			},
+			enc:   codec.NewEncoder(),
+			dec:   dec,
+			clock: clock,
+		}
+
+		actx := b.NewAppendContext(nil)
+		_, _, _, err := b.PrepareAppend(context.Background(), actx, cetest.MakeEntry0(ctrl))
+		So(err, ShouldBeNil)
+		So(actx.Stime(), ShouldEqual, 1000)
+
+		clock.Advance(time.Second)
+		_, _, _, err = b.PrepareAppend(context.Background(), actx, cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+		So(actx.Stime(), ShouldEqual, 2000)
+	})
+
 	Convey("append entries to vsb", t, func() {
 		ctrl := NewController(t)
 		defer ctrl.Finish()
@@ -99,12 +132,14 @@ func TestVSBlock_Append(t *testing.T) {
 			actx := b.NewAppendContext(nil)
 			So(actx, ShouldNotBeNil)
 
+			So(actx.Stime(), ShouldEqual, 0)
 			seqs, frag, full, err := b.PrepareAppend(context.Background(), actx, ent0)
 			So(err, ShouldBeNil)
 			So(seqs, ShouldResemble, []int64{0})
 			So(frag.StartOffset(), ShouldEqual, headerBlockSize)
 			So(frag.Size(), ShouldEqual, vsbtest.EntrySize0)
 			So(full, ShouldBeFalse)
+			So(actx.Stime(), ShouldBeGreaterThan, 0)
 
 			stat := b.status()
 			So(stat.Archived, ShouldBeFalse)
@@ -331,3 +366,170 @@ func TestVSBlock_Append(t *testing.T) {
 		So(buf, ShouldResemble, vsbtest.ArchivedHeaderData)
 	})
 }
+
+func TestVSBlock_SnapshotIsolation(t *testing.T) {
+	Convey("snapshot isolation of vsb", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		const num = 64
+		var archived block.Statistics
+		ctx := context.Background()
+		e := &engine{dir: dir, lis: block.ArchivedCallback(func(stat block.Statistics) {
+			archived = stat
+		})}
+		r, err := e.Create(ctx, vanus.NewTestID(), 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		entries := make([]block.Entry, num)
+		for i := range entries {
+			entries[i] = cetest.MakeEntry0(ctrl)
+		}
+
+		first := b.makeSnapshot()
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var last uint64
+				for {
+					snap := b.makeSnapshot()
+					if snap.version < last || snap.meta.entryNum != int64(len(snap.indexes)) {
+						t.Error("inconsistent snapshot")
+					}
+					last = snap.version
+					_ = b.status()
+					select {
+					case <-done:
+						return
+					default:
+					}
+				}
+			}()
+		}
+
+		actx := b.NewAppendContext(nil)
+		for i := range entries {
+			_, frag, _, err := b.PrepareAppend(ctx, actx, entries[i])
+			So(err, ShouldBeNil)
+			_, err = b.CommitAppend(ctx, frag)
+			So(err, ShouldBeNil)
+		}
+		frag, err := b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		ok, err := b.CommitAppend(ctx, frag)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+		close(done)
+		wg.Wait()
+
+		// The snapshot made before appending is never changed by appends.
+		So(first.version, ShouldEqual, 0)
+		So(first.indexes, ShouldBeEmpty)
+
+		last := b.makeSnapshot()
+		So(last.version, ShouldEqual, num+1)
+		So(last.meta.archived, ShouldBeTrue)
+		So(last.indexes, ShouldHaveLength, num)
+		So(cap(last.indexes), ShouldEqual, num)
+		So(archived.Archived, ShouldBeTrue)
+		So(archived.EntryNum, ShouldEqual, num)
+
+		So(b.Close(ctx), ShouldBeNil)
+	})
+}
+
+func TestVSBlock_RepairAppendContext(t *testing.T) {
+	Convey("repair append context from data tail", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		ctx := context.Background()
+		e := &engine{dir: dir}
+		r, err := e.Create(ctx, vanus.NewTestID(), 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		actx := b.NewAppendContext(nil)
+		_, frag0, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl))
+		So(err, ShouldBeNil)
+		_, frag1, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+		_, err = b.CommitAppend(ctx, frag0, frag1)
+		So(err, ShouldBeNil)
+
+		Convey("the fragment ahead of written data is trusted", func() {
+			_, frag2, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl))
+			So(err, ShouldBeNil)
+			repaired := b.NewAppendContext(frag2)
+			So(repaired.WriteOffset(), ShouldEqual, frag2.EndOffset())
+		})
+
+		Convey("the stale fragment is repaired by written data", func() {
+			repaired := b.NewAppendContext(frag0)
+			So(repaired.WriteOffset(), ShouldEqual, frag1.EndOffset())
+			So(repaired.(*appendContext).seq, ShouldEqual, 2)
+		})
+
+		Convey("the data written but not indexed yet is scanned", func() {
+			_, frag2, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl))
+			So(err, ShouldBeNil)
+			_, err = b.f.WriteAt(frag2.Payload(), frag2.StartOffset())
+			So(err, ShouldBeNil)
+
+			repaired := b.NewAppendContext(frag1)
+			So(repaired.WriteOffset(), ShouldEqual, frag2.EndOffset())
+			So(repaired.(*appendContext).seq, ShouldEqual, 3)
+			So(repaired.Archived(), ShouldBeFalse)
+		})
+
+		So(b.Close(ctx), ShouldBeNil)
+	})
+}
+
+func TestVSBlock_SizeLimits(t *testing.T) {
+	Convey("size limits of appends", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dec, _ := codec.NewDecoder(false, codec.IndexSize)
+		b := &vsBlock{
+			capacity:   64 * 1024,
+			dataOffset: headerBlockSize,
+			actx: appendContext{
+				offset: headerBlockSize,
+			},
+			enc:             codec.NewEncoder(),
+			dec:             dec,
+			maxEntrySize:    vsbtest.EntrySize1,
+			maxFragmentSize: vsbtest.EntrySize0 + vsbtest.EntrySize1,
+		}
+		ctx := context.Background()
+		actx := b.NewAppendContext(nil)
+
+		_, _, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl), cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+
+		offset := actx.WriteOffset()
+		_, _, _, err = b.PrepareAppend(ctx, actx,
+			cetest.MakeEntry0(ctrl), cetest.MakeEntry1(ctrl), cetest.MakeEntry0(ctrl))
+		So(errors.Is(err, errors.ErrEventTooLarge), ShouldBeTrue)
+		So(actx.WriteOffset(), ShouldEqual, offset)
+
+		b.maxEntrySize = vsbtest.EntrySize0 - 1
+		_, _, _, err = b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl))
+		So(errors.Is(err, errors.ErrEventTooLarge), ShouldBeTrue)
+		So(actx.WriteOffset(), ShouldEqual, offset)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"io"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+// Make sure vsBlock implements block.IDIndexer.
+var _ block.IDIndexer = (*vsBlock)(nil)
+
+// ExcludeID checks id by the bloom filter.
+func (b *vsBlock) ExcludeID(id string) (int64, bool) {
+	b.mu.RLock()
+	bloom, num := b.bloom, len(b.indexes)
+	b.mu.RUnlock()
+
+	if bloom == nil || bloom.MayContain(id) {
+		return 0, false
+	}
+	return int64(num), true
+}
+
+func (b *vsBlock) appendBloomEntry(ctx context.Context, m meta, off int64) (int, error) {
+	bloom := index.NewBloom(int(m.entryNum), b.bloomFPR)
+	err := b.scanEntries(m, func(entry block.Entry) {
+		bloom.Add(entry.GetString(ceschema.IDOrdinal))
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	entry := index.NewBloomEntry(bloom)
+	data := getBuffer(b.enc.Size(entry))
+	defer putBuffer(data)
+	if _, err = b.enc.MarshalTo(ctx, entry, data); err != nil {
+		return 0, err
+	}
+	if _, err = b.f.WriteAt(data, off); err != nil {
+		return 0, err
+	}
+
+	b.mu.Lock()
+	b.bloom = bloom
+	b.mu.Unlock()
+	b.flags |= flagBloomFilter
+
+	return len(data), nil
+}
+
+// loadBloom reads the bloom filter after the attribute index or the index entry, it's dropped if corrupted.
+func (b *vsBlock) loadBloom(ctx context.Context, r io.ReadSeeker) {
+	_, entry, err := b.dec.UnmarshalReader(r)
+	if err == nil && ceschema.EntryType(entry) == ceschema.BloomIndex {
+		if bloom, _ := entry.Get(ceschema.BloomOrdinal).(*index.Bloom); bloom != nil && len(bloom.Bits) > 0 {
+			b.bloom = bloom
+			return
+		}
+	}
+	logger.Warning(ctx, "vsb: the bloom filter is corrupted, drop it.",
+		log.Stringer("block_id", b.id),
+	)
+	b.flags &^= flagBloomFilter
+}
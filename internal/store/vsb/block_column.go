@@ -0,0 +1,180 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"io"
+	"sort"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+// Make sure vsBlock implements block.AttributeIndexer.
+var _ block.AttributeIndexer = (*vsBlock)(nil)
+
+// LookupAttribute returns the value of attr of the entry at seq from the attribute index.
+func (b *vsBlock) LookupAttribute(seq int64, attr string) (string, bool) {
+	ordinal, ok := index.IndexedAttributes[attr]
+	if !ok {
+		return "", false
+	}
+
+	b.mu.RLock()
+	columns := b.columns
+	b.mu.RUnlock()
+
+	for i := range columns {
+		if columns[i].Ordinal == ordinal {
+			return columns[i].Value(int(seq))
+		}
+	}
+	return "", false
+}
+
+// persistIndexes appends the index entry at the write offset, and the attribute index and the bloom
+// filter after it if Block is archived.
+func (b *vsBlock) persistIndexes(ctx context.Context, m meta, indexes []index.Index) error {
+	n, err := b.appendIndexEntry(ctx, indexes, m.writeOffset)
+	if err != nil {
+		return err
+	}
+	b.indexOffset = m.writeOffset
+	b.indexLength = n
+
+	if !m.archived {
+		return nil
+	}
+
+	// The attribute index and the bloom filter are optional, Block is still readable without them.
+	off := m.writeOffset + int64(n)
+	if b.indexAttributes && b.flags&flagAttributeIndex == 0 {
+		sz, err := b.appendColumnsEntry(ctx, m, off)
+		if err != nil {
+			logger.Warning(ctx, "vsb: build attribute index failed.",
+				log.Stringer("block_id", b.id),
+				log.Err(err),
+			)
+		}
+		off += int64(sz)
+	}
+	if b.bloomFPR > 0 && b.flags&flagBloomFilter == 0 {
+		if _, err := b.appendBloomEntry(ctx, m, off); err != nil {
+			logger.Warning(ctx, "vsb: build bloom filter failed.",
+				log.Stringer("block_id", b.id),
+				log.Err(err),
+			)
+		}
+	}
+	return nil
+}
+
+func (b *vsBlock) appendColumnsEntry(ctx context.Context, m meta, off int64) (int, error) {
+	columns, err := b.buildColumns(m)
+	if err != nil {
+		return 0, err
+	}
+
+	entry := index.NewColumnsEntry(columns)
+	data := getBuffer(b.enc.Size(entry))
+	defer putBuffer(data)
+	if _, err = b.enc.MarshalTo(ctx, entry, data); err != nil {
+		return 0, err
+	}
+	if _, err = b.f.WriteAt(data, off); err != nil {
+		return 0, err
+	}
+
+	b.mu.Lock()
+	b.columns = columns
+	b.mu.Unlock()
+	b.flags |= flagAttributeIndex
+
+	return len(data), nil
+}
+
+// buildColumns scans the entries of archived Block.
+func (b *vsBlock) buildColumns(m meta) ([]index.Column, error) {
+	ordinals := make([]int, 0, len(index.IndexedAttributes))
+	for _, ordinal := range index.IndexedAttributes {
+		ordinals = append(ordinals, ordinal)
+	}
+	sort.Ints(ordinals)
+
+	builders := make([]*index.ColumnBuilder, len(ordinals))
+	for i, ordinal := range ordinals {
+		builders[i] = index.NewColumnBuilder(ordinal)
+	}
+
+	err := b.scanEntries(m, func(entry block.Entry) {
+		for _, builder := range builders {
+			builder.Add(entry)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]index.Column, len(builders))
+	for i, builder := range builders {
+		columns[i] = builder.Build()
+	}
+	return columns, nil
+}
+
+// scanEntries iterates the entries of archived Block in sequence.
+func (b *vsBlock) scanEntries(m meta, fn func(entry block.Entry)) error {
+	it := b.NewIterator(0)
+	defer it.Close()
+	for i := int64(0); i < m.entryNum; i++ {
+		entry, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if ceschema.EntryType(entry) != ceschema.CloudEvent {
+			return errCorrupted
+		}
+		fn(entry)
+	}
+	return nil
+}
+
+// loadColumns reads the attribute index after the index entry, it's dropped if corrupted.
+func (b *vsBlock) loadColumns(ctx context.Context, r io.ReadSeeker) bool {
+	_, entry, err := b.dec.UnmarshalReader(r)
+	if err == nil && ceschema.EntryType(entry) == ceschema.AttributeIndex {
+		columns, _ := entry.Get(ceschema.ColumnsOrdinal).([]index.Column)
+		valid := true
+		for i := range columns {
+			valid = valid && len(columns[i].Refs) == len(b.indexes)
+		}
+		if valid {
+			b.columns = columns
+			return true
+		}
+	}
+	logger.Warning(ctx, "vsb: the attribute index is corrupted, drop it.",
+		log.Stringer("block_id", b.id),
+	)
+	b.flags &^= flagAttributeIndex
+	return false
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+)
+
+func TestVSBlock_AttributeIndex(t *testing.T) {
+	Convey("attribute index of vsb", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		ctx := context.Background()
+		e := &engine{dir: dir, indexAttributes: true}
+		id := vanus.NewTestID()
+		r, err := e.Create(ctx, id, 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		actx := b.NewAppendContext(nil)
+		_, frag0, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl), cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+		frag1, err := b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		archived, err := b.CommitAppend(ctx, frag0, frag1)
+		So(err, ShouldBeNil)
+		So(archived, ShouldBeTrue)
+
+		checkColumns := func(idx block.AttributeIndexer) {
+			v, ok := idx.LookupAttribute(0, "type")
+			So(ok, ShouldBeTrue)
+			So(v, ShouldEqual, "ce-type")
+			v, ok = idx.LookupAttribute(0, "subject")
+			So(ok, ShouldBeTrue)
+			So(v, ShouldBeEmpty)
+			v, ok = idx.LookupAttribute(1, "subject")
+			So(ok, ShouldBeTrue)
+			So(v, ShouldEqual, "ce-subject")
+			_, ok = idx.LookupAttribute(2, "type")
+			So(ok, ShouldBeFalse)
+			_, ok = idx.LookupAttribute(0, "id")
+			So(ok, ShouldBeFalse)
+		}
+
+		// The attribute index is built asynchronously.
+		b.wg.Wait()
+		checkColumns(b)
+		So(b.Close(ctx), ShouldBeNil)
+
+		r, err = e.Open(ctx, id)
+		So(err, ShouldBeNil)
+		b, _ = r.(*vsBlock)
+		So(b.flags&flagAttributeIndex, ShouldNotEqual, 0)
+		checkColumns(b)
+		So(b.Close(ctx), ShouldBeNil)
+	})
+}
+
+func TestVSBlock_BloomFilter(t *testing.T) {
+	Convey("bloom filter of vsb", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dir, err := os.MkdirTemp("", "vsb-*")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		ctx := context.Background()
+		e := &engine{dir: dir, indexAttributes: true, bloomFPR: 0.01}
+		id := vanus.NewTestID()
+		r, err := e.Create(ctx, id, 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+
+		_, ok := b.ExcludeID("not-exist")
+		So(ok, ShouldBeFalse)
+
+		actx := b.NewAppendContext(nil)
+		_, frag0, _, err := b.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl), cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+		frag1, err := b.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		_, err = b.CommitAppend(ctx, frag0, frag1)
+		So(err, ShouldBeNil)
+
+		checkBloom := func(idx block.IDIndexer) {
+			_, ok := idx.ExcludeID("ce-id0")
+			So(ok, ShouldBeFalse)
+			_, ok = idx.ExcludeID("ce-id1")
+			So(ok, ShouldBeFalse)
+			num, ok := idx.ExcludeID("not-exist")
+			So(ok, ShouldBeTrue)
+			So(num, ShouldEqual, 2)
+		}
+
+		b.wg.Wait()
+		checkBloom(b)
+		So(b.Close(ctx), ShouldBeNil)
+
+		r, err = e.Open(ctx, id)
+		So(err, ShouldBeNil)
+		b, _ = r.(*vsBlock)
+		So(b.flags&flagBloomFilter, ShouldNotEqual, 0)
+		So(b.flags&flagAttributeIndex, ShouldNotEqual, 0)
+		checkBloom(b)
+		So(b.Close(ctx), ShouldBeNil)
+	})
+}
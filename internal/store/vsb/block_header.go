@@ -34,6 +34,7 @@ const (
 	breakFlagsOffset  = 12
 	dataOffsetOffset  = 16
 	stateOffset       = 20
+	formatOffset      = 21
 	indexSizeOffset   = 22
 	capacityOffset    = 24
 	entryLengthOffset = 32
@@ -41,6 +42,14 @@ const (
 	indexOffsetOffset = 44
 )
 
+const (
+	// flagAttributeIndex indicates the attribute index is persisted after the index entry.
+	flagAttributeIndex uint32 = 1 << iota
+	// flagBloomFilter indicates the bloom filter of ids is persisted after the attribute index or the
+	// index entry.
+	flagBloomFilter
+)
+
 var (
 	crc32q      = crc32.MakeTable(crc32.Castagnoli)
 	emptyHeader = make([]byte, headerBlockSize)
@@ -49,12 +58,13 @@ var (
 func (b *vsBlock) persistHeader(ctx context.Context, m meta) error {
 	var buf [headerSize]byte
 	binary.LittleEndian.PutUint32(buf[magicOffset:], FormatMagic)               // magic
-	binary.LittleEndian.PutUint32(buf[flagsOffset:], 0)                         // flags
+	binary.LittleEndian.PutUint32(buf[flagsOffset:], b.flags)                   // flags
 	binary.LittleEndian.PutUint32(buf[breakFlagsOffset:], 0)                    // break flags
 	binary.LittleEndian.PutUint32(buf[dataOffsetOffset:], uint32(b.dataOffset)) // data offset
 	if m.archived {                                                             // state
 		buf[stateOffset] = 1
 	}
+	buf[formatOffset] = b.format                                                  // format version
 	binary.LittleEndian.PutUint16(buf[indexSizeOffset:], b.indexSize)             // index size
 	binary.LittleEndian.PutUint64(buf[capacityOffset:], uint64(b.capacity))       // capacity
 	binary.LittleEndian.PutUint64(buf[entryLengthOffset:], uint64(m.entryLength)) // entry length
@@ -94,6 +104,12 @@ func (b *vsBlock) loadHeader(ctx context.Context) error {
 		return errIncomplete
 	}
 
+	b.format = buf[formatOffset] // format version
+	if !isReadableFormat(b.format) {
+		return errUnsupportedFormat
+	}
+
+	b.flags = binary.LittleEndian.Uint32(buf[flagsOffset:])                       // flags
 	b.dataOffset = int64(binary.LittleEndian.Uint32(buf[dataOffsetOffset:]))      // data offset
 	b.fm.archived = buf[stateOffset] != 0                                         // state
 	b.indexSize = binary.LittleEndian.Uint16(buf[indexSizeOffset:])               // index size
@@ -0,0 +1,113 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+const iteratorBufferSize = 64 * 1024
+
+// Make sure vsBlock implements block.Iterable.
+var _ block.Iterable = (*vsBlock)(nil)
+
+func (b *vsBlock) NewIterator(seq int64) block.Iterator {
+	return &iterator{
+		b:   b,
+		seq: seq,
+	}
+}
+
+// iterator reads the entries in chunks of at most iteratorBufferSize bytes, unless an entry is larger
+// than it, into a pooled buffer. The entries are decoded when they are iterated.
+type iterator struct {
+	b   *vsBlock
+	seq int64
+	buf []byte
+	// data is the chunk of entries [first, end) in buffer, base is the offset of it in file.
+	data       []byte
+	base       int64
+	first, end int64
+	pos        int
+}
+
+// Make sure iterator implements block.Iterator.
+var _ block.Iterator = (*iterator)(nil)
+
+func (it *iterator) Next() (block.Entry, error) {
+	if it.seq < it.first || it.seq >= it.end {
+		if err := it.fill(); err != nil {
+			return nil, err
+		}
+	}
+	n, entry, err := it.b.dec.Unmarshal(it.data[it.pos:])
+	if err != nil {
+		return nil, err
+	}
+	it.pos += n
+	it.seq++
+	return entry, nil
+}
+
+func (it *iterator) SeekTo(seq int64) {
+	if seq == it.seq {
+		return
+	}
+	it.seq = seq
+	if seq >= it.first && seq < it.end {
+		it.pos = int(it.b.entryOffset(int(seq)) - it.base)
+	} else {
+		it.first, it.end = 0, 0
+	}
+}
+
+func (it *iterator) Close() {
+	if it.buf != nil {
+		putBuffer(it.buf)
+		it.buf = nil
+	}
+	it.data = nil
+	it.first, it.end = 0, 0
+}
+
+func (it *iterator) fill() error {
+	from, to, num, err := it.b.entryRangeBySize(int(it.seq), iteratorBufferSize)
+	if err != nil {
+		return err
+	}
+
+	length := int(to - from)
+	if cap(it.buf) < length {
+		if it.buf != nil {
+			putBuffer(it.buf)
+		}
+		size := iteratorBufferSize
+		if length > size {
+			size = length
+		}
+		it.buf = getBuffer(size)
+	}
+	data := it.buf[:length]
+	if _, err = it.b.f.ReadAt(data, from); err != nil {
+		return err
+	}
+
+	it.data = data
+	it.base = from
+	it.first, it.end = it.seq, it.seq+int64(num)
+	it.pos = 0
+	return nil
+}
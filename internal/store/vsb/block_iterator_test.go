@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+	idxtest "github.com/linkall-labs/vanus/internal/store/vsb/index/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+func TestVSBlock_Iterator(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	idx0 := idxtest.MakeIndex0(ctrl)
+	idx1 := idxtest.MakeIndex1(ctrl)
+
+	dataOffset := vsbtest.EntryOffset0
+
+	Convey("iterate entries of block", t, func() {
+		f, err := os.CreateTemp("", "*.vsb")
+		So(err, ShouldBeNil)
+		defer func() {
+			err = f.Close()
+			So(err, ShouldBeNil)
+			err = os.Remove(f.Name())
+			So(err, ShouldBeNil)
+		}()
+
+		_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
+		So(err, ShouldBeNil)
+
+		dec, _ := codec.NewDecoder(false, codec.IndexSize)
+		b := &vsBlock{
+			dataOffset: dataOffset,
+			actx: appendContext{
+				offset: dataOffset,
+			},
+			indexes: []index.Index{idx0, idx1},
+			dec:     dec,
+			f:       f,
+		}
+
+		it := b.NewIterator(0)
+		defer it.Close()
+
+		entry, err := it.Next()
+		So(err, ShouldBeNil)
+		cetest.CheckEntry0(entry, false, false)
+		entry, err = it.Next()
+		So(err, ShouldBeNil)
+		cetest.CheckEntry1(entry, false, false)
+		_, err = it.Next()
+		So(err, ShouldBeError, errors.ErrOffsetOnEnd)
+
+		// seek in the buffer.
+		it.SeekTo(1)
+		entry, err = it.Next()
+		So(err, ShouldBeNil)
+		cetest.CheckEntry1(entry, false, false)
+		it.SeekTo(0)
+		entry, err = it.Next()
+		So(err, ShouldBeNil)
+		cetest.CheckEntry0(entry, false, false)
+
+		it.SeekTo(3)
+		_, err = it.Next()
+		So(err, ShouldBeError, errors.ErrOffsetOverflow)
+
+		Convey("read entries in chunks", func() {
+			from, to, num, err := b.entryRangeBySize(0, int64(idx0.Length()))
+			So(err, ShouldBeNil)
+			So(from, ShouldEqual, idx0.StartOffset())
+			So(to, ShouldEqual, idx0.EndOffset())
+			So(num, ShouldEqual, 1)
+
+			// the range contains one entry at least.
+			_, to, num, err = b.entryRangeBySize(1, 1)
+			So(err, ShouldBeNil)
+			So(to, ShouldEqual, idx1.EndOffset())
+			So(num, ShouldEqual, 1)
+
+			_, to, num, err = b.entryRangeBySize(0, iteratorBufferSize)
+			So(err, ShouldBeNil)
+			So(to, ShouldEqual, idx1.EndOffset())
+			So(num, ShouldEqual, 2)
+		})
+	})
+}
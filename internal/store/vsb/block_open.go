@@ -35,6 +35,8 @@ import (
 var (
 	errCorrupted  = stderr.New("corrupted vsb")
 	errIncomplete = stderr.New("incomplete vsb")
+	// errUnsupportedFormat is returned if the format version is neither the current nor the previous one.
+	errUnsupportedFormat = stderr.New("unsupported vsb format version")
 )
 
 func (b *vsBlock) Open(ctx context.Context) error {
@@ -72,7 +74,7 @@ func (b *vsBlock) init(ctx context.Context) error {
 		return err
 	}
 
-	if err := b.repairMeta(); err != nil {
+	if err := b.repairMeta(ctx); err != nil {
 		return err
 	}
 
@@ -83,7 +85,7 @@ func (b *vsBlock) init(ctx context.Context) error {
 	return nil
 }
 
-func (b *vsBlock) repairMeta() error {
+func (b *vsBlock) repairMeta(ctx context.Context) error {
 	off := b.dataOffset + b.fm.entryLength
 	seq := b.fm.entryNum
 	full := b.fm.archived
@@ -154,6 +156,13 @@ FOUND_INDEX:
 	}
 	b.indexOffset = off
 	b.indexLength = n
+	if b.flags&flagAttributeIndex != 0 && !b.loadColumns(ctx, r) {
+		// The bloom filter is after the attribute index, it can't be located.
+		b.flags &^= flagBloomFilter
+	}
+	if b.flags&flagBloomFilter != 0 {
+		b.loadBloom(ctx, r)
+	}
 
 SET_META:
 	b.fm.writeOffset = off
@@ -17,6 +17,8 @@ package vsb
 import (
 	// standard libraries.
 	"context"
+	"sort"
+	"time"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
@@ -30,6 +32,7 @@ var _ block.Reader = (*vsBlock)(nil)
 func (b *vsBlock) Read(ctx context.Context, seq int64, num int) ([]block.Entry, error) {
 	_, span := b.tracer.Start(ctx, "Read")
 	defer span.End()
+	defer b.metrics.observeRead(time.Now())
 
 	from, to, num, err := b.entryRange(int(seq), num)
 	if err != nil {
@@ -60,10 +63,7 @@ func (b *vsBlock) entryRange(start, num int) (int64, int64, int, error) {
 	sz := len(b.indexes)
 
 	if start >= sz {
-		if start == sz && !b.full() {
-			return -1, -1, 0, errors.ErrOffsetOnEnd
-		}
-		return -1, -1, 0, errors.ErrOffsetOverflow
+		return -1, -1, 0, b.rangeError(start, sz)
 	}
 
 	end := start + num - 1
@@ -73,3 +73,39 @@ func (b *vsBlock) entryRange(start, num int) (int64, int64, int, error) {
 
 	return b.indexes[start].StartOffset(), b.indexes[end].EndOffset(), end - start + 1, nil
 }
+
+// entryRangeBySize returns the range of entries from start whose length is at most size, the range
+// contains one entry at least.
+func (b *vsBlock) entryRangeBySize(start int, size int64) (int64, int64, int, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	sz := len(b.indexes)
+
+	if start < 0 || start >= sz {
+		return -1, -1, 0, b.rangeError(start, sz)
+	}
+
+	from := b.indexes[start].StartOffset()
+	num := sort.Search(sz-start, func(i int) bool {
+		return b.indexes[start+i].EndOffset()-from > size
+	})
+	if num == 0 {
+		num = 1
+	}
+
+	return from, b.indexes[start+num-1].EndOffset(), num, nil
+}
+
+func (b *vsBlock) entryOffset(seq int) int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.indexes[seq].StartOffset()
+}
+
+func (b *vsBlock) rangeError(start, sz int) error {
+	if start == sz && !b.full() {
+		return errors.ErrOffsetOnEnd
+	}
+	return errors.ErrOffsetOverflow
+}
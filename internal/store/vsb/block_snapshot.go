@@ -29,26 +29,41 @@ import (
 // Make sure block implements block.Snapshoter.
 var _ block.Snapshoter = (*vsBlock)(nil)
 
-func (b *vsBlock) makeSnapshot() (meta, []index.Index) {
+// snapshot is an immutable view of Block, whose meta and indexes are consistent. It's safe to be used
+// without holding the lock of Block, since its indexes never share the tail with later appends.
+type snapshot struct {
+	// version is increased by every change of Block, the snapshots of the same version are identical.
+	version uint64
+	meta    meta
+	indexes []index.Index
+}
+
+func (b *vsBlock) makeSnapshot() snapshot {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
-	return makeSnapshot(b.actx, b.indexes)
+	return b.snapshotLocked()
 }
 
-func makeSnapshot(actx appendContext, indexes []index.Index) (meta, []index.Index) {
-	m := meta{
-		writeOffset: actx.offset,
-		archived:    actx.Archived(),
+// snapshotLocked makes the snapshot of Block, the caller must hold b.mu.
+func (b *vsBlock) snapshotLocked() snapshot {
+	sz := len(b.indexes)
+	s := snapshot{
+		version: b.version,
+		meta: meta{
+			writeOffset: b.actx.offset,
+			archived:    b.actx.Archived(),
+		},
+		indexes: b.indexes[:sz:sz],
 	}
-	if sz := len(indexes); sz > 0 {
-		m.entryLength = indexes[sz-1].EndOffset() - indexes[0].StartOffset()
-		m.entryNum = int64(sz)
+	if sz > 0 {
+		s.meta.entryLength = b.indexes[sz-1].EndOffset() - b.indexes[0].StartOffset()
+		s.meta.entryNum = int64(sz)
 	}
-	return m, indexes
+	return s
 }
 
 func (b *vsBlock) Snapshot(ctx context.Context) (block.Fragment, error) {
-	m, _ := b.makeSnapshot()
+	m := b.makeSnapshot().meta
 
 	if m.writeOffset == b.dataOffset {
 		buf := make([]byte, 8)
@@ -103,6 +118,8 @@ func (b *vsBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error
 
 	b.actx.seq = int64(len(b.indexes))
 	b.actx.offset = eo
+	b.version++
+	b.metrics.setIndexes(len(b.indexes))
 
 	return nil
 }
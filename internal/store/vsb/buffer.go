@@ -0,0 +1,59 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"math/bits"
+	"sync"
+)
+
+// The buffers are pooled in size classes of powers of two, from 4KB to 4MB.
+const (
+	minBufferClass = 12
+	maxBufferClass = 22
+)
+
+var bufferPools [maxBufferClass - minBufferClass + 1]sync.Pool
+
+func bufferClass(size int) int {
+	if c := bits.Len(uint(size - 1)); c > minBufferClass {
+		return c
+	}
+	return minBufferClass
+}
+
+// getBuffer returns a buffer whose length is size, it must be released by putBuffer once it isn't
+// referenced any more. The buffer larger than the max size class isn't pooled.
+func getBuffer(size int) []byte {
+	c := bufferClass(size)
+	if c > maxBufferClass {
+		return make([]byte, size)
+	}
+	if buf, _ := bufferPools[c-minBufferClass].Get().(*[]byte); buf != nil {
+		return (*buf)[:size]
+	}
+	return make([]byte, size, 1<<c)
+}
+
+// putBuffer releases the buffer got by getBuffer.
+func putBuffer(buf []byte) {
+	c := bits.Len(uint(cap(buf))) - 1
+	if c < minBufferClass || c > maxBufferClass || cap(buf) != 1<<c {
+		return
+	}
+	buf = buf[:cap(buf)]
+	bufferPools[c-minBufferClass].Put(&buf)
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuffer(t *testing.T) {
+	Convey("pooled buffer", t, func() {
+		Convey("size classes", func() {
+			So(bufferClass(1), ShouldEqual, minBufferClass)
+			So(bufferClass(4096), ShouldEqual, 12)
+			So(bufferClass(4097), ShouldEqual, 13)
+			So(bufferClass(1<<maxBufferClass), ShouldEqual, maxBufferClass)
+		})
+
+		Convey("get and put", func() {
+			buf := getBuffer(5000)
+			So(buf, ShouldHaveLength, 5000)
+			So(cap(buf), ShouldEqual, 8192)
+			putBuffer(buf)
+
+			buf = getBuffer(100)
+			So(buf, ShouldHaveLength, 100)
+			So(cap(buf), ShouldEqual, 4096)
+			putBuffer(buf)
+		})
+
+		Convey("large buffer is not pooled", func() {
+			buf := getBuffer(1<<maxBufferClass + 1)
+			So(buf, ShouldHaveLength, 1<<maxBufferClass+1)
+			So(cap(buf), ShouldEqual, 1<<maxBufferClass+1)
+			// It's ignored by the pool.
+			putBuffer(buf)
+			putBuffer(make([]byte, 5000))
+		})
+	})
+}
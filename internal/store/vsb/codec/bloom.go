@@ -0,0 +1,87 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"encoding/binary"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+const (
+	bloomHeaderSize = 4 + 4
+	bloomWordSize   = 8
+)
+
+type bloomEntryEncoder struct{}
+
+// Make sure bloomEntryEncoder implements RecordDataEncoder.
+var _ RecordDataEncoder = (*bloomEntryEncoder)(nil)
+
+func (e *bloomEntryEncoder) Size(entry block.Entry) int {
+	bloom, _ := entry.Get(ceschema.BloomOrdinal).(*index.Bloom)
+	if bloom == nil {
+		return bloomHeaderSize
+	}
+	return bloomHeaderSize + bloomWordSize*len(bloom.Bits)
+}
+
+func (e *bloomEntryEncoder) MarshalTo(entry block.Entry, buf []byte) (int, int, error) {
+	bloom, _ := entry.Get(ceschema.BloomOrdinal).(*index.Bloom)
+	if bloom == nil {
+		bloom = &index.Bloom{}
+	}
+	binary.LittleEndian.PutUint32(buf[0:], bloom.Hashes)            // hash number
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(bloom.Bits))) // word number
+	off := bloomHeaderSize
+	for _, w := range bloom.Bits {
+		binary.LittleEndian.PutUint64(buf[off:], w)
+		off += bloomWordSize
+	}
+	return off, 0, nil
+}
+
+type bloomEntryDecoder struct{}
+
+// Make sure bloomEntryDecoder implements RecordDataDecoder.
+var _ RecordDataDecoder = (*bloomEntryDecoder)(nil)
+
+func (d *bloomEntryDecoder) Unmarshal(t uint16, offset int, data []byte) (block.Entry, error) {
+	payload := data
+	if offset > 0 {
+		payload = data[offset:]
+	}
+
+	if len(payload) < bloomHeaderSize {
+		return nil, ErrCorruptedRecord
+	}
+	bloom := &index.Bloom{
+		Hashes: binary.LittleEndian.Uint32(payload[0:]),
+	}
+	num := int(binary.LittleEndian.Uint32(payload[4:]))
+	if len(payload) < bloomHeaderSize+bloomWordSize*num {
+		return nil, ErrCorruptedRecord
+	}
+	bloom.Bits = make([]uint64, num)
+	for i := range bloom.Bits {
+		bloom.Bits[i] = binary.LittleEndian.Uint64(payload[bloomHeaderSize+bloomWordSize*i:])
+	}
+
+	return index.NewBloomEntry(bloom), nil
+}
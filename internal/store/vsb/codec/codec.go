@@ -44,6 +44,15 @@ var (
 type EntryEncoder interface {
 	Size(entry block.Entry) int
 	MarshalTo(ctx context.Context, entry block.Entry, buf []byte) (int, error)
+	BatchEncoder
+}
+
+// BatchEncoder encodes entries one after another into a single buffer.
+type BatchEncoder interface {
+	// SizeBatch appends the offsets of entries to offsets, and the end of the last entry at last.
+	SizeBatch(entries []block.Entry, offsets []int) []int
+	// MarshalBatch encodes entries at the offsets computed by SizeBatch, buf must hold all of them.
+	MarshalBatch(ctx context.Context, entries []block.Entry, offsets []int, buf []byte) error
 }
 
 type EntryDecoder interface {
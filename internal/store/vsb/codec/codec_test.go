@@ -24,6 +24,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 
 	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
 	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
 	idxtest "github.com/linkall-labs/vanus/internal/store/vsb/index/testing"
 	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
@@ -101,6 +102,27 @@ func TestEntryEncoder(t *testing.T) {
 			So(n, ShouldEqual, vsbtest.IndexEntrySize)
 			So(buf, ShouldResemble, vsbtest.IndexEntryData)
 		})
+
+		Convey("marshal batch", func() {
+			entries := []block.Entry{entry0, entry1, endEntry}
+			offsets := enc.SizeBatch(entries, nil)
+			So(offsets, ShouldResemble, []int{
+				0, vsbtest.EntrySize0, vsbtest.EntrySize0 + vsbtest.EntrySize1,
+				vsbtest.EntrySize0 + vsbtest.EntrySize1 + vsbtest.EndEntrySize,
+			})
+
+			buf := make([]byte, offsets[len(entries)])
+			err := enc.MarshalBatch(context.Background(), entries, offsets, buf)
+			So(err, ShouldBeNil)
+			So(buf[:offsets[1]], ShouldResemble, vsbtest.EntryData0)
+			So(buf[offsets[1]:offsets[2]], ShouldResemble, vsbtest.EntryData1)
+			So(buf[offsets[2]:], ShouldResemble, vsbtest.EndEntryData)
+
+			err = enc.MarshalBatch(context.Background(), entries, offsets, buf[:offsets[2]])
+			So(err, ShouldEqual, ErrBufferNotEnough)
+			err = enc.MarshalBatch(context.Background(), entries, offsets[:2], buf)
+			So(err, ShouldEqual, ErrInvalid)
+		})
 	})
 }
 
@@ -0,0 +1,130 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	// standard libraries.
+	"encoding/binary"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+const (
+	columnCountSize  = 2
+	columnHeaderSize = 2 + 4 + 4
+	dictLengthSize   = 4
+	columnRefSize    = 4
+)
+
+type columnsEntryEncoder struct{}
+
+// Make sure columnsEntryEncoder implements RecordDataEncoder.
+var _ RecordDataEncoder = (*columnsEntryEncoder)(nil)
+
+func (e *columnsEntryEncoder) Size(entry block.Entry) int {
+	columns, _ := entry.Get(ceschema.ColumnsOrdinal).([]index.Column)
+	sz := columnCountSize
+	for i := range columns {
+		sz += columnHeaderSize + columnRefSize*len(columns[i].Refs)
+		for _, v := range columns[i].Dict {
+			sz += dictLengthSize + len(v)
+		}
+	}
+	return sz
+}
+
+func (e *columnsEntryEncoder) MarshalTo(entry block.Entry, buf []byte) (int, int, error) {
+	columns, _ := entry.Get(ceschema.ColumnsOrdinal).([]index.Column)
+	binary.LittleEndian.PutUint16(buf[0:], uint16(len(columns))) // column count
+	off := columnCountSize
+	for i := range columns {
+		col := &columns[i]
+		binary.LittleEndian.PutUint16(buf[off:], uint16(col.Ordinal))     // ordinal
+		binary.LittleEndian.PutUint32(buf[off+2:], uint32(len(col.Dict))) // dict size
+		binary.LittleEndian.PutUint32(buf[off+6:], uint32(len(col.Refs))) // entry num
+		off += columnHeaderSize
+		for _, v := range col.Dict {
+			binary.LittleEndian.PutUint32(buf[off:], uint32(len(v)))
+			off += dictLengthSize
+			off += copy(buf[off:], v)
+		}
+		for _, ref := range col.Refs {
+			binary.LittleEndian.PutUint32(buf[off:], ref)
+			off += columnRefSize
+		}
+	}
+	return off, 0, nil
+}
+
+type columnsEntryDecoder struct{}
+
+// Make sure columnsEntryDecoder implements RecordDataDecoder.
+var _ RecordDataDecoder = (*columnsEntryDecoder)(nil)
+
+func (d *columnsEntryDecoder) Unmarshal(t uint16, offset int, data []byte) (block.Entry, error) {
+	payload := data
+	if offset > 0 {
+		payload = data[offset:]
+	}
+
+	if len(payload) < columnCountSize {
+		return nil, ErrCorruptedRecord
+	}
+	num := int(binary.LittleEndian.Uint16(payload))
+	columns := make([]index.Column, num)
+	off := columnCountSize
+	for i := 0; i < num; i++ {
+		if len(payload) < off+columnHeaderSize {
+			return nil, ErrCorruptedRecord
+		}
+		col := &columns[i]
+		col.Ordinal = int(binary.LittleEndian.Uint16(payload[off:]))
+		dictSize := int(binary.LittleEndian.Uint32(payload[off+2:]))
+		entryNum := int(binary.LittleEndian.Uint32(payload[off+6:]))
+		off += columnHeaderSize
+
+		col.Dict = make([]string, dictSize)
+		for j := 0; j < dictSize; j++ {
+			if len(payload) < off+dictLengthSize {
+				return nil, ErrCorruptedRecord
+			}
+			sz := int(binary.LittleEndian.Uint32(payload[off:]))
+			off += dictLengthSize
+			if len(payload) < off+sz {
+				return nil, ErrCorruptedRecord
+			}
+			col.Dict[j] = string(payload[off : off+sz])
+			off += sz
+		}
+
+		if len(payload) < off+columnRefSize*entryNum {
+			return nil, ErrCorruptedRecord
+		}
+		col.Refs = make([]uint32, entryNum)
+		for j := 0; j < entryNum; j++ {
+			ref := binary.LittleEndian.Uint32(payload[off:])
+			if int(ref) > dictSize {
+				return nil, ErrCorruptedRecord
+			}
+			col.Refs[j] = ref
+			off += columnRefSize
+		}
+	}
+
+	return index.NewColumnsEntry(columns), nil
+}
@@ -207,6 +207,8 @@ type entryEncoder struct {
 	ceEnc    ceEntryEncoder
 	endEnc   endEntryEncoder
 	indexEnc indexEntryEncoder
+	colEnc   columnsEntryEncoder
+	bloomEnc bloomEntryEncoder
 }
 
 // Make sure entryEncoder implements RecordDataEncoder.
@@ -220,6 +222,10 @@ func (e *entryEncoder) Size(entry block.Entry) int {
 		return e.endEnc.Size(entry)
 	case ceschema.Index:
 		return e.indexEnc.Size(entry)
+	case ceschema.AttributeIndex:
+		return e.colEnc.Size(entry)
+	case ceschema.BloomIndex:
+		return e.bloomEnc.Size(entry)
 	}
 	return -1
 }
@@ -232,12 +238,18 @@ func (e *entryEncoder) MarshalTo(entry block.Entry, buf []byte) (int, int, error
 		return e.endEnc.MarshalTo(entry, buf)
 	case ceschema.Index:
 		return e.indexEnc.MarshalTo(entry, buf)
+	case ceschema.AttributeIndex:
+		return e.colEnc.MarshalTo(entry, buf)
+	case ceschema.BloomIndex:
+		return e.bloomEnc.MarshalTo(entry, buf)
 	}
 	return 0, 0, ErrUnknownRecord
 }
 
 type entryDecoder struct {
 	indexDec indexEntryDecoder
+	colDec   columnsEntryDecoder
+	bloomDec bloomEntryDecoder
 }
 
 // Make sure entryDecoder implements RecordDataDecoder.
@@ -249,6 +261,10 @@ func (d *entryDecoder) Unmarshal(t uint16, offset int, data []byte) (block.Entry
 		return &entry{t: t, data: data[offset:]}, nil
 	case ceschema.Index:
 		return d.indexDec.Unmarshal(t, offset, data)
+	case ceschema.AttributeIndex:
+		return d.colDec.Unmarshal(t, offset, data)
+	case ceschema.BloomIndex:
+		return d.bloomDec.Unmarshal(t, offset, data)
 	}
 	return nil, ErrUnknownRecord
 }
@@ -64,6 +64,43 @@ func (e *packetEncoder) MarshalTo(ctx context.Context, entry block.Entry, buf []
 	_, span := e.tracer.Start(ctx, "MarshalTo")
 	defer span.End()
 
+	return e.marshal(entry, buf)
+}
+
+func (e *packetEncoder) SizeBatch(entries []block.Entry, offsets []int) []int {
+	off := 0
+	for _, entry := range entries {
+		offsets = append(offsets, off)
+		off += packetMetaSize + e.pde.Size(entry)
+	}
+	return append(offsets, off)
+}
+
+func (e *packetEncoder) MarshalBatch(
+	ctx context.Context, entries []block.Entry, offsets []int, buf []byte,
+) error {
+	_, span := e.tracer.Start(ctx, "MarshalBatch")
+	defer span.End()
+
+	if len(offsets) != len(entries)+1 {
+		return ErrInvalid
+	}
+	if len(buf) < offsets[len(entries)] {
+		return ErrBufferNotEnough
+	}
+	for i, entry := range entries {
+		n, err := e.marshal(entry, buf[offsets[i]:offsets[i+1]])
+		if err != nil {
+			return err
+		}
+		if n != offsets[i+1]-offsets[i] {
+			return ErrInvalid
+		}
+	}
+	return nil
+}
+
+func (e *packetEncoder) marshal(entry block.Entry, buf []byte) (int, error) {
 	n, err := e.pde.MarshalTo(entry, buf[packetPayloadOffset:])
 	if err != nil {
 		return 0, err
@@ -17,9 +17,9 @@
 //
 // The layout of `File` is:
 //
-//	┌──────────┬───────────────┬─────────────────┬───────────────────┐
-//	│  Header  │  Entries ...  │  [ End Entry ]  │  [ Index Entry ]  │
-//	└──────────┴───────────────┴─────────────────┴───────────────────┘
+//	┌──────────┬───────────────┬─────────────────┬───────────────────┬─────────────────────────────┬─────────────────────────┐
+//	│  Header  │  Entries ...  │  [ End Entry ]  │  [ Index Entry ]  │  [ Attribute Index Entry ]  │  [ Bloom Index Entry ]  │
+//	└──────────┴───────────────┴─────────────────┴───────────────────┴─────────────────────────────┴─────────────────────────┘
 //
 // The layout of `Header` is:
 //
@@ -35,11 +35,11 @@
 //
 //	+00 4B Magic number (0x00627376, "vsb" in ASCII)
 //	+04 4B CRC-32c of header block
-//	+08 4B Flags
+//	+08 4B Flags (bit 0: Attribute Index Entry is persisted, bit 1: Bloom Index Entry is persisted)
 //	+0C 4B Break Flags
 //	+10 4B Data Offset (in bytes, currently 4096)
 //	+14 1B State (0: working, 1: archived)
-//	+15 1B Reserved
+//	+15 1B Format Version (0: legacy, 1: current)
 //	+16 2B Index Size (in bytes, currently 24)
 //	+18 8B Capacity (in bytes)
 //	+20 8B Entry Length (in bytes)
@@ -122,4 +122,42 @@
 //	+08 4B Length (in bytes)
 //	+0C 4B Reserved (all 0)
 //	+10 8B Stime
+//
+// The layout of `Attribute Index` is:
+//
+//	┌─────────────────┬─────────────────────────────────────────────────────┐
+//	│  Column Num(2)  │                     Columns ...                     │
+//	└─────────────────┴─────────────────────────────────────────────────────┘
+//
+// The layout of `Column` is:
+//
+//	┌─────────────────┬───────────────────────────────────┬─────────────────┐
+//	│    Ordinal(2)   │            Dict Size(4)           │                 ;
+//	├─────────────────┴─────────────────┬─────────────────┴─────────────────┤
+//	;    Entry Num(4)                   │            Dict Values ...        │
+//	├───────────────────────────────────┴───────────────────────────────────┤
+//	│                             Value Refs ...                            │
+//	└───────────────────────────────────────────────────────────────────────┘
+//
+// All values little-endian
+//
+//	+00 2B Ordinal of attribute
+//	+02 4B Dict Size (number of distinct values)
+//	+06 4B Entry Num
+//	+0A    Dict Values, each is 4B length followed by the value
+//	       Value Refs, each is 4B position in dict plus one of the value of entry, 0 means null
+//
+// The layout of `Bloom Index` is:
+//
+//	┌───────────────────────────────────┬───────────────────────────────────┐
+//	│             Hashes(4)             │              Words(4)             │
+//	├───────────────────────────────────┴───────────────────────────────────┤
+//	│                               Bits ...                                │
+//	└───────────────────────────────────────────────────────────────────────┘
+//
+// All values little-endian
+//
+//	+00 4B Hashes (number of hash functions)
+//	+04 4B Words (number of 8B words of bits)
+//	+08    Bits, the bloom filter of ids of entries
 package vsb
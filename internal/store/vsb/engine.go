@@ -18,6 +18,9 @@ import (
 	// standard libraries.
 	"os"
 
+	// first-party.
+	"github.com/linkall-labs/vanus/pkg/util"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
@@ -29,8 +32,72 @@ const (
 )
 
 type engine struct {
-	dir string
-	lis block.ArchivedListener
+	dir    string
+	volume string
+	lis    block.ArchivedListener
+	// indexAttributes enables the attribute index of blocks.
+	indexAttributes bool
+	// bloomFPR is the false positive rate of bloom filters of blocks, 0 disables them.
+	bloomFPR float64
+	// autoFields are the extra auto fields of each schema.
+	autoFields autoFieldPipeline
+	// maxEntrySize and maxFragmentSize limit the encoded size of entries appended to blocks.
+	maxEntrySize    int
+	maxFragmentSize int
+	// clock stamps the stime of entries appended to blocks.
+	clock util.Clock
+}
+
+type Option func(*engine)
+
+// WithVolume sets the volume label of metrics reported by blocks.
+func WithVolume(volume string) Option {
+	return func(e *engine) {
+		e.volume = volume
+	}
+}
+
+// WithAttributeIndex builds the columnar index of attributes when blocks are archived, so that
+// the entries can be filtered by attributes without being decoded.
+func WithAttributeIndex() Option {
+	return func(e *engine) {
+		e.indexAttributes = true
+	}
+}
+
+// WithBloomFilter builds the bloom filter of ids when blocks are archived, whose false positive rate
+// is fpr, so that the blocks which don't contain an id can be skipped.
+func WithBloomFilter(fpr float64) Option {
+	return func(e *engine) {
+		e.bloomFPR = fpr
+	}
+}
+
+// WithAutoFields fills fields of entries of schema t when they are appended, after the sequence number
+// and stime which are always filled.
+func WithAutoFields(t uint16, fields ...AutoField) Option {
+	return func(e *engine) {
+		if e.autoFields == nil {
+			e.autoFields = make(autoFieldPipeline)
+		}
+		e.autoFields[t] = append(e.autoFields[t], fields...)
+	}
+}
+
+// WithSizeLimits rejects the appends whose encoded entry is larger than maxEntry, or whose entries are
+// larger than maxFragment in total, by errors.ErrEventTooLarge. 0 means no limit.
+func WithSizeLimits(maxEntry, maxFragment int) Option {
+	return func(e *engine) {
+		e.maxEntrySize = maxEntry
+		e.maxFragmentSize = maxFragment
+	}
+}
+
+// WithClock sets the clock which stamps the stime of entries, it's the system time by default.
+func WithClock(clock util.Clock) Option {
+	return func(e *engine) {
+		e.clock = clock
+	}
 }
 
 // Make sure engine implements raw.Engine.
@@ -45,14 +112,29 @@ func (e *engine) GetBlockStatistics(id vanus.ID, r block.Raw) (block.Statistics,
 	return block.Statistics{}, nil
 }
 
-func Initialize(dir string, lis block.ArchivedListener) error {
+func Initialize(dir string, lis block.ArchivedListener, opts ...Option) error {
+	e, err := NewEngine(dir, lis, opts...)
+	if err != nil {
+		return err
+	}
+	return raw.RegisterEngine(raw.VSB, e)
+}
+
+// NewEngine creates the engine of blocks in dir without registering it, Initialize registers the engine
+// used by store.
+func NewEngine(dir string, lis block.ArchivedListener, opts ...Option) (raw.Engine, error) {
 	// Make sure the block directory exists.
 	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
-		return err
+		return nil, err
 	}
 
-	return raw.RegisterEngine(raw.VSB, &engine{
-		dir: dir,
-		lis: lis,
-	})
+	e := &engine{
+		dir:   dir,
+		lis:   lis,
+		clock: util.RealClock,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
 }
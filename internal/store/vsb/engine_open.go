@@ -56,6 +56,7 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 		id:         id,
 		path:       path,
 		capacity:   capacity,
+		format:     FormatVersion,
 		dataOffset: headerBlockSize,
 		indexSize:  codec.IndexSize,
 		fm: meta{
@@ -64,11 +65,18 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 		actx: appendContext{
 			offset: headerBlockSize,
 		},
-		enc:    codec.NewEncoder(),
-		dec:    dec,
-		lis:    e.lis,
-		f:      f,
-		tracer: tracing.NewTracer("store.vsb.vsBlock", trace.SpanKindInternal),
+		enc:             codec.NewEncoder(),
+		dec:             dec,
+		lis:             e.lis,
+		indexAttributes: e.indexAttributes,
+		bloomFPR:        e.bloomFPR,
+		autoFields:      e.autoFields,
+		maxEntrySize:    e.maxEntrySize,
+		maxFragmentSize: e.maxFragmentSize,
+		clock:           e.clock,
+		f:               f,
+		tracer:          tracing.NewTracer("store.vsb.vsBlock", trace.SpanKindInternal),
+		metrics:         newBlockMetrics(e.volume, id),
 	}
 
 	if err := b.persistHeader(ctx, b.fm); err != nil {
@@ -92,15 +100,23 @@ func (e *engine) Open(ctx context.Context, id vanus.ID) (block.Raw, error) {
 	path := e.resolvePath(id)
 
 	b := &vsBlock{
-		id:     id,
-		path:   path,
-		lis:    e.lis,
-		tracer: tracing.NewTracer("store.vsb.vsBlock", trace.SpanKindInternal),
+		id:              id,
+		path:            path,
+		lis:             e.lis,
+		indexAttributes: e.indexAttributes,
+		bloomFPR:        e.bloomFPR,
+		autoFields:      e.autoFields,
+		maxEntrySize:    e.maxEntrySize,
+		maxFragmentSize: e.maxFragmentSize,
+		clock:           e.clock,
+		tracer:          tracing.NewTracer("store.vsb.vsBlock", trace.SpanKindInternal),
+		metrics:         newBlockMetrics(e.volume, id),
 	}
 
 	if err := b.Open(ctx); err != nil {
 		return nil, err
 	}
+	b.metrics.setIndexes(len(b.indexes))
 
 	return b, nil
 }
@@ -15,20 +15,18 @@
 package vsb
 
 import (
+	// standard libraries.
+	"bytes"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
 	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
 )
 
-const (
-	addedOptCount = 2
-)
-
 type entryExtWrapper struct {
 	block.EntryExtWrapper
-	t     uint16
-	seq   int64
-	stime int64
+	t      uint16
+	fields *AutoFields
 }
 
 // Make sure entryWrapper implements block.Entry.
@@ -42,34 +40,57 @@ func (w *entryExtWrapper) GetUint16(ordinal int) uint16 {
 }
 
 func (w *entryExtWrapper) GetInt64(ordinal int) int64 {
-	switch ordinal {
-	case ceschema.SequenceNumberOrdinal:
-		return w.seq
-	case ceschema.StimeOrdinal:
-		return w.stime
+	if v, ok := w.fields.int64(ordinal); ok {
+		return v
 	}
 	return w.EntryExtWrapper.GetInt64(ordinal)
 }
 
 func (w *entryExtWrapper) RangeOptionalAttributes(cb block.OptionalAttributeCallback) {
-	cb.OnInt64(ceschema.SequenceNumberOrdinal, w.seq)
-	cb.OnInt64(ceschema.StimeOrdinal, w.stime)
+	for _, f := range w.fields.ints {
+		cb.OnInt64(f.ordinal, f.val)
+	}
 	w.EntryExtWrapper.RangeOptionalAttributes(cb)
 }
 
 func (w *entryExtWrapper) OptionalAttributeCount() int {
-	return addedOptCount + w.EntryExtWrapper.OptionalAttributeCount()
+	return len(w.fields.ints) + w.EntryExtWrapper.OptionalAttributeCount()
+}
+
+func (w *entryExtWrapper) GetExtensionAttribute(attr []byte) []byte {
+	if v := w.fields.extension(attr); v != nil {
+		return v
+	}
+	return w.EntryExtWrapper.GetExtensionAttribute(attr)
+}
+
+// RangeExtensionAttributes merges the auto-filled extension attributes into the ones of entry in order.
+func (w *entryExtWrapper) RangeExtensionAttributes(cb block.ExtensionAttributeCallback) {
+	exts := w.fields.exts
+	w.EntryExtWrapper.RangeExtensionAttributes(block.OnExtensionAttributeFunc(func(attr, val []byte) {
+		for len(exts) > 0 && bytes.Compare(exts[0].attr, attr) < 0 {
+			cb.OnAttribute(exts[0].attr, exts[0].val)
+			exts = exts[1:]
+		}
+		cb.OnAttribute(attr, val)
+	}))
+	for _, e := range exts {
+		cb.OnAttribute(e.attr, e.val)
+	}
+}
+
+func (w *entryExtWrapper) ExtensionAttributeCount() int {
+	return len(w.fields.exts) + w.EntryExtWrapper.ExtensionAttributeCount()
 }
 
-func wrapEntry(e block.Entry, t uint16, seq int64, stime int64) block.Entry {
-	if ext, ok := e.(block.EntryExt); ok {
+func (b *vsBlock) wrapEntry(fc *FieldContext) block.Entry {
+	if ext, ok := fc.Entry.(block.EntryExt); ok {
 		return &entryExtWrapper{
 			EntryExtWrapper: block.EntryExtWrapper{
 				E: ext,
 			},
-			t:     t,
-			seq:   seq,
-			stime: stime,
+			t:      fc.Type,
+			fields: b.autoFields.fill(fc),
 		}
 	}
 	// TODO(james.yin): entry wrapper
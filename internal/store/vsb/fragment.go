@@ -18,6 +18,7 @@ import (
 	// standard libraries.
 	"context"
 	"encoding/binary"
+	"time"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
@@ -34,7 +35,10 @@ type fragment struct {
 	offset  int64
 	entries []block.Entry
 	enc     codec.EntryEncoder
+	// offsets of entries in payload, which are computed once for both sizing and encoding.
+	offsets []int
 	data    []byte
+	metrics *blockMetrics
 }
 
 // Make sure fragment implements block.Fragment and block.FragmentMarshaler.
@@ -88,22 +92,21 @@ func (f *fragment) MarshalFragment(ctx context.Context) ([]byte, error) {
 }
 
 func (f *fragment) size() int {
-	var sz int
-	for _, entry := range f.entries {
-		sz += f.enc.Size(entry)
+	if f.offsets == nil {
+		f.offsets = f.enc.SizeBatch(f.entries, make([]int, 0, len(f.entries)+1))
 	}
-	return sz
+	return f.offsets[len(f.entries)]
 }
 
 func (f *fragment) doMarshal(ctx context.Context) ([]byte, error) {
+	defer f.metrics.observeEncode(time.Now())
+
 	data := make([]byte, OffsetSize+f.size())
 
 	binary.LittleEndian.PutUint64(data, uint64(f.offset))
 
-	off := PayloadOffset
-	for _, entry := range f.entries {
-		n, _ := f.enc.MarshalTo(ctx, entry, data[off:])
-		off += n
+	if err := f.enc.MarshalBatch(ctx, f.entries, f.offsets, data[PayloadOffset:]); err != nil {
+		return nil, err
 	}
 
 	return data, nil
@@ -120,12 +120,11 @@ func getEntry() block.Entry {
 	}
 	e := convert.ToEntry(ce)
 
-	return &entryExtWrapper{
-		EntryExtWrapper: block.EntryExtWrapper{
-			E: e,
-		},
-		t:     ceschema.CloudEvent,
-		seq:   111,
-		stime: time.Now().UnixMilli(),
-	}
+	b := &vsBlock{}
+	return b.wrapEntry(&FieldContext{
+		Entry: e,
+		Type:  ceschema.CloudEvent,
+		Seq:   111,
+		Stime: time.Now().UnixMilli(),
+	})
 }
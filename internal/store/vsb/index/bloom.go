@@ -0,0 +1,119 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	// standard libraries.
+	"hash/fnv"
+	"math"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+const (
+	minBloomBits   = 64
+	maxBloomHashes = 16
+)
+
+// Bloom is the bloom filter of ids of entries.
+type Bloom struct {
+	// Hashes is the number of hash functions.
+	Hashes uint32
+	Bits   []uint64
+}
+
+// NewBloom returns an empty bloom filter for n ids, whose false positive rate is fpr.
+func NewBloom(n int, fpr float64) *Bloom {
+	if n < 1 {
+		n = 1
+	}
+	m := int(math.Ceil(-float64(n) * math.Log(fpr) / (math.Ln2 * math.Ln2)))
+	if m < minBloomBits {
+		m = minBloomBits
+	}
+	k := int(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	} else if k > maxBloomHashes {
+		k = maxBloomHashes
+	}
+	return &Bloom{
+		Hashes: uint32(k),
+		Bits:   make([]uint64, (m+63)/64),
+	}
+}
+
+func (b *Bloom) Add(id string) {
+	h1, h2 := bloomHash(id)
+	m := uint64(len(b.Bits)) * 64
+	for i := uint64(0); i < uint64(b.Hashes); i++ {
+		pos := (h1 + i*h2) % m
+		b.Bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+// MayContain returns false if id was never added, it may return true for an id which wasn't added.
+func (b *Bloom) MayContain(id string) bool {
+	m := uint64(len(b.Bits)) * 64
+	if m == 0 {
+		return true
+	}
+	h1, h2 := bloomHash(id)
+	for i := uint64(0); i < uint64(b.Hashes); i++ {
+		pos := (h1 + i*h2) % m
+		if b.Bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHash derives the hash functions from the two halves of a 64-bit hash.
+func bloomHash(id string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id))
+	sum := h.Sum64()
+	return sum & math.MaxUint32, sum>>32 | 1
+}
+
+type bloomEntry struct {
+	block.EmptyEntryExt
+	bloom *Bloom
+}
+
+// Make sure bloomEntry implements block.EntryExt.
+var _ block.EntryExt = (*bloomEntry)(nil)
+
+func NewBloomEntry(bloom *Bloom) block.Entry {
+	return &bloomEntry{
+		bloom: bloom,
+	}
+}
+
+func (e *bloomEntry) Get(ordinal int) interface{} {
+	if ordinal == ceschema.BloomOrdinal {
+		return e.bloom
+	}
+	return e.EmptyEntry.Get(ordinal)
+}
+
+func (e *bloomEntry) GetUint16(ordinal int) uint16 {
+	if ordinal == ceschema.EntryTypeOrdinal {
+		return ceschema.BloomIndex
+	}
+	return e.EmptyEntry.GetUint16(ordinal)
+}
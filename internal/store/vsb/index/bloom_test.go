@@ -0,0 +1,54 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index_test
+
+import (
+	// standard libraries.
+	"strconv"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+func TestBloom(t *testing.T) {
+	Convey("bloom filter", t, func() {
+		const n = 1000
+		bloom := index.NewBloom(n, 0.01)
+		So(bloom.Hashes, ShouldEqual, 7)
+		for i := 0; i < n; i++ {
+			bloom.Add(strconv.Itoa(i))
+		}
+		for i := 0; i < n; i++ {
+			So(bloom.MayContain(strconv.Itoa(i)), ShouldBeTrue)
+		}
+
+		fp := 0
+		for i := n; i < 2*n; i++ {
+			if bloom.MayContain(strconv.Itoa(i)) {
+				fp++
+			}
+		}
+		So(fp, ShouldBeLessThan, n*5/100)
+
+		ent := index.NewBloomEntry(bloom)
+		So(ceschema.EntryType(ent), ShouldEqual, ceschema.BloomIndex)
+		So(ent.Get(ceschema.BloomOrdinal), ShouldEqual, bloom)
+	})
+}
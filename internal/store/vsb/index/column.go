@@ -0,0 +1,110 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+// IndexedAttributes are the attributes indexed by columns, and their ordinals in entry.
+var IndexedAttributes = map[string]int{
+	"type":    ceschema.TypeOrdinal,
+	"source":  ceschema.SourceOrdinal,
+	"subject": ceschema.SubjectOrdinal,
+}
+
+// Column is the values of an attribute of entries in sequence, the values are encoded by dictionary.
+type Column struct {
+	Ordinal int
+	// Dict is the distinct values of the attribute.
+	Dict []string
+	// Refs are the references of values, Refs[i] is the position in Dict plus one of the value of
+	// the entry i, 0 means the attribute of the entry is null.
+	Refs []uint32
+}
+
+// Value returns the value of the entry at seq, a null value is empty. It returns false if seq is
+// out of range.
+func (c *Column) Value(seq int) (string, bool) {
+	if seq < 0 || seq >= len(c.Refs) {
+		return "", false
+	}
+	if ref := c.Refs[seq]; ref != 0 {
+		return c.Dict[ref-1], true
+	}
+	return "", true
+}
+
+type ColumnBuilder struct {
+	col  Column
+	refs map[string]uint32
+}
+
+func NewColumnBuilder(ordinal int) *ColumnBuilder {
+	return &ColumnBuilder{
+		col:  Column{Ordinal: ordinal},
+		refs: map[string]uint32{},
+	}
+}
+
+// Add appends the value of entry, the entries must be added in sequence.
+func (b *ColumnBuilder) Add(entry block.Entry) {
+	v := entry.GetString(b.col.Ordinal)
+	if v == "" {
+		b.col.Refs = append(b.col.Refs, 0)
+		return
+	}
+	ref, ok := b.refs[v]
+	if !ok {
+		b.col.Dict = append(b.col.Dict, v)
+		ref = uint32(len(b.col.Dict))
+		b.refs[v] = ref
+	}
+	b.col.Refs = append(b.col.Refs, ref)
+}
+
+func (b *ColumnBuilder) Build() Column {
+	return b.col
+}
+
+type columnsEntry struct {
+	block.EmptyEntryExt
+	columns []Column
+}
+
+// Make sure columnsEntry implements block.EntryExt.
+var _ block.EntryExt = (*columnsEntry)(nil)
+
+func NewColumnsEntry(columns []Column) block.Entry {
+	return &columnsEntry{
+		columns: columns,
+	}
+}
+
+func (e *columnsEntry) Get(ordinal int) interface{} {
+	if ordinal == ceschema.ColumnsOrdinal {
+		return e.columns
+	}
+	return e.EmptyEntry.Get(ordinal)
+}
+
+func (e *columnsEntry) GetUint16(ordinal int) uint16 {
+	if ordinal == ceschema.EntryTypeOrdinal {
+		return ceschema.AttributeIndex
+	}
+	return e.EmptyEntry.GetUint16(ordinal)
+}
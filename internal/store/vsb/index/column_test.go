@@ -0,0 +1,59 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index_test
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+func TestColumn(t *testing.T) {
+	Convey("build column", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		builder := index.NewColumnBuilder(ceschema.SubjectOrdinal)
+		builder.Add(cetest.MakeStoredEntry0(ctrl))
+		builder.Add(cetest.MakeStoredEntry1(ctrl))
+		builder.Add(cetest.MakeStoredEntry1(ctrl))
+		col := builder.Build()
+
+		So(col.Ordinal, ShouldEqual, ceschema.SubjectOrdinal)
+		So(col.Dict, ShouldResemble, []string{"ce-subject"})
+		So(col.Refs, ShouldResemble, []uint32{0, 1, 1})
+
+		v, ok := col.Value(0)
+		So(ok, ShouldBeTrue)
+		So(v, ShouldBeEmpty)
+		v, ok = col.Value(2)
+		So(ok, ShouldBeTrue)
+		So(v, ShouldEqual, "ce-subject")
+		_, ok = col.Value(3)
+		So(ok, ShouldBeFalse)
+
+		ent := index.NewColumnsEntry([]index.Column{col})
+		So(ceschema.EntryType(ent), ShouldEqual, ceschema.AttributeIndex)
+		So(ent.Get(ceschema.ColumnsOrdinal), ShouldResemble, []index.Column{col})
+	})
+}
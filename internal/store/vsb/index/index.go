@@ -16,6 +16,9 @@
 package index
 
 import (
+	// standard libraries.
+	"unsafe"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
 	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
@@ -28,6 +31,10 @@ type Index interface {
 	Stime() int64
 }
 
+// MemorySize is the approximate number of bytes an Index occupies in memory,
+// including the interface value referencing it.
+const MemorySize = int(unsafe.Sizeof(index{})) + int(unsafe.Sizeof(Index(nil)))
+
 type Option func(*index)
 
 func WithEntry(entry block.Entry) Option {
@@ -0,0 +1,161 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"time"
+
+	// third-party libraries.
+	"github.com/prometheus/client_golang/prometheus"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/metrics"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+// blockMetrics holds the metric children of a vsBlock. A nil *blockMetrics
+// records nothing, so blocks built without an engine stay usable.
+type blockMetrics struct {
+	volume string
+	block  string
+
+	encode    prometheus.Observer
+	writeAt   prometheus.Observer
+	index     prometheus.Observer
+	read      prometheus.Observer
+	batchNum  prometheus.Observer
+	batchSize prometheus.Observer
+	indexMem  prometheus.Gauge
+	archived  prometheus.Counter
+
+	skipped       prometheus.Counter
+	skippedBytes  prometheus.Counter
+	missing       prometheus.Counter
+	discontinuous prometheus.Counter
+}
+
+func newBlockMetrics(volume string, id vanus.ID) *blockMetrics {
+	blk := id.String()
+	return &blockMetrics{
+		volume: volume,
+		block:  blk,
+		encode: metrics.StoreAppendStageSecond.WithLabelValues(
+			volume, blk, metrics.LabelValueStageEncode),
+		writeAt: metrics.StoreAppendStageSecond.WithLabelValues(
+			volume, blk, metrics.LabelValueStageWriteAt),
+		index: metrics.StoreAppendStageSecond.WithLabelValues(
+			volume, blk, metrics.LabelValueStageIndex),
+		read:      metrics.StoreReadSecond.WithLabelValues(volume, blk),
+		batchNum:  metrics.StoreFragmentBatchNumber.WithLabelValues(volume, blk),
+		batchSize: metrics.StoreFragmentBatchByte.WithLabelValues(volume, blk),
+		indexMem:  metrics.StoreIndexMemoryGaugeVec.WithLabelValues(volume, blk),
+		archived:  metrics.StoreBlockArchivedCounterVec.WithLabelValues(volume),
+
+		skipped:      metrics.StoreFragmentSkippedCounterVec.WithLabelValues(volume, blk),
+		skippedBytes: metrics.StoreFragmentSkippedByteCounterVec.WithLabelValues(volume, blk),
+		missing: metrics.StoreFragmentDiscontinuityCounterVec.WithLabelValues(
+			volume, blk, metrics.LabelValueFragmentMissing),
+		discontinuous: metrics.StoreFragmentDiscontinuityCounterVec.WithLabelValues(
+			volume, blk, metrics.LabelValueFragmentDiscontinuous),
+	}
+}
+
+func (m *blockMetrics) observeEncode(start time.Time) {
+	if m != nil {
+		m.encode.Observe(time.Since(start).Seconds())
+	}
+}
+
+func (m *blockMetrics) observeWriteAt(start time.Time) {
+	if m != nil {
+		m.writeAt.Observe(time.Since(start).Seconds())
+	}
+}
+
+func (m *blockMetrics) observeIndex(start time.Time) {
+	if m != nil {
+		m.index.Observe(time.Since(start).Seconds())
+	}
+}
+
+func (m *blockMetrics) observeRead(start time.Time) {
+	if m != nil {
+		m.read.Observe(time.Since(start).Seconds())
+	}
+}
+
+func (m *blockMetrics) observeBatch(num, size int) {
+	if m != nil {
+		m.batchNum.Observe(float64(num))
+		m.batchSize.Observe(float64(size))
+	}
+}
+
+func (m *blockMetrics) setIndexes(num int) {
+	if m != nil {
+		m.indexMem.Set(float64(num * index.MemorySize))
+	}
+}
+
+func (m *blockMetrics) incArchived() {
+	if m != nil {
+		m.archived.Inc()
+	}
+}
+
+func (m *blockMetrics) incSkipped(size int) {
+	if m != nil {
+		m.skipped.Inc()
+		m.skippedBytes.Add(float64(size))
+	}
+}
+
+func (m *blockMetrics) incMissing() {
+	if m != nil {
+		m.missing.Inc()
+	}
+}
+
+func (m *blockMetrics) incDiscontinuous() {
+	if m != nil {
+		m.discontinuous.Inc()
+	}
+}
+
+// release drops the per-block children, so deleted blocks do not linger in
+// the exported series.
+func (m *blockMetrics) release() {
+	if m == nil {
+		return
+	}
+	for _, stage := range []string{
+		metrics.LabelValueStageEncode, metrics.LabelValueStageWriteAt, metrics.LabelValueStageIndex,
+	} {
+		metrics.StoreAppendStageSecond.DeleteLabelValues(m.volume, m.block, stage)
+	}
+	metrics.StoreReadSecond.DeleteLabelValues(m.volume, m.block)
+	metrics.StoreFragmentBatchNumber.DeleteLabelValues(m.volume, m.block)
+	metrics.StoreFragmentBatchByte.DeleteLabelValues(m.volume, m.block)
+	metrics.StoreIndexMemoryGaugeVec.DeleteLabelValues(m.volume, m.block)
+	metrics.StoreFragmentSkippedCounterVec.DeleteLabelValues(m.volume, m.block)
+	metrics.StoreFragmentSkippedByteCounterVec.DeleteLabelValues(m.volume, m.block)
+	for _, typ := range []string{metrics.LabelValueFragmentMissing, metrics.LabelValueFragmentDiscontinuous} {
+		metrics.StoreFragmentDiscontinuityCounterVec.DeleteLabelValues(m.volume, m.block, typ)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"testing"
+	"time"
+
+	// third-party libraries.
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/metrics"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+func TestBlockMetrics(t *testing.T) {
+	Convey("nil block metrics", t, func() {
+		var m *blockMetrics
+		So(func() {
+			m.observeEncode(time.Now())
+			m.observeWriteAt(time.Now())
+			m.observeIndex(time.Now())
+			m.observeRead(time.Now())
+			m.observeBatch(1, 1)
+			m.setIndexes(1)
+			m.incArchived()
+			m.incSkipped(1)
+			m.incMissing()
+			m.incDiscontinuous()
+			m.release()
+		}, ShouldNotPanic)
+	})
+
+	Convey("block metrics", t, func() {
+		id := vanus.NewTestID()
+		m := newBlockMetrics("test-volume", id)
+		defer m.release()
+
+		m.setIndexes(3)
+		So(testutil.ToFloat64(metrics.StoreIndexMemoryGaugeVec.WithLabelValues("test-volume", id.String())),
+			ShouldEqual, 3*index.MemorySize)
+
+		before := testutil.ToFloat64(metrics.StoreBlockArchivedCounterVec.WithLabelValues("test-volume"))
+		m.incArchived()
+		So(testutil.ToFloat64(metrics.StoreBlockArchivedCounterVec.WithLabelValues("test-volume")),
+			ShouldEqual, before+1)
+
+		m.observeBatch(2, 128)
+		m.observeWriteAt(time.Now())
+		So(testutil.CollectAndCount(metrics.StoreFragmentBatchNumber), ShouldBeGreaterThan, 0)
+		So(testutil.CollectAndCount(metrics.StoreAppendStageSecond), ShouldBeGreaterThan, 0)
+
+		m.incSkipped(64)
+		m.incSkipped(32)
+		m.incDiscontinuous()
+		So(testutil.ToFloat64(metrics.StoreFragmentSkippedCounterVec.WithLabelValues("test-volume", id.String())),
+			ShouldEqual, 2)
+		So(testutil.ToFloat64(metrics.StoreFragmentSkippedByteCounterVec.WithLabelValues("test-volume", id.String())),
+			ShouldEqual, 96)
+		So(testutil.ToFloat64(metrics.StoreFragmentDiscontinuityCounterVec.WithLabelValues(
+			"test-volume", id.String(), metrics.LabelValueFragmentDiscontinuous)), ShouldEqual, 1)
+		So(testutil.ToFloat64(metrics.StoreFragmentDiscontinuityCounterVec.WithLabelValues(
+			"test-volume", id.String(), metrics.LabelValueFragmentMissing)), ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const legacyFormatVersion = uint8(0)
+
+// upgraders rewrite a block of the format version to the next version.
+var upgraders = map[uint8]func(ctx context.Context, b *vsBlock) error{
+	// The layout of version 1 is the same as the legacy one, only the format version is stamped.
+	legacyFormatVersion: func(ctx context.Context, b *vsBlock) error {
+		return nil
+	},
+}
+
+func isReadableFormat(format uint8) bool {
+	return format == FormatVersion || format+1 == FormatVersion
+}
+
+// Upgrade rewrites the blocks in dir to the current format version in place, it returns the number
+// of upgraded blocks. It must be called offline, when the store isn't running.
+func Upgrade(ctx context.Context, dir string) (int, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	files = filterRegularBlock(files)
+
+	e := &engine{dir: dir}
+	upgraded := 0
+	for _, file := range files {
+		filename := file.Name()
+		id, err := vanus.NewIDFromString(filename[:len(filename)-len(vsbExt)])
+		if err != nil {
+			return upgraded, err
+		}
+		ok, err := e.upgrade(ctx, id)
+		if err != nil {
+			return upgraded, err
+		}
+		if ok {
+			logger.Info(ctx, "The block has been upgraded.",
+				log.Stringer("block_id", id),
+				log.Any("format_version", FormatVersion),
+			)
+			upgraded++
+		}
+	}
+	return upgraded, nil
+}
+
+func (e *engine) upgrade(ctx context.Context, id vanus.ID) (bool, error) {
+	r, err := e.Open(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	b, _ := r.(*vsBlock)
+	defer func() {
+		_ = b.f.Close()
+	}()
+
+	if b.format == FormatVersion {
+		return false, nil
+	}
+	for b.format < FormatVersion {
+		upgrade, ok := upgraders[b.format]
+		if !ok {
+			return false, errUnsupportedFormat
+		}
+		if err = upgrade(ctx, b); err != nil {
+			return false, err
+		}
+		b.format++
+	}
+	if err = b.persistHeader(ctx, b.fm); err != nil {
+		return false, err
+	}
+	return true, nil
+}
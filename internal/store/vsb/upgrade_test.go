@@ -0,0 +1,90 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func TestUpgrade(t *testing.T) {
+	Convey("upgrade legacy vsb", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+		id := vanus.NewTestID()
+		path := filepath.Join(dir, id.String()+vsbExt)
+
+		f, err := os.Create(path)
+		So(err, ShouldBeNil)
+		// The test data is in the legacy format.
+		_, err = f.WriteAt(vsbtest.ArchivedHeaderData, 0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EndEntryData, vsbtest.EndEntryOffset)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.IndexEntryData, vsbtest.IndexEntryOffset)
+		So(err, ShouldBeNil)
+		So(f.Close(), ShouldBeNil)
+
+		b := &vsBlock{path: path}
+		So(b.Open(ctx), ShouldBeNil)
+		So(b.format, ShouldEqual, legacyFormatVersion)
+		So(b.f.Close(), ShouldBeNil)
+
+		n, err := Upgrade(ctx, dir)
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, 1)
+
+		b = &vsBlock{path: path}
+		So(b.Open(ctx), ShouldBeNil)
+		So(b.format, ShouldEqual, FormatVersion)
+		stat := b.status()
+		So(stat.Archived, ShouldBeTrue)
+		So(stat.EntryNum, ShouldEqual, 2)
+		So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0+vsbtest.EntrySize1)
+		So(b.indexes, ShouldHaveLength, 2)
+		So(b.f.Close(), ShouldBeNil)
+
+		n, err = Upgrade(ctx, dir)
+		So(err, ShouldBeNil)
+		So(n, ShouldEqual, 0)
+
+		Convey("open vsb of unsupported format", func() {
+			f, err := os.OpenFile(path, os.O_RDWR, 0)
+			So(err, ShouldBeNil)
+			_, err = f.WriteAt([]byte{FormatVersion + 1}, formatOffset)
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+
+			b = &vsBlock{path: path}
+			So(b.Open(ctx), ShouldEqual, errUnsupportedFormat)
+			_, err = Upgrade(ctx, dir)
+			So(err, ShouldEqual, errUnsupportedFormat)
+		})
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	// standard libraries.
+	stderr "errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// FormatVersion is the version of the format of log files written by WAL. The log files of the
+	// previous version are still readable, Upgrade rewrites them to the current version.
+	FormatVersion = 1
+
+	// legacyFormatVersion is the version of WAL created before the format version is stamped.
+	legacyFormatVersion = 0
+	formatFile          = "FORMAT"
+	defaultFilePerm     = 0o644
+)
+
+var ErrUnsupportedFormat = stderr.New("WAL: unsupported format version")
+
+func isReadableFormat(version int) bool {
+	return version == FormatVersion || version+1 == FormatVersion
+}
+
+// checkFormat checks the format version of WAL in dir, and stamps the current version if WAL is new.
+func checkFormat(dir string, empty bool) error {
+	version, err := readFormat(dir)
+	if err != nil {
+		return err
+	}
+	if version == legacyFormatVersion && empty {
+		return writeFormat(dir, FormatVersion)
+	}
+	if !isReadableFormat(version) {
+		return ErrUnsupportedFormat
+	}
+	return nil
+}
+
+// readFormat returns the format version of WAL in dir, WAL without format file is legacy.
+func readFormat(dir string) (int, error) {
+	data, err := os.ReadFile(filepath.Join(dir, formatFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return legacyFormatVersion, nil
+		}
+		return 0, err
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, ErrUnsupportedFormat
+	}
+	return version, nil
+}
+
+func writeFormat(dir string, version int) error {
+	path := filepath.Join(dir, formatFile)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.Itoa(version)), defaultFilePerm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Upgrade rewrites WAL in dir to the current format version in place, it reports whether WAL is
+// upgraded. It must be called offline, when WAL isn't opened.
+func Upgrade(dir string) (bool, error) {
+	version, err := readFormat(dir)
+	if err != nil {
+		return false, err
+	}
+	if version == FormatVersion {
+		return false, nil
+	}
+	if version != legacyFormatVersion {
+		return false, ErrUnsupportedFormat
+	}
+	// The records of version 1 are the same as the legacy ones, only the format version is stamped.
+	if err = writeFormat(dir, FormatVersion); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFormat(t *testing.T) {
+	Convey("wal format version", t, func() {
+		ctx := context.Background()
+		walDir := t.TempDir()
+
+		Convey("stamp the format version of new wal", func() {
+			wal, err := Open(ctx, walDir, WithFileSize(fileSize))
+			So(err, ShouldBeNil)
+			wal.Close()
+			wal.Wait()
+
+			version, err := readFormat(walDir)
+			So(err, ShouldBeNil)
+			So(version, ShouldEqual, FormatVersion)
+
+			upgraded, err := Upgrade(walDir)
+			So(err, ShouldBeNil)
+			So(upgraded, ShouldBeFalse)
+		})
+
+		Convey("open and upgrade legacy wal", func() {
+			wal, err := Open(ctx, walDir, WithFileSize(fileSize))
+			So(err, ShouldBeNil)
+			wal.AppendOne(ctx, data0).Wait()
+			wal.Close()
+			wal.Wait()
+			So(os.Remove(filepath.Join(walDir, formatFile)), ShouldBeNil)
+
+			entries := 0
+			wal, err = Open(ctx, walDir, WithRecoveryCallback(func(entry []byte, r Range) error {
+				entries++
+				return nil
+			}), WithFileSize(fileSize))
+			So(err, ShouldBeNil)
+			So(entries, ShouldEqual, 1)
+			wal.Close()
+			wal.Wait()
+
+			version, err := readFormat(walDir)
+			So(err, ShouldBeNil)
+			So(version, ShouldEqual, legacyFormatVersion)
+
+			upgraded, err := Upgrade(walDir)
+			So(err, ShouldBeNil)
+			So(upgraded, ShouldBeTrue)
+			version, err = readFormat(walDir)
+			So(err, ShouldBeNil)
+			So(version, ShouldEqual, FormatVersion)
+		})
+
+		Convey("open wal of unsupported format", func() {
+			So(writeFormat(walDir, FormatVersion+1), ShouldBeNil)
+			_, err := Open(ctx, walDir, WithFileSize(fileSize))
+			So(err, ShouldEqual, ErrUnsupportedFormat)
+			_, err = Upgrade(walDir)
+			So(err, ShouldEqual, ErrUnsupportedFormat)
+		})
+	})
+}
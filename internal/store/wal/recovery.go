@@ -45,6 +45,10 @@ func recoverLogStream(ctx context.Context, dir string, cfg config) (*logStream,
 		return nil, err
 	}
 
+	if err = checkFormat(dir, len(files) == 0); err != nil {
+		return nil, err
+	}
+
 	stream := &logStream{
 		stream:    files,
 		dir:       dir,
@@ -75,11 +79,10 @@ func scanLogFiles(ctx context.Context, dir string, blockSize int64) (stream []*l
 		if last != nil {
 			// discontinuous log file
 			if so != last.eo {
-				log.Warning(ctx, "Discontinuous log file, discard before.",
-					map[string]interface{}{
-						"last_end":   last.eo,
-						"next_start": so,
-					})
+				logger.Warning(ctx, "Discontinuous log file, discard before.",
+					log.Any("last_end", last.eo),
+					log.Any("next_start", so),
+				)
 				discards = append(discards, stream...)
 				stream = nil
 			}
@@ -96,12 +99,11 @@ func scanLogFiles(ctx context.Context, dir string, blockSize int64) (stream []*l
 		if size%blockSize != 0 {
 			// TODO(james.yin): return error
 			truncated := size - size%blockSize
-			log.Warning(context.Background(), "The size of log file is not a multiple of blockSize, truncate it.",
-				map[string]interface{}{
-					"file":        path,
-					"origin_size": size,
-					"new_size":    truncated,
-				})
+			logger.Warning(context.Background(), "The size of log file is not a multiple of blockSize, truncate it.",
+				log.String("file", path),
+				log.Any("origin_size", size),
+				log.Any("new_size", truncated),
+			)
 			size = truncated
 		}
 
@@ -41,6 +41,8 @@ var (
 	errEndOfLog   = stderr.New("WAL: end of log")
 )
 
+var logger = log.Module("store.wal")
+
 type OnEntryCallback func(entry []byte, r Range) error
 
 type logStream struct {
@@ -55,10 +57,10 @@ type logStream struct {
 func (s *logStream) Close(ctx context.Context) {
 	for _, f := range s.stream {
 		if err := f.Close(); err != nil {
-			log.Error(ctx, "Close log file failed.", map[string]interface{}{
-				"path":       f.path,
-				log.KeyError: err,
-			})
+			logger.Error(ctx, "Close log file failed.",
+				log.String("path", f.path),
+				log.Err(err),
+			)
 		}
 	}
 }
@@ -192,10 +194,9 @@ func (s *logStream) Range(ctx context.Context, from int64, cb OnEntryCallback) (
 
 			// TODO(james.yin): Has incomplete entry, truncate it.
 			if sCtx.last.IsNonTerminal() {
-				log.Info(context.Background(), "Found incomplete entry, truncate it.",
-					map[string]interface{}{
-						"last_type": sCtx.last,
-					})
+				logger.Info(context.Background(), "Found incomplete entry, truncate it.",
+					log.Any("last_type", sCtx.last),
+				)
 			}
 
 			return sCtx.eo, nil
@@ -218,10 +219,10 @@ func (s *logStream) scanFile(ctx context.Context, sCtx *scanContext, lf *logFile
 
 	defer func() {
 		if err2 := f.Close(); err2 != nil {
-			log.Error(context.Background(), "Close file failed.", map[string]interface{}{
-				"path":       lf.path,
-				log.KeyError: err2,
-			})
+			logger.Error(context.Background(), "Close file failed.",
+				log.String("path", lf.path),
+				log.Err(err2),
+			)
 			err = errors.Chain(err, err2)
 		}
 	}()
@@ -426,8 +426,10 @@ func (w *WAL) runFlush() {
 
 		writer := w.logWriter(ctx, fb.SO)
 
+		start := time.Now()
 		w.flushWg.Add(1)
 		fb.Flush(writer, task.offset, fb.SO, func(off int64, err error) {
+			metrics.WALFlushSecond.Observe(time.Since(start).Seconds())
 			span.End()
 
 			if err != nil {
@@ -15,6 +15,7 @@
 package timer
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
@@ -44,6 +45,7 @@ func (c *Config) GetLeaderElectionConfig() *leaderelection.Config {
 	return &leaderelection.Config{
 		LeaseDuration: c.LeaderElectionConfig.LeaseDuration,
 		Name:          resourceLockName,
+		Identity:      c.Name,
 		KeyPrefix:     c.MetadataConfig.KeyPrefix,
 		EtcdEndpoints: c.EtcdEndpoints,
 	}
@@ -51,15 +53,23 @@ func (c *Config) GetLeaderElectionConfig() *leaderelection.Config {
 
 func (c *Config) GetTimingWheelConfig() *timingwheel.Config {
 	return &timingwheel.Config{
-		Tick:          time.Duration(c.TimingWheelConfig.Tick) * time.Second,
-		WheelSize:     c.TimingWheelConfig.WheelSize,
-		Layers:        c.TimingWheelConfig.Layers,
-		KeyPrefix:     c.MetadataConfig.KeyPrefix,
-		EtcdEndpoints: c.EtcdEndpoints,
-		CtrlEndpoints: c.CtrlEndpoints,
+		Tick:               time.Duration(c.TimingWheelConfig.Tick) * time.Second,
+		WheelSize:          c.TimingWheelConfig.WheelSize,
+		Layers:             c.TimingWheelConfig.Layers,
+		TombstoneRetention: time.Duration(c.TimingWheelConfig.TombstoneRetention) * time.Hour,
+		DriftThreshold:     time.Duration(c.TimingWheelConfig.DriftThreshold) * time.Millisecond,
+		KeyPrefix:          c.MetadataConfig.KeyPrefix,
+		EtcdEndpoints:      c.EtcdEndpoints,
+		CtrlEndpoints:      c.CtrlEndpoints,
+		ReplicaName:        c.Name,
+		ReplicaAddress:     c.GetAddress(),
 	}
 }
 
+func (c *Config) GetAddress() string {
+	return fmt.Sprintf("%s:%d", c.IP, c.Port)
+}
+
 type MetadataConfig struct {
 	KeyPrefix string `yaml:"key_prefix"`
 }
@@ -72,9 +82,19 @@ type TimingWheelConfig struct {
 	Tick      int64 `yaml:"tick"`
 	WheelSize int64 `yaml:"wheel_size"`
 	Layers    int64 `yaml:"layers"`
+	// TombstoneRetention is the hours to keep the tombstones of cancelled or rescheduled events, the
+	// delayed event isn't cancelled if it fires after its tombstone expired.
+	TombstoneRetention int64 `yaml:"tombstone_retention"`
+	// DriftThreshold is the milliseconds which the events can fire later than scheduled, an ops event is
+	// emitted to the ops eventbus when it's exceeded.
+	DriftThreshold int64 `yaml:"drift_threshold"`
 }
 
 func Default(c *Config) {
+	// the name identifies the replica in leader election and its status, it must be unique among replicas
+	if c.Name == "" {
+		c.Name = c.GetAddress()
+	}
 	if c.LeaderElectionConfig.LeaseDuration == 0 {
 		c.LeaderElectionConfig.LeaseDuration = 15
 	}
@@ -87,6 +107,12 @@ func Default(c *Config) {
 	if c.TimingWheelConfig.Layers == 0 {
 		c.TimingWheelConfig.Layers = 4
 	}
+	if c.TimingWheelConfig.TombstoneRetention == 0 {
+		c.TimingWheelConfig.TombstoneRetention = 30 * 24
+	}
+	if c.TimingWheelConfig.DriftThreshold == 0 {
+		c.TimingWheelConfig.DriftThreshold = 5000
+	}
 }
 
 func InitConfig(filename string) (*Config, error) {
@@ -0,0 +1,241 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cron parses the standard 5-field cron expression, which is
+// "minute hour day-of-month month day-of-week", and the descriptors like @hourly.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// give up searching if no time matches in the next 5 years, e.g. "0 0 30 2 *".
+	maxSearchYears = 5
+
+	// minute, hour, day-of-month, month and day-of-week.
+	numberOfFields = 5
+	// "range/step" and "low-high".
+	numberOfParts = 2
+)
+
+var (
+	descriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+	monthNames = map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+	weekdayNames = map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+type bounds struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteBounds  = bounds{name: "minute", min: 0, max: 59}
+	hourBounds    = bounds{name: "hour", min: 0, max: 23}
+	domBounds     = bounds{name: "day-of-month", min: 1, max: 31}
+	monthBounds   = bounds{name: "month", min: 1, max: 12, names: monthNames}
+	weekdayBounds = bounds{name: "day-of-week", min: 0, max: 7, names: weekdayNames}
+)
+
+// Schedule is a parsed cron expression, each field is a bit set of the matched values.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// if both day-of-month and day-of-week are restricted, a day matches either of them.
+	domStar, dowStar bool
+	loc              *time.Location
+}
+
+// Parse parses the cron expression, the times are evaluated in UTC.
+func Parse(expr string) (*Schedule, error) {
+	return ParseInLocation(expr, time.UTC)
+}
+
+// ParseInLocation parses the cron expression, the times are evaluated in the given location.
+func ParseInLocation(expr string, loc *time.Location) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		std, ok := descriptors[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized descriptor: %s", expr)
+		}
+		expr = std
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != numberOfFields {
+		return nil, fmt.Errorf("expected 5 fields, found %d: %s", len(fields), expr)
+	}
+	var err error
+	s := &Schedule{loc: loc}
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], weekdayBounds); err != nil {
+		return nil, err
+	}
+	// both 0 and 7 are Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domStar = isStar(fields[2])
+	s.dowStar = isStar(fields[4])
+	return s, nil
+}
+
+// Next returns the first time matched by the schedule which is strictly after t,
+// or the zero time if nothing matches.
+func (s *Schedule) Next(t time.Time) time.Time {
+	origin := t.Location()
+	t = t.In(s.loc).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t.In(origin)
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func isStar(field string) bool {
+	return strings.HasPrefix(field, "*") || strings.HasPrefix(field, "?")
+}
+
+// parseField parses the comma separated list, each item is one of "*", "a", "a-b", "*/n", "a/n" and "a-b/n".
+func parseField(field string, b bounds) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		v, err := parseRange(item, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= v
+	}
+	return bits, nil
+}
+
+func parseRange(item string, b bounds) (uint64, error) {
+	var (
+		start, end uint
+		step       uint = 1
+		err        error
+	)
+	rangeAndStep := strings.Split(item, "/")
+	if len(rangeAndStep) > numberOfParts {
+		return 0, fmt.Errorf("invalid %s: %s", b.name, item)
+	}
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	switch {
+	case lowAndHigh[0] == "*" || lowAndHigh[0] == "?":
+		if len(lowAndHigh) > 1 {
+			return 0, fmt.Errorf("invalid %s: %s", b.name, item)
+		}
+		start, end = b.min, b.max
+	default:
+		if start, err = parseValue(lowAndHigh[0], b); err != nil {
+			return 0, err
+		}
+		switch len(lowAndHigh) {
+		case 1:
+			end = start
+			// "a/n" means "a-max/n"
+			if len(rangeAndStep) == numberOfParts {
+				end = b.max
+			}
+		case numberOfParts:
+			if end, err = parseValue(lowAndHigh[1], b); err != nil {
+				return 0, err
+			}
+		default:
+			return 0, fmt.Errorf("invalid %s: %s", b.name, item)
+		}
+	}
+	if len(rangeAndStep) == numberOfParts {
+		n, err := strconv.ParseUint(rangeAndStep[1], 10, 32)
+		if err != nil || n == 0 {
+			return 0, fmt.Errorf("invalid step of %s: %s", b.name, item)
+		}
+		step = uint(n)
+	}
+	if start > end {
+		return 0, fmt.Errorf("invalid range of %s: %s", b.name, item)
+	}
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+	return bits, nil
+}
+
+func parseValue(v string, b bounds) (uint, error) {
+	if n, ok := b.names[strings.ToLower(v)]; ok {
+		return n, nil
+	}
+	n, err := strconv.ParseUint(v, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", b.name, v)
+	}
+	if uint(n) < b.min || uint(n) > b.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", b.name, n, b.min, b.max)
+	}
+	return uint(n), nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("test parse cron expression", t, func() {
+		Convey("test valid expressions", func() {
+			for _, expr := range []string{
+				"* * * * *", "*/5 * * * *", "0 0 1 1 *", "0-30/10 9-17 * jan-jun mon-fri",
+				"5,10,15 0 * * 7", "0 12 ? * sun", "@hourly", "@DAILY", "30/15 * * * *",
+			} {
+				_, err := Parse(expr)
+				So(err, ShouldBeNil)
+			}
+		})
+
+		Convey("test invalid expressions", func() {
+			for _, expr := range []string{
+				"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *",
+				"* * * 13 *", "* * * * 8", "*/0 * * * *", "10-5 * * * *", "a * * * *",
+				"*-5 * * * *", "1/2/3 * * * *", "@every 1m",
+			} {
+				_, err := Parse(expr)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
+
+func TestSchedule_Next(t *testing.T) {
+	Convey("test the next time of schedule", t, func() {
+		base := time.Date(2022, 10, 14, 10, 17, 30, 0, time.UTC) // Friday
+		cases := []struct {
+			expr string
+			want time.Time
+		}{
+			{"* * * * *", time.Date(2022, 10, 14, 10, 18, 0, 0, time.UTC)},
+			{"*/15 * * * *", time.Date(2022, 10, 14, 10, 30, 0, 0, time.UTC)},
+			{"17 * * * *", time.Date(2022, 10, 14, 11, 17, 0, 0, time.UTC)},
+			{"0 9 * * *", time.Date(2022, 10, 15, 9, 0, 0, 0, time.UTC)},
+			{"0 0 * * mon", time.Date(2022, 10, 17, 0, 0, 0, 0, time.UTC)},
+			{"0 0 1 * *", time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC)},
+			{"@yearly", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 7", time.Date(2022, 10, 16, 0, 0, 0, 0, time.UTC)},
+			// day-of-month or day-of-week if both are restricted
+			{"0 0 20 * 1", time.Date(2022, 10, 17, 0, 0, 0, 0, time.UTC)},
+			{"0 0 30 2 *", time.Time{}},
+		}
+		for _, c := range cases {
+			s, err := Parse(c.expr)
+			So(err, ShouldBeNil)
+			So(s.Next(base), ShouldEqual, c.want)
+		}
+
+		Convey("test the next time is strictly after", func() {
+			s, _ := Parse("0 * * * *")
+			t := time.Date(2022, 10, 14, 10, 0, 0, 0, time.UTC)
+			So(s.Next(t), ShouldEqual, t.Add(time.Hour))
+		})
+	})
+}
@@ -17,6 +17,7 @@ package leaderelection
 type Config struct {
 	LeaseDuration int64    `yaml:"lease_duration"`
 	Name          string   `yaml:"name"`
+	Identity      string   `yaml:"identity"`
 	KeyPrefix     string   `yaml:"key_prefix"`
 	EtcdEndpoints []string `yaml:"etcd"`
 }
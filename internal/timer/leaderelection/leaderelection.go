@@ -56,7 +56,9 @@ type Mutex interface {
 type leaderElection struct {
 	key           string
 	name          string
+	identity      string
 	isLeader      bool
+	stopping      bool
 	leaseDuration int64
 
 	etcdClient *v3client.Client
@@ -90,23 +92,23 @@ func NewLeaderElection(c *Config) Manager {
 
 	le := &leaderElection{
 		name:          c.Name,
+		identity:      c.Identity,
 		key:           fmt.Sprintf("%s/%s", metadata.ResourceLockKeyPrefixInKVStore, c.Name),
 		isLeader:      false,
 		leaseDuration: c.LeaseDuration,
 		etcdClient:    client,
 	}
 
-	le.session, err = newSession(client, concurrency.WithTTL(int(le.leaseDuration)))
-	if err != nil {
+	if err = le.newSession(); err != nil {
 		log.Error(context.Background(), "new session failed", map[string]interface{}{
 			log.KeyError: err,
 		})
 		panic("new session failed")
 	}
-	le.mutex = newMutex(le.session, le.key)
 
 	log.Info(context.Background(), "new leaderelection manager", map[string]interface{}{
 		"name":           le.name,
+		"identity":       le.identity,
 		"key":            le.key,
 		"lease_duration": le.leaseDuration,
 	})
@@ -124,6 +126,8 @@ func (le *leaderElection) Start(ctx context.Context, callbacks LeaderCallbacks)
 
 func (le *leaderElection) Stop(ctx context.Context) error {
 	log.Info(ctx, "stop leaderelection", nil)
+	// the session is closed on purpose, don't campaign again
+	le.stopping = true
 	err := le.release(ctx)
 	if err != nil {
 		log.Error(ctx, "release lock failed", map[string]interface{}{
@@ -177,14 +181,62 @@ func (le *leaderElection) tryLock(ctx context.Context) error {
 	}
 
 	log.Info(ctx, "acquired lock", map[string]interface{}{
-		"identity": le.name,
+		"identity": le.identity,
 		"lock":     le.key,
 	})
 	le.isLeader = true
 	le.callbacks.OnStartedLeading(ctx)
+	le.watchSession(ctx)
+	return nil
+}
+
+func (le *leaderElection) newSession() error {
+	session, err := newSession(le.etcdClient, concurrency.WithTTL(int(le.leaseDuration)))
+	if err != nil {
+		return err
+	}
+	le.session = session
+	le.mutex = newMutex(session, le.key)
 	return nil
 }
 
+// watchSession steps down when the session expires, e.g. the leader is partitioned from etcd, because
+// the lock has been released by etcd and another replica may be leading. Then it campaigns again.
+func (le *leaderElection) watchSession(ctx context.Context) {
+	if le.session == nil {
+		return
+	}
+	le.wg.Add(1)
+	go func() {
+		defer le.wg.Done()
+		select {
+		case <-ctx.Done():
+			return
+		case <-le.session.Done():
+		}
+		if !le.isLeader || le.stopping {
+			return
+		}
+		log.Warning(ctx, "session expired, step down", map[string]interface{}{
+			"identity": le.identity,
+			"lock":     le.key,
+		})
+		le.isLeader = false
+		le.callbacks.OnStoppedLeading(ctx)
+		for {
+			if err := le.newSession(); err == nil {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(acquireLockDuration * time.Second):
+			}
+		}
+		_ = le.tryAcquireLockLoop(ctx)
+	}()
+}
+
 func (le *leaderElection) release(ctx context.Context) error {
 	err := le.mutex.Unlock(ctx)
 	if err != nil {
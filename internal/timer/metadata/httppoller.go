@@ -0,0 +1,95 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	HTTPPollerAuthBasic  = "basic"
+	HTTPPollerAuthBearer = "bearer"
+)
+
+// HTTPPoller polls the url every interval, and publishes the items of response which haven't been
+// published into the eventbus.
+type HTTPPoller struct {
+	ID          vanus.ID          `json:"id"`
+	Name        string            `json:"name"`
+	Eventbus    string            `json:"eventbus"`
+	URL         string            `json:"url"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Auth        HTTPPollerAuth    `json:"auth"`
+	Interval    time.Duration     `json:"interval"`
+	ItemsPath   string            `json:"items_path,omitempty"`
+	DedupKey    string            `json:"dedup_key,omitempty"`
+	EventType   string            `json:"event_type"`
+	EventSource string            `json:"event_source"`
+	Paused      bool              `json:"paused"`
+	Description string            `json:"description"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+type HTTPPollerAuth struct {
+	Type     string `json:"type,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
+// HTTPPollerState is the poll progress of an HTTP poller, the keys are the dedup keys of the latest
+// published items, the oldest ones are dropped when the number exceeds the limit of timer.
+type HTTPPollerState struct {
+	Keys         []string  `json:"keys,omitempty"`
+	LastPollTime time.Time `json:"last_poll_time"`
+}
+
+// Convert2ProtoHTTPPoller converts the pollers to protobuf, the secrets of auth are masked.
+func Convert2ProtoHTTPPoller(ins ...*HTTPPoller) []*ctrlpb.HTTPPoller {
+	pps := make([]*ctrlpb.HTTPPoller, len(ins))
+	for idx := 0; idx < len(ins); idx++ {
+		p := ins[idx]
+		pp := &ctrlpb.HTTPPoller{
+			Id:          p.ID.Uint64(),
+			Name:        p.Name,
+			Eventbus:    p.Eventbus,
+			Url:         p.URL,
+			Headers:     p.Headers,
+			Interval:    uint32(p.Interval / time.Second),
+			ItemsPath:   p.ItemsPath,
+			DedupKey:    p.DedupKey,
+			EventType:   p.EventType,
+			EventSource: p.EventSource,
+			Paused:      p.Paused,
+			Description: p.Description,
+			CreatedAt:   p.CreatedAt.UnixMilli(),
+		}
+		if p.Auth.Type != "" {
+			pp.Auth = &ctrlpb.HTTPPollerAuth{Type: p.Auth.Type, Username: p.Auth.Username}
+			if p.Auth.Password != "" {
+				pp.Auth.Password = primitive.SecretsMask
+			}
+			if p.Auth.Token != "" {
+				pp.Auth.Token = primitive.SecretsMask
+			}
+		}
+		pps[idx] = pp
+	}
+	return pps
+}
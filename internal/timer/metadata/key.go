@@ -14,7 +14,59 @@
 
 package metadata
 
+import (
+	"path"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
 const (
 	ResourceLockKeyPrefixInKVStore = "/vanus/internal/resource/resourcelock"
 	MetadataKeyPrefixInKVStore     = "/vanus/internal/resource/timer/metadata"
+	CronEventKeyPrefixInKVStore    = "/vanus/internal/resource/timer/cron"
+	ReplicaKeyPrefixInKVStore      = "/vanus/internal/resource/timer/replica"
+	ReplayJobKeyPrefixInKVStore    = "/vanus/internal/resource/timer/replay"
+	HTTPPollerKeyPrefixInKVStore   = "/vanus/internal/resource/timer/httppoller"
 )
+
+var (
+	// EpochKeyInKVStore is the key of fencing epoch, it's increased each time a replica becomes leader.
+	EpochKeyInKVStore = path.Join(MetadataKeyPrefixInKVStore, "epoch")
+	// FiredCheckpointKeyInKVStore is the key of the fire progress of distribution station.
+	FiredCheckpointKeyInKVStore = path.Join(MetadataKeyPrefixInKVStore, "fired")
+)
+
+// GetCronEventKeyInKVStore returns the key of cron event definition, which is written by controller.
+func GetCronEventKeyInKVStore(id vanus.ID) string {
+	return path.Join(CronEventKeyPrefixInKVStore, id.Key())
+}
+
+// GetCronStateKeyInKVStore returns the key of the last fire time of cron event, which is written by timer.
+func GetCronStateKeyInKVStore(id vanus.ID) string {
+	return path.Join(MetadataKeyPrefixInKVStore, "cron", id.Key())
+}
+
+// GetReplicaKeyInKVStore returns the key of replica status, which is reported by each replica of timer.
+func GetReplicaKeyInKVStore(name string) string {
+	return path.Join(ReplicaKeyPrefixInKVStore, name)
+}
+
+// GetReplayJobKeyInKVStore returns the key of replay job definition, which is written by controller.
+func GetReplayJobKeyInKVStore(id vanus.ID) string {
+	return path.Join(ReplayJobKeyPrefixInKVStore, id.Key())
+}
+
+// GetReplayStateKeyInKVStore returns the key of the progress of replay job, which is written by timer.
+func GetReplayStateKeyInKVStore(id vanus.ID) string {
+	return path.Join(MetadataKeyPrefixInKVStore, "replay", id.Key())
+}
+
+// GetHTTPPollerKeyInKVStore returns the key of HTTP poller definition, which is written by controller.
+func GetHTTPPollerKeyInKVStore(id vanus.ID) string {
+	return path.Join(HTTPPollerKeyPrefixInKVStore, id.Key())
+}
+
+// GetHTTPPollerStateKeyInKVStore returns the key of the poll progress of HTTP poller, which is written by timer.
+func GetHTTPPollerStateKeyInKVStore(id vanus.ID) string {
+	return path.Join(MetadataKeyPrefixInKVStore, "httppoller", id.Key())
+}
@@ -14,9 +14,104 @@
 
 package metadata
 
+import (
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
 type OffsetMeta struct {
 	Layer    int64  `json:"layer"`
 	Slot     int64  `json:"slot"`
 	Offset   int64  `json:"offset"`
 	Eventbus string `json:"eventbus"`
 }
+
+// Epoch is the fencing epoch, only the leader with the latest epoch fires events.
+type Epoch struct {
+	Epoch  uint64 `json:"epoch"`
+	Leader string `json:"leader"`
+}
+
+// FiredCheckpoint is the fire progress of distribution station. The events before Offset have been fired,
+// and the events in [Offset, InFlight) are being fired since Time, some of them may have been fired.
+type FiredCheckpoint struct {
+	Epoch    uint64    `json:"epoch"`
+	Offset   int64     `json:"offset"`
+	InFlight int64     `json:"in_flight"`
+	Time     time.Time `json:"time"`
+}
+
+// TimerReplica is the status of a timer replica.
+type TimerReplica struct {
+	Name        string    `json:"name"`
+	Address     string    `json:"address"`
+	IsLeader    bool      `json:"is_leader"`
+	Epoch       uint64    `json:"epoch"`
+	FiredOffset int64     `json:"fired_offset"`
+	HeartbeatAt time.Time `json:"heartbeat_at"`
+}
+
+// CronEvent fires an event built from the template into the eventbus at each time matched by the schedule.
+type CronEvent struct {
+	ID          vanus.ID          `json:"id"`
+	Name        string            `json:"name"`
+	Schedule    string            `json:"schedule"`
+	Eventbus    string            `json:"eventbus"`
+	Template    CronEventTemplate `json:"template"`
+	Description string            `json:"description"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+type CronEventTemplate struct {
+	Type            string `json:"type"`
+	Source          string `json:"source"`
+	Subject         string `json:"subject,omitempty"`
+	DataContentType string `json:"data_content_type,omitempty"`
+	Data            []byte `json:"data,omitempty"`
+}
+
+// CronState is the fire progress of a cron event, it's used to avoid firing twice after the leader changed.
+type CronState struct {
+	LastFireTime time.Time `json:"last_fire_time"`
+}
+
+func Convert2ProtoCronEvent(ins ...*CronEvent) []*ctrlpb.CronEvent {
+	pces := make([]*ctrlpb.CronEvent, len(ins))
+	for idx := 0; idx < len(ins); idx++ {
+		ce := ins[idx]
+		pces[idx] = &ctrlpb.CronEvent{
+			Id:       ce.ID.Uint64(),
+			Name:     ce.Name,
+			Schedule: ce.Schedule,
+			Eventbus: ce.Eventbus,
+			Template: &ctrlpb.CronEventTemplate{
+				Type:            ce.Template.Type,
+				Source:          ce.Template.Source,
+				Subject:         ce.Template.Subject,
+				DataContentType: ce.Template.DataContentType,
+				Data:            ce.Template.Data,
+			},
+			Description: ce.Description,
+			CreatedAt:   ce.CreatedAt.UnixMilli(),
+		}
+	}
+	return pces
+}
+
+func Convert2ProtoTimerReplica(ins ...*TimerReplica) []*ctrlpb.TimerReplica {
+	prs := make([]*ctrlpb.TimerReplica, len(ins))
+	for idx := 0; idx < len(ins); idx++ {
+		r := ins[idx]
+		prs[idx] = &ctrlpb.TimerReplica{
+			Name:        r.Name,
+			Address:     r.Address,
+			IsLeader:    r.IsLeader,
+			Epoch:       r.Epoch,
+			FiredOffset: r.FiredOffset,
+			HeartbeatAt: r.HeartbeatAt.UnixMilli(),
+		}
+	}
+	return prs
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	ReplayStatusPending   = "pending"
+	ReplayStatusRunning   = "running"
+	ReplayStatusCompleted = "completed"
+)
+
+// ReplayJob copies the events of source eventbus within the time range, or the offset range of each
+// eventlog, into the target eventbus.
+type ReplayJob struct {
+	ID             vanus.ID                        `json:"id"`
+	SourceEventbus string                          `json:"source_eventbus"`
+	TargetEventbus string                          `json:"target_eventbus"`
+	StartTime      time.Time                       `json:"start_time"`
+	EndTime        time.Time                       `json:"end_time"`
+	ByOffset       bool                            `json:"by_offset,omitempty"`
+	StartOffset    int64                           `json:"start_offset,omitempty"`
+	EndOffset      int64                           `json:"end_offset,omitempty"`
+	Filters        []*primitive.SubscriptionFilter `json:"filters,omitempty"`
+	RateLimit      uint32                          `json:"rate_limit,omitempty"`
+	Description    string                          `json:"description"`
+	CreatedAt      time.Time                       `json:"created_at"`
+}
+
+// ReplayState is the progress of a replay job, the job is pending until the cursors are initialized.
+type ReplayState struct {
+	Cursors   []*ReplayCursor `json:"cursors"`
+	Replayed  uint64          `json:"replayed"`
+	Filtered  uint64          `json:"filtered"`
+	Error     string          `json:"error,omitempty"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// ReplayCursor is the progress of an eventlog of the source eventbus.
+type ReplayCursor struct {
+	EventlogID uint64 `json:"eventlog_id"`
+	Offset     int64  `json:"offset"`
+	EndOffset  int64  `json:"end_offset"`
+	Done       bool   `json:"done,omitempty"`
+}
+
+func (s *ReplayState) Status() string {
+	if s == nil || s.Cursors == nil {
+		return ReplayStatusPending
+	}
+	for _, c := range s.Cursors {
+		if !c.Done {
+			return ReplayStatusRunning
+		}
+	}
+	return ReplayStatusCompleted
+}
+
+func Convert2ProtoReplayJob(job *ReplayJob, state *ReplayState) *ctrlpb.ReplayJob {
+	to := &ctrlpb.ReplayJob{
+		Id:             job.ID.Uint64(),
+		SourceEventbus: job.SourceEventbus,
+		TargetEventbus: job.TargetEventbus,
+		ByOffset:       job.ByOffset,
+		StartOffset:    job.StartOffset,
+		EndOffset:      job.EndOffset,
+		Filters:        convert.ToPbFilters(job.Filters),
+		RateLimit:      job.RateLimit,
+		Description:    job.Description,
+		CreatedAt:      job.CreatedAt.UnixMilli(),
+		Status:         state.Status(),
+	}
+	if !job.ByOffset {
+		to.StartTime = job.StartTime.UnixMilli()
+		to.EndTime = job.EndTime.UnixMilli()
+	}
+	if state == nil {
+		return to
+	}
+	to.Replayed = state.Replayed
+	to.Filtered = state.Filtered
+	to.Error = state.Error
+	to.UpdatedAt = state.UpdatedAt.UnixMilli()
+	for _, c := range state.Cursors {
+		to.Progress = append(to.Progress, &ctrlpb.ReplayProgress{
+			EventlogId: c.EventlogID,
+			Offset:     c.Offset,
+			EndOffset:  c.EndOffset,
+			Done:       c.Done,
+		})
+	}
+	return to
+}
@@ -75,8 +75,8 @@ func newTimingMsg(ctx context.Context, e *ce.Event) *timingMsg {
 	}
 }
 
-func (tm *timingMsg) hasExpired() bool {
-	return !time.Now().Before(tm.expiration)
+func (tm *timingMsg) hasExpired(now time.Time) bool {
+	return !now.Before(tm.expiration)
 }
 
 func (tm *timingMsg) getExpiration() time.Time {
@@ -106,6 +106,8 @@ type bucket struct {
 
 	timingwheel *timingWheel
 	element     *list.Element
+	// the bucket of overflow layer doesn't read events until it's loaded.
+	loaded bool
 
 	waitingForReady func(ctx context.Context, events []*ce.Event)
 	eventHandler    func(ctx context.Context, event *ce.Event)
@@ -138,13 +140,19 @@ func newBucket(tw *timingWheel, element *list.Element, tick time.Duration, ebNam
 }
 
 func (b *bucket) start(ctx context.Context) error {
-	var err error
-	if err = b.createEventbus(ctx); err != nil {
+	if err := b.prepare(ctx); err != nil {
 		return err
 	}
+	b.run(ctx)
+	return nil
+}
 
+// prepare makes the bucket ready to receive events, but doesn't read events from it.
+func (b *bucket) prepare(ctx context.Context) error {
+	if err := b.createEventbus(ctx); err != nil {
+		return err
+	}
 	b.connectEventbus(ctx)
-	b.run(ctx)
 	return nil
 }
 
@@ -205,11 +213,11 @@ func (b *bucket) run(ctx context.Context) {
 							log.KeyError: err,
 						})
 					}
-					time.Sleep(sleepDuration)
+					b.config.clock().Sleep(sleepDuration)
 					break
 				}
 				if len(events) == 0 {
-					time.Sleep(sleepDuration)
+					b.config.clock().Sleep(sleepDuration)
 					log.Debug(ctx, "no more message", map[string]interface{}{
 						"function": "run",
 					})
@@ -268,7 +276,7 @@ func (b *bucket) pushToDistributionStation(ctx context.Context, e *ce.Event) {
 func (b *bucket) pushToPrevTimingWheel(ctx context.Context, e *ce.Event) {
 	var handler func(ctx context.Context, tm *timingMsg) bool
 	tm := newTimingMsg(ctx, e)
-	if tm.hasExpired() {
+	if tm.hasExpired(b.config.clock().Now()) {
 		handler = b.timingwheel.getDistributionStation().push
 	} else {
 		handler = b.getTimingWheelElement().prev().flow
@@ -299,7 +307,7 @@ func (b *bucket) waitingForExpired(ctx context.Context, events []*ce.Event) {
 
 func (b *bucket) isReadyToDeliver(tm *timingMsg) bool {
 	startTimeOfBucket := tm.getExpiration().UnixNano() - (tm.getExpiration().UnixNano() % b.tick.Nanoseconds())
-	return time.Now().UnixNano() >= startTimeOfBucket
+	return b.config.clock().Now().UnixNano() >= startTimeOfBucket
 }
 
 func (b *bucket) waitingForFlow(ctx context.Context, events []*ce.Event) {
@@ -315,7 +323,7 @@ func (b *bucket) waitingForFlow(ctx context.Context, events []*ce.Event) {
 func (b *bucket) isReadyToFlow(tm *timingMsg) bool {
 	startTimeOfBucket := tm.getExpiration().UnixNano() - (tm.getExpiration().UnixNano() % b.tick.Nanoseconds())
 	advanceTimeOfFlow := defaultNumberOfTickFlowInAdvance * b.getTimingWheelElement().prev().tick
-	return time.Now().Add(advanceTimeOfFlow).UnixNano() >= startTimeOfBucket
+	return b.config.clock().Now().Add(advanceTimeOfFlow).UnixNano() >= startTimeOfBucket
 }
 
 func (b *bucket) push(ctx context.Context, tm *timingMsg) bool {
@@ -378,7 +386,7 @@ func (b *bucket) getEvent(ctx context.Context, number int16) (events []*ce.Event
 	}()
 	if !b.isLeader() {
 		// TODO(jiangkai): redesign here for reduce cpu overload, by jiangkai, 2022.09.16
-		time.Sleep(time.Second)
+		b.config.clock().Sleep(time.Second)
 		return []*ce.Event{}, errors.ErrOffsetOnEnd
 	}
 	ls, err := b.client.Eventbus(ctx, b.eventbus).ListLog(ctx)
@@ -57,7 +57,8 @@ func TestTimingMsg_hasExpired(t *testing.T) {
 		e := ce.NewEvent()
 		e.SetExtension(xVanusDeliveryTime, time.Now().Add(2*time.Second).UTC().Format(time.RFC3339))
 		tm := newTimingMsg(ctx, &e)
-		So(tm.hasExpired(), ShouldEqual, false)
+		So(tm.hasExpired(time.Now()), ShouldEqual, false)
+		So(tm.hasExpired(time.Now().Add(3*time.Second)), ShouldEqual, true)
 	})
 }
 
@@ -16,6 +16,8 @@ package timingwheel
 
 import (
 	"time"
+
+	"github.com/linkall-labs/vanus/pkg/util"
 )
 
 type Config struct {
@@ -25,4 +27,20 @@ type Config struct {
 	KeyPrefix     string        `yaml:"key_prefix"`
 	EtcdEndpoints []string      `yaml:"etcd"`
 	CtrlEndpoints []string      `yaml:"controllers"`
+	// how long a tombstone of cancelled or rescheduled event is kept.
+	TombstoneRetention time.Duration `yaml:"tombstone_retention"`
+	// an ops event is emitted when the events fire later than the threshold.
+	DriftThreshold time.Duration `yaml:"drift_threshold"`
+	// the name and address of the replica, they are reported as the status of replica.
+	ReplicaName    string `yaml:"replica_name"`
+	ReplicaAddress string `yaml:"replica_address"`
+	// the clock which decides when the events fire, it's the system time if nil.
+	Clock util.Clock `yaml:"-"`
+}
+
+func (c *Config) clock() util.Clock {
+	if c.Clock != nil {
+		return c.Clock
+	}
+	return util.RealClock
 }
@@ -0,0 +1,216 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/cron"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	cronCheckInterval = time.Second
+	// the extension attribute of fired event, the value is the id of cron event.
+	xVanusCronEvent = "xvanuscronevent"
+)
+
+type cronEntry struct {
+	event    *metadata.CronEvent
+	schedule *cron.Schedule
+	next     time.Time
+}
+
+// cronScheduler fires the cron events created by controller. Only the leader of timer fires them, and
+// the last fire time is saved in kv, so the new leader neither fires the same time twice nor misses it.
+// If many times are missed, for example the timer was stopped for a while, only the earliest one is fired.
+type cronScheduler struct {
+	tw      *timingWheel
+	entries map[vanus.ID]*cronEntry
+}
+
+func newCronScheduler(tw *timingWheel) *cronScheduler {
+	return &cronScheduler{
+		tw:      tw,
+		entries: map[vanus.ID]*cronEntry{},
+	}
+}
+
+func (tw *timingWheel) startCronScheduler(ctx context.Context) {
+	cs := newCronScheduler(tw)
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(cronCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at cron scheduler", nil)
+				return
+			case <-ticker.C:
+				if !tw.IsLeader() {
+					// reload the fire progress from kv when becoming leader again
+					cs.reset()
+					break
+				}
+				cs.run(ctx, tw.config.clock().Now())
+			}
+		}
+	}()
+}
+
+func (cs *cronScheduler) reset() {
+	if len(cs.entries) > 0 {
+		cs.entries = map[vanus.ID]*cronEntry{}
+	}
+}
+
+func (cs *cronScheduler) run(ctx context.Context, now time.Time) {
+	if err := cs.load(ctx); err != nil {
+		log.Warning(ctx, "load cron events failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return
+	}
+	for _, entry := range cs.entries {
+		if entry.next.IsZero() || entry.next.After(now) {
+			continue
+		}
+		if err := cs.fire(ctx, entry); err != nil {
+			log.Warning(ctx, "fire cron event failed, retry at next check", map[string]interface{}{
+				log.KeyError: err,
+				"id":         entry.event.ID,
+				"eventbus":   entry.event.Eventbus,
+				"fire_time":  entry.next.Format(time.RFC3339),
+			})
+			continue
+		}
+		entry.next = entry.schedule.Next(now)
+	}
+}
+
+// load synchronizes the entries with the cron events in kv.
+func (cs *cronScheduler) load(ctx context.Context) error {
+	pairs, err := cs.tw.kvStore.List(ctx, metadata.CronEventKeyPrefixInKVStore)
+	if err != nil {
+		return err
+	}
+	exist := make(map[vanus.ID]struct{}, len(pairs))
+	for _, pair := range pairs {
+		event := &metadata.CronEvent{}
+		if err = json.Unmarshal(pair.Value, event); err != nil {
+			log.Warning(ctx, "unmarshal cron event failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		exist[event.ID] = struct{}{}
+		if _, ok := cs.entries[event.ID]; ok {
+			continue
+		}
+		entry, err := cs.newEntry(ctx, event)
+		if err != nil {
+			log.Warning(ctx, "load cron event failed", map[string]interface{}{
+				log.KeyError: err,
+				"id":         event.ID,
+			})
+			continue
+		}
+		cs.entries[event.ID] = entry
+	}
+	for id := range cs.entries {
+		if _, ok := exist[id]; !ok {
+			delete(cs.entries, id)
+		}
+	}
+	return nil
+}
+
+func (cs *cronScheduler) newEntry(ctx context.Context, event *metadata.CronEvent) (*cronEntry, error) {
+	schedule, err := cron.Parse(event.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	last := event.CreatedAt
+	data, err := cs.tw.kvStore.Get(ctx, metadata.GetCronStateKeyInKVStore(event.ID))
+	if err != nil && !stderr.Is(err, kv.ErrKeyNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		state := &metadata.CronState{}
+		if err = json.Unmarshal(data, state); err != nil {
+			return nil, err
+		}
+		last = state.LastFireTime
+	}
+	return &cronEntry{
+		event:    event,
+		schedule: schedule,
+		next:     schedule.Next(last),
+	}, nil
+}
+
+func (cs *cronScheduler) fire(ctx context.Context, entry *cronEntry) error {
+	event := newCronEvent(entry.event, entry.next)
+	_, err := cs.tw.client.Eventbus(ctx, entry.event.Eventbus).Writer().AppendOne(ctx, event)
+	if err != nil {
+		return err
+	}
+	data, _ := json.Marshal(&metadata.CronState{LastFireTime: entry.next})
+	if err = cs.tw.kvStore.Set(ctx, metadata.GetCronStateKeyInKVStore(entry.event.ID), data); err != nil {
+		// the event has been fired, it may be fired again after the leader changed.
+		log.Warning(ctx, "save cron state failed", map[string]interface{}{
+			log.KeyError: err,
+			"id":         entry.event.ID,
+		})
+	}
+	log.Debug(ctx, "cron event fired", map[string]interface{}{
+		"id":        entry.event.ID,
+		"event_id":  event.ID(),
+		"eventbus":  entry.event.Eventbus,
+		"fire_time": entry.next.Format(time.RFC3339),
+	})
+	return nil
+}
+
+// newCronEvent builds the event fired at t, the event id is unique for each fire time of the cron event.
+func newCronEvent(c *metadata.CronEvent, t time.Time) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID(fmt.Sprintf("%s-%d", c.ID.String(), t.Unix()))
+	e.SetType(c.Template.Type)
+	e.SetSource(c.Template.Source)
+	e.SetTime(t)
+	if c.Template.Subject != "" {
+		e.SetSubject(c.Template.Subject)
+	}
+	if c.Template.DataContentType != "" {
+		e.SetDataContentType(c.Template.DataContentType)
+	}
+	if len(c.Template.Data) > 0 {
+		e.DataEncoded = c.Template.Data
+	}
+	e.SetExtension(xVanusCronEvent, c.ID.String())
+	return &e
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCronScheduler_run(t *testing.T) {
+	Convey("test cron scheduler run", t, func() {
+		ctx := context.Background()
+		tw := newtimingwheel(cfg())
+		mockCtrl := NewController(t)
+		mockStoreCli := kv.NewMockClient(mockCtrl)
+		mockClient := client.NewMockClient(mockCtrl)
+		mockEventbus := api.NewMockEventbus(mockCtrl)
+		mockBusWriter := api.NewMockBusWriter(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), "test-bus").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		tw.kvStore = mockStoreCli
+		tw.client = mockClient
+		cs := newCronScheduler(tw)
+
+		id := vanus.NewTestID()
+		createdAt := time.Date(2022, 10, 14, 10, 0, 30, 0, time.UTC)
+		data, _ := json.Marshal(&metadata.CronEvent{
+			ID:       id,
+			Name:     "test",
+			Schedule: "*/5 * * * *",
+			Eventbus: "test-bus",
+			Template: metadata.CronEventTemplate{
+				Type:            "test.type",
+				Source:          "test.source",
+				DataContentType: ce.ApplicationJSON,
+				Data:            []byte(`{"hello":"world"}`),
+			},
+			CreatedAt: createdAt,
+		})
+		pairs := []kv.Pair{{Key: metadata.GetCronEventKeyInKVStore(id), Value: data}}
+		stateKey := metadata.GetCronStateKeyInKVStore(id)
+
+		Convey("test fire from created time", func() {
+			mockStoreCli.EXPECT().List(Any(), metadata.CronEventKeyPrefixInKVStore).Times(2).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			fireTime := time.Date(2022, 10, 14, 10, 5, 0, 0, time.UTC)
+
+			// not yet
+			cs.run(ctx, fireTime.Add(-time.Second))
+			So(cs.entries[id].next, ShouldEqual, fireTime)
+
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(1).DoAndReturn(
+				func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+					So(e.ID(), ShouldEqual, id.String()+"-1665741900")
+					So(e.Type(), ShouldEqual, "test.type")
+					So(e.Time(), ShouldEqual, fireTime)
+					So(string(e.Data()), ShouldEqual, `{"hello":"world"}`)
+					return "", nil
+				})
+			mockStoreCli.EXPECT().Set(Any(), stateKey, Any()).Times(1).DoAndReturn(
+				func(_ context.Context, _ string, value []byte) error {
+					state := &metadata.CronState{}
+					_ = json.Unmarshal(value, state)
+					So(state.LastFireTime, ShouldEqual, fireTime)
+					return nil
+				})
+			cs.run(ctx, fireTime.Add(time.Second))
+			So(cs.entries[id].next, ShouldEqual, fireTime.Add(5*time.Minute))
+		})
+
+		Convey("test fire only the earliest missed time after recovery", func() {
+			lastFireTime := time.Date(2022, 10, 14, 10, 5, 0, 0, time.UTC)
+			state, _ := json.Marshal(&metadata.CronState{LastFireTime: lastFireTime})
+			mockStoreCli.EXPECT().List(Any(), metadata.CronEventKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(state, nil)
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(1).Return("", nil)
+			mockStoreCli.EXPECT().Set(Any(), stateKey, Any()).Times(1).Return(nil)
+			now := time.Date(2022, 10, 14, 10, 32, 0, 0, time.UTC)
+			cs.run(ctx, now)
+			So(cs.entries[id].next, ShouldEqual, time.Date(2022, 10, 14, 10, 35, 0, 0, time.UTC))
+		})
+
+		Convey("test retry if fire failed", func() {
+			mockStoreCli.EXPECT().List(Any(), metadata.CronEventKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(1).Return("", errors.ErrInternal)
+			fireTime := time.Date(2022, 10, 14, 10, 5, 0, 0, time.UTC)
+			cs.run(ctx, fireTime)
+			So(cs.entries[id].next, ShouldEqual, fireTime)
+		})
+
+		Convey("test remove the deleted cron event", func() {
+			mockStoreCli.EXPECT().List(Any(), metadata.CronEventKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			cs.run(ctx, createdAt)
+			So(cs.entries, ShouldContainKey, id)
+
+			mockStoreCli.EXPECT().List(Any(), metadata.CronEventKeyPrefixInKVStore).Times(1).Return(nil, nil)
+			cs.run(ctx, createdAt)
+			So(cs.entries, ShouldBeEmpty)
+		})
+	})
+}
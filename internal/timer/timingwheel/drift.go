@@ -0,0 +1,161 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+const (
+	// the ops event of drift is emitted at most once in driftAlertInterval.
+	driftAlertInterval = time.Minute
+	opsEventSource     = "vanus-timer"
+	driftAlertType     = "vanus.timer.drift.exceeded"
+)
+
+// driftMonitor measures how late the events fire. The drift of events fired in each tick is aggregated, an ops
+// event is emitted when the max drift exceeds the threshold, which means the timingwheel is overloaded.
+type driftMonitor struct {
+	threshold      time.Duration
+	maxDrift       time.Duration
+	numberOfEvents int64
+	lastAlert      time.Time
+	mu             sync.Mutex
+}
+
+type driftAlert struct {
+	Replica        string  `json:"replica"`
+	MaxDrift       float64 `json:"max_drift_seconds"`
+	Threshold      float64 `json:"threshold_seconds"`
+	NumberOfEvents int64   `json:"number_of_events"`
+	Tick           float64 `json:"tick_seconds"`
+	Time           string  `json:"time"`
+}
+
+func newDriftMonitor(threshold time.Duration) *driftMonitor {
+	return &driftMonitor{
+		threshold: threshold,
+	}
+}
+
+// observe records the drift of a fired event, drift is the actual fire time minus the scheduled one.
+func (dm *driftMonitor) observe(drift time.Duration) {
+	if drift < 0 {
+		drift = 0
+	}
+	metrics.TimerFireDriftSeconds.Observe(drift.Seconds())
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	if drift > dm.maxDrift {
+		dm.maxDrift = drift
+	}
+	dm.numberOfEvents++
+}
+
+// flush returns the max drift and number of events fired in the tick, and starts the next one.
+func (dm *driftMonitor) flush() (time.Duration, int64) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	maxDrift, n := dm.maxDrift, dm.numberOfEvents
+	dm.maxDrift = 0
+	dm.numberOfEvents = 0
+	return maxDrift, n
+}
+
+// shouldAlert returns whether an ops event is emitted for the drift, it's rate limited by driftAlertInterval.
+func (dm *driftMonitor) shouldAlert(drift time.Duration, now time.Time) bool {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	if drift <= dm.threshold || now.Sub(dm.lastAlert) < driftAlertInterval {
+		return false
+	}
+	dm.lastAlert = now
+	return true
+}
+
+func (tw *timingWheel) startDriftMonitoring(ctx context.Context) {
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(tw.config.Tick)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at drift monitoring", nil)
+				return
+			case now := <-ticker.C:
+				tw.checkDrift(ctx, now)
+			}
+		}
+	}()
+}
+
+func (tw *timingWheel) checkDrift(ctx context.Context, now time.Time) {
+	maxDrift, n := tw.drift.flush()
+	if n == 0 {
+		return
+	}
+	metrics.TimerTickDriftSeconds.Observe(maxDrift.Seconds())
+	if !tw.IsLeader() || !tw.drift.shouldAlert(maxDrift, now) {
+		return
+	}
+	log.Warning(ctx, "the drift of timer exceeds the threshold", map[string]interface{}{
+		"max_drift":        maxDrift,
+		"threshold":        tw.drift.threshold,
+		"number_of_events": n,
+	})
+	alert := &driftAlert{
+		Replica:        tw.config.ReplicaName,
+		MaxDrift:       maxDrift.Seconds(),
+		Threshold:      tw.drift.threshold.Seconds(),
+		NumberOfEvents: n,
+		Tick:           tw.config.Tick.Seconds(),
+		Time:           now.UTC().Format(time.RFC3339Nano),
+	}
+	if err := tw.emitOpsEvent(ctx, driftAlertType, alert); err != nil {
+		log.Warning(ctx, "emit ops event of timer drift failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return
+	}
+	metrics.TimerDriftAlertCounter.Inc()
+}
+
+// emitOpsEvent writes an event to the ops eventbus, the operators subscribe to it to get notified.
+func (tw *timingWheel) emitOpsEvent(ctx context.Context, eventType string, data interface{}) error {
+	if err := tw.ctrl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.OpsEventbusName,
+		"System Eventbus For Ops Events"); err != nil {
+		return err
+	}
+	e := ce.NewEvent()
+	e.SetID(uuid.NewString())
+	e.SetSource(opsEventSource)
+	e.SetType(eventType)
+	e.SetTime(tw.config.clock().Now())
+	if err := e.SetData(ce.ApplicationJSON, data); err != nil {
+		return err
+	}
+	_, err := tw.client.Eventbus(ctx, primitive.OpsEventbusName).Writer().AppendOne(ctx, &e)
+	return err
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDriftMonitor(t *testing.T) {
+	Convey("test drift monitor", t, func() {
+		dm := newDriftMonitor(time.Second)
+		now := time.Now()
+
+		Convey("test observe and flush", func() {
+			dm.observe(-time.Second)
+			dm.observe(3 * time.Second)
+			dm.observe(time.Second)
+			maxDrift, n := dm.flush()
+			So(maxDrift, ShouldEqual, 3*time.Second)
+			So(n, ShouldEqual, 3)
+			maxDrift, n = dm.flush()
+			So(maxDrift, ShouldEqual, 0)
+			So(n, ShouldEqual, 0)
+		})
+
+		Convey("test alert is rate limited", func() {
+			So(dm.shouldAlert(time.Second, now), ShouldBeFalse)
+			So(dm.shouldAlert(2*time.Second, now), ShouldBeTrue)
+			So(dm.shouldAlert(2*time.Second, now.Add(time.Second)), ShouldBeFalse)
+			So(dm.shouldAlert(2*time.Second, now.Add(driftAlertInterval)), ShouldBeTrue)
+		})
+	})
+}
+
+func TestTimingWheel_checkDrift(t *testing.T) {
+	Convey("test timingwheel check drift", t, func() {
+		ctx := context.Background()
+		c := cfg()
+		c.DriftThreshold = time.Second
+		c.ReplicaName = "ut"
+		tw := newtimingwheel(c)
+		tw.SetLeader(true)
+		mockCtrl := NewController(t)
+		mockClient := client.NewMockClient(mockCtrl)
+		mockEventbus := api.NewMockEventbus(mockCtrl)
+		mockBusWriter := api.NewMockBusWriter(mockCtrl)
+		mockCl := cluster.NewMockCluster(mockCtrl)
+		mockSvc := cluster.NewMockEventbusService(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), primitive.OpsEventbusName).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		mockCl.EXPECT().EventbusService().AnyTimes().Return(mockSvc)
+		tw.client = mockClient
+		tw.ctrl = mockCl
+		now := time.Now()
+
+		Convey("test drift within the threshold", func() {
+			tw.drift.observe(500 * time.Millisecond)
+			tw.checkDrift(ctx, now)
+		})
+
+		Convey("test drift exceeds the threshold", func() {
+			mockSvc.EXPECT().CreateSystemEventbusIfNotExist(Any(), primitive.OpsEventbusName, Any()).
+				Times(1).Return(nil)
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(1).DoAndReturn(
+				func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+					So(e.Type(), ShouldEqual, driftAlertType)
+					alert := &driftAlert{}
+					So(json.Unmarshal(e.Data(), alert), ShouldBeNil)
+					So(alert.Replica, ShouldEqual, "ut")
+					So(alert.MaxDrift, ShouldEqual, 3)
+					So(alert.NumberOfEvents, ShouldEqual, 2)
+					return "", nil
+				})
+			tw.drift.observe(3 * time.Second)
+			tw.drift.observe(time.Second)
+			tw.checkDrift(ctx, now)
+			// rate limited in the same interval
+			tw.drift.observe(3 * time.Second)
+			tw.checkDrift(ctx, now.Add(time.Second))
+		})
+
+		Convey("test follower emits nothing", func() {
+			tw.SetLeader(false)
+			tw.drift.observe(3 * time.Second)
+			tw.checkDrift(ctx, now)
+		})
+
+		Convey("test emit ops event failed", func() {
+			mockSvc.EXPECT().CreateSystemEventbusIfNotExist(Any(), Any(), Any()).Times(1).Return(stderr.New("test"))
+			tw.drift.observe(3 * time.Second)
+			tw.checkDrift(ctx, now)
+		})
+	})
+}
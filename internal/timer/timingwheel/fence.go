@@ -0,0 +1,396 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	// the fired events are remembered in the window, the copies of an event replayed after failover
+	// arrive at distribution station within it.
+	dedupWindow = time.Minute
+
+	// the max number of events scanned in each eventlog of the target eventbus, when checking whether
+	// the in-flight events of the previous leader have been fired.
+	maxNumberOfEventsScanned = 10000
+
+	// number of events read each time when scanning.
+	numberOfEventsScanned = 64
+)
+
+var (
+	errFenced          = stderr.New("fenced by a newer leader")
+	errFenceNotAcquire = stderr.New("the fence hasn't been acquired")
+)
+
+type firedEntry struct {
+	key string
+	at  time.Time
+}
+
+// fence makes sure a failover of leader neither fires an event twice nor skips it.
+//   - Each leader increases the epoch when elected, and the fired checkpoint is always compared and
+//     swapped, so the checkpoint written by the new leader fences off the old one.
+//   - The distribution station saves the range of in-flight events before firing them. The new leader
+//     checks the target eventbus for them, and skips the ones which have been fired.
+//   - The events replayed from the saved offset of buckets are copies of the events which may have been
+//     fired, so the events fired in the recent window are remembered and their copies are skipped.
+type fence struct {
+	tw         *timingWheel
+	epoch      uint64
+	checkpoint *metadata.FiredCheckpoint
+	// the last checkpoint written, it's compared when swapping.
+	raw []byte
+	// the keys of fired events, and element of queue is *firedEntry in the order of fire time.
+	fired map[string]time.Time
+	queue *list.List
+	mu    sync.Mutex
+}
+
+func newFence(tw *timingWheel) *fence {
+	return &fence{
+		tw:    tw,
+		fired: make(map[string]time.Time),
+		queue: list.New(),
+	}
+}
+
+// firedKey is the identity of a delayed event, all copies of the event have the same key.
+func firedKey(ebName string, e *ce.Event) string {
+	return fmt.Sprintf("%s/%s/%s/%v", ebName, e.Source(), e.ID(), e.Extensions()[xVanusDeliveryTime])
+}
+
+// acquire increases the epoch and takes over the fired checkpoint, offset is the saved offset of
+// distribution station before the checkpoint was introduced.
+func (f *fence) acquire(ctx context.Context, offset int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	epoch, err := f.increaseEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	prev := &metadata.FiredCheckpoint{Offset: offset, InFlight: offset}
+	raw, err := f.tw.kvStore.Get(ctx, metadata.FiredCheckpointKeyInKVStore)
+	if err != nil && !stderr.Is(err, kv.ErrKeyNotFound) {
+		return err
+	}
+	if err == nil {
+		if err = json.Unmarshal(raw, prev); err != nil {
+			return err
+		}
+	}
+
+	f.resetFired()
+	if err == nil {
+		// the events fired by the previous leader are unknown until now
+		if err = f.recall(ctx, prev); err != nil {
+			return err
+		}
+	}
+
+	cp := *prev
+	cp.Epoch = epoch
+	data, _ := json.Marshal(&cp)
+	if raw == nil {
+		err = f.tw.kvStore.Create(ctx, metadata.FiredCheckpointKeyInKVStore, data)
+	} else {
+		err = f.tw.kvStore.CompareAndSwap(ctx, metadata.FiredCheckpointKeyInKVStore, raw, data)
+	}
+	if err != nil {
+		return err
+	}
+	f.epoch = epoch
+	f.checkpoint = &cp
+	f.raw = data
+	log.Info(ctx, "acquired the fence of timer", map[string]interface{}{
+		"epoch":     epoch,
+		"offset":    cp.Offset,
+		"in_flight": cp.InFlight,
+	})
+	return nil
+}
+
+func (f *fence) increaseEpoch(ctx context.Context) (uint64, error) {
+	raw, err := f.tw.kvStore.Get(ctx, metadata.EpochKeyInKVStore)
+	if err != nil && !stderr.Is(err, kv.ErrKeyNotFound) {
+		return 0, err
+	}
+	epoch := &metadata.Epoch{}
+	if err == nil {
+		if err = json.Unmarshal(raw, epoch); err != nil {
+			return 0, err
+		}
+	}
+	epoch.Epoch++
+	epoch.Leader = f.tw.config.ReplicaName
+	data, _ := json.Marshal(epoch)
+	if raw == nil {
+		err = f.tw.kvStore.Create(ctx, metadata.EpochKeyInKVStore, data)
+	} else {
+		err = f.tw.kvStore.CompareAndSwap(ctx, metadata.EpochKeyInKVStore, raw, data)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return epoch.Epoch, nil
+}
+
+// begin saves the end of in-flight events before they are fired.
+func (f *fence) begin(ctx context.Context, end int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.checkpoint == nil {
+		return errFenceNotAcquire
+	}
+	cp := *f.checkpoint
+	if cp.InFlight <= cp.Offset {
+		cp.Time = f.tw.config.clock().Now()
+	}
+	if end > cp.InFlight {
+		cp.InFlight = end
+	}
+	return f.swap(ctx, &cp)
+}
+
+// commit saves the offset before which all events have been fired.
+func (f *fence) commit(ctx context.Context, offset int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.checkpoint == nil {
+		return errFenceNotAcquire
+	}
+	cp := *f.checkpoint
+	cp.Offset = offset
+	if cp.InFlight < offset {
+		cp.InFlight = offset
+	}
+	return f.swap(ctx, &cp)
+}
+
+func (f *fence) swap(ctx context.Context, cp *metadata.FiredCheckpoint) error {
+	data, _ := json.Marshal(cp)
+	err := f.tw.kvStore.CompareAndSwap(ctx, metadata.FiredCheckpointKeyInKVStore, f.raw, data)
+	if stderr.Is(err, kv.ErrSetFailed) {
+		log.Error(ctx, "the fired checkpoint has been taken over by a newer leader, step down", map[string]interface{}{
+			"epoch": f.epoch,
+		})
+		f.checkpoint = nil
+		f.tw.SetLeader(false)
+		return errFenced
+	}
+	if err != nil {
+		return err
+	}
+	f.raw = data
+	f.checkpoint = cp
+	return nil
+}
+
+func (f *fence) getEpoch() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.epoch
+}
+
+func (f *fence) getFiredOffset() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.checkpoint == nil {
+		return 0
+	}
+	return f.checkpoint.Offset
+}
+
+// markFiring marks the event as fired, and returns false if it has been marked, e.g. a copy of it is fired.
+func (f *fence) markFiring(key string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := f.tw.config.clock().Now()
+	f.expireFired(now)
+	if _, ok := f.fired[key]; ok {
+		return false
+	}
+	f.fired[key] = now
+	f.queue.PushBack(&firedEntry{key: key, at: now})
+	return true
+}
+
+// unmark removes the mark of the event which failed to fire.
+func (f *fence) unmark(key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.fired, key)
+}
+
+func (f *fence) expireFired(now time.Time) {
+	for e := f.queue.Front(); e != nil; e = f.queue.Front() {
+		entry := e.Value.(*firedEntry)
+		if now.Sub(entry.at) < dedupWindow {
+			return
+		}
+		f.queue.Remove(e)
+		if at, ok := f.fired[entry.key]; ok && at.Equal(entry.at) {
+			delete(f.fired, entry.key)
+		}
+	}
+}
+
+func (f *fence) resetFired() {
+	f.fired = make(map[string]time.Time)
+	f.queue.Init()
+}
+
+func (f *fence) addFired(key string, at time.Time) {
+	f.fired[key] = at
+	f.queue.PushBack(&firedEntry{key: key, at: at})
+}
+
+// recall remembers the events fired by the previous leader. The events fired in the recent window are
+// read from distribution station, and the in-flight events are checked in their target eventbus.
+func (f *fence) recall(ctx context.Context, cp *metadata.FiredCheckpoint) error {
+	now := f.tw.config.clock().Now()
+	ebName := f.tw.distributionStation.getEventbus()
+	ls, err := f.tw.client.Eventbus(ctx, ebName).ListLog(ctx)
+	if err != nil {
+		return err
+	}
+	start, err := ls[0].QueryOffsetByTime(ctx, cp.Time.Add(-dedupWindow).UnixMilli())
+	if err != nil {
+		return err
+	}
+	if start < 0 || start > cp.Offset {
+		start = cp.Offset
+	}
+	events, err := f.readEvents(ctx, ebName, ls[0], start, cp.InFlight-start)
+	if err != nil {
+		return err
+	}
+	inDoubt := make(map[string]map[string]struct{})
+	for idx, e := range events {
+		var target string
+		if err = e.ExtensionAs(xVanusEventbus, &target); err != nil {
+			continue
+		}
+		key := firedKey(target, e)
+		if start+int64(idx) < cp.Offset {
+			f.addFired(key, now)
+			continue
+		}
+		if _, ok := inDoubt[target]; !ok {
+			inDoubt[target] = make(map[string]struct{})
+		}
+		inDoubt[target][key] = struct{}{}
+	}
+	for target, keys := range inDoubt {
+		if err = f.scan(ctx, target, keys, cp.Time); err != nil {
+			return err
+		}
+		for key := range keys {
+			log.Info(ctx, "in-flight event has been fired by the previous leader, skip it", map[string]interface{}{
+				"key": key,
+			})
+			f.addFired(key, now)
+		}
+	}
+	return nil
+}
+
+// scan reads the target eventbus from the time when the in-flight events began to fire, and keeps the
+// keys of events found in it.
+func (f *fence) scan(ctx context.Context, target string, keys map[string]struct{}, since time.Time) error {
+	ls, err := f.tw.client.Eventbus(ctx, target).ListLog(ctx)
+	if err != nil {
+		// the eventbus may have been deleted, the events will be discarded when delivering
+		for key := range keys {
+			delete(keys, key)
+		}
+		return nil //nolint:nilerr // it's expected.
+	}
+	found := make(map[string]struct{}, len(keys))
+	for _, el := range ls {
+		offset, err := el.QueryOffsetByTime(ctx, since.UnixMilli())
+		if err != nil {
+			return err
+		}
+		if offset < 0 {
+			continue
+		}
+		events, err := f.readEvents(ctx, target, el, offset, maxNumberOfEventsScanned)
+		if err != nil {
+			return err
+		}
+		if len(events) == maxNumberOfEventsScanned {
+			log.Warning(ctx, "too many events to scan, the in-flight events may be fired twice", map[string]interface{}{
+				"eventbus": target,
+				"eventlog": el.ID(),
+			})
+		}
+		for _, e := range events {
+			key := firedKey(target, e)
+			if _, ok := keys[key]; ok {
+				found[key] = struct{}{}
+			}
+		}
+	}
+	for key := range keys {
+		if _, ok := found[key]; !ok {
+			delete(keys, key)
+		}
+	}
+	return nil
+}
+
+// readEvents reads at most number events of the eventlog from offset.
+func (f *fence) readEvents(ctx context.Context, ebName string, el api.Eventlog,
+	offset, number int64) ([]*ce.Event, error) {
+	reader := f.tw.client.Eventbus(ctx, ebName).Reader(option.WithDisablePolling())
+	result := make([]*ce.Event, 0)
+	for int64(len(result)) < number {
+		size := number - int64(len(result))
+		if size > numberOfEventsScanned {
+			size = numberOfEventsScanned
+		}
+		events, _, _, err := reader.Read(ctx,
+			option.WithReadPolicy(policy.NewManuallyReadPolicy(el, offset+int64(len(result)))),
+			option.WithBatchSize(int(size)))
+		if err != nil {
+			if errors.Is(err, errors.ErrOffsetOnEnd) {
+				break
+			}
+			return nil, err
+		}
+		if len(events) == 0 {
+			break
+		}
+		result = append(result, events...)
+	}
+	return result, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func firedEvent(id string) *ce.Event {
+	e := event(0)
+	e.SetID(id)
+	e.SetSource("ut")
+	return e
+}
+
+func TestFence_markFiring(t *testing.T) {
+	Convey("test fence mark firing", t, func() {
+		f := newFence(newtimingwheel(cfg()))
+		key := firedKey("quick-start", firedEvent("ut"))
+
+		So(f.markFiring(key), ShouldBeTrue)
+		So(f.markFiring(key), ShouldBeFalse)
+		f.unmark(key)
+		So(f.markFiring(key), ShouldBeTrue)
+
+		Convey("test the mark expires after the window", func() {
+			f.expireFired(time.Now().Add(dedupWindow))
+			So(f.markFiring(key), ShouldBeTrue)
+		})
+	})
+}
+
+func TestFence_beginAndCommit(t *testing.T) {
+	Convey("test fence begin and commit", t, func() {
+		ctx := context.Background()
+		tw := newtimingwheel(cfg())
+		tw.SetLeader(true)
+		mockCtrl := NewController(t)
+		mockStoreCli := kv.NewMockClient(mockCtrl)
+		tw.kvStore = mockStoreCli
+		f := tw.fence
+
+		Convey("test begin before acquired", func() {
+			So(f.begin(ctx, 1), ShouldEqual, errFenceNotAcquire)
+			So(f.commit(ctx, 1), ShouldEqual, errFenceNotAcquire)
+		})
+
+		f.checkpoint = &metadata.FiredCheckpoint{Epoch: 1}
+		f.raw, _ = json.Marshal(f.checkpoint)
+
+		Convey("test begin and commit success", func() {
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), metadata.FiredCheckpointKeyInKVStore, Any(), Any()).
+				Times(3).Return(nil)
+			So(f.begin(ctx, 2), ShouldBeNil)
+			So(f.checkpoint.InFlight, ShouldEqual, 2)
+			began := f.checkpoint.Time
+			So(began.IsZero(), ShouldBeFalse)
+			So(f.begin(ctx, 4), ShouldBeNil)
+			So(f.checkpoint.InFlight, ShouldEqual, 4)
+			// the time is kept until all in-flight events are fired
+			So(f.checkpoint.Time, ShouldEqual, began)
+			So(f.commit(ctx, 2), ShouldBeNil)
+			So(f.getFiredOffset(), ShouldEqual, 2)
+			So(f.checkpoint.InFlight, ShouldEqual, 4)
+		})
+
+		Convey("test fenced by a newer leader", func() {
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), Any(), Any(), Any()).Times(1).Return(kv.ErrSetFailed)
+			So(f.begin(ctx, 2), ShouldEqual, errFenced)
+			So(tw.IsLeader(), ShouldBeFalse)
+			So(f.commit(ctx, 2), ShouldEqual, errFenceNotAcquire)
+		})
+
+		Convey("test commit failed", func() {
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), Any(), Any(), Any()).Times(1).Return(stderr.New("test"))
+			So(f.commit(ctx, 2), ShouldNotBeNil)
+			So(tw.IsLeader(), ShouldBeTrue)
+			So(f.getFiredOffset(), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestFence_acquire(t *testing.T) {
+	Convey("test fence acquire", t, func() {
+		ctx := context.Background()
+		tw := newtimingwheel(cfg())
+		mockCtrl := NewController(t)
+		mockStoreCli := kv.NewMockClient(mockCtrl)
+		mockClient := client.NewMockClient(mockCtrl)
+		mockEventbus := api.NewMockEventbus(mockCtrl)
+		mockEventlog := api.NewMockEventlog(mockCtrl)
+		mockBusReader := api.NewMockBusReader(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Reader(Any()).AnyTimes().Return(mockBusReader)
+		mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+		mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+		tw.kvStore = mockStoreCli
+		tw.client = mockClient
+		f := tw.fence
+
+		epoch, _ := json.Marshal(&metadata.Epoch{Epoch: 3, Leader: "ut"})
+		checkpoint, _ := json.Marshal(&metadata.FiredCheckpoint{Epoch: 3, Offset: 2, InFlight: 4, Time: time.Now()})
+		mockStoreCli.EXPECT().Get(Any(), metadata.EpochKeyInKVStore).AnyTimes().Return(epoch, nil)
+		mockStoreCli.EXPECT().Get(Any(), metadata.FiredCheckpointKeyInKVStore).AnyTimes().Return(checkpoint, nil)
+
+		Convey("test acquire with epoch taken by another replica", func() {
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), metadata.EpochKeyInKVStore, epoch, Any()).
+				Times(1).Return(kv.ErrSetFailed)
+			err := f.acquire(ctx, 0)
+			So(err, ShouldNotBeNil)
+			So(f.getEpoch(), ShouldEqual, 0)
+		})
+
+		Convey("test acquire and recall the fired events", func() {
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), metadata.EpochKeyInKVStore, epoch, Any()).
+				Times(1).Return(nil)
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), metadata.FiredCheckpointKeyInKVStore, checkpoint, Any()).
+				Times(1).Return(nil)
+			mockEventlog.EXPECT().QueryOffsetByTime(Any(), Any()).AnyTimes().Return(int64(0), nil)
+			// a and b were fired, c and d were in flight and only c arrived at the target eventbus
+			events := []*ce.Event{firedEvent("a"), firedEvent("b"), firedEvent("c"), firedEvent("d")}
+			InOrder(
+				mockBusReader.EXPECT().Read(Any(), Any(), Any()).Times(1).Return(events, int64(0), uint64(0), nil),
+				mockBusReader.EXPECT().Read(Any(), Any(), Any()).Times(1).
+					Return([]*ce.Event{events[2]}, int64(0), uint64(0), nil),
+				mockBusReader.EXPECT().Read(Any(), Any(), Any()).Times(1).
+					Return(nil, int64(0), uint64(0), errors.ErrOffsetOnEnd),
+			)
+			err := f.acquire(ctx, 0)
+			So(err, ShouldBeNil)
+			So(f.getEpoch(), ShouldEqual, 4)
+			So(f.getFiredOffset(), ShouldEqual, 2)
+			So(f.markFiring(firedKey("quick-start", events[0])), ShouldBeFalse)
+			So(f.markFiring(firedKey("quick-start", events[1])), ShouldBeFalse)
+			So(f.markFiring(firedKey("quick-start", events[2])), ShouldBeFalse)
+			So(f.markFiring(firedKey("quick-start", events[3])), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,356 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/ohler55/ojg/jp"
+	"github.com/ohler55/ojg/oj"
+)
+
+const (
+	httpPollerCheckInterval = time.Second
+	httpPollTimeout         = 10 * time.Second
+	// maxHTTPPollResponseSize limits the response read from the endpoint, the larger one fails.
+	maxHTTPPollResponseSize = 10 * 1024 * 1024
+	// maxHTTPPollerKeys is the number of latest published keys remembered by a poller, it should be
+	// larger than the items returned by a poll, otherwise the older items may be published again.
+	maxHTTPPollerKeys = 10000
+	// the extension attribute of published event, the value is the id of HTTP poller.
+	xVanusHTTPPoller = "xvanushttppoller"
+)
+
+var errHTTPPollStatus = stderr.New("unexpected status code of HTTP poll")
+
+type httpPollerEntry struct {
+	poller *metadata.HTTPPoller
+	state  *metadata.HTTPPollerState
+	seen   map[string]struct{}
+	next   time.Time
+}
+
+// httpPollerScheduler runs the HTTP pollers created by controller. Only the leader of timer polls, the
+// keys of published items are saved in kv after each poll, so the new leader doesn't publish them again.
+// The items of a poll may be published twice if the leader changed before its state was saved.
+type httpPollerScheduler struct {
+	tw      *timingWheel
+	client  *http.Client
+	entries map[vanus.ID]*httpPollerEntry
+}
+
+func newHTTPPollerScheduler(tw *timingWheel) *httpPollerScheduler {
+	return &httpPollerScheduler{
+		tw:      tw,
+		client:  &http.Client{Timeout: httpPollTimeout},
+		entries: map[vanus.ID]*httpPollerEntry{},
+	}
+}
+
+func (tw *timingWheel) startHTTPPollerScheduler(ctx context.Context) {
+	ps := newHTTPPollerScheduler(tw)
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(httpPollerCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at HTTP poller scheduler", nil)
+				return
+			case <-ticker.C:
+				if !tw.IsLeader() {
+					// reload the poll progress from kv when becoming leader again
+					ps.reset()
+					break
+				}
+				ps.run(ctx, tw.config.clock().Now())
+			}
+		}
+	}()
+}
+
+func (ps *httpPollerScheduler) reset() {
+	if len(ps.entries) > 0 {
+		ps.entries = map[vanus.ID]*httpPollerEntry{}
+	}
+}
+
+func (ps *httpPollerScheduler) run(ctx context.Context, now time.Time) {
+	if err := ps.load(ctx); err != nil {
+		log.Warning(ctx, "load HTTP pollers failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return
+	}
+	for _, entry := range ps.entries {
+		if entry.poller.Paused || entry.next.After(now) {
+			continue
+		}
+		// the failed poll is retried at next interval rather than next check, so that the
+		// endpoint isn't flooded when it's unavailable.
+		entry.next = now.Add(entry.poller.Interval)
+		if err := ps.poll(ctx, entry, now); err != nil {
+			log.Warning(ctx, "poll HTTP endpoint failed, retry at next interval", map[string]interface{}{
+				log.KeyError: err,
+				"id":         entry.poller.ID,
+				"url":        entry.poller.URL,
+				"eventbus":   entry.poller.Eventbus,
+			})
+		}
+	}
+}
+
+// load synchronizes the entries with the HTTP pollers in kv, the paused flag is updated by controller
+// so the definition of loaded entries is refreshed too.
+func (ps *httpPollerScheduler) load(ctx context.Context) error {
+	pairs, err := ps.tw.kvStore.List(ctx, metadata.HTTPPollerKeyPrefixInKVStore)
+	if err != nil {
+		return err
+	}
+	exist := make(map[vanus.ID]struct{}, len(pairs))
+	for _, pair := range pairs {
+		poller := &metadata.HTTPPoller{}
+		if err = json.Unmarshal(pair.Value, poller); err != nil {
+			log.Warning(ctx, "unmarshal HTTP poller failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		exist[poller.ID] = struct{}{}
+		if entry, ok := ps.entries[poller.ID]; ok {
+			entry.poller = poller
+			continue
+		}
+		entry, err := ps.newEntry(ctx, poller)
+		if err != nil {
+			log.Warning(ctx, "load HTTP poller failed", map[string]interface{}{
+				log.KeyError: err,
+				"id":         poller.ID,
+			})
+			continue
+		}
+		ps.entries[poller.ID] = entry
+	}
+	for id := range ps.entries {
+		if _, ok := exist[id]; !ok {
+			delete(ps.entries, id)
+		}
+	}
+	return nil
+}
+
+func (ps *httpPollerScheduler) newEntry(ctx context.Context,
+	poller *metadata.HTTPPoller) (*httpPollerEntry, error) {
+	state := &metadata.HTTPPollerState{}
+	data, err := ps.tw.kvStore.Get(ctx, metadata.GetHTTPPollerStateKeyInKVStore(poller.ID))
+	if err != nil && !stderr.Is(err, kv.ErrKeyNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		if err = json.Unmarshal(data, state); err != nil {
+			return nil, err
+		}
+	}
+	seen := make(map[string]struct{}, len(state.Keys))
+	for _, key := range state.Keys {
+		seen[key] = struct{}{}
+	}
+	return &httpPollerEntry{
+		poller: poller,
+		state:  state,
+		seen:   seen,
+		next:   state.LastPollTime.Add(poller.Interval),
+	}, nil
+}
+
+func (ps *httpPollerScheduler) poll(ctx context.Context, entry *httpPollerEntry, now time.Time) error {
+	body, err := ps.request(ctx, entry.poller)
+	if err != nil {
+		return err
+	}
+	items, err := extractItems(body, entry.poller.ItemsPath)
+	if err != nil {
+		return err
+	}
+	var (
+		events = make([]*ce.Event, 0, len(items))
+		keys   = make([]string, 0, len(items))
+	)
+	for idx, item := range items {
+		key := ""
+		if entry.poller.DedupKey != "" {
+			if key, err = itemKey(item, entry.poller.DedupKey); err != nil {
+				log.Warning(ctx, "the dedup key of item is missing, skip it", map[string]interface{}{
+					log.KeyError: err,
+					"id":         entry.poller.ID,
+				})
+				continue
+			}
+			if _, ok := entry.seen[key]; ok {
+				continue
+			}
+			keys = append(keys, key)
+		}
+		event, err := newHTTPPollerEvent(entry.poller, item, key, now, idx)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+	if len(events) > 0 {
+		_, err = ps.tw.client.Eventbus(ctx, entry.poller.Eventbus).Writer().AppendMany(ctx, events)
+		if err != nil {
+			return err
+		}
+	}
+	entry.remember(keys)
+	entry.state.LastPollTime = now
+	data, _ := json.Marshal(entry.state)
+	if err = ps.tw.kvStore.Set(ctx, metadata.GetHTTPPollerStateKeyInKVStore(entry.poller.ID), data); err != nil {
+		// the items have been published, they may be published again after the leader changed.
+		log.Warning(ctx, "save HTTP poller state failed", map[string]interface{}{
+			log.KeyError: err,
+			"id":         entry.poller.ID,
+		})
+	}
+	log.Debug(ctx, "HTTP endpoint polled", map[string]interface{}{
+		"id":        entry.poller.ID,
+		"eventbus":  entry.poller.Eventbus,
+		"items":     len(items),
+		"published": len(events),
+	})
+	return nil
+}
+
+func (ps *httpPollerScheduler) request(ctx context.Context, poller *metadata.HTTPPoller) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, poller.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range poller.Headers {
+		req.Header.Set(k, v)
+	}
+	switch poller.Auth.Type {
+	case metadata.HTTPPollerAuthBasic:
+		req.SetBasicAuth(poller.Auth.Username, poller.Auth.Password)
+	case metadata.HTTPPollerAuthBearer:
+		req.Header.Set("Authorization", "Bearer "+poller.Auth.Token)
+	}
+	resp, err := ps.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%w: %d", errHTTPPollStatus, resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPPollResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxHTTPPollResponseSize {
+		return nil, fmt.Errorf("the response exceeds %d bytes", maxHTTPPollResponseSize)
+	}
+	return body, nil
+}
+
+// remember adds the keys of published items, the oldest keys are dropped when exceeding the limit.
+func (e *httpPollerEntry) remember(keys []string) {
+	for _, key := range keys {
+		e.seen[key] = struct{}{}
+	}
+	e.state.Keys = append(e.state.Keys, keys...)
+	if n := len(e.state.Keys) - maxHTTPPollerKeys; n > 0 {
+		for _, key := range e.state.Keys[:n] {
+			delete(e.seen, key)
+		}
+		e.state.Keys = append([]string{}, e.state.Keys[n:]...)
+	}
+}
+
+// extractItems returns the items matched by the JSONPath in the response, if the path matches an array,
+// its elements are the items. The whole response is an item if the path is empty.
+func extractItems(body []byte, path string) ([]interface{}, error) {
+	obj, err := oj.Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return []interface{}{obj}, nil
+	}
+	p, err := jp.ParseString(path)
+	if err != nil {
+		return nil, err
+	}
+	res := p.Get(obj)
+	if len(res) == 1 {
+		if arr, ok := res[0].([]interface{}); ok {
+			return arr, nil
+		}
+	}
+	return res, nil
+}
+
+func itemKey(item interface{}, path string) (string, error) {
+	p, err := jp.ParseString(path)
+	if err != nil {
+		return "", err
+	}
+	res := p.Get(item)
+	if len(res) == 0 || res[0] == nil {
+		return "", fmt.Errorf("no value at %s", path)
+	}
+	if s, ok := res[0].(string); ok {
+		return s, nil
+	}
+	return oj.JSON(res[0]), nil
+}
+
+// newHTTPPollerEvent builds the event of an item, the event id is derived from the dedup key if it's set,
+// so the same item has the same id even if it's published twice.
+func newHTTPPollerEvent(p *metadata.HTTPPoller, item interface{}, key string,
+	t time.Time, idx int) (*ce.Event, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	e := ce.NewEvent()
+	if key != "" {
+		e.SetID(fmt.Sprintf("%s-%s", p.ID.String(), key))
+	} else {
+		e.SetID(fmt.Sprintf("%s-%d-%d", p.ID.String(), t.UnixMilli(), idx))
+	}
+	e.SetType(p.EventType)
+	e.SetSource(p.EventSource)
+	e.SetTime(t)
+	e.SetDataContentType(ce.ApplicationJSON)
+	e.DataEncoded = data
+	e.SetExtension(xVanusHTTPPoller, p.ID.String())
+	return &e, nil
+}
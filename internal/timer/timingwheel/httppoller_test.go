@@ -0,0 +1,196 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHTTPPollerScheduler_run(t *testing.T) {
+	Convey("test HTTP poller scheduler run", t, func() {
+		ctx := context.Background()
+		tw := newtimingwheel(cfg())
+		mockCtrl := NewController(t)
+		mockStoreCli := kv.NewMockClient(mockCtrl)
+		mockClient := client.NewMockClient(mockCtrl)
+		mockEventbus := api.NewMockEventbus(mockCtrl)
+		mockBusWriter := api.NewMockBusWriter(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), "test-bus").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		tw.kvStore = mockStoreCli
+		tw.client = mockClient
+		ps := newHTTPPollerScheduler(tw)
+
+		var (
+			response = `{"data":{"items":[{"id":"a","v":1},{"id":"b","v":2}]}}`
+			auth     string
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte(response))
+		}))
+		defer server.Close()
+
+		id := vanus.NewTestID()
+		poller := &metadata.HTTPPoller{
+			ID:          id,
+			Name:        "test",
+			Eventbus:    "test-bus",
+			URL:         server.URL,
+			Auth:        metadata.HTTPPollerAuth{Type: metadata.HTTPPollerAuthBearer, Token: "secret"},
+			Interval:    time.Minute,
+			ItemsPath:   "$.data.items",
+			DedupKey:    "$.id",
+			EventType:   "test.type",
+			EventSource: "test.source",
+		}
+		data, _ := json.Marshal(poller)
+		pairs := []kv.Pair{{Key: metadata.GetHTTPPollerKeyInKVStore(id), Value: data}}
+		stateKey := metadata.GetHTTPPollerStateKeyInKVStore(id)
+		now := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+
+		Convey("test publish the new items only", func() {
+			mockStoreCli.EXPECT().List(Any(), metadata.HTTPPollerKeyPrefixInKVStore).Times(3).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			var published []*ce.Event
+			mockBusWriter.EXPECT().AppendMany(Any(), Any()).Times(2).DoAndReturn(
+				func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) (string, error) {
+					published = append(published, events...)
+					return "", nil
+				})
+			var saved []byte
+			mockStoreCli.EXPECT().Set(Any(), stateKey, Any()).Times(2).DoAndReturn(
+				func(_ context.Context, _ string, value []byte) error {
+					saved = value
+					return nil
+				})
+
+			ps.run(ctx, now)
+			So(auth, ShouldEqual, "Bearer secret")
+			So(published, ShouldHaveLength, 2)
+			So(published[0].ID(), ShouldEqual, id.String()+"-a")
+			So(published[0].Type(), ShouldEqual, "test.type")
+			So(published[0].Source(), ShouldEqual, "test.source")
+			So(string(published[0].Data()), ShouldEqual, `{"id":"a","v":1}`)
+			So(published[0].Extensions()[xVanusHTTPPoller], ShouldEqual, id.String())
+
+			// not yet
+			ps.run(ctx, now.Add(time.Second))
+			So(published, ShouldHaveLength, 2)
+
+			response = `{"data":{"items":[{"id":"b","v":2},{"id":"c","v":3}]}}`
+			ps.run(ctx, now.Add(time.Minute))
+			So(published, ShouldHaveLength, 3)
+			So(published[2].ID(), ShouldEqual, id.String()+"-c")
+			state := &metadata.HTTPPollerState{}
+			So(json.Unmarshal(saved, state), ShouldBeNil)
+			So(state.Keys, ShouldResemble, []string{"a", "b", "c"})
+			So(state.LastPollTime, ShouldEqual, now.Add(time.Minute))
+		})
+
+		Convey("test continue from the saved state", func() {
+			state, _ := json.Marshal(&metadata.HTTPPollerState{Keys: []string{"a"}, LastPollTime: now})
+			mockStoreCli.EXPECT().List(Any(), metadata.HTTPPollerKeyPrefixInKVStore).Times(2).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(state, nil)
+			ps.run(ctx, now.Add(time.Second))
+
+			mockBusWriter.EXPECT().AppendMany(Any(), Any()).Times(1).DoAndReturn(
+				func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) (string, error) {
+					So(events, ShouldHaveLength, 1)
+					So(events[0].ID(), ShouldEqual, id.String()+"-b")
+					return "", nil
+				})
+			mockStoreCli.EXPECT().Set(Any(), stateKey, Any()).Times(1).Return(nil)
+			ps.run(ctx, now.Add(time.Minute))
+		})
+
+		Convey("test paused poller", func() {
+			poller.Paused = true
+			data, _ = json.Marshal(poller)
+			pairs[0].Value = data
+			mockStoreCli.EXPECT().List(Any(), metadata.HTTPPollerKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			ps.run(ctx, now)
+			So(ps.entries, ShouldContainKey, id)
+		})
+
+		Convey("test deleted poller", func() {
+			mockStoreCli.EXPECT().List(Any(), metadata.HTTPPollerKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			So(ps.load(ctx), ShouldBeNil)
+			So(ps.entries, ShouldContainKey, id)
+			mockStoreCli.EXPECT().List(Any(), metadata.HTTPPollerKeyPrefixInKVStore).Times(1).Return(nil, nil)
+			So(ps.load(ctx), ShouldBeNil)
+			So(ps.entries, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestHTTPPoller_extractItems(t *testing.T) {
+	Convey("test extract items", t, func() {
+		body := []byte(`{"data":{"items":[{"id":1},{"id":2}]}}`)
+		items, err := extractItems(body, "$.data.items")
+		So(err, ShouldBeNil)
+		So(items, ShouldHaveLength, 2)
+		items, err = extractItems(body, "$.data.items[*].id")
+		So(err, ShouldBeNil)
+		So(items, ShouldResemble, []interface{}{int64(1), int64(2)})
+		items, err = extractItems(body, "")
+		So(err, ShouldBeNil)
+		So(items, ShouldHaveLength, 1)
+		items, err = extractItems(body, "$.none")
+		So(err, ShouldBeNil)
+		So(items, ShouldBeEmpty)
+		_, err = extractItems([]byte("not json"), "$.data")
+		So(err, ShouldNotBeNil)
+
+		key, err := itemKey(map[string]interface{}{"id": int64(1)}, "$.id")
+		So(err, ShouldBeNil)
+		So(key, ShouldEqual, "1")
+		_, err = itemKey(map[string]interface{}{}, "$.id")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestHTTPPollerEntry_remember(t *testing.T) {
+	Convey("test remember keys", t, func() {
+		e := &httpPollerEntry{state: &metadata.HTTPPollerState{}, seen: map[string]struct{}{}}
+		keys := make([]string, maxHTTPPollerKeys)
+		for i := range keys {
+			keys[i] = strconv.Itoa(i)
+		}
+		e.remember(keys)
+		e.remember([]string{"new"})
+		So(e.state.Keys, ShouldHaveLength, maxHTTPPollerKeys)
+		So(e.seen, ShouldHaveLength, maxHTTPPollerKeys)
+		So(e.seen, ShouldNotContainKey, keys[0])
+		So(e.seen, ShouldContainKey, "new")
+	})
+}
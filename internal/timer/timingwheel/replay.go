@@ -0,0 +1,335 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"strings"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const (
+	replayCheckInterval = time.Second
+	// maxReplayEventsPerCheck limits the events read by a job at each check if its rate isn't limited,
+	// so that a large job doesn't starve the others.
+	maxReplayEventsPerCheck = 10000
+	replayReadBatchSize     = 64
+	// the extension attribute of replayed event, the value is the id of replay job.
+	xVanusReplayJob = "xvanusreplayjob"
+	// the prefix of extension attributes set by vanus, they are removed from the replayed event.
+	xVanusPrefix = "xvanus"
+)
+
+// replayScheduler runs the replay jobs created by controller. Only the leader of timer runs them, the
+// progress is saved in kv after each batch is appended, so the new leader continues from there. The
+// events of a batch may be replayed twice if the leader changed before its progress was saved.
+type replayScheduler struct {
+	tw *timingWheel
+	// completed is the jobs which needn't be checked anymore.
+	completed map[vanus.ID]struct{}
+}
+
+func newReplayScheduler(tw *timingWheel) *replayScheduler {
+	return &replayScheduler{
+		tw:        tw,
+		completed: map[vanus.ID]struct{}{},
+	}
+}
+
+func (tw *timingWheel) startReplayScheduler(ctx context.Context) {
+	rs := newReplayScheduler(tw)
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(replayCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at replay scheduler", nil)
+				return
+			case <-ticker.C:
+				if !tw.IsLeader() {
+					rs.completed = map[vanus.ID]struct{}{}
+					break
+				}
+				rs.run(ctx)
+			}
+		}
+	}()
+}
+
+func (rs *replayScheduler) run(ctx context.Context) {
+	pairs, err := rs.tw.kvStore.List(ctx, metadata.ReplayJobKeyPrefixInKVStore)
+	if err != nil {
+		log.Warning(ctx, "load replay jobs failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return
+	}
+	for _, pair := range pairs {
+		job := &metadata.ReplayJob{}
+		if err = json.Unmarshal(pair.Value, job); err != nil {
+			log.Warning(ctx, "unmarshal replay job failed", map[string]interface{}{
+				log.KeyError: err,
+				"key":        pair.Key,
+			})
+			continue
+		}
+		if _, ok := rs.completed[job.ID]; ok {
+			continue
+		}
+		if err = rs.replay(ctx, job); err != nil {
+			log.Warning(ctx, "replay events failed, retry at next check", map[string]interface{}{
+				log.KeyError: err,
+				"id":         job.ID,
+				"source":     job.SourceEventbus,
+				"target":     job.TargetEventbus,
+			})
+		}
+	}
+}
+
+func (rs *replayScheduler) replay(ctx context.Context, job *metadata.ReplayJob) error {
+	state, err := rs.loadState(ctx, job.ID)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		if state, err = rs.initState(ctx, job); err != nil {
+			return err
+		}
+		if err = rs.saveState(ctx, job, state); err != nil {
+			return err
+		}
+	}
+
+	budget := maxReplayEventsPerCheck
+	if job.RateLimit > 0 && int(job.RateLimit) < budget {
+		// the job is checked every second.
+		budget = int(job.RateLimit)
+	}
+	err = rs.copy(ctx, job, state, budget)
+	if err != nil {
+		state.Error = err.Error()
+		_ = rs.saveState(ctx, job, state)
+		return err
+	}
+	if state.Status() == metadata.ReplayStatusCompleted {
+		rs.completed[job.ID] = struct{}{}
+		log.Info(ctx, "replay job completed", map[string]interface{}{
+			"id":       job.ID,
+			"replayed": state.Replayed,
+			"filtered": state.Filtered,
+		})
+	}
+	return nil
+}
+
+// initState positions the cursors of each eventlog of the source eventbus at the start of range.
+func (rs *replayScheduler) initState(ctx context.Context, job *metadata.ReplayJob) (*metadata.ReplayState, error) {
+	ls, err := rs.tw.client.Eventbus(ctx, job.SourceEventbus).ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state := &metadata.ReplayState{Cursors: make([]*metadata.ReplayCursor, 0, len(ls))}
+	for _, l := range ls {
+		c := &metadata.ReplayCursor{EventlogID: l.ID()}
+		state.Cursors = append(state.Cursors, c)
+		earliest, err := l.EarliestOffset(ctx)
+		if errors.Is(err, errors.ErrNotReadable) {
+			// there are no readable segments yet.
+			c.Done = true
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if c.EndOffset, err = l.LatestOffset(ctx); err != nil {
+			return nil, err
+		}
+		if job.ByOffset {
+			c.Offset = job.StartOffset
+			if c.Offset < earliest {
+				c.Offset = earliest
+			}
+			if job.EndOffset > 0 && job.EndOffset < c.EndOffset {
+				c.EndOffset = job.EndOffset
+			}
+		} else if c.Offset, err = l.QueryOffsetByTime(ctx, job.StartTime.UnixMilli()); err != nil {
+			return nil, err
+		}
+		c.Done = c.Offset < 0 || c.Offset >= c.EndOffset
+	}
+	return state, nil
+}
+
+// copy reads at most budget events of the source eventbus, and appends the ones matching the
+// filters to the target eventbus.
+func (rs *replayScheduler) copy(ctx context.Context, job *metadata.ReplayJob,
+	state *metadata.ReplayState, budget int) error {
+	source := rs.tw.client.Eventbus(ctx, job.SourceEventbus)
+	ls, err := source.ListLog(ctx)
+	if err != nil {
+		return err
+	}
+	logs := make(map[uint64]api.Eventlog, len(ls))
+	for _, l := range ls {
+		logs[l.ID()] = l
+	}
+	writer := rs.tw.client.Eventbus(ctx, job.TargetEventbus).Writer()
+	f := filter.GetFilter(job.Filters)
+
+	for _, c := range state.Cursors {
+		l, ok := logs[c.EventlogID]
+		if !ok {
+			// the eventlog has been deleted.
+			c.Done = true
+		}
+		for !c.Done && budget > 0 {
+			num := budget
+			if rest := int(c.EndOffset - c.Offset); rest < num {
+				num = rest
+			}
+			if num > replayReadBatchSize {
+				num = replayReadBatchSize
+			}
+			events, _, _, err := source.Reader(
+				option.WithDisablePolling(),
+				option.WithReadPolicy(policy.NewManuallyReadPolicy(l, c.Offset)),
+				option.WithBatchSize(num),
+			).Read(ctx)
+			if errors.Is(err, errors.ErrOffsetUnderflow) {
+				// the events have been expired, continue from the earliest.
+				if c.Offset, err = l.EarliestOffset(ctx); err != nil {
+					return err
+				}
+				c.Done = c.Offset >= c.EndOffset
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				break
+			}
+
+			batch := make([]*ce.Event, 0, len(events))
+			var read, filtered int
+			for _, e := range events {
+				if !job.ByOffset {
+					stime, ok := storedTime(e)
+					if ok && !stime.Before(job.EndTime) {
+						c.Done = true
+						break
+					}
+					if ok && stime.Before(job.StartTime) {
+						read++
+						continue
+					}
+				}
+				read++
+				if filter.Run(f, *e) == filter.FailFilter {
+					filtered++
+					continue
+				}
+				batch = append(batch, newReplayEvent(job, e))
+			}
+			if len(batch) > 0 {
+				if _, err = writer.AppendMany(ctx, batch); err != nil {
+					return err
+				}
+			}
+
+			c.Offset += int64(read)
+			if c.Offset >= c.EndOffset {
+				c.Done = true
+			}
+			state.Replayed += uint64(len(batch))
+			state.Filtered += uint64(filtered)
+			state.Error = ""
+			budget -= read
+			if err = rs.saveState(ctx, job, state); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (rs *replayScheduler) loadState(ctx context.Context, id vanus.ID) (*metadata.ReplayState, error) {
+	data, err := rs.tw.kvStore.Get(ctx, metadata.GetReplayStateKeyInKVStore(id))
+	if stderr.Is(err, kv.ErrKeyNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	state := &metadata.ReplayState{}
+	if err = json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (rs *replayScheduler) saveState(ctx context.Context, job *metadata.ReplayJob, state *metadata.ReplayState) error {
+	// don't leave the state of a deleted job behind.
+	exist, err := rs.tw.kvStore.Exists(ctx, metadata.GetReplayJobKeyInKVStore(job.ID))
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.ErrResourceNotFound.WithMessage("the replay job has been deleted")
+	}
+	state.UpdatedAt = rs.tw.config.clock().Now()
+	data, _ := json.Marshal(state)
+	return rs.tw.kvStore.Set(ctx, metadata.GetReplayStateKeyInKVStore(job.ID), data)
+}
+
+// newReplayEvent removes the extension attributes set by vanus from the event, and marks it replayed.
+func newReplayEvent(job *metadata.ReplayJob, e *ce.Event) *ce.Event {
+	for name := range e.Extensions() {
+		if strings.HasPrefix(name, xVanusPrefix) {
+			e.SetExtension(name, nil)
+		}
+	}
+	e.SetExtension(xVanusReplayJob, job.ID.String())
+	return e
+}
+
+func storedTime(e *ce.Event) (time.Time, bool) {
+	v, ok := e.Extensions()[segpb.XVanusStime]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := types.ToTime(v)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
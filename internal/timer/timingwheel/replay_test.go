@@ -0,0 +1,168 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReplayScheduler_run(t *testing.T) {
+	Convey("test replay scheduler run", t, func() {
+		ctx := context.Background()
+		tw := newtimingwheel(cfg())
+		mockCtrl := NewController(t)
+		mockStoreCli := kv.NewMockClient(mockCtrl)
+		mockClient := client.NewMockClient(mockCtrl)
+		source := api.NewMockEventbus(mockCtrl)
+		target := api.NewMockEventbus(mockCtrl)
+		writer := api.NewMockBusWriter(mockCtrl)
+		reader := api.NewMockBusReader(mockCtrl)
+		el := api.NewMockEventlog(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), "source").AnyTimes().Return(source)
+		mockClient.EXPECT().Eventbus(Any(), "target").AnyTimes().Return(target)
+		target.EXPECT().Writer().AnyTimes().Return(writer)
+		source.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{el}, nil)
+		el.EXPECT().ID().AnyTimes().Return(uint64(1))
+		el.EXPECT().EarliestOffset(Any()).AnyTimes().Return(int64(0), nil)
+		el.EXPECT().LatestOffset(Any()).AnyTimes().Return(int64(10), nil)
+		tw.kvStore = mockStoreCli
+		tw.client = mockClient
+		rs := newReplayScheduler(tw)
+
+		id := vanus.NewTestID()
+		job := &metadata.ReplayJob{
+			ID:             id,
+			SourceEventbus: "source",
+			TargetEventbus: "target",
+			StartTime:      time.UnixMilli(1000),
+			EndTime:        time.UnixMilli(2000),
+			Filters:        []*primitive.SubscriptionFilter{{Exact: map[string]string{"source": "match"}}},
+		}
+		stateKey := metadata.GetReplayStateKeyInKVStore(id)
+		mockStoreCli.EXPECT().Exists(Any(), metadata.GetReplayJobKeyInKVStore(id)).AnyTimes().Return(true, nil)
+		state := &metadata.ReplayState{}
+		mockStoreCli.EXPECT().Set(Any(), stateKey, Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, _ string, value []byte) error {
+				state = &metadata.ReplayState{}
+				return json.Unmarshal(value, state)
+			})
+
+		newEvent := func(id, source string, stime int64) *ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource(source)
+			e.SetType("test")
+			e.SetExtension(segpb.XVanusStime, time.UnixMilli(stime))
+			return &e
+		}
+		expectRead := func(offset int64, num int, events []*ce.Event, err error) {
+			source.EXPECT().Reader(Any()).DoAndReturn(func(opts ...api.ReadOption) api.BusReader {
+				opt := &api.ReadOptions{}
+				opt.Apply(opts...)
+				So(opt.Policy.Offset(), ShouldEqual, offset)
+				So(opt.BatchSize, ShouldEqual, num)
+				return reader
+			})
+			reader.EXPECT().Read(Any()).Return(events, offset, uint64(1), err)
+		}
+		load := func() {
+			data, _ := json.Marshal(job)
+			pairs := []kv.Pair{{Key: metadata.GetReplayJobKeyInKVStore(id), Value: data}}
+			mockStoreCli.EXPECT().List(Any(), metadata.ReplayJobKeyPrefixInKVStore).Times(1).Return(pairs, nil)
+		}
+
+		Convey("test replay a time range", func() {
+			load()
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			el.EXPECT().QueryOffsetByTime(Any(), int64(1000)).Times(1).Return(int64(5), nil)
+			expectRead(5, 5, []*ce.Event{
+				newEvent("1", "match", 1000), newEvent("2", "not-match", 1500), newEvent("3", "match", 2000),
+			}, nil)
+			writer.EXPECT().AppendMany(Any(), Any()).Times(1).DoAndReturn(
+				func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) (string, error) {
+					So(events, ShouldHaveLength, 1)
+					So(events[0].ID(), ShouldEqual, "1")
+					So(events[0].Extensions(), ShouldNotContainKey, segpb.XVanusStime)
+					So(events[0].Extensions()[xVanusReplayJob], ShouldEqual, id.String())
+					return "", nil
+				})
+			rs.run(ctx)
+			So(state.Status(), ShouldEqual, metadata.ReplayStatusCompleted)
+			So(state.Replayed, ShouldEqual, 1)
+			So(state.Filtered, ShouldEqual, 1)
+			So(state.Cursors[0].Offset, ShouldEqual, 7)
+
+			// the completed job isn't checked again.
+			load()
+			rs.run(ctx)
+		})
+
+		Convey("test replay an offset range at limited rate", func() {
+			job.ByOffset = true
+			job.StartOffset = 2
+			job.EndOffset = 6
+			job.RateLimit = 3
+			job.Filters = nil
+			load()
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			expectRead(2, 3, []*ce.Event{
+				newEvent("1", "a", 1000), newEvent("2", "b", 3000), newEvent("3", "c", 5000),
+			}, nil)
+			writer.EXPECT().AppendMany(Any(), Any()).Times(1).Return("", nil)
+			rs.run(ctx)
+			So(state.Status(), ShouldEqual, metadata.ReplayStatusRunning)
+			So(state.Replayed, ShouldEqual, 3)
+			So(state.Cursors[0].Offset, ShouldEqual, 5)
+			So(state.Cursors[0].EndOffset, ShouldEqual, 6)
+
+			load()
+			saved, _ := json.Marshal(state)
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(saved, nil)
+			expectRead(5, 1, []*ce.Event{newEvent("4", "d", 7000)}, nil)
+			writer.EXPECT().AppendMany(Any(), Any()).Times(1).Return("", nil)
+			rs.run(ctx)
+			So(state.Status(), ShouldEqual, metadata.ReplayStatusCompleted)
+			So(state.Replayed, ShouldEqual, 4)
+		})
+
+		Convey("test retry if append failed", func() {
+			load()
+			mockStoreCli.EXPECT().Get(Any(), stateKey).Times(1).Return(nil, kv.ErrKeyNotFound)
+			el.EXPECT().QueryOffsetByTime(Any(), int64(1000)).Times(1).Return(int64(5), nil)
+			expectRead(5, 5, []*ce.Event{newEvent("1", "match", 1000)}, nil)
+			writer.EXPECT().AppendMany(Any(), Any()).Times(1).Return("", errors.ErrInternal)
+			rs.run(ctx)
+			So(state.Status(), ShouldEqual, metadata.ReplayStatusRunning)
+			So(state.Error, ShouldNotBeEmpty)
+			So(state.Cursors[0].Offset, ShouldEqual, 5)
+			So(state.Replayed, ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/timer/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	// the status of replica is reported every heartbeatInterval, and it expires after replicaStatusTTL,
+	// so the status of a crashed replica disappears from controller.
+	heartbeatInterval = 5 * time.Second
+	replicaStatusTTL  = 3 * heartbeatInterval
+)
+
+func (tw *timingWheel) replicaStatus() *metadata.TimerReplica {
+	return &metadata.TimerReplica{
+		Name:        tw.config.ReplicaName,
+		Address:     tw.config.ReplicaAddress,
+		IsLeader:    tw.IsLeader(),
+		Epoch:       tw.fence.getEpoch(),
+		FiredOffset: tw.fence.getFiredOffset(),
+		HeartbeatAt: tw.config.clock().Now(),
+	}
+}
+
+func (tw *timingWheel) reportStatus(ctx context.Context) {
+	data, _ := json.Marshal(tw.replicaStatus())
+	key := metadata.GetReplicaKeyInKVStore(tw.config.ReplicaName)
+	if err := tw.kvStore.SetWithTTL(ctx, key, data, replicaStatusTTL); err != nil {
+		log.Warning(ctx, "report status of timer replica failed", map[string]interface{}{
+			log.KeyError: err,
+			"replica":    tw.config.ReplicaName,
+		})
+	}
+}
+
+// startReporting reports the status of replica periodically, the controller lists them for operators.
+func (tw *timingWheel) startReporting(ctx context.Context) {
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at replica status reporting", nil)
+				return
+			case <-ticker.C:
+				tw.reportStatus(ctx)
+			}
+		}
+	}()
+}
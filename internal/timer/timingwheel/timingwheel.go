@@ -55,6 +55,9 @@ const (
 	defaultMaxNumberOfWorkers = 1000
 
 	recycleInterval = 60 * time.Second
+
+	// check the buckets of overflow layer which need to be loaded every loadInterval.
+	loadInterval = 10 * time.Second
 )
 
 var (
@@ -85,6 +88,10 @@ type timingWheel struct {
 
 	receivingStation    *bucket
 	distributionStation *bucket
+	tombstoneStation    *bucket
+	tombstones          *tombstoneIndex
+	fence               *fence
+	drift               *driftMonitor
 
 	leader bool
 	exitC  chan struct{}
@@ -93,12 +100,14 @@ type timingWheel struct {
 
 func NewTimingWheel(c *Config) Manager {
 	log.Info(context.Background(), "new timingwheel manager", map[string]interface{}{
-		"tick":           c.Tick,
-		"layers":         c.Layers,
-		"wheel_size":     c.WheelSize,
-		"key_prefix":     c.KeyPrefix,
-		"etcd_endpoints": c.EtcdEndpoints,
-		"ctrl_endpoints": c.CtrlEndpoints,
+		"tick":                c.Tick,
+		"layers":              c.Layers,
+		"wheel_size":          c.WheelSize,
+		"tombstone_retention": c.TombstoneRetention,
+		"drift_threshold":     c.DriftThreshold,
+		"key_prefix":          c.KeyPrefix,
+		"etcd_endpoints":      c.EtcdEndpoints,
+		"ctrl_endpoints":      c.CtrlEndpoints,
 	})
 	metrics.TimingWheelTickGauge.Set(float64(c.Tick))
 	metrics.TimingWheelSizeGauge.Set(float64(c.WheelSize))
@@ -151,6 +160,10 @@ func (tw *timingWheel) Init(ctx context.Context) error {
 	}
 	tw.receivingStation = newBucket(tw, nil, 0, timerBuiltInEventbusReceivingStation, 0, 0)
 	tw.distributionStation = newBucket(tw, nil, 0, timerBuiltInEventbusDistributionStation, 0, 0)
+	tw.tombstoneStation = newBucket(tw, nil, 0, timerBuiltInEventbusTombstoneStation, 0, 0)
+	tw.tombstones = newTombstoneIndex(tw.config.TombstoneRetention)
+	tw.fence = newFence(tw)
+	tw.drift = newDriftMonitor(tw.config.DriftThreshold)
 
 	return nil
 }
@@ -172,13 +185,18 @@ func (tw *timingWheel) Start(ctx context.Context) error {
 		}
 	}, time.Second, waitCtx.Done())
 
+	// start tombstone station for scheduled events cancelling, it's checked by distribution station
+	if err = tw.startTombstoneStation(ctx); err != nil {
+		return err
+	}
+
 	// start distribution station for scheduled events distributing
 	if err = tw.startDistributionStation(ctx); err != nil {
 		return err
 	}
 
-	// start all bucket of each layer
-	for e := tw.twList.Front(); e != nil; e = e.Next() {
+	// start all bucket of each layer, the buckets of overflow layer are loaded lazily
+	for e := tw.twList.Front(); e != tw.twList.Back(); e = e.Next() {
 		for _, bucket := range e.Value.(*timingWheelElement).getBuckets() {
 			if err = bucket.start(ctx); err != nil {
 				log.Error(ctx, "start bucket failed", map[string]interface{}{
@@ -195,9 +213,25 @@ func (tw *timingWheel) Start(ctx context.Context) error {
 		return err
 	}
 
-	// start bucket recycling
+	// start bucket loading and recycling of overflow layer
+	tw.startLoading(ctx)
 	tw.startRecycling(ctx)
 
+	// start cron scheduler for recurring events firing
+	tw.startCronScheduler(ctx)
+
+	// start replay scheduler for copying historical events between eventbuses
+	tw.startReplayScheduler(ctx)
+
+	// start HTTP poller scheduler for publishing the items of polled endpoints
+	tw.startHTTPPollerScheduler(ctx)
+
+	// start reporting the status of replica to controller
+	tw.startReporting(ctx)
+
+	// start measuring the drift of fire time
+	tw.startDriftMonitoring(ctx)
+
 	return nil
 }
 
@@ -217,6 +251,7 @@ func (tw *timingWheel) Stop(ctx context.Context) {
 	}
 	tw.receivingStation.wait(ctx)
 	tw.distributionStation.wait(ctx)
+	tw.tombstoneStation.wait(ctx)
 	close(tw.exitC)
 	tw.wg.Wait()
 	if closer, ok := tw.ctrlCli.(io.Closer); ok {
@@ -234,26 +269,27 @@ func (tw *timingWheel) IsLeader() bool {
 
 func (tw *timingWheel) IsDeployed(ctx context.Context) bool {
 	return tw.ctrl.EventbusService().IsExist(ctx, tw.receivingStation.eventbus) &&
-		tw.ctrl.EventbusService().IsExist(ctx, tw.distributionStation.eventbus)
+		tw.ctrl.EventbusService().IsExist(ctx, tw.distributionStation.eventbus) &&
+		tw.ctrl.EventbusService().IsExist(ctx, tw.tombstoneStation.eventbus)
 }
 
 func (tw *timingWheel) Recover(ctx context.Context) error {
+	// the tombstones are read again from the oldest one kept
+	tw.tombstones.reset()
 	offsetPath := fmt.Sprintf("%s/offset", metadata.MetadataKeyPrefixInKVStore)
 	offsetPairs, err := tw.kvStore.List(ctx, offsetPath)
 	if err != nil {
 		return err
 	}
-	// no offset metadata, no recovery required
-	if len(offsetPairs) == 0 {
-		return nil
-	}
 	offsetMetaMap := make(map[string]*metadata.OffsetMeta, tw.config.Layers+1)
 	for _, v := range offsetPairs {
 		md := &metadata.OffsetMeta{}
 		_ = json.Unmarshal(v.Value, md)
-		if md.Layer > tw.config.Layers &&
-			tw.twList.Back().Value.(*timingWheelElement).makeSureBucketExist(ctx, md.Slot) != nil {
-			return err
+		if md.Layer > tw.config.Layers {
+			// the bucket isn't loaded here, so it reads from the recovered offset after loaded
+			if err = tw.twList.Back().Value.(*timingWheelElement).makeSureBucketExist(ctx, md.Slot); err != nil {
+				return err
+			}
 		}
 		offsetMetaMap[md.Eventbus] = md
 	}
@@ -286,6 +322,23 @@ func (tw *timingWheel) Recover(ctx context.Context) error {
 		})
 		tw.distributionStation.offset = offsetMetaMap[timerBuiltInEventbusDistributionStation].Offset
 	}
+	if _, ok := offsetMetaMap[timerBuiltInEventbusTombstoneStation]; ok {
+		log.Info(ctx, "recover tombstone station metadata", map[string]interface{}{
+			"offset":   offsetMetaMap[timerBuiltInEventbusTombstoneStation].Offset,
+			"eventbus": tw.tombstoneStation.getEventbus(),
+		})
+		tw.tombstoneStation.offset = offsetMetaMap[timerBuiltInEventbusTombstoneStation].Offset
+	}
+
+	// the fired checkpoint takes the place of offset metadata of distribution station
+	if err = tw.fence.acquire(ctx, tw.distributionStation.offset); err != nil {
+		return err
+	}
+	log.Info(ctx, "recover distribution station from fired checkpoint", map[string]interface{}{
+		"offset":   tw.fence.getFiredOffset(),
+		"eventbus": tw.distributionStation.getEventbus(),
+	})
+	tw.distributionStation.offset = tw.fence.getFiredOffset()
 
 	return nil
 }
@@ -299,9 +352,9 @@ func (tw *timingWheel) Push(ctx context.Context, e *ce.Event) bool {
 	})
 
 	metrics.TimerScheduledEventDelayTime.WithLabelValues(metrics.LabelScheduledEventDelayTime).
-		Observe(time.Until(tm.getExpiration()).Seconds())
+		Observe(tm.getExpiration().Sub(tw.config.clock().Now()).Seconds())
 
-	if tm.hasExpired() {
+	if tm.hasExpired(tw.config.clock().Now()) {
 		// Already expired
 		return tw.getDistributionStation().push(ctx, tm)
 	}
@@ -338,6 +391,27 @@ func (tw *timingWheel) startRecycling(ctx context.Context) {
 	}()
 }
 
+func (tw *timingWheel) startLoading(ctx context.Context) {
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(loadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at timingwheel loading", nil)
+				return
+			case <-ticker.C:
+				if !tw.IsLeader() {
+					break
+				}
+				tw.twList.Back().Value.(*timingWheelElement).loading(ctx)
+			}
+		}
+	}()
+}
+
 func (tw *timingWheel) startReceivingStation(ctx context.Context) error {
 	var err error
 	if err = tw.getReceivingStation().createEventbus(ctx); err != nil {
@@ -393,11 +467,11 @@ func (tw *timingWheel) runReceivingStation(ctx context.Context) {
 							"eventbus":   tw.receivingStation.getEventbus(),
 						})
 					}
-					time.Sleep(sleepDuration)
+					tw.config.clock().Sleep(sleepDuration)
 					break
 				}
 				if len(events) == 0 {
-					time.Sleep(sleepDuration)
+					tw.config.clock().Sleep(sleepDuration)
 					log.Info(ctx, "no more message", map[string]interface{}{
 						"function": "runReceivingStation",
 					})
@@ -474,11 +548,16 @@ func (tw *timingWheel) runDistributionStation(ctx context.Context) {
 			case offset := <-offsetC:
 				// wait for all goroutines to finish before updating offset metadata
 				offset.wg.Wait()
-				log.Debug(ctx, "update offset metadata", map[string]interface{}{
+				log.Debug(ctx, "update fired checkpoint", map[string]interface{}{
 					"eventbus":  tw.distributionStation.getEventbus(),
 					"update_to": offset.data,
 				})
-				tw.distributionStation.updateOffsetMeta(ctx, offset.data)
+				if err := tw.fence.commit(ctx, offset.data); err != nil {
+					log.Warning(ctx, "update fired checkpoint failed", map[string]interface{}{
+						log.KeyError: err,
+						"update_to":  offset.data,
+					})
+				}
 			}
 		}
 	}()
@@ -503,18 +582,27 @@ func (tw *timingWheel) runDistributionStation(ctx context.Context) {
 							"eventbus":   tw.distributionStation.getEventbus(),
 						})
 					}
-					time.Sleep(sleepDuration)
+					tw.config.clock().Sleep(sleepDuration)
 					break
 				}
 				if len(events) == 0 {
-					time.Sleep(sleepDuration)
+					tw.config.clock().Sleep(sleepDuration)
 					log.Debug(ctx, "no more message", map[string]interface{}{
 						"function": "runDistributionStation",
 					})
 					break
 				}
-				// concurrent write
+				// save the in-flight events before firing, so the next leader knows which ones may have been fired
 				numberOfEvents := int64(len(events))
+				if err = tw.fence.begin(ctx, tw.distributionStation.getOffset()+numberOfEvents); err != nil {
+					log.Error(ctx, "save in-flight events failed when distribution station running", map[string]interface{}{
+						log.KeyError: err,
+						"eventbus":   tw.distributionStation.getEventbus(),
+					})
+					tw.config.clock().Sleep(sleepDuration)
+					break
+				}
+				// concurrent write
 				log.Debug(ctx, "got events when distribution station running", map[string]interface{}{
 					"eventbus":         tw.distributionStation.getEventbus(),
 					"offset":           tw.distributionStation.getOffset(),
@@ -529,11 +617,17 @@ func (tw *timingWheel) runDistributionStation(ctx context.Context) {
 						defer wg.Done()
 						waitCtx, cancel := context.WithCancel(ctx)
 						wait.Until(func() {
+							if !tw.IsLeader() {
+								// fenced, the events in flight are left to the new leader
+								cancel()
+								return
+							}
 							startTime := time.Now()
 							if err = tw.deliver(ctx, e); err == nil {
 								metrics.TimerDeliverEventTime.WithLabelValues(metrics.LabelTimerDeliverScheduledEventTime).
 									Observe(time.Since(startTime).Seconds())
 								metrics.TimerDeliverEventTPSCounterVec.WithLabelValues(metrics.LabelTimer).Inc()
+								tw.drift.observe(tw.config.clock().Now().Sub(newTimingMsg(ctx, e).getExpiration()))
 								cancel()
 							} else {
 								log.Warning(ctx, "deliver event failed, retry until it succeed", map[string]interface{}{
@@ -547,9 +641,13 @@ func (tw *timingWheel) runDistributionStation(ctx context.Context) {
 					}(ctx, event)
 				}
 				// asynchronously update offset after the same batch of events are successfully written
-				offsetC <- waitGroup{
+				select {
+				case <-ctx.Done():
+					return
+				case offsetC <- waitGroup{
 					wg:   &wg,
 					data: tw.distributionStation.getOffset() + numberOfEvents,
+				}:
 				}
 				tw.distributionStation.incOffset(numberOfEvents)
 			}
@@ -570,6 +668,18 @@ func (tw *timingWheel) deliver(ctx context.Context, e *ce.Event) error {
 		})
 		return err
 	}
+	deliverable, err := tw.checkTombstone(ctx, ebName, e)
+	if err != nil || !deliverable {
+		return err
+	}
+	key := firedKey(ebName, e)
+	if !tw.fence.markFiring(key) {
+		log.Info(ctx, "event has been fired, skip the copy", map[string]interface{}{
+			"event_id": e.ID(),
+			"eventbus": ebName,
+		})
+		return nil
+	}
 	_, err = tw.client.Eventbus(ctx, ebName).Writer().AppendOne(ctx, e)
 	if err != nil {
 		if errors.Is(err, errors.ErrOffsetOnEnd) {
@@ -585,6 +695,7 @@ func (tw *timingWheel) deliver(ctx context.Context, e *ce.Event) error {
 			log.KeyError: err,
 			"eventbus":   ebName,
 		})
+		tw.fence.unmark(key)
 		return err
 	}
 	log.Debug(ctx, "event delivered", map[string]interface{}{
@@ -655,7 +766,7 @@ func (twe *timingWheelElement) pushBack(ctx context.Context, tm *timingMsg) bool
 	// Put it into its own bucket
 	if twe.makeSureBucketExist(ctx, index) != nil {
 		log.Error(ctx, "push timing message failed because bucket not exist", map[string]interface{}{
-			"eventbus":   twe.buckets[index].getEventbus(),
+			"eventbus":   fmt.Sprintf(timerBuiltInEventbus, twe.layer, index),
 			"expiration": tm.getExpiration().Format(time.RFC3339Nano),
 		})
 		return false
@@ -664,7 +775,7 @@ func (twe *timingWheelElement) pushBack(ctx context.Context, tm *timingMsg) bool
 }
 
 func (twe *timingWheelElement) allowPush(tm *timingMsg) bool {
-	now := time.Now()
+	now := twe.config.clock().Now()
 	timeOfBufferBoundaryLine := now.UnixNano() - (now.UnixNano() % twe.tick.Nanoseconds()) + twe.interval.Nanoseconds()
 	return tm.getExpiration().UnixNano() < timeOfBufferBoundaryLine
 }
@@ -688,6 +799,8 @@ func (twe *timingWheelElement) calculateIndex(tm *timingMsg) int64 {
 	return tm.getExpiration().UnixNano() % twe.interval.Nanoseconds() / twe.tick.Nanoseconds()
 }
 
+// makeSureBucketExist makes sure the bucket of overflow layer exists. The bucket receives events at once, but it
+// doesn't read them until loaded, so the far future events are only kept in its eventbus.
 func (twe *timingWheelElement) makeSureBucketExist(ctx context.Context, index int64) error {
 	// TODO(jiangkai): redesign locks if here is a performance bottleneck in the future, by jiangkai, 2022.09.16
 	// the segmented lock may solve the problem.
@@ -703,26 +816,51 @@ func (twe *timingWheelElement) makeSureBucketExist(ctx context.Context, index in
 		return nil
 	}
 	ebName := fmt.Sprintf(timerBuiltInEventbus, twe.layer, index)
-	twe.buckets[index] = newBucket(twe.timingwheel, twe.element, twe.tick, ebName, twe.layer, index)
-	if err := twe.buckets[index].start(ctx); err != nil {
-		log.Error(ctx, "start bucket failed when makesure bucket exist", map[string]interface{}{
+	b := newBucket(twe.timingwheel, twe.element, twe.tick, ebName, twe.layer, index)
+	if err := b.prepare(ctx); err != nil {
+		log.Error(ctx, "prepare bucket failed when makesure bucket exist", map[string]interface{}{
 			log.KeyError: err,
-			"eventbus":   twe.buckets[index].getEventbus(),
+			"eventbus":   b.getEventbus(),
 		})
 		return err
 	}
-	exist, err := twe.buckets[index].existsOffsetMeta(ctx)
+	twe.buckets[index] = b
+	exist, err := b.existsOffsetMeta(ctx)
 	if !exist && err == nil {
-		twe.buckets[index].updateOffsetMeta(ctx, twe.buckets[index].offset)
+		b.updateOffsetMeta(ctx, b.offset)
 	}
 	return nil
 }
 
+// loading starts reading the buckets of overflow layer whose events are going to flow.
+func (twe *timingWheelElement) loading(ctx context.Context) {
+	twe.mu.Lock()
+	defer twe.mu.Unlock()
+	for idx, bucket := range twe.buckets {
+		if bucket.loaded || !twe.isDue(idx, twe.config.clock().Now()) {
+			continue
+		}
+		log.Info(ctx, "load bucket", map[string]interface{}{
+			"bucket": bucket.eventbus,
+			"offset": bucket.offset,
+		})
+		bucket.run(ctx)
+		bucket.loaded = true
+	}
+}
+
+// isDue returns whether the bucket of overflow layer needs to be loaded, it's loaded one tick of the
+// previous layer earlier than its events begin to flow, which leaves enough time for loading.
+func (twe *timingWheelElement) isDue(index int64, now time.Time) bool {
+	advance := (defaultNumberOfTickFlowInAdvance + 1) * twe.prev().tick
+	return now.Add(advance).UnixNano() >= index*twe.tick.Nanoseconds()
+}
+
 func (twe *timingWheelElement) recycling(ctx context.Context) {
 	twe.mu.Lock()
 	defer twe.mu.Unlock()
 	for idx, bucket := range twe.buckets {
-		if time.Now().UnixNano()/bucket.tick.Nanoseconds() > idx && bucket.hasOnEnd(ctx) {
+		if twe.config.clock().Now().UnixNano()/bucket.tick.Nanoseconds() > idx && bucket.hasOnEnd(ctx) {
 			log.Info(ctx, "recycle expired bucket", map[string]interface{}{
 				"bucket": bucket.eventbus,
 			})
@@ -32,6 +32,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/timer/metadata"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	. "github.com/prashantv/gostub"
 	. "github.com/smartystreets/goconvey/convey"
@@ -75,8 +76,10 @@ func TestTimingWheel_Start(t *testing.T) {
 		tw.client = mockClient
 		tw.receivingStation.client = mockClient
 		tw.distributionStation.client = mockClient
+		tw.tombstoneStation.client = mockClient
 		tw.receivingStation.timingwheel = tw
 		tw.distributionStation.timingwheel = tw
+		tw.tombstoneStation.timingwheel = tw
 		ls := make([]*record.Eventlog, 1)
 		ls[0] = &record.Eventlog{
 			ID: 1,
@@ -179,8 +182,18 @@ func TestTimingWheel_Recover(t *testing.T) {
 
 		Convey("test timingwheel recover with no metadata", func() {
 			mockStoreCli.EXPECT().List(Any(), Any()).Times(1).Return([]kv.Pair{}, nil)
+			mockStoreCli.EXPECT().Get(Any(), Any()).Times(2).Return(nil, kv.ErrKeyNotFound)
+			mockStoreCli.EXPECT().Create(Any(), Any(), Any()).Times(2).Return(nil)
 			err := tw.Recover(ctx)
 			So(err, ShouldBeNil)
+			So(tw.fence.getEpoch(), ShouldEqual, 1)
+		})
+
+		Convey("test timingwheel recover with acquire fence failed", func() {
+			mockStoreCli.EXPECT().List(Any(), Any()).Times(1).Return([]kv.Pair{}, nil)
+			mockStoreCli.EXPECT().Get(Any(), Any()).Times(1).Return(nil, stderr.New("test"))
+			err := tw.Recover(ctx)
+			So(err, ShouldNotBeNil)
 		})
 
 		Convey("test timingwheel recover success", func() {
@@ -190,7 +203,7 @@ func TestTimingWheel_Recover(t *testing.T) {
 				Offset:   1,
 				Eventbus: "__Timer_1_1",
 			})
-			offsetKvPairs := make([]kv.Pair, 3)
+			offsetKvPairs := make([]kv.Pair, 4)
 			offsetKvPairs[0] = kv.Pair{
 				Key:   "0",
 				Value: data,
@@ -215,9 +228,25 @@ func TestTimingWheel_Recover(t *testing.T) {
 				Key:   "2",
 				Value: data,
 			}
+			data, _ = json.Marshal(metadata.OffsetMeta{
+				Offset:   5,
+				Eventbus: "__Timer_TS",
+			})
+			offsetKvPairs[3] = kv.Pair{
+				Key:   "3",
+				Value: data,
+			}
+			tw.tombstones.setLoaded()
 			mockStoreCli.EXPECT().List(Any(), Any()).Times(1).Return(offsetKvPairs, nil)
+			mockStoreCli.EXPECT().Get(Any(), Any()).Times(2).Return(nil, kv.ErrKeyNotFound)
+			mockStoreCli.EXPECT().Create(Any(), Any(), Any()).Times(2).Return(nil)
 			err := tw.Recover(ctx)
 			So(err, ShouldBeNil)
+			So(tw.tombstoneStation.offset, ShouldEqual, 5)
+			So(tw.tombstones.isLoaded(), ShouldBeFalse)
+			// the legacy offset of distribution station is taken over by the fired checkpoint
+			So(tw.distributionStation.offset, ShouldEqual, 1)
+			So(tw.fence.getFiredOffset(), ShouldEqual, 1)
 		})
 	})
 }
@@ -408,6 +437,8 @@ func TestTimingWheel_runDistributionStation(t *testing.T) {
 		tw.distributionStation.kvStore = mockStoreCli
 		tw.distributionStation.timingwheel = tw
 		tw.distributionStation.client = mockClient
+		tw.kvStore = mockStoreCli
+		tw.fence.checkpoint = &metadata.FiredCheckpoint{}
 		events := make([]*ce.Event, 1)
 		events[0] = event(0)
 
@@ -426,7 +457,7 @@ func TestTimingWheel_runDistributionStation(t *testing.T) {
 			mockBusReader.EXPECT().Read(Any(), Any(), Any()).AnyTimes().Return(events, int64(0), uint64(0), nil)
 			mockBusWriter.EXPECT().AppendOne(Any(), Any()).AnyTimes().Return("", errors.ErrNotWritable)
 			mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
-			mockStoreCli.EXPECT().Set(Any(), Any(), Any()).AnyTimes().Return(nil)
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), Any(), Any(), Any()).AnyTimes().Return(nil)
 			go func() {
 				time.Sleep(100 * time.Millisecond)
 				cancel()
@@ -436,10 +467,11 @@ func TestTimingWheel_runDistributionStation(t *testing.T) {
 		})
 
 		Convey("test timingwheel run distribution station with deliver success", func() {
+			tw.tombstones.setLoaded()
 			mockBusReader.EXPECT().Read(Any(), Any(), Any()).AnyTimes().Return(events, int64(0), uint64(0), nil)
 			mockBusWriter.EXPECT().AppendOne(Any(), Any()).AnyTimes().Return("", nil)
 			mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
-			mockStoreCli.EXPECT().Set(Any(), Any(), Any()).AnyTimes().Return(nil)
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), Any(), Any(), Any()).AnyTimes().Return(nil)
 			go func() {
 				time.Sleep(100 * time.Millisecond)
 				cancel()
@@ -447,6 +479,21 @@ func TestTimingWheel_runDistributionStation(t *testing.T) {
 			tw.runDistributionStation(ctx)
 			tw.wg.Wait()
 		})
+
+		Convey("test timingwheel run distribution station fenced by a newer leader", func() {
+			tw.tombstones.setLoaded()
+			mockBusReader.EXPECT().Read(Any(), Any(), Any()).AnyTimes().Return(events, int64(0), uint64(0), nil)
+			mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+			mockStoreCli.EXPECT().CompareAndSwap(Any(), Any(), Any(), Any()).AnyTimes().Return(kv.ErrSetFailed)
+			go func() {
+				time.Sleep(100 * time.Millisecond)
+				cancel()
+			}()
+			tw.runDistributionStation(ctx)
+			tw.wg.Wait()
+			So(tw.IsLeader(), ShouldBeFalse)
+			So(tw.distributionStation.getOffset(), ShouldEqual, 0)
+		})
 	})
 }
 
@@ -464,6 +511,7 @@ func TestTimingWheel_deliver(t *testing.T) {
 		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
 		mockEventbus.EXPECT().Reader().AnyTimes().Return(mockBusReader)
 		tw.client = mockClient
+		tw.tombstones.setLoaded()
 
 		Convey("test timingwheel deliver failure with abnormal event", func() {
 			e.SetExtension(xVanusEventbus, time.Now())
@@ -488,6 +536,15 @@ func TestTimingWheel_deliver(t *testing.T) {
 			err := tw.deliver(ctx, e)
 			So(err, ShouldBeNil)
 		})
+
+		Convey("test timingwheel deliver the copy of fired event", func() {
+			mockBusWriter.EXPECT().AppendOne(Any(), Any()).Times(1).Return("", nil)
+			err := tw.deliver(ctx, e)
+			So(err, ShouldBeNil)
+			copied := e.Clone()
+			err = tw.deliver(ctx, &copied)
+			So(err, ShouldBeNil)
+		})
 	})
 }
 
@@ -610,6 +667,25 @@ func TestTimingWheelElement_allowPush(t *testing.T) {
 			ret := tw.twList.Front().Value.(*timingWheelElement).allowPush(tm)
 			So(ret, ShouldBeTrue)
 		})
+
+		Convey("test timingwheelelement allow push by the clock", func() {
+			now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			clock := util.NewFakeClock(now)
+			c := cfg()
+			c.Clock = clock
+			tw = newtimingwheel(c)
+			e := ce.NewEvent()
+			e.SetExtension(xVanusDeliveryTime, now.Add(30*time.Second).Format(time.RFC3339))
+			tm := newTimingMsg(ctx, &e)
+			twe := tw.twList.Front().Value.(*timingWheelElement)
+			So(twe.allowPush(tm), ShouldBeFalse)
+			So(tm.hasExpired(clock.Now()), ShouldBeFalse)
+
+			clock.Advance(25 * time.Second)
+			So(twe.allowPush(tm), ShouldBeTrue)
+			clock.Advance(5 * time.Second)
+			So(tm.hasExpired(clock.Now()), ShouldBeTrue)
+		})
 	})
 }
 
@@ -705,6 +781,37 @@ func TestTimingWheelElement_makeSureBucketExist(t *testing.T) {
 	})
 }
 
+func TestTimingWheelElement_loading(t *testing.T) {
+	Convey("test timingwheelelement loading", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		tw := newtimingwheel(cfg())
+		twe := tw.twList.Back().Value.(*timingWheelElement)
+		now := time.Now()
+		current := now.UnixNano() / twe.tick.Nanoseconds()
+
+		Convey("test the bucket is due one tick of previous layer earlier than flow", func() {
+			So(twe.isDue(current, now), ShouldBeTrue)
+			So(twe.isDue(current+2, now), ShouldBeFalse)
+			So(twe.isDue(current+2, now.Add(twe.tick*2-twe.prev().tick*2)), ShouldBeTrue)
+		})
+
+		Convey("test only load the due buckets", func() {
+			mockCtrl := NewController(t)
+			mockBusReader := api.NewMockBusReader(mockCtrl)
+			for _, idx := range []int64{current, current + 2} {
+				ebName := fmt.Sprintf(timerBuiltInEventbus, twe.layer, idx)
+				twe.buckets[idx] = newBucket(tw, twe.element, twe.tick, ebName, twe.layer, idx)
+				twe.buckets[idx].eventbusReader = mockBusReader
+			}
+			twe.loading(ctx)
+			So(twe.buckets[current].loaded, ShouldBeTrue)
+			So(twe.buckets[current+2].loaded, ShouldBeFalse)
+			cancel()
+			twe.buckets[current].wait(ctx)
+		})
+	})
+}
+
 func cfg() *Config {
 	return &Config{
 		CtrlEndpoints: []string{"127.0.0.1"},
@@ -740,6 +847,10 @@ func newtimingwheel(c *Config) *timingWheel {
 		}
 		timingWheelInstance.receivingStation = newBucket(timingWheelInstance, nil, 0, timerBuiltInEventbusReceivingStation, 0, 0)
 		timingWheelInstance.distributionStation = newBucket(timingWheelInstance, nil, 0, timerBuiltInEventbusDistributionStation, 0, 0)
+		timingWheelInstance.tombstoneStation = newBucket(timingWheelInstance, nil, 0, timerBuiltInEventbusTombstoneStation, 0, 0)
+		timingWheelInstance.tombstones = newTombstoneIndex(c.TombstoneRetention)
+		timingWheelInstance.fence = newFence(timingWheelInstance)
+		timingWheelInstance.drift = newDriftMonitor(c.DriftThreshold)
 	}
 	return timingWheelInstance
 }
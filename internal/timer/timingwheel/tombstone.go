@@ -0,0 +1,254 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"container/list"
+	"context"
+	stderr "errors"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	timerBuiltInEventbusTombstoneStation = "__Timer_TS"
+	// the extension attribute of tombstone, the value is the id of cancelled or rescheduled event.
+	xVanusTimerEventID = "xvanustimereventid"
+)
+
+var errTombstoneNotLoaded = stderr.New("the tombstones haven't been loaded")
+
+// tombstone cancels or reschedules a pending delayed event, it's written to the tombstone station by gateway.
+type tombstone struct {
+	key    string
+	offset int64
+	// the time the tombstone was written, the tombstone expires after the retention since then.
+	time time.Time
+	// the new delivery time of the event, the event is cancelled if it's zero.
+	deliveryTime time.Time
+}
+
+func newTombstone(ctx context.Context, e *ce.Event, offset int64) *tombstone {
+	var ebName, eventID string
+	if err := e.ExtensionAs(xVanusEventbus, &ebName); err != nil {
+		return nil
+	}
+	if err := e.ExtensionAs(xVanusTimerEventID, &eventID); err != nil {
+		return nil
+	}
+	t := &tombstone{
+		key:    tombstoneKey(ebName, eventID),
+		offset: offset,
+		time:   e.Time(),
+	}
+	if deliveryTime, ok := e.Extensions()[xVanusDeliveryTime]; ok {
+		tm, err := types.ParseTime(deliveryTime.(string))
+		if err != nil {
+			log.Warning(ctx, "parse delivery time of tombstone failed", map[string]interface{}{
+				log.KeyError: err,
+				"time":       deliveryTime,
+			})
+			return nil
+		}
+		t.deliveryTime = tm
+	}
+	return t
+}
+
+func tombstoneKey(eventbus, eventID string) string {
+	return eventbus + "/" + eventID
+}
+
+func (t *tombstone) isCancelled() bool {
+	return t.deliveryTime.IsZero()
+}
+
+// tombstoneIndex is the in-memory index of tombstones, it's checked when the delayed events fire. The later
+// tombstone of the same event overrides the earlier one.
+type tombstoneIndex struct {
+	retention  time.Duration
+	tombstones map[string]*tombstone
+	// element: *tombstone, in the order of offset.
+	queue  *list.List
+	loaded bool
+	mu     sync.RWMutex
+}
+
+func newTombstoneIndex(retention time.Duration) *tombstoneIndex {
+	return &tombstoneIndex{
+		retention:  retention,
+		tombstones: make(map[string]*tombstone),
+		queue:      list.New(),
+	}
+}
+
+func (ti *tombstoneIndex) add(t *tombstone) {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	ti.tombstones[t.key] = t
+	ti.queue.PushBack(t)
+}
+
+func (ti *tombstoneIndex) get(eventbus, eventID string) *tombstone {
+	ti.mu.RLock()
+	defer ti.mu.RUnlock()
+	return ti.tombstones[tombstoneKey(eventbus, eventID)]
+}
+
+// expire removes the tombstones older than the retention, and returns the offset of the oldest tombstone kept,
+// which is where reading starts from after the index is lost. next is the offset following the last tombstone.
+func (ti *tombstoneIndex) expire(now time.Time, next int64) int64 {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	for e := ti.queue.Front(); e != nil; e = ti.queue.Front() {
+		t := e.Value.(*tombstone)
+		if now.Sub(t.time) < ti.retention {
+			return t.offset
+		}
+		ti.queue.Remove(e)
+		if ti.tombstones[t.key] == t {
+			delete(ti.tombstones, t.key)
+		}
+	}
+	return next
+}
+
+func (ti *tombstoneIndex) reset() {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	ti.tombstones = make(map[string]*tombstone)
+	ti.queue.Init()
+	ti.loaded = false
+}
+
+func (ti *tombstoneIndex) setLoaded() {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+	ti.loaded = true
+}
+
+func (ti *tombstoneIndex) isLoaded() bool {
+	ti.mu.RLock()
+	defer ti.mu.RUnlock()
+	return ti.loaded
+}
+
+func (tw *timingWheel) getTombstoneStation() *bucket {
+	return tw.tombstoneStation
+}
+
+func (tw *timingWheel) startTombstoneStation(ctx context.Context) error {
+	if err := tw.getTombstoneStation().createEventbus(ctx); err != nil {
+		return err
+	}
+
+	tw.getTombstoneStation().connectEventbus(ctx)
+	tw.runTombstoneStation(ctx)
+	return nil
+}
+
+// runTombstoneStation reads the tombstones into the index. The offset metadata of tombstone station is the
+// offset of the oldest tombstone kept rather than the read position, so the index is rebuilt after recovery.
+func (tw *timingWheel) runTombstoneStation(ctx context.Context) {
+	tw.wg.Add(1)
+	go func() {
+		defer tw.wg.Done()
+		ticker := time.NewTicker(recycleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug(ctx, "context canceled at tombstone station running", nil)
+				return
+			case <-ticker.C:
+				if !tw.IsLeader() {
+					break
+				}
+				offset := tw.tombstones.expire(tw.config.clock().Now(), tw.tombstoneStation.getOffset())
+				tw.tombstoneStation.updateOffsetMeta(ctx, offset)
+			default:
+				events, err := tw.tombstoneStation.getEvent(ctx, defaultNumberOfEventsRead)
+				if err != nil {
+					if !errors.Is(err, errors.ErrOffsetOnEnd) {
+						log.Error(ctx, "get event failed when tombstone station running", map[string]interface{}{
+							log.KeyError: err,
+							"eventbus":   tw.tombstoneStation.getEventbus(),
+						})
+					} else if tw.IsLeader() {
+						// all tombstones are in the index, the delayed events can be delivered from now on.
+						tw.tombstones.setLoaded()
+					}
+					tw.config.clock().Sleep(sleepDuration)
+					break
+				}
+				if len(events) == 0 {
+					tw.config.clock().Sleep(sleepDuration)
+					break
+				}
+				offset := tw.tombstoneStation.getOffset()
+				for idx, e := range events {
+					t := newTombstone(ctx, e, offset+int64(idx))
+					if t == nil {
+						log.Warning(ctx, "invalid tombstone, discard it", map[string]interface{}{
+							"event_id": e.ID(),
+						})
+						continue
+					}
+					tw.tombstones.add(t)
+				}
+				tw.tombstoneStation.incOffset(int64(len(events)))
+			}
+		}
+	}()
+}
+
+// checkTombstone returns whether the event is still delivered now. The cancelled event is discarded, and the
+// event rescheduled to a later time is pushed to the timingwheel again with the new delivery time.
+func (tw *timingWheel) checkTombstone(ctx context.Context, ebName string, e *ce.Event) (bool, error) {
+	if !tw.tombstones.isLoaded() {
+		return false, errTombstoneNotLoaded
+	}
+	t := tw.tombstones.get(ebName, e.ID())
+	if t == nil {
+		return true, nil
+	}
+	if t.isCancelled() {
+		log.Info(ctx, "delayed event has been cancelled, discard it", map[string]interface{}{
+			"eventbus": ebName,
+			"event_id": e.ID(),
+		})
+		return false, nil
+	}
+	// an event rescheduled to an earlier time is delivered at its original time.
+	if !t.deliveryTime.After(newTimingMsg(ctx, e).getExpiration()) {
+		return true, nil
+	}
+	rescheduled := e.Clone()
+	rescheduled.SetExtension(xVanusDeliveryTime, t.deliveryTime.UTC().Format(time.RFC3339Nano))
+	if !tw.Push(ctx, &rescheduled) {
+		return false, stderr.New("push rescheduled event failed")
+	}
+	log.Info(ctx, "delayed event has been rescheduled", map[string]interface{}{
+		"eventbus":      ebName,
+		"event_id":      e.ID(),
+		"delivery_time": t.deliveryTime.Format(time.RFC3339Nano),
+	})
+	return false, nil
+}
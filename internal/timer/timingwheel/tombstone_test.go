@@ -0,0 +1,166 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timingwheel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func tombstoneEvent(eventID string, t time.Time, deliveryTime string) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID("tombstone")
+	e.SetTime(t)
+	e.SetExtension(xVanusEventbus, "quick-start")
+	e.SetExtension(xVanusTimerEventID, eventID)
+	if deliveryTime != "" {
+		e.SetExtension(xVanusDeliveryTime, deliveryTime)
+	}
+	return &e
+}
+
+func TestTombstone_newTombstone(t *testing.T) {
+	Convey("test new tombstone", t, func() {
+		ctx := context.Background()
+		now := time.Now()
+
+		Convey("test new tombstone of cancelled event", func() {
+			ts := newTombstone(ctx, tombstoneEvent("ut", now, ""), 3)
+			So(ts, ShouldNotBeNil)
+			So(ts.key, ShouldEqual, "quick-start/ut")
+			So(ts.offset, ShouldEqual, 3)
+			So(ts.isCancelled(), ShouldBeTrue)
+		})
+
+		Convey("test new tombstone of rescheduled event", func() {
+			ts := newTombstone(ctx, tombstoneEvent("ut", now, "2022-11-24T11:20:56Z"), 3)
+			So(ts, ShouldNotBeNil)
+			So(ts.isCancelled(), ShouldBeFalse)
+			So(ts.deliveryTime.Unix(), ShouldEqual, 1669288856)
+		})
+
+		Convey("test new tombstone with invalid event", func() {
+			So(newTombstone(ctx, tombstoneEvent("ut", now, "tomorrow"), 3), ShouldBeNil)
+			e := ce.NewEvent()
+			So(newTombstone(ctx, &e, 3), ShouldBeNil)
+		})
+	})
+}
+
+func TestTombstoneIndex(t *testing.T) {
+	Convey("test tombstone index", t, func() {
+		ctx := context.Background()
+		now := time.Now()
+		ti := newTombstoneIndex(time.Hour)
+		ti.add(newTombstone(ctx, tombstoneEvent("ut1", now.Add(-2*time.Hour), ""), 0))
+		ti.add(newTombstone(ctx, tombstoneEvent("ut2", now.Add(-2*time.Hour), ""), 1))
+		ti.add(newTombstone(ctx, tombstoneEvent("ut1", now, "2022-11-24T11:20:56Z"), 2))
+
+		Convey("test the later tombstone overrides the earlier one", func() {
+			So(ti.get("quick-start", "ut1").isCancelled(), ShouldBeFalse)
+			So(ti.get("quick-start", "ut2").isCancelled(), ShouldBeTrue)
+			So(ti.get("quick-start", "ut3"), ShouldBeNil)
+		})
+
+		Convey("test expire", func() {
+			So(ti.expire(now, 3), ShouldEqual, 2)
+			So(ti.get("quick-start", "ut1"), ShouldNotBeNil)
+			So(ti.get("quick-start", "ut2"), ShouldBeNil)
+			So(ti.expire(now.Add(2*time.Hour), 3), ShouldEqual, 3)
+			So(ti.get("quick-start", "ut1"), ShouldBeNil)
+		})
+
+		Convey("test reset", func() {
+			ti.setLoaded()
+			So(ti.isLoaded(), ShouldBeTrue)
+			ti.reset()
+			So(ti.isLoaded(), ShouldBeFalse)
+			So(ti.get("quick-start", "ut1"), ShouldBeNil)
+			So(ti.expire(now, 3), ShouldEqual, 3)
+		})
+	})
+}
+
+func TestTimingWheel_checkTombstone(t *testing.T) {
+	Convey("test timingwheel check tombstone", t, func() {
+		ctx := context.Background()
+		tw := newtimingwheel(cfg())
+		mockCtrl := NewController(t)
+		mockClient := client.NewMockClient(mockCtrl)
+		mockEventbus := api.NewMockEventbus(mockCtrl)
+		mockBusWriter := api.NewMockBusWriter(mockCtrl)
+		mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		tw.client = mockClient
+		e := event(0)
+		e.SetID("ut")
+
+		Convey("test check tombstone before loaded", func() {
+			_, err := tw.checkTombstone(ctx, "quick-start", e)
+			So(err, ShouldEqual, errTombstoneNotLoaded)
+			err = tw.deliver(ctx, e)
+			So(err, ShouldEqual, errTombstoneNotLoaded)
+		})
+
+		tw.tombstones.setLoaded()
+		Convey("test check event without tombstone", func() {
+			deliverable, err := tw.checkTombstone(ctx, "quick-start", e)
+			So(err, ShouldBeNil)
+			So(deliverable, ShouldBeTrue)
+		})
+
+		Convey("test check cancelled event", func() {
+			tw.tombstones.add(newTombstone(ctx, tombstoneEvent("ut", time.Now(), ""), 0))
+			deliverable, err := tw.checkTombstone(ctx, "quick-start", e)
+			So(err, ShouldBeNil)
+			So(deliverable, ShouldBeFalse)
+			// the cancelled event isn't appended
+			err = tw.deliver(ctx, e)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test check event rescheduled to an earlier time", func() {
+			earlier := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+			tw.tombstones.add(newTombstone(ctx, tombstoneEvent("ut", time.Now(), earlier), 0))
+			deliverable, err := tw.checkTombstone(ctx, "quick-start", e)
+			So(err, ShouldBeNil)
+			So(deliverable, ShouldBeTrue)
+		})
+
+		Convey("test check event rescheduled to a later time", func() {
+			later := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+			tw.tombstones.add(newTombstone(ctx, tombstoneEvent("ut", time.Now(), later), 0))
+			// the follower pushes nothing, so it always succeeds
+			tw.SetLeader(false)
+			deliverable, err := tw.checkTombstone(ctx, "quick-start", e)
+			So(err, ShouldBeNil)
+			So(deliverable, ShouldBeFalse)
+
+			// the rescheduled event is delivered at the new delivery time
+			rescheduled := e.Clone()
+			rescheduled.SetExtension(xVanusDeliveryTime, later)
+			deliverable, err = tw.checkTombstone(ctx, "quick-start", &rescheduled)
+			So(err, ShouldBeNil)
+			So(deliverable, ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,398 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/internal/primitive/amqp"
+)
+
+const (
+	amqpDefaultPort      = "5672"
+	amqpsDefaultPort     = "5671"
+	amqpDialTimeout      = 10 * time.Second
+	amqpSessionWindow    = uint32(2048)
+	amqpSettleModeMixed  = uint8(2)
+	amqpSettleModeFirst  = uint8(0)
+	amqpMinimumFrameSize = 512
+)
+
+var errAMQPLinkClosed = errors.New("AMQP link is closed by peer")
+
+// amqpClient sends events in the binary content mode of CloudEvents AMQP binding to the
+// address of sink url, events are sent one by one over a single sender link and each of
+// them is finished when the peer settles it.
+type amqpClient struct {
+	url      string
+	username string
+	password string
+	lock     sync.Mutex
+
+	conn          net.Conn
+	r             *bufio.Reader
+	maxFrameSize  uint32
+	credit        uint32
+	deliveryCount uint32
+}
+
+func NewAMQPClient(url, username, password string) EventClient {
+	return &amqpClient{
+		url:      url,
+		username: username,
+		password: password,
+	}
+}
+
+func (c *amqpClient) Send(ctx context.Context, event ce.Event) Result {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.conn == nil {
+		if err := c.connect(ctx); err != nil {
+			c.close()
+			return c.result(ctx, err)
+		}
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Time{}
+	}
+	_ = c.conn.SetDeadline(deadline)
+	msg, err := eventToAMQPMessage(event)
+	if err != nil {
+		return newInternalErr(err)
+	}
+	payload, err := msg.MarshalBinary()
+	if err != nil {
+		return newInternalErr(err)
+	}
+	res, err := c.transfer(payload)
+	if err != nil {
+		c.close()
+		return c.result(ctx, err)
+	}
+	return res
+}
+
+func (c *amqpClient) result(ctx context.Context, err error) Result {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil ||
+		(errors.As(err, &netErr) && netErr.Timeout()) {
+		return DeliveryTimeout
+	}
+	return newUndefinedErr(err)
+}
+
+func (c *amqpClient) close() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+	c.conn = nil
+	c.credit = 0
+	c.deliveryCount = 0
+}
+
+func (c *amqpClient) transfer(payload []byte) (Result, error) {
+	for c.credit == 0 {
+		if _, err := c.readFrame(); err != nil {
+			return Result{}, err
+		}
+	}
+	deliveryID := c.deliveryCount
+	tag := []byte(uuid.NewString())
+	// the overhead of transfer frame is far less than the minimum frame size
+	chunk := int(c.maxFrameSize) - amqpMinimumFrameSize
+	for first := true; first || len(payload) > 0; first = false {
+		n := len(payload)
+		if n > chunk {
+			n = chunk
+		}
+		more := n < len(payload)
+		var f *amqp.Described
+		if first {
+			f = amqp.NewPerformative(amqp.CodeTransfer, uint32(0), deliveryID, tag, uint32(0), false, more)
+		} else {
+			f = amqp.NewPerformative(amqp.CodeTransfer, uint32(0), nil, nil, nil, nil, more)
+		}
+		if err := amqp.WriteFrame(c.conn, &amqp.Frame{Performative: f, Payload: payload[:n]}); err != nil {
+			return Result{}, err
+		}
+		payload = payload[n:]
+	}
+	c.credit--
+	c.deliveryCount++
+	for {
+		f, err := c.readFrame()
+		if err != nil {
+			return Result{}, err
+		}
+		if f == nil || f.Code() != amqp.CodeDisposition {
+			continue
+		}
+		fields := f.Fields()
+		first, _ := fields.Field(1).(uint32)
+		last, ok := fields.Field(2).(uint32)
+		if !ok {
+			last = first
+		}
+		if deliveryID < first || deliveryID > last {
+			continue
+		}
+		state, _ := fields.Field(4).(*amqp.Described)
+		if state == nil {
+			// not settled yet
+			continue
+		}
+		switch state.Code() {
+		case amqp.CodeAccepted:
+			return Success, nil
+		case amqp.CodeRejected:
+			return Result{
+				StatusCode: nethttp.StatusBadRequest,
+				Err:        fmt.Errorf("AMQP message rejected, %s", amqp.ErrorDescription(state.Fields().Field(0))),
+			}, nil
+		default:
+			return newUndefinedErr(fmt.Errorf("AMQP message isn't accepted, outcome: 0x%x", state.Code())), nil
+		}
+	}
+}
+
+// readFrame reads a frame and handles link credit and closing of link, it returns
+// the performative of frame.
+func (c *amqpClient) readFrame() (*amqp.Described, error) {
+	f, err := amqp.ReadFrame(c.r, amqp.DefaultMaxFrameSize)
+	if err != nil {
+		return nil, err
+	}
+	if f.Performative == nil {
+		return nil, nil
+	}
+	switch f.Performative.Code() {
+	case amqp.CodeFlow:
+		fields := f.Performative.Fields()
+		credit, ok := fields.Field(6).(uint32)
+		if !ok {
+			break
+		}
+		deliveryCount, ok := fields.Field(5).(uint32)
+		if !ok {
+			deliveryCount = c.deliveryCount
+		}
+		c.credit = deliveryCount + credit - c.deliveryCount
+	case amqp.CodeDetach, amqp.CodeEnd, amqp.CodeClose:
+		var desc string
+		if f.Performative.Code() == amqp.CodeDetach {
+			desc = amqp.ErrorDescription(f.Performative.Fields().Field(2))
+		} else {
+			desc = amqp.ErrorDescription(f.Performative.Fields().Field(0))
+		}
+		if desc != "" {
+			return nil, fmt.Errorf("%w, %s", errAMQPLinkClosed, desc)
+		}
+		return nil, errAMQPLinkClosed
+	}
+	return f.Performative, nil
+}
+
+func (c *amqpClient) expect(code uint64) (*amqp.Described, error) {
+	for {
+		f, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if f != nil && f.Code() == code {
+			return f, nil
+		}
+	}
+}
+
+func (c *amqpClient) connect(ctx context.Context) error {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return err
+	}
+	host := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "amqps" {
+			host = net.JoinHostPort(u.Hostname(), amqpsDefaultPort)
+		} else {
+			host = net.JoinHostPort(u.Hostname(), amqpDefaultPort)
+		}
+	}
+	dialer := &net.Dialer{Timeout: amqpDialTimeout}
+	if u.Scheme == "amqps" {
+		c.conn, err = (&tls.Dialer{NetDialer: dialer}).DialContext(ctx, "tcp", host)
+	} else {
+		c.conn, err = dialer.DialContext(ctx, "tcp", host)
+	}
+	if err != nil {
+		return err
+	}
+	c.r = bufio.NewReader(c.conn)
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = c.conn.SetDeadline(deadline)
+	}
+	username, password := c.username, c.password
+	if username == "" && u.User != nil {
+		username = u.User.Username()
+		password, _ = u.User.Password()
+	}
+	if err = c.saslHandshake(u.Hostname(), username, password); err != nil {
+		return err
+	}
+	if _, err = c.conn.Write(amqp.ProtocolHeaderAMQP); err != nil {
+		return err
+	}
+	if _, err = amqp.ReadProtocolHeader(c.r); err != nil {
+		return err
+	}
+	frames := []*amqp.Described{
+		amqp.NewPerformative(amqp.CodeOpen, uuid.NewString(), u.Hostname(), uint32(amqp.DefaultMaxFrameSize)),
+		amqp.NewPerformative(amqp.CodeBegin, nil, uint32(0), amqpSessionWindow, amqpSessionWindow),
+		amqp.NewPerformative(amqp.CodeAttach, "vanus-"+uuid.NewString(), uint32(0), amqp.RoleSender,
+			amqpSettleModeMixed, amqpSettleModeFirst, amqp.NewPerformative(amqp.CodeSource),
+			amqp.NewPerformative(amqp.CodeTarget, strings.TrimPrefix(u.Path, "/")), nil, nil, uint32(0)),
+	}
+	for _, f := range frames {
+		if err = amqp.WriteFrame(c.conn, &amqp.Frame{Performative: f}); err != nil {
+			return err
+		}
+	}
+	open, err := c.expect(amqp.CodeOpen)
+	if err != nil {
+		return err
+	}
+	c.maxFrameSize = amqp.DefaultMaxFrameSize
+	if size, ok := open.Fields().Field(2).(uint32); ok && size < c.maxFrameSize {
+		c.maxFrameSize = size
+	}
+	if c.maxFrameSize < 2*amqpMinimumFrameSize {
+		return fmt.Errorf("AMQP max frame size %d is too small", c.maxFrameSize)
+	}
+	attach, err := c.expect(amqp.CodeAttach)
+	if err != nil {
+		return err
+	}
+	// the peer refuses the link by attaching with a null target and detaching it then
+	if attach.Fields().Field(6) == nil {
+		_, err = c.expect(amqp.CodeDetach)
+		if err == nil {
+			err = errAMQPLinkClosed
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *amqpClient) saslHandshake(hostname, username, password string) error {
+	if _, err := c.conn.Write(amqp.ProtocolHeaderSASL); err != nil {
+		return err
+	}
+	if _, err := amqp.ReadProtocolHeader(c.r); err != nil {
+		return err
+	}
+	f, err := amqp.ReadFrame(c.r, amqp.DefaultMaxFrameSize)
+	if err != nil {
+		return err
+	}
+	if f.Performative == nil || f.Performative.Code() != amqp.CodeSASLMechanisms {
+		return fmt.Errorf("unexpected AMQP SASL frame")
+	}
+	mechanism := amqp.SASLMechanismAnonymous
+	var response []byte
+	if username != "" {
+		mechanism = amqp.SASLMechanismPlain
+		response = []byte("\x00" + username + "\x00" + password)
+	}
+	if !saslMechanismSupported(f.Performative.Fields().Field(0), mechanism) {
+		return fmt.Errorf("AMQP SASL mechanism %s isn't supported by server", mechanism)
+	}
+	err = amqp.WriteFrame(c.conn, &amqp.Frame{
+		Type:         amqp.FrameTypeSASL,
+		Performative: amqp.NewPerformative(amqp.CodeSASLInit, mechanism, response, hostname),
+	})
+	if err != nil {
+		return err
+	}
+	f, err = amqp.ReadFrame(c.r, amqp.DefaultMaxFrameSize)
+	if err != nil {
+		return err
+	}
+	if f.Performative == nil || f.Performative.Code() != amqp.CodeSASLOutcome {
+		return fmt.Errorf("unexpected AMQP SASL frame")
+	}
+	if code, _ := f.Performative.Fields().Field(0).(uint8); code != amqp.SASLCodeOK {
+		return fmt.Errorf("AMQP SASL authentication failed, code: %d", code)
+	}
+	return nil
+}
+
+func saslMechanismSupported(mechanisms interface{}, mechanism amqp.Symbol) bool {
+	switch v := mechanisms.(type) {
+	case amqp.Symbol:
+		return v == mechanism
+	case amqp.Array:
+		for _, m := range v {
+			if m == mechanism {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func eventToAMQPMessage(event ce.Event) (*amqp.Message, error) {
+	props := amqp.Map{
+		{Key: amqp.CloudEventsPropertyPrefix + "specversion", Value: event.SpecVersion()},
+		{Key: amqp.CloudEventsPropertyPrefix + "id", Value: event.ID()},
+		{Key: amqp.CloudEventsPropertyPrefix + "source", Value: event.Source()},
+		{Key: amqp.CloudEventsPropertyPrefix + "type", Value: event.Type()},
+	}
+	if event.Subject() != "" {
+		props = append(props, amqp.MapEntry{Key: amqp.CloudEventsPropertyPrefix + "subject", Value: event.Subject()})
+	}
+	if event.DataSchema() != "" {
+		props = append(props, amqp.MapEntry{Key: amqp.CloudEventsPropertyPrefix + "dataschema", Value: event.DataSchema()})
+	}
+	if !event.Time().IsZero() {
+		props = append(props, amqp.MapEntry{Key: amqp.CloudEventsPropertyPrefix + "time", Value: event.Time()})
+	}
+	for k, v := range event.Extensions() {
+		s, err := types.Format(v)
+		if err != nil {
+			return nil, err
+		}
+		props = append(props, amqp.MapEntry{Key: amqp.CloudEventsPropertyPrefix + k, Value: s})
+	}
+	return &amqp.Message{
+		MessageID:             event.ID(),
+		ContentType:           amqp.Symbol(event.DataContentType()),
+		ApplicationProperties: props,
+		Data:                  event.Data(),
+	}, nil
+}
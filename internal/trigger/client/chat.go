@@ -0,0 +1,280 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
+)
+
+const (
+	// DefaultChatMessageTemplate is the message template of chat if it isn't set.
+	DefaultChatMessageTemplate = `{{.type}} from {{.source}}: {{json .data}}`
+	// maxCoalescedMessages is the max messages coalesced into a post, the others wait the next post.
+	maxCoalescedMessages = 50
+	chatTimeout          = 30 * time.Second
+
+	chatPlatformSlack   = "slack"
+	chatPlatformDiscord = "discord"
+	chatPlatformTeams   = "teams"
+	chatPlatformWebhook = "webhook"
+)
+
+// maxChatMessageLength is the max characters of a post of the platforms.
+var maxChatMessageLength = map[string]int{
+	chatPlatformSlack:   40000,
+	chatPlatformDiscord: 2000,
+	chatPlatformTeams:   28000,
+	chatPlatformWebhook: 40000,
+}
+
+// chatRetryPolicy is the backoff to post again when the webhook is rate limited without Retry-After.
+var chatRetryPolicy = retry.Policy{
+	Initial: time.Second,
+	Max:     10 * time.Second,
+	Jitter:  retry.FullJitter,
+}
+
+var chatTemplateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// ParseChatMessageTemplate parses the Go template of message, it's executed over the attributes of event
+// and the data, which is the JSON value of data or the string if the data isn't JSON, e.g.
+// {{.type}} from {{.source}}: {{.data.message}}. The function json formats the value in JSON.
+func ParseChatMessageTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = DefaultChatMessageTemplate
+	}
+	return template.New("message").Funcs(chatTemplateFuncs).Parse(text)
+}
+
+// chatPlatformOf returns the platform of the webhook by the host.
+func chatPlatformOf(webhook string) (string, error) {
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return "", err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("sink must be the http[s] url of webhook")
+	}
+	host := u.Hostname()
+	switch {
+	case host == "hooks.slack.com":
+		return chatPlatformSlack, nil
+	case host == "discord.com" || host == "discordapp.com":
+		return chatPlatformDiscord, nil
+	case strings.HasSuffix(host, ".webhook.office.com"):
+		return chatPlatformTeams, nil
+	default:
+		return chatPlatformWebhook, nil
+	}
+}
+
+type chatMessage struct {
+	text string
+	done chan Result
+}
+
+type chat struct {
+	webhook  string
+	platform string
+	template *template.Template
+	err      error
+	window   time.Duration
+	interval time.Duration
+	client   *nethttp.Client
+	lock     sync.Mutex
+	pending  []*chatMessage
+	lastPost time.Time
+}
+
+// NewChatClient returns the client posting the events as messages to the webhook of Slack, Discord, Teams or a
+// generic chat which accepts {"text": message}. The messages rendered in the coalesceWindow are coalesced into
+// one post, and the posts are limited to messagesPerMinute if it's positive, the messages exceed it wait and
+// are coalesced into the next post.
+func NewChatClient(webhook, messageTemplate string, coalesceWindow time.Duration,
+	messagesPerMinute int) EventClient {
+	c := &chat{
+		webhook: webhook,
+		window:  coalesceWindow,
+		client:  &nethttp.Client{},
+	}
+	if messagesPerMinute > 0 {
+		c.interval = time.Minute / time.Duration(messagesPerMinute)
+	}
+	if c.platform, c.err = chatPlatformOf(webhook); c.err == nil {
+		c.template, c.err = ParseChatMessageTemplate(messageTemplate)
+	}
+	return c
+}
+
+func (c *chat) Send(ctx context.Context, event ce.Event) Result {
+	if c.err != nil {
+		return Result{StatusCode: errStatusCode, Err: c.err}
+	}
+	text, err := c.render(&event)
+	if err != nil {
+		return Result{StatusCode: errStatusCode, Err: err}
+	}
+	m := &chatMessage{text: text, done: make(chan Result, 1)}
+	c.lock.Lock()
+	c.pending = append(c.pending, m)
+	if len(c.pending) == 1 {
+		c.schedule()
+	}
+	c.lock.Unlock()
+	select {
+	case r := <-m.done:
+		return r
+	case <-ctx.Done():
+		return DeliveryTimeout
+	}
+}
+
+func (c *chat) render(e *ce.Event) (string, error) {
+	values := make(map[string]interface{}, len(e.Extensions())+8)
+	for name, v := range e.Extensions() {
+		values[name] = v
+	}
+	values["id"] = e.ID()
+	values["source"] = e.Source()
+	values["type"] = e.Type()
+	values["subject"] = e.Subject()
+	values["time"] = e.Time()
+	values["datacontenttype"] = e.DataContentType()
+	var data interface{}
+	if err := json.Unmarshal(e.Data(), &data); err != nil {
+		data = string(e.Data())
+	}
+	values["data"] = data
+	var sb strings.Builder
+	if err := c.template.Execute(&sb, values); err != nil {
+		return "", fmt.Errorf("render message error: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// schedule posts the pending messages after the coalesce window, or later if the posts are rate limited,
+// the caller must hold c.lock.
+func (c *chat) schedule() {
+	delay := c.window
+	if wait := time.Until(c.lastPost.Add(c.interval)); wait > delay {
+		delay = wait
+	}
+	time.AfterFunc(delay, c.flush)
+}
+
+func (c *chat) flush() {
+	c.lock.Lock()
+	batch := c.pending
+	if len(batch) > maxCoalescedMessages {
+		batch = batch[:maxCoalescedMessages]
+		c.pending = append([]*chatMessage{}, c.pending[maxCoalescedMessages:]...)
+	} else {
+		c.pending = nil
+	}
+	c.lastPost = time.Now()
+	if len(c.pending) > 0 {
+		c.schedule()
+	}
+	c.lock.Unlock()
+	r := c.post(batch)
+	for _, m := range batch {
+		m.done <- r
+	}
+}
+
+// post posts the messages in one message, it's posted again after Retry-After if the webhook is rate limited.
+func (c *chat) post(batch []*chatMessage) Result {
+	texts := make([]string, len(batch))
+	for i, m := range batch {
+		texts[i] = m.text
+	}
+	text := truncateMessage(strings.Join(texts, "\n"), maxChatMessageLength[c.platform])
+	key := "text"
+	if c.platform == chatPlatformDiscord {
+		key = "content"
+	}
+	body, _ := json.Marshal(map[string]string{key: text})
+	ctx, cancel := context.WithTimeout(context.Background(), chatTimeout)
+	defer cancel()
+	backoff := chatRetryPolicy.Backoff()
+	for {
+		r, retryAfter := c.postOnce(ctx, body)
+		if r.StatusCode != nethttp.StatusTooManyRequests {
+			return r
+		}
+		if retryAfter <= 0 {
+			retryAfter = backoff.Next()
+		}
+		if !pkgUtil.SleepWithContext(ctx, retryAfter) {
+			return r
+		}
+	}
+}
+
+func (c *chat) postOnce(ctx context.Context, body []byte) (Result, time.Duration) {
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, c.webhook, bytes.NewReader(body))
+	if err != nil {
+		return newInternalErr(err), 0
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return DeliveryTimeout, 0
+		}
+		return newUndefinedErr(err), 0
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= nethttp.StatusMultipleChoices {
+		var retryAfter time.Duration
+		if seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+			retryAfter = time.Duration(seconds * float64(time.Second))
+		}
+		return convertHTTPResponse(resp.StatusCode, "chat post", data), retryAfter
+	}
+	return Success, 0
+}
+
+// truncateMessage truncates the message to the max characters.
+func truncateMessage(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:max-1]) + "…"
+}
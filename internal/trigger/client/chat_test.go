@@ -0,0 +1,182 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestChatPlatformOf(t *testing.T) {
+	Convey("test chat platform of webhook", t, func() {
+		for webhook, platform := range map[string]string{
+			"https://hooks.slack.com/services/T000/B000/XXX":        chatPlatformSlack,
+			"https://discord.com/api/webhooks/1/token":              chatPlatformDiscord,
+			"https://example.webhook.office.com/webhookb2/1":        chatPlatformTeams,
+			"http://127.0.0.1:8080/hooks/alert":                     chatPlatformWebhook,
+			"https://discordapp.com/api/webhooks/1/token?wait=true": chatPlatformDiscord,
+		} {
+			p, err := chatPlatformOf(webhook)
+			So(err, ShouldBeNil)
+			So(p, ShouldEqual, platform)
+		}
+		_, err := chatPlatformOf("amqp://127.0.0.1/queue")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestChat_render(t *testing.T) {
+	Convey("test render chat message", t, func() {
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("orders")
+		e.SetType("order.failed")
+		e.SetExtension("tenant", "a")
+		_ = e.SetData(ce.ApplicationJSON, map[string]interface{}{"message": "out of stock", "id": 1})
+
+		c := NewChatClient("https://hooks.slack.com/services/T000/B000/XXX", "", 0, 0).(*chat)
+		text, err := c.render(&e)
+		So(err, ShouldBeNil)
+		So(text, ShouldEqual, `order.failed from orders: {"id":1,"message":"out of stock"}`)
+
+		c = NewChatClient("https://hooks.slack.com/services/T000/B000/XXX",
+			"[{{.tenant}}] {{.subject}}{{.data.message}} ({{.id}})", 0, 0).(*chat)
+		text, err = c.render(&e)
+		So(err, ShouldBeNil)
+		So(text, ShouldEqual, "[a] out of stock (1)")
+
+		_ = e.SetData(ce.TextPlain, "text")
+		_, err = c.render(&e)
+		So(err, ShouldNotBeNil)
+
+		r := NewChatClient("https://hooks.slack.com/services/T000/B000/XXX", "{{.type", 0, 0).
+			Send(context.Background(), e)
+		So(r.StatusCode, ShouldEqual, errStatusCode)
+	})
+}
+
+func TestChat_Send(t *testing.T) {
+	Convey("test chat send", t, func() {
+		ctx := context.Background()
+		var (
+			mutex   sync.Mutex
+			posts   []map[string]string
+			times   []time.Time
+			handler func(w nethttp.ResponseWriter) bool
+		)
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			if handler != nil && !handler(w) {
+				return
+			}
+			body := map[string]string{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			mutex.Lock()
+			posts = append(posts, body)
+			times = append(times, time.Now())
+			mutex.Unlock()
+		}))
+		defer server.Close()
+		newEvent := func(id string) ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("source")
+			e.SetType("alert")
+			return e
+		}
+		send := func(c EventClient, ids ...string) []Result {
+			var wg sync.WaitGroup
+			results := make([]Result, len(ids))
+			for i := range ids {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i] = c.Send(ctx, newEvent(ids[i]))
+				}(i)
+			}
+			wg.Wait()
+			return results
+		}
+
+		Convey("test coalesce the messages in window", func() {
+			c := NewChatClient(server.URL, "{{.id}}", 50*time.Millisecond, 0)
+			for _, r := range send(c, "1", "2", "3") {
+				So(r.Err, ShouldBeNil)
+			}
+			So(posts, ShouldHaveLength, 1)
+			lines := strings.Split(posts[0]["text"], "\n")
+			So(lines, ShouldHaveLength, 3)
+			So(lines, ShouldContain, "2")
+		})
+
+		Convey("test limit the posts per minute", func() {
+			c := NewChatClient(server.URL, "{{.id}}", 0, 600)
+			So(c.Send(ctx, newEvent("1")).Err, ShouldBeNil)
+			for _, r := range send(c, "2", "3") {
+				So(r.Err, ShouldBeNil)
+			}
+			So(posts, ShouldHaveLength, 2)
+			So(times[1].Sub(times[0]), ShouldBeGreaterThanOrEqualTo, 90*time.Millisecond)
+			So(strings.Split(posts[1]["text"], "\n"), ShouldHaveLength, 2)
+		})
+
+		Convey("test post again after rate limited", func() {
+			limited := false
+			handler = func(w nethttp.ResponseWriter) bool {
+				if !limited {
+					limited = true
+					w.Header().Set("Retry-After", "0.01")
+					w.WriteHeader(nethttp.StatusTooManyRequests)
+					return false
+				}
+				return true
+			}
+			c := NewChatClient(server.URL, "{{.id}}", 0, 0)
+			So(c.Send(ctx, newEvent("1")).Err, ShouldBeNil)
+			So(posts, ShouldHaveLength, 1)
+		})
+
+		Convey("test post failed", func() {
+			handler = func(w nethttp.ResponseWriter) bool {
+				w.WriteHeader(nethttp.StatusForbidden)
+				return false
+			}
+			c := NewChatClient(server.URL, "{{.id}}", 0, 0)
+			So(c.Send(ctx, newEvent("1")), ShouldResemble, Forbidden)
+		})
+
+		Convey("test the delivery timeout", func() {
+			c := NewChatClient(server.URL, "{{.id}}", time.Second, 0)
+			timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			So(c.Send(timeoutCtx, newEvent("1")), ShouldResemble, DeliveryTimeout)
+		})
+	})
+}
+
+func TestTruncateMessage(t *testing.T) {
+	Convey("test truncate message", t, func() {
+		So(truncateMessage("abc", 3), ShouldEqual, "abc")
+		So(truncateMessage("你好世界", 3), ShouldEqual, "你好…")
+	})
+}
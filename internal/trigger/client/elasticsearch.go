@@ -0,0 +1,386 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/trigger/util"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
+)
+
+const (
+	defaultBulkSize      = 500
+	maxBulkSize          = 10000
+	defaultFlushInterval = 100 * time.Millisecond
+	// bulkTimeout bounds a bulk request including the retries of the events rejected by 429, the events
+	// whose delivery timeout is shorter get DeliveryTimeout, and they are indexed again by the same id.
+	bulkTimeout = 30 * time.Second
+
+	documentEvent = "event"
+	documentData  = "data"
+)
+
+// bulkRetryPolicy is the backoff to index the events rejected by 429 again.
+var bulkRetryPolicy = retry.Policy{
+	Initial: 100 * time.Millisecond,
+	Max:     5 * time.Second,
+	Jitter:  retry.FullJitter,
+}
+
+// ElasticsearchSink is the sink of elasticsearch protocol in format
+// http[s]://host[:port]/<index>[?bulk_size=500&flush_interval=100ms&document=event], the index is a template
+// in which {attribute} is replaced by the attribute of event, and {time:layout} by the event time in the Go
+// time layout, e.g. events-{source}-{time:2006.01.02}. The events are indexed in bulks by bulk_size or every
+// flush_interval, the document is the event in structured JSON or the JSON data of it by document=data.
+type ElasticsearchSink struct {
+	Endpoint      string
+	Index         string
+	BulkSize      int
+	FlushInterval time.Duration
+	DataOnly      bool
+	index         []templatePart
+}
+
+type templatePart struct {
+	literal string
+	attr    string
+	layout  string
+}
+
+func ParseElasticsearchSink(sink string) (*ElasticsearchSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("sink must be http[s]://host[:port]/index")
+	}
+	s := &ElasticsearchSink{
+		Endpoint:      u.Scheme + "://" + u.Host,
+		Index:         strings.Trim(u.Path, "/"),
+		BulkSize:      defaultBulkSize,
+		FlushInterval: defaultFlushInterval,
+	}
+	if s.Index == "" || strings.Contains(s.Index, "/") {
+		return nil, fmt.Errorf("index is empty or contains /")
+	}
+	if s.index, err = parseTemplate(s.Index); err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	if v := query.Get("bulk_size"); v != "" {
+		if s.BulkSize, err = strconv.Atoi(v); err != nil || s.BulkSize <= 0 || s.BulkSize > maxBulkSize {
+			return nil, fmt.Errorf("bulk_size must be in [1, %d]", maxBulkSize)
+		}
+	}
+	if v := query.Get("flush_interval"); v != "" {
+		if s.FlushInterval, err = time.ParseDuration(v); err != nil || s.FlushInterval <= 0 {
+			return nil, fmt.Errorf("flush_interval must be a positive duration")
+		}
+	}
+	switch query.Get("document") {
+	case "", documentEvent:
+	case documentData:
+		s.DataOnly = true
+	default:
+		return nil, fmt.Errorf("document must be event or data")
+	}
+	return s, nil
+}
+
+// parseTemplate parses the template in which {attribute} is replaced by the attribute of event, and
+// {time:layout} by the event time in the Go time layout.
+func parseTemplate(template string) ([]templatePart, error) {
+	var parts []templatePart
+	for template != "" {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			parts = append(parts, templatePart{literal: template})
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("template has unclosed {")
+		}
+		if start > 0 {
+			parts = append(parts, templatePart{literal: template[:start]})
+		}
+		name := template[start+1 : start+end]
+		if strings.HasPrefix(name, "time:") {
+			layout := strings.TrimPrefix(name, "time:")
+			if layout == "" {
+				return nil, fmt.Errorf("template has empty time layout")
+			}
+			parts = append(parts, templatePart{attr: "time", layout: layout})
+		} else {
+			if err := pkgUtil.ValidateEventAttrName(name); err != nil {
+				return nil, err
+			}
+			parts = append(parts, templatePart{attr: name})
+		}
+		template = template[start+end+1:]
+	}
+	return parts, nil
+}
+
+// indexOf returns the index of the event, the index names of Elasticsearch are lowercase.
+func (s *ElasticsearchSink) indexOf(e *ce.Event) (string, error) {
+	index, err := renderTemplate(s.index, e)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(index), nil
+}
+
+func renderTemplate(parts []templatePart, e *ce.Event) (string, error) {
+	var sb strings.Builder
+	for _, p := range parts {
+		switch {
+		case p.attr == "":
+			sb.WriteString(p.literal)
+		case p.layout != "":
+			t := e.Time()
+			if t.IsZero() {
+				t = time.Now()
+			}
+			sb.WriteString(t.UTC().Format(p.layout))
+		default:
+			v, exist := util.LookupAttribute(*e, p.attr)
+			if !exist || v == nil {
+				return "", fmt.Errorf("the attribute %s of template doesn't exist", p.attr)
+			}
+			sb.WriteString(fmt.Sprint(v))
+		}
+	}
+	return sb.String(), nil
+}
+
+type bulkItem struct {
+	index string
+	id    string
+	doc   []byte
+	done  chan Result
+}
+
+type elasticsearch struct {
+	sink     *ElasticsearchSink
+	err      error
+	username string
+	password string
+	client   *nethttp.Client
+	lock     sync.Mutex
+	pending  []*bulkItem
+	timer    *time.Timer
+}
+
+func NewElasticsearchClient(sink, username, password string) EventClient {
+	s, err := ParseElasticsearchSink(sink)
+	return &elasticsearch{
+		sink:     s,
+		err:      err,
+		username: username,
+		password: password,
+		client:   &nethttp.Client{},
+	}
+}
+
+func (c *elasticsearch) Send(ctx context.Context, event ce.Event) Result {
+	if c.err != nil {
+		return Result{StatusCode: errStatusCode, Err: c.err}
+	}
+	item, err := c.newItem(&event)
+	if err != nil {
+		return Result{StatusCode: errStatusCode, Err: err}
+	}
+	c.lock.Lock()
+	c.pending = append(c.pending, item)
+	var batch []*bulkItem
+	if len(c.pending) >= c.sink.BulkSize {
+		batch = c.takePending()
+	} else if len(c.pending) == 1 {
+		c.timer = time.AfterFunc(c.sink.FlushInterval, c.flushPending)
+	}
+	c.lock.Unlock()
+	if batch != nil {
+		go c.flush(batch)
+	}
+	select {
+	case r := <-item.done:
+		return r
+	case <-ctx.Done():
+		return DeliveryTimeout
+	}
+}
+
+func (c *elasticsearch) newItem(e *ce.Event) (*bulkItem, error) {
+	index, err := c.sink.indexOf(e)
+	if err != nil {
+		return nil, err
+	}
+	var doc []byte
+	if c.sink.DataOnly {
+		doc = e.Data()
+		if !json.Valid(doc) || !bytes.HasPrefix(bytes.TrimSpace(doc), []byte("{")) {
+			return nil, fmt.Errorf("the data of event isn't a JSON object")
+		}
+		doc = compactJSON(doc)
+	} else if doc, err = json.Marshal(e); err != nil {
+		return nil, err
+	}
+	return &bulkItem{
+		index: index,
+		id:    e.ID(),
+		doc:   doc,
+		done:  make(chan Result, 1),
+	}, nil
+}
+
+// compactJSON removes the newlines which aren't allowed in the documents of bulk request.
+func compactJSON(doc []byte) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, doc); err != nil {
+		return doc
+	}
+	return buf.Bytes()
+}
+
+// takePending takes the pending events, the caller must hold c.lock.
+func (c *elasticsearch) takePending() []*bulkItem {
+	batch := c.pending
+	c.pending = nil
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	return batch
+}
+
+func (c *elasticsearch) flushPending() {
+	c.lock.Lock()
+	batch := c.takePending()
+	c.lock.Unlock()
+	if len(batch) > 0 {
+		c.flush(batch)
+	}
+}
+
+// flush indexes the events in a bulk request, the events rejected by 429 are indexed again after backoff.
+func (c *elasticsearch) flush(batch []*bulkItem) {
+	ctx, cancel := context.WithTimeout(context.Background(), bulkTimeout)
+	defer cancel()
+	backoff := bulkRetryPolicy.Backoff()
+	for {
+		statuses, r := c.bulk(ctx, batch)
+		var rejected []*bulkItem
+		for i, item := range batch {
+			switch {
+			case r.StatusCode == nethttp.StatusTooManyRequests || (r.Err == nil &&
+				statuses[i].Status == nethttp.StatusTooManyRequests):
+				rejected = append(rejected, item)
+			case r.Err != nil:
+				item.done <- r
+			case statuses[i].Status >= nethttp.StatusMultipleChoices:
+				item.done <- Result{
+					StatusCode: statuses[i].Status,
+					Err:        fmt.Errorf("index event error: %s", string(statuses[i].Error)),
+				}
+			default:
+				item.done <- Success
+			}
+		}
+		if len(rejected) == 0 {
+			return
+		}
+		batch = rejected
+		if !pkgUtil.SleepWithContext(ctx, backoff.Next()) {
+			for _, item := range batch {
+				item.done <- newResultByHTTPCode(nethttp.StatusTooManyRequests)
+			}
+			return
+		}
+	}
+}
+
+type bulkItemStatus struct {
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+type bulkResponse struct {
+	Errors bool                        `json:"errors"`
+	Items  []map[string]bulkItemStatus `json:"items"`
+}
+
+// bulk sends the bulk request, the statuses of the events are returned if the request succeeds.
+func (c *elasticsearch) bulk(ctx context.Context, batch []*bulkItem) ([]bulkItemStatus, Result) {
+	var body bytes.Buffer
+	for _, item := range batch {
+		meta, _ := json.Marshal(map[string]interface{}{
+			"index": map[string]string{"_index": item.index, "_id": item.id},
+		})
+		body.Write(meta)
+		body.WriteByte('\n')
+		body.Write(item.doc)
+		body.WriteByte('\n')
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, c.sink.Endpoint+"/_bulk", &body)
+	if err != nil {
+		return nil, newInternalErr(err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, newUndefinedErr(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, newUndefinedErr(err)
+	}
+	if resp.StatusCode >= nethttp.StatusMultipleChoices {
+		return nil, convertHTTPResponse(resp.StatusCode, "elasticsearch bulk", data)
+	}
+	res := &bulkResponse{}
+	if err = json.Unmarshal(data, res); err != nil {
+		return nil, newInternalErr(err)
+	}
+	if len(res.Items) != len(batch) {
+		return nil, newInternalErr(fmt.Errorf("bulk response has %d items, but %d are sent",
+			len(res.Items), len(batch)))
+	}
+	statuses := make([]bulkItemStatus, len(batch))
+	for i, item := range res.Items {
+		for _, status := range item {
+			statuses[i] = status
+		}
+	}
+	return statuses, Success
+}
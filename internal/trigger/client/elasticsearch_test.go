@@ -0,0 +1,187 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseElasticsearchSink(t *testing.T) {
+	Convey("test parse elasticsearch sink", t, func() {
+		s, err := ParseElasticsearchSink("https://127.0.0.1:9200/events-{source}-{time:2006.01.02}")
+		So(err, ShouldBeNil)
+		So(s.Endpoint, ShouldEqual, "https://127.0.0.1:9200")
+		So(s.BulkSize, ShouldEqual, defaultBulkSize)
+		So(s.FlushInterval, ShouldEqual, defaultFlushInterval)
+		So(s.DataOnly, ShouldBeFalse)
+
+		e := ce.NewEvent()
+		e.SetSource("Orders")
+		e.SetTime(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+		index, err := s.indexOf(&e)
+		So(err, ShouldBeNil)
+		So(index, ShouldEqual, "events-orders-2023.01.02")
+
+		s, err = ParseElasticsearchSink("http://es:9200/events-{tenant}?bulk_size=10&flush_interval=1s&document=data")
+		So(err, ShouldBeNil)
+		So(s.BulkSize, ShouldEqual, 10)
+		So(s.FlushInterval, ShouldEqual, time.Second)
+		So(s.DataOnly, ShouldBeTrue)
+		_, err = s.indexOf(&e)
+		So(err, ShouldNotBeNil)
+
+		for _, sink := range []string{
+			"amqp://es:9200/events",
+			"http://es:9200",
+			"http://es:9200/events-{source",
+			"http://es:9200/events-{time:}",
+			"http://es:9200/events?bulk_size=0",
+			"http://es:9200/events?flush_interval=abc",
+			"http://es:9200/events?document=raw",
+		} {
+			_, err = ParseElasticsearchSink(sink)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
+func TestElasticsearch_Send(t *testing.T) {
+	Convey("test elasticsearch send", t, func() {
+		ctx := context.Background()
+		var (
+			mutex    sync.Mutex
+			bulks    [][]map[string]interface{}
+			username string
+			handler  func(lines []map[string]interface{}) (int, []int)
+		)
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			var lines []map[string]interface{}
+			scanner := bufio.NewScanner(r.Body)
+			for scanner.Scan() {
+				line := map[string]interface{}{}
+				_ = json.Unmarshal(scanner.Bytes(), &line)
+				lines = append(lines, line)
+			}
+			mutex.Lock()
+			bulks = append(bulks, lines)
+			username, _, _ = r.BasicAuth()
+			mutex.Unlock()
+			code, statuses := handler(lines)
+			w.WriteHeader(code)
+			res := bulkResponse{}
+			for _, status := range statuses {
+				item := bulkItemStatus{Status: status}
+				if status >= 300 {
+					item.Error = json.RawMessage(`{"type":"error"}`)
+				}
+				res.Items = append(res.Items, map[string]bulkItemStatus{"index": item})
+			}
+			_ = json.NewEncoder(w).Encode(res)
+		}))
+		defer server.Close()
+		newEvent := func(id string) ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("source")
+			e.SetType("order.created")
+			_ = e.SetData(ce.ApplicationJSON, map[string]interface{}{"id": id})
+			return e
+		}
+		allCreated := func(lines []map[string]interface{}) (int, []int) {
+			statuses := make([]int, len(lines)/2)
+			for i := range statuses {
+				statuses[i] = nethttp.StatusCreated
+			}
+			return nethttp.StatusOK, statuses
+		}
+
+		Convey("test index events in a bulk", func() {
+			handler = allCreated
+			c := NewElasticsearchClient(server.URL+"/events-{type}?bulk_size=3&flush_interval=1m&document=data",
+				"elastic", "password")
+			var wg sync.WaitGroup
+			results := make([]Result, 3)
+			for i := 0; i < 3; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					results[i] = c.Send(ctx, newEvent(fmt.Sprint(i)))
+				}(i)
+			}
+			wg.Wait()
+			for _, r := range results {
+				So(r.Err, ShouldBeNil)
+			}
+			So(bulks, ShouldHaveLength, 1)
+			So(bulks[0], ShouldHaveLength, 6)
+			meta, _ := bulks[0][0]["index"].(map[string]interface{})
+			So(meta["_index"], ShouldEqual, "events-order.created")
+			So(bulks[0][1], ShouldContainKey, "id")
+			So(username, ShouldEqual, "elastic")
+		})
+
+		Convey("test flush by interval and retry on 429", func() {
+			var calls int32
+			handler = func(lines []map[string]interface{}) (int, []int) {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					return nethttp.StatusOK, []int{nethttp.StatusTooManyRequests}
+				}
+				return allCreated(lines)
+			}
+			c := NewElasticsearchClient(server.URL+"/events?flush_interval=10ms", "", "")
+			r := c.Send(ctx, newEvent("1"))
+			So(r.Err, ShouldBeNil)
+			So(bulks, ShouldHaveLength, 2)
+			So(bulks[1][1]["id"], ShouldEqual, "1")
+			So(bulks[1][1]["type"], ShouldEqual, "order.created")
+		})
+
+		Convey("test index failed", func() {
+			handler = func(lines []map[string]interface{}) (int, []int) {
+				return nethttp.StatusOK, []int{nethttp.StatusBadRequest}
+			}
+			c := NewElasticsearchClient(server.URL+"/events?flush_interval=10ms", "", "")
+			r := c.Send(ctx, newEvent("1"))
+			So(r.Err, ShouldNotBeNil)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusBadRequest)
+
+			handler = func(lines []map[string]interface{}) (int, []int) {
+				return nethttp.StatusServiceUnavailable, nil
+			}
+			r = c.Send(ctx, newEvent("1"))
+			So(r.StatusCode, ShouldEqual, nethttp.StatusServiceUnavailable)
+		})
+
+		Convey("test the data isn't a JSON object", func() {
+			c := NewElasticsearchClient(server.URL+"/events?document=data", "", "")
+			e := newEvent("1")
+			_ = e.SetData(ce.TextPlain, "text")
+			r := c.Send(ctx, e)
+			So(r.StatusCode, ShouldEqual, errStatusCode)
+		})
+	})
+}
@@ -17,6 +17,7 @@ package client
 import (
 	"context"
 	"errors"
+	nethttp "net/http"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
@@ -34,7 +35,26 @@ func NewHTTPClient(url string) EventClient {
 }
 
 func (c *http) Send(ctx context.Context, event ce.Event) Result {
-	res := c.client.Send(ctx, event)
+	return newHTTPResult(c.client.Send(ctx, event))
+}
+
+// Request sends the event, the reply of result is the response of sink if it's a CloudEvent.
+func (c *http) Request(ctx context.Context, event ce.Event) Result {
+	reply, res := c.client.Request(ctx, event)
+	var httpResult *cehttp.Result
+	if ce.ResultAs(res, &httpResult) && httpResult.StatusCode >= nethttp.StatusMultipleChoices {
+		// the response which isn't a CloudEvent is an ack of the request, check the status code to find
+		// out the failure.
+		return Result{StatusCode: httpResult.StatusCode, Err: res}
+	}
+	r := newHTTPResult(res)
+	if r.Err == nil {
+		r.Reply = reply
+	}
+	return r
+}
+
+func newHTTPResult(res ce.Result) Result {
 	if ce.IsACK(res) {
 		return Success
 	}
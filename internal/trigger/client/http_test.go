@@ -0,0 +1,73 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHTTP_Request(t *testing.T) {
+	Convey("test http request", t, func() {
+		ctx := context.Background()
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("source")
+		e.SetType("type")
+		var handler nethttp.HandlerFunc
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			handler(w, r)
+		}))
+		defer server.Close()
+		c, _ := NewHTTPClient(server.URL).(Requester)
+
+		Convey("test reply an event", func() {
+			handler = func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				w.Header().Set("ce-specversion", "1.0")
+				w.Header().Set("ce-id", "2")
+				w.Header().Set("ce-source", "sink")
+				w.Header().Set("ce-type", "reply")
+				w.WriteHeader(nethttp.StatusOK)
+			}
+			r := c.Request(ctx, e)
+			So(r.Err, ShouldBeNil)
+			So(r.Reply, ShouldNotBeNil)
+			So(r.Reply.ID(), ShouldEqual, "2")
+			So(r.Reply.Type(), ShouldEqual, "reply")
+		})
+		Convey("test response isn't an event", func() {
+			handler = func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				w.WriteHeader(nethttp.StatusAccepted)
+			}
+			r := c.Request(ctx, e)
+			So(r.Err, ShouldBeNil)
+			So(r.Reply, ShouldBeNil)
+		})
+		Convey("test failed", func() {
+			handler = func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				w.WriteHeader(nethttp.StatusInternalServerError)
+			}
+			r := c.Request(ctx, e)
+			So(r.Err, ShouldNotBeNil)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusInternalServerError)
+			So(r.Reply, ShouldBeNil)
+		})
+	})
+}
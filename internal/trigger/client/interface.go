@@ -22,6 +22,7 @@ import (
 	nethttp "net/http"
 
 	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
 
 type Sender interface {
@@ -32,9 +33,34 @@ type EventClient interface {
 	Sender
 }
 
+// Requester is the EventClient which can reply an event to the delivered event.
+type Requester interface {
+	Request(ctx context.Context, event ce.Event) Result
+}
+
+type eventOffsetKey struct{}
+
+type eventOffset struct {
+	eventlogID vanus.ID
+	offset     uint64
+}
+
+// WithEventOffset returns the context carrying the offset of the delivered event in its eventlog, the
+// EventClient which names its output by the offsets gets it from the context.
+func WithEventOffset(ctx context.Context, eventlogID vanus.ID, offset uint64) context.Context {
+	return context.WithValue(ctx, eventOffsetKey{}, eventOffset{eventlogID: eventlogID, offset: offset})
+}
+
+func eventOffsetFrom(ctx context.Context) (eventOffset, bool) {
+	o, ok := ctx.Value(eventOffsetKey{}).(eventOffset)
+	return o, ok
+}
+
 type Result struct {
 	StatusCode int
 	Err        error
+	// Reply is the event replied by the sink, it's only set by Requester.
+	Reply *ce.Event
 }
 
 func newResultByHTTPCode(httpCode int) Result {
@@ -74,7 +100,7 @@ func convertHTTPResponse(statusCode int, desc string, body []byte) Result {
 
 var (
 	Success               = Result{}
-	DeliveryTimeout       = Result{ErrDeliveryTimeout, errors.New("DeliveryTimeout")}
+	DeliveryTimeout       = Result{StatusCode: ErrDeliveryTimeout, Err: errors.New("DeliveryTimeout")}
 	Forbidden             = newResultByHTTPCode(nethttp.StatusForbidden)
 	RequestEntityTooLarge = newResultByHTTPCode(nethttp.StatusRequestEntityTooLarge)
 )
@@ -85,3 +85,40 @@ func (mr *MockEventClientMockRecorder) Send(ctx, event interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEventClient)(nil).Send), ctx, event)
 }
+
+// MockRequester is a mock of Requester interface.
+type MockRequester struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequesterMockRecorder
+}
+
+// MockRequesterMockRecorder is the mock recorder for MockRequester.
+type MockRequesterMockRecorder struct {
+	mock *MockRequester
+}
+
+// NewMockRequester creates a new mock instance.
+func NewMockRequester(ctrl *gomock.Controller) *MockRequester {
+	mock := &MockRequester{ctrl: ctrl}
+	mock.recorder = &MockRequesterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequester) EXPECT() *MockRequesterMockRecorder {
+	return m.recorder
+}
+
+// Request mocks base method.
+func (m *MockRequester) Request(ctx context.Context, event v2.Event) Result {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Request", ctx, event)
+	ret0, _ := ret[0].(Result)
+	return ret0
+}
+
+// Request indicates an expected call of Request.
+func (mr *MockRequesterMockRecorder) Request(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Request", reflect.TypeOf((*MockRequester)(nil).Request), ctx, event)
+}
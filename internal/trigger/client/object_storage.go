@@ -0,0 +1,404 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	defaultFileEvents           = 1000
+	maxFileEvents               = 100000
+	defaultArchiveFlushInterval = time.Second
+	defaultObjectStorageRegion  = "us-east-1"
+	gcsEndpoint                 = "https://storage.googleapis.com"
+	uploadTimeout               = 30 * time.Second
+	archiveFormatJSONL          = "jsonl"
+	archiveFormatParquet        = "parquet"
+	objectStorageService        = "s3"
+)
+
+// uploadRetryPolicy is the backoff to upload the file again when the object storage is throttling or
+// unavailable.
+var uploadRetryPolicy = retry.Policy{
+	Initial: 200 * time.Millisecond,
+	Max:     5 * time.Second,
+	Jitter:  retry.FullJitter,
+}
+
+// ObjectStorageSink is the sink of object-storage protocol in format
+// s3://bucket[/prefix][?region=us-east-1&endpoint=https://host&format=jsonl&file_events=1000&flush_interval=1s]
+// or gs://bucket[/prefix] for the S3 interoperable API of Google Cloud Storage. The prefix is a template like
+// the index of ElasticsearchSink, e.g. archive/{source}/{time:2006/01/02/15}. The events are written in a file
+// by file_events or every flush_interval which must be shorter than the delivery timeout of the subscription,
+// the file is JSONL of the structured events or Parquet by format=parquet.
+type ObjectStorageSink struct {
+	Endpoint      string
+	Region        string
+	Bucket        string
+	Prefix        string
+	Format        string
+	FileEvents    int
+	FlushInterval time.Duration
+	prefix        []templatePart
+}
+
+func ParseObjectStorageSink(sink string) (*ObjectStorageSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("bucket is empty")
+	}
+	query := u.Query()
+	s := &ObjectStorageSink{
+		Region:        query.Get("region"),
+		Bucket:        u.Host,
+		Prefix:        strings.Trim(u.Path, "/"),
+		Format:        archiveFormatJSONL,
+		FileEvents:    defaultFileEvents,
+		FlushInterval: defaultArchiveFlushInterval,
+	}
+	switch u.Scheme {
+	case "s3":
+		if s.Region == "" {
+			s.Region = defaultObjectStorageRegion
+		}
+		s.Endpoint = "https://s3." + s.Region + ".amazonaws.com"
+	case "gs":
+		if s.Region == "" {
+			s.Region = "auto"
+		}
+		s.Endpoint = gcsEndpoint
+	default:
+		return nil, fmt.Errorf("sink must be s3://bucket[/prefix] or gs://bucket[/prefix]")
+	}
+	if v := query.Get("endpoint"); v != "" {
+		e, err := url.Parse(v)
+		if err != nil || (e.Scheme != "http" && e.Scheme != "https") || e.Host == "" {
+			return nil, fmt.Errorf("endpoint must be http[s]://host[:port]")
+		}
+		s.Endpoint = e.Scheme + "://" + e.Host
+	}
+	if s.prefix, err = parseTemplate(s.Prefix); err != nil {
+		return nil, err
+	}
+	switch f := query.Get("format"); f {
+	case "", archiveFormatJSONL:
+	case archiveFormatParquet:
+		s.Format = f
+	default:
+		return nil, fmt.Errorf("format must be jsonl or parquet")
+	}
+	if v := query.Get("file_events"); v != "" {
+		if s.FileEvents, err = strconv.Atoi(v); err != nil || s.FileEvents <= 0 || s.FileEvents > maxFileEvents {
+			return nil, fmt.Errorf("file_events must be in [1, %d]", maxFileEvents)
+		}
+	}
+	if v := query.Get("flush_interval"); v != "" {
+		if s.FlushInterval, err = time.ParseDuration(v); err != nil || s.FlushInterval <= 0 {
+			return nil, fmt.Errorf("flush_interval must be a positive duration")
+		}
+	}
+	return s, nil
+}
+
+type archiveItem struct {
+	event  *ce.Event
+	offset uint64
+	done   chan Result
+}
+
+// archiveFile is the events of an eventlog which are written in the same file.
+type archiveFile struct {
+	prefix     string
+	eventlogID vanus.ID
+	hasOffset  bool
+	items      []*archiveItem
+	timer      *time.Timer
+}
+
+type objectStorage struct {
+	sink        *ObjectStorageSink
+	err         error
+	credentials aws.Credentials
+	signer      *v4.Signer
+	client      *nethttp.Client
+	lock        sync.Mutex
+	files       map[string]*archiveFile
+}
+
+func NewObjectStorageClient(sink, accessKeyID, secretAccessKey string) EventClient {
+	s, err := ParseObjectStorageSink(sink)
+	return &objectStorage{
+		sink: s,
+		err:  err,
+		credentials: aws.Credentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+		},
+		signer: v4.NewSigner(func(options *v4.SignerOptions) {
+			// the object keys are escaped once by S3.
+			options.DisableURIPathEscaping = true
+		}),
+		client: &nethttp.Client{},
+		files:  map[string]*archiveFile{},
+	}
+}
+
+func (c *objectStorage) Send(ctx context.Context, event ce.Event) Result {
+	if c.err != nil {
+		return Result{StatusCode: errStatusCode, Err: c.err}
+	}
+	prefix, err := renderTemplate(c.sink.prefix, &event)
+	if err != nil {
+		return Result{StatusCode: errStatusCode, Err: err}
+	}
+	item := &archiveItem{event: &event, done: make(chan Result, 1)}
+	o, hasOffset := eventOffsetFrom(ctx)
+	item.offset = o.offset
+	key := fmt.Sprintf("%s/%s/%t", prefix, o.eventlogID, hasOffset)
+	c.lock.Lock()
+	f, exist := c.files[key]
+	if !exist {
+		f = &archiveFile{prefix: prefix, eventlogID: o.eventlogID, hasOffset: hasOffset}
+		c.files[key] = f
+		f.timer = time.AfterFunc(c.sink.FlushInterval, func() {
+			c.flushFile(key, f)
+		})
+	}
+	f.items = append(f.items, item)
+	full := len(f.items) >= c.sink.FileEvents
+	if full {
+		c.takeFile(key, f)
+	}
+	c.lock.Unlock()
+	if full {
+		go c.upload(f)
+	}
+	select {
+	case r := <-item.done:
+		return r
+	case <-ctx.Done():
+		return DeliveryTimeout
+	}
+}
+
+// takeFile removes the file from the pending files, the caller must hold c.lock.
+func (c *objectStorage) takeFile(key string, f *archiveFile) bool {
+	if c.files[key] != f {
+		// the file has been taken because it's full.
+		return false
+	}
+	delete(c.files, key)
+	f.timer.Stop()
+	return true
+}
+
+func (c *objectStorage) flushFile(key string, f *archiveFile) {
+	c.lock.Lock()
+	taken := c.takeFile(key, f)
+	c.lock.Unlock()
+	if taken {
+		c.upload(f)
+	}
+}
+
+// objectKey names the file by the eventlog and the offsets of its events, so the file written again after
+// the events are redelivered overwrites the same object instead of archiving them twice. The events without
+// offset, which aren't delivered by a trigger, are named by the hash of their ids.
+func (c *objectStorage) objectKey(f *archiveFile) string {
+	var name string
+	if f.hasOffset {
+		name = fmt.Sprintf("%s-%020d-%020d", f.eventlogID, f.items[0].offset, f.items[len(f.items)-1].offset)
+	} else {
+		h := sha256.New()
+		for _, item := range f.items {
+			h.Write([]byte(item.event.Source() + "/" + item.event.ID() + "\n"))
+		}
+		name = hex.EncodeToString(h.Sum(nil))[:32]
+	}
+	if f.prefix != "" {
+		name = f.prefix + "/" + name
+	}
+	return name + "." + c.sink.Format
+}
+
+// upload writes the events in the file and puts it to the bucket, the upload is retried when the object
+// storage is throttling or unavailable.
+func (c *objectStorage) upload(f *archiveFile) {
+	sort.SliceStable(f.items, func(i, j int) bool {
+		return f.items[i].offset < f.items[j].offset
+	})
+	r := c.put(f)
+	for _, item := range f.items {
+		item.done <- r
+	}
+}
+
+func (c *objectStorage) put(f *archiveFile) Result {
+	body, contentType, err := c.encode(f.items, f.hasOffset)
+	if err != nil {
+		return newInternalErr(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), uploadTimeout)
+	defer cancel()
+	key := c.objectKey(f)
+	backoff := uploadRetryPolicy.Backoff()
+	for {
+		r := c.putObject(ctx, key, body, contentType)
+		if r.StatusCode != nethttp.StatusServiceUnavailable && r.StatusCode != nethttp.StatusInternalServerError &&
+			r.StatusCode != nethttp.StatusTooManyRequests {
+			return r
+		}
+		if !pkgUtil.SleepWithContext(ctx, backoff.Next()) {
+			return r
+		}
+	}
+}
+
+// encode writes the events in the format of sink, the events of the same offset, which are delivered
+// again before the file is uploaded, are written once.
+func (c *objectStorage) encode(items []*archiveItem, hasOffset bool) ([]byte, string, error) {
+	events := make([]*ce.Event, 0, len(items))
+	for i, item := range items {
+		if hasOffset && i > 0 && item.offset == items[i-1].offset {
+			continue
+		}
+		events = append(events, item.event)
+	}
+	if c.sink.Format == archiveFormatParquet {
+		data, err := encodeParquet(events)
+		return data, "application/vnd.apache.parquet", err
+	}
+	var buf bytes.Buffer
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, "", err
+		}
+		buf.Write(compactJSON(data))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), "application/x-ndjson", nil
+}
+
+// parquetEvent is the row of an event in the Parquet file.
+type parquetEvent struct {
+	ID              string  `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Source          string  `parquet:"name=source, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Type            string  `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Subject         *string `parquet:"name=subject, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Time            *int64  `parquet:"name=time, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	DataContentType *string `parquet:"name=datacontenttype, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Data            *string `parquet:"name=data, type=BYTE_ARRAY, repetitiontype=OPTIONAL"`
+	// Extensions is the extension attributes in JSON.
+	Extensions *string `parquet:"name=extensions, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+}
+
+func encodeParquet(events []*ce.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	pw, err := writer.NewParquetWriterFromWriter(&buf, new(parquetEvent), 1)
+	if err != nil {
+		return nil, err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	for _, e := range events {
+		row := parquetEvent{
+			ID:     e.ID(),
+			Source: e.Source(),
+			Type:   e.Type(),
+		}
+		if v := e.Subject(); v != "" {
+			row.Subject = &v
+		}
+		if t := e.Time(); !t.IsZero() {
+			v := t.UnixMilli()
+			row.Time = &v
+		}
+		if v := e.DataContentType(); v != "" {
+			row.DataContentType = &v
+		}
+		if data := e.Data(); data != nil {
+			v := string(data)
+			row.Data = &v
+		}
+		if ext := e.Extensions(); len(ext) > 0 {
+			data, err := json.Marshal(ext)
+			if err != nil {
+				return nil, err
+			}
+			v := string(data)
+			row.Extensions = &v
+		}
+		if err = pw.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	if err = pw.WriteStop(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *objectStorage) putObject(ctx context.Context, key string, body []byte, contentType string) Result {
+	u := &url.URL{Path: "/" + c.sink.Bucket + "/" + key}
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPut,
+		c.sink.Endpoint+u.EscapedPath(), bytes.NewReader(body))
+	if err != nil {
+		return newInternalErr(err)
+	}
+	h := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(h[:])
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if err = c.signer.SignHTTP(ctx, c.credentials, req, payloadHash, objectStorageService,
+		c.sink.Region, time.Now()); err != nil {
+		return newInternalErr(err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return newUndefinedErr(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode >= nethttp.StatusMultipleChoices {
+		return convertHTTPResponse(resp.StatusCode, "object storage put", data)
+	}
+	return Success
+}
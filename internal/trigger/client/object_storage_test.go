@@ -0,0 +1,212 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func TestParseObjectStorageSink(t *testing.T) {
+	Convey("test parse object storage sink", t, func() {
+		s, err := ParseObjectStorageSink("s3://bucket/archive/{source}/{time:2006/01/02}")
+		So(err, ShouldBeNil)
+		So(s.Endpoint, ShouldEqual, "https://s3.us-east-1.amazonaws.com")
+		So(s.Region, ShouldEqual, defaultObjectStorageRegion)
+		So(s.Bucket, ShouldEqual, "bucket")
+		So(s.Format, ShouldEqual, archiveFormatJSONL)
+		So(s.FileEvents, ShouldEqual, defaultFileEvents)
+		So(s.FlushInterval, ShouldEqual, defaultArchiveFlushInterval)
+
+		e := ce.NewEvent()
+		e.SetSource("Orders")
+		e.SetTime(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+		prefix, err := renderTemplate(s.prefix, &e)
+		So(err, ShouldBeNil)
+		So(prefix, ShouldEqual, "archive/Orders/2023/01/02")
+
+		s, err = ParseObjectStorageSink("gs://bucket?format=parquet&file_events=10&flush_interval=2s")
+		So(err, ShouldBeNil)
+		So(s.Endpoint, ShouldEqual, gcsEndpoint)
+		So(s.Prefix, ShouldEqual, "")
+		So(s.Format, ShouldEqual, archiveFormatParquet)
+		So(s.FileEvents, ShouldEqual, 10)
+		So(s.FlushInterval, ShouldEqual, 2*time.Second)
+
+		s, err = ParseObjectStorageSink("s3://bucket/archive?region=cn-north-1&endpoint=http://127.0.0.1:9000/path")
+		So(err, ShouldBeNil)
+		So(s.Endpoint, ShouldEqual, "http://127.0.0.1:9000")
+		So(s.Region, ShouldEqual, "cn-north-1")
+
+		for _, sink := range []string{
+			"http://bucket/archive",
+			"s3:///archive",
+			"s3://bucket/archive-{source",
+			"s3://bucket/archive?endpoint=127.0.0.1",
+			"s3://bucket/archive?format=csv",
+			"s3://bucket/archive?file_events=0",
+			"s3://bucket/archive?flush_interval=-1s",
+		} {
+			_, err = ParseObjectStorageSink(sink)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
+func TestObjectStorage_Send(t *testing.T) {
+	Convey("test object storage send", t, func() {
+		var (
+			mutex   sync.Mutex
+			objects map[string][]byte
+			auth    string
+			status  []int
+		)
+		objects = map[string][]byte{}
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mutex.Lock()
+			defer mutex.Unlock()
+			auth = r.Header.Get("Authorization")
+			if len(status) > 0 {
+				code := status[0]
+				status = status[1:]
+				w.WriteHeader(code)
+				return
+			}
+			objects[r.URL.Path] = body
+		}))
+		defer server.Close()
+		eventlogID := vanus.NewTestID()
+		newEvent := func(id string) ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("source")
+			e.SetType("order.created")
+			e.SetTime(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+			e.SetExtension("tenant", "a")
+			_ = e.SetData(ce.ApplicationJSON, map[string]interface{}{"id": id})
+			return e
+		}
+		send := func(c EventClient, events map[uint64]string) []Result {
+			var wg sync.WaitGroup
+			var lock sync.Mutex
+			var results []Result
+			for offset, id := range events {
+				wg.Add(1)
+				go func(offset uint64, id string) {
+					defer wg.Done()
+					ctx := WithEventOffset(context.Background(), eventlogID, offset)
+					r := c.Send(ctx, newEvent(id))
+					lock.Lock()
+					results = append(results, r)
+					lock.Unlock()
+				}(offset, id)
+			}
+			wg.Wait()
+			return results
+		}
+
+		Convey("test archive events in a jsonl file by offsets", func() {
+			c := NewObjectStorageClient("s3://bucket/archive/{time:2006/01/02}?endpoint="+server.URL+
+				"&file_events=3&flush_interval=1m", "ak", "sk")
+			results := send(c, map[uint64]string{12: "c", 10: "a", 11: "b"})
+			for _, r := range results {
+				So(r.Err, ShouldBeNil)
+			}
+			So(auth, ShouldStartWith, "AWS4-HMAC-SHA256 Credential=ak/")
+			path := "/bucket/archive/2023/01/02/" + eventlogID.String() +
+				"-00000000000000000010-00000000000000000012.jsonl"
+			So(objects, ShouldContainKey, path)
+			var ids []string
+			scanner := bufio.NewScanner(bytes.NewReader(objects[path]))
+			for scanner.Scan() {
+				e := ce.NewEvent()
+				So(e.UnmarshalJSON(scanner.Bytes()), ShouldBeNil)
+				ids = append(ids, e.ID())
+			}
+			So(ids, ShouldResemble, []string{"a", "b", "c"})
+		})
+
+		Convey("test archive events in a parquet file by interval", func() {
+			c := NewObjectStorageClient("gs://bucket?endpoint="+server.URL+
+				"&format=parquet&flush_interval=10ms", "ak", "sk")
+			results := send(c, map[uint64]string{1: "a", 2: "b"})
+			for _, r := range results {
+				So(r.Err, ShouldBeNil)
+			}
+			path := "/bucket/" + eventlogID.String() + "-00000000000000000001-00000000000000000002.parquet"
+			So(objects, ShouldContainKey, path)
+			file, err := buffer.NewBufferFile(objects[path])
+			So(err, ShouldBeNil)
+			pr, err := reader.NewParquetReader(file, new(parquetEvent), 1)
+			So(err, ShouldBeNil)
+			So(pr.GetNumRows(), ShouldEqual, 2)
+			rows := make([]parquetEvent, 2)
+			So(pr.Read(&rows), ShouldBeNil)
+			pr.ReadStop()
+			So(rows[0].ID, ShouldEqual, "a")
+			So(rows[0].Type, ShouldEqual, "order.created")
+			So(*rows[0].Time, ShouldEqual, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC).UnixMilli())
+			So(*rows[0].Data, ShouldEqual, `{"id":"a"}`)
+			So(*rows[0].Extensions, ShouldEqual, `{"tenant":"a"}`)
+			So(rows[1].ID, ShouldEqual, "b")
+		})
+
+		Convey("test the events without offset", func() {
+			c := NewObjectStorageClient("s3://bucket/archive?endpoint="+server.URL+"&flush_interval=10ms", "ak", "sk")
+			r := c.Send(context.Background(), newEvent("a"))
+			So(r.Err, ShouldBeNil)
+			So(objects, ShouldHaveLength, 1)
+			for path := range objects {
+				So(strings.HasPrefix(path, "/bucket/archive/"), ShouldBeTrue)
+				So(strings.HasSuffix(path, ".jsonl"), ShouldBeTrue)
+			}
+		})
+
+		Convey("test upload retry and failed", func() {
+			c := NewObjectStorageClient("s3://bucket?endpoint="+server.URL+"&flush_interval=10ms", "ak", "sk")
+			status = []int{nethttp.StatusServiceUnavailable}
+			results := send(c, map[uint64]string{1: "a"})
+			So(results[0].Err, ShouldBeNil)
+			So(objects, ShouldHaveLength, 1)
+
+			status = []int{nethttp.StatusForbidden}
+			results = send(c, map[uint64]string{2: "b"})
+			So(results[0], ShouldResemble, Forbidden)
+			So(objects, ShouldHaveLength, 1)
+		})
+
+		Convey("test the delivery timeout", func() {
+			c := NewObjectStorageClient("s3://bucket?endpoint="+server.URL+"&flush_interval=1m", "ak", "sk")
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			So(c.Send(ctx, newEvent("a")), ShouldResemble, DeliveryTimeout)
+		})
+	})
+}
@@ -0,0 +1,551 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	"github.com/linkall-labs/vanus/internal/trigger/util"
+	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
+	"github.com/ohler55/ojg/jp"
+	"github.com/ohler55/ojg/oj"
+)
+
+const (
+	defaultSQLBatchSize     = 100
+	maxSQLBatchSize         = 1000
+	defaultSQLFlushInterval = 100 * time.Millisecond
+	defaultSQLMaxConns      = 4
+	maxSQLMaxConns          = 64
+	sqlTimeout              = 30 * time.Second
+	sqlConnMaxIdleTime      = 5 * time.Minute
+	// maxSQLPlaceholders is the max placeholders of a statement of Postgres and MySQL.
+	maxSQLPlaceholders = 65535
+
+	dialectPostgres = "postgres"
+	dialectMySQL    = "mysql"
+
+	sqlColumnParamPrefix = "column."
+	sqlDataPath          = "$.data"
+)
+
+// sqlDrivers is the database/sql driver of the dialects.
+var sqlDrivers = map[string]string{
+	dialectPostgres: "postgres",
+	dialectMySQL:    "mysql",
+}
+
+var sqlIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SQLSink is the sink of sql protocol in format
+// postgres://[user:password@]host[:port]/database?table=orders&column.id=$.data.id&column.source=source&key=id
+// or mysql://... in the same format. The value of column is an attribute of event, a JSON path of data
+// starting with $.data, or $.data for the whole data, the rows are upserted by the key columns if key is set.
+// The events are written in batches by batch_size or every flush_interval, max_conns limits the connections
+// to the database, the other parameters are passed to the driver, e.g. sslmode of Postgres.
+type SQLSink struct {
+	Dialect       string
+	Host          string
+	Database      string
+	Table         string
+	Columns       []SQLColumn
+	Keys          []string
+	BatchSize     int
+	FlushInterval time.Duration
+	MaxConns      int
+	user          *url.Userinfo
+	params        url.Values
+	keys          []int
+}
+
+// SQLColumn is a column of the table and the value of it in the event.
+type SQLColumn struct {
+	Name  string
+	Value string
+	attr  string
+	path  jp.Expr
+}
+
+func ParseSQLSink(sink string) (*SQLSink, error) {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil, err
+	}
+	s := &SQLSink{
+		Host:          u.Host,
+		Database:      strings.Trim(u.Path, "/"),
+		BatchSize:     defaultSQLBatchSize,
+		FlushInterval: defaultSQLFlushInterval,
+		MaxConns:      defaultSQLMaxConns,
+		user:          u.User,
+		params:        url.Values{},
+	}
+	switch u.Scheme {
+	case "postgres", "postgresql":
+		s.Dialect = dialectPostgres
+	case "mysql":
+		s.Dialect = dialectMySQL
+	default:
+		return nil, fmt.Errorf("sink must be postgres://host[:port]/database or mysql://host[:port]/database")
+	}
+	if s.Host == "" || s.Database == "" || strings.Contains(s.Database, "/") {
+		return nil, fmt.Errorf("host or database is invalid")
+	}
+	for name, values := range u.Query() {
+		v := values[0]
+		switch {
+		case name == "table":
+			s.Table = v
+		case name == "key":
+			s.Keys = strings.Split(v, ",")
+		case name == "batch_size":
+			if s.BatchSize, err = strconv.Atoi(v); err != nil || s.BatchSize <= 0 || s.BatchSize > maxSQLBatchSize {
+				return nil, fmt.Errorf("batch_size must be in [1, %d]", maxSQLBatchSize)
+			}
+		case name == "flush_interval":
+			if s.FlushInterval, err = time.ParseDuration(v); err != nil || s.FlushInterval <= 0 {
+				return nil, fmt.Errorf("flush_interval must be a positive duration")
+			}
+		case name == "max_conns":
+			if s.MaxConns, err = strconv.Atoi(v); err != nil || s.MaxConns <= 0 || s.MaxConns > maxSQLMaxConns {
+				return nil, fmt.Errorf("max_conns must be in [1, %d]", maxSQLMaxConns)
+			}
+		case strings.HasPrefix(name, sqlColumnParamPrefix):
+			c, err := parseSQLColumn(strings.TrimPrefix(name, sqlColumnParamPrefix), v)
+			if err != nil {
+				return nil, err
+			}
+			s.Columns = append(s.Columns, c)
+		default:
+			s.params[name] = values
+		}
+	}
+	if err = validateSQLTable(s.Table); err != nil {
+		return nil, err
+	}
+	if len(s.Columns) == 0 {
+		return nil, fmt.Errorf("no column is set")
+	}
+	sort.Slice(s.Columns, func(i, j int) bool {
+		return s.Columns[i].Name < s.Columns[j].Name
+	})
+	for _, key := range s.Keys {
+		i := sort.Search(len(s.Columns), func(i int) bool {
+			return s.Columns[i].Name >= key
+		})
+		if i == len(s.Columns) || s.Columns[i].Name != key {
+			return nil, fmt.Errorf("key %s isn't a column", key)
+		}
+		s.keys = append(s.keys, i)
+	}
+	if s.Dialect == dialectMySQL {
+		if _, err = mysql.ParseDSN(s.dsn(nil)); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func validateSQLTable(table string) error {
+	if table == "" {
+		return fmt.Errorf("table is empty")
+	}
+	parts := strings.Split(table, ".")
+	if len(parts) > 2 {
+		return fmt.Errorf("table must be table or schema.table")
+	}
+	for _, p := range parts {
+		if !sqlIdentifierRegexp.MatchString(p) {
+			return fmt.Errorf("table %s is invalid", table)
+		}
+	}
+	return nil
+}
+
+func parseSQLColumn(name, value string) (SQLColumn, error) {
+	c := SQLColumn{Name: name, Value: value}
+	if !sqlIdentifierRegexp.MatchString(name) {
+		return c, fmt.Errorf("column %s is invalid", name)
+	}
+	switch {
+	case value == sqlDataPath:
+	case strings.HasPrefix(value, sqlDataPath+".") || strings.HasPrefix(value, sqlDataPath+"["):
+		path, err := jp.ParseString("$" + strings.TrimPrefix(value, sqlDataPath))
+		if err != nil {
+			return c, fmt.Errorf("the value of column %s is invalid: %w", name, err)
+		}
+		c.path = path
+	default:
+		if err := pkgUtil.ValidateEventAttrName(value); err != nil || value == "" {
+			return c, fmt.Errorf("the value of column %s must be an attribute or a JSON path of data", name)
+		}
+		c.attr = value
+	}
+	return c, nil
+}
+
+// dsn returns the data source name of the driver, the user of sink is used if user is nil.
+func (s *SQLSink) dsn(user *url.Userinfo) string {
+	if user == nil {
+		user = s.user
+	}
+	if s.Dialect == dialectPostgres {
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     user,
+			Host:     s.Host,
+			Path:     "/" + s.Database,
+			RawQuery: s.params.Encode(),
+		}
+		return u.String()
+	}
+	var sb strings.Builder
+	if user != nil {
+		sb.WriteString(user.Username())
+		if password, ok := user.Password(); ok {
+			sb.WriteString(":" + password)
+		}
+		sb.WriteString("@")
+	}
+	sb.WriteString("tcp(" + s.Host + ")/" + s.Database)
+	if len(s.params) > 0 {
+		sb.WriteString("?" + s.params.Encode())
+	}
+	return sb.String()
+}
+
+func (s *SQLSink) quote(ident string) string {
+	q := `"`
+	if s.Dialect == dialectMySQL {
+		q = "`"
+	}
+	parts := strings.Split(ident, ".")
+	for i := range parts {
+		parts[i] = q + parts[i] + q
+	}
+	return strings.Join(parts, ".")
+}
+
+func (s *SQLSink) isKey(column int) bool {
+	for _, k := range s.keys {
+		if k == column {
+			return true
+		}
+	}
+	return false
+}
+
+// statement returns the statement to insert rows, or upsert them if the keys are set.
+func (s *SQLSink) statement(rows int) string {
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO " + s.quote(s.Table) + " (")
+	for i, c := range s.Columns {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(s.quote(c.Name))
+	}
+	sb.WriteString(") VALUES ")
+	n := 0
+	for r := 0; r < rows; r++ {
+		if r > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(")
+		for i := range s.Columns {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			n++
+			if s.Dialect == dialectPostgres {
+				sb.WriteString("$" + strconv.Itoa(n))
+			} else {
+				sb.WriteString("?")
+			}
+		}
+		sb.WriteString(")")
+	}
+	if len(s.keys) == 0 {
+		return sb.String()
+	}
+	var updates []string
+	for i, c := range s.Columns {
+		if s.isKey(i) {
+			continue
+		}
+		if s.Dialect == dialectPostgres {
+			updates = append(updates, s.quote(c.Name)+" = EXCLUDED."+s.quote(c.Name))
+		} else {
+			updates = append(updates, s.quote(c.Name)+" = VALUES("+s.quote(c.Name)+")")
+		}
+	}
+	if s.Dialect == dialectPostgres {
+		keys := make([]string, len(s.Keys))
+		for i, k := range s.Keys {
+			keys[i] = s.quote(k)
+		}
+		sb.WriteString(" ON CONFLICT (" + strings.Join(keys, ", ") + ") DO ")
+		if len(updates) == 0 {
+			sb.WriteString("NOTHING")
+		} else {
+			sb.WriteString("UPDATE SET " + strings.Join(updates, ", "))
+		}
+		return sb.String()
+	}
+	sb.WriteString(" ON DUPLICATE KEY UPDATE ")
+	if len(updates) == 0 {
+		// the row exists, nothing is updated.
+		key := s.quote(s.Keys[0])
+		updates = append(updates, key+" = "+key)
+	}
+	sb.WriteString(strings.Join(updates, ", "))
+	return sb.String()
+}
+
+// rowOf returns the values of the columns in the event, the value doesn't exist is NULL.
+func (s *SQLSink) rowOf(e *ce.Event) ([]interface{}, error) {
+	var (
+		data   interface{}
+		parsed bool
+	)
+	row := make([]interface{}, len(s.Columns))
+	for i, c := range s.Columns {
+		switch {
+		case c.path != nil:
+			if !parsed {
+				var err error
+				if data, err = oj.Parse(e.Data()); err != nil {
+					return nil, fmt.Errorf("the data of event isn't JSON: %w", err)
+				}
+				parsed = true
+			}
+			switch res := c.path.Get(data); len(res) {
+			case 0:
+			case 1:
+				row[i] = sqlValue(res[0])
+			default:
+				row[i] = sqlValue(res)
+			}
+		case c.attr != "":
+			if v, exist := util.LookupAttribute(*e, c.attr); exist {
+				row[i] = sqlValue(v)
+			}
+		default:
+			if d := e.Data(); d != nil {
+				row[i] = string(d)
+			}
+		}
+	}
+	return row, nil
+}
+
+// sqlValue converts the value to the type supported by the drivers, the objects and arrays are JSON.
+func sqlValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case nil, string, bool, int64, float64, []byte, time.Time:
+		return val
+	case int32:
+		return int64(val)
+	case int:
+		return int64(val)
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(val)
+		return string(data)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+type sqlItem struct {
+	row  []interface{}
+	done chan Result
+}
+
+type sqlClient struct {
+	sink    *SQLSink
+	err     error
+	db      *sql.DB
+	lock    sync.Mutex
+	pending []*sqlItem
+	timer   *time.Timer
+}
+
+// NewSQLClient returns the client of sql sink, the username and password override the user of sink if set.
+// The client has its own connection pool which is released by Close.
+func NewSQLClient(sink, username, password string) EventClient {
+	s, err := ParseSQLSink(sink)
+	c := &sqlClient{sink: s, err: err}
+	if err != nil {
+		return c
+	}
+	var user *url.Userinfo
+	if username != "" {
+		user = url.UserPassword(username, password)
+	}
+	if c.db, c.err = sql.Open(sqlDrivers[s.Dialect], s.dsn(user)); c.err == nil {
+		c.db.SetMaxOpenConns(s.MaxConns)
+		c.db.SetMaxIdleConns(s.MaxConns)
+		c.db.SetConnMaxIdleTime(sqlConnMaxIdleTime)
+	}
+	return c
+}
+
+func (c *sqlClient) Send(ctx context.Context, event ce.Event) Result {
+	if c.err != nil {
+		return Result{StatusCode: errStatusCode, Err: c.err}
+	}
+	row, err := c.sink.rowOf(&event)
+	if err != nil {
+		return Result{StatusCode: errStatusCode, Err: err}
+	}
+	item := &sqlItem{row: row, done: make(chan Result, 1)}
+	c.lock.Lock()
+	c.pending = append(c.pending, item)
+	var batch []*sqlItem
+	if len(c.pending) >= c.sink.BatchSize {
+		batch = c.takePending()
+	} else if len(c.pending) == 1 {
+		c.timer = time.AfterFunc(c.sink.FlushInterval, c.flushPending)
+	}
+	c.lock.Unlock()
+	if batch != nil {
+		go c.write(batch)
+	}
+	select {
+	case r := <-item.done:
+		return r
+	case <-ctx.Done():
+		return DeliveryTimeout
+	}
+}
+
+// Close writes the pending events and closes the connection pool.
+func (c *sqlClient) Close() error {
+	if c.db == nil {
+		return nil
+	}
+	c.flushPending()
+	return c.db.Close()
+}
+
+// takePending takes the pending events, the caller must hold c.lock.
+func (c *sqlClient) takePending() []*sqlItem {
+	batch := c.pending
+	c.pending = nil
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	return batch
+}
+
+func (c *sqlClient) flushPending() {
+	c.lock.Lock()
+	batch := c.takePending()
+	c.lock.Unlock()
+	if len(batch) > 0 {
+		c.write(batch)
+	}
+}
+
+// write writes the batch in a transaction, if it fails the events are written one by one, so an invalid
+// event doesn't fail the others.
+func (c *sqlClient) write(batch []*sqlItem) {
+	ctx, cancel := context.WithTimeout(context.Background(), sqlTimeout)
+	defer cancel()
+	err := c.writeRows(ctx, c.sink.dedupe(batch))
+	if err == nil || len(batch) == 1 {
+		r := Success
+		if err != nil {
+			r = newUndefinedErr(err)
+		}
+		for _, item := range batch {
+			item.done <- r
+		}
+		return
+	}
+	for _, item := range batch {
+		if _, err = c.db.ExecContext(ctx, c.sink.statement(1), item.row...); err != nil {
+			item.done <- newUndefinedErr(err)
+		} else {
+			item.done <- Success
+		}
+	}
+}
+
+// dedupe removes the rows upserted by the later rows of the same keys, a statement of Postgres can't upsert
+// a row twice.
+func (s *SQLSink) dedupe(batch []*sqlItem) [][]interface{} {
+	rows := make([][]interface{}, 0, len(batch))
+	if len(s.keys) == 0 {
+		for _, item := range batch {
+			rows = append(rows, item.row)
+		}
+		return rows
+	}
+	latest := make(map[string]int, len(batch))
+	keyOf := func(row []interface{}) string {
+		var sb strings.Builder
+		for _, k := range s.keys {
+			sb.WriteString(fmt.Sprintf("%T:%v\x00", row[k], row[k]))
+		}
+		return sb.String()
+	}
+	for i, item := range batch {
+		latest[keyOf(item.row)] = i
+	}
+	for i, item := range batch {
+		if latest[keyOf(item.row)] == i {
+			rows = append(rows, item.row)
+		}
+	}
+	return rows
+}
+
+func (c *sqlClient) writeRows(ctx context.Context, rows [][]interface{}) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	size := maxSQLPlaceholders / len(c.sink.Columns)
+	for start := 0; start < len(rows); start += size {
+		end := start + size
+		if end > len(rows) {
+			end = len(rows)
+		}
+		args := make([]interface{}, 0, (end-start)*len(c.sink.Columns))
+		for _, row := range rows[start:end] {
+			args = append(args, row...)
+		}
+		if _, err = tx.ExecContext(ctx, c.sink.statement(end-start), args...); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
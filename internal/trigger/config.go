@@ -16,9 +16,13 @@ package trigger
 
 import (
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
+	"github.com/linkall-labs/vanus/internal/trigger/connector"
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/pkg/util"
 )
@@ -29,10 +33,75 @@ type Config struct {
 	IP             string               `yaml:"ip"`
 	ControllerAddr []string             `yaml:"controllers"`
 	Observability  observability.Config `yaml:"observability"`
+	Schemas        []SchemaConfig       `yaml:"schemas"`
+	// SchemaRegistry the schemas which aren't in Schemas are fetched from it.
+	SchemaRegistry schema.RegistryConfig `yaml:"schema_registry"`
+	// BlobDir is the directory of blob store shared with gateways, the data of events offloaded
+	// by gateways is resolved from it before delivery. It must be a volume shared by all gateways
+	// and trigger workers, or use BlobS3 instead.
+	BlobDir string                 `yaml:"blob_dir"`
+	BlobS3  primitive.BlobS3Config `yaml:"blob_s3"`
+	// CorrelationSpillDir is the directory the pending events of correlation are spilled to when
+	// the number of them exceeds CorrelationMaxMemoryEvents, empty means never spill.
+	CorrelationSpillDir        string `yaml:"correlation_spill_dir"`
+	CorrelationMaxMemoryEvents int    `yaml:"correlation_max_memory_events"`
+	// DedupDir is the directory the delivered keys of the subscriptions with dedup window are saved in,
+	// so the duplicates are still dropped after restart, empty means the keys are only kept in memory.
+	DedupDir string `yaml:"dedup_dir"`
+	// DrainTimeout is how long the inflight deliveries are waited when the subscriptions are stopped,
+	// the deliveries not completed in it are canceled and the events are delivered again after restart.
+	DrainTimeout time.Duration `yaml:"drain_timeout"`
+	// EventTrace the delivery results of the events of the eventbuses are kept to be looked up by event id.
+	EventTrace EventTraceConfig `yaml:"event_trace"`
+	// FeatureFlags the defaults of experimental features, which are overridden by the flags saved in
+	// controller.
+	FeatureFlags featureflag.Config `yaml:"feature_flags"`
+	// Connector the connector plugins installed on the worker, which run the source and sink connectors
+	// assigned by controller.
+	Connector connector.Config `yaml:"connector"`
+	// ReservedAttributePrefixes are the prefixes of extension attributes which transformers can't set
+	// besides xvanus, it should be same as the one of gateway.
+	ReservedAttributePrefixes []string `yaml:"reserved_attribute_prefixes"`
 
 	HeartbeatInterval time.Duration
 }
 
+const defaultDrainTimeout = 10 * time.Second
+
+func (c Config) GetDrainTimeout() time.Duration {
+	if c.DrainTimeout <= 0 {
+		return defaultDrainTimeout
+	}
+	return c.DrainTimeout
+}
+
+type EventTraceConfig struct {
+	// Eventbuses the events of them are traced, "*" means all eventbuses.
+	Eventbuses []string `yaml:"eventbuses"`
+	// Capacity the maximum number of events traced by each subscription.
+	Capacity int `yaml:"capacity"`
+}
+
+const (
+	defaultEventTraceCapacity = 10000
+	allEventbuses             = "*"
+)
+
+// GetCapacity returns the number of events traced by the subscriptions of the eventbus, 0 means
+// the eventbus isn't traced.
+func (c EventTraceConfig) GetCapacity(eventbus string) int {
+	for _, name := range c.Eventbuses {
+		if name != eventbus && name != allEventbuses {
+			continue
+		}
+		if c.Capacity <= 0 {
+			return defaultEventTraceCapacity
+		}
+		return c.Capacity
+	}
+	return 0
+}
+
 func InitConfig(filename string) (*Config, error) {
 	c := new(Config)
 	err := primitive.LoadConfig(filename, c)
@@ -45,3 +114,30 @@ func InitConfig(filename string) (*Config, error) {
 	c.TriggerAddr = fmt.Sprintf("%s:%d", c.IP, c.Port)
 	return c, nil
 }
+
+type SchemaConfig struct {
+	ID   string      `yaml:"id"`
+	Type schema.Type `yaml:"type"`
+	// File is the path of the FileDescriptorSet for protobuf or the schema JSON for avro.
+	File        string `yaml:"file"`
+	MessageName string `yaml:"message_name"`
+}
+
+func registerSchemas(registry schema.Registry, schemas []SchemaConfig) error {
+	for _, s := range schemas {
+		definition, err := os.ReadFile(s.File)
+		if err != nil {
+			return fmt.Errorf("read schema %s file error: %w", s.ID, err)
+		}
+		err = registry.Register(&schema.Schema{
+			ID:          s.ID,
+			Type:        s.Type,
+			Definition:  definition,
+			MessageName: s.MessageName,
+		})
+		if err != nil {
+			return fmt.Errorf("register schema %s error: %w", s.ID, err)
+		}
+	}
+	return nil
+}
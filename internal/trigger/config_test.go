@@ -0,0 +1,34 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventTraceConfig_GetCapacity(t *testing.T) {
+	Convey("test get capacity of event trace", t, func() {
+		c := EventTraceConfig{}
+		So(c.GetCapacity("test"), ShouldEqual, 0)
+		c.Eventbuses = []string{"test"}
+		So(c.GetCapacity("test"), ShouldEqual, defaultEventTraceCapacity)
+		So(c.GetCapacity("other"), ShouldEqual, 0)
+		c.Eventbuses = []string{allEventbuses}
+		c.Capacity = 100
+		So(c.GetCapacity("other"), ShouldEqual, 100)
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import "time"
+
+const (
+	defaultSyncInterval = 5 * time.Second
+	defaultReadBatch    = 100
+	defaultIdleInterval = time.Second
+)
+
+type Config struct {
+	// Plugins is the connector plugins installed on the trigger worker, keyed by the plugin name which
+	// the connectors reference.
+	Plugins map[string]PluginConfig `yaml:"plugins"`
+	// SyncInterval is the interval to sync the connector instances assigned to the worker.
+	SyncInterval time.Duration `yaml:"sync_interval"`
+	// ReadBatch is the max number of events a source instance reads once.
+	ReadBatch uint32 `yaml:"read_batch"`
+	// IdleInterval is how long a source instance waits to read again when there is nothing new.
+	IdleInterval time.Duration `yaml:"idle_interval"`
+}
+
+// PluginConfig is how the worker reaches a plugin which serves the connector contract.
+type PluginConfig struct {
+	// Address is the gRPC endpoint of the plugin.
+	Address string `yaml:"address"`
+	// Command launches the plugin as a child process of the worker, the process is restarted if it
+	// exits, and it should listen on the address passed by VANUS_CONNECTOR_ADDRESS. The plugin is
+	// a sidecar managed outside the worker if it's empty.
+	Command []string `yaml:"command"`
+}
+
+func (c Config) getSyncInterval() time.Duration {
+	if c.SyncInterval <= 0 {
+		return defaultSyncInterval
+	}
+	return c.SyncInterval
+}
+
+func (c Config) getReadBatch() uint32 {
+	if c.ReadBatch == 0 {
+		return defaultReadBatch
+	}
+	return c.ReadBatch
+}
+
+func (c Config) getIdleInterval() time.Duration {
+	if c.IdleInterval <= 0 {
+		return defaultIdleInterval
+	}
+	return c.IdleInterval
+}
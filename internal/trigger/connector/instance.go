@@ -0,0 +1,207 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/consumer"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	"github.com/linkall-labs/vanus/pkg/util"
+	connectorpb "github.com/linkall-labs/vanus/proto/pkg/connector"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// instancePolicy is the backoff of an instance after it fails, the instance starts the plugin again and
+// continues from the last committed position.
+var instancePolicy = retry.Policy{
+	Initial: 200 * time.Millisecond,
+	Max:     30 * time.Second,
+	Jitter:  retry.EqualJitter,
+}
+
+type instance struct {
+	connector *ctrlpb.Connector
+	id        uint32
+	// position is where the source instance continues reading, it's committed after the events read
+	// before it are appended.
+	position []byte
+	plugin   connectorpb.ConnectorClient
+	ctrl     ctrlpb.TriggerControllerClient
+	client   eb.Client
+	config   Config
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func instanceKey(name string, id uint32) string {
+	return name + "/" + strconv.FormatUint(uint64(id), 10)
+}
+
+func (ins *instance) key() string {
+	return instanceKey(ins.connector.Name, ins.id)
+}
+
+func (ins *instance) fields() map[string]interface{} {
+	return map[string]interface{}{
+		"connector": ins.connector.Name,
+		"instance":  ins.id,
+		"version":   ins.connector.Version,
+	}
+}
+
+func (ins *instance) start(ctx context.Context) {
+	ctx, ins.cancel = context.WithCancel(ctx)
+	ins.done = make(chan struct{})
+	go func() {
+		defer close(ins.done)
+		ins.run(ctx)
+	}()
+}
+
+// stop stops the instance and tells the plugin, the plugin isn't told if ctx is done.
+func (ins *instance) stop(ctx context.Context) {
+	ins.cancel()
+	<-ins.done
+	_, err := ins.plugin.Stop(ctx, &connectorpb.StopRequest{Name: ins.connector.Name, Instance: ins.id})
+	if err != nil {
+		log.Warning(ctx, "stop connector instance error", ins.withError(err))
+	}
+}
+
+func (ins *instance) withError(err error) map[string]interface{} {
+	fields := ins.fields()
+	fields[log.KeyError] = err
+	return fields
+}
+
+// run starts the instance in the plugin and moves the events until ctx is done, it starts again after a
+// backoff if anything fails, e.g. the plugin process is restarted.
+func (ins *instance) run(ctx context.Context) {
+	backoff := instancePolicy.Backoff()
+	for ctx.Err() == nil {
+		var err error
+		if err = ins.startPlugin(ctx); err == nil {
+			log.Info(ctx, "connector instance started", ins.fields())
+			backoff.Reset()
+			if ins.connector.Kind == metadata.ConnectorKindSource {
+				err = ins.runSource(ctx)
+			} else {
+				err = ins.runSink(ctx)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		d := backoff.Next()
+		fields := ins.withError(err)
+		fields["backoff"] = d
+		log.Warning(ctx, "connector instance failed, start it again later", fields)
+		if !util.SleepWithContext(ctx, d) {
+			return
+		}
+	}
+}
+
+func (ins *instance) startPlugin(ctx context.Context) error {
+	_, err := ins.plugin.Start(ctx, &connectorpb.StartRequest{
+		Name:     ins.connector.Name,
+		Kind:     ins.connector.Kind,
+		Config:   ins.connector.Config,
+		Instance: ins.id,
+		Replicas: ins.connector.Replicas,
+	})
+	return err
+}
+
+// runSource reads the events from the plugin and appends them to the eventbus, the position is committed
+// after the events are appended, so the events are delivered at least once.
+func (ins *instance) runSource(ctx context.Context) error {
+	writer := ins.client.Eventbus(ctx, ins.connector.Eventbus).Writer()
+	for {
+		res, err := ins.plugin.Read(ctx, &connectorpb.ReadRequest{
+			Name:      ins.connector.Name,
+			Instance:  ins.id,
+			Position:  ins.position,
+			MaxEvents: ins.config.getReadBatch(),
+		})
+		if err != nil {
+			return err
+		}
+		events := make([]*ce.Event, 0, len(res.Events))
+		for _, data := range res.Events {
+			e := &ce.Event{}
+			if err = json.Unmarshal(data, e); err != nil {
+				log.Warning(ctx, "skip the invalid event read by connector", ins.withError(err))
+				continue
+			}
+			events = append(events, e)
+		}
+		if len(events) > 0 {
+			if _, err = writer.AppendMany(ctx, events); err != nil {
+				return err
+			}
+		}
+		if !bytes.Equal(res.Position, ins.position) {
+			_, err = ins.ctrl.CommitConnectorPosition(ctx, &ctrlpb.CommitConnectorPositionRequest{
+				Name:     ins.connector.Name,
+				Instance: ins.id,
+				Position: res.Position,
+			})
+			if err != nil {
+				return err
+			}
+			ins.position = res.Position
+		}
+		if len(res.Events) == 0 && !util.SleepWithContext(ctx, ins.config.getIdleInterval()) {
+			return ctx.Err()
+		}
+	}
+}
+
+// runSink consumes the eventbus by the consumer group of the connector, in which the instances are the
+// members, and writes the events to the plugin.
+func (ins *instance) runSink(ctx context.Context) error {
+	group := ins.client.ConsumerGroup(ctx, "connector."+ins.connector.Name, ins.connector.Eventbus,
+		consumer.WithMemberID(ins.key()))
+	return group.Consume(ctx, func(ctx context.Context, _ uint64, events []*ce.Event) error {
+		req := &connectorpb.WriteRequest{
+			Name:     ins.connector.Name,
+			Instance: ins.id,
+			Events:   make([][]byte, 0, len(events)),
+		}
+		for _, e := range events {
+			data, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+			req.Events = append(req.Events, data)
+		}
+		if _, err := ins.plugin.Write(ctx, req); err != nil {
+			// the events are written again by the group, start the instance in case the plugin restarted.
+			_ = ins.startPlugin(ctx)
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	"github.com/linkall-labs/vanus/pkg/util"
+	connectorpb "github.com/linkall-labs/vanus/proto/pkg/connector"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// EnvConnectorAddress is the address which the plugin launched by the worker should listen on.
+const EnvConnectorAddress = "VANUS_CONNECTOR_ADDRESS"
+
+var restartPolicy = retry.Policy{
+	Initial: time.Second,
+	Max:     time.Minute,
+	Jitter:  retry.EqualJitter,
+}
+
+type plugin struct {
+	name   string
+	config PluginConfig
+	conn   *grpc.ClientConn
+	client connectorpb.ConnectorClient
+}
+
+func newPlugin(name string, config PluginConfig) (*plugin, error) {
+	// the connection is established lazily, so the plugin launched by the worker can start later.
+	conn, err := grpc.Dial(config.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	return &plugin{
+		name:   name,
+		config: config,
+		conn:   conn,
+		client: connectorpb.NewConnectorClient(conn),
+	}, nil
+}
+
+// supervise launches the plugin process and restarts it after it exits until ctx is done.
+func (p *plugin) supervise(ctx context.Context) {
+	backoff := restartPolicy.Backoff()
+	for {
+		start := time.Now()
+		cmd := exec.CommandContext(ctx, p.config.Command[0], p.config.Command[1:]...)
+		cmd.Env = append(os.Environ(), EnvConnectorAddress+"="+p.config.Address)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err := cmd.Run()
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(start) > restartPolicy.Max {
+			backoff.Reset()
+		}
+		d := backoff.Next()
+		log.Warning(ctx, "connector plugin exited, restart it later", map[string]interface{}{
+			log.KeyError: err,
+			"plugin":     p.name,
+			"backoff":    d,
+		})
+		if !util.SleepWithContext(ctx, d) {
+			return
+		}
+	}
+}
+
+func (p *plugin) close() {
+	_ = p.conn.Close()
+}
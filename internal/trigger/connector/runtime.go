@@ -0,0 +1,170 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// Runtime runs the connector instances which controller assigns to the trigger worker in the plugins
+// installed on it. It polls the assignment, starts the newly assigned instances, and stops the instances
+// which are removed or moved to other workers, an updated instance is restarted with the new config.
+type Runtime struct {
+	config    Config
+	addr      string
+	ctrl      ctrlpb.TriggerControllerClient
+	client    eb.Client
+	plugins   map[string]*plugin
+	instances map[string]*instance
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	mutex     sync.Mutex
+}
+
+func NewRuntime(config Config, addr string, ctrl ctrlpb.TriggerControllerClient, client eb.Client) *Runtime {
+	return &Runtime{
+		config:    config,
+		addr:      addr,
+		ctrl:      ctrl,
+		client:    client,
+		plugins:   map[string]*plugin{},
+		instances: map[string]*instance{},
+	}
+}
+
+func (r *Runtime) Start(ctx context.Context) error {
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	for name, config := range r.config.Plugins {
+		p, err := newPlugin(name, config)
+		if err != nil {
+			r.closePlugins()
+			return err
+		}
+		r.plugins[name] = p
+		if len(config.Command) == 0 {
+			continue
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			p.supervise(r.ctx)
+		}()
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.getSyncInterval())
+		defer ticker.Stop()
+		for {
+			r.sync(r.ctx)
+			select {
+			case <-r.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Info(ctx, "connector runtime started", map[string]interface{}{
+		"plugins": r.pluginNames(),
+	})
+	return nil
+}
+
+// Stop stops the instances and the plugin processes launched by the worker, and disconnects the client.
+func (r *Runtime) Stop(ctx context.Context) {
+	r.cancel()
+	r.mutex.Lock()
+	for key, ins := range r.instances {
+		ins.stop(ctx)
+		delete(r.instances, key)
+	}
+	r.mutex.Unlock()
+	r.wg.Wait()
+	r.closePlugins()
+	r.client.Disconnect(ctx)
+}
+
+func (r *Runtime) closePlugins() {
+	for _, p := range r.plugins {
+		p.close()
+	}
+}
+
+func (r *Runtime) pluginNames() []string {
+	names := make([]string, 0, len(r.plugins))
+	for name := range r.plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *Runtime) sync(ctx context.Context) {
+	res, err := r.ctrl.ListWorkerConnector(ctx, &ctrlpb.ListWorkerConnectorRequest{
+		Address: r.addr,
+		Plugins: r.pluginNames(),
+	})
+	if err != nil {
+		log.Warning(ctx, "list connector instances error", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return
+	}
+	assigned := make(map[string]*ctrlpb.ConnectorInstance, len(res.Instances))
+	for _, ins := range res.Instances {
+		assigned[instanceKey(ins.Connector.Name, ins.Instance)] = ins
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if ctx.Err() != nil {
+		return
+	}
+	for key, ins := range r.instances {
+		if a, ok := assigned[key]; ok && a.Connector.Version == ins.connector.Version {
+			continue
+		}
+		ins.stop(ctx)
+		delete(r.instances, key)
+		log.Info(ctx, "connector instance stopped", ins.fields())
+	}
+	for key, a := range assigned {
+		if _, exist := r.instances[key]; exist {
+			continue
+		}
+		p, exist := r.plugins[a.Connector.Plugin]
+		if !exist {
+			continue
+		}
+		ins := &instance{
+			connector: a.Connector,
+			id:        a.Instance,
+			position:  a.Position,
+			plugin:    p.client,
+			ctrl:      r.ctrl,
+			client:    r.client,
+			config:    r.config,
+		}
+		ins.start(r.ctx)
+		r.instances[key] = ins
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connector
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	connectorpb "github.com/linkall-labs/vanus/proto/pkg/connector"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type testPlugin struct {
+	connectorpb.UnimplementedConnectorServer
+	event   []byte
+	read    int32
+	started chan *connectorpb.StartRequest
+	stopped chan *connectorpb.StopRequest
+}
+
+func (p *testPlugin) Start(_ context.Context, req *connectorpb.StartRequest) (*emptypb.Empty, error) {
+	p.started <- req
+	return &emptypb.Empty{}, nil
+}
+
+func (p *testPlugin) Read(_ context.Context, req *connectorpb.ReadRequest) (*connectorpb.ReadResponse, error) {
+	if atomic.AddInt32(&p.read, 1) == 1 {
+		return &connectorpb.ReadResponse{Events: [][]byte{p.event}, Position: []byte("1")}, nil
+	}
+	return &connectorpb.ReadResponse{Position: req.Position}, nil
+}
+
+func (p *testPlugin) Stop(_ context.Context, req *connectorpb.StopRequest) (*emptypb.Empty, error) {
+	p.stopped <- req
+	return &emptypb.Empty{}, nil
+}
+
+func TestRuntime_Source(t *testing.T) {
+	Convey("test run source connector", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctx := context.Background()
+
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("postgres")
+		e.SetType("row.inserted")
+		data, _ := json.Marshal(e)
+		p := &testPlugin{
+			event:   data,
+			started: make(chan *connectorpb.StartRequest, 1),
+			stopped: make(chan *connectorpb.StopRequest, 1),
+		}
+		ls, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		srv := grpc.NewServer()
+		connectorpb.RegisterConnectorServer(srv, p)
+		go func() {
+			_ = srv.Serve(ls)
+		}()
+		defer srv.Stop()
+
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(mockCtrl)
+		cli := client.NewMockClient(mockCtrl)
+		bus := api.NewMockEventbus(mockCtrl)
+		writer := api.NewMockBusWriter(mockCtrl)
+		cli.EXPECT().Eventbus(gomock.Any(), "orders").AnyTimes().Return(bus)
+		bus.EXPECT().Writer().AnyTimes().Return(writer)
+
+		var assigned atomic.Value
+		assigned.Store([]*ctrlpb.ConnectorInstance{{
+			Connector: &ctrlpb.Connector{
+				Name: "pg", Kind: metadata.ConnectorKindSource, Plugin: "postgres-cdc",
+				Eventbus: "orders", Replicas: 1, Version: 1,
+			},
+		}})
+		triggerCtrl.EXPECT().ListWorkerConnector(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, req *ctrlpb.ListWorkerConnectorRequest,
+				_ ...grpc.CallOption) (*ctrlpb.ListWorkerConnectorResponse, error) {
+				instances, _ := assigned.Load().([]*ctrlpb.ConnectorInstance)
+				return &ctrlpb.ListWorkerConnectorResponse{Instances: instances}, nil
+			})
+		appended := make(chan []*ce.Event, 1)
+		writer.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ context.Context, events []*ce.Event, _ ...api.WriteOption) (string, error) {
+				appended <- events
+				return "", nil
+			})
+		committed := make(chan *ctrlpb.CommitConnectorPositionRequest, 1)
+		triggerCtrl.EXPECT().CommitConnectorPosition(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ context.Context, req *ctrlpb.CommitConnectorPositionRequest,
+				_ ...grpc.CallOption) (*emptypb.Empty, error) {
+				committed <- req
+				return &emptypb.Empty{}, nil
+			})
+
+		r := NewRuntime(Config{
+			Plugins:      map[string]PluginConfig{"postgres-cdc": {Address: ls.Addr().String()}},
+			SyncInterval: time.Hour,
+			IdleInterval: 10 * time.Millisecond,
+		}, "worker", triggerCtrl, cli)
+		So(r.Start(ctx), ShouldBeNil)
+
+		start := <-p.started
+		So(start.Name, ShouldEqual, "pg")
+		So(start.Replicas, ShouldEqual, 1)
+		events := <-appended
+		So(events, ShouldHaveLength, 1)
+		So(events[0].ID(), ShouldEqual, "1")
+		commit := <-committed
+		So(commit.Position, ShouldResemble, []byte("1"))
+
+		assigned.Store([]*ctrlpb.ConnectorInstance{})
+		r.sync(ctx)
+		stop := <-p.stopped
+		So(stop.Name, ShouldEqual, "pg")
+		So(r.instances, ShouldBeEmpty)
+
+		cli.EXPECT().Disconnect(gomock.Any()).Times(1)
+		r.Stop(ctx)
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+)
+
+const (
+	defaultTimeout         = time.Minute
+	defaultMaxMemoryEvents = 10000
+)
+
+// Side is the eventbus an event comes from.
+type Side int
+
+const (
+	// Left is the subscribed eventbus.
+	Left Side = iota
+	// Right is the correlation eventbus.
+	Right
+)
+
+func (s Side) other() Side {
+	return 1 - s
+}
+
+type Config struct {
+	// Timeout is how long an event waits for the event of the other side, 1 minute if it's 0.
+	Timeout time.Duration
+	// MaxMemoryEvents is the max number of pending events kept in memory, the ones beyond it are
+	// spilled to SpillDir.
+	MaxMemoryEvents int
+	// SpillDir is the directory of spilled events, the events aren't spilled if it's empty.
+	SpillDir string
+}
+
+type pending struct {
+	key     string
+	side    Side
+	arrived time.Time
+	// record.Event is nil if the event has been spilled.
+	record info.EventRecord
+	file   string
+	elem   *list.Element
+}
+
+// Match is the pair of events with the same correlation key.
+type Match struct {
+	Key   string
+	Left  info.EventRecord
+	Right info.EventRecord
+}
+
+// Correlator buffers the events of both sides until the event of the other side with the same key
+// arrives, events are correlated in the order they arrived. An event is expired if it isn't
+// correlated in the timeout.
+type Correlator struct {
+	config  Config
+	pending [2]map[string][]*pending
+	// front is the oldest.
+	arrivals *list.List
+	inMemory int
+	spill    *spillStore
+	lock     sync.Mutex
+}
+
+func New(config Config) (*Correlator, error) {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.MaxMemoryEvents <= 0 {
+		config.MaxMemoryEvents = defaultMaxMemoryEvents
+	}
+	c := &Correlator{
+		config:   config,
+		pending:  [2]map[string][]*pending{{}, {}},
+		arrivals: list.New(),
+	}
+	if config.SpillDir != "" {
+		s, err := newSpillStore(config.SpillDir)
+		if err != nil {
+			return nil, err
+		}
+		c.spill = s
+	}
+	return c, nil
+}
+
+// Add returns the match if the event of the other side with the same key is pending, otherwise
+// the event is buffered.
+func (c *Correlator) Add(side Side, key string, record info.EventRecord, now time.Time) (*Match, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.expire(now)
+
+	others := c.pending[side.other()][key]
+	if len(others) > 0 {
+		p := others[0]
+		c.remove(p)
+		other, err := c.load(p)
+		if err != nil {
+			return nil, err
+		}
+		m := &Match{Key: key, Left: record, Right: other}
+		if side == Right {
+			m.Left, m.Right = other, record
+		}
+		return m, nil
+	}
+
+	p := &pending{key: key, side: side, arrived: now, record: record}
+	if c.spill != nil && c.inMemory >= c.config.MaxMemoryEvents {
+		file, err := c.spill.write(record.Event)
+		if err != nil {
+			return nil, err
+		}
+		p.file = file
+		p.record.Event = nil
+	} else {
+		c.inMemory++
+	}
+	p.elem = c.arrivals.PushBack(p)
+	c.pending[side][key] = append(c.pending[side][key], p)
+	return nil, nil
+}
+
+// Expire removes the events not correlated in the timeout, and returns their offsets.
+func (c *Correlator) Expire(now time.Time) []pInfo.OffsetInfo {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.expire(now)
+}
+
+// Len returns the number of pending events.
+func (c *Correlator) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.arrivals.Len()
+}
+
+// Spilled returns the number of pending events spilled to disk.
+func (c *Correlator) Spilled() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.arrivals.Len() - c.inMemory
+}
+
+// Close drops the pending events and removes the spilled ones.
+func (c *Correlator) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pending = [2]map[string][]*pending{{}, {}}
+	c.arrivals.Init()
+	c.inMemory = 0
+	if c.spill != nil {
+		return c.spill.close()
+	}
+	return nil
+}
+
+func (c *Correlator) expire(now time.Time) []pInfo.OffsetInfo {
+	var expired []pInfo.OffsetInfo
+	for e := c.arrivals.Front(); e != nil; e = c.arrivals.Front() {
+		p, _ := e.Value.(*pending)
+		if now.Sub(p.arrived) < c.config.Timeout {
+			break
+		}
+		c.remove(p)
+		if p.file != "" {
+			c.spill.remove(p.file)
+		}
+		expired = append(expired, p.record.OffsetInfo)
+	}
+	return expired
+}
+
+func (c *Correlator) remove(p *pending) {
+	c.arrivals.Remove(p.elem)
+	ps := c.pending[p.side][p.key]
+	for i := range ps {
+		if ps[i] == p {
+			ps = append(ps[:i], ps[i+1:]...)
+			break
+		}
+	}
+	if len(ps) == 0 {
+		delete(c.pending[p.side], p.key)
+	} else {
+		c.pending[p.side][p.key] = ps
+	}
+	if p.file == "" {
+		c.inMemory--
+	}
+}
+
+func (c *Correlator) load(p *pending) (info.EventRecord, error) {
+	if p.file == "" {
+		return p.record, nil
+	}
+	e, err := c.spill.read(p.file)
+	if err != nil {
+		return info.EventRecord{}, err
+	}
+	c.spill.remove(p.file)
+	return info.EventRecord{Event: e, OffsetInfo: p.record.OffsetInfo}, nil
+}
+
+// Merge returns the event sent for the match, its attributes are the ones of the left event, and
+// its data is an object containing both events by the names of their eventbuses.
+func Merge(m *Match, leftEventbus, rightEventbus string) (*ce.Event, error) {
+	e := m.Left.Event.Clone()
+	e.SetID(m.Left.Event.ID() + "+" + m.Right.Event.ID())
+	err := e.SetData(ce.ApplicationJSON, map[string]*ce.Event{
+		leftEventbus:  m.Left.Event,
+		rightEventbus: m.Right.Event,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
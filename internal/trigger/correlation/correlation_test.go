@@ -0,0 +1,120 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newRecord(id string, offset uint64) info.EventRecord {
+	e := ce.NewEvent()
+	e.SetID(id)
+	e.SetSource("source")
+	e.SetType("type")
+	_ = e.SetData(ce.ApplicationJSON, map[string]string{"id": id})
+	return info.EventRecord{
+		Event:      &e,
+		OffsetInfo: pInfo.OffsetInfo{EventLogID: vanus.NewIDFromUint64(1), Offset: offset},
+	}
+}
+
+func TestCorrelator(t *testing.T) {
+	Convey("test correlator", t, func() {
+		now := time.Now()
+		dir := filepath.Join(t.TempDir(), "spill")
+		c, err := New(Config{Timeout: time.Minute, MaxMemoryEvents: 2, SpillDir: dir})
+		So(err, ShouldBeNil)
+		defer func() {
+			_ = c.Close()
+		}()
+
+		Convey("test correlate both sides", func() {
+			m, err := c.Add(Left, "k1", newRecord("l1", 1), now)
+			So(err, ShouldBeNil)
+			So(m, ShouldBeNil)
+			m, err = c.Add(Left, "k1", newRecord("l2", 2), now)
+			So(err, ShouldBeNil)
+			So(m, ShouldBeNil)
+			So(c.Len(), ShouldEqual, 2)
+
+			m, err = c.Add(Right, "k1", newRecord("r1", 3), now)
+			So(err, ShouldBeNil)
+			So(m.Left.Event.ID(), ShouldEqual, "l1")
+			So(m.Right.Event.ID(), ShouldEqual, "r1")
+			So(c.Len(), ShouldEqual, 1)
+
+			merged, err := Merge(m, "orders", "payments")
+			So(err, ShouldBeNil)
+			So(merged.ID(), ShouldEqual, "l1+r1")
+			So(merged.Source(), ShouldEqual, "source")
+			data := map[string]map[string]interface{}{}
+			So(json.Unmarshal(merged.Data(), &data), ShouldBeNil)
+			So(data["orders"]["id"], ShouldEqual, "l1")
+			So(data["payments"]["id"], ShouldEqual, "r1")
+		})
+
+		Convey("test spill pending events", func() {
+			for i, key := range []string{"k1", "k2", "k3", "k4"} {
+				_, err = c.Add(Left, key, newRecord(key, uint64(i)), now)
+				So(err, ShouldBeNil)
+			}
+			So(c.Len(), ShouldEqual, 4)
+			So(c.Spilled(), ShouldEqual, 2)
+			files, _ := os.ReadDir(dir)
+			So(files, ShouldHaveLength, 2)
+
+			m, err := c.Add(Right, "k4", newRecord("r4", 10), now)
+			So(err, ShouldBeNil)
+			So(m.Left.Event.ID(), ShouldEqual, "k4")
+			So(m.Left.Offset, ShouldEqual, 3)
+			So(m.Left.Event.Data(), ShouldNotBeEmpty)
+			files, _ = os.ReadDir(dir)
+			So(files, ShouldHaveLength, 1)
+
+			So(c.Close(), ShouldBeNil)
+			_, err = os.Stat(dir)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("test expire pending events", func() {
+			_, _ = c.Add(Left, "k1", newRecord("l1", 1), now)
+			_, _ = c.Add(Right, "k2", newRecord("r2", 2), now.Add(time.Second))
+			_, _ = c.Add(Right, "k3", newRecord("r3", 3), now.Add(2*time.Second))
+			So(c.Expire(now.Add(30*time.Second)), ShouldBeEmpty)
+			expired := c.Expire(now.Add(time.Minute + time.Second))
+			So(expired, ShouldHaveLength, 2)
+			So(expired[0].Offset, ShouldEqual, 1)
+			So(expired[1].Offset, ShouldEqual, 2)
+			// the third one was spilled.
+			So(c.Len(), ShouldEqual, 1)
+			So(c.Spilled(), ShouldEqual, 1)
+
+			// the expired event isn't correlated.
+			m, err := c.Add(Left, "k2", newRecord("l2", 4), now.Add(time.Minute+time.Second))
+			So(err, ShouldBeNil)
+			So(m, ShouldBeNil)
+		})
+	})
+}
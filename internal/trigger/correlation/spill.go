@@ -0,0 +1,74 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+)
+
+// spillStore saves events as files in the directory, the directory is owned by the store. It isn't
+// safe for concurrent use, the correlator calls it with its lock held.
+type spillStore struct {
+	dir string
+	seq uint64
+}
+
+func newSpillStore(dir string) (*spillStore, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &spillStore{dir: dir}, nil
+}
+
+func (s *spillStore) write(e *ce.Event) (string, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+	s.seq++
+	file := filepath.Join(s.dir, fmt.Sprintf("%020d.json", s.seq))
+	if err = os.WriteFile(file, data, 0o644); err != nil {
+		return "", err
+	}
+	return file, nil
+}
+
+func (s *spillStore) read(file string) (*ce.Event, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	e := ce.NewEvent()
+	if err = json.Unmarshal(data, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (s *spillStore) remove(file string) {
+	_ = os.Remove(file)
+}
+
+func (s *spillStore) close() error {
+	return os.RemoveAll(s.dir)
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedup
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCapacity = 1 << 20
+)
+
+type entry struct {
+	key  string
+	time time.Time
+	// pending is true when the event of the key is being delivered, the key is recorded after the
+	// delivery succeeded.
+	pending bool
+}
+
+// Deduplicator remembers the keys delivered in a sliding time window. The recent keys are kept in
+// memory, the oldest key will be evicted when the number of keys exceed capacity. If it's opened
+// with a directory, the delivered keys are also appended to disk, so the keys evicted from memory
+// and the keys delivered before restart are still found.
+type Deduplicator struct {
+	window   time.Duration
+	capacity int
+	keys     map[string]*list.Element
+	// front is the oldest.
+	entries *list.List
+	disk    *diskStore
+	lock    sync.Mutex
+}
+
+func New(window time.Duration, capacity int) *Deduplicator {
+	if capacity <= 0 {
+		capacity = defaultCapacity
+	}
+	return &Deduplicator{
+		window:   window,
+		capacity: capacity,
+		keys:     make(map[string]*list.Element),
+		entries:  list.New(),
+	}
+}
+
+// Open creates a Deduplicator whose delivered keys are persisted in dir, the keys in the window
+// saved by the previous one are loaded.
+func Open(dir string, window time.Duration, capacity int) (*Deduplicator, error) {
+	disk, err := openDiskStore(dir, window, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	d := New(window, capacity)
+	d.disk = disk
+	return d, nil
+}
+
+// Seen return true if the key has been delivered or is being delivered in the window, otherwise
+// the key is reserved until Done or Forget is called.
+func (d *Deduplicator) Seen(key string) bool {
+	return d.seenAt(key, time.Now())
+}
+
+func (d *Deduplicator) seenAt(key string, now time.Time) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.expire(now)
+	if _, exist := d.keys[key]; exist {
+		return true
+	}
+	if d.disk != nil && d.disk.contains(key, now) {
+		return true
+	}
+	d.push(&entry{key: key, time: now, pending: true})
+	return false
+}
+
+// Done records the key after the event is delivered, the window of the key starts from now.
+func (d *Deduplicator) Done(key string) error {
+	return d.doneAt(key, time.Now())
+}
+
+func (d *Deduplicator) doneAt(key string, now time.Time) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if e, exist := d.keys[key]; exist {
+		d.remove(e)
+	}
+	d.push(&entry{key: key, time: now})
+	if d.disk != nil {
+		return d.disk.add(key, now)
+	}
+	return nil
+}
+
+// Forget releases the key reserved by Seen, it is used when the event delivery isn't completed,
+// so the event delivered again isn't taken as a duplicate.
+func (d *Deduplicator) Forget(key string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if e, exist := d.keys[key]; exist && e.Value.(*entry).pending {
+		d.remove(e)
+	}
+}
+
+func (d *Deduplicator) Len() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.entries.Len()
+}
+
+// Close closes the file being appended, the Deduplicator can still be used after closed.
+func (d *Deduplicator) Close() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.disk != nil {
+		return d.disk.close()
+	}
+	return nil
+}
+
+func (d *Deduplicator) push(e *entry) {
+	d.keys[e.key] = d.entries.PushBack(e)
+	for d.entries.Len() > d.capacity {
+		d.remove(d.entries.Front())
+	}
+}
+
+func (d *Deduplicator) expire(now time.Time) {
+	for e := d.entries.Front(); e != nil; e = d.entries.Front() {
+		if now.Sub(e.Value.(*entry).time) < d.window {
+			return
+		}
+		d.remove(e)
+	}
+}
+
+func (d *Deduplicator) remove(e *list.Element) {
+	d.entries.Remove(e)
+	delete(d.keys, e.Value.(*entry).key)
+}
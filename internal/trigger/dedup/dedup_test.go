@@ -0,0 +1,125 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeduplicator_Seen(t *testing.T) {
+	Convey("test deduplicator seen", t, func() {
+		now := time.Now()
+		d := New(time.Minute, 2)
+		So(d.seenAt("a", now), ShouldBeFalse)
+		So(d.seenAt("a", now.Add(time.Second)), ShouldBeTrue)
+		Convey("test window expired", func() {
+			So(d.seenAt("a", now.Add(time.Minute)), ShouldBeFalse)
+			So(d.Len(), ShouldEqual, 1)
+		})
+		Convey("test capacity exceeded", func() {
+			So(d.seenAt("b", now), ShouldBeFalse)
+			So(d.seenAt("c", now), ShouldBeFalse)
+			So(d.Len(), ShouldEqual, 2)
+			So(d.seenAt("a", now), ShouldBeFalse)
+		})
+		Convey("test forget", func() {
+			d.Forget("a")
+			So(d.Len(), ShouldEqual, 0)
+			So(d.seenAt("a", now), ShouldBeFalse)
+		})
+		Convey("test done", func() {
+			So(d.doneAt("a", now.Add(time.Second)), ShouldBeNil)
+			// the delivered key isn't forgotten
+			d.Forget("a")
+			So(d.seenAt("a", now.Add(time.Minute)), ShouldBeTrue)
+			So(d.seenAt("a", now.Add(time.Minute+time.Second)), ShouldBeFalse)
+		})
+	})
+}
+
+func TestDeduplicator_Open(t *testing.T) {
+	Convey("test deduplicator on disk", t, func() {
+		dir := t.TempDir()
+		now := time.Now()
+		d, err := Open(dir, time.Minute, 1)
+		So(err, ShouldBeNil)
+		So(d.seenAt("a", now), ShouldBeFalse)
+		So(d.doneAt("a", now), ShouldBeNil)
+		So(d.seenAt("b", now), ShouldBeFalse)
+		So(d.doneAt("b", now), ShouldBeNil)
+		// a is evicted from memory, but it's found on disk.
+		So(d.Len(), ShouldEqual, 1)
+		So(d.seenAt("a", now.Add(time.Second)), ShouldBeTrue)
+		So(d.seenAt("c", now.Add(time.Second)), ShouldBeFalse)
+		So(d.Close(), ShouldBeNil)
+
+		Convey("test reopen", func() {
+			d, err = Open(dir, time.Minute, 10)
+			So(err, ShouldBeNil)
+			defer func() {
+				_ = d.Close()
+			}()
+			So(d.seenAt("a", now.Add(time.Second)), ShouldBeTrue)
+			So(d.seenAt("b", now.Add(time.Second)), ShouldBeTrue)
+			// c isn't delivered.
+			So(d.seenAt("c", now.Add(time.Second)), ShouldBeFalse)
+		})
+
+		Convey("test expire", func() {
+			d, err = Open(dir, time.Minute, 10)
+			So(err, ShouldBeNil)
+			So(d.seenAt("a", now.Add(time.Minute)), ShouldBeFalse)
+			So(d.doneAt("a", now.Add(time.Minute)), ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			files, err := os.ReadDir(dir)
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 1)
+		})
+
+		Convey("test partial record", func() {
+			files, err := os.ReadDir(dir)
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, 1)
+			f, err := os.OpenFile(filepath.Join(dir, files[0].Name()), os.O_WRONLY|os.O_APPEND, 0o644)
+			So(err, ShouldBeNil)
+			_, _ = f.Write([]byte{1, 2, 3, 4, 5, 6, 7, 8, 20, 'd'})
+			_ = f.Close()
+			d, err = Open(dir, time.Minute, 10)
+			So(err, ShouldBeNil)
+			So(d.seenAt("b", now.Add(time.Second)), ShouldBeTrue)
+			So(d.seenAt("d", now.Add(time.Second)), ShouldBeFalse)
+		})
+
+		Convey("test index", func() {
+			s, err := openDiskStore(dir, time.Minute, now)
+			So(err, ShouldBeNil)
+			So(s.segments, ShouldHaveLength, 1)
+			So(s.add("c", now.Add(time.Second)), ShouldBeNil)
+			So(s.segments, ShouldHaveLength, 2)
+			So(s.contains("a", now.Add(time.Second)), ShouldBeTrue)
+			So(s.contains("c", now.Add(time.Second)), ShouldBeTrue)
+			// the record of a hash collision isn't the key.
+			seg := s.segments[1]
+			seg.index[keyHash("d")] = seg.index[keyHash("c")]
+			So(s.contains("d", now.Add(time.Second)), ShouldBeFalse)
+			So(s.close(), ShouldBeNil)
+		})
+	})
+}
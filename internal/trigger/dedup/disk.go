@@ -0,0 +1,254 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dedup
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// segmentsPerWindow the keys are appended to a new file every 1/segmentsPerWindow of the window,
+	// a file is removed when all its keys are out of the window.
+	segmentsPerWindow = 4
+	minSegmentSpan    = time.Second
+	segmentFileSuffix = ".keys"
+	// timeSize is the size of the delivered time of a record, a record is the time, the length of the key
+	// as uvarint and the key.
+	timeSize = 8
+)
+
+type segment struct {
+	path  string
+	start time.Time
+	// last is the delivered time of the latest key in the file.
+	last time.Time
+	// size is the end offset of the last complete record.
+	size int64
+	// index maps the hash of key to the offsets of its records, so a lookup only reads the records
+	// whose hash matches instead of scanning the file.
+	index map[uint64][]int64
+}
+
+func newSegment(path string, start time.Time) *segment {
+	return &segment{path: path, start: start, last: start, index: make(map[uint64][]int64)}
+}
+
+func (seg *segment) addIndex(key string, offset int64) {
+	h := keyHash(key)
+	seg.index[h] = append(seg.index[h], offset)
+}
+
+// diskStore appends the delivered keys to files of time ranges in the directory. It isn't safe for
+// concurrent use, the Deduplicator calls it with its lock held.
+type diskStore struct {
+	dir    string
+	window time.Duration
+	span   time.Duration
+	// front is the oldest.
+	segments []*segment
+	// active is the file of the last segment, nil means a new segment is created by the next add.
+	active *os.File
+}
+
+func openDiskStore(dir string, window time.Duration, now time.Time) (*diskStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	span := window / segmentsPerWindow
+	if span < minSegmentSpan {
+		span = minSegmentSpan
+	}
+	s := &diskStore{dir: dir, window: window, span: span}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, segmentFileSuffix) {
+			continue
+		}
+		nano, err := strconv.ParseInt(strings.TrimSuffix(name, segmentFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		seg := newSegment(filepath.Join(dir, name), time.Unix(0, nano))
+		seg.size, err = scanSegment(seg.path, func(key string, t time.Time, offset int64) {
+			seg.addIndex(key, offset)
+			if t.After(seg.last) {
+				seg.last = t
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		s.segments = append(s.segments, seg)
+	}
+	sort.Slice(s.segments, func(i, j int) bool {
+		return s.segments[i].start.Before(s.segments[j].start)
+	})
+	s.expire(now)
+	return s, nil
+}
+
+func (s *diskStore) add(key string, now time.Time) error {
+	s.expire(now)
+	if s.active == nil || now.Sub(s.segments[len(s.segments)-1].start) >= s.span {
+		if err := s.rotate(now); err != nil {
+			return err
+		}
+	}
+	var header [timeSize + binary.MaxVarintLen64]byte
+	binary.BigEndian.PutUint64(header[:], uint64(now.UnixNano()))
+	n := timeSize + binary.PutUvarint(header[timeSize:], uint64(len(key)))
+	record := make([]byte, 0, n+len(key))
+	record = append(record, header[:n]...)
+	record = append(record, key...)
+	seg := s.segments[len(s.segments)-1]
+	if _, err := s.active.Write(record); err != nil {
+		return err
+	}
+	seg.addIndex(key, seg.size)
+	seg.size += int64(len(record))
+	seg.last = now
+	return nil
+}
+
+// contains looks the key up by the index of segments, only the records whose hash matches the key are
+// read from disk.
+func (s *diskStore) contains(key string, now time.Time) bool {
+	s.expire(now)
+	h := keyHash(key)
+	// the newer segments are more likely to have the key.
+	for i := len(s.segments) - 1; i >= 0; i-- {
+		seg := s.segments[i]
+		offsets, exist := seg.index[h]
+		if !exist {
+			continue
+		}
+		if found, _ := readRecords(seg.path, offsets, key, func(t time.Time) bool {
+			return now.Sub(t) < s.window
+		}); found {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *diskStore) rotate(now time.Time) error {
+	if err := s.close(); err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("%020d%s", now.UnixNano(), segmentFileSuffix))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	s.active = f
+	s.segments = append(s.segments, newSegment(path, now))
+	return nil
+}
+
+// expire removes the files whose keys are all out of the window.
+func (s *diskStore) expire(now time.Time) {
+	for len(s.segments) > 0 && now.Sub(s.segments[0].last) >= s.window {
+		if len(s.segments) == 1 {
+			_ = s.close()
+		}
+		_ = os.Remove(s.segments[0].path)
+		s.segments = s.segments[1:]
+	}
+}
+
+func (s *diskStore) close() error {
+	if s.active == nil {
+		return nil
+	}
+	err := s.active.Close()
+	s.active = nil
+	return err
+}
+
+// scanSegment calls fn with the keys of the file and the offsets of their records in order, and returns
+// the end offset of the last complete record. A partial record at the end of file, which is written
+// when the process crashed, is ignored.
+func scanSegment(path string, fn func(key string, t time.Time, offset int64)) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	offset := int64(0)
+	for {
+		key, t, size := parseRecord(data[offset:])
+		if size == 0 {
+			return offset, nil
+		}
+		fn(key, t, offset)
+		offset += int64(size)
+	}
+}
+
+// readRecords reads the records at offsets of the file, returns true if one of them is the key and
+// match returns true for its delivered time.
+func readRecords(path string, offsets []int64, key string, match func(t time.Time) bool) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	buf := make([]byte, timeSize+binary.MaxVarintLen64+len(key))
+	for _, offset := range offsets {
+		n, err := f.ReadAt(buf, offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return false, err
+		}
+		k, t, size := parseRecord(buf[:n])
+		if size > 0 && k == key && match(t) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseRecord returns the key, the delivered time and the size of the record at the beginning of data,
+// the size is 0 if data doesn't begin with a complete record.
+func parseRecord(data []byte) (string, time.Time, int) {
+	if len(data) <= timeSize {
+		return "", time.Time{}, 0
+	}
+	t := time.Unix(0, int64(binary.BigEndian.Uint64(data)))
+	n, size := binary.Uvarint(data[timeSize:])
+	if size <= 0 || uint64(len(data)-timeSize-size) < n {
+		return "", time.Time{}, 0
+	}
+	start := timeSize + size
+	return string(data[start : start+int(n)]), t, start + int(n)
+}
+
+func keyHash(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
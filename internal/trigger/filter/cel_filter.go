@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/linkall-labs/vanus/internal/primitive/cel"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
 	"github.com/linkall-labs/vanus/observability/log"
 
 	ce "github.com/cloudevents/sdk-go/v2"
@@ -43,6 +44,16 @@ func NewCELFilter(expression string) Filter {
 }
 
 func (filter *CELFilter) Filter(event ce.Event) Result {
+	if schema.IsBinary(event.DataContentType()) {
+		data, err := schema.JSONData(schema.DefaultRegistry(), event)
+		if err != nil {
+			log.Info(context.Background(), "decode event data error", map[string]interface{}{
+				log.KeyError: err,
+			})
+			return FailFilter
+		}
+		event.DataEncoded = data
+	}
 	result, err := filter.parsedExpression.Eval(event)
 	if err != nil {
 		log.Info(context.Background(), "cel eval error", map[string]interface{}{
@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"github.com/linkall-labs/vanus/internal/primitive"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+// GetPushdownFilter compiles the subscription filters to the filter evaluated by segment servers when reading.
+// The compiled filter is looser than the subscription filters, the CEL and CeSQL filters can't be compiled and
+// are treated as passed, so the events read must be filtered by GetFilter still. It returns nil if nothing
+// can be pushed down.
+func GetPushdownFilter(subscriptionFilters []*primitive.SubscriptionFilter) *segpb.EventFilter {
+	filters, _ := compileFilters(subscriptionFilters)
+	if len(filters) == 0 {
+		return nil
+	}
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &segpb.EventFilter{All: filters}
+}
+
+// compileFilters drops the filters which can't be compiled, which is looser for conjunction, exact is
+// false if any filter is dropped or compiled loosely.
+func compileFilters(subscriptionFilters []*primitive.SubscriptionFilter) ([]*segpb.EventFilter, bool) {
+	filters := make([]*segpb.EventFilter, 0, len(subscriptionFilters))
+	exact := true
+	for _, f := range subscriptionFilters {
+		pf, ok := compileFilter(f)
+		if pf != nil {
+			filters = append(filters, pf)
+		}
+		exact = exact && ok
+	}
+	return filters, exact
+}
+
+// compileFilter follows the precedence of extractFilter. It returns nil if the filter can't be compiled,
+// and exact is false if the compiled filter is looser than the subscription filter.
+func compileFilter(subscriptionFilter *primitive.SubscriptionFilter) (*segpb.EventFilter, bool) {
+	if len(subscriptionFilter.Exact) > 0 {
+		return attributesFilter(subscriptionFilter.Exact, func(attrs map[string]string) *segpb.EventFilter {
+			return &segpb.EventFilter{Exact: attrs}
+		})
+	}
+	if len(subscriptionFilter.Prefix) > 0 {
+		return attributesFilter(subscriptionFilter.Prefix, func(attrs map[string]string) *segpb.EventFilter {
+			return &segpb.EventFilter{Prefix: attrs}
+		})
+	}
+	if len(subscriptionFilter.Suffix) > 0 {
+		return attributesFilter(subscriptionFilter.Suffix, func(attrs map[string]string) *segpb.EventFilter {
+			return &segpb.EventFilter{Suffix: attrs}
+		})
+	}
+	if subscriptionFilter.Not != nil {
+		// the negation of a looser filter is stricter, so only the exact one can be negated.
+		not, exact := compileFilter(subscriptionFilter.Not)
+		if not == nil || !exact {
+			return nil, false
+		}
+		return &segpb.EventFilter{Not: not}, true
+	}
+	if subscriptionFilter.CeSQL != "" || subscriptionFilter.CEL != "" {
+		return nil, false
+	}
+	if len(subscriptionFilter.All) > 0 {
+		all, exact := compileFilters(subscriptionFilter.All)
+		if len(all) == 0 {
+			return nil, false
+		}
+		return &segpb.EventFilter{All: all}, exact
+	}
+	if len(subscriptionFilter.Any) > 0 {
+		// any of the alternatives which can't be compiled makes the disjunction unknown.
+		anyOf := make([]*segpb.EventFilter, 0, len(subscriptionFilter.Any))
+		exact := true
+		for _, f := range subscriptionFilter.Any {
+			pf, ok := compileFilter(f)
+			if pf == nil {
+				return nil, false
+			}
+			anyOf = append(anyOf, pf)
+			exact = exact && ok
+		}
+		return &segpb.EventFilter{Any: anyOf}, exact
+	}
+	// the empty filter is ignored by trigger worker.
+	return nil, true
+}
+
+func attributesFilter(
+	attrs map[string]string, newFilter func(map[string]string) *segpb.EventFilter,
+) (*segpb.EventFilter, bool) {
+	for attr, v := range attrs {
+		if attr == "" || v == "" {
+			// the invalid filter is ignored by trigger worker.
+			return nil, true
+		}
+	}
+	return newFilter(attrs), true
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGetPushdownFilter(t *testing.T) {
+	exact := &primitive.SubscriptionFilter{Exact: map[string]string{"type": "order.created"}}
+	prefix := &primitive.SubscriptionFilter{Prefix: map[string]string{"source": "vanus"}}
+	cel := &primitive.SubscriptionFilter{CEL: "$type.(string) == 'order.created'"}
+
+	Convey("no filter to push down", t, func() {
+		So(filter.GetPushdownFilter(nil), ShouldBeNil)
+		So(filter.GetPushdownFilter([]*primitive.SubscriptionFilter{{}, cel}), ShouldBeNil)
+		So(filter.GetPushdownFilter([]*primitive.SubscriptionFilter{
+			{Exact: map[string]string{"type": ""}},
+		}), ShouldBeNil)
+	})
+
+	Convey("push down attribute filters", t, func() {
+		f := filter.GetPushdownFilter([]*primitive.SubscriptionFilter{exact})
+		So(f.Exact, ShouldResemble, exact.Exact)
+		f = filter.GetPushdownFilter([]*primitive.SubscriptionFilter{exact, cel, prefix})
+		So(f.All, ShouldHaveLength, 2)
+		So(f.All[0].Exact, ShouldResemble, exact.Exact)
+		So(f.All[1].Prefix, ShouldResemble, prefix.Prefix)
+	})
+
+	Convey("push down composite filters", t, func() {
+		f := filter.GetPushdownFilter([]*primitive.SubscriptionFilter{
+			{Any: []*primitive.SubscriptionFilter{exact, prefix}},
+		})
+		So(f.Any, ShouldHaveLength, 2)
+		f = filter.GetPushdownFilter([]*primitive.SubscriptionFilter{{Not: exact}})
+		So(f.Not.Exact, ShouldResemble, exact.Exact)
+	})
+
+	Convey("composite filters with CEL", t, func() {
+		// the disjunction is unknown.
+		So(filter.GetPushdownFilter([]*primitive.SubscriptionFilter{
+			{Any: []*primitive.SubscriptionFilter{exact, cel}},
+		}), ShouldBeNil)
+		// the conjunction is looser.
+		f := filter.GetPushdownFilter([]*primitive.SubscriptionFilter{
+			{All: []*primitive.SubscriptionFilter{exact, cel}},
+		})
+		So(f.All, ShouldResemble, []*segpb.EventFilter{{Exact: exact.Exact}})
+		// the negation of a looser filter can't be pushed down.
+		So(filter.GetPushdownFilter([]*primitive.SubscriptionFilter{
+			{Not: &primitive.SubscriptionFilter{All: []*primitive.SubscriptionFilter{exact, cel}}},
+		}), ShouldBeNil)
+	})
+
+	Convey("negation of URI extension", t, func() {
+		event := ce.NewEvent()
+		event.SetID("1")
+		event.SetSource("vanus")
+		event.SetType("order.created")
+		event.SetExtension("xurl", types.URI{URL: url.URL{Scheme: "https", Host: "vanus.ai"}})
+		subscriptionFilters := []*primitive.SubscriptionFilter{
+			{Not: &primitive.SubscriptionFilter{Exact: map[string]string{"xurl": "https://vanus.ai"}}},
+		}
+		// the URI isn't equal to the string for trigger worker, so the event is kept, and segment
+		// server must not drop it by the pushed down filter either.
+		So(filter.GetFilter(subscriptionFilters).Filter(event), ShouldEqual, filter.PassFilter)
+		f := filter.GetPushdownFilter(subscriptionFilters)
+		So(f.Not.Exact, ShouldResemble, map[string]string{"xurl": "https://vanus.ai"})
+	})
+}
@@ -22,4 +22,6 @@ import (
 type EventRecord struct {
 	Event *ce.Event
 	info.OffsetInfo
+	// DedupKey is the key reserved in the dedup window, it's recorded after the event is delivered.
+	DedupKey string
 }
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	primitive "github.com/linkall-labs/vanus/internal/primitive"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	trigger "github.com/linkall-labs/vanus/internal/trigger/trigger"
 )
 
 // MockWorker is a mock of Worker interface.
@@ -162,6 +163,22 @@ func (mr *MockWorkerMockRecorder) Stop(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockWorker)(nil).Stop), ctx)
 }
 
+// TraceEvent mocks base method.
+func (m *MockWorker) TraceEvent(id vanus.ID, eventID string) ([]trigger.DeliveryResult, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceEvent", id, eventID)
+	ret0, _ := ret[0].([]trigger.DeliveryResult)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TraceEvent indicates an expected call of TraceEvent.
+func (mr *MockWorkerMockRecorder) TraceEvent(id, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceEvent", reflect.TypeOf((*MockWorker)(nil).TraceEvent), id, eventID)
+}
+
 // Unregister mocks base method.
 func (m *MockWorker) Unregister(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -175,3 +192,18 @@ func (mr *MockWorkerMockRecorder) Unregister(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unregister", reflect.TypeOf((*MockWorker)(nil).Unregister), ctx)
 }
+
+// WatchDelivery mocks base method.
+func (m *MockWorker) WatchDelivery(id vanus.ID) (*trigger.DeliveryWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchDelivery", id)
+	ret0, _ := ret[0].(*trigger.DeliveryWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchDelivery indicates an expected call of WatchDelivery.
+func (mr *MockWorkerMockRecorder) WatchDelivery(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchDelivery", reflect.TypeOf((*MockWorker)(nil).WatchDelivery), id)
+}
@@ -17,12 +17,17 @@ package offset
 import (
 	"math"
 	"sync"
+	"sync/atomic"
 
 	"github.com/huandu/skiplist"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
 
+// maxDeliveredOffsets is the max number of delivered offsets behind commit offset each eventlog,
+// the offsets over it will be delivered again after restart.
+const maxDeliveredOffsets = 1000
+
 func NewSubscriptionOffset(id vanus.ID) *SubscriptionOffset {
 	return &SubscriptionOffset{
 		subscriptionID: id,
@@ -32,6 +37,16 @@ func NewSubscriptionOffset(id vanus.ID) *SubscriptionOffset {
 type SubscriptionOffset struct {
 	subscriptionID vanus.ID
 	elOffset       sync.Map
+	trackDelivered bool
+	deliveredLock  sync.Mutex
+	delivered      map[vanus.ID]map[uint64]struct{}
+	commitCount    uint64
+}
+
+// SetTrackDelivered if true GetCommit contains the offsets which are behind commit offset
+// but have been committed.
+func (offset *SubscriptionOffset) SetTrackDelivered(track bool) {
+	offset.trackDelivered = track
 }
 
 func (offset *SubscriptionOffset) Clear() {
@@ -39,6 +54,42 @@ func (offset *SubscriptionOffset) Clear() {
 		offset.elOffset.Delete(key)
 		return true
 	})
+	offset.deliveredLock.Lock()
+	defer offset.deliveredLock.Unlock()
+	offset.delivered = nil
+}
+
+// SetDelivered set the delivered offsets which is got from controller, the event of
+// them no need deliver again.
+func (offset *SubscriptionOffset) SetDelivered(offsets info.ListOffsetInfo) {
+	offset.deliveredLock.Lock()
+	defer offset.deliveredLock.Unlock()
+	offset.delivered = make(map[vanus.ID]map[uint64]struct{}, len(offsets))
+	for _, o := range offsets {
+		if len(o.Delivered) == 0 {
+			continue
+		}
+		m := make(map[uint64]struct{}, len(o.Delivered))
+		for _, d := range o.Delivered {
+			m[d] = struct{}{}
+		}
+		offset.delivered[o.EventLogID] = m
+	}
+}
+
+// IsDelivered return true if the offset has been delivered before, it only return true once.
+func (offset *SubscriptionOffset) IsDelivered(info info.OffsetInfo) bool {
+	offset.deliveredLock.Lock()
+	defer offset.deliveredLock.Unlock()
+	m, exist := offset.delivered[info.EventLogID]
+	if !exist {
+		return false
+	}
+	if _, exist = m[info.Offset]; !exist {
+		return false
+	}
+	delete(m, info.Offset)
+	return true
 }
 
 func (offset *SubscriptionOffset) EventReceive(info info.OffsetInfo) {
@@ -55,16 +106,27 @@ func (offset *SubscriptionOffset) EventCommit(info info.OffsetInfo) {
 		return
 	}
 	o.(*offsetTracker).commitOffset(info.Offset)
+	atomic.AddUint64(&offset.commitCount, 1)
+}
+
+// CommitCount return the number of the committed events since created.
+func (offset *SubscriptionOffset) CommitCount() uint64 {
+	return atomic.LoadUint64(&offset.commitCount)
 }
 
 func (offset *SubscriptionOffset) GetCommit() info.ListOffsetInfo {
 	var commit info.ListOffsetInfo
 	offset.elOffset.Range(func(key, value interface{}) bool {
 		tracker, _ := value.(*offsetTracker)
-		commit = append(commit, info.OffsetInfo{
+		o := info.OffsetInfo{
 			EventLogID: key.(vanus.ID),
-			Offset:     tracker.offsetToCommit(),
-		})
+		}
+		if offset.trackDelivered {
+			o.Offset, o.Delivered = tracker.offsetToCommitWithDelivered()
+		} else {
+			o.Offset = tracker.offsetToCommit()
+		}
+		commit = append(commit, o)
 		return true
 	})
 	return commit
@@ -118,3 +180,32 @@ func (o *offsetTracker) offsetToCommit() uint64 {
 	}
 	return o.list.Front().Key().(uint64)
 }
+
+// offsetToCommitWithDelivered the offset between two pending offset has been committed.
+func (o *offsetTracker) offsetToCommitWithDelivered() (uint64, []uint64) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.list.Len() == 0 {
+		if o.maxOffset == math.MaxUint64 {
+			return o.initOffset, nil
+		}
+		return o.maxOffset + 1, nil
+	}
+	var delivered []uint64
+	front := o.list.Front()
+	prev, _ := front.Key().(uint64)
+	for elem := front.Next(); ; elem = elem.Next() {
+		next := o.maxOffset + 1
+		if elem != nil {
+			next, _ = elem.Key().(uint64)
+		}
+		for v := prev + 1; v < next && len(delivered) < maxDeliveredOffsets; v++ {
+			delivered = append(delivered, v)
+		}
+		if elem == nil || len(delivered) >= maxDeliveredOffsets {
+			break
+		}
+		prev = next
+	}
+	return front.Key().(uint64), delivered
+}
@@ -38,6 +38,7 @@ func TestSubscriptionOffset(t *testing.T) {
 			}
 			commits := subOffset.GetCommit()
 			So(0, ShouldEqual, len(commits))
+			So(subOffset.CommitCount(), ShouldEqual, 0)
 		})
 		Convey("commit with receive", func() {
 			offsetBegin := uint64(1)
@@ -69,6 +70,7 @@ func TestSubscriptionOffset(t *testing.T) {
 			commits := subOffset.GetCommit()
 			So(1, ShouldEqual, len(commits))
 			So(commitEnd, ShouldEqual, commits[0].Offset)
+			So(subOffset.CommitCount(), ShouldEqual, commitEnd-offsetBegin)
 			offsetBegin = commitEnd
 			commitEnd = offsetEnd
 			for offset := offsetBegin; offset <= commitEnd; offset++ {
@@ -118,3 +120,43 @@ func TestOffsetTracker(t *testing.T) {
 		So(tracker.offsetToCommit(), ShouldEqual, offset+4)
 	})
 }
+
+func TestOffsetTrackerWithDelivered(t *testing.T) {
+	Convey("test offset tracker with delivered", t, func() {
+		tracker := initOffset(0)
+		for offset := uint64(0); offset < 10; offset++ {
+			tracker.putOffset(offset)
+		}
+		commit, delivered := tracker.offsetToCommitWithDelivered()
+		So(commit, ShouldEqual, 0)
+		So(delivered, ShouldBeEmpty)
+		tracker.commitOffset(0)
+		tracker.commitOffset(2)
+		tracker.commitOffset(3)
+		tracker.commitOffset(5)
+		tracker.commitOffset(9)
+		commit, delivered = tracker.offsetToCommitWithDelivered()
+		So(commit, ShouldEqual, 1)
+		So(delivered, ShouldResemble, []uint64{2, 3, 5, 9})
+		for _, offset := range []uint64{1, 4, 6, 7, 8} {
+			tracker.commitOffset(offset)
+		}
+		commit, delivered = tracker.offsetToCommitWithDelivered()
+		So(commit, ShouldEqual, 10)
+		So(delivered, ShouldBeEmpty)
+	})
+}
+
+func TestSubscriptionOffset_IsDelivered(t *testing.T) {
+	Convey("test subscription offset delivered", t, func() {
+		eventLogID := vanus.NewTestID()
+		subOffset := NewSubscriptionOffset(vanus.NewTestID())
+		subOffset.SetDelivered(info.ListOffsetInfo{{EventLogID: eventLogID, Offset: 1, Delivered: []uint64{3, 5}}})
+		So(subOffset.IsDelivered(info.OffsetInfo{EventLogID: eventLogID, Offset: 1}), ShouldBeFalse)
+		So(subOffset.IsDelivered(info.OffsetInfo{EventLogID: eventLogID, Offset: 3}), ShouldBeTrue)
+		So(subOffset.IsDelivered(info.OffsetInfo{EventLogID: eventLogID, Offset: 3}), ShouldBeFalse)
+		So(subOffset.IsDelivered(info.OffsetInfo{EventLogID: vanus.NewTestID(), Offset: 5}), ShouldBeFalse)
+		subOffset.Clear()
+		So(subOffset.IsDelivered(info.OffsetInfo{EventLogID: eventLogID, Offset: 5}), ShouldBeFalse)
+	})
+}
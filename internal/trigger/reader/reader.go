@@ -25,6 +25,7 @@ import (
 	ce "github.com/cloudevents/sdk-go/v2"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/blob"
 	"github.com/linkall-labs/vanus/client/pkg/eventlog"
 	"github.com/linkall-labs/vanus/client/pkg/option"
 	"github.com/linkall-labs/vanus/client/pkg/policy"
@@ -36,6 +37,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
 const (
@@ -57,6 +59,13 @@ type Config struct {
 	Offset            EventLogOffset
 	OffsetType        primitive.OffsetType
 	OffsetTimestamp   int64
+	// Filter returns the filter pushed down to segment servers, it's called on each read so that the
+	// change of subscription filters takes effect, nil means all events are read.
+	Filter func() *segpb.EventFilter
+	// BlobStore resolves the data of offloaded events, nil means the events are read as is.
+	BlobStore blob.Store
+	// EventlogFilter reports whether the eventlog is read, nil means all eventlogs are read.
+	EventlogFilter func(id uint64) bool
 
 	CheckEventLogInterval time.Duration
 }
@@ -150,9 +159,12 @@ func (r *reader) checkEventLogChange() {
 		})
 		return
 	}
-	els := make([]uint64, len(ls))
-	for idx, l := range ls {
-		els[idx] = l.ID()
+	els := make([]uint64, 0, len(ls))
+	for _, l := range ls {
+		if r.config.EventlogFilter != nil && !r.config.EventlogFilter(l.ID()) {
+			continue
+		}
+		els = append(els, l.ID())
 	}
 	if len(els) != len(r.elReader) {
 		log.Info(ctx, "event eventlog change,will restart event eventlog reader", map[string]interface{}{
@@ -306,7 +318,11 @@ func (elReader *eventLogReader) run(ctx context.Context) {
 }
 
 func (elReader *eventLogReader) readEvent(ctx context.Context, lr api.BusReader) error {
-	events, err := readEvents(ctx, lr, elReader.policy)
+	var f *segpb.EventFilter
+	if elReader.config.Filter != nil {
+		f = elReader.config.Filter()
+	}
+	events, err := readEvents(ctx, lr, elReader.policy, f)
 	if err != nil {
 		return err
 	}
@@ -323,7 +339,10 @@ func (elReader *eventLogReader) readEvent(ctx context.Context, lr api.BusReader)
 			return err
 		}
 		elReader.offset = offset
-		elReader.policy.Forward(1)
+		if f == nil {
+			// the filtered read forwards the policy by itself, the events are not contiguous.
+			elReader.policy.Forward(1)
+		}
 	}
 	metrics.TriggerPullEventCounter.WithLabelValues(
 		elReader.config.SubscriptionIDStr, elReader.config.EventBusName, elReader.eventLogIDStr).
@@ -339,14 +358,24 @@ func (elReader *eventLogReader) putEvent(ctx context.Context, event info.EventRe
 	}
 }
 
-func readEvents(ctx context.Context, lr api.BusReader, p api.ReadPolicy) ([]*ce.Event, error) {
+func readEvents(
+	ctx context.Context, lr api.BusReader, p api.ReadPolicy, f *segpb.EventFilter,
+) ([]*ce.Event, error) {
 	timeout, cancel := context.WithTimeout(ctx, readEventTimeout)
 	defer cancel()
-	events, _, _, err := lr.Read(timeout, option.WithReadPolicy(p), option.WithBatchSize(int(readSize)))
+	opts := []api.ReadOption{option.WithReadPolicy(p), option.WithBatchSize(int(readSize))}
+	if f != nil {
+		opts = append(opts, option.WithFilter(f))
+	}
+	events, _, _, err := lr.Read(timeout, opts...)
 	return events, err
 }
 
 func (elReader *eventLogReader) init(ctx context.Context) (api.BusReader, error) {
-	lr := elReader.config.Client.Eventbus(ctx, elReader.config.EventBusName).Reader()
+	var opts []api.ReadOption
+	if elReader.config.BlobStore != nil {
+		opts = append(opts, option.WithBlobResolver(elReader.config.BlobStore))
+	}
+	lr := elReader.config.Client.Eventbus(ctx, elReader.config.EventBusName).Reader(opts...)
 	return lr, nil
 }
@@ -191,4 +191,14 @@ func TestReaderStart(t *testing.T) {
 		wg.Wait()
 		r.Close()
 	})
+
+	Convey("test skip eventLogs not subscribed", t, func() {
+		r := NewReader(Config{
+			EventBusName:   "test",
+			Client:         mockClient,
+			EventlogFilter: func(id uint64) bool { return id != 0 },
+		}, make(chan info.EventRecord, 1)).(*reader)
+		r.checkEventLogChange()
+		So(r.elReader, ShouldBeEmpty)
+	})
 }
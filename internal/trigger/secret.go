@@ -0,0 +1,175 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// secretWatchRetryInterval is the interval to watch the secrets again after the stream is broken.
+const secretWatchRetryInterval = 3 * time.Second
+
+// resolveSinkSecret fills the sink credential of the subscription from the secret it references,
+// the caller must hold w.lock.
+func (w *worker) resolveSinkSecret(ctx context.Context, subscription *primitive.Subscription) error {
+	if subscription.SinkSecret == "" {
+		w.releaseSinkSecret(subscription.ID)
+		return nil
+	}
+	s, exist := w.secrets[subscription.SinkSecret]
+	if !exist {
+		var err error
+		if s, err = w.getSecret(ctx, subscription.SinkSecret); err != nil {
+			return err
+		}
+		w.secrets[s.Name] = s
+	}
+	subscription.SinkCredential = s.Credential
+	w.releaseSinkSecret(subscription.ID)
+	w.secretSubscriptions[subscription.ID] = subscription
+	return nil
+}
+
+// releaseSinkSecret forgets the secret which the subscription references, the cached secret is
+// removed if no subscription references it, the caller must hold w.lock.
+func (w *worker) releaseSinkSecret(id vanus.ID) {
+	sub, exist := w.secretSubscriptions[id]
+	if !exist {
+		return
+	}
+	delete(w.secretSubscriptions, id)
+	for _, other := range w.secretSubscriptions {
+		if other.SinkSecret == sub.SinkSecret {
+			return
+		}
+	}
+	delete(w.secrets, sub.SinkSecret)
+}
+
+func (w *worker) getSecret(ctx context.Context, name string) (*metadata.Secret, error) {
+	s, err := w.client.GetSecret(ctx, &ctrlpb.GetSecretRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return convert.FromPbSecret(s), nil
+}
+
+func (w *worker) startWatchSecret(ctx context.Context) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		for {
+			err := w.watchSecret(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(secretWatchRetryInterval):
+			}
+			log.Warning(ctx, "watch secret error, retry", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+}
+
+func (w *worker) watchSecret(ctx context.Context) error {
+	stream, err := w.client.WatchSecret(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	// the secrets may be rotated while the stream is broken.
+	w.lock.RLock()
+	names := make([]string, 0, len(w.secrets))
+	for name := range w.secrets {
+		names = append(names, name)
+	}
+	w.lock.RUnlock()
+	for _, name := range names {
+		w.rotateSecret(ctx, name)
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		// the secret referenced by subscriptions can't be deleted, nothing to do.
+		if event.Deleted {
+			continue
+		}
+		w.rotateSecret(ctx, event.Name)
+	}
+}
+
+// rotateSecret changes the sink credential of the triggers which reference the secret if it's rotated.
+func (w *worker) rotateSecret(ctx context.Context, name string) {
+	w.lock.RLock()
+	_, exist := w.secrets[name]
+	w.lock.RUnlock()
+	if !exist {
+		return
+	}
+	// fetch and decrypt the secret without w.lock, so a slow controller doesn't block the
+	// subscriptions being added or removed.
+	s, err := w.getSecret(ctx, name)
+	if err != nil {
+		log.Warning(ctx, "get secret error", map[string]interface{}{
+			log.KeyError: err,
+			"secret":     name,
+		})
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	// the secret may be released or rotated by others while it's fetched.
+	curr, exist := w.secrets[name]
+	if !exist || s.Version <= curr.Version {
+		return
+	}
+	w.secrets[name] = s
+	for id, sub := range w.secretSubscriptions {
+		if sub.SinkSecret != name {
+			continue
+		}
+		changed := *sub
+		changed.SinkCredential = s.Credential
+		t, exist := w.getTrigger(id)
+		if !exist {
+			t, exist = w.getStandby(id)
+		}
+		if exist {
+			if err = t.Change(ctx, &changed); err != nil {
+				log.Warning(ctx, "change sink credential error", map[string]interface{}{
+					log.KeyError:          err,
+					log.KeySubscriptionID: id,
+				})
+				continue
+			}
+		}
+		w.secretSubscriptions[id] = &changed
+	}
+	log.Info(ctx, "secret is rotated", map[string]interface{}{
+		"secret":  name,
+		"version": s.Version,
+	})
+}
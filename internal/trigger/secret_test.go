@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/trigger"
+	"github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/grpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWorker_SinkSecret(t *testing.T) {
+	ctx := context.Background()
+	Convey("test sink secret", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		tg := trigger.NewMockTrigger(ctrl)
+		m := NewWorker(Config{ControllerAddr: []string{"test"}}).(*worker)
+		m.newTrigger = testNewTrigger(tg)
+		triggerClient := controller.NewMockTriggerControllerClient(ctrl)
+		m.client = triggerClient
+		newSecret := func(version uint64, password string) *controller.Secret {
+			return &controller.Secret{
+				Name:    "test",
+				Version: version,
+				Credential: &metapb.SinkCredential{
+					CredentialType: metapb.SinkCredential_PLAIN,
+					Credential: &metapb.SinkCredential_Plain{
+						Plain: &metapb.PlainCredential{Identifier: "user", Secret: password},
+					},
+				},
+			}
+		}
+		tg.EXPECT().Init(gomock.Any()).AnyTimes().Return(nil)
+		tg.EXPECT().Start(gomock.Any()).AnyTimes().Return(nil)
+
+		triggerClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Times(1).Return(newSecret(1, "v1"), nil)
+		id1, id2 := vanus.NewTestID(), vanus.NewTestID()
+		sub1 := &primitive.Subscription{ID: id1, SinkSecret: "test"}
+		So(m.AddSubscription(ctx, sub1), ShouldBeNil)
+		So(sub1.SinkCredential, ShouldResemble, primitive.NewPlainSinkCredential("user", "v1"))
+		sub2 := &primitive.Subscription{ID: id2, SinkSecret: "test"}
+		So(m.AddSubscription(ctx, sub2), ShouldBeNil)
+		So(m.secrets, ShouldHaveLength, 1)
+
+		Convey("test rotate secret", func() {
+			stream := controller.NewMockTriggerController_WatchSecretClient(ctrl)
+			triggerClient.EXPECT().WatchSecret(gomock.Any(), gomock.Any()).Return(stream, nil)
+			gomock.InOrder(
+				triggerClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).Return(newSecret(1, "v1"), nil),
+				triggerClient.EXPECT().GetSecret(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *controller.GetSecretRequest, _ ...grpc.CallOption) (*controller.Secret, error) {
+						// the secret is fetched without holding the lock.
+						if !m.lock.TryLock() {
+							return nil, errors.New("lock is held")
+						}
+						m.lock.Unlock()
+						return newSecret(2, "v2"), nil
+					}),
+			)
+			gomock.InOrder(
+				stream.EXPECT().Recv().Return(&controller.SecretEvent{Name: "test", Version: 2}, nil),
+				stream.EXPECT().Recv().Return(nil, io.EOF),
+			)
+			tg.EXPECT().Change(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+				func(_ context.Context, sub *primitive.Subscription) error {
+					So(sub.SinkCredential, ShouldResemble, primitive.NewPlainSinkCredential("user", "v2"))
+					return nil
+				})
+			So(m.watchSecret(ctx), ShouldEqual, io.EOF)
+			So(m.secrets["test"].Version, ShouldEqual, 2)
+		})
+
+		Convey("test release secret", func() {
+			tg.EXPECT().Stop(gomock.Any()).AnyTimes().Return(nil)
+			tg.EXPECT().GetCommitOffsets(gomock.Any(), true).AnyTimes().Return(nil)
+			So(m.RemoveSubscription(ctx, id1), ShouldBeNil)
+			So(m.secrets, ShouldHaveLength, 1)
+			So(m.RemoveSubscription(ctx, id2), ShouldBeNil)
+			So(m.secrets, ShouldBeEmpty)
+			So(m.secretSubscriptions, ShouldBeEmpty)
+		})
+	})
+}
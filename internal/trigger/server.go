@@ -21,7 +21,10 @@ import (
 
 	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/trigger"
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
@@ -155,8 +158,75 @@ func (s *server) ResetOffsetToTimestamp(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+// TailSubscription streams the results of delivering events of the subscription until the client cancels
+// or the subscription stops.
+func (s *server) TailSubscription(request *pbtrigger.TailSubscriptionRequest,
+	stream pbtrigger.TriggerWorker_TailSubscriptionServer) error {
+	id := vanus.NewIDFromUint64(request.SubscriptionId)
+	w, err := s.worker.WatchDelivery(id)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	for _, r := range w.Recent {
+		if err = stream.Send(toPbDeliveryResult(r)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case r, ok := <-w.C:
+			if !ok {
+				return nil
+			}
+			if err = stream.Send(toPbDeliveryResult(r)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// TraceEvent returns the results of delivering the event to the sink of subscription.
+func (s *server) TraceEvent(_ context.Context,
+	request *pbtrigger.TraceEventRequest) (*pbtrigger.TraceEventResponse, error) {
+	id := vanus.NewIDFromUint64(request.SubscriptionId)
+	results, enabled, err := s.worker.TraceEvent(id, request.EventId)
+	if err != nil {
+		return nil, err
+	}
+	res := &pbtrigger.TraceEventResponse{Enabled: enabled}
+	for _, r := range results {
+		res.Results = append(res.Results, toPbDeliveryResult(r))
+	}
+	return res, nil
+}
+
+func toPbDeliveryResult(r trigger.DeliveryResult) *pbtrigger.DeliveryResult {
+	return &pbtrigger.DeliveryResult{
+		EventId:       r.EventID,
+		StatusCode:    int32(r.StatusCode),
+		Latency:       r.Latency.Microseconds(),
+		RetryAttempts: r.RetryAttempts,
+		Error:         r.Err,
+		Time:          r.Time.UnixMilli(),
+	}
+}
+
 func (s *server) Initialize(ctx context.Context) error {
-	err := s.worker.Init(ctx)
+	if s.config.SchemaRegistry.Endpoint != "" {
+		schema.SetDefaultRegistry(schema.NewCachedRegistry(
+			schema.NewHTTPFetcher(s.config.SchemaRegistry.Endpoint), s.config.SchemaRegistry.CacheTTL))
+	}
+	err := registerSchemas(schema.DefaultRegistry(), s.config.Schemas)
+	if err != nil {
+		log.Error(ctx, "register schema error", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return err
+	}
+	err = s.worker.Init(ctx)
 	if err != nil {
 		log.Error(ctx, "worker init error", map[string]interface{}{
 			log.KeyError: err,
@@ -177,11 +247,18 @@ func (s *server) Initialize(ctx context.Context) error {
 	})
 	s.state = primitive.ServerStateStarted
 	s.startTime = time.Now()
+	health.AddReadinessCheck("state", health.Condition(func() bool {
+		return s.state == primitive.ServerStateStarted || s.state == primitive.ServerStateRunning
+	}))
 	return nil
 }
 
 func (s *server) Close(ctx context.Context) error {
 	log.Info(ctx, "trigger worker server stop...", nil)
+	// the ctx has been canceled by the shutdown signal, the offsets are flushed and the controller is
+	// notified to reassign the subscriptions with a new one.
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.GetDrainTimeout()+notifyTimeout)
+	defer cancel()
 	s.stop(ctx, true)
 	log.Info(ctx, "trigger worker server stopped", nil)
 	return nil
@@ -21,6 +21,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/trigger/trigger"
 	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -93,6 +94,42 @@ func TestServerApi(t *testing.T) {
 	})
 }
 
+func TestServerTailSubscription(t *testing.T) {
+	Convey("test tail subscription", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		w := NewMockWorker(ctrl)
+		s := NewTriggerServer(Config{}).(*server)
+		s.worker = w
+		stream := pbtrigger.NewMockTriggerWorker_TailSubscriptionServer(ctrl)
+		stream.EXPECT().Context().AnyTimes().Return(ctx)
+		Convey("test subscription not exist", func() {
+			w.EXPECT().WatchDelivery(gomock.Any()).Return(nil, fmt.Errorf("test error"))
+			err := s.TailSubscription(&pbtrigger.TailSubscriptionRequest{SubscriptionId: 1}, stream)
+			So(err, ShouldNotBeNil)
+		})
+		Convey("test send results", func() {
+			ch := make(chan trigger.DeliveryResult, 1)
+			ch <- trigger.DeliveryResult{EventID: "2", StatusCode: 500, RetryAttempts: 1}
+			close(ch)
+			w.EXPECT().WatchDelivery(gomock.Any()).Return(&trigger.DeliveryWatcher{
+				Recent: []trigger.DeliveryResult{{EventID: "1", StatusCode: 200}},
+				C:      ch,
+			}, nil)
+			var ids []string
+			stream.EXPECT().Send(gomock.Any()).Times(2).DoAndReturn(func(r *pbtrigger.DeliveryResult) error {
+				ids = append(ids, r.EventId)
+				return nil
+			})
+			err := s.TailSubscription(&pbtrigger.TailSubscriptionRequest{SubscriptionId: 1}, stream)
+			So(err, ShouldBeNil)
+			So(ids, ShouldResemble, []string{"1", "2"})
+		})
+	})
+}
+
 func TestServerInitAndClose(t *testing.T) {
 	Convey("test server init and close", t, func() {
 		ctx := context.Background()
@@ -115,5 +152,45 @@ func TestServerInitAndClose(t *testing.T) {
 			s.stop(ctx, true)
 			So(s.state, ShouldEqual, primitive.ServerStateStopped)
 		})
+		Convey("test close after shutdown signal", func() {
+			canceled, cancel := context.WithCancel(ctx)
+			cancel()
+			w.EXPECT().Stop(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+				So(ctx.Err(), ShouldBeNil)
+				return nil
+			})
+			w.EXPECT().Unregister(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+				So(ctx.Err(), ShouldBeNil)
+				return nil
+			})
+			So(s.Close(canceled), ShouldBeNil)
+			So(s.state, ShouldEqual, primitive.ServerStateStopped)
+		})
+	})
+}
+
+func TestServerTraceEvent(t *testing.T) {
+	Convey("test trace event", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		w := NewMockWorker(ctrl)
+		s := NewTriggerServer(Config{}).(*server)
+		s.worker = w
+		Convey("test subscription not exist", func() {
+			w.EXPECT().TraceEvent(gomock.Any(), "1").Return(nil, false, fmt.Errorf("test error"))
+			_, err := s.TraceEvent(ctx, &pbtrigger.TraceEventRequest{SubscriptionId: 1, EventId: "1"})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("test trace results", func() {
+			w.EXPECT().TraceEvent(gomock.Any(), "1").Return([]trigger.DeliveryResult{
+				{EventID: "1", StatusCode: 500}, {EventID: "1", StatusCode: 200},
+			}, true, nil)
+			res, err := s.TraceEvent(ctx, &pbtrigger.TraceEventRequest{SubscriptionId: 1, EventId: "1"})
+			So(err, ShouldBeNil)
+			So(res.Enabled, ShouldBeTrue)
+			So(res.Results, ShouldHaveLength, 2)
+			So(res.Results[1].StatusCode, ShouldEqual, 200)
+		})
 	})
 }
@@ -0,0 +1,87 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	pbtriggerv2 "github.com/linkall-labs/vanus/proto/pkg/v2/trigger"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewTriggerServerV2 returns the v2 of trigger worker API served by srv, the methods which aren't
+// changed are same as v1, the changed ones are converted to v1.
+func NewTriggerServerV2(srv pbtrigger.TriggerWorkerServer) pbtriggerv2.TriggerWorkerServer {
+	return &serverV2{TriggerWorkerServer: srv}
+}
+
+type serverV2 struct {
+	pbtrigger.TriggerWorkerServer
+}
+
+func (s *serverV2) ResetOffsetToTimestamp(ctx context.Context,
+	request *pbtriggerv2.ResetOffsetToTimestampRequest) (*emptypb.Empty, error) {
+	if request.Time == nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("time is required")
+	}
+	// the timestamp of v1 is in milliseconds.
+	return s.TriggerWorkerServer.ResetOffsetToTimestamp(ctx, &pbtrigger.ResetOffsetToTimestampRequest{
+		SubscriptionId: request.SubscriptionId,
+		Timestamp:      uint64(request.Time.AsTime().UnixMilli()),
+	})
+}
+
+func (s *serverV2) TailSubscription(request *pbtrigger.TailSubscriptionRequest,
+	stream pbtriggerv2.TriggerWorker_TailSubscriptionServer) error {
+	return s.TriggerWorkerServer.TailSubscription(request, tailSubscriptionServerV1{stream})
+}
+
+func (s *serverV2) TraceEvent(ctx context.Context,
+	request *pbtrigger.TraceEventRequest) (*pbtriggerv2.TraceEventResponse, error) {
+	res, err := s.TriggerWorkerServer.TraceEvent(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*pbtriggerv2.DeliveryResult, len(res.Results))
+	for i, r := range res.Results {
+		results[i] = toPbDeliveryResultV2(r)
+	}
+	return &pbtriggerv2.TraceEventResponse{Enabled: res.Enabled, Results: results}, nil
+}
+
+// tailSubscriptionServerV1 sends the delivery results of v1 to the stream of v2.
+type tailSubscriptionServerV1 struct {
+	pbtriggerv2.TriggerWorker_TailSubscriptionServer
+}
+
+func (s tailSubscriptionServerV1) Send(r *pbtrigger.DeliveryResult) error {
+	return s.TriggerWorker_TailSubscriptionServer.Send(toPbDeliveryResultV2(r))
+}
+
+func toPbDeliveryResultV2(r *pbtrigger.DeliveryResult) *pbtriggerv2.DeliveryResult {
+	return &pbtriggerv2.DeliveryResult{
+		EventId:       r.EventId,
+		StatusCode:    r.StatusCode,
+		Latency:       durationpb.New(time.Duration(r.Latency) * time.Microsecond),
+		RetryAttempts: r.RetryAttempts,
+		Error:         r.Error,
+		Time:          timestamppb.New(time.UnixMilli(r.Time)),
+	}
+}
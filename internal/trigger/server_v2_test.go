@@ -0,0 +1,97 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	pbtriggerv2 "github.com/linkall-labs/vanus/proto/pkg/v2/trigger"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestServerV2(t *testing.T) {
+	Convey("test server v2", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		v1 := pbtrigger.NewMockTriggerWorkerServer(ctrl)
+		s := NewTriggerServerV2(v1)
+		now := time.UnixMilli(time.Now().UnixMilli())
+		Convey("test reset offset to timestamp", func() {
+			v1.EXPECT().ResetOffsetToTimestamp(gomock.Any(), &pbtrigger.ResetOffsetToTimestampRequest{
+				SubscriptionId: 1,
+				Timestamp:      uint64(now.UnixMilli()),
+			}).Return(&emptypb.Empty{}, nil)
+			_, err := s.ResetOffsetToTimestamp(ctx, &pbtriggerv2.ResetOffsetToTimestampRequest{
+				SubscriptionId: 1,
+				Time:           timestamppb.New(now),
+			})
+			So(err, ShouldBeNil)
+			_, err = s.ResetOffsetToTimestamp(ctx, &pbtriggerv2.ResetOffsetToTimestampRequest{SubscriptionId: 1})
+			So(err, ShouldNotBeNil)
+		})
+		result := &pbtrigger.DeliveryResult{
+			EventId:       "id",
+			StatusCode:    200,
+			Latency:       1500,
+			RetryAttempts: 1,
+			Time:          now.UnixMilli(),
+		}
+		checkResult := func(r *pbtriggerv2.DeliveryResult) {
+			So(r.EventId, ShouldEqual, "id")
+			So(r.StatusCode, ShouldEqual, 200)
+			So(r.Latency.AsDuration(), ShouldEqual, 1500*time.Microsecond)
+			So(r.RetryAttempts, ShouldEqual, 1)
+			So(r.Time.AsTime().Equal(now), ShouldBeTrue)
+		}
+		Convey("test trace event", func() {
+			v1.EXPECT().TraceEvent(gomock.Any(), gomock.Any()).Return(&pbtrigger.TraceEventResponse{
+				Enabled: true,
+				Results: []*pbtrigger.DeliveryResult{result},
+			}, nil)
+			res, err := s.TraceEvent(ctx, &pbtrigger.TraceEventRequest{})
+			So(err, ShouldBeNil)
+			So(res.Enabled, ShouldBeTrue)
+			So(res.Results, ShouldHaveLength, 1)
+			checkResult(res.Results[0])
+		})
+		Convey("test tail subscription", func() {
+			stream := pbtriggerv2.NewMockTriggerWorker_TailSubscriptionServer(ctrl)
+			var sent *pbtriggerv2.DeliveryResult
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(r *pbtriggerv2.DeliveryResult) error {
+				sent = r
+				return nil
+			})
+			v1.EXPECT().TailSubscription(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *pbtrigger.TailSubscriptionRequest, stream pbtrigger.TriggerWorker_TailSubscriptionServer) error {
+					return stream.Send(result)
+				})
+			err := s.TailSubscription(&pbtrigger.TailSubscriptionRequest{}, stream)
+			So(err, ShouldBeNil)
+			checkResult(sent)
+		})
+		Convey("test unchanged method", func() {
+			v1.EXPECT().AddSubscription(gomock.Any(), gomock.Any()).Return(&pbtrigger.AddSubscriptionResponse{}, nil)
+			_, err := s.AddSubscription(ctx, &pbtrigger.AddSubscriptionRequest{})
+			So(err, ShouldBeNil)
+		})
+	})
+}
@@ -15,11 +15,11 @@
 package transform
 
 import (
-	"encoding/json"
 	"runtime"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/schema"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
 	"github.com/linkall-labs/vanus/internal/trigger/transform/define"
 	"github.com/linkall-labs/vanus/internal/trigger/transform/pipeline"
@@ -31,6 +31,7 @@ type Transformer struct {
 	define   *define.Define
 	pipeline *pipeline.Pipeline
 	template *template.Template
+	emit     context.Emitter
 }
 
 func NewTransformer(transformer *primitive.Transformer) *Transformer {
@@ -48,6 +49,12 @@ func NewTransformer(transformer *primitive.Transformer) *Transformer {
 	return tf
 }
 
+// SetEmitter sets the emitter of the events emitted by actions, such as the aggregate events of
+// windows, they are discarded if it isn't set.
+func (tf *Transformer) SetEmitter(emit context.Emitter) {
+	tf.emit = emit
+}
+
 func (tf *Transformer) Execute(event *ce.Event) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -58,14 +65,14 @@ func (tf *Transformer) Execute(event *ce.Event) (err error) {
 		}
 	}()
 
-	var data interface{}
-	err = json.Unmarshal(event.Data(), &data)
+	data, err := schema.Data(schema.DefaultRegistry(), *event)
 	if err != nil {
 		return err
 	}
 	ceCtx := &context.EventContext{
 		Event: event,
 		Data:  data,
+		Emit:  tf.emit,
 	}
 	defineValue, err := tf.define.EvaluateValue(ceCtx)
 	if err != nil {
@@ -16,6 +16,7 @@ package transform
 
 import (
 	"testing"
+	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/linkall-labs/vanus/internal/primitive"
@@ -79,5 +80,21 @@ func TestExecute(t *testing.T) {
 			So(e.DataContentType(), ShouldEqual, ce.ApplicationJSON)
 			So(string(e.Data()), ShouldEqual, `{"data": "source is \"value\"","data2": "source is \"<noExist>\""}`)
 		})
+		Convey("test execute with emitted events", func() {
+			_ = e.SetData(ce.ApplicationJSON, map[string]interface{}{"key": "value"})
+			input.Pipeline = []*primitive.Action{{Command: []interface{}{"window_count", "agg", "1m"}}}
+			it := NewTransformer(input)
+			var emitted []*ce.Event
+			it.SetEmitter(func(eventbus string, event *ce.Event) {
+				So(eventbus, ShouldEqual, "agg")
+				emitted = append(emitted, event)
+			})
+			now := time.Now()
+			e.SetTime(now.Add(-time.Hour))
+			So(it.Execute(&e), ShouldBeNil)
+			e.SetTime(now)
+			So(it.Execute(&e), ShouldBeNil)
+			So(emitted, ShouldHaveLength, 1)
+		})
 	})
 }
@@ -15,9 +15,15 @@
 package trigger
 
 import (
+	"context"
+	"path/filepath"
 	"time"
 
+	"github.com/linkall-labs/vanus/client/pkg/blob"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
+	"github.com/linkall-labs/vanus/internal/trigger/dedup"
+	"github.com/linkall-labs/vanus/observability/log"
 
 	"go.uber.org/ratelimit"
 )
@@ -27,6 +33,11 @@ const (
 	defaultFilterProcessSize = 2
 	defaultDeliveryTimeout   = 5 * time.Second
 	defaultMaxWriteAttempt   = 3
+	defaultOrderingPartition = 32
+	defaultCommitInterval    = 2 * time.Second
+
+	writeBackoff    = time.Second
+	maxWriteBackoff = 5 * time.Second
 )
 
 type Config struct {
@@ -39,6 +50,39 @@ type Config struct {
 	DeadLetterEventbus string
 	MaxWriteAttempt    int
 	Ordered            bool
+	DedupWindow        time.Duration
+	DedupKeyAttribute  string
+	// DedupDir is the directory the delivered keys of dedup window are saved in, so they are kept after
+	// restart, empty means the keys are only kept in memory.
+	DedupDir string
+	// OrderingKeyAttribute events with same key send in order, different keys send in parallel.
+	OrderingKeyAttribute string
+	OrderingPartition    int
+	// IdempotentDelivery carry idempotency key and skip the delivered events after restart.
+	IdempotentDelivery bool
+	CommitInterval     time.Duration
+	// CommitBatchSize commit offset if the number of committed events reach it even if commit interval not reached.
+	CommitBatchSize  int
+	SyncCommitOnStop bool
+	// BlobStore resolves the data of events offloaded to it, nil means the events are delivered as read.
+	BlobStore blob.Store
+	// CorrelationEventbus the events of it are correlated with the events of subscribed eventbus, empty
+	// means disable, the change of correlation takes effect when the trigger is started again.
+	CorrelationEventbus     string
+	CorrelationKeyAttribute string
+	CorrelationTimeout      time.Duration
+	// CorrelationSpillDir is the directory the pending events beyond CorrelationMaxMemoryEvents are
+	// spilled to, empty means all pending events are kept in memory.
+	CorrelationSpillDir        string
+	CorrelationMaxMemoryEvents int
+	// PoisonThreshold the event is quarantined to dead letter after the number of failed deliveries
+	// while the sink works for the other events, 0 means disable.
+	PoisonThreshold int
+	// ReplyEventbus the event replied by the sink is written to it, empty means the reply is discarded.
+	ReplyEventbus string
+	// EventTraceCapacity the maximum number of events whose delivery results are kept to be looked up
+	// by event id, 0 means the events aren't traced.
+	EventTraceCapacity int
 }
 
 func defaultConfig() Config {
@@ -49,6 +93,9 @@ func defaultConfig() Config {
 		DeliveryTimeout:    defaultDeliveryTimeout,
 		DeadLetterEventbus: primitive.DeadLetterEventbusName,
 		MaxWriteAttempt:    defaultMaxWriteAttempt,
+		OrderingPartition:  defaultOrderingPartition,
+		CommitInterval:     defaultCommitInterval,
+		SyncCommitOnStop:   true,
 	}
 	return c
 }
@@ -124,3 +171,121 @@ func WithDeadLetterEventbus(eventbus string) Option {
 		t.config.DeadLetterEventbus = eventbus
 	}
 }
+
+func WithDedupDir(dir string) Option {
+	return func(t *trigger) {
+		t.config.DedupDir = dir
+	}
+}
+
+// WithDedup the keys are saved in the DedupDir set before it.
+func WithDedup(window uint32, keyAttribute string) Option {
+	return func(t *trigger) {
+		t.config.DedupWindow = time.Duration(window) * time.Millisecond
+		t.config.DedupKeyAttribute = keyAttribute
+		if t.deduplicator != nil {
+			_ = t.deduplicator.Close()
+		}
+		if window == 0 {
+			t.deduplicator = nil
+			return
+		}
+		if t.config.DedupDir == "" {
+			t.deduplicator = dedup.New(t.config.DedupWindow, 0)
+			return
+		}
+		d, err := dedup.Open(filepath.Join(t.config.DedupDir, t.subscriptionIDStr), t.config.DedupWindow, 0)
+		if err != nil {
+			log.Warning(context.Background(), "open dedup dir failed, the keys are only kept in memory",
+				map[string]interface{}{
+					log.KeyError:          err,
+					log.KeySubscriptionID: t.subscriptionIDStr,
+				})
+			d = dedup.New(t.config.DedupWindow, 0)
+		}
+		t.deduplicator = d
+	}
+}
+
+func WithOrderingKey(attribute string) Option {
+	return func(t *trigger) {
+		t.config.OrderingKeyAttribute = attribute
+	}
+}
+
+func WithBlobStore(store blob.Store) Option {
+	return func(t *trigger) {
+		t.config.BlobStore = store
+	}
+}
+
+func WithIdempotentDelivery(enable bool) Option {
+	return func(t *trigger) {
+		t.config.IdempotentDelivery = enable
+		t.offsetManager.SetTrackDelivered(enable)
+	}
+}
+
+func WithOffsetCommit(interval, batchSize uint32, syncOnStop bool) Option {
+	return func(t *trigger) {
+		t.config.CommitInterval = defaultCommitInterval
+		if interval > 0 {
+			t.config.CommitInterval = time.Duration(interval) * time.Millisecond
+		}
+		t.config.CommitBatchSize = int(batchSize)
+		t.config.SyncCommitOnStop = syncOnStop
+	}
+}
+
+func WithCorrelation(eventbus, keyAttribute string, timeout uint32) Option {
+	return func(t *trigger) {
+		t.config.CorrelationEventbus = eventbus
+		t.config.CorrelationKeyAttribute = keyAttribute
+		t.config.CorrelationTimeout = time.Duration(timeout) * time.Millisecond
+	}
+}
+
+func WithCorrelationSpill(dir string, maxMemoryEvents int) Option {
+	return func(t *trigger) {
+		t.config.CorrelationSpillDir = dir
+		t.config.CorrelationMaxMemoryEvents = maxMemoryEvents
+	}
+}
+
+func WithPoisonThreshold(threshold uint32) Option {
+	return func(t *trigger) {
+		t.config.PoisonThreshold = int(threshold)
+		if threshold == 0 {
+			t.poison = nil
+			return
+		}
+		t.poison = newPoisonDetector(int(threshold), 0)
+	}
+}
+
+func WithReplyEventbus(eventbus string) Option {
+	return func(t *trigger) {
+		t.config.ReplyEventbus = eventbus
+	}
+}
+
+// WithFeatureFlags the experimental features are used if they are enabled for the eventbus of
+// subscription, a nil registry uses the builtin defaults.
+func WithFeatureFlags(features *featureflag.Registry) Option {
+	return func(t *trigger) {
+		t.features = features
+	}
+}
+
+func WithEventTrace(capacity int) Option {
+	return func(t *trigger) {
+		t.config.EventTraceCapacity = capacity
+		if capacity <= 0 {
+			t.traces = nil
+			return
+		}
+		if t.traces == nil || cap(t.traces.ids) != capacity {
+			t.traces = newEventTrace(capacity)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/trigger/correlation"
+	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/internal/trigger/reader"
+	"github.com/linkall-labs/vanus/internal/trigger/util"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+const correlationExpireInterval = time.Second
+
+// initCorrelation creates the correlator and the reader of correlation eventbus. The events of both
+// sides are committed once they are correlated or expired, so the pending events are read again
+// after restart.
+func (t *trigger) initCorrelation() error {
+	var spillDir string
+	if t.config.CorrelationSpillDir != "" {
+		spillDir = filepath.Join(t.config.CorrelationSpillDir, t.subscriptionIDStr)
+	}
+	c, err := correlation.New(correlation.Config{
+		Timeout:         t.config.CorrelationTimeout,
+		MaxMemoryEvents: t.config.CorrelationMaxMemoryEvents,
+		SpillDir:        spillDir,
+	})
+	if err != nil {
+		return err
+	}
+	t.correlator = c
+	t.correlationEventCh = make(chan info.EventRecord, t.config.BufferSize)
+	t.correlationEventReader = reader.NewReader(t.getCorrelationEventReaderConfig(), t.correlationEventCh)
+	return nil
+}
+
+func (t *trigger) getCorrelationEventReaderConfig() reader.Config {
+	config := t.getReaderConfig()
+	config.EventBusName = t.config.CorrelationEventbus
+	// the filters and eventlogs of subscription are for the subscribed eventbus.
+	config.Filter = nil
+	config.EventlogFilter = nil
+	return config
+}
+
+func (t *trigger) runCorrelationEvent(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-t.correlationEventCh:
+			if !ok {
+				return
+			}
+			t.offsetManager.EventReceive(event.OffsetInfo)
+			t.correlate(ctx, correlation.Right, event)
+		}
+	}
+}
+
+func (t *trigger) runCorrelationExpire(ctx context.Context) {
+	ticker := time.NewTicker(correlationExpireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			expired := t.correlator.Expire(now)
+			for _, offset := range expired {
+				t.offsetManager.EventCommit(offset)
+			}
+			metrics.TriggerCorrelationEventCounter.WithLabelValues(t.subscriptionIDStr,
+				metrics.LabelValueCorrelationExpired).Add(float64(len(expired)))
+		}
+	}
+}
+
+// correlate buffers the event until the event of the other side with the same key arrives, then
+// sends the merged event.
+func (t *trigger) correlate(ctx context.Context, side correlation.Side, event info.EventRecord) {
+	v, exist := util.LookupAttribute(*event.Event, t.config.CorrelationKeyAttribute)
+	if !exist {
+		t.offsetManager.EventCommit(event.OffsetInfo)
+		metrics.TriggerCorrelationEventCounter.WithLabelValues(t.subscriptionIDStr,
+			metrics.LabelValueCorrelationNoKey).Inc()
+		return
+	}
+	m, err := t.correlator.Add(side, fmt.Sprint(v), event, time.Now())
+	if err != nil {
+		log.Warning(ctx, "correlate event failed", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+			"event":               event.Event,
+		})
+		t.offsetManager.EventCommit(event.OffsetInfo)
+		return
+	}
+	if m == nil {
+		return
+	}
+	metrics.TriggerCorrelationEventCounter.WithLabelValues(t.subscriptionIDStr,
+		metrics.LabelValueCorrelationMatched).Inc()
+	merged, err := correlation.Merge(m, t.subscription.EventBus, t.config.CorrelationEventbus)
+	// the left event is committed after the merged event is sent.
+	t.offsetManager.EventCommit(m.Right.OffsetInfo)
+	if err != nil {
+		log.Warning(ctx, "merge correlated events failed", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+			"key":                 m.Key,
+		})
+		t.offsetManager.EventCommit(m.Left.OffsetInfo)
+		return
+	}
+	select {
+	case t.sendCh <- info.EventRecord{Event: merged, OffsetInfo: m.Left.OffsetInfo}:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultDeliveryHistorySize = 100
+	deliveryWatcherBufferSize  = 128
+)
+
+// DeliveryResult is the result of delivering an event to the sink.
+type DeliveryResult struct {
+	EventID       string
+	StatusCode    int
+	Latency       time.Duration
+	RetryAttempts int32
+	Err           string
+	Time          time.Time
+}
+
+// DeliveryWatcher receives the delivery results of a trigger, Recent is the results before watching.
+// C is closed when the trigger stops.
+type DeliveryWatcher struct {
+	Recent []DeliveryResult
+	C      <-chan DeliveryResult
+	close  func()
+}
+
+func (w *DeliveryWatcher) Close() {
+	if w.close != nil {
+		w.close()
+	}
+}
+
+// deliveryLog keeps the recent delivery results and fans them out to the watchers, a slow watcher misses
+// results instead of blocking the delivery.
+type deliveryLog struct {
+	mu       sync.Mutex
+	history  []DeliveryResult
+	next     int
+	watchers map[chan DeliveryResult]struct{}
+}
+
+func newDeliveryLog(size int) *deliveryLog {
+	return &deliveryLog{
+		history:  make([]DeliveryResult, 0, size),
+		watchers: map[chan DeliveryResult]struct{}{},
+	}
+}
+
+func (l *deliveryLog) record(r DeliveryResult) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.history) < cap(l.history) {
+		l.history = append(l.history, r)
+	} else {
+		l.history[l.next] = r
+		l.next = (l.next + 1) % len(l.history)
+	}
+	for ch := range l.watchers {
+		select {
+		case ch <- r:
+		default:
+		}
+	}
+}
+
+// recent returns the results in the order they were recorded.
+func (l *deliveryLog) recent() []DeliveryResult {
+	results := make([]DeliveryResult, 0, len(l.history))
+	results = append(results, l.history[l.next:]...)
+	return append(results, l.history[:l.next]...)
+}
+
+func (l *deliveryLog) watch() *DeliveryWatcher {
+	ch := make(chan DeliveryResult, deliveryWatcherBufferSize)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.watchers[ch] = struct{}{}
+	return &DeliveryWatcher{
+		Recent: l.recent(),
+		C:      ch,
+		close: func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if _, ok := l.watchers[ch]; ok {
+				delete(l.watchers, ch)
+				close(ch)
+			}
+		},
+	}
+}
+
+// closeWatchers closes the channels of all watchers.
+func (l *deliveryLog) closeWatchers() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.watchers {
+		delete(l.watchers, ch)
+		close(ch)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeliveryLog(t *testing.T) {
+	Convey("test delivery log", t, func() {
+		l := newDeliveryLog(3)
+		Convey("test recent", func() {
+			for i := 0; i < 5; i++ {
+				l.record(DeliveryResult{EventID: fmt.Sprintf("%d", i)})
+			}
+			w := l.watch()
+			defer w.Close()
+			So(w.Recent, ShouldHaveLength, 3)
+			So(w.Recent[0].EventID, ShouldEqual, "2")
+			So(w.Recent[2].EventID, ShouldEqual, "4")
+		})
+		Convey("test watch", func() {
+			w := l.watch()
+			So(w.Recent, ShouldBeEmpty)
+			l.record(DeliveryResult{EventID: "1", StatusCode: 200})
+			r := <-w.C
+			So(r.EventID, ShouldEqual, "1")
+			So(r.StatusCode, ShouldEqual, 200)
+			w.Close()
+			_, ok := <-w.C
+			So(ok, ShouldBeFalse)
+			l.record(DeliveryResult{EventID: "2"})
+		})
+		Convey("test slow watcher", func() {
+			w := l.watch()
+			for i := 0; i < deliveryWatcherBufferSize+1; i++ {
+				l.record(DeliveryResult{})
+			}
+			So(w.C, ShouldHaveLength, deliveryWatcherBufferSize)
+			l.closeWatchers()
+			w.Close()
+		})
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const defaultLatencyWindowSize = 1024
+
+// latencyWindow keeps the latest end-to-end latencies to calculate the percentiles.
+type latencyWindow struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+}
+
+func newLatencyWindow(size int) *latencyWindow {
+	return &latencyWindow{
+		samples: make([]time.Duration, 0, size),
+	}
+}
+
+func (w *latencyWindow) record(d time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.samples) < cap(w.samples) {
+		w.samples = append(w.samples, d)
+		return
+	}
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % len(w.samples)
+}
+
+// percentiles returns the p50 and p99 of the latencies in the window, both are zero if there is no sample.
+func (w *latencyWindow) percentiles() (time.Duration, time.Duration) {
+	w.mu.Lock()
+	sorted := make([]time.Duration, len(w.samples))
+	copy(sorted, w.samples)
+	w.mu.Unlock()
+	if len(sorted) == 0 {
+		return 0, 0
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return percentile(sorted, 50), percentile(sorted, 99)
+}
+
+func percentile(sorted []time.Duration, p int) time.Duration {
+	idx := (len(sorted)*p+99)/100 - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// getBornTime return the time when the event was written to the eventbus at first, the retried event
+// carries it in xvanusborntime, others use the stime of the block.
+func getBornTime(e *ce.Event) (time.Time, bool) {
+	for _, attr := range []string{primitive.XVanusBornTime, segpb.XVanusStime} {
+		v, ok := e.Extensions()[attr]
+		if !ok {
+			continue
+		}
+		if t, err := types.ToTime(v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
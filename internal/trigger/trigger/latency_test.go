@@ -0,0 +1,68 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLatencyWindow(t *testing.T) {
+	Convey("test latency window", t, func() {
+		w := newLatencyWindow(100)
+		p50, p99 := w.percentiles()
+		So(p50, ShouldEqual, 0)
+		So(p99, ShouldEqual, 0)
+		for i := 1; i <= 100; i++ {
+			w.record(time.Duration(i) * time.Millisecond)
+		}
+		p50, p99 = w.percentiles()
+		So(p50, ShouldEqual, 50*time.Millisecond)
+		So(p99, ShouldEqual, 99*time.Millisecond)
+		Convey("test window overwrite oldest", func() {
+			for i := 0; i < 100; i++ {
+				w.record(time.Second)
+			}
+			p50, p99 = w.percentiles()
+			So(p50, ShouldEqual, time.Second)
+			So(p99, ShouldEqual, time.Second)
+		})
+	})
+}
+
+func TestGetBornTime(t *testing.T) {
+	Convey("test get born time", t, func() {
+		e := ce.NewEvent()
+		_, ok := getBornTime(&e)
+		So(ok, ShouldBeFalse)
+		stime := time.UnixMilli(time.Now().UnixMilli())
+		e.SetExtension(segpb.XVanusStime, stime)
+		born, ok := getBornTime(&e)
+		So(ok, ShouldBeTrue)
+		So(born.Equal(stime), ShouldBeTrue)
+		Convey("test retried event use born time", func() {
+			first := stime.Add(-time.Minute)
+			e.SetExtension(primitive.XVanusBornTime, ce.Timestamp{Time: first.UTC()}.Format(time.RFC3339Nano))
+			born, ok = getBornTime(&e)
+			So(ok, ShouldBeTrue)
+			So(born.Equal(first), ShouldBeTrue)
+		})
+	})
+}
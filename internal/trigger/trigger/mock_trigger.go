@@ -50,6 +50,34 @@ func (mr *MockTriggerMockRecorder) Change(ctx, subscription interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockTrigger)(nil).Change), ctx, subscription)
 }
 
+// GetCommitOffsets mocks base method.
+func (m *MockTrigger) GetCommitOffsets(ctx context.Context, stop bool) *OffsetCommit {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitOffsets", ctx, stop)
+	ret0, _ := ret[0].(*OffsetCommit)
+	return ret0
+}
+
+// GetCommitOffsets indicates an expected call of GetCommitOffsets.
+func (mr *MockTriggerMockRecorder) GetCommitOffsets(ctx, stop interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitOffsets", reflect.TypeOf((*MockTrigger)(nil).GetCommitOffsets), ctx, stop)
+}
+
+// GetLoad mocks base method.
+func (m *MockTrigger) GetLoad(ctx context.Context) info.SubscriptionLoad {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoad", ctx)
+	ret0, _ := ret[0].(info.SubscriptionLoad)
+	return ret0
+}
+
+// GetLoad indicates an expected call of GetLoad.
+func (mr *MockTriggerMockRecorder) GetLoad(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoad", reflect.TypeOf((*MockTrigger)(nil).GetLoad), ctx)
+}
+
 // GetOffsets mocks base method.
 func (m *MockTrigger) GetOffsets(ctx context.Context) info.ListOffsetInfo {
 	m.ctrl.T.Helper()
@@ -78,6 +106,32 @@ func (mr *MockTriggerMockRecorder) Init(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockTrigger)(nil).Init), ctx)
 }
 
+// OffsetCommitted mocks base method.
+func (m *MockTrigger) OffsetCommitted(ctx context.Context, commit *OffsetCommit) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OffsetCommitted", ctx, commit)
+}
+
+// OffsetCommitted indicates an expected call of OffsetCommitted.
+func (mr *MockTriggerMockRecorder) OffsetCommitted(ctx, commit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffsetCommitted", reflect.TypeOf((*MockTrigger)(nil).OffsetCommitted), ctx, commit)
+}
+
+// Promote mocks base method.
+func (m *MockTrigger) Promote(ctx context.Context, subscription *primitive.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promote", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Promote indicates an expected call of Promote.
+func (mr *MockTriggerMockRecorder) Promote(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promote", reflect.TypeOf((*MockTrigger)(nil).Promote), ctx, subscription)
+}
+
 // ResetOffsetToTimestamp mocks base method.
 func (m *MockTrigger) ResetOffsetToTimestamp(ctx context.Context, timestamp int64) (info.ListOffsetInfo, error) {
 	m.ctrl.T.Helper()
@@ -120,3 +174,32 @@ func (mr *MockTriggerMockRecorder) Stop(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTrigger)(nil).Stop), ctx)
 }
+
+// TraceEvent mocks base method.
+func (m *MockTrigger) TraceEvent(eventID string) ([]DeliveryResult, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceEvent", eventID)
+	ret0, _ := ret[0].([]DeliveryResult)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// TraceEvent indicates an expected call of TraceEvent.
+func (mr *MockTriggerMockRecorder) TraceEvent(eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceEvent", reflect.TypeOf((*MockTrigger)(nil).TraceEvent), eventID)
+}
+
+// WatchDelivery mocks base method.
+func (m *MockTrigger) WatchDelivery() *DeliveryWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchDelivery")
+	ret0, _ := ret[0].(*DeliveryWatcher)
+	return ret0
+}
+
+// WatchDelivery indicates an expected call of WatchDelivery.
+func (mr *MockTriggerMockRecorder) WatchDelivery() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchDelivery", reflect.TypeOf((*MockTrigger)(nil).WatchDelivery))
+}
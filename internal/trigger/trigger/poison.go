@@ -0,0 +1,124 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/trigger/client"
+)
+
+const (
+	// PoisonMessage is the dead letter reason of the quarantined event.
+	PoisonMessage = "PoisonMessage"
+
+	defaultPoisonCapacity = 1 << 14
+	// maxOrderedRetryInterval limits the backoff of an ordered event retried in place.
+	maxOrderedRetryInterval = 30 * time.Second
+)
+
+type poisonRecord struct {
+	key         string
+	failures    int
+	firstFailed time.Time
+}
+
+// poisonDetector tells the poison events, which fail to be delivered repeatedly while the sink works
+// for the other events. A poison event is quarantined to dead letter so that it doesn't block the
+// events after it, the events failed because the sink is unavailable are retried as usual.
+type poisonDetector struct {
+	threshold int
+	capacity  int
+	records   map[string]*list.Element
+	// front is the oldest.
+	entries     *list.List
+	lastSuccess time.Time
+	lock        sync.Mutex
+}
+
+func newPoisonDetector(threshold, capacity int) *poisonDetector {
+	if capacity <= 0 {
+		capacity = defaultPoisonCapacity
+	}
+	return &poisonDetector{
+		threshold: threshold,
+		capacity:  capacity,
+		records:   make(map[string]*list.Element),
+		entries:   list.New(),
+	}
+}
+
+func poisonKey(e *ce.Event) string {
+	return e.Source() + "/" + e.ID()
+}
+
+// succeeded records the event is delivered.
+func (d *poisonDetector) succeeded(e *ce.Event) {
+	d.succeededAt(e, time.Now())
+}
+
+func (d *poisonDetector) succeededAt(e *ce.Event, now time.Time) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.lastSuccess = now
+	if elem, exist := d.records[poisonKey(e)]; exist {
+		d.remove(elem)
+	}
+}
+
+// failed records a failed delivery of the event and returns true if the event is poison. exclusive
+// means no other events are delivered while the event is failing, so the failures are counted
+// without the health of sink.
+func (d *poisonDetector) failed(e *ce.Event, code int, exclusive bool) bool {
+	return d.failedAt(e, code, exclusive, time.Now())
+}
+
+func (d *poisonDetector) failedAt(e *ce.Event, code int, exclusive bool, now time.Time) bool {
+	if code == client.ErrUndefined {
+		// the sink isn't reachable, it's not the fault of the event.
+		return false
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	key := poisonKey(e)
+	var r *poisonRecord
+	if elem, exist := d.records[key]; exist {
+		r, _ = elem.Value.(*poisonRecord)
+	} else {
+		r = &poisonRecord{key: key, firstFailed: now}
+		d.records[key] = d.entries.PushBack(r)
+		for d.entries.Len() > d.capacity {
+			d.remove(d.entries.Front())
+		}
+	}
+	r.failures++
+	if r.failures < d.threshold {
+		return false
+	}
+	if !exclusive && !d.lastSuccess.After(r.firstFailed) {
+		// the sink doesn't work for any event since the event failed.
+		return false
+	}
+	d.remove(d.records[key])
+	return true
+}
+
+func (d *poisonDetector) remove(elem *list.Element) {
+	r, _ := d.entries.Remove(elem).(*poisonRecord)
+	delete(d.records, r.key)
+}
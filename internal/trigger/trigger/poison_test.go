@@ -0,0 +1,70 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/trigger/client"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPoisonDetector(t *testing.T) {
+	Convey("test poison detector", t, func() {
+		now := time.Now()
+		d := newPoisonDetector(2, 2)
+		newEvent := func(id string) *ce.Event {
+			e := ce.NewEvent()
+			e.SetID(id)
+			e.SetSource("source")
+			return &e
+		}
+		a, b := newEvent("a"), newEvent("b")
+
+		Convey("test poison while the sink works for the others", func() {
+			So(d.failedAt(a, 500, false, now), ShouldBeFalse)
+			d.succeededAt(b, now.Add(time.Second))
+			So(d.failedAt(a, 500, false, now.Add(2*time.Second)), ShouldBeTrue)
+			// the record is removed after the event is told poison.
+			So(d.failedAt(a, 500, false, now.Add(3*time.Second)), ShouldBeFalse)
+		})
+		Convey("test not poison while the sink doesn't work", func() {
+			So(d.failedAt(a, 500, false, now), ShouldBeFalse)
+			So(d.failedAt(b, 500, false, now), ShouldBeFalse)
+			So(d.failedAt(a, 500, false, now.Add(time.Second)), ShouldBeFalse)
+			So(d.failedAt(a, 500, true, now.Add(2*time.Second)), ShouldBeTrue)
+		})
+		Convey("test the sink isn't reachable", func() {
+			d.succeededAt(b, now)
+			So(d.failedAt(a, client.ErrUndefined, true, now.Add(time.Second)), ShouldBeFalse)
+			So(d.failedAt(a, client.ErrUndefined, true, now.Add(2*time.Second)), ShouldBeFalse)
+			So(d.records, ShouldBeEmpty)
+		})
+		Convey("test delivered after failed", func() {
+			So(d.failedAt(a, 500, true, now), ShouldBeFalse)
+			d.succeededAt(a, now)
+			So(d.failedAt(a, 500, true, now), ShouldBeFalse)
+		})
+		Convey("test capacity exceeded", func() {
+			So(d.failedAt(a, 500, true, now), ShouldBeFalse)
+			So(d.failedAt(b, 500, true, now), ShouldBeFalse)
+			So(d.failedAt(newEvent("c"), 500, true, now), ShouldBeFalse)
+			So(d.entries.Len(), ShouldEqual, 2)
+			So(d.failedAt(a, 500, true, now), ShouldBeFalse)
+		})
+	})
+}
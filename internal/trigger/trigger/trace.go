@@ -0,0 +1,62 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import "sync"
+
+const maxTracedResultsPerEvent = 32
+
+// eventTrace keeps the delivery results of the recent events by the id of event, so where an event
+// went can be looked up after it's delivered, the oldest event is evicted when the number of events
+// exceeds the capacity.
+type eventTrace struct {
+	mu      sync.Mutex
+	results map[string][]DeliveryResult
+	ids     []string
+	next    int
+}
+
+func newEventTrace(capacity int) *eventTrace {
+	return &eventTrace{
+		results: map[string][]DeliveryResult{},
+		ids:     make([]string, 0, capacity),
+	}
+}
+
+func (t *eventTrace) record(r DeliveryResult) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	results, exist := t.results[r.EventID]
+	if !exist {
+		if len(t.ids) < cap(t.ids) {
+			t.ids = append(t.ids, r.EventID)
+		} else {
+			delete(t.results, t.ids[t.next])
+			t.ids[t.next] = r.EventID
+			t.next = (t.next + 1) % len(t.ids)
+		}
+	}
+	if len(results) >= maxTracedResultsPerEvent {
+		results = results[1:]
+	}
+	t.results[r.EventID] = append(results, r)
+}
+
+func (t *eventTrace) get(eventID string) []DeliveryResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	results := t.results[eventID]
+	return append(make([]DeliveryResult, 0, len(results)), results...)
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventTrace(t *testing.T) {
+	Convey("test event trace", t, func() {
+		tr := newEventTrace(2)
+		tr.record(DeliveryResult{EventID: "1", StatusCode: 500})
+		tr.record(DeliveryResult{EventID: "1", StatusCode: 200})
+		tr.record(DeliveryResult{EventID: "2", StatusCode: 200})
+		results := tr.get("1")
+		So(results, ShouldHaveLength, 2)
+		So(results[0].StatusCode, ShouldEqual, 500)
+		So(results[1].StatusCode, ShouldEqual, 200)
+
+		Convey("test evict the oldest event", func() {
+			tr.record(DeliveryResult{EventID: "3"})
+			So(tr.get("1"), ShouldBeEmpty)
+			So(tr.get("2"), ShouldHaveLength, 1)
+			So(tr.get("3"), ShouldHaveLength, 1)
+		})
+		Convey("test the results of an event are bounded", func() {
+			for i := 0; i < maxTracedResultsPerEvent; i++ {
+				tr.record(DeliveryResult{EventID: "2", StatusCode: i})
+			}
+			results = tr.get("2")
+			So(results, ShouldHaveLength, maxTracedResultsPerEvent)
+			So(results[maxTracedResultsPerEvent-1].StatusCode, ShouldEqual, maxTracedResultsPerEvent-1)
+		})
+	})
+}
+
+func TestTriggerTraceEvent(t *testing.T) {
+	Convey("test trigger trace event", t, func() {
+		id := vanus.NewTestID()
+		Convey("test trace disabled", func() {
+			tg := NewTrigger(&primitive.Subscription{ID: id}).(*trigger)
+			_, enabled := tg.TraceEvent("1")
+			So(enabled, ShouldBeFalse)
+		})
+		Convey("test trace enabled", func() {
+			tg := NewTrigger(&primitive.Subscription{ID: id}, WithEventTrace(10)).(*trigger)
+			for i := 0; i < 3; i++ {
+				e := makeEventRecord("test").Event
+				e.SetID(fmt.Sprintf("%d", i%2))
+				tg.recordDelivery(e, 500, fmt.Errorf("test error"), 0)
+			}
+			results, enabled := tg.TraceEvent("0")
+			So(enabled, ShouldBeTrue)
+			So(results, ShouldHaveLength, 2)
+			So(results[0].Err, ShouldEqual, "test error")
+			results, _ = tg.TraceEvent("2")
+			So(results, ShouldBeEmpty)
+		})
+	})
+}
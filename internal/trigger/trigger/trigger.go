@@ -17,25 +17,36 @@ package trigger
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
 	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/internal/trigger/correlation"
+	"github.com/linkall-labs/vanus/internal/trigger/dedup"
 	"github.com/linkall-labs/vanus/internal/trigger/filter"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
 	"github.com/linkall-labs/vanus/internal/trigger/offset"
 	"github.com/linkall-labs/vanus/internal/trigger/reader"
 	"github.com/linkall-labs/vanus/internal/trigger/transform"
+	"github.com/linkall-labs/vanus/internal/trigger/util"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
-	"github.com/linkall-labs/vanus/pkg/util"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/retry"
+	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/ratelimit"
 )
 
@@ -56,8 +67,14 @@ type Trigger interface {
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
 	Change(ctx context.Context, subscription *primitive.Subscription) error
+	Promote(ctx context.Context, subscription *primitive.Subscription) error
 	GetOffsets(ctx context.Context) pInfo.ListOffsetInfo
+	GetCommitOffsets(ctx context.Context, stop bool) *OffsetCommit
+	OffsetCommitted(ctx context.Context, commit *OffsetCommit)
+	GetLoad(ctx context.Context) pInfo.SubscriptionLoad
 	ResetOffsetToTimestamp(ctx context.Context, timestamp int64) (pInfo.ListOffsetInfo, error)
+	WatchDelivery() *DeliveryWatcher
+	TraceEvent(eventID string) ([]DeliveryResult, bool)
 }
 
 type trigger struct {
@@ -71,19 +88,38 @@ type trigger struct {
 	eventCli      client.EventClient
 	client        eb.Client
 	filter        filter.Filter
+	pushdown      *segpb.EventFilter
 	transformer   *transform.Transformer
 	rateLimiter   ratelimit.Limiter
+	deduplicator  *dedup.Deduplicator
+	poison        *poisonDetector
 	config        Config
+	load          loadStat
+	commit        commitStat
+	tracer        *tracing.Tracer
+	deliveries    *deliveryLog
+	traces        *eventTrace
+	latencies     *latencyWindow
+	features      *featureflag.Registry
 
 	retryEventCh     chan info.EventRecord
 	retryEventReader reader.Reader
 	timerEventWriter api.BusWriter
 	dlEventWriter    api.BusWriter
 
+	correlator             *correlation.Correlator
+	correlationEventCh     chan info.EventRecord
+	correlationEventReader reader.Reader
+
 	state State
 	stop  context.CancelFunc
 	lock  sync.RWMutex
-	wg    util.Group
+	wg    pkgUtil.Group
+	// deliveryCtx is used by the deliveries, it isn't canceled by stop until the inflight deliveries
+	// are drained or the deadline of stop exceeded.
+	deliveryCtx    context.Context
+	cancelDelivery context.CancelFunc
+	inflight       sync.WaitGroup
 }
 
 func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
@@ -92,11 +128,16 @@ func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
 		config:            defaultConfig(),
 		state:             TriggerCreated,
 		filter:            filter.GetFilter(subscription.Filters),
+		pushdown:          filter.GetPushdownFilter(subscription.Filters),
 		offsetManager:     offset.NewSubscriptionOffset(subscription.ID),
 		subscription:      subscription,
 		subscriptionIDStr: subscription.ID.String(),
-		transformer:       transform.NewTransformer(subscription.Transformer),
+		tracer:            tracing.NewTracer("trigger", trace.SpanKindProducer),
+		deliveries:        newDeliveryLog(defaultDeliveryHistorySize),
+		latencies:         newLatencyWindow(defaultLatencyWindowSize),
 	}
+	t.deliveryCtx, t.cancelDelivery = context.WithCancel(context.Background())
+	t.transformer = t.newTransformer(subscription.Transformer)
 	t.applyOptions(opts...)
 	if t.rateLimiter == nil {
 		t.rateLimiter = ratelimit.NewUnlimited()
@@ -124,14 +165,18 @@ func (t *trigger) getClient() client.EventClient {
 
 func (t *trigger) changeTarget(sink primitive.URI,
 	protocol primitive.Protocol,
-	credential primitive.SinkCredential) error {
-	eventCli := newEventClient(sink, protocol, credential)
+	credential primitive.SinkCredential,
+	setting *primitive.ProtocolSetting) error {
+	eventCli := newEventClient(sink, protocol, credential, setting)
 	t.lock.Lock()
 	defer t.lock.Unlock()
+	// the deliveries using the previous client fail after it's closed, and they are retried.
+	go closeEventClient(t.eventCli)
 	t.eventCli = eventCli
 	t.subscription.Sink = sink
 	t.subscription.Protocol = protocol
 	t.subscription.SinkCredential = credential
+	t.subscription.ProtocolSetting = setting
 	return nil
 }
 
@@ -141,11 +186,29 @@ func (t *trigger) getFilter() filter.Filter {
 	return t.filter
 }
 
+// getPushdownFilter returns nil if the filter pushdown isn't enabled for the eventbus, then the
+// events are only filtered by trigger.
+func (t *trigger) getPushdownFilter() *segpb.EventFilter {
+	t.lock.RLock()
+	pushdown, features := t.pushdown, t.features
+	t.lock.RUnlock()
+	if pushdown == nil {
+		return nil
+	}
+	scope := featureflag.Scope{Eventbus: t.subscription.EventBus}
+	if !features.Enabled(context.Background(), featureflag.FilterPushdown, scope) {
+		return nil
+	}
+	return pushdown
+}
+
 func (t *trigger) changeFilter(filters []*primitive.SubscriptionFilter) {
 	f := filter.GetFilter(filters)
+	pf := filter.GetPushdownFilter(filters)
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	t.filter = f
+	t.pushdown = pf
 	t.subscription.Filters = filters
 }
 
@@ -155,8 +218,16 @@ func (t *trigger) getTransformer() *transform.Transformer {
 	return t.transformer
 }
 
-func (t *trigger) changeTransformer(transformer *primitive.Transformer) {
+func (t *trigger) newTransformer(transformer *primitive.Transformer) *transform.Transformer {
 	trans := transform.NewTransformer(transformer)
+	if trans != nil {
+		trans.SetEmitter(t.writeEmittedEvent)
+	}
+	return trans
+}
+
+func (t *trigger) changeTransformer(transformer *primitive.Transformer) {
+	trans := t.newTransformer(transformer)
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	t.transformer = trans
@@ -175,9 +246,89 @@ func (t *trigger) changeConfig(config primitive.SubscriptionConfig) {
 	if config.GetMaxRetryAttempts() != t.subscription.Config.GetMaxRetryAttempts() {
 		t.applyOptions(WithMaxRetryAttempts(config.GetMaxRetryAttempts()))
 	}
+	if config.DedupWindow != t.subscription.Config.DedupWindow ||
+		config.DedupKeyAttribute != t.subscription.Config.DedupKeyAttribute {
+		t.applyOptions(WithDedup(config.DedupWindow, config.DedupKeyAttribute))
+	}
+	if config.OrderingKeyAttribute != t.subscription.Config.OrderingKeyAttribute {
+		t.applyOptions(WithOrderingKey(config.OrderingKeyAttribute))
+	}
+	if config.IdempotentDelivery != t.subscription.Config.IdempotentDelivery {
+		t.applyOptions(WithIdempotentDelivery(config.IdempotentDelivery))
+	}
+	if config.CommitInterval != t.subscription.Config.CommitInterval ||
+		config.CommitBatchSize != t.subscription.Config.CommitBatchSize ||
+		config.DisableSyncCommitOnStop != t.subscription.Config.DisableSyncCommitOnStop {
+		t.applyOptions(WithOffsetCommit(config.CommitInterval, config.CommitBatchSize, !config.DisableSyncCommitOnStop))
+	}
+	if config.PoisonThreshold != t.subscription.Config.PoisonThreshold {
+		t.applyOptions(WithPoisonThreshold(config.PoisonThreshold))
+	}
+	if config.ReplyEventbus != t.subscription.Config.ReplyEventbus {
+		t.applyOptions(WithReplyEventbus(config.ReplyEventbus))
+	}
 	t.subscription.Config = config
 }
 
+func (t *trigger) getPoisonDetector() *poisonDetector {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.poison
+}
+
+func (t *trigger) getDeduplicator() *dedup.Deduplicator {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.deduplicator
+}
+
+// isDuplicate check the event whether has been sent in the dedup window, otherwise the key of event
+// is reserved and returned, it must be released by dedupDone or dedupForget.
+func (t *trigger) isDuplicate(e *ce.Event) (string, bool) {
+	t.lock.RLock()
+	d := t.deduplicator
+	keyAttribute := t.config.DedupKeyAttribute
+	t.lock.RUnlock()
+	if d == nil {
+		return "", false
+	}
+	key := e.ID()
+	if keyAttribute != "" {
+		v, exist := util.LookupAttribute(*e, keyAttribute)
+		if !exist {
+			return "", false
+		}
+		key = fmt.Sprint(v)
+	}
+	if d.Seen(key) {
+		return "", true
+	}
+	return key, false
+}
+
+// dedupDone records the key after the event is delivered, the events with the key are dropped
+// in the window.
+func (t *trigger) dedupDone(ctx context.Context, key string) {
+	d := t.getDeduplicator()
+	if key == "" || d == nil {
+		return
+	}
+	if err := d.Done(key); err != nil {
+		log.Warning(ctx, "save dedup key failed", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+		})
+	}
+}
+
+// dedupForget releases the key when the delivery failed, the event will be delivered again by retry
+// or after restart, which isn't a duplicate.
+func (t *trigger) dedupForget(key string) {
+	if d := t.getDeduplicator(); key != "" && d != nil {
+		d.Forget(key)
+	}
+}
+
 // eventArrived for test.
 func (t *trigger) eventArrived(ctx context.Context, event info.EventRecord) error {
 	select {
@@ -189,12 +340,21 @@ func (t *trigger) eventArrived(ctx context.Context, event info.EventRecord) erro
 }
 
 func (t *trigger) sendEvent(ctx context.Context, e *ce.Event) (int, error) {
+	// the span of delivery is in the trace carried by the event.
+	ctx, span := t.tracer.Start(tracing.ExtractEvent(ctx, e), "sendEvent")
+	defer span.End()
 	var err error
 	transformer := t.getTransformer()
+	config := t.getConfig()
 	sendEvent := *e
-	if transformer != nil {
+	if transformer != nil || config.IdempotentDelivery {
 		// transform will chang event which lost origin event
 		sendEvent = e.Clone()
+	}
+	if config.IdempotentDelivery {
+		sendEvent.SetExtension(primitive.XVanusIdempotencyKey, idempotencyKey(t.subscriptionIDStr, e))
+	}
+	if transformer != nil {
 		startTime := time.Now()
 		err = transformer.Execute(&sendEvent)
 		metrics.TriggerTransformCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
@@ -202,13 +362,25 @@ func (t *trigger) sendEvent(ctx context.Context, e *ce.Event) (int, error) {
 			return -1, err
 		}
 	}
-	timeoutCtx, cancel := context.WithTimeout(ctx, t.getConfig().DeliveryTimeout)
+	timeoutCtx, cancel := context.WithTimeout(ctx, config.DeliveryTimeout)
 	defer cancel()
 	t.rateLimiter.Take()
 	startTime := time.Now()
-	r := t.getClient().Send(timeoutCtx, sendEvent)
-	if r == client.Success {
+	var r client.Result
+	requester, ok := t.getClient().(client.Requester)
+	if ok && config.ReplyEventbus != "" {
+		r = requester.Request(timeoutCtx, sendEvent)
+	} else {
+		r = t.getClient().Send(timeoutCtx, sendEvent)
+	}
+	if r.Err == nil {
 		metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+		if chaos.Hit(chaos.PointTriggerDuplicateDelivery) {
+			_ = t.getClient().Send(timeoutCtx, sendEvent)
+		}
+		if r.Reply != nil {
+			t.writeReplyEvent(ctx, config.ReplyEventbus, r.Reply)
+		}
 	}
 	return r.StatusCode, r.Err
 }
@@ -256,6 +428,11 @@ func (t *trigger) runEventFilter(ctx context.Context) {
 				return
 			}
 			t.offsetManager.EventReceive(event.OffsetInfo)
+			if t.offsetManager.IsDelivered(event.OffsetInfo) {
+				// the event has been delivered before restart
+				t.offsetManager.EventCommit(event.OffsetInfo)
+				continue
+			}
 			startTime := time.Now()
 			res := filter.Run(t.getFilter(), *event.Event)
 			metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
@@ -263,13 +440,36 @@ func (t *trigger) runEventFilter(ctx context.Context) {
 				t.offsetManager.EventCommit(event.OffsetInfo)
 				continue
 			}
-			t.sendCh <- event
 			metrics.TriggerFilterMatchEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+			key, duplicate := t.isDuplicate(event.Event)
+			if duplicate {
+				t.offsetManager.EventCommit(event.OffsetInfo)
+				metrics.TriggerDedupEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+				continue
+			}
+			if deliveryTime, ok := getDeliveryTime(event.Event); ok && deliveryTime.After(time.Now()) {
+				// the event is not due, park it in timer and it will come back by the retry eventbus
+				t.writeEventToDelay(ctx, event.Event)
+				t.dedupDone(ctx, key)
+				t.offsetManager.EventCommit(event.OffsetInfo)
+				metrics.TriggerDelayEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+				continue
+			}
+			if t.correlator != nil {
+				// the correlated events are merged to a new event, the key is recorded once it's accepted.
+				t.dedupDone(ctx, key)
+				t.correlate(ctx, correlation.Left, event)
+				continue
+			}
+			event.DedupKey = key
+			t.sendCh <- event
 		}
 	}
 }
 
 func (t *trigger) runEventSend(ctx context.Context) {
+	// partitions is created when the first event with ordering key arrived
+	var partitions []chan info.EventRecord
 	for {
 		select {
 		case <-ctx.Done():
@@ -278,44 +478,200 @@ func (t *trigger) runEventSend(ctx context.Context) {
 			if !ok {
 				return
 			}
-			if t.config.Ordered {
-				t.processEvent(ctx, event)
-			} else {
-				go func(event info.EventRecord) {
-					t.processEvent(ctx, event)
-				}(event)
+			config := t.getConfig()
+			if config.Ordered {
+				t.processEvent(t.deliveryCtx, event, true)
+				continue
+			}
+			if config.OrderingKeyAttribute != "" {
+				if key, exist := getOrderingKey(event.Event, config.OrderingKeyAttribute); exist {
+					if partitions == nil {
+						partitions = t.startOrderingPartitions(ctx, config.OrderingPartition)
+					}
+					select {
+					case partitions[orderingPartition(key, len(partitions))] <- event:
+					case <-ctx.Done():
+						return
+					}
+					continue
+				}
 			}
+			t.inflight.Add(1)
+			go func(event info.EventRecord) {
+				defer t.inflight.Done()
+				t.processEvent(t.deliveryCtx, event, false)
+			}(event)
 		}
 	}
 }
 
-func (t *trigger) processEvent(ctx context.Context, event info.EventRecord) {
-	code, err := t.sendEvent(ctx, event.Event)
+// startOrderingPartitions start goroutines which send events in the order they arrived,
+// one partition has many ordering keys.
+func (t *trigger) startOrderingPartitions(ctx context.Context, size int) []chan info.EventRecord {
+	if size <= 0 {
+		size = defaultOrderingPartition
+	}
+	partitions := make([]chan info.EventRecord, size)
+	for i := range partitions {
+		ch := make(chan info.EventRecord, t.config.BufferSize/size+1)
+		partitions[i] = ch
+		t.wg.StartWithContext(ctx, func(ctx context.Context) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event := <-ch:
+					t.processEvent(t.deliveryCtx, event, true)
+				}
+			}
+		})
+	}
+	return partitions
+}
+
+func (t *trigger) processEvent(ctx context.Context, event info.EventRecord, ordered bool) {
+	atomic.AddInt64(&t.load.sendingNum, 1)
+	defer func() {
+		atomic.AddInt64(&t.load.sendingNum, -1)
+		atomic.AddUint64(&t.load.sentNum, 1)
+	}()
+	ctx = client.WithEventOffset(ctx, event.EventLogID, event.Offset)
+	code, err := t.deliverEvent(ctx, event.Event)
+	var poison bool
+	if detector := t.getPoisonDetector(); err != nil && detector != nil {
+		if ordered {
+			code, poison, err = t.retryOrderedEvent(ctx, detector, event.Event, code, err)
+		} else {
+			poison = detector.failed(event.Event, code, false)
+		}
+	}
+	if err != nil && ctx.Err() != nil {
+		// the delivery is canceled by stop, the offset isn't committed and the event is delivered again
+		// after restart.
+		t.dedupForget(event.DedupKey)
+		return
+	}
 	if err != nil {
+		t.dedupForget(event.DedupKey)
 		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventFail).Inc()
 		log.Info(ctx, "send event fail", map[string]interface{}{
 			log.KeyError: err,
 			"event":      event.Event,
 		})
-		if t.config.Ordered {
+		switch {
+		case poison:
+			t.quarantineEvent(ctx, event.Event, err)
+		case ordered:
 			// ordered event no need retry direct into dead letter
-			code = NoNeedRetryCode
+			t.writeFailEvent(ctx, event.Event, NoNeedRetryCode, err)
+		default:
+			t.writeFailEvent(ctx, event.Event, code, err)
 		}
-		t.writeFailEvent(ctx, event.Event, code, err)
 	} else {
 		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventSuccess).Inc()
+		t.dedupDone(ctx, event.DedupKey)
+		t.recordLatency(event.Event)
 		log.Debug(ctx, "send event success", map[string]interface{}{
 			"event": event.Event,
 		})
 	}
 	t.offsetManager.EventCommit(event.OffsetInfo)
 }
+
+// deliverEvent sends the event to the sink once and records the result.
+func (t *trigger) deliverEvent(ctx context.Context, e *ce.Event) (int, error) {
+	startTime := time.Now()
+	code, err := t.sendEvent(ctx, e)
+	t.recordDelivery(e, code, err, time.Since(startTime))
+	if detector := t.getPoisonDetector(); err == nil && detector != nil {
+		detector.succeeded(e)
+	}
+	return code, err
+}
+
+// retryOrderedEvent retries the failed ordered event in place, so the events after it keep waiting,
+// until it's delivered, it's told poison or it can't be retried.
+func (t *trigger) retryOrderedEvent(ctx context.Context, detector *poisonDetector, e *ce.Event,
+	code int, err error) (int, bool, error) {
+	// the events of ordering key partitions are delivered at the same time, so the health of sink
+	// is known by them.
+	exclusive := t.getConfig().Ordered
+	for attempts := int32(1); ; attempts++ {
+		if needRetry, _ := isShouldRetry(code); !needRetry {
+			return code, false, err
+		}
+		if detector.failed(e, code, exclusive) {
+			return code, true, err
+		}
+		interval := calDeliveryTime(attempts)
+		if interval > maxOrderedRetryInterval {
+			interval = maxOrderedRetryInterval
+		}
+		select {
+		case <-ctx.Done():
+			return code, false, err
+		case <-time.After(interval):
+		}
+		if code, err = t.deliverEvent(ctx, e); err == nil {
+			return code, false, nil
+		}
+	}
+}
+
+func (t *trigger) recordDelivery(e *ce.Event, code int, err error, latency time.Duration) {
+	r := DeliveryResult{
+		EventID:    e.ID(),
+		StatusCode: code,
+		Latency:    latency,
+		Time:       time.Now(),
+	}
+	if v, ok := e.Extensions()[primitive.XVanusRetryAttempts]; ok {
+		r.RetryAttempts, _ = getRetryAttempts(v)
+	}
+	if err != nil {
+		r.Err = err.Error()
+	}
+	t.deliveries.record(r)
+	if t.traces != nil {
+		t.traces.record(r)
+	}
+}
+
+// recordLatency records the end-to-end latency from the event was written to the eventbus to the sink acks it.
+func (t *trigger) recordLatency(e *ce.Event) {
+	born, ok := getBornTime(e)
+	if !ok {
+		return
+	}
+	latency := time.Since(born)
+	t.latencies.record(latency)
+	metrics.TriggerDeliveryLatencySecond.WithLabelValues(t.subscriptionIDStr).Observe(latency.Seconds())
+}
+
+// WatchDelivery watches the results of delivering events to the sink.
+func (t *trigger) WatchDelivery() *DeliveryWatcher {
+	return t.deliveries.watch()
+}
+
+// TraceEvent returns the results of delivering the event, false means the events aren't traced.
+func (t *trigger) TraceEvent(eventID string) ([]DeliveryResult, bool) {
+	if t.traces == nil {
+		return nil, false
+	}
+	return t.traces.get(eventID), true
+}
+
 func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, sendErr error) {
 	needRetry, reason := isShouldRetry(code)
 	ec, _ := e.Context.(*ce.EventContextV1)
 	if ec.Extensions == nil {
 		ec.Extensions = make(map[string]interface{})
 	}
+	if _, ok := ec.Extensions[primitive.XVanusBornTime]; !ok {
+		if born, ok := getBornTime(e); ok {
+			ec.Extensions[primitive.XVanusBornTime] = ce.Timestamp{Time: born.UTC()}.Format(time.RFC3339Nano)
+		}
+	}
 	attempts := int32(0)
 	if needRetry {
 		// get attempts
@@ -344,52 +700,107 @@ func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, sen
 	metrics.TriggerRetryEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
 }
 
+// quarantineEvent writes the poison event to dead letter, the events after it are delivered as usual.
+func (t *trigger) quarantineEvent(ctx context.Context, e *ce.Event, sendErr error) {
+	log.Warning(ctx, "quarantine poison event", map[string]interface{}{
+		log.KeySubscriptionID: t.subscription.ID,
+		"event_id":            e.ID(),
+		"event_source":        e.Source(),
+	})
+	t.writeEventToDeadLetter(ctx, e, PoisonMessage, sendErr.Error())
+	metrics.TriggerDeadLetterEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+	metrics.TriggerPoisonEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+}
+
 func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts int32) {
 	ec, _ := e.Context.(*ce.EventContextV1)
 	attempts++
 	ec.Extensions[primitive.XVanusRetryAttempts] = attempts
 	delayTime := calDeliveryTime(attempts)
 	ec.Extensions[primitive.XVanusDeliveryTime] = ce.Timestamp{Time: time.Now().Add(delayTime).UTC()}.Format(time.RFC3339)
+	startTime := time.Now()
+	t.writeEventToTimer(ctx, e)
+	metrics.TriggerRetryEventAppendSecond.WithLabelValues(t.subscriptionIDStr).
+		Observe(time.Since(startTime).Seconds())
+}
+
+// writeEventToDelay park the event which delivery time is in the future, it keeps the delivery time and
+// retry attempts of the event.
+func (t *trigger) writeEventToDelay(ctx context.Context, e *ce.Event) {
+	ec, _ := e.Context.(*ce.EventContextV1)
+	t.writeEventToTimer(ctx, e)
+	log.Debug(ctx, "write delay event", map[string]interface{}{
+		log.KeySubscriptionID: t.subscription.ID,
+		"delivery_time":       ec.Extensions[primitive.XVanusDeliveryTime],
+	})
+}
+
+// writeEventToTimer write event to timer, the timer will write it to retry eventbus when it's delivery time.
+func (t *trigger) writeEventToTimer(ctx context.Context, e *ce.Event) {
+	ec, _ := e.Context.(*ce.EventContextV1)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
 	ec.Extensions[primitive.XVanusEventbus] = primitive.RetryEventbusName
-	var writeAttempt int
-	for {
-		writeAttempt++
-		startTime := time.Now()
+	err := retry.Do(ctx, t.writePolicy("trigger_write_timer"), func(ctx context.Context, attempt int) error {
 		_, err := t.timerEventWriter.AppendOne(ctx, e)
-		metrics.TriggerRetryEventAppendSecond.WithLabelValues(t.subscriptionIDStr).
-			Observe(time.Since(startTime).Seconds())
 		if err != nil {
-			log.Info(ctx, "write retry event error", map[string]interface{}{
+			log.Info(ctx, "write timer event error", map[string]interface{}{
 				log.KeyError:          err,
 				log.KeySubscriptionID: t.subscription.ID,
-				"attempt":             writeAttempt,
+				"attempt":             attempt,
 				"event":               e,
 			})
-			if writeAttempt >= t.config.MaxWriteAttempt {
-				return
-			}
-			time.Sleep(time.Second)
-		} else {
-			break
 		}
+		return err
+	})
+	if err != nil {
+		return
 	}
-	log.Debug(ctx, "write retry event success", map[string]interface{}{
+	log.Debug(ctx, "write timer event success", map[string]interface{}{
 		log.KeyEventlogID: t.subscription.ID,
 		"event":           e,
 	})
 }
 
+// writeEmittedEvent writes the event emitted by the transformer, such as the aggregate event of a
+// window, to the eventbus.
+func (t *trigger) writeEmittedEvent(eventbus string, e *ce.Event) {
+	t.writeEventToEventbus(context.Background(), eventbus, e)
+}
+
+// writeReplyEvent writes the event replied by the sink to the reply eventbus.
+func (t *trigger) writeReplyEvent(ctx context.Context, eventbus string, e *ce.Event) {
+	t.writeEventToEventbus(ctx, eventbus, e)
+	metrics.TriggerReplyEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
+}
+
+func (t *trigger) writeEventToEventbus(ctx context.Context, eventbus string, e *ce.Event) {
+	e.SetExtension(primitive.XVanusSubscriptionID, t.subscriptionIDStr)
+	_ = retry.Do(ctx, t.writePolicy("trigger_write_eventbus"), func(ctx context.Context, attempt int) error {
+		_, err := t.client.Eventbus(ctx, eventbus).Writer().AppendOne(ctx, e)
+		if err != nil {
+			log.Info(ctx, "write event to eventbus error", map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: t.subscription.ID,
+				log.KeyEventbusName:   eventbus,
+				"attempt":             attempt,
+				"event":               e,
+			})
+		}
+		return err
+	})
+}
+
 func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reason, errorMsg string) {
 	ec, _ := e.Context.(*ce.EventContextV1)
+	if ec.Extensions == nil {
+		ec.Extensions = make(map[string]interface{})
+	}
 	delete(ec.Extensions, primitive.XVanusEventbus)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
 	ec.Extensions[primitive.LastDeliveryTime] = ce.Timestamp{Time: time.Now().UTC()}.Format(time.RFC3339)
 	ec.Extensions[primitive.LastDeliveryError] = errorMsg
 	ec.Extensions[primitive.DeadLetterReason] = reason
-	var writeAttempt int
-	for {
-		writeAttempt++
+	err := retry.Do(ctx, t.writePolicy("trigger_write_dead_letter"), func(ctx context.Context, attempt int) error {
 		startTime := time.Now()
 		_, err := t.dlEventWriter.AppendOne(ctx, e)
 		metrics.TriggerDeadLetterEventAppendSecond.WithLabelValues(t.subscriptionIDStr).
@@ -398,16 +809,14 @@ func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reaso
 			log.Info(ctx, "write dl event error", map[string]interface{}{
 				log.KeyError:          err,
 				log.KeySubscriptionID: t.subscription.ID,
-				"attempt":             writeAttempt,
+				"attempt":             attempt,
 				"event":               e,
 			})
-			if writeAttempt >= t.config.MaxWriteAttempt {
-				return
-			}
-			time.Sleep(time.Second)
-		} else {
-			break
 		}
+		return err
+	})
+	if err != nil {
+		return
 	}
 	log.Debug(ctx, "write dl event success", map[string]interface{}{
 		log.KeyEventlogID: t.subscription.ID,
@@ -415,6 +824,18 @@ func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reaso
 	})
 }
 
+// writePolicy is how the events written by the trigger are retried, the backoff is jittered so the
+// triggers failed at the same time don't retry at the same time.
+func (t *trigger) writePolicy(name string) retry.Policy {
+	return retry.Policy{
+		Name:        name,
+		Initial:     writeBackoff,
+		Max:         maxWriteBackoff,
+		Jitter:      retry.EqualJitter,
+		MaxAttempts: t.config.MaxWriteAttempt,
+	}
+}
+
 func (t *trigger) getReaderConfig() reader.Config {
 	controllers := t.config.Controllers
 	sub := t.subscription
@@ -422,6 +843,8 @@ func (t *trigger) getReaderConfig() reader.Config {
 	if sub.Config.OffsetTimestamp != nil {
 		offsetTimestamp = int64(*sub.Config.OffsetTimestamp)
 	}
+	// the change of eventlogs takes effect when the trigger is started again.
+	config := sub.Config
 	return reader.Config{
 		EventBusName:    sub.EventBus,
 		Controllers:     controllers,
@@ -430,6 +853,9 @@ func (t *trigger) getReaderConfig() reader.Config {
 		OffsetType:      sub.Config.OffsetType,
 		OffsetTimestamp: offsetTimestamp,
 		Offset:          getOffset(t.offsetManager, sub),
+		Filter:          t.getPushdownFilter,
+		BlobStore:       t.config.BlobStore,
+		EventlogFilter:  config.ContainsEventlog,
 	}
 }
 
@@ -444,6 +870,7 @@ func (t *trigger) getRetryEventReaderConfig() reader.Config {
 		SubscriptionID: sub.ID,
 		OffsetType:     primitive.LatestOffset,
 		Offset:         getOffset(t.offsetManager, sub),
+		BlobStore:      t.config.BlobStore,
 	}
 }
 
@@ -463,7 +890,8 @@ func getOffset(subscriptionOffset *offset.SubscriptionOffset, sub *primitive.Sub
 }
 
 func (t *trigger) Init(ctx context.Context) error {
-	t.eventCli = newEventClient(t.subscription.Sink, t.subscription.Protocol, t.subscription.SinkCredential)
+	t.eventCli = newEventClient(t.subscription.Sink, t.subscription.Protocol, t.subscription.SinkCredential,
+		t.subscription.ProtocolSetting)
 	t.client = eb.Connect(t.config.Controllers)
 
 	t.timerEventWriter = t.client.Eventbus(ctx, primitive.TimerEventbusName).Writer()
@@ -474,6 +902,12 @@ func (t *trigger) Init(ctx context.Context) error {
 	t.retryEventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.retryEventReader = reader.NewReader(t.getRetryEventReaderConfig(), t.retryEventCh)
 	t.offsetManager.Clear()
+	if t.config.IdempotentDelivery {
+		t.offsetManager.SetDelivered(t.subscription.Offsets)
+	}
+	if t.config.CorrelationEventbus != "" {
+		return t.initCorrelation()
+	}
 	return nil
 }
 
@@ -483,6 +917,7 @@ func (t *trigger) Start(ctx context.Context) error {
 	})
 	ctx, cancel := context.WithCancel(context.Background())
 	t.stop = cancel
+	t.deliveryCtx, t.cancelDelivery = context.WithCancel(context.Background())
 	// eb event
 	_ = t.reader.Start()
 	for i := 0; i < t.config.FilterProcessSize; i++ {
@@ -492,6 +927,12 @@ func (t *trigger) Start(ctx context.Context) error {
 	// retry event
 	_ = t.retryEventReader.Start()
 	t.wg.StartWithContext(ctx, t.runRetryEventFilter)
+	// correlation event
+	if t.correlator != nil {
+		_ = t.correlationEventReader.Start()
+		t.wg.StartWithContext(ctx, t.runCorrelationEvent)
+		t.wg.StartWithContext(ctx, t.runCorrelationExpire)
+	}
 	t.state = TriggerRunning
 	log.Info(ctx, "trigger started", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -506,13 +947,27 @@ func (t *trigger) Stop(ctx context.Context) error {
 	if t.state == TriggerStopped {
 		return nil
 	}
-	t.stop()
+	// stop pulling events first.
 	t.reader.Close()
 	t.retryEventReader.Close()
+	if t.correlator != nil {
+		t.correlationEventReader.Close()
+	}
+	t.stop()
+	t.drain(ctx)
 	t.wg.Wait()
 	close(t.eventCh)
 	close(t.sendCh)
 	close(t.retryEventCh)
+	if t.correlator != nil {
+		close(t.correlationEventCh)
+		_ = t.correlator.Close()
+	}
+	t.deliveries.closeWatchers()
+	if d := t.getDeduplicator(); d != nil {
+		_ = d.Close()
+	}
+	closeEventClient(t.getClient())
 	t.state = TriggerStopped
 	log.Info(ctx, "trigger stopped", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -520,11 +975,33 @@ func (t *trigger) Stop(ctx context.Context) error {
 	return nil
 }
 
+// drain waits the inflight deliveries until the deadline of ctx, the deliveries not completed are canceled.
+func (t *trigger) drain(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		t.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Warning(ctx, "inflight deliveries are canceled when trigger stop", map[string]interface{}{
+			log.KeySubscriptionID: t.subscription.ID,
+			"inflight":            atomic.LoadInt64(&t.load.sendingNum),
+		})
+	}
+	t.cancelDelivery()
+	<-done
+}
+
 func (t *trigger) Change(ctx context.Context, subscription *primitive.Subscription) error {
 	if t.subscription.Sink != subscription.Sink ||
 		t.subscription.Protocol != subscription.Protocol ||
-		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) {
-		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential)
+		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) ||
+		!reflect.DeepEqual(t.subscription.ProtocolSetting, subscription.ProtocolSetting) {
+		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential,
+			subscription.ProtocolSetting)
 		if err != nil {
 			return err
 		}
@@ -541,6 +1018,27 @@ func (t *trigger) Change(ctx context.Context, subscription *primitive.Subscripti
 	return nil
 }
 
+// Promote starts the standby trigger which has been initialized, the readers are created again
+// from the offsets of subscription because the ones known at init are stale.
+func (t *trigger) Promote(ctx context.Context, subscription *primitive.Subscription) error {
+	if err := t.Change(ctx, subscription); err != nil {
+		return err
+	}
+	t.lock.Lock()
+	t.subscription.Offsets = subscription.Offsets
+	t.lock.Unlock()
+	t.offsetManager.Clear()
+	if t.config.IdempotentDelivery {
+		t.offsetManager.SetDelivered(subscription.Offsets)
+	}
+	t.reader = reader.NewReader(t.getReaderConfig(), t.eventCh)
+	t.retryEventReader = reader.NewReader(t.getRetryEventReaderConfig(), t.retryEventCh)
+	if t.correlator != nil {
+		t.correlationEventReader = reader.NewReader(t.getCorrelationEventReaderConfig(), t.correlationEventCh)
+	}
+	return t.Start(ctx)
+}
+
 func (t *trigger) ResetOffsetToTimestamp(ctx context.Context, timestamp int64) (pInfo.ListOffsetInfo, error) {
 	offsets, err := t.reader.GetOffsetByTimestamp(ctx, timestamp)
 	if err != nil {
@@ -555,3 +1053,84 @@ func (t *trigger) ResetOffsetToTimestamp(ctx context.Context, timestamp int64) (
 func (t *trigger) GetOffsets(ctx context.Context) pInfo.ListOffsetInfo {
 	return t.offsetManager.GetCommit()
 }
+
+// OffsetCommit is the offsets need commit to controller.
+type OffsetCommit struct {
+	Offsets pInfo.ListOffsetInfo
+	count   uint64
+}
+
+// commitStat record the last offset commit to controller.
+type commitStat struct {
+	lock  sync.Mutex
+	count uint64
+	time  time.Time
+}
+
+// GetCommitOffsets return nil if no need commit now, the offsets need commit when commit interval
+// or commit batch size is reached. If stop is true, it returns the offsets not committed without
+// waiting, but return nil if sync commit on stop is disabled.
+func (t *trigger) GetCommitOffsets(ctx context.Context, stop bool) *OffsetCommit {
+	config := t.getConfig()
+	if stop && !config.SyncCommitOnStop {
+		return nil
+	}
+	count := t.offsetManager.CommitCount()
+	t.commit.lock.Lock()
+	defer t.commit.lock.Unlock()
+	pending := count - t.commit.count
+	if pending == 0 {
+		return nil
+	}
+	if !stop && time.Since(t.commit.time) < config.CommitInterval &&
+		(config.CommitBatchSize <= 0 || pending < uint64(config.CommitBatchSize)) {
+		return nil
+	}
+	return &OffsetCommit{
+		Offsets: t.offsetManager.GetCommit(),
+		count:   count,
+	}
+}
+
+// OffsetCommitted is called after the offsets which got from GetCommitOffsets commit to controller success.
+func (t *trigger) OffsetCommitted(ctx context.Context, commit *OffsetCommit) {
+	t.commit.lock.Lock()
+	defer t.commit.lock.Unlock()
+	if commit.count > t.commit.count {
+		t.commit.count = commit.count
+	}
+	t.commit.time = time.Now()
+}
+
+// loadStat record delivered event number to calculate event rate between two GetLoad.
+type loadStat struct {
+	sentNum    uint64
+	sendingNum int64
+	lock       sync.Mutex
+	lastNum    uint64
+	lastTime   time.Time
+}
+
+// GetLoad event rate is the average since last call.
+func (t *trigger) GetLoad(ctx context.Context) pInfo.SubscriptionLoad {
+	now := time.Now()
+	sentNum := atomic.LoadUint64(&t.load.sentNum)
+	var rate float64
+	t.load.lock.Lock()
+	if !t.load.lastTime.IsZero() {
+		if d := now.Sub(t.load.lastTime).Seconds(); d > 0 {
+			rate = float64(sentNum-t.load.lastNum) / d
+		}
+	}
+	t.load.lastNum, t.load.lastTime = sentNum, now
+	t.load.lock.Unlock()
+	p50, p99 := t.latencies.percentiles()
+	return pInfo.SubscriptionLoad{
+		SubscriptionID:     t.subscription.ID,
+		EventRate:          rate,
+		Inflight:           uint64(atomic.LoadInt64(&t.load.sendingNum)) + uint64(len(t.sendCh)),
+		Lag:                uint64(len(t.eventCh) + len(t.retryEventCh)),
+		DeliveryLatencyP50: p50,
+		DeliveryLatencyP99: p99,
+	}
+}
@@ -33,6 +33,7 @@ import (
 	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/internal/trigger/correlation"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
 	"github.com/linkall-labs/vanus/internal/trigger/reader"
 	. "github.com/smartystreets/goconvey/convey"
@@ -70,6 +71,98 @@ func TestTrigger_Options(t *testing.T) {
 		So(tg.config.DeadLetterEventbus, ShouldEqual, primitive.DeadLetterEventbusName)
 		WithDeadLetterEventbus("test_eb")(tg)
 		So(tg.config.DeadLetterEventbus, ShouldEqual, "test_eb")
+		WithDedup(0, "")(tg)
+		So(tg.deduplicator, ShouldBeNil)
+		WithDedup(1000, "orderid")(tg)
+		So(tg.deduplicator, ShouldNotBeNil)
+		So(tg.config.DedupWindow, ShouldEqual, time.Second)
+		So(tg.config.DedupKeyAttribute, ShouldEqual, "orderid")
+	})
+}
+
+func TestTrigger_IsDuplicate(t *testing.T) {
+	Convey("test trigger is duplicate", t, func() {
+		tg := &trigger{}
+		e := ce.NewEvent()
+		e.SetID(uuid.NewString())
+		isDuplicate := func() bool {
+			_, duplicate := tg.isDuplicate(&e)
+			return duplicate
+		}
+		So(isDuplicate(), ShouldBeFalse)
+		So(isDuplicate(), ShouldBeFalse)
+		Convey("test dedup by id", func() {
+			WithDedup(1000, "")(tg)
+			key, duplicate := tg.isDuplicate(&e)
+			So(duplicate, ShouldBeFalse)
+			So(key, ShouldEqual, e.ID())
+			So(isDuplicate(), ShouldBeTrue)
+			Convey("test forget when delivery failed", func() {
+				tg.dedupForget(key)
+				So(isDuplicate(), ShouldBeFalse)
+			})
+			Convey("test done when delivery succeeded", func() {
+				tg.dedupDone(context.Background(), key)
+				tg.dedupForget(key)
+				So(isDuplicate(), ShouldBeTrue)
+			})
+		})
+		Convey("test dedup by attribute", func() {
+			WithDedup(1000, "orderid")(tg)
+			So(isDuplicate(), ShouldBeFalse)
+			So(isDuplicate(), ShouldBeFalse)
+			e.SetExtension("orderid", "1")
+			So(isDuplicate(), ShouldBeFalse)
+			e.SetID(uuid.NewString())
+			So(isDuplicate(), ShouldBeTrue)
+		})
+		Convey("test dedup dir", func() {
+			dir := t.TempDir()
+			tg.subscriptionIDStr = vanus.NewTestID().String()
+			WithDedupDir(dir)(tg)
+			WithDedup(1000, "")(tg)
+			key, _ := tg.isDuplicate(&e)
+			tg.dedupDone(context.Background(), key)
+			// the key is loaded from disk by the new deduplicator.
+			WithDedup(2000, "")(tg)
+			So(isDuplicate(), ShouldBeTrue)
+			So(tg.deduplicator.Close(), ShouldBeNil)
+		})
+	})
+}
+
+func TestTrigger_Correlate(t *testing.T) {
+	Convey("test trigger correlate", t, func() {
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithCorrelation("payments", "orderid", 1000)).(*trigger)
+		So(tg.config.CorrelationTimeout, ShouldEqual, time.Second)
+		So(tg.initCorrelation(), ShouldBeNil)
+		defer func() {
+			_ = tg.correlator.Close()
+		}()
+		tg.sendCh = make(chan info.EventRecord, 10)
+		newRecord := func(offset uint64, orderID string) info.EventRecord {
+			e := ce.NewEvent()
+			e.SetID(uuid.NewString())
+			if orderID != "" {
+				e.SetExtension("orderid", orderID)
+			}
+			r := info.EventRecord{Event: &e, OffsetInfo: pInfo.OffsetInfo{EventLogID: id, Offset: offset}}
+			tg.offsetManager.EventReceive(r.OffsetInfo)
+			return r
+		}
+
+		tg.correlate(ctx, correlation.Left, newRecord(0, ""))
+		tg.correlate(ctx, correlation.Left, newRecord(1, "1"))
+		So(tg.sendCh, ShouldBeEmpty)
+		So(tg.correlator.Len(), ShouldEqual, 1)
+		tg.correlate(ctx, correlation.Right, newRecord(2, "1"))
+		So(tg.sendCh, ShouldHaveLength, 1)
+		merged := <-tg.sendCh
+		So(merged.Offset, ShouldEqual, 1)
+		So(merged.Event.Extensions()["orderid"], ShouldEqual, "1")
+		So(tg.correlator.Len(), ShouldEqual, 0)
 	})
 }
 
@@ -102,6 +195,66 @@ func TestTriggerStartStop(t *testing.T) {
 		So(tg.state, ShouldEqual, TriggerRunning)
 		r.EXPECT().Close().Return()
 		r2.EXPECT().Close().Return()
+
+		cli := client.NewMockEventClient(ctrl)
+		tg.eventCli = cli
+		Convey("test drain inflight deliveries", func() {
+			var delivered int64
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(context.Context, ce.Event) client.Result {
+					time.Sleep(100 * time.Millisecond)
+					atomic.AddInt64(&delivered, 1)
+					return client.Success
+				})
+			tg.sendCh <- makeEventRecord("test")
+			time.Sleep(10 * time.Millisecond)
+			_ = tg.Stop(ctx)
+			So(tg.state, ShouldEqual, TriggerStopped)
+			So(atomic.LoadInt64(&delivered), ShouldEqual, 1)
+		})
+		Convey("test cancel inflight deliveries after deadline", func() {
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(ctx context.Context, _ ce.Event) client.Result {
+					<-ctx.Done()
+					return client.Result{StatusCode: client.ErrUndefined, Err: ctx.Err()}
+				})
+			tg.sendCh <- makeEventRecord("test")
+			time.Sleep(10 * time.Millisecond)
+			stopCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			_ = tg.Stop(stopCtx)
+			So(tg.state, ShouldEqual, TriggerStopped)
+			So(tg.GetLoad(ctx).Inflight, ShouldEqual, 0)
+		})
+	})
+}
+
+func TestTriggerPromote(t *testing.T) {
+	Convey("test promote standby trigger", t, func() {
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"})).(*trigger)
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(api.NewMockBusWriter(ctrl))
+		mockEventbus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return(nil, nil)
+		tg.client = mockClient
+		err := tg.Init(ctx)
+		So(err, ShouldBeNil)
+		So(tg.state, ShouldEqual, TriggerCreated)
+
+		sub := makeSubscription(id)
+		sub.Sink = "http://localhost:8081"
+		elID := vanus.NewTestID()
+		sub.Offsets = pInfo.ListOffsetInfo{{EventLogID: elID, Offset: 100}}
+		err = tg.Promote(ctx, sub)
+		So(err, ShouldBeNil)
+		So(tg.state, ShouldEqual, TriggerRunning)
+		So(tg.subscription.Sink, ShouldEqual, sub.Sink)
+		So(getOffset(tg.offsetManager, tg.subscription)[elID], ShouldEqual, 100)
 		_ = tg.Stop(ctx)
 		So(tg.state, ShouldEqual, TriggerStopped)
 	})
@@ -160,6 +313,99 @@ func TestTriggerWriteFailEvent(t *testing.T) {
 			So(e.Event.Extensions()[primitive.XVanusRetryAttempts], ShouldEqual, strconv.Itoa(attempts))
 			So(e.Event.Extensions()[primitive.DeadLetterReason], ShouldNotBeNil)
 		})
+		Convey("test delay event,in timer", func() {
+			deliveryTime := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+			e.Event.SetExtension(primitive.XVanusDeliveryTime, deliveryTime)
+			tg.writeEventToDelay(ctx, e.Event)
+			So(e.Event.Extensions()[primitive.XVanusRetryAttempts], ShouldBeNil)
+			So(e.Event.Extensions()[primitive.XVanusDeliveryTime], ShouldEqual, deliveryTime)
+			So(e.Event.Extensions()[primitive.XVanusEventbus], ShouldEqual, primitive.RetryEventbusName)
+			So(e.Event.Extensions()[primitive.XVanusSubscriptionID], ShouldEqual, id.String())
+		})
+	})
+}
+
+func TestTriggerQuarantinePoisonEvent(t *testing.T) {
+	Convey("test quarantine poison event", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		dlWriter := api.NewMockBusWriter(ctrl)
+		timerWriter := api.NewMockBusWriter(ctrl)
+		var deadLetters []*ce.Event
+		dlWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+				deadLetters = append(deadLetters, e)
+				return "", nil
+			})
+		timerWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).AnyTimes().Return("", nil)
+		poison := makeEventRecord("test")
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, e ce.Event) client.Result {
+				if e.ID() == poison.Event.ID() {
+					return client.Result{StatusCode: 500, Err: fmt.Errorf("500 error")}
+				}
+				return client.Success
+			})
+		newTrigger := func(opts ...Option) *trigger {
+			tg := NewTrigger(makeSubscription(id), append(opts, WithPoisonThreshold(2))...).(*trigger)
+			tg.eventCli = cli
+			tg.dlEventWriter = dlWriter
+			tg.timerEventWriter = timerWriter
+			return tg
+		}
+
+		Convey("test unordered event", func() {
+			tg := newTrigger()
+			tg.processEvent(ctx, poison, false)
+			So(deadLetters, ShouldBeEmpty)
+			So(poison.Event.Extensions()[primitive.XVanusRetryAttempts], ShouldEqual, 1)
+			tg.processEvent(ctx, makeEventRecord("test"), false)
+			tg.processEvent(ctx, poison, false)
+			So(deadLetters, ShouldHaveLength, 1)
+			So(deadLetters[0].Extensions()[primitive.DeadLetterReason], ShouldEqual, PoisonMessage)
+		})
+		Convey("test ordered event", func() {
+			tg := newTrigger(WithOrdered(true))
+			tg.processEvent(ctx, poison, true)
+			So(deadLetters, ShouldHaveLength, 1)
+			So(deadLetters[0].Extensions()[primitive.DeadLetterReason], ShouldEqual, PoisonMessage)
+		})
+	})
+}
+
+func TestTriggerWriteReplyEvent(t *testing.T) {
+	Convey("test write reply event", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithReplyEventbus("replies")).(*trigger)
+		requester := client.NewMockRequester(ctrl)
+		tg.eventCli = &struct {
+			*client.MockEventClient
+			*client.MockRequester
+		}{client.NewMockEventClient(ctrl), requester}
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), "replies").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+		tg.client = mockClient
+
+		reply := ce.NewEvent()
+		reply.SetID("reply")
+		requester.EXPECT().Request(gomock.Any(), gomock.Any()).Times(1).Return(client.Result{Reply: &reply})
+		mockBusWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ context.Context, e *ce.Event, _ ...api.WriteOption) (string, error) {
+				So(e.ID(), ShouldEqual, "reply")
+				So(e.Extensions()[primitive.XVanusSubscriptionID], ShouldEqual, id.String())
+				return "", nil
+			})
+		_, err := tg.sendEvent(ctx, makeEventRecord("test").Event)
+		So(err, ShouldBeNil)
 	})
 }
 
@@ -211,6 +457,182 @@ func TestTriggerRunEventSend(t *testing.T) {
 	})
 }
 
+func TestTriggerRunEventSendWithOrderingKey(t *testing.T) {
+	Convey("test event run process with ordering key", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"}),
+			WithOrderingKey("orderkey")).(*trigger)
+		tg.eventCli = cli
+		tg.sendCh = make(chan info.EventRecord, 100)
+		var lock sync.Mutex
+		received := map[string][]int{}
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, event ce.Event) client.Result {
+				time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+				key, _ := event.Extensions()["orderkey"].(string)
+				seq, _ := event.Extensions()["seq"].(int32)
+				lock.Lock()
+				received[key] = append(received[key], int(seq))
+				lock.Unlock()
+				return client.Success
+			})
+		size := 20
+		for i := 0; i < size; i++ {
+			e := makeEventRecord("test")
+			e.Event.SetExtension("orderkey", strconv.Itoa(i%2))
+			e.Event.SetExtension("seq", i)
+			tg.sendCh <- e
+		}
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tg.runEventSend(ctx)
+		}()
+		time.Sleep(300 * time.Millisecond)
+		close(tg.sendCh)
+		wg.Wait()
+		cancel()
+		tg.wg.Wait()
+		lock.Lock()
+		defer lock.Unlock()
+		So(received, ShouldHaveLength, 2)
+		for key, seqs := range received {
+			So(seqs, ShouldHaveLength, size/2)
+			for i := 1; i < len(seqs); i++ {
+				So(seqs[i], ShouldBeGreaterThan, seqs[i-1])
+			}
+			So(seqs[0]%2, ShouldEqual, key[0]-'0')
+		}
+	})
+}
+
+func TestTriggerIdempotentDelivery(t *testing.T) {
+	Convey("test trigger idempotent delivery", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		eventLogID := vanus.NewTestID()
+		sub := makeSubscription(id)
+		sub.Offsets = pInfo.ListOffsetInfo{{EventLogID: eventLogID, Offset: 1, Delivered: []uint64{2}}}
+		tg := NewTrigger(sub, WithControllers([]string{"test"}), WithIdempotentDelivery(true)).(*trigger)
+		So(tg.config.IdempotentDelivery, ShouldBeTrue)
+		mockClient := eb.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), gomock.Any()).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(api.NewMockBusWriter(ctrl))
+		mockEventbus.EXPECT().Reader().AnyTimes().Return(api.NewMockBusReader(ctrl))
+		tg.client = mockClient
+		_ = tg.Init(ctx)
+		tg.eventCli = cli
+		Convey("test skip delivered event", func() {
+			delivered := makeEventRecord("test")
+			delivered.OffsetInfo = pInfo.OffsetInfo{EventLogID: eventLogID, Offset: 2}
+			notDelivered := makeEventRecord("test")
+			notDelivered.OffsetInfo = pInfo.OffsetInfo{EventLogID: eventLogID, Offset: 3}
+			_ = tg.eventArrived(ctx, delivered)
+			_ = tg.eventArrived(ctx, notDelivered)
+			close(tg.eventCh)
+			tg.runEventFilter(ctx)
+			So(len(tg.sendCh), ShouldEqual, 1)
+			So((<-tg.sendCh).OffsetInfo.Offset, ShouldEqual, 3)
+		})
+		Convey("test send with idempotency key", func() {
+			record := makeEventRecord("test")
+			var key interface{}
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+				func(_ context.Context, e ce.Event) client.Result {
+					if key == nil {
+						key = e.Extensions()[primitive.XVanusIdempotencyKey]
+					}
+					So(e.Extensions()[primitive.XVanusIdempotencyKey], ShouldEqual, key)
+					return client.Success
+				})
+			_, err := tg.sendEvent(ctx, record.Event)
+			So(err, ShouldBeNil)
+			_, err = tg.sendEvent(ctx, record.Event)
+			So(err, ShouldBeNil)
+			So(key, ShouldEqual, idempotencyKey(tg.subscriptionIDStr, record.Event))
+			So(record.Event.Extensions(), ShouldNotContainKey, primitive.XVanusIdempotencyKey)
+		})
+	})
+}
+
+func TestTriggerGetCommitOffsets(t *testing.T) {
+	Convey("test trigger get commit offsets", t, func() {
+		ctx := context.Background()
+		tg := NewTrigger(makeSubscription(vanus.NewTestID()), WithOffsetCommit(60*1000, 3, true)).(*trigger)
+		So(tg.config.CommitInterval, ShouldEqual, time.Minute)
+		So(tg.config.CommitBatchSize, ShouldEqual, 3)
+		eventLogID := vanus.NewTestID()
+		commitEvents := func(begin, end uint64) {
+			for i := begin; i < end; i++ {
+				offsetInfo := pInfo.OffsetInfo{EventLogID: eventLogID, Offset: i}
+				tg.offsetManager.EventReceive(offsetInfo)
+				tg.offsetManager.EventCommit(offsetInfo)
+			}
+		}
+		So(tg.GetCommitOffsets(ctx, false), ShouldBeNil)
+		So(tg.GetCommitOffsets(ctx, true), ShouldBeNil)
+		// first commit not wait interval
+		commitEvents(0, 1)
+		commit := tg.GetCommitOffsets(ctx, false)
+		So(commit, ShouldNotBeNil)
+		So(commit.Offsets[0].Offset, ShouldEqual, 1)
+		tg.OffsetCommitted(ctx, commit)
+		commitEvents(1, 3)
+		So(tg.GetCommitOffsets(ctx, false), ShouldBeNil)
+		commit = tg.GetCommitOffsets(ctx, true)
+		So(commit, ShouldNotBeNil)
+		So(commit.Offsets[0].Offset, ShouldEqual, 3)
+		// batch size reached
+		commitEvents(3, 4)
+		commit = tg.GetCommitOffsets(ctx, false)
+		So(commit, ShouldNotBeNil)
+		So(commit.Offsets[0].Offset, ShouldEqual, 4)
+		tg.OffsetCommitted(ctx, commit)
+		So(tg.GetCommitOffsets(ctx, false), ShouldBeNil)
+		WithOffsetCommit(0, 0, false)(tg)
+		So(tg.config.CommitInterval, ShouldEqual, defaultCommitInterval)
+		commitEvents(4, 5)
+		So(tg.GetCommitOffsets(ctx, true), ShouldBeNil)
+	})
+}
+
+func TestTriggerGetLoad(t *testing.T) {
+	Convey("test trigger get load", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithControllers([]string{"test"})).(*trigger)
+		tg.eventCli = cli
+		tg.eventCh = make(chan info.EventRecord, 10)
+		tg.sendCh = make(chan info.EventRecord, 10)
+		cli.EXPECT().Send(gomock.Any(), gomock.Any()).AnyTimes().Return(client.Success)
+		load := tg.GetLoad(ctx)
+		So(load.SubscriptionID, ShouldEqual, id)
+		So(load.EventRate, ShouldEqual, 0)
+		for i := 0; i < 5; i++ {
+			tg.processEvent(ctx, makeEventRecord("test"), false)
+		}
+		tg.eventCh <- makeEventRecord("test")
+		tg.sendCh <- makeEventRecord("test")
+		time.Sleep(10 * time.Millisecond)
+		load = tg.GetLoad(ctx)
+		So(load.EventRate, ShouldBeGreaterThan, 0)
+		So(load.Inflight, ShouldEqual, 1)
+		So(load.Lag, ShouldEqual, 1)
+	})
+}
+
 func TestTriggerRateLimit(t *testing.T) {
 	Convey("test rate limit", t, func() {
 		ctrl := gomock.NewController(t)
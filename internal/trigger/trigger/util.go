@@ -15,18 +15,26 @@
 package trigger
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash/fnv"
+	"io"
 	"math"
 	"strconv"
 	"time"
 
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/internal/trigger/util"
 )
 
 func newEventClient(sink primitive.URI,
 	protocol primitive.Protocol,
-	credential primitive.SinkCredential) client.EventClient {
+	credential primitive.SinkCredential,
+	setting *primitive.ProtocolSetting) client.EventClient {
 	switch protocol {
 	case primitive.AwsLambdaProtocol:
 		_credential, _ := credential.(*primitive.AkSkSinkCredential)
@@ -34,11 +42,43 @@ func newEventClient(sink primitive.URI,
 	case primitive.GCloudFunctions:
 		_credential, _ := credential.(*primitive.GCloudSinkCredential)
 		return client.NewGCloudFunctionClient(string(sink), _credential.CredentialJSON)
+	case primitive.AMQPProtocol:
+		if _credential, ok := credential.(*primitive.PlainSinkCredential); ok {
+			return client.NewAMQPClient(string(sink), _credential.Identifier, _credential.Secret)
+		}
+		return client.NewAMQPClient(string(sink), "", "")
+	case primitive.ElasticsearchProtocol:
+		if _credential, ok := credential.(*primitive.PlainSinkCredential); ok {
+			return client.NewElasticsearchClient(string(sink), _credential.Identifier, _credential.Secret)
+		}
+		return client.NewElasticsearchClient(string(sink), "", "")
+	case primitive.ObjectStorageProtocol:
+		_credential, _ := credential.(*primitive.AkSkSinkCredential)
+		return client.NewObjectStorageClient(string(sink), _credential.AccessKeyID, _credential.SecretAccessKey)
+	case primitive.SQLProtocol:
+		if _credential, ok := credential.(*primitive.PlainSinkCredential); ok {
+			return client.NewSQLClient(string(sink), _credential.Identifier, _credential.Secret)
+		}
+		return client.NewSQLClient(string(sink), "", "")
+	case primitive.ChatProtocol:
+		chat := &primitive.ChatSetting{}
+		if setting != nil && setting.Chat != nil {
+			chat = setting.Chat
+		}
+		return client.NewChatClient(string(sink), chat.MessageTemplate,
+			time.Duration(chat.CoalesceWindow)*time.Millisecond, int(chat.MessagesPerMinute))
 	default:
 		return client.NewHTTPClient(string(sink))
 	}
 }
 
+// closeEventClient releases the resources of the client if it holds any, e.g. the connection pool of sql.
+func closeEventClient(c client.EventClient) {
+	if closer, ok := c.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
+
 const NoNeedRetryCode = -1
 
 func isShouldRetry(statusCode int) (bool, string) {
@@ -71,6 +111,39 @@ func calDeliveryTime(attempts int32) time.Duration {
 	return time.Duration(v) * time.Second
 }
 
+// getDeliveryTime return the delivery time of the event if it has xvanusdeliverytime.
+func getDeliveryTime(e *ce.Event) (time.Time, bool) {
+	v, ok := e.Extensions()[primitive.XVanusDeliveryTime]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := types.ToTime(v)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func getOrderingKey(e *ce.Event, attribute string) (string, bool) {
+	v, exist := util.LookupAttribute(*e, attribute)
+	if !exist || v == nil {
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
+
+// idempotencyKey is same for the event no matter how many times it is delivered to the subscription.
+func idempotencyKey(subscriptionID string, e *ce.Event) string {
+	h := sha256.Sum256([]byte(subscriptionID + "/" + e.Source() + "/" + e.ID()))
+	return hex.EncodeToString(h[:])
+}
+
+func orderingPartition(key string, size int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(size))
+}
+
 func getRetryAttempts(attempts interface{}) (int32, error) {
 	switch v := attempts.(type) {
 	case int32:
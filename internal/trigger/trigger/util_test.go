@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/linkall-labs/vanus/internal/primitive"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -27,12 +28,12 @@ func TestNewEventClient(t *testing.T) {
 	Convey("test new event client", t, func() {
 		Convey("new lambda client", func() {
 			cli := newEventClient("test", primitive.AwsLambdaProtocol,
-				primitive.NewAkSkSinkCredential("ak", "sk"))
+				primitive.NewAkSkSinkCredential("ak", "sk"), nil)
 			So(cli, ShouldNotBeNil)
 		})
 		Convey("new http client", func() {
 			cli := newEventClient("test", primitive.HTTPProtocol,
-				primitive.NewPlainSinkCredential("identifier", "secret"))
+				primitive.NewPlainSinkCredential("identifier", "secret"), nil)
 			So(cli, ShouldNotBeNil)
 		})
 	})
@@ -75,3 +76,48 @@ func TestCalDeliveryTime(t *testing.T) {
 		So(d, ShouldEqual, time.Second*3600)
 	})
 }
+
+func TestGetDeliveryTime(t *testing.T) {
+	Convey("test get delivery time", t, func() {
+		e := ce.NewEvent()
+		_, ok := getDeliveryTime(&e)
+		So(ok, ShouldBeFalse)
+		e.SetExtension(primitive.XVanusDeliveryTime, "invalid")
+		_, ok = getDeliveryTime(&e)
+		So(ok, ShouldBeFalse)
+		now := time.Now().Truncate(time.Second)
+		e.SetExtension(primitive.XVanusDeliveryTime, now.UTC().Format(time.RFC3339))
+		v, ok := getDeliveryTime(&e)
+		So(ok, ShouldBeTrue)
+		So(v.Equal(now), ShouldBeTrue)
+	})
+}
+
+func TestOrderingPartition(t *testing.T) {
+	Convey("test ordering partition", t, func() {
+		e := ce.NewEvent()
+		_, ok := getOrderingKey(&e, "orderkey")
+		So(ok, ShouldBeFalse)
+		e.SetExtension("orderkey", 100)
+		key, ok := getOrderingKey(&e, "orderkey")
+		So(ok, ShouldBeTrue)
+		So(key, ShouldEqual, "100")
+		p := orderingPartition(key, 32)
+		So(p, ShouldBeBetweenOrEqual, 0, 31)
+		So(orderingPartition(key, 32), ShouldEqual, p)
+	})
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	Convey("test idempotency key", t, func() {
+		e := ce.NewEvent()
+		e.SetID("id")
+		e.SetSource("source")
+		key := idempotencyKey("sub", &e)
+		So(key, ShouldHaveLength, 64)
+		So(idempotencyKey("sub", &e), ShouldEqual, key)
+		So(idempotencyKey("sub2", &e), ShouldNotEqual, key)
+		e.SetID("id2")
+		So(idempotencyKey("sub", &e), ShouldNotEqual, key)
+	})
+}
@@ -18,14 +18,23 @@ package trigger
 import (
 	"context"
 	"io"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/blob"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/chaos"
+	"github.com/linkall-labs/vanus/internal/primitive/featureflag"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/connector"
 	"github.com/linkall-labs/vanus/internal/trigger/trigger"
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/cluster"
@@ -46,10 +55,16 @@ type Worker interface {
 	PauseSubscription(ctx context.Context, id vanus.ID) error
 	StartSubscription(ctx context.Context, id vanus.ID) error
 	ResetOffsetToTimestamp(ctx context.Context, id vanus.ID, timestamp int64) error
+	WatchDelivery(id vanus.ID) (*trigger.DeliveryWatcher, error)
+	TraceEvent(id vanus.ID, eventID string) ([]trigger.DeliveryResult, bool, error)
 }
 
 const (
 	defaultHeartbeatInterval = 2 * time.Second
+	// commitCheckInterval is the interval to check which trigger need commit offset.
+	commitCheckInterval = 100 * time.Millisecond
+	// notifyTimeout is the timeout of committing offsets and unregistering from controller when stop.
+	notifyTimeout = 10 * time.Second
 )
 
 type newTrigger func(subscription *primitive.Subscription,
@@ -57,6 +72,9 @@ type newTrigger func(subscription *primitive.Subscription,
 
 type worker struct {
 	triggerMap map[vanus.ID]trigger.Trigger
+	// standbyMap is the triggers which are initialized but not started, one of them is started at once
+	// when its subscription is added without standby.
+	standbyMap map[vanus.ID]trigger.Trigger
 	ctx        context.Context
 	stop       context.CancelFunc
 	config     Config
@@ -66,6 +84,12 @@ type worker struct {
 	tgLock     sync.RWMutex
 	client     ctrlpb.TriggerControllerClient
 	ctrl       cluster.Cluster
+	blobStore  blob.Store
+	features   *featureflag.Registry
+	// secrets is the cached secrets which the subscriptions in secretSubscriptions reference.
+	secrets             map[string]*metadata.Secret
+	secretSubscriptions map[vanus.ID]*primitive.Subscription
+	connectors          *connector.Runtime
 }
 
 func NewWorker(config Config) Worker {
@@ -77,9 +101,14 @@ func NewWorker(config Config) Worker {
 		config:     config,
 		ctrl:       cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
 		triggerMap: make(map[vanus.ID]trigger.Trigger),
+		standbyMap: make(map[vanus.ID]trigger.Trigger),
 		newTrigger: trigger.NewTrigger,
+
+		secrets:             make(map[string]*metadata.Secret),
+		secretSubscriptions: make(map[vanus.ID]*primitive.Subscription),
 	}
 	m.client = m.ctrl.TriggerService().RawClient()
+	m.features = featureflag.NewRegistry(config.FeatureFlags, m.ctrl.EventbusService().RawClient())
 	m.ctx, m.stop = context.WithCancel(context.Background())
 	return m
 }
@@ -103,9 +132,39 @@ func (w *worker) deleteTrigger(id vanus.ID) {
 	delete(w.triggerMap, id)
 }
 
+func (w *worker) getStandby(id vanus.ID) (trigger.Trigger, bool) {
+	w.tgLock.RLock()
+	defer w.tgLock.RUnlock()
+	t, exist := w.standbyMap[id]
+	return t, exist
+}
+
+func (w *worker) addStandby(id vanus.ID, t trigger.Trigger) {
+	w.tgLock.Lock()
+	defer w.tgLock.Unlock()
+	w.standbyMap[id] = t
+}
+
+func (w *worker) deleteStandby(id vanus.ID) bool {
+	w.tgLock.Lock()
+	defer w.tgLock.Unlock()
+	_, exist := w.standbyMap[id]
+	delete(w.standbyMap, id)
+	return exist
+}
+
 func (w *worker) Init(ctx context.Context) error {
 	err := w.ctrl.WaitForControllerReady(false)
-	return err
+	if err != nil {
+		return err
+	}
+	health.AddReadinessCheck("controller", health.Condition(func() bool {
+		return w.ctrl.IsReady(false)
+	}))
+	if w.blobStore, err = primitive.NewBlobStore(w.config.BlobDir, w.config.BlobS3); err != nil {
+		return err
+	}
+	return nil
 }
 
 func (w *worker) Register(ctx context.Context) error {
@@ -123,23 +182,38 @@ func (w *worker) Unregister(ctx context.Context) error {
 }
 
 func (w *worker) Start(ctx context.Context) error {
+	if len(w.config.Connector.Plugins) > 0 {
+		w.connectors = connector.NewRuntime(w.config.Connector, w.config.TriggerAddr,
+			w.client, eb.Connect(w.config.ControllerAddr))
+		if err := w.connectors.Start(w.ctx); err != nil {
+			return err
+		}
+	}
+	w.startCommit(w.ctx)
+	w.startWatchSecret(w.ctx)
 	return w.startHeartbeat(w.ctx)
 }
 
 func (w *worker) Stop(ctx context.Context) error {
 	var wg sync.WaitGroup
-	// stop subscription
+	// stop subscription, they stop pulling events at once and wait the inflight deliveries until
+	// drain timeout.
+	drainCtx, cancel := context.WithTimeout(ctx, w.config.GetDrainTimeout())
 	for id, t := range w.triggerMap {
 		wg.Add(1)
 		go func(id vanus.ID, t trigger.Trigger) {
 			defer wg.Done()
-			_ = t.Stop(ctx)
+			_ = t.Stop(drainCtx)
 		}(id, t)
 	}
 
 	wg.Wait()
+	cancel()
+	if w.connectors != nil {
+		w.connectors.Stop(ctx)
+	}
 	// commit offset
-	err := w.commitOffsets(ctx)
+	err := w.commitOffsets(ctx, true)
 	if err != nil {
 		log.Error(ctx, "commit offsets error", map[string]interface{}{
 			log.KeyError: err,
@@ -151,6 +225,9 @@ func (w *worker) Stop(ctx context.Context) error {
 	for id := range w.triggerMap {
 		delete(w.triggerMap, id)
 	}
+	for id := range w.standbyMap {
+		delete(w.standbyMap, id)
+	}
 	w.wg.Wait()
 	if closer, ok := w.client.(io.Closer); ok {
 		_ = closer.Close()
@@ -161,30 +238,75 @@ func (w *worker) Stop(ctx context.Context) error {
 func (w *worker) AddSubscription(ctx context.Context, subscription *primitive.Subscription) error {
 	w.lock.Lock()
 	defer w.lock.Unlock()
+	if err := w.resolveSinkSecret(ctx, subscription); err != nil {
+		return err
+	}
+	if subscription.Standby {
+		return w.addStandbySubscription(ctx, subscription)
+	}
 	t, exist := w.getTrigger(subscription.ID)
 	if exist {
 		err := t.Change(ctx, subscription)
 		return err
 	}
+	if t, exist = w.getStandby(subscription.ID); exist {
+		// take over the subscription from the standby trigger.
+		w.deleteStandby(subscription.ID)
+		err := t.Promote(w.ctx, subscription)
+		if err != nil {
+			return err
+		}
+		log.Info(ctx, "standby subscription is promoted", map[string]interface{}{
+			log.KeySubscriptionID: subscription.ID,
+		})
+	} else {
+		t = w.newTrigger(subscription, w.getTriggerOptions(subscription)...)
+		err := t.Init(ctx)
+		if err != nil {
+			return err
+		}
+		err = t.Start(w.ctx)
+		if err != nil {
+			return err
+		}
+	}
+	w.addTrigger(subscription.ID, t)
+	metrics.TriggerGauge.WithLabelValues(w.config.IP).Inc()
+	return nil
+}
+
+// addStandbySubscription prepares the trigger of subscription without starting it, the offsets are
+// known when it's promoted.
+func (w *worker) addStandbySubscription(ctx context.Context, subscription *primitive.Subscription) error {
+	if _, exist := w.getTrigger(subscription.ID); exist {
+		// the subscription is running on this trigger worker.
+		return nil
+	}
+	t, exist := w.getStandby(subscription.ID)
+	if exist {
+		return t.Change(ctx, subscription)
+	}
 	t = w.newTrigger(subscription, w.getTriggerOptions(subscription)...)
 	err := t.Init(ctx)
 	if err != nil {
 		return err
 	}
-	err = t.Start(w.ctx)
-	if err != nil {
-		return err
-	}
-	w.addTrigger(subscription.ID, t)
-	metrics.TriggerGauge.WithLabelValues(w.config.IP).Inc()
+	w.addStandby(subscription.ID, t)
 	return nil
 }
 
 func (w *worker) RemoveSubscription(ctx context.Context, id vanus.ID) error {
 	w.lock.Lock()
 	defer w.lock.Unlock()
+	w.releaseSinkSecret(id)
+	if w.deleteStandby(id) {
+		return nil
+	}
 	_ = w.stopSubscription(ctx, id)
 	w.deleteTrigger(id)
+	if w.config.DedupDir != "" {
+		_ = os.RemoveAll(filepath.Join(w.config.DedupDir, id.String()))
+	}
 	metrics.TriggerGauge.WithLabelValues(w.config.IP).Dec()
 	return nil
 }
@@ -229,13 +351,34 @@ func (w *worker) ResetOffsetToTimestamp(ctx context.Context,
 	return nil
 }
 
+func (w *worker) WatchDelivery(id vanus.ID) (*trigger.DeliveryWatcher, error) {
+	t, exist := w.getTrigger(id)
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	return t.WatchDelivery(), nil
+}
+
+func (w *worker) TraceEvent(id vanus.ID, eventID string) ([]trigger.DeliveryResult, bool, error) {
+	t, exist := w.getTrigger(id)
+	if !exist {
+		return nil, false, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	results, enabled := t.TraceEvent(eventID)
+	return results, enabled, nil
+}
+
 func (w *worker) startHeartbeat(ctx context.Context) error {
 	w.wg.Add(1)
 	defer w.wg.Done()
 	f := func() interface{} {
+		if chaos.Hit(chaos.PointTriggerDropHeartbeat) {
+			return nil
+		}
 		return &ctrlpb.TriggerWorkerHeartbeatRequest{
 			Address:          w.config.TriggerAddr,
 			SubscriptionInfo: w.getAllSubscriptionInfo(ctx),
+			SubscriptionLoad: w.getAllSubscriptionLoad(ctx),
 		}
 	}
 	return w.ctrl.TriggerService().RegisterHeartbeat(ctx, w.config.HeartbeatInterval, f)
@@ -246,7 +389,20 @@ func (w *worker) stopSubscription(ctx context.Context, id vanus.ID) error {
 	if !exist {
 		return nil
 	}
-	return t.Stop(ctx)
+	drainCtx, cancel := context.WithTimeout(ctx, w.config.GetDrainTimeout())
+	err := t.Stop(drainCtx)
+	cancel()
+	if err != nil {
+		return err
+	}
+	err = w.commitTriggerOffsets(ctx, true, map[vanus.ID]trigger.Trigger{id: t})
+	if err != nil {
+		log.Warning(ctx, "commit offset error when stop subscription", map[string]interface{}{
+			log.KeySubscriptionID: id,
+			log.KeyError:          err,
+		})
+	}
+	return nil
 }
 
 func (w *worker) startSubscription(ctx context.Context, id vanus.ID) error {
@@ -272,27 +428,95 @@ func (w *worker) commitOffset(ctx context.Context, id vanus.ID, offsets info.Lis
 	return err
 }
 
-func (w *worker) commitOffsets(ctx context.Context) error {
-	_, err := w.client.CommitOffset(ctx, &ctrlpb.CommitOffsetRequest{
-		ForceCommit:      true,
-		SubscriptionInfo: w.getAllSubscriptionInfo(ctx),
+func (w *worker) startCommit(ctx context.Context) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(commitCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				err := w.commitOffsets(ctx, false)
+				if err != nil {
+					log.Warning(ctx, "commit offsets error", map[string]interface{}{
+						log.KeyError: err,
+					})
+				}
+			}
+		}
+	}()
+}
+
+// commitOffsets commit the offsets of all triggers need commit in one request.
+func (w *worker) commitOffsets(ctx context.Context, stop bool) error {
+	w.tgLock.RLock()
+	triggers := make(map[vanus.ID]trigger.Trigger, len(w.triggerMap))
+	for id, t := range w.triggerMap {
+		triggers[id] = t
+	}
+	w.tgLock.RUnlock()
+	return w.commitTriggerOffsets(ctx, stop, triggers)
+}
+
+func (w *worker) commitTriggerOffsets(ctx context.Context, stop bool, triggers map[vanus.ID]trigger.Trigger) error {
+	commits := make(map[vanus.ID]*trigger.OffsetCommit, len(triggers))
+	subInfos := make([]*metapb.SubscriptionInfo, 0, len(triggers))
+	for id, t := range triggers {
+		commit := t.GetCommitOffsets(ctx, stop)
+		if commit == nil || len(commit.Offsets) == 0 {
+			continue
+		}
+		commits[id] = commit
+		subInfos = append(subInfos, convert.ToPbSubscriptionInfo(info.SubscriptionInfo{
+			SubscriptionID: id,
+			Offsets:        commit.Offsets,
+		}))
+	}
+	if len(subInfos) == 0 {
+		return nil
+	}
+	resp, err := w.client.CommitOffset(ctx, &ctrlpb.CommitOffsetRequest{
+		ForceCommit:      stop,
+		SubscriptionInfo: subInfos,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	for _, id := range resp.GetFailSubscriptionId() {
+		delete(commits, vanus.ID(id))
+	}
+	for id, commit := range commits {
+		triggers[id].OffsetCommitted(ctx, commit)
+	}
+	return nil
 }
 
+// getAllSubscriptionInfo offsets are committed by commitOffsets, heartbeat not contain them.
 func (w *worker) getAllSubscriptionInfo(ctx context.Context) []*metapb.SubscriptionInfo {
 	w.tgLock.RLock()
 	defer w.tgLock.RUnlock()
 	subInfos := make([]*metapb.SubscriptionInfo, 0, len(w.triggerMap))
-	for id, t := range w.triggerMap {
+	for id := range w.triggerMap {
 		subInfos = append(subInfos, &metapb.SubscriptionInfo{
 			SubscriptionId: uint64(id),
-			Offsets:        convert.ToPbOffsetInfos(t.GetOffsets(ctx)),
 		})
 	}
 	return subInfos
 }
 
+func (w *worker) getAllSubscriptionLoad(ctx context.Context) []*ctrlpb.SubscriptionLoad {
+	w.tgLock.RLock()
+	defer w.tgLock.RUnlock()
+	loads := make([]*ctrlpb.SubscriptionLoad, 0, len(w.triggerMap))
+	for _, t := range w.triggerMap {
+		loads = append(loads, convert.ToPbSubscriptionLoad(t.GetLoad(ctx)))
+	}
+	return loads
+}
+
 func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigger.Option {
 	opts := []trigger.Option{trigger.WithControllers(w.config.ControllerAddr)}
 	config := subscription.Config
@@ -300,6 +524,18 @@ func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigg
 		trigger.WithDeliveryTimeout(config.DeliveryTimeout),
 		trigger.WithMaxRetryAttempts(config.GetMaxRetryAttempts()),
 		trigger.WithDeadLetterEventbus(config.DeadLetterEventbus),
-		trigger.WithOrdered(config.OrderedEvent))
+		trigger.WithOrdered(config.OrderedEvent),
+		trigger.WithDedupDir(w.config.DedupDir),
+		trigger.WithDedup(config.DedupWindow, config.DedupKeyAttribute),
+		trigger.WithOrderingKey(config.OrderingKeyAttribute),
+		trigger.WithIdempotentDelivery(config.IdempotentDelivery),
+		trigger.WithBlobStore(w.blobStore),
+		trigger.WithOffsetCommit(config.CommitInterval, config.CommitBatchSize, !config.DisableSyncCommitOnStop),
+		trigger.WithCorrelation(config.CorrelationEventbus, config.CorrelationKeyAttribute, config.CorrelationTimeout),
+		trigger.WithCorrelationSpill(w.config.CorrelationSpillDir, w.config.CorrelationMaxMemoryEvents),
+		trigger.WithPoisonThreshold(config.PoisonThreshold),
+		trigger.WithReplyEventbus(config.ReplyEventbus),
+		trigger.WithFeatureFlags(w.features),
+		trigger.WithEventTrace(w.config.EventTrace.GetCapacity(subscription.EventBus)))
 	return opts
 }
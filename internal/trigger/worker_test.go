@@ -26,6 +26,8 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/trigger"
 	"github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc"
+
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -75,6 +77,47 @@ func TestAddSubscription(t *testing.T) {
 			So(exist, ShouldBeFalse)
 			So(v, ShouldBeNil)
 		})
+		Convey("add standby subscription", func() {
+			id := vanus.NewTestID()
+			tg.EXPECT().Init(gomock.Any()).Return(nil)
+			err := m.AddSubscription(ctx, &primitive.Subscription{
+				ID:      id,
+				Standby: true,
+			})
+			So(err, ShouldBeNil)
+			_, exist := m.getTrigger(id)
+			So(exist, ShouldBeFalse)
+			_, exist = m.getStandby(id)
+			So(exist, ShouldBeTrue)
+			Convey("update standby subscription", func() {
+				tg.EXPECT().Change(gomock.Any(), gomock.Any()).Return(nil)
+				err = m.AddSubscription(ctx, &primitive.Subscription{
+					ID:      id,
+					Sink:    "http://localhost:8080",
+					Standby: true,
+				})
+				So(err, ShouldBeNil)
+				_, exist = m.getStandby(id)
+				So(exist, ShouldBeTrue)
+			})
+			Convey("promote standby subscription", func() {
+				tg.EXPECT().Promote(gomock.Any(), gomock.Any()).Return(nil)
+				err = m.AddSubscription(ctx, &primitive.Subscription{
+					ID: id,
+				})
+				So(err, ShouldBeNil)
+				_, exist = m.getStandby(id)
+				So(exist, ShouldBeFalse)
+				_, exist = m.getTrigger(id)
+				So(exist, ShouldBeTrue)
+			})
+			Convey("remove standby subscription", func() {
+				err = m.RemoveSubscription(ctx, id)
+				So(err, ShouldBeNil)
+				_, exist = m.getStandby(id)
+				So(exist, ShouldBeFalse)
+			})
+		})
 	})
 }
 
@@ -106,6 +149,7 @@ func TestRemoveSubscription(t *testing.T) {
 			So(exist, ShouldBeTrue)
 			So(v, ShouldNotBeNil)
 			tg.EXPECT().Stop(gomock.Any()).Return(nil)
+			tg.EXPECT().GetCommitOffsets(gomock.Any(), true).Return(nil)
 			err = m.RemoveSubscription(ctx, id)
 			So(err, ShouldBeNil)
 			v, exist = m.getTrigger(id)
@@ -143,6 +187,7 @@ func TestPauseStartSubscription(t *testing.T) {
 			So(exist, ShouldBeTrue)
 			So(v, ShouldNotBeNil)
 			tg.EXPECT().Stop(gomock.Any()).Return(nil)
+			tg.EXPECT().GetCommitOffsets(gomock.Any(), true).Return(nil)
 			err = m.PauseSubscription(ctx, id)
 			So(err, ShouldBeNil)
 			v, exist = m.getTrigger(id)
@@ -180,6 +225,7 @@ func TestResetOffsetToTimestamp(t *testing.T) {
 			})
 			So(err, ShouldBeNil)
 			tg.EXPECT().Stop(gomock.Any()).Return(nil)
+			tg.EXPECT().GetCommitOffsets(gomock.Any(), true).Return(nil)
 			offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: uint64(100)}}
 			tg.EXPECT().ResetOffsetToTimestamp(gomock.Any(), gomock.Any()).Return(offsets, nil)
 			triggerClient := controller.NewMockTriggerControllerClient(ctrl)
@@ -213,7 +259,9 @@ func TestWorker_Stop(t *testing.T) {
 		m.client = triggerClient
 		tg.EXPECT().Stop(gomock.Any()).AnyTimes().Return(nil)
 		offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: uint64(100)}}
-		tg.EXPECT().GetOffsets(gomock.Any()).AnyTimes().Return(offsets)
+		commit := &trigger.OffsetCommit{Offsets: offsets}
+		tg.EXPECT().GetCommitOffsets(gomock.Any(), true).Return(commit)
+		tg.EXPECT().OffsetCommitted(gomock.Any(), commit)
 		triggerClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).Return(nil, nil)
 		err = m.Stop(ctx)
 		So(err, ShouldBeNil)
@@ -223,6 +271,45 @@ func TestWorker_Stop(t *testing.T) {
 	})
 }
 
+func TestWorker_CommitOffsets(t *testing.T) {
+	ctx := context.Background()
+	Convey("test commit offsets", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewWorker(Config{}).(*worker)
+		triggerClient := controller.NewMockTriggerControllerClient(ctrl)
+		m.client = triggerClient
+		tg1 := trigger.NewMockTrigger(ctrl)
+		tg2 := trigger.NewMockTrigger(ctrl)
+		tg3 := trigger.NewMockTrigger(ctrl)
+		id1, id2, id3 := vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()
+		m.addTrigger(id1, tg1)
+		m.addTrigger(id2, tg2)
+		m.addTrigger(id3, tg3)
+		commit1 := &trigger.OffsetCommit{Offsets: info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: 1}}}
+		commit3 := &trigger.OffsetCommit{Offsets: info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: 3}}}
+		tg1.EXPECT().GetCommitOffsets(gomock.Any(), false).Return(commit1)
+		tg2.EXPECT().GetCommitOffsets(gomock.Any(), false).Return(nil)
+		tg3.EXPECT().GetCommitOffsets(gomock.Any(), false).Return(commit3)
+		Convey("test commit success", func() {
+			triggerClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *controller.CommitOffsetRequest, _ ...grpc.CallOption) (*controller.CommitOffsetResponse, error) {
+					So(req.ForceCommit, ShouldBeFalse)
+					So(req.SubscriptionInfo, ShouldHaveLength, 2)
+					return &controller.CommitOffsetResponse{FailSubscriptionId: []uint64{uint64(id3)}}, nil
+				})
+			tg1.EXPECT().OffsetCommitted(gomock.Any(), commit1)
+			err := m.commitOffsets(ctx, false)
+			So(err, ShouldBeNil)
+		})
+		Convey("test commit fail", func() {
+			triggerClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("test"))
+			err := m.commitOffsets(ctx, false)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
 func TestWorker_Register(t *testing.T) {
 	Convey("test register", t, func() {
 		ctx := context.Background()
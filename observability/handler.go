@@ -0,0 +1,45 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observability
+
+import (
+	"net/http"
+	"sync"
+)
+
+var (
+	handlers   = map[string]http.Handler{}
+	handlersMu sync.RWMutex
+)
+
+// Handle registers the admin endpoint of the component at path, it's served by the http server of
+// metrics and probes, and can be registered before or after Initialize.
+func Handle(path string, handler http.Handler) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	handlers[path] = handler
+}
+
+// serveHandlers dispatches the requests of the paths without a fixed handler to the registered ones.
+func serveHandlers(w http.ResponseWriter, r *http.Request) {
+	handlersMu.RLock()
+	handler, ok := handlers[r.URL.Path]
+	handlersMu.RUnlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	handler.ServeHTTP(w, r)
+}
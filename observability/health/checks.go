@@ -0,0 +1,55 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"os"
+)
+
+var ErrNotReady = errors.New("not ready")
+
+// DiskWritable checks if a file can be written to and synced in dir.
+func DiskWritable(dir string) Check {
+	return func(ctx context.Context) error {
+		f, err := os.CreateTemp(dir, ".healthz-*")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = os.Remove(f.Name())
+		}()
+		if _, err = f.WriteString("ok"); err != nil {
+			_ = f.Close()
+			return err
+		}
+		if err = f.Sync(); err != nil {
+			_ = f.Close()
+			return err
+		}
+		return f.Close()
+	}
+}
+
+// Condition converts a function reporting the state to a Check.
+func Condition(f func() bool) Check {
+	return func(ctx context.Context) error {
+		if !f() {
+			return ErrNotReady
+		}
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceLiveness is the service name to query the liveness, the empty service name
+// queries the readiness.
+const ServiceLiveness = "liveness"
+
+type grpcServer struct {
+	healthpb.UnimplementedHealthServer
+}
+
+// RegisterGRPC registers the standard gRPC health service, whose status is decided by the checks.
+func RegisterGRPC(srv *grpc.Server) {
+	healthpb.RegisterHealthServer(srv, &grpcServer{})
+}
+
+func (s *grpcServer) Check(
+	ctx context.Context, req *healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	var res Result
+	switch req.GetService() {
+	case "":
+		res = Readiness(ctx)
+	case ServiceLiveness:
+		res = Liveness(ctx)
+	default:
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+	if res.Healthy() {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+
+	statusOK     = "ok"
+	statusFailed = "failed"
+
+	defaultCheckTimeout = 3 * time.Second
+)
+
+var ErrCheckTimeout = errors.New("check timeout")
+
+// Check returns nil if the dependency it checks is healthy.
+type Check func(ctx context.Context) error
+
+type registry struct {
+	mu     sync.RWMutex
+	checks map[string]Check
+}
+
+func (r *registry) add(name string, check Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = check
+}
+
+func (r *registry) snapshot() map[string]Check {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m := make(map[string]Check, len(r.checks))
+	for k, v := range r.checks {
+		m[k] = v
+	}
+	return m
+}
+
+var (
+	liveness  = &registry{checks: map[string]Check{}}
+	readiness = &registry{checks: map[string]Check{}}
+)
+
+// AddLivenessCheck adds a check to /healthz, the component should be restarted if it fails.
+func AddLivenessCheck(name string, check Check) {
+	liveness.add(name, check)
+}
+
+// AddReadinessCheck adds a check to /readyz and the gRPC health service, the component
+// shouldn't serve requests if it fails.
+func AddReadinessCheck(name string, check Check) {
+	readiness.add(name, check)
+}
+
+// Result is the result of a group of checks.
+type Result struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+func (r Result) Healthy() bool {
+	return r.Status == statusOK
+}
+
+// Liveness runs all liveness checks.
+func Liveness(ctx context.Context) Result {
+	return run(ctx, liveness.snapshot())
+}
+
+// Readiness runs all readiness checks, the component isn't ready if it isn't alive.
+func Readiness(ctx context.Context) Result {
+	checks := liveness.snapshot()
+	for k, v := range readiness.snapshot() {
+		checks[k] = v
+	}
+	return run(ctx, checks)
+}
+
+func run(ctx context.Context, checks map[string]Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, defaultCheckTimeout)
+	defer cancel()
+
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, len(names))
+	wg := sync.WaitGroup{}
+	for idx := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = runCheck(ctx, checks[names[i]])
+		}(idx)
+	}
+	wg.Wait()
+
+	res := Result{Status: statusOK, Checks: make(map[string]string, len(names))}
+	for i, name := range names {
+		if errs[i] != nil {
+			res.Status = statusFailed
+			res.Checks[name] = errs[i].Error()
+		} else {
+			res.Checks[name] = statusOK
+		}
+	}
+	return res
+}
+
+// runCheck returns ErrCheckTimeout if the check doesn't return in time, because some
+// dependencies don't respect the context.
+func runCheck(ctx context.Context, check Check) error {
+	errC := make(chan error, 1)
+	go func() {
+		errC <- check(ctx)
+	}()
+	select {
+	case err := <-errC:
+		return err
+	case <-ctx.Done():
+		return ErrCheckTimeout
+	}
+}
+
+func LivenessHandler() http.Handler {
+	return handler(Liveness)
+}
+
+func ReadinessHandler() http.Handler {
+	return handler(Readiness)
+}
+
+func handler(f func(ctx context.Context) Result) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := f(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if !res.Healthy() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
+
+// RegisterHandlers mounts /healthz and /readyz on mux.
+func RegisterHandlers(mux *http.ServeMux) {
+	mux.Handle(LivenessPath, LivenessHandler())
+	mux.Handle(ReadinessPath, ReadinessHandler())
+}
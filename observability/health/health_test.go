@@ -0,0 +1,114 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func reset() {
+	liveness = &registry{checks: map[string]Check{}}
+	readiness = &registry{checks: map[string]Check{}}
+}
+
+func TestHandlers(t *testing.T) {
+	reset()
+	defer reset()
+	mux := http.NewServeMux()
+	RegisterHandlers(mux)
+
+	ready := false
+	AddLivenessCheck("disk", DiskWritable(t.TempDir()))
+	AddReadinessCheck("controller", Condition(func() bool { return ready }))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, LivenessPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expect alive, got %d: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expect not ready, got %d", w.Code)
+	}
+	res := Result{}
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Checks["disk"] != statusOK || res.Checks["controller"] != ErrNotReady.Error() {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+
+	ready = true
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expect ready, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDiskWritable(t *testing.T) {
+	if err := DiskWritable(t.TempDir())(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := DiskWritable(filepath.Join(t.TempDir(), "not-exist"))(context.Background()); err == nil {
+		t.Fatal("expect error for a directory which doesn't exist")
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	reset()
+	defer reset()
+	AddReadinessCheck("blocked", func(ctx context.Context) error {
+		time.Sleep(time.Hour)
+		return nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	res := Readiness(ctx)
+	if res.Healthy() || res.Checks["blocked"] != ErrCheckTimeout.Error() {
+		t.Fatalf("unexpected result: %+v", res)
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	reset()
+	defer reset()
+	srv := &grpcServer{}
+	AddReadinessCheck("failed", func(ctx context.Context) error {
+		return errors.New("failed")
+	})
+	res, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil || res.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("expect not serving, got %v, %v", res, err)
+	}
+	res, err = srv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: ServiceLiveness})
+	if err != nil || res.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("expect serving, got %v, %v", res, err)
+	}
+	if _, err = srv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"}); err == nil {
+		t.Fatal("expect error for unknown service")
+	}
+}
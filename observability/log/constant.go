@@ -17,6 +17,7 @@ package log
 const (
 	KeyError   = "error"
 	KeyUnknown = "known"
+	KeyModule  = "module"
 
 	KeySegmentID         = "segment_id"
 	KeySegmentServerID   = "segment_server_id"
@@ -0,0 +1,75 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"time"
+)
+
+// Field is a key-value pair of structured log.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+func String(key, val string) Field {
+	return Field{Key: key, Value: val}
+}
+
+func Int(key string, val int) Field {
+	return Field{Key: key, Value: val}
+}
+
+func Int64(key string, val int64) Field {
+	return Field{Key: key, Value: val}
+}
+
+func Uint64(key string, val uint64) Field {
+	return Field{Key: key, Value: val}
+}
+
+func Bool(key string, val bool) Field {
+	return Field{Key: key, Value: val}
+}
+
+func Duration(key string, val time.Duration) Field {
+	return Field{Key: key, Value: val}
+}
+
+// Stringer formats the value lazily, e.g. vanus.ID.
+func Stringer(key string, val fmt.Stringer) Field {
+	return Field{Key: key, Value: val}
+}
+
+// Err is the field of KeyError.
+func Err(err error) Field {
+	return Field{Key: KeyError, Value: err}
+}
+
+func Any(key string, val interface{}) Field {
+	return Field{Key: key, Value: val}
+}
+
+func toMap(module string, fields []Field) map[string]interface{} {
+	m := make(map[string]interface{}, len(fields)+1)
+	if module != "" {
+		m[KeyModule] = module
+	}
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+	return m
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// LevelHandlerPath is the path of admin endpoint which changes the log levels at runtime.
+const LevelHandlerPath = "/debug/log/level"
+
+type levelState struct {
+	Level   string            `json:"level"`
+	Modules map[string]string `json:"modules"`
+	// Registered is the known modules, including the ones whose level is inherited.
+	Registered []string `json:"registered"`
+}
+
+// LevelHandler serves the log levels. GET returns the levels, PUT or POST changes the level
+// by the query parameters "level" and "module", the global level is changed if module is absent,
+// and the module uses the global level again if the level is empty.
+//
+//	curl -X PUT 'http://127.0.0.1:2112/debug/log/level?module=store.vsb&level=debug'
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			query := r.URL.Query()
+			level := query.Get("level")
+			if name := query.Get("module"); name != "" {
+				if err := SetModuleLevel(name, level); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				break
+			}
+			if _, err := ParseLevel(level); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			SetLogLevel(level)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelState{
+			Level:      GlobalLevel().String(),
+			Modules:    ModuleLevels(),
+			Registered: moduleNames(),
+		})
+	})
+}
@@ -18,7 +18,6 @@ import (
 	"context"
 	"io"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -37,22 +36,14 @@ type Logger interface {
 func init() {
 	logger := logrus.New()
 	logger.Formatter = &logrus.TextFormatter{TimestampFormat: time.RFC3339, FullTimestamp: true}
+	// The level is filtered by defaultLogger, so that a module is able to log below the global level.
+	logger.SetLevel(logrus.DebugLevel)
 	r := &defaultLogger{
 		logger: logger,
 	}
 	level := os.Getenv("VANUS_LOG_LEVEL")
-	switch strings.ToLower(level) {
-	case "debug":
-		r.logger.SetLevel(logrus.DebugLevel)
-	case "warn":
-		r.logger.SetLevel(logrus.WarnLevel)
-	case "error":
-		r.logger.SetLevel(logrus.ErrorLevel)
-	case "fatal":
-		r.logger.SetLevel(logrus.FatalLevel)
-	default:
-		r.logger.SetLevel(logrus.InfoLevel)
-	}
+	r.SetLevel(level)
+	initModuleLevels(os.Getenv("VANUS_LOG_MODULE_LEVEL"))
 
 	vLog = r
 	vLog.Debug(context.Background(), "logger level has been set", map[string]interface{}{
@@ -66,59 +57,71 @@ type defaultLogger struct {
 	logger *logrus.Logger
 }
 
+// Make sure defaultLogger implements levelWriter.
+var _ levelWriter = (*defaultLogger)(nil)
+
 func (l *defaultLogger) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
-	if msg == "" && len(fields) == 0 {
+	if msg == "" && len(fields) == 0 || !globalEnabled(DebugLevel) {
 		return
 	}
-	l.logger.WithFields(fields).Debug(msg)
+	l.write(DebugLevel, msg, fields)
 }
 
 func (l *defaultLogger) Info(ctx context.Context, msg string, fields map[string]interface{}) {
-	if msg == "" && len(fields) == 0 {
+	if msg == "" && len(fields) == 0 || !globalEnabled(InfoLevel) {
 		return
 	}
-	l.logger.WithFields(fields).Info(msg)
+	l.write(InfoLevel, msg, fields)
 }
 
 func (l *defaultLogger) Warning(ctx context.Context, msg string, fields map[string]interface{}) {
-	if msg == "" && len(fields) == 0 {
+	if msg == "" && len(fields) == 0 || !globalEnabled(WarnLevel) {
 		return
 	}
-	l.logger.WithFields(fields).Warning(msg)
+	l.write(WarnLevel, msg, fields)
 }
 
 func (l *defaultLogger) Error(ctx context.Context, msg string, fields map[string]interface{}) {
-	if msg == "" && len(fields) == 0 {
+	if msg == "" && len(fields) == 0 || !globalEnabled(ErrorLevel) {
 		return
 	}
-	l.logger.WithFields(fields).WithFields(fields).Error(msg)
+	l.write(ErrorLevel, msg, fields)
 }
 
 func (l *defaultLogger) Fatal(ctx context.Context, msg string, fields map[string]interface{}) {
 	if msg == "" && len(fields) == 0 {
 		return
 	}
-	l.logger.WithFields(fields).Fatal(msg)
+	l.write(FatalLevel, msg, fields)
+}
+
+// write writes the log without checking the level.
+func (l *defaultLogger) write(level Level, msg string, fields map[string]interface{}) {
+	entry := l.logger.WithFields(fields)
+	switch level {
+	case DebugLevel:
+		entry.Debug(msg)
+	case InfoLevel:
+		entry.Info(msg)
+	case WarnLevel:
+		entry.Warning(msg)
+	case ErrorLevel:
+		entry.Error(msg)
+	default:
+		entry.Fatal(msg)
+	}
 }
 
 func (l *defaultLogger) SetLevel(level string) {
-	switch strings.ToLower(level) {
-	case "debug":
-		l.logger.SetLevel(logrus.DebugLevel)
-	case "warn":
-		l.logger.SetLevel(logrus.WarnLevel)
-	case "error":
-		l.logger.SetLevel(logrus.ErrorLevel)
-	case "fatal":
-		l.logger.SetLevel(logrus.FatalLevel)
-	default:
-		l.logger.SetLevel(logrus.InfoLevel)
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		lvl = InfoLevel
 	}
+	setGlobalLevel(lvl)
 }
 
 func (l *defaultLogger) SetLogWriter(writer io.Writer) {
 	l.logger.Out = writer
-	return
 }
 
 // SetLogger use specified logger user customized, in general, we suggest user to replace the default logger with specified
@@ -130,6 +133,9 @@ func SetLogLevel(level string) {
 	if level == "" {
 		return
 	}
+	if lvl, err := ParseLevel(level); err == nil {
+		setGlobalLevel(lvl)
+	}
 	vLog.SetLevel(level)
 }
 
@@ -0,0 +1,132 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	SetLogWriter(buf)
+	level := GlobalLevel()
+	t.Cleanup(func() {
+		SetLogWriter(os.Stderr)
+		SetLogLevel(level.String())
+	})
+	return buf
+}
+
+func TestModuleLevel(t *testing.T) {
+	buf := captureLog(t)
+	SetLogLevel("info")
+	l := Module("test-level")
+	defer func() { _ = SetModuleLevel("test-level", "") }()
+
+	l.Debug(context.Background(), "hidden debug")
+	if buf.Len() != 0 {
+		t.Fatalf("debug log is written under info level: %s", buf.String())
+	}
+
+	if err := SetModuleLevel("test-level", "debug"); err != nil {
+		t.Fatal(err)
+	}
+	l.Debug(context.Background(), "visible debug", String("key", "value"))
+	out := buf.String()
+	if !strings.Contains(out, "visible debug") || !strings.Contains(out, "key=value") ||
+		!strings.Contains(out, "module=test-level") {
+		t.Fatalf("unexpected log: %s", out)
+	}
+
+	// Other modules still use the global level.
+	buf.Reset()
+	Module("test-other").Debug(context.Background(), "hidden debug")
+	if buf.Len() != 0 {
+		t.Fatalf("debug log of other module is written: %s", buf.String())
+	}
+
+	if err := SetModuleLevel("test-level", "verbose"); err == nil {
+		t.Fatal("unknown level is accepted")
+	}
+	if got := ModuleLevels()["test-level"]; got != "debug" {
+		t.Fatalf("module level is %q", got)
+	}
+}
+
+func TestSampler(t *testing.T) {
+	s := newSampler(time.Hour, 2, 3)
+	var allowed int
+	for i := 0; i < 11; i++ {
+		if s.allow("msg") {
+			allowed++
+		}
+	}
+	// 2 first, and the 5th, 8th, 11th.
+	if allowed != 5 {
+		t.Fatalf("allowed %d logs", allowed)
+	}
+	if !s.allow("other msg") {
+		t.Fatal("different message is not sampled separately")
+	}
+}
+
+func TestLevelHandler(t *testing.T) {
+	_ = captureLog(t)
+	defer func() { _ = SetModuleLevel("test-handler", "") }()
+	h := LevelHandler()
+
+	req := httptest.NewRequest(http.MethodPut, LevelHandlerPath+"?module=test-handler&level=warn", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body.String())
+	}
+	var state levelState
+	if err := json.Unmarshal(rec.Body.Bytes(), &state); err != nil {
+		t.Fatal(err)
+	}
+	if state.Modules["test-handler"] != "warn" {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	req = httptest.NewRequest(http.MethodPut, LevelHandlerPath+"?level=error", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || GlobalLevel() != ErrorLevel {
+		t.Fatalf("global level is not changed, status %d, level %s", rec.Code, GlobalLevel())
+	}
+
+	req = httptest.NewRequest(http.MethodPut, LevelHandlerPath+"?level=verbose", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, LevelHandlerPath, nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status %d", rec.Code)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// inheritLevel means the module uses the global level.
+const inheritLevel = -1
+
+// levelWriter is implemented by the logger which is able to write logs without checking its own level,
+// otherwise a module can not log below the global level.
+type levelWriter interface {
+	write(level Level, msg string, fields map[string]interface{})
+}
+
+type module struct {
+	name  string
+	level int32
+}
+
+func (m *module) Level() Level {
+	if lvl := atomic.LoadInt32(&m.level); lvl != inheritLevel {
+		return Level(lvl)
+	}
+	return GlobalLevel()
+}
+
+var modules = struct {
+	sync.Mutex
+	m map[string]*module
+}{m: map[string]*module{}}
+
+func getModule(name string) *module {
+	modules.Lock()
+	defer modules.Unlock()
+	m, ok := modules.m[name]
+	if !ok {
+		m = &module{name: name, level: inheritLevel}
+		modules.m[name] = m
+	}
+	return m
+}
+
+// ModuleLogger logs with structured fields, the level of module can be changed at runtime by SetModuleLevel.
+type ModuleLogger struct {
+	mod     *module
+	sampler *sampler
+}
+
+// Module returns the logger of the named module, the loggers of the same name share the level.
+func Module(name string) *ModuleLogger {
+	return &ModuleLogger{mod: getModule(name)}
+}
+
+// Sampled returns a logger which logs the first messages in every tick, and thereafter every
+// thereafter-th message, logs of different messages are sampled separately. It is used in hot paths.
+func (l *ModuleLogger) Sampled(tick time.Duration, first, thereafter int) *ModuleLogger {
+	return &ModuleLogger{mod: l.mod, sampler: newSampler(tick, first, thereafter)}
+}
+
+func (l *ModuleLogger) Enabled(level Level) bool {
+	return level >= l.mod.Level()
+}
+
+func (l *ModuleLogger) Debug(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, DebugLevel, msg, fields)
+}
+
+func (l *ModuleLogger) Info(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, InfoLevel, msg, fields)
+}
+
+func (l *ModuleLogger) Warning(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, WarnLevel, msg, fields)
+}
+
+func (l *ModuleLogger) Error(ctx context.Context, msg string, fields ...Field) {
+	l.log(ctx, ErrorLevel, msg, fields)
+}
+
+func (l *ModuleLogger) log(ctx context.Context, level Level, msg string, fields []Field) {
+	if !l.Enabled(level) {
+		return
+	}
+	if l.sampler != nil && !l.sampler.allow(msg) {
+		return
+	}
+	m := toMap(l.mod.name, fields)
+	if w, ok := vLog.(levelWriter); ok {
+		w.write(level, msg, m)
+		return
+	}
+	switch level {
+	case DebugLevel:
+		vLog.Debug(ctx, msg, m)
+	case InfoLevel:
+		vLog.Info(ctx, msg, m)
+	case WarnLevel:
+		vLog.Warning(ctx, msg, m)
+	default:
+		vLog.Error(ctx, msg, m)
+	}
+}
+
+// SetModuleLevel changes the level of module at runtime, the module uses the global level again
+// if the level is empty.
+func SetModuleLevel(name, level string) error {
+	lvl := int32(inheritLevel)
+	if level != "" {
+		l, err := ParseLevel(level)
+		if err != nil {
+			return err
+		}
+		lvl = int32(l)
+	}
+	atomic.StoreInt32(&getModule(name).level, lvl)
+	return nil
+}
+
+// ModuleLevels returns the levels of modules which override the global level.
+func ModuleLevels() map[string]string {
+	modules.Lock()
+	defer modules.Unlock()
+	levels := make(map[string]string, len(modules.m))
+	for name, m := range modules.m {
+		if lvl := atomic.LoadInt32(&m.level); lvl != inheritLevel {
+			levels[name] = Level(lvl).String()
+		}
+	}
+	return levels
+}
+
+// initModuleLevels parses the levels of modules in form of "vsb=debug,wal=warn".
+func initModuleLevels(spec string) {
+	for _, kv := range strings.Split(spec, ",") {
+		name, level, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok || name == "" {
+			continue
+		}
+		_ = SetModuleLevel(name, level)
+	}
+}
+
+func moduleNames() []string {
+	modules.Lock()
+	defer modules.Unlock()
+	names := make([]string, 0, len(modules.m))
+	for name := range modules.m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sync"
+	"time"
+)
+
+type sampleCounter struct {
+	resetAt time.Time
+	n       int
+}
+
+// sampler is similar with the sampler of zap, it counts logs by message in every tick.
+type sampler struct {
+	tick       time.Duration
+	first      int
+	thereafter int
+	mu         sync.Mutex
+	counters   map[string]*sampleCounter
+}
+
+func newSampler(tick time.Duration, first, thereafter int) *sampler {
+	return &sampler{
+		tick:       tick,
+		first:      first,
+		thereafter: thereafter,
+		counters:   map[string]*sampleCounter{},
+	}
+}
+
+func (s *sampler) allow(msg string) bool {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.counters[msg]
+	if !ok || !now.Before(c.resetAt) {
+		c = &sampleCounter{resetAt: now.Add(s.tick)}
+		s.counters[msg] = c
+	}
+	c.n++
+	if c.n <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (c.n-s.first)%s.thereafter == 0
+}
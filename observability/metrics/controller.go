@@ -74,4 +74,18 @@ var (
 		Name:      "trigger_number",
 		Help:      "The number of trigger",
 	}, []string{LabelTriggerWorker})
+
+	SubscriptionLagEventGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "subscription_lag_event_number",
+		Help:      "The number of events which subscription has not committed",
+	}, []string{LabelEventbus, LabelSubscription})
+
+	SubscriptionLagTimeGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "subscription_lag_time_second",
+		Help:      "The age of the oldest event which subscription has not committed",
+	}, []string{LabelEventbus, LabelSubscription})
 )
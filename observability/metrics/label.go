@@ -22,8 +22,11 @@ const (
 
 	LabelTriggerWorker = "trigger_worker"
 	LabelTrigger       = "trigger"
+	LabelSubscription  = "subscription"
 	LabelResult        = "result"
 	LabelBlock         = "block"
+	LabelStage         = "stage"
+	LabelOperation     = "operation"
 
 	LabelTimer = "timer"
 )
@@ -36,6 +39,24 @@ const (
 	LabelSegmentDeletedBecauseExpired      = "segment_expired"
 	LabelSegmentDeletedBecauseCreateFailed = "segment_create_failed"
 	LabelSegmentDeletedBecauseDeleted      = "segment_deleted"
+	LabelValueMirrorEventMirrored          = "mirrored"
+	LabelValueMirrorEventSkipped           = "skipped"
+	LabelValueCorrelationMatched           = "matched"
+	LabelValueCorrelationExpired           = "expired"
+	LabelValueCorrelationNoKey             = "no_key"
+)
+
+const (
+	LabelValueStageEncode  = "encode"
+	LabelValueStageWriteAt = "write_at"
+	LabelValueStageIndex   = "index"
+)
+
+const (
+	// LabelValueFragmentMissing is the discontinuity that fragments don't start at the write offset of block.
+	LabelValueFragmentMissing = "missing"
+	// LabelValueFragmentDiscontinuous is the discontinuity between adjacent fragments of a batch.
+	LabelValueFragmentDiscontinuous = "discontinuous"
 )
 
 const (
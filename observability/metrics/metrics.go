@@ -28,6 +28,7 @@ const (
 )
 
 func RegisterControllerMetrics() {
+	registerRetryMetrics()
 	registerGoRuntimeMetrics()
 	prometheus.MustRegister(EventbusGauge)
 	prometheus.MustRegister(EventlogGaugeVec)
@@ -37,9 +38,12 @@ func RegisterControllerMetrics() {
 	prometheus.MustRegister(SubscriptionGauge)
 	prometheus.MustRegister(SubscriptionTransformerGauge)
 	prometheus.MustRegister(CtrlTriggerGauge)
+	prometheus.MustRegister(SubscriptionLagEventGauge)
+	prometheus.MustRegister(SubscriptionLagTimeGauge)
 }
 
 func RegisterTriggerMetrics() {
+	registerRetryMetrics()
 	registerGoRuntimeMetrics()
 	prometheus.MustRegister(TriggerGauge)
 	prometheus.MustRegister(TriggerPullEventCounter)
@@ -51,11 +55,18 @@ func RegisterTriggerMetrics() {
 	prometheus.MustRegister(TriggerRetryEventAppendSecond)
 	prometheus.MustRegister(TriggerDeadLetterEventCounter)
 	prometheus.MustRegister(TriggerDeadLetterEventAppendSecond)
+	prometheus.MustRegister(TriggerDedupEventCounter)
+	prometheus.MustRegister(TriggerDelayEventCounter)
+	prometheus.MustRegister(TriggerPoisonEventCounter)
+	prometheus.MustRegister(TriggerReplyEventCounter)
+	prometheus.MustRegister(TriggerCorrelationEventCounter)
 	prometheus.MustRegister(TriggerPushEventCounter)
 	prometheus.MustRegister(TriggerPushEventTime)
+	prometheus.MustRegister(TriggerDeliveryLatencySecond)
 }
 
 func RegisterTimerMetrics() {
+	registerRetryMetrics()
 	prometheus.MustRegister(TimingWheelTickGauge)
 	prometheus.MustRegister(TimingWheelSizeGauge)
 	prometheus.MustRegister(TimingWheelLayersGauge)
@@ -64,13 +75,46 @@ func RegisterTimerMetrics() {
 	prometheus.MustRegister(TimerScheduledEventDelayTime)
 	prometheus.MustRegister(TimerPushEventTime)
 	prometheus.MustRegister(TimerDeliverEventTime)
+	prometheus.MustRegister(TimerFireDriftSeconds)
+	prometheus.MustRegister(TimerTickDriftSeconds)
+	prometheus.MustRegister(TimerDriftAlertCounter)
+}
+
+// RegisterStandaloneMetrics registers the metrics of all components running in one process.
+func RegisterStandaloneMetrics() {
+	RegisterControllerMetrics()
+	RegisterSegmentServerMetrics()
+	RegisterTimerMetrics()
+	RegisterTriggerMetrics()
+}
+
+func RegisterMirrorMetrics() {
+	registerRetryMetrics()
+	prometheus.MustRegister(MirrorEventCounterVec)
+	prometheus.MustRegister(MirrorLagEventGaugeVec)
+	prometheus.MustRegister(MirrorLagSecondGaugeVec)
 }
 
 func RegisterSegmentServerMetrics() {
+	registerRetryMetrics()
 	prometheus.MustRegister(WriteTPSCounterVec)
 	prometheus.MustRegister(WriteThroughputCounterVec)
 	prometheus.MustRegister(ReadTPSCounterVec)
 	prometheus.MustRegister(ReadThroughputCounterVec)
+	prometheus.MustRegister(WALEntryWriteCounter)
+	prometheus.MustRegister(WALEntryWriteSizeCounter)
+	prometheus.MustRegister(WALRecordWriteCounter)
+	prometheus.MustRegister(WALRecordWriteSizeCounter)
+	prometheus.MustRegister(WALFlushSecond)
+	prometheus.MustRegister(StoreAppendStageSecond)
+	prometheus.MustRegister(StoreReadSecond)
+	prometheus.MustRegister(StoreFragmentBatchNumber)
+	prometheus.MustRegister(StoreFragmentBatchByte)
+	prometheus.MustRegister(StoreFragmentSkippedCounterVec)
+	prometheus.MustRegister(StoreFragmentSkippedByteCounterVec)
+	prometheus.MustRegister(StoreFragmentDiscontinuityCounterVec)
+	prometheus.MustRegister(StoreBlockArchivedCounterVec)
+	prometheus.MustRegister(StoreIndexMemoryGaugeVec)
 }
 
 func registerGoRuntimeMetrics() {
@@ -0,0 +1,42 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	moduleOfMirror = "mirror"
+
+	MirrorEventCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfMirror,
+		Name:      "event_count",
+		Help:      "Total events read by mirror, the skipped ones originated from the target cluster",
+	}, []string{LabelEventbus, LabelResult})
+
+	MirrorLagEventGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfMirror,
+		Name:      "lag_event_number",
+		Help:      "The number of events which haven't been mirrored",
+	}, []string{LabelEventbus, LabelEventlog})
+
+	MirrorLagSecondGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfMirror,
+		Name:      "lag_second",
+		Help:      "The seconds between the last mirrored event was stored and mirrored",
+	}, []string{LabelEventbus})
+)
@@ -0,0 +1,276 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/metric/global"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	"go.opentelemetry.io/otel/sdk/metric/export/aggregation"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	selector "go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const (
+	defaultOTLPInterval = 15 * time.Second
+	bridgeScopeName     = "github.com/linkall-labs/vanus/observability/metrics/prometheus"
+)
+
+type OTLPConfig struct {
+	ServerName    string        `yaml:"-"`
+	Enable        bool          `yaml:"enable"`
+	OtelCollector string        `yaml:"otel_collector"`
+	Interval      time.Duration `yaml:"interval"`
+}
+
+func (c OTLPConfig) GetInterval() time.Duration {
+	if c.Interval <= 0 {
+		return defaultOTLPInterval
+	}
+	return c.Interval
+}
+
+// InitOTLP installs an OTel meter provider which pushes to the collector and starts
+// a bridge which forwards everything registered with the default prometheus registry,
+// so that traces and metrics of a component end up at the same collector.
+func InitOTLP(ctx context.Context, cfg OTLPConfig) error {
+	endpoint := strings.TrimPrefix(strings.TrimPrefix(cfg.OtelCollector, "http://"), "https://")
+	client := otlpmetricgrpc.NewClient(
+		otlpmetricgrpc.WithEndpoint(endpoint),
+		otlpmetricgrpc.WithInsecure(),
+	)
+	exporter, err := otlpmetric.New(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to create metric exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(cfg.ServerName),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	c := controller.New(
+		processor.NewFactory(
+			selector.NewWithHistogramDistribution(),
+			aggregation.CumulativeTemporalitySelector(),
+		),
+		controller.WithExporter(exporter),
+		controller.WithResource(res),
+		controller.WithCollectPeriod(cfg.GetInterval()),
+	)
+	if err = c.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start meter provider: %w", err)
+	}
+	global.SetMeterProvider(c)
+
+	b := &promBridge{
+		gatherer: prometheus.DefaultGatherer,
+		client:   client,
+		resource: toResourcePB(res),
+		start:    time.Now(),
+	}
+	go b.run(ctx, cfg.GetInterval())
+	log.Info(ctx, "otlp metrics exporter started", map[string]interface{}{
+		"collector": endpoint,
+		"interval":  cfg.GetInterval(),
+	})
+	return nil
+}
+
+// promBridge periodically gathers prometheus metrics and uploads them in OTLP format.
+type promBridge struct {
+	gatherer prometheus.Gatherer
+	client   otlpmetric.Client
+	resource *resourcepb.Resource
+	start    time.Time
+}
+
+func (b *promBridge) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.push(ctx); err != nil {
+				log.Warning(ctx, "failed to push prometheus metrics to collector", map[string]interface{}{
+					log.KeyError: err,
+				})
+			}
+		}
+	}
+}
+
+func (b *promBridge) push(ctx context.Context) error {
+	families, err := b.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+	return b.client.UploadMetrics(ctx, &metricpb.ResourceMetrics{
+		Resource: b.resource,
+		ScopeMetrics: []*metricpb.ScopeMetrics{{
+			Scope:   &commonpb.InstrumentationScope{Name: bridgeScopeName},
+			Metrics: convertFamilies(families, b.start, time.Now()),
+		}},
+	})
+}
+
+func convertFamilies(families []*dto.MetricFamily, start, now time.Time) []*metricpb.Metric {
+	startNano := uint64(start.UnixNano())
+	nowNano := uint64(now.UnixNano())
+	result := make([]*metricpb.Metric, 0, len(families))
+	for _, mf := range families {
+		m := &metricpb.Metric{
+			Name:        mf.GetName(),
+			Description: mf.GetHelp(),
+		}
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			points := make([]*metricpb.NumberDataPoint, 0, len(mf.Metric))
+			for _, pm := range mf.Metric {
+				points = append(points, &metricpb.NumberDataPoint{
+					Attributes:        toAttributes(pm.Label),
+					StartTimeUnixNano: startNano,
+					TimeUnixNano:      nowNano,
+					Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: pm.GetCounter().GetValue()},
+				})
+			}
+			m.Data = &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+				DataPoints:             points,
+				AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+			}}
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			points := make([]*metricpb.NumberDataPoint, 0, len(mf.Metric))
+			for _, pm := range mf.Metric {
+				v := pm.GetGauge().GetValue()
+				if mf.GetType() == dto.MetricType_UNTYPED {
+					v = pm.GetUntyped().GetValue()
+				}
+				points = append(points, &metricpb.NumberDataPoint{
+					Attributes:   toAttributes(pm.Label),
+					TimeUnixNano: nowNano,
+					Value:        &metricpb.NumberDataPoint_AsDouble{AsDouble: v},
+				})
+			}
+			m.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: points}}
+		case dto.MetricType_HISTOGRAM:
+			points := make([]*metricpb.HistogramDataPoint, 0, len(mf.Metric))
+			for _, pm := range mf.Metric {
+				points = append(points, toHistogramPoint(pm, startNano, nowNano))
+			}
+			m.Data = &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+				DataPoints:             points,
+				AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			}}
+		case dto.MetricType_SUMMARY:
+			points := make([]*metricpb.SummaryDataPoint, 0, len(mf.Metric))
+			for _, pm := range mf.Metric {
+				s := pm.GetSummary()
+				quantiles := make([]*metricpb.SummaryDataPoint_ValueAtQuantile, 0, len(s.Quantile))
+				for _, q := range s.Quantile {
+					quantiles = append(quantiles, &metricpb.SummaryDataPoint_ValueAtQuantile{
+						Quantile: q.GetQuantile(),
+						Value:    q.GetValue(),
+					})
+				}
+				points = append(points, &metricpb.SummaryDataPoint{
+					Attributes:        toAttributes(pm.Label),
+					StartTimeUnixNano: startNano,
+					TimeUnixNano:      nowNano,
+					Count:             s.GetSampleCount(),
+					Sum:               s.GetSampleSum(),
+					QuantileValues:    quantiles,
+				})
+			}
+			m.Data = &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: points}}
+		default:
+			continue
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// toHistogramPoint converts the cumulative buckets of prometheus to the per-bucket
+// counts of OTLP, the +Inf bucket of prometheus is implicit in OTLP.
+func toHistogramPoint(pm *dto.Metric, startNano, nowNano uint64) *metricpb.HistogramDataPoint {
+	h := pm.GetHistogram()
+	bounds := make([]float64, 0, len(h.Bucket))
+	counts := make([]uint64, 0, len(h.Bucket)+1)
+	var prev uint64
+	for _, bucket := range h.Bucket {
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			continue
+		}
+		bounds = append(bounds, bucket.GetUpperBound())
+		counts = append(counts, bucket.GetCumulativeCount()-prev)
+		prev = bucket.GetCumulativeCount()
+	}
+	counts = append(counts, h.GetSampleCount()-prev)
+	sum := h.GetSampleSum()
+	return &metricpb.HistogramDataPoint{
+		Attributes:        toAttributes(pm.Label),
+		StartTimeUnixNano: startNano,
+		TimeUnixNano:      nowNano,
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+		BucketCounts:      counts,
+		ExplicitBounds:    bounds,
+	}
+}
+
+func toAttributes(labels []*dto.LabelPair) []*commonpb.KeyValue {
+	attrs := make([]*commonpb.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		attrs = append(attrs, &commonpb.KeyValue{
+			Key:   l.GetName(),
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: l.GetValue()}},
+		})
+	}
+	return attrs
+}
+
+func toResourcePB(res *resource.Resource) *resourcepb.Resource {
+	attrs := make([]*commonpb.KeyValue, 0, res.Len())
+	iter := res.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		attrs = append(attrs, &commonpb.KeyValue{
+			Key:   string(kv.Key),
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: kv.Value.Emit()}},
+		})
+	}
+	return &resourcepb.Resource{Attributes: attrs}
+}
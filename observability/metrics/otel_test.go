@@ -0,0 +1,92 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+func TestConvertFamilies(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "test_counter",
+	}, []string{"k"})
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_gauge"})
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_histogram",
+		Buckets: []float64{1, 10},
+	})
+	reg.MustRegister(counter, gauge, histogram)
+
+	counter.WithLabelValues("v").Add(3)
+	gauge.Set(7)
+	histogram.Observe(0.5)
+	histogram.Observe(5)
+	histogram.Observe(50)
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	result := convertFamilies(families, now.Add(-time.Minute), now)
+	if len(result) != 3 {
+		t.Fatalf("expect 3 metrics, got %d", len(result))
+	}
+	byName := map[string]*metricpb.Metric{}
+	for _, m := range result {
+		byName[m.Name] = m
+	}
+
+	sum := byName["test_counter"].GetSum()
+	if sum == nil || !sum.IsMonotonic || len(sum.DataPoints) != 1 {
+		t.Fatalf("unexpected counter: %v", byName["test_counter"])
+	}
+	dp := sum.DataPoints[0]
+	if dp.GetAsDouble() != 3 || len(dp.Attributes) != 1 || dp.Attributes[0].Key != "k" ||
+		dp.Attributes[0].Value.GetStringValue() != "v" {
+		t.Fatalf("unexpected counter point: %v", dp)
+	}
+
+	g := byName["test_gauge"].GetGauge()
+	if g == nil || g.DataPoints[0].GetAsDouble() != 7 {
+		t.Fatalf("unexpected gauge: %v", byName["test_gauge"])
+	}
+
+	h := byName["test_histogram"].GetHistogram()
+	if h == nil || len(h.DataPoints) != 1 {
+		t.Fatalf("unexpected histogram: %v", byName["test_histogram"])
+	}
+	hp := h.DataPoints[0]
+	if hp.Count != 3 || hp.GetSum() != 55.5 {
+		t.Fatalf("unexpected histogram count or sum: %v", hp)
+	}
+	if len(hp.ExplicitBounds) != 2 || hp.ExplicitBounds[0] != 1 || hp.ExplicitBounds[1] != 10 {
+		t.Fatalf("unexpected bounds: %v", hp.ExplicitBounds)
+	}
+	expect := []uint64{1, 1, 1}
+	if len(hp.BucketCounts) != len(expect) {
+		t.Fatalf("unexpected bucket counts: %v", hp.BucketCounts)
+	}
+	for i := range expect {
+		if hp.BucketCounts[i] != expect[i] {
+			t.Fatalf("unexpected bucket counts: %v", hp.BucketCounts)
+		}
+	}
+}
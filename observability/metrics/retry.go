@@ -0,0 +1,56 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	moduleOfRetry = "retry"
+	retryOnce     sync.Once
+
+	RetryCallCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfRetry,
+		Name:      "call_count",
+		Help:      "Total calls with retry by operation and the final result",
+	}, []string{LabelOperation, LabelResult})
+
+	RetryAttemptsHistogramVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfRetry,
+		Name:      "attempts",
+		Help:      "The number of attempts of each call with retry",
+		Buckets:   []float64{1, 2, 3, 5, 8, 13, 21},
+	}, []string{LabelOperation})
+)
+
+// registerRetryMetrics registers the retry metrics only once, they're shared by the components running
+// in one process.
+func registerRetryMetrics() {
+	retryOnce.Do(func() {
+		prometheus.MustRegister(RetryCallCounterVec)
+		prometheus.MustRegister(RetryAttemptsHistogramVec)
+	})
+}
+
+// ObserveRetry records a call with retry, it's the recorder of the retry package.
+func ObserveRetry(operation, result string, attempts int) {
+	RetryCallCounterVec.WithLabelValues(operation, result).Inc()
+	RetryAttemptsHistogramVec.WithLabelValues(operation).Observe(float64(attempts))
+}
@@ -74,4 +74,82 @@ var (
 		Name:      "wal_record_write_size",
 		Help:      "Total record size (in bytes) for wal writing",
 	})
+
+	WALFlushSecond = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "wal_flush_second",
+		Help:      "The cost second of flushing wal block, the wal is written with O_DSYNC",
+		Buckets:   storeLatencyBuckets,
+	})
+
+	StoreAppendStageSecond = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_append_stage_second",
+		Help:      "The cost second of each stage of appending entries to block",
+		Buckets:   storeLatencyBuckets,
+	}, []string{LabelVolume, LabelBlock, LabelStage})
+
+	StoreReadSecond = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_read_second",
+		Help:      "The cost second of reading entries from block",
+		Buckets:   storeLatencyBuckets,
+	}, []string{LabelVolume, LabelBlock})
+
+	StoreFragmentBatchNumber = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_fragment_batch_number",
+		Help:      "The number of fragments committed to block in a batch",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 8),
+	}, []string{LabelVolume, LabelBlock})
+
+	StoreFragmentBatchByte = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_fragment_batch_byte",
+		Help:      "The bytes of fragments committed to block in a batch",
+		Buckets:   prometheus.ExponentialBuckets(256, 4, 10),
+	}, []string{LabelVolume, LabelBlock})
+
+	StoreFragmentSkippedCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_fragment_skipped_count",
+		Help:      "Total duplicate fragments skipped by block, whose data has been written",
+	}, []string{LabelVolume, LabelBlock})
+
+	StoreFragmentSkippedByteCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_fragment_skipped_byte",
+		Help:      "Total bytes of duplicate fragments skipped by block",
+	}, []string{LabelVolume, LabelBlock})
+
+	StoreFragmentDiscontinuityCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_fragment_discontinuity_count",
+		Help:      "Total discontinuities of fragments rejected by block",
+	}, []string{LabelVolume, LabelBlock, LabelType})
+
+	StoreBlockArchivedCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_block_archived_count",
+		Help:      "Total blocks archived",
+	}, []string{LabelVolume})
+
+	StoreIndexMemoryGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "store_index_memory_byte",
+		Help:      "The memory bytes of indexes kept by block",
+	}, []string{LabelVolume, LabelBlock})
 )
+
+// storeLatencyBuckets are from 50us to about 1.6s.
+var storeLatencyBuckets = prometheus.ExponentialBuckets(0.00005, 2, 16)
@@ -74,4 +74,27 @@ var (
 		Name:      "deliver_scheduled_event_time",
 		Help:      "The time of timer deliver scheduled event",
 	}, []string{LabelTimerDeliverScheduledEventTime})
+
+	TimerFireDriftSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTimer,
+		Name:      "fire_drift_seconds",
+		Help:      "The difference between the actual and scheduled fire time of each event",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 15),
+	})
+
+	TimerTickDriftSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTimer,
+		Name:      "tick_drift_seconds",
+		Help:      "The max drift of events fired in each tick",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 15),
+	})
+
+	TimerDriftAlertCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTimer,
+		Name:      "drift_alert_count",
+		Help:      "Total ops events emitted because the drift exceeded the threshold",
+	})
 )
@@ -89,6 +89,41 @@ var (
 		Help:      "The cost second of dead letter event append",
 	}, []string{LabelTrigger})
 
+	TriggerDedupEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "dedup_event_number",
+		Help:      "The event number of duplicate event dropped",
+	}, []string{LabelTrigger})
+
+	TriggerDelayEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "delay_event_number",
+		Help:      "The event number of not due event parked in timer",
+	}, []string{LabelTrigger})
+
+	TriggerPoisonEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "poison_event_number",
+		Help:      "The event number of quarantined poison event",
+	}, []string{LabelTrigger})
+
+	TriggerReplyEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "reply_event_number",
+		Help:      "The event number of replied by sink",
+	}, []string{LabelTrigger})
+
+	TriggerCorrelationEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "correlation_event_number",
+		Help:      "The event number of correlation by result",
+	}, []string{LabelTrigger, LabelResult})
+
 	TriggerPushEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfTriggerWorker,
@@ -102,4 +137,12 @@ var (
 		Name:      "push_event_rt",
 		Help:      "The rt of trigger push event",
 	}, []string{LabelTrigger})
+
+	TriggerDeliveryLatencySecond = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "delivery_latency_second",
+		Help:      "The latency from the event is written to eventbus to the sink acks it",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 18),
+	}, []string{LabelTrigger})
 )
@@ -19,41 +19,79 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/linkall-labs/vanus/observability/health"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/observability/profiling"
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func Initialize(cfg Config, metricsFunc func()) error {
-	if cfg.M.Enable {
-		if metricsFunc != nil {
-			metricsFunc()
+	if (cfg.M.Enable || cfg.M.OTLP.Enable) && metricsFunc != nil {
+		metricsFunc()
+	}
+	profiling.Init(cfg.P)
+	if cfg.LogLevel != "" {
+		log.SetLogLevel(cfg.LogLevel)
+	}
+	// the http server always runs because the probes of health are served by it.
+	go func() {
+		mux := http.NewServeMux()
+		if cfg.M.Enable {
+			mux.Handle("/metrics", promhttp.Handler())
 		}
-		go func() {
-			http.Handle("/metrics", promhttp.Handler())
-			if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.M.GetPort()), nil); err != nil {
-				log.Error(context.Background(), "Metrics listen and serve failed.", map[string]interface{}{
-					log.KeyError: err,
-				})
-			}
-		}()
+		mux.Handle(log.LevelHandlerPath, log.LevelHandler())
+		profiling.RegisterHandlers(mux)
+		health.RegisterHandlers(mux)
+		mux.HandleFunc("/", serveHandlers)
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.M.GetPort()), mux); err != nil {
+			log.Error(context.Background(), "Metrics listen and serve failed.", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}()
+	if cfg.M.Enable {
 		log.Info(context.Background(), "metrics module started", map[string]interface{}{
 			"port": cfg.M.Port,
 		})
 	}
 
+	if cfg.M.OTLP.Enable {
+		cfg.M.OTLP.ServerName = cfg.T.ServerName
+		if cfg.M.OTLP.OtelCollector == "" {
+			cfg.M.OTLP.OtelCollector = cfg.T.OtelCollector
+		}
+		if err := metrics.InitOTLP(context.Background(), cfg.M.OTLP); err != nil {
+			log.Error(context.Background(), "failed to init otlp metrics exporter", map[string]interface{}{
+				log.KeyError: err,
+			})
+		}
+	}
+
 	tracing.Init(cfg.T)
 	return nil
 }
 
 type Config struct {
-	M Metrics        `yaml:"metrics"`
-	T tracing.Config `yaml:"tracing"`
+	M Metrics          `yaml:"metrics"`
+	T tracing.Config   `yaml:"tracing"`
+	P profiling.Config `yaml:"profiling"`
+	// LogLevel overrides the level of VANUS_LOG_LEVEL, it's applied again when the config is reloaded.
+	LogLevel string `yaml:"log_level" reload:"true"`
+}
+
+// Reload applies the reloadable fields of the config at runtime.
+func Reload(cfg Config) {
+	if cfg.LogLevel != "" {
+		log.SetLogLevel(cfg.LogLevel)
+	}
 }
 
 type Metrics struct {
-	Enable bool `yaml:"enable"`
-	Port   int  `yaml:"port"`
+	Enable bool               `yaml:"enable"`
+	Port   int                `yaml:"port"`
+	OTLP   metrics.OTLPConfig `yaml:"otlp"`
 }
 
 func (m Metrics) GetPort() int {
@@ -0,0 +1,138 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	runtimepprof "runtime/pprof"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	TypeCPU          = "cpu"
+	TypeHeap         = "heap"
+	TypeAllocs       = "allocs"
+	TypeGoroutine    = "goroutine"
+	TypeBlock        = "block"
+	TypeMutex        = "mutex"
+	TypeThreadCreate = "threadcreate"
+
+	defaultCPUDuration = 30 * time.Second
+	defaultMaxDuration = 5 * time.Minute
+
+	HandlerPathPrefix = "/debug/pprof/"
+)
+
+var (
+	ErrUnknownType = errors.New("unknown profile type")
+	ErrInProgress  = errors.New("another cpu profile is in progress")
+)
+
+type Config struct {
+	// DisableHTTP removes the pprof endpoints from the metrics server.
+	DisableHTTP bool `yaml:"disable_http"`
+	// MaxDuration limits the duration of a cpu profile.
+	MaxDuration time.Duration `yaml:"max_duration"`
+}
+
+func (c Config) GetMaxDuration() time.Duration {
+	if c.MaxDuration <= 0 {
+		return defaultMaxDuration
+	}
+	return c.MaxDuration
+}
+
+var (
+	cfg   Config
+	cpuMu sync.Mutex
+)
+
+func Init(c Config) {
+	cfg = c
+}
+
+// Capture collects a profile of the given type, a cpu profile lasts for the given duration
+// and only one of it can be in progress at the same time.
+func Capture(ctx context.Context, typ string, duration time.Duration) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	if typ != TypeCPU {
+		p := runtimepprof.Lookup(typ)
+		if p == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownType, typ)
+		}
+		if err := p.WriteTo(buf, 0); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	if duration <= 0 {
+		duration = defaultCPUDuration
+	}
+	if limit := cfg.GetMaxDuration(); duration > limit {
+		duration = limit
+	}
+	if !cpuMu.TryLock() {
+		return nil, ErrInProgress
+	}
+	defer cpuMu.Unlock()
+	if err := runtimepprof.StartCPUProfile(buf); err != nil {
+		return nil, err
+	}
+	t := time.NewTimer(duration)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+	runtimepprof.StopCPUProfile()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return buf.Bytes(), nil
+}
+
+// RegisterHandlers mounts the endpoints of net/http/pprof on mux unless they are disabled.
+func RegisterHandlers(mux *http.ServeMux) {
+	if cfg.DisableHTTP {
+		return
+	}
+	mux.HandleFunc(HandlerPathPrefix, pprof.Index)
+	mux.HandleFunc(HandlerPathPrefix+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(HandlerPathPrefix+"profile", limitSeconds(pprof.Profile))
+	mux.HandleFunc(HandlerPathPrefix+"symbol", pprof.Symbol)
+	mux.HandleFunc(HandlerPathPrefix+"trace", limitSeconds(pprof.Trace))
+}
+
+// limitSeconds caps the seconds parameter of the profile and trace endpoints.
+func limitSeconds(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := int64(cfg.GetMaxDuration() / time.Second)
+		if sec, err := strconv.ParseInt(r.FormValue("seconds"), 10, 64); err == nil && sec > limit {
+			q := r.URL.Query()
+			q.Set("seconds", strconv.FormatInt(limit, 10))
+			r.URL.RawQuery = q.Encode()
+			r.Form = nil
+		}
+		h(w, r)
+	}
+}
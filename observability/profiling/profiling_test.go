@@ -0,0 +1,99 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCapture(t *testing.T) {
+	ctx := context.Background()
+	for _, typ := range []string{TypeHeap, TypeGoroutine, TypeAllocs} {
+		data, err := Capture(ctx, typ, 0)
+		if err != nil {
+			t.Fatalf("capture %s failed: %s", typ, err)
+		}
+		if len(data) == 0 {
+			t.Fatalf("the %s profile is empty", typ)
+		}
+	}
+
+	if _, err := Capture(ctx, "unknown", 0); !errors.Is(err, ErrUnknownType) {
+		t.Fatalf("expect ErrUnknownType, got %v", err)
+	}
+
+	Init(Config{MaxDuration: 100 * time.Millisecond})
+	defer Init(Config{})
+	done := make(chan error, 1)
+	go func() {
+		// the duration is capped by MaxDuration.
+		_, err := Capture(ctx, TypeCPU, time.Hour)
+		done <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	if _, err := Capture(ctx, TypeCPU, time.Second); !errors.Is(err, ErrInProgress) {
+		t.Fatalf("expect ErrInProgress, got %v", err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("capture cpu failed: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the cpu profile isn't capped by the max duration")
+	}
+}
+
+func TestLimitSeconds(t *testing.T) {
+	Init(Config{MaxDuration: 10 * time.Second})
+	defer Init(Config{})
+	var got string
+	h := limitSeconds(func(w http.ResponseWriter, r *http.Request) {
+		got = r.FormValue("seconds")
+	})
+	h(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/debug/pprof/profile?seconds=600", nil))
+	if got != "10" {
+		t.Fatalf("expect seconds to be capped to 10, got %s", got)
+	}
+	h(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/debug/pprof/profile?seconds=5", nil))
+	if got != "5" {
+		t.Fatalf("expect seconds to be 5, got %s", got)
+	}
+}
+
+func TestRegisterHandlers(t *testing.T) {
+	Init(Config{DisableHTTP: true})
+	mux := http.NewServeMux()
+	RegisterHandlers(mux)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, HandlerPathPrefix, nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expect pprof to be disabled, got %d", w.Code)
+	}
+
+	Init(Config{})
+	mux = http.NewServeMux()
+	RegisterHandlers(mux)
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, HandlerPathPrefix+"goroutine?debug=1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expect pprof to be served, got %d", w.Code)
+	}
+}
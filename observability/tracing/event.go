@@ -0,0 +1,84 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// The trace context is carried by the CloudEvents distributed tracing extension, whose attributes are
+// traceparent and tracestate in the W3C Trace Context format. It's injected when the event is published
+// and extracted when the event is consumed, so the spans of producers, Vanus and sinks are in one trace.
+
+const (
+	TraceParentExtension = "traceparent"
+	TraceStateExtension  = "tracestate"
+)
+
+var eventPropagator = propagation.TraceContext{}
+
+// Extensible is implemented by *event.Event of CloudEvents SDK.
+type Extensible interface {
+	Extensions() map[string]interface{}
+	SetExtension(name string, value interface{})
+}
+
+type eventCarrier struct {
+	e Extensible
+}
+
+func (c eventCarrier) Get(key string) string {
+	v, ok := c.e.Extensions()[key]
+	if !ok {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+func (c eventCarrier) Set(key, value string) {
+	c.e.SetExtension(key, value)
+}
+
+func (c eventCarrier) Keys() []string {
+	return []string{TraceParentExtension, TraceStateExtension}
+}
+
+// InjectEvent sets the trace context of ctx to the event. The event which has carried a trace context
+// keeps it, the trace starts from where the event was created.
+func InjectEvent(ctx context.Context, e Extensible) {
+	if _, ok := e.Extensions()[TraceParentExtension]; ok {
+		return
+	}
+	if !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	eventPropagator.Inject(ctx, eventCarrier{e: e})
+}
+
+// ExtractEvent returns a context with the trace context carried by the event as the remote parent, the
+// ctx is returned if the event carries nothing or it already has a span.
+func ExtractEvent(ctx context.Context, e Extensible) context.Context {
+	if oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	return eventPropagator.Extract(ctx, eventCarrier{e: e})
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+type testEvent map[string]interface{}
+
+func (e testEvent) Extensions() map[string]interface{} {
+	return e
+}
+
+func (e testEvent) SetExtension(name string, value interface{}) {
+	e[name] = value
+}
+
+func TestInjectAndExtractEvent(t *testing.T) {
+	traceID, _ := oteltrace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := oteltrace.SpanIDFromHex("00f067aa0ba902b7")
+	sc := oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: oteltrace.FlagsSampled,
+	})
+	ctx := oteltrace.ContextWithSpanContext(context.Background(), sc)
+
+	e := testEvent{}
+	InjectEvent(context.Background(), e)
+	if len(e) != 0 {
+		t.Fatalf("inject without span: %v", e)
+	}
+	InjectEvent(ctx, e)
+	want := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	if e[TraceParentExtension] != want {
+		t.Fatalf("got traceparent %v, want %s", e[TraceParentExtension], want)
+	}
+
+	// the trace context carried by event isn't overwritten.
+	other := oteltrace.ContextWithSpanContext(context.Background(), sc.WithSpanID(oteltrace.SpanID{1}))
+	InjectEvent(other, e)
+	if e[TraceParentExtension] != want {
+		t.Fatalf("traceparent is overwritten: %v", e[TraceParentExtension])
+	}
+
+	got := oteltrace.SpanContextFromContext(ExtractEvent(context.Background(), e))
+	if got.TraceID() != traceID || got.SpanID() != spanID || !got.IsRemote() {
+		t.Errorf("extracted %v", got)
+	}
+	// the span of ctx takes precedence.
+	got = oteltrace.SpanContextFromContext(ExtractEvent(other, e))
+	if got.SpanID() != (oteltrace.SpanID{1}) {
+		t.Errorf("extracted %v", got)
+	}
+}
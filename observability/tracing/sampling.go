@@ -0,0 +1,221 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultSlowThreshold = time.Second
+	// the spans of a trace are buffered until its local root span ends, the buffer is bounded.
+	maxPendingTraces = 10000
+	pendingTraceTTL  = time.Minute
+	// the spans of a slow trace which end after the slow span are exported as well.
+	maxSlowTraces = 10000
+	slowTraceTTL  = time.Minute
+)
+
+// DefaultSlowSpans are the spans checked by the slow request sampling by default, which are the
+// committing of appends in the store and the delivery of events in the trigger.
+var DefaultSlowSpans = []string{
+	"store.vsb.vsBlock/CommitAppend",
+	"trigger/sendEvent",
+}
+
+// SlowRequestConfig exports a trace entirely if any of its checked spans lasts longer than the
+// threshold, even if it isn't sampled at head.
+type SlowRequestConfig struct {
+	Enable    bool          `yaml:"enable"`
+	Threshold time.Duration `yaml:"threshold"`
+	// Spans are the names of checked spans, in form of module/method, defaults to DefaultSlowSpans.
+	Spans []string `yaml:"spans"`
+}
+
+func (c SlowRequestConfig) GetThreshold() time.Duration {
+	if c.Threshold <= 0 {
+		return defaultSlowThreshold
+	}
+	return c.Threshold
+}
+
+func (c SlowRequestConfig) GetSpans() []string {
+	if len(c.Spans) == 0 {
+		return DefaultSlowSpans
+	}
+	return c.Spans
+}
+
+// newSampler samples traces by the ratio at head. If the slow request sampling is enabled, the spans
+// which aren't sampled are still recorded, so that the slow ones can be exported at tail.
+func newSampler(ratio float64, slow bool) trace.Sampler {
+	var base trace.Sampler
+	if ratio <= 0 || ratio >= 1 {
+		base = trace.AlwaysSample()
+	} else {
+		base = trace.ParentBased(trace.TraceIDRatioBased(ratio))
+	}
+	if !slow {
+		return base
+	}
+	return recordingSampler{base: base}
+}
+
+type recordingSampler struct {
+	base trace.Sampler
+}
+
+func (s recordingSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	res := s.base.ShouldSample(p)
+	if res.Decision == trace.Drop {
+		res.Decision = trace.RecordOnly
+	}
+	return res
+}
+
+func (s recordingSampler) Description() string {
+	return "RecordingSampler{" + s.base.Description() + "}"
+}
+
+type pendingTrace struct {
+	spans   []trace.ReadOnlySpan
+	created time.Time
+}
+
+// slowSpanProcessor forwards sampled spans to next. The spans which aren't sampled are buffered
+// by trace until the local root span ends, and they are forwarded as sampled ones if any checked
+// span of the trace is slow, otherwise they are dropped.
+type slowSpanProcessor struct {
+	next      trace.SpanProcessor
+	threshold time.Duration
+	spans     map[string]struct{}
+	pending   map[oteltrace.TraceID]*pendingTrace
+	slow      map[oteltrace.TraceID]time.Time
+	mu        sync.Mutex
+}
+
+func newSlowSpanProcessor(next trace.SpanProcessor, cfg SlowRequestConfig) *slowSpanProcessor {
+	p := &slowSpanProcessor{
+		next:      next,
+		threshold: cfg.GetThreshold(),
+		spans:     map[string]struct{}{},
+		pending:   map[oteltrace.TraceID]*pendingTrace{},
+		slow:      map[oteltrace.TraceID]time.Time{},
+	}
+	for _, name := range cfg.GetSpans() {
+		p.spans[name] = struct{}{}
+	}
+	return p
+}
+
+func (p *slowSpanProcessor) OnStart(parent context.Context, s trace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+func (p *slowSpanProcessor) OnEnd(s trace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+		return
+	}
+	for _, span := range p.collect(s, time.Now()) {
+		p.next.OnEnd(sampledSpan{ReadOnlySpan: span})
+	}
+}
+
+// collect returns the spans to be exported when s ends.
+func (p *slowSpanProcessor) collect(s trace.ReadOnlySpan, now time.Time) []trace.ReadOnlySpan {
+	id := s.SpanContext().TraceID()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.slow[id]; ok {
+		return []trace.ReadOnlySpan{s}
+	}
+	if p.isSlow(s) {
+		p.markSlow(id, now)
+		spans := []trace.ReadOnlySpan{s}
+		if pt, ok := p.pending[id]; ok {
+			spans = append(pt.spans, s)
+			delete(p.pending, id)
+		}
+		return spans
+	}
+	if !s.Parent().IsValid() || s.Parent().IsRemote() {
+		// the local part of the trace is completed.
+		delete(p.pending, id)
+		return nil
+	}
+	pt, ok := p.pending[id]
+	if !ok {
+		if len(p.pending) >= maxPendingTraces {
+			for k, v := range p.pending {
+				if now.Sub(v.created) > pendingTraceTTL {
+					delete(p.pending, k)
+				}
+			}
+			if len(p.pending) >= maxPendingTraces {
+				return nil
+			}
+		}
+		pt = &pendingTrace{created: now}
+		p.pending[id] = pt
+	}
+	pt.spans = append(pt.spans, s)
+	return nil
+}
+
+func (p *slowSpanProcessor) isSlow(s trace.ReadOnlySpan) bool {
+	if _, ok := p.spans[s.Name()]; !ok {
+		return false
+	}
+	return s.EndTime().Sub(s.StartTime()) >= p.threshold
+}
+
+func (p *slowSpanProcessor) markSlow(id oteltrace.TraceID, now time.Time) {
+	if len(p.slow) >= maxSlowTraces {
+		for k, v := range p.slow {
+			if now.Sub(v) > slowTraceTTL {
+				delete(p.slow, k)
+			}
+		}
+		if len(p.slow) >= maxSlowTraces {
+			p.slow = map[oteltrace.TraceID]time.Time{}
+		}
+	}
+	p.slow[id] = now
+}
+
+func (p *slowSpanProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *slowSpanProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// sampledSpan marks a recorded span as sampled, the exporters drop the spans which aren't sampled.
+type sampledSpan struct {
+	trace.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() oteltrace.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
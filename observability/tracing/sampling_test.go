@@ -0,0 +1,94 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func newTestProvider(sampler trace.Sampler) (*trace.TracerProvider, *tracetest.SpanRecorder, *slowSpanProcessor) {
+	recorder := tracetest.NewSpanRecorder()
+	p := newSlowSpanProcessor(recorder, SlowRequestConfig{
+		Enable:    true,
+		Threshold: time.Second,
+	})
+	return trace.NewTracerProvider(trace.WithSampler(sampler), trace.WithSpanProcessor(p)), recorder, p
+}
+
+// startTrace starts a trace of three spans, the CommitAppend span lasts for d.
+func startTrace(tp oteltrace.TracerProvider, d time.Duration) {
+	now := time.Now()
+	ctx, root := tp.Tracer("store.segment.server").Start(context.Background(), "store.segment.server/AppendToBlock",
+		oteltrace.WithTimestamp(now))
+	ctx, commit := tp.Tracer("store.vsb.vsBlock").Start(ctx, "store.vsb.vsBlock/CommitAppend",
+		oteltrace.WithTimestamp(now))
+	_, inner := tp.Tracer("store.wal.walog").Start(ctx, "store.wal.walog/Append", oteltrace.WithTimestamp(now))
+	inner.End(oteltrace.WithTimestamp(now.Add(time.Millisecond)))
+	commit.End(oteltrace.WithTimestamp(now.Add(d)))
+	root.End(oteltrace.WithTimestamp(now.Add(d)))
+}
+
+func TestSlowSpanProcessor(t *testing.T) {
+	tp, recorder, p := newTestProvider(recordingSampler{base: trace.NeverSample()})
+
+	startTrace(tp, 10*time.Millisecond)
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("expect the fast trace to be dropped, got %d spans", n)
+	}
+	if len(p.pending) != 0 {
+		t.Fatalf("expect the pending spans to be released, got %d traces", len(p.pending))
+	}
+
+	startTrace(tp, 2*time.Second)
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expect the slow trace to be exported entirely, got %d spans", len(spans))
+	}
+	for _, s := range spans {
+		if !s.SpanContext().IsSampled() {
+			t.Fatalf("expect span %s to be sampled", s.Name())
+		}
+	}
+	if len(p.pending) != 0 {
+		t.Fatalf("expect the pending spans to be released, got %d traces", len(p.pending))
+	}
+}
+
+func TestSlowSpanProcessor_Sampled(t *testing.T) {
+	tp, recorder, _ := newTestProvider(newSampler(0, true))
+	startTrace(tp, 10*time.Millisecond)
+	if n := len(recorder.Ended()); n != 3 {
+		t.Fatalf("expect the sampled trace to be exported, got %d spans", n)
+	}
+}
+
+func TestNewSampler(t *testing.T) {
+	if _, ok := newSampler(0.5, true).(recordingSampler); !ok {
+		t.Fatal("expect the recording sampler if the slow request sampling is enabled")
+	}
+	if _, ok := newSampler(0.5, false).(recordingSampler); ok {
+		t.Fatal("expect no recording sampler if the slow request sampling is disabled")
+	}
+	res := recordingSampler{base: trace.NeverSample()}.ShouldSample(trace.SamplingParameters{})
+	if res.Decision != trace.RecordOnly {
+		t.Fatalf("expect the dropped span to be recorded, got %v", res.Decision)
+	}
+}
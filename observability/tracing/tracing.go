@@ -43,6 +43,9 @@ type Config struct {
 	ServerName    string `yaml:"-"`
 	Enable        bool   `yaml:"enable"`
 	OtelCollector string `yaml:"otel_collector"`
+	// SampleRatio is the ratio of traces sampled at head, all traces are sampled if it isn't in (0, 1).
+	SampleRatio float64           `yaml:"sample_ratio"`
+	SlowRequest SlowRequestConfig `yaml:"slow_request"`
 }
 
 var tp *tracerProvider
@@ -57,13 +60,15 @@ func Init(cfg Config) {
 	}
 	if cfg.Enable {
 		if cfg.OtelCollector != "" {
-			provider, err := newTracerProvider(p.serverName, cfg.OtelCollector)
+			provider, err := newTracerProvider(cfg)
 			if err != nil {
 				panic("init tracer error: " + err.Error())
 			}
 			p.p = provider
 			log.Info(context.Background(), "tracing module started, OpenTelemetry is enable", map[string]interface{}{
 				"otel_collector": cfg.OtelCollector,
+				"sample_ratio":   cfg.SampleRatio,
+				"slow_request":   cfg.SlowRequest.Enable,
 			})
 		} else {
 			log.Warning(context.Background(), "tracing module is enabled,"+
@@ -121,7 +126,7 @@ func NewTracer(moduleName string, kind oteltrace.SpanKind) *Tracer {
 	}
 }
 
-func newTracerProvider(serviceName string, collectorEndpoint string) (*trace.TracerProvider, error) {
+func newTracerProvider(cfg Config) (*trace.TracerProvider, error) {
 	ctx := context.Background()
 	res, err := resource.New(ctx, resource.WithContainer())
 	if err != nil {
@@ -135,7 +140,7 @@ func newTracerProvider(serviceName string, collectorEndpoint string) (*trace.Tra
 		res,
 		resource.NewWithAttributes(
 			semconv.SchemaURL,
-			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceNameKey.String(cfg.ServerName),
 			semconv.ServiceVersionKey.String(vanusVersion),
 			attribute.String(environmentKey, environmentValue),
 		),
@@ -146,12 +151,12 @@ func newTracerProvider(serviceName string, collectorEndpoint string) (*trace.Tra
 
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
-	conn, err := grpc.DialContext(ctx, collectorEndpoint,
+	conn, err := grpc.DialContext(ctx, cfg.OtelCollector,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gRPC connection to collector[ %s ]: %w",
-			collectorEndpoint, err)
+			cfg.OtelCollector, err)
 	}
 
 	// Set up a trace exporter
@@ -162,11 +167,14 @@ func newTracerProvider(serviceName string, collectorEndpoint string) (*trace.Tra
 
 	// Register the trace exporter with a TracerProvider, using a batch
 	// span processor to aggregate spans before export.
-	bsp := trace.NewBatchSpanProcessor(traceExporter)
+	var sp trace.SpanProcessor = trace.NewBatchSpanProcessor(traceExporter)
+	if cfg.SlowRequest.Enable {
+		sp = newSlowSpanProcessor(sp, cfg.SlowRequest)
+	}
 	tracerProvider := trace.NewTracerProvider(
-		trace.WithSampler(trace.AlwaysSample()),
+		trace.WithSampler(newSampler(cfg.SampleRatio, cfg.SlowRequest.Enable)),
 		trace.WithResource(res),
-		trace.WithSpanProcessor(bsp),
+		trace.WithSpanProcessor(sp),
 	)
 
 	otel.SetTracerProvider(tracerProvider)
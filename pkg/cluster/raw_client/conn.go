@@ -24,6 +24,7 @@ import (
 
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/retry"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -37,6 +38,16 @@ const (
 	vanusConnBypass = "VANUS_CONN_BYPASS"
 )
 
+// invokePolicy retries the call on the renewed leader once if the controller isn't the leader or
+// is unavailable.
+var invokePolicy = retry.Policy{
+	Name:        "controller_invoke",
+	Initial:     50 * time.Millisecond,
+	Jitter:      retry.FullJitter,
+	MaxAttempts: 2,
+	Retryable:   isNeedRetry,
+}
+
 type Conn struct {
 	mutex        sync.Mutex
 	leader       string
@@ -66,25 +77,24 @@ func (c *Conn) invoke(ctx context.Context, method string, args, reply interface{
 		"method": method,
 		"args":   fmt.Sprintf("%v", args),
 	})
-	conn := c.makeSureClient(ctx, false)
-	if conn == nil {
-		log.Warning(ctx, "not get client for controller", map[string]interface{}{})
-		return errors.ErrNoControllerLeader
-	}
-	err := conn.Invoke(ctx, method, args, reply, opts...)
-	if err != nil {
-		log.Warning(ctx, "invoke error, try to retry", map[string]interface{}{
-			log.KeyError: err,
-		})
-	}
-	if isNeedRetry(err) {
-		conn = c.makeSureClient(ctx, true)
+	err := retry.Do(ctx, invokePolicy, func(ctx context.Context, attempt int) error {
+		// the leader may have changed if the previous attempt failed, renew the client.
+		conn := c.makeSureClient(ctx, attempt > 1)
 		if conn == nil {
-			log.Warning(ctx, "not get client when try to renew client", map[string]interface{}{})
+			log.Warning(ctx, "not get client for controller", map[string]interface{}{
+				"attempt": attempt,
+			})
 			return errors.ErrNoControllerLeader
 		}
-		err = conn.Invoke(ctx, method, args, reply, opts...)
-	}
+		err := conn.Invoke(ctx, method, args, reply, opts...)
+		if isNeedRetry(err) {
+			log.Warning(ctx, "invoke error, try to retry", map[string]interface{}{
+				log.KeyError: err,
+				"attempt":    attempt,
+			})
+		}
+		return err
+	})
 	if err != nil {
 		log.Warning(ctx, "invoke error", map[string]interface{}{
 			log.KeyError: err,
@@ -95,3 +95,255 @@ func (ec *eventbusClient) UpdateEventBus(ctx context.Context, in *ctrlpb.UpdateE
 	}
 	return out, nil
 }
+
+func (ec *eventbusClient) CreateCronEvent(ctx context.Context, in *ctrlpb.CreateCronEventRequest, opts ...grpc.CallOption) (*ctrlpb.CronEvent, error) {
+	out := new(ctrlpb.CronEvent)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CreateCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListCronEvent(ctx context.Context, in *ctrlpb.ListCronEventRequest, opts ...grpc.CallOption) (*ctrlpb.ListCronEventResponse, error) {
+	out := new(ctrlpb.ListCronEventResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteCronEvent(ctx context.Context, in *ctrlpb.DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ListTimerReplicaResponse, error) {
+	out := new(ctrlpb.ListTimerReplicaResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListTimerReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ConsumerGroupHeartbeat(ctx context.Context, in *ctrlpb.ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*ctrlpb.ConsumerGroupAssignment, error) {
+	out := new(ctrlpb.ConsumerGroupAssignment)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ConsumerGroupHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) LeaveConsumerGroup(ctx context.Context, in *ctrlpb.LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/LeaveConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CommitConsumerGroupOffset(ctx context.Context, in *ctrlpb.CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CommitConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetConsumerGroupOffset(ctx context.Context, in *ctrlpb.GetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*ctrlpb.GetConsumerGroupOffsetResponse, error) {
+	out := new(ctrlpb.GetConsumerGroupOffsetResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/GetConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) SetConsumerGroupOffset(ctx context.Context, in *ctrlpb.SetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/SetConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ClusterStats, error) {
+	out := new(ctrlpb.ClusterStats)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/GetClusterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CreateReplayJob(ctx context.Context, in *ctrlpb.CreateReplayJobRequest, opts ...grpc.CallOption) (*ctrlpb.ReplayJob, error) {
+	out := new(ctrlpb.ReplayJob)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CreateReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) GetReplayJob(ctx context.Context, in *ctrlpb.GetReplayJobRequest, opts ...grpc.CallOption) (*ctrlpb.ReplayJob, error) {
+	out := new(ctrlpb.ReplayJob)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/GetReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListReplayJob(ctx context.Context, in *ctrlpb.ListReplayJobRequest, opts ...grpc.CallOption) (*ctrlpb.ListReplayJobResponse, error) {
+	out := new(ctrlpb.ListReplayJobResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteReplayJob(ctx context.Context, in *ctrlpb.DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CreateHTTPPoller(ctx context.Context, in *ctrlpb.CreateHTTPPollerRequest, opts ...grpc.CallOption) (*ctrlpb.HTTPPoller, error) {
+	out := new(ctrlpb.HTTPPoller)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CreateHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListHTTPPoller(ctx context.Context, in *ctrlpb.ListHTTPPollerRequest, opts ...grpc.CallOption) (*ctrlpb.ListHTTPPollerResponse, error) {
+	out := new(ctrlpb.ListHTTPPollerResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) PauseHTTPPoller(ctx context.Context, in *ctrlpb.PauseHTTPPollerRequest, opts ...grpc.CallOption) (*ctrlpb.HTTPPoller, error) {
+	out := new(ctrlpb.HTTPPoller)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/PauseHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ResumeHTTPPoller(ctx context.Context, in *ctrlpb.ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*ctrlpb.HTTPPoller, error) {
+	out := new(ctrlpb.HTTPPoller)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ResumeHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteHTTPPoller(ctx context.Context, in *ctrlpb.DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) PutEventbusACL(ctx context.Context, in *ctrlpb.EventbusACL, opts ...grpc.CallOption) (*ctrlpb.EventbusACL, error) {
+	out := new(ctrlpb.EventbusACL)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/PutEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteEventbusACL(ctx context.Context, in *ctrlpb.DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListEventbusACL(ctx context.Context, in *ctrlpb.ListEventbusACLRequest, opts ...grpc.CallOption) (*ctrlpb.ListEventbusACLResponse, error) {
+	out := new(ctrlpb.ListEventbusACLResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) PutIPAllowlist(ctx context.Context, in *ctrlpb.IPAllowlist, opts ...grpc.CallOption) (*ctrlpb.IPAllowlist, error) {
+	out := new(ctrlpb.IPAllowlist)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/PutIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteIPAllowlist(ctx context.Context, in *ctrlpb.DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ListIPAllowlistResponse, error) {
+	out := new(ctrlpb.ListIPAllowlistResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) PutFeatureFlag(ctx context.Context, in *ctrlpb.FeatureFlag, opts ...grpc.CallOption) (*ctrlpb.FeatureFlag, error) {
+	out := new(ctrlpb.FeatureFlag)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/PutFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteFeatureFlag(ctx context.Context, in *ctrlpb.DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ListFeatureFlagResponse, error) {
+	out := new(ctrlpb.ListFeatureFlagResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
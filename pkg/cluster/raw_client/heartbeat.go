@@ -41,7 +41,12 @@ func RegisterHeartbeat(ctx context.Context, interval time.Duration,
 				// TODO log
 				return
 			case <-ticker.C:
-				if err := hb.Beat(ctx, reqFunc()); err != nil {
+				req := reqFunc()
+				if req == nil {
+					// the beat is skipped.
+					continue
+				}
+				if err := hb.Beat(ctx, req); err != nil {
 					log.Warning(ctx, "heartbeat error", map[string]interface{}{
 						log.KeyError: err,
 					})
@@ -66,3 +66,13 @@ func (sfc *snowflakeClient) UnregisterNode(ctx context.Context, in *wrapperspb.U
 	}
 	return out, nil
 }
+
+func (sfc *snowflakeClient) AllocateIDs(ctx context.Context, in *ctrlpb.AllocateIDsRequest,
+	opts ...grpc.CallOption) (*ctrlpb.AllocateIDsResponse, error) {
+	out := &ctrlpb.AllocateIDsResponse{}
+	err := sfc.cc.invoke(ctx, "/linkall.vanus.controller.SnowflakeController/AllocateIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
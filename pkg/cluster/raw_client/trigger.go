@@ -192,6 +192,148 @@ func (tc *triggerClient) CommitOffset(ctx context.Context, in *ctrlpb.CommitOffs
 	return out, nil
 }
 
+func (tc *triggerClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty,
+	opts ...grpc.CallOption) (*ctrlpb.ListTriggerWorkerResponse, error) {
+	out := new(ctrlpb.ListTriggerWorkerResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListTriggerWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ExportSubscription(ctx context.Context, in *ctrlpb.ExportSubscriptionRequest,
+	opts ...grpc.CallOption) (*ctrlpb.ExportSubscriptionResponse, error) {
+	out := new(ctrlpb.ExportSubscriptionResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ExportSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ImportSubscription(ctx context.Context, in *ctrlpb.ImportSubscriptionRequest,
+	opts ...grpc.CallOption) (*metapb.Subscription, error) {
+	out := new(metapb.Subscription)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ImportSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) PutSecret(ctx context.Context, in *ctrlpb.PutSecretRequest,
+	opts ...grpc.CallOption) (*ctrlpb.Secret, error) {
+	out := new(ctrlpb.Secret)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/PutSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) GetSecret(ctx context.Context, in *ctrlpb.GetSecretRequest,
+	opts ...grpc.CallOption) (*ctrlpb.Secret, error) {
+	out := new(ctrlpb.Secret)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ListSecret(ctx context.Context, in *emptypb.Empty,
+	opts ...grpc.CallOption) (*ctrlpb.ListSecretResponse, error) {
+	out := new(ctrlpb.ListSecretResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) DeleteSecret(ctx context.Context, in *ctrlpb.DeleteSecretRequest,
+	opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) PutConnector(ctx context.Context, in *ctrlpb.Connector, opts ...grpc.CallOption) (*ctrlpb.Connector, error) {
+	out := new(ctrlpb.Connector)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/PutConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) GetConnector(ctx context.Context, in *ctrlpb.GetConnectorRequest, opts ...grpc.CallOption) (*ctrlpb.Connector, error) {
+	out := new(ctrlpb.Connector)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/GetConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ListConnectorResponse, error) {
+	out := new(ctrlpb.ListConnectorResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) DeleteConnector(ctx context.Context, in *ctrlpb.DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/DeleteConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ListWorkerConnector(ctx context.Context, in *ctrlpb.ListWorkerConnectorRequest, opts ...grpc.CallOption) (*ctrlpb.ListWorkerConnectorResponse, error) {
+	out := new(ctrlpb.ListWorkerConnectorResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListWorkerConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) CommitConnectorPosition(ctx context.Context, in *ctrlpb.CommitConnectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/CommitConnectorPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatchSecret opens the stream on the leader, it's opened again by the caller after the leader changed.
+func (tc *triggerClient) WatchSecret(ctx context.Context, in *emptypb.Empty,
+	opts ...grpc.CallOption) (ctrlpb.TriggerController_WatchSecretClient, error) {
+	conn := tc.cc.makeSureClient(ctx, false)
+	if conn == nil {
+		return nil, errors.ErrNoControllerLeader
+	}
+	stream, err := ctrlpb.NewTriggerControllerClient(conn).WatchSecret(ctx, in, opts...)
+	if status.Convert(err).Code() != codes.Unavailable {
+		return stream, err
+	}
+	conn = tc.cc.makeSureClient(ctx, true)
+	if conn == nil {
+		return nil, errors.ErrNoControllerLeader
+	}
+	return ctrlpb.NewTriggerControllerClient(conn).WatchSecret(ctx, in, opts...)
+}
+
 func (tc *triggerClient) TriggerWorkerHeartbeat(_ context.Context,
 	_ ...grpc.CallOption) (ctrlpb.TriggerController_TriggerWorkerHeartbeatClient, error) {
 	panic("unsupported method, please use controller.RegisterHeartbeat")
@@ -31,6 +31,7 @@ const (
 	ErrorCode_JSON_PARSE                ErrorCode = 9107
 	ErrorCode_TRANSFORM_INPUT_PARSE     ErrorCode = 9108
 	ErrorCode_CORRUPTED_EVENT           ErrorCode = 9109
+	ErrorCode_EVENT_TOO_LARGE           ErrorCode = 9110
 
 	// ErrorCode_SERVICE_NOT_RUNNING 92xx
 	ErrorCode_SERVICE_NOT_RUNNING           ErrorCode = 9200
@@ -86,6 +87,8 @@ const (
 	// ErrorCode_OTHERS 99xx
 	ErrorCode_RESOURCE_EXHAUSTED  ErrorCode = 9901
 	ErrorCode_RESOURCE_CAN_NOT_OP ErrorCode = 9902
+	ErrorCode_GROUP_REBALANCED    ErrorCode = 9903
+	ErrorCode_PERMISSION_DENIED   ErrorCode = 9904
 )
 
 var (
@@ -143,6 +146,7 @@ var (
 	ErrVanusJSONParse          = New("invalid json").WithGRPCCode(ErrorCode_JSON_PARSE)
 	ErrTransformInputParse     = New("transform input invalid").WithGRPCCode(ErrorCode_TRANSFORM_INPUT_PARSE)
 	ErrCorruptedEvent          = New("corrupted event").WithGRPCCode(ErrorCode_CORRUPTED_EVENT)
+	ErrEventTooLarge           = New("event too large").WithGRPCCode(ErrorCode_EVENT_TOO_LARGE)
 
 	// RESOURCE_EXIST
 	ErrResourceAlreadyExist = New("resource already exist").WithGRPCCode(ErrorCode_RESOURCE_EXIST)
@@ -159,4 +163,10 @@ var (
 
 	// RESOURCE_CAN_NOT_OP
 	ErrResourceCanNotOp = New("resource can not operation").WithGRPCCode(ErrorCode_RESOURCE_CAN_NOT_OP)
+
+	// GROUP_REBALANCED
+	ErrGroupRebalanced = New("consumer group rebalanced").WithGRPCCode(ErrorCode_GROUP_REBALANCED)
+
+	// PERMISSION_DENIED
+	ErrPermissionDenied = New("permission denied").WithGRPCCode(ErrorCode_PERMISSION_DENIED)
 )
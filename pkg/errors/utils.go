@@ -15,7 +15,8 @@
 package errors
 
 import (
-	"fmt"
+	"encoding/json"
+	stderrors "errors"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/status"
@@ -73,11 +74,17 @@ func ConvertToGRPCError(err error) error {
 	if err == nil {
 		return nil
 	}
+	// the error has been returned by another gRPC server, it's forwarded as is to keep its code.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
 	e, ok := err.(*ErrorType)
-	if ok {
-		return fmt.Errorf("{\"code\":%d,\"message\":\"%s\"}",
-			e.Code, e.Message)
+	if !ok {
+		e = &ErrorType{Code: ErrorCode_UNKNOWN, Message: err.Error()}
 	}
-	return fmt.Errorf("{\"code\":%d,\"message\":\"%s\"}",
-		ErrorCode_UNKNOWN, err.Error())
+	data, _ := json.Marshal(struct {
+		Code    ErrorCode `json:"code"`
+		Message string    `json:"message"`
+	}{Code: e.Code, Message: e.Message})
+	return stderrors.New(string(data))
 }
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestChain(t *testing.T) {
@@ -39,3 +41,24 @@ func TestChain(t *testing.T) {
 		So(errors.Unwrap(err).Error(), ShouldResemble, "err4: err3: err2: err1")
 	})
 }
+
+func TestConvertToGRPCError(t *testing.T) {
+	Convey("test convert to gRPC error", t, func() {
+		So(ConvertToGRPCError(nil), ShouldBeNil)
+
+		err := ConvertToGRPCError(ErrResourceNotFound.WithMessage(`eventbus "test" not found`))
+		et, ok := Convert(err.Error())
+		So(ok, ShouldBeTrue)
+		So(et.Code, ShouldEqual, ErrorCode_RESOURCE_NOT_FOUND)
+		So(et.Message, ShouldEqual, `eventbus "test" not found`)
+
+		err = ConvertToGRPCError(errors.New("test"))
+		et, ok = Convert(err.Error())
+		So(ok, ShouldBeTrue)
+		So(et.Code, ShouldEqual, ErrorCode_UNKNOWN)
+
+		grpcErr := status.Error(codes.Unknown, err.Error())
+		So(ConvertToGRPCError(grpcErr), ShouldEqual, grpcErr)
+		So(Is(ConvertToGRPCError(grpcErr), ErrUnknown), ShouldBeTrue)
+	})
+}
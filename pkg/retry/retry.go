@@ -0,0 +1,276 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultInitial    = 100 * time.Millisecond
+	defaultMultiplier = 2
+)
+
+// Jitter is how the backoff is randomized, so the callers failed at the same time don't retry at the
+// same time.
+type Jitter int
+
+const (
+	// NoJitter backs off exactly initial*multiplier^n.
+	NoJitter Jitter = iota
+	// FullJitter backs off a random duration in [0, d).
+	FullJitter
+	// EqualJitter backs off a random duration in [d/2, d).
+	EqualJitter
+	// DecorrelatedJitter backs off a random duration in [initial, 3*previous), it grows with the
+	// previous backoff instead of the attempt.
+	DecorrelatedJitter
+)
+
+const (
+	ResultSuccess      = "success"
+	ResultExhausted    = "exhausted"
+	ResultNotRetryable = "not_retryable"
+	ResultCanceled     = "canceled"
+)
+
+// Policy is how a call is retried.
+type Policy struct {
+	// Name identifies the retried operation in the metrics, the calls of a policy without name
+	// aren't recorded.
+	Name string
+	// Initial is the backoff of the first retry, default 100ms.
+	Initial time.Duration
+	// Max caps the backoff, no cap if it's zero.
+	Max time.Duration
+	// Multiplier is how the backoff grows by attempt, default 2.
+	Multiplier float64
+	Jitter     Jitter
+	// MaxAttempts is the max number of calls including the first one, no limit if it's zero.
+	MaxAttempts int
+	// MaxElapsed is the budget of the whole call including the backoffs, the call isn't retried if
+	// the next backoff exceeds the budget. No budget if it's zero.
+	MaxElapsed time.Duration
+	// Retryable classifies the errors, IsRetryable is used if it's nil.
+	Retryable func(err error) bool
+	// Clock measures the elapsed time and the backoffs, util.RealClock is used if it's nil.
+	Clock util.Clock
+}
+
+func (p Policy) clock() util.Clock {
+	if p.Clock != nil {
+		return p.Clock
+	}
+	return util.RealClock
+}
+
+func (p Policy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// Backoff generates the backoffs of the successive retries of a policy, it isn't safe for concurrent use.
+type Backoff struct {
+	policy  Policy
+	attempt int
+	prev    time.Duration
+}
+
+func (p Policy) Backoff() *Backoff {
+	if p.Initial <= 0 {
+		p.Initial = defaultInitial
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = defaultMultiplier
+	}
+	return &Backoff{policy: p}
+}
+
+// Next returns the backoff before the next retry.
+func (b *Backoff) Next() time.Duration {
+	b.attempt++
+	p := b.policy
+	var d time.Duration
+	switch p.Jitter {
+	case FullJitter:
+		d = random(0, b.exponential())
+	case EqualJitter:
+		e := b.exponential()
+		d = e/2 + random(0, e-e/2)
+	case DecorrelatedJitter:
+		if b.prev < p.Initial {
+			b.prev = p.Initial
+		}
+		d = random(p.Initial, 3*b.prev)
+		if p.Max > 0 && d > p.Max {
+			d = p.Max
+		}
+		b.prev = d
+	default:
+		d = b.exponential()
+	}
+	return d
+}
+
+// Reset makes the backoff start from the first retry again.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+	b.prev = 0
+}
+
+func (b *Backoff) exponential() time.Duration {
+	p := b.policy
+	d := float64(p.Initial) * math.Pow(p.Multiplier, float64(b.attempt-1))
+	if p.Max > 0 && d > float64(p.Max) {
+		return p.Max
+	}
+	if d > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// Exponential returns the backoff before the attempt-th retry without jitter, which doubles from
+// initial and is capped by max.
+func Exponential(attempt int, initial, max time.Duration) time.Duration {
+	if attempt <= 0 {
+		return 0
+	}
+	b := Policy{Initial: initial, Max: max}.Backoff()
+	b.attempt = attempt - 1
+	return b.Next()
+}
+
+func random(min, max time.Duration) time.Duration {
+	if max <= min {
+		return min
+	}
+	return min + time.Duration(rand.Int63n(int64(max-min))) //nolint:gosec // jitter isn't security sensitive.
+}
+
+// Do calls fn until it succeeds, the error isn't retryable, the attempts or the elapsed time are
+// beyond the policy, or ctx is done. It returns the last error of fn.
+func Do(ctx context.Context, p Policy, fn func(ctx context.Context, attempt int) error) error {
+	b := p.Backoff()
+	clock := p.clock()
+	start := clock.Now()
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx, attempt)
+		if err == nil {
+			record(p.Name, ResultSuccess, attempt)
+			return nil
+		}
+		if !p.retryable(err) {
+			record(p.Name, ResultNotRetryable, attempt)
+			return err
+		}
+		if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+			record(p.Name, ResultExhausted, attempt)
+			return err
+		}
+		d := b.Next()
+		if p.MaxElapsed > 0 && clock.Now().Sub(start)+d > p.MaxElapsed {
+			record(p.Name, ResultExhausted, attempt)
+			return err
+		}
+		if !sleep(ctx, clock, d) {
+			record(p.Name, ResultCanceled, attempt)
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, clock util.Clock, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks the error as not retryable.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsRetryable is the default classification of the errors. The errors marked Permanent, the errors
+// of context and the gRPC errors caused by the request itself aren't retryable, the others are.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pe *permanentError
+	if errors.As(err, &pe) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+			codes.Unauthenticated, codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented,
+			codes.Canceled:
+			return false
+		}
+	}
+	return true
+}
+
+var recorder func(name, result string, attempts int)
+
+// SetRecorder sets the function records the result and the attempts of each call retried by a named
+// policy, it should be called before any call.
+func SetRecorder(fn func(name, result string, attempts int)) {
+	recorder = fn
+}
+
+func record(name, result string, attempts int) {
+	if name == "" || recorder == nil {
+		return
+	}
+	recorder(name, result, attempts)
+}
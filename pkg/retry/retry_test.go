@@ -0,0 +1,191 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/util"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBackoff(t *testing.T) {
+	Convey("test backoff", t, func() {
+		Convey("test no jitter", func() {
+			b := Policy{Initial: 10 * time.Millisecond, Max: 50 * time.Millisecond}.Backoff()
+			So(b.Next(), ShouldEqual, 10*time.Millisecond)
+			So(b.Next(), ShouldEqual, 20*time.Millisecond)
+			So(b.Next(), ShouldEqual, 40*time.Millisecond)
+			So(b.Next(), ShouldEqual, 50*time.Millisecond)
+			b.Reset()
+			So(b.Next(), ShouldEqual, 10*time.Millisecond)
+			So(Exponential(0, 100*time.Millisecond, time.Second), ShouldEqual, 0)
+			So(Exponential(3, 100*time.Millisecond, time.Second), ShouldEqual, 400*time.Millisecond)
+		})
+
+		Convey("test full jitter", func() {
+			b := Policy{Initial: 10 * time.Millisecond, Max: 40 * time.Millisecond, Jitter: FullJitter}.Backoff()
+			for i := 1; i <= 10; i++ {
+				d := b.Next()
+				So(d, ShouldBeGreaterThanOrEqualTo, 0)
+				So(d, ShouldBeLessThan, 40*time.Millisecond)
+			}
+		})
+
+		Convey("test equal jitter", func() {
+			b := Policy{Initial: 10 * time.Millisecond, Max: 40 * time.Millisecond, Jitter: EqualJitter}.Backoff()
+			for i := 1; i <= 10; i++ {
+				d := b.Next()
+				So(d, ShouldBeGreaterThanOrEqualTo, 5*time.Millisecond)
+				So(d, ShouldBeLessThan, 40*time.Millisecond)
+			}
+		})
+
+		Convey("test decorrelated jitter", func() {
+			b := Policy{Initial: 10 * time.Millisecond, Max: 40 * time.Millisecond, Jitter: DecorrelatedJitter}.Backoff()
+			prev := 10 * time.Millisecond
+			for i := 1; i <= 10; i++ {
+				d := b.Next()
+				So(d, ShouldBeGreaterThanOrEqualTo, 10*time.Millisecond)
+				So(d, ShouldBeLessThanOrEqualTo, 40*time.Millisecond)
+				So(d, ShouldBeLessThan, 3*prev)
+				prev = d
+			}
+		})
+	})
+}
+
+func TestDo(t *testing.T) {
+	Convey("test do", t, func() {
+		ctx := context.Background()
+		type call struct {
+			name, result string
+			attempts     int
+		}
+		var calls []call
+		SetRecorder(func(name, result string, attempts int) {
+			calls = append(calls, call{name: name, result: result, attempts: attempts})
+		})
+		defer SetRecorder(nil)
+		p := Policy{Name: "test", Initial: time.Millisecond, MaxAttempts: 3}
+		errTest := errors.New("test")
+
+		Convey("test succeed after retry", func() {
+			err := Do(ctx, p, func(_ context.Context, attempt int) error {
+				if attempt < 2 {
+					return errTest
+				}
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(calls, ShouldResemble, []call{{name: "test", result: ResultSuccess, attempts: 2}})
+		})
+
+		Convey("test attempts exhausted", func() {
+			var n int
+			err := Do(ctx, p, func(context.Context, int) error {
+				n++
+				return errTest
+			})
+			So(err, ShouldEqual, errTest)
+			So(n, ShouldEqual, 3)
+			So(calls[0].result, ShouldEqual, ResultExhausted)
+		})
+
+		Convey("test elapsed budget exhausted", func() {
+			p.MaxAttempts = 0
+			p.Initial = 20 * time.Millisecond
+			p.MaxElapsed = 50 * time.Millisecond
+			var n int
+			err := Do(ctx, p, func(context.Context, int) error {
+				n++
+				return errTest
+			})
+			So(err, ShouldEqual, errTest)
+			So(n, ShouldEqual, 2)
+			So(calls[0].result, ShouldEqual, ResultExhausted)
+		})
+
+		Convey("test backoff by the fake clock", func() {
+			clock := util.NewFakeClock(time.Now())
+			p.Clock = clock
+			p.MaxAttempts = 0
+			p.Initial = time.Second
+			p.MaxElapsed = 4 * time.Second
+			done := make(chan error)
+			var n int
+			go func() {
+				done <- Do(ctx, p, func(context.Context, int) error {
+					n++
+					return errTest
+				})
+			}()
+			// the backoffs are 1s and 2s, the third backoff 4s is beyond the budget.
+			for i := 0; i < 2; i++ {
+				for clock.Waiters() == 0 {
+					time.Sleep(time.Millisecond)
+				}
+				clock.Advance(time.Duration(1<<i) * time.Second)
+			}
+			So(<-done, ShouldEqual, errTest)
+			So(n, ShouldEqual, 3)
+			So(calls[0].result, ShouldEqual, ResultExhausted)
+		})
+
+		Convey("test not retryable", func() {
+			var n int
+			err := Do(ctx, p, func(context.Context, int) error {
+				n++
+				return Permanent(errTest)
+			})
+			So(errors.Is(err, errTest), ShouldBeTrue)
+			So(n, ShouldEqual, 1)
+			So(calls[0].result, ShouldEqual, ResultNotRetryable)
+		})
+
+		Convey("test canceled", func() {
+			p.Initial = time.Second
+			cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			err := Do(cctx, p, func(context.Context, int) error {
+				return errTest
+			})
+			So(err, ShouldEqual, errTest)
+			So(calls[0].result, ShouldEqual, ResultCanceled)
+		})
+
+		Convey("test the policy without name isn't recorded", func() {
+			p.Name = ""
+			_ = Do(ctx, p, func(context.Context, int) error { return nil })
+			So(calls, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestIsRetryable(t *testing.T) {
+	Convey("test is retryable", t, func() {
+		So(IsRetryable(nil), ShouldBeFalse)
+		So(IsRetryable(errors.New("test")), ShouldBeTrue)
+		So(IsRetryable(Permanent(errors.New("test"))), ShouldBeFalse)
+		So(IsRetryable(context.DeadlineExceeded), ShouldBeFalse)
+		So(IsRetryable(status.Error(codes.Unavailable, "test")), ShouldBeTrue)
+		So(IsRetryable(status.Error(codes.InvalidArgument, "test")), ShouldBeFalse)
+	})
+}
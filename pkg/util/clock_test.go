@@ -0,0 +1,67 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFakeClock(t *testing.T) {
+	Convey("test fake clock", t, func() {
+		start := time.UnixMilli(1000)
+		c := NewFakeClock(start)
+		So(c.Now(), ShouldEqual, start)
+
+		t1 := c.NewTimer(time.Second)
+		t2 := c.NewTimer(2 * time.Second)
+		So(c.Waiters(), ShouldEqual, 2)
+
+		c.Advance(500 * time.Millisecond)
+		So(c.Now(), ShouldEqual, start.Add(500*time.Millisecond))
+		select {
+		case <-t1.C():
+			So("t1 fired early", ShouldBeEmpty)
+		default:
+		}
+
+		c.Advance(500 * time.Millisecond)
+		So(<-t1.C(), ShouldEqual, start.Add(time.Second))
+		So(c.Waiters(), ShouldEqual, 1)
+
+		So(t2.Stop(), ShouldBeTrue)
+		So(c.Waiters(), ShouldEqual, 0)
+		So(t2.Reset(time.Second), ShouldBeFalse)
+		So(c.Waiters(), ShouldEqual, 1)
+
+		done := make(chan struct{})
+		go func() {
+			c.Sleep(3 * time.Second)
+			close(done)
+		}()
+		for c.Waiters() < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		c.Advance(3 * time.Second)
+		<-done
+		So(<-t2.C(), ShouldEqual, start.Add(4*time.Second))
+		So(c.Waiters(), ShouldEqual, 0)
+
+		// the timer of non-positive duration fires immediately.
+		So(<-c.NewTimer(0).C(), ShouldEqual, c.Now())
+	})
+}
@@ -36,6 +36,9 @@ func ParseTime(str string) (time.Time, error) {
 	return time.Parse(vanusTimeLayout, str)
 }
 
+// Backoff returns 100ms*2^attempt capped by max, or 0 for the first attempt.
+//
+// Deprecated: use retry.Policy, which supports jitter and retry budgets.
 func Backoff(attempt int, max time.Duration) time.Duration {
 	if attempt == 0 {
 		return 0
@@ -121,6 +121,66 @@ func (m *MockEventBusControllerClient) EXPECT() *MockEventBusControllerClientMoc
 	return m.recorder
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) CommitConsumerGroupOffset(ctx context.Context, in *CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) CommitConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).CommitConsumerGroupOffset), varargs...)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockEventBusControllerClient) ConsumerGroupHeartbeat(ctx context.Context, in *ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", varargs...)
+	ret0, _ := ret[0].(*ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockEventBusControllerClientMockRecorder) ConsumerGroupHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockEventBusControllerClient)(nil).ConsumerGroupHeartbeat), varargs...)
+}
+
+// CreateCronEvent mocks base method.
+func (m *MockEventBusControllerClient) CreateCronEvent(ctx context.Context, in *CreateCronEventRequest, opts ...grpc.CallOption) (*CronEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCronEvent", varargs...)
+	ret0, _ := ret[0].(*CronEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCronEvent indicates an expected call of CreateCronEvent.
+func (mr *MockEventBusControllerClientMockRecorder) CreateCronEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCronEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateCronEvent), varargs...)
+}
+
 // CreateEventBus mocks base method.
 func (m *MockEventBusControllerClient) CreateEventBus(ctx context.Context, in *CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +201,46 @@ func (mr *MockEventBusControllerClientMockRecorder) CreateEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateEventBus), varargs...)
 }
 
+// CreateHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) CreateHTTPPoller(ctx context.Context, in *CreateHTTPPollerRequest, opts ...grpc.CallOption) (*HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHTTPPoller indicates an expected call of CreateHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) CreateHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateHTTPPoller), varargs...)
+}
+
+// CreateReplayJob mocks base method.
+func (m *MockEventBusControllerClient) CreateReplayJob(ctx context.Context, in *CreateReplayJobRequest, opts ...grpc.CallOption) (*ReplayJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateReplayJob", varargs...)
+	ret0, _ := ret[0].(*ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReplayJob indicates an expected call of CreateReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) CreateReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateReplayJob), varargs...)
+}
+
 // CreateSystemEventBus mocks base method.
 func (m *MockEventBusControllerClient) CreateSystemEventBus(ctx context.Context, in *CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +261,26 @@ func (mr *MockEventBusControllerClientMockRecorder) CreateSystemEventBus(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystemEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateSystemEventBus), varargs...)
 }
 
+// DeleteCronEvent mocks base method.
+func (m *MockEventBusControllerClient) DeleteCronEvent(ctx context.Context, in *DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCronEvent", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCronEvent indicates an expected call of DeleteCronEvent.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteCronEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCronEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteCronEvent), varargs...)
+}
+
 // DeleteEventBus mocks base method.
 func (m *MockEventBusControllerClient) DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +301,146 @@ func (mr *MockEventBusControllerClientMockRecorder) DeleteEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventBus), varargs...)
 }
 
+// DeleteEventbusACL mocks base method.
+func (m *MockEventBusControllerClient) DeleteEventbusACL(ctx context.Context, in *DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEventbusACL", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventbusACL indicates an expected call of DeleteEventbusACL.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteEventbusACL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbusACL", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventbusACL), varargs...)
+}
+
+// DeleteFeatureFlag mocks base method.
+func (m *MockEventBusControllerClient) DeleteFeatureFlag(ctx context.Context, in *DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFeatureFlag", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFeatureFlag indicates an expected call of DeleteFeatureFlag.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteFeatureFlag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatureFlag", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteFeatureFlag), varargs...)
+}
+
+// DeleteHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) DeleteHTTPPoller(ctx context.Context, in *DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHTTPPoller indicates an expected call of DeleteHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteHTTPPoller), varargs...)
+}
+
+// DeleteIPAllowlist mocks base method.
+func (m *MockEventBusControllerClient) DeleteIPAllowlist(ctx context.Context, in *DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIPAllowlist", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIPAllowlist indicates an expected call of DeleteIPAllowlist.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteIPAllowlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPAllowlist", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteIPAllowlist), varargs...)
+}
+
+// DeleteReplayJob mocks base method.
+func (m *MockEventBusControllerClient) DeleteReplayJob(ctx context.Context, in *DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReplayJob", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReplayJob indicates an expected call of DeleteReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteReplayJob), varargs...)
+}
+
+// GetClusterStats mocks base method.
+func (m *MockEventBusControllerClient) GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterStats", varargs...)
+	ret0, _ := ret[0].(*ClusterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterStats indicates an expected call of GetClusterStats.
+func (mr *MockEventBusControllerClientMockRecorder) GetClusterStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStats", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetClusterStats), varargs...)
+}
+
+// GetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) GetConsumerGroupOffset(ctx context.Context, in *GetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*GetConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*GetConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroupOffset indicates an expected call of GetConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) GetConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetConsumerGroupOffset), varargs...)
+}
+
 // GetEventBus mocks base method.
 func (m *MockEventBusControllerClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +461,66 @@ func (mr *MockEventBusControllerClientMockRecorder) GetEventBus(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetEventBus), varargs...)
 }
 
+// GetReplayJob mocks base method.
+func (m *MockEventBusControllerClient) GetReplayJob(ctx context.Context, in *GetReplayJobRequest, opts ...grpc.CallOption) (*ReplayJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplayJob", varargs...)
+	ret0, _ := ret[0].(*ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplayJob indicates an expected call of GetReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) GetReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetReplayJob), varargs...)
+}
+
+// LeaveConsumerGroup mocks base method.
+func (m *MockEventBusControllerClient) LeaveConsumerGroup(ctx context.Context, in *LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockEventBusControllerClientMockRecorder) LeaveConsumerGroup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockEventBusControllerClient)(nil).LeaveConsumerGroup), varargs...)
+}
+
+// ListCronEvent mocks base method.
+func (m *MockEventBusControllerClient) ListCronEvent(ctx context.Context, in *ListCronEventRequest, opts ...grpc.CallOption) (*ListCronEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCronEvent", varargs...)
+	ret0, _ := ret[0].(*ListCronEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCronEvent indicates an expected call of ListCronEvent.
+func (mr *MockEventBusControllerClientMockRecorder) ListCronEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCronEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListCronEvent), varargs...)
+}
+
 // ListEventBus mocks base method.
 func (m *MockEventBusControllerClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,122 +541,782 @@ func (mr *MockEventBusControllerClientMockRecorder) ListEventBus(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventBus), varargs...)
 }
 
-// UpdateEventBus mocks base method.
-func (m *MockEventBusControllerClient) UpdateEventBus(ctx context.Context, in *UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+// ListEventbusACL mocks base method.
+func (m *MockEventBusControllerClient) ListEventbusACL(ctx context.Context, in *ListEventbusACLRequest, opts ...grpc.CallOption) (*ListEventbusACLResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateEventBus", varargs...)
-	ret0, _ := ret[0].(*meta.EventBus)
+	ret := m.ctrl.Call(m, "ListEventbusACL", varargs...)
+	ret0, _ := ret[0].(*ListEventbusACLResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateEventBus indicates an expected call of UpdateEventBus.
-func (mr *MockEventBusControllerClientMockRecorder) UpdateEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListEventbusACL indicates an expected call of ListEventbusACL.
+func (mr *MockEventBusControllerClientMockRecorder) ListEventbusACL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).UpdateEventBus), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusACL", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventbusACL), varargs...)
 }
 
-// MockEventBusControllerServer is a mock of EventBusControllerServer interface.
-type MockEventBusControllerServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockEventBusControllerServerMockRecorder
+// ListFeatureFlag mocks base method.
+func (m *MockEventBusControllerClient) ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFeatureFlagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFeatureFlag", varargs...)
+	ret0, _ := ret[0].(*ListFeatureFlagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureFlag indicates an expected call of ListFeatureFlag.
+func (mr *MockEventBusControllerClientMockRecorder) ListFeatureFlag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureFlag", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListFeatureFlag), varargs...)
+}
+
+// ListHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) ListHTTPPoller(ctx context.Context, in *ListHTTPPollerRequest, opts ...grpc.CallOption) (*ListHTTPPollerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*ListHTTPPollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHTTPPoller indicates an expected call of ListHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) ListHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListHTTPPoller), varargs...)
+}
+
+// ListIPAllowlist mocks base method.
+func (m *MockEventBusControllerClient) ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListIPAllowlistResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIPAllowlist", varargs...)
+	ret0, _ := ret[0].(*ListIPAllowlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPAllowlist indicates an expected call of ListIPAllowlist.
+func (mr *MockEventBusControllerClientMockRecorder) ListIPAllowlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPAllowlist", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListIPAllowlist), varargs...)
+}
+
+// ListReplayJob mocks base method.
+func (m *MockEventBusControllerClient) ListReplayJob(ctx context.Context, in *ListReplayJobRequest, opts ...grpc.CallOption) (*ListReplayJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReplayJob", varargs...)
+	ret0, _ := ret[0].(*ListReplayJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReplayJob indicates an expected call of ListReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) ListReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListReplayJob), varargs...)
+}
+
+// ListTimerReplica mocks base method.
+func (m *MockEventBusControllerClient) ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTimerReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTimerReplica", varargs...)
+	ret0, _ := ret[0].(*ListTimerReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimerReplica indicates an expected call of ListTimerReplica.
+func (mr *MockEventBusControllerClientMockRecorder) ListTimerReplica(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimerReplica", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListTimerReplica), varargs...)
+}
+
+// PauseHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) PauseHTTPPoller(ctx context.Context, in *PauseHTTPPollerRequest, opts ...grpc.CallOption) (*HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseHTTPPoller indicates an expected call of PauseHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) PauseHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).PauseHTTPPoller), varargs...)
+}
+
+// PutEventbusACL mocks base method.
+func (m *MockEventBusControllerClient) PutEventbusACL(ctx context.Context, in *EventbusACL, opts ...grpc.CallOption) (*EventbusACL, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutEventbusACL", varargs...)
+	ret0, _ := ret[0].(*EventbusACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEventbusACL indicates an expected call of PutEventbusACL.
+func (mr *MockEventBusControllerClientMockRecorder) PutEventbusACL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEventbusACL", reflect.TypeOf((*MockEventBusControllerClient)(nil).PutEventbusACL), varargs...)
+}
+
+// PutFeatureFlag mocks base method.
+func (m *MockEventBusControllerClient) PutFeatureFlag(ctx context.Context, in *FeatureFlag, opts ...grpc.CallOption) (*FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutFeatureFlag", varargs...)
+	ret0, _ := ret[0].(*FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutFeatureFlag indicates an expected call of PutFeatureFlag.
+func (mr *MockEventBusControllerClientMockRecorder) PutFeatureFlag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFeatureFlag", reflect.TypeOf((*MockEventBusControllerClient)(nil).PutFeatureFlag), varargs...)
+}
+
+// PutIPAllowlist mocks base method.
+func (m *MockEventBusControllerClient) PutIPAllowlist(ctx context.Context, in *IPAllowlist, opts ...grpc.CallOption) (*IPAllowlist, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutIPAllowlist", varargs...)
+	ret0, _ := ret[0].(*IPAllowlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutIPAllowlist indicates an expected call of PutIPAllowlist.
+func (mr *MockEventBusControllerClientMockRecorder) PutIPAllowlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutIPAllowlist", reflect.TypeOf((*MockEventBusControllerClient)(nil).PutIPAllowlist), varargs...)
+}
+
+// ResumeHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) ResumeHTTPPoller(ctx context.Context, in *ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeHTTPPoller indicates an expected call of ResumeHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) ResumeHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).ResumeHTTPPoller), varargs...)
+}
+
+// SetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) SetConsumerGroupOffset(ctx context.Context, in *SetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConsumerGroupOffset indicates an expected call of SetConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) SetConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).SetConsumerGroupOffset), varargs...)
+}
+
+// UpdateEventBus mocks base method.
+func (m *MockEventBusControllerClient) UpdateEventBus(ctx context.Context, in *UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventBus indicates an expected call of UpdateEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) UpdateEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).UpdateEventBus), varargs...)
+}
+
+// MockEventBusControllerServer is a mock of EventBusControllerServer interface.
+type MockEventBusControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBusControllerServerMockRecorder
+}
+
+// MockEventBusControllerServerMockRecorder is the mock recorder for MockEventBusControllerServer.
+type MockEventBusControllerServerMockRecorder struct {
+	mock *MockEventBusControllerServer
+}
+
+// NewMockEventBusControllerServer creates a new mock instance.
+func NewMockEventBusControllerServer(ctrl *gomock.Controller) *MockEventBusControllerServer {
+	mock := &MockEventBusControllerServer{ctrl: ctrl}
+	mock.recorder = &MockEventBusControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBusControllerServer) EXPECT() *MockEventBusControllerServerMockRecorder {
+	return m.recorder
+}
+
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) CommitConsumerGroupOffset(arg0 context.Context, arg1 *CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).CommitConsumerGroupOffset), arg0, arg1)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockEventBusControllerServer) ConsumerGroupHeartbeat(arg0 context.Context, arg1 *ConsumerGroupHeartbeatRequest) (*ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockEventBusControllerServerMockRecorder) ConsumerGroupHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockEventBusControllerServer)(nil).ConsumerGroupHeartbeat), arg0, arg1)
+}
+
+// CreateCronEvent mocks base method.
+func (m *MockEventBusControllerServer) CreateCronEvent(arg0 context.Context, arg1 *CreateCronEventRequest) (*CronEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCronEvent", arg0, arg1)
+	ret0, _ := ret[0].(*CronEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCronEvent indicates an expected call of CreateCronEvent.
+func (mr *MockEventBusControllerServerMockRecorder) CreateCronEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCronEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateCronEvent), arg0, arg1)
+}
+
+// CreateEventBus mocks base method.
+func (m *MockEventBusControllerServer) CreateEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventBus indicates an expected call of CreateEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) CreateEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateEventBus), arg0, arg1)
+}
+
+// CreateHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) CreateHTTPPoller(arg0 context.Context, arg1 *CreateHTTPPollerRequest) (*HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHTTPPoller indicates an expected call of CreateHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) CreateHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateHTTPPoller), arg0, arg1)
+}
+
+// CreateReplayJob mocks base method.
+func (m *MockEventBusControllerServer) CreateReplayJob(arg0 context.Context, arg1 *CreateReplayJobRequest) (*ReplayJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReplayJob indicates an expected call of CreateReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) CreateReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateReplayJob), arg0, arg1)
+}
+
+// CreateSystemEventBus mocks base method.
+func (m *MockEventBusControllerServer) CreateSystemEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSystemEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSystemEventBus indicates an expected call of CreateSystemEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) CreateSystemEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystemEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateSystemEventBus), arg0, arg1)
+}
+
+// DeleteCronEvent mocks base method.
+func (m *MockEventBusControllerServer) DeleteCronEvent(arg0 context.Context, arg1 *DeleteCronEventRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCronEvent", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCronEvent indicates an expected call of DeleteCronEvent.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteCronEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCronEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteCronEvent), arg0, arg1)
+}
+
+// DeleteEventBus mocks base method.
+func (m *MockEventBusControllerServer) DeleteEventBus(arg0 context.Context, arg1 *meta.EventBus) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventBus indicates an expected call of DeleteEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventBus), arg0, arg1)
+}
+
+// DeleteEventbusACL mocks base method.
+func (m *MockEventBusControllerServer) DeleteEventbusACL(arg0 context.Context, arg1 *DeleteEventbusACLRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventbusACL", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventbusACL indicates an expected call of DeleteEventbusACL.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteEventbusACL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbusACL", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventbusACL), arg0, arg1)
+}
+
+// DeleteFeatureFlag mocks base method.
+func (m *MockEventBusControllerServer) DeleteFeatureFlag(arg0 context.Context, arg1 *DeleteFeatureFlagRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFeatureFlag indicates an expected call of DeleteFeatureFlag.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatureFlag", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteFeatureFlag), arg0, arg1)
+}
+
+// DeleteHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) DeleteHTTPPoller(arg0 context.Context, arg1 *DeleteHTTPPollerRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHTTPPoller indicates an expected call of DeleteHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteHTTPPoller), arg0, arg1)
+}
+
+// DeleteIPAllowlist mocks base method.
+func (m *MockEventBusControllerServer) DeleteIPAllowlist(arg0 context.Context, arg1 *DeleteIPAllowlistRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIPAllowlist indicates an expected call of DeleteIPAllowlist.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteIPAllowlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPAllowlist", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteIPAllowlist), arg0, arg1)
+}
+
+// DeleteReplayJob mocks base method.
+func (m *MockEventBusControllerServer) DeleteReplayJob(arg0 context.Context, arg1 *DeleteReplayJobRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReplayJob indicates an expected call of DeleteReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteReplayJob), arg0, arg1)
+}
+
+// GetClusterStats mocks base method.
+func (m *MockEventBusControllerServer) GetClusterStats(arg0 context.Context, arg1 *emptypb.Empty) (*ClusterStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStats", arg0, arg1)
+	ret0, _ := ret[0].(*ClusterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterStats indicates an expected call of GetClusterStats.
+func (mr *MockEventBusControllerServerMockRecorder) GetClusterStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStats", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetClusterStats), arg0, arg1)
+}
+
+// GetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) GetConsumerGroupOffset(arg0 context.Context, arg1 *GetConsumerGroupOffsetRequest) (*GetConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*GetConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroupOffset indicates an expected call of GetConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) GetConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetConsumerGroupOffset), arg0, arg1)
+}
+
+// GetEventBus mocks base method.
+func (m *MockEventBusControllerServer) GetEventBus(arg0 context.Context, arg1 *meta.EventBus) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventBus indicates an expected call of GetEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) GetEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetEventBus), arg0, arg1)
+}
+
+// GetReplayJob mocks base method.
+func (m *MockEventBusControllerServer) GetReplayJob(arg0 context.Context, arg1 *GetReplayJobRequest) (*ReplayJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplayJob indicates an expected call of GetReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) GetReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetReplayJob), arg0, arg1)
+}
+
+// LeaveConsumerGroup mocks base method.
+func (m *MockEventBusControllerServer) LeaveConsumerGroup(arg0 context.Context, arg1 *LeaveConsumerGroupRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockEventBusControllerServerMockRecorder) LeaveConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockEventBusControllerServer)(nil).LeaveConsumerGroup), arg0, arg1)
+}
+
+// ListCronEvent mocks base method.
+func (m *MockEventBusControllerServer) ListCronEvent(arg0 context.Context, arg1 *ListCronEventRequest) (*ListCronEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCronEvent", arg0, arg1)
+	ret0, _ := ret[0].(*ListCronEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCronEvent indicates an expected call of ListCronEvent.
+func (mr *MockEventBusControllerServerMockRecorder) ListCronEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCronEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListCronEvent), arg0, arg1)
+}
+
+// ListEventBus mocks base method.
+func (m *MockEventBusControllerServer) ListEventBus(arg0 context.Context, arg1 *emptypb.Empty) (*ListEventbusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*ListEventbusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventBus indicates an expected call of ListEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) ListEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventBus), arg0, arg1)
+}
+
+// ListEventbusACL mocks base method.
+func (m *MockEventBusControllerServer) ListEventbusACL(arg0 context.Context, arg1 *ListEventbusACLRequest) (*ListEventbusACLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventbusACL", arg0, arg1)
+	ret0, _ := ret[0].(*ListEventbusACLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusACL indicates an expected call of ListEventbusACL.
+func (mr *MockEventBusControllerServerMockRecorder) ListEventbusACL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusACL", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventbusACL), arg0, arg1)
+}
+
+// ListFeatureFlag mocks base method.
+func (m *MockEventBusControllerServer) ListFeatureFlag(arg0 context.Context, arg1 *emptypb.Empty) (*ListFeatureFlagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(*ListFeatureFlagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureFlag indicates an expected call of ListFeatureFlag.
+func (mr *MockEventBusControllerServerMockRecorder) ListFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureFlag", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListFeatureFlag), arg0, arg1)
+}
+
+// ListHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) ListHTTPPoller(arg0 context.Context, arg1 *ListHTTPPollerRequest) (*ListHTTPPollerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*ListHTTPPollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHTTPPoller indicates an expected call of ListHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) ListHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListHTTPPoller), arg0, arg1)
+}
+
+// ListIPAllowlist mocks base method.
+func (m *MockEventBusControllerServer) ListIPAllowlist(arg0 context.Context, arg1 *emptypb.Empty) (*ListIPAllowlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIPAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*ListIPAllowlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPAllowlist indicates an expected call of ListIPAllowlist.
+func (mr *MockEventBusControllerServerMockRecorder) ListIPAllowlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPAllowlist", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListIPAllowlist), arg0, arg1)
+}
+
+// ListReplayJob mocks base method.
+func (m *MockEventBusControllerServer) ListReplayJob(arg0 context.Context, arg1 *ListReplayJobRequest) (*ListReplayJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*ListReplayJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReplayJob indicates an expected call of ListReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) ListReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListReplayJob), arg0, arg1)
+}
+
+// ListTimerReplica mocks base method.
+func (m *MockEventBusControllerServer) ListTimerReplica(arg0 context.Context, arg1 *emptypb.Empty) (*ListTimerReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimerReplica", arg0, arg1)
+	ret0, _ := ret[0].(*ListTimerReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockEventBusControllerServerMockRecorder is the mock recorder for MockEventBusControllerServer.
-type MockEventBusControllerServerMockRecorder struct {
-	mock *MockEventBusControllerServer
+// ListTimerReplica indicates an expected call of ListTimerReplica.
+func (mr *MockEventBusControllerServerMockRecorder) ListTimerReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimerReplica", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListTimerReplica), arg0, arg1)
 }
 
-// NewMockEventBusControllerServer creates a new mock instance.
-func NewMockEventBusControllerServer(ctrl *gomock.Controller) *MockEventBusControllerServer {
-	mock := &MockEventBusControllerServer{ctrl: ctrl}
-	mock.recorder = &MockEventBusControllerServerMockRecorder{mock}
-	return mock
+// PauseHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) PauseHTTPPoller(arg0 context.Context, arg1 *PauseHTTPPollerRequest) (*HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockEventBusControllerServer) EXPECT() *MockEventBusControllerServerMockRecorder {
-	return m.recorder
+// PauseHTTPPoller indicates an expected call of PauseHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) PauseHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).PauseHTTPPoller), arg0, arg1)
 }
 
-// CreateEventBus mocks base method.
-func (m *MockEventBusControllerServer) CreateEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
+// PutEventbusACL mocks base method.
+func (m *MockEventBusControllerServer) PutEventbusACL(arg0 context.Context, arg1 *EventbusACL) (*EventbusACL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEventBus", arg0, arg1)
-	ret0, _ := ret[0].(*meta.EventBus)
+	ret := m.ctrl.Call(m, "PutEventbusACL", arg0, arg1)
+	ret0, _ := ret[0].(*EventbusACL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEventBus indicates an expected call of CreateEventBus.
-func (mr *MockEventBusControllerServerMockRecorder) CreateEventBus(arg0, arg1 interface{}) *gomock.Call {
+// PutEventbusACL indicates an expected call of PutEventbusACL.
+func (mr *MockEventBusControllerServerMockRecorder) PutEventbusACL(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateEventBus), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEventbusACL", reflect.TypeOf((*MockEventBusControllerServer)(nil).PutEventbusACL), arg0, arg1)
 }
 
-// CreateSystemEventBus mocks base method.
-func (m *MockEventBusControllerServer) CreateSystemEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
+// PutFeatureFlag mocks base method.
+func (m *MockEventBusControllerServer) PutFeatureFlag(arg0 context.Context, arg1 *FeatureFlag) (*FeatureFlag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSystemEventBus", arg0, arg1)
-	ret0, _ := ret[0].(*meta.EventBus)
+	ret := m.ctrl.Call(m, "PutFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(*FeatureFlag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSystemEventBus indicates an expected call of CreateSystemEventBus.
-func (mr *MockEventBusControllerServerMockRecorder) CreateSystemEventBus(arg0, arg1 interface{}) *gomock.Call {
+// PutFeatureFlag indicates an expected call of PutFeatureFlag.
+func (mr *MockEventBusControllerServerMockRecorder) PutFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystemEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateSystemEventBus), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFeatureFlag", reflect.TypeOf((*MockEventBusControllerServer)(nil).PutFeatureFlag), arg0, arg1)
 }
 
-// DeleteEventBus mocks base method.
-func (m *MockEventBusControllerServer) DeleteEventBus(arg0 context.Context, arg1 *meta.EventBus) (*emptypb.Empty, error) {
+// PutIPAllowlist mocks base method.
+func (m *MockEventBusControllerServer) PutIPAllowlist(arg0 context.Context, arg1 *IPAllowlist) (*IPAllowlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEventBus", arg0, arg1)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret := m.ctrl.Call(m, "PutIPAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*IPAllowlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteEventBus indicates an expected call of DeleteEventBus.
-func (mr *MockEventBusControllerServerMockRecorder) DeleteEventBus(arg0, arg1 interface{}) *gomock.Call {
+// PutIPAllowlist indicates an expected call of PutIPAllowlist.
+func (mr *MockEventBusControllerServerMockRecorder) PutIPAllowlist(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventBus), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutIPAllowlist", reflect.TypeOf((*MockEventBusControllerServer)(nil).PutIPAllowlist), arg0, arg1)
 }
 
-// GetEventBus mocks base method.
-func (m *MockEventBusControllerServer) GetEventBus(arg0 context.Context, arg1 *meta.EventBus) (*meta.EventBus, error) {
+// ResumeHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) ResumeHTTPPoller(arg0 context.Context, arg1 *ResumeHTTPPollerRequest) (*HTTPPoller, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventBus", arg0, arg1)
-	ret0, _ := ret[0].(*meta.EventBus)
+	ret := m.ctrl.Call(m, "ResumeHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*HTTPPoller)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEventBus indicates an expected call of GetEventBus.
-func (mr *MockEventBusControllerServerMockRecorder) GetEventBus(arg0, arg1 interface{}) *gomock.Call {
+// ResumeHTTPPoller indicates an expected call of ResumeHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) ResumeHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetEventBus), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).ResumeHTTPPoller), arg0, arg1)
 }
 
-// ListEventBus mocks base method.
-func (m *MockEventBusControllerServer) ListEventBus(arg0 context.Context, arg1 *emptypb.Empty) (*ListEventbusResponse, error) {
+// SetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) SetConsumerGroupOffset(arg0 context.Context, arg1 *SetConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEventBus", arg0, arg1)
-	ret0, _ := ret[0].(*ListEventbusResponse)
+	ret := m.ctrl.Call(m, "SetConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEventBus indicates an expected call of ListEventBus.
-func (mr *MockEventBusControllerServerMockRecorder) ListEventBus(arg0, arg1 interface{}) *gomock.Call {
+// SetConsumerGroupOffset indicates an expected call of SetConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) SetConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventBus), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).SetConsumerGroupOffset), arg0, arg1)
 }
 
 // UpdateEventBus mocks base method.
@@ -1019,104 +1999,364 @@ func (m *MockTriggerControllerClient) EXPECT() *MockTriggerControllerClientMockR
 	return m.recorder
 }
 
-// CommitOffset mocks base method.
-func (m *MockTriggerControllerClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
+// CommitConnectorPosition mocks base method.
+func (m *MockTriggerControllerClient) CommitConnectorPosition(ctx context.Context, in *CommitConnectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConnectorPosition", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConnectorPosition indicates an expected call of CommitConnectorPosition.
+func (mr *MockTriggerControllerClientMockRecorder) CommitConnectorPosition(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConnectorPosition", reflect.TypeOf((*MockTriggerControllerClient)(nil).CommitConnectorPosition), varargs...)
+}
+
+// CommitOffset mocks base method.
+func (m *MockTriggerControllerClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitOffset", varargs...)
+	ret0, _ := ret[0].(*CommitOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockTriggerControllerClientMockRecorder) CommitOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTriggerControllerClient)(nil).CommitOffset), varargs...)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockTriggerControllerClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) CreateSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).CreateSubscription), varargs...)
+}
+
+// DeleteConnector mocks base method.
+func (m *MockTriggerControllerClient) DeleteConnector(ctx context.Context, in *DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConnector", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockTriggerControllerClientMockRecorder) DeleteConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteConnector), varargs...)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockTriggerControllerClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSecret", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockTriggerControllerClientMockRecorder) DeleteSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteSecret), varargs...)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockTriggerControllerClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSubscription", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) DeleteSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteSubscription), varargs...)
+}
+
+// ExportSubscription mocks base method.
+func (m *MockTriggerControllerClient) ExportSubscription(ctx context.Context, in *ExportSubscriptionRequest, opts ...grpc.CallOption) (*ExportSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportSubscription", varargs...)
+	ret0, _ := ret[0].(*ExportSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSubscription indicates an expected call of ExportSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) ExportSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ExportSubscription), varargs...)
+}
+
+// GetConnector mocks base method.
+func (m *MockTriggerControllerClient) GetConnector(ctx context.Context, in *GetConnectorRequest, opts ...grpc.CallOption) (*Connector, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConnector", varargs...)
+	ret0, _ := ret[0].(*Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnector indicates an expected call of GetConnector.
+func (mr *MockTriggerControllerClientMockRecorder) GetConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetConnector), varargs...)
+}
+
+// GetSecret mocks base method.
+func (m *MockTriggerControllerClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*Secret, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecret", varargs...)
+	ret0, _ := ret[0].(*Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockTriggerControllerClientMockRecorder) GetSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSecret), varargs...)
+}
+
+// GetSubscription mocks base method.
+func (m *MockTriggerControllerClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) GetSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscription), varargs...)
+}
+
+// ImportSubscription mocks base method.
+func (m *MockTriggerControllerClient) ImportSubscription(ctx context.Context, in *ImportSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSubscription indicates an expected call of ImportSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) ImportSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ImportSubscription), varargs...)
+}
+
+// ListConnector mocks base method.
+func (m *MockTriggerControllerClient) ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConnector", varargs...)
+	ret0, _ := ret[0].(*ListConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnector indicates an expected call of ListConnector.
+func (mr *MockTriggerControllerClientMockRecorder) ListConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListConnector), varargs...)
+}
+
+// ListSecret mocks base method.
+func (m *MockTriggerControllerClient) ListSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecret", varargs...)
+	ret0, _ := ret[0].(*ListSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecret indicates an expected call of ListSecret.
+func (mr *MockTriggerControllerClientMockRecorder) ListSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSecret), varargs...)
+}
+
+// ListSubscription mocks base method.
+func (m *MockTriggerControllerClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CommitOffset", varargs...)
-	ret0, _ := ret[0].(*CommitOffsetResponse)
+	ret := m.ctrl.Call(m, "ListSubscription", varargs...)
+	ret0, _ := ret[0].(*ListSubscriptionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CommitOffset indicates an expected call of CommitOffset.
-func (mr *MockTriggerControllerClientMockRecorder) CommitOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListSubscription indicates an expected call of ListSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) ListSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTriggerControllerClient)(nil).CommitOffset), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSubscription), varargs...)
 }
 
-// CreateSubscription mocks base method.
-func (m *MockTriggerControllerClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+// ListTriggerWorker mocks base method.
+func (m *MockTriggerControllerClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTriggerWorkerResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateSubscription", varargs...)
-	ret0, _ := ret[0].(*meta.Subscription)
+	ret := m.ctrl.Call(m, "ListTriggerWorker", varargs...)
+	ret0, _ := ret[0].(*ListTriggerWorkerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSubscription indicates an expected call of CreateSubscription.
-func (mr *MockTriggerControllerClientMockRecorder) CreateSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockTriggerControllerClientMockRecorder) ListTriggerWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).CreateSubscription), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListTriggerWorker), varargs...)
 }
 
-// DeleteSubscription mocks base method.
-func (m *MockTriggerControllerClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// ListWorkerConnector mocks base method.
+func (m *MockTriggerControllerClient) ListWorkerConnector(ctx context.Context, in *ListWorkerConnectorRequest, opts ...grpc.CallOption) (*ListWorkerConnectorResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteSubscription", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret := m.ctrl.Call(m, "ListWorkerConnector", varargs...)
+	ret0, _ := ret[0].(*ListWorkerConnectorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteSubscription indicates an expected call of DeleteSubscription.
-func (mr *MockTriggerControllerClientMockRecorder) DeleteSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// ListWorkerConnector indicates an expected call of ListWorkerConnector.
+func (mr *MockTriggerControllerClientMockRecorder) ListWorkerConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteSubscription), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListWorkerConnector), varargs...)
 }
 
-// GetSubscription mocks base method.
-func (m *MockTriggerControllerClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+// PutConnector mocks base method.
+func (m *MockTriggerControllerClient) PutConnector(ctx context.Context, in *Connector, opts ...grpc.CallOption) (*Connector, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetSubscription", varargs...)
-	ret0, _ := ret[0].(*meta.Subscription)
+	ret := m.ctrl.Call(m, "PutConnector", varargs...)
+	ret0, _ := ret[0].(*Connector)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSubscription indicates an expected call of GetSubscription.
-func (mr *MockTriggerControllerClientMockRecorder) GetSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// PutConnector indicates an expected call of PutConnector.
+func (mr *MockTriggerControllerClientMockRecorder) PutConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscription), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).PutConnector), varargs...)
 }
 
-// ListSubscription mocks base method.
-func (m *MockTriggerControllerClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSubscriptionResponse, error) {
+// PutSecret mocks base method.
+func (m *MockTriggerControllerClient) PutSecret(ctx context.Context, in *PutSecretRequest, opts ...grpc.CallOption) (*Secret, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListSubscription", varargs...)
-	ret0, _ := ret[0].(*ListSubscriptionResponse)
+	ret := m.ctrl.Call(m, "PutSecret", varargs...)
+	ret0, _ := ret[0].(*Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSubscription indicates an expected call of ListSubscription.
-func (mr *MockTriggerControllerClientMockRecorder) ListSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MockTriggerControllerClientMockRecorder) PutSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSubscription), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).PutSecret), varargs...)
 }
 
 // RegisterTriggerWorker mocks base method.
@@ -1219,6 +2459,26 @@ func (mr *MockTriggerControllerClientMockRecorder) UpdateSubscription(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).UpdateSubscription), varargs...)
 }
 
+// WatchSecret mocks base method.
+func (m *MockTriggerControllerClient) WatchSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TriggerController_WatchSecretClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchSecret", varargs...)
+	ret0, _ := ret[0].(TriggerController_WatchSecretClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSecret indicates an expected call of WatchSecret.
+func (mr *MockTriggerControllerClientMockRecorder) WatchSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).WatchSecret), varargs...)
+}
+
 // MockTriggerController_TriggerWorkerHeartbeatClient is a mock of TriggerController_TriggerWorkerHeartbeatClient interface.
 type MockTriggerController_TriggerWorkerHeartbeatClient struct {
 	ctrl     *gomock.Controller
@@ -1356,6 +2616,129 @@ func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) Traile
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).Trailer))
 }
 
+// MockTriggerController_WatchSecretClient is a mock of TriggerController_WatchSecretClient interface.
+type MockTriggerController_WatchSecretClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_WatchSecretClientMockRecorder
+}
+
+// MockTriggerController_WatchSecretClientMockRecorder is the mock recorder for MockTriggerController_WatchSecretClient.
+type MockTriggerController_WatchSecretClientMockRecorder struct {
+	mock *MockTriggerController_WatchSecretClient
+}
+
+// NewMockTriggerController_WatchSecretClient creates a new mock instance.
+func NewMockTriggerController_WatchSecretClient(ctrl *gomock.Controller) *MockTriggerController_WatchSecretClient {
+	mock := &MockTriggerController_WatchSecretClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_WatchSecretClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_WatchSecretClient) EXPECT() *MockTriggerController_WatchSecretClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTriggerController_WatchSecretClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Recv() (*SecretEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*SecretEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Trailer))
+}
+
 // MockTriggerControllerServer is a mock of TriggerControllerServer interface.
 type MockTriggerControllerServer struct {
 	ctrl     *gomock.Controller
@@ -1379,6 +2762,21 @@ func (m *MockTriggerControllerServer) EXPECT() *MockTriggerControllerServerMockR
 	return m.recorder
 }
 
+// CommitConnectorPosition mocks base method.
+func (m *MockTriggerControllerServer) CommitConnectorPosition(arg0 context.Context, arg1 *CommitConnectorPositionRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConnectorPosition", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConnectorPosition indicates an expected call of CommitConnectorPosition.
+func (mr *MockTriggerControllerServerMockRecorder) CommitConnectorPosition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConnectorPosition", reflect.TypeOf((*MockTriggerControllerServer)(nil).CommitConnectorPosition), arg0, arg1)
+}
+
 // CommitOffset mocks base method.
 func (m *MockTriggerControllerServer) CommitOffset(arg0 context.Context, arg1 *CommitOffsetRequest) (*CommitOffsetResponse, error) {
 	m.ctrl.T.Helper()
@@ -1409,6 +2807,36 @@ func (mr *MockTriggerControllerServerMockRecorder) CreateSubscription(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).CreateSubscription), arg0, arg1)
 }
 
+// DeleteConnector mocks base method.
+func (m *MockTriggerControllerServer) DeleteConnector(arg0 context.Context, arg1 *DeleteConnectorRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnector", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockTriggerControllerServerMockRecorder) DeleteConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).DeleteConnector), arg0, arg1)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockTriggerControllerServer) DeleteSecret(arg0 context.Context, arg1 *DeleteSecretRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockTriggerControllerServerMockRecorder) DeleteSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).DeleteSecret), arg0, arg1)
+}
+
 // DeleteSubscription mocks base method.
 func (m *MockTriggerControllerServer) DeleteSubscription(arg0 context.Context, arg1 *DeleteSubscriptionRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -1424,6 +2852,51 @@ func (mr *MockTriggerControllerServerMockRecorder) DeleteSubscription(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).DeleteSubscription), arg0, arg1)
 }
 
+// ExportSubscription mocks base method.
+func (m *MockTriggerControllerServer) ExportSubscription(arg0 context.Context, arg1 *ExportSubscriptionRequest) (*ExportSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*ExportSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSubscription indicates an expected call of ExportSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) ExportSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ExportSubscription), arg0, arg1)
+}
+
+// GetConnector mocks base method.
+func (m *MockTriggerControllerServer) GetConnector(arg0 context.Context, arg1 *GetConnectorRequest) (*Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnector", arg0, arg1)
+	ret0, _ := ret[0].(*Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnector indicates an expected call of GetConnector.
+func (mr *MockTriggerControllerServerMockRecorder) GetConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetConnector), arg0, arg1)
+}
+
+// GetSecret mocks base method.
+func (m *MockTriggerControllerServer) GetSecret(arg0 context.Context, arg1 *GetSecretRequest) (*Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1)
+	ret0, _ := ret[0].(*Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockTriggerControllerServerMockRecorder) GetSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSecret), arg0, arg1)
+}
+
 // GetSubscription mocks base method.
 func (m *MockTriggerControllerServer) GetSubscription(arg0 context.Context, arg1 *GetSubscriptionRequest) (*meta.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -1439,6 +2912,51 @@ func (mr *MockTriggerControllerServerMockRecorder) GetSubscription(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSubscription), arg0, arg1)
 }
 
+// ImportSubscription mocks base method.
+func (m *MockTriggerControllerServer) ImportSubscription(arg0 context.Context, arg1 *ImportSubscriptionRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSubscription indicates an expected call of ImportSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) ImportSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ImportSubscription), arg0, arg1)
+}
+
+// ListConnector mocks base method.
+func (m *MockTriggerControllerServer) ListConnector(arg0 context.Context, arg1 *emptypb.Empty) (*ListConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnector", arg0, arg1)
+	ret0, _ := ret[0].(*ListConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnector indicates an expected call of ListConnector.
+func (mr *MockTriggerControllerServerMockRecorder) ListConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListConnector), arg0, arg1)
+}
+
+// ListSecret mocks base method.
+func (m *MockTriggerControllerServer) ListSecret(arg0 context.Context, arg1 *emptypb.Empty) (*ListSecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecret", arg0, arg1)
+	ret0, _ := ret[0].(*ListSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecret indicates an expected call of ListSecret.
+func (mr *MockTriggerControllerServerMockRecorder) ListSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSecret), arg0, arg1)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerServer) ListSubscription(arg0 context.Context, arg1 *emptypb.Empty) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1448,10 +2966,70 @@ func (m *MockTriggerControllerServer) ListSubscription(arg0 context.Context, arg
 	return ret0, ret1
 }
 
-// ListSubscription indicates an expected call of ListSubscription.
-func (mr *MockTriggerControllerServerMockRecorder) ListSubscription(arg0, arg1 interface{}) *gomock.Call {
+// ListSubscription indicates an expected call of ListSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) ListSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSubscription), arg0, arg1)
+}
+
+// ListTriggerWorker mocks base method.
+func (m *MockTriggerControllerServer) ListTriggerWorker(arg0 context.Context, arg1 *emptypb.Empty) (*ListTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTriggerWorker", arg0, arg1)
+	ret0, _ := ret[0].(*ListTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockTriggerControllerServerMockRecorder) ListTriggerWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListTriggerWorker), arg0, arg1)
+}
+
+// ListWorkerConnector mocks base method.
+func (m *MockTriggerControllerServer) ListWorkerConnector(arg0 context.Context, arg1 *ListWorkerConnectorRequest) (*ListWorkerConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkerConnector", arg0, arg1)
+	ret0, _ := ret[0].(*ListWorkerConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerConnector indicates an expected call of ListWorkerConnector.
+func (mr *MockTriggerControllerServerMockRecorder) ListWorkerConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListWorkerConnector), arg0, arg1)
+}
+
+// PutConnector mocks base method.
+func (m *MockTriggerControllerServer) PutConnector(arg0 context.Context, arg1 *Connector) (*Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConnector", arg0, arg1)
+	ret0, _ := ret[0].(*Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConnector indicates an expected call of PutConnector.
+func (mr *MockTriggerControllerServerMockRecorder) PutConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).PutConnector), arg0, arg1)
+}
+
+// PutSecret mocks base method.
+func (m *MockTriggerControllerServer) PutSecret(arg0 context.Context, arg1 *PutSecretRequest) (*Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", arg0, arg1)
+	ret0, _ := ret[0].(*Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MockTriggerControllerServerMockRecorder) PutSecret(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSubscription), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).PutSecret), arg0, arg1)
 }
 
 // RegisterTriggerWorker mocks base method.
@@ -1528,6 +3106,20 @@ func (mr *MockTriggerControllerServerMockRecorder) UpdateSubscription(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).UpdateSubscription), arg0, arg1)
 }
 
+// WatchSecret mocks base method.
+func (m *MockTriggerControllerServer) WatchSecret(arg0 *emptypb.Empty, arg1 TriggerController_WatchSecretServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSecret", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchSecret indicates an expected call of WatchSecret.
+func (mr *MockTriggerControllerServerMockRecorder) WatchSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).WatchSecret), arg0, arg1)
+}
+
 // MockTriggerController_TriggerWorkerHeartbeatServer is a mock of TriggerController_TriggerWorkerHeartbeatServer interface.
 type MockTriggerController_TriggerWorkerHeartbeatServer struct {
 	ctrl     *gomock.Controller
@@ -1662,6 +3254,125 @@ func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SetTra
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SetTrailer), arg0)
 }
 
+// MockTriggerController_WatchSecretServer is a mock of TriggerController_WatchSecretServer interface.
+type MockTriggerController_WatchSecretServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_WatchSecretServerMockRecorder
+}
+
+// MockTriggerController_WatchSecretServerMockRecorder is the mock recorder for MockTriggerController_WatchSecretServer.
+type MockTriggerController_WatchSecretServerMockRecorder struct {
+	mock *MockTriggerController_WatchSecretServer
+}
+
+// NewMockTriggerController_WatchSecretServer creates a new mock instance.
+func NewMockTriggerController_WatchSecretServer(ctrl *gomock.Controller) *MockTriggerController_WatchSecretServer {
+	mock := &MockTriggerController_WatchSecretServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_WatchSecretServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_WatchSecretServer) EXPECT() *MockTriggerController_WatchSecretServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_WatchSecretServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTriggerController_WatchSecretServer) Send(arg0 *SecretEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTriggerController_WatchSecretServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTriggerController_WatchSecretServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTriggerController_WatchSecretServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SetTrailer), arg0)
+}
+
 // MockSnowflakeControllerClient is a mock of SnowflakeControllerClient interface.
 type MockSnowflakeControllerClient struct {
 	ctrl     *gomock.Controller
@@ -1685,6 +3396,26 @@ func (m *MockSnowflakeControllerClient) EXPECT() *MockSnowflakeControllerClientM
 	return m.recorder
 }
 
+// AllocateIDs mocks base method.
+func (m *MockSnowflakeControllerClient) AllocateIDs(ctx context.Context, in *AllocateIDsRequest, opts ...grpc.CallOption) (*AllocateIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AllocateIDs", varargs...)
+	ret0, _ := ret[0].(*AllocateIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllocateIDs indicates an expected call of AllocateIDs.
+func (mr *MockSnowflakeControllerClientMockRecorder) AllocateIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIDs", reflect.TypeOf((*MockSnowflakeControllerClient)(nil).AllocateIDs), varargs...)
+}
+
 // GetClusterStartTime mocks base method.
 func (m *MockSnowflakeControllerClient) GetClusterStartTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
 	m.ctrl.T.Helper()
@@ -1768,6 +3499,21 @@ func (m *MockSnowflakeControllerServer) EXPECT() *MockSnowflakeControllerServerM
 	return m.recorder
 }
 
+// AllocateIDs mocks base method.
+func (m *MockSnowflakeControllerServer) AllocateIDs(arg0 context.Context, arg1 *AllocateIDsRequest) (*AllocateIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateIDs", arg0, arg1)
+	ret0, _ := ret[0].(*AllocateIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllocateIDs indicates an expected call of AllocateIDs.
+func (mr *MockSnowflakeControllerServerMockRecorder) AllocateIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIDs", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).AllocateIDs), arg0, arg1)
+}
+
 // GetClusterStartTime mocks base method.
 func (m *MockSnowflakeControllerServer) GetClusterStartTime(arg0 context.Context, arg1 *emptypb.Empty) (*timestamppb.Timestamp, error) {
 	m.ctrl.T.Helper()
@@ -1812,3 +3558,235 @@ func (mr *MockSnowflakeControllerServerMockRecorder) UnregisterNode(arg0, arg1 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterNode", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).UnregisterNode), arg0, arg1)
 }
+
+// MockProfilingServerClient is a mock of ProfilingServerClient interface.
+type MockProfilingServerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfilingServerClientMockRecorder
+}
+
+// MockProfilingServerClientMockRecorder is the mock recorder for MockProfilingServerClient.
+type MockProfilingServerClientMockRecorder struct {
+	mock *MockProfilingServerClient
+}
+
+// NewMockProfilingServerClient creates a new mock instance.
+func NewMockProfilingServerClient(ctrl *gomock.Controller) *MockProfilingServerClient {
+	mock := &MockProfilingServerClient{ctrl: ctrl}
+	mock.recorder = &MockProfilingServerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfilingServerClient) EXPECT() *MockProfilingServerClientMockRecorder {
+	return m.recorder
+}
+
+// CaptureProfile mocks base method.
+func (m *MockProfilingServerClient) CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CaptureProfile", varargs...)
+	ret0, _ := ret[0].(*CaptureProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureProfile indicates an expected call of CaptureProfile.
+func (mr *MockProfilingServerClientMockRecorder) CaptureProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureProfile", reflect.TypeOf((*MockProfilingServerClient)(nil).CaptureProfile), varargs...)
+}
+
+// MockProfilingServerServer is a mock of ProfilingServerServer interface.
+type MockProfilingServerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfilingServerServerMockRecorder
+}
+
+// MockProfilingServerServerMockRecorder is the mock recorder for MockProfilingServerServer.
+type MockProfilingServerServerMockRecorder struct {
+	mock *MockProfilingServerServer
+}
+
+// NewMockProfilingServerServer creates a new mock instance.
+func NewMockProfilingServerServer(ctrl *gomock.Controller) *MockProfilingServerServer {
+	mock := &MockProfilingServerServer{ctrl: ctrl}
+	mock.recorder = &MockProfilingServerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfilingServerServer) EXPECT() *MockProfilingServerServerMockRecorder {
+	return m.recorder
+}
+
+// CaptureProfile mocks base method.
+func (m *MockProfilingServerServer) CaptureProfile(arg0 context.Context, arg1 *CaptureProfileRequest) (*CaptureProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureProfile", arg0, arg1)
+	ret0, _ := ret[0].(*CaptureProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureProfile indicates an expected call of CaptureProfile.
+func (mr *MockProfilingServerServerMockRecorder) CaptureProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureProfile", reflect.TypeOf((*MockProfilingServerServer)(nil).CaptureProfile), arg0, arg1)
+}
+
+// MockChaosServerClient is a mock of ChaosServerClient interface.
+type MockChaosServerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChaosServerClientMockRecorder
+}
+
+// MockChaosServerClientMockRecorder is the mock recorder for MockChaosServerClient.
+type MockChaosServerClientMockRecorder struct {
+	mock *MockChaosServerClient
+}
+
+// NewMockChaosServerClient creates a new mock instance.
+func NewMockChaosServerClient(ctrl *gomock.Controller) *MockChaosServerClient {
+	mock := &MockChaosServerClient{ctrl: ctrl}
+	mock.recorder = &MockChaosServerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChaosServerClient) EXPECT() *MockChaosServerClientMockRecorder {
+	return m.recorder
+}
+
+// ClearFault mocks base method.
+func (m *MockChaosServerClient) ClearFault(ctx context.Context, in *ClearFaultRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ClearFault", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearFault indicates an expected call of ClearFault.
+func (mr *MockChaosServerClientMockRecorder) ClearFault(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearFault", reflect.TypeOf((*MockChaosServerClient)(nil).ClearFault), varargs...)
+}
+
+// InjectFault mocks base method.
+func (m *MockChaosServerClient) InjectFault(ctx context.Context, in *Fault, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InjectFault", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InjectFault indicates an expected call of InjectFault.
+func (mr *MockChaosServerClientMockRecorder) InjectFault(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectFault", reflect.TypeOf((*MockChaosServerClient)(nil).InjectFault), varargs...)
+}
+
+// ListFault mocks base method.
+func (m *MockChaosServerClient) ListFault(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFaultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFault", varargs...)
+	ret0, _ := ret[0].(*ListFaultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFault indicates an expected call of ListFault.
+func (mr *MockChaosServerClientMockRecorder) ListFault(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFault", reflect.TypeOf((*MockChaosServerClient)(nil).ListFault), varargs...)
+}
+
+// MockChaosServerServer is a mock of ChaosServerServer interface.
+type MockChaosServerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockChaosServerServerMockRecorder
+}
+
+// MockChaosServerServerMockRecorder is the mock recorder for MockChaosServerServer.
+type MockChaosServerServerMockRecorder struct {
+	mock *MockChaosServerServer
+}
+
+// NewMockChaosServerServer creates a new mock instance.
+func NewMockChaosServerServer(ctrl *gomock.Controller) *MockChaosServerServer {
+	mock := &MockChaosServerServer{ctrl: ctrl}
+	mock.recorder = &MockChaosServerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChaosServerServer) EXPECT() *MockChaosServerServerMockRecorder {
+	return m.recorder
+}
+
+// ClearFault mocks base method.
+func (m *MockChaosServerServer) ClearFault(arg0 context.Context, arg1 *ClearFaultRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearFault", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearFault indicates an expected call of ClearFault.
+func (mr *MockChaosServerServerMockRecorder) ClearFault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearFault", reflect.TypeOf((*MockChaosServerServer)(nil).ClearFault), arg0, arg1)
+}
+
+// InjectFault mocks base method.
+func (m *MockChaosServerServer) InjectFault(arg0 context.Context, arg1 *Fault) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectFault", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InjectFault indicates an expected call of InjectFault.
+func (mr *MockChaosServerServerMockRecorder) InjectFault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectFault", reflect.TypeOf((*MockChaosServerServer)(nil).InjectFault), arg0, arg1)
+}
+
+// ListFault mocks base method.
+func (m *MockChaosServerServer) ListFault(arg0 context.Context, arg1 *emptypb.Empty) (*ListFaultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFault", arg0, arg1)
+	ret0, _ := ret[0].(*ListFaultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFault indicates an expected call of ListFault.
+func (mr *MockChaosServerServerMockRecorder) ListFault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFault", reflect.TypeOf((*MockChaosServerServer)(nil).ListFault), arg0, arg1)
+}
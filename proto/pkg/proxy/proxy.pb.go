@@ -24,6 +24,7 @@ import (
 	context "context"
 	controller "github.com/linkall-labs/vanus/proto/pkg/controller"
 	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
+	trigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -152,6 +153,236 @@ func (x *LookupOffsetResponse) GetOffsets() map[uint64]int64 {
 	return nil
 }
 
+type LookupEventlogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eventbus string `protobuf:"bytes,1,opt,name=eventbus,proto3" json:"eventbus,omitempty"`
+	// lookup all eventlogs of eventbus if it's 0
+	EventlogId uint64 `protobuf:"varint,2,opt,name=eventlog_id,json=eventlogId,proto3" json:"eventlog_id,omitempty"`
+	// the epoch of routes cached by client, the routes aren't returned if they
+	// haven't been changed
+	Epoch uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *LookupEventlogRequest) Reset() {
+	*x = LookupEventlogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupEventlogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupEventlogRequest) ProtoMessage() {}
+
+func (x *LookupEventlogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupEventlogRequest.ProtoReflect.Descriptor instead.
+func (*LookupEventlogRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LookupEventlogRequest) GetEventbus() string {
+	if x != nil {
+		return x.Eventbus
+	}
+	return ""
+}
+
+func (x *LookupEventlogRequest) GetEventlogId() uint64 {
+	if x != nil {
+		return x.EventlogId
+	}
+	return 0
+}
+
+func (x *LookupEventlogRequest) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type LookupEventlogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*EventlogRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// epoch changes whenever the appendable segment or the leader of any
+	// eventlog looked up changes
+	Epoch       uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NotModified bool   `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+}
+
+func (x *LookupEventlogResponse) Reset() {
+	*x = LookupEventlogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupEventlogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupEventlogResponse) ProtoMessage() {}
+
+func (x *LookupEventlogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupEventlogResponse.ProtoReflect.Descriptor instead.
+func (*LookupEventlogResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LookupEventlogResponse) GetRoutes() []*EventlogRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *LookupEventlogResponse) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LookupEventlogResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+// EventlogRoute is where the events appended to an eventlog go.
+type EventlogRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventlogId uint64 `protobuf:"varint,1,opt,name=eventlog_id,json=eventlogId,proto3" json:"eventlog_id,omitempty"`
+	// the index of eventlog in eventbus, which is the partition of eventbus
+	Partition      int32  `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+	SegmentId      uint64 `protobuf:"varint,3,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	StartOffset    int64  `protobuf:"varint,4,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	LeaderBlockId  uint64 `protobuf:"varint,5,opt,name=leader_block_id,json=leaderBlockId,proto3" json:"leader_block_id,omitempty"`
+	LeaderEndpoint string `protobuf:"bytes,6,opt,name=leader_endpoint,json=leaderEndpoint,proto3" json:"leader_endpoint,omitempty"`
+	// the blocks of segment keyed by block id, they are the candidates when the
+	// leader is unavailable
+	Replicas map[uint64]string `protobuf:"bytes,7,rep,name=replicas,proto3" json:"replicas,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EventlogRoute) Reset() {
+	*x = EventlogRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventlogRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventlogRoute) ProtoMessage() {}
+
+func (x *EventlogRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventlogRoute.ProtoReflect.Descriptor instead.
+func (*EventlogRoute) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventlogRoute) GetEventlogId() uint64 {
+	if x != nil {
+		return x.EventlogId
+	}
+	return 0
+}
+
+func (x *EventlogRoute) GetPartition() int32 {
+	if x != nil {
+		return x.Partition
+	}
+	return 0
+}
+
+func (x *EventlogRoute) GetSegmentId() uint64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *EventlogRoute) GetStartOffset() int64 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *EventlogRoute) GetLeaderBlockId() uint64 {
+	if x != nil {
+		return x.LeaderBlockId
+	}
+	return 0
+}
+
+func (x *EventlogRoute) GetLeaderEndpoint() string {
+	if x != nil {
+		return x.LeaderEndpoint
+	}
+	return ""
+}
+
+func (x *EventlogRoute) GetReplicas() map[uint64]string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
 type GetEventRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -167,7 +398,7 @@ type GetEventRequest struct {
 func (x *GetEventRequest) Reset() {
 	*x = GetEventRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[2]
+		mi := &file_proxy_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -180,7 +411,7 @@ func (x *GetEventRequest) String() string {
 func (*GetEventRequest) ProtoMessage() {}
 
 func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[2]
+	mi := &file_proxy_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -193,7 +424,7 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
 func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{2}
+	return file_proxy_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetEventRequest) GetEventbus() string {
@@ -242,7 +473,7 @@ type GetEventResponse struct {
 func (x *GetEventResponse) Reset() {
 	*x = GetEventResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[3]
+		mi := &file_proxy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -255,7 +486,7 @@ func (x *GetEventResponse) String() string {
 func (*GetEventResponse) ProtoMessage() {}
 
 func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[3]
+	mi := &file_proxy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +499,7 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
 func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{3}
+	return file_proxy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetEventResponse) GetEvents() []*wrapperspb.BytesValue {
@@ -290,7 +521,7 @@ type ClusterInfoResponse struct {
 func (x *ClusterInfoResponse) Reset() {
 	*x = ClusterInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[4]
+		mi := &file_proxy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -303,7 +534,7 @@ func (x *ClusterInfoResponse) String() string {
 func (*ClusterInfoResponse) ProtoMessage() {}
 
 func (x *ClusterInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[4]
+	mi := &file_proxy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +547,7 @@ func (x *ClusterInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterInfoResponse.ProtoReflect.Descriptor instead.
 func (*ClusterInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{4}
+	return file_proxy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ClusterInfoResponse) GetCloudeventsPort() int64 {
@@ -349,7 +580,7 @@ type ValidateSubscriptionRequest struct {
 func (x *ValidateSubscriptionRequest) Reset() {
 	*x = ValidateSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[5]
+		mi := &file_proxy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -362,7 +593,7 @@ func (x *ValidateSubscriptionRequest) String() string {
 func (*ValidateSubscriptionRequest) ProtoMessage() {}
 
 func (x *ValidateSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[5]
+	mi := &file_proxy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +606,7 @@ func (x *ValidateSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*ValidateSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{5}
+	return file_proxy_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ValidateSubscriptionRequest) GetEventbus() string {
@@ -432,7 +663,7 @@ type ValidateSubscriptionResponse struct {
 func (x *ValidateSubscriptionResponse) Reset() {
 	*x = ValidateSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[6]
+		mi := &file_proxy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -445,7 +676,7 @@ func (x *ValidateSubscriptionResponse) String() string {
 func (*ValidateSubscriptionResponse) ProtoMessage() {}
 
 func (x *ValidateSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[6]
+	mi := &file_proxy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +689,7 @@ func (x *ValidateSubscriptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*ValidateSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{6}
+	return file_proxy_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ValidateSubscriptionResponse) GetFilterResult() bool {
@@ -475,883 +706,3700 @@ func (x *ValidateSubscriptionResponse) GetTransformerResult() []byte {
 	return nil
 }
 
-var File_proxy_proto protoreflect.FileDescriptor
+type PreviewSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proxy_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6c,
-	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a,
-	0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0xa4, 0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c,
-	0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xff, 0x01, 0x0a,
-	0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x6c, 0x6f, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72,
-	0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x32, 0xba, 0x0b, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
-	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x49, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x1c,
-	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x1a, 0x1c, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74,
-	0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x42, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x42, 0x75, 0x73, 0x12, 0x6a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x6b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x12,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
-	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
-	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7b, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69,
-	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// the number of latest events sampled from each eventlog
+	Sample int32 `protobuf:"varint,2,opt,name=sample,proto3" json:"sample,omitempty"`
 }
 
-var (
-	file_proxy_proto_rawDescOnce sync.Once
-	file_proxy_proto_rawDescData = file_proxy_proto_rawDesc
-)
+func (x *PreviewSubscriptionRequest) Reset() {
+	*x = PreviewSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proxy_proto_rawDescGZIP() []byte {
-	file_proxy_proto_rawDescOnce.Do(func() {
-		file_proxy_proto_rawDescData = protoimpl.X.CompressGZIP(file_proxy_proto_rawDescData)
-	})
-	return file_proxy_proto_rawDescData
+func (x *PreviewSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_proxy_proto_goTypes = []interface{}{
-	(*LookupOffsetRequest)(nil),                  // 0: linkall.vanus.proxy.LookupOffsetRequest
-	(*LookupOffsetResponse)(nil),                 // 1: linkall.vanus.proxy.LookupOffsetResponse
-	(*GetEventRequest)(nil),                      // 2: linkall.vanus.proxy.GetEventRequest
-	(*GetEventResponse)(nil),                     // 3: linkall.vanus.proxy.GetEventResponse
-	(*ClusterInfoResponse)(nil),                  // 4: linkall.vanus.proxy.ClusterInfoResponse
-	(*ValidateSubscriptionRequest)(nil),          // 5: linkall.vanus.proxy.ValidateSubscriptionRequest
-	(*ValidateSubscriptionResponse)(nil),         // 6: linkall.vanus.proxy.ValidateSubscriptionResponse
-	nil,                                          // 7: linkall.vanus.proxy.LookupOffsetResponse.OffsetsEntry
-	(*wrapperspb.BytesValue)(nil),                // 8: google.protobuf.BytesValue
-	(*controller.SubscriptionRequest)(nil),       // 9: linkall.vanus.controller.SubscriptionRequest
-	(*controller.CreateEventBusRequest)(nil),     // 10: linkall.vanus.controller.CreateEventBusRequest
-	(*meta.EventBus)(nil),                        // 11: linkall.vanus.meta.EventBus
-	(*emptypb.Empty)(nil),                        // 12: google.protobuf.Empty
-	(*controller.UpdateEventBusRequest)(nil),     // 13: linkall.vanus.controller.UpdateEventBusRequest
-	(*controller.ListSegmentRequest)(nil),        // 14: linkall.vanus.controller.ListSegmentRequest
-	(*controller.CreateSubscriptionRequest)(nil), // 15: linkall.vanus.controller.CreateSubscriptionRequest
-	(*controller.UpdateSubscriptionRequest)(nil), // 16: linkall.vanus.controller.UpdateSubscriptionRequest
-	(*controller.DeleteSubscriptionRequest)(nil), // 17: linkall.vanus.controller.DeleteSubscriptionRequest
-	(*controller.GetSubscriptionRequest)(nil),    // 18: linkall.vanus.controller.GetSubscriptionRequest
-	(*controller.ListEventbusResponse)(nil),      // 19: linkall.vanus.controller.ListEventbusResponse
-	(*controller.ListSegmentResponse)(nil),       // 20: linkall.vanus.controller.ListSegmentResponse
-	(*meta.Subscription)(nil),                    // 21: linkall.vanus.meta.Subscription
-	(*controller.ListSubscriptionResponse)(nil),  // 22: linkall.vanus.controller.ListSubscriptionResponse
+func (*PreviewSubscriptionRequest) ProtoMessage() {}
+
+func (x *PreviewSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proxy_proto_depIdxs = []int32{
-	7,  // 0: linkall.vanus.proxy.LookupOffsetResponse.offsets:type_name -> linkall.vanus.proxy.LookupOffsetResponse.OffsetsEntry
-	8,  // 1: linkall.vanus.proxy.GetEventResponse.events:type_name -> google.protobuf.BytesValue
-	9,  // 2: linkall.vanus.proxy.ValidateSubscriptionRequest.subscription:type_name -> linkall.vanus.controller.SubscriptionRequest
-	10, // 3: linkall.vanus.proxy.ControllerProxy.CreateEventBus:input_type -> linkall.vanus.controller.CreateEventBusRequest
-	11, // 4: linkall.vanus.proxy.ControllerProxy.DeleteEventBus:input_type -> linkall.vanus.meta.EventBus
-	11, // 5: linkall.vanus.proxy.ControllerProxy.GetEventBus:input_type -> linkall.vanus.meta.EventBus
-	12, // 6: linkall.vanus.proxy.ControllerProxy.ListEventBus:input_type -> google.protobuf.Empty
-	13, // 7: linkall.vanus.proxy.ControllerProxy.UpdateEventBus:input_type -> linkall.vanus.controller.UpdateEventBusRequest
-	14, // 8: linkall.vanus.proxy.ControllerProxy.ListSegment:input_type -> linkall.vanus.controller.ListSegmentRequest
-	15, // 9: linkall.vanus.proxy.ControllerProxy.CreateSubscription:input_type -> linkall.vanus.controller.CreateSubscriptionRequest
-	16, // 10: linkall.vanus.proxy.ControllerProxy.UpdateSubscription:input_type -> linkall.vanus.controller.UpdateSubscriptionRequest
-	17, // 11: linkall.vanus.proxy.ControllerProxy.DeleteSubscription:input_type -> linkall.vanus.controller.DeleteSubscriptionRequest
-	18, // 12: linkall.vanus.proxy.ControllerProxy.GetSubscription:input_type -> linkall.vanus.controller.GetSubscriptionRequest
-	12, // 13: linkall.vanus.proxy.ControllerProxy.ListSubscription:input_type -> google.protobuf.Empty
-	12, // 14: linkall.vanus.proxy.ControllerProxy.ClusterInfo:input_type -> google.protobuf.Empty
-	0,  // 15: linkall.vanus.proxy.ControllerProxy.LookupOffset:input_type -> linkall.vanus.proxy.LookupOffsetRequest
-	2,  // 16: linkall.vanus.proxy.ControllerProxy.GetEvent:input_type -> linkall.vanus.proxy.GetEventRequest
-	5,  // 17: linkall.vanus.proxy.ControllerProxy.ValidateSubscription:input_type -> linkall.vanus.proxy.ValidateSubscriptionRequest
-	11, // 18: linkall.vanus.proxy.ControllerProxy.CreateEventBus:output_type -> linkall.vanus.meta.EventBus
-	12, // 19: linkall.vanus.proxy.ControllerProxy.DeleteEventBus:output_type -> google.protobuf.Empty
-	11, // 20: linkall.vanus.proxy.ControllerProxy.GetEventBus:output_type -> linkall.vanus.meta.EventBus
-	19, // 21: linkall.vanus.proxy.ControllerProxy.ListEventBus:output_type -> linkall.vanus.controller.ListEventbusResponse
-	11, // 22: linkall.vanus.proxy.ControllerProxy.UpdateEventBus:output_type -> linkall.vanus.meta.EventBus
-	20, // 23: linkall.vanus.proxy.ControllerProxy.ListSegment:output_type -> linkall.vanus.controller.ListSegmentResponse
-	21, // 24: linkall.vanus.proxy.ControllerProxy.CreateSubscription:output_type -> linkall.vanus.meta.Subscription
-	21, // 25: linkall.vanus.proxy.ControllerProxy.UpdateSubscription:output_type -> linkall.vanus.meta.Subscription
-	12, // 26: linkall.vanus.proxy.ControllerProxy.DeleteSubscription:output_type -> google.protobuf.Empty
-	21, // 27: linkall.vanus.proxy.ControllerProxy.GetSubscription:output_type -> linkall.vanus.meta.Subscription
-	22, // 28: linkall.vanus.proxy.ControllerProxy.ListSubscription:output_type -> linkall.vanus.controller.ListSubscriptionResponse
-	4,  // 29: linkall.vanus.proxy.ControllerProxy.ClusterInfo:output_type -> linkall.vanus.proxy.ClusterInfoResponse
-	1,  // 30: linkall.vanus.proxy.ControllerProxy.LookupOffset:output_type -> linkall.vanus.proxy.LookupOffsetResponse
-	3,  // 31: linkall.vanus.proxy.ControllerProxy.GetEvent:output_type -> linkall.vanus.proxy.GetEventResponse
-	6,  // 32: linkall.vanus.proxy.ControllerProxy.ValidateSubscription:output_type -> linkall.vanus.proxy.ValidateSubscriptionResponse
-	18, // [18:33] is the sub-list for method output_type
-	3,  // [3:18] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+// Deprecated: Use PreviewSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{10}
 }
 
-func init() { file_proxy_proto_init() }
-func file_proxy_proto_init() {
-	if File_proxy_proto != nil {
-		return
+func (x *PreviewSubscriptionRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proxy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupOffsetRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *PreviewSubscriptionRequest) GetSample() int32 {
+	if x != nil {
+		return x.Sample
+	}
+	return 0
+}
+
+type PreviewSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*PreviewResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *PreviewSubscriptionResponse) Reset() {
+	*x = PreviewSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSubscriptionResponse) ProtoMessage() {}
+
+func (x *PreviewSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proxy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupOffsetResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreviewSubscriptionResponse) GetResults() []*PreviewResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type PreviewResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventlogId   uint64 `protobuf:"varint,1,opt,name=eventlog_id,json=eventlogId,proto3" json:"eventlog_id,omitempty"`
+	Offset       int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Event        []byte `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	FilterResult bool   `protobuf:"varint,4,opt,name=filter_result,json=filterResult,proto3" json:"filter_result,omitempty"`
+	// the payload would be delivered to sink, it's empty if filter not matched
+	TransformerResult []byte `protobuf:"bytes,5,opt,name=transformer_result,json=transformerResult,proto3" json:"transformer_result,omitempty"`
+	Error             string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PreviewResult) Reset() {
+	*x = PreviewResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewResult) ProtoMessage() {}
+
+func (x *PreviewResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewResult.ProtoReflect.Descriptor instead.
+func (*PreviewResult) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PreviewResult) GetEventlogId() uint64 {
+	if x != nil {
+		return x.EventlogId
+	}
+	return 0
+}
+
+func (x *PreviewResult) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PreviewResult) GetEvent() []byte {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PreviewResult) GetFilterResult() bool {
+	if x != nil {
+		return x.FilterResult
+	}
+	return false
+}
+
+func (x *PreviewResult) GetTransformerResult() []byte {
+	if x != nil {
+		return x.TransformerResult
+	}
+	return nil
+}
+
+func (x *PreviewResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CancelDelayedEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the eventbus which the delayed event is going to be delivered to
+	Eventbus string `protobuf:"bytes,1,opt,name=eventbus,proto3" json:"eventbus,omitempty"`
+	// the id attribute of the delayed event
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+}
+
+func (x *CancelDelayedEventRequest) Reset() {
+	*x = CancelDelayedEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDelayedEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDelayedEventRequest) ProtoMessage() {}
+
+func (x *CancelDelayedEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEventResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDelayedEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelDelayedEventRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelDelayedEventRequest) GetEventbus() string {
+	if x != nil {
+		return x.Eventbus
+	}
+	return ""
+}
+
+func (x *CancelDelayedEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type RescheduleDelayedEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eventbus string `protobuf:"bytes,1,opt,name=eventbus,proto3" json:"eventbus,omitempty"`
+	EventId  string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// the new delivery time, RFC3339 format
+	DeliveryTime string `protobuf:"bytes,3,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
+}
+
+func (x *RescheduleDelayedEventRequest) Reset() {
+	*x = RescheduleDelayedEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleDelayedEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleDelayedEventRequest) ProtoMessage() {}
+
+func (x *RescheduleDelayedEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleDelayedEventRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleDelayedEventRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RescheduleDelayedEventRequest) GetEventbus() string {
+	if x != nil {
+		return x.Eventbus
+	}
+	return ""
+}
+
+func (x *RescheduleDelayedEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RescheduleDelayedEventRequest) GetDeliveryTime() string {
+	if x != nil {
+		return x.DeliveryTime
+	}
+	return ""
+}
+
+type CaptureProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gateway, controller, the address of a segment server or trigger worker,
+	// or the hostname of it, such as vanus-store-0
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Seconds   uint32 `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+}
+
+func (x *CaptureProfileRequest) Reset() {
+	*x = CaptureProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptureProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureProfileRequest) ProtoMessage() {}
+
+func (x *CaptureProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateSubscriptionRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureProfileRequest.ProtoReflect.Descriptor instead.
+func (*CaptureProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CaptureProfileRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *CaptureProfileRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CaptureProfileRequest) GetSeconds() uint32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type QueryEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eventbus string `protobuf:"bytes,1,opt,name=eventbus,proto3" json:"eventbus,omitempty"`
+	// the events match all filters are returned, same as the filters of subscription
+	Filters []*meta.Filter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// the time window [start_time, end_time) in unix milliseconds, it's the time
+	// when events were stored, end_time is now if it's 0
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// the maximum number of events in a page
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the next_page_token of previous page, the other fields must be the same as
+	// the request of previous page
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *QueryEventsRequest) Reset() {
+	*x = QueryEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsRequest) ProtoMessage() {}
+
+func (x *QueryEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateSubscriptionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsRequest.ProtoReflect.Descriptor instead.
+func (*QueryEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QueryEventsRequest) GetEventbus() string {
+	if x != nil {
+		return x.Eventbus
+	}
+	return ""
+}
+
+func (x *QueryEventsRequest) GetFilters() []*meta.Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *QueryEventsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type QueryEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*wrapperspb.BytesValue `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// it's empty if there are no more events in the time window
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *QueryEventsResponse) Reset() {
+	*x = QueryEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEventsResponse) ProtoMessage() {}
+
+func (x *QueryEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEventsResponse.ProtoReflect.Descriptor instead.
+func (*QueryEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *QueryEventsResponse) GetEvents() []*wrapperspb.BytesValue {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *QueryEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TraceEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Eventbus string `protobuf:"bytes,1,opt,name=eventbus,proto3" json:"eventbus,omitempty"`
+	// the event id returned by gateway when the event was published
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+}
+
+func (x *TraceEventRequest) Reset() {
+	*x = TraceEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventRequest) ProtoMessage() {}
+
+func (x *TraceEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventRequest.ProtoReflect.Descriptor instead.
+func (*TraceEventRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TraceEventRequest) GetEventbus() string {
+	if x != nil {
+		return x.Eventbus
+	}
+	return ""
+}
+
+func (x *TraceEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type TraceEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// where the event was appended
+	EventlogId    uint64               `protobuf:"varint,1,opt,name=eventlog_id,json=eventlogId,proto3" json:"eventlog_id,omitempty"`
+	Offset        int64                `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Event         []byte               `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Subscriptions []*SubscriptionTrace `protobuf:"bytes,4,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *TraceEventResponse) Reset() {
+	*x = TraceEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventResponse) ProtoMessage() {}
+
+func (x *TraceEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventResponse.ProtoReflect.Descriptor instead.
+func (*TraceEventResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TraceEventResponse) GetEventlogId() uint64 {
+	if x != nil {
+		return x.EventlogId
+	}
+	return 0
+}
+
+func (x *TraceEventResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TraceEventResponse) GetEvent() []byte {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TraceEventResponse) GetSubscriptions() []*SubscriptionTrace {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// SubscriptionTrace is where the event went in a subscription of the eventbus.
+type SubscriptionTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FilterResult   bool   `protobuf:"varint,3,opt,name=filter_result,json=filterResult,proto3" json:"filter_result,omitempty"`
+	// the offset of subscription has passed the event
+	Committed bool `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+	// the trigger worker which the subscription is running on, it's empty if not running
+	TriggerWorker string `protobuf:"bytes,5,opt,name=trigger_worker,json=triggerWorker,proto3" json:"trigger_worker,omitempty"`
+	// whether the deliveries of event are traced by the trigger worker
+	TraceEnabled bool                      `protobuf:"varint,6,opt,name=trace_enabled,json=traceEnabled,proto3" json:"trace_enabled,omitempty"`
+	Deliveries   []*trigger.DeliveryResult `protobuf:"bytes,7,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	// the error of querying the deliveries from trigger worker
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SubscriptionTrace) Reset() {
+	*x = SubscriptionTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionTrace) ProtoMessage() {}
+
+func (x *SubscriptionTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionTrace.ProtoReflect.Descriptor instead.
+func (*SubscriptionTrace) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SubscriptionTrace) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *SubscriptionTrace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubscriptionTrace) GetFilterResult() bool {
+	if x != nil {
+		return x.FilterResult
+	}
+	return false
+}
+
+func (x *SubscriptionTrace) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *SubscriptionTrace) GetTriggerWorker() string {
+	if x != nil {
+		return x.TriggerWorker
+	}
+	return ""
+}
+
+func (x *SubscriptionTrace) GetTraceEnabled() bool {
+	if x != nil {
+		return x.TraceEnabled
+	}
+	return false
+}
+
+func (x *SubscriptionTrace) GetDeliveries() []*trigger.DeliveryResult {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *SubscriptionTrace) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proxy_proto protoreflect.FileDescriptor
+
+var file_proxy_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a, 0x13,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xa4,
+	0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6a, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x22, 0xec, 0x02, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c,
+	0x6f, 0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x99, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a,
+	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5d, 0x0a, 0x1a,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x1b, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x52, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x1d, 0x52, 0x65, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x62, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x15, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x12, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x72, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4a, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xb1, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbc, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x32, 0xe5, 0x2b, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x49, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73,
+	0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x1a, 0x1c,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x56, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x6a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x68, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x70, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x6b, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x33,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x6b, 0x0a, 0x10, 0x54, 0x61, 0x69, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x61,
+	0x69, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x4f,
+	0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x63, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x64, 0x0a,
+	0x16, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x6e, 0x0a, 0x0e, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12,
+	0x62, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12,
+	0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x4a, 0x6f, 0x62, 0x12, 0x70, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x4a, 0x6f, 0x62, 0x12, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x5d, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x26, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x09, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2a,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c,
+	0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12, 0x5f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12, 0x32, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x76, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12, 0x30, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49, 0x50,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x5f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x31, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5e, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x5f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x31, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x0c, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x58, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x6b, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54,
+	0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x73, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x48, 0x54,
+	0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x6b, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5d, 0x0a,
+	0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x2f, 0x5a, 0x2d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proxy_proto_rawDescOnce sync.Once
+	file_proxy_proto_rawDescData = file_proxy_proto_rawDesc
+)
+
+func file_proxy_proto_rawDescGZIP() []byte {
+	file_proxy_proto_rawDescOnce.Do(func() {
+		file_proxy_proto_rawDescData = protoimpl.X.CompressGZIP(file_proxy_proto_rawDescData)
+	})
+	return file_proxy_proto_rawDescData
+}
+
+var file_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_proxy_proto_goTypes = []interface{}{
+	(*LookupOffsetRequest)(nil),                   // 0: linkall.vanus.proxy.LookupOffsetRequest
+	(*LookupOffsetResponse)(nil),                  // 1: linkall.vanus.proxy.LookupOffsetResponse
+	(*LookupEventlogRequest)(nil),                 // 2: linkall.vanus.proxy.LookupEventlogRequest
+	(*LookupEventlogResponse)(nil),                // 3: linkall.vanus.proxy.LookupEventlogResponse
+	(*EventlogRoute)(nil),                         // 4: linkall.vanus.proxy.EventlogRoute
+	(*GetEventRequest)(nil),                       // 5: linkall.vanus.proxy.GetEventRequest
+	(*GetEventResponse)(nil),                      // 6: linkall.vanus.proxy.GetEventResponse
+	(*ClusterInfoResponse)(nil),                   // 7: linkall.vanus.proxy.ClusterInfoResponse
+	(*ValidateSubscriptionRequest)(nil),           // 8: linkall.vanus.proxy.ValidateSubscriptionRequest
+	(*ValidateSubscriptionResponse)(nil),          // 9: linkall.vanus.proxy.ValidateSubscriptionResponse
+	(*PreviewSubscriptionRequest)(nil),            // 10: linkall.vanus.proxy.PreviewSubscriptionRequest
+	(*PreviewSubscriptionResponse)(nil),           // 11: linkall.vanus.proxy.PreviewSubscriptionResponse
+	(*PreviewResult)(nil),                         // 12: linkall.vanus.proxy.PreviewResult
+	(*CancelDelayedEventRequest)(nil),             // 13: linkall.vanus.proxy.CancelDelayedEventRequest
+	(*RescheduleDelayedEventRequest)(nil),         // 14: linkall.vanus.proxy.RescheduleDelayedEventRequest
+	(*CaptureProfileRequest)(nil),                 // 15: linkall.vanus.proxy.CaptureProfileRequest
+	(*QueryEventsRequest)(nil),                    // 16: linkall.vanus.proxy.QueryEventsRequest
+	(*QueryEventsResponse)(nil),                   // 17: linkall.vanus.proxy.QueryEventsResponse
+	(*TraceEventRequest)(nil),                     // 18: linkall.vanus.proxy.TraceEventRequest
+	(*TraceEventResponse)(nil),                    // 19: linkall.vanus.proxy.TraceEventResponse
+	(*SubscriptionTrace)(nil),                     // 20: linkall.vanus.proxy.SubscriptionTrace
+	nil,                                           // 21: linkall.vanus.proxy.LookupOffsetResponse.OffsetsEntry
+	nil,                                           // 22: linkall.vanus.proxy.EventlogRoute.ReplicasEntry
+	(*wrapperspb.BytesValue)(nil),                 // 23: google.protobuf.BytesValue
+	(*controller.SubscriptionRequest)(nil),        // 24: linkall.vanus.controller.SubscriptionRequest
+	(*meta.Filter)(nil),                           // 25: linkall.vanus.meta.Filter
+	(*trigger.DeliveryResult)(nil),                // 26: linkall.vanus.trigger.DeliveryResult
+	(*controller.CreateEventBusRequest)(nil),      // 27: linkall.vanus.controller.CreateEventBusRequest
+	(*meta.EventBus)(nil),                         // 28: linkall.vanus.meta.EventBus
+	(*emptypb.Empty)(nil),                         // 29: google.protobuf.Empty
+	(*controller.UpdateEventBusRequest)(nil),      // 30: linkall.vanus.controller.UpdateEventBusRequest
+	(*controller.ListSegmentRequest)(nil),         // 31: linkall.vanus.controller.ListSegmentRequest
+	(*controller.CreateCronEventRequest)(nil),     // 32: linkall.vanus.controller.CreateCronEventRequest
+	(*controller.ListCronEventRequest)(nil),       // 33: linkall.vanus.controller.ListCronEventRequest
+	(*controller.DeleteCronEventRequest)(nil),     // 34: linkall.vanus.controller.DeleteCronEventRequest
+	(*controller.CreateSubscriptionRequest)(nil),  // 35: linkall.vanus.controller.CreateSubscriptionRequest
+	(*controller.UpdateSubscriptionRequest)(nil),  // 36: linkall.vanus.controller.UpdateSubscriptionRequest
+	(*controller.DeleteSubscriptionRequest)(nil),  // 37: linkall.vanus.controller.DeleteSubscriptionRequest
+	(*controller.GetSubscriptionRequest)(nil),     // 38: linkall.vanus.controller.GetSubscriptionRequest
+	(*controller.ExportSubscriptionRequest)(nil),  // 39: linkall.vanus.controller.ExportSubscriptionRequest
+	(*controller.ImportSubscriptionRequest)(nil),  // 40: linkall.vanus.controller.ImportSubscriptionRequest
+	(*trigger.TailSubscriptionRequest)(nil),       // 41: linkall.vanus.trigger.TailSubscriptionRequest
+	(*controller.CreateReplayJobRequest)(nil),     // 42: linkall.vanus.controller.CreateReplayJobRequest
+	(*controller.GetReplayJobRequest)(nil),        // 43: linkall.vanus.controller.GetReplayJobRequest
+	(*controller.ListReplayJobRequest)(nil),       // 44: linkall.vanus.controller.ListReplayJobRequest
+	(*controller.DeleteReplayJobRequest)(nil),     // 45: linkall.vanus.controller.DeleteReplayJobRequest
+	(*controller.PutSecretRequest)(nil),           // 46: linkall.vanus.controller.PutSecretRequest
+	(*controller.DeleteSecretRequest)(nil),        // 47: linkall.vanus.controller.DeleteSecretRequest
+	(*controller.EventbusACL)(nil),                // 48: linkall.vanus.controller.EventbusACL
+	(*controller.DeleteEventbusACLRequest)(nil),   // 49: linkall.vanus.controller.DeleteEventbusACLRequest
+	(*controller.ListEventbusACLRequest)(nil),     // 50: linkall.vanus.controller.ListEventbusACLRequest
+	(*controller.IPAllowlist)(nil),                // 51: linkall.vanus.controller.IPAllowlist
+	(*controller.DeleteIPAllowlistRequest)(nil),   // 52: linkall.vanus.controller.DeleteIPAllowlistRequest
+	(*controller.FeatureFlag)(nil),                // 53: linkall.vanus.controller.FeatureFlag
+	(*controller.DeleteFeatureFlagRequest)(nil),   // 54: linkall.vanus.controller.DeleteFeatureFlagRequest
+	(*controller.Connector)(nil),                  // 55: linkall.vanus.controller.Connector
+	(*controller.GetConnectorRequest)(nil),        // 56: linkall.vanus.controller.GetConnectorRequest
+	(*controller.DeleteConnectorRequest)(nil),     // 57: linkall.vanus.controller.DeleteConnectorRequest
+	(*controller.CreateHTTPPollerRequest)(nil),    // 58: linkall.vanus.controller.CreateHTTPPollerRequest
+	(*controller.ListHTTPPollerRequest)(nil),      // 59: linkall.vanus.controller.ListHTTPPollerRequest
+	(*controller.PauseHTTPPollerRequest)(nil),     // 60: linkall.vanus.controller.PauseHTTPPollerRequest
+	(*controller.ResumeHTTPPollerRequest)(nil),    // 61: linkall.vanus.controller.ResumeHTTPPollerRequest
+	(*controller.DeleteHTTPPollerRequest)(nil),    // 62: linkall.vanus.controller.DeleteHTTPPollerRequest
+	(*controller.ListEventbusResponse)(nil),       // 63: linkall.vanus.controller.ListEventbusResponse
+	(*controller.ListSegmentResponse)(nil),        // 64: linkall.vanus.controller.ListSegmentResponse
+	(*controller.CronEvent)(nil),                  // 65: linkall.vanus.controller.CronEvent
+	(*controller.ListCronEventResponse)(nil),      // 66: linkall.vanus.controller.ListCronEventResponse
+	(*controller.ListTimerReplicaResponse)(nil),   // 67: linkall.vanus.controller.ListTimerReplicaResponse
+	(*controller.ClusterStats)(nil),               // 68: linkall.vanus.controller.ClusterStats
+	(*meta.Subscription)(nil),                     // 69: linkall.vanus.meta.Subscription
+	(*controller.ListSubscriptionResponse)(nil),   // 70: linkall.vanus.controller.ListSubscriptionResponse
+	(*controller.ListTriggerWorkerResponse)(nil),  // 71: linkall.vanus.controller.ListTriggerWorkerResponse
+	(*controller.ExportSubscriptionResponse)(nil), // 72: linkall.vanus.controller.ExportSubscriptionResponse
+	(*controller.CaptureProfileResponse)(nil),     // 73: linkall.vanus.controller.CaptureProfileResponse
+	(*controller.ReplayJob)(nil),                  // 74: linkall.vanus.controller.ReplayJob
+	(*controller.ListReplayJobResponse)(nil),      // 75: linkall.vanus.controller.ListReplayJobResponse
+	(*controller.Secret)(nil),                     // 76: linkall.vanus.controller.Secret
+	(*controller.ListSecretResponse)(nil),         // 77: linkall.vanus.controller.ListSecretResponse
+	(*controller.ListEventbusACLResponse)(nil),    // 78: linkall.vanus.controller.ListEventbusACLResponse
+	(*controller.ListIPAllowlistResponse)(nil),    // 79: linkall.vanus.controller.ListIPAllowlistResponse
+	(*controller.ListFeatureFlagResponse)(nil),    // 80: linkall.vanus.controller.ListFeatureFlagResponse
+	(*controller.ListConnectorResponse)(nil),      // 81: linkall.vanus.controller.ListConnectorResponse
+	(*controller.HTTPPoller)(nil),                 // 82: linkall.vanus.controller.HTTPPoller
+	(*controller.ListHTTPPollerResponse)(nil),     // 83: linkall.vanus.controller.ListHTTPPollerResponse
+}
+var file_proxy_proto_depIdxs = []int32{
+	21, // 0: linkall.vanus.proxy.LookupOffsetResponse.offsets:type_name -> linkall.vanus.proxy.LookupOffsetResponse.OffsetsEntry
+	4,  // 1: linkall.vanus.proxy.LookupEventlogResponse.routes:type_name -> linkall.vanus.proxy.EventlogRoute
+	22, // 2: linkall.vanus.proxy.EventlogRoute.replicas:type_name -> linkall.vanus.proxy.EventlogRoute.ReplicasEntry
+	23, // 3: linkall.vanus.proxy.GetEventResponse.events:type_name -> google.protobuf.BytesValue
+	24, // 4: linkall.vanus.proxy.ValidateSubscriptionRequest.subscription:type_name -> linkall.vanus.controller.SubscriptionRequest
+	12, // 5: linkall.vanus.proxy.PreviewSubscriptionResponse.results:type_name -> linkall.vanus.proxy.PreviewResult
+	25, // 6: linkall.vanus.proxy.QueryEventsRequest.filters:type_name -> linkall.vanus.meta.Filter
+	23, // 7: linkall.vanus.proxy.QueryEventsResponse.events:type_name -> google.protobuf.BytesValue
+	20, // 8: linkall.vanus.proxy.TraceEventResponse.subscriptions:type_name -> linkall.vanus.proxy.SubscriptionTrace
+	26, // 9: linkall.vanus.proxy.SubscriptionTrace.deliveries:type_name -> linkall.vanus.trigger.DeliveryResult
+	27, // 10: linkall.vanus.proxy.ControllerProxy.CreateEventBus:input_type -> linkall.vanus.controller.CreateEventBusRequest
+	28, // 11: linkall.vanus.proxy.ControllerProxy.DeleteEventBus:input_type -> linkall.vanus.meta.EventBus
+	28, // 12: linkall.vanus.proxy.ControllerProxy.GetEventBus:input_type -> linkall.vanus.meta.EventBus
+	29, // 13: linkall.vanus.proxy.ControllerProxy.ListEventBus:input_type -> google.protobuf.Empty
+	30, // 14: linkall.vanus.proxy.ControllerProxy.UpdateEventBus:input_type -> linkall.vanus.controller.UpdateEventBusRequest
+	31, // 15: linkall.vanus.proxy.ControllerProxy.ListSegment:input_type -> linkall.vanus.controller.ListSegmentRequest
+	32, // 16: linkall.vanus.proxy.ControllerProxy.CreateCronEvent:input_type -> linkall.vanus.controller.CreateCronEventRequest
+	33, // 17: linkall.vanus.proxy.ControllerProxy.ListCronEvent:input_type -> linkall.vanus.controller.ListCronEventRequest
+	34, // 18: linkall.vanus.proxy.ControllerProxy.DeleteCronEvent:input_type -> linkall.vanus.controller.DeleteCronEventRequest
+	29, // 19: linkall.vanus.proxy.ControllerProxy.ListTimerReplica:input_type -> google.protobuf.Empty
+	29, // 20: linkall.vanus.proxy.ControllerProxy.GetClusterStats:input_type -> google.protobuf.Empty
+	35, // 21: linkall.vanus.proxy.ControllerProxy.CreateSubscription:input_type -> linkall.vanus.controller.CreateSubscriptionRequest
+	36, // 22: linkall.vanus.proxy.ControllerProxy.UpdateSubscription:input_type -> linkall.vanus.controller.UpdateSubscriptionRequest
+	37, // 23: linkall.vanus.proxy.ControllerProxy.DeleteSubscription:input_type -> linkall.vanus.controller.DeleteSubscriptionRequest
+	38, // 24: linkall.vanus.proxy.ControllerProxy.GetSubscription:input_type -> linkall.vanus.controller.GetSubscriptionRequest
+	29, // 25: linkall.vanus.proxy.ControllerProxy.ListSubscription:input_type -> google.protobuf.Empty
+	29, // 26: linkall.vanus.proxy.ControllerProxy.ListTriggerWorker:input_type -> google.protobuf.Empty
+	39, // 27: linkall.vanus.proxy.ControllerProxy.ExportSubscription:input_type -> linkall.vanus.controller.ExportSubscriptionRequest
+	40, // 28: linkall.vanus.proxy.ControllerProxy.ImportSubscription:input_type -> linkall.vanus.controller.ImportSubscriptionRequest
+	41, // 29: linkall.vanus.proxy.ControllerProxy.TailSubscription:input_type -> linkall.vanus.trigger.TailSubscriptionRequest
+	29, // 30: linkall.vanus.proxy.ControllerProxy.ClusterInfo:input_type -> google.protobuf.Empty
+	0,  // 31: linkall.vanus.proxy.ControllerProxy.LookupOffset:input_type -> linkall.vanus.proxy.LookupOffsetRequest
+	2,  // 32: linkall.vanus.proxy.ControllerProxy.LookupEventlog:input_type -> linkall.vanus.proxy.LookupEventlogRequest
+	5,  // 33: linkall.vanus.proxy.ControllerProxy.GetEvent:input_type -> linkall.vanus.proxy.GetEventRequest
+	8,  // 34: linkall.vanus.proxy.ControllerProxy.ValidateSubscription:input_type -> linkall.vanus.proxy.ValidateSubscriptionRequest
+	10, // 35: linkall.vanus.proxy.ControllerProxy.PreviewSubscription:input_type -> linkall.vanus.proxy.PreviewSubscriptionRequest
+	13, // 36: linkall.vanus.proxy.ControllerProxy.CancelDelayedEvent:input_type -> linkall.vanus.proxy.CancelDelayedEventRequest
+	14, // 37: linkall.vanus.proxy.ControllerProxy.RescheduleDelayedEvent:input_type -> linkall.vanus.proxy.RescheduleDelayedEventRequest
+	15, // 38: linkall.vanus.proxy.ControllerProxy.CaptureProfile:input_type -> linkall.vanus.proxy.CaptureProfileRequest
+	16, // 39: linkall.vanus.proxy.ControllerProxy.QueryEvents:input_type -> linkall.vanus.proxy.QueryEventsRequest
+	42, // 40: linkall.vanus.proxy.ControllerProxy.CreateReplayJob:input_type -> linkall.vanus.controller.CreateReplayJobRequest
+	43, // 41: linkall.vanus.proxy.ControllerProxy.GetReplayJob:input_type -> linkall.vanus.controller.GetReplayJobRequest
+	44, // 42: linkall.vanus.proxy.ControllerProxy.ListReplayJob:input_type -> linkall.vanus.controller.ListReplayJobRequest
+	45, // 43: linkall.vanus.proxy.ControllerProxy.DeleteReplayJob:input_type -> linkall.vanus.controller.DeleteReplayJobRequest
+	18, // 44: linkall.vanus.proxy.ControllerProxy.TraceEvent:input_type -> linkall.vanus.proxy.TraceEventRequest
+	46, // 45: linkall.vanus.proxy.ControllerProxy.PutSecret:input_type -> linkall.vanus.controller.PutSecretRequest
+	29, // 46: linkall.vanus.proxy.ControllerProxy.ListSecret:input_type -> google.protobuf.Empty
+	47, // 47: linkall.vanus.proxy.ControllerProxy.DeleteSecret:input_type -> linkall.vanus.controller.DeleteSecretRequest
+	48, // 48: linkall.vanus.proxy.ControllerProxy.PutEventbusACL:input_type -> linkall.vanus.controller.EventbusACL
+	49, // 49: linkall.vanus.proxy.ControllerProxy.DeleteEventbusACL:input_type -> linkall.vanus.controller.DeleteEventbusACLRequest
+	50, // 50: linkall.vanus.proxy.ControllerProxy.ListEventbusACL:input_type -> linkall.vanus.controller.ListEventbusACLRequest
+	51, // 51: linkall.vanus.proxy.ControllerProxy.PutIPAllowlist:input_type -> linkall.vanus.controller.IPAllowlist
+	52, // 52: linkall.vanus.proxy.ControllerProxy.DeleteIPAllowlist:input_type -> linkall.vanus.controller.DeleteIPAllowlistRequest
+	29, // 53: linkall.vanus.proxy.ControllerProxy.ListIPAllowlist:input_type -> google.protobuf.Empty
+	53, // 54: linkall.vanus.proxy.ControllerProxy.PutFeatureFlag:input_type -> linkall.vanus.controller.FeatureFlag
+	54, // 55: linkall.vanus.proxy.ControllerProxy.DeleteFeatureFlag:input_type -> linkall.vanus.controller.DeleteFeatureFlagRequest
+	29, // 56: linkall.vanus.proxy.ControllerProxy.ListFeatureFlag:input_type -> google.protobuf.Empty
+	55, // 57: linkall.vanus.proxy.ControllerProxy.PutConnector:input_type -> linkall.vanus.controller.Connector
+	56, // 58: linkall.vanus.proxy.ControllerProxy.GetConnector:input_type -> linkall.vanus.controller.GetConnectorRequest
+	29, // 59: linkall.vanus.proxy.ControllerProxy.ListConnector:input_type -> google.protobuf.Empty
+	57, // 60: linkall.vanus.proxy.ControllerProxy.DeleteConnector:input_type -> linkall.vanus.controller.DeleteConnectorRequest
+	58, // 61: linkall.vanus.proxy.ControllerProxy.CreateHTTPPoller:input_type -> linkall.vanus.controller.CreateHTTPPollerRequest
+	59, // 62: linkall.vanus.proxy.ControllerProxy.ListHTTPPoller:input_type -> linkall.vanus.controller.ListHTTPPollerRequest
+	60, // 63: linkall.vanus.proxy.ControllerProxy.PauseHTTPPoller:input_type -> linkall.vanus.controller.PauseHTTPPollerRequest
+	61, // 64: linkall.vanus.proxy.ControllerProxy.ResumeHTTPPoller:input_type -> linkall.vanus.controller.ResumeHTTPPollerRequest
+	62, // 65: linkall.vanus.proxy.ControllerProxy.DeleteHTTPPoller:input_type -> linkall.vanus.controller.DeleteHTTPPollerRequest
+	28, // 66: linkall.vanus.proxy.ControllerProxy.CreateEventBus:output_type -> linkall.vanus.meta.EventBus
+	29, // 67: linkall.vanus.proxy.ControllerProxy.DeleteEventBus:output_type -> google.protobuf.Empty
+	28, // 68: linkall.vanus.proxy.ControllerProxy.GetEventBus:output_type -> linkall.vanus.meta.EventBus
+	63, // 69: linkall.vanus.proxy.ControllerProxy.ListEventBus:output_type -> linkall.vanus.controller.ListEventbusResponse
+	28, // 70: linkall.vanus.proxy.ControllerProxy.UpdateEventBus:output_type -> linkall.vanus.meta.EventBus
+	64, // 71: linkall.vanus.proxy.ControllerProxy.ListSegment:output_type -> linkall.vanus.controller.ListSegmentResponse
+	65, // 72: linkall.vanus.proxy.ControllerProxy.CreateCronEvent:output_type -> linkall.vanus.controller.CronEvent
+	66, // 73: linkall.vanus.proxy.ControllerProxy.ListCronEvent:output_type -> linkall.vanus.controller.ListCronEventResponse
+	29, // 74: linkall.vanus.proxy.ControllerProxy.DeleteCronEvent:output_type -> google.protobuf.Empty
+	67, // 75: linkall.vanus.proxy.ControllerProxy.ListTimerReplica:output_type -> linkall.vanus.controller.ListTimerReplicaResponse
+	68, // 76: linkall.vanus.proxy.ControllerProxy.GetClusterStats:output_type -> linkall.vanus.controller.ClusterStats
+	69, // 77: linkall.vanus.proxy.ControllerProxy.CreateSubscription:output_type -> linkall.vanus.meta.Subscription
+	69, // 78: linkall.vanus.proxy.ControllerProxy.UpdateSubscription:output_type -> linkall.vanus.meta.Subscription
+	29, // 79: linkall.vanus.proxy.ControllerProxy.DeleteSubscription:output_type -> google.protobuf.Empty
+	69, // 80: linkall.vanus.proxy.ControllerProxy.GetSubscription:output_type -> linkall.vanus.meta.Subscription
+	70, // 81: linkall.vanus.proxy.ControllerProxy.ListSubscription:output_type -> linkall.vanus.controller.ListSubscriptionResponse
+	71, // 82: linkall.vanus.proxy.ControllerProxy.ListTriggerWorker:output_type -> linkall.vanus.controller.ListTriggerWorkerResponse
+	72, // 83: linkall.vanus.proxy.ControllerProxy.ExportSubscription:output_type -> linkall.vanus.controller.ExportSubscriptionResponse
+	69, // 84: linkall.vanus.proxy.ControllerProxy.ImportSubscription:output_type -> linkall.vanus.meta.Subscription
+	26, // 85: linkall.vanus.proxy.ControllerProxy.TailSubscription:output_type -> linkall.vanus.trigger.DeliveryResult
+	7,  // 86: linkall.vanus.proxy.ControllerProxy.ClusterInfo:output_type -> linkall.vanus.proxy.ClusterInfoResponse
+	1,  // 87: linkall.vanus.proxy.ControllerProxy.LookupOffset:output_type -> linkall.vanus.proxy.LookupOffsetResponse
+	3,  // 88: linkall.vanus.proxy.ControllerProxy.LookupEventlog:output_type -> linkall.vanus.proxy.LookupEventlogResponse
+	6,  // 89: linkall.vanus.proxy.ControllerProxy.GetEvent:output_type -> linkall.vanus.proxy.GetEventResponse
+	9,  // 90: linkall.vanus.proxy.ControllerProxy.ValidateSubscription:output_type -> linkall.vanus.proxy.ValidateSubscriptionResponse
+	11, // 91: linkall.vanus.proxy.ControllerProxy.PreviewSubscription:output_type -> linkall.vanus.proxy.PreviewSubscriptionResponse
+	29, // 92: linkall.vanus.proxy.ControllerProxy.CancelDelayedEvent:output_type -> google.protobuf.Empty
+	29, // 93: linkall.vanus.proxy.ControllerProxy.RescheduleDelayedEvent:output_type -> google.protobuf.Empty
+	73, // 94: linkall.vanus.proxy.ControllerProxy.CaptureProfile:output_type -> linkall.vanus.controller.CaptureProfileResponse
+	17, // 95: linkall.vanus.proxy.ControllerProxy.QueryEvents:output_type -> linkall.vanus.proxy.QueryEventsResponse
+	74, // 96: linkall.vanus.proxy.ControllerProxy.CreateReplayJob:output_type -> linkall.vanus.controller.ReplayJob
+	74, // 97: linkall.vanus.proxy.ControllerProxy.GetReplayJob:output_type -> linkall.vanus.controller.ReplayJob
+	75, // 98: linkall.vanus.proxy.ControllerProxy.ListReplayJob:output_type -> linkall.vanus.controller.ListReplayJobResponse
+	29, // 99: linkall.vanus.proxy.ControllerProxy.DeleteReplayJob:output_type -> google.protobuf.Empty
+	19, // 100: linkall.vanus.proxy.ControllerProxy.TraceEvent:output_type -> linkall.vanus.proxy.TraceEventResponse
+	76, // 101: linkall.vanus.proxy.ControllerProxy.PutSecret:output_type -> linkall.vanus.controller.Secret
+	77, // 102: linkall.vanus.proxy.ControllerProxy.ListSecret:output_type -> linkall.vanus.controller.ListSecretResponse
+	29, // 103: linkall.vanus.proxy.ControllerProxy.DeleteSecret:output_type -> google.protobuf.Empty
+	48, // 104: linkall.vanus.proxy.ControllerProxy.PutEventbusACL:output_type -> linkall.vanus.controller.EventbusACL
+	29, // 105: linkall.vanus.proxy.ControllerProxy.DeleteEventbusACL:output_type -> google.protobuf.Empty
+	78, // 106: linkall.vanus.proxy.ControllerProxy.ListEventbusACL:output_type -> linkall.vanus.controller.ListEventbusACLResponse
+	51, // 107: linkall.vanus.proxy.ControllerProxy.PutIPAllowlist:output_type -> linkall.vanus.controller.IPAllowlist
+	29, // 108: linkall.vanus.proxy.ControllerProxy.DeleteIPAllowlist:output_type -> google.protobuf.Empty
+	79, // 109: linkall.vanus.proxy.ControllerProxy.ListIPAllowlist:output_type -> linkall.vanus.controller.ListIPAllowlistResponse
+	53, // 110: linkall.vanus.proxy.ControllerProxy.PutFeatureFlag:output_type -> linkall.vanus.controller.FeatureFlag
+	29, // 111: linkall.vanus.proxy.ControllerProxy.DeleteFeatureFlag:output_type -> google.protobuf.Empty
+	80, // 112: linkall.vanus.proxy.ControllerProxy.ListFeatureFlag:output_type -> linkall.vanus.controller.ListFeatureFlagResponse
+	55, // 113: linkall.vanus.proxy.ControllerProxy.PutConnector:output_type -> linkall.vanus.controller.Connector
+	55, // 114: linkall.vanus.proxy.ControllerProxy.GetConnector:output_type -> linkall.vanus.controller.Connector
+	81, // 115: linkall.vanus.proxy.ControllerProxy.ListConnector:output_type -> linkall.vanus.controller.ListConnectorResponse
+	29, // 116: linkall.vanus.proxy.ControllerProxy.DeleteConnector:output_type -> google.protobuf.Empty
+	82, // 117: linkall.vanus.proxy.ControllerProxy.CreateHTTPPoller:output_type -> linkall.vanus.controller.HTTPPoller
+	83, // 118: linkall.vanus.proxy.ControllerProxy.ListHTTPPoller:output_type -> linkall.vanus.controller.ListHTTPPollerResponse
+	82, // 119: linkall.vanus.proxy.ControllerProxy.PauseHTTPPoller:output_type -> linkall.vanus.controller.HTTPPoller
+	82, // 120: linkall.vanus.proxy.ControllerProxy.ResumeHTTPPoller:output_type -> linkall.vanus.controller.HTTPPoller
+	29, // 121: linkall.vanus.proxy.ControllerProxy.DeleteHTTPPoller:output_type -> google.protobuf.Empty
+	66, // [66:122] is the sub-list for method output_type
+	10, // [10:66] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_proxy_proto_init() }
+func file_proxy_proto_init() {
+	if File_proxy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proxy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOffsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOffsetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupEventlogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupEventlogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventlogRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDelayedEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleDelayedEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptureProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proxy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proxy_proto_goTypes,
+		DependencyIndexes: file_proxy_proto_depIdxs,
+		MessageInfos:      file_proxy_proto_msgTypes,
+	}.Build()
+	File_proxy_proto = out.File
+	file_proxy_proto_rawDesc = nil
+	file_proxy_proto_goTypes = nil
+	file_proxy_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ControllerProxyClient is the client API for ControllerProxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControllerProxyClient interface {
+	// Eventbus
+	CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
+	DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error)
+	ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error)
+	UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
+	ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error)
+	CreateCronEvent(ctx context.Context, in *controller.CreateCronEventRequest, opts ...grpc.CallOption) (*controller.CronEvent, error)
+	ListCronEvent(ctx context.Context, in *controller.ListCronEventRequest, opts ...grpc.CallOption) (*controller.ListCronEventResponse, error)
+	DeleteCronEvent(ctx context.Context, in *controller.DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTimerReplicaResponse, error)
+	GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ClusterStats, error)
+	// Trigger
+	CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error)
+	ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTriggerWorkerResponse, error)
+	ExportSubscription(ctx context.Context, in *controller.ExportSubscriptionRequest, opts ...grpc.CallOption) (*controller.ExportSubscriptionResponse, error)
+	ImportSubscription(ctx context.Context, in *controller.ImportSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	TailSubscription(ctx context.Context, in *trigger.TailSubscriptionRequest, opts ...grpc.CallOption) (ControllerProxy_TailSubscriptionClient, error)
+	// custom
+	ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
+	LookupOffset(ctx context.Context, in *LookupOffsetRequest, opts ...grpc.CallOption) (*LookupOffsetResponse, error)
+	LookupEventlog(ctx context.Context, in *LookupEventlogRequest, opts ...grpc.CallOption) (*LookupEventlogResponse, error)
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
+	ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error)
+	PreviewSubscription(ctx context.Context, in *PreviewSubscriptionRequest, opts ...grpc.CallOption) (*PreviewSubscriptionResponse, error)
+	CancelDelayedEvent(ctx context.Context, in *CancelDelayedEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RescheduleDelayedEvent(ctx context.Context, in *RescheduleDelayedEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*controller.CaptureProfileResponse, error)
+	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
+	CreateReplayJob(ctx context.Context, in *controller.CreateReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error)
+	GetReplayJob(ctx context.Context, in *controller.GetReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error)
+	ListReplayJob(ctx context.Context, in *controller.ListReplayJobRequest, opts ...grpc.CallOption) (*controller.ListReplayJobResponse, error)
+	DeleteReplayJob(ctx context.Context, in *controller.DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TraceEvent(ctx context.Context, in *TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error)
+	PutSecret(ctx context.Context, in *controller.PutSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error)
+	ListSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSecretResponse, error)
+	DeleteSecret(ctx context.Context, in *controller.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PutEventbusACL(ctx context.Context, in *controller.EventbusACL, opts ...grpc.CallOption) (*controller.EventbusACL, error)
+	DeleteEventbusACL(ctx context.Context, in *controller.DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListEventbusACL(ctx context.Context, in *controller.ListEventbusACLRequest, opts ...grpc.CallOption) (*controller.ListEventbusACLResponse, error)
+	PutIPAllowlist(ctx context.Context, in *controller.IPAllowlist, opts ...grpc.CallOption) (*controller.IPAllowlist, error)
+	DeleteIPAllowlist(ctx context.Context, in *controller.DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListIPAllowlistResponse, error)
+	PutFeatureFlag(ctx context.Context, in *controller.FeatureFlag, opts ...grpc.CallOption) (*controller.FeatureFlag, error)
+	DeleteFeatureFlag(ctx context.Context, in *controller.DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListFeatureFlagResponse, error)
+	PutConnector(ctx context.Context, in *controller.Connector, opts ...grpc.CallOption) (*controller.Connector, error)
+	GetConnector(ctx context.Context, in *controller.GetConnectorRequest, opts ...grpc.CallOption) (*controller.Connector, error)
+	ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListConnectorResponse, error)
+	DeleteConnector(ctx context.Context, in *controller.DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateHTTPPoller(ctx context.Context, in *controller.CreateHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error)
+	ListHTTPPoller(ctx context.Context, in *controller.ListHTTPPollerRequest, opts ...grpc.CallOption) (*controller.ListHTTPPollerResponse, error)
+	PauseHTTPPoller(ctx context.Context, in *controller.PauseHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error)
+	ResumeHTTPPoller(ctx context.Context, in *controller.ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error)
+	DeleteHTTPPoller(ctx context.Context, in *controller.DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type controllerProxyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewControllerProxyClient(cc grpc.ClientConnInterface) ControllerProxyClient {
+	return &controllerProxyClient{cc}
+}
+
+func (c *controllerProxyClient) CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error) {
+	out := new(controller.ListEventbusResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/UpdateEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error) {
+	out := new(controller.ListSegmentResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CreateCronEvent(ctx context.Context, in *controller.CreateCronEventRequest, opts ...grpc.CallOption) (*controller.CronEvent, error) {
+	out := new(controller.CronEvent)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListCronEvent(ctx context.Context, in *controller.ListCronEventRequest, opts ...grpc.CallOption) (*controller.ListCronEventResponse, error) {
+	out := new(controller.ListCronEventResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteCronEvent(ctx context.Context, in *controller.DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTimerReplicaResponse, error) {
+	out := new(controller.ListTimerReplicaResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListTimerReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ClusterStats, error) {
+	out := new(controller.ClusterStats)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetClusterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/UpdateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error) {
+	out := new(controller.ListSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTriggerWorkerResponse, error) {
+	out := new(controller.ListTriggerWorkerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListTriggerWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ExportSubscription(ctx context.Context, in *controller.ExportSubscriptionRequest, opts ...grpc.CallOption) (*controller.ExportSubscriptionResponse, error) {
+	out := new(controller.ExportSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ExportSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ImportSubscription(ctx context.Context, in *controller.ImportSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ImportSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) TailSubscription(ctx context.Context, in *trigger.TailSubscriptionRequest, opts ...grpc.CallOption) (ControllerProxy_TailSubscriptionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ControllerProxy_serviceDesc.Streams[0], "/linkall.vanus.proxy.ControllerProxy/TailSubscription", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &controllerProxyTailSubscriptionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ControllerProxy_TailSubscriptionClient interface {
+	Recv() (*trigger.DeliveryResult, error)
+	grpc.ClientStream
+}
+
+type controllerProxyTailSubscriptionClient struct {
+	grpc.ClientStream
+}
+
+func (x *controllerProxyTailSubscriptionClient) Recv() (*trigger.DeliveryResult, error) {
+	m := new(trigger.DeliveryResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *controllerProxyClient) ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
+	out := new(ClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ClusterInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) LookupOffset(ctx context.Context, in *LookupOffsetRequest, opts ...grpc.CallOption) (*LookupOffsetResponse, error) {
+	out := new(LookupOffsetResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/LookupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) LookupEventlog(ctx context.Context, in *LookupEventlogRequest, opts ...grpc.CallOption) (*LookupEventlogResponse, error) {
+	out := new(LookupEventlogResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/LookupEventlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error) {
+	out := new(GetEventResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error) {
+	out := new(ValidateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ValidateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PreviewSubscription(ctx context.Context, in *PreviewSubscriptionRequest, opts ...grpc.CallOption) (*PreviewSubscriptionResponse, error) {
+	out := new(PreviewSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PreviewSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CancelDelayedEvent(ctx context.Context, in *CancelDelayedEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CancelDelayedEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) RescheduleDelayedEvent(ctx context.Context, in *RescheduleDelayedEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/RescheduleDelayedEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*controller.CaptureProfileResponse, error) {
+	out := new(controller.CaptureProfileResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CaptureProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error) {
+	out := new(QueryEventsResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/QueryEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CreateReplayJob(ctx context.Context, in *controller.CreateReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error) {
+	out := new(controller.ReplayJob)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetReplayJob(ctx context.Context, in *controller.GetReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error) {
+	out := new(controller.ReplayJob)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListReplayJob(ctx context.Context, in *controller.ListReplayJobRequest, opts ...grpc.CallOption) (*controller.ListReplayJobResponse, error) {
+	out := new(controller.ListReplayJobResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteReplayJob(ctx context.Context, in *controller.DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) TraceEvent(ctx context.Context, in *TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error) {
+	out := new(TraceEventResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/TraceEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PutSecret(ctx context.Context, in *controller.PutSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error) {
+	out := new(controller.Secret)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PutSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSecretResponse, error) {
+	out := new(controller.ListSecretResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteSecret(ctx context.Context, in *controller.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PutEventbusACL(ctx context.Context, in *controller.EventbusACL, opts ...grpc.CallOption) (*controller.EventbusACL, error) {
+	out := new(controller.EventbusACL)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PutEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteEventbusACL(ctx context.Context, in *controller.DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListEventbusACL(ctx context.Context, in *controller.ListEventbusACLRequest, opts ...grpc.CallOption) (*controller.ListEventbusACLResponse, error) {
+	out := new(controller.ListEventbusACLResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PutIPAllowlist(ctx context.Context, in *controller.IPAllowlist, opts ...grpc.CallOption) (*controller.IPAllowlist, error) {
+	out := new(controller.IPAllowlist)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PutIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteIPAllowlist(ctx context.Context, in *controller.DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListIPAllowlistResponse, error) {
+	out := new(controller.ListIPAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PutFeatureFlag(ctx context.Context, in *controller.FeatureFlag, opts ...grpc.CallOption) (*controller.FeatureFlag, error) {
+	out := new(controller.FeatureFlag)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PutFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteFeatureFlag(ctx context.Context, in *controller.DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListFeatureFlagResponse, error) {
+	out := new(controller.ListFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PutConnector(ctx context.Context, in *controller.Connector, opts ...grpc.CallOption) (*controller.Connector, error) {
+	out := new(controller.Connector)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PutConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) GetConnector(ctx context.Context, in *controller.GetConnectorRequest, opts ...grpc.CallOption) (*controller.Connector, error) {
+	out := new(controller.Connector)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListConnectorResponse, error) {
+	out := new(controller.ListConnectorResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteConnector(ctx context.Context, in *controller.DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) CreateHTTPPoller(ctx context.Context, in *controller.CreateHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	out := new(controller.HTTPPoller)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ListHTTPPoller(ctx context.Context, in *controller.ListHTTPPollerRequest, opts ...grpc.CallOption) (*controller.ListHTTPPollerResponse, error) {
+	out := new(controller.ListHTTPPollerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) PauseHTTPPoller(ctx context.Context, in *controller.PauseHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	out := new(controller.HTTPPoller)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/PauseHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) ResumeHTTPPoller(ctx context.Context, in *controller.ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	out := new(controller.HTTPPoller)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ResumeHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerProxyClient) DeleteHTTPPoller(ctx context.Context, in *controller.DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControllerProxyServer is the server API for ControllerProxy service.
+type ControllerProxyServer interface {
+	// Eventbus
+	CreateEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error)
+	DeleteEventBus(context.Context, *meta.EventBus) (*emptypb.Empty, error)
+	GetEventBus(context.Context, *meta.EventBus) (*meta.EventBus, error)
+	ListEventBus(context.Context, *emptypb.Empty) (*controller.ListEventbusResponse, error)
+	UpdateEventBus(context.Context, *controller.UpdateEventBusRequest) (*meta.EventBus, error)
+	ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error)
+	CreateCronEvent(context.Context, *controller.CreateCronEventRequest) (*controller.CronEvent, error)
+	ListCronEvent(context.Context, *controller.ListCronEventRequest) (*controller.ListCronEventResponse, error)
+	DeleteCronEvent(context.Context, *controller.DeleteCronEventRequest) (*emptypb.Empty, error)
+	ListTimerReplica(context.Context, *emptypb.Empty) (*controller.ListTimerReplicaResponse, error)
+	GetClusterStats(context.Context, *emptypb.Empty) (*controller.ClusterStats, error)
+	// Trigger
+	CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error)
+	UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error)
+	DeleteSubscription(context.Context, *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error)
+	GetSubscription(context.Context, *controller.GetSubscriptionRequest) (*meta.Subscription, error)
+	ListSubscription(context.Context, *emptypb.Empty) (*controller.ListSubscriptionResponse, error)
+	ListTriggerWorker(context.Context, *emptypb.Empty) (*controller.ListTriggerWorkerResponse, error)
+	ExportSubscription(context.Context, *controller.ExportSubscriptionRequest) (*controller.ExportSubscriptionResponse, error)
+	ImportSubscription(context.Context, *controller.ImportSubscriptionRequest) (*meta.Subscription, error)
+	TailSubscription(*trigger.TailSubscriptionRequest, ControllerProxy_TailSubscriptionServer) error
+	// custom
+	ClusterInfo(context.Context, *emptypb.Empty) (*ClusterInfoResponse, error)
+	LookupOffset(context.Context, *LookupOffsetRequest) (*LookupOffsetResponse, error)
+	LookupEventlog(context.Context, *LookupEventlogRequest) (*LookupEventlogResponse, error)
+	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
+	ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error)
+	PreviewSubscription(context.Context, *PreviewSubscriptionRequest) (*PreviewSubscriptionResponse, error)
+	CancelDelayedEvent(context.Context, *CancelDelayedEventRequest) (*emptypb.Empty, error)
+	RescheduleDelayedEvent(context.Context, *RescheduleDelayedEventRequest) (*emptypb.Empty, error)
+	CaptureProfile(context.Context, *CaptureProfileRequest) (*controller.CaptureProfileResponse, error)
+	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
+	CreateReplayJob(context.Context, *controller.CreateReplayJobRequest) (*controller.ReplayJob, error)
+	GetReplayJob(context.Context, *controller.GetReplayJobRequest) (*controller.ReplayJob, error)
+	ListReplayJob(context.Context, *controller.ListReplayJobRequest) (*controller.ListReplayJobResponse, error)
+	DeleteReplayJob(context.Context, *controller.DeleteReplayJobRequest) (*emptypb.Empty, error)
+	TraceEvent(context.Context, *TraceEventRequest) (*TraceEventResponse, error)
+	PutSecret(context.Context, *controller.PutSecretRequest) (*controller.Secret, error)
+	ListSecret(context.Context, *emptypb.Empty) (*controller.ListSecretResponse, error)
+	DeleteSecret(context.Context, *controller.DeleteSecretRequest) (*emptypb.Empty, error)
+	PutEventbusACL(context.Context, *controller.EventbusACL) (*controller.EventbusACL, error)
+	DeleteEventbusACL(context.Context, *controller.DeleteEventbusACLRequest) (*emptypb.Empty, error)
+	ListEventbusACL(context.Context, *controller.ListEventbusACLRequest) (*controller.ListEventbusACLResponse, error)
+	PutIPAllowlist(context.Context, *controller.IPAllowlist) (*controller.IPAllowlist, error)
+	DeleteIPAllowlist(context.Context, *controller.DeleteIPAllowlistRequest) (*emptypb.Empty, error)
+	ListIPAllowlist(context.Context, *emptypb.Empty) (*controller.ListIPAllowlistResponse, error)
+	PutFeatureFlag(context.Context, *controller.FeatureFlag) (*controller.FeatureFlag, error)
+	DeleteFeatureFlag(context.Context, *controller.DeleteFeatureFlagRequest) (*emptypb.Empty, error)
+	ListFeatureFlag(context.Context, *emptypb.Empty) (*controller.ListFeatureFlagResponse, error)
+	PutConnector(context.Context, *controller.Connector) (*controller.Connector, error)
+	GetConnector(context.Context, *controller.GetConnectorRequest) (*controller.Connector, error)
+	ListConnector(context.Context, *emptypb.Empty) (*controller.ListConnectorResponse, error)
+	DeleteConnector(context.Context, *controller.DeleteConnectorRequest) (*emptypb.Empty, error)
+	CreateHTTPPoller(context.Context, *controller.CreateHTTPPollerRequest) (*controller.HTTPPoller, error)
+	ListHTTPPoller(context.Context, *controller.ListHTTPPollerRequest) (*controller.ListHTTPPollerResponse, error)
+	PauseHTTPPoller(context.Context, *controller.PauseHTTPPollerRequest) (*controller.HTTPPoller, error)
+	ResumeHTTPPoller(context.Context, *controller.ResumeHTTPPollerRequest) (*controller.HTTPPoller, error)
+	DeleteHTTPPoller(context.Context, *controller.DeleteHTTPPollerRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedControllerProxyServer can be embedded to have forward compatible implementations.
+type UnimplementedControllerProxyServer struct {
+}
+
+func (*UnimplementedControllerProxyServer) CreateEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventBus not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteEventBus(context.Context, *meta.EventBus) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventBus not implemented")
+}
+func (*UnimplementedControllerProxyServer) GetEventBus(context.Context, *meta.EventBus) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventBus not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListEventBus(context.Context, *emptypb.Empty) (*controller.ListEventbusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventBus not implemented")
+}
+func (*UnimplementedControllerProxyServer) UpdateEventBus(context.Context, *controller.UpdateEventBusRequest) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEventBus not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegment not implemented")
+}
+func (*UnimplementedControllerProxyServer) CreateCronEvent(context.Context, *controller.CreateCronEventRequest) (*controller.CronEvent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCronEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListCronEvent(context.Context, *controller.ListCronEventRequest) (*controller.ListCronEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCronEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteCronEvent(context.Context, *controller.DeleteCronEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListTimerReplica(context.Context, *emptypb.Empty) (*controller.ListTimerReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimerReplica not implemented")
+}
+func (*UnimplementedControllerProxyServer) GetClusterStats(context.Context, *emptypb.Empty) (*controller.ClusterStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStats not implemented")
+}
+func (*UnimplementedControllerProxyServer) CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteSubscription(context.Context, *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) GetSubscription(context.Context, *controller.GetSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListSubscription(context.Context, *emptypb.Empty) (*controller.ListSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListTriggerWorker(context.Context, *emptypb.Empty) (*controller.ListTriggerWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTriggerWorker not implemented")
+}
+func (*UnimplementedControllerProxyServer) ExportSubscription(context.Context, *controller.ExportSubscriptionRequest) (*controller.ExportSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) ImportSubscription(context.Context, *controller.ImportSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) TailSubscription(*trigger.TailSubscriptionRequest, ControllerProxy_TailSubscriptionServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) ClusterInfo(context.Context, *emptypb.Empty) (*ClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterInfo not implemented")
+}
+func (*UnimplementedControllerProxyServer) LookupOffset(context.Context, *LookupOffsetRequest) (*LookupOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupOffset not implemented")
+}
+func (*UnimplementedControllerProxyServer) LookupEventlog(context.Context, *LookupEventlogRequest) (*LookupEventlogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupEventlog not implemented")
+}
+func (*UnimplementedControllerProxyServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) PreviewSubscription(context.Context, *PreviewSubscriptionRequest) (*PreviewSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSubscription not implemented")
+}
+func (*UnimplementedControllerProxyServer) CancelDelayedEvent(context.Context, *CancelDelayedEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDelayedEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) RescheduleDelayedEvent(context.Context, *RescheduleDelayedEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleDelayedEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) CaptureProfile(context.Context, *CaptureProfileRequest) (*controller.CaptureProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureProfile not implemented")
+}
+func (*UnimplementedControllerProxyServer) QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
+}
+func (*UnimplementedControllerProxyServer) CreateReplayJob(context.Context, *controller.CreateReplayJobRequest) (*controller.ReplayJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplayJob not implemented")
+}
+func (*UnimplementedControllerProxyServer) GetReplayJob(context.Context, *controller.GetReplayJobRequest) (*controller.ReplayJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplayJob not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListReplayJob(context.Context, *controller.ListReplayJobRequest) (*controller.ListReplayJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplayJob not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteReplayJob(context.Context, *controller.DeleteReplayJobRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplayJob not implemented")
+}
+func (*UnimplementedControllerProxyServer) TraceEvent(context.Context, *TraceEventRequest) (*TraceEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceEvent not implemented")
+}
+func (*UnimplementedControllerProxyServer) PutSecret(context.Context, *controller.PutSecretRequest) (*controller.Secret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutSecret not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListSecret(context.Context, *emptypb.Empty) (*controller.ListSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecret not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteSecret(context.Context, *controller.DeleteSecretRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (*UnimplementedControllerProxyServer) PutEventbusACL(context.Context, *controller.EventbusACL) (*controller.EventbusACL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutEventbusACL not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteEventbusACL(context.Context, *controller.DeleteEventbusACLRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventbusACL not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListEventbusACL(context.Context, *controller.ListEventbusACLRequest) (*controller.ListEventbusACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventbusACL not implemented")
+}
+func (*UnimplementedControllerProxyServer) PutIPAllowlist(context.Context, *controller.IPAllowlist) (*controller.IPAllowlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutIPAllowlist not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteIPAllowlist(context.Context, *controller.DeleteIPAllowlistRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIPAllowlist not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListIPAllowlist(context.Context, *emptypb.Empty) (*controller.ListIPAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIPAllowlist not implemented")
+}
+func (*UnimplementedControllerProxyServer) PutFeatureFlag(context.Context, *controller.FeatureFlag) (*controller.FeatureFlag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutFeatureFlag not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteFeatureFlag(context.Context, *controller.DeleteFeatureFlagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeatureFlag not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListFeatureFlag(context.Context, *emptypb.Empty) (*controller.ListFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlag not implemented")
+}
+func (*UnimplementedControllerProxyServer) PutConnector(context.Context, *controller.Connector) (*controller.Connector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutConnector not implemented")
+}
+func (*UnimplementedControllerProxyServer) GetConnector(context.Context, *controller.GetConnectorRequest) (*controller.Connector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnector not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListConnector(context.Context, *emptypb.Empty) (*controller.ListConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnector not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteConnector(context.Context, *controller.DeleteConnectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnector not implemented")
+}
+func (*UnimplementedControllerProxyServer) CreateHTTPPoller(context.Context, *controller.CreateHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHTTPPoller not implemented")
+}
+func (*UnimplementedControllerProxyServer) ListHTTPPoller(context.Context, *controller.ListHTTPPollerRequest) (*controller.ListHTTPPollerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHTTPPoller not implemented")
+}
+func (*UnimplementedControllerProxyServer) PauseHTTPPoller(context.Context, *controller.PauseHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseHTTPPoller not implemented")
+}
+func (*UnimplementedControllerProxyServer) ResumeHTTPPoller(context.Context, *controller.ResumeHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeHTTPPoller not implemented")
+}
+func (*UnimplementedControllerProxyServer) DeleteHTTPPoller(context.Context, *controller.DeleteHTTPPollerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHTTPPoller not implemented")
+}
+
+func RegisterControllerProxyServer(s *grpc.Server, srv ControllerProxyServer) {
+	s.RegisterService(&_ControllerProxy_serviceDesc, srv)
+}
+
+func _ControllerProxy_CreateEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateEventBusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CreateEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CreateEventBus(ctx, req.(*controller.CreateEventBusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_DeleteEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(meta.EventBus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteEventBus(ctx, req.(*meta.EventBus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_GetEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(meta.EventBus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetEventBus(ctx, req.(*meta.EventBus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ListEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListEventBus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_UpdateEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.UpdateEventBusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).UpdateEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/UpdateEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).UpdateEventBus(ctx, req.(*controller.UpdateEventBusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ListSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListSegment(ctx, req.(*controller.ListSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_CreateCronEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateCronEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CreateCronEvent(ctx, in)
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proxy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proxy_proto_goTypes,
-		DependencyIndexes: file_proxy_proto_depIdxs,
-		MessageInfos:      file_proxy_proto_msgTypes,
-	}.Build()
-	File_proxy_proto = out.File
-	file_proxy_proto_rawDesc = nil
-	file_proxy_proto_goTypes = nil
-	file_proxy_proto_depIdxs = nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateCronEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CreateCronEvent(ctx, req.(*controller.CreateCronEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _ControllerProxy_ListCronEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListCronEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListCronEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListCronEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListCronEvent(ctx, req.(*controller.ListCronEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _ControllerProxy_DeleteCronEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteCronEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteCronEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteCronEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteCronEvent(ctx, req.(*controller.DeleteCronEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// ControllerProxyClient is the client API for ControllerProxy service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ControllerProxyClient interface {
-	// EventbusService
-	CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
-	DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error)
-	ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error)
-	UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
-	ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error)
-	// Trigger
-	CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
-	UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
-	DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
-	ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error)
-	// custom
-	ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
-	LookupOffset(ctx context.Context, in *LookupOffsetRequest, opts ...grpc.CallOption) (*LookupOffsetResponse, error)
-	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
-	ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error)
+func _ControllerProxy_ListTimerReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListTimerReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListTimerReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListTimerReplica(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type controllerProxyClient struct {
-	cc grpc.ClientConnInterface
+func _ControllerProxy_GetClusterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetClusterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetClusterStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetClusterStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewControllerProxyClient(cc grpc.ClientConnInterface) ControllerProxyClient {
-	return &controllerProxyClient{cc}
+func _ControllerProxy_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CreateSubscription(ctx, req.(*controller.CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
-	out := new(meta.EventBus)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateEventBus", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).UpdateSubscription(ctx, req.(*controller.UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteEventBus", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteSubscription(ctx, req.(*controller.DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetSubscription(ctx, req.(*controller.GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ListSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListSubscription(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ListTriggerWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListTriggerWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListTriggerWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListTriggerWorker(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ExportSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ExportSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ExportSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ExportSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ExportSubscription(ctx, req.(*controller.ExportSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_ImportSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ImportSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ImportSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ImportSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ImportSubscription(ctx, req.(*controller.ImportSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_TailSubscription_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(trigger.TailSubscriptionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControllerProxyServer).TailSubscription(m, &controllerProxyTailSubscriptionServer{stream})
+}
+
+type ControllerProxy_TailSubscriptionServer interface {
+	Send(*trigger.DeliveryResult) error
+	grpc.ServerStream
+}
+
+type controllerProxyTailSubscriptionServer struct {
+	grpc.ServerStream
+}
+
+func (x *controllerProxyTailSubscriptionServer) Send(m *trigger.DeliveryResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ControllerProxy_ClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ClusterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ClusterInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ClusterInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
-	out := new(meta.EventBus)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetEventBus", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_LookupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupOffsetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).LookupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/LookupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).LookupOffset(ctx, req.(*LookupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error) {
-	out := new(controller.ListEventbusResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListEventBus", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_LookupEventlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupEventlogRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).LookupEventlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/LookupEventlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).LookupEventlog(ctx, req.(*LookupEventlogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
-	out := new(meta.EventBus)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/UpdateEventBus", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error) {
-	out := new(controller.ListSegmentResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListSegment", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_ValidateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSubscriptionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ValidateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ValidateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ValidateSubscription(ctx, req.(*ValidateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
-	out := new(meta.Subscription)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/CreateSubscription", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_PreviewSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSubscriptionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).PreviewSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PreviewSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).PreviewSubscription(ctx, req.(*PreviewSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
-	out := new(meta.Subscription)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/UpdateSubscription", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_CancelDelayedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDelayedEventRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CancelDelayedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CancelDelayedEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CancelDelayedEvent(ctx, req.(*CancelDelayedEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/DeleteSubscription", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_RescheduleDelayedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleDelayedEventRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).RescheduleDelayedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/RescheduleDelayedEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).RescheduleDelayedEvent(ctx, req.(*RescheduleDelayedEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
-	out := new(meta.Subscription)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetSubscription", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_CaptureProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CaptureProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CaptureProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CaptureProfile(ctx, req.(*CaptureProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error) {
-	out := new(controller.ListSubscriptionResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ListSubscription", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_QueryEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEventsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).QueryEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/QueryEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).QueryEvents(ctx, req.(*QueryEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
-	out := new(ClusterInfoResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ClusterInfo", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_CreateReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateReplayJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).CreateReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).CreateReplayJob(ctx, req.(*controller.CreateReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) LookupOffset(ctx context.Context, in *LookupOffsetRequest, opts ...grpc.CallOption) (*LookupOffsetResponse, error) {
-	out := new(LookupOffsetResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/LookupOffset", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_GetReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetReplayJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).GetReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).GetReplayJob(ctx, req.(*controller.GetReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error) {
-	out := new(GetEventResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/GetEvent", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_ListReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListReplayJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListReplayJob(ctx, req.(*controller.ListReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *controllerProxyClient) ValidateSubscription(ctx context.Context, in *ValidateSubscriptionRequest, opts ...grpc.CallOption) (*ValidateSubscriptionResponse, error) {
-	out := new(ValidateSubscriptionResponse)
-	err := c.cc.Invoke(ctx, "/linkall.vanus.proxy.ControllerProxy/ValidateSubscription", in, out, opts...)
-	if err != nil {
+func _ControllerProxy_DeleteReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteReplayJobRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ControllerProxyServer is the server API for ControllerProxy service.
-type ControllerProxyServer interface {
-	// EventbusService
-	CreateEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error)
-	DeleteEventBus(context.Context, *meta.EventBus) (*emptypb.Empty, error)
-	GetEventBus(context.Context, *meta.EventBus) (*meta.EventBus, error)
-	ListEventBus(context.Context, *emptypb.Empty) (*controller.ListEventbusResponse, error)
-	UpdateEventBus(context.Context, *controller.UpdateEventBusRequest) (*meta.EventBus, error)
-	ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error)
-	// Trigger
-	CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error)
-	UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error)
-	DeleteSubscription(context.Context, *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error)
-	GetSubscription(context.Context, *controller.GetSubscriptionRequest) (*meta.Subscription, error)
-	ListSubscription(context.Context, *emptypb.Empty) (*controller.ListSubscriptionResponse, error)
-	// custom
-	ClusterInfo(context.Context, *emptypb.Empty) (*ClusterInfoResponse, error)
-	LookupOffset(context.Context, *LookupOffsetRequest) (*LookupOffsetResponse, error)
-	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
-	ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error)
-}
-
-// UnimplementedControllerProxyServer can be embedded to have forward compatible implementations.
-type UnimplementedControllerProxyServer struct {
-}
-
-func (*UnimplementedControllerProxyServer) CreateEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEventBus not implemented")
-}
-func (*UnimplementedControllerProxyServer) DeleteEventBus(context.Context, *meta.EventBus) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventBus not implemented")
-}
-func (*UnimplementedControllerProxyServer) GetEventBus(context.Context, *meta.EventBus) (*meta.EventBus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEventBus not implemented")
-}
-func (*UnimplementedControllerProxyServer) ListEventBus(context.Context, *emptypb.Empty) (*controller.ListEventbusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEventBus not implemented")
-}
-func (*UnimplementedControllerProxyServer) UpdateEventBus(context.Context, *controller.UpdateEventBusRequest) (*meta.EventBus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEventBus not implemented")
-}
-func (*UnimplementedControllerProxyServer) ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSegment not implemented")
-}
-func (*UnimplementedControllerProxyServer) CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
-}
-func (*UnimplementedControllerProxyServer) UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
-}
-func (*UnimplementedControllerProxyServer) DeleteSubscription(context.Context, *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
-}
-func (*UnimplementedControllerProxyServer) GetSubscription(context.Context, *controller.GetSubscriptionRequest) (*meta.Subscription, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteReplayJob(ctx, req.(*controller.DeleteReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedControllerProxyServer) ListSubscription(context.Context, *emptypb.Empty) (*controller.ListSubscriptionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSubscription not implemented")
+
+func _ControllerProxy_TraceEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).TraceEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/TraceEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).TraceEvent(ctx, req.(*TraceEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedControllerProxyServer) ClusterInfo(context.Context, *emptypb.Empty) (*ClusterInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClusterInfo not implemented")
+
+func _ControllerProxy_PutSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.PutSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).PutSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PutSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).PutSecret(ctx, req.(*controller.PutSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedControllerProxyServer) LookupOffset(context.Context, *LookupOffsetRequest) (*LookupOffsetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupOffset not implemented")
+
+func _ControllerProxy_ListSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).ListSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).ListSecret(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedControllerProxyServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+
+func _ControllerProxy_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteSecret(ctx, req.(*controller.DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedControllerProxyServer) ValidateSubscription(context.Context, *ValidateSubscriptionRequest) (*ValidateSubscriptionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateSubscription not implemented")
+
+func _ControllerProxy_PutEventbusACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.EventbusACL)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).PutEventbusACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PutEventbusACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).PutEventbusACL(ctx, req.(*controller.EventbusACL))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterControllerProxyServer(s *grpc.Server, srv ControllerProxyServer) {
-	s.RegisterService(&_ControllerProxy_serviceDesc, srv)
+func _ControllerProxy_DeleteEventbusACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteEventbusACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteEventbusACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteEventbusACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteEventbusACL(ctx, req.(*controller.DeleteEventbusACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_CreateEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.CreateEventBusRequest)
+func _ControllerProxy_ListEventbusACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListEventbusACLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).CreateEventBus(ctx, in)
+		return srv.(ControllerProxyServer).ListEventbusACL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateEventBus",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListEventbusACL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).CreateEventBus(ctx, req.(*controller.CreateEventBusRequest))
+		return srv.(ControllerProxyServer).ListEventbusACL(ctx, req.(*controller.ListEventbusACLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_DeleteEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(meta.EventBus)
+func _ControllerProxy_PutIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.IPAllowlist)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).DeleteEventBus(ctx, in)
+		return srv.(ControllerProxyServer).PutIPAllowlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteEventBus",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PutIPAllowlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).DeleteEventBus(ctx, req.(*meta.EventBus))
+		return srv.(ControllerProxyServer).PutIPAllowlist(ctx, req.(*controller.IPAllowlist))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_GetEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(meta.EventBus)
+func _ControllerProxy_DeleteIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteIPAllowlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).GetEventBus(ctx, in)
+		return srv.(ControllerProxyServer).DeleteIPAllowlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetEventBus",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteIPAllowlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).GetEventBus(ctx, req.(*meta.EventBus))
+		return srv.(ControllerProxyServer).DeleteIPAllowlist(ctx, req.(*controller.DeleteIPAllowlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_ListEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ControllerProxy_ListIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).ListEventBus(ctx, in)
+		return srv.(ControllerProxyServer).ListIPAllowlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListEventBus",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListIPAllowlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).ListEventBus(ctx, req.(*emptypb.Empty))
+		return srv.(ControllerProxyServer).ListIPAllowlist(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_UpdateEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.UpdateEventBusRequest)
+func _ControllerProxy_PutFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.FeatureFlag)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).UpdateEventBus(ctx, in)
+		return srv.(ControllerProxyServer).PutFeatureFlag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/UpdateEventBus",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PutFeatureFlag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).UpdateEventBus(ctx, req.(*controller.UpdateEventBusRequest))
+		return srv.(ControllerProxyServer).PutFeatureFlag(ctx, req.(*controller.FeatureFlag))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_ListSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.ListSegmentRequest)
+func _ControllerProxy_DeleteFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteFeatureFlagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).ListSegment(ctx, in)
+		return srv.(ControllerProxyServer).DeleteFeatureFlag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListSegment",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteFeatureFlag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).ListSegment(ctx, req.(*controller.ListSegmentRequest))
+		return srv.(ControllerProxyServer).DeleteFeatureFlag(ctx, req.(*controller.DeleteFeatureFlagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.CreateSubscriptionRequest)
+func _ControllerProxy_ListFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).CreateSubscription(ctx, in)
+		return srv.(ControllerProxyServer).ListFeatureFlag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateSubscription",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListFeatureFlag",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).CreateSubscription(ctx, req.(*controller.CreateSubscriptionRequest))
+		return srv.(ControllerProxyServer).ListFeatureFlag(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.UpdateSubscriptionRequest)
+func _ControllerProxy_PutConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.Connector)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).UpdateSubscription(ctx, in)
+		return srv.(ControllerProxyServer).PutConnector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/UpdateSubscription",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PutConnector",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).UpdateSubscription(ctx, req.(*controller.UpdateSubscriptionRequest))
+		return srv.(ControllerProxyServer).PutConnector(ctx, req.(*controller.Connector))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.DeleteSubscriptionRequest)
+func _ControllerProxy_GetConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetConnectorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).DeleteSubscription(ctx, in)
+		return srv.(ControllerProxyServer).GetConnector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteSubscription",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetConnector",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).DeleteSubscription(ctx, req.(*controller.DeleteSubscriptionRequest))
+		return srv.(ControllerProxyServer).GetConnector(ctx, req.(*controller.GetConnectorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(controller.GetSubscriptionRequest)
+func _ControllerProxy_ListConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).GetSubscription(ctx, in)
+		return srv.(ControllerProxyServer).ListConnector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetSubscription",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListConnector",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).GetSubscription(ctx, req.(*controller.GetSubscriptionRequest))
+		return srv.(ControllerProxyServer).ListConnector(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_ListSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ControllerProxy_DeleteConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteConnectorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).ListSubscription(ctx, in)
+		return srv.(ControllerProxyServer).DeleteConnector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListSubscription",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteConnector",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).ListSubscription(ctx, req.(*emptypb.Empty))
+		return srv.(ControllerProxyServer).DeleteConnector(ctx, req.(*controller.DeleteConnectorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_ClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ControllerProxy_CreateHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateHTTPPollerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).ClusterInfo(ctx, in)
+		return srv.(ControllerProxyServer).CreateHTTPPoller(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ClusterInfo",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/CreateHTTPPoller",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).ClusterInfo(ctx, req.(*emptypb.Empty))
+		return srv.(ControllerProxyServer).CreateHTTPPoller(ctx, req.(*controller.CreateHTTPPollerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_LookupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LookupOffsetRequest)
+func _ControllerProxy_ListHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListHTTPPollerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).LookupOffset(ctx, in)
+		return srv.(ControllerProxyServer).ListHTTPPoller(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/LookupOffset",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ListHTTPPoller",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).LookupOffset(ctx, req.(*LookupOffsetRequest))
+		return srv.(ControllerProxyServer).ListHTTPPoller(ctx, req.(*controller.ListHTTPPollerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventRequest)
+func _ControllerProxy_PauseHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.PauseHTTPPollerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).GetEvent(ctx, in)
+		return srv.(ControllerProxyServer).PauseHTTPPoller(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/GetEvent",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/PauseHTTPPoller",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).GetEvent(ctx, req.(*GetEventRequest))
+		return srv.(ControllerProxyServer).PauseHTTPPoller(ctx, req.(*controller.PauseHTTPPollerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControllerProxy_ValidateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateSubscriptionRequest)
+func _ControllerProxy_ResumeHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ResumeHTTPPollerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControllerProxyServer).ValidateSubscription(ctx, in)
+		return srv.(ControllerProxyServer).ResumeHTTPPoller(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ValidateSubscription",
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/ResumeHTTPPoller",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControllerProxyServer).ValidateSubscription(ctx, req.(*ValidateSubscriptionRequest))
+		return srv.(ControllerProxyServer).ResumeHTTPPoller(ctx, req.(*controller.ResumeHTTPPollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControllerProxy_DeleteHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteHTTPPollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerProxyServer).DeleteHTTPPoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.proxy.ControllerProxy/DeleteHTTPPoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerProxyServer).DeleteHTTPPoller(ctx, req.(*controller.DeleteHTTPPollerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1384,6 +4432,26 @@ var _ControllerProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSegment",
 			Handler:    _ControllerProxy_ListSegment_Handler,
 		},
+		{
+			MethodName: "CreateCronEvent",
+			Handler:    _ControllerProxy_CreateCronEvent_Handler,
+		},
+		{
+			MethodName: "ListCronEvent",
+			Handler:    _ControllerProxy_ListCronEvent_Handler,
+		},
+		{
+			MethodName: "DeleteCronEvent",
+			Handler:    _ControllerProxy_DeleteCronEvent_Handler,
+		},
+		{
+			MethodName: "ListTimerReplica",
+			Handler:    _ControllerProxy_ListTimerReplica_Handler,
+		},
+		{
+			MethodName: "GetClusterStats",
+			Handler:    _ControllerProxy_GetClusterStats_Handler,
+		},
 		{
 			MethodName: "CreateSubscription",
 			Handler:    _ControllerProxy_CreateSubscription_Handler,
@@ -1404,6 +4472,18 @@ var _ControllerProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscription",
 			Handler:    _ControllerProxy_ListSubscription_Handler,
 		},
+		{
+			MethodName: "ListTriggerWorker",
+			Handler:    _ControllerProxy_ListTriggerWorker_Handler,
+		},
+		{
+			MethodName: "ExportSubscription",
+			Handler:    _ControllerProxy_ExportSubscription_Handler,
+		},
+		{
+			MethodName: "ImportSubscription",
+			Handler:    _ControllerProxy_ImportSubscription_Handler,
+		},
 		{
 			MethodName: "ClusterInfo",
 			Handler:    _ControllerProxy_ClusterInfo_Handler,
@@ -1412,6 +4492,10 @@ var _ControllerProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupOffset",
 			Handler:    _ControllerProxy_LookupOffset_Handler,
 		},
+		{
+			MethodName: "LookupEventlog",
+			Handler:    _ControllerProxy_LookupEventlog_Handler,
+		},
 		{
 			MethodName: "GetEvent",
 			Handler:    _ControllerProxy_GetEvent_Handler,
@@ -1420,7 +4504,137 @@ var _ControllerProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateSubscription",
 			Handler:    _ControllerProxy_ValidateSubscription_Handler,
 		},
+		{
+			MethodName: "PreviewSubscription",
+			Handler:    _ControllerProxy_PreviewSubscription_Handler,
+		},
+		{
+			MethodName: "CancelDelayedEvent",
+			Handler:    _ControllerProxy_CancelDelayedEvent_Handler,
+		},
+		{
+			MethodName: "RescheduleDelayedEvent",
+			Handler:    _ControllerProxy_RescheduleDelayedEvent_Handler,
+		},
+		{
+			MethodName: "CaptureProfile",
+			Handler:    _ControllerProxy_CaptureProfile_Handler,
+		},
+		{
+			MethodName: "QueryEvents",
+			Handler:    _ControllerProxy_QueryEvents_Handler,
+		},
+		{
+			MethodName: "CreateReplayJob",
+			Handler:    _ControllerProxy_CreateReplayJob_Handler,
+		},
+		{
+			MethodName: "GetReplayJob",
+			Handler:    _ControllerProxy_GetReplayJob_Handler,
+		},
+		{
+			MethodName: "ListReplayJob",
+			Handler:    _ControllerProxy_ListReplayJob_Handler,
+		},
+		{
+			MethodName: "DeleteReplayJob",
+			Handler:    _ControllerProxy_DeleteReplayJob_Handler,
+		},
+		{
+			MethodName: "TraceEvent",
+			Handler:    _ControllerProxy_TraceEvent_Handler,
+		},
+		{
+			MethodName: "PutSecret",
+			Handler:    _ControllerProxy_PutSecret_Handler,
+		},
+		{
+			MethodName: "ListSecret",
+			Handler:    _ControllerProxy_ListSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _ControllerProxy_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "PutEventbusACL",
+			Handler:    _ControllerProxy_PutEventbusACL_Handler,
+		},
+		{
+			MethodName: "DeleteEventbusACL",
+			Handler:    _ControllerProxy_DeleteEventbusACL_Handler,
+		},
+		{
+			MethodName: "ListEventbusACL",
+			Handler:    _ControllerProxy_ListEventbusACL_Handler,
+		},
+		{
+			MethodName: "PutIPAllowlist",
+			Handler:    _ControllerProxy_PutIPAllowlist_Handler,
+		},
+		{
+			MethodName: "DeleteIPAllowlist",
+			Handler:    _ControllerProxy_DeleteIPAllowlist_Handler,
+		},
+		{
+			MethodName: "ListIPAllowlist",
+			Handler:    _ControllerProxy_ListIPAllowlist_Handler,
+		},
+		{
+			MethodName: "PutFeatureFlag",
+			Handler:    _ControllerProxy_PutFeatureFlag_Handler,
+		},
+		{
+			MethodName: "DeleteFeatureFlag",
+			Handler:    _ControllerProxy_DeleteFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlag",
+			Handler:    _ControllerProxy_ListFeatureFlag_Handler,
+		},
+		{
+			MethodName: "PutConnector",
+			Handler:    _ControllerProxy_PutConnector_Handler,
+		},
+		{
+			MethodName: "GetConnector",
+			Handler:    _ControllerProxy_GetConnector_Handler,
+		},
+		{
+			MethodName: "ListConnector",
+			Handler:    _ControllerProxy_ListConnector_Handler,
+		},
+		{
+			MethodName: "DeleteConnector",
+			Handler:    _ControllerProxy_DeleteConnector_Handler,
+		},
+		{
+			MethodName: "CreateHTTPPoller",
+			Handler:    _ControllerProxy_CreateHTTPPoller_Handler,
+		},
+		{
+			MethodName: "ListHTTPPoller",
+			Handler:    _ControllerProxy_ListHTTPPoller_Handler,
+		},
+		{
+			MethodName: "PauseHTTPPoller",
+			Handler:    _ControllerProxy_PauseHTTPPoller_Handler,
+		},
+		{
+			MethodName: "ResumeHTTPPoller",
+			Handler:    _ControllerProxy_ResumeHTTPPoller_Handler,
+		},
+		{
+			MethodName: "DeleteHTTPPoller",
+			Handler:    _ControllerProxy_DeleteHTTPPoller_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailSubscription",
+			Handler:       _ControllerProxy_TailSubscription_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
 }
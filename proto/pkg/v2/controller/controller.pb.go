@@ -0,0 +1,3802 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.4
+// source: v2/controller.proto
+
+package controller
+
+import (
+	context "context"
+	controller "github.com/linkall-labs/vanus/proto/pkg/controller"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResetOffsetToTimestampRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// the events of subscription are delivered again from the first one written at or after time.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ResetOffsetToTimestampRequest) Reset() {
+	*x = ResetOffsetToTimestampRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_controller_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetOffsetToTimestampRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetOffsetToTimestampRequest) ProtoMessage() {}
+
+func (x *ResetOffsetToTimestampRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_controller_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetOffsetToTimestampRequest.ProtoReflect.Descriptor instead.
+func (*ResetOffsetToTimestampRequest) Descriptor() ([]byte, []int) {
+	return file_v2_controller_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResetOffsetToTimestampRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ResetOffsetToTimestampRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+var File_v2_controller_proto protoreflect.FileDescriptor
+
+var file_v2_controller_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x32, 0x1a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x78,
+	0x0a, 0x1d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x32, 0x54, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x95,
+	0x1b, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5f, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x65, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x2f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x46, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12,
+	0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x42, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x75, 0x73, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73,
+	0x12, 0x56, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x42, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x73, 0x12, 0x68, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x70, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x72, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x72, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x5e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x37, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x61, 0x0a, 0x12, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6f, 0x0a, 0x19,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x3a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x8b, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x68,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f,
+	0x62, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x62, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x70, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x2e, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f,
+	0x62, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e, 0x0a, 0x0e, 0x50,
+	0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12, 0x25, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75,
+	0x73, 0x41, 0x43, 0x4c, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12, 0x5f, 0x0a, 0x11, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c,
+	0x12, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x76, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x12,
+	0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x62, 0x75, 0x73, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x49, 0x50, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x50,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x50, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x50, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x1a, 0x25, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x5f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6b, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x73, 0x0a, 0x0e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54, 0x50,
+	0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x54, 0x54,
+	0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x0f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x31,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54,
+	0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x48, 0x54, 0x54, 0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x54, 0x54,
+	0x50, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x88, 0x02, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x6a, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x83, 0x06, 0x0a, 0x11, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x36, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7b, 0x0a, 0x10, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x88, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x36, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x17, 0x55,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x38, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x39, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x18, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x63, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x88, 0x14, 0x0a, 0x11, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x6b, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5e, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x16, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x37, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x36, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a,
+	0x17, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x38, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x55, 0x6e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a,
+	0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6d, 0x0a, 0x0c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x12,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x59, 0x0a, 0x09, 0x50, 0x75,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x52, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x0b, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x0c, 0x50,
+	0x75, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x58, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x82, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x34, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x32, 0xda, 0x02, 0x0a, 0x13, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x44, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x55,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x6a, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x49,
+	0x44, 0x73, 0x12, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v2_controller_proto_rawDescOnce sync.Once
+	file_v2_controller_proto_rawDescData = file_v2_controller_proto_rawDesc
+)
+
+func file_v2_controller_proto_rawDescGZIP() []byte {
+	file_v2_controller_proto_rawDescOnce.Do(func() {
+		file_v2_controller_proto_rawDescData = protoimpl.X.CompressGZIP(file_v2_controller_proto_rawDescData)
+	})
+	return file_v2_controller_proto_rawDescData
+}
+
+var file_v2_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_v2_controller_proto_goTypes = []interface{}{
+	(*ResetOffsetToTimestampRequest)(nil),               // 0: linkall.vanus.controller.v2.ResetOffsetToTimestampRequest
+	(*timestamppb.Timestamp)(nil),                       // 1: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                               // 2: google.protobuf.Empty
+	(*controller.CreateEventBusRequest)(nil),            // 3: linkall.vanus.controller.CreateEventBusRequest
+	(*meta.EventBus)(nil),                               // 4: linkall.vanus.meta.EventBus
+	(*controller.UpdateEventBusRequest)(nil),            // 5: linkall.vanus.controller.UpdateEventBusRequest
+	(*controller.CreateCronEventRequest)(nil),           // 6: linkall.vanus.controller.CreateCronEventRequest
+	(*controller.ListCronEventRequest)(nil),             // 7: linkall.vanus.controller.ListCronEventRequest
+	(*controller.DeleteCronEventRequest)(nil),           // 8: linkall.vanus.controller.DeleteCronEventRequest
+	(*controller.ConsumerGroupHeartbeatRequest)(nil),    // 9: linkall.vanus.controller.ConsumerGroupHeartbeatRequest
+	(*controller.LeaveConsumerGroupRequest)(nil),        // 10: linkall.vanus.controller.LeaveConsumerGroupRequest
+	(*controller.CommitConsumerGroupOffsetRequest)(nil), // 11: linkall.vanus.controller.CommitConsumerGroupOffsetRequest
+	(*controller.GetConsumerGroupOffsetRequest)(nil),    // 12: linkall.vanus.controller.GetConsumerGroupOffsetRequest
+	(*controller.CreateReplayJobRequest)(nil),           // 13: linkall.vanus.controller.CreateReplayJobRequest
+	(*controller.GetReplayJobRequest)(nil),              // 14: linkall.vanus.controller.GetReplayJobRequest
+	(*controller.ListReplayJobRequest)(nil),             // 15: linkall.vanus.controller.ListReplayJobRequest
+	(*controller.DeleteReplayJobRequest)(nil),           // 16: linkall.vanus.controller.DeleteReplayJobRequest
+	(*controller.EventbusACL)(nil),                      // 17: linkall.vanus.controller.EventbusACL
+	(*controller.DeleteEventbusACLRequest)(nil),         // 18: linkall.vanus.controller.DeleteEventbusACLRequest
+	(*controller.ListEventbusACLRequest)(nil),           // 19: linkall.vanus.controller.ListEventbusACLRequest
+	(*controller.IPAllowlist)(nil),                      // 20: linkall.vanus.controller.IPAllowlist
+	(*controller.DeleteIPAllowlistRequest)(nil),         // 21: linkall.vanus.controller.DeleteIPAllowlistRequest
+	(*controller.FeatureFlag)(nil),                      // 22: linkall.vanus.controller.FeatureFlag
+	(*controller.DeleteFeatureFlagRequest)(nil),         // 23: linkall.vanus.controller.DeleteFeatureFlagRequest
+	(*controller.SetConsumerGroupOffsetRequest)(nil),    // 24: linkall.vanus.controller.SetConsumerGroupOffsetRequest
+	(*controller.CreateHTTPPollerRequest)(nil),          // 25: linkall.vanus.controller.CreateHTTPPollerRequest
+	(*controller.ListHTTPPollerRequest)(nil),            // 26: linkall.vanus.controller.ListHTTPPollerRequest
+	(*controller.PauseHTTPPollerRequest)(nil),           // 27: linkall.vanus.controller.PauseHTTPPollerRequest
+	(*controller.ResumeHTTPPollerRequest)(nil),          // 28: linkall.vanus.controller.ResumeHTTPPollerRequest
+	(*controller.DeleteHTTPPollerRequest)(nil),          // 29: linkall.vanus.controller.DeleteHTTPPollerRequest
+	(*controller.ListSegmentRequest)(nil),               // 30: linkall.vanus.controller.ListSegmentRequest
+	(*controller.GetAppendableSegmentRequest)(nil),      // 31: linkall.vanus.controller.GetAppendableSegmentRequest
+	(*controller.QuerySegmentRouteInfoRequest)(nil),     // 32: linkall.vanus.controller.QuerySegmentRouteInfoRequest
+	(*controller.SegmentHeartbeatRequest)(nil),          // 33: linkall.vanus.controller.SegmentHeartbeatRequest
+	(*controller.RegisterSegmentServerRequest)(nil),     // 34: linkall.vanus.controller.RegisterSegmentServerRequest
+	(*controller.UnregisterSegmentServerRequest)(nil),   // 35: linkall.vanus.controller.UnregisterSegmentServerRequest
+	(*controller.ReportSegmentLeaderRequest)(nil),       // 36: linkall.vanus.controller.ReportSegmentLeaderRequest
+	(*controller.CreateSubscriptionRequest)(nil),        // 37: linkall.vanus.controller.CreateSubscriptionRequest
+	(*controller.UpdateSubscriptionRequest)(nil),        // 38: linkall.vanus.controller.UpdateSubscriptionRequest
+	(*controller.DeleteSubscriptionRequest)(nil),        // 39: linkall.vanus.controller.DeleteSubscriptionRequest
+	(*controller.GetSubscriptionRequest)(nil),           // 40: linkall.vanus.controller.GetSubscriptionRequest
+	(*controller.TriggerWorkerHeartbeatRequest)(nil),    // 41: linkall.vanus.controller.TriggerWorkerHeartbeatRequest
+	(*controller.RegisterTriggerWorkerRequest)(nil),     // 42: linkall.vanus.controller.RegisterTriggerWorkerRequest
+	(*controller.UnregisterTriggerWorkerRequest)(nil),   // 43: linkall.vanus.controller.UnregisterTriggerWorkerRequest
+	(*controller.CommitOffsetRequest)(nil),              // 44: linkall.vanus.controller.CommitOffsetRequest
+	(*controller.ExportSubscriptionRequest)(nil),        // 45: linkall.vanus.controller.ExportSubscriptionRequest
+	(*controller.ImportSubscriptionRequest)(nil),        // 46: linkall.vanus.controller.ImportSubscriptionRequest
+	(*controller.PutSecretRequest)(nil),                 // 47: linkall.vanus.controller.PutSecretRequest
+	(*controller.GetSecretRequest)(nil),                 // 48: linkall.vanus.controller.GetSecretRequest
+	(*controller.DeleteSecretRequest)(nil),              // 49: linkall.vanus.controller.DeleteSecretRequest
+	(*controller.Connector)(nil),                        // 50: linkall.vanus.controller.Connector
+	(*controller.GetConnectorRequest)(nil),              // 51: linkall.vanus.controller.GetConnectorRequest
+	(*controller.DeleteConnectorRequest)(nil),           // 52: linkall.vanus.controller.DeleteConnectorRequest
+	(*controller.ListWorkerConnectorRequest)(nil),       // 53: linkall.vanus.controller.ListWorkerConnectorRequest
+	(*controller.CommitConnectorPositionRequest)(nil),   // 54: linkall.vanus.controller.CommitConnectorPositionRequest
+	(*wrapperspb.UInt32Value)(nil),                      // 55: google.protobuf.UInt32Value
+	(*controller.AllocateIDsRequest)(nil),               // 56: linkall.vanus.controller.AllocateIDsRequest
+	(*controller.PingResponse)(nil),                     // 57: linkall.vanus.controller.PingResponse
+	(*controller.ListEventbusResponse)(nil),             // 58: linkall.vanus.controller.ListEventbusResponse
+	(*controller.CronEvent)(nil),                        // 59: linkall.vanus.controller.CronEvent
+	(*controller.ListCronEventResponse)(nil),            // 60: linkall.vanus.controller.ListCronEventResponse
+	(*controller.ListTimerReplicaResponse)(nil),         // 61: linkall.vanus.controller.ListTimerReplicaResponse
+	(*controller.ConsumerGroupAssignment)(nil),          // 62: linkall.vanus.controller.ConsumerGroupAssignment
+	(*controller.GetConsumerGroupOffsetResponse)(nil),   // 63: linkall.vanus.controller.GetConsumerGroupOffsetResponse
+	(*controller.ClusterStats)(nil),                     // 64: linkall.vanus.controller.ClusterStats
+	(*controller.ReplayJob)(nil),                        // 65: linkall.vanus.controller.ReplayJob
+	(*controller.ListReplayJobResponse)(nil),            // 66: linkall.vanus.controller.ListReplayJobResponse
+	(*controller.ListEventbusACLResponse)(nil),          // 67: linkall.vanus.controller.ListEventbusACLResponse
+	(*controller.ListIPAllowlistResponse)(nil),          // 68: linkall.vanus.controller.ListIPAllowlistResponse
+	(*controller.ListFeatureFlagResponse)(nil),          // 69: linkall.vanus.controller.ListFeatureFlagResponse
+	(*controller.HTTPPoller)(nil),                       // 70: linkall.vanus.controller.HTTPPoller
+	(*controller.ListHTTPPollerResponse)(nil),           // 71: linkall.vanus.controller.ListHTTPPollerResponse
+	(*controller.ListSegmentResponse)(nil),              // 72: linkall.vanus.controller.ListSegmentResponse
+	(*controller.GetAppendableSegmentResponse)(nil),     // 73: linkall.vanus.controller.GetAppendableSegmentResponse
+	(*controller.QuerySegmentRouteInfoResponse)(nil),    // 74: linkall.vanus.controller.QuerySegmentRouteInfoResponse
+	(*controller.SegmentHeartbeatResponse)(nil),         // 75: linkall.vanus.controller.SegmentHeartbeatResponse
+	(*controller.RegisterSegmentServerResponse)(nil),    // 76: linkall.vanus.controller.RegisterSegmentServerResponse
+	(*controller.UnregisterSegmentServerResponse)(nil),  // 77: linkall.vanus.controller.UnregisterSegmentServerResponse
+	(*meta.Subscription)(nil),                           // 78: linkall.vanus.meta.Subscription
+	(*controller.ListSubscriptionResponse)(nil),         // 79: linkall.vanus.controller.ListSubscriptionResponse
+	(*controller.TriggerWorkerHeartbeatResponse)(nil),   // 80: linkall.vanus.controller.TriggerWorkerHeartbeatResponse
+	(*controller.RegisterTriggerWorkerResponse)(nil),    // 81: linkall.vanus.controller.RegisterTriggerWorkerResponse
+	(*controller.UnregisterTriggerWorkerResponse)(nil),  // 82: linkall.vanus.controller.UnregisterTriggerWorkerResponse
+	(*controller.CommitOffsetResponse)(nil),             // 83: linkall.vanus.controller.CommitOffsetResponse
+	(*controller.ListTriggerWorkerResponse)(nil),        // 84: linkall.vanus.controller.ListTriggerWorkerResponse
+	(*controller.ExportSubscriptionResponse)(nil),       // 85: linkall.vanus.controller.ExportSubscriptionResponse
+	(*controller.Secret)(nil),                           // 86: linkall.vanus.controller.Secret
+	(*controller.ListSecretResponse)(nil),               // 87: linkall.vanus.controller.ListSecretResponse
+	(*controller.SecretEvent)(nil),                      // 88: linkall.vanus.controller.SecretEvent
+	(*controller.ListConnectorResponse)(nil),            // 89: linkall.vanus.controller.ListConnectorResponse
+	(*controller.ListWorkerConnectorResponse)(nil),      // 90: linkall.vanus.controller.ListWorkerConnectorResponse
+	(*controller.AllocateIDsResponse)(nil),              // 91: linkall.vanus.controller.AllocateIDsResponse
+}
+var file_v2_controller_proto_depIdxs = []int32{
+	1,  // 0: linkall.vanus.controller.v2.ResetOffsetToTimestampRequest.time:type_name -> google.protobuf.Timestamp
+	2,  // 1: linkall.vanus.controller.v2.PingServer.Ping:input_type -> google.protobuf.Empty
+	3,  // 2: linkall.vanus.controller.v2.EventBusController.CreateEventBus:input_type -> linkall.vanus.controller.CreateEventBusRequest
+	3,  // 3: linkall.vanus.controller.v2.EventBusController.CreateSystemEventBus:input_type -> linkall.vanus.controller.CreateEventBusRequest
+	4,  // 4: linkall.vanus.controller.v2.EventBusController.DeleteEventBus:input_type -> linkall.vanus.meta.EventBus
+	4,  // 5: linkall.vanus.controller.v2.EventBusController.GetEventBus:input_type -> linkall.vanus.meta.EventBus
+	2,  // 6: linkall.vanus.controller.v2.EventBusController.ListEventBus:input_type -> google.protobuf.Empty
+	5,  // 7: linkall.vanus.controller.v2.EventBusController.UpdateEventBus:input_type -> linkall.vanus.controller.UpdateEventBusRequest
+	6,  // 8: linkall.vanus.controller.v2.EventBusController.CreateCronEvent:input_type -> linkall.vanus.controller.CreateCronEventRequest
+	7,  // 9: linkall.vanus.controller.v2.EventBusController.ListCronEvent:input_type -> linkall.vanus.controller.ListCronEventRequest
+	8,  // 10: linkall.vanus.controller.v2.EventBusController.DeleteCronEvent:input_type -> linkall.vanus.controller.DeleteCronEventRequest
+	2,  // 11: linkall.vanus.controller.v2.EventBusController.ListTimerReplica:input_type -> google.protobuf.Empty
+	9,  // 12: linkall.vanus.controller.v2.EventBusController.ConsumerGroupHeartbeat:input_type -> linkall.vanus.controller.ConsumerGroupHeartbeatRequest
+	10, // 13: linkall.vanus.controller.v2.EventBusController.LeaveConsumerGroup:input_type -> linkall.vanus.controller.LeaveConsumerGroupRequest
+	11, // 14: linkall.vanus.controller.v2.EventBusController.CommitConsumerGroupOffset:input_type -> linkall.vanus.controller.CommitConsumerGroupOffsetRequest
+	12, // 15: linkall.vanus.controller.v2.EventBusController.GetConsumerGroupOffset:input_type -> linkall.vanus.controller.GetConsumerGroupOffsetRequest
+	2,  // 16: linkall.vanus.controller.v2.EventBusController.GetClusterStats:input_type -> google.protobuf.Empty
+	13, // 17: linkall.vanus.controller.v2.EventBusController.CreateReplayJob:input_type -> linkall.vanus.controller.CreateReplayJobRequest
+	14, // 18: linkall.vanus.controller.v2.EventBusController.GetReplayJob:input_type -> linkall.vanus.controller.GetReplayJobRequest
+	15, // 19: linkall.vanus.controller.v2.EventBusController.ListReplayJob:input_type -> linkall.vanus.controller.ListReplayJobRequest
+	16, // 20: linkall.vanus.controller.v2.EventBusController.DeleteReplayJob:input_type -> linkall.vanus.controller.DeleteReplayJobRequest
+	17, // 21: linkall.vanus.controller.v2.EventBusController.PutEventbusACL:input_type -> linkall.vanus.controller.EventbusACL
+	18, // 22: linkall.vanus.controller.v2.EventBusController.DeleteEventbusACL:input_type -> linkall.vanus.controller.DeleteEventbusACLRequest
+	19, // 23: linkall.vanus.controller.v2.EventBusController.ListEventbusACL:input_type -> linkall.vanus.controller.ListEventbusACLRequest
+	20, // 24: linkall.vanus.controller.v2.EventBusController.PutIPAllowlist:input_type -> linkall.vanus.controller.IPAllowlist
+	21, // 25: linkall.vanus.controller.v2.EventBusController.DeleteIPAllowlist:input_type -> linkall.vanus.controller.DeleteIPAllowlistRequest
+	2,  // 26: linkall.vanus.controller.v2.EventBusController.ListIPAllowlist:input_type -> google.protobuf.Empty
+	22, // 27: linkall.vanus.controller.v2.EventBusController.PutFeatureFlag:input_type -> linkall.vanus.controller.FeatureFlag
+	23, // 28: linkall.vanus.controller.v2.EventBusController.DeleteFeatureFlag:input_type -> linkall.vanus.controller.DeleteFeatureFlagRequest
+	2,  // 29: linkall.vanus.controller.v2.EventBusController.ListFeatureFlag:input_type -> google.protobuf.Empty
+	24, // 30: linkall.vanus.controller.v2.EventBusController.SetConsumerGroupOffset:input_type -> linkall.vanus.controller.SetConsumerGroupOffsetRequest
+	25, // 31: linkall.vanus.controller.v2.EventBusController.CreateHTTPPoller:input_type -> linkall.vanus.controller.CreateHTTPPollerRequest
+	26, // 32: linkall.vanus.controller.v2.EventBusController.ListHTTPPoller:input_type -> linkall.vanus.controller.ListHTTPPollerRequest
+	27, // 33: linkall.vanus.controller.v2.EventBusController.PauseHTTPPoller:input_type -> linkall.vanus.controller.PauseHTTPPollerRequest
+	28, // 34: linkall.vanus.controller.v2.EventBusController.ResumeHTTPPoller:input_type -> linkall.vanus.controller.ResumeHTTPPollerRequest
+	29, // 35: linkall.vanus.controller.v2.EventBusController.DeleteHTTPPoller:input_type -> linkall.vanus.controller.DeleteHTTPPollerRequest
+	30, // 36: linkall.vanus.controller.v2.EventLogController.ListSegment:input_type -> linkall.vanus.controller.ListSegmentRequest
+	31, // 37: linkall.vanus.controller.v2.EventLogController.GetAppendableSegment:input_type -> linkall.vanus.controller.GetAppendableSegmentRequest
+	32, // 38: linkall.vanus.controller.v2.SegmentController.QuerySegmentRouteInfo:input_type -> linkall.vanus.controller.QuerySegmentRouteInfoRequest
+	33, // 39: linkall.vanus.controller.v2.SegmentController.SegmentHeartbeat:input_type -> linkall.vanus.controller.SegmentHeartbeatRequest
+	34, // 40: linkall.vanus.controller.v2.SegmentController.RegisterSegmentServer:input_type -> linkall.vanus.controller.RegisterSegmentServerRequest
+	35, // 41: linkall.vanus.controller.v2.SegmentController.UnregisterSegmentServer:input_type -> linkall.vanus.controller.UnregisterSegmentServerRequest
+	33, // 42: linkall.vanus.controller.v2.SegmentController.ReportSegmentBlockIsFull:input_type -> linkall.vanus.controller.SegmentHeartbeatRequest
+	36, // 43: linkall.vanus.controller.v2.SegmentController.ReportSegmentLeader:input_type -> linkall.vanus.controller.ReportSegmentLeaderRequest
+	37, // 44: linkall.vanus.controller.v2.TriggerController.CreateSubscription:input_type -> linkall.vanus.controller.CreateSubscriptionRequest
+	38, // 45: linkall.vanus.controller.v2.TriggerController.UpdateSubscription:input_type -> linkall.vanus.controller.UpdateSubscriptionRequest
+	39, // 46: linkall.vanus.controller.v2.TriggerController.DeleteSubscription:input_type -> linkall.vanus.controller.DeleteSubscriptionRequest
+	40, // 47: linkall.vanus.controller.v2.TriggerController.GetSubscription:input_type -> linkall.vanus.controller.GetSubscriptionRequest
+	2,  // 48: linkall.vanus.controller.v2.TriggerController.ListSubscription:input_type -> google.protobuf.Empty
+	41, // 49: linkall.vanus.controller.v2.TriggerController.TriggerWorkerHeartbeat:input_type -> linkall.vanus.controller.TriggerWorkerHeartbeatRequest
+	42, // 50: linkall.vanus.controller.v2.TriggerController.RegisterTriggerWorker:input_type -> linkall.vanus.controller.RegisterTriggerWorkerRequest
+	43, // 51: linkall.vanus.controller.v2.TriggerController.UnregisterTriggerWorker:input_type -> linkall.vanus.controller.UnregisterTriggerWorkerRequest
+	0,  // 52: linkall.vanus.controller.v2.TriggerController.ResetOffsetToTimestamp:input_type -> linkall.vanus.controller.v2.ResetOffsetToTimestampRequest
+	44, // 53: linkall.vanus.controller.v2.TriggerController.CommitOffset:input_type -> linkall.vanus.controller.CommitOffsetRequest
+	2,  // 54: linkall.vanus.controller.v2.TriggerController.ListTriggerWorker:input_type -> google.protobuf.Empty
+	45, // 55: linkall.vanus.controller.v2.TriggerController.ExportSubscription:input_type -> linkall.vanus.controller.ExportSubscriptionRequest
+	46, // 56: linkall.vanus.controller.v2.TriggerController.ImportSubscription:input_type -> linkall.vanus.controller.ImportSubscriptionRequest
+	47, // 57: linkall.vanus.controller.v2.TriggerController.PutSecret:input_type -> linkall.vanus.controller.PutSecretRequest
+	48, // 58: linkall.vanus.controller.v2.TriggerController.GetSecret:input_type -> linkall.vanus.controller.GetSecretRequest
+	2,  // 59: linkall.vanus.controller.v2.TriggerController.ListSecret:input_type -> google.protobuf.Empty
+	49, // 60: linkall.vanus.controller.v2.TriggerController.DeleteSecret:input_type -> linkall.vanus.controller.DeleteSecretRequest
+	2,  // 61: linkall.vanus.controller.v2.TriggerController.WatchSecret:input_type -> google.protobuf.Empty
+	50, // 62: linkall.vanus.controller.v2.TriggerController.PutConnector:input_type -> linkall.vanus.controller.Connector
+	51, // 63: linkall.vanus.controller.v2.TriggerController.GetConnector:input_type -> linkall.vanus.controller.GetConnectorRequest
+	2,  // 64: linkall.vanus.controller.v2.TriggerController.ListConnector:input_type -> google.protobuf.Empty
+	52, // 65: linkall.vanus.controller.v2.TriggerController.DeleteConnector:input_type -> linkall.vanus.controller.DeleteConnectorRequest
+	53, // 66: linkall.vanus.controller.v2.TriggerController.ListWorkerConnector:input_type -> linkall.vanus.controller.ListWorkerConnectorRequest
+	54, // 67: linkall.vanus.controller.v2.TriggerController.CommitConnectorPosition:input_type -> linkall.vanus.controller.CommitConnectorPositionRequest
+	2,  // 68: linkall.vanus.controller.v2.SnowflakeController.GetClusterStartTime:input_type -> google.protobuf.Empty
+	55, // 69: linkall.vanus.controller.v2.SnowflakeController.RegisterNode:input_type -> google.protobuf.UInt32Value
+	55, // 70: linkall.vanus.controller.v2.SnowflakeController.UnregisterNode:input_type -> google.protobuf.UInt32Value
+	56, // 71: linkall.vanus.controller.v2.SnowflakeController.AllocateIDs:input_type -> linkall.vanus.controller.AllocateIDsRequest
+	57, // 72: linkall.vanus.controller.v2.PingServer.Ping:output_type -> linkall.vanus.controller.PingResponse
+	4,  // 73: linkall.vanus.controller.v2.EventBusController.CreateEventBus:output_type -> linkall.vanus.meta.EventBus
+	4,  // 74: linkall.vanus.controller.v2.EventBusController.CreateSystemEventBus:output_type -> linkall.vanus.meta.EventBus
+	2,  // 75: linkall.vanus.controller.v2.EventBusController.DeleteEventBus:output_type -> google.protobuf.Empty
+	4,  // 76: linkall.vanus.controller.v2.EventBusController.GetEventBus:output_type -> linkall.vanus.meta.EventBus
+	58, // 77: linkall.vanus.controller.v2.EventBusController.ListEventBus:output_type -> linkall.vanus.controller.ListEventbusResponse
+	4,  // 78: linkall.vanus.controller.v2.EventBusController.UpdateEventBus:output_type -> linkall.vanus.meta.EventBus
+	59, // 79: linkall.vanus.controller.v2.EventBusController.CreateCronEvent:output_type -> linkall.vanus.controller.CronEvent
+	60, // 80: linkall.vanus.controller.v2.EventBusController.ListCronEvent:output_type -> linkall.vanus.controller.ListCronEventResponse
+	2,  // 81: linkall.vanus.controller.v2.EventBusController.DeleteCronEvent:output_type -> google.protobuf.Empty
+	61, // 82: linkall.vanus.controller.v2.EventBusController.ListTimerReplica:output_type -> linkall.vanus.controller.ListTimerReplicaResponse
+	62, // 83: linkall.vanus.controller.v2.EventBusController.ConsumerGroupHeartbeat:output_type -> linkall.vanus.controller.ConsumerGroupAssignment
+	2,  // 84: linkall.vanus.controller.v2.EventBusController.LeaveConsumerGroup:output_type -> google.protobuf.Empty
+	2,  // 85: linkall.vanus.controller.v2.EventBusController.CommitConsumerGroupOffset:output_type -> google.protobuf.Empty
+	63, // 86: linkall.vanus.controller.v2.EventBusController.GetConsumerGroupOffset:output_type -> linkall.vanus.controller.GetConsumerGroupOffsetResponse
+	64, // 87: linkall.vanus.controller.v2.EventBusController.GetClusterStats:output_type -> linkall.vanus.controller.ClusterStats
+	65, // 88: linkall.vanus.controller.v2.EventBusController.CreateReplayJob:output_type -> linkall.vanus.controller.ReplayJob
+	65, // 89: linkall.vanus.controller.v2.EventBusController.GetReplayJob:output_type -> linkall.vanus.controller.ReplayJob
+	66, // 90: linkall.vanus.controller.v2.EventBusController.ListReplayJob:output_type -> linkall.vanus.controller.ListReplayJobResponse
+	2,  // 91: linkall.vanus.controller.v2.EventBusController.DeleteReplayJob:output_type -> google.protobuf.Empty
+	17, // 92: linkall.vanus.controller.v2.EventBusController.PutEventbusACL:output_type -> linkall.vanus.controller.EventbusACL
+	2,  // 93: linkall.vanus.controller.v2.EventBusController.DeleteEventbusACL:output_type -> google.protobuf.Empty
+	67, // 94: linkall.vanus.controller.v2.EventBusController.ListEventbusACL:output_type -> linkall.vanus.controller.ListEventbusACLResponse
+	20, // 95: linkall.vanus.controller.v2.EventBusController.PutIPAllowlist:output_type -> linkall.vanus.controller.IPAllowlist
+	2,  // 96: linkall.vanus.controller.v2.EventBusController.DeleteIPAllowlist:output_type -> google.protobuf.Empty
+	68, // 97: linkall.vanus.controller.v2.EventBusController.ListIPAllowlist:output_type -> linkall.vanus.controller.ListIPAllowlistResponse
+	22, // 98: linkall.vanus.controller.v2.EventBusController.PutFeatureFlag:output_type -> linkall.vanus.controller.FeatureFlag
+	2,  // 99: linkall.vanus.controller.v2.EventBusController.DeleteFeatureFlag:output_type -> google.protobuf.Empty
+	69, // 100: linkall.vanus.controller.v2.EventBusController.ListFeatureFlag:output_type -> linkall.vanus.controller.ListFeatureFlagResponse
+	2,  // 101: linkall.vanus.controller.v2.EventBusController.SetConsumerGroupOffset:output_type -> google.protobuf.Empty
+	70, // 102: linkall.vanus.controller.v2.EventBusController.CreateHTTPPoller:output_type -> linkall.vanus.controller.HTTPPoller
+	71, // 103: linkall.vanus.controller.v2.EventBusController.ListHTTPPoller:output_type -> linkall.vanus.controller.ListHTTPPollerResponse
+	70, // 104: linkall.vanus.controller.v2.EventBusController.PauseHTTPPoller:output_type -> linkall.vanus.controller.HTTPPoller
+	70, // 105: linkall.vanus.controller.v2.EventBusController.ResumeHTTPPoller:output_type -> linkall.vanus.controller.HTTPPoller
+	2,  // 106: linkall.vanus.controller.v2.EventBusController.DeleteHTTPPoller:output_type -> google.protobuf.Empty
+	72, // 107: linkall.vanus.controller.v2.EventLogController.ListSegment:output_type -> linkall.vanus.controller.ListSegmentResponse
+	73, // 108: linkall.vanus.controller.v2.EventLogController.GetAppendableSegment:output_type -> linkall.vanus.controller.GetAppendableSegmentResponse
+	74, // 109: linkall.vanus.controller.v2.SegmentController.QuerySegmentRouteInfo:output_type -> linkall.vanus.controller.QuerySegmentRouteInfoResponse
+	75, // 110: linkall.vanus.controller.v2.SegmentController.SegmentHeartbeat:output_type -> linkall.vanus.controller.SegmentHeartbeatResponse
+	76, // 111: linkall.vanus.controller.v2.SegmentController.RegisterSegmentServer:output_type -> linkall.vanus.controller.RegisterSegmentServerResponse
+	77, // 112: linkall.vanus.controller.v2.SegmentController.UnregisterSegmentServer:output_type -> linkall.vanus.controller.UnregisterSegmentServerResponse
+	2,  // 113: linkall.vanus.controller.v2.SegmentController.ReportSegmentBlockIsFull:output_type -> google.protobuf.Empty
+	2,  // 114: linkall.vanus.controller.v2.SegmentController.ReportSegmentLeader:output_type -> google.protobuf.Empty
+	78, // 115: linkall.vanus.controller.v2.TriggerController.CreateSubscription:output_type -> linkall.vanus.meta.Subscription
+	78, // 116: linkall.vanus.controller.v2.TriggerController.UpdateSubscription:output_type -> linkall.vanus.meta.Subscription
+	2,  // 117: linkall.vanus.controller.v2.TriggerController.DeleteSubscription:output_type -> google.protobuf.Empty
+	78, // 118: linkall.vanus.controller.v2.TriggerController.GetSubscription:output_type -> linkall.vanus.meta.Subscription
+	79, // 119: linkall.vanus.controller.v2.TriggerController.ListSubscription:output_type -> linkall.vanus.controller.ListSubscriptionResponse
+	80, // 120: linkall.vanus.controller.v2.TriggerController.TriggerWorkerHeartbeat:output_type -> linkall.vanus.controller.TriggerWorkerHeartbeatResponse
+	81, // 121: linkall.vanus.controller.v2.TriggerController.RegisterTriggerWorker:output_type -> linkall.vanus.controller.RegisterTriggerWorkerResponse
+	82, // 122: linkall.vanus.controller.v2.TriggerController.UnregisterTriggerWorker:output_type -> linkall.vanus.controller.UnregisterTriggerWorkerResponse
+	2,  // 123: linkall.vanus.controller.v2.TriggerController.ResetOffsetToTimestamp:output_type -> google.protobuf.Empty
+	83, // 124: linkall.vanus.controller.v2.TriggerController.CommitOffset:output_type -> linkall.vanus.controller.CommitOffsetResponse
+	84, // 125: linkall.vanus.controller.v2.TriggerController.ListTriggerWorker:output_type -> linkall.vanus.controller.ListTriggerWorkerResponse
+	85, // 126: linkall.vanus.controller.v2.TriggerController.ExportSubscription:output_type -> linkall.vanus.controller.ExportSubscriptionResponse
+	78, // 127: linkall.vanus.controller.v2.TriggerController.ImportSubscription:output_type -> linkall.vanus.meta.Subscription
+	86, // 128: linkall.vanus.controller.v2.TriggerController.PutSecret:output_type -> linkall.vanus.controller.Secret
+	86, // 129: linkall.vanus.controller.v2.TriggerController.GetSecret:output_type -> linkall.vanus.controller.Secret
+	87, // 130: linkall.vanus.controller.v2.TriggerController.ListSecret:output_type -> linkall.vanus.controller.ListSecretResponse
+	2,  // 131: linkall.vanus.controller.v2.TriggerController.DeleteSecret:output_type -> google.protobuf.Empty
+	88, // 132: linkall.vanus.controller.v2.TriggerController.WatchSecret:output_type -> linkall.vanus.controller.SecretEvent
+	50, // 133: linkall.vanus.controller.v2.TriggerController.PutConnector:output_type -> linkall.vanus.controller.Connector
+	50, // 134: linkall.vanus.controller.v2.TriggerController.GetConnector:output_type -> linkall.vanus.controller.Connector
+	89, // 135: linkall.vanus.controller.v2.TriggerController.ListConnector:output_type -> linkall.vanus.controller.ListConnectorResponse
+	2,  // 136: linkall.vanus.controller.v2.TriggerController.DeleteConnector:output_type -> google.protobuf.Empty
+	90, // 137: linkall.vanus.controller.v2.TriggerController.ListWorkerConnector:output_type -> linkall.vanus.controller.ListWorkerConnectorResponse
+	2,  // 138: linkall.vanus.controller.v2.TriggerController.CommitConnectorPosition:output_type -> google.protobuf.Empty
+	1,  // 139: linkall.vanus.controller.v2.SnowflakeController.GetClusterStartTime:output_type -> google.protobuf.Timestamp
+	2,  // 140: linkall.vanus.controller.v2.SnowflakeController.RegisterNode:output_type -> google.protobuf.Empty
+	2,  // 141: linkall.vanus.controller.v2.SnowflakeController.UnregisterNode:output_type -> google.protobuf.Empty
+	91, // 142: linkall.vanus.controller.v2.SnowflakeController.AllocateIDs:output_type -> linkall.vanus.controller.AllocateIDsResponse
+	72, // [72:143] is the sub-list for method output_type
+	1,  // [1:72] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_v2_controller_proto_init() }
+func file_v2_controller_proto_init() {
+	if File_v2_controller_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v2_controller_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetOffsetToTimestampRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v2_controller_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_v2_controller_proto_goTypes,
+		DependencyIndexes: file_v2_controller_proto_depIdxs,
+		MessageInfos:      file_v2_controller_proto_msgTypes,
+	}.Build()
+	File_v2_controller_proto = out.File
+	file_v2_controller_proto_rawDesc = nil
+	file_v2_controller_proto_goTypes = nil
+	file_v2_controller_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PingServerClient is the client API for PingServer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PingServerClient interface {
+	Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.PingResponse, error)
+}
+
+type pingServerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPingServerClient(cc grpc.ClientConnInterface) PingServerClient {
+	return &pingServerClient{cc}
+}
+
+func (c *pingServerClient) Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.PingResponse, error) {
+	out := new(controller.PingResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.PingServer/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PingServerServer is the server API for PingServer service.
+type PingServerServer interface {
+	Ping(context.Context, *emptypb.Empty) (*controller.PingResponse, error)
+}
+
+// UnimplementedPingServerServer can be embedded to have forward compatible implementations.
+type UnimplementedPingServerServer struct {
+}
+
+func (*UnimplementedPingServerServer) Ping(context.Context, *emptypb.Empty) (*controller.PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+
+func RegisterPingServerServer(s *grpc.Server, srv PingServerServer) {
+	s.RegisterService(&_PingServer_serviceDesc, srv)
+}
+
+func _PingServer_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PingServerServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.PingServer/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PingServerServer).Ping(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PingServer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.controller.v2.PingServer",
+	HandlerType: (*PingServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _PingServer_Ping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/controller.proto",
+}
+
+// EventBusControllerClient is the client API for EventBusController service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventBusControllerClient interface {
+	// grpc -> HTTP
+	CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
+	CreateSystemEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
+	DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error)
+	ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error)
+	UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error)
+	// Don't consider UpdateEventBus now
+	CreateCronEvent(ctx context.Context, in *controller.CreateCronEventRequest, opts ...grpc.CallOption) (*controller.CronEvent, error)
+	ListCronEvent(ctx context.Context, in *controller.ListCronEventRequest, opts ...grpc.CallOption) (*controller.ListCronEventResponse, error)
+	DeleteCronEvent(ctx context.Context, in *controller.DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTimerReplicaResponse, error)
+	// a member joins the consumer group by its first heartbeat
+	ConsumerGroupHeartbeat(ctx context.Context, in *controller.ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*controller.ConsumerGroupAssignment, error)
+	LeaveConsumerGroup(ctx context.Context, in *controller.LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CommitConsumerGroupOffset(ctx context.Context, in *controller.CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetConsumerGroupOffset(ctx context.Context, in *controller.GetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*controller.GetConsumerGroupOffsetResponse, error)
+	GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ClusterStats, error)
+	CreateReplayJob(ctx context.Context, in *controller.CreateReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error)
+	GetReplayJob(ctx context.Context, in *controller.GetReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error)
+	ListReplayJob(ctx context.Context, in *controller.ListReplayJobRequest, opts ...grpc.CallOption) (*controller.ListReplayJobResponse, error)
+	DeleteReplayJob(ctx context.Context, in *controller.DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// the principal of caller is carried by the x-vanus-principal metadata
+	PutEventbusACL(ctx context.Context, in *controller.EventbusACL, opts ...grpc.CallOption) (*controller.EventbusACL, error)
+	DeleteEventbusACL(ctx context.Context, in *controller.DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListEventbusACL(ctx context.Context, in *controller.ListEventbusACLRequest, opts ...grpc.CallOption) (*controller.ListEventbusACLResponse, error)
+	PutIPAllowlist(ctx context.Context, in *controller.IPAllowlist, opts ...grpc.CallOption) (*controller.IPAllowlist, error)
+	DeleteIPAllowlist(ctx context.Context, in *controller.DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListIPAllowlistResponse, error)
+	PutFeatureFlag(ctx context.Context, in *controller.FeatureFlag, opts ...grpc.CallOption) (*controller.FeatureFlag, error)
+	DeleteFeatureFlag(ctx context.Context, in *controller.DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListFeatureFlagResponse, error)
+	// sets the offsets of a consumer group which has no member, it's used by the
+	// consumers which commit by the REST API of gateway and to reset a group.
+	SetConsumerGroupOffset(ctx context.Context, in *controller.SetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateHTTPPoller(ctx context.Context, in *controller.CreateHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error)
+	ListHTTPPoller(ctx context.Context, in *controller.ListHTTPPollerRequest, opts ...grpc.CallOption) (*controller.ListHTTPPollerResponse, error)
+	// a paused poller keeps the keys of published items, they aren't published
+	// again after it's resumed.
+	PauseHTTPPoller(ctx context.Context, in *controller.PauseHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error)
+	ResumeHTTPPoller(ctx context.Context, in *controller.ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error)
+	DeleteHTTPPoller(ctx context.Context, in *controller.DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type eventBusControllerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventBusControllerClient(cc grpc.ClientConnInterface) EventBusControllerClient {
+	return &eventBusControllerClient{cc}
+}
+
+func (c *eventBusControllerClient) CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/CreateEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) CreateSystemEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/CreateSystemEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/GetEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error) {
+	out := new(controller.ListEventbusResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	out := new(meta.EventBus)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/UpdateEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) CreateCronEvent(ctx context.Context, in *controller.CreateCronEventRequest, opts ...grpc.CallOption) (*controller.CronEvent, error) {
+	out := new(controller.CronEvent)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/CreateCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListCronEvent(ctx context.Context, in *controller.ListCronEventRequest, opts ...grpc.CallOption) (*controller.ListCronEventResponse, error) {
+	out := new(controller.ListCronEventResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteCronEvent(ctx context.Context, in *controller.DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteCronEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTimerReplicaResponse, error) {
+	out := new(controller.ListTimerReplicaResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListTimerReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ConsumerGroupHeartbeat(ctx context.Context, in *controller.ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*controller.ConsumerGroupAssignment, error) {
+	out := new(controller.ConsumerGroupAssignment)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ConsumerGroupHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) LeaveConsumerGroup(ctx context.Context, in *controller.LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/LeaveConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) CommitConsumerGroupOffset(ctx context.Context, in *controller.CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/CommitConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) GetConsumerGroupOffset(ctx context.Context, in *controller.GetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*controller.GetConsumerGroupOffsetResponse, error) {
+	out := new(controller.GetConsumerGroupOffsetResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/GetConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ClusterStats, error) {
+	out := new(controller.ClusterStats)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/GetClusterStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) CreateReplayJob(ctx context.Context, in *controller.CreateReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error) {
+	out := new(controller.ReplayJob)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/CreateReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) GetReplayJob(ctx context.Context, in *controller.GetReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error) {
+	out := new(controller.ReplayJob)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/GetReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListReplayJob(ctx context.Context, in *controller.ListReplayJobRequest, opts ...grpc.CallOption) (*controller.ListReplayJobResponse, error) {
+	out := new(controller.ListReplayJobResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteReplayJob(ctx context.Context, in *controller.DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) PutEventbusACL(ctx context.Context, in *controller.EventbusACL, opts ...grpc.CallOption) (*controller.EventbusACL, error) {
+	out := new(controller.EventbusACL)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/PutEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteEventbusACL(ctx context.Context, in *controller.DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListEventbusACL(ctx context.Context, in *controller.ListEventbusACLRequest, opts ...grpc.CallOption) (*controller.ListEventbusACLResponse, error) {
+	out := new(controller.ListEventbusACLResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListEventbusACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) PutIPAllowlist(ctx context.Context, in *controller.IPAllowlist, opts ...grpc.CallOption) (*controller.IPAllowlist, error) {
+	out := new(controller.IPAllowlist)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/PutIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteIPAllowlist(ctx context.Context, in *controller.DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListIPAllowlistResponse, error) {
+	out := new(controller.ListIPAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListIPAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) PutFeatureFlag(ctx context.Context, in *controller.FeatureFlag, opts ...grpc.CallOption) (*controller.FeatureFlag, error) {
+	out := new(controller.FeatureFlag)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/PutFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteFeatureFlag(ctx context.Context, in *controller.DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListFeatureFlagResponse, error) {
+	out := new(controller.ListFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListFeatureFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) SetConsumerGroupOffset(ctx context.Context, in *controller.SetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/SetConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) CreateHTTPPoller(ctx context.Context, in *controller.CreateHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	out := new(controller.HTTPPoller)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/CreateHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ListHTTPPoller(ctx context.Context, in *controller.ListHTTPPollerRequest, opts ...grpc.CallOption) (*controller.ListHTTPPollerResponse, error) {
+	out := new(controller.ListHTTPPollerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ListHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) PauseHTTPPoller(ctx context.Context, in *controller.PauseHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	out := new(controller.HTTPPoller)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/PauseHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) ResumeHTTPPoller(ctx context.Context, in *controller.ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	out := new(controller.HTTPPoller)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/ResumeHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventBusControllerClient) DeleteHTTPPoller(ctx context.Context, in *controller.DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventBusController/DeleteHTTPPoller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventBusControllerServer is the server API for EventBusController service.
+type EventBusControllerServer interface {
+	// grpc -> HTTP
+	CreateEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error)
+	CreateSystemEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error)
+	DeleteEventBus(context.Context, *meta.EventBus) (*emptypb.Empty, error)
+	GetEventBus(context.Context, *meta.EventBus) (*meta.EventBus, error)
+	ListEventBus(context.Context, *emptypb.Empty) (*controller.ListEventbusResponse, error)
+	UpdateEventBus(context.Context, *controller.UpdateEventBusRequest) (*meta.EventBus, error)
+	// Don't consider UpdateEventBus now
+	CreateCronEvent(context.Context, *controller.CreateCronEventRequest) (*controller.CronEvent, error)
+	ListCronEvent(context.Context, *controller.ListCronEventRequest) (*controller.ListCronEventResponse, error)
+	DeleteCronEvent(context.Context, *controller.DeleteCronEventRequest) (*emptypb.Empty, error)
+	ListTimerReplica(context.Context, *emptypb.Empty) (*controller.ListTimerReplicaResponse, error)
+	// a member joins the consumer group by its first heartbeat
+	ConsumerGroupHeartbeat(context.Context, *controller.ConsumerGroupHeartbeatRequest) (*controller.ConsumerGroupAssignment, error)
+	LeaveConsumerGroup(context.Context, *controller.LeaveConsumerGroupRequest) (*emptypb.Empty, error)
+	CommitConsumerGroupOffset(context.Context, *controller.CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error)
+	GetConsumerGroupOffset(context.Context, *controller.GetConsumerGroupOffsetRequest) (*controller.GetConsumerGroupOffsetResponse, error)
+	GetClusterStats(context.Context, *emptypb.Empty) (*controller.ClusterStats, error)
+	CreateReplayJob(context.Context, *controller.CreateReplayJobRequest) (*controller.ReplayJob, error)
+	GetReplayJob(context.Context, *controller.GetReplayJobRequest) (*controller.ReplayJob, error)
+	ListReplayJob(context.Context, *controller.ListReplayJobRequest) (*controller.ListReplayJobResponse, error)
+	DeleteReplayJob(context.Context, *controller.DeleteReplayJobRequest) (*emptypb.Empty, error)
+	// the principal of caller is carried by the x-vanus-principal metadata
+	PutEventbusACL(context.Context, *controller.EventbusACL) (*controller.EventbusACL, error)
+	DeleteEventbusACL(context.Context, *controller.DeleteEventbusACLRequest) (*emptypb.Empty, error)
+	ListEventbusACL(context.Context, *controller.ListEventbusACLRequest) (*controller.ListEventbusACLResponse, error)
+	PutIPAllowlist(context.Context, *controller.IPAllowlist) (*controller.IPAllowlist, error)
+	DeleteIPAllowlist(context.Context, *controller.DeleteIPAllowlistRequest) (*emptypb.Empty, error)
+	ListIPAllowlist(context.Context, *emptypb.Empty) (*controller.ListIPAllowlistResponse, error)
+	PutFeatureFlag(context.Context, *controller.FeatureFlag) (*controller.FeatureFlag, error)
+	DeleteFeatureFlag(context.Context, *controller.DeleteFeatureFlagRequest) (*emptypb.Empty, error)
+	ListFeatureFlag(context.Context, *emptypb.Empty) (*controller.ListFeatureFlagResponse, error)
+	// sets the offsets of a consumer group which has no member, it's used by the
+	// consumers which commit by the REST API of gateway and to reset a group.
+	SetConsumerGroupOffset(context.Context, *controller.SetConsumerGroupOffsetRequest) (*emptypb.Empty, error)
+	CreateHTTPPoller(context.Context, *controller.CreateHTTPPollerRequest) (*controller.HTTPPoller, error)
+	ListHTTPPoller(context.Context, *controller.ListHTTPPollerRequest) (*controller.ListHTTPPollerResponse, error)
+	// a paused poller keeps the keys of published items, they aren't published
+	// again after it's resumed.
+	PauseHTTPPoller(context.Context, *controller.PauseHTTPPollerRequest) (*controller.HTTPPoller, error)
+	ResumeHTTPPoller(context.Context, *controller.ResumeHTTPPollerRequest) (*controller.HTTPPoller, error)
+	DeleteHTTPPoller(context.Context, *controller.DeleteHTTPPollerRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedEventBusControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedEventBusControllerServer struct {
+}
+
+func (*UnimplementedEventBusControllerServer) CreateEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEventBus not implemented")
+}
+func (*UnimplementedEventBusControllerServer) CreateSystemEventBus(context.Context, *controller.CreateEventBusRequest) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSystemEventBus not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteEventBus(context.Context, *meta.EventBus) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventBus not implemented")
+}
+func (*UnimplementedEventBusControllerServer) GetEventBus(context.Context, *meta.EventBus) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventBus not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListEventBus(context.Context, *emptypb.Empty) (*controller.ListEventbusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventBus not implemented")
+}
+func (*UnimplementedEventBusControllerServer) UpdateEventBus(context.Context, *controller.UpdateEventBusRequest) (*meta.EventBus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEventBus not implemented")
+}
+func (*UnimplementedEventBusControllerServer) CreateCronEvent(context.Context, *controller.CreateCronEventRequest) (*controller.CronEvent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCronEvent not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListCronEvent(context.Context, *controller.ListCronEventRequest) (*controller.ListCronEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCronEvent not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteCronEvent(context.Context, *controller.DeleteCronEventRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronEvent not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListTimerReplica(context.Context, *emptypb.Empty) (*controller.ListTimerReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimerReplica not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ConsumerGroupHeartbeat(context.Context, *controller.ConsumerGroupHeartbeatRequest) (*controller.ConsumerGroupAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerGroupHeartbeat not implemented")
+}
+func (*UnimplementedEventBusControllerServer) LeaveConsumerGroup(context.Context, *controller.LeaveConsumerGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveConsumerGroup not implemented")
+}
+func (*UnimplementedEventBusControllerServer) CommitConsumerGroupOffset(context.Context, *controller.CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitConsumerGroupOffset not implemented")
+}
+func (*UnimplementedEventBusControllerServer) GetConsumerGroupOffset(context.Context, *controller.GetConsumerGroupOffsetRequest) (*controller.GetConsumerGroupOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerGroupOffset not implemented")
+}
+func (*UnimplementedEventBusControllerServer) GetClusterStats(context.Context, *emptypb.Empty) (*controller.ClusterStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStats not implemented")
+}
+func (*UnimplementedEventBusControllerServer) CreateReplayJob(context.Context, *controller.CreateReplayJobRequest) (*controller.ReplayJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReplayJob not implemented")
+}
+func (*UnimplementedEventBusControllerServer) GetReplayJob(context.Context, *controller.GetReplayJobRequest) (*controller.ReplayJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplayJob not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListReplayJob(context.Context, *controller.ListReplayJobRequest) (*controller.ListReplayJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplayJob not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteReplayJob(context.Context, *controller.DeleteReplayJobRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReplayJob not implemented")
+}
+func (*UnimplementedEventBusControllerServer) PutEventbusACL(context.Context, *controller.EventbusACL) (*controller.EventbusACL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutEventbusACL not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteEventbusACL(context.Context, *controller.DeleteEventbusACLRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEventbusACL not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListEventbusACL(context.Context, *controller.ListEventbusACLRequest) (*controller.ListEventbusACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventbusACL not implemented")
+}
+func (*UnimplementedEventBusControllerServer) PutIPAllowlist(context.Context, *controller.IPAllowlist) (*controller.IPAllowlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutIPAllowlist not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteIPAllowlist(context.Context, *controller.DeleteIPAllowlistRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIPAllowlist not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListIPAllowlist(context.Context, *emptypb.Empty) (*controller.ListIPAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIPAllowlist not implemented")
+}
+func (*UnimplementedEventBusControllerServer) PutFeatureFlag(context.Context, *controller.FeatureFlag) (*controller.FeatureFlag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutFeatureFlag not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteFeatureFlag(context.Context, *controller.DeleteFeatureFlagRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeatureFlag not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListFeatureFlag(context.Context, *emptypb.Empty) (*controller.ListFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlag not implemented")
+}
+func (*UnimplementedEventBusControllerServer) SetConsumerGroupOffset(context.Context, *controller.SetConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsumerGroupOffset not implemented")
+}
+func (*UnimplementedEventBusControllerServer) CreateHTTPPoller(context.Context, *controller.CreateHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHTTPPoller not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ListHTTPPoller(context.Context, *controller.ListHTTPPollerRequest) (*controller.ListHTTPPollerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHTTPPoller not implemented")
+}
+func (*UnimplementedEventBusControllerServer) PauseHTTPPoller(context.Context, *controller.PauseHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseHTTPPoller not implemented")
+}
+func (*UnimplementedEventBusControllerServer) ResumeHTTPPoller(context.Context, *controller.ResumeHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeHTTPPoller not implemented")
+}
+func (*UnimplementedEventBusControllerServer) DeleteHTTPPoller(context.Context, *controller.DeleteHTTPPollerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHTTPPoller not implemented")
+}
+
+func RegisterEventBusControllerServer(s *grpc.Server, srv EventBusControllerServer) {
+	s.RegisterService(&_EventBusController_serviceDesc, srv)
+}
+
+func _EventBusController_CreateEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateEventBusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).CreateEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/CreateEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).CreateEventBus(ctx, req.(*controller.CreateEventBusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_CreateSystemEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateEventBusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).CreateSystemEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/CreateSystemEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).CreateSystemEventBus(ctx, req.(*controller.CreateEventBusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(meta.EventBus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteEventBus(ctx, req.(*meta.EventBus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_GetEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(meta.EventBus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).GetEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/GetEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).GetEventBus(ctx, req.(*meta.EventBus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListEventBus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_UpdateEventBus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.UpdateEventBusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).UpdateEventBus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/UpdateEventBus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).UpdateEventBus(ctx, req.(*controller.UpdateEventBusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_CreateCronEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateCronEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).CreateCronEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/CreateCronEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).CreateCronEvent(ctx, req.(*controller.CreateCronEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListCronEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListCronEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListCronEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListCronEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListCronEvent(ctx, req.(*controller.ListCronEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteCronEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteCronEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteCronEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteCronEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteCronEvent(ctx, req.(*controller.DeleteCronEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListTimerReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListTimerReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListTimerReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListTimerReplica(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ConsumerGroupHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ConsumerGroupHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ConsumerGroupHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ConsumerGroupHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ConsumerGroupHeartbeat(ctx, req.(*controller.ConsumerGroupHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_LeaveConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.LeaveConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).LeaveConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/LeaveConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).LeaveConsumerGroup(ctx, req.(*controller.LeaveConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_CommitConsumerGroupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CommitConsumerGroupOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).CommitConsumerGroupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/CommitConsumerGroupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).CommitConsumerGroupOffset(ctx, req.(*controller.CommitConsumerGroupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_GetConsumerGroupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetConsumerGroupOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).GetConsumerGroupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/GetConsumerGroupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).GetConsumerGroupOffset(ctx, req.(*controller.GetConsumerGroupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_GetClusterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).GetClusterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/GetClusterStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).GetClusterStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_CreateReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateReplayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).CreateReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/CreateReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).CreateReplayJob(ctx, req.(*controller.CreateReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_GetReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetReplayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).GetReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/GetReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).GetReplayJob(ctx, req.(*controller.GetReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListReplayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListReplayJob(ctx, req.(*controller.ListReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteReplayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteReplayJob(ctx, req.(*controller.DeleteReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_PutEventbusACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.EventbusACL)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).PutEventbusACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/PutEventbusACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).PutEventbusACL(ctx, req.(*controller.EventbusACL))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteEventbusACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteEventbusACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteEventbusACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteEventbusACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteEventbusACL(ctx, req.(*controller.DeleteEventbusACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListEventbusACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListEventbusACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListEventbusACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListEventbusACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListEventbusACL(ctx, req.(*controller.ListEventbusACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_PutIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.IPAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).PutIPAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/PutIPAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).PutIPAllowlist(ctx, req.(*controller.IPAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteIPAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteIPAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteIPAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteIPAllowlist(ctx, req.(*controller.DeleteIPAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListIPAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListIPAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListIPAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListIPAllowlist(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_PutFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.FeatureFlag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).PutFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/PutFeatureFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).PutFeatureFlag(ctx, req.(*controller.FeatureFlag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteFeatureFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteFeatureFlag(ctx, req.(*controller.DeleteFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListFeatureFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListFeatureFlag(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_SetConsumerGroupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.SetConsumerGroupOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).SetConsumerGroupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/SetConsumerGroupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).SetConsumerGroupOffset(ctx, req.(*controller.SetConsumerGroupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_CreateHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateHTTPPollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).CreateHTTPPoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/CreateHTTPPoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).CreateHTTPPoller(ctx, req.(*controller.CreateHTTPPollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ListHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListHTTPPollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ListHTTPPoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ListHTTPPoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ListHTTPPoller(ctx, req.(*controller.ListHTTPPollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_PauseHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.PauseHTTPPollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).PauseHTTPPoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/PauseHTTPPoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).PauseHTTPPoller(ctx, req.(*controller.PauseHTTPPollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_ResumeHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ResumeHTTPPollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).ResumeHTTPPoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/ResumeHTTPPoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).ResumeHTTPPoller(ctx, req.(*controller.ResumeHTTPPollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventBusController_DeleteHTTPPoller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteHTTPPollerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventBusControllerServer).DeleteHTTPPoller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventBusController/DeleteHTTPPoller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventBusControllerServer).DeleteHTTPPoller(ctx, req.(*controller.DeleteHTTPPollerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventBusController_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.controller.v2.EventBusController",
+	HandlerType: (*EventBusControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEventBus",
+			Handler:    _EventBusController_CreateEventBus_Handler,
+		},
+		{
+			MethodName: "CreateSystemEventBus",
+			Handler:    _EventBusController_CreateSystemEventBus_Handler,
+		},
+		{
+			MethodName: "DeleteEventBus",
+			Handler:    _EventBusController_DeleteEventBus_Handler,
+		},
+		{
+			MethodName: "GetEventBus",
+			Handler:    _EventBusController_GetEventBus_Handler,
+		},
+		{
+			MethodName: "ListEventBus",
+			Handler:    _EventBusController_ListEventBus_Handler,
+		},
+		{
+			MethodName: "UpdateEventBus",
+			Handler:    _EventBusController_UpdateEventBus_Handler,
+		},
+		{
+			MethodName: "CreateCronEvent",
+			Handler:    _EventBusController_CreateCronEvent_Handler,
+		},
+		{
+			MethodName: "ListCronEvent",
+			Handler:    _EventBusController_ListCronEvent_Handler,
+		},
+		{
+			MethodName: "DeleteCronEvent",
+			Handler:    _EventBusController_DeleteCronEvent_Handler,
+		},
+		{
+			MethodName: "ListTimerReplica",
+			Handler:    _EventBusController_ListTimerReplica_Handler,
+		},
+		{
+			MethodName: "ConsumerGroupHeartbeat",
+			Handler:    _EventBusController_ConsumerGroupHeartbeat_Handler,
+		},
+		{
+			MethodName: "LeaveConsumerGroup",
+			Handler:    _EventBusController_LeaveConsumerGroup_Handler,
+		},
+		{
+			MethodName: "CommitConsumerGroupOffset",
+			Handler:    _EventBusController_CommitConsumerGroupOffset_Handler,
+		},
+		{
+			MethodName: "GetConsumerGroupOffset",
+			Handler:    _EventBusController_GetConsumerGroupOffset_Handler,
+		},
+		{
+			MethodName: "GetClusterStats",
+			Handler:    _EventBusController_GetClusterStats_Handler,
+		},
+		{
+			MethodName: "CreateReplayJob",
+			Handler:    _EventBusController_CreateReplayJob_Handler,
+		},
+		{
+			MethodName: "GetReplayJob",
+			Handler:    _EventBusController_GetReplayJob_Handler,
+		},
+		{
+			MethodName: "ListReplayJob",
+			Handler:    _EventBusController_ListReplayJob_Handler,
+		},
+		{
+			MethodName: "DeleteReplayJob",
+			Handler:    _EventBusController_DeleteReplayJob_Handler,
+		},
+		{
+			MethodName: "PutEventbusACL",
+			Handler:    _EventBusController_PutEventbusACL_Handler,
+		},
+		{
+			MethodName: "DeleteEventbusACL",
+			Handler:    _EventBusController_DeleteEventbusACL_Handler,
+		},
+		{
+			MethodName: "ListEventbusACL",
+			Handler:    _EventBusController_ListEventbusACL_Handler,
+		},
+		{
+			MethodName: "PutIPAllowlist",
+			Handler:    _EventBusController_PutIPAllowlist_Handler,
+		},
+		{
+			MethodName: "DeleteIPAllowlist",
+			Handler:    _EventBusController_DeleteIPAllowlist_Handler,
+		},
+		{
+			MethodName: "ListIPAllowlist",
+			Handler:    _EventBusController_ListIPAllowlist_Handler,
+		},
+		{
+			MethodName: "PutFeatureFlag",
+			Handler:    _EventBusController_PutFeatureFlag_Handler,
+		},
+		{
+			MethodName: "DeleteFeatureFlag",
+			Handler:    _EventBusController_DeleteFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlag",
+			Handler:    _EventBusController_ListFeatureFlag_Handler,
+		},
+		{
+			MethodName: "SetConsumerGroupOffset",
+			Handler:    _EventBusController_SetConsumerGroupOffset_Handler,
+		},
+		{
+			MethodName: "CreateHTTPPoller",
+			Handler:    _EventBusController_CreateHTTPPoller_Handler,
+		},
+		{
+			MethodName: "ListHTTPPoller",
+			Handler:    _EventBusController_ListHTTPPoller_Handler,
+		},
+		{
+			MethodName: "PauseHTTPPoller",
+			Handler:    _EventBusController_PauseHTTPPoller_Handler,
+		},
+		{
+			MethodName: "ResumeHTTPPoller",
+			Handler:    _EventBusController_ResumeHTTPPoller_Handler,
+		},
+		{
+			MethodName: "DeleteHTTPPoller",
+			Handler:    _EventBusController_DeleteHTTPPoller_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/controller.proto",
+}
+
+// EventLogControllerClient is the client API for EventLogController service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventLogControllerClient interface {
+	ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error)
+	GetAppendableSegment(ctx context.Context, in *controller.GetAppendableSegmentRequest, opts ...grpc.CallOption) (*controller.GetAppendableSegmentResponse, error)
+}
+
+type eventLogControllerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventLogControllerClient(cc grpc.ClientConnInterface) EventLogControllerClient {
+	return &eventLogControllerClient{cc}
+}
+
+func (c *eventLogControllerClient) ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error) {
+	out := new(controller.ListSegmentResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventLogController/ListSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventLogControllerClient) GetAppendableSegment(ctx context.Context, in *controller.GetAppendableSegmentRequest, opts ...grpc.CallOption) (*controller.GetAppendableSegmentResponse, error) {
+	out := new(controller.GetAppendableSegmentResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.EventLogController/GetAppendableSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventLogControllerServer is the server API for EventLogController service.
+type EventLogControllerServer interface {
+	ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error)
+	GetAppendableSegment(context.Context, *controller.GetAppendableSegmentRequest) (*controller.GetAppendableSegmentResponse, error)
+}
+
+// UnimplementedEventLogControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedEventLogControllerServer struct {
+}
+
+func (*UnimplementedEventLogControllerServer) ListSegment(context.Context, *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegment not implemented")
+}
+func (*UnimplementedEventLogControllerServer) GetAppendableSegment(context.Context, *controller.GetAppendableSegmentRequest) (*controller.GetAppendableSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppendableSegment not implemented")
+}
+
+func RegisterEventLogControllerServer(s *grpc.Server, srv EventLogControllerServer) {
+	s.RegisterService(&_EventLogController_serviceDesc, srv)
+}
+
+func _EventLogController_ListSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventLogControllerServer).ListSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventLogController/ListSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventLogControllerServer).ListSegment(ctx, req.(*controller.ListSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventLogController_GetAppendableSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetAppendableSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventLogControllerServer).GetAppendableSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.EventLogController/GetAppendableSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventLogControllerServer).GetAppendableSegment(ctx, req.(*controller.GetAppendableSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventLogController_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.controller.v2.EventLogController",
+	HandlerType: (*EventLogControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSegment",
+			Handler:    _EventLogController_ListSegment_Handler,
+		},
+		{
+			MethodName: "GetAppendableSegment",
+			Handler:    _EventLogController_GetAppendableSegment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/controller.proto",
+}
+
+// SegmentControllerClient is the client API for SegmentController service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SegmentControllerClient interface {
+	QuerySegmentRouteInfo(ctx context.Context, in *controller.QuerySegmentRouteInfoRequest, opts ...grpc.CallOption) (*controller.QuerySegmentRouteInfoResponse, error)
+	SegmentHeartbeat(ctx context.Context, opts ...grpc.CallOption) (SegmentController_SegmentHeartbeatClient, error)
+	RegisterSegmentServer(ctx context.Context, in *controller.RegisterSegmentServerRequest, opts ...grpc.CallOption) (*controller.RegisterSegmentServerResponse, error)
+	UnregisterSegmentServer(ctx context.Context, in *controller.UnregisterSegmentServerRequest, opts ...grpc.CallOption) (*controller.UnregisterSegmentServerResponse, error)
+	ReportSegmentBlockIsFull(ctx context.Context, in *controller.SegmentHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReportSegmentLeader(ctx context.Context, in *controller.ReportSegmentLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type segmentControllerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSegmentControllerClient(cc grpc.ClientConnInterface) SegmentControllerClient {
+	return &segmentControllerClient{cc}
+}
+
+func (c *segmentControllerClient) QuerySegmentRouteInfo(ctx context.Context, in *controller.QuerySegmentRouteInfoRequest, opts ...grpc.CallOption) (*controller.QuerySegmentRouteInfoResponse, error) {
+	out := new(controller.QuerySegmentRouteInfoResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SegmentController/QuerySegmentRouteInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentControllerClient) SegmentHeartbeat(ctx context.Context, opts ...grpc.CallOption) (SegmentController_SegmentHeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SegmentController_serviceDesc.Streams[0], "/linkall.vanus.controller.v2.SegmentController/SegmentHeartbeat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &segmentControllerSegmentHeartbeatClient{stream}
+	return x, nil
+}
+
+type SegmentController_SegmentHeartbeatClient interface {
+	Send(*controller.SegmentHeartbeatRequest) error
+	CloseAndRecv() (*controller.SegmentHeartbeatResponse, error)
+	grpc.ClientStream
+}
+
+type segmentControllerSegmentHeartbeatClient struct {
+	grpc.ClientStream
+}
+
+func (x *segmentControllerSegmentHeartbeatClient) Send(m *controller.SegmentHeartbeatRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *segmentControllerSegmentHeartbeatClient) CloseAndRecv() (*controller.SegmentHeartbeatResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(controller.SegmentHeartbeatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *segmentControllerClient) RegisterSegmentServer(ctx context.Context, in *controller.RegisterSegmentServerRequest, opts ...grpc.CallOption) (*controller.RegisterSegmentServerResponse, error) {
+	out := new(controller.RegisterSegmentServerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SegmentController/RegisterSegmentServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentControllerClient) UnregisterSegmentServer(ctx context.Context, in *controller.UnregisterSegmentServerRequest, opts ...grpc.CallOption) (*controller.UnregisterSegmentServerResponse, error) {
+	out := new(controller.UnregisterSegmentServerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SegmentController/UnregisterSegmentServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentControllerClient) ReportSegmentBlockIsFull(ctx context.Context, in *controller.SegmentHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SegmentController/ReportSegmentBlockIsFull", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentControllerClient) ReportSegmentLeader(ctx context.Context, in *controller.ReportSegmentLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SegmentController/ReportSegmentLeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SegmentControllerServer is the server API for SegmentController service.
+type SegmentControllerServer interface {
+	QuerySegmentRouteInfo(context.Context, *controller.QuerySegmentRouteInfoRequest) (*controller.QuerySegmentRouteInfoResponse, error)
+	SegmentHeartbeat(SegmentController_SegmentHeartbeatServer) error
+	RegisterSegmentServer(context.Context, *controller.RegisterSegmentServerRequest) (*controller.RegisterSegmentServerResponse, error)
+	UnregisterSegmentServer(context.Context, *controller.UnregisterSegmentServerRequest) (*controller.UnregisterSegmentServerResponse, error)
+	ReportSegmentBlockIsFull(context.Context, *controller.SegmentHeartbeatRequest) (*emptypb.Empty, error)
+	ReportSegmentLeader(context.Context, *controller.ReportSegmentLeaderRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedSegmentControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedSegmentControllerServer struct {
+}
+
+func (*UnimplementedSegmentControllerServer) QuerySegmentRouteInfo(context.Context, *controller.QuerySegmentRouteInfoRequest) (*controller.QuerySegmentRouteInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySegmentRouteInfo not implemented")
+}
+func (*UnimplementedSegmentControllerServer) SegmentHeartbeat(SegmentController_SegmentHeartbeatServer) error {
+	return status.Errorf(codes.Unimplemented, "method SegmentHeartbeat not implemented")
+}
+func (*UnimplementedSegmentControllerServer) RegisterSegmentServer(context.Context, *controller.RegisterSegmentServerRequest) (*controller.RegisterSegmentServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSegmentServer not implemented")
+}
+func (*UnimplementedSegmentControllerServer) UnregisterSegmentServer(context.Context, *controller.UnregisterSegmentServerRequest) (*controller.UnregisterSegmentServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterSegmentServer not implemented")
+}
+func (*UnimplementedSegmentControllerServer) ReportSegmentBlockIsFull(context.Context, *controller.SegmentHeartbeatRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSegmentBlockIsFull not implemented")
+}
+func (*UnimplementedSegmentControllerServer) ReportSegmentLeader(context.Context, *controller.ReportSegmentLeaderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportSegmentLeader not implemented")
+}
+
+func RegisterSegmentControllerServer(s *grpc.Server, srv SegmentControllerServer) {
+	s.RegisterService(&_SegmentController_serviceDesc, srv)
+}
+
+func _SegmentController_QuerySegmentRouteInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.QuerySegmentRouteInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).QuerySegmentRouteInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SegmentController/QuerySegmentRouteInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).QuerySegmentRouteInfo(ctx, req.(*controller.QuerySegmentRouteInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentController_SegmentHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SegmentControllerServer).SegmentHeartbeat(&segmentControllerSegmentHeartbeatServer{stream})
+}
+
+type SegmentController_SegmentHeartbeatServer interface {
+	SendAndClose(*controller.SegmentHeartbeatResponse) error
+	Recv() (*controller.SegmentHeartbeatRequest, error)
+	grpc.ServerStream
+}
+
+type segmentControllerSegmentHeartbeatServer struct {
+	grpc.ServerStream
+}
+
+func (x *segmentControllerSegmentHeartbeatServer) SendAndClose(m *controller.SegmentHeartbeatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *segmentControllerSegmentHeartbeatServer) Recv() (*controller.SegmentHeartbeatRequest, error) {
+	m := new(controller.SegmentHeartbeatRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SegmentController_RegisterSegmentServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.RegisterSegmentServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).RegisterSegmentServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SegmentController/RegisterSegmentServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).RegisterSegmentServer(ctx, req.(*controller.RegisterSegmentServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentController_UnregisterSegmentServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.UnregisterSegmentServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).UnregisterSegmentServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SegmentController/UnregisterSegmentServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).UnregisterSegmentServer(ctx, req.(*controller.UnregisterSegmentServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentController_ReportSegmentBlockIsFull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.SegmentHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).ReportSegmentBlockIsFull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SegmentController/ReportSegmentBlockIsFull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).ReportSegmentBlockIsFull(ctx, req.(*controller.SegmentHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentController_ReportSegmentLeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ReportSegmentLeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentControllerServer).ReportSegmentLeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SegmentController/ReportSegmentLeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentControllerServer).ReportSegmentLeader(ctx, req.(*controller.ReportSegmentLeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SegmentController_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.controller.v2.SegmentController",
+	HandlerType: (*SegmentControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QuerySegmentRouteInfo",
+			Handler:    _SegmentController_QuerySegmentRouteInfo_Handler,
+		},
+		{
+			MethodName: "RegisterSegmentServer",
+			Handler:    _SegmentController_RegisterSegmentServer_Handler,
+		},
+		{
+			MethodName: "UnregisterSegmentServer",
+			Handler:    _SegmentController_UnregisterSegmentServer_Handler,
+		},
+		{
+			MethodName: "ReportSegmentBlockIsFull",
+			Handler:    _SegmentController_ReportSegmentBlockIsFull_Handler,
+		},
+		{
+			MethodName: "ReportSegmentLeader",
+			Handler:    _SegmentController_ReportSegmentLeader_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SegmentHeartbeat",
+			Handler:       _SegmentController_SegmentHeartbeat_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "v2/controller.proto",
+}
+
+// TriggerControllerClient is the client API for TriggerController service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TriggerControllerClient interface {
+	CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error)
+	TriggerWorkerHeartbeat(ctx context.Context, opts ...grpc.CallOption) (TriggerController_TriggerWorkerHeartbeatClient, error)
+	RegisterTriggerWorker(ctx context.Context, in *controller.RegisterTriggerWorkerRequest, opts ...grpc.CallOption) (*controller.RegisterTriggerWorkerResponse, error)
+	UnregisterTriggerWorker(ctx context.Context, in *controller.UnregisterTriggerWorkerRequest, opts ...grpc.CallOption) (*controller.UnregisterTriggerWorkerResponse, error)
+	ResetOffsetToTimestamp(ctx context.Context, in *ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CommitOffset(ctx context.Context, in *controller.CommitOffsetRequest, opts ...grpc.CallOption) (*controller.CommitOffsetResponse, error)
+	ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTriggerWorkerResponse, error)
+	ExportSubscription(ctx context.Context, in *controller.ExportSubscriptionRequest, opts ...grpc.CallOption) (*controller.ExportSubscriptionResponse, error)
+	ImportSubscription(ctx context.Context, in *controller.ImportSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error)
+	PutSecret(ctx context.Context, in *controller.PutSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error)
+	GetSecret(ctx context.Context, in *controller.GetSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error)
+	ListSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSecretResponse, error)
+	DeleteSecret(ctx context.Context, in *controller.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchSecret notifies the secrets are rotated or deleted
+	WatchSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TriggerController_WatchSecretClient, error)
+	// PutConnector installs or updates a connector, the instances are restarted
+	// with the new config and scaled to the replicas.
+	PutConnector(ctx context.Context, in *controller.Connector, opts ...grpc.CallOption) (*controller.Connector, error)
+	GetConnector(ctx context.Context, in *controller.GetConnectorRequest, opts ...grpc.CallOption) (*controller.Connector, error)
+	ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListConnectorResponse, error)
+	DeleteConnector(ctx context.Context, in *controller.DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListWorkerConnector returns the connector instances assigned to the trigger
+	// worker, which are run by the plugins installed on it.
+	ListWorkerConnector(ctx context.Context, in *controller.ListWorkerConnectorRequest, opts ...grpc.CallOption) (*controller.ListWorkerConnectorResponse, error)
+	CommitConnectorPosition(ctx context.Context, in *controller.CommitConnectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type triggerControllerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggerControllerClient(cc grpc.ClientConnInterface) TriggerControllerClient {
+	return &triggerControllerClient{cc}
+}
+
+func (c *triggerControllerClient) CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/UpdateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/DeleteSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/GetSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error) {
+	out := new(controller.ListSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ListSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) TriggerWorkerHeartbeat(ctx context.Context, opts ...grpc.CallOption) (TriggerController_TriggerWorkerHeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TriggerController_serviceDesc.Streams[0], "/linkall.vanus.controller.v2.TriggerController/TriggerWorkerHeartbeat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &triggerControllerTriggerWorkerHeartbeatClient{stream}
+	return x, nil
+}
+
+type TriggerController_TriggerWorkerHeartbeatClient interface {
+	Send(*controller.TriggerWorkerHeartbeatRequest) error
+	CloseAndRecv() (*controller.TriggerWorkerHeartbeatResponse, error)
+	grpc.ClientStream
+}
+
+type triggerControllerTriggerWorkerHeartbeatClient struct {
+	grpc.ClientStream
+}
+
+func (x *triggerControllerTriggerWorkerHeartbeatClient) Send(m *controller.TriggerWorkerHeartbeatRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *triggerControllerTriggerWorkerHeartbeatClient) CloseAndRecv() (*controller.TriggerWorkerHeartbeatResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(controller.TriggerWorkerHeartbeatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *triggerControllerClient) RegisterTriggerWorker(ctx context.Context, in *controller.RegisterTriggerWorkerRequest, opts ...grpc.CallOption) (*controller.RegisterTriggerWorkerResponse, error) {
+	out := new(controller.RegisterTriggerWorkerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/RegisterTriggerWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) UnregisterTriggerWorker(ctx context.Context, in *controller.UnregisterTriggerWorkerRequest, opts ...grpc.CallOption) (*controller.UnregisterTriggerWorkerResponse, error) {
+	out := new(controller.UnregisterTriggerWorkerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/UnregisterTriggerWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ResetOffsetToTimestamp(ctx context.Context, in *ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ResetOffsetToTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) CommitOffset(ctx context.Context, in *controller.CommitOffsetRequest, opts ...grpc.CallOption) (*controller.CommitOffsetResponse, error) {
+	out := new(controller.CommitOffsetResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/CommitOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTriggerWorkerResponse, error) {
+	out := new(controller.ListTriggerWorkerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ListTriggerWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ExportSubscription(ctx context.Context, in *controller.ExportSubscriptionRequest, opts ...grpc.CallOption) (*controller.ExportSubscriptionResponse, error) {
+	out := new(controller.ExportSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ExportSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ImportSubscription(ctx context.Context, in *controller.ImportSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	out := new(meta.Subscription)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ImportSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) PutSecret(ctx context.Context, in *controller.PutSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error) {
+	out := new(controller.Secret)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/PutSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) GetSecret(ctx context.Context, in *controller.GetSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error) {
+	out := new(controller.Secret)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/GetSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ListSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSecretResponse, error) {
+	out := new(controller.ListSecretResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ListSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) DeleteSecret(ctx context.Context, in *controller.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/DeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) WatchSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TriggerController_WatchSecretClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TriggerController_serviceDesc.Streams[1], "/linkall.vanus.controller.v2.TriggerController/WatchSecret", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &triggerControllerWatchSecretClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TriggerController_WatchSecretClient interface {
+	Recv() (*controller.SecretEvent, error)
+	grpc.ClientStream
+}
+
+type triggerControllerWatchSecretClient struct {
+	grpc.ClientStream
+}
+
+func (x *triggerControllerWatchSecretClient) Recv() (*controller.SecretEvent, error) {
+	m := new(controller.SecretEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *triggerControllerClient) PutConnector(ctx context.Context, in *controller.Connector, opts ...grpc.CallOption) (*controller.Connector, error) {
+	out := new(controller.Connector)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/PutConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) GetConnector(ctx context.Context, in *controller.GetConnectorRequest, opts ...grpc.CallOption) (*controller.Connector, error) {
+	out := new(controller.Connector)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/GetConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListConnectorResponse, error) {
+	out := new(controller.ListConnectorResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ListConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) DeleteConnector(ctx context.Context, in *controller.DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/DeleteConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) ListWorkerConnector(ctx context.Context, in *controller.ListWorkerConnectorRequest, opts ...grpc.CallOption) (*controller.ListWorkerConnectorResponse, error) {
+	out := new(controller.ListWorkerConnectorResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/ListWorkerConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerControllerClient) CommitConnectorPosition(ctx context.Context, in *controller.CommitConnectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.TriggerController/CommitConnectorPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerControllerServer is the server API for TriggerController service.
+type TriggerControllerServer interface {
+	CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error)
+	UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error)
+	DeleteSubscription(context.Context, *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error)
+	GetSubscription(context.Context, *controller.GetSubscriptionRequest) (*meta.Subscription, error)
+	ListSubscription(context.Context, *emptypb.Empty) (*controller.ListSubscriptionResponse, error)
+	TriggerWorkerHeartbeat(TriggerController_TriggerWorkerHeartbeatServer) error
+	RegisterTriggerWorker(context.Context, *controller.RegisterTriggerWorkerRequest) (*controller.RegisterTriggerWorkerResponse, error)
+	UnregisterTriggerWorker(context.Context, *controller.UnregisterTriggerWorkerRequest) (*controller.UnregisterTriggerWorkerResponse, error)
+	ResetOffsetToTimestamp(context.Context, *ResetOffsetToTimestampRequest) (*emptypb.Empty, error)
+	CommitOffset(context.Context, *controller.CommitOffsetRequest) (*controller.CommitOffsetResponse, error)
+	ListTriggerWorker(context.Context, *emptypb.Empty) (*controller.ListTriggerWorkerResponse, error)
+	ExportSubscription(context.Context, *controller.ExportSubscriptionRequest) (*controller.ExportSubscriptionResponse, error)
+	ImportSubscription(context.Context, *controller.ImportSubscriptionRequest) (*meta.Subscription, error)
+	PutSecret(context.Context, *controller.PutSecretRequest) (*controller.Secret, error)
+	GetSecret(context.Context, *controller.GetSecretRequest) (*controller.Secret, error)
+	ListSecret(context.Context, *emptypb.Empty) (*controller.ListSecretResponse, error)
+	DeleteSecret(context.Context, *controller.DeleteSecretRequest) (*emptypb.Empty, error)
+	// WatchSecret notifies the secrets are rotated or deleted
+	WatchSecret(*emptypb.Empty, TriggerController_WatchSecretServer) error
+	// PutConnector installs or updates a connector, the instances are restarted
+	// with the new config and scaled to the replicas.
+	PutConnector(context.Context, *controller.Connector) (*controller.Connector, error)
+	GetConnector(context.Context, *controller.GetConnectorRequest) (*controller.Connector, error)
+	ListConnector(context.Context, *emptypb.Empty) (*controller.ListConnectorResponse, error)
+	DeleteConnector(context.Context, *controller.DeleteConnectorRequest) (*emptypb.Empty, error)
+	// ListWorkerConnector returns the connector instances assigned to the trigger
+	// worker, which are run by the plugins installed on it.
+	ListWorkerConnector(context.Context, *controller.ListWorkerConnectorRequest) (*controller.ListWorkerConnectorResponse, error)
+	CommitConnectorPosition(context.Context, *controller.CommitConnectorPositionRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedTriggerControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedTriggerControllerServer struct {
+}
+
+func (*UnimplementedTriggerControllerServer) CreateSubscription(context.Context, *controller.CreateSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) UpdateSubscription(context.Context, *controller.UpdateSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) DeleteSubscription(context.Context, *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) GetSubscription(context.Context, *controller.GetSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ListSubscription(context.Context, *emptypb.Empty) (*controller.ListSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) TriggerWorkerHeartbeat(TriggerController_TriggerWorkerHeartbeatServer) error {
+	return status.Errorf(codes.Unimplemented, "method TriggerWorkerHeartbeat not implemented")
+}
+func (*UnimplementedTriggerControllerServer) RegisterTriggerWorker(context.Context, *controller.RegisterTriggerWorkerRequest) (*controller.RegisterTriggerWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTriggerWorker not implemented")
+}
+func (*UnimplementedTriggerControllerServer) UnregisterTriggerWorker(context.Context, *controller.UnregisterTriggerWorkerRequest) (*controller.UnregisterTriggerWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTriggerWorker not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ResetOffsetToTimestamp(context.Context, *ResetOffsetToTimestampRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOffsetToTimestamp not implemented")
+}
+func (*UnimplementedTriggerControllerServer) CommitOffset(context.Context, *controller.CommitOffsetRequest) (*controller.CommitOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOffset not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ListTriggerWorker(context.Context, *emptypb.Empty) (*controller.ListTriggerWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTriggerWorker not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ExportSubscription(context.Context, *controller.ExportSubscriptionRequest) (*controller.ExportSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ImportSubscription(context.Context, *controller.ImportSubscriptionRequest) (*meta.Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSubscription not implemented")
+}
+func (*UnimplementedTriggerControllerServer) PutSecret(context.Context, *controller.PutSecretRequest) (*controller.Secret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutSecret not implemented")
+}
+func (*UnimplementedTriggerControllerServer) GetSecret(context.Context, *controller.GetSecretRequest) (*controller.Secret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ListSecret(context.Context, *emptypb.Empty) (*controller.ListSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecret not implemented")
+}
+func (*UnimplementedTriggerControllerServer) DeleteSecret(context.Context, *controller.DeleteSecretRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (*UnimplementedTriggerControllerServer) WatchSecret(*emptypb.Empty, TriggerController_WatchSecretServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSecret not implemented")
+}
+func (*UnimplementedTriggerControllerServer) PutConnector(context.Context, *controller.Connector) (*controller.Connector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutConnector not implemented")
+}
+func (*UnimplementedTriggerControllerServer) GetConnector(context.Context, *controller.GetConnectorRequest) (*controller.Connector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnector not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ListConnector(context.Context, *emptypb.Empty) (*controller.ListConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnector not implemented")
+}
+func (*UnimplementedTriggerControllerServer) DeleteConnector(context.Context, *controller.DeleteConnectorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnector not implemented")
+}
+func (*UnimplementedTriggerControllerServer) ListWorkerConnector(context.Context, *controller.ListWorkerConnectorRequest) (*controller.ListWorkerConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkerConnector not implemented")
+}
+func (*UnimplementedTriggerControllerServer) CommitConnectorPosition(context.Context, *controller.CommitConnectorPositionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitConnectorPosition not implemented")
+}
+
+func RegisterTriggerControllerServer(s *grpc.Server, srv TriggerControllerServer) {
+	s.RegisterService(&_TriggerController_serviceDesc, srv)
+}
+
+func _TriggerController_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).CreateSubscription(ctx, req.(*controller.CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).UpdateSubscription(ctx, req.(*controller.UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/DeleteSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).DeleteSubscription(ctx, req.(*controller.DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/GetSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).GetSubscription(ctx, req.(*controller.GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ListSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ListSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ListSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ListSubscription(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_TriggerWorkerHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TriggerControllerServer).TriggerWorkerHeartbeat(&triggerControllerTriggerWorkerHeartbeatServer{stream})
+}
+
+type TriggerController_TriggerWorkerHeartbeatServer interface {
+	SendAndClose(*controller.TriggerWorkerHeartbeatResponse) error
+	Recv() (*controller.TriggerWorkerHeartbeatRequest, error)
+	grpc.ServerStream
+}
+
+type triggerControllerTriggerWorkerHeartbeatServer struct {
+	grpc.ServerStream
+}
+
+func (x *triggerControllerTriggerWorkerHeartbeatServer) SendAndClose(m *controller.TriggerWorkerHeartbeatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *triggerControllerTriggerWorkerHeartbeatServer) Recv() (*controller.TriggerWorkerHeartbeatRequest, error) {
+	m := new(controller.TriggerWorkerHeartbeatRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TriggerController_RegisterTriggerWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.RegisterTriggerWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).RegisterTriggerWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/RegisterTriggerWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).RegisterTriggerWorker(ctx, req.(*controller.RegisterTriggerWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_UnregisterTriggerWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.UnregisterTriggerWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).UnregisterTriggerWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/UnregisterTriggerWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).UnregisterTriggerWorker(ctx, req.(*controller.UnregisterTriggerWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ResetOffsetToTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetOffsetToTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ResetOffsetToTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ResetOffsetToTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ResetOffsetToTimestamp(ctx, req.(*ResetOffsetToTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_CommitOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CommitOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).CommitOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/CommitOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).CommitOffset(ctx, req.(*controller.CommitOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ListTriggerWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ListTriggerWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ListTriggerWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ListTriggerWorker(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ExportSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ExportSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ExportSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ExportSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ExportSubscription(ctx, req.(*controller.ExportSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ImportSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ImportSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ImportSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ImportSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ImportSubscription(ctx, req.(*controller.ImportSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_PutSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.PutSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).PutSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/PutSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).PutSecret(ctx, req.(*controller.PutSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/GetSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).GetSecret(ctx, req.(*controller.GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ListSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ListSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ListSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ListSecret(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/DeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).DeleteSecret(ctx, req.(*controller.DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_WatchSecret_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TriggerControllerServer).WatchSecret(m, &triggerControllerWatchSecretServer{stream})
+}
+
+type TriggerController_WatchSecretServer interface {
+	Send(*controller.SecretEvent) error
+	grpc.ServerStream
+}
+
+type triggerControllerWatchSecretServer struct {
+	grpc.ServerStream
+}
+
+func (x *triggerControllerWatchSecretServer) Send(m *controller.SecretEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TriggerController_PutConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.Connector)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).PutConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/PutConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).PutConnector(ctx, req.(*controller.Connector))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_GetConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.GetConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).GetConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/GetConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).GetConnector(ctx, req.(*controller.GetConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ListConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ListConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ListConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ListConnector(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_DeleteConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.DeleteConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).DeleteConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/DeleteConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).DeleteConnector(ctx, req.(*controller.DeleteConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_ListWorkerConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.ListWorkerConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).ListWorkerConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/ListWorkerConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).ListWorkerConnector(ctx, req.(*controller.ListWorkerConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerController_CommitConnectorPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.CommitConnectorPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerControllerServer).CommitConnectorPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.TriggerController/CommitConnectorPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerControllerServer).CommitConnectorPosition(ctx, req.(*controller.CommitConnectorPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TriggerController_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.controller.v2.TriggerController",
+	HandlerType: (*TriggerControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _TriggerController_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _TriggerController_UpdateSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _TriggerController_DeleteSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _TriggerController_GetSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscription",
+			Handler:    _TriggerController_ListSubscription_Handler,
+		},
+		{
+			MethodName: "RegisterTriggerWorker",
+			Handler:    _TriggerController_RegisterTriggerWorker_Handler,
+		},
+		{
+			MethodName: "UnregisterTriggerWorker",
+			Handler:    _TriggerController_UnregisterTriggerWorker_Handler,
+		},
+		{
+			MethodName: "ResetOffsetToTimestamp",
+			Handler:    _TriggerController_ResetOffsetToTimestamp_Handler,
+		},
+		{
+			MethodName: "CommitOffset",
+			Handler:    _TriggerController_CommitOffset_Handler,
+		},
+		{
+			MethodName: "ListTriggerWorker",
+			Handler:    _TriggerController_ListTriggerWorker_Handler,
+		},
+		{
+			MethodName: "ExportSubscription",
+			Handler:    _TriggerController_ExportSubscription_Handler,
+		},
+		{
+			MethodName: "ImportSubscription",
+			Handler:    _TriggerController_ImportSubscription_Handler,
+		},
+		{
+			MethodName: "PutSecret",
+			Handler:    _TriggerController_PutSecret_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _TriggerController_GetSecret_Handler,
+		},
+		{
+			MethodName: "ListSecret",
+			Handler:    _TriggerController_ListSecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _TriggerController_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "PutConnector",
+			Handler:    _TriggerController_PutConnector_Handler,
+		},
+		{
+			MethodName: "GetConnector",
+			Handler:    _TriggerController_GetConnector_Handler,
+		},
+		{
+			MethodName: "ListConnector",
+			Handler:    _TriggerController_ListConnector_Handler,
+		},
+		{
+			MethodName: "DeleteConnector",
+			Handler:    _TriggerController_DeleteConnector_Handler,
+		},
+		{
+			MethodName: "ListWorkerConnector",
+			Handler:    _TriggerController_ListWorkerConnector_Handler,
+		},
+		{
+			MethodName: "CommitConnectorPosition",
+			Handler:    _TriggerController_CommitConnectorPosition_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TriggerWorkerHeartbeat",
+			Handler:       _TriggerController_TriggerWorkerHeartbeat_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchSecret",
+			Handler:       _TriggerController_WatchSecret_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v2/controller.proto",
+}
+
+// SnowflakeControllerClient is the client API for SnowflakeController service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SnowflakeControllerClient interface {
+	GetClusterStartTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error)
+	RegisterNode(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnregisterNode(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AllocateIDs leases a range of IDs which are unique in the cluster and
+	// greater than all IDs allocated before.
+	AllocateIDs(ctx context.Context, in *controller.AllocateIDsRequest, opts ...grpc.CallOption) (*controller.AllocateIDsResponse, error)
+}
+
+type snowflakeControllerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSnowflakeControllerClient(cc grpc.ClientConnInterface) SnowflakeControllerClient {
+	return &snowflakeControllerClient{cc}
+}
+
+func (c *snowflakeControllerClient) GetClusterStartTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
+	out := new(timestamppb.Timestamp)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SnowflakeController/GetClusterStartTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeControllerClient) RegisterNode(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SnowflakeController/RegisterNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeControllerClient) UnregisterNode(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SnowflakeController/UnregisterNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *snowflakeControllerClient) AllocateIDs(ctx context.Context, in *controller.AllocateIDsRequest, opts ...grpc.CallOption) (*controller.AllocateIDsResponse, error) {
+	out := new(controller.AllocateIDsResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.controller.v2.SnowflakeController/AllocateIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SnowflakeControllerServer is the server API for SnowflakeController service.
+type SnowflakeControllerServer interface {
+	GetClusterStartTime(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error)
+	RegisterNode(context.Context, *wrapperspb.UInt32Value) (*emptypb.Empty, error)
+	UnregisterNode(context.Context, *wrapperspb.UInt32Value) (*emptypb.Empty, error)
+	// AllocateIDs leases a range of IDs which are unique in the cluster and
+	// greater than all IDs allocated before.
+	AllocateIDs(context.Context, *controller.AllocateIDsRequest) (*controller.AllocateIDsResponse, error)
+}
+
+// UnimplementedSnowflakeControllerServer can be embedded to have forward compatible implementations.
+type UnimplementedSnowflakeControllerServer struct {
+}
+
+func (*UnimplementedSnowflakeControllerServer) GetClusterStartTime(context.Context, *emptypb.Empty) (*timestamppb.Timestamp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStartTime not implemented")
+}
+func (*UnimplementedSnowflakeControllerServer) RegisterNode(context.Context, *wrapperspb.UInt32Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNode not implemented")
+}
+func (*UnimplementedSnowflakeControllerServer) UnregisterNode(context.Context, *wrapperspb.UInt32Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterNode not implemented")
+}
+func (*UnimplementedSnowflakeControllerServer) AllocateIDs(context.Context, *controller.AllocateIDsRequest) (*controller.AllocateIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateIDs not implemented")
+}
+
+func RegisterSnowflakeControllerServer(s *grpc.Server, srv SnowflakeControllerServer) {
+	s.RegisterService(&_SnowflakeController_serviceDesc, srv)
+}
+
+func _SnowflakeController_GetClusterStartTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeControllerServer).GetClusterStartTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SnowflakeController/GetClusterStartTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeControllerServer).GetClusterStartTime(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeController_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt32Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeControllerServer).RegisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SnowflakeController/RegisterNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeControllerServer).RegisterNode(ctx, req.(*wrapperspb.UInt32Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeController_UnregisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.UInt32Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeControllerServer).UnregisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SnowflakeController/UnregisterNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeControllerServer).UnregisterNode(ctx, req.(*wrapperspb.UInt32Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SnowflakeController_AllocateIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(controller.AllocateIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SnowflakeControllerServer).AllocateIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.controller.v2.SnowflakeController/AllocateIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SnowflakeControllerServer).AllocateIDs(ctx, req.(*controller.AllocateIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SnowflakeController_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.controller.v2.SnowflakeController",
+	HandlerType: (*SnowflakeControllerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClusterStartTime",
+			Handler:    _SnowflakeController_GetClusterStartTime_Handler,
+		},
+		{
+			MethodName: "RegisterNode",
+			Handler:    _SnowflakeController_RegisterNode_Handler,
+		},
+		{
+			MethodName: "UnregisterNode",
+			Handler:    _SnowflakeController_UnregisterNode_Handler,
+		},
+		{
+			MethodName: "AllocateIDs",
+			Handler:    _SnowflakeController_AllocateIDs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/controller.proto",
+}
@@ -0,0 +1,3561 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: controller.pb.go
+
+// Package controller is a generated GoMock package.
+package controller
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	controller "github.com/linkall-labs/vanus/proto/pkg/controller"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// MockPingServerClient is a mock of PingServerClient interface.
+type MockPingServerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServerClientMockRecorder
+}
+
+// MockPingServerClientMockRecorder is the mock recorder for MockPingServerClient.
+type MockPingServerClientMockRecorder struct {
+	mock *MockPingServerClient
+}
+
+// NewMockPingServerClient creates a new mock instance.
+func NewMockPingServerClient(ctrl *gomock.Controller) *MockPingServerClient {
+	mock := &MockPingServerClient{ctrl: ctrl}
+	mock.recorder = &MockPingServerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServerClient) EXPECT() *MockPingServerClientMockRecorder {
+	return m.recorder
+}
+
+// Ping mocks base method.
+func (m *MockPingServerClient) Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.PingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Ping", varargs...)
+	ret0, _ := ret[0].(*controller.PingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockPingServerClientMockRecorder) Ping(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPingServerClient)(nil).Ping), varargs...)
+}
+
+// MockPingServerServer is a mock of PingServerServer interface.
+type MockPingServerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPingServerServerMockRecorder
+}
+
+// MockPingServerServerMockRecorder is the mock recorder for MockPingServerServer.
+type MockPingServerServerMockRecorder struct {
+	mock *MockPingServerServer
+}
+
+// NewMockPingServerServer creates a new mock instance.
+func NewMockPingServerServer(ctrl *gomock.Controller) *MockPingServerServer {
+	mock := &MockPingServerServer{ctrl: ctrl}
+	mock.recorder = &MockPingServerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPingServerServer) EXPECT() *MockPingServerServerMockRecorder {
+	return m.recorder
+}
+
+// Ping mocks base method.
+func (m *MockPingServerServer) Ping(arg0 context.Context, arg1 *emptypb.Empty) (*controller.PingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0, arg1)
+	ret0, _ := ret[0].(*controller.PingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockPingServerServerMockRecorder) Ping(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockPingServerServer)(nil).Ping), arg0, arg1)
+}
+
+// MockEventBusControllerClient is a mock of EventBusControllerClient interface.
+type MockEventBusControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBusControllerClientMockRecorder
+}
+
+// MockEventBusControllerClientMockRecorder is the mock recorder for MockEventBusControllerClient.
+type MockEventBusControllerClientMockRecorder struct {
+	mock *MockEventBusControllerClient
+}
+
+// NewMockEventBusControllerClient creates a new mock instance.
+func NewMockEventBusControllerClient(ctrl *gomock.Controller) *MockEventBusControllerClient {
+	mock := &MockEventBusControllerClient{ctrl: ctrl}
+	mock.recorder = &MockEventBusControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBusControllerClient) EXPECT() *MockEventBusControllerClientMockRecorder {
+	return m.recorder
+}
+
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) CommitConsumerGroupOffset(ctx context.Context, in *controller.CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) CommitConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).CommitConsumerGroupOffset), varargs...)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockEventBusControllerClient) ConsumerGroupHeartbeat(ctx context.Context, in *controller.ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*controller.ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", varargs...)
+	ret0, _ := ret[0].(*controller.ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockEventBusControllerClientMockRecorder) ConsumerGroupHeartbeat(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockEventBusControllerClient)(nil).ConsumerGroupHeartbeat), varargs...)
+}
+
+// CreateCronEvent mocks base method.
+func (m *MockEventBusControllerClient) CreateCronEvent(ctx context.Context, in *controller.CreateCronEventRequest, opts ...grpc.CallOption) (*controller.CronEvent, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCronEvent", varargs...)
+	ret0, _ := ret[0].(*controller.CronEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCronEvent indicates an expected call of CreateCronEvent.
+func (mr *MockEventBusControllerClientMockRecorder) CreateCronEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCronEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateCronEvent), varargs...)
+}
+
+// CreateEventBus mocks base method.
+func (m *MockEventBusControllerClient) CreateEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventBus indicates an expected call of CreateEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) CreateEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateEventBus), varargs...)
+}
+
+// CreateHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) CreateHTTPPoller(ctx context.Context, in *controller.CreateHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*controller.HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHTTPPoller indicates an expected call of CreateHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) CreateHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateHTTPPoller), varargs...)
+}
+
+// CreateReplayJob mocks base method.
+func (m *MockEventBusControllerClient) CreateReplayJob(ctx context.Context, in *controller.CreateReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateReplayJob", varargs...)
+	ret0, _ := ret[0].(*controller.ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReplayJob indicates an expected call of CreateReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) CreateReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateReplayJob), varargs...)
+}
+
+// CreateSystemEventBus mocks base method.
+func (m *MockEventBusControllerClient) CreateSystemEventBus(ctx context.Context, in *controller.CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSystemEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSystemEventBus indicates an expected call of CreateSystemEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) CreateSystemEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystemEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateSystemEventBus), varargs...)
+}
+
+// DeleteCronEvent mocks base method.
+func (m *MockEventBusControllerClient) DeleteCronEvent(ctx context.Context, in *controller.DeleteCronEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCronEvent", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCronEvent indicates an expected call of DeleteCronEvent.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteCronEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCronEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteCronEvent), varargs...)
+}
+
+// DeleteEventBus mocks base method.
+func (m *MockEventBusControllerClient) DeleteEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEventBus", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventBus indicates an expected call of DeleteEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventBus), varargs...)
+}
+
+// DeleteEventbusACL mocks base method.
+func (m *MockEventBusControllerClient) DeleteEventbusACL(ctx context.Context, in *controller.DeleteEventbusACLRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEventbusACL", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventbusACL indicates an expected call of DeleteEventbusACL.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteEventbusACL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbusACL", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventbusACL), varargs...)
+}
+
+// DeleteFeatureFlag mocks base method.
+func (m *MockEventBusControllerClient) DeleteFeatureFlag(ctx context.Context, in *controller.DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFeatureFlag", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFeatureFlag indicates an expected call of DeleteFeatureFlag.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteFeatureFlag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatureFlag", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteFeatureFlag), varargs...)
+}
+
+// DeleteHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) DeleteHTTPPoller(ctx context.Context, in *controller.DeleteHTTPPollerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHTTPPoller indicates an expected call of DeleteHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteHTTPPoller), varargs...)
+}
+
+// DeleteIPAllowlist mocks base method.
+func (m *MockEventBusControllerClient) DeleteIPAllowlist(ctx context.Context, in *controller.DeleteIPAllowlistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteIPAllowlist", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIPAllowlist indicates an expected call of DeleteIPAllowlist.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteIPAllowlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPAllowlist", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteIPAllowlist), varargs...)
+}
+
+// DeleteReplayJob mocks base method.
+func (m *MockEventBusControllerClient) DeleteReplayJob(ctx context.Context, in *controller.DeleteReplayJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReplayJob", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReplayJob indicates an expected call of DeleteReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteReplayJob), varargs...)
+}
+
+// GetClusterStats mocks base method.
+func (m *MockEventBusControllerClient) GetClusterStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ClusterStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterStats", varargs...)
+	ret0, _ := ret[0].(*controller.ClusterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterStats indicates an expected call of GetClusterStats.
+func (mr *MockEventBusControllerClientMockRecorder) GetClusterStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStats", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetClusterStats), varargs...)
+}
+
+// GetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) GetConsumerGroupOffset(ctx context.Context, in *controller.GetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*controller.GetConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*controller.GetConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroupOffset indicates an expected call of GetConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) GetConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetConsumerGroupOffset), varargs...)
+}
+
+// GetEventBus mocks base method.
+func (m *MockEventBusControllerClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventBus indicates an expected call of GetEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) GetEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetEventBus), varargs...)
+}
+
+// GetReplayJob mocks base method.
+func (m *MockEventBusControllerClient) GetReplayJob(ctx context.Context, in *controller.GetReplayJobRequest, opts ...grpc.CallOption) (*controller.ReplayJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplayJob", varargs...)
+	ret0, _ := ret[0].(*controller.ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplayJob indicates an expected call of GetReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) GetReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetReplayJob), varargs...)
+}
+
+// LeaveConsumerGroup mocks base method.
+func (m *MockEventBusControllerClient) LeaveConsumerGroup(ctx context.Context, in *controller.LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockEventBusControllerClientMockRecorder) LeaveConsumerGroup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockEventBusControllerClient)(nil).LeaveConsumerGroup), varargs...)
+}
+
+// ListCronEvent mocks base method.
+func (m *MockEventBusControllerClient) ListCronEvent(ctx context.Context, in *controller.ListCronEventRequest, opts ...grpc.CallOption) (*controller.ListCronEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCronEvent", varargs...)
+	ret0, _ := ret[0].(*controller.ListCronEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCronEvent indicates an expected call of ListCronEvent.
+func (mr *MockEventBusControllerClientMockRecorder) ListCronEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCronEvent", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListCronEvent), varargs...)
+}
+
+// ListEventBus mocks base method.
+func (m *MockEventBusControllerClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListEventbusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventBus", varargs...)
+	ret0, _ := ret[0].(*controller.ListEventbusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventBus indicates an expected call of ListEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) ListEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventBus), varargs...)
+}
+
+// ListEventbusACL mocks base method.
+func (m *MockEventBusControllerClient) ListEventbusACL(ctx context.Context, in *controller.ListEventbusACLRequest, opts ...grpc.CallOption) (*controller.ListEventbusACLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventbusACL", varargs...)
+	ret0, _ := ret[0].(*controller.ListEventbusACLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusACL indicates an expected call of ListEventbusACL.
+func (mr *MockEventBusControllerClientMockRecorder) ListEventbusACL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusACL", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventbusACL), varargs...)
+}
+
+// ListFeatureFlag mocks base method.
+func (m *MockEventBusControllerClient) ListFeatureFlag(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListFeatureFlagResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFeatureFlag", varargs...)
+	ret0, _ := ret[0].(*controller.ListFeatureFlagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureFlag indicates an expected call of ListFeatureFlag.
+func (mr *MockEventBusControllerClientMockRecorder) ListFeatureFlag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureFlag", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListFeatureFlag), varargs...)
+}
+
+// ListHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) ListHTTPPoller(ctx context.Context, in *controller.ListHTTPPollerRequest, opts ...grpc.CallOption) (*controller.ListHTTPPollerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*controller.ListHTTPPollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHTTPPoller indicates an expected call of ListHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) ListHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListHTTPPoller), varargs...)
+}
+
+// ListIPAllowlist mocks base method.
+func (m *MockEventBusControllerClient) ListIPAllowlist(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListIPAllowlistResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIPAllowlist", varargs...)
+	ret0, _ := ret[0].(*controller.ListIPAllowlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPAllowlist indicates an expected call of ListIPAllowlist.
+func (mr *MockEventBusControllerClientMockRecorder) ListIPAllowlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPAllowlist", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListIPAllowlist), varargs...)
+}
+
+// ListReplayJob mocks base method.
+func (m *MockEventBusControllerClient) ListReplayJob(ctx context.Context, in *controller.ListReplayJobRequest, opts ...grpc.CallOption) (*controller.ListReplayJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReplayJob", varargs...)
+	ret0, _ := ret[0].(*controller.ListReplayJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReplayJob indicates an expected call of ListReplayJob.
+func (mr *MockEventBusControllerClientMockRecorder) ListReplayJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplayJob", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListReplayJob), varargs...)
+}
+
+// ListTimerReplica mocks base method.
+func (m *MockEventBusControllerClient) ListTimerReplica(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTimerReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTimerReplica", varargs...)
+	ret0, _ := ret[0].(*controller.ListTimerReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimerReplica indicates an expected call of ListTimerReplica.
+func (mr *MockEventBusControllerClientMockRecorder) ListTimerReplica(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimerReplica", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListTimerReplica), varargs...)
+}
+
+// PauseHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) PauseHTTPPoller(ctx context.Context, in *controller.PauseHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*controller.HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseHTTPPoller indicates an expected call of PauseHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) PauseHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).PauseHTTPPoller), varargs...)
+}
+
+// PutEventbusACL mocks base method.
+func (m *MockEventBusControllerClient) PutEventbusACL(ctx context.Context, in *controller.EventbusACL, opts ...grpc.CallOption) (*controller.EventbusACL, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutEventbusACL", varargs...)
+	ret0, _ := ret[0].(*controller.EventbusACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEventbusACL indicates an expected call of PutEventbusACL.
+func (mr *MockEventBusControllerClientMockRecorder) PutEventbusACL(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEventbusACL", reflect.TypeOf((*MockEventBusControllerClient)(nil).PutEventbusACL), varargs...)
+}
+
+// PutFeatureFlag mocks base method.
+func (m *MockEventBusControllerClient) PutFeatureFlag(ctx context.Context, in *controller.FeatureFlag, opts ...grpc.CallOption) (*controller.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutFeatureFlag", varargs...)
+	ret0, _ := ret[0].(*controller.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutFeatureFlag indicates an expected call of PutFeatureFlag.
+func (mr *MockEventBusControllerClientMockRecorder) PutFeatureFlag(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFeatureFlag", reflect.TypeOf((*MockEventBusControllerClient)(nil).PutFeatureFlag), varargs...)
+}
+
+// PutIPAllowlist mocks base method.
+func (m *MockEventBusControllerClient) PutIPAllowlist(ctx context.Context, in *controller.IPAllowlist, opts ...grpc.CallOption) (*controller.IPAllowlist, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutIPAllowlist", varargs...)
+	ret0, _ := ret[0].(*controller.IPAllowlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutIPAllowlist indicates an expected call of PutIPAllowlist.
+func (mr *MockEventBusControllerClientMockRecorder) PutIPAllowlist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutIPAllowlist", reflect.TypeOf((*MockEventBusControllerClient)(nil).PutIPAllowlist), varargs...)
+}
+
+// ResumeHTTPPoller mocks base method.
+func (m *MockEventBusControllerClient) ResumeHTTPPoller(ctx context.Context, in *controller.ResumeHTTPPollerRequest, opts ...grpc.CallOption) (*controller.HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeHTTPPoller", varargs...)
+	ret0, _ := ret[0].(*controller.HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeHTTPPoller indicates an expected call of ResumeHTTPPoller.
+func (mr *MockEventBusControllerClientMockRecorder) ResumeHTTPPoller(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeHTTPPoller", reflect.TypeOf((*MockEventBusControllerClient)(nil).ResumeHTTPPoller), varargs...)
+}
+
+// SetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerClient) SetConsumerGroupOffset(ctx context.Context, in *controller.SetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConsumerGroupOffset indicates an expected call of SetConsumerGroupOffset.
+func (mr *MockEventBusControllerClientMockRecorder) SetConsumerGroupOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerClient)(nil).SetConsumerGroupOffset), varargs...)
+}
+
+// UpdateEventBus mocks base method.
+func (m *MockEventBusControllerClient) UpdateEventBus(ctx context.Context, in *controller.UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventBus indicates an expected call of UpdateEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) UpdateEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).UpdateEventBus), varargs...)
+}
+
+// MockEventBusControllerServer is a mock of EventBusControllerServer interface.
+type MockEventBusControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventBusControllerServerMockRecorder
+}
+
+// MockEventBusControllerServerMockRecorder is the mock recorder for MockEventBusControllerServer.
+type MockEventBusControllerServerMockRecorder struct {
+	mock *MockEventBusControllerServer
+}
+
+// NewMockEventBusControllerServer creates a new mock instance.
+func NewMockEventBusControllerServer(ctrl *gomock.Controller) *MockEventBusControllerServer {
+	mock := &MockEventBusControllerServer{ctrl: ctrl}
+	mock.recorder = &MockEventBusControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventBusControllerServer) EXPECT() *MockEventBusControllerServerMockRecorder {
+	return m.recorder
+}
+
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) CommitConsumerGroupOffset(arg0 context.Context, arg1 *controller.CommitConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).CommitConsumerGroupOffset), arg0, arg1)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockEventBusControllerServer) ConsumerGroupHeartbeat(arg0 context.Context, arg1 *controller.ConsumerGroupHeartbeatRequest) (*controller.ConsumerGroupAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ConsumerGroupAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockEventBusControllerServerMockRecorder) ConsumerGroupHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockEventBusControllerServer)(nil).ConsumerGroupHeartbeat), arg0, arg1)
+}
+
+// CreateCronEvent mocks base method.
+func (m *MockEventBusControllerServer) CreateCronEvent(arg0 context.Context, arg1 *controller.CreateCronEventRequest) (*controller.CronEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCronEvent", arg0, arg1)
+	ret0, _ := ret[0].(*controller.CronEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCronEvent indicates an expected call of CreateCronEvent.
+func (mr *MockEventBusControllerServerMockRecorder) CreateCronEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCronEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateCronEvent), arg0, arg1)
+}
+
+// CreateEventBus mocks base method.
+func (m *MockEventBusControllerServer) CreateEventBus(arg0 context.Context, arg1 *controller.CreateEventBusRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventBus indicates an expected call of CreateEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) CreateEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateEventBus), arg0, arg1)
+}
+
+// CreateHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) CreateHTTPPoller(arg0 context.Context, arg1 *controller.CreateHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*controller.HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHTTPPoller indicates an expected call of CreateHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) CreateHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateHTTPPoller), arg0, arg1)
+}
+
+// CreateReplayJob mocks base method.
+func (m *MockEventBusControllerServer) CreateReplayJob(arg0 context.Context, arg1 *controller.CreateReplayJobRequest) (*controller.ReplayJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReplayJob indicates an expected call of CreateReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) CreateReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateReplayJob), arg0, arg1)
+}
+
+// CreateSystemEventBus mocks base method.
+func (m *MockEventBusControllerServer) CreateSystemEventBus(arg0 context.Context, arg1 *controller.CreateEventBusRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSystemEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSystemEventBus indicates an expected call of CreateSystemEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) CreateSystemEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSystemEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateSystemEventBus), arg0, arg1)
+}
+
+// DeleteCronEvent mocks base method.
+func (m *MockEventBusControllerServer) DeleteCronEvent(arg0 context.Context, arg1 *controller.DeleteCronEventRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCronEvent", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCronEvent indicates an expected call of DeleteCronEvent.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteCronEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCronEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteCronEvent), arg0, arg1)
+}
+
+// DeleteEventBus mocks base method.
+func (m *MockEventBusControllerServer) DeleteEventBus(arg0 context.Context, arg1 *meta.EventBus) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventBus indicates an expected call of DeleteEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventBus), arg0, arg1)
+}
+
+// DeleteEventbusACL mocks base method.
+func (m *MockEventBusControllerServer) DeleteEventbusACL(arg0 context.Context, arg1 *controller.DeleteEventbusACLRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventbusACL", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventbusACL indicates an expected call of DeleteEventbusACL.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteEventbusACL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbusACL", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventbusACL), arg0, arg1)
+}
+
+// DeleteFeatureFlag mocks base method.
+func (m *MockEventBusControllerServer) DeleteFeatureFlag(arg0 context.Context, arg1 *controller.DeleteFeatureFlagRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFeatureFlag indicates an expected call of DeleteFeatureFlag.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatureFlag", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteFeatureFlag), arg0, arg1)
+}
+
+// DeleteHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) DeleteHTTPPoller(arg0 context.Context, arg1 *controller.DeleteHTTPPollerRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHTTPPoller indicates an expected call of DeleteHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteHTTPPoller), arg0, arg1)
+}
+
+// DeleteIPAllowlist mocks base method.
+func (m *MockEventBusControllerServer) DeleteIPAllowlist(arg0 context.Context, arg1 *controller.DeleteIPAllowlistRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIPAllowlist indicates an expected call of DeleteIPAllowlist.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteIPAllowlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPAllowlist", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteIPAllowlist), arg0, arg1)
+}
+
+// DeleteReplayJob mocks base method.
+func (m *MockEventBusControllerServer) DeleteReplayJob(arg0 context.Context, arg1 *controller.DeleteReplayJobRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReplayJob indicates an expected call of DeleteReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteReplayJob), arg0, arg1)
+}
+
+// GetClusterStats mocks base method.
+func (m *MockEventBusControllerServer) GetClusterStats(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ClusterStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStats", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ClusterStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterStats indicates an expected call of GetClusterStats.
+func (mr *MockEventBusControllerServerMockRecorder) GetClusterStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStats", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetClusterStats), arg0, arg1)
+}
+
+// GetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) GetConsumerGroupOffset(arg0 context.Context, arg1 *controller.GetConsumerGroupOffsetRequest) (*controller.GetConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*controller.GetConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroupOffset indicates an expected call of GetConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) GetConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetConsumerGroupOffset), arg0, arg1)
+}
+
+// GetEventBus mocks base method.
+func (m *MockEventBusControllerServer) GetEventBus(arg0 context.Context, arg1 *meta.EventBus) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventBus indicates an expected call of GetEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) GetEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetEventBus), arg0, arg1)
+}
+
+// GetReplayJob mocks base method.
+func (m *MockEventBusControllerServer) GetReplayJob(arg0 context.Context, arg1 *controller.GetReplayJobRequest) (*controller.ReplayJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ReplayJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplayJob indicates an expected call of GetReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) GetReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetReplayJob), arg0, arg1)
+}
+
+// LeaveConsumerGroup mocks base method.
+func (m *MockEventBusControllerServer) LeaveConsumerGroup(arg0 context.Context, arg1 *controller.LeaveConsumerGroupRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockEventBusControllerServerMockRecorder) LeaveConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockEventBusControllerServer)(nil).LeaveConsumerGroup), arg0, arg1)
+}
+
+// ListCronEvent mocks base method.
+func (m *MockEventBusControllerServer) ListCronEvent(arg0 context.Context, arg1 *controller.ListCronEventRequest) (*controller.ListCronEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCronEvent", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListCronEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCronEvent indicates an expected call of ListCronEvent.
+func (mr *MockEventBusControllerServerMockRecorder) ListCronEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCronEvent", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListCronEvent), arg0, arg1)
+}
+
+// ListEventBus mocks base method.
+func (m *MockEventBusControllerServer) ListEventBus(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListEventbusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListEventbusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventBus indicates an expected call of ListEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) ListEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventBus), arg0, arg1)
+}
+
+// ListEventbusACL mocks base method.
+func (m *MockEventBusControllerServer) ListEventbusACL(arg0 context.Context, arg1 *controller.ListEventbusACLRequest) (*controller.ListEventbusACLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventbusACL", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListEventbusACLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusACL indicates an expected call of ListEventbusACL.
+func (mr *MockEventBusControllerServerMockRecorder) ListEventbusACL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusACL", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventbusACL), arg0, arg1)
+}
+
+// ListFeatureFlag mocks base method.
+func (m *MockEventBusControllerServer) ListFeatureFlag(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListFeatureFlagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListFeatureFlagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureFlag indicates an expected call of ListFeatureFlag.
+func (mr *MockEventBusControllerServerMockRecorder) ListFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureFlag", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListFeatureFlag), arg0, arg1)
+}
+
+// ListHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) ListHTTPPoller(arg0 context.Context, arg1 *controller.ListHTTPPollerRequest) (*controller.ListHTTPPollerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListHTTPPollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHTTPPoller indicates an expected call of ListHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) ListHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListHTTPPoller), arg0, arg1)
+}
+
+// ListIPAllowlist mocks base method.
+func (m *MockEventBusControllerServer) ListIPAllowlist(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListIPAllowlistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIPAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListIPAllowlistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPAllowlist indicates an expected call of ListIPAllowlist.
+func (mr *MockEventBusControllerServerMockRecorder) ListIPAllowlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPAllowlist", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListIPAllowlist), arg0, arg1)
+}
+
+// ListReplayJob mocks base method.
+func (m *MockEventBusControllerServer) ListReplayJob(arg0 context.Context, arg1 *controller.ListReplayJobRequest) (*controller.ListReplayJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListReplayJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReplayJob indicates an expected call of ListReplayJob.
+func (mr *MockEventBusControllerServerMockRecorder) ListReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReplayJob", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListReplayJob), arg0, arg1)
+}
+
+// ListTimerReplica mocks base method.
+func (m *MockEventBusControllerServer) ListTimerReplica(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListTimerReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimerReplica", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListTimerReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimerReplica indicates an expected call of ListTimerReplica.
+func (mr *MockEventBusControllerServerMockRecorder) ListTimerReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimerReplica", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListTimerReplica), arg0, arg1)
+}
+
+// PauseHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) PauseHTTPPoller(arg0 context.Context, arg1 *controller.PauseHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*controller.HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseHTTPPoller indicates an expected call of PauseHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) PauseHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).PauseHTTPPoller), arg0, arg1)
+}
+
+// PutEventbusACL mocks base method.
+func (m *MockEventBusControllerServer) PutEventbusACL(arg0 context.Context, arg1 *controller.EventbusACL) (*controller.EventbusACL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutEventbusACL", arg0, arg1)
+	ret0, _ := ret[0].(*controller.EventbusACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutEventbusACL indicates an expected call of PutEventbusACL.
+func (mr *MockEventBusControllerServerMockRecorder) PutEventbusACL(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEventbusACL", reflect.TypeOf((*MockEventBusControllerServer)(nil).PutEventbusACL), arg0, arg1)
+}
+
+// PutFeatureFlag mocks base method.
+func (m *MockEventBusControllerServer) PutFeatureFlag(arg0 context.Context, arg1 *controller.FeatureFlag) (*controller.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(*controller.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutFeatureFlag indicates an expected call of PutFeatureFlag.
+func (mr *MockEventBusControllerServerMockRecorder) PutFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFeatureFlag", reflect.TypeOf((*MockEventBusControllerServer)(nil).PutFeatureFlag), arg0, arg1)
+}
+
+// PutIPAllowlist mocks base method.
+func (m *MockEventBusControllerServer) PutIPAllowlist(arg0 context.Context, arg1 *controller.IPAllowlist) (*controller.IPAllowlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutIPAllowlist", arg0, arg1)
+	ret0, _ := ret[0].(*controller.IPAllowlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutIPAllowlist indicates an expected call of PutIPAllowlist.
+func (mr *MockEventBusControllerServerMockRecorder) PutIPAllowlist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutIPAllowlist", reflect.TypeOf((*MockEventBusControllerServer)(nil).PutIPAllowlist), arg0, arg1)
+}
+
+// ResumeHTTPPoller mocks base method.
+func (m *MockEventBusControllerServer) ResumeHTTPPoller(arg0 context.Context, arg1 *controller.ResumeHTTPPollerRequest) (*controller.HTTPPoller, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeHTTPPoller", arg0, arg1)
+	ret0, _ := ret[0].(*controller.HTTPPoller)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeHTTPPoller indicates an expected call of ResumeHTTPPoller.
+func (mr *MockEventBusControllerServerMockRecorder) ResumeHTTPPoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeHTTPPoller", reflect.TypeOf((*MockEventBusControllerServer)(nil).ResumeHTTPPoller), arg0, arg1)
+}
+
+// SetConsumerGroupOffset mocks base method.
+func (m *MockEventBusControllerServer) SetConsumerGroupOffset(arg0 context.Context, arg1 *controller.SetConsumerGroupOffsetRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConsumerGroupOffset indicates an expected call of SetConsumerGroupOffset.
+func (mr *MockEventBusControllerServerMockRecorder) SetConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConsumerGroupOffset", reflect.TypeOf((*MockEventBusControllerServer)(nil).SetConsumerGroupOffset), arg0, arg1)
+}
+
+// UpdateEventBus mocks base method.
+func (m *MockEventBusControllerServer) UpdateEventBus(arg0 context.Context, arg1 *controller.UpdateEventBusRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEventBus indicates an expected call of UpdateEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) UpdateEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).UpdateEventBus), arg0, arg1)
+}
+
+// MockEventLogControllerClient is a mock of EventLogControllerClient interface.
+type MockEventLogControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogControllerClientMockRecorder
+}
+
+// MockEventLogControllerClientMockRecorder is the mock recorder for MockEventLogControllerClient.
+type MockEventLogControllerClientMockRecorder struct {
+	mock *MockEventLogControllerClient
+}
+
+// NewMockEventLogControllerClient creates a new mock instance.
+func NewMockEventLogControllerClient(ctrl *gomock.Controller) *MockEventLogControllerClient {
+	mock := &MockEventLogControllerClient{ctrl: ctrl}
+	mock.recorder = &MockEventLogControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventLogControllerClient) EXPECT() *MockEventLogControllerClientMockRecorder {
+	return m.recorder
+}
+
+// GetAppendableSegment mocks base method.
+func (m *MockEventLogControllerClient) GetAppendableSegment(ctx context.Context, in *controller.GetAppendableSegmentRequest, opts ...grpc.CallOption) (*controller.GetAppendableSegmentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAppendableSegment", varargs...)
+	ret0, _ := ret[0].(*controller.GetAppendableSegmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppendableSegment indicates an expected call of GetAppendableSegment.
+func (mr *MockEventLogControllerClientMockRecorder) GetAppendableSegment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppendableSegment", reflect.TypeOf((*MockEventLogControllerClient)(nil).GetAppendableSegment), varargs...)
+}
+
+// ListSegment mocks base method.
+func (m *MockEventLogControllerClient) ListSegment(ctx context.Context, in *controller.ListSegmentRequest, opts ...grpc.CallOption) (*controller.ListSegmentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSegment", varargs...)
+	ret0, _ := ret[0].(*controller.ListSegmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSegment indicates an expected call of ListSegment.
+func (mr *MockEventLogControllerClientMockRecorder) ListSegment(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegment", reflect.TypeOf((*MockEventLogControllerClient)(nil).ListSegment), varargs...)
+}
+
+// MockEventLogControllerServer is a mock of EventLogControllerServer interface.
+type MockEventLogControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogControllerServerMockRecorder
+}
+
+// MockEventLogControllerServerMockRecorder is the mock recorder for MockEventLogControllerServer.
+type MockEventLogControllerServerMockRecorder struct {
+	mock *MockEventLogControllerServer
+}
+
+// NewMockEventLogControllerServer creates a new mock instance.
+func NewMockEventLogControllerServer(ctrl *gomock.Controller) *MockEventLogControllerServer {
+	mock := &MockEventLogControllerServer{ctrl: ctrl}
+	mock.recorder = &MockEventLogControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventLogControllerServer) EXPECT() *MockEventLogControllerServerMockRecorder {
+	return m.recorder
+}
+
+// GetAppendableSegment mocks base method.
+func (m *MockEventLogControllerServer) GetAppendableSegment(arg0 context.Context, arg1 *controller.GetAppendableSegmentRequest) (*controller.GetAppendableSegmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppendableSegment", arg0, arg1)
+	ret0, _ := ret[0].(*controller.GetAppendableSegmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppendableSegment indicates an expected call of GetAppendableSegment.
+func (mr *MockEventLogControllerServerMockRecorder) GetAppendableSegment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppendableSegment", reflect.TypeOf((*MockEventLogControllerServer)(nil).GetAppendableSegment), arg0, arg1)
+}
+
+// ListSegment mocks base method.
+func (m *MockEventLogControllerServer) ListSegment(arg0 context.Context, arg1 *controller.ListSegmentRequest) (*controller.ListSegmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSegment", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListSegmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSegment indicates an expected call of ListSegment.
+func (mr *MockEventLogControllerServerMockRecorder) ListSegment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegment", reflect.TypeOf((*MockEventLogControllerServer)(nil).ListSegment), arg0, arg1)
+}
+
+// MockSegmentControllerClient is a mock of SegmentControllerClient interface.
+type MockSegmentControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentControllerClientMockRecorder
+}
+
+// MockSegmentControllerClientMockRecorder is the mock recorder for MockSegmentControllerClient.
+type MockSegmentControllerClientMockRecorder struct {
+	mock *MockSegmentControllerClient
+}
+
+// NewMockSegmentControllerClient creates a new mock instance.
+func NewMockSegmentControllerClient(ctrl *gomock.Controller) *MockSegmentControllerClient {
+	mock := &MockSegmentControllerClient{ctrl: ctrl}
+	mock.recorder = &MockSegmentControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentControllerClient) EXPECT() *MockSegmentControllerClientMockRecorder {
+	return m.recorder
+}
+
+// QuerySegmentRouteInfo mocks base method.
+func (m *MockSegmentControllerClient) QuerySegmentRouteInfo(ctx context.Context, in *controller.QuerySegmentRouteInfoRequest, opts ...grpc.CallOption) (*controller.QuerySegmentRouteInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QuerySegmentRouteInfo", varargs...)
+	ret0, _ := ret[0].(*controller.QuerySegmentRouteInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySegmentRouteInfo indicates an expected call of QuerySegmentRouteInfo.
+func (mr *MockSegmentControllerClientMockRecorder) QuerySegmentRouteInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySegmentRouteInfo", reflect.TypeOf((*MockSegmentControllerClient)(nil).QuerySegmentRouteInfo), varargs...)
+}
+
+// RegisterSegmentServer mocks base method.
+func (m *MockSegmentControllerClient) RegisterSegmentServer(ctx context.Context, in *controller.RegisterSegmentServerRequest, opts ...grpc.CallOption) (*controller.RegisterSegmentServerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterSegmentServer", varargs...)
+	ret0, _ := ret[0].(*controller.RegisterSegmentServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSegmentServer indicates an expected call of RegisterSegmentServer.
+func (mr *MockSegmentControllerClientMockRecorder) RegisterSegmentServer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSegmentServer", reflect.TypeOf((*MockSegmentControllerClient)(nil).RegisterSegmentServer), varargs...)
+}
+
+// ReportSegmentBlockIsFull mocks base method.
+func (m *MockSegmentControllerClient) ReportSegmentBlockIsFull(ctx context.Context, in *controller.SegmentHeartbeatRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportSegmentBlockIsFull", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSegmentBlockIsFull indicates an expected call of ReportSegmentBlockIsFull.
+func (mr *MockSegmentControllerClientMockRecorder) ReportSegmentBlockIsFull(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSegmentBlockIsFull", reflect.TypeOf((*MockSegmentControllerClient)(nil).ReportSegmentBlockIsFull), varargs...)
+}
+
+// ReportSegmentLeader mocks base method.
+func (m *MockSegmentControllerClient) ReportSegmentLeader(ctx context.Context, in *controller.ReportSegmentLeaderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportSegmentLeader", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSegmentLeader indicates an expected call of ReportSegmentLeader.
+func (mr *MockSegmentControllerClientMockRecorder) ReportSegmentLeader(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSegmentLeader", reflect.TypeOf((*MockSegmentControllerClient)(nil).ReportSegmentLeader), varargs...)
+}
+
+// SegmentHeartbeat mocks base method.
+func (m *MockSegmentControllerClient) SegmentHeartbeat(ctx context.Context, opts ...grpc.CallOption) (SegmentController_SegmentHeartbeatClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SegmentHeartbeat", varargs...)
+	ret0, _ := ret[0].(SegmentController_SegmentHeartbeatClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SegmentHeartbeat indicates an expected call of SegmentHeartbeat.
+func (mr *MockSegmentControllerClientMockRecorder) SegmentHeartbeat(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SegmentHeartbeat", reflect.TypeOf((*MockSegmentControllerClient)(nil).SegmentHeartbeat), varargs...)
+}
+
+// UnregisterSegmentServer mocks base method.
+func (m *MockSegmentControllerClient) UnregisterSegmentServer(ctx context.Context, in *controller.UnregisterSegmentServerRequest, opts ...grpc.CallOption) (*controller.UnregisterSegmentServerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnregisterSegmentServer", varargs...)
+	ret0, _ := ret[0].(*controller.UnregisterSegmentServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterSegmentServer indicates an expected call of UnregisterSegmentServer.
+func (mr *MockSegmentControllerClientMockRecorder) UnregisterSegmentServer(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterSegmentServer", reflect.TypeOf((*MockSegmentControllerClient)(nil).UnregisterSegmentServer), varargs...)
+}
+
+// MockSegmentController_SegmentHeartbeatClient is a mock of SegmentController_SegmentHeartbeatClient interface.
+type MockSegmentController_SegmentHeartbeatClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentController_SegmentHeartbeatClientMockRecorder
+}
+
+// MockSegmentController_SegmentHeartbeatClientMockRecorder is the mock recorder for MockSegmentController_SegmentHeartbeatClient.
+type MockSegmentController_SegmentHeartbeatClientMockRecorder struct {
+	mock *MockSegmentController_SegmentHeartbeatClient
+}
+
+// NewMockSegmentController_SegmentHeartbeatClient creates a new mock instance.
+func NewMockSegmentController_SegmentHeartbeatClient(ctrl *gomock.Controller) *MockSegmentController_SegmentHeartbeatClient {
+	mock := &MockSegmentController_SegmentHeartbeatClient{ctrl: ctrl}
+	mock.recorder = &MockSegmentController_SegmentHeartbeatClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentController_SegmentHeartbeatClient) EXPECT() *MockSegmentController_SegmentHeartbeatClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatClient) CloseAndRecv() (*controller.SegmentHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*controller.SegmentHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentController_SegmentHeartbeatClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatClient) Send(arg0 *controller.SegmentHeartbeatRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentController_SegmentHeartbeatClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockSegmentController_SegmentHeartbeatClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatClient)(nil).Trailer))
+}
+
+// MockSegmentControllerServer is a mock of SegmentControllerServer interface.
+type MockSegmentControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentControllerServerMockRecorder
+}
+
+// MockSegmentControllerServerMockRecorder is the mock recorder for MockSegmentControllerServer.
+type MockSegmentControllerServerMockRecorder struct {
+	mock *MockSegmentControllerServer
+}
+
+// NewMockSegmentControllerServer creates a new mock instance.
+func NewMockSegmentControllerServer(ctrl *gomock.Controller) *MockSegmentControllerServer {
+	mock := &MockSegmentControllerServer{ctrl: ctrl}
+	mock.recorder = &MockSegmentControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentControllerServer) EXPECT() *MockSegmentControllerServerMockRecorder {
+	return m.recorder
+}
+
+// QuerySegmentRouteInfo mocks base method.
+func (m *MockSegmentControllerServer) QuerySegmentRouteInfo(arg0 context.Context, arg1 *controller.QuerySegmentRouteInfoRequest) (*controller.QuerySegmentRouteInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySegmentRouteInfo", arg0, arg1)
+	ret0, _ := ret[0].(*controller.QuerySegmentRouteInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySegmentRouteInfo indicates an expected call of QuerySegmentRouteInfo.
+func (mr *MockSegmentControllerServerMockRecorder) QuerySegmentRouteInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySegmentRouteInfo", reflect.TypeOf((*MockSegmentControllerServer)(nil).QuerySegmentRouteInfo), arg0, arg1)
+}
+
+// RegisterSegmentServer mocks base method.
+func (m *MockSegmentControllerServer) RegisterSegmentServer(arg0 context.Context, arg1 *controller.RegisterSegmentServerRequest) (*controller.RegisterSegmentServerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSegmentServer", arg0, arg1)
+	ret0, _ := ret[0].(*controller.RegisterSegmentServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSegmentServer indicates an expected call of RegisterSegmentServer.
+func (mr *MockSegmentControllerServerMockRecorder) RegisterSegmentServer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSegmentServer", reflect.TypeOf((*MockSegmentControllerServer)(nil).RegisterSegmentServer), arg0, arg1)
+}
+
+// ReportSegmentBlockIsFull mocks base method.
+func (m *MockSegmentControllerServer) ReportSegmentBlockIsFull(arg0 context.Context, arg1 *controller.SegmentHeartbeatRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportSegmentBlockIsFull", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSegmentBlockIsFull indicates an expected call of ReportSegmentBlockIsFull.
+func (mr *MockSegmentControllerServerMockRecorder) ReportSegmentBlockIsFull(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSegmentBlockIsFull", reflect.TypeOf((*MockSegmentControllerServer)(nil).ReportSegmentBlockIsFull), arg0, arg1)
+}
+
+// ReportSegmentLeader mocks base method.
+func (m *MockSegmentControllerServer) ReportSegmentLeader(arg0 context.Context, arg1 *controller.ReportSegmentLeaderRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportSegmentLeader", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportSegmentLeader indicates an expected call of ReportSegmentLeader.
+func (mr *MockSegmentControllerServerMockRecorder) ReportSegmentLeader(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSegmentLeader", reflect.TypeOf((*MockSegmentControllerServer)(nil).ReportSegmentLeader), arg0, arg1)
+}
+
+// SegmentHeartbeat mocks base method.
+func (m *MockSegmentControllerServer) SegmentHeartbeat(arg0 SegmentController_SegmentHeartbeatServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SegmentHeartbeat", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SegmentHeartbeat indicates an expected call of SegmentHeartbeat.
+func (mr *MockSegmentControllerServerMockRecorder) SegmentHeartbeat(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SegmentHeartbeat", reflect.TypeOf((*MockSegmentControllerServer)(nil).SegmentHeartbeat), arg0)
+}
+
+// UnregisterSegmentServer mocks base method.
+func (m *MockSegmentControllerServer) UnregisterSegmentServer(arg0 context.Context, arg1 *controller.UnregisterSegmentServerRequest) (*controller.UnregisterSegmentServerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterSegmentServer", arg0, arg1)
+	ret0, _ := ret[0].(*controller.UnregisterSegmentServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterSegmentServer indicates an expected call of UnregisterSegmentServer.
+func (mr *MockSegmentControllerServerMockRecorder) UnregisterSegmentServer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterSegmentServer", reflect.TypeOf((*MockSegmentControllerServer)(nil).UnregisterSegmentServer), arg0, arg1)
+}
+
+// MockSegmentController_SegmentHeartbeatServer is a mock of SegmentController_SegmentHeartbeatServer interface.
+type MockSegmentController_SegmentHeartbeatServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentController_SegmentHeartbeatServerMockRecorder
+}
+
+// MockSegmentController_SegmentHeartbeatServerMockRecorder is the mock recorder for MockSegmentController_SegmentHeartbeatServer.
+type MockSegmentController_SegmentHeartbeatServerMockRecorder struct {
+	mock *MockSegmentController_SegmentHeartbeatServer
+}
+
+// NewMockSegmentController_SegmentHeartbeatServer creates a new mock instance.
+func NewMockSegmentController_SegmentHeartbeatServer(ctrl *gomock.Controller) *MockSegmentController_SegmentHeartbeatServer {
+	mock := &MockSegmentController_SegmentHeartbeatServer{ctrl: ctrl}
+	mock.recorder = &MockSegmentController_SegmentHeartbeatServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentController_SegmentHeartbeatServer) EXPECT() *MockSegmentController_SegmentHeartbeatServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatServer) Recv() (*controller.SegmentHeartbeatRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*controller.SegmentHeartbeatRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentController_SegmentHeartbeatServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatServer) SendAndClose(arg0 *controller.SegmentHeartbeatResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentController_SegmentHeartbeatServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockSegmentController_SegmentHeartbeatServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockSegmentController_SegmentHeartbeatServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSegmentController_SegmentHeartbeatServer)(nil).SetTrailer), arg0)
+}
+
+// MockTriggerControllerClient is a mock of TriggerControllerClient interface.
+type MockTriggerControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerControllerClientMockRecorder
+}
+
+// MockTriggerControllerClientMockRecorder is the mock recorder for MockTriggerControllerClient.
+type MockTriggerControllerClientMockRecorder struct {
+	mock *MockTriggerControllerClient
+}
+
+// NewMockTriggerControllerClient creates a new mock instance.
+func NewMockTriggerControllerClient(ctrl *gomock.Controller) *MockTriggerControllerClient {
+	mock := &MockTriggerControllerClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerControllerClient) EXPECT() *MockTriggerControllerClientMockRecorder {
+	return m.recorder
+}
+
+// CommitConnectorPosition mocks base method.
+func (m *MockTriggerControllerClient) CommitConnectorPosition(ctx context.Context, in *controller.CommitConnectorPositionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConnectorPosition", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConnectorPosition indicates an expected call of CommitConnectorPosition.
+func (mr *MockTriggerControllerClientMockRecorder) CommitConnectorPosition(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConnectorPosition", reflect.TypeOf((*MockTriggerControllerClient)(nil).CommitConnectorPosition), varargs...)
+}
+
+// CommitOffset mocks base method.
+func (m *MockTriggerControllerClient) CommitOffset(ctx context.Context, in *controller.CommitOffsetRequest, opts ...grpc.CallOption) (*controller.CommitOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitOffset", varargs...)
+	ret0, _ := ret[0].(*controller.CommitOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockTriggerControllerClientMockRecorder) CommitOffset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTriggerControllerClient)(nil).CommitOffset), varargs...)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockTriggerControllerClient) CreateSubscription(ctx context.Context, in *controller.CreateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) CreateSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).CreateSubscription), varargs...)
+}
+
+// DeleteConnector mocks base method.
+func (m *MockTriggerControllerClient) DeleteConnector(ctx context.Context, in *controller.DeleteConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConnector", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockTriggerControllerClientMockRecorder) DeleteConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteConnector), varargs...)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockTriggerControllerClient) DeleteSecret(ctx context.Context, in *controller.DeleteSecretRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSecret", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockTriggerControllerClientMockRecorder) DeleteSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteSecret), varargs...)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockTriggerControllerClient) DeleteSubscription(ctx context.Context, in *controller.DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSubscription", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) DeleteSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).DeleteSubscription), varargs...)
+}
+
+// ExportSubscription mocks base method.
+func (m *MockTriggerControllerClient) ExportSubscription(ctx context.Context, in *controller.ExportSubscriptionRequest, opts ...grpc.CallOption) (*controller.ExportSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportSubscription", varargs...)
+	ret0, _ := ret[0].(*controller.ExportSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSubscription indicates an expected call of ExportSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) ExportSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ExportSubscription), varargs...)
+}
+
+// GetConnector mocks base method.
+func (m *MockTriggerControllerClient) GetConnector(ctx context.Context, in *controller.GetConnectorRequest, opts ...grpc.CallOption) (*controller.Connector, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConnector", varargs...)
+	ret0, _ := ret[0].(*controller.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnector indicates an expected call of GetConnector.
+func (mr *MockTriggerControllerClientMockRecorder) GetConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetConnector), varargs...)
+}
+
+// GetSecret mocks base method.
+func (m *MockTriggerControllerClient) GetSecret(ctx context.Context, in *controller.GetSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecret", varargs...)
+	ret0, _ := ret[0].(*controller.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockTriggerControllerClientMockRecorder) GetSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSecret), varargs...)
+}
+
+// GetSubscription mocks base method.
+func (m *MockTriggerControllerClient) GetSubscription(ctx context.Context, in *controller.GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) GetSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscription), varargs...)
+}
+
+// ImportSubscription mocks base method.
+func (m *MockTriggerControllerClient) ImportSubscription(ctx context.Context, in *controller.ImportSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSubscription indicates an expected call of ImportSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) ImportSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ImportSubscription), varargs...)
+}
+
+// ListConnector mocks base method.
+func (m *MockTriggerControllerClient) ListConnector(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConnector", varargs...)
+	ret0, _ := ret[0].(*controller.ListConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnector indicates an expected call of ListConnector.
+func (mr *MockTriggerControllerClientMockRecorder) ListConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListConnector), varargs...)
+}
+
+// ListSecret mocks base method.
+func (m *MockTriggerControllerClient) ListSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecret", varargs...)
+	ret0, _ := ret[0].(*controller.ListSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecret indicates an expected call of ListSecret.
+func (mr *MockTriggerControllerClientMockRecorder) ListSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSecret), varargs...)
+}
+
+// ListSubscription mocks base method.
+func (m *MockTriggerControllerClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSubscription", varargs...)
+	ret0, _ := ret[0].(*controller.ListSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscription indicates an expected call of ListSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) ListSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSubscription), varargs...)
+}
+
+// ListTriggerWorker mocks base method.
+func (m *MockTriggerControllerClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*controller.ListTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTriggerWorker", varargs...)
+	ret0, _ := ret[0].(*controller.ListTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockTriggerControllerClientMockRecorder) ListTriggerWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListTriggerWorker), varargs...)
+}
+
+// ListWorkerConnector mocks base method.
+func (m *MockTriggerControllerClient) ListWorkerConnector(ctx context.Context, in *controller.ListWorkerConnectorRequest, opts ...grpc.CallOption) (*controller.ListWorkerConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkerConnector", varargs...)
+	ret0, _ := ret[0].(*controller.ListWorkerConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerConnector indicates an expected call of ListWorkerConnector.
+func (mr *MockTriggerControllerClientMockRecorder) ListWorkerConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListWorkerConnector), varargs...)
+}
+
+// PutConnector mocks base method.
+func (m *MockTriggerControllerClient) PutConnector(ctx context.Context, in *controller.Connector, opts ...grpc.CallOption) (*controller.Connector, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutConnector", varargs...)
+	ret0, _ := ret[0].(*controller.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConnector indicates an expected call of PutConnector.
+func (mr *MockTriggerControllerClientMockRecorder) PutConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConnector", reflect.TypeOf((*MockTriggerControllerClient)(nil).PutConnector), varargs...)
+}
+
+// PutSecret mocks base method.
+func (m *MockTriggerControllerClient) PutSecret(ctx context.Context, in *controller.PutSecretRequest, opts ...grpc.CallOption) (*controller.Secret, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutSecret", varargs...)
+	ret0, _ := ret[0].(*controller.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MockTriggerControllerClientMockRecorder) PutSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).PutSecret), varargs...)
+}
+
+// RegisterTriggerWorker mocks base method.
+func (m *MockTriggerControllerClient) RegisterTriggerWorker(ctx context.Context, in *controller.RegisterTriggerWorkerRequest, opts ...grpc.CallOption) (*controller.RegisterTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterTriggerWorker", varargs...)
+	ret0, _ := ret[0].(*controller.RegisterTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTriggerWorker indicates an expected call of RegisterTriggerWorker.
+func (mr *MockTriggerControllerClientMockRecorder) RegisterTriggerWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTriggerWorker", reflect.TypeOf((*MockTriggerControllerClient)(nil).RegisterTriggerWorker), varargs...)
+}
+
+// ResetOffsetToTimestamp mocks base method.
+func (m *MockTriggerControllerClient) ResetOffsetToTimestamp(ctx context.Context, in *ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetOffsetToTimestamp", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOffsetToTimestamp indicates an expected call of ResetOffsetToTimestamp.
+func (mr *MockTriggerControllerClientMockRecorder) ResetOffsetToTimestamp(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOffsetToTimestamp", reflect.TypeOf((*MockTriggerControllerClient)(nil).ResetOffsetToTimestamp), varargs...)
+}
+
+// TriggerWorkerHeartbeat mocks base method.
+func (m *MockTriggerControllerClient) TriggerWorkerHeartbeat(ctx context.Context, opts ...grpc.CallOption) (TriggerController_TriggerWorkerHeartbeatClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TriggerWorkerHeartbeat", varargs...)
+	ret0, _ := ret[0].(TriggerController_TriggerWorkerHeartbeatClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerWorkerHeartbeat indicates an expected call of TriggerWorkerHeartbeat.
+func (mr *MockTriggerControllerClientMockRecorder) TriggerWorkerHeartbeat(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerWorkerHeartbeat", reflect.TypeOf((*MockTriggerControllerClient)(nil).TriggerWorkerHeartbeat), varargs...)
+}
+
+// UnregisterTriggerWorker mocks base method.
+func (m *MockTriggerControllerClient) UnregisterTriggerWorker(ctx context.Context, in *controller.UnregisterTriggerWorkerRequest, opts ...grpc.CallOption) (*controller.UnregisterTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnregisterTriggerWorker", varargs...)
+	ret0, _ := ret[0].(*controller.UnregisterTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterTriggerWorker indicates an expected call of UnregisterTriggerWorker.
+func (mr *MockTriggerControllerClientMockRecorder) UnregisterTriggerWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterTriggerWorker", reflect.TypeOf((*MockTriggerControllerClient)(nil).UnregisterTriggerWorker), varargs...)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockTriggerControllerClient) UpdateSubscription(ctx context.Context, in *controller.UpdateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) UpdateSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).UpdateSubscription), varargs...)
+}
+
+// WatchSecret mocks base method.
+func (m *MockTriggerControllerClient) WatchSecret(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (TriggerController_WatchSecretClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchSecret", varargs...)
+	ret0, _ := ret[0].(TriggerController_WatchSecretClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSecret indicates an expected call of WatchSecret.
+func (mr *MockTriggerControllerClientMockRecorder) WatchSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSecret", reflect.TypeOf((*MockTriggerControllerClient)(nil).WatchSecret), varargs...)
+}
+
+// MockTriggerController_TriggerWorkerHeartbeatClient is a mock of TriggerController_TriggerWorkerHeartbeatClient interface.
+type MockTriggerController_TriggerWorkerHeartbeatClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder
+}
+
+// MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder is the mock recorder for MockTriggerController_TriggerWorkerHeartbeatClient.
+type MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder struct {
+	mock *MockTriggerController_TriggerWorkerHeartbeatClient
+}
+
+// NewMockTriggerController_TriggerWorkerHeartbeatClient creates a new mock instance.
+func NewMockTriggerController_TriggerWorkerHeartbeatClient(ctrl *gomock.Controller) *MockTriggerController_TriggerWorkerHeartbeatClient {
+	mock := &MockTriggerController_TriggerWorkerHeartbeatClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) EXPECT() *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) CloseAndRecv() (*controller.TriggerWorkerHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*controller.TriggerWorkerHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_TriggerWorkerHeartbeatClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) Send(arg0 *controller.TriggerWorkerHeartbeatRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_TriggerWorkerHeartbeatClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).Trailer))
+}
+
+// MockTriggerController_WatchSecretClient is a mock of TriggerController_WatchSecretClient interface.
+type MockTriggerController_WatchSecretClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_WatchSecretClientMockRecorder
+}
+
+// MockTriggerController_WatchSecretClientMockRecorder is the mock recorder for MockTriggerController_WatchSecretClient.
+type MockTriggerController_WatchSecretClientMockRecorder struct {
+	mock *MockTriggerController_WatchSecretClient
+}
+
+// NewMockTriggerController_WatchSecretClient creates a new mock instance.
+func NewMockTriggerController_WatchSecretClient(ctrl *gomock.Controller) *MockTriggerController_WatchSecretClient {
+	mock := &MockTriggerController_WatchSecretClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_WatchSecretClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_WatchSecretClient) EXPECT() *MockTriggerController_WatchSecretClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTriggerController_WatchSecretClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Recv() (*controller.SecretEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*controller.SecretEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTriggerController_WatchSecretClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTriggerController_WatchSecretClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerController_WatchSecretClient)(nil).Trailer))
+}
+
+// MockTriggerControllerServer is a mock of TriggerControllerServer interface.
+type MockTriggerControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerControllerServerMockRecorder
+}
+
+// MockTriggerControllerServerMockRecorder is the mock recorder for MockTriggerControllerServer.
+type MockTriggerControllerServerMockRecorder struct {
+	mock *MockTriggerControllerServer
+}
+
+// NewMockTriggerControllerServer creates a new mock instance.
+func NewMockTriggerControllerServer(ctrl *gomock.Controller) *MockTriggerControllerServer {
+	mock := &MockTriggerControllerServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerControllerServer) EXPECT() *MockTriggerControllerServerMockRecorder {
+	return m.recorder
+}
+
+// CommitConnectorPosition mocks base method.
+func (m *MockTriggerControllerServer) CommitConnectorPosition(arg0 context.Context, arg1 *controller.CommitConnectorPositionRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConnectorPosition", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConnectorPosition indicates an expected call of CommitConnectorPosition.
+func (mr *MockTriggerControllerServerMockRecorder) CommitConnectorPosition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConnectorPosition", reflect.TypeOf((*MockTriggerControllerServer)(nil).CommitConnectorPosition), arg0, arg1)
+}
+
+// CommitOffset mocks base method.
+func (m *MockTriggerControllerServer) CommitOffset(arg0 context.Context, arg1 *controller.CommitOffsetRequest) (*controller.CommitOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", arg0, arg1)
+	ret0, _ := ret[0].(*controller.CommitOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockTriggerControllerServerMockRecorder) CommitOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockTriggerControllerServer)(nil).CommitOffset), arg0, arg1)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockTriggerControllerServer) CreateSubscription(arg0 context.Context, arg1 *controller.CreateSubscriptionRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) CreateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).CreateSubscription), arg0, arg1)
+}
+
+// DeleteConnector mocks base method.
+func (m *MockTriggerControllerServer) DeleteConnector(arg0 context.Context, arg1 *controller.DeleteConnectorRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnector", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockTriggerControllerServerMockRecorder) DeleteConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).DeleteConnector), arg0, arg1)
+}
+
+// DeleteSecret mocks base method.
+func (m *MockTriggerControllerServer) DeleteSecret(arg0 context.Context, arg1 *controller.DeleteSecretRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockTriggerControllerServerMockRecorder) DeleteSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).DeleteSecret), arg0, arg1)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockTriggerControllerServer) DeleteSubscription(arg0 context.Context, arg1 *controller.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) DeleteSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).DeleteSubscription), arg0, arg1)
+}
+
+// ExportSubscription mocks base method.
+func (m *MockTriggerControllerServer) ExportSubscription(arg0 context.Context, arg1 *controller.ExportSubscriptionRequest) (*controller.ExportSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ExportSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSubscription indicates an expected call of ExportSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) ExportSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ExportSubscription), arg0, arg1)
+}
+
+// GetConnector mocks base method.
+func (m *MockTriggerControllerServer) GetConnector(arg0 context.Context, arg1 *controller.GetConnectorRequest) (*controller.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnector", arg0, arg1)
+	ret0, _ := ret[0].(*controller.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnector indicates an expected call of GetConnector.
+func (mr *MockTriggerControllerServerMockRecorder) GetConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetConnector), arg0, arg1)
+}
+
+// GetSecret mocks base method.
+func (m *MockTriggerControllerServer) GetSecret(arg0 context.Context, arg1 *controller.GetSecretRequest) (*controller.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", arg0, arg1)
+	ret0, _ := ret[0].(*controller.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret.
+func (mr *MockTriggerControllerServerMockRecorder) GetSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSecret), arg0, arg1)
+}
+
+// GetSubscription mocks base method.
+func (m *MockTriggerControllerServer) GetSubscription(arg0 context.Context, arg1 *controller.GetSubscriptionRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) GetSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSubscription), arg0, arg1)
+}
+
+// ImportSubscription mocks base method.
+func (m *MockTriggerControllerServer) ImportSubscription(arg0 context.Context, arg1 *controller.ImportSubscriptionRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSubscription indicates an expected call of ImportSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) ImportSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ImportSubscription), arg0, arg1)
+}
+
+// ListConnector mocks base method.
+func (m *MockTriggerControllerServer) ListConnector(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnector", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnector indicates an expected call of ListConnector.
+func (mr *MockTriggerControllerServerMockRecorder) ListConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListConnector), arg0, arg1)
+}
+
+// ListSecret mocks base method.
+func (m *MockTriggerControllerServer) ListSecret(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListSecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecret", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecret indicates an expected call of ListSecret.
+func (mr *MockTriggerControllerServerMockRecorder) ListSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSecret), arg0, arg1)
+}
+
+// ListSubscription mocks base method.
+func (m *MockTriggerControllerServer) ListSubscription(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscription indicates an expected call of ListSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) ListSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSubscription), arg0, arg1)
+}
+
+// ListTriggerWorker mocks base method.
+func (m *MockTriggerControllerServer) ListTriggerWorker(arg0 context.Context, arg1 *emptypb.Empty) (*controller.ListTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTriggerWorker", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockTriggerControllerServerMockRecorder) ListTriggerWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListTriggerWorker), arg0, arg1)
+}
+
+// ListWorkerConnector mocks base method.
+func (m *MockTriggerControllerServer) ListWorkerConnector(arg0 context.Context, arg1 *controller.ListWorkerConnectorRequest) (*controller.ListWorkerConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkerConnector", arg0, arg1)
+	ret0, _ := ret[0].(*controller.ListWorkerConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerConnector indicates an expected call of ListWorkerConnector.
+func (mr *MockTriggerControllerServerMockRecorder) ListWorkerConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListWorkerConnector), arg0, arg1)
+}
+
+// PutConnector mocks base method.
+func (m *MockTriggerControllerServer) PutConnector(arg0 context.Context, arg1 *controller.Connector) (*controller.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutConnector", arg0, arg1)
+	ret0, _ := ret[0].(*controller.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutConnector indicates an expected call of PutConnector.
+func (mr *MockTriggerControllerServerMockRecorder) PutConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutConnector", reflect.TypeOf((*MockTriggerControllerServer)(nil).PutConnector), arg0, arg1)
+}
+
+// PutSecret mocks base method.
+func (m *MockTriggerControllerServer) PutSecret(arg0 context.Context, arg1 *controller.PutSecretRequest) (*controller.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", arg0, arg1)
+	ret0, _ := ret[0].(*controller.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MockTriggerControllerServerMockRecorder) PutSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).PutSecret), arg0, arg1)
+}
+
+// RegisterTriggerWorker mocks base method.
+func (m *MockTriggerControllerServer) RegisterTriggerWorker(arg0 context.Context, arg1 *controller.RegisterTriggerWorkerRequest) (*controller.RegisterTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTriggerWorker", arg0, arg1)
+	ret0, _ := ret[0].(*controller.RegisterTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTriggerWorker indicates an expected call of RegisterTriggerWorker.
+func (mr *MockTriggerControllerServerMockRecorder) RegisterTriggerWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTriggerWorker", reflect.TypeOf((*MockTriggerControllerServer)(nil).RegisterTriggerWorker), arg0, arg1)
+}
+
+// ResetOffsetToTimestamp mocks base method.
+func (m *MockTriggerControllerServer) ResetOffsetToTimestamp(arg0 context.Context, arg1 *ResetOffsetToTimestampRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetOffsetToTimestamp", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOffsetToTimestamp indicates an expected call of ResetOffsetToTimestamp.
+func (mr *MockTriggerControllerServerMockRecorder) ResetOffsetToTimestamp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOffsetToTimestamp", reflect.TypeOf((*MockTriggerControllerServer)(nil).ResetOffsetToTimestamp), arg0, arg1)
+}
+
+// TriggerWorkerHeartbeat mocks base method.
+func (m *MockTriggerControllerServer) TriggerWorkerHeartbeat(arg0 TriggerController_TriggerWorkerHeartbeatServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerWorkerHeartbeat", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerWorkerHeartbeat indicates an expected call of TriggerWorkerHeartbeat.
+func (mr *MockTriggerControllerServerMockRecorder) TriggerWorkerHeartbeat(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerWorkerHeartbeat", reflect.TypeOf((*MockTriggerControllerServer)(nil).TriggerWorkerHeartbeat), arg0)
+}
+
+// UnregisterTriggerWorker mocks base method.
+func (m *MockTriggerControllerServer) UnregisterTriggerWorker(arg0 context.Context, arg1 *controller.UnregisterTriggerWorkerRequest) (*controller.UnregisterTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterTriggerWorker", arg0, arg1)
+	ret0, _ := ret[0].(*controller.UnregisterTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterTriggerWorker indicates an expected call of UnregisterTriggerWorker.
+func (mr *MockTriggerControllerServerMockRecorder) UnregisterTriggerWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterTriggerWorker", reflect.TypeOf((*MockTriggerControllerServer)(nil).UnregisterTriggerWorker), arg0, arg1)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockTriggerControllerServer) UpdateSubscription(arg0 context.Context, arg1 *controller.UpdateSubscriptionRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) UpdateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).UpdateSubscription), arg0, arg1)
+}
+
+// WatchSecret mocks base method.
+func (m *MockTriggerControllerServer) WatchSecret(arg0 *emptypb.Empty, arg1 TriggerController_WatchSecretServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSecret", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchSecret indicates an expected call of WatchSecret.
+func (mr *MockTriggerControllerServerMockRecorder) WatchSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSecret", reflect.TypeOf((*MockTriggerControllerServer)(nil).WatchSecret), arg0, arg1)
+}
+
+// MockTriggerController_TriggerWorkerHeartbeatServer is a mock of TriggerController_TriggerWorkerHeartbeatServer interface.
+type MockTriggerController_TriggerWorkerHeartbeatServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder
+}
+
+// MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder is the mock recorder for MockTriggerController_TriggerWorkerHeartbeatServer.
+type MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder struct {
+	mock *MockTriggerController_TriggerWorkerHeartbeatServer
+}
+
+// NewMockTriggerController_TriggerWorkerHeartbeatServer creates a new mock instance.
+func NewMockTriggerController_TriggerWorkerHeartbeatServer(ctrl *gomock.Controller) *MockTriggerController_TriggerWorkerHeartbeatServer {
+	mock := &MockTriggerController_TriggerWorkerHeartbeatServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) EXPECT() *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) Recv() (*controller.TriggerWorkerHeartbeatRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*controller.TriggerWorkerHeartbeatRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_TriggerWorkerHeartbeatServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) SendAndClose(arg0 *controller.TriggerWorkerHeartbeatResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_TriggerWorkerHeartbeatServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTriggerController_TriggerWorkerHeartbeatServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SetTrailer), arg0)
+}
+
+// MockTriggerController_WatchSecretServer is a mock of TriggerController_WatchSecretServer interface.
+type MockTriggerController_WatchSecretServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_WatchSecretServerMockRecorder
+}
+
+// MockTriggerController_WatchSecretServerMockRecorder is the mock recorder for MockTriggerController_WatchSecretServer.
+type MockTriggerController_WatchSecretServerMockRecorder struct {
+	mock *MockTriggerController_WatchSecretServer
+}
+
+// NewMockTriggerController_WatchSecretServer creates a new mock instance.
+func NewMockTriggerController_WatchSecretServer(ctrl *gomock.Controller) *MockTriggerController_WatchSecretServer {
+	mock := &MockTriggerController_WatchSecretServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_WatchSecretServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_WatchSecretServer) EXPECT() *MockTriggerController_WatchSecretServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_WatchSecretServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTriggerController_WatchSecretServer) Send(arg0 *controller.SecretEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTriggerController_WatchSecretServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_WatchSecretServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTriggerController_WatchSecretServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTriggerController_WatchSecretServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTriggerController_WatchSecretServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerController_WatchSecretServer)(nil).SetTrailer), arg0)
+}
+
+// MockSnowflakeControllerClient is a mock of SnowflakeControllerClient interface.
+type MockSnowflakeControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnowflakeControllerClientMockRecorder
+}
+
+// MockSnowflakeControllerClientMockRecorder is the mock recorder for MockSnowflakeControllerClient.
+type MockSnowflakeControllerClientMockRecorder struct {
+	mock *MockSnowflakeControllerClient
+}
+
+// NewMockSnowflakeControllerClient creates a new mock instance.
+func NewMockSnowflakeControllerClient(ctrl *gomock.Controller) *MockSnowflakeControllerClient {
+	mock := &MockSnowflakeControllerClient{ctrl: ctrl}
+	mock.recorder = &MockSnowflakeControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnowflakeControllerClient) EXPECT() *MockSnowflakeControllerClientMockRecorder {
+	return m.recorder
+}
+
+// AllocateIDs mocks base method.
+func (m *MockSnowflakeControllerClient) AllocateIDs(ctx context.Context, in *controller.AllocateIDsRequest, opts ...grpc.CallOption) (*controller.AllocateIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AllocateIDs", varargs...)
+	ret0, _ := ret[0].(*controller.AllocateIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllocateIDs indicates an expected call of AllocateIDs.
+func (mr *MockSnowflakeControllerClientMockRecorder) AllocateIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIDs", reflect.TypeOf((*MockSnowflakeControllerClient)(nil).AllocateIDs), varargs...)
+}
+
+// GetClusterStartTime mocks base method.
+func (m *MockSnowflakeControllerClient) GetClusterStartTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*timestamppb.Timestamp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterStartTime", varargs...)
+	ret0, _ := ret[0].(*timestamppb.Timestamp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterStartTime indicates an expected call of GetClusterStartTime.
+func (mr *MockSnowflakeControllerClientMockRecorder) GetClusterStartTime(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStartTime", reflect.TypeOf((*MockSnowflakeControllerClient)(nil).GetClusterStartTime), varargs...)
+}
+
+// RegisterNode mocks base method.
+func (m *MockSnowflakeControllerClient) RegisterNode(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterNode", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterNode indicates an expected call of RegisterNode.
+func (mr *MockSnowflakeControllerClientMockRecorder) RegisterNode(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNode", reflect.TypeOf((*MockSnowflakeControllerClient)(nil).RegisterNode), varargs...)
+}
+
+// UnregisterNode mocks base method.
+func (m *MockSnowflakeControllerClient) UnregisterNode(ctx context.Context, in *wrapperspb.UInt32Value, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnregisterNode", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterNode indicates an expected call of UnregisterNode.
+func (mr *MockSnowflakeControllerClientMockRecorder) UnregisterNode(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterNode", reflect.TypeOf((*MockSnowflakeControllerClient)(nil).UnregisterNode), varargs...)
+}
+
+// MockSnowflakeControllerServer is a mock of SnowflakeControllerServer interface.
+type MockSnowflakeControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnowflakeControllerServerMockRecorder
+}
+
+// MockSnowflakeControllerServerMockRecorder is the mock recorder for MockSnowflakeControllerServer.
+type MockSnowflakeControllerServerMockRecorder struct {
+	mock *MockSnowflakeControllerServer
+}
+
+// NewMockSnowflakeControllerServer creates a new mock instance.
+func NewMockSnowflakeControllerServer(ctrl *gomock.Controller) *MockSnowflakeControllerServer {
+	mock := &MockSnowflakeControllerServer{ctrl: ctrl}
+	mock.recorder = &MockSnowflakeControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnowflakeControllerServer) EXPECT() *MockSnowflakeControllerServerMockRecorder {
+	return m.recorder
+}
+
+// AllocateIDs mocks base method.
+func (m *MockSnowflakeControllerServer) AllocateIDs(arg0 context.Context, arg1 *controller.AllocateIDsRequest) (*controller.AllocateIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateIDs", arg0, arg1)
+	ret0, _ := ret[0].(*controller.AllocateIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllocateIDs indicates an expected call of AllocateIDs.
+func (mr *MockSnowflakeControllerServerMockRecorder) AllocateIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIDs", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).AllocateIDs), arg0, arg1)
+}
+
+// GetClusterStartTime mocks base method.
+func (m *MockSnowflakeControllerServer) GetClusterStartTime(arg0 context.Context, arg1 *emptypb.Empty) (*timestamppb.Timestamp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterStartTime", arg0, arg1)
+	ret0, _ := ret[0].(*timestamppb.Timestamp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterStartTime indicates an expected call of GetClusterStartTime.
+func (mr *MockSnowflakeControllerServerMockRecorder) GetClusterStartTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterStartTime", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).GetClusterStartTime), arg0, arg1)
+}
+
+// RegisterNode mocks base method.
+func (m *MockSnowflakeControllerServer) RegisterNode(arg0 context.Context, arg1 *wrapperspb.UInt32Value) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterNode", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterNode indicates an expected call of RegisterNode.
+func (mr *MockSnowflakeControllerServerMockRecorder) RegisterNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNode", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).RegisterNode), arg0, arg1)
+}
+
+// UnregisterNode mocks base method.
+func (m *MockSnowflakeControllerServer) UnregisterNode(arg0 context.Context, arg1 *wrapperspb.UInt32Value) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterNode", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterNode indicates an expected call of UnregisterNode.
+func (mr *MockSnowflakeControllerServerMockRecorder) UnregisterNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterNode", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).UnregisterNode), arg0, arg1)
+}
@@ -0,0 +1,993 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.4
+// source: v2/segment.proto
+
+package segment
+
+import (
+	context "context"
+	cloudevents "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segment "github.com/linkall-labs/vanus/proto/pkg/segment"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AppendToBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the results are in the order of the appended events.
+	Results []*AppendResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *AppendToBlockResponse) Reset() {
+	*x = AppendToBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_segment_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendToBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendToBlockResponse) ProtoMessage() {}
+
+func (x *AppendToBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_segment_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendToBlockResponse.ProtoReflect.Descriptor instead.
+func (*AppendToBlockResponse) Descriptor() ([]byte, []int) {
+	return file_v2_segment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AppendToBlockResponse) GetResults() []*AppendResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// AppendResult is where an event is placed in block.
+type AppendResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// the millisecond timestamp when the event is written to block.
+	Stime int64 `protobuf:"varint,2,opt,name=stime,proto3" json:"stime,omitempty"`
+}
+
+func (x *AppendResult) Reset() {
+	*x = AppendResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_segment_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendResult) ProtoMessage() {}
+
+func (x *AppendResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_segment_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendResult.ProtoReflect.Descriptor instead.
+func (*AppendResult) Descriptor() ([]byte, []int) {
+	return file_v2_segment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppendResult) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AppendResult) GetStime() int64 {
+	if x != nil {
+		return x.Stime
+	}
+	return 0
+}
+
+type ReadFromBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Number  int64  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// how long to wait for the events if there are none, unset or zero disables polling.
+	PollingTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=polling_timeout,json=pollingTimeout,proto3" json:"polling_timeout,omitempty"`
+	// only the events matching the filter are returned if it's set.
+	Filter *segment.EventFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ReadFromBlockRequest) Reset() {
+	*x = ReadFromBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_segment_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFromBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFromBlockRequest) ProtoMessage() {}
+
+func (x *ReadFromBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_segment_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFromBlockRequest.ProtoReflect.Descriptor instead.
+func (*ReadFromBlockRequest) Descriptor() ([]byte, []int) {
+	return file_v2_segment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadFromBlockRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetPollingTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.PollingTimeout
+	}
+	return nil
+}
+
+func (x *ReadFromBlockRequest) GetFilter() *segment.EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ReadFromBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events *cloudevents.CloudEventBatch `protobuf:"bytes,1,opt,name=events,proto3" json:"events,omitempty"`
+	// the offset to read next, the events aren't contiguous if the request has a filter.
+	NextOffset int64 `protobuf:"varint,2,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"`
+}
+
+func (x *ReadFromBlockResponse) Reset() {
+	*x = ReadFromBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_segment_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFromBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFromBlockResponse) ProtoMessage() {}
+
+func (x *ReadFromBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_segment_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFromBlockResponse.ProtoReflect.Descriptor instead.
+func (*ReadFromBlockResponse) Descriptor() ([]byte, []int) {
+	return file_v2_segment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadFromBlockResponse) GetEvents() *cloudevents.CloudEventBatch {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ReadFromBlockResponse) GetNextOffset() int64 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
+var File_v2_segment_proto protoreflect.FileDescriptor
+
+var file_v2_segment_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x76, 0x32, 0x2f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x18, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x1a, 0x11, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x15, 0x41,
+	0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x6f, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0xed, 0x08, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x6c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x2f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x29, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x29, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70,
+	0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5c, 0x0a, 0x11, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6d,
+	0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
+	0x0d, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2e,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7c, 0x0a, 0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v2_segment_proto_rawDescOnce sync.Once
+	file_v2_segment_proto_rawDescData = file_v2_segment_proto_rawDesc
+)
+
+func file_v2_segment_proto_rawDescGZIP() []byte {
+	file_v2_segment_proto_rawDescOnce.Do(func() {
+		file_v2_segment_proto_rawDescData = protoimpl.X.CompressGZIP(file_v2_segment_proto_rawDescData)
+	})
+	return file_v2_segment_proto_rawDescData
+}
+
+var file_v2_segment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v2_segment_proto_goTypes = []interface{}{
+	(*AppendToBlockResponse)(nil),               // 0: linkall.vanus.segment.v2.AppendToBlockResponse
+	(*AppendResult)(nil),                        // 1: linkall.vanus.segment.v2.AppendResult
+	(*ReadFromBlockRequest)(nil),                // 2: linkall.vanus.segment.v2.ReadFromBlockRequest
+	(*ReadFromBlockResponse)(nil),               // 3: linkall.vanus.segment.v2.ReadFromBlockResponse
+	(*durationpb.Duration)(nil),                 // 4: google.protobuf.Duration
+	(*segment.EventFilter)(nil),                 // 5: linkall.vanus.segment.EventFilter
+	(*cloudevents.CloudEventBatch)(nil),         // 6: linkall.vanus.cloudevents.CloudEventBatch
+	(*segment.StartSegmentServerRequest)(nil),   // 7: linkall.vanus.segment.StartSegmentServerRequest
+	(*segment.StopSegmentServerRequest)(nil),    // 8: linkall.vanus.segment.StopSegmentServerRequest
+	(*segment.CreateBlockRequest)(nil),          // 9: linkall.vanus.segment.CreateBlockRequest
+	(*segment.RemoveBlockRequest)(nil),          // 10: linkall.vanus.segment.RemoveBlockRequest
+	(*segment.GetBlockInfoRequest)(nil),         // 11: linkall.vanus.segment.GetBlockInfoRequest
+	(*segment.ActivateSegmentRequest)(nil),      // 12: linkall.vanus.segment.ActivateSegmentRequest
+	(*segment.InactivateSegmentRequest)(nil),    // 13: linkall.vanus.segment.InactivateSegmentRequest
+	(*segment.AppendToBlockRequest)(nil),        // 14: linkall.vanus.segment.AppendToBlockRequest
+	(*segment.LookupOffsetInBlockRequest)(nil),  // 15: linkall.vanus.segment.LookupOffsetInBlockRequest
+	(*emptypb.Empty)(nil),                       // 16: google.protobuf.Empty
+	(*segment.StartSegmentServerResponse)(nil),  // 17: linkall.vanus.segment.StartSegmentServerResponse
+	(*segment.StopSegmentServerResponse)(nil),   // 18: linkall.vanus.segment.StopSegmentServerResponse
+	(*segment.GetBlockInfoResponse)(nil),        // 19: linkall.vanus.segment.GetBlockInfoResponse
+	(*segment.ActivateSegmentResponse)(nil),     // 20: linkall.vanus.segment.ActivateSegmentResponse
+	(*segment.LookupOffsetInBlockResponse)(nil), // 21: linkall.vanus.segment.LookupOffsetInBlockResponse
+	(*segment.StatusResponse)(nil),              // 22: linkall.vanus.segment.StatusResponse
+}
+var file_v2_segment_proto_depIdxs = []int32{
+	1,  // 0: linkall.vanus.segment.v2.AppendToBlockResponse.results:type_name -> linkall.vanus.segment.v2.AppendResult
+	4,  // 1: linkall.vanus.segment.v2.ReadFromBlockRequest.polling_timeout:type_name -> google.protobuf.Duration
+	5,  // 2: linkall.vanus.segment.v2.ReadFromBlockRequest.filter:type_name -> linkall.vanus.segment.EventFilter
+	6,  // 3: linkall.vanus.segment.v2.ReadFromBlockResponse.events:type_name -> linkall.vanus.cloudevents.CloudEventBatch
+	7,  // 4: linkall.vanus.segment.v2.SegmentServer.Start:input_type -> linkall.vanus.segment.StartSegmentServerRequest
+	8,  // 5: linkall.vanus.segment.v2.SegmentServer.Stop:input_type -> linkall.vanus.segment.StopSegmentServerRequest
+	9,  // 6: linkall.vanus.segment.v2.SegmentServer.CreateBlock:input_type -> linkall.vanus.segment.CreateBlockRequest
+	10, // 7: linkall.vanus.segment.v2.SegmentServer.RemoveBlock:input_type -> linkall.vanus.segment.RemoveBlockRequest
+	11, // 8: linkall.vanus.segment.v2.SegmentServer.GetBlockInfo:input_type -> linkall.vanus.segment.GetBlockInfoRequest
+	12, // 9: linkall.vanus.segment.v2.SegmentServer.ActivateSegment:input_type -> linkall.vanus.segment.ActivateSegmentRequest
+	13, // 10: linkall.vanus.segment.v2.SegmentServer.InactivateSegment:input_type -> linkall.vanus.segment.InactivateSegmentRequest
+	14, // 11: linkall.vanus.segment.v2.SegmentServer.AppendToBlock:input_type -> linkall.vanus.segment.AppendToBlockRequest
+	2,  // 12: linkall.vanus.segment.v2.SegmentServer.ReadFromBlock:input_type -> linkall.vanus.segment.v2.ReadFromBlockRequest
+	15, // 13: linkall.vanus.segment.v2.SegmentServer.LookupOffsetInBlock:input_type -> linkall.vanus.segment.LookupOffsetInBlockRequest
+	16, // 14: linkall.vanus.segment.v2.SegmentServer.Status:input_type -> google.protobuf.Empty
+	17, // 15: linkall.vanus.segment.v2.SegmentServer.Start:output_type -> linkall.vanus.segment.StartSegmentServerResponse
+	18, // 16: linkall.vanus.segment.v2.SegmentServer.Stop:output_type -> linkall.vanus.segment.StopSegmentServerResponse
+	16, // 17: linkall.vanus.segment.v2.SegmentServer.CreateBlock:output_type -> google.protobuf.Empty
+	16, // 18: linkall.vanus.segment.v2.SegmentServer.RemoveBlock:output_type -> google.protobuf.Empty
+	19, // 19: linkall.vanus.segment.v2.SegmentServer.GetBlockInfo:output_type -> linkall.vanus.segment.GetBlockInfoResponse
+	20, // 20: linkall.vanus.segment.v2.SegmentServer.ActivateSegment:output_type -> linkall.vanus.segment.ActivateSegmentResponse
+	16, // 21: linkall.vanus.segment.v2.SegmentServer.InactivateSegment:output_type -> google.protobuf.Empty
+	0,  // 22: linkall.vanus.segment.v2.SegmentServer.AppendToBlock:output_type -> linkall.vanus.segment.v2.AppendToBlockResponse
+	3,  // 23: linkall.vanus.segment.v2.SegmentServer.ReadFromBlock:output_type -> linkall.vanus.segment.v2.ReadFromBlockResponse
+	21, // 24: linkall.vanus.segment.v2.SegmentServer.LookupOffsetInBlock:output_type -> linkall.vanus.segment.LookupOffsetInBlockResponse
+	22, // 25: linkall.vanus.segment.v2.SegmentServer.Status:output_type -> linkall.vanus.segment.StatusResponse
+	15, // [15:26] is the sub-list for method output_type
+	4,  // [4:15] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v2_segment_proto_init() }
+func file_v2_segment_proto_init() {
+	if File_v2_segment_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v2_segment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendToBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_segment_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_segment_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFromBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_segment_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFromBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v2_segment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_segment_proto_goTypes,
+		DependencyIndexes: file_v2_segment_proto_depIdxs,
+		MessageInfos:      file_v2_segment_proto_msgTypes,
+	}.Build()
+	File_v2_segment_proto = out.File
+	file_v2_segment_proto_rawDesc = nil
+	file_v2_segment_proto_goTypes = nil
+	file_v2_segment_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SegmentServerClient is the client API for SegmentServer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SegmentServerClient interface {
+	Start(ctx context.Context, in *segment.StartSegmentServerRequest, opts ...grpc.CallOption) (*segment.StartSegmentServerResponse, error)
+	Stop(ctx context.Context, in *segment.StopSegmentServerRequest, opts ...grpc.CallOption) (*segment.StopSegmentServerResponse, error)
+	CreateBlock(ctx context.Context, in *segment.CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveBlock(ctx context.Context, in *segment.RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBlockInfo(ctx context.Context, in *segment.GetBlockInfoRequest, opts ...grpc.CallOption) (*segment.GetBlockInfoResponse, error)
+	ActivateSegment(ctx context.Context, in *segment.ActivateSegmentRequest, opts ...grpc.CallOption) (*segment.ActivateSegmentResponse, error)
+	InactivateSegment(ctx context.Context, in *segment.InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AppendToBlock(ctx context.Context, in *segment.AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error)
+	ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error)
+	LookupOffsetInBlock(ctx context.Context, in *segment.LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*segment.LookupOffsetInBlockResponse, error)
+	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*segment.StatusResponse, error)
+}
+
+type segmentServerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSegmentServerClient(cc grpc.ClientConnInterface) SegmentServerClient {
+	return &segmentServerClient{cc}
+}
+
+func (c *segmentServerClient) Start(ctx context.Context, in *segment.StartSegmentServerRequest, opts ...grpc.CallOption) (*segment.StartSegmentServerResponse, error) {
+	out := new(segment.StartSegmentServerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) Stop(ctx context.Context, in *segment.StopSegmentServerRequest, opts ...grpc.CallOption) (*segment.StopSegmentServerResponse, error) {
+	out := new(segment.StopSegmentServerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) CreateBlock(ctx context.Context, in *segment.CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/CreateBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) RemoveBlock(ctx context.Context, in *segment.RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/RemoveBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) GetBlockInfo(ctx context.Context, in *segment.GetBlockInfoRequest, opts ...grpc.CallOption) (*segment.GetBlockInfoResponse, error) {
+	out := new(segment.GetBlockInfoResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/GetBlockInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) ActivateSegment(ctx context.Context, in *segment.ActivateSegmentRequest, opts ...grpc.CallOption) (*segment.ActivateSegmentResponse, error) {
+	out := new(segment.ActivateSegmentResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/ActivateSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) InactivateSegment(ctx context.Context, in *segment.InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/InactivateSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) AppendToBlock(ctx context.Context, in *segment.AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error) {
+	out := new(AppendToBlockResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/AppendToBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error) {
+	out := new(ReadFromBlockResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/ReadFromBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) LookupOffsetInBlock(ctx context.Context, in *segment.LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*segment.LookupOffsetInBlockResponse, error) {
+	out := new(segment.LookupOffsetInBlockResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/LookupOffsetInBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*segment.StatusResponse, error) {
+	out := new(segment.StatusResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.v2.SegmentServer/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SegmentServerServer is the server API for SegmentServer service.
+type SegmentServerServer interface {
+	Start(context.Context, *segment.StartSegmentServerRequest) (*segment.StartSegmentServerResponse, error)
+	Stop(context.Context, *segment.StopSegmentServerRequest) (*segment.StopSegmentServerResponse, error)
+	CreateBlock(context.Context, *segment.CreateBlockRequest) (*emptypb.Empty, error)
+	RemoveBlock(context.Context, *segment.RemoveBlockRequest) (*emptypb.Empty, error)
+	GetBlockInfo(context.Context, *segment.GetBlockInfoRequest) (*segment.GetBlockInfoResponse, error)
+	ActivateSegment(context.Context, *segment.ActivateSegmentRequest) (*segment.ActivateSegmentResponse, error)
+	InactivateSegment(context.Context, *segment.InactivateSegmentRequest) (*emptypb.Empty, error)
+	AppendToBlock(context.Context, *segment.AppendToBlockRequest) (*AppendToBlockResponse, error)
+	ReadFromBlock(context.Context, *ReadFromBlockRequest) (*ReadFromBlockResponse, error)
+	LookupOffsetInBlock(context.Context, *segment.LookupOffsetInBlockRequest) (*segment.LookupOffsetInBlockResponse, error)
+	Status(context.Context, *emptypb.Empty) (*segment.StatusResponse, error)
+}
+
+// UnimplementedSegmentServerServer can be embedded to have forward compatible implementations.
+type UnimplementedSegmentServerServer struct {
+}
+
+func (*UnimplementedSegmentServerServer) Start(context.Context, *segment.StartSegmentServerRequest) (*segment.StartSegmentServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (*UnimplementedSegmentServerServer) Stop(context.Context, *segment.StopSegmentServerRequest) (*segment.StopSegmentServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (*UnimplementedSegmentServerServer) CreateBlock(context.Context, *segment.CreateBlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) RemoveBlock(context.Context, *segment.RemoveBlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) GetBlockInfo(context.Context, *segment.GetBlockInfoRequest) (*segment.GetBlockInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockInfo not implemented")
+}
+func (*UnimplementedSegmentServerServer) ActivateSegment(context.Context, *segment.ActivateSegmentRequest) (*segment.ActivateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateSegment not implemented")
+}
+func (*UnimplementedSegmentServerServer) InactivateSegment(context.Context, *segment.InactivateSegmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactivateSegment not implemented")
+}
+func (*UnimplementedSegmentServerServer) AppendToBlock(context.Context, *segment.AppendToBlockRequest) (*AppendToBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendToBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) ReadFromBlock(context.Context, *ReadFromBlockRequest) (*ReadFromBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFromBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) LookupOffsetInBlock(context.Context, *segment.LookupOffsetInBlockRequest) (*segment.LookupOffsetInBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupOffsetInBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) Status(context.Context, *emptypb.Empty) (*segment.StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+
+func RegisterSegmentServerServer(s *grpc.Server, srv SegmentServerServer) {
+	s.RegisterService(&_SegmentServer_serviceDesc, srv)
+}
+
+func _SegmentServer_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.StartSegmentServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).Start(ctx, req.(*segment.StartSegmentServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.StopSegmentServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).Stop(ctx, req.(*segment.StopSegmentServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_CreateBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.CreateBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).CreateBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/CreateBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).CreateBlock(ctx, req.(*segment.CreateBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_RemoveBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.RemoveBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).RemoveBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/RemoveBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).RemoveBlock(ctx, req.(*segment.RemoveBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_GetBlockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.GetBlockInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).GetBlockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/GetBlockInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).GetBlockInfo(ctx, req.(*segment.GetBlockInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_ActivateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.ActivateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).ActivateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/ActivateSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).ActivateSegment(ctx, req.(*segment.ActivateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_InactivateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.InactivateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).InactivateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/InactivateSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).InactivateSegment(ctx, req.(*segment.InactivateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_AppendToBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.AppendToBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).AppendToBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/AppendToBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).AppendToBlock(ctx, req.(*segment.AppendToBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_ReadFromBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadFromBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).ReadFromBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/ReadFromBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).ReadFromBlock(ctx, req.(*ReadFromBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_LookupOffsetInBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(segment.LookupOffsetInBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).LookupOffsetInBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/LookupOffsetInBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).LookupOffsetInBlock(ctx, req.(*segment.LookupOffsetInBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.v2.SegmentServer/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).Status(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SegmentServer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.segment.v2.SegmentServer",
+	HandlerType: (*SegmentServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler:    _SegmentServer_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _SegmentServer_Stop_Handler,
+		},
+		{
+			MethodName: "CreateBlock",
+			Handler:    _SegmentServer_CreateBlock_Handler,
+		},
+		{
+			MethodName: "RemoveBlock",
+			Handler:    _SegmentServer_RemoveBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockInfo",
+			Handler:    _SegmentServer_GetBlockInfo_Handler,
+		},
+		{
+			MethodName: "ActivateSegment",
+			Handler:    _SegmentServer_ActivateSegment_Handler,
+		},
+		{
+			MethodName: "InactivateSegment",
+			Handler:    _SegmentServer_InactivateSegment_Handler,
+		},
+		{
+			MethodName: "AppendToBlock",
+			Handler:    _SegmentServer_AppendToBlock_Handler,
+		},
+		{
+			MethodName: "ReadFromBlock",
+			Handler:    _SegmentServer_ReadFromBlock_Handler,
+		},
+		{
+			MethodName: "LookupOffsetInBlock",
+			Handler:    _SegmentServer_LookupOffsetInBlock_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _SegmentServer_Status_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/segment.proto",
+}
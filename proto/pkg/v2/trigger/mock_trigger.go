@@ -0,0 +1,618 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trigger.pb.go
+
+// Package trigger is a generated GoMock package.
+package trigger
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	trigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MockTriggerWorkerClient is a mock of TriggerWorkerClient interface.
+type MockTriggerWorkerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerWorkerClientMockRecorder
+}
+
+// MockTriggerWorkerClientMockRecorder is the mock recorder for MockTriggerWorkerClient.
+type MockTriggerWorkerClientMockRecorder struct {
+	mock *MockTriggerWorkerClient
+}
+
+// NewMockTriggerWorkerClient creates a new mock instance.
+func NewMockTriggerWorkerClient(ctrl *gomock.Controller) *MockTriggerWorkerClient {
+	mock := &MockTriggerWorkerClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerWorkerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerWorkerClient) EXPECT() *MockTriggerWorkerClientMockRecorder {
+	return m.recorder
+}
+
+// AddSubscription mocks base method.
+func (m *MockTriggerWorkerClient) AddSubscription(ctx context.Context, in *trigger.AddSubscriptionRequest, opts ...grpc.CallOption) (*trigger.AddSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddSubscription", varargs...)
+	ret0, _ := ret[0].(*trigger.AddSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSubscription indicates an expected call of AddSubscription.
+func (mr *MockTriggerWorkerClientMockRecorder) AddSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).AddSubscription), varargs...)
+}
+
+// PauseSubscription mocks base method.
+func (m *MockTriggerWorkerClient) PauseSubscription(ctx context.Context, in *trigger.PauseSubscriptionRequest, opts ...grpc.CallOption) (*trigger.PauseSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseSubscription", varargs...)
+	ret0, _ := ret[0].(*trigger.PauseSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSubscription indicates an expected call of PauseSubscription.
+func (mr *MockTriggerWorkerClientMockRecorder) PauseSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).PauseSubscription), varargs...)
+}
+
+// RemoveSubscription mocks base method.
+func (m *MockTriggerWorkerClient) RemoveSubscription(ctx context.Context, in *trigger.RemoveSubscriptionRequest, opts ...grpc.CallOption) (*trigger.RemoveSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveSubscription", varargs...)
+	ret0, _ := ret[0].(*trigger.RemoveSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSubscription indicates an expected call of RemoveSubscription.
+func (mr *MockTriggerWorkerClientMockRecorder) RemoveSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).RemoveSubscription), varargs...)
+}
+
+// ResetOffsetToTimestamp mocks base method.
+func (m *MockTriggerWorkerClient) ResetOffsetToTimestamp(ctx context.Context, in *ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetOffsetToTimestamp", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOffsetToTimestamp indicates an expected call of ResetOffsetToTimestamp.
+func (mr *MockTriggerWorkerClientMockRecorder) ResetOffsetToTimestamp(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOffsetToTimestamp", reflect.TypeOf((*MockTriggerWorkerClient)(nil).ResetOffsetToTimestamp), varargs...)
+}
+
+// ResumeSubscription mocks base method.
+func (m *MockTriggerWorkerClient) ResumeSubscription(ctx context.Context, in *trigger.ResumeSubscriptionRequest, opts ...grpc.CallOption) (*trigger.ResumeSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeSubscription", varargs...)
+	ret0, _ := ret[0].(*trigger.ResumeSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSubscription indicates an expected call of ResumeSubscription.
+func (mr *MockTriggerWorkerClientMockRecorder) ResumeSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).ResumeSubscription), varargs...)
+}
+
+// Start mocks base method.
+func (m *MockTriggerWorkerClient) Start(ctx context.Context, in *trigger.StartTriggerWorkerRequest, opts ...grpc.CallOption) (*trigger.StartTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Start", varargs...)
+	ret0, _ := ret[0].(*trigger.StartTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockTriggerWorkerClientMockRecorder) Start(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTriggerWorkerClient)(nil).Start), varargs...)
+}
+
+// Stop mocks base method.
+func (m *MockTriggerWorkerClient) Stop(ctx context.Context, in *trigger.StopTriggerWorkerRequest, opts ...grpc.CallOption) (*trigger.StopTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stop", varargs...)
+	ret0, _ := ret[0].(*trigger.StopTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockTriggerWorkerClientMockRecorder) Stop(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTriggerWorkerClient)(nil).Stop), varargs...)
+}
+
+// TailSubscription mocks base method.
+func (m *MockTriggerWorkerClient) TailSubscription(ctx context.Context, in *trigger.TailSubscriptionRequest, opts ...grpc.CallOption) (TriggerWorker_TailSubscriptionClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TailSubscription", varargs...)
+	ret0, _ := ret[0].(TriggerWorker_TailSubscriptionClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TailSubscription indicates an expected call of TailSubscription.
+func (mr *MockTriggerWorkerClientMockRecorder) TailSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TailSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).TailSubscription), varargs...)
+}
+
+// TraceEvent mocks base method.
+func (m *MockTriggerWorkerClient) TraceEvent(ctx context.Context, in *trigger.TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TraceEvent", varargs...)
+	ret0, _ := ret[0].(*TraceEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceEvent indicates an expected call of TraceEvent.
+func (mr *MockTriggerWorkerClientMockRecorder) TraceEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceEvent", reflect.TypeOf((*MockTriggerWorkerClient)(nil).TraceEvent), varargs...)
+}
+
+// MockTriggerWorker_TailSubscriptionClient is a mock of TriggerWorker_TailSubscriptionClient interface.
+type MockTriggerWorker_TailSubscriptionClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerWorker_TailSubscriptionClientMockRecorder
+}
+
+// MockTriggerWorker_TailSubscriptionClientMockRecorder is the mock recorder for MockTriggerWorker_TailSubscriptionClient.
+type MockTriggerWorker_TailSubscriptionClientMockRecorder struct {
+	mock *MockTriggerWorker_TailSubscriptionClient
+}
+
+// NewMockTriggerWorker_TailSubscriptionClient creates a new mock instance.
+func NewMockTriggerWorker_TailSubscriptionClient(ctrl *gomock.Controller) *MockTriggerWorker_TailSubscriptionClient {
+	mock := &MockTriggerWorker_TailSubscriptionClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerWorker_TailSubscriptionClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerWorker_TailSubscriptionClient) EXPECT() *MockTriggerWorker_TailSubscriptionClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionClient) Recv() (*DeliveryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*DeliveryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerWorker_TailSubscriptionClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerWorker_TailSubscriptionClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTriggerWorker_TailSubscriptionClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionClient)(nil).Trailer))
+}
+
+// MockTriggerWorkerServer is a mock of TriggerWorkerServer interface.
+type MockTriggerWorkerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerWorkerServerMockRecorder
+}
+
+// MockTriggerWorkerServerMockRecorder is the mock recorder for MockTriggerWorkerServer.
+type MockTriggerWorkerServerMockRecorder struct {
+	mock *MockTriggerWorkerServer
+}
+
+// NewMockTriggerWorkerServer creates a new mock instance.
+func NewMockTriggerWorkerServer(ctrl *gomock.Controller) *MockTriggerWorkerServer {
+	mock := &MockTriggerWorkerServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerWorkerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerWorkerServer) EXPECT() *MockTriggerWorkerServerMockRecorder {
+	return m.recorder
+}
+
+// AddSubscription mocks base method.
+func (m *MockTriggerWorkerServer) AddSubscription(arg0 context.Context, arg1 *trigger.AddSubscriptionRequest) (*trigger.AddSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*trigger.AddSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSubscription indicates an expected call of AddSubscription.
+func (mr *MockTriggerWorkerServerMockRecorder) AddSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).AddSubscription), arg0, arg1)
+}
+
+// PauseSubscription mocks base method.
+func (m *MockTriggerWorkerServer) PauseSubscription(arg0 context.Context, arg1 *trigger.PauseSubscriptionRequest) (*trigger.PauseSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*trigger.PauseSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSubscription indicates an expected call of PauseSubscription.
+func (mr *MockTriggerWorkerServerMockRecorder) PauseSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).PauseSubscription), arg0, arg1)
+}
+
+// RemoveSubscription mocks base method.
+func (m *MockTriggerWorkerServer) RemoveSubscription(arg0 context.Context, arg1 *trigger.RemoveSubscriptionRequest) (*trigger.RemoveSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*trigger.RemoveSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSubscription indicates an expected call of RemoveSubscription.
+func (mr *MockTriggerWorkerServerMockRecorder) RemoveSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).RemoveSubscription), arg0, arg1)
+}
+
+// ResetOffsetToTimestamp mocks base method.
+func (m *MockTriggerWorkerServer) ResetOffsetToTimestamp(arg0 context.Context, arg1 *ResetOffsetToTimestampRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetOffsetToTimestamp", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetOffsetToTimestamp indicates an expected call of ResetOffsetToTimestamp.
+func (mr *MockTriggerWorkerServerMockRecorder) ResetOffsetToTimestamp(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOffsetToTimestamp", reflect.TypeOf((*MockTriggerWorkerServer)(nil).ResetOffsetToTimestamp), arg0, arg1)
+}
+
+// ResumeSubscription mocks base method.
+func (m *MockTriggerWorkerServer) ResumeSubscription(arg0 context.Context, arg1 *trigger.ResumeSubscriptionRequest) (*trigger.ResumeSubscriptionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*trigger.ResumeSubscriptionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSubscription indicates an expected call of ResumeSubscription.
+func (mr *MockTriggerWorkerServerMockRecorder) ResumeSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).ResumeSubscription), arg0, arg1)
+}
+
+// Start mocks base method.
+func (m *MockTriggerWorkerServer) Start(arg0 context.Context, arg1 *trigger.StartTriggerWorkerRequest) (*trigger.StartTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0, arg1)
+	ret0, _ := ret[0].(*trigger.StartTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockTriggerWorkerServerMockRecorder) Start(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTriggerWorkerServer)(nil).Start), arg0, arg1)
+}
+
+// Stop mocks base method.
+func (m *MockTriggerWorkerServer) Stop(arg0 context.Context, arg1 *trigger.StopTriggerWorkerRequest) (*trigger.StopTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0, arg1)
+	ret0, _ := ret[0].(*trigger.StopTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockTriggerWorkerServerMockRecorder) Stop(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockTriggerWorkerServer)(nil).Stop), arg0, arg1)
+}
+
+// TailSubscription mocks base method.
+func (m *MockTriggerWorkerServer) TailSubscription(arg0 *trigger.TailSubscriptionRequest, arg1 TriggerWorker_TailSubscriptionServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TailSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TailSubscription indicates an expected call of TailSubscription.
+func (mr *MockTriggerWorkerServerMockRecorder) TailSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TailSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).TailSubscription), arg0, arg1)
+}
+
+// TraceEvent mocks base method.
+func (m *MockTriggerWorkerServer) TraceEvent(arg0 context.Context, arg1 *trigger.TraceEventRequest) (*TraceEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceEvent", arg0, arg1)
+	ret0, _ := ret[0].(*TraceEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceEvent indicates an expected call of TraceEvent.
+func (mr *MockTriggerWorkerServerMockRecorder) TraceEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceEvent", reflect.TypeOf((*MockTriggerWorkerServer)(nil).TraceEvent), arg0, arg1)
+}
+
+// MockTriggerWorker_TailSubscriptionServer is a mock of TriggerWorker_TailSubscriptionServer interface.
+type MockTriggerWorker_TailSubscriptionServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerWorker_TailSubscriptionServerMockRecorder
+}
+
+// MockTriggerWorker_TailSubscriptionServerMockRecorder is the mock recorder for MockTriggerWorker_TailSubscriptionServer.
+type MockTriggerWorker_TailSubscriptionServerMockRecorder struct {
+	mock *MockTriggerWorker_TailSubscriptionServer
+}
+
+// NewMockTriggerWorker_TailSubscriptionServer creates a new mock instance.
+func NewMockTriggerWorker_TailSubscriptionServer(ctrl *gomock.Controller) *MockTriggerWorker_TailSubscriptionServer {
+	mock := &MockTriggerWorker_TailSubscriptionServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerWorker_TailSubscriptionServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerWorker_TailSubscriptionServer) EXPECT() *MockTriggerWorker_TailSubscriptionServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerWorker_TailSubscriptionServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionServer) Send(arg0 *DeliveryResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerWorker_TailSubscriptionServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTriggerWorker_TailSubscriptionServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTriggerWorker_TailSubscriptionServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerWorker_TailSubscriptionServer)(nil).SetTrailer), arg0)
+}
@@ -0,0 +1,885 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.19.4
+// source: v2/trigger.proto
+
+package trigger
+
+import (
+	context "context"
+	trigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResetOffsetToTimestampRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// the events of subscription are delivered again from the first one written at or after time.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ResetOffsetToTimestampRequest) Reset() {
+	*x = ResetOffsetToTimestampRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_trigger_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetOffsetToTimestampRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetOffsetToTimestampRequest) ProtoMessage() {}
+
+func (x *ResetOffsetToTimestampRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_trigger_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetOffsetToTimestampRequest.ProtoReflect.Descriptor instead.
+func (*ResetOffsetToTimestampRequest) Descriptor() ([]byte, []int) {
+	return file_v2_trigger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResetOffsetToTimestampRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ResetOffsetToTimestampRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// DeliveryResult is the result of delivering an event to the sink of
+// subscription, the recent results are sent first when tailing.
+type DeliveryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// the status code returned by sink, it's -1 if the event wasn't sent
+	StatusCode    int32                `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Latency       *durationpb.Duration `protobuf:"bytes,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	RetryAttempts int32                `protobuf:"varint,4,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
+	Error         string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// when the delivery finished
+	Time *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *DeliveryResult) Reset() {
+	*x = DeliveryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_trigger_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryResult) ProtoMessage() {}
+
+func (x *DeliveryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_trigger_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryResult.ProtoReflect.Descriptor instead.
+func (*DeliveryResult) Descriptor() ([]byte, []int) {
+	return file_v2_trigger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeliveryResult) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeliveryResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *DeliveryResult) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *DeliveryResult) GetRetryAttempts() int32 {
+	if x != nil {
+		return x.RetryAttempts
+	}
+	return 0
+}
+
+func (x *DeliveryResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeliveryResult) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type TraceEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// whether the events of subscription are traced by the trigger worker
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// the results of delivering the event, retries included, in the order they finished
+	Results []*DeliveryResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *TraceEventResponse) Reset() {
+	*x = TraceEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_trigger_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEventResponse) ProtoMessage() {}
+
+func (x *TraceEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_trigger_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEventResponse.ProtoReflect.Descriptor instead.
+func (*TraceEventResponse) Descriptor() ([]byte, []int) {
+	return file_v2_trigger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TraceEventResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TraceEventResponse) GetResults() []*DeliveryResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_v2_trigger_proto protoreflect.FileDescriptor
+
+var file_v2_trigger_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x76, 0x32, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x18, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x1a, 0x0d, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x78, 0x0a, 0x1d, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0x89, 0x08, 0x0a, 0x0d, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x6c, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x64,
+	0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x76, 0x0a, 0x11, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x37, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6e,
+	0x0a, 0x10, 0x54, 0x61, 0x69, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x69, 0x6c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x64,
+	0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x76, 0x32, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_v2_trigger_proto_rawDescOnce sync.Once
+	file_v2_trigger_proto_rawDescData = file_v2_trigger_proto_rawDesc
+)
+
+func file_v2_trigger_proto_rawDescGZIP() []byte {
+	file_v2_trigger_proto_rawDescOnce.Do(func() {
+		file_v2_trigger_proto_rawDescData = protoimpl.X.CompressGZIP(file_v2_trigger_proto_rawDescData)
+	})
+	return file_v2_trigger_proto_rawDescData
+}
+
+var file_v2_trigger_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_v2_trigger_proto_goTypes = []interface{}{
+	(*ResetOffsetToTimestampRequest)(nil),      // 0: linkall.vanus.trigger.v2.ResetOffsetToTimestampRequest
+	(*DeliveryResult)(nil),                     // 1: linkall.vanus.trigger.v2.DeliveryResult
+	(*TraceEventResponse)(nil),                 // 2: linkall.vanus.trigger.v2.TraceEventResponse
+	(*timestamppb.Timestamp)(nil),              // 3: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 4: google.protobuf.Duration
+	(*trigger.StartTriggerWorkerRequest)(nil),  // 5: linkall.vanus.trigger.StartTriggerWorkerRequest
+	(*trigger.StopTriggerWorkerRequest)(nil),   // 6: linkall.vanus.trigger.StopTriggerWorkerRequest
+	(*trigger.AddSubscriptionRequest)(nil),     // 7: linkall.vanus.trigger.AddSubscriptionRequest
+	(*trigger.RemoveSubscriptionRequest)(nil),  // 8: linkall.vanus.trigger.RemoveSubscriptionRequest
+	(*trigger.PauseSubscriptionRequest)(nil),   // 9: linkall.vanus.trigger.PauseSubscriptionRequest
+	(*trigger.ResumeSubscriptionRequest)(nil),  // 10: linkall.vanus.trigger.ResumeSubscriptionRequest
+	(*trigger.TailSubscriptionRequest)(nil),    // 11: linkall.vanus.trigger.TailSubscriptionRequest
+	(*trigger.TraceEventRequest)(nil),          // 12: linkall.vanus.trigger.TraceEventRequest
+	(*trigger.StartTriggerWorkerResponse)(nil), // 13: linkall.vanus.trigger.StartTriggerWorkerResponse
+	(*trigger.StopTriggerWorkerResponse)(nil),  // 14: linkall.vanus.trigger.StopTriggerWorkerResponse
+	(*trigger.AddSubscriptionResponse)(nil),    // 15: linkall.vanus.trigger.AddSubscriptionResponse
+	(*trigger.RemoveSubscriptionResponse)(nil), // 16: linkall.vanus.trigger.RemoveSubscriptionResponse
+	(*trigger.PauseSubscriptionResponse)(nil),  // 17: linkall.vanus.trigger.PauseSubscriptionResponse
+	(*trigger.ResumeSubscriptionResponse)(nil), // 18: linkall.vanus.trigger.ResumeSubscriptionResponse
+	(*emptypb.Empty)(nil),                      // 19: google.protobuf.Empty
+}
+var file_v2_trigger_proto_depIdxs = []int32{
+	3,  // 0: linkall.vanus.trigger.v2.ResetOffsetToTimestampRequest.time:type_name -> google.protobuf.Timestamp
+	4,  // 1: linkall.vanus.trigger.v2.DeliveryResult.latency:type_name -> google.protobuf.Duration
+	3,  // 2: linkall.vanus.trigger.v2.DeliveryResult.time:type_name -> google.protobuf.Timestamp
+	1,  // 3: linkall.vanus.trigger.v2.TraceEventResponse.results:type_name -> linkall.vanus.trigger.v2.DeliveryResult
+	5,  // 4: linkall.vanus.trigger.v2.TriggerWorker.Start:input_type -> linkall.vanus.trigger.StartTriggerWorkerRequest
+	6,  // 5: linkall.vanus.trigger.v2.TriggerWorker.Stop:input_type -> linkall.vanus.trigger.StopTriggerWorkerRequest
+	7,  // 6: linkall.vanus.trigger.v2.TriggerWorker.AddSubscription:input_type -> linkall.vanus.trigger.AddSubscriptionRequest
+	8,  // 7: linkall.vanus.trigger.v2.TriggerWorker.RemoveSubscription:input_type -> linkall.vanus.trigger.RemoveSubscriptionRequest
+	9,  // 8: linkall.vanus.trigger.v2.TriggerWorker.PauseSubscription:input_type -> linkall.vanus.trigger.PauseSubscriptionRequest
+	10, // 9: linkall.vanus.trigger.v2.TriggerWorker.ResumeSubscription:input_type -> linkall.vanus.trigger.ResumeSubscriptionRequest
+	0,  // 10: linkall.vanus.trigger.v2.TriggerWorker.ResetOffsetToTimestamp:input_type -> linkall.vanus.trigger.v2.ResetOffsetToTimestampRequest
+	11, // 11: linkall.vanus.trigger.v2.TriggerWorker.TailSubscription:input_type -> linkall.vanus.trigger.TailSubscriptionRequest
+	12, // 12: linkall.vanus.trigger.v2.TriggerWorker.TraceEvent:input_type -> linkall.vanus.trigger.TraceEventRequest
+	13, // 13: linkall.vanus.trigger.v2.TriggerWorker.Start:output_type -> linkall.vanus.trigger.StartTriggerWorkerResponse
+	14, // 14: linkall.vanus.trigger.v2.TriggerWorker.Stop:output_type -> linkall.vanus.trigger.StopTriggerWorkerResponse
+	15, // 15: linkall.vanus.trigger.v2.TriggerWorker.AddSubscription:output_type -> linkall.vanus.trigger.AddSubscriptionResponse
+	16, // 16: linkall.vanus.trigger.v2.TriggerWorker.RemoveSubscription:output_type -> linkall.vanus.trigger.RemoveSubscriptionResponse
+	17, // 17: linkall.vanus.trigger.v2.TriggerWorker.PauseSubscription:output_type -> linkall.vanus.trigger.PauseSubscriptionResponse
+	18, // 18: linkall.vanus.trigger.v2.TriggerWorker.ResumeSubscription:output_type -> linkall.vanus.trigger.ResumeSubscriptionResponse
+	19, // 19: linkall.vanus.trigger.v2.TriggerWorker.ResetOffsetToTimestamp:output_type -> google.protobuf.Empty
+	1,  // 20: linkall.vanus.trigger.v2.TriggerWorker.TailSubscription:output_type -> linkall.vanus.trigger.v2.DeliveryResult
+	2,  // 21: linkall.vanus.trigger.v2.TriggerWorker.TraceEvent:output_type -> linkall.vanus.trigger.v2.TraceEventResponse
+	13, // [13:22] is the sub-list for method output_type
+	4,  // [4:13] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v2_trigger_proto_init() }
+func file_v2_trigger_proto_init() {
+	if File_v2_trigger_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v2_trigger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetOffsetToTimestampRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_trigger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliveryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_trigger_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v2_trigger_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_trigger_proto_goTypes,
+		DependencyIndexes: file_v2_trigger_proto_depIdxs,
+		MessageInfos:      file_v2_trigger_proto_msgTypes,
+	}.Build()
+	File_v2_trigger_proto = out.File
+	file_v2_trigger_proto_rawDesc = nil
+	file_v2_trigger_proto_goTypes = nil
+	file_v2_trigger_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TriggerWorkerClient is the client API for TriggerWorker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TriggerWorkerClient interface {
+	Start(ctx context.Context, in *trigger.StartTriggerWorkerRequest, opts ...grpc.CallOption) (*trigger.StartTriggerWorkerResponse, error)
+	Stop(ctx context.Context, in *trigger.StopTriggerWorkerRequest, opts ...grpc.CallOption) (*trigger.StopTriggerWorkerResponse, error)
+	AddSubscription(ctx context.Context, in *trigger.AddSubscriptionRequest, opts ...grpc.CallOption) (*trigger.AddSubscriptionResponse, error)
+	RemoveSubscription(ctx context.Context, in *trigger.RemoveSubscriptionRequest, opts ...grpc.CallOption) (*trigger.RemoveSubscriptionResponse, error)
+	PauseSubscription(ctx context.Context, in *trigger.PauseSubscriptionRequest, opts ...grpc.CallOption) (*trigger.PauseSubscriptionResponse, error)
+	ResumeSubscription(ctx context.Context, in *trigger.ResumeSubscriptionRequest, opts ...grpc.CallOption) (*trigger.ResumeSubscriptionResponse, error)
+	ResetOffsetToTimestamp(ctx context.Context, in *ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TailSubscription(ctx context.Context, in *trigger.TailSubscriptionRequest, opts ...grpc.CallOption) (TriggerWorker_TailSubscriptionClient, error)
+	TraceEvent(ctx context.Context, in *trigger.TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error)
+}
+
+type triggerWorkerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggerWorkerClient(cc grpc.ClientConnInterface) TriggerWorkerClient {
+	return &triggerWorkerClient{cc}
+}
+
+func (c *triggerWorkerClient) Start(ctx context.Context, in *trigger.StartTriggerWorkerRequest, opts ...grpc.CallOption) (*trigger.StartTriggerWorkerResponse, error) {
+	out := new(trigger.StartTriggerWorkerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) Stop(ctx context.Context, in *trigger.StopTriggerWorkerRequest, opts ...grpc.CallOption) (*trigger.StopTriggerWorkerResponse, error) {
+	out := new(trigger.StopTriggerWorkerResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/Stop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) AddSubscription(ctx context.Context, in *trigger.AddSubscriptionRequest, opts ...grpc.CallOption) (*trigger.AddSubscriptionResponse, error) {
+	out := new(trigger.AddSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/AddSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) RemoveSubscription(ctx context.Context, in *trigger.RemoveSubscriptionRequest, opts ...grpc.CallOption) (*trigger.RemoveSubscriptionResponse, error) {
+	out := new(trigger.RemoveSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/RemoveSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) PauseSubscription(ctx context.Context, in *trigger.PauseSubscriptionRequest, opts ...grpc.CallOption) (*trigger.PauseSubscriptionResponse, error) {
+	out := new(trigger.PauseSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/PauseSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) ResumeSubscription(ctx context.Context, in *trigger.ResumeSubscriptionRequest, opts ...grpc.CallOption) (*trigger.ResumeSubscriptionResponse, error) {
+	out := new(trigger.ResumeSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/ResumeSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) ResetOffsetToTimestamp(ctx context.Context, in *ResetOffsetToTimestampRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/ResetOffsetToTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerWorkerClient) TailSubscription(ctx context.Context, in *trigger.TailSubscriptionRequest, opts ...grpc.CallOption) (TriggerWorker_TailSubscriptionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TriggerWorker_serviceDesc.Streams[0], "/linkall.vanus.trigger.v2.TriggerWorker/TailSubscription", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &triggerWorkerTailSubscriptionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TriggerWorker_TailSubscriptionClient interface {
+	Recv() (*DeliveryResult, error)
+	grpc.ClientStream
+}
+
+type triggerWorkerTailSubscriptionClient struct {
+	grpc.ClientStream
+}
+
+func (x *triggerWorkerTailSubscriptionClient) Recv() (*DeliveryResult, error) {
+	m := new(DeliveryResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *triggerWorkerClient) TraceEvent(ctx context.Context, in *trigger.TraceEventRequest, opts ...grpc.CallOption) (*TraceEventResponse, error) {
+	out := new(TraceEventResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.trigger.v2.TriggerWorker/TraceEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerWorkerServer is the server API for TriggerWorker service.
+type TriggerWorkerServer interface {
+	Start(context.Context, *trigger.StartTriggerWorkerRequest) (*trigger.StartTriggerWorkerResponse, error)
+	Stop(context.Context, *trigger.StopTriggerWorkerRequest) (*trigger.StopTriggerWorkerResponse, error)
+	AddSubscription(context.Context, *trigger.AddSubscriptionRequest) (*trigger.AddSubscriptionResponse, error)
+	RemoveSubscription(context.Context, *trigger.RemoveSubscriptionRequest) (*trigger.RemoveSubscriptionResponse, error)
+	PauseSubscription(context.Context, *trigger.PauseSubscriptionRequest) (*trigger.PauseSubscriptionResponse, error)
+	ResumeSubscription(context.Context, *trigger.ResumeSubscriptionRequest) (*trigger.ResumeSubscriptionResponse, error)
+	ResetOffsetToTimestamp(context.Context, *ResetOffsetToTimestampRequest) (*emptypb.Empty, error)
+	TailSubscription(*trigger.TailSubscriptionRequest, TriggerWorker_TailSubscriptionServer) error
+	TraceEvent(context.Context, *trigger.TraceEventRequest) (*TraceEventResponse, error)
+}
+
+// UnimplementedTriggerWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedTriggerWorkerServer struct {
+}
+
+func (*UnimplementedTriggerWorkerServer) Start(context.Context, *trigger.StartTriggerWorkerRequest) (*trigger.StartTriggerWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) Stop(context.Context, *trigger.StopTriggerWorkerRequest) (*trigger.StopTriggerWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) AddSubscription(context.Context, *trigger.AddSubscriptionRequest) (*trigger.AddSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubscription not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) RemoveSubscription(context.Context, *trigger.RemoveSubscriptionRequest) (*trigger.RemoveSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubscription not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) PauseSubscription(context.Context, *trigger.PauseSubscriptionRequest) (*trigger.PauseSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscription not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) ResumeSubscription(context.Context, *trigger.ResumeSubscriptionRequest) (*trigger.ResumeSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscription not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) ResetOffsetToTimestamp(context.Context, *ResetOffsetToTimestampRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetOffsetToTimestamp not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) TailSubscription(*trigger.TailSubscriptionRequest, TriggerWorker_TailSubscriptionServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailSubscription not implemented")
+}
+func (*UnimplementedTriggerWorkerServer) TraceEvent(context.Context, *trigger.TraceEventRequest) (*TraceEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceEvent not implemented")
+}
+
+func RegisterTriggerWorkerServer(s *grpc.Server, srv TriggerWorkerServer) {
+	s.RegisterService(&_TriggerWorker_serviceDesc, srv)
+}
+
+func _TriggerWorker_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.StartTriggerWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).Start(ctx, req.(*trigger.StartTriggerWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.StopTriggerWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/Stop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).Stop(ctx, req.(*trigger.StopTriggerWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_AddSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.AddSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).AddSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/AddSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).AddSubscription(ctx, req.(*trigger.AddSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_RemoveSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.RemoveSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).RemoveSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/RemoveSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).RemoveSubscription(ctx, req.(*trigger.RemoveSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_PauseSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.PauseSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).PauseSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/PauseSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).PauseSubscription(ctx, req.(*trigger.PauseSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_ResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.ResumeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).ResumeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/ResumeSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).ResumeSubscription(ctx, req.(*trigger.ResumeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_ResetOffsetToTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetOffsetToTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).ResetOffsetToTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/ResetOffsetToTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).ResetOffsetToTimestamp(ctx, req.(*ResetOffsetToTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerWorker_TailSubscription_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(trigger.TailSubscriptionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TriggerWorkerServer).TailSubscription(m, &triggerWorkerTailSubscriptionServer{stream})
+}
+
+type TriggerWorker_TailSubscriptionServer interface {
+	Send(*DeliveryResult) error
+	grpc.ServerStream
+}
+
+type triggerWorkerTailSubscriptionServer struct {
+	grpc.ServerStream
+}
+
+func (x *triggerWorkerTailSubscriptionServer) Send(m *DeliveryResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TriggerWorker_TraceEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(trigger.TraceEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerWorkerServer).TraceEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.trigger.v2.TriggerWorker/TraceEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerWorkerServer).TraceEvent(ctx, req.(*trigger.TraceEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TriggerWorker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "linkall.vanus.trigger.v2.TriggerWorker",
+	HandlerType: (*TriggerWorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler:    _TriggerWorker_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _TriggerWorker_Stop_Handler,
+		},
+		{
+			MethodName: "AddSubscription",
+			Handler:    _TriggerWorker_AddSubscription_Handler,
+		},
+		{
+			MethodName: "RemoveSubscription",
+			Handler:    _TriggerWorker_RemoveSubscription_Handler,
+		},
+		{
+			MethodName: "PauseSubscription",
+			Handler:    _TriggerWorker_PauseSubscription_Handler,
+		},
+		{
+			MethodName: "ResumeSubscription",
+			Handler:    _TriggerWorker_ResumeSubscription_Handler,
+		},
+		{
+			MethodName: "ResetOffsetToTimestamp",
+			Handler:    _TriggerWorker_ResetOffsetToTimestamp_Handler,
+		},
+		{
+			MethodName: "TraceEvent",
+			Handler:    _TriggerWorker_TraceEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailSubscription",
+			Handler:       _TriggerWorker_TailSubscription_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v2/trigger.proto",
+}
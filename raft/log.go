@@ -26,6 +26,8 @@ type raftLog struct {
 	storage Storage
 
 	inflight *inflight
+	// persisting contains the callbacks waiting for entries to be persisted.
+	persisting inflight
 
 	// unstable contains all unstable entries and snapshot.
 	// they will be saved into storage.
@@ -132,6 +134,7 @@ func (l *raftLog) append(ents ...pb.Entry) uint64 {
 	start := ents[0].Index
 	if truncated {
 		l.inflight.truncateFrom(start)
+		l.persisting.truncateFrom(start)
 	}
 	// Reset pending when any entry being persisted is truncated.
 	if l.pending > start {
@@ -330,7 +333,11 @@ func (l *raftLog) persistingTo(i, t uint64) {
 }
 
 func (l *raftLog) stableTo(i, t uint64) bool {
-	return l.unstable.stableTo(i, t)
+	if !l.unstable.stableTo(i, t) {
+		return false
+	}
+	l.persisting.commitTo(i)
+	return true
 }
 
 func (l *raftLog) stableSnapTo(i uint64) {
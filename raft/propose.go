@@ -29,6 +29,9 @@ type ProposeData struct {
 	Data         []byte
 	Callback     ProposeCallback
 	NoWaitCommit bool
+	// WaitPersist invokes Callback after the entry is persisted to the local log, without
+	// waiting for commit.
+	WaitPersist bool
 }
 
 type ProposeDataOption func(cfg *ProposeData)
@@ -51,6 +54,12 @@ func NoWaitCommit() ProposeDataOption {
 	}
 }
 
+func WaitPersist() ProposeDataOption {
+	return func(pd *ProposeData) {
+		pd.WaitPersist = true
+	}
+}
+
 type ProposeOption func(cfg *ProposeData)
 
 func WithData(opts ...ProposeDataOption) ProposeOption {
@@ -191,6 +191,10 @@ type Config struct {
 	// multiple raft group, each raft group can have its own logger
 	Logger Logger
 
+	// Rand randomizes the election timeout, a global source seeded by the
+	// current time is used if it's nil. Deterministic simulations set it.
+	Rand interface{ Intn(n int) int }
+
 	// DisableProposalForwarding set to true means that followers will drop
 	// proposals, rather than forwarding them to the leader. One use case for
 	// this feature would be in a situation where the Raft leader is used to
@@ -314,6 +318,7 @@ type raft struct {
 	propose proposeFunc
 
 	logger Logger
+	rand   interface{ Intn(n int) int }
 
 	// pendingReadIndexMessages is used to store messages of type MsgReadIndex
 	// that can't be answered as new leader didn't committed any log in
@@ -343,6 +348,7 @@ func newRaft(c *Config) *raft {
 		electionTimeout:           c.ElectionTick,
 		heartbeatTimeout:          c.HeartbeatTick,
 		logger:                    c.Logger,
+		rand:                      c.Rand,
 		checkQuorum:               c.CheckQuorum,
 		preVote:                   c.PreVote,
 		readOnly:                  newReadOnly(c.ReadOnlyOption),
@@ -889,6 +895,8 @@ func (r *raft) Propose(pds ...ProposeData) {
 			pd.Callback(err)
 		} else if pd.NoWaitCommit {
 			pd.Callback(nil)
+		} else if pd.WaitPersist {
+			r.raftLog.persisting.append(ents[i].Index, pd.Callback)
 		} else {
 			r.inflight.append(ents[i].Index, pd.Callback)
 		}
@@ -1806,6 +1814,10 @@ func (r *raft) pastElectionTimeout() bool {
 }
 
 func (r *raft) resetRandomizedElectionTimeout() {
+	if r.rand != nil {
+		r.randomizedElectionTimeout = r.electionTimeout + r.rand.Intn(r.electionTimeout)
+		return
+	}
 	r.randomizedElectionTimeout = r.electionTimeout + globalRand.Intn(r.electionTimeout)
 }
 
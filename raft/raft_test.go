@@ -1124,6 +1124,71 @@ func TestProposalByProxy(t *testing.T) {
 	}
 }
 
+// TestProposeWaitPersist ensures the callback of a proposal waiting for persist is invoked once the
+// entry is stable in the local log, before it's committed.
+func TestProposeWaitPersist(t *testing.T) {
+	r := newTestRaft(1, 10, 1, newTestMemoryStorage(withPeers(1, 2)))
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	var called bool
+	var perr error
+	r.Propose(ProposeData{Data: []byte("somedata"), WaitPersist: true, Callback: func(err error) {
+		called = true
+		perr = err
+	}})
+	li := r.raftLog.lastIndex()
+	if called {
+		t.Fatalf("callback is invoked before the entry is persisted")
+	}
+
+	r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgLogResp, Index: li, LogTerm: r.Term})
+	if !called {
+		t.Fatalf("callback is not invoked after the entry is persisted")
+	}
+	if perr != nil {
+		t.Errorf("err = %v, want nil", perr)
+	}
+	if r.raftLog.committed >= li {
+		t.Errorf("committed = %d, want < %d", r.raftLog.committed, li)
+	}
+}
+
+// TestProposeWaitPersistTruncated ensures the callback of a proposal waiting for persist gets
+// ErrProposalDropped if the entry is truncated before it's persisted.
+func TestProposeWaitPersistTruncated(t *testing.T) {
+	r := newTestRaft(1, 10, 1, newTestMemoryStorage(withPeers(1, 2)))
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	var called bool
+	var perr error
+	r.Propose(ProposeData{Data: []byte("somedata"), WaitPersist: true, Callback: func(err error) {
+		called = true
+		perr = err
+	}})
+	li := r.raftLog.lastIndex()
+
+	// the new leader overwrites the entry.
+	r.Step(pb.Message{
+		From: 2, To: 1, Type: pb.MsgApp, Term: r.Term + 1,
+		Index: li - 1, LogTerm: r.Term, Entries: []pb.Entry{{Index: li, Term: r.Term + 1}},
+	})
+	if !called {
+		t.Fatalf("callback is not invoked after the entry is truncated")
+	}
+	if perr != ErrProposalDropped {
+		t.Errorf("err = %v, want %v", perr, ErrProposalDropped)
+	}
+
+	// the callback isn't invoked again once the entry at the same index is persisted.
+	called = false
+	r.Step(pb.Message{From: 1, To: 1, Type: pb.MsgLogResp, Index: li, LogTerm: r.Term})
+	if called {
+		t.Errorf("callback is invoked again after the entry is truncated")
+	}
+}
+
 func TestCommit(t *testing.T) {
 	tests := []struct {
 		matches []uint64
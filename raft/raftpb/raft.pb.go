@@ -209,7 +209,7 @@ func (ConfChangeType) EnumDescriptor() ([]byte, []int) {
 type Entry struct {
 	Term     uint64    `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
 	Index    uint64    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	Type     EntryType `protobuf:"varint,1,opt,name=type,proto3,enum=raftpb.EntryType" json:"type,omitempty"`
+	Type     EntryType `protobuf:"varint,1,opt,name=type,proto3,enum=linkall.vanus.raftpb.EntryType" json:"type,omitempty"`
 	Data     []byte    `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	NodeId   uint64    `protobuf:"varint,5,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	PrevTerm uint64    `protobuf:"varint,6,opt,name=prev_term,json=prevTerm,proto3" json:"prev_term,omitempty"`
@@ -326,7 +326,7 @@ func (m *Snapshot) XXX_DiscardUnknown() {
 var xxx_messageInfo_Snapshot proto.InternalMessageInfo
 
 type Message struct {
-	Type MessageType `protobuf:"varint,1,opt,name=type,proto3,enum=raftpb.MessageType" json:"type,omitempty"`
+	Type MessageType `protobuf:"varint,1,opt,name=type,proto3,enum=linkall.vanus.raftpb.MessageType" json:"type,omitempty"`
 	To   uint64      `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
 	From uint64      `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
 	Term uint64      `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
@@ -470,7 +470,7 @@ func (m *ConfState) XXX_DiscardUnknown() {
 var xxx_messageInfo_ConfState proto.InternalMessageInfo
 
 type ConfChange struct {
-	Type    ConfChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=raftpb.ConfChangeType" json:"type,omitempty"`
+	Type    ConfChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=linkall.vanus.raftpb.ConfChangeType" json:"type,omitempty"`
 	NodeID  uint64         `protobuf:"varint,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	Context []byte         `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
 	// NB: this is used only by etcd to thread through a unique identifier.
@@ -515,7 +515,7 @@ var xxx_messageInfo_ConfChange proto.InternalMessageInfo
 // ConfChangeSingle is an individual configuration change operation. Multiple
 // such operations can be carried out atomically via a ConfChangeV2.
 type ConfChangeSingle struct {
-	Type   ConfChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=raftpb.ConfChangeType" json:"type,omitempty"`
+	Type   ConfChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=linkall.vanus.raftpb.ConfChangeType" json:"type,omitempty"`
 	NodeID uint64         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 }
 
@@ -585,7 +585,7 @@ var xxx_messageInfo_ConfChangeSingle proto.InternalMessageInfo
 //
 // [1]: https://github.com/ongardie/dissertation/blob/master/online-trim.pdf
 type ConfChangeV2 struct {
-	Transition ConfChangeTransition `protobuf:"varint,1,opt,name=transition,proto3,enum=raftpb.ConfChangeTransition" json:"transition,omitempty"`
+	Transition ConfChangeTransition `protobuf:"varint,1,opt,name=transition,proto3,enum=linkall.vanus.raftpb.ConfChangeTransition" json:"transition,omitempty"`
 	Changes    []ConfChangeSingle   `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
 	Context    []byte               `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
 }
@@ -624,93 +624,94 @@ func (m *ConfChangeV2) XXX_DiscardUnknown() {
 var xxx_messageInfo_ConfChangeV2 proto.InternalMessageInfo
 
 func init() {
-	proto.RegisterEnum("raftpb.EntryType", EntryType_name, EntryType_value)
-	proto.RegisterEnum("raftpb.MessageType", MessageType_name, MessageType_value)
-	proto.RegisterEnum("raftpb.ConfChangeTransition", ConfChangeTransition_name, ConfChangeTransition_value)
-	proto.RegisterEnum("raftpb.ConfChangeType", ConfChangeType_name, ConfChangeType_value)
-	proto.RegisterType((*Entry)(nil), "raftpb.Entry")
-	proto.RegisterType((*SnapshotMetadata)(nil), "raftpb.SnapshotMetadata")
-	proto.RegisterType((*Snapshot)(nil), "raftpb.Snapshot")
-	proto.RegisterType((*Message)(nil), "raftpb.Message")
-	proto.RegisterType((*HardState)(nil), "raftpb.HardState")
-	proto.RegisterType((*ConfState)(nil), "raftpb.ConfState")
-	proto.RegisterType((*ConfChange)(nil), "raftpb.ConfChange")
-	proto.RegisterType((*ConfChangeSingle)(nil), "raftpb.ConfChangeSingle")
-	proto.RegisterType((*ConfChangeV2)(nil), "raftpb.ConfChangeV2")
+	proto.RegisterEnum("linkall.vanus.raftpb.EntryType", EntryType_name, EntryType_value)
+	proto.RegisterEnum("linkall.vanus.raftpb.MessageType", MessageType_name, MessageType_value)
+	proto.RegisterEnum("linkall.vanus.raftpb.ConfChangeTransition", ConfChangeTransition_name, ConfChangeTransition_value)
+	proto.RegisterEnum("linkall.vanus.raftpb.ConfChangeType", ConfChangeType_name, ConfChangeType_value)
+	proto.RegisterType((*Entry)(nil), "linkall.vanus.raftpb.Entry")
+	proto.RegisterType((*SnapshotMetadata)(nil), "linkall.vanus.raftpb.SnapshotMetadata")
+	proto.RegisterType((*Snapshot)(nil), "linkall.vanus.raftpb.Snapshot")
+	proto.RegisterType((*Message)(nil), "linkall.vanus.raftpb.Message")
+	proto.RegisterType((*HardState)(nil), "linkall.vanus.raftpb.HardState")
+	proto.RegisterType((*ConfState)(nil), "linkall.vanus.raftpb.ConfState")
+	proto.RegisterType((*ConfChange)(nil), "linkall.vanus.raftpb.ConfChange")
+	proto.RegisterType((*ConfChangeSingle)(nil), "linkall.vanus.raftpb.ConfChangeSingle")
+	proto.RegisterType((*ConfChangeV2)(nil), "linkall.vanus.raftpb.ConfChangeV2")
 }
 
 func init() { proto.RegisterFile("raftpb/raft.proto", fileDescriptor_f652ee94e728864d) }
 
 var fileDescriptor_f652ee94e728864d = []byte{
-	// 1086 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0x9d, 0x90, 0x1f, 0x2f, 0x21, 0x0c, 0x03, 0xa5, 0xee, 0x76, 0x1b, 0xd2, 0xac, 0x56,
-	0x4b, 0x91, 0x16, 0xa4, 0x54, 0xaa, 0xaa, 0xaa, 0x17, 0x60, 0x57, 0x82, 0x96, 0xb0, 0x5b, 0xc3,
-	0x72, 0xd8, 0x4b, 0x34, 0xb1, 0x07, 0xc7, 0x5d, 0xdb, 0x63, 0x8d, 0x27, 0x14, 0xae, 0xed, 0x1f,
-	0xd0, 0x1e, 0x7b, 0xac, 0x7a, 0xee, 0x1f, 0xc2, 0x91, 0x63, 0x4f, 0xa8, 0x0b, 0xff, 0x48, 0x35,
-	0x3f, 0x9c, 0x38, 0x88, 0x56, 0xda, 0x53, 0xe6, 0x7d, 0xef, 0xf3, 0xbc, 0xef, 0x7d, 0x33, 0x6f,
-	0x14, 0x58, 0xe6, 0xe4, 0x4c, 0xa4, 0xa3, 0x6d, 0xf9, 0xb3, 0x95, 0x72, 0x26, 0x18, 0xae, 0x6a,
-	0xe8, 0xd1, 0x6a, 0xc0, 0x02, 0xa6, 0xa0, 0x6d, 0xb9, 0xd2, 0xd9, 0xde, 0x9f, 0x16, 0x2c, 0xbc,
-	0x4c, 0x04, 0xbf, 0xc4, 0x18, 0x2a, 0x82, 0xf2, 0xd8, 0xb1, 0xbb, 0xd6, 0x46, 0xc5, 0x55, 0x6b,
-	0xbc, 0x0a, 0x0b, 0x61, 0xe2, 0xd3, 0x0b, 0xa7, 0xac, 0x40, 0x1d, 0xe0, 0xa7, 0x50, 0x11, 0x97,
-	0x29, 0x75, 0xac, 0xae, 0xb5, 0xd1, 0xee, 0x2f, 0x6f, 0xe9, 0x02, 0x5b, 0x6a, 0x9b, 0x93, 0xcb,
-	0x94, 0xba, 0x2a, 0x2d, 0x37, 0xf4, 0x89, 0x20, 0x4e, 0xa5, 0x6b, 0x6d, 0xb4, 0x5c, 0xb5, 0xc6,
-	0x1f, 0x43, 0x2d, 0x61, 0x3e, 0x1d, 0x86, 0xbe, 0xb3, 0xa0, 0xb6, 0xac, 0xca, 0xf0, 0xc0, 0xc7,
-	0x9f, 0x42, 0x23, 0xe5, 0xf4, 0x7c, 0xa8, 0x24, 0x54, 0x55, 0xaa, 0x2e, 0x81, 0x13, 0xca, 0xe3,
-	0x9e, 0x00, 0x74, 0x9c, 0x90, 0x34, 0x1b, 0x33, 0x31, 0xa0, 0x82, 0xa8, 0x9d, 0xbe, 0x02, 0xf0,
-	0x58, 0x72, 0x36, 0xcc, 0x04, 0x11, 0x5a, 0x4a, 0x73, 0x26, 0x65, 0x8f, 0x25, 0x67, 0xc7, 0x32,
-	0xb1, 0x5b, 0xb9, 0xba, 0x59, 0x2f, 0xb9, 0x0d, 0x2f, 0x07, 0x66, 0x2d, 0xd9, 0xc5, 0x96, 0xf2,
-	0xe6, 0xcb, 0xb3, 0xe6, 0x7b, 0x6f, 0xa1, 0x9e, 0x57, 0x9d, 0xf6, 0x62, 0x15, 0x7a, 0xf9, 0x06,
-	0xea, 0xb1, 0x51, 0xa3, 0x36, 0x6b, 0xf6, 0x9d, 0xbc, 0xfe, 0x7d, 0xb5, 0x46, 0xc6, 0x94, 0xdf,
-	0xfb, 0xa5, 0x0c, 0xb5, 0x01, 0xcd, 0x32, 0x12, 0x50, 0xfc, 0x6c, 0xce, 0xce, 0x95, 0x7c, 0x0f,
-	0x93, 0x2e, 0x18, 0xda, 0x06, 0x5b, 0x30, 0xa3, 0xdb, 0x16, 0x4c, 0x8a, 0x3a, 0xe3, 0x6c, 0x2a,
-	0x5a, 0xae, 0xa7, 0x8d, 0x54, 0x0a, 0xa7, 0xf8, 0x09, 0xd4, 0x23, 0x16, 0x68, 0x6b, 0xb5, 0xeb,
-	0xb5, 0x88, 0x05, 0x27, 0x73, 0x07, 0x5c, 0x2d, 0xba, 0xf1, 0x1c, 0x6a, 0x34, 0x11, 0x3c, 0xa4,
-	0x99, 0x53, 0xeb, 0x96, 0x37, 0x9a, 0xfd, 0xc5, 0xb9, 0x33, 0x36, 0xdd, 0xe4, 0x1c, 0xbc, 0x06,
-	0x55, 0x8f, 0xc5, 0x71, 0x28, 0x9c, 0xba, 0x3e, 0x53, 0x1d, 0x61, 0x07, 0x6a, 0x1e, 0x8b, 0x53,
-	0xe2, 0x09, 0x67, 0x51, 0x97, 0x35, 0x21, 0xee, 0x43, 0x3d, 0x33, 0x16, 0x39, 0x0d, 0x65, 0x1d,
-	0xba, 0x6f, 0x5d, 0x6e, 0x59, 0xce, 0x93, 0x55, 0x38, 0xfd, 0x91, 0x7a, 0xc2, 0x81, 0xae, 0xb5,
-	0x51, 0x77, 0x4d, 0x84, 0xd7, 0xa1, 0xa9, 0x57, 0xc3, 0x71, 0x98, 0x08, 0xa7, 0xa9, 0x2a, 0x81,
-	0x86, 0xf6, 0xc3, 0xc4, 0xc8, 0x48, 0x04, 0xbd, 0x10, 0x4e, 0x4b, 0x1d, 0x5f, 0x1e, 0xf6, 0xbe,
-	0x87, 0xc6, 0x3e, 0xe1, 0xbe, 0xbe, 0x18, 0xb9, 0x73, 0x56, 0xc1, 0x39, 0x0c, 0x95, 0x73, 0x26,
-	0x68, 0x3e, 0x13, 0x72, 0x5d, 0xe8, 0xb6, 0x5c, 0xec, 0xb6, 0xf7, 0x97, 0x05, 0x8d, 0xe9, 0xbd,
-	0x93, 0x2c, 0xc9, 0xe6, 0x99, 0x63, 0x75, 0xcb, 0x92, 0xa5, 0x23, 0xfc, 0x08, 0xea, 0x11, 0x25,
-	0x3c, 0x91, 0x19, 0x5b, 0x65, 0xa6, 0x31, 0x7e, 0x06, 0x4b, 0x9a, 0x35, 0x64, 0x13, 0x11, 0xb0,
-	0x30, 0x09, 0x9c, 0xb2, 0xa2, 0xb4, 0x35, 0xfc, 0xca, 0xa0, 0xf8, 0x09, 0x2c, 0xe6, 0x1f, 0x0d,
-	0x13, 0xd9, 0x57, 0x45, 0xd1, 0x5a, 0x39, 0x78, 0x44, 0x2f, 0x04, 0xfe, 0x0c, 0x80, 0x4c, 0x04,
-	0x1b, 0x46, 0x94, 0x9c, 0x53, 0x75, 0xee, 0x75, 0xb7, 0x21, 0x91, 0x43, 0x09, 0xf4, 0x7e, 0xb5,
-	0x00, 0xa4, 0xdc, 0xbd, 0x31, 0x49, 0x02, 0x8a, 0x37, 0xcd, 0x25, 0xb4, 0xd5, 0x25, 0x5c, 0x2b,
-	0x0e, 0x92, 0x66, 0x14, 0xee, 0xe1, 0x93, 0xd9, 0x10, 0x2b, 0x0b, 0x76, 0xe1, 0xf6, 0x66, 0xbd,
-	0x7a, 0x24, 0x07, 0xf9, 0xc5, 0x74, 0xa0, 0x0b, 0xae, 0x57, 0xe6, 0x5c, 0xc7, 0x6b, 0x60, 0x87,
-	0xbe, 0xb6, 0x79, 0xb7, 0x7a, 0x7b, 0xb3, 0x6e, 0x1f, 0xbc, 0x70, 0xed, 0xd0, 0xef, 0x79, 0x80,
-	0x66, 0xe5, 0x8e, 0xc3, 0x24, 0x88, 0x66, 0xb2, 0xac, 0x0f, 0x93, 0x65, 0xff, 0x97, 0xac, 0xde,
-	0x1f, 0x16, 0xb4, 0x66, 0x5f, 0x9f, 0xf6, 0xf1, 0xb7, 0x00, 0x82, 0x93, 0x24, 0x0b, 0x45, 0xc8,
-	0x12, 0x53, 0xe7, 0xf1, 0x03, 0x75, 0xa6, 0x1c, 0xb7, 0xc0, 0xc7, 0x5f, 0x43, 0xcd, 0x53, 0x79,
-	0x7d, 0x9a, 0x85, 0x27, 0xe0, 0x7e, 0x2b, 0xf9, 0xd0, 0x18, 0x7a, 0xd1, 0x9f, 0xf2, 0x9c, 0x3f,
-	0x9b, 0xfb, 0xd0, 0x98, 0x3e, 0xa5, 0x78, 0x09, 0x9a, 0x2a, 0x38, 0x62, 0x3c, 0x26, 0x11, 0x2a,
-	0xe1, 0x15, 0x58, 0x52, 0xc0, 0x6c, 0x7f, 0x64, 0xe1, 0x8f, 0x60, 0xf9, 0x1e, 0x78, 0xda, 0x47,
-	0xf6, 0xe6, 0x9d, 0x0d, 0xcd, 0xc2, 0x33, 0x82, 0x01, 0xaa, 0x83, 0x2c, 0xd8, 0x9f, 0xa4, 0xa8,
-	0x84, 0x9b, 0x50, 0x1b, 0x64, 0xc1, 0x2e, 0x25, 0x02, 0x59, 0x26, 0x78, 0xcd, 0x59, 0x8a, 0x6c,
-	0xc3, 0xda, 0x49, 0x53, 0x54, 0xc6, 0x6d, 0x00, 0xbd, 0x76, 0x69, 0x96, 0xa2, 0x8a, 0x21, 0x9e,
-	0x32, 0x41, 0xd1, 0x82, 0xd4, 0x66, 0x02, 0x95, 0xad, 0x9a, 0xac, 0x9c, 0x60, 0x54, 0xc3, 0x08,
-	0x5a, 0xb2, 0x18, 0x25, 0x5c, 0x8c, 0x64, 0x95, 0x3a, 0x5e, 0x05, 0x54, 0x44, 0xd4, 0x47, 0x0d,
-	0x8c, 0xa1, 0x3d, 0xc8, 0x82, 0x37, 0x09, 0xa7, 0xc4, 0x1b, 0x93, 0x51, 0x44, 0x11, 0xe0, 0x65,
-	0x58, 0x34, 0x1b, 0xc9, 0x69, 0x9a, 0x64, 0xa8, 0x69, 0x68, 0x7b, 0x63, 0xea, 0xbd, 0xfb, 0x61,
-	0xc2, 0xf8, 0x24, 0x46, 0x2d, 0xd9, 0xf6, 0x20, 0x0b, 0xd4, 0xd1, 0x9c, 0x51, 0x7e, 0x48, 0x89,
-	0x4f, 0x39, 0x5a, 0x34, 0x5f, 0x9f, 0x84, 0x31, 0x65, 0x13, 0x71, 0xc4, 0x7e, 0x42, 0x6d, 0x23,
-	0xc6, 0xa5, 0xc4, 0x3f, 0x90, 0x2f, 0x1c, 0x5a, 0x32, 0x62, 0xa6, 0x88, 0x12, 0x83, 0x4c, 0xbf,
-	0xaf, 0x39, 0x55, 0x2d, 0x2e, 0x9b, 0xaa, 0x26, 0x56, 0x1c, 0x6c, 0x38, 0x87, 0x2c, 0x50, 0xf1,
-	0xca, 0xe6, 0xcf, 0x16, 0xac, 0x3e, 0x74, 0x51, 0xf0, 0x63, 0x70, 0x1e, 0xc2, 0x77, 0x26, 0x82,
-	0xa1, 0x12, 0x7e, 0x0a, 0x9f, 0x3f, 0x94, 0xfd, 0x8e, 0x85, 0x89, 0x38, 0x88, 0xd3, 0x28, 0xf4,
-	0x42, 0x79, 0x34, 0xff, 0x47, 0x7b, 0x79, 0x61, 0x68, 0xf6, 0xe6, 0x25, 0xb4, 0xe7, 0x87, 0x42,
-	0x9a, 0x33, 0x43, 0x76, 0x7c, 0x5f, 0x0e, 0x02, 0x2a, 0x61, 0xa7, 0x28, 0xd6, 0xa5, 0x31, 0x3b,
-	0xa7, 0x2a, 0x63, 0xcd, 0x67, 0xde, 0xa4, 0x3e, 0x11, 0x3a, 0x63, 0xcf, 0x37, 0xb2, 0xe3, 0xfb,
-	0x87, 0xfa, 0x9d, 0x51, 0xd9, 0xf2, 0xee, 0xab, 0xab, 0xf7, 0x9d, 0xd2, 0xf5, 0xfb, 0x4e, 0xe9,
-	0xea, 0xb6, 0x63, 0x5d, 0xdf, 0x76, 0xac, 0x7f, 0x6e, 0x3b, 0xd6, 0x6f, 0x77, 0x9d, 0xd2, 0xef,
-	0x77, 0x9d, 0xd2, 0xf5, 0x5d, 0xa7, 0xf4, 0xf7, 0x5d, 0xa7, 0xf4, 0xf6, 0x8b, 0x20, 0x14, 0xe3,
-	0xc9, 0x68, 0xcb, 0x63, 0xf1, 0x76, 0x14, 0x26, 0xef, 0x48, 0x14, 0x3d, 0x8f, 0xc8, 0x28, 0xdb,
-	0x3e, 0x27, 0xc9, 0x24, 0x53, 0x7f, 0x58, 0xb6, 0xf5, 0xe8, 0x8c, 0xaa, 0xea, 0xaf, 0xc9, 0x97,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xff, 0x8c, 0xad, 0xd0, 0xcd, 0x08, 0x00, 0x00,
+	// 1102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x26, 0x29, 0x59, 0x3f, 0x23, 0x59, 0x5e, 0x6f, 0xdc, 0x94, 0x4d, 0x5a, 0x59, 0x51, 0xfa,
+	0xe3, 0x1a, 0xa8, 0x0c, 0x38, 0x28, 0x50, 0xa0, 0x97, 0xda, 0x49, 0x0a, 0x3b, 0xb5, 0x9c, 0x94,
+	0x76, 0x7c, 0xe8, 0x45, 0x58, 0x91, 0x6b, 0x8a, 0x0d, 0xc9, 0x65, 0xc9, 0x95, 0x6b, 0x5f, 0xf3,
+	0x04, 0x3d, 0xf6, 0xd2, 0x37, 0x68, 0x81, 0x3e, 0x40, 0x1f, 0xc0, 0x47, 0x1f, 0x7b, 0x32, 0x1a,
+	0xfb, 0x45, 0x8a, 0xfd, 0xa1, 0x44, 0x19, 0xb2, 0x7b, 0xe8, 0x89, 0x3b, 0x33, 0xdf, 0xec, 0x7c,
+	0xf3, 0xb7, 0x20, 0x2c, 0xa7, 0xe4, 0x98, 0x27, 0xc3, 0x0d, 0xf1, 0xe9, 0x25, 0x29, 0xe3, 0x0c,
+	0xaf, 0x84, 0x41, 0xfc, 0x86, 0x84, 0x61, 0xef, 0x84, 0xc4, 0xe3, 0xac, 0xa7, 0x00, 0x0f, 0x56,
+	0x7c, 0xe6, 0x33, 0x09, 0xd8, 0x10, 0x27, 0x85, 0xed, 0xfe, 0x69, 0xc2, 0xc2, 0xf3, 0x98, 0xa7,
+	0x67, 0x18, 0x43, 0x99, 0xd3, 0x34, 0xb2, 0xad, 0x8e, 0xb9, 0x56, 0x76, 0xe4, 0x19, 0xaf, 0xc0,
+	0x42, 0x10, 0x7b, 0xf4, 0xd4, 0x2e, 0x49, 0xa5, 0x12, 0xf0, 0x13, 0x28, 0xf3, 0xb3, 0x84, 0xda,
+	0x66, 0xc7, 0x5c, 0x6b, 0x6d, 0xae, 0xf6, 0xe6, 0x85, 0xeb, 0xc9, 0x4b, 0x0f, 0xcf, 0x12, 0xea,
+	0x48, 0xb0, 0xb8, 0xde, 0x23, 0x9c, 0xd8, 0xe5, 0x8e, 0xb9, 0xd6, 0x74, 0xe4, 0x19, 0xbf, 0x0f,
+	0xd5, 0x98, 0x79, 0x74, 0x10, 0x78, 0xf6, 0x82, 0x0c, 0x50, 0x11, 0xe2, 0xae, 0x87, 0x1f, 0x42,
+	0x3d, 0x49, 0xe9, 0xc9, 0x40, 0x12, 0xaa, 0x48, 0x53, 0x4d, 0x28, 0x0e, 0x69, 0x1a, 0x75, 0xdf,
+	0x9a, 0x80, 0x0e, 0x62, 0x92, 0x64, 0x23, 0xc6, 0xfb, 0x94, 0x13, 0x79, 0xd5, 0x33, 0x00, 0x97,
+	0xc5, 0xc7, 0x83, 0x8c, 0x13, 0xae, 0x98, 0x35, 0x6e, 0x63, 0xf6, 0x94, 0xc5, 0xc7, 0x07, 0x02,
+	0xb6, 0x5d, 0x3e, 0xbf, 0x5c, 0x35, 0x9c, 0xba, 0x9b, 0x2b, 0xa6, 0xf9, 0x5a, 0xc5, 0x7c, 0xf3,
+	0xca, 0x94, 0xa6, 0x95, 0xe9, 0x8e, 0xa0, 0x96, 0x73, 0x98, 0xa4, 0x66, 0x16, 0x52, 0xdb, 0x81,
+	0x5a, 0xa4, 0xb9, 0xc9, 0xcb, 0x1a, 0x9b, 0x9f, 0xce, 0x67, 0x73, 0x33, 0x13, 0x4d, 0x6a, 0xe2,
+	0xdd, 0xfd, 0xa3, 0x04, 0xd5, 0x3e, 0xcd, 0x32, 0xe2, 0x53, 0xfc, 0xe5, 0x4c, 0xe5, 0x1f, 0xcd,
+	0xbf, 0x51, 0x83, 0x0b, 0xb5, 0x6f, 0x81, 0xc5, 0x99, 0xce, 0xc9, 0xe2, 0x4c, 0x10, 0x3e, 0x4e,
+	0xd9, 0x24, 0x21, 0x71, 0x9e, 0x24, 0x59, 0x2e, 0xb4, 0xff, 0x03, 0xa8, 0x85, 0xcc, 0x57, 0x5d,
+	0x50, 0x0d, 0xaa, 0x86, 0xcc, 0x3f, 0x9c, 0x99, 0x8c, 0x4a, 0xb1, 0x52, 0x5f, 0x43, 0x95, 0xc6,
+	0x3c, 0x0d, 0x68, 0x66, 0x57, 0x3b, 0xa5, 0xb5, 0xc6, 0xe6, 0xc3, 0x3b, 0x86, 0x43, 0x67, 0x9a,
+	0x7b, 0xe0, 0xfb, 0x50, 0x71, 0x59, 0x14, 0x05, 0xdc, 0xae, 0xa9, 0x61, 0x50, 0x12, 0xb6, 0xa1,
+	0xea, 0xb2, 0x28, 0x21, 0x2e, 0xb7, 0x17, 0x15, 0x09, 0x2d, 0xe2, 0x6f, 0xa0, 0x96, 0xe9, 0xf2,
+	0xd9, 0x75, 0x59, 0xe4, 0xf6, 0xdd, 0x45, 0xce, 0x8b, 0x9b, 0x7b, 0x89, 0x98, 0x29, 0xfd, 0x91,
+	0xba, 0xdc, 0x86, 0x8e, 0xb9, 0x56, 0x73, 0xb4, 0x84, 0x57, 0xa1, 0xa1, 0x4e, 0x83, 0x51, 0x10,
+	0x73, 0xbb, 0x21, 0xe3, 0x82, 0x52, 0xed, 0x04, 0xb1, 0x26, 0x15, 0x73, 0x7a, 0xca, 0xed, 0xa6,
+	0x6c, 0x7b, 0x2e, 0x76, 0xbf, 0x83, 0xfa, 0x0e, 0x49, 0x3d, 0x35, 0x50, 0x79, 0x55, 0xcd, 0x42,
+	0x55, 0x31, 0x94, 0x4f, 0x18, 0xa7, 0xf9, 0xa2, 0x89, 0x73, 0x21, 0xf7, 0x52, 0x31, 0xf7, 0xee,
+	0xef, 0x26, 0xd4, 0x27, 0xf3, 0x2a, 0x50, 0x02, 0x9d, 0x66, 0xb6, 0xd9, 0x29, 0x09, 0x94, 0x92,
+	0xf0, 0x03, 0xa8, 0x85, 0x94, 0xa4, 0xb1, 0xb0, 0x58, 0xd2, 0x32, 0x91, 0xf1, 0x67, 0xb0, 0xa4,
+	0x50, 0x03, 0x36, 0xe6, 0x3e, 0x0b, 0x62, 0xdf, 0x2e, 0x49, 0x48, 0x4b, 0xa9, 0x5f, 0x6a, 0x2d,
+	0x7e, 0x0c, 0x8b, 0xb9, 0xd3, 0x20, 0x16, 0x79, 0x95, 0x25, 0xac, 0x99, 0x2b, 0xf7, 0xe9, 0x29,
+	0xc7, 0x1f, 0x01, 0x90, 0x31, 0x67, 0x83, 0x90, 0x92, 0x13, 0x2a, 0x67, 0xa2, 0xe6, 0xd4, 0x85,
+	0x66, 0x4f, 0x28, 0xba, 0xbf, 0x99, 0x00, 0x82, 0xee, 0xd3, 0x11, 0x89, 0x7d, 0x8a, 0xbf, 0xd2,
+	0xe3, 0x6a, 0xc9, 0x71, 0xfd, 0xf8, 0xf6, 0x75, 0x54, 0xf8, 0xc2, 0xc4, 0x3e, 0x9e, 0xbe, 0x0c,
+	0xb2, 0x20, 0xdb, 0x70, 0x75, 0xb9, 0x5a, 0xd9, 0x17, 0xaf, 0xc3, 0xb3, 0xc9, 0x2b, 0x51, 0xe8,
+	0x41, 0x79, 0xa6, 0x07, 0xf8, 0x3e, 0x58, 0x81, 0xa7, 0x8a, 0xbe, 0x5d, 0xb9, 0xba, 0x5c, 0xb5,
+	0x76, 0x9f, 0x39, 0x56, 0xe0, 0x75, 0x7f, 0x02, 0x34, 0x0d, 0x77, 0x10, 0xc4, 0x7e, 0x38, 0x25,
+	0x69, 0xfe, 0x1f, 0x92, 0xd6, 0x6d, 0x24, 0xbb, 0x7f, 0x99, 0xd0, 0x9c, 0x7a, 0x1f, 0x6d, 0xe2,
+	0x17, 0x00, 0x3c, 0x25, 0x71, 0x16, 0xf0, 0x80, 0xc5, 0x3a, 0xea, 0xfa, 0x7f, 0x46, 0x9d, 0x78,
+	0x38, 0x05, 0x6f, 0xfc, 0x2d, 0x54, 0x5d, 0x69, 0x57, 0x7d, 0xbf, 0xf5, 0x91, 0xb9, 0x99, 0x74,
+	0xbe, 0x7a, 0xda, 0xb9, 0x58, 0xc9, 0xd2, 0x4c, 0x25, 0xd7, 0x77, 0xa0, 0x3e, 0x79, 0xc9, 0xf1,
+	0x12, 0x34, 0xa4, 0xb0, 0xcf, 0xd2, 0x88, 0x84, 0xc8, 0xc0, 0xf7, 0x60, 0x49, 0x2a, 0xa6, 0xf7,
+	0x23, 0x13, 0xbf, 0x07, 0xcb, 0x37, 0x94, 0x47, 0x9b, 0xc8, 0x5a, 0xbf, 0xb6, 0xa0, 0x51, 0x78,
+	0x9a, 0x30, 0x40, 0xa5, 0x9f, 0xf9, 0x3b, 0xe3, 0x04, 0x19, 0xb8, 0x01, 0xd5, 0x7e, 0xe6, 0x6f,
+	0x53, 0xc2, 0x91, 0xa9, 0x85, 0x57, 0x29, 0x4b, 0x90, 0xa5, 0x51, 0x5b, 0x49, 0x82, 0x4a, 0xb8,
+	0x05, 0xa0, 0xce, 0x0e, 0xcd, 0x12, 0x54, 0xd6, 0xc0, 0x23, 0xc6, 0x29, 0x5a, 0x10, 0xdc, 0xb4,
+	0x20, 0xad, 0x15, 0x6d, 0x15, 0x9b, 0x8f, 0xaa, 0x18, 0x41, 0x53, 0x04, 0xa3, 0x24, 0xe5, 0x43,
+	0x11, 0xa5, 0x86, 0x57, 0x00, 0x15, 0x35, 0xd2, 0xa9, 0x8e, 0x31, 0xb4, 0xfa, 0x99, 0xff, 0x3a,
+	0x4e, 0x29, 0x71, 0x47, 0x64, 0x18, 0x52, 0x04, 0x78, 0x19, 0x16, 0xf5, 0x45, 0x62, 0x0b, 0xc7,
+	0x19, 0x6a, 0x68, 0xd8, 0xd3, 0x11, 0x75, 0xdf, 0x7c, 0x3f, 0x66, 0xe9, 0x38, 0x42, 0x4d, 0x91,
+	0x76, 0x3f, 0xf3, 0x65, 0xa3, 0x8e, 0x69, 0xba, 0x47, 0x89, 0x47, 0x53, 0xb4, 0xa8, 0xbd, 0x0f,
+	0x83, 0x88, 0xb2, 0x31, 0xdf, 0x67, 0x3f, 0xa3, 0x96, 0x26, 0xe3, 0x50, 0xe2, 0xed, 0x8a, 0x57,
+	0x13, 0x2d, 0x69, 0x32, 0x13, 0x8d, 0x24, 0x83, 0x74, 0xbe, 0xaf, 0x52, 0x2a, 0x53, 0x5c, 0xd6,
+	0x51, 0xb5, 0x2c, 0x31, 0x58, 0x63, 0xf6, 0x98, 0x2f, 0xe5, 0x7b, 0xeb, 0x6f, 0x4d, 0x58, 0x99,
+	0x37, 0x36, 0xf8, 0x43, 0xb0, 0xe7, 0xe9, 0xb7, 0xc6, 0x9c, 0x21, 0x03, 0x7f, 0x02, 0x8f, 0xe6,
+	0x59, 0x5f, 0xb0, 0x20, 0xe6, 0xbb, 0x51, 0x12, 0x06, 0x6e, 0x20, 0x5a, 0x73, 0x17, 0xec, 0xf9,
+	0xa9, 0x86, 0x59, 0xeb, 0x67, 0xd0, 0x9a, 0x5d, 0x18, 0x51, 0x9c, 0xa9, 0x66, 0xcb, 0xf3, 0xc4,
+	0x92, 0x20, 0x03, 0xdb, 0x45, 0xb2, 0x0e, 0x8d, 0xd8, 0x09, 0x95, 0x16, 0x73, 0xd6, 0xf2, 0x3a,
+	0xf1, 0x08, 0x57, 0x16, 0x6b, 0x36, 0x91, 0x2d, 0xcf, 0xdb, 0x53, 0xef, 0x93, 0xb4, 0x96, 0xb6,
+	0x5f, 0xfe, 0xf0, 0xb9, 0x1f, 0xf0, 0xd1, 0x78, 0xd8, 0x73, 0x59, 0xb4, 0xa1, 0x97, 0xe1, 0x8b,
+	0x90, 0x0c, 0xb3, 0x0d, 0xb9, 0x11, 0xf2, 0x3f, 0x69, 0x43, 0xad, 0xc5, 0xf9, 0xbb, 0xb6, 0x71,
+	0xf1, 0xae, 0x6d, 0x9c, 0x5f, 0xb5, 0xcd, 0x8b, 0xab, 0xb6, 0xf9, 0xcf, 0x55, 0xdb, 0xfc, 0xe5,
+	0xba, 0x6d, 0xfc, 0x7a, 0xdd, 0x36, 0x2e, 0xae, 0xdb, 0xc6, 0xdf, 0xd7, 0x6d, 0x63, 0x58, 0x91,
+	0xff, 0x49, 0x4f, 0xfe, 0x1d, 0x00, 0xdf, 0x78, 0xf7, 0xc9, 0x68, 0x09, 0x00, 0x00,
 }
 
 func (m *Entry) Marshal() (dAtA []byte, err error) {
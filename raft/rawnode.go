@@ -90,6 +90,12 @@ func (rn *RawNode) Propose(data []byte) error {
 		}})
 }
 
+// ProposeWithCallback proposes data be appended to the raft log, the callbacks are
+// invoked like the ones proposed by Node.Propose.
+func (rn *RawNode) ProposeWithCallback(pds ...ProposeData) {
+	rn.raft.Propose(pds...)
+}
+
 // ProposeConfChange proposes a config change. See (Node).ProposeConfChange for
 // details.
 func (rn *RawNode) ProposeConfChange(cc pb.ConfChangeI) error {
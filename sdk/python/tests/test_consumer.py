@@ -0,0 +1,108 @@
+# Copyright 2023 Linkall Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+import json
+import threading
+import unittest
+import urllib.parse
+from datetime import datetime, timezone
+from http.server import BaseHTTPRequestHandler, HTTPServer
+
+from vanus import EARLIEST, Consumer, VanusError
+
+
+class _Gateway(BaseHTTPRequestHandler):
+    """Fakes the consume endpoints of gateway, the requests are recorded in server.requests."""
+
+    def do_GET(self):
+        self._serve(None)
+
+    def do_POST(self):
+        length = int(self.headers.get("Content-Length", 0))
+        self._serve(json.loads(self.rfile.read(length)))
+
+    def _serve(self, body):
+        url = urllib.parse.urlparse(self.path)
+        query = dict(urllib.parse.parse_qsl(url.query))
+        self.server.requests.append((self.command, url.path, query, body, self.headers.get("Authorization")))
+        status, res = self.server.responses.pop(0)
+        data = json.dumps(res).encode() if isinstance(res, dict) else res.encode()
+        self.send_response(status)
+        self.send_header("Content-Length", str(len(data)))
+        self.end_headers()
+        self.wfile.write(data)
+
+    def log_message(self, *args):
+        pass
+
+
+class ConsumerTest(unittest.TestCase):
+    def setUp(self):
+        self.server = HTTPServer(("127.0.0.1", 0), _Gateway)
+        self.server.requests = []
+        self.server.responses = []
+        threading.Thread(target=self.server.serve_forever, daemon=True).start()
+        endpoint = "http://127.0.0.1:%d" % self.server.server_port
+        self.consumer = Consumer(endpoint, "orders", "c1", token="t1", filters=[{"exact": {"type": "a"}}])
+
+    def tearDown(self):
+        self.server.shutdown()
+        self.server.server_close()
+
+    def test_pull_and_commit(self):
+        event = {"specversion": "1.0", "id": "1", "source": "/test", "type": "a"}
+        self.server.responses = [
+            (200, {"events": [event], "cursor": "cursor-1"}),
+            (200, {"events": [], "cursor": "cursor-1"}),
+            (204, ""),
+        ]
+        self.assertEqual(self.consumer.pull(max_events=10), [event])
+        self.assertEqual(self.consumer.cursor, "cursor-1")
+        self.assertEqual(self.consumer.pull(), [])
+        self.consumer.commit()
+
+        method, path, query, _, auth = self.server.requests[0]
+        self.assertEqual((method, path), ("GET", "/consume/eventbus/orders/events"))
+        self.assertEqual(query, {"consumer": "c1", "filters": '[{"exact": {"type": "a"}}]', "max": "10"})
+        self.assertEqual(auth, "Bearer t1")
+        self.assertEqual(self.server.requests[1][2]["cursor"], "cursor-1")
+        self.assertEqual(self.server.requests[2][3], {"consumer": "c1", "cursor": "cursor-1"})
+
+    def test_seek_and_committed(self):
+        self.server.responses = [
+            (200, {"cursor": "cursor-0"}),
+            (200, {"cursor": "cursor-2"}),
+            (200, {"cursor": ""}),
+        ]
+        self.assertEqual(self.consumer.seek(EARLIEST), "cursor-0")
+        self.assertEqual(self.consumer.cursor, "cursor-0")
+        self.consumer.seek(timestamp=datetime(2023, 1, 1, tzinfo=timezone.utc))
+        self.assertIsNone(self.consumer.committed())
+
+        self.assertEqual(self.server.requests[0][3], {"consumer": "c1", "position": "earliest"})
+        self.assertEqual(self.server.requests[1][3]["time"], "2023-01-01T00:00:00+00:00")
+        self.assertEqual(self.server.requests[2][2], {"consumer": "c1"})
+
+    def test_error(self):
+        self.server.responses = [(400, "invalid cursor\n")]
+        with self.assertRaises(VanusError) as ctx:
+            self.consumer.pull()
+        self.assertEqual(ctx.exception.status, 400)
+        self.assertEqual(ctx.exception.message, "invalid cursor")
+        with self.assertRaises(ValueError):
+            Consumer("http://127.0.0.1:1", "orders", "c1").commit()
+
+
+if __name__ == "__main__":
+    unittest.main()
@@ -0,0 +1,24 @@
+# Copyright 2023 Linkall Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Python SDK of Vanus.
+
+The SDK consumes eventbuses by the REST API of gateway, which is enabled by the consume section of
+the gateway config. Events are published to the CloudEvents endpoint of gateway by any CloudEvents SDK.
+"""
+
+from .consumer import EARLIEST, LATEST, Consumer, VanusError
+
+__all__ = ["Consumer", "VanusError", "EARLIEST", "LATEST"]
+__version__ = "0.1.0"
@@ -0,0 +1,146 @@
+# Copyright 2023 Linkall Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+"""Consumer of an eventbus by the REST API of gateway.
+
+A consumer pulls events with the cursor returned by its last pull, so events are read exactly once
+by a consumer as long as it's the only one pulling. The cursor committed to the controller is where
+the consumer resumes after restarting, on any gateway.
+"""
+
+import json
+import time
+import urllib.error
+import urllib.parse
+import urllib.request
+
+EARLIEST = "earliest"
+LATEST = "latest"
+
+_PREFIX = "/consume/eventbus/"
+
+
+class VanusError(Exception):
+    """The request is rejected by gateway, status is the HTTP status code."""
+
+    def __init__(self, status, message):
+        super().__init__("%d: %s" % (status, message))
+        self.status = status
+        self.message = message
+
+
+class Consumer:
+    """Consumer pulls events of an eventbus and commits the offsets as the consumer name.
+
+    The name is a consumer group of the eventbus without members, the offsets of a group which has
+    members joined by the Go client can't be committed by the REST API.
+
+    :param endpoint: the address of consume endpoint of gateway, e.g. http://127.0.0.1:8085
+    :param eventbus: the name of eventbus
+    :param consumer: the name of consumer
+    :param token: the bearer token if ACL of gateway is enabled
+    :param filters: the filters of subscription, only the events passed them are pulled
+    :param max_events: the max number of events of each pull, it's capped by gateway
+    :param timeout: the timeout in seconds of each request
+    """
+
+    def __init__(self, endpoint, eventbus, consumer, token=None, filters=None, max_events=None, timeout=10.0):
+        self._base = endpoint.rstrip("/") + _PREFIX + urllib.parse.quote(eventbus, safe="") + "/"
+        self.eventbus = eventbus
+        self.consumer = consumer
+        self._token = token
+        self._filters = json.dumps(filters) if filters else None
+        self._max_events = max_events
+        self._timeout = timeout
+        self._cursor = None
+
+    @property
+    def cursor(self):
+        """The position after the events pulled, it's None before the first pull."""
+        return self._cursor
+
+    def pull(self, max_events=None):
+        """Pulls the events after the cursor, or after the committed offsets before the first pull.
+
+        An eventbus the consumer has never committed is pulled from the latest. It returns the events
+        in structured JSON of CloudEvents, which is empty if there is no new event.
+        """
+        query = {"consumer": self.consumer}
+        if self._cursor:
+            query["cursor"] = self._cursor
+        if self._filters:
+            query["filters"] = self._filters
+        max_events = max_events or self._max_events
+        if max_events:
+            query["max"] = str(max_events)
+        res = self._request("GET", "events?" + urllib.parse.urlencode(query))
+        self._cursor = res["cursor"]
+        return res["events"]
+
+    def commit(self, cursor=None):
+        """Commits the cursor, defaults to the one after the events pulled."""
+        cursor = cursor or self._cursor
+        if not cursor:
+            raise ValueError("nothing to commit")
+        self._request("POST", "commit", {"consumer": self.consumer, "cursor": cursor})
+
+    def committed(self):
+        """Returns the committed cursor, or None if the consumer has never committed."""
+        query = urllib.parse.urlencode({"consumer": self.consumer})
+        return self._request("GET", "commit?" + query)["cursor"] or None
+
+    def seek(self, position=None, timestamp=None):
+        """Moves the consumer to the position and commits it.
+
+        :param position: EARLIEST, LATEST or a cursor
+        :param timestamp: a datetime, seeks to the events stored since it and takes precedence, a naive
+            one is in local time
+        """
+        if position is None and timestamp is None:
+            raise ValueError("either position or timestamp is required")
+        body = {"consumer": self.consumer, "position": position or ""}
+        if timestamp is not None:
+            body["time"] = timestamp.astimezone().isoformat()
+        self._cursor = self._request("POST", "seek", body)["cursor"]
+        return self._cursor
+
+    def run(self, handler, idle_interval=1.0, auto_commit=True):
+        """Calls handler with each event pulled until it raises, the cursor is committed after each
+        non-empty pull if auto_commit is set, so a failed batch is pulled again after restarting.
+        """
+        while True:
+            events = self.pull()
+            if not events:
+                time.sleep(idle_interval)
+                continue
+            for event in events:
+                handler(event)
+            if auto_commit:
+                self.commit()
+
+    def _request(self, method, path, body=None):
+        headers = {"Accept": "application/json"}
+        data = None
+        if body is not None:
+            data = json.dumps(body).encode("utf-8")
+            headers["Content-Type"] = "application/json"
+        if self._token:
+            headers["Authorization"] = "Bearer " + self._token
+        req = urllib.request.Request(self._base + path, data=data, headers=headers, method=method)
+        try:
+            with urllib.request.urlopen(req, timeout=self._timeout) as resp:
+                payload = resp.read()
+        except urllib.error.HTTPError as e:
+            raise VanusError(e.code, e.read().decode("utf-8", "replace").strip()) from None
+        return json.loads(payload) if payload else {}
@@ -0,0 +1,151 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func eventbusACLCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acl sub-command",
+		Short: "sub-commands for the ACL entries which allow principals to access an eventbus",
+	}
+	cmd.AddCommand(putEventbusACLCommand())
+	cmd.AddCommand(deleteEventbusACLCommand())
+	cmd.AddCommand(listEventbusACLCommand())
+	return cmd
+}
+
+func putEventbusACLCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "allow a principal to publish, subscribe or admin an eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedWithHelpNotice(cmd, "the --eventbus flag MUST be set\n")
+			}
+			if aclPrincipal == "" {
+				cmdFailedWithHelpNotice(cmd, "the --principal flag MUST be set\n")
+			}
+			if len(aclPermissions) == 0 {
+				cmdFailedWithHelpNotice(cmd, "the --permissions flag MUST be set\n")
+			}
+			res, err := client.PutEventbusACL(context.Background(), &ctrlpb.EventbusACL{
+				Eventbus:    eventbus,
+				Principal:   aclPrincipal,
+				Permissions: aclPermissions,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "put eventbus acl failed: %s", err)
+			}
+			printEventbusACL(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "eventbus name")
+	cmd.Flags().StringVar(&aclPrincipal, "principal", "", "principal name, * matches all principals")
+	cmd.Flags().StringSliceVar(&aclPermissions, "permissions", nil,
+		"comma separated permissions: publish, subscribe or admin")
+	return cmd
+}
+
+func deleteEventbusACLCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete the ACL entry of a principal",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedWithHelpNotice(cmd, "the --eventbus flag MUST be set\n")
+			}
+			if aclPrincipal == "" {
+				cmdFailedWithHelpNotice(cmd, "the --principal flag MUST be set\n")
+			}
+			_, err := client.DeleteEventbusACL(context.Background(), &ctrlpb.DeleteEventbusACLRequest{
+				Eventbus:  eventbus,
+				Principal: aclPrincipal,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete eventbus acl failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"eventbus": eventbus, "principal": aclPrincipal})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"eventbus", "principal"})
+				t.AppendRow(table.Row{eventbus, aclPrincipal})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "eventbus name")
+	cmd.Flags().StringVar(&aclPrincipal, "principal", "", "principal name")
+	return cmd
+}
+
+func listEventbusACLCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the ACL entries of an eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedWithHelpNotice(cmd, "the --eventbus flag MUST be set\n")
+			}
+			res, err := client.ListEventbusACL(context.Background(), &ctrlpb.ListEventbusACLRequest{
+				Eventbus: eventbus,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list eventbus acl failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res)
+				return
+			}
+			printEventbusACL(cmd, res.Acls...)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "eventbus name")
+	return cmd
+}
+
+func printEventbusACL(cmd *cobra.Command, data ...*ctrlpb.EventbusACL) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"eventbus", "principal", "permissions"})
+	for _, a := range data {
+		t.AppendRow(table.Row{a.Eventbus, a.Principal, strings.Join(a.Permissions, ",")})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+		{Number: 3, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
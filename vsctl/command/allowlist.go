@@ -0,0 +1,146 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewAllowlistCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowlist sub-command",
+		Short: "sub-commands for the source IP allowlists of eventbuses and namespaces enforced by gateway",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(putAllowlistCommand())
+	cmd.AddCommand(deleteAllowlistCommand())
+	cmd.AddCommand(listAllowlistCommand())
+	return cmd
+}
+
+func putAllowlistCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "allow only the CIDRs to access an eventbus or a namespace",
+		Run: func(cmd *cobra.Command, args []string) {
+			if allowlistName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			if len(allowlistCIDRs) == 0 {
+				cmdFailedWithHelpNotice(cmd, "the --cidrs flag MUST be set\n")
+			}
+			res, err := client.PutIPAllowlist(context.Background(), &ctrlpb.IPAllowlist{
+				Kind:  allowlistKind,
+				Name:  allowlistName,
+				Cidrs: allowlistCIDRs,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "put ip allowlist failed: %s", err)
+			}
+			printAllowlist(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&allowlistKind, "kind", "eventbus", "eventbus or namespace")
+	cmd.Flags().StringVar(&allowlistName, "name", "", "eventbus or namespace name")
+	cmd.Flags().StringSliceVar(&allowlistCIDRs, "cidrs", nil,
+		"comma separated CIDRs, e.g. 10.0.0.0/8,192.168.1.1")
+	return cmd
+}
+
+func deleteAllowlistCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete the ip allowlist of an eventbus or a namespace",
+		Run: func(cmd *cobra.Command, args []string) {
+			if allowlistName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			_, err := client.DeleteIPAllowlist(context.Background(), &ctrlpb.DeleteIPAllowlistRequest{
+				Kind: allowlistKind,
+				Name: allowlistName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete ip allowlist failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"kind": allowlistKind, "name": allowlistName})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"kind", "name"})
+				t.AppendRow(table.Row{allowlistKind, allowlistName})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, AlignHeader: text.AlignCenter},
+					{Number: 2, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&allowlistKind, "kind", "eventbus", "eventbus or namespace")
+	cmd.Flags().StringVar(&allowlistName, "name", "", "eventbus or namespace name")
+	return cmd
+}
+
+func listAllowlistCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the ip allowlists",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListIPAllowlist(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list ip allowlist failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res)
+				return
+			}
+			printAllowlist(cmd, res.Allowlists...)
+		},
+	}
+	return cmd
+}
+
+func printAllowlist(cmd *cobra.Command, data ...*ctrlpb.IPAllowlist) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"kind", "name", "cidrs"})
+	for _, a := range data {
+		t.AppendRow(table.Row{a.Kind, a.Name, strings.Join(a.Cidrs, ",")})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+		{Number: 3, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
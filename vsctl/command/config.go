@@ -0,0 +1,440 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	EnvGatewayEndpoint = "VANUS_GATEWAY"
+	namespaceHeader    = "X-Vanus-Namespace"
+)
+
+// vsctlConfig is the config file of vsctl, it keeps the contexts of clusters like kubeconfig.
+type vsctlConfig struct {
+	CurrentContext string            `yaml:"current-context" json:"current_context"`
+	Contexts       []*clusterContext `yaml:"contexts" json:"contexts"`
+}
+
+// clusterContext is how vsctl connects to a cluster.
+type clusterContext struct {
+	Name     string `yaml:"name" json:"name"`
+	Endpoint string `yaml:"endpoint" json:"endpoint"`
+	// Token is sent as the bearer token of Authorization.
+	Token string `yaml:"token,omitempty" json:"-"`
+	// Namespace is sent by the X-Vanus-Namespace header.
+	Namespace string           `yaml:"namespace,omitempty" json:"namespace"`
+	TLS       clusterTLSConfig `yaml:"tls,omitempty" json:"tls"`
+}
+
+type clusterTLSConfig struct {
+	Enable             bool   `yaml:"enable,omitempty" json:"enable"`
+	CAFile             string `yaml:"ca_file,omitempty" json:"ca_file"`
+	CertFile           string `yaml:"cert_file,omitempty" json:"cert_file"`
+	KeyFile            string `yaml:"key_file,omitempty" json:"key_file"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty" json:"insecure_skip_verify"`
+}
+
+func (c *vsctlConfig) context(name string) *clusterContext {
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ctx
+		}
+	}
+	return nil
+}
+
+func (c *vsctlConfig) deleteContext(name string) bool {
+	for idx, ctx := range c.Contexts {
+		if ctx.Name == name {
+			c.Contexts = append(c.Contexts[:idx], c.Contexts[idx+1:]...)
+			if c.CurrentContext == name {
+				c.CurrentContext = ""
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func mustGetConfigPath(cmd *cobra.Command) string {
+	path, err := cmd.Flags().GetString("config")
+	if err != nil {
+		cmdFailedf(cmd, "get config file failed: %s", err)
+	}
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			cmdFailedf(cmd, "get home directory failed: %s", err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+	return path
+}
+
+// loadConfig returns an empty config if the file doesn't exist.
+func loadConfig(path string) (*vsctlConfig, error) {
+	cfg := &vsctlConfig{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func saveConfig(path string, cfg *vsctlConfig) error {
+	data, err := marshalYAML(cfg)
+	if err != nil {
+		return err
+	}
+	// the config file has tokens.
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+var resolvedContext *clusterContext
+
+// mustGetContext returns the context used by the command, it's selected by --context or the current
+// context of config file. The --endpoint flag and VANUS_GATEWAY env override the endpoint of context.
+func mustGetContext(cmd *cobra.Command) *clusterContext {
+	if resolvedContext != nil {
+		return resolvedContext
+	}
+	endpoint, err := cmd.Flags().GetString("endpoint")
+	if err != nil {
+		cmdFailedf(cmd, "get gateway endpoint failed: %s", err)
+	}
+	name, _ := cmd.Flags().GetString("context")
+	cfg, err := loadConfig(mustGetConfigPath(cmd))
+	if err != nil {
+		// the config file isn't required if the context isn't specified.
+		if name != "" {
+			cmdFailedf(cmd, "load config failed: %s", err)
+		}
+		cfg = &vsctlConfig{}
+	}
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+
+	c := &clusterContext{Endpoint: endpoint}
+	if name != "" {
+		ctx := cfg.context(name)
+		if ctx == nil {
+			cmdFailedf(cmd, "the context %s doesn't exist", name)
+		}
+		*c = *ctx
+		if c.Endpoint == "" || cmd.Flags().Changed("endpoint") || os.Getenv(EnvGatewayEndpoint) != "" {
+			c.Endpoint = endpoint
+		}
+	}
+	resolvedContext = c
+	return c
+}
+
+func (c *clusterContext) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		InsecureSkipVerify: c.TLS.InsecureSkipVerify, //nolint:gosec // it's set by user explicitly.
+		MinVersion:         tls.VersionTLS12,
+	}
+	if c.TLS.CAFile != "" {
+		ca, err := os.ReadFile(c.TLS.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificate in CA file %s", c.TLS.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if c.TLS.CertFile != "" || c.TLS.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.TLS.CertFile, c.TLS.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+func (c *clusterContext) dialOptions() ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+	if c.TLS.Enable {
+		cfg, err := c.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	if c.Token != "" || c.Namespace != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&contextCredentials{ctx: c}))
+	}
+	return opts, nil
+}
+
+// httpHeaders returns the headers of requests sent to the CloudEvents endpoint.
+func (c *clusterContext) httpHeaders() http.Header {
+	h := http.Header{}
+	if c.Token != "" {
+		h.Set("Authorization", "Bearer "+c.Token)
+	}
+	if c.Namespace != "" {
+		h.Set(namespaceHeader, c.Namespace)
+	}
+	return h
+}
+
+// contextCredentials attaches the token and namespace of context to each RPC.
+type contextCredentials struct {
+	ctx *clusterContext
+}
+
+func (cc *contextCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	md := map[string]string{}
+	if cc.ctx.Token != "" {
+		md["authorization"] = "Bearer " + cc.ctx.Token
+	}
+	if cc.ctx.Namespace != "" {
+		md[strings.ToLower(namespaceHeader)] = cc.ctx.Namespace
+	}
+	return md, nil
+}
+
+func (cc *contextCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config sub-command",
+		Short: "sub-commands for the contexts of clusters in config file",
+	}
+	cmd.AddCommand(setContextCommand())
+	cmd.AddCommand(useContextCommand())
+	cmd.AddCommand(getContextsCommand())
+	cmd.AddCommand(currentContextCommand())
+	cmd.AddCommand(deleteContextCommand())
+	return cmd
+}
+
+func setContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-context <name>",
+		Short: "create or update a context, only the specified fields are updated",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 || args[0] == "" {
+				cmdFailedWithHelpNotice(cmd, "context name can't be empty\n")
+			}
+			path := mustGetConfigPath(cmd)
+			cfg, err := loadConfig(path)
+			if err != nil {
+				cmdFailedf(cmd, "load config failed: %s", err)
+			}
+			ctx := cfg.context(args[0])
+			if ctx == nil {
+				ctx = &clusterContext{Name: args[0]}
+				cfg.Contexts = append(cfg.Contexts, ctx)
+			}
+			flags := cmd.Flags()
+			if flags.Changed("endpoint") {
+				ctx.Endpoint, _ = flags.GetString("endpoint")
+			}
+			if flags.Changed("token") {
+				ctx.Token = contextToken
+			}
+			if flags.Changed("namespace") {
+				ctx.Namespace = contextNamespace
+			}
+			if flags.Changed("tls") {
+				ctx.TLS.Enable = contextTLS.Enable
+			}
+			if flags.Changed("tls-ca-file") {
+				ctx.TLS.CAFile = contextTLS.CAFile
+			}
+			if flags.Changed("tls-cert-file") {
+				ctx.TLS.CertFile = contextTLS.CertFile
+			}
+			if flags.Changed("tls-key-file") {
+				ctx.TLS.KeyFile = contextTLS.KeyFile
+			}
+			if flags.Changed("tls-insecure-skip-verify") {
+				ctx.TLS.InsecureSkipVerify = contextTLS.InsecureSkipVerify
+			}
+			if ctx.Endpoint == "" {
+				cmdFailedf(cmd, "the --endpoint flag MUST be set for the new context")
+			}
+			// the first context is used by default.
+			if cfg.CurrentContext == "" {
+				cfg.CurrentContext = ctx.Name
+			}
+			if err = saveConfig(path, cfg); err != nil {
+				cmdFailedf(cmd, "save config failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, ctx)
+				return
+			}
+			color.Green("context %s is set\n", ctx.Name)
+		},
+	}
+	cmd.Flags().StringVar(&contextToken, "token", "", "the token to access the cluster")
+	cmd.Flags().StringVar(&contextNamespace, "namespace", "", "the default namespace")
+	cmd.Flags().BoolVar(&contextTLS.Enable, "tls", false, "connect to the cluster with TLS")
+	cmd.Flags().StringVar(&contextTLS.CAFile, "tls-ca-file", "", "the CA certificate to verify the cluster")
+	cmd.Flags().StringVar(&contextTLS.CertFile, "tls-cert-file", "", "the client certificate")
+	cmd.Flags().StringVar(&contextTLS.KeyFile, "tls-key-file", "", "the key of client certificate")
+	cmd.Flags().BoolVar(&contextTLS.InsecureSkipVerify, "tls-insecure-skip-verify", false,
+		"don't verify the certificate of the cluster")
+	return cmd
+}
+
+func useContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use-context <name>",
+		Short: "set the current context",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 || args[0] == "" {
+				cmdFailedWithHelpNotice(cmd, "context name can't be empty\n")
+			}
+			path := mustGetConfigPath(cmd)
+			cfg, err := loadConfig(path)
+			if err != nil {
+				cmdFailedf(cmd, "load config failed: %s", err)
+			}
+			if cfg.context(args[0]) == nil {
+				cmdFailedWithExitCode(cmd, ExitCodeNotFound, "the context %s doesn't exist", args[0])
+			}
+			cfg.CurrentContext = args[0]
+			if err = saveConfig(path, cfg); err != nil {
+				cmdFailedf(cmd, "save config failed: %s", err)
+			}
+			color.Green("switched to context %s\n", args[0])
+		},
+	}
+	return cmd
+}
+
+func getContextsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "list the contexts",
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := loadConfig(mustGetConfigPath(cmd))
+			if err != nil {
+				cmdFailedf(cmd, "load config failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, cfg)
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Current", "Name", "Endpoint", "Namespace", "TLS", "Token"})
+			for _, ctx := range cfg.Contexts {
+				current := ""
+				if ctx.Name == cfg.CurrentContext {
+					current = "*"
+				}
+				token := ""
+				if ctx.Token != "" {
+					token = "<set>"
+				}
+				t.AppendRow(table.Row{current, ctx.Name, ctx.Endpoint, ctx.Namespace, ctx.TLS.Enable, token})
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	return cmd
+}
+
+func currentContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-context",
+		Short: "print the current context",
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg, err := loadConfig(mustGetConfigPath(cmd))
+			if err != nil {
+				cmdFailedf(cmd, "load config failed: %s", err)
+			}
+			if cfg.CurrentContext == "" {
+				cmdFailedWithExitCode(cmd, ExitCodeNotFound, "the current context isn't set")
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"current_context": cfg.CurrentContext})
+				return
+			}
+			fmt.Println(cfg.CurrentContext)
+		},
+	}
+	return cmd
+}
+
+func deleteContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-context <name>",
+		Short: "delete a context",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 || args[0] == "" {
+				cmdFailedWithHelpNotice(cmd, "context name can't be empty\n")
+			}
+			path := mustGetConfigPath(cmd)
+			cfg, err := loadConfig(path)
+			if err != nil {
+				cmdFailedf(cmd, "load config failed: %s", err)
+			}
+			if !cfg.deleteContext(args[0]) {
+				cmdFailedWithExitCode(cmd, ExitCodeNotFound, "the context %s doesn't exist", args[0])
+			}
+			if err = saveConfig(path, cfg); err != nil {
+				cmdFailedf(cmd, "save config failed: %s", err)
+			}
+			color.Green("context %s is deleted\n", args[0])
+		},
+	}
+	return cmd
+}
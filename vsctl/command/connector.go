@@ -0,0 +1,223 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewConnectorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connector sub-command",
+		Short: "sub-commands for source and sink connectors which run on trigger workers",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(putConnectorCommand())
+	cmd.AddCommand(scaleConnectorCommand())
+	cmd.AddCommand(getConnectorCommand())
+	cmd.AddCommand(listConnectorCommand())
+	cmd.AddCommand(deleteConnectorCommand())
+	return cmd
+}
+
+func putConnectorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "create a connector or replace the config of it, the instances are restarted with the new config",
+		Run: func(cmd *cobra.Command, args []string) {
+			if connectorName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			if connectorKind == "" {
+				cmdFailedWithHelpNotice(cmd, "the --kind flag MUST be set\n")
+			}
+			if connectorPlugin == "" {
+				cmdFailedWithHelpNotice(cmd, "the --plugin flag MUST be set\n")
+			}
+			if connectorEventbus == "" {
+				cmdFailedWithHelpNotice(cmd, "the --eventbus flag MUST be set\n")
+			}
+			res, err := client.PutConnector(context.Background(), &ctrlpb.Connector{
+				Name:     connectorName,
+				Kind:     connectorKind,
+				Plugin:   connectorPlugin,
+				Eventbus: connectorEventbus,
+				Config:   connectorConfig,
+				Replicas: connectorReplicas,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "put connector failed: %s", err)
+			}
+			printConnector(cmd, false, true, res)
+		},
+	}
+	cmd.Flags().StringVar(&connectorName, "name", "", "connector name")
+	cmd.Flags().StringVar(&connectorKind, "kind", "", "connector kind: source or sink")
+	cmd.Flags().StringVar(&connectorPlugin, "plugin", "", "the plugin installed on trigger workers, e.g. postgres-cdc")
+	cmd.Flags().StringVar(&connectorEventbus, "eventbus", "",
+		"the eventbus which a source appends events to or a sink consumes events from")
+	cmd.Flags().StringToStringVar(&connectorConfig, "config", nil, "the config passed to the plugin, e.g. dsn=...")
+	cmd.Flags().Uint32Var(&connectorReplicas, "replicas", 1, "the number of instances, 0 stops the connector")
+	return cmd
+}
+
+func scaleConnectorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scale",
+		Short: "change the number of instances of a connector",
+		Run: func(cmd *cobra.Command, args []string) {
+			if connectorName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			ctx := context.Background()
+			c, err := client.GetConnector(ctx, &ctrlpb.GetConnectorRequest{Name: connectorName})
+			if err != nil {
+				cmdFailedf(cmd, "get connector failed: %s", err)
+			}
+			c.Replicas = connectorReplicas
+			res, err := client.PutConnector(ctx, c)
+			if err != nil {
+				cmdFailedf(cmd, "scale connector failed: %s", err)
+			}
+			printConnector(cmd, false, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&connectorName, "name", "", "connector name")
+	cmd.Flags().Uint32Var(&connectorReplicas, "replicas", 1, "the number of instances, 0 stops the connector")
+	return cmd
+}
+
+func getConnectorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "get the connector with the config",
+		Run: func(cmd *cobra.Command, args []string) {
+			if connectorName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			res, err := client.GetConnector(context.Background(), &ctrlpb.GetConnectorRequest{
+				Name: connectorName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "get connector failed: %s", err)
+			}
+			printConnector(cmd, false, true, res)
+		},
+	}
+	cmd.Flags().StringVar(&connectorName, "name", "", "connector name")
+	return cmd
+}
+
+func listConnectorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the connectors",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListConnector(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list connector failed: %s", err)
+			}
+			printConnector(cmd, true, false, res.Connectors...)
+		},
+	}
+	return cmd
+}
+
+func deleteConnectorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete a connector, the instances are stopped and the positions of a source are dropped",
+		Run: func(cmd *cobra.Command, args []string) {
+			if connectorName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			_, err := client.DeleteConnector(context.Background(), &ctrlpb.DeleteConnectorRequest{
+				Name: connectorName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete connector failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"connector": connectorName})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"connector"})
+				t.AppendRow(table.Row{connectorName})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&connectorName, "name", "", "connector name to deleting")
+	return cmd
+}
+
+func printConnector(cmd *cobra.Command, showNo, showConfig bool, data ...*ctrlpb.Connector) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"name", "kind", "plugin", "eventbus", "replicas", "version", "created_at", "updated_at"}
+	if showNo {
+		header = append(table.Row{"no."}, header...)
+	}
+	if showConfig {
+		header = append(header, "config")
+	}
+	t.AppendHeader(header)
+	for idx, c := range data {
+		var row table.Row
+		if showNo {
+			row = append(row, idx+1)
+		}
+		row = append(row, c.Name, c.Kind, c.Plugin, c.Eventbus, c.Replicas, c.Version,
+			time.UnixMilli(c.CreatedAt).Format(time.RFC3339), time.UnixMilli(c.UpdatedAt).Format(time.RFC3339))
+		if showConfig {
+			config := make([]string, 0, len(c.Config))
+			for k, v := range c.Config {
+				config = append(config, k+"="+v)
+			}
+			sort.Strings(config)
+			row = append(row, strings.Join(config, "\n"))
+		}
+		t.AppendRow(row)
+	}
+	colConfigs := make([]table.ColumnConfig, len(header))
+	for idx := range header {
+		colConfigs[idx] = table.ColumnConfig{Number: idx + 1, AlignHeader: text.AlignCenter}
+	}
+	t.SetColumnConfigs(colConfigs)
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func NewCronCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron sub-command",
+		Short: "sub-commands for cron event operations",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(createCronEventCommand())
+	cmd.AddCommand(listCronEventCommand())
+	cmd.AddCommand(deleteCronEventCommand())
+	return cmd
+}
+
+func createCronEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a cron event which is fired into the eventbus repeatedly",
+		Run: func(cmd *cobra.Command, args []string) {
+			if cronName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			if cronSchedule == "" {
+				cmdFailedWithHelpNotice(cmd, "the --schedule flag MUST be set\n")
+			}
+			if eventbus == "" {
+				cmdFailedWithHelpNotice(cmd, "the --eventbus flag MUST be set\n")
+			}
+			template := &ctrlpb.CronEventTemplate{
+				Type:    eventType,
+				Source:  eventSource,
+				Subject: eventSubject,
+				Data:    []byte(eventData),
+			}
+			if eventData != "" {
+				if strings.ToLower(dataFormat) == "json" {
+					template.DataContentType = "application/json"
+				} else {
+					template.DataContentType = "text/plain"
+				}
+			}
+			res, err := client.CreateCronEvent(context.Background(), &ctrlpb.CreateCronEventRequest{
+				Name:        cronName,
+				Schedule:    cronSchedule,
+				Eventbus:    eventbus,
+				Template:    template,
+				Description: description,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "create cron event failed: %s", err)
+			}
+			printCronEvent(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&cronName, "name", "", "cron event name")
+	cmd.Flags().StringVar(&cronSchedule, "schedule", "", "cron expression in UTC, "+
+		"e.g. \"*/5 * * * *\" or @hourly")
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "the eventbus which the event is fired into")
+	cmd.Flags().StringVar(&eventType, "type", "", "type of the fired event")
+	cmd.Flags().StringVar(&eventSource, "source", "", "source of the fired event")
+	cmd.Flags().StringVar(&eventSubject, "subject", "", "subject of the fired event")
+	cmd.Flags().StringVar(&eventData, "data", "", "data of the fired event")
+	cmd.Flags().StringVar(&dataFormat, "data-format", "json", "the format of event body, JSON or plain")
+	cmd.Flags().StringVar(&description, "description", "", "cron event description")
+	return cmd
+}
+
+func listCronEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the cron events",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListCronEvent(context.Background(), &ctrlpb.ListCronEventRequest{
+				Eventbus: eventbus,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list cron event failed: %s", err)
+			}
+			printCronEvent(cmd, true, res.CronEvents...)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "only list the cron events of the eventbus")
+	return cmd
+}
+
+func deleteCronEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete a cron event",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(cronIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, "invalid cron event id: "+err.Error()+"\n")
+			}
+			_, err = client.DeleteCronEvent(context.Background(), &ctrlpb.DeleteCronEventRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete cron event failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"cron_event_id": id.String()})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"cron_event_id"})
+				t.AppendRow(table.Row{id.String()})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&cronIDStr, "id", "", "cron event id to deleting")
+	return cmd
+}
+
+func printCronEvent(cmd *cobra.Command, showNo bool, data ...*ctrlpb.CronEvent) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"id", "name", "schedule", "eventbus", "type", "source", "description", "created_at"}
+	if showNo {
+		header = append(table.Row{"no."}, header...)
+	}
+	t.AppendHeader(header)
+	for idx, ce := range data {
+		var row table.Row
+		if showNo {
+			row = append(row, idx+1)
+		}
+		row = append(row, formatID(ce.Id), ce.Name, ce.Schedule, ce.Eventbus, ce.Template.GetType(),
+			ce.Template.GetSource(), ce.Description, time.UnixMilli(ce.CreatedAt).Format(time.RFC3339))
+		t.AppendRow(row)
+	}
+	colConfigs := make([]table.ColumnConfig, len(header))
+	for idx := range header {
+		colConfigs[idx] = table.ColumnConfig{Number: idx + 1, AlignHeader: text.AlignCenter}
+	}
+	t.SetColumnConfigs(colConfigs)
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
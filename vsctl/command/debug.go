@@ -0,0 +1,92 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+func NewDebugCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug sub-command ",
+		Short: "diagnose the components of vanus",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(profileCommand())
+	return cmd
+}
+
+func profileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "profile",
+		Short: "capture a profile of a component and download it, the component is gateway, controller, " +
+			"or the address or hostname of a segment server or trigger worker, such as vanus-store-0",
+		Run: func(cmd *cobra.Command, args []string) {
+			if profileComponent == "" {
+				cmdFailedWithHelpNotice(cmd, "component can't be empty\n")
+			}
+			if profileFile == "" {
+				name := strings.NewReplacer(":", "_", "/", "_").Replace(profileComponent)
+				profileFile = fmt.Sprintf("%s-%s-%s.pprof", name, profileType, time.Now().Format("20060102150405"))
+			}
+			res, err := client.CaptureProfile(context.Background(), &proxypb.CaptureProfileRequest{
+				Component: profileComponent,
+				Type:      profileType,
+				Seconds:   profileSeconds,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "capture profile failed: %s", err)
+			}
+			if err = os.WriteFile(profileFile, res.GetData(), 0o644); err != nil {
+				cmdFailedf(cmd, "save profile failed: %s", err)
+			}
+
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{
+					"component": profileComponent,
+					"type":      profileType,
+					"file":      profileFile,
+					"size":      len(res.GetData()),
+				})
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"component", "type", "file", "size"})
+			t.AppendRow(table.Row{profileComponent, profileType, profileFile, formatBytes(int64(len(res.GetData())))})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	cmd.Flags().StringVar(&profileComponent, "component", "", "the component to profile")
+	cmd.Flags().StringVar(&profileType, "type", "cpu",
+		"the type of profile, cpu, heap, allocs, goroutine, block, mutex or threadcreate")
+	cmd.Flags().Uint32Var(&profileSeconds, "seconds", 30, "the duration of cpu profiling")
+	cmd.Flags().StringVar(&profileFile, "file", "",
+		"the file to save the profile, default is <component>-<type>-<time>.pprof")
+	return cmd
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+func cancelEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel <eventbus-name> ",
+		Short: "cancel a delayed event before it's delivered",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if eventID == "" {
+				cmdFailedWithHelpNotice(cmd, "event-id can't be empty\n")
+			}
+			_, err := client.CancelDelayedEvent(context.Background(), &proxypb.CancelDelayedEventRequest{
+				Eventbus: args[0],
+				EventId:  eventID,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "cancel delayed event failed: %s", err)
+			}
+			printDelayedEvent(cmd, args[0], "")
+		},
+	}
+	cmd.Flags().StringVar(&eventID, "event-id", "", "the id of delayed event")
+	return cmd
+}
+
+func rescheduleEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reschedule <eventbus-name> ",
+		Short: "change the delivery time of a delayed event before it's delivered",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if eventID == "" {
+				cmdFailedWithHelpNotice(cmd, "event-id can't be empty\n")
+			}
+			if _, err := time.Parse(time.RFC3339Nano, eventDeliveryTime); err != nil {
+				cmdFailedf(cmd, "invalid format of delivery-time: %s\n", err)
+			}
+			_, err := client.RescheduleDelayedEvent(context.Background(), &proxypb.RescheduleDelayedEventRequest{
+				Eventbus:     args[0],
+				EventId:      eventID,
+				DeliveryTime: eventDeliveryTime,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "reschedule delayed event failed: %s", err)
+			}
+			printDelayedEvent(cmd, args[0], eventDeliveryTime)
+		},
+	}
+	cmd.Flags().StringVar(&eventID, "event-id", "", "the id of delayed event")
+	cmd.Flags().StringVar(&eventDeliveryTime, "delivery-time", "",
+		"the new delivery time, only support the time layout of RFC3339, for example: 2022-01-01T08:00:00Z. "+
+			"the event is still delivered at its original time if the new time is earlier")
+	return cmd
+}
+
+func printDelayedEvent(cmd *cobra.Command, eventbus, deliveryTime string) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, map[string]interface{}{
+			"eventbus":      eventbus,
+			"event_id":      eventID,
+			"delivery_time": deliveryTime,
+		})
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"eventbus", "event_id", "delivery_time"})
+	t.AppendRow(table.Row{eventbus, eventID, deliveryTime})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
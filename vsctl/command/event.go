@@ -17,14 +17,19 @@ package command
 import (
 	"bufio"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	cesql "github.com/cloudevents/sdk-go/sql/v2"
+	cesqlparser "github.com/cloudevents/sdk-go/sql/v2/parser"
 	v2 "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/protocol"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
@@ -33,15 +38,18 @@ import (
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"github.com/spf13/cobra"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	cloudEventDataRowLength = 4
 	httpPrefix              = "http://"
+	httpsPrefix             = "https://"
 	xceVanusDeliveryTime    = "xvanusdeliverytime"
+	// filterBatchSize is the number of events read once when filtering by CESQL.
+	filterBatchSize = 64
 )
 
 func NewEventCommand() *cobra.Command {
@@ -58,6 +66,10 @@ func NewEventCommand() *cobra.Command {
 	cmd.AddCommand(getEventCommand())
 	cmd.AddCommand(putEventCommand())
 	cmd.AddCommand(queryEventCommand())
+	cmd.AddCommand(searchEventCommand())
+	cmd.AddCommand(cancelEventCommand())
+	cmd.AddCommand(rescheduleEventCommand())
+	cmd.AddCommand(traceEventCommand())
 	return cmd
 }
 
@@ -75,16 +87,30 @@ func putEventCommand() *cobra.Command {
 			if len(args) == 0 {
 				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
 			}
-			c, err := v2.NewClientHTTP()
+			cctx := mustGetContext(cmd)
+			var opts []cehttp.Option
+			for k, v := range cctx.httpHeaders() {
+				opts = append(opts, cehttp.WithHeader(k, v[0]))
+			}
+			scheme := httpPrefix
+			if cctx.TLS.Enable {
+				tlsCfg, err := cctx.tlsConfig()
+				if err != nil {
+					cmdFailedf(cmd, "invalid TLS config of context: %s", err)
+				}
+				opts = append(opts, cehttp.WithRoundTripper(&http.Transport{TLSClientConfig: tlsCfg}))
+				scheme = httpsPrefix
+			}
+			c, err := v2.NewClientHTTP(opts...)
 			if err != nil {
 				cmdFailedf(cmd, "create ce client error: %s\n", err)
 			}
 			var target string
 			endpoint := mustGetGatewayCloudEventsEndpoint(cmd)
-			if strings.HasPrefix(endpoint, httpPrefix) {
+			if strings.HasPrefix(endpoint, httpPrefix) || strings.HasPrefix(endpoint, httpsPrefix) {
 				target = fmt.Sprintf("%s/gateway/%s", endpoint, args[0])
 			} else {
-				target = fmt.Sprintf("%s%s/gateway/%s", httpPrefix, endpoint, args[0])
+				target = fmt.Sprintf("%s%s/gateway/%s", scheme, endpoint, args[0])
 			}
 
 			ctx := v2.ContextWithTarget(context.Background(), target)
@@ -160,19 +186,18 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 	}
 
 	if v2.IsUndelivered(res) {
-		cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+		cmdFailedWithExitCode(cmd, ExitCodeServerError, "failed to send: %s\n", res)
 	} else {
 		var httpResult *cehttp.Result
 		v2.ResultAs(res, &httpResult)
 		if httpResult == nil {
-			cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+			cmdFailedWithExitCode(cmd, ExitCodeServerError, "failed to send: %s\n", res)
 		} else {
-			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{
 					"Result": httpResult.StatusCode,
-					"Error":  fmt.Errorf(httpResult.Format, httpResult.Args...),
+					"Error":  fmt.Sprintf(httpResult.Format, httpResult.Args...),
 				})
-				color.Green(string(data))
 			} else {
 				t := table.NewWriter()
 				tbcfg := []table.ColumnConfig{
@@ -191,10 +216,26 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 				t.SetOutputMirror(os.Stdout)
 				t.Render()
 			}
+			if code := httpExitCode(httpResult.StatusCode); code != ExitCodeSuccess {
+				os.Exit(code)
+			}
 		}
 	}
 }
 
+// httpExitCode returns the exit code of vsctl for the status code of sending event to gateway.
+func httpExitCode(statusCode int) int {
+	switch {
+	case statusCode < http.StatusBadRequest:
+		return ExitCodeSuccess
+	case statusCode == http.StatusNotFound:
+		return ExitCodeNotFound
+	case statusCode < http.StatusInternalServerError:
+		return ExitCodeInvalidArgument
+	}
+	return ExitCodeServerError
+}
+
 func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 	f, err := os.Open(dataFile)
 	defer func() {
@@ -240,6 +281,8 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 	}
 	t.SetColumnConfigs(tbcfg)
 	t.SetOutputMirror(os.Stdout)
+	// the exit code is determined by the last failed event.
+	exitCode := ExitCodeSuccess
 	for idx, v := range events {
 		event := v2.NewEvent()
 		event.SetID(v[0])
@@ -259,19 +302,18 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 		}
 
 		if v2.IsUndelivered(res) {
-			cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+			cmdFailedWithExitCode(cmd, ExitCodeServerError, "failed to send: %s\n", res)
 		} else {
 			var httpResult *cehttp.Result
 			v2.ResultAs(res, &httpResult)
 			if httpResult == nil {
-				cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+				cmdFailedWithExitCode(cmd, ExitCodeServerError, "failed to send: %s\n", res)
 			} else {
-				if IsFormatJSON(cmd) {
-					data, _ := json.Marshal(map[string]interface{}{
+				if IsFormatStructured(cmd) {
+					PrintStructured(cmd, map[string]interface{}{
 						"No.":    idx,
 						"Result": httpResult.StatusCode,
 					})
-					color.Green(string(data))
 				} else {
 					if detail {
 						t.AppendRow(table.Row{idx, httpResult.StatusCode, resEvent})
@@ -281,55 +323,49 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 					t.AppendSeparator()
 					t.Render()
 				}
+				if code := httpExitCode(httpResult.StatusCode); code != ExitCodeSuccess {
+					exitCode = code
+				}
 			}
 		}
 	}
+	if exitCode != ExitCodeSuccess {
+		os.Exit(exitCode)
+	}
 }
 
 func getEventCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "get <eventbus-name or event-id> ",
-		Short: "get a event from specified eventbus",
+		Use:   "get <eventbus-name> ",
+		Short: "get events from specified eventbus",
 		Run: func(cmd *cobra.Command, args []string) {
-			if len(args) == 0 && eventID == "" {
-				cmdFailedWithHelpNotice(cmd, "eventbus name and eventID can't be both empty\n")
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
 			}
-
-			res, err := client.GetEvent(context.Background(), &proxypb.GetEventRequest{
-				Eventbus:   args[0],
-				EventlogId: eventlogID,
-				Offset:     offset,
-				EventId:    eventID,
-				Number:     int32(number),
-			})
-
-			if err != nil {
-				cmdFailedf(cmd, "failed to get event: %s", err)
+			if number <= 0 {
+				cmdFailedf(cmd, "the number must be greater than 0")
 			}
 
-			if IsFormatJSON(cmd) {
-				for idx := range res.Events {
-					data, _ := json.Marshal(map[string]interface{}{
-						"No.":   idx,
-						"Event": res.Events[idx].String(),
-					})
-
-					color.Yellow(string(data))
+			var expr cesql.Expression
+			if eventFilter != "" {
+				var err error
+				if expr, err = cesqlparser.Parse(eventFilter); err != nil {
+					cmdFailedf(cmd, "invalid cesql filter expression: %s", err)
 				}
+			}
+
+			ctx := context.Background()
+			var events []*gotEvent
+			if eventID != "" {
+				events = getEventByID(ctx, cmd, args[0])
 			} else {
-				t := table.NewWriter()
-				t.AppendHeader(table.Row{"No.", "Event"})
-				for idx := range res.Events {
-					t.AppendRow(table.Row{idx, format(res.Events[idx])})
-					t.AppendSeparator()
+				start := offset
+				if eventFromTime != "" {
+					start = lookupStartOffset(ctx, cmd, args[0])
 				}
-				t.SetColumnConfigs([]table.ColumnConfig{
-					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
-					{Number: 2, AlignHeader: text.AlignCenter},
-				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				events = getEventRange(ctx, cmd, args[0], start, expr)
 			}
+			printEvents(cmd, events)
 		},
 	}
 
@@ -337,12 +373,153 @@ func getEventCommand() *cobra.Command {
 	cmd.Flags().Int16Var(&number, "number", 1, "the number of event you want to get")
 	cmd.Flags().Uint64Var(&eventlogID, "eventlog", 0, "get events from a specified eventlog")
 	cmd.Flags().StringVar(&eventID, "event-id", "", "get event by event ID")
+	cmd.Flags().StringVar(&eventFromTime, "from-time", "",
+		"get events from the first one its created time >= the specified time with RFC3339 format, "+
+			"like 2022-11-24T11:20:56+08:00. it overrides --offset")
+	cmd.Flags().StringVar(&eventFilter, "filter", "",
+		"the CESQL expression to filter events, like \"type = 'order' AND source LIKE 'shop%'\", "+
+			"the events are read until the number of matched events is reached or the eventlog is end")
 	return cmd
 }
 
-func format(value *wrapperspb.BytesValue) string {
+type gotEvent struct {
+	Offset int64           `json:"offset"`
+	Event  json.RawMessage `json:"event"`
+}
+
+func getEventByID(ctx context.Context, cmd *cobra.Command, eb string) []*gotEvent {
+	res, err := client.GetEvent(ctx, &proxypb.GetEventRequest{
+		Eventbus: eb,
+		EventId:  eventID,
+	})
+	if err != nil {
+		cmdFailedf(cmd, "failed to get event: %s", err)
+	}
+	// the event ID consists of eventlog ID and offset.
+	off := int64(-1)
+	if b, err := base64.StdEncoding.DecodeString(eventID); err == nil && len(b) == 16 {
+		off = int64(binary.BigEndian.Uint64(b[8:]))
+	}
+	events := make([]*gotEvent, 0, len(res.Events))
+	for _, v := range res.Events {
+		events = append(events, &gotEvent{Offset: off, Event: v.Value})
+	}
+	return events
+}
+
+func lookupStartOffset(ctx context.Context, cmd *cobra.Command, eb string) int64 {
+	t, err := time.Parse(time.RFC3339, eventFromTime)
+	if err != nil {
+		cmdFailedf(cmd, "failed to parse time, make sure your time passed in is format "+
+			"RFC3339, like 2022-11-24T11:20:56+08:00.")
+	}
+	res, err := client.LookupOffset(ctx, &proxypb.LookupOffsetRequest{
+		Eventbus:   eb,
+		EventlogId: eventlogID,
+		Timestamp:  t.UnixMilli(),
+	})
+	if err != nil {
+		cmdFailedf(cmd, "failed to lookup offset: %s", err)
+	}
+	if eventlogID == 0 {
+		if len(res.Offsets) != 1 {
+			cmdFailedf(cmd, "the eventbus has %d eventlogs, specify one by --eventlog", len(res.Offsets))
+		}
+		for id := range res.Offsets {
+			eventlogID = id
+		}
+	}
+	off := res.Offsets[eventlogID]
+	if off < 0 {
+		return 0
+	}
+	return off
+}
+
+// getEventRange reads events from start until the number of events matched by expr is reached, or there
+// are no more events.
+func getEventRange(ctx context.Context, cmd *cobra.Command, eb string, start int64,
+	expr cesql.Expression) []*gotEvent {
+	events := make([]*gotEvent, 0, number)
+	for off := start; len(events) < int(number); {
+		batch := int32(int(number) - len(events))
+		if expr != nil {
+			batch = filterBatchSize
+		}
+		res, err := client.GetEvent(ctx, &proxypb.GetEventRequest{
+			Eventbus:   eb,
+			EventlogId: eventlogID,
+			Offset:     off,
+			Number:     batch,
+		})
+		if err != nil {
+			if errors.Is(err, errors.ErrOffsetOnEnd) || errors.Is(err, errors.ErrOffsetOverflow) {
+				break
+			}
+			cmdFailedf(cmd, "failed to get event: %s", err)
+		}
+		if len(res.Events) == 0 {
+			break
+		}
+		for idx, v := range res.Events {
+			if expr != nil && !matchEvent(expr, v.Value) {
+				continue
+			}
+			events = append(events, &gotEvent{Offset: off + int64(idx), Event: v.Value})
+			if len(events) == int(number) {
+				break
+			}
+		}
+		off += int64(len(res.Events))
+	}
+	return events
+}
+
+func matchEvent(expr cesql.Expression, data []byte) bool {
 	e := v2.NewEvent()
-	_ = e.UnmarshalJSON(value.Value)
+	if err := e.UnmarshalJSON(data); err != nil {
+		return false
+	}
+	v, err := expr.Evaluate(e)
+	if err != nil {
+		return false
+	}
+	matched, ok := v.(bool)
+	return ok && matched
+}
+
+func printEvents(cmd *cobra.Command, events []*gotEvent) {
+	switch {
+	case IsFormatRaw(cmd):
+		for _, v := range events {
+			e := v2.NewEvent()
+			_ = e.UnmarshalJSON(v.Event)
+			fmt.Println(string(e.Data()))
+		}
+	case IsFormatStructured(cmd):
+		for _, v := range events {
+			PrintStructured(cmd, v)
+		}
+	default:
+		t := table.NewWriter()
+		t.AppendHeader(table.Row{"No.", "Offset", "Event"})
+		for idx, v := range events {
+			t.AppendRow(table.Row{idx, v.Offset, format(v.Event)})
+			t.AppendSeparator()
+		}
+		t.SetColumnConfigs([]table.ColumnConfig{
+			{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			{Number: 3, AlignHeader: text.AlignCenter},
+		})
+		t.SetOutputMirror(os.Stdout)
+		t.Render()
+	}
+}
+
+func format(value []byte) string {
+	e := v2.NewEvent()
+	_ = e.UnmarshalJSON(value)
 	return e.String()
 }
 
@@ -366,7 +543,7 @@ func queryEventCommand() *cobra.Command {
 				Timestamp:  t.UnixMilli(),
 			})
 			if err != nil {
-				cmdFailedf(cmd, fmt.Sprintf("failed to query: %s.", err.Error()))
+				cmdFailedf(cmd, "failed to query: %s.", err)
 			}
 
 			result := make([]*QueryOutput, 0)
@@ -387,16 +564,15 @@ func queryEventCommand() *cobra.Command {
 						cmdFailedf(cmd, "failed to get event: %s", err)
 					}
 					if len(res.Events) >= 1 {
-						qo.Event = format(res.Events[0])
+						qo.Event = format(res.Events[0].Value)
 					} else {
 						qo.Event = "EOF"
 					}
 				}
 				result = append(result, qo)
 			}
-			if IsFormatJSON(cmd) {
-				data, _ := json.MarshalIndent(result, "", " ")
-				color.Yellow(string(data))
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, result)
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Eventlog", "Offset", "Event"})
@@ -425,6 +601,82 @@ func queryEventCommand() *cobra.Command {
 	return cmd
 }
 
+func searchEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <eventbus-name> ",
+		Short: "search events match the filters within a time window",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			req := &proxypb.QueryEventsRequest{
+				Eventbus:  args[0],
+				Limit:     int32(number),
+				PageToken: pageToken,
+			}
+			if filters != "" {
+				if err := json.Unmarshal([]byte(filters), &req.Filters); err != nil {
+					cmdFailedf(cmd, "the filter invalid: %s", err)
+				}
+			}
+			from, err := time.Parse(time.RFC3339, eventFromTime)
+			if err != nil {
+				cmdFailedf(cmd, "failed to parse --from-time, make sure your time passed in is format "+
+					"RFC3339, like 2022-11-24T11:20:56+08:00.")
+			}
+			req.StartTime = from.UnixMilli()
+			if eventToTime != "" {
+				to, err := time.Parse(time.RFC3339, eventToTime)
+				if err != nil {
+					cmdFailedf(cmd, "failed to parse --to-time, make sure your time passed in is format "+
+						"RFC3339, like 2022-11-24T11:20:56+08:00.")
+				}
+				req.EndTime = to.UnixMilli()
+			}
+
+			res, err := client.QueryEvents(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "failed to search events: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				data := struct {
+					Events        []json.RawMessage `json:"events"`
+					NextPageToken string            `json:"next_page_token,omitempty"`
+				}{NextPageToken: res.NextPageToken}
+				for _, v := range res.Events {
+					data.Events = append(data.Events, v.Value)
+				}
+				PrintStructured(cmd, data)
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"No.", "Event"})
+			for idx, v := range res.Events {
+				t.AppendRow(table.Row{idx, format(v.Value)})
+				t.AppendSeparator()
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+			if res.NextPageToken != "" {
+				color.Green("more events, get the next page by --page-token %s\n", res.NextPageToken)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&filters, "filters", "", "the filters of events, same as the filters of subscription, "+
+		"JSON format required")
+	cmd.Flags().StringVar(&eventFromTime, "from-time", "",
+		"the events stored at or after the time are searched, RFC3339 format, like 2022-11-24T11:20:56+08:00")
+	cmd.Flags().StringVar(&eventToTime, "to-time", "",
+		"the events stored before the time are searched, RFC3339 format, it's now by default")
+	cmd.Flags().Int16Var(&number, "number", 20, "the maximum number of events in a page")
+	cmd.Flags().StringVar(&pageToken, "page-token", "", "the token of the page to get, printed by previous search")
+	return cmd
+}
+
 type QueryOutput struct {
 	Eventlog string `json:"eventlog"`
 	Offset   int64  `json:"offset"`
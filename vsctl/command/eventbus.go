@@ -16,12 +16,11 @@ package command
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 	"time"
 
-	"github.com/fatih/color"
 	"github.com/gogo/protobuf/sortkeys"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -46,7 +45,9 @@ func NewEventbusCommand() *cobra.Command {
 	cmd.AddCommand(createEventbusCommand())
 	cmd.AddCommand(deleteEventbusCommand())
 	cmd.AddCommand(getEventbusInfoCommand())
+	cmd.AddCommand(describeEventbusCommand())
 	cmd.AddCommand(listEventbusInfoCommand())
+	cmd.AddCommand(eventbusACLCommand())
 	return cmd
 }
 
@@ -66,9 +67,8 @@ func createEventbusCommand() *cobra.Command {
 			if err != nil {
 				cmdFailedf(cmd, "create eventbus failed: %s", err)
 			}
-			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"Result": "Create Success", "EventbusService": eventbus})
-				color.Green(string(data))
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"Result": "Create Success", "EventbusService": eventbus})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Result", "EventbusService"})
@@ -101,9 +101,8 @@ func deleteEventbusCommand() *cobra.Command {
 			if err != nil {
 				cmdFailedf(cmd, "delete eventbus failed: %s", err)
 			}
-			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"Result": "Delete Success", "EventbusService": eventbus})
-				color.Green(string(data))
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"Result": "Delete Success", "EventbusService": eventbus})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Result", "EventbusService"})
@@ -161,10 +160,22 @@ func getEventbusInfoCommand() *cobra.Command {
 				}
 			}
 
-			t := table.NewWriter()
-			if IsFormatJSON(cmd) {
-				color.Yellow("WARN: this command doesn't support --output-format\n")
+			if IsFormatStructured(cmd) {
+				for _, eb := range busMetas {
+					v := map[string]interface{}{"eventbus": eb}
+					if showSegment || showBlock {
+						logSegs := make(map[string][]*metapb.Segment, len(eb.Logs))
+						for _, l := range eb.Logs {
+							logSegs[formatID(l.EventLogId)] = segs[l.EventLogId]
+						}
+						v["segments"] = logSegs
+					}
+					PrintStructured(cmd, v)
+				}
+				return
 			}
+
+			t := table.NewWriter()
 			if !showSegment && !showBlock {
 				t.AppendHeader(table.Row{"EventbusService", "Description", "Created_At", "Updated_At",
 					"Eventlog", "Segment Number"})
@@ -285,6 +296,167 @@ func getEventbusInfoCommand() *cobra.Command {
 	return cmd
 }
 
+type describedEventbus struct {
+	Name      string               `json:"name"`
+	ID        string               `json:"id"`
+	Eventlogs []*describedEventlog `json:"eventlogs"`
+}
+
+type describedEventlog struct {
+	ID       string              `json:"id"`
+	Segments []*describedSegment `json:"segments"`
+}
+
+type describedSegment struct {
+	ID          string              `json:"id"`
+	State       string              `json:"state"`
+	StartOffset int64               `json:"start_offset"`
+	EndOffset   int64               `json:"end_offset"`
+	Events      int32               `json:"events"`
+	Size        int64               `json:"size"`
+	Capacity    int64               `json:"capacity"`
+	Replicas    []*describedReplica `json:"replicas"`
+}
+
+type describedReplica struct {
+	Block  string `json:"block"`
+	Volume uint64 `json:"volume"`
+	Server string `json:"server"`
+	Leader bool   `json:"leader"`
+}
+
+func describeEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe [flag] ",
+		Short: "describe the eventlogs, segments and replica placements of eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			name := eventbus
+			if len(args) > 0 && args[0] != "" {
+				name = args[0]
+			}
+			if name == "" {
+				cmdFailedf(cmd, "the eventbus must be set")
+			}
+			ctx := context.Background()
+			eb, err := client.GetEventBus(ctx, &metapb.EventBus{Name: name})
+			if err != nil {
+				cmdFailedf(cmd, "get eventbus failed: %s", err)
+			}
+			stats, err := client.GetClusterStats(ctx, &empty.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "get cluster stats failed: %s", err)
+			}
+			servers := make(map[uint64]string, len(stats.SegmentServers))
+			for _, ss := range stats.SegmentServers {
+				servers[ss.VolumeId] = ss.Address
+			}
+
+			desc := &describedEventbus{Name: eb.Name, ID: formatID(eb.Id)}
+			for _, l := range eb.Logs {
+				res, err := client.ListSegment(ctx, &ctrlpb.ListSegmentRequest{
+					EventBusId: eb.Id,
+					EventLogId: l.EventLogId,
+				})
+				if err != nil {
+					cmdFailedf(cmd, "get segments failed: %s", err)
+				}
+				el := &describedEventlog{ID: formatID(l.EventLogId)}
+				for _, seg := range res.Segments {
+					el.Segments = append(el.Segments, describeSegment(seg, servers))
+				}
+				desc.Eventlogs = append(desc.Eventlogs, el)
+			}
+
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, desc)
+				return
+			}
+			printEventbusDescription(desc)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "eventbus to describe")
+	return cmd
+}
+
+func describeSegment(seg *metapb.Segment, servers map[uint64]string) *describedSegment {
+	ds := &describedSegment{
+		ID:          formatID(seg.Id),
+		State:       seg.State,
+		StartOffset: seg.StartOffsetInLog,
+		EndOffset:   seg.EndOffsetInLog,
+		Events:      seg.NumberEventStored,
+		Size:        seg.Size,
+		Capacity:    seg.Capacity,
+	}
+	var vols []uint64
+	var volMap = map[uint64]*metapb.Block{}
+	for _, blk := range seg.Replicas {
+		vols = append(vols, blk.VolumeID)
+		volMap[blk.VolumeID] = blk
+	}
+	sortkeys.Uint64s(vols)
+	for _, vol := range vols {
+		blk := volMap[vol]
+		// the endpoint of block is empty if the segment server has been offline.
+		server, ok := servers[blk.VolumeID]
+		if !ok {
+			server = "offline"
+			if blk.Endpoint != "" {
+				server = blk.Endpoint
+			}
+		}
+		ds.Replicas = append(ds.Replicas, &describedReplica{
+			Block:  formatID(blk.Id),
+			Volume: blk.VolumeID,
+			Server: server,
+			Leader: blk.Id == seg.LeaderBlockId,
+		})
+	}
+	return ds
+}
+
+func printEventbusDescription(desc *describedEventbus) {
+	var segNum, events, size int64
+	for _, el := range desc.Eventlogs {
+		for _, seg := range el.Segments {
+			segNum++
+			events += int64(seg.Events)
+			size += seg.Size
+		}
+	}
+	fmt.Printf("Eventbus: %s (%s)\nEventlogs: %d, Segments: %d, Events: %d, Size: %s\n\n",
+		desc.Name, desc.ID, len(desc.Eventlogs), segNum, events, formatBytes(size))
+
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Eventlog", "Segment", "State", "Offsets", "Events", "Size", "Block",
+		"Volume", "Server", "Leader"})
+	for _, el := range desc.Eventlogs {
+		for _, seg := range el.Segments {
+			offsets := fmt.Sprintf("[%d, %d)", seg.StartOffset, seg.EndOffset)
+			usage := fmt.Sprintf("%s / %s", formatBytes(seg.Size), formatBytes(seg.Capacity))
+			if len(seg.Replicas) == 0 {
+				t.AppendRow(table.Row{el.ID, seg.ID, seg.State, offsets, seg.Events, usage, "-", "-", "-", "-"})
+			}
+			for _, r := range seg.Replicas {
+				t.AppendRow(table.Row{el.ID, seg.ID, seg.State, offsets, seg.Events, usage, r.Block,
+					r.Volume, r.Server, r.Leader})
+			}
+		}
+		t.AppendSeparator()
+	}
+	cfgs := make([]table.ColumnConfig, 0, 10)
+	for idx := 1; idx <= 10; idx++ {
+		cfgs = append(cfgs, table.ColumnConfig{Number: idx, AutoMerge: idx <= 6, VAlign: text.VAlignMiddle,
+			Align: text.AlignCenter, AlignHeader: text.AlignCenter})
+	}
+	t.SetColumnConfigs(cfgs)
+	t.SetStyle(table.StyleLight)
+	t.Style().Options.SeparateRows = true
+	t.Style().Box = table.StyleBoxDefault
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
 func listEventbusInfoCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -294,9 +466,8 @@ func listEventbusInfoCommand() *cobra.Command {
 			if err != nil {
 				cmdFailedf(cmd, "list eventbus failed: %s", err)
 			}
-			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(res)
-				color.Green(string(data))
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res)
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Name", "Description", "Created_At",
@@ -0,0 +1,184 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewFeatureFlagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "featureflag sub-command",
+		Short: "sub-commands for the flags of experimental features used by gateways and triggers",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(putFeatureFlagCommand())
+	cmd.AddCommand(deleteFeatureFlagCommand())
+	cmd.AddCommand(listFeatureFlagCommand())
+	return cmd
+}
+
+func putFeatureFlagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "enable or disable a feature, or roll it out to a percentage of eventbuses",
+		Run: func(cmd *cobra.Command, args []string) {
+			if featureName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			namespaces, err := parseFeatureOverrides(featureNamespaces)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid --namespaces: %s\n", err))
+			}
+			eventbuses, err := parseFeatureOverrides(featureEventbuses)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid --eventbuses: %s\n", err))
+			}
+			res, err := client.PutFeatureFlag(context.Background(), &ctrlpb.FeatureFlag{
+				Name:           featureName,
+				Enabled:        featureEnabled,
+				Namespaces:     namespaces,
+				Eventbuses:     eventbuses,
+				RolloutPercent: featureRolloutPercent,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "put feature flag failed: %s", err)
+			}
+			printFeatureFlag(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&featureName, "name", "", "feature name, e.g. filter_pushdown")
+	cmd.Flags().BoolVar(&featureEnabled, "enabled", false, "whether the feature is enabled by default")
+	cmd.Flags().StringToStringVar(&featureNamespaces, "namespaces", nil,
+		"the overrides of namespaces, e.g. team-a=true,team-b=false")
+	cmd.Flags().StringToStringVar(&featureEventbuses, "eventbuses", nil,
+		"the overrides of eventbuses, which take precedence over the ones of namespaces")
+	cmd.Flags().Uint32Var(&featureRolloutPercent, "rollout-percent", 0,
+		"the percentage of eventbuses enabled besides the overrides, in [0, 100]")
+	return cmd
+}
+
+func parseFeatureOverrides(overrides map[string]string) (map[string]bool, error) {
+	if len(overrides) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]bool, len(overrides))
+	for k, v := range overrides {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, err
+		}
+		res[k] = enabled
+	}
+	return res, nil
+}
+
+func deleteFeatureFlagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete the flag of a feature, then the default of gateways and triggers is used",
+		Run: func(cmd *cobra.Command, args []string) {
+			if featureName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			_, err := client.DeleteFeatureFlag(context.Background(), &ctrlpb.DeleteFeatureFlagRequest{
+				Name: featureName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete feature flag failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"name": featureName})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"name"})
+				t.AppendRow(table.Row{featureName})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&featureName, "name", "", "feature name")
+	return cmd
+}
+
+func listFeatureFlagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the feature flags",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListFeatureFlag(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list feature flag failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res)
+				return
+			}
+			printFeatureFlag(cmd, res.Flags...)
+		},
+	}
+	return cmd
+}
+
+func printFeatureFlag(cmd *cobra.Command, data ...*ctrlpb.FeatureFlag) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"name", "enabled", "namespaces", "eventbuses", "rollout_percent"})
+	for _, f := range data {
+		t.AppendRow(table.Row{f.Name, f.Enabled, formatFeatureOverrides(f.Namespaces),
+			formatFeatureOverrides(f.Eventbuses), f.RolloutPercent})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+		{Number: 3, AlignHeader: text.AlignCenter},
+		{Number: 4, AlignHeader: text.AlignCenter},
+		{Number: 5, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
+func formatFeatureOverrides(overrides map[string]bool) string {
+	items := make([]string, 0, len(overrides))
+	for k, v := range overrides {
+		items = append(items, k+"="+strconv.FormatBool(v))
+	}
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
@@ -14,6 +14,8 @@
 
 package command
 
+import "time"
+
 var (
 	// for vsctl event.
 	id                string
@@ -30,6 +32,10 @@ var (
 	detail            bool
 	eventID           string
 	eventCreateTime   string
+	eventFromTime     string
+	eventFilter       string
+	eventToTime       string
+	pageToken         string
 
 	// for both of eventbus and subscription.
 	eventbus            string
@@ -46,18 +52,110 @@ var (
 	subscriptionName    string
 	disableSubscription bool
 	orderedPushEvent    bool
+	dedupWindow         uint32
+	dedupKeyAttribute   string
+	orderingKey         string
+	idempotentDelivery  bool
+	commitInterval      uint32
+	commitBatchSize     uint32
+	asyncCommitOnStop   bool
+	correlationEventbus string
+	correlationKey      string
+	correlationTimeout  uint32
+	eventlogs           []uint
+	eventlogHashStart   uint32
+	eventlogHashEnd     uint32
+	poisonThreshold     uint32
+	replyEventbus       string
+	warmStandby         bool
+	checkpointFile      string
+	previewSample       int32
 
 	subProtocol        string
 	sinkCredentialType string
 	sinkCredential     string
+	sinkSecret         string
 	deliveryTimeout    uint32
 	maxRetryAttempts   int32
 
+	// for the subscription of chat protocol.
+	messageTemplate   string
+	coalesceWindow    uint32
+	messagesPerMinute uint32
+
 	showSegment bool
 	showBlock   bool
+
+	// for vsctl cluster top.
+	topInterval time.Duration
+
+	// for vsctl config.
+	contextToken     string
+	contextNamespace string
+	contextTLS       clusterTLSConfig
+
+	// for vsctl cron.
+	cronIDStr    string
+	cronName     string
+	cronSchedule string
+	eventSubject string
+
+	// for vsctl replay.
+	replayIDStr       string
+	replaySource      string
+	replayTarget      string
+	replayStartOffset int64
+	replayEndOffset   int64
+
+	// for vsctl debug.
+	profileComponent string
+	profileType      string
+	profileSeconds   uint32
+	profileFile      string
+
+	// for vsctl secret.
+	secretName string
+
+	// for vsctl eventbus acl.
+	aclPrincipal   string
+	aclPermissions []string
+
+	// for vsctl allowlist.
+	allowlistKind  string
+	allowlistName  string
+	allowlistCIDRs []string
+
+	// for vsctl featureflag.
+	featureName           string
+	featureEnabled        bool
+	featureNamespaces     map[string]string
+	featureEventbuses     map[string]string
+	featureRolloutPercent uint32
+
+	// for vsctl connector.
+	connectorName     string
+	connectorKind     string
+	connectorPlugin   string
+	connectorEventbus string
+	connectorConfig   map[string]string
+	connectorReplicas uint32
+
+	// for vsctl poller.
+	pollerIDStr     string
+	pollerName      string
+	pollerURL       string
+	pollerHeaders   map[string]string
+	pollerAuthType  string
+	pollerUsername  string
+	pollerPassword  string
+	pollerToken     string
+	pollerInterval  uint32
+	pollerItemsPath string
+	pollerDedupKey  string
 )
 
 const (
 	AWSCredentialType    = "aws"
 	GCloudCredentialType = "gcloud"
+	PlainCredentialType  = "plain"
 )
@@ -25,17 +25,20 @@ import (
 	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
-	FormatJSON = "json"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+	FormatTable = "table"
+	FormatRaw   = "raw"
 )
 
 type GlobalFlags struct {
 	Endpoint   string
 	Debug      bool
 	ConfigFile string
+	Context    string
 	Format     string
 }
 
@@ -45,19 +48,17 @@ var (
 )
 
 func InitGatewayClient(cmd *cobra.Command) {
-	endpoint, err := cmd.Flags().GetString("endpoint")
+	c := mustGetContext(cmd)
+	opts, err := c.dialOptions()
 	if err != nil {
-		cmdFailedf(cmd, "get gateway endpoint failed: %s", err)
-	}
-	opts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cmdFailedf(cmd, "invalid TLS config of context: %s", err)
 	}
+	opts = append(opts, grpc.WithBlock())
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	conn, err := grpc.DialContext(ctx, c.Endpoint, opts...)
 	if err != nil {
-		panic("failed to dial gateway: " + err.Error())
+		cmdFailedWithExitCode(cmd, ExitCodeServerError, "failed to dial gateway: %s", err)
 	}
 	cc = conn
 	client = proxypb.NewControllerProxyClient(conn)
@@ -82,17 +83,38 @@ func mustGetGatewayCloudEventsEndpoint(cmd *cobra.Command) string {
 }
 
 func mustGetGatewayEndpoint(cmd *cobra.Command) string {
-	endpoint, err := cmd.Flags().GetString("endpoint")
+	return mustGetContext(cmd).Endpoint
+}
+
+// OutputFormat returns the output format set by --output, the --format is a deprecated alias of it.
+func OutputFormat(cmd *cobra.Command) string {
+	v, err := cmd.Flags().GetString("output")
 	if err != nil {
-		cmdFailedf(cmd, "get gateway endpoint failed: %s", err)
+		return FormatTable
 	}
-	return endpoint
+	return strings.ToLower(v)
 }
 
-func IsFormatJSON(cmd *cobra.Command) bool {
-	v, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return false
+// ValidateOutputFormat checks the output format is one of json, yaml, table and raw.
+func ValidateOutputFormat(format string) error {
+	switch strings.ToLower(format) {
+	case FormatJSON, FormatYAML, FormatTable, FormatRaw:
+		return nil
 	}
-	return strings.ToLower(v) == FormatJSON
+	return fmt.Errorf("invalid output format: %s, it must be one of json, yaml or table", format)
+}
+
+func IsFormatJSON(cmd *cobra.Command) bool {
+	return OutputFormat(cmd) == FormatJSON
+}
+
+// IsFormatStructured returns whether the output is machine-readable, json or yaml.
+func IsFormatStructured(cmd *cobra.Command) bool {
+	f := OutputFormat(cmd)
+	return f == FormatJSON || f == FormatYAML
+}
+
+// IsFormatRaw returns whether only the data of events is printed, it's supported by event get.
+func IsFormatRaw(cmd *cobra.Command) bool {
+	return OutputFormat(cmd) == FormatRaw
 }
@@ -15,10 +15,15 @@
 package command
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/spf13/cobra"
 )
 
@@ -34,6 +39,9 @@ func NewClusterCommand() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(controllerCommand())
+	cmd.AddCommand(triggerWorkerCommand())
+	cmd.AddCommand(timerCommand())
+	cmd.AddCommand(clusterTopCommand())
 	return cmd
 }
 
@@ -51,6 +59,13 @@ func getControllerTopology() *cobra.Command {
 		Use:   "topology",
 		Short: "get topology",
 		Run: func(cmd *cobra.Command, args []string) {
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{
+					"gateway_endpoint":     mustGetGatewayEndpoint(cmd),
+					"cloudevents_endpoint": mustGetGatewayCloudEventsEndpoint(cmd),
+				})
+				return
+			}
 			t := table.NewWriter()
 			t.AppendHeader(table.Row{"Name", "Endpoint"})
 			t.AppendRows([]table.Row{
@@ -70,3 +85,102 @@ func getControllerTopology() *cobra.Command {
 	}
 	return cmd
 }
+
+func triggerWorkerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-worker sub-command",
+		Short: "get trigger worker metadata",
+	}
+	cmd.AddCommand(listTriggerWorkerCommand())
+	return cmd
+}
+
+func listTriggerWorkerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the trigger worker and subscriptions assigned to it",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListTriggerWorker(context.Background(), &empty.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list trigger worker failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res.TriggerWorker)
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Address", "Phase", "Load", "Subscription", "Event Rate", "Inflight", "Lag"})
+			for _, tw := range res.TriggerWorker {
+				if len(tw.SubscriptionLoad) == 0 {
+					t.AppendRow(table.Row{tw.Address, tw.Phase, fmt.Sprintf("%.2f", tw.Load)})
+				}
+				for _, load := range tw.SubscriptionLoad {
+					t.AppendRow(table.Row{tw.Address, tw.Phase, fmt.Sprintf("%.2f", tw.Load),
+						vanus.ID(load.SubscriptionId).String(), fmt.Sprintf("%.2f", load.EventRate),
+						load.Inflight, load.Lag})
+				}
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, AutoMerge: true, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, AutoMerge: true, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, AutoMerge: true, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 7, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			t.SetStyle(table.StyleLight)
+			t.Style().Options.SeparateRows = true
+			t.Style().Box = table.StyleBoxDefault
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	return cmd
+}
+
+func timerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timer sub-command",
+		Short: "get timer metadata",
+	}
+	cmd.AddCommand(listTimerReplicaCommand())
+	return cmd
+}
+
+func listTimerReplicaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the timer replicas and which one is the leader",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListTimerReplica(context.Background(), &empty.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list timer replica failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res.Replicas)
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Name", "Address", "Leader", "Epoch", "Fired Offset", "Heartbeat"})
+			for _, r := range res.Replicas {
+				t.AppendRow(table.Row{r.Name, r.Address, r.IsLeader, r.Epoch, r.FiredOffset,
+					time.UnixMilli(r.HeartbeatAt).Format(time.RFC3339)})
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 6, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			t.SetStyle(table.StyleLight)
+			t.Style().Options.SeparateRows = true
+			t.Style().Box = table.StyleBoxDefault
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,231 @@
+// Copyright 2023 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func NewPollerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poller sub-command",
+		Short: "sub-commands for HTTP poller operations",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(createHTTPPollerCommand())
+	cmd.AddCommand(listHTTPPollerCommand())
+	cmd.AddCommand(pauseHTTPPollerCommand())
+	cmd.AddCommand(resumeHTTPPollerCommand())
+	cmd.AddCommand(deleteHTTPPollerCommand())
+	return cmd
+}
+
+func createHTTPPollerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create an HTTP poller which publishes the new items of an endpoint into the eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if pollerName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			if pollerURL == "" {
+				cmdFailedWithHelpNotice(cmd, "the --url flag MUST be set\n")
+			}
+			if eventbus == "" {
+				cmdFailedWithHelpNotice(cmd, "the --eventbus flag MUST be set\n")
+			}
+			req := &ctrlpb.CreateHTTPPollerRequest{
+				Name:        pollerName,
+				Eventbus:    eventbus,
+				Url:         pollerURL,
+				Headers:     pollerHeaders,
+				Interval:    pollerInterval,
+				ItemsPath:   pollerItemsPath,
+				DedupKey:    pollerDedupKey,
+				EventType:   eventType,
+				EventSource: eventSource,
+				Description: description,
+			}
+			if pollerAuthType != "" {
+				req.Auth = &ctrlpb.HTTPPollerAuth{
+					Type:     pollerAuthType,
+					Username: pollerUsername,
+					Password: pollerPassword,
+					Token:    pollerToken,
+				}
+			}
+			res, err := client.CreateHTTPPoller(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "create HTTP poller failed: %s", err)
+			}
+			printHTTPPoller(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&pollerName, "name", "", "HTTP poller name")
+	cmd.Flags().StringVar(&pollerURL, "url", "", "the http or https endpoint polled by GET")
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "the eventbus which the items are published into")
+	cmd.Flags().StringToStringVar(&pollerHeaders, "headers", nil, "the headers of request, e.g. X-Api-Key=...")
+	cmd.Flags().StringVar(&pollerAuthType, "auth-type", "", "the authentication of request, basic or bearer")
+	cmd.Flags().StringVar(&pollerUsername, "username", "", "username of basic authentication")
+	cmd.Flags().StringVar(&pollerPassword, "password", "", "password of basic authentication")
+	cmd.Flags().StringVar(&pollerToken, "token", "", "token of bearer authentication")
+	cmd.Flags().Uint32Var(&pollerInterval, "interval", 60, "poll interval in seconds")
+	cmd.Flags().StringVar(&pollerItemsPath, "items-path", "", "JSONPath of the items in the response, "+
+		"e.g. $.data.items, the whole response is an item if empty")
+	cmd.Flags().StringVar(&pollerDedupKey, "dedup-key", "", "JSONPath of the unique key in an item, "+
+		"e.g. $.id, the items whose key has been published are skipped")
+	cmd.Flags().StringVar(&eventType, "type", "", "type of the published events")
+	cmd.Flags().StringVar(&eventSource, "source", "", "source of the published events")
+	cmd.Flags().StringVar(&description, "description", "", "HTTP poller description")
+	return cmd
+}
+
+func listHTTPPollerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the HTTP pollers",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListHTTPPoller(context.Background(), &ctrlpb.ListHTTPPollerRequest{
+				Eventbus: eventbus,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list HTTP poller failed: %s", err)
+			}
+			printHTTPPoller(cmd, true, res.HttpPollers...)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "only list the HTTP pollers of the eventbus")
+	return cmd
+}
+
+func pauseHTTPPollerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause an HTTP poller, the published items aren't published again after it's resumed",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseHTTPPollerID(cmd)
+			res, err := client.PauseHTTPPoller(context.Background(), &ctrlpb.PauseHTTPPollerRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "pause HTTP poller failed: %s", err)
+			}
+			printHTTPPoller(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&pollerIDStr, "id", "", "HTTP poller id")
+	return cmd
+}
+
+func resumeHTTPPollerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "resume a paused HTTP poller",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseHTTPPollerID(cmd)
+			res, err := client.ResumeHTTPPoller(context.Background(), &ctrlpb.ResumeHTTPPollerRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "resume HTTP poller failed: %s", err)
+			}
+			printHTTPPoller(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&pollerIDStr, "id", "", "HTTP poller id")
+	return cmd
+}
+
+func deleteHTTPPollerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete an HTTP poller",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseHTTPPollerID(cmd)
+			_, err := client.DeleteHTTPPoller(context.Background(), &ctrlpb.DeleteHTTPPollerRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete HTTP poller failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"http_poller_id": id.String()})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"http_poller_id"})
+				t.AppendRow(table.Row{id.String()})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&pollerIDStr, "id", "", "HTTP poller id to deleting")
+	return cmd
+}
+
+func parseHTTPPollerID(cmd *cobra.Command) vanus.ID {
+	id, err := vanus.NewIDFromString(pollerIDStr)
+	if err != nil {
+		cmdFailedWithHelpNotice(cmd, "invalid HTTP poller id: "+err.Error()+"\n")
+	}
+	return id
+}
+
+func printHTTPPoller(cmd *cobra.Command, showNo bool, data ...*ctrlpb.HTTPPoller) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"id", "name", "url", "eventbus", "interval", "items_path", "dedup_key",
+		"type", "source", "paused", "description", "created_at"}
+	if showNo {
+		header = append(table.Row{"no."}, header...)
+	}
+	t.AppendHeader(header)
+	for idx, p := range data {
+		var row table.Row
+		if showNo {
+			row = append(row, idx+1)
+		}
+		row = append(row, formatID(p.Id), p.Name, p.Url, p.Eventbus,
+			(time.Duration(p.Interval) * time.Second).String(), p.ItemsPath, p.DedupKey, p.EventType,
+			p.EventSource, p.Paused, p.Description, time.UnixMilli(p.CreatedAt).Format(time.RFC3339))
+		t.AppendRow(row)
+	}
+	colConfigs := make([]table.ColumnConfig, len(header))
+	for idx := range header {
+		colConfigs[idx] = table.ColumnConfig{Number: idx + 1, AlignHeader: text.AlignCenter}
+	}
+	t.SetColumnConfigs(colConfigs)
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
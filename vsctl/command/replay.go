@@ -0,0 +1,247 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func NewReplayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay sub-command",
+		Short: "sub-commands for replaying historical events into another eventbus",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(createReplayJobCommand())
+	cmd.AddCommand(getReplayJobCommand())
+	cmd.AddCommand(listReplayJobCommand())
+	cmd.AddCommand(deleteReplayJobCommand())
+	return cmd
+}
+
+func createReplayJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a replay job which copies the events of a time or offset range into another eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if replaySource == "" {
+				cmdFailedWithHelpNotice(cmd, "the --source flag MUST be set\n")
+			}
+			if replayTarget == "" {
+				cmdFailedWithHelpNotice(cmd, "the --target flag MUST be set\n")
+			}
+			req := &ctrlpb.CreateReplayJobRequest{
+				SourceEventbus: replaySource,
+				TargetEventbus: replayTarget,
+				RateLimit:      rateLimit,
+				Description:    description,
+			}
+			if cmd.Flags().Changed("start-offset") || cmd.Flags().Changed("end-offset") {
+				req.ByOffset = true
+				req.StartOffset = replayStartOffset
+				req.EndOffset = replayEndOffset
+			} else {
+				if eventFromTime == "" {
+					cmdFailedWithHelpNotice(cmd, "either the --from-time or the --start-offset flag MUST be set\n")
+				}
+				from, err := time.Parse(time.RFC3339, eventFromTime)
+				if err != nil {
+					cmdFailedf(cmd, "failed to parse --from-time, make sure your time passed in is format "+
+						"RFC3339, like 2022-11-24T11:20:56+08:00.")
+				}
+				req.StartTime = from.UnixMilli()
+				if eventToTime != "" {
+					to, err := time.Parse(time.RFC3339, eventToTime)
+					if err != nil {
+						cmdFailedf(cmd, "failed to parse --to-time, make sure your time passed in is format "+
+							"RFC3339, like 2022-11-24T11:20:56+08:00.")
+					}
+					req.EndTime = to.UnixMilli()
+				}
+			}
+			if filters != "" {
+				if err := json.Unmarshal([]byte(filters), &req.Filters); err != nil {
+					cmdFailedf(cmd, "the filter invalid: %s", err)
+				}
+			}
+			res, err := client.CreateReplayJob(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "create replay job failed: %s", err)
+			}
+			printReplayJob(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&replaySource, "source", "", "the eventbus whose events are replayed")
+	cmd.Flags().StringVar(&replayTarget, "target", "", "the eventbus which the events are replayed into")
+	cmd.Flags().StringVar(&eventFromTime, "from-time", "",
+		"the events stored at or after the time are replayed, RFC3339 format, like 2022-11-24T11:20:56+08:00")
+	cmd.Flags().StringVar(&eventToTime, "to-time", "",
+		"the events stored before the time are replayed, RFC3339 format, it's now by default")
+	cmd.Flags().Int64Var(&replayStartOffset, "start-offset", 0,
+		"replay the events at or after the offset of each eventlog instead of a time range")
+	cmd.Flags().Int64Var(&replayEndOffset, "end-offset", 0,
+		"replay the events before the offset of each eventlog, it's the latest offset by default")
+	cmd.Flags().StringVar(&filters, "filters", "", "only the events matching the filters are replayed, "+
+		"same as the filters of subscription, JSON format required")
+	cmd.Flags().Uint32Var(&rateLimit, "rate-limit", 0, "the max events replayed per second, 0 means unlimited")
+	cmd.Flags().StringVar(&description, "description", "", "replay job description")
+	return cmd
+}
+
+func getReplayJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "get the progress of a replay job",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseReplayJobID(cmd)
+			res, err := client.GetReplayJob(context.Background(), &ctrlpb.GetReplayJobRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "get replay job failed: %s", err)
+			}
+			printReplayJob(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&replayIDStr, "id", "", "replay job id")
+	return cmd
+}
+
+func listReplayJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the replay jobs",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListReplayJob(context.Background(), &ctrlpb.ListReplayJobRequest{
+				Eventbus: eventbus,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list replay job failed: %s", err)
+			}
+			printReplayJob(cmd, true, res.ReplayJobs...)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "only list the replay jobs from or into the eventbus")
+	return cmd
+}
+
+func deleteReplayJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete a replay job, it's stopped if it's running",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseReplayJobID(cmd)
+			_, err := client.DeleteReplayJob(context.Background(), &ctrlpb.DeleteReplayJobRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete replay job failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"replay_job_id": id.String()})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"replay_job_id"})
+				t.AppendRow(table.Row{id.String()})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&replayIDStr, "id", "", "replay job id to deleting")
+	return cmd
+}
+
+func parseReplayJobID(cmd *cobra.Command) vanus.ID {
+	id, err := vanus.NewIDFromString(replayIDStr)
+	if err != nil {
+		cmdFailedWithHelpNotice(cmd, "invalid replay job id: "+err.Error()+"\n")
+	}
+	return id
+}
+
+func printReplayJob(cmd *cobra.Command, showNo bool, data ...*ctrlpb.ReplayJob) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"id", "source", "target", "range", "status", "remaining", "replayed", "filtered",
+		"error", "created_at"}
+	if showNo {
+		header = append(table.Row{"no."}, header...)
+	}
+	t.AppendHeader(header)
+	for idx, job := range data {
+		var row table.Row
+		if showNo {
+			row = append(row, idx+1)
+		}
+		row = append(row, formatID(job.Id), job.SourceEventbus, job.TargetEventbus, formatReplayRange(job),
+			job.Status, formatReplayRemaining(job), job.Replayed, job.Filtered, job.Error,
+			time.UnixMilli(job.CreatedAt).Format(time.RFC3339))
+		t.AppendRow(row)
+	}
+	colConfigs := make([]table.ColumnConfig, len(header))
+	for idx := range header {
+		colConfigs[idx] = table.ColumnConfig{Number: idx + 1, AlignHeader: text.AlignCenter}
+	}
+	t.SetColumnConfigs(colConfigs)
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
+func formatReplayRange(job *ctrlpb.ReplayJob) string {
+	if job.ByOffset {
+		if job.EndOffset == 0 {
+			return fmt.Sprintf("offset [%d, latest)", job.StartOffset)
+		}
+		return fmt.Sprintf("offset [%d, %d)", job.StartOffset, job.EndOffset)
+	}
+	return fmt.Sprintf("[%s, %s)", time.UnixMilli(job.StartTime).Format(time.RFC3339),
+		time.UnixMilli(job.EndTime).Format(time.RFC3339))
+}
+
+// formatReplayRemaining returns the number of events left to read of all eventlogs.
+func formatReplayRemaining(job *ctrlpb.ReplayJob) string {
+	if len(job.Progress) == 0 {
+		return "-"
+	}
+	var remaining int64
+	for _, p := range job.Progress {
+		if !p.Done {
+			remaining += p.EndOffset - p.Offset
+		}
+	}
+	return fmt.Sprintf("%d", remaining)
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/linkall-labs/vanus/proto/pkg/meta"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewSecretCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret sub-command",
+		Short: "sub-commands for sink credential secrets which subscriptions reference by name",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(putSecretCommand())
+	cmd.AddCommand(listSecretCommand())
+	cmd.AddCommand(deleteSecretCommand())
+	return cmd
+}
+
+func putSecretCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "create a secret or rotate the credential of it",
+		Run: func(cmd *cobra.Command, args []string) {
+			if secretName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			if sinkCredentialType == "" {
+				cmdFailedWithHelpNotice(cmd, "the --credential-type flag MUST be set\n")
+			}
+			res, err := client.PutSecret(context.Background(), &ctrlpb.PutSecretRequest{
+				Name:       secretName,
+				Credential: getSinkCredential(cmd),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "put secret failed: %s", err)
+			}
+			printSecret(cmd, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&secretName, "name", "", "secret name")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud or plain")
+	cmd.Flags().StringVar(&sinkCredential, "credential", "", "sink credential info, JSON format or @file")
+	return cmd
+}
+
+func listSecretCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list the secrets without credentials",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListSecret(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list secret failed: %s", err)
+			}
+			printSecret(cmd, true, res.Secrets...)
+		},
+	}
+	return cmd
+}
+
+func deleteSecretCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete a secret which isn't referenced by any subscription",
+		Run: func(cmd *cobra.Command, args []string) {
+			if secretName == "" {
+				cmdFailedWithHelpNotice(cmd, "the --name flag MUST be set\n")
+			}
+			_, err := client.DeleteSecret(context.Background(), &ctrlpb.DeleteSecretRequest{
+				Name: secretName,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "delete secret failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"secret": secretName})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"secret"})
+				t.AppendRow(table.Row{secretName})
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				t.SetOutputMirror(os.Stdout)
+				t.Render()
+			}
+		},
+	}
+	cmd.Flags().StringVar(&secretName, "name", "", "secret name to deleting")
+	return cmd
+}
+
+func printSecret(cmd *cobra.Command, showNo bool, data ...*ctrlpb.Secret) {
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"name", "credential_type", "version", "created_at", "updated_at"}
+	if showNo {
+		header = append(table.Row{"no."}, header...)
+	}
+	t.AppendHeader(header)
+	for idx, s := range data {
+		var row table.Row
+		if showNo {
+			row = append(row, idx+1)
+		}
+		var credentialType string
+		switch s.Credential.GetCredentialType() {
+		case meta.SinkCredential_AWS:
+			credentialType = AWSCredentialType
+		case meta.SinkCredential_GCLOUD:
+			credentialType = GCloudCredentialType
+		case meta.SinkCredential_PLAIN:
+			credentialType = PlainCredentialType
+		}
+		row = append(row, s.Name, credentialType, s.Version, time.UnixMilli(s.CreatedAt).Format(time.RFC3339),
+			time.UnixMilli(s.UpdatedAt).Format(time.RFC3339))
+		t.AppendRow(row)
+	}
+	colConfigs := make([]table.ColumnConfig, len(header))
+	for idx := range header {
+		colConfigs[idx] = table.ColumnConfig{Number: idx + 1, AlignHeader: text.AlignCenter}
+	}
+	t.SetColumnConfigs(colConfigs)
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
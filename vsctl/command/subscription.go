@@ -18,8 +18,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -33,7 +36,10 @@ import (
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/linkall-labs/vanus/proto/pkg/trigger"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 	"k8s.io/utils/strings/slices"
 )
 
@@ -52,6 +58,10 @@ func NewSubscriptionCommand() *cobra.Command {
 	cmd.AddCommand(deleteSubscriptionCommand())
 	cmd.AddCommand(getSubscriptionCommand())
 	cmd.AddCommand(listSubscriptionCommand())
+	cmd.AddCommand(exportSubscriptionCommand())
+	cmd.AddCommand(importSubscriptionCommand())
+	cmd.AddCommand(previewSubscriptionCommand())
+	cmd.AddCommand(tailSubscriptionCommand())
 	return cmd
 }
 
@@ -76,66 +86,60 @@ func createSubscriptionCommand() *cobra.Command {
 				if _, err := arn.Parse(sink); err != nil {
 					cmdFailedf(cmd, "protocol is aws-lambda sink is aws arn, arn parse error: %s\n", err.Error())
 				}
-				if sinkCredentialType != AWSCredentialType {
+				if sinkSecret == "" && sinkCredentialType != AWSCredentialType {
 					cmdFailedf(cmd, "protocol is aws-lambda, credential-type must be %s\n", AWSCredentialType)
 				}
 			case "gcloud-functions":
 				p = meta.Protocol_GCLOUD_FUNCTIONS
-				if sinkCredentialType != GCloudCredentialType {
+				if sinkSecret == "" && sinkCredentialType != GCloudCredentialType {
 					cmdFailedf(cmd, "protocol is aws-lambda, credential-type must be %s\n", GCloudCredentialType)
 				}
+			case "amqp":
+				p = meta.Protocol_AMQP
+				if sinkCredentialType != "" && sinkCredentialType != PlainCredentialType {
+					cmdFailedf(cmd, "protocol is amqp, credential-type must be %s if it's set\n", PlainCredentialType)
+				}
+			case "elasticsearch":
+				p = meta.Protocol_ELASTICSEARCH
+				if sinkCredentialType != "" && sinkCredentialType != PlainCredentialType {
+					cmdFailedf(cmd, "protocol is elasticsearch, credential-type must be %s if it's set\n",
+						PlainCredentialType)
+				}
+			case "object-storage":
+				p = meta.Protocol_OBJECT_STORAGE
+				if sinkSecret == "" && sinkCredentialType != AWSCredentialType {
+					cmdFailedf(cmd, "protocol is object-storage, credential-type must be %s\n", AWSCredentialType)
+				}
+			case "sql":
+				p = meta.Protocol_SQL
+				if sinkCredentialType != "" && sinkCredentialType != PlainCredentialType {
+					cmdFailedf(cmd, "protocol is sql, credential-type must be %s if it's set\n", PlainCredentialType)
+				}
+			case "chat":
+				p = meta.Protocol_CHAT
+				if sinkCredentialType != "" {
+					cmdFailedf(cmd, "protocol is chat, credential isn't supported\n")
+				}
 			default:
 				cmdFailedf(cmd, "protocol is invalid\n")
 			}
 
-			var credential *meta.SinkCredential
-			if sinkCredentialType != "" {
-				if sinkCredential == "" {
-					cmdFailedf(cmd, "credential-type is set but sinkCredential empty\n")
-				}
-				if sinkCredential[0] == '@' {
-					credentialBytes, err := ioutil.ReadFile(sinkCredential[1:])
-					if err != nil {
-						cmdFailedf(cmd, "read sinkCredential file:%s error:%s\n", sinkCredential, err.Error())
-					}
-					sinkCredential = string(credentialBytes)
-					fmt.Println(sinkCredential)
-				}
-				// expand value from env
-				sinkCredential = os.ExpandEnv(sinkCredential)
-				switch sinkCredentialType {
-				case AWSCredentialType:
-					var akSK *meta.AKSKCredential
-					err := json.Unmarshal([]byte(sinkCredential), &akSK)
-					if err != nil {
-						cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
-					}
-					if akSK.AccessKeyId == "" || akSK.SecretAccessKey == "" {
-						cmdFailedf(cmd, "credential-type is aws, access_key_id and secret_access_key must not be empty\n")
-					}
-					credential = &meta.SinkCredential{
-						CredentialType: meta.SinkCredential_AWS,
-						Credential: &meta.SinkCredential_Aws{
-							Aws: akSK,
-						},
-					}
-				case GCloudCredentialType:
-					var m map[string]string
-					err := json.Unmarshal([]byte(sinkCredential), &m)
-					if err != nil {
-						cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
-					}
-					credential = &meta.SinkCredential{
-						CredentialType: meta.SinkCredential_GCLOUD,
-						Credential: &meta.SinkCredential_Gcloud{
-							Gcloud: &meta.GCloudCredential{
-								CredentialsJson: sinkCredential,
-							},
-						},
-					}
-				default:
-					cmdFailedf(cmd, "credential-type is invalid\n")
+			credential := getSinkCredential(cmd)
+			if credential != nil && sinkSecret != "" {
+				cmdFailedf(cmd, "credential and sink-secret can't be both set\n")
+			}
+
+			var settings *meta.ProtocolSetting
+			if messageTemplate != "" || coalesceWindow > 0 || messagesPerMinute > 0 {
+				if p != meta.Protocol_CHAT {
+					cmdFailedf(cmd, "message-template, coalesce-window and messages-per-minute are only "+
+						"supported by chat protocol\n")
 				}
+				settings = &meta.ProtocolSetting{Chat: &meta.ChatSetting{
+					MessageTemplate:   messageTemplate,
+					CoalesceWindow:    coalesceWindow,
+					MessagesPerMinute: messagesPerMinute,
+				}}
 			}
 
 			var filter []*meta.Filter
@@ -158,9 +162,27 @@ func createSubscriptionCommand() *cobra.Command {
 
 			// subscription config
 			config := &meta.SubscriptionConfig{
-				RateLimit:       rateLimit,
-				DeliveryTimeout: deliveryTimeout,
-				OrderedEvent:    orderedPushEvent,
+				RateLimit:               rateLimit,
+				DeliveryTimeout:         deliveryTimeout,
+				OrderedEvent:            orderedPushEvent,
+				DedupWindow:             dedupWindow,
+				DedupKeyAttribute:       dedupKeyAttribute,
+				OrderingKeyAttribute:    orderingKey,
+				IdempotentDelivery:      idempotentDelivery,
+				CommitInterval:          commitInterval,
+				CommitBatchSize:         commitBatchSize,
+				DisableSyncCommitOnStop: asyncCommitOnStop,
+				CorrelationEventbus:     correlationEventbus,
+				CorrelationKeyAttribute: correlationKey,
+				CorrelationTimeout:      correlationTimeout,
+				EventlogHashStart:       eventlogHashStart,
+				EventlogHashEnd:         eventlogHashEnd,
+				PoisonThreshold:         poisonThreshold,
+				ReplyEventbus:           replyEventbus,
+				WarmStandby:             warmStandby,
+			}
+			for _, id := range eventlogs {
+				config.Eventlogs = append(config.Eventlogs, uint64(id))
 			}
 			if maxRetryAttempts >= 0 {
 				value := uint32(maxRetryAttempts)
@@ -185,17 +207,19 @@ func createSubscriptionCommand() *cobra.Command {
 
 			res, err := client.CreateSubscription(context.Background(), &ctrlpb.CreateSubscriptionRequest{
 				Subscription: &ctrlpb.SubscriptionRequest{
-					Source:         source,
-					Config:         config,
-					Filters:        filter,
-					Sink:           sink,
-					SinkCredential: credential,
-					Protocol:       p,
-					EventBus:       eventbus,
-					Transformer:    trans,
-					Name:           subscriptionName,
-					Description:    description,
-					Disable:        disableSubscription,
+					Source:           source,
+					Config:           config,
+					Filters:          filter,
+					Sink:             sink,
+					SinkCredential:   credential,
+					SinkSecret:       sinkSecret,
+					Protocol:         p,
+					ProtocolSettings: settings,
+					EventBus:         eventbus,
+					Transformer:      trans,
+					Name:             subscriptionName,
+					Description:      description,
+					Disable:          disableSubscription,
 				},
 			})
 			if err != nil {
@@ -210,9 +234,11 @@ func createSubscriptionCommand() *cobra.Command {
 	cmd.Flags().StringVar(&transformer, "transformer", "", "transformer, JSON format required")
 	cmd.Flags().Uint32Var(&rateLimit, "rate-limit", 0, "max event number pushing to sink per second, default is 0, means unlimited")
 	cmd.Flags().StringVar(&from, "from", "", "consume events from, latest,earliest or RFC3339 format time")
-	cmd.Flags().StringVar(&subProtocol, "protocol", "http", "protocol,http or aws-lambda or gcloud-functions")
-	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
+	cmd.Flags().StringVar(&subProtocol, "protocol", "http", "protocol,http or aws-lambda or gcloud-functions or amqp or elasticsearch or object-storage or sql or chat")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud or plain")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "", "sink credential info, JSON format or @file")
+	cmd.Flags().StringVar(&sinkSecret, "sink-secret", "", "the name of secret which holds the sink credential, "+
+		"the credential is rotated by vsctl secret put without updating the subscription")
 	cmd.Flags().Uint32Var(&deliveryTimeout, "delivery-timeout", 0, "event delivery to sink timeout by millisecond, default is 0, means using server-side default value: 5s")
 	cmd.Flags().Int32Var(&maxRetryAttempts, "max-retry-attempts", -1, "event delivery fail max retry attempts, default is -1, means using server-side max retry attempts: 32")
 	cmd.Flags().StringVar(&subscriptionName, "name", "", "subscription name")
@@ -221,9 +247,115 @@ func createSubscriptionCommand() *cobra.Command {
 		"subscription (just create if disable=true)")
 	cmd.Flags().BoolVar(&orderedPushEvent, "ordered-event", false, "whether push the "+
 		"event with ordered")
+	cmd.Flags().Uint32Var(&dedupWindow, "dedup-window", 0, "drop duplicate event in the window by millisecond, "+
+		"default is 0, means disable dedup")
+	cmd.Flags().StringVar(&dedupKeyAttribute, "dedup-key-attribute", "", "the event attribute used as dedup key, "+
+		"default is event id")
+	cmd.Flags().StringVar(&orderingKey, "ordering-key", "", "the event attribute used as ordering key, events "+
+		"with the same key are pushed in order")
+	cmd.Flags().BoolVar(&idempotentDelivery, "idempotent-delivery", false, "whether carry the "+
+		"xvanusidempotencykey extension and skip the events delivered before restart")
+	cmd.Flags().Uint32Var(&commitInterval, "commit-interval", 0, "offset commit interval by millisecond, "+
+		"default is 0, means using server-side default value: 2s")
+	cmd.Flags().Uint32Var(&commitBatchSize, "commit-batch-size", 0, "commit offset after the number of events "+
+		"processed even if commit interval not reached, default is 0, means disable")
+	cmd.Flags().BoolVar(&asyncCommitOnStop, "async-commit-on-stop", false, "whether stop the "+
+		"subscription without waiting offset committed")
+	cmd.Flags().StringVar(&correlationEventbus, "correlation-eventbus", "", "the eventbus whose events are "+
+		"correlated with the events of subscribed eventbus, the merged event is pushed when both sides arrived")
+	cmd.Flags().StringVar(&correlationKey, "correlation-key", "", "the event attribute used as correlation key "+
+		"of both sides")
+	cmd.Flags().Uint32Var(&correlationTimeout, "correlation-timeout", 0, "drop the events not correlated in the "+
+		"timeout by millisecond, default is 0, means using server-side default value: 1m")
+	cmd.Flags().UintSliceVar(&eventlogs, "eventlogs", nil, "the ids of eventlogs the subscription consumes, "+
+		"default is empty, means all eventlogs")
+	cmd.Flags().Uint32Var(&eventlogHashStart, "eventlog-hash-start", 0, "the start of hash range of eventlogs "+
+		"the subscription consumes, inclusive, the range of hash slot is [0, 65536)")
+	cmd.Flags().Uint32Var(&eventlogHashEnd, "eventlog-hash-end", 0, "the end of hash range of eventlogs "+
+		"the subscription consumes, exclusive, default is 0, means all eventlogs")
+	cmd.Flags().Uint32Var(&poisonThreshold, "poison-threshold", 0, "quarantine the event to dead letter after "+
+		"the number of failed deliveries while the sink works for the other events, default is 0, means disable")
+	cmd.Flags().StringVar(&replyEventbus, "reply-eventbus", "", "the eventbus which the event replied by the "+
+		"sink is written to, only http sink supports reply")
+	cmd.Flags().BoolVar(&warmStandby, "warm-standby", false, "whether prepare the subscription on a standby "+
+		"trigger worker which takes over it at once when the running trigger worker is disconnected")
+	cmd.Flags().StringVar(&messageTemplate, "message-template", "", "the Go template of message posted by chat "+
+		"protocol, e.g. '{{.type}} from {{.source}}: {{.data.message}}', default is the type, source and data")
+	cmd.Flags().Uint32Var(&coalesceWindow, "coalesce-window", 0, "coalesce the chat messages in the window by "+
+		"millisecond into one post, default is 0, means post at once")
+	cmd.Flags().Uint32Var(&messagesPerMinute, "messages-per-minute", 0, "the max chat posts per minute, the "+
+		"messages exceed it are coalesced into the next post, default is 0, means unlimited")
 	return cmd
 }
 
+func getSinkCredential(cmd *cobra.Command) *meta.SinkCredential {
+	var credential *meta.SinkCredential
+	if sinkCredentialType != "" {
+		if sinkCredential == "" {
+			cmdFailedf(cmd, "credential-type is set but sinkCredential empty\n")
+		}
+		if sinkCredential[0] == '@' {
+			credentialBytes, err := ioutil.ReadFile(sinkCredential[1:])
+			if err != nil {
+				cmdFailedf(cmd, "read sinkCredential file:%s error:%s\n", sinkCredential, err.Error())
+			}
+			sinkCredential = string(credentialBytes)
+			fmt.Println(sinkCredential)
+		}
+		// expand value from env
+		sinkCredential = os.ExpandEnv(sinkCredential)
+		switch sinkCredentialType {
+		case AWSCredentialType:
+			var akSK *meta.AKSKCredential
+			err := json.Unmarshal([]byte(sinkCredential), &akSK)
+			if err != nil {
+				cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+			}
+			if akSK.AccessKeyId == "" || akSK.SecretAccessKey == "" {
+				cmdFailedf(cmd, "credential-type is aws, access_key_id and secret_access_key must not be empty\n")
+			}
+			credential = &meta.SinkCredential{
+				CredentialType: meta.SinkCredential_AWS,
+				Credential: &meta.SinkCredential_Aws{
+					Aws: akSK,
+				},
+			}
+		case GCloudCredentialType:
+			var m map[string]string
+			err := json.Unmarshal([]byte(sinkCredential), &m)
+			if err != nil {
+				cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+			}
+			credential = &meta.SinkCredential{
+				CredentialType: meta.SinkCredential_GCLOUD,
+				Credential: &meta.SinkCredential_Gcloud{
+					Gcloud: &meta.GCloudCredential{
+						CredentialsJson: sinkCredential,
+					},
+				},
+			}
+		case PlainCredentialType:
+			var plain *meta.PlainCredential
+			err := json.Unmarshal([]byte(sinkCredential), &plain)
+			if err != nil {
+				cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+			}
+			if plain.Identifier == "" || plain.Secret == "" {
+				cmdFailedf(cmd, "credential-type is plain, identifier and secret must not be empty\n")
+			}
+			credential = &meta.SinkCredential{
+				CredentialType: meta.SinkCredential_PLAIN,
+				Credential: &meta.SinkCredential_Plain{
+					Plain: plain,
+				},
+			}
+		default:
+			cmdFailedf(cmd, "credential-type is invalid\n")
+		}
+	}
+	return credential
+}
+
 func deleteSubscriptionCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
@@ -241,9 +373,8 @@ func deleteSubscriptionCommand() *cobra.Command {
 				cmdFailedf(cmd, "delete subscription failed: %s", err)
 			}
 
-			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"subscription_id": subscriptionIDStr})
-				color.Green(string(data))
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, map[string]interface{}{"subscription_id": subscriptionIDStr})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"subscription_id"})
@@ -253,8 +384,8 @@ func deleteSubscriptionCommand() *cobra.Command {
 				})
 				t.SetOutputMirror(os.Stdout)
 				t.Render()
+				color.Green("delete subscription: %s success\n", subscriptionIDStr)
 			}
-			color.Green("delete subscription: %d success\n", subscriptionIDStr)
 		},
 	}
 	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to deleting")
@@ -278,12 +409,208 @@ func getSubscriptionCommand() *cobra.Command {
 				cmdFailedf(cmd, "get subscription info failed: %s", err)
 			}
 			printSubscription(cmd, false, true, true, res)
+			if detail && !IsFormatStructured(cmd) {
+				printSubscriptionLag(res)
+			}
 		},
 	}
 	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	cmd.Flags().BoolVar(&detail, "detail", false, "show lag of subscription")
 	return cmd
 }
 
+func exportSubscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export the subscription definition and offsets as checkpoint",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+			res, err := client.ExportSubscription(context.Background(), &ctrlpb.ExportSubscriptionRequest{
+				Id: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "export subscription failed: %s", err)
+			}
+			data, err := protojson.MarshalOptions{Multiline: true}.Marshal(res.Checkpoint)
+			if err != nil {
+				cmdFailedf(cmd, "marshal checkpoint failed: %s", err)
+			}
+			if checkpointFile == "" {
+				fmt.Println(string(data))
+				return
+			}
+			if err = ioutil.WriteFile(checkpointFile, data, 0o600); err != nil {
+				cmdFailedf(cmd, "write checkpoint file failed: %s", err)
+			}
+			color.Green("export subscription %s to %s success\n", id.String(), checkpointFile)
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	cmd.Flags().StringVar(&checkpointFile, "file", "", "the file which checkpoint write to, default is stdout")
+	return cmd
+}
+
+func importSubscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "create a subscription from the checkpoint and consume from its offsets",
+		Run: func(cmd *cobra.Command, args []string) {
+			if checkpointFile == "" {
+				cmdFailedWithHelpNotice(cmd, "checkpoint file can't be empty\n")
+			}
+			data, err := ioutil.ReadFile(checkpointFile)
+			if err != nil {
+				cmdFailedf(cmd, "read checkpoint file failed: %s", err)
+			}
+			checkpoint := &ctrlpb.SubscriptionCheckpoint{}
+			if err = protojson.Unmarshal(data, checkpoint); err != nil {
+				cmdFailedf(cmd, "the checkpoint invalid: %s", err)
+			}
+			res, err := client.ImportSubscription(context.Background(), &ctrlpb.ImportSubscriptionRequest{
+				Checkpoint:     checkpoint,
+				EventBus:       eventbus,
+				Name:           subscriptionName,
+				SinkCredential: getSinkCredential(cmd),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "import subscription failed: %s", err)
+			}
+			printSubscription(cmd, false, false, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&checkpointFile, "file", "", "the checkpoint file exported by subscription export")
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "override the eventbus of checkpoint")
+	cmd.Flags().StringVar(&subscriptionName, "name", "", "override the subscription name of checkpoint")
+	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud or plain")
+	cmd.Flags().StringVar(&sinkCredential, "credential", "", "sink credential info, JSON format or @file, "+
+		"required if the sink of checkpoint has credential")
+	return cmd
+}
+
+func previewSubscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "run the filter and transformer of subscription against the latest events without invoking sink",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+			res, err := client.PreviewSubscription(context.Background(), &proxypb.PreviewSubscriptionRequest{
+				SubscriptionId: id.Uint64(),
+				Sample:         previewSample,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "preview subscription failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				for _, r := range res.Results {
+					PrintStructured(cmd, map[string]interface{}{
+						"Eventlog": formatID(r.EventlogId),
+						"Offset":   r.Offset,
+						"Filter":   r.FilterResult,
+						"Payload":  string(r.TransformerResult),
+						"Error":    r.Error,
+					})
+				}
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Eventlog", "Offset", "Filter", "Payload"})
+			for _, r := range res.Results {
+				payload := string(r.TransformerResult)
+				if r.Error != "" {
+					payload = "transform error: " + r.Error
+				}
+				t.AppendRow(table.Row{formatID(r.EventlogId), r.Offset, r.FilterResult, payload})
+				t.AppendSeparator()
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, AlignHeader: text.AlignCenter},
+			})
+			t.SetOutputMirror(os.Stdout)
+			t.Render()
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	cmd.Flags().Int32Var(&previewSample, "sample", 10, "the number of latest events sampled from each eventlog")
+	return cmd
+}
+
+func tailSubscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "stream the recent delivery results of subscription from the trigger worker it's running on",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			stream, err := client.TailSubscription(ctx, &trigger.TailSubscriptionRequest{SubscriptionId: id.Uint64()})
+			if err != nil {
+				cmdFailedf(cmd, "tail subscription failed: %s", err)
+			}
+			if !IsFormatStructured(cmd) {
+				fmt.Printf("%-24s %-36s %-6s %-12s %-7s %s\n", "TIME", "EVENT_ID", "STATUS", "LATENCY", "RETRIES", "ERROR")
+			}
+			for {
+				r, err := stream.Recv()
+				if err == io.EOF || ctx.Err() != nil {
+					return
+				}
+				if err != nil {
+					cmdFailedf(cmd, "tail subscription failed: %s", err)
+				}
+				latency := time.Duration(r.Latency) * time.Microsecond
+				if IsFormatStructured(cmd) {
+					PrintStructured(cmd, map[string]interface{}{
+						"Time":          time.UnixMilli(r.Time).Format(time.RFC3339Nano),
+						"EventID":       r.EventId,
+						"StatusCode":    r.StatusCode,
+						"Latency":       latency.String(),
+						"RetryAttempts": r.RetryAttempts,
+						"Error":         r.Error,
+					})
+					continue
+				}
+				line := fmt.Sprintf("%-24s %-36s %-6d %-12s %-7d %s", time.UnixMilli(r.Time).Format("2006-01-02T15:04:05.000"),
+					r.EventId, r.StatusCode, latency.String(), r.RetryAttempts, r.Error)
+				if r.Error != "" {
+					color.Red(line)
+				} else {
+					fmt.Println(line)
+				}
+			}
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id")
+	return cmd
+}
+
+func printSubscriptionLag(sub *meta.Subscription) {
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"lag_events", "lag_time", "latency_p50", "latency_p99"})
+	t.AppendRow(table.Row{sub.LagEvents, (time.Duration(sub.LagTime) * time.Millisecond).String(),
+		(time.Duration(sub.DeliveryLatencyP50) * time.Millisecond).String(),
+		(time.Duration(sub.DeliveryLatencyP99) * time.Millisecond).String()})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
 func listSubscriptionCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -300,9 +627,8 @@ func listSubscriptionCommand() *cobra.Command {
 }
 
 func printSubscription(cmd *cobra.Command, showNo, showFilters, showTransformer bool, data ...*metapb.Subscription) {
-	if IsFormatJSON(cmd) {
-		data, _ := json.Marshal(data)
-		color.Green(string(data))
+	if IsFormatStructured(cmd) {
+		PrintStructured(cmd, data)
 	} else {
 		t := table.NewWriter()
 		header := getSubscriptionHeader(showNo)
@@ -367,11 +693,25 @@ func getSubscriptionRow(sub *meta.Subscription) []interface{} {
 		protocol = "aws-lambda"
 	case meta.Protocol_GCLOUD_FUNCTIONS:
 		protocol = "gcloud-functions"
+	case meta.Protocol_AMQP:
+		protocol = "amqp"
+	case meta.Protocol_ELASTICSEARCH:
+		protocol = "elasticsearch"
+	case meta.Protocol_OBJECT_STORAGE:
+		protocol = "object-storage"
+	case meta.Protocol_SQL:
+		protocol = "sql"
+	case meta.Protocol_CHAT:
+		protocol = "chat"
 	}
 	result = append(result, protocol)
 
-	sinkCredential, _ := json.MarshalIndent(sub.SinkCredential, "", "  ")
-	result = append(result, string(sinkCredential))
+	if sub.SinkSecret != "" {
+		result = append(result, "secret: "+sub.SinkSecret)
+	} else {
+		sinkCredential, _ := json.MarshalIndent(sub.SinkCredential, "", "  ")
+		result = append(result, string(sinkCredential))
+	}
 
 	cfg, _ := json.MarshalIndent(sub.Config, "", "  ")
 	result = append(result, string(cfg))
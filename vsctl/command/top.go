@@ -0,0 +1,184 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/linkall-labs/vanus/proto/pkg/meta"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// clearScreen moves the cursor to top-left and clears the terminal.
+	clearScreen        = "\033[H\033[2J"
+	minTopInterval     = 500 * time.Millisecond
+	defaultTopInterval = 2 * time.Second
+)
+
+func clusterTopCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "live view of cluster health, press Ctrl+C to exit, it prints a snapshot if the output is json or yaml",
+		Run: func(cmd *cobra.Command, args []string) {
+			if topInterval < minTopInterval {
+				cmdFailedf(cmd, "the interval must be greater than or equal to %s", minTopInterval)
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			// the structured output is a snapshot of the cluster for scripts.
+			if IsFormatStructured(cmd) {
+				stats, subs, err := collectTopStats(ctx)
+				if err != nil {
+					cmdFailedf(cmd, "%s", err)
+				}
+				PrintStructured(cmd, map[string]interface{}{"stats": stats, "subscriptions": subs})
+				return
+			}
+
+			ticker := time.NewTicker(topInterval)
+			defer ticker.Stop()
+			var prev *ctrlpb.ClusterStats
+			for {
+				cur, subs, err := collectTopStats(ctx)
+				if ctx.Err() != nil {
+					return
+				}
+				fmt.Print(clearScreen + renderTop(prev, cur, subs, err))
+				if err == nil {
+					prev = cur
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+	cmd.Flags().DurationVar(&topInterval, "interval", defaultTopInterval, "the interval of refreshing")
+	return cmd
+}
+
+func collectTopStats(ctx context.Context) (*ctrlpb.ClusterStats, []*meta.Subscription, error) {
+	stats, err := client.GetClusterStats(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get cluster stats failed: %w", err)
+	}
+	res, err := client.ListSubscription(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("list subscription failed: %w", err)
+	}
+	// the lag is only calculated when getting a subscription.
+	subs := make([]*meta.Subscription, 0, len(res.Subscription))
+	for _, sub := range res.Subscription {
+		s, err := client.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: sub.Id})
+		if err != nil {
+			continue
+		}
+		subs = append(subs, s)
+	}
+	return stats, subs, nil
+}
+
+func renderTop(prev, cur *ctrlpb.ClusterStats, subs []*meta.Subscription, err error) string {
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "vanus cluster top - %s, refresh every %s\n", time.Now().Format(time.RFC3339), topInterval)
+	if err != nil {
+		fmt.Fprintf(sb, "ERROR: %s\n", err)
+		return sb.String()
+	}
+	fmt.Fprintf(sb, "controller leader: %s\n\n", cur.ControllerLeader)
+
+	t := newTopTable(sb)
+	t.SetTitle("Segment Servers")
+	t.AppendHeader(table.Row{"Address", "Volume", "Capacity", "Used", "Usage", "Blocks", "Leaders"})
+	for _, ss := range cur.SegmentServers {
+		t.AppendRow(table.Row{ss.Address, formatID(ss.VolumeId), formatBytes(ss.Capacity), formatBytes(ss.Used),
+			formatPercent(ss.Used, ss.Capacity), ss.BlockNumber, ss.LeaderNumber})
+	}
+	t.Render()
+	sb.WriteString("\n")
+
+	prevEvents := map[uint64]int64{}
+	var elapsed float64
+	if prev != nil {
+		elapsed = float64(cur.Timestamp-prev.Timestamp) / float64(time.Second/time.Millisecond)
+		for _, eb := range prev.Eventbuses {
+			prevEvents[eb.Id] = eb.EventNumber
+		}
+	}
+	t = newTopTable(sb)
+	t.SetTitle("Eventbuses")
+	t.AppendHeader(table.Row{"Name", "Eventlogs", "Events", "Throughput(events/s)"})
+	for _, eb := range cur.Eventbuses {
+		throughput := "-"
+		if n, ok := prevEvents[eb.Id]; ok && elapsed > 0 {
+			throughput = fmt.Sprintf("%.2f", float64(eb.EventNumber-n)/elapsed)
+		}
+		t.AppendRow(table.Row{eb.Name, eb.EventlogNumber, eb.EventNumber, throughput})
+	}
+	t.Render()
+	sb.WriteString("\n")
+
+	t = newTopTable(sb)
+	t.SetTitle("Subscriptions")
+	t.AppendHeader(table.Row{"ID", "Name", "Eventbus", "Lag Events", "Lag Time"})
+	for _, sub := range subs {
+		t.AppendRow(table.Row{formatID(sub.Id), sub.Name, sub.EventBus, sub.LagEvents,
+			(time.Duration(sub.LagTime) * time.Millisecond).String()})
+	}
+	t.Render()
+	return sb.String()
+}
+
+func newTopTable(sb *strings.Builder) table.Writer {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleLight)
+	t.Style().Title.Align = text.AlignCenter
+	t.SetOutputMirror(sb)
+	return t
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func formatPercent(used, capacity int64) string {
+	if capacity <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(used)*100/float64(capacity))
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+func traceEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace <eventbus-name> ",
+		Short: "look up where an event went, the subscriptions matched it and the deliveries of it",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			if eventID == "" {
+				cmdFailedWithHelpNotice(cmd, "event-id can't be empty\n")
+			}
+			res, err := client.TraceEvent(context.Background(), &proxypb.TraceEventRequest{
+				Eventbus: args[0],
+				EventId:  eventID,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "trace event failed: %s", err)
+			}
+			if IsFormatStructured(cmd) {
+				PrintStructured(cmd, res)
+				return
+			}
+			fmt.Printf("eventlog: %s, offset: %d\n%s\n", vanus.NewIDFromUint64(res.EventlogId).String(),
+				res.Offset, format(res.Event))
+			printSubscriptionTraces(res.Subscriptions)
+		},
+	}
+	cmd.Flags().StringVar(&eventID, "event-id", "", "the event id returned when the event was published")
+	return cmd
+}
+
+func printSubscriptionTraces(traces []*proxypb.SubscriptionTrace) {
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Subscription", "Name", "Matched", "Committed", "Trigger Worker", "Deliveries"})
+	for _, st := range traces {
+		t.AppendRow(table.Row{vanus.NewIDFromUint64(st.SubscriptionId).String(), st.Name, st.FilterResult,
+			st.Committed, st.TriggerWorker, formatDeliveries(st)})
+		t.AppendSeparator()
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 5, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 6, AlignHeader: text.AlignCenter},
+	})
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
+func formatDeliveries(st *proxypb.SubscriptionTrace) string {
+	switch {
+	case !st.FilterResult:
+		return "-"
+	case st.Error != "":
+		return color.RedString(st.Error)
+	case st.TriggerWorker == "":
+		return "not running"
+	case !st.TraceEnabled:
+		return "not traced"
+	case len(st.Deliveries) == 0:
+		return "not delivered"
+	}
+	var s string
+	for idx, r := range st.Deliveries {
+		if idx > 0 {
+			s += "\n"
+		}
+		s += fmt.Sprintf("%s status=%d latency=%s retries=%d",
+			time.UnixMilli(r.Time).Format("2006-01-02T15:04:05.000"), r.StatusCode,
+			(time.Duration(r.Latency) * time.Microsecond).String(), r.RetryAttempts)
+		if r.Error != "" {
+			s += " error=" + r.Error
+		}
+	}
+	return s
+}
@@ -15,25 +15,56 @@
 package command
 
 import (
+	"bytes"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
 )
 
+// The exit codes of vsctl, scripts can tell why a command failed by them.
+const (
+	ExitCodeSuccess = 0
+	// ExitCodeServerError means the request failed in the server or the server can't be reached.
+	ExitCodeServerError = 1
+	// ExitCodeInvalidArgument means the flags or arguments of the command are invalid.
+	ExitCodeInvalidArgument = 2
+	// ExitCodeNotFound means the resource operated by the command doesn't exist.
+	ExitCodeNotFound = 3
+	// ExitCodeAlreadyExists means the resource created by the command has existed.
+	ExitCodeAlreadyExists = 4
+)
+
+// cmdFailedf prints the error and exits. The exit code is determined by the error in args, if there
+// isn't an error, the failure is caused by invalid arguments.
 func cmdFailedf(cmd *cobra.Command, format string, a ...interface{}) {
+	code := ExitCodeInvalidArgument
+	for _, v := range a {
+		if err, ok := v.(error); ok {
+			code = exitCodeOf(err)
+			break
+		}
+	}
+	cmdFailedWithExitCode(cmd, code, format, a...)
+}
+
+func cmdFailedWithExitCode(cmd *cobra.Command, code int, format string, a ...interface{}) {
 	errStr := format
 	if a != nil {
 		errStr = fmt.Sprintf(format, a...)
 	}
-	if IsFormatJSON(cmd) {
-		m := map[string]string{"ERROR": errStr}
-		data, _ := json.Marshal(m)
-		color.Red(string(data))
+	if IsFormatStructured(cmd) {
+		writeStructured(cmd, os.Stderr, map[string]interface{}{"ERROR": errStr, "EXIT_CODE": code})
 	} else {
 		t := table.NewWriter()
 		t.AppendHeader(table.Row{"ERROR"})
@@ -42,16 +73,101 @@ func cmdFailedf(cmd *cobra.Command, format string, a ...interface{}) {
 			{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 			{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 		})
-		t.SetOutputMirror(os.Stdout)
+		t.SetOutputMirror(os.Stderr)
 		t.Render()
 	}
 
-	os.Exit(-1)
+	os.Exit(code)
 }
 
 func cmdFailedWithHelpNotice(cmd *cobra.Command, format string) {
 	color.White(format)
 	color.Cyan("\n============ see below for right usage ============\n\n")
 	_ = cmd.Help()
-	os.Exit(-1)
+	os.Exit(ExitCodeInvalidArgument)
+}
+
+// exitCodeOf classifies the error returned by gateway, the errors aren't returned by gateway are
+// caused by the local input, e.g. an invalid file.
+func exitCodeOf(err error) int {
+	var se interface{ GRPCStatus() *status.Status }
+	if !stderrors.As(err, &se) {
+		return ExitCodeInvalidArgument
+	}
+	s := se.GRPCStatus()
+	switch s.Code() {
+	case codes.NotFound:
+		return ExitCodeNotFound
+	case codes.AlreadyExists:
+		return ExitCodeAlreadyExists
+	case codes.InvalidArgument, codes.OutOfRange:
+		return ExitCodeInvalidArgument
+	}
+	et, ok := errors.Convert(s.Message())
+	if !ok {
+		return ExitCodeServerError
+	}
+	switch et.Code / 100 * 100 {
+	case errors.ErrorCode_INVALID_REQUEST:
+		return ExitCodeInvalidArgument
+	case errors.ErrorCode_RESOURCE_EXIST:
+		return ExitCodeAlreadyExists
+	case errors.ErrorCode_RESOURCE_NOT_FOUND:
+		return ExitCodeNotFound
+	}
+	return ExitCodeServerError
+}
+
+// PrintStructured prints v to stdout as json or yaml, see writeStructured.
+func PrintStructured(cmd *cobra.Command, v interface{}) {
+	writeStructured(cmd, os.Stdout, v)
+}
+
+// writeStructured writes v as a json line or a yaml document. The yaml is converted from the json, so
+// both formats share the same schema.
+func writeStructured(cmd *cobra.Command, w io.Writer, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "marshal output failed: %s\n", err)
+		os.Exit(ExitCodeServerError)
+	}
+	if OutputFormat(cmd) != FormatYAML {
+		_, _ = fmt.Fprintln(w, string(data))
+		return
+	}
+	if data, err = jsonToYAML(data); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "convert output to yaml failed: %s\n", err)
+		os.Exit(ExitCodeServerError)
+	}
+	_, _ = fmt.Fprint(w, "---\n"+string(data))
+}
+
+func jsonToYAML(data []byte) ([]byte, error) {
+	// json is a subset of yaml, the styles of nodes are reset to print it in block style.
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal(data, node); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(node)
+	return marshalYAML(node)
+}
+
+func marshalYAML(v interface{}) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetYAMLStyle(n)
+	}
 }
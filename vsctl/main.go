@@ -44,20 +44,40 @@ func init() {
 		"127.0.0.1:8080", "the endpoints of vanus controller")
 	rootCmd.PersistentFlags().StringVarP(&globalFlags.ConfigFile, "config", "C",
 		"~/.vanus/vanus.yml", "the config file of vsctl")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.Context, "context", "",
+		"the context of config file to use, default is the current context")
 	rootCmd.PersistentFlags().BoolVarP(&globalFlags.Debug, "debug", "D", false,
 		"is debug mode enable")
-	rootCmd.PersistentFlags().StringVar(&globalFlags.Format, "format", "table",
-		"the output format of vsctl, json or table")
+	rootCmd.PersistentFlags().StringVarP(&globalFlags.Format, "output", "o", command.FormatTable,
+		"the output format of vsctl, json, yaml or table, vsctl event get supports raw to print data of events only")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.Format, "format", command.FormatTable,
+		"the output format of vsctl")
+	_ = rootCmd.PersistentFlags().MarkDeprecated("format", "use --output instead")
+	cobra.OnInitialize(func() {
+		if err := command.ValidateOutputFormat(globalFlags.Format); err != nil {
+			color.Red(err.Error())
+			os.Exit(command.ExitCodeInvalidArgument)
+		}
+	})
 
-	if os.Getenv("VANUS_GATEWAY") != "" {
-		globalFlags.Endpoint = os.Getenv("VANUS_GATEWAY")
+	if os.Getenv(command.EnvGatewayEndpoint) != "" {
+		globalFlags.Endpoint = os.Getenv(command.EnvGatewayEndpoint)
 	}
 
 	rootCmd.AddCommand(
 		command.NewEventCommand(),
 		command.NewEventbusCommand(),
 		command.NewSubscriptionCommand(),
+		command.NewCronCommand(),
+		command.NewReplayCommand(),
+		command.NewPollerCommand(),
+		command.NewSecretCommand(),
+		command.NewConnectorCommand(),
+		command.NewAllowlistCommand(),
+		command.NewFeatureFlagCommand(),
 		command.NewClusterCommand(),
+		command.NewConfigCommand(),
+		command.NewDebugCommand(),
 		newVersionCommand(),
 	)
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
@@ -74,6 +94,6 @@ func Start() error {
 func MustStart() {
 	if err := Start(); err != nil {
 		color.Red("vsctl run error: %s", err)
-		os.Exit(-1)
+		os.Exit(command.ExitCodeInvalidArgument)
 	}
 }
@@ -15,10 +15,8 @@
 package main
 
 import (
-	"encoding/json"
 	"os"
 
-	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/linkall-labs/vanus/vsctl/command"
@@ -38,7 +36,7 @@ func newVersionCommand() *cobra.Command {
 		Use:   "version",
 		Short: "get vsctl version info",
 		Run: func(cmd *cobra.Command, args []string) {
-			if !command.IsFormatJSON(cmd) {
+			if !command.IsFormatStructured(cmd) {
 				t := table.NewWriter()
 				t.AppendRow(table.Row{"Version", Version})
 				t.AppendRow(table.Row{"Platform", Platform})
@@ -59,8 +57,7 @@ func newVersionCommand() *cobra.Command {
 					"BuildDate": BuildDate,
 					"GoVersion": GoVersion,
 				}
-				data, _ := json.MarshalIndent(info, "", "  ")
-				color.Green(string(data))
+				command.PrintStructured(cmd, info)
 			}
 		},
 	}